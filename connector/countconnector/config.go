@@ -6,6 +6,7 @@ package countconnector // import "github.com/open-telemetry/opentelemetry-collec
 import (
 	"errors"
 	"fmt"
+	"time"
 
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/confmap"
@@ -13,6 +14,11 @@ import (
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/filter/filterottl"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottldatapoint"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottllog"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlprofile"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlspan"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlspanevent"
 )
 
 // Default metrics are emitted if no conditions are specified.
@@ -42,6 +48,33 @@ type Config struct {
 	DataPoints map[string]MetricInfo `mapstructure:"datapoints"`
 	Logs       map[string]MetricInfo `mapstructure:"logs"`
 	Profiles   map[string]MetricInfo `mapstructure:"profiles"`
+	// Window configures the aggregation of counts over time. By default, the
+	// counts of each batch of telemetry are emitted as delta sums.
+	Window WindowConfig `mapstructure:"window"`
+	// prevent unkeyed literal initialization
+	_ struct{}
+}
+
+// Window types
+const (
+	windowTypeSliding = "sliding"
+	windowTypeDecay   = "decay"
+
+	defaultWindowInterval = 10 * time.Second
+)
+
+// WindowConfig configures the aggregation of counts over time windows.
+type WindowConfig struct {
+	// Type is "sliding" to emit the counts observed during the last Size, or
+	// "decay" to emit counts that decay exponentially with HalfLife. When
+	// empty, counts are not aggregated over time.
+	Type string `mapstructure:"type"`
+	// Interval is how often windowed counts are emitted. Default 10s.
+	Interval time.Duration `mapstructure:"interval"`
+	// Size is the length of a sliding window. It must be a multiple of Interval.
+	Size time.Duration `mapstructure:"size"`
+	// HalfLife is the time it takes for a count to decay to half of its value.
+	HalfLife time.Duration `mapstructure:"half_life"`
 	// prevent unkeyed literal initialization
 	_ struct{}
 }
@@ -56,7 +89,11 @@ type MetricInfo struct {
 }
 
 type AttributeConfig struct {
-	Key          string `mapstructure:"key"`
+	Key string `mapstructure:"key"`
+	// Value is an optional OTTL value expression, evaluated in the same context
+	// as the conditions, which computes the attribute value. When empty, the
+	// value of the attribute named Key is used.
+	Value        string `mapstructure:"value"`
 	DefaultValue any    `mapstructure:"default_value"`
 	// prevent unkeyed literal initialization
 	_ struct{}
@@ -70,7 +107,7 @@ func (c *Config) Validate() error {
 		if _, err := filterottl.NewBoolExprForSpan(info.Conditions, filterottl.StandardSpanFuncs(), ottl.PropagateError, component.TelemetrySettings{Logger: zap.NewNop()}); err != nil {
 			return fmt.Errorf("spans condition: metric %q: %w", name, err)
 		}
-		if err := info.validateAttributes(); err != nil {
+		if err := validateAttributes(info.Attributes, ottlspan.NewParser, filterottl.StandardSpanFuncs()); err != nil {
 			return fmt.Errorf("spans attributes: metric %q: %w", name, err)
 		}
 	}
@@ -81,7 +118,7 @@ func (c *Config) Validate() error {
 		if _, err := filterottl.NewBoolExprForSpanEvent(info.Conditions, filterottl.StandardSpanEventFuncs(), ottl.PropagateError, component.TelemetrySettings{Logger: zap.NewNop()}); err != nil {
 			return fmt.Errorf("spanevents condition: metric %q: %w", name, err)
 		}
-		if err := info.validateAttributes(); err != nil {
+		if err := validateAttributes(info.Attributes, ottlspanevent.NewParser, filterottl.StandardSpanEventFuncs()); err != nil {
 			return fmt.Errorf("spanevents attributes: metric %q: %w", name, err)
 		}
 	}
@@ -104,8 +141,8 @@ func (c *Config) Validate() error {
 		if _, err := filterottl.NewBoolExprForDataPoint(info.Conditions, filterottl.StandardDataPointFuncs(), ottl.PropagateError, component.TelemetrySettings{Logger: zap.NewNop()}); err != nil {
 			return fmt.Errorf("datapoints condition: metric %q: %w", name, err)
 		}
-		if err := info.validateAttributes(); err != nil {
-			return fmt.Errorf("spans attributes: metric %q: %w", name, err)
+		if err := validateAttributes(info.Attributes, ottldatapoint.NewParser, filterottl.StandardDataPointFuncs()); err != nil {
+			return fmt.Errorf("datapoints attributes: metric %q: %w", name, err)
 		}
 	}
 	for name, info := range c.Logs {
@@ -115,7 +152,7 @@ func (c *Config) Validate() error {
 		if _, err := filterottl.NewBoolExprForLog(info.Conditions, filterottl.StandardLogFuncs(), ottl.PropagateError, component.TelemetrySettings{Logger: zap.NewNop()}); err != nil {
 			return fmt.Errorf("logs condition: metric %q: %w", name, err)
 		}
-		if err := info.validateAttributes(); err != nil {
+		if err := validateAttributes(info.Attributes, ottllog.NewParser, filterottl.StandardLogFuncs()); err != nil {
 			return fmt.Errorf("logs attributes: metric %q: %w", name, err)
 		}
 	}
@@ -126,22 +163,56 @@ func (c *Config) Validate() error {
 		if _, err := filterottl.NewBoolExprForProfile(info.Conditions, filterottl.StandardProfileFuncs(), ottl.PropagateError, component.TelemetrySettings{Logger: zap.NewNop()}); err != nil {
 			return fmt.Errorf("profiles condition: metric %q: %w", name, err)
 		}
-		if err := info.validateAttributes(); err != nil {
+		if err := validateAttributes(info.Attributes, ottlprofile.NewParser, filterottl.StandardProfileFuncs()); err != nil {
 			return fmt.Errorf("profiles attributes: metric %q: %w", name, err)
 		}
 	}
-	return nil
+	return c.Window.Validate()
 }
 
-func (i *MetricInfo) validateAttributes() error {
-	for _, attr := range i.Attributes {
+func validateAttributes[K any](attrs []AttributeConfig, newParser parserFactory[K], functions map[string]ottl.Factory[K]) error {
+	for _, attr := range attrs {
 		if attr.Key == "" {
 			return errors.New("attribute key missing")
 		}
 	}
+	_, err := parseAttributeValues(attrs, newParser, functions, component.TelemetrySettings{Logger: zap.NewNop()})
+	return err
+}
+
+// Validate checks the window configuration.
+func (w *WindowConfig) Validate() error {
+	if w.Type == "" {
+		return nil
+	}
+	if w.Interval < 0 {
+		return errors.New("window: interval must not be negative")
+	}
+	switch w.Type {
+	case windowTypeSliding:
+		if w.Size <= 0 {
+			return errors.New("window: size must be positive for a sliding window")
+		}
+		if w.Size%w.interval() != 0 {
+			return fmt.Errorf("window: size %v must be a multiple of interval %v", w.Size, w.interval())
+		}
+	case windowTypeDecay:
+		if w.HalfLife <= 0 {
+			return errors.New("window: half_life must be positive for a decay window")
+		}
+	default:
+		return fmt.Errorf("window: unsupported type %q", w.Type)
+	}
 	return nil
 }
 
+func (w *WindowConfig) interval() time.Duration {
+	if w.Interval <= 0 {
+		return defaultWindowInterval
+	}
+	return w.Interval
+}
+
 var _ confmap.Unmarshaler = (*Config)(nil)
 
 // Unmarshal with custom logic to set default values.
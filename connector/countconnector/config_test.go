@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -432,6 +433,55 @@ func TestLoadConfig(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "window",
+			expect: &Config{
+				Spans: map[string]MetricInfo{
+					"span.count.by_status": {
+						Description: "Span count by status class.",
+						Attributes: []AttributeConfig{
+							{
+								Key:   "http.status_class",
+								Value: `Concat([Substring(Format("%d", [attributes["http.response.status_code"]]), 0, 1), "xx"], "")`,
+							},
+							{
+								Key: "env",
+							},
+						},
+					},
+				},
+				SpanEvents: map[string]MetricInfo{
+					defaultMetricNameSpanEvents: {
+						Description: defaultMetricDescSpanEvents,
+					},
+				},
+				Metrics: map[string]MetricInfo{
+					defaultMetricNameMetrics: {
+						Description: defaultMetricDescMetrics,
+					},
+				},
+				DataPoints: map[string]MetricInfo{
+					defaultMetricNameDataPoints: {
+						Description: defaultMetricDescDataPoints,
+					},
+				},
+				Logs: map[string]MetricInfo{
+					defaultMetricNameLogs: {
+						Description: defaultMetricDescLogs,
+					},
+				},
+				Profiles: map[string]MetricInfo{
+					defaultMetricNameProfiles: {
+						Description: defaultMetricDescProfiles,
+					},
+				},
+				Window: WindowConfig{
+					Type:     "decay",
+					Interval: 30 * time.Second,
+					HalfLife: 5 * time.Minute,
+				},
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -595,6 +645,75 @@ func TestConfigErrors(t *testing.T) {
 			},
 			expect: fmt.Sprintf("profiles condition: metric %q: unable to parse OTTL condition", defaultMetricNameProfiles),
 		},
+		{
+			name: "invalid_attribute_value_span",
+			input: &Config{
+				Spans: map[string]MetricInfo{
+					defaultMetricNameSpans: {
+						Description: defaultMetricDescSpans,
+						Attributes: []AttributeConfig{
+							{
+								Key:   "status",
+								Value: "invalid expression(",
+							},
+						},
+					},
+				},
+			},
+			expect: fmt.Sprintf("spans attributes: metric %q: attribute %q", defaultMetricNameSpans, "status"),
+		},
+		{
+			name: "invalid_attribute_value_datapoint",
+			input: &Config{
+				DataPoints: map[string]MetricInfo{
+					defaultMetricNameDataPoints: {
+						Description: defaultMetricDescDataPoints,
+						Attributes: []AttributeConfig{
+							{
+								Key:   "bucket",
+								Value: `NoSuchFunction(attributes["x"])`,
+							},
+						},
+					},
+				},
+			},
+			expect: fmt.Sprintf("datapoints attributes: metric %q: attribute %q", defaultMetricNameDataPoints, "bucket"),
+		},
+		{
+			name: "unsupported_window_type",
+			input: &Config{
+				Window: WindowConfig{Type: "tumbling"},
+			},
+			expect: `window: unsupported type "tumbling"`,
+		},
+		{
+			name: "sliding_window_without_size",
+			input: &Config{
+				Window: WindowConfig{Type: "sliding"},
+			},
+			expect: "window: size must be positive for a sliding window",
+		},
+		{
+			name: "sliding_window_size_not_multiple_of_interval",
+			input: &Config{
+				Window: WindowConfig{Type: "sliding", Interval: 20 * time.Second, Size: 50 * time.Second},
+			},
+			expect: "window: size 50s must be a multiple of interval 20s",
+		},
+		{
+			name: "decay_window_without_half_life",
+			input: &Config{
+				Window: WindowConfig{Type: "decay"},
+			},
+			expect: "window: half_life must be positive for a decay window",
+		},
+		{
+			name: "negative_window_interval",
+			input: &Config{
+				Window: WindowConfig{Type: "decay", Interval: -time.Second, HalfLife: time.Minute},
+			},
+			expect: "window: interval must not be negative",
+		},
 	}
 
 	for _, tc := range testCases {
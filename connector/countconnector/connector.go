@@ -7,6 +7,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/consumer"
@@ -29,8 +30,10 @@ import (
 // profiles and emit the counts onto a metrics pipeline.
 type count struct {
 	metricsConsumer consumer.Metrics
-	component.StartFunc
-	component.ShutdownFunc
+
+	// window aggregates the counts over a time window before they are
+	// emitted, it is nil when no window is configured.
+	window *windowAggregator
 
 	spansMetricDefs      map[string]metricDef[ottlspan.TransformContext]
 	spanEventsMetricDefs map[string]metricDef[ottlspanevent.TransformContext]
@@ -44,6 +47,30 @@ func (*count) Capabilities() consumer.Capabilities {
 	return consumer.Capabilities{MutatesData: false}
 }
 
+func (c *count) Start(context.Context, component.Host) error {
+	if c.window != nil {
+		c.window.start()
+	}
+	return nil
+}
+
+func (c *count) Shutdown(ctx context.Context) error {
+	if c.window != nil {
+		return c.window.shutdown(ctx)
+	}
+	return nil
+}
+
+// emit sends the counts to the next consumer, or records them in the window
+// when one is configured.
+func (c *count) emit(ctx context.Context, countMetrics pmetric.Metrics) error {
+	if c.window != nil {
+		c.window.record(countMetrics, time.Now())
+		return nil
+	}
+	return c.metricsConsumer.ConsumeMetrics(ctx, countMetrics)
+}
+
 func (c *count) ConsumeTraces(ctx context.Context, td ptrace.Traces) error {
 	var multiError error
 	countMetrics := pmetric.NewMetrics()
@@ -86,7 +113,7 @@ func (c *count) ConsumeTraces(ctx context.Context, td ptrace.Traces) error {
 	if multiError != nil {
 		return multiError
 	}
-	return c.metricsConsumer.ConsumeMetrics(ctx, countMetrics)
+	return c.emit(ctx, countMetrics)
 }
 
 func (c *count) ConsumeMetrics(ctx context.Context, md pmetric.Metrics) error {
@@ -161,7 +188,7 @@ func (c *count) ConsumeMetrics(ctx context.Context, md pmetric.Metrics) error {
 	if multiError != nil {
 		return multiError
 	}
-	return c.metricsConsumer.ConsumeMetrics(ctx, countMetrics)
+	return c.emit(ctx, countMetrics)
 }
 
 func (c *count) ConsumeLogs(ctx context.Context, ld plog.Logs) error {
@@ -199,7 +226,7 @@ func (c *count) ConsumeLogs(ctx context.Context, ld plog.Logs) error {
 	if multiError != nil {
 		return multiError
 	}
-	return c.metricsConsumer.ConsumeMetrics(ctx, countMetrics)
+	return c.emit(ctx, countMetrics)
 }
 
 func (c *count) ConsumeProfiles(ctx context.Context, ld pprofile.Profiles) error {
@@ -238,5 +265,5 @@ func (c *count) ConsumeProfiles(ctx context.Context, ld pprofile.Profiles) error
 	if multiError != nil {
 		return multiError
 	}
-	return c.metricsConsumer.ConsumeMetrics(ctx, countMetrics)
+	return c.emit(ctx, countMetrics)
 }
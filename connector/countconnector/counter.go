@@ -6,11 +6,14 @@ package countconnector // import "github.com/open-telemetry/opentelemetry-collec
 import (
 	"context"
 	"errors"
+	"fmt"
 	"time"
 
+	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/pmetric"
 
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/pdatautil"
 )
 
@@ -39,8 +42,16 @@ func (c *counter[K]) update(ctx context.Context, attrs pcommon.Map, tCtx K) erro
 	var multiError error
 	for name, md := range c.metricDefs {
 		countAttrs := pcommon.NewMap()
-		for _, attr := range md.attrs {
-			if attrVal, ok := attrs.Get(attr.Key); ok {
+		for i, attr := range md.attrs {
+			if i < len(md.attrValues) && md.attrValues[i] != nil {
+				val, err := md.attrValues[i].Eval(ctx, tCtx)
+				if err != nil {
+					multiError = errors.Join(multiError, err)
+				} else if val != nil {
+					putValue(countAttrs, attr.Key, val)
+					continue
+				}
+			} else if attrVal, ok := attrs.Get(attr.Key); ok {
 				switch typeAttr := attrVal.Type(); typeAttr {
 				case pcommon.ValueTypeInt:
 					countAttrs.PutInt(attr.Key, attrVal.Int())
@@ -49,7 +60,9 @@ func (c *counter[K]) update(ctx context.Context, attrs pcommon.Map, tCtx K) erro
 				default:
 					countAttrs.PutStr(attr.Key, attrVal.Str())
 				}
-			} else if attr.DefaultValue != nil {
+				continue
+			}
+			if attr.DefaultValue != nil {
 				switch v := attr.DefaultValue.(type) {
 				case string:
 					if v != "" {
@@ -87,6 +100,62 @@ func (c *counter[K]) update(ctx context.Context, attrs pcommon.Map, tCtx K) erro
 	return multiError
 }
 
+// putValue sets an attribute to the result of an OTTL value expression.
+func putValue(m pcommon.Map, key string, val any) {
+	switch v := val.(type) {
+	case string:
+		m.PutStr(key, v)
+	case int64:
+		m.PutInt(key, v)
+	case float64:
+		m.PutDouble(key, v)
+	case bool:
+		m.PutBool(key, v)
+	case pcommon.Value:
+		switch v.Type() {
+		case pcommon.ValueTypeInt:
+			m.PutInt(key, v.Int())
+		case pcommon.ValueTypeDouble:
+			m.PutDouble(key, v.Double())
+		case pcommon.ValueTypeBool:
+			m.PutBool(key, v.Bool())
+		default:
+			m.PutStr(key, v.AsString())
+		}
+	default:
+		m.PutStr(key, fmt.Sprint(v))
+	}
+}
+
+// parserFactory creates an OTTL parser for a context.
+type parserFactory[K any] func(map[string]ottl.Factory[K], component.TelemetrySettings, ...ottl.Option[K]) (ottl.Parser[K], error)
+
+// parseAttributeValues parses the OTTL value expressions of attributes. The
+// result is aligned with attrs, with nil for attributes without an expression.
+func parseAttributeValues[K any](attrs []AttributeConfig, newParser parserFactory[K], functions map[string]ottl.Factory[K], set component.TelemetrySettings) ([]*ottl.ValueExpression[K], error) {
+	var parser *ottl.Parser[K]
+	var values []*ottl.ValueExpression[K]
+	for i, attr := range attrs {
+		if attr.Value == "" {
+			continue
+		}
+		if parser == nil {
+			p, err := newParser(functions, set)
+			if err != nil {
+				return nil, err
+			}
+			parser = &p
+			values = make([]*ottl.ValueExpression[K], len(attrs))
+		}
+		expr, err := parser.ParseValueExpression(attr.Value)
+		if err != nil {
+			return nil, fmt.Errorf("attribute %q: %w", attr.Key, err)
+		}
+		values[i] = expr
+	}
+	return values, nil
+}
+
 func (c *counter[K]) increment(metricName string, attrs pcommon.Map) error {
 	if _, ok := c.counts[metricName]; !ok {
 		c.counts[metricName] = make(map[[16]byte]*attrCounter)
@@ -56,6 +56,8 @@ func createTracesToMetrics(
 			desc:  info.Description,
 			attrs: info.Attributes,
 		}
+		// Error checked in Config.Validate()
+		md.attrValues, _ = parseAttributeValues(info.Attributes, ottlspan.NewParser, filterottl.StandardSpanFuncs(), set.TelemetrySettings)
 		if len(info.Conditions) > 0 {
 			// Error checked in Config.Validate()
 			condition, _ := filterottl.NewBoolExprForSpan(info.Conditions, filterottl.StandardSpanFuncs(), ottl.PropagateError, set.TelemetrySettings)
@@ -70,6 +72,8 @@ func createTracesToMetrics(
 			desc:  info.Description,
 			attrs: info.Attributes,
 		}
+		// Error checked in Config.Validate()
+		md.attrValues, _ = parseAttributeValues(info.Attributes, ottlspanevent.NewParser, filterottl.StandardSpanEventFuncs(), set.TelemetrySettings)
 		if len(info.Conditions) > 0 {
 			// Error checked in Config.Validate()
 			condition, _ := filterottl.NewBoolExprForSpanEvent(info.Conditions, filterottl.StandardSpanEventFuncs(), ottl.PropagateError, set.TelemetrySettings)
@@ -80,6 +84,7 @@ func createTracesToMetrics(
 
 	return &count{
 		metricsConsumer:      nextConsumer,
+		window:               newWindowAggregator(c.Window, nextConsumer, set.Logger),
 		spansMetricDefs:      spanMetricDefs,
 		spanEventsMetricDefs: spanEventMetricDefs,
 	}, nil
@@ -113,6 +118,8 @@ func createMetricsToMetrics(
 			desc:  info.Description,
 			attrs: info.Attributes,
 		}
+		// Error checked in Config.Validate()
+		md.attrValues, _ = parseAttributeValues(info.Attributes, ottldatapoint.NewParser, filterottl.StandardDataPointFuncs(), set.TelemetrySettings)
 		if len(info.Conditions) > 0 {
 			// Error checked in Config.Validate()
 			condition, _ := filterottl.NewBoolExprForDataPoint(info.Conditions, filterottl.StandardDataPointFuncs(), ottl.PropagateError, set.TelemetrySettings)
@@ -123,6 +130,7 @@ func createMetricsToMetrics(
 
 	return &count{
 		metricsConsumer:      nextConsumer,
+		window:               newWindowAggregator(c.Window, nextConsumer, set.Logger),
 		metricsMetricDefs:    metricMetricDefs,
 		dataPointsMetricDefs: dataPointMetricDefs,
 	}, nil
@@ -143,6 +151,8 @@ func createLogsToMetrics(
 			desc:  info.Description,
 			attrs: info.Attributes,
 		}
+		// Error checked in Config.Validate()
+		md.attrValues, _ = parseAttributeValues(info.Attributes, ottllog.NewParser, filterottl.StandardLogFuncs(), set.TelemetrySettings)
 		if len(info.Conditions) > 0 {
 			// Error checked in Config.Validate()
 			condition, _ := filterottl.NewBoolExprForLog(info.Conditions, filterottl.StandardLogFuncs(), ottl.PropagateError, set.TelemetrySettings)
@@ -153,6 +163,7 @@ func createLogsToMetrics(
 
 	return &count{
 		metricsConsumer: nextConsumer,
+		window:          newWindowAggregator(c.Window, nextConsumer, set.Logger),
 		logsMetricDefs:  metricDefs,
 	}, nil
 }
@@ -172,6 +183,8 @@ func createProfilesToMetrics(
 			desc:  info.Description,
 			attrs: info.Attributes,
 		}
+		// Error checked in Config.Validate()
+		md.attrValues, _ = parseAttributeValues(info.Attributes, ottlprofile.NewParser, filterottl.StandardProfileFuncs(), set.TelemetrySettings)
 		if len(info.Conditions) > 0 {
 			// Error checked in Config.Validate()
 			condition, _ := filterottl.NewBoolExprForProfile(info.Conditions, filterottl.StandardProfileFuncs(), ottl.PropagateError, set.TelemetrySettings)
@@ -182,12 +195,14 @@ func createProfilesToMetrics(
 
 	return &count{
 		metricsConsumer:    nextConsumer,
+		window:             newWindowAggregator(c.Window, nextConsumer, set.Logger),
 		profilesMetricDefs: metricDefs,
 	}, nil
 }
 
 type metricDef[K any] struct {
-	condition *ottl.ConditionSequence[K]
-	desc      string
-	attrs     []AttributeConfig
+	condition  *ottl.ConditionSequence[K]
+	desc       string
+	attrs      []AttributeConfig
+	attrValues []*ottl.ValueExpression[K]
 }
@@ -0,0 +1,235 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package countconnector // import "github.com/open-telemetry/opentelemetry-collector-contrib/connector/countconnector"
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/connector/countconnector/internal/metadata"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/pdatautil"
+)
+
+// minDecayedCount is the value below which a decayed count is dropped.
+const minDecayedCount = 0.01
+
+// windowAggregator accumulates the delta counts produced by the connector
+// and periodically emits them, aggregated over a time window, as gauges.
+type windowAggregator struct {
+	cfg      WindowConfig
+	interval time.Duration
+	next     consumer.Metrics
+	logger   *zap.Logger
+
+	mu     sync.Mutex
+	series map[seriesKey]*windowSeries
+
+	done chan struct{}
+	wg   sync.WaitGroup
+}
+
+type seriesKey struct {
+	name     string
+	resource [16]byte
+	attrs    [16]byte
+}
+
+type windowSeries struct {
+	resource pcommon.Map
+	name     string
+	desc     string
+	attrs    pcommon.Map
+
+	// buckets hold the counts of each interval of a sliding window, the
+	// current interval is at index head.
+	buckets []uint64
+	head    int
+
+	// value is the decaying count as of updated.
+	value   float64
+	updated time.Time
+}
+
+func newWindowAggregator(cfg WindowConfig, next consumer.Metrics, logger *zap.Logger) *windowAggregator {
+	if cfg.Type == "" {
+		return nil
+	}
+	return &windowAggregator{
+		cfg:      cfg,
+		interval: cfg.interval(),
+		next:     next,
+		logger:   logger,
+		series:   make(map[seriesKey]*windowSeries),
+	}
+}
+
+func (w *windowAggregator) start() {
+	w.done = make(chan struct{})
+	w.wg.Add(1)
+	go func() {
+		defer w.wg.Done()
+		ticker := time.NewTicker(w.interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-w.done:
+				return
+			case now := <-ticker.C:
+				if err := w.emit(context.Background(), now); err != nil {
+					w.logger.Error("failed to emit windowed counts", zap.Error(err))
+				}
+			}
+		}
+	}()
+}
+
+// shutdown stops the periodic emission and emits the counts still pending in
+// the window, so that they aren't lost.
+func (w *windowAggregator) shutdown(ctx context.Context) error {
+	if w.done == nil {
+		return nil
+	}
+	close(w.done)
+	w.wg.Wait()
+	return w.emit(ctx, time.Now())
+}
+
+// emit sends the windowed counts at time now to the next consumer.
+func (w *windowAggregator) emit(ctx context.Context, now time.Time) error {
+	md := w.flush(now)
+	if md.DataPointCount() == 0 {
+		return nil
+	}
+	return w.next.ConsumeMetrics(ctx, md)
+}
+
+// record adds the delta counts of md to their series.
+func (w *windowAggregator) record(md pmetric.Metrics, now time.Time) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	for i := 0; i < md.ResourceMetrics().Len(); i++ {
+		rm := md.ResourceMetrics().At(i)
+		resourceHash := pdatautil.MapHash(rm.Resource().Attributes())
+		for j := 0; j < rm.ScopeMetrics().Len(); j++ {
+			metrics := rm.ScopeMetrics().At(j).Metrics()
+			for k := 0; k < metrics.Len(); k++ {
+				m := metrics.At(k)
+				dps := m.Sum().DataPoints()
+				for l := 0; l < dps.Len(); l++ {
+					dp := dps.At(l)
+					key := seriesKey{
+						name:     m.Name(),
+						resource: resourceHash,
+						attrs:    pdatautil.MapHash(dp.Attributes()),
+					}
+					s, ok := w.series[key]
+					if !ok {
+						s = w.newSeries(rm.Resource().Attributes(), m, dp.Attributes(), now)
+						w.series[key] = s
+					}
+					s.add(w.cfg, uint64(dp.IntValue()), now)
+				}
+			}
+		}
+	}
+}
+
+func (w *windowAggregator) newSeries(resource pcommon.Map, m pmetric.Metric, attrs pcommon.Map, now time.Time) *windowSeries {
+	s := &windowSeries{
+		resource: pcommon.NewMap(),
+		name:     m.Name(),
+		desc:     m.Description(),
+		attrs:    pcommon.NewMap(),
+		updated:  now,
+	}
+	resource.CopyTo(s.resource)
+	attrs.CopyTo(s.attrs)
+	if w.cfg.Type == windowTypeSliding {
+		s.buckets = make([]uint64, w.cfg.Size/w.interval)
+	}
+	return s
+}
+
+func (s *windowSeries) add(cfg WindowConfig, n uint64, now time.Time) {
+	switch cfg.Type {
+	case windowTypeSliding:
+		s.buckets[s.head] += n
+	case windowTypeDecay:
+		s.value = s.decayed(cfg, now) + float64(n)
+		s.updated = now
+	}
+}
+
+// decayed returns the decaying count at time now.
+func (s *windowSeries) decayed(cfg WindowConfig, now time.Time) float64 {
+	elapsed := now.Sub(s.updated)
+	if elapsed <= 0 {
+		return s.value
+	}
+	return s.value * math.Exp2(-float64(elapsed)/float64(cfg.HalfLife))
+}
+
+// flush returns the current windowed counts as gauges, advances sliding
+// windows by one interval and forgets series whose count reached zero.
+func (w *windowAggregator) flush(now time.Time) pmetric.Metrics {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	md := pmetric.NewMetrics()
+	scopes := make(map[[16]byte]pmetric.MetricSlice)
+	timestamp := pcommon.NewTimestampFromTime(now)
+	for key, s := range w.series {
+		switch w.cfg.Type {
+		case windowTypeSliding:
+			var total uint64
+			for _, c := range s.buckets {
+				total += c
+			}
+			if total == 0 {
+				delete(w.series, key)
+				continue
+			}
+			appendGauge(md, scopes, key.resource, s, timestamp).SetIntValue(int64(total))
+			s.head = (s.head + 1) % len(s.buckets)
+			s.buckets[s.head] = 0
+		case windowTypeDecay:
+			value := s.decayed(w.cfg, now)
+			if value < minDecayedCount {
+				delete(w.series, key)
+				continue
+			}
+			appendGauge(md, scopes, key.resource, s, timestamp).SetDoubleValue(value)
+		}
+	}
+	return md
+}
+
+// appendGauge appends a gauge with a single data point for a series to md,
+// reusing the scope of a resource already present in scopes.
+func appendGauge(md pmetric.Metrics, scopes map[[16]byte]pmetric.MetricSlice, resource [16]byte, s *windowSeries, timestamp pcommon.Timestamp) pmetric.NumberDataPoint {
+	metrics, ok := scopes[resource]
+	if !ok {
+		rm := md.ResourceMetrics().AppendEmpty()
+		s.resource.CopyTo(rm.Resource().Attributes())
+		sm := rm.ScopeMetrics().AppendEmpty()
+		sm.Scope().SetName(metadata.ScopeName)
+		metrics = sm.Metrics()
+		scopes[resource] = metrics
+	}
+	m := metrics.AppendEmpty()
+	m.SetName(s.name)
+	m.SetDescription(s.desc)
+	dp := m.SetEmptyGauge().DataPoints().AppendEmpty()
+	s.attrs.CopyTo(dp.Attributes())
+	dp.SetTimestamp(timestamp)
+	return dp
+}
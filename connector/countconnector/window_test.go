@@ -0,0 +1,180 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package countconnector
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/connector/connectortest"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/connector/countconnector/internal/metadata"
+)
+
+func deltaCounts(name string, counts map[string]int64) pmetric.Metrics {
+	md := pmetric.NewMetrics()
+	rm := md.ResourceMetrics().AppendEmpty()
+	rm.Resource().Attributes().PutStr("service.name", "checkout")
+	m := rm.ScopeMetrics().AppendEmpty().Metrics().AppendEmpty()
+	m.SetName(name)
+	sum := m.SetEmptySum()
+	sum.SetIsMonotonic(true)
+	sum.SetAggregationTemporality(pmetric.AggregationTemporalityDelta)
+	for level, n := range counts {
+		dp := sum.DataPoints().AppendEmpty()
+		dp.Attributes().PutStr("level", level)
+		dp.SetIntValue(n)
+	}
+	return md
+}
+
+// gaugeValues returns the values of the gauge data points of md keyed by the
+// "level" attribute.
+func gaugeValues(t *testing.T, md pmetric.Metrics) map[string]float64 {
+	values := make(map[string]float64)
+	for i := 0; i < md.ResourceMetrics().Len(); i++ {
+		rm := md.ResourceMetrics().At(i)
+		for j := 0; j < rm.ScopeMetrics().Len(); j++ {
+			sm := rm.ScopeMetrics().At(j)
+			assert.Equal(t, metadata.ScopeName, sm.Scope().Name())
+			for k := 0; k < sm.Metrics().Len(); k++ {
+				m := sm.Metrics().At(k)
+				require.Equal(t, pmetric.MetricTypeGauge, m.Type())
+				for l := 0; l < m.Gauge().DataPoints().Len(); l++ {
+					dp := m.Gauge().DataPoints().At(l)
+					level, _ := dp.Attributes().Get("level")
+					switch dp.ValueType() {
+					case pmetric.NumberDataPointValueTypeInt:
+						values[level.Str()] = float64(dp.IntValue())
+					case pmetric.NumberDataPointValueTypeDouble:
+						values[level.Str()] = dp.DoubleValue()
+					}
+				}
+			}
+		}
+	}
+	return values
+}
+
+func TestSlidingWindow(t *testing.T) {
+	w := newWindowAggregator(WindowConfig{
+		Type:     windowTypeSliding,
+		Interval: time.Second,
+		Size:     3 * time.Second,
+	}, consumertest.NewNop(), zap.NewNop())
+	now := time.Now()
+
+	w.record(deltaCounts("log.count", map[string]int64{"error": 2, "info": 5}), now)
+	assert.Equal(t, map[string]float64{"error": 2, "info": 5}, gaugeValues(t, w.flush(now)))
+
+	w.record(deltaCounts("log.count", map[string]int64{"error": 1}), now)
+	assert.Equal(t, map[string]float64{"error": 3, "info": 5}, gaugeValues(t, w.flush(now)))
+
+	// The first interval is still part of the window.
+	assert.Equal(t, map[string]float64{"error": 3, "info": 5}, gaugeValues(t, w.flush(now)))
+
+	// The first interval slides out of the window.
+	assert.Equal(t, map[string]float64{"error": 1}, gaugeValues(t, w.flush(now)))
+
+	// Series are forgotten once their window is empty.
+	assert.Empty(t, gaugeValues(t, w.flush(now)))
+	assert.Empty(t, w.series)
+}
+
+func TestDecayWindow(t *testing.T) {
+	w := newWindowAggregator(WindowConfig{
+		Type:     windowTypeDecay,
+		HalfLife: time.Minute,
+	}, consumertest.NewNop(), zap.NewNop())
+	now := time.Now()
+
+	w.record(deltaCounts("log.count", map[string]int64{"error": 8}), now)
+	assert.Equal(t, map[string]float64{"error": 8}, gaugeValues(t, w.flush(now)))
+
+	now = now.Add(time.Minute)
+	assert.InDelta(t, 4.0, gaugeValues(t, w.flush(now))["error"], 1e-9)
+
+	w.record(deltaCounts("log.count", map[string]int64{"error": 4}), now)
+	now = now.Add(2 * time.Minute)
+	assert.InDelta(t, 2.0, gaugeValues(t, w.flush(now))["error"], 1e-9)
+
+	// Series are forgotten once they have decayed.
+	assert.Empty(t, gaugeValues(t, w.flush(now.Add(time.Hour))))
+	assert.Empty(t, w.series)
+}
+
+func TestNoWindow(t *testing.T) {
+	assert.Nil(t, newWindowAggregator(WindowConfig{}, consumertest.NewNop(), zap.NewNop()))
+}
+
+func TestLogsToMetricsWithWindow(t *testing.T) {
+	cfg := &Config{
+		Logs: map[string]MetricInfo{
+			"log.count.by_level": {
+				Description: "Log count by level",
+				Attributes: []AttributeConfig{
+					{
+						Key:   "level",
+						Value: `ConvertCase(severity_text, "lower")`,
+					},
+				},
+			},
+		},
+		Window: WindowConfig{
+			Type:     windowTypeSliding,
+			Interval: time.Hour,
+			Size:     time.Hour,
+		},
+	}
+	require.NoError(t, cfg.Validate())
+
+	sink := &consumertest.MetricsSink{}
+	conn, err := NewFactory().CreateLogsToMetrics(context.Background(),
+		connectortest.NewNopSettings(metadata.Type), cfg, sink)
+	require.NoError(t, err)
+	require.NoError(t, conn.Start(context.Background(), componenttest.NewNopHost()))
+	defer func() {
+		assert.NoError(t, conn.Shutdown(context.Background()))
+	}()
+
+	ld := plog.NewLogs()
+	records := ld.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords()
+	for _, severity := range []string{"ERROR", "Error", "INFO"} {
+		records.AppendEmpty().SetSeverityText(severity)
+	}
+	require.NoError(t, conn.ConsumeLogs(context.Background(), ld))
+
+	// The counts are held by the window until the next interval.
+	assert.Empty(t, sink.AllMetrics())
+
+	w := conn.(*count).window
+	assert.Equal(t, map[string]float64{"error": 2, "info": 1}, gaugeValues(t, w.flush(time.Now())))
+}
+
+func TestWindowFlushOnShutdown(t *testing.T) {
+	for _, cfg := range []WindowConfig{
+		{Type: windowTypeSliding, Interval: time.Hour, Size: time.Hour},
+		{Type: windowTypeDecay, Interval: time.Hour, HalfLife: time.Hour},
+	} {
+		t.Run(cfg.Type, func(t *testing.T) {
+			sink := &consumertest.MetricsSink{}
+			w := newWindowAggregator(cfg, sink, zap.NewNop())
+			w.start()
+			w.record(deltaCounts("log.count", map[string]int64{"error": 2}), time.Now())
+			assert.Empty(t, sink.AllMetrics())
+
+			require.NoError(t, w.shutdown(context.Background()))
+			require.Len(t, sink.AllMetrics(), 1)
+			assert.InDelta(t, 2.0, gaugeValues(t, sink.AllMetrics()[0])["error"], 0.01)
+		})
+	}
+}
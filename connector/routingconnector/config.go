@@ -102,6 +102,14 @@ type RoutingTableItem struct {
 	// The routing processor will fail upon the first failure from these pipelines.
 	// Optional.
 	Pipelines []pipeline.ID `mapstructure:"pipelines"`
+
+	// Continue controls fan-out.  By default, data matched by this route is only
+	// sent to its pipelines and is not evaluated by the following routes.  When
+	// Continue is true, matched data is duplicated to this route's pipelines and
+	// the following routes, and the default pipelines when no other route
+	// matches, are evaluated as if this route did not exist.
+	// Optional.
+	Continue bool `mapstructure:"continue"`
 	// prevent unkeyed literal initialization
 	_ struct{}
 }
@@ -96,6 +96,14 @@ func TestLoadConfig(t *testing.T) {
 							pipeline.NewIDWithName(pipeline.SignalLogs, "otlp-globex"),
 						},
 					},
+					{
+						Context:   "log",
+						Condition: `severity_number >= SEVERITY_NUMBER_ERROR`,
+						Continue:  true,
+						Pipelines: []pipeline.ID{
+							pipeline.NewIDWithName(pipeline.SignalLogs, "siem"),
+						},
+					},
 				},
 			},
 		},
@@ -401,6 +409,13 @@ func withRoute(context, condition string, pipelines ...pipeline.ID) testConfigOp
 	}
 }
 
+// withContinue sets Continue on the last route added to the table.
+func withContinue() testConfigOption {
+	return func(cfg *Config) {
+		cfg.Table[len(cfg.Table)-1].Continue = true
+	}
+}
+
 func withDefault(pipelines ...pipeline.ID) testConfigOption {
 	return func(cfg *Config) {
 		cfg.DefaultPipelines = pipelines
@@ -65,14 +65,23 @@ func (c *logsConnector) ConsumeLogs(ctx context.Context, ld plog.Logs) error {
 	for i := 0; i < len(c.router.routeSlice) && ld.ResourceLogs().Len() > 0; i++ {
 		route := c.router.routeSlice[i]
 		matchedLogs := plog.NewLogs()
+		source := ld
+		if route.continueMatching && route.statementContext != "request" {
+			// Match against a copy, so that matched logs are duplicated
+			// to this route and remain available to the following routes.
+			source = plog.NewLogs()
+			ld.CopyTo(source)
+		}
 		switch route.statementContext {
 		case "request":
 			if route.requestCondition.matchRequest(ctx) {
 				groupAllLogs(groups, route.consumer, ld)
-				ld = plog.NewLogs() // all logs have been routed
+				if !route.continueMatching {
+					ld = plog.NewLogs() // all logs have been routed
+				}
 			}
 		case "", "resource":
-			plogutil.MoveResourcesIf(ld, matchedLogs,
+			plogutil.MoveResourcesIf(source, matchedLogs,
 				func(rl plog.ResourceLogs) bool {
 					rtx := ottlresource.NewTransformContext(rl.Resource(), rl)
 					_, isMatch, err := route.resourceStatement.Execute(ctx, rtx)
@@ -81,7 +90,7 @@ func (c *logsConnector) ConsumeLogs(ctx context.Context, ld plog.Logs) error {
 				},
 			)
 		case "log":
-			plogutil.MoveRecordsWithContextIf(ld, matchedLogs,
+			plogutil.MoveRecordsWithContextIf(source, matchedLogs,
 				func(rl plog.ResourceLogs, sl plog.ScopeLogs, lr plog.LogRecord) bool {
 					ltx := ottllog.NewTransformContext(lr, sl.Scope(), rl.Resource(), sl, rl)
 					_, isMatch, err := route.logStatement.Execute(ctx, ltx)
@@ -639,6 +639,58 @@ func TestLogsConnectorDetailed(t *testing.T) {
 			expectSink1: plog.Logs{},
 			expectSinkD: plog.Logs{},
 		},
+		{
+			name: "request/continue",
+			cfg: testConfig(
+				withRoute("request", isAcme, idSink0),
+				withContinue(),
+				withRoute("log", isLogF, idSink1),
+				withDefault(idSinkD),
+			),
+			ctx:         withGRPCMetadata(context.Background(), map[string]string{"X-Tenant": "acme"}),
+			input:       plogutiltest.NewLogs("AB", "CD", "EF"),
+			expectSink0: plogutiltest.NewLogs("AB", "CD", "EF"),
+			expectSink1: plogutiltest.NewLogs("AB", "CD", "F"),
+			expectSinkD: plogutiltest.NewLogs("AB", "CD", "E"),
+		},
+		{
+			name: "resource/continue",
+			cfg: testConfig(
+				withRoute("resource", isResourceA, idSink0),
+				withContinue(),
+				withRoute("resource", "true", idSink1),
+				withDefault(idSinkD),
+			),
+			input:       plogutiltest.NewLogs("AB", "CD", "EF"),
+			expectSink0: plogutiltest.NewLogs("A", "CD", "EF"),
+			expectSink1: plogutiltest.NewLogs("AB", "CD", "EF"),
+			expectSinkD: plog.Logs{},
+		},
+		{
+			name: "log/continue_then_default",
+			cfg: testConfig(
+				withRoute("log", isLogE, idSink0),
+				withContinue(),
+				withDefault(idSinkD),
+			),
+			input:       plogutiltest.NewLogs("AB", "CD", "EF"),
+			expectSink0: plogutiltest.NewLogs("AB", "CD", "E"),
+			expectSink1: plog.Logs{},
+			expectSinkD: plogutiltest.NewLogs("AB", "CD", "EF"),
+		},
+		{
+			name: "log/continue_then_match",
+			cfg: testConfig(
+				withRoute("log", isLogE, idSink0),
+				withContinue(),
+				withRoute("log", isLogE+" or "+isLogF, idSink1),
+				withDefault(idSinkD),
+			),
+			input:       plogutiltest.NewLogs("AB", "CD", "EF"),
+			expectSink0: plogutiltest.NewLogs("AB", "CD", "E"),
+			expectSink1: plogutiltest.NewLogs("AB", "CD", "EF"),
+			expectSinkD: plog.Logs{},
+		},
 		{
 			name: "log/all_match_first_only",
 			cfg: testConfig(
@@ -66,14 +66,23 @@ func (c *metricsConnector) ConsumeMetrics(ctx context.Context, md pmetric.Metric
 	for i := 0; i < len(c.router.routeSlice) && md.ResourceMetrics().Len() > 0; i++ {
 		route := c.router.routeSlice[i]
 		matchedMetrics := pmetric.NewMetrics()
+		source := md
+		if route.continueMatching && route.statementContext != "request" {
+			// Match against a copy, so that matched metrics are duplicated
+			// to this route and remain available to the following routes.
+			source = pmetric.NewMetrics()
+			md.CopyTo(source)
+		}
 		switch route.statementContext {
 		case "request":
 			if route.requestCondition.matchRequest(ctx) {
 				groupAllMetrics(groups, route.consumer, md)
-				md = pmetric.NewMetrics() // all metrics have been routed
+				if !route.continueMatching {
+					md = pmetric.NewMetrics() // all metrics have been routed
+				}
 			}
 		case "", "resource":
-			pmetricutil.MoveResourcesIf(md, matchedMetrics,
+			pmetricutil.MoveResourcesIf(source, matchedMetrics,
 				func(rs pmetric.ResourceMetrics) bool {
 					rtx := ottlresource.NewTransformContext(rs.Resource(), rs)
 					_, isMatch, err := route.resourceStatement.Execute(ctx, rtx)
@@ -82,7 +91,7 @@ func (c *metricsConnector) ConsumeMetrics(ctx context.Context, md pmetric.Metric
 				},
 			)
 		case "metric":
-			pmetricutil.MoveMetricsWithContextIf(md, matchedMetrics,
+			pmetricutil.MoveMetricsWithContextIf(source, matchedMetrics,
 				func(rm pmetric.ResourceMetrics, sm pmetric.ScopeMetrics, m pmetric.Metric) bool {
 					mtx := ottlmetric.NewTransformContext(m, sm.Metrics(), sm.Scope(), rm.Resource(), sm, rm)
 					_, isMatch, err := route.metricStatement.Execute(ctx, mtx)
@@ -91,7 +100,7 @@ func (c *metricsConnector) ConsumeMetrics(ctx context.Context, md pmetric.Metric
 				},
 			)
 		case "datapoint":
-			pmetricutil.MoveDataPointsWithContextIf(md, matchedMetrics,
+			pmetricutil.MoveDataPointsWithContextIf(source, matchedMetrics,
 				func(rm pmetric.ResourceMetrics, sm pmetric.ScopeMetrics, m pmetric.Metric, dp any) bool {
 					dptx := ottldatapoint.NewTransformContext(dp, m, sm.Metrics(), sm.Scope(), rm.Resource(), sm, rm)
 					_, isMatch, err := route.dataPointStatement.Execute(ctx, dptx)
@@ -867,6 +867,19 @@ func TestMetricsConnectorDetailed(t *testing.T) {
 			expectSink1: pmetric.Metrics{},
 			expectSinkD: pmetric.Metrics{},
 		},
+		{
+			name: "datapoint/continue",
+			cfg: testConfig(
+				withRoute("datapoint", isDataPointG, idSink0),
+				withContinue(),
+				withRoute("datapoint", "true", idSink1),
+				withDefault(idSinkD),
+			),
+			input:       pmetricutiltest.NewGauges("AB", "CD", "EF", "GH"),
+			expectSink0: pmetricutiltest.NewGauges("AB", "CD", "EF", "G"),
+			expectSink1: pmetricutiltest.NewGauges("AB", "CD", "EF", "GH"),
+			expectSinkD: pmetric.Metrics{},
+		},
 		{
 			name: "datapoint/each_matches_one",
 			cfg: testConfig(
@@ -80,6 +80,7 @@ type routingItem[C any] struct {
 	dataPointStatement *ottl.Statement[ottldatapoint.TransformContext]
 	logStatement       *ottl.Statement[ottllog.TransformContext]
 	statementContext   string
+	continueMatching   bool
 }
 
 func (r *router[C]) buildParsers(table []RoutingTableItem, settings component.TelemetrySettings) error {
@@ -208,6 +209,7 @@ func (r *router[C]) registerRouteConsumers() (err error) {
 		route, ok := r.routes[key(item)]
 		if !ok {
 			route.statementContext = item.Context
+			route.continueMatching = item.Continue
 			switch item.Context {
 			case "request":
 				route.requestCondition, err = parseRequestCondition(item.Condition)
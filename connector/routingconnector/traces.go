@@ -65,14 +65,23 @@ func (c *tracesConnector) ConsumeTraces(ctx context.Context, td ptrace.Traces) e
 	for i := 0; i < len(c.router.routeSlice) && td.ResourceSpans().Len() > 0; i++ {
 		route := c.router.routeSlice[i]
 		matchedSpans := ptrace.NewTraces()
+		source := td
+		if route.continueMatching && route.statementContext != "request" {
+			// Match against a copy, so that matched traces are duplicated
+			// to this route and remain available to the following routes.
+			source = ptrace.NewTraces()
+			td.CopyTo(source)
+		}
 		switch route.statementContext {
 		case "request":
 			if route.requestCondition.matchRequest(ctx) {
 				groupAllTraces(groups, route.consumer, td)
-				td = ptrace.NewTraces() // all traces have been routed
+				if !route.continueMatching {
+					td = ptrace.NewTraces() // all traces have been routed
+				}
 			}
 		case "", "resource":
-			ptraceutil.MoveResourcesIf(td, matchedSpans,
+			ptraceutil.MoveResourcesIf(source, matchedSpans,
 				func(rs ptrace.ResourceSpans) bool {
 					rtx := ottlresource.NewTransformContext(rs.Resource(), rs)
 					_, isMatch, err := route.resourceStatement.Execute(ctx, rtx)
@@ -81,7 +90,7 @@ func (c *tracesConnector) ConsumeTraces(ctx context.Context, td ptrace.Traces) e
 				},
 			)
 		case "span":
-			ptraceutil.MoveSpansWithContextIf(td, matchedSpans,
+			ptraceutil.MoveSpansWithContextIf(source, matchedSpans,
 				func(rs ptrace.ResourceSpans, ss ptrace.ScopeSpans, s ptrace.Span) bool {
 					mtx := ottlspan.NewTransformContext(s, ss.Scope(), rs.Resource(), ss, rs)
 					_, isMatch, err := route.spanStatement.Execute(ctx, mtx)
@@ -673,6 +673,18 @@ func TestTracesConnectorDetailed(t *testing.T) {
 			expectSink1: ptrace.Traces{},
 			expectSinkD: ptrace.Traces{},
 		},
+		{
+			name: "span/continue",
+			cfg: testConfig(
+				withRoute("span", isSpanE, idSink0),
+				withContinue(),
+				withDefault(idSinkD),
+			),
+			input:       ptraceutiltest.NewTraces("AB", "CD", "EF", "GH"),
+			expectSink0: ptraceutiltest.NewTraces("AB", "CD", "E", "GH"),
+			expectSink1: ptrace.Traces{},
+			expectSinkD: ptraceutiltest.NewTraces("AB", "CD", "EF", "GH"),
+		},
 		{
 			name: "span/each_matches_one",
 			cfg: testConfig(
@@ -47,6 +47,10 @@ type Config struct {
 	// VirtualNodeExtraLabel enables the `virtual_node` label to be added to the spans.
 	VirtualNodeExtraLabel bool `mapstructure:"virtual_node_extra_label"`
 
+	// VirtualNodeMirrorLatency records the latency observed by the instrumented side of an edge
+	// as the latency of the virtual node, which has no span of its own to measure it.
+	VirtualNodeMirrorLatency bool `mapstructure:"virtual_node_mirror_latency"`
+
 	// MetricsFlushInterval is the interval at which metrics are flushed to the exporter.
 	// If set to 0, metrics are flushed on every received batch of traces.
 	// Default is 60s if unset.
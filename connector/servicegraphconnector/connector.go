@@ -370,6 +370,9 @@ func (p *serviceGraphConnector) onExpire(e *store.Edge) {
 		e.ConnectionType = store.VirtualNode
 		if e.ClientService == "" && e.Key.SpanIDIsEmpty() {
 			e.ClientService = "user"
+			if p.config.VirtualNodeMirrorLatency {
+				e.ClientLatencySec = e.ServerLatencySec
+			}
 			if p.config.VirtualNodeExtraLabel {
 				e.VirtualNodeLabel = store.ClientVirtualNode
 			}
@@ -378,6 +381,9 @@ func (p *serviceGraphConnector) onExpire(e *store.Edge) {
 
 		if e.ServerService == "" {
 			e.ServerService = p.getPeerHost(p.config.VirtualNodePeerAttributes, e.Peer)
+			if p.config.VirtualNodeMirrorLatency {
+				e.ServerLatencySec = e.ClientLatencySec
+			}
 			if p.config.VirtualNodeExtraLabel {
 				e.VirtualNodeLabel = store.ServerVirtualNode
 			}
@@ -30,6 +30,7 @@ import (
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/connector/servicegraphconnector/internal/metadata"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/connector/servicegraphconnector/internal/metadatatest"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/connector/servicegraphconnector/internal/store"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/golden"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/pdatatest/pmetrictest"
 )
@@ -733,6 +734,31 @@ func TestVirtualNodeClientLabels(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestVirtualNodeMirrorLatency(t *testing.T) {
+	cfg := &Config{
+		LatencyHistogramBuckets:   []time.Duration{time.Duration(0.1 * float64(time.Second)), time.Duration(1 * float64(time.Second)), time.Duration(10 * float64(time.Second))},
+		Store:                     StoreConfig{MaxItems: 10},
+		VirtualNodePeerAttributes: []string{"peer.service"},
+		VirtualNodeMirrorLatency:  true,
+	}
+
+	conn, err := newConnector(componenttest.NewNopTelemetrySettings(), cfg, newMockMetricsExporter())
+	require.NoError(t, err)
+
+	conn.onExpire(&store.Edge{
+		Key:              store.NewKey(pcommon.TraceID([16]byte{1}), pcommon.SpanID([8]byte{1})),
+		ClientService:    "foo",
+		ClientLatencySec: 0.5,
+		Dimensions:       map[string]string{},
+		Peer:             map[string]string{"peer.service": "external-api"},
+	})
+
+	metricKey := conn.buildMetricKey("foo", "external-api", string(store.VirtualNode), "false", map[string]string{})
+	assert.Equal(t, uint64(1), conn.reqServerDurationSecondsCount[metricKey])
+	assert.Equal(t, 0.5, conn.reqServerDurationSecondsSum[metricKey])
+	assert.Equal(t, []uint64{0, 1, 0, 0}, conn.reqServerDurationSecondsBucketCounts[metricKey])
+}
+
 func TestExponentialHistogram(t *testing.T) {
 	// Prepare
 	set := componenttest.NewNopTelemetrySettings()
@@ -0,0 +1,167 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package sloconnector // import "github.com/open-telemetry/opentelemetry-collector-contrib/connector/sloconnector"
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/filter/filterottl"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+const (
+	defaultEvaluationInterval = 30 * time.Second
+	defaultWindow             = 30 * 24 * time.Hour
+)
+
+// defaultBurnRateWindows are the windows of the multiwindow, multi-burn-rate
+// alerts recommended for a 30 day SLO.
+var defaultBurnRateWindows = []time.Duration{
+	5 * time.Minute,
+	30 * time.Minute,
+	time.Hour,
+	6 * time.Hour,
+}
+
+// Config for the connector
+type Config struct {
+	// EvaluationInterval is how often the SLIs and burn rates are computed
+	// and emitted.
+	EvaluationInterval time.Duration `mapstructure:"evaluation_interval"`
+
+	// Spans are the SLOs computed from spans, keyed by SLO name.
+	Spans map[string]SLOConfig `mapstructure:"spans"`
+
+	// Logs are the SLOs computed from log records, keyed by SLO name.
+	Logs map[string]SLOConfig `mapstructure:"logs"`
+
+	// prevent unkeyed literal initialization
+	_ struct{}
+}
+
+// SLOConfig defines a service level objective.
+type SLOConfig struct {
+	Description string `mapstructure:"description"`
+
+	// Good are OTTL conditions matching the good events. When only Good is
+	// set, every other event is bad.
+	Good []string `mapstructure:"good"`
+
+	// Bad are OTTL conditions matching the bad events. When only Bad is
+	// set, every other event is good. When both Good and Bad are set,
+	// events matching neither are not counted.
+	Bad []string `mapstructure:"bad"`
+
+	// Objective is the target ratio of good events, e.g. 0.999.
+	Objective float64 `mapstructure:"objective"`
+
+	// Window is the compliance period of the SLO, over which the SLI and
+	// the remaining error budget are reported. Defaults to 30 days.
+	Window time.Duration `mapstructure:"window"`
+
+	// BurnRateWindows are the windows over which the error budget burn
+	// rate is reported.
+	BurnRateWindows []time.Duration `mapstructure:"burn_rate_windows"`
+
+	// Attributes are the keys of the attributes, or of the resource
+	// attributes, by which the SLO is computed separately.
+	Attributes []string `mapstructure:"attributes"`
+
+	// prevent unkeyed literal initialization
+	_ struct{}
+}
+
+func (c *Config) Validate() error {
+	if c.EvaluationInterval <= 0 {
+		return errors.New("evaluation_interval must be positive")
+	}
+	if len(c.Spans)+len(c.Logs) == 0 {
+		return errors.New("no SLOs defined")
+	}
+	set := component.TelemetrySettings{Logger: zap.NewNop()}
+	for name, slo := range c.Spans {
+		if err := slo.validate(name, c.EvaluationInterval); err != nil {
+			return fmt.Errorf("spans: %w", err)
+		}
+		if err := validateConditions(slo, func(conditions []string) error {
+			_, err := filterottl.NewBoolExprForSpan(conditions, filterottl.StandardSpanFuncs(), ottl.PropagateError, set)
+			return err
+		}); err != nil {
+			return fmt.Errorf("spans: SLO %q: %w", name, err)
+		}
+	}
+	for name, slo := range c.Logs {
+		if err := slo.validate(name, c.EvaluationInterval); err != nil {
+			return fmt.Errorf("logs: %w", err)
+		}
+		if err := validateConditions(slo, func(conditions []string) error {
+			_, err := filterottl.NewBoolExprForLog(conditions, filterottl.StandardLogFuncs(), ottl.PropagateError, set)
+			return err
+		}); err != nil {
+			return fmt.Errorf("logs: SLO %q: %w", name, err)
+		}
+	}
+	return nil
+}
+
+func (s *SLOConfig) validate(name string, interval time.Duration) error {
+	if name == "" {
+		return errors.New("SLO name missing")
+	}
+	if len(s.Good)+len(s.Bad) == 0 {
+		return fmt.Errorf("SLO %q: good or bad conditions are required", name)
+	}
+	if s.Objective <= 0 || s.Objective >= 1 {
+		return fmt.Errorf("SLO %q: objective must be between 0 and 1, exclusive", name)
+	}
+	if s.Window < 0 {
+		return fmt.Errorf("SLO %q: window must not be negative", name)
+	}
+	window := s.window()
+	if window < interval {
+		return fmt.Errorf("SLO %q: window %v is shorter than the evaluation interval %v", name, window, interval)
+	}
+	for _, w := range s.burnRateWindows() {
+		if w <= 0 || w%interval != 0 {
+			return fmt.Errorf("SLO %q: burn rate window %v must be a positive multiple of the evaluation interval %v", name, w, interval)
+		}
+		if w > window {
+			return fmt.Errorf("SLO %q: burn rate window %v is longer than the window %v", name, w, window)
+		}
+	}
+	return nil
+}
+
+func validateConditions(s SLOConfig, parse func([]string) error) error {
+	if len(s.Good) > 0 {
+		if err := parse(s.Good); err != nil {
+			return fmt.Errorf("good: %w", err)
+		}
+	}
+	if len(s.Bad) > 0 {
+		if err := parse(s.Bad); err != nil {
+			return fmt.Errorf("bad: %w", err)
+		}
+	}
+	return nil
+}
+
+func (s *SLOConfig) window() time.Duration {
+	if s.Window <= 0 {
+		return defaultWindow
+	}
+	return s.Window
+}
+
+func (s *SLOConfig) burnRateWindows() []time.Duration {
+	if len(s.BurnRateWindows) == 0 {
+		return defaultBurnRateWindows
+	}
+	return s.BurnRateWindows
+}
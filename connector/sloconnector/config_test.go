@@ -0,0 +1,184 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package sloconnector
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/confmap/confmaptest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/connector/sloconnector/internal/metadata"
+)
+
+func TestLoadConfig(t *testing.T) {
+	testCases := []struct {
+		name   string
+		expect *Config
+	}{
+		{
+			name: "",
+			expect: &Config{
+				EvaluationInterval: defaultEvaluationInterval,
+				Spans: map[string]SLOConfig{
+					"checkout.availability": {
+						Description: "Checkout requests served without a server error.",
+						Bad:         []string{"kind == SPAN_KIND_SERVER and status.code == STATUS_CODE_ERROR"},
+						Objective:   0.999,
+						Attributes:  []string{"service.name"},
+					},
+				},
+			},
+		},
+		{
+			name: "custom",
+			expect: &Config{
+				EvaluationInterval: time.Minute,
+				Spans: map[string]SLOConfig{
+					"checkout.latency": {
+						Good:            []string{"end_time_unix_nano - start_time_unix_nano < 300000000"},
+						Objective:       0.99,
+						Window:          168 * time.Hour,
+						BurnRateWindows: []time.Duration{5 * time.Minute, time.Hour},
+					},
+				},
+				Logs: map[string]SLOConfig{
+					"payments.errors": {
+						Good:      []string{"severity_number < SEVERITY_NUMBER_ERROR"},
+						Bad:       []string{"severity_number >= SEVERITY_NUMBER_ERROR"},
+						Objective: 0.995,
+					},
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
+			require.NoError(t, err)
+
+			factory := NewFactory()
+			cfg := factory.CreateDefaultConfig()
+
+			sub, err := cm.Sub(component.NewIDWithName(metadata.Type, tc.name).String())
+			require.NoError(t, err)
+			require.NoError(t, sub.Unmarshal(cfg))
+
+			assert.NoError(t, cfg.(*Config).Validate())
+			assert.Equal(t, tc.expect, cfg)
+		})
+	}
+}
+
+func TestConfigErrors(t *testing.T) {
+	valid := func() SLOConfig {
+		return SLOConfig{
+			Bad:       []string{`attributes["error"] == true`},
+			Objective: 0.99,
+		}
+	}
+	testCases := []struct {
+		name   string
+		input  *Config
+		expect string
+	}{
+		{
+			name:   "no_slos",
+			input:  &Config{EvaluationInterval: time.Minute},
+			expect: "no SLOs defined",
+		},
+		{
+			name: "no_evaluation_interval",
+			input: &Config{
+				Spans: map[string]SLOConfig{"a": valid()},
+			},
+			expect: "evaluation_interval must be positive",
+		},
+		{
+			name: "missing_name",
+			input: &Config{
+				EvaluationInterval: time.Minute,
+				Logs:               map[string]SLOConfig{"": valid()},
+			},
+			expect: "logs: SLO name missing",
+		},
+		{
+			name: "no_conditions",
+			input: &Config{
+				EvaluationInterval: time.Minute,
+				Spans:              map[string]SLOConfig{"a": {Objective: 0.99}},
+			},
+			expect: `spans: SLO "a": good or bad conditions are required`,
+		},
+		{
+			name: "invalid_objective",
+			input: &Config{
+				EvaluationInterval: time.Minute,
+				Spans: map[string]SLOConfig{"a": func() SLOConfig {
+					s := valid()
+					s.Objective = 1
+					return s
+				}()},
+			},
+			expect: `spans: SLO "a": objective must be between 0 and 1, exclusive`,
+		},
+		{
+			name: "burn_rate_window_not_multiple_of_interval",
+			input: &Config{
+				EvaluationInterval: time.Minute,
+				Spans: map[string]SLOConfig{"a": func() SLOConfig {
+					s := valid()
+					s.BurnRateWindows = []time.Duration{90 * time.Second}
+					return s
+				}()},
+			},
+			expect: `spans: SLO "a": burn rate window 1m30s must be a positive multiple of the evaluation interval 1m0s`,
+		},
+		{
+			name: "burn_rate_window_longer_than_window",
+			input: &Config{
+				EvaluationInterval: time.Minute,
+				Spans: map[string]SLOConfig{"a": func() SLOConfig {
+					s := valid()
+					s.Window = time.Hour
+					return s
+				}()},
+			},
+			expect: `spans: SLO "a": burn rate window 6h0m0s is longer than the window 1h0m0s`,
+		},
+		{
+			name: "invalid_good_condition",
+			input: &Config{
+				EvaluationInterval: time.Minute,
+				Logs: map[string]SLOConfig{"a": {
+					Good:      []string{"invalid condition"},
+					Objective: 0.99,
+				}},
+			},
+			expect: `logs: SLO "a": good: unable to parse OTTL condition`,
+		},
+		{
+			name: "invalid_bad_condition",
+			input: &Config{
+				EvaluationInterval: time.Minute,
+				Spans: map[string]SLOConfig{"a": {
+					Bad:       []string{"invalid condition"},
+					Objective: 0.99,
+				}},
+			},
+			expect: `spans: SLO "a": bad: unable to parse OTTL condition`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.ErrorContains(t, tc.input.Validate(), tc.expect)
+		})
+	}
+}
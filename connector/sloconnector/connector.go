@@ -0,0 +1,135 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package sloconnector // import "github.com/open-telemetry/opentelemetry-collector-contrib/connector/sloconnector"
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/connector/sloconnector/internal/metadata"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottllog"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlspan"
+)
+
+// slo counts the good and bad spans or log records of SLOs and periodically
+// emits their SLIs and error budget burn rates onto a metrics pipeline.
+type slo struct {
+	metricsConsumer consumer.Metrics
+	logger          *zap.Logger
+	interval        time.Duration
+
+	spanSLOs []sloDef[ottlspan.TransformContext]
+	logSLOs  []sloDef[ottllog.TransformContext]
+	trackers []*tracker
+
+	done chan struct{}
+	wg   sync.WaitGroup
+}
+
+func (*slo) Capabilities() consumer.Capabilities {
+	return consumer.Capabilities{MutatesData: false}
+}
+
+func (c *slo) Start(context.Context, component.Host) error {
+	c.done = make(chan struct{})
+	c.wg.Add(1)
+	go func() {
+		defer c.wg.Done()
+		ticker := time.NewTicker(c.interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-c.done:
+				return
+			case now := <-ticker.C:
+				md := c.evaluate(now)
+				if md.DataPointCount() == 0 {
+					continue
+				}
+				if err := c.metricsConsumer.ConsumeMetrics(context.Background(), md); err != nil {
+					c.logger.Error("failed to emit SLO metrics", zap.Error(err))
+				}
+			}
+		}
+	}()
+	return nil
+}
+
+func (c *slo) Shutdown(context.Context) error {
+	if c.done == nil {
+		return nil
+	}
+	close(c.done)
+	c.wg.Wait()
+	return nil
+}
+
+func (c *slo) ConsumeTraces(ctx context.Context, td ptrace.Traces) error {
+	var multiError error
+	for i := 0; i < td.ResourceSpans().Len(); i++ {
+		resourceSpan := td.ResourceSpans().At(i)
+		for j := 0; j < resourceSpan.ScopeSpans().Len(); j++ {
+			scopeSpan := resourceSpan.ScopeSpans().At(j)
+			for k := 0; k < scopeSpan.Spans().Len(); k++ {
+				span := scopeSpan.Spans().At(k)
+				sCtx := ottlspan.NewTransformContext(span, scopeSpan.Scope(), resourceSpan.Resource(), scopeSpan, resourceSpan)
+				multiError = errors.Join(multiError, record(ctx, c.spanSLOs, sCtx, span.Attributes(), resourceSpan.Resource().Attributes()))
+			}
+		}
+	}
+	return multiError
+}
+
+func (c *slo) ConsumeLogs(ctx context.Context, ld plog.Logs) error {
+	var multiError error
+	for i := 0; i < ld.ResourceLogs().Len(); i++ {
+		resourceLog := ld.ResourceLogs().At(i)
+		for j := 0; j < resourceLog.ScopeLogs().Len(); j++ {
+			scopeLogs := resourceLog.ScopeLogs().At(j)
+			for k := 0; k < scopeLogs.LogRecords().Len(); k++ {
+				logRecord := scopeLogs.LogRecords().At(k)
+				lCtx := ottllog.NewTransformContext(logRecord, scopeLogs.Scope(), resourceLog.Resource(), scopeLogs, resourceLog)
+				multiError = errors.Join(multiError, record(ctx, c.logSLOs, lCtx, logRecord.Attributes(), resourceLog.Resource().Attributes()))
+			}
+		}
+	}
+	return multiError
+}
+
+// record classifies an event for each SLO and counts it.
+func record[K any](ctx context.Context, defs []sloDef[K], tCtx K, attrs, resourceAttrs pcommon.Map) error {
+	var multiError error
+	for _, def := range defs {
+		counted, good, err := def.classify(ctx, tCtx)
+		if err != nil {
+			multiError = errors.Join(multiError, err)
+			continue
+		}
+		if counted {
+			def.tracker.record(attrs, resourceAttrs, good)
+		}
+	}
+	return multiError
+}
+
+// evaluate returns the SLO metrics as of now and advances the windows of
+// every SLO by one evaluation interval.
+func (c *slo) evaluate(now time.Time) pmetric.Metrics {
+	m := newSLOMetrics(metadata.ScopeName)
+	timestamp := pcommon.NewTimestampFromTime(now)
+	for _, t := range c.trackers {
+		t.evaluate(m, timestamp)
+	}
+	return m.md
+}
@@ -0,0 +1,193 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package sloconnector
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/connector/connectortest"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/connector/sloconnector/internal/metadata"
+)
+
+// dataPoints returns the values of the data points of md keyed by metric
+// name, SLO name and window.
+func dataPoints(t *testing.T, md pmetric.Metrics) map[string]float64 {
+	values := make(map[string]float64)
+	for i := 0; i < md.ResourceMetrics().Len(); i++ {
+		rm := md.ResourceMetrics().At(i)
+		for j := 0; j < rm.ScopeMetrics().Len(); j++ {
+			sm := rm.ScopeMetrics().At(j)
+			assert.Equal(t, metadata.ScopeName, sm.Scope().Name())
+			for k := 0; k < sm.Metrics().Len(); k++ {
+				m := sm.Metrics().At(k)
+				for l := 0; l < m.Gauge().DataPoints().Len(); l++ {
+					dp := m.Gauge().DataPoints().At(l)
+					key := m.Name()
+					if name, ok := dp.Attributes().Get(sloNameKey); ok {
+						key += " " + name.Str()
+					}
+					if service, ok := dp.Attributes().Get("service.name"); ok {
+						key += " " + service.Str()
+					}
+					if window, ok := dp.Attributes().Get(sloWindowKey); ok {
+						key += " " + window.Str()
+					}
+					values[key] = dp.DoubleValue()
+				}
+			}
+		}
+	}
+	return values
+}
+
+func assertValues(t *testing.T, expected, actual map[string]float64) {
+	assert.Len(t, actual, len(expected))
+	for key, value := range expected {
+		if assert.Contains(t, actual, key) {
+			assert.InDelta(t, value, actual[key], 1e-9, key)
+		}
+	}
+}
+
+func newTraces(service string, failed, succeeded int) ptrace.Traces {
+	td := ptrace.NewTraces()
+	rs := td.ResourceSpans().AppendEmpty()
+	rs.Resource().Attributes().PutStr("service.name", service)
+	spans := rs.ScopeSpans().AppendEmpty().Spans()
+	for i := 0; i < failed+succeeded; i++ {
+		span := spans.AppendEmpty()
+		span.SetKind(ptrace.SpanKindServer)
+		if i < failed {
+			span.Status().SetCode(ptrace.StatusCodeError)
+		}
+	}
+	// Client spans are not counted by the SLO.
+	spans.AppendEmpty().SetKind(ptrace.SpanKindClient)
+	return td
+}
+
+func TestTracesToMetrics(t *testing.T) {
+	cfg := &Config{
+		EvaluationInterval: time.Minute,
+		Spans: map[string]SLOConfig{
+			"availability": {
+				Good:            []string{"kind == SPAN_KIND_SERVER and status.code != STATUS_CODE_ERROR"},
+				Bad:             []string{"kind == SPAN_KIND_SERVER and status.code == STATUS_CODE_ERROR"},
+				Objective:       0.5,
+				Window:          3 * time.Minute,
+				BurnRateWindows: []time.Duration{time.Minute, 2 * time.Minute},
+				Attributes:      []string{"service.name"},
+			},
+		},
+	}
+	require.NoError(t, cfg.Validate())
+
+	sink := &consumertest.MetricsSink{}
+	conn, err := NewFactory().CreateTracesToMetrics(context.Background(),
+		connectortest.NewNopSettings(metadata.Type), cfg, sink)
+	require.NoError(t, err)
+	assert.False(t, conn.Capabilities().MutatesData)
+	require.NoError(t, conn.Start(context.Background(), componenttest.NewNopHost()))
+	defer func() {
+		assert.NoError(t, conn.Shutdown(context.Background()))
+	}()
+
+	require.NoError(t, conn.ConsumeTraces(context.Background(), newTraces("checkout", 1, 3)))
+	require.NoError(t, conn.ConsumeTraces(context.Background(), newTraces("cart", 0, 2)))
+
+	c := conn.(*slo)
+	now := time.Now()
+	assertValues(t, map[string]float64{
+		"slo.sli availability checkout 3m":                 0.75,
+		"slo.sli availability checkout 1m":                 0.75,
+		"slo.sli availability checkout 2m":                 0.75,
+		"slo.burn_rate availability checkout 1m":           0.5,
+		"slo.burn_rate availability checkout 2m":           0.5,
+		"slo.error_budget.remaining availability checkout": 0.5,
+		"slo.objective availability checkout":              0.5,
+		"slo.sli availability cart 3m":                     1,
+		"slo.sli availability cart 1m":                     1,
+		"slo.sli availability cart 2m":                     1,
+		"slo.burn_rate availability cart 1m":               0,
+		"slo.burn_rate availability cart 2m":               0,
+		"slo.error_budget.remaining availability cart":     1,
+		"slo.objective availability cart":                  0.5,
+	}, dataPoints(t, c.evaluate(now)))
+
+	// The events of the first interval leave the shortest burn rate window.
+	require.NoError(t, conn.ConsumeTraces(context.Background(), newTraces("checkout", 0, 1)))
+	assertValues(t, map[string]float64{
+		"slo.sli availability checkout 3m":                 0.8,
+		"slo.sli availability checkout 1m":                 1,
+		"slo.sli availability checkout 2m":                 0.8,
+		"slo.burn_rate availability checkout 1m":           0,
+		"slo.burn_rate availability checkout 2m":           0.4,
+		"slo.error_budget.remaining availability checkout": 0.6,
+		"slo.objective availability checkout":              0.5,
+		"slo.sli availability cart 3m":                     1,
+		"slo.sli availability cart 2m":                     1,
+		"slo.burn_rate availability cart 1m":               0,
+		"slo.burn_rate availability cart 2m":               0,
+		"slo.error_budget.remaining availability cart":     1,
+		"slo.objective availability cart":                  0.5,
+	}, dataPoints(t, c.evaluate(now.Add(time.Minute))))
+
+	values := dataPoints(t, c.evaluate(now.Add(2*time.Minute)))
+	assert.Equal(t, 0.0, values["slo.burn_rate availability cart 2m"])
+	assert.Equal(t, 1.0, values["slo.sli availability cart 3m"])
+
+	// Series without events in the compliance window are forgotten.
+	values = dataPoints(t, c.evaluate(now.Add(3*time.Minute)))
+	assert.NotContains(t, values, "slo.sli availability cart 3m")
+	assert.Contains(t, values, "slo.sli availability checkout 3m")
+	assert.Equal(t, 0, c.evaluate(now.Add(4*time.Minute)).DataPointCount())
+}
+
+func TestLogsToMetrics(t *testing.T) {
+	cfg := &Config{
+		EvaluationInterval: time.Minute,
+		Logs: map[string]SLOConfig{
+			"payments": {
+				Bad:             []string{"severity_number >= SEVERITY_NUMBER_ERROR"},
+				Objective:       0.9,
+				Window:          time.Hour,
+				BurnRateWindows: []time.Duration{5 * time.Minute},
+			},
+		},
+	}
+	require.NoError(t, cfg.Validate())
+
+	conn, err := NewFactory().CreateLogsToMetrics(context.Background(),
+		connectortest.NewNopSettings(metadata.Type), cfg, consumertest.NewNop())
+	require.NoError(t, err)
+
+	ld := plog.NewLogs()
+	records := ld.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords()
+	for _, severity := range []plog.SeverityNumber{plog.SeverityNumberError, plog.SeverityNumberInfo, plog.SeverityNumberInfo, plog.SeverityNumberWarn} {
+		records.AppendEmpty().SetSeverityNumber(severity)
+	}
+	require.NoError(t, conn.ConsumeLogs(context.Background(), ld))
+
+	values := dataPoints(t, conn.(*slo).evaluate(time.Now()))
+	assert.InDelta(t, 0.75, values["slo.sli payments 1h"], 1e-9)
+	assert.InDelta(t, 2.5, values["slo.burn_rate payments 5m"], 1e-9)
+	assert.InDelta(t, -1.5, values["slo.error_budget.remaining payments"], 1e-9)
+}
+
+func TestFormatWindow(t *testing.T) {
+	assert.Equal(t, "30d", formatWindow(30*24*time.Hour))
+	assert.Equal(t, "6h", formatWindow(6*time.Hour))
+	assert.Equal(t, "5m", formatWindow(5*time.Minute))
+	assert.Equal(t, "1m30s", formatWindow(90*time.Second))
+}
@@ -0,0 +1,8 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:generate mdatagen metadata.yaml
+
+// Package sloconnector computes service level indicators and error budget
+// burn rates from spans and logs and emits them as metrics.
+package sloconnector // import "github.com/open-telemetry/opentelemetry-collector-contrib/connector/sloconnector"
@@ -0,0 +1,99 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package sloconnector // import "github.com/open-telemetry/opentelemetry-collector-contrib/connector/sloconnector"
+
+import (
+	"context"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/connector"
+	"go.opentelemetry.io/collector/consumer"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/connector/sloconnector/internal/metadata"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/filter/filterottl"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottllog"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlspan"
+)
+
+// NewFactory returns a ConnectorFactory.
+func NewFactory() connector.Factory {
+	return connector.NewFactory(
+		metadata.Type,
+		createDefaultConfig,
+		connector.WithTracesToMetrics(createTracesToMetrics, metadata.TracesToMetricsStability),
+		connector.WithLogsToMetrics(createLogsToMetrics, metadata.LogsToMetricsStability),
+	)
+}
+
+// createDefaultConfig creates the default configuration.
+func createDefaultConfig() component.Config {
+	return &Config{
+		EvaluationInterval: defaultEvaluationInterval,
+	}
+}
+
+// createTracesToMetrics creates a traces to metrics connector based on provided config.
+func createTracesToMetrics(
+	_ context.Context,
+	set connector.Settings,
+	cfg component.Config,
+	nextConsumer consumer.Metrics,
+) (connector.Traces, error) {
+	c := cfg.(*Config)
+	conn := newSLOConnector(c, set, nextConsumer)
+	for name, info := range c.Spans {
+		def := sloDef[ottlspan.TransformContext]{tracker: newTracker(name, info, c.EvaluationInterval)}
+		var err error
+		if len(info.Good) > 0 {
+			if def.good, err = filterottl.NewBoolExprForSpan(info.Good, filterottl.StandardSpanFuncs(), ottl.PropagateError, set.TelemetrySettings); err != nil {
+				return nil, err
+			}
+		}
+		if len(info.Bad) > 0 {
+			if def.bad, err = filterottl.NewBoolExprForSpan(info.Bad, filterottl.StandardSpanFuncs(), ottl.PropagateError, set.TelemetrySettings); err != nil {
+				return nil, err
+			}
+		}
+		conn.spanSLOs = append(conn.spanSLOs, def)
+		conn.trackers = append(conn.trackers, def.tracker)
+	}
+	return conn, nil
+}
+
+// createLogsToMetrics creates a logs to metrics connector based on provided config.
+func createLogsToMetrics(
+	_ context.Context,
+	set connector.Settings,
+	cfg component.Config,
+	nextConsumer consumer.Metrics,
+) (connector.Logs, error) {
+	c := cfg.(*Config)
+	conn := newSLOConnector(c, set, nextConsumer)
+	for name, info := range c.Logs {
+		def := sloDef[ottllog.TransformContext]{tracker: newTracker(name, info, c.EvaluationInterval)}
+		var err error
+		if len(info.Good) > 0 {
+			if def.good, err = filterottl.NewBoolExprForLog(info.Good, filterottl.StandardLogFuncs(), ottl.PropagateError, set.TelemetrySettings); err != nil {
+				return nil, err
+			}
+		}
+		if len(info.Bad) > 0 {
+			if def.bad, err = filterottl.NewBoolExprForLog(info.Bad, filterottl.StandardLogFuncs(), ottl.PropagateError, set.TelemetrySettings); err != nil {
+				return nil, err
+			}
+		}
+		conn.logSLOs = append(conn.logSLOs, def)
+		conn.trackers = append(conn.trackers, def.tracker)
+	}
+	return conn, nil
+}
+
+func newSLOConnector(cfg *Config, set connector.Settings, nextConsumer consumer.Metrics) *slo {
+	return &slo{
+		metricsConsumer: nextConsumer,
+		logger:          set.Logger,
+		interval:        cfg.EvaluationInterval,
+	}
+}
@@ -0,0 +1,290 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package sloconnector // import "github.com/open-telemetry/opentelemetry-collector-contrib/connector/sloconnector"
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/pdatautil"
+)
+
+const (
+	sloNameKey   = "slo.name"
+	sloWindowKey = "slo.window"
+
+	// maxWindowBuckets bounds the number of buckets kept per series for the
+	// compliance window, which is typically much longer than the evaluation
+	// interval.
+	maxWindowBuckets = 720
+)
+
+// sloDef is an SLO whose conditions are evaluated in the OTTL context K.
+type sloDef[K any] struct {
+	good    *ottl.ConditionSequence[K]
+	bad     *ottl.ConditionSequence[K]
+	tracker *tracker
+}
+
+// classify returns whether an event is counted by the SLO and whether it
+// is a good event.
+func (d *sloDef[K]) classify(ctx context.Context, tCtx K) (counted, good bool, err error) {
+	if d.good != nil {
+		good, err = d.good.Eval(ctx, tCtx)
+		if err != nil {
+			return false, false, err
+		}
+		if good || d.bad == nil {
+			return true, good, nil
+		}
+	}
+	bad, err := d.bad.Eval(ctx, tCtx)
+	if err != nil {
+		return false, false, err
+	}
+	if d.good != nil {
+		// Both good and bad are set, events matching neither are ignored.
+		return bad, false, nil
+	}
+	return true, !bad, nil
+}
+
+type counts struct {
+	good  uint64
+	total uint64
+}
+
+func (c *counts) add(o counts) {
+	c.good += o.good
+	c.total += o.total
+}
+
+// ring holds the counts of consecutive periods, the current period is at
+// index head. Each period spans ticksPerBucket evaluation intervals.
+type ring struct {
+	buckets        []counts
+	head           int
+	ticks          int
+	ticksPerBucket int
+}
+
+func newRing(size, ticksPerBucket int) ring {
+	return ring{buckets: make([]counts, size), ticksPerBucket: ticksPerBucket}
+}
+
+func (r *ring) record(good bool) {
+	r.buckets[r.head].total++
+	if good {
+		r.buckets[r.head].good++
+	}
+}
+
+// sum returns the counts of the last n buckets, including the current one.
+func (r *ring) sum(n int) counts {
+	var c counts
+	for i := 0; i < n && i < len(r.buckets); i++ {
+		c.add(r.buckets[(r.head-i+len(r.buckets))%len(r.buckets)])
+	}
+	return c
+}
+
+// tick advances the ring by one evaluation interval.
+func (r *ring) tick() {
+	r.ticks++
+	if r.ticks < r.ticksPerBucket {
+		return
+	}
+	r.ticks = 0
+	r.head = (r.head + 1) % len(r.buckets)
+	r.buckets[r.head] = counts{}
+}
+
+type series struct {
+	attrs pcommon.Map
+
+	// recent has one bucket per evaluation interval and covers the
+	// longest burn rate window.
+	recent ring
+
+	// window covers the compliance window with coarser buckets.
+	window ring
+}
+
+// tracker counts the good and total events of an SLO per set of
+// attributes and computes its SLI and burn rates.
+type tracker struct {
+	name       string
+	objective  float64
+	window     time.Duration
+	burnRates  []time.Duration
+	attributes []string
+	interval   time.Duration
+
+	recentSize       int
+	windowSize       int
+	windowBucketTick int
+
+	mu     sync.Mutex
+	series map[[16]byte]*series
+}
+
+func newTracker(name string, cfg SLOConfig, interval time.Duration) *tracker {
+	t := &tracker{
+		name:       name,
+		objective:  cfg.Objective,
+		window:     cfg.window(),
+		burnRates:  cfg.burnRateWindows(),
+		attributes: cfg.Attributes,
+		interval:   interval,
+		series:     make(map[[16]byte]*series),
+	}
+	for _, w := range t.burnRates {
+		t.recentSize = max(t.recentSize, int(w/interval))
+	}
+	windowTicks := int((t.window + interval - 1) / interval)
+	t.windowBucketTick = (windowTicks + maxWindowBuckets - 1) / maxWindowBuckets
+	t.windowSize = (windowTicks + t.windowBucketTick - 1) / t.windowBucketTick
+	return t
+}
+
+// record counts an event with the given attributes, falling back to the
+// resource attributes for keys the event does not have.
+func (t *tracker) record(attrs, resourceAttrs pcommon.Map, good bool) {
+	seriesAttrs := pcommon.NewMap()
+	seriesAttrs.PutStr(sloNameKey, t.name)
+	for _, key := range t.attributes {
+		if v, ok := attrs.Get(key); ok {
+			v.CopyTo(seriesAttrs.PutEmpty(key))
+		} else if v, ok := resourceAttrs.Get(key); ok {
+			v.CopyTo(seriesAttrs.PutEmpty(key))
+		}
+	}
+	key := pdatautil.MapHash(seriesAttrs)
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	s, ok := t.series[key]
+	if !ok {
+		s = &series{
+			attrs:  seriesAttrs,
+			recent: newRing(t.recentSize, 1),
+			window: newRing(t.windowSize, t.windowBucketTick),
+		}
+		t.series[key] = s
+	}
+	s.recent.record(good)
+	s.window.record(good)
+}
+
+// evaluate appends the SLI, burn rate, error budget and objective data
+// points of every series to the metrics, then advances the series by one
+// evaluation interval. Series without events in the compliance window are
+// forgotten.
+func (t *tracker) evaluate(m *sloMetrics, timestamp pcommon.Timestamp) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	windowLabel := formatWindow(t.window)
+	for key, s := range t.series {
+		total := s.window.sum(t.windowSize)
+		if total.total == 0 {
+			delete(t.series, key)
+			continue
+		}
+
+		dp := m.appendDataPoint(m.sli, s.attrs, timestamp)
+		dp.Attributes().PutStr(sloWindowKey, windowLabel)
+		dp.SetDoubleValue(ratio(total.good, total.total))
+
+		m.appendDataPoint(m.errorBudget, s.attrs, timestamp).SetDoubleValue(1 - t.burnRate(total))
+		m.appendDataPoint(m.objective, s.attrs, timestamp).SetDoubleValue(t.objective)
+
+		for _, w := range t.burnRates {
+			c := s.recent.sum(int(w / t.interval))
+			label := formatWindow(w)
+			if c.total > 0 {
+				dp = m.appendDataPoint(m.sli, s.attrs, timestamp)
+				dp.Attributes().PutStr(sloWindowKey, label)
+				dp.SetDoubleValue(ratio(c.good, c.total))
+			}
+			dp = m.appendDataPoint(m.burnRate, s.attrs, timestamp)
+			dp.Attributes().PutStr(sloWindowKey, label)
+			dp.SetDoubleValue(t.burnRate(c))
+		}
+
+		s.recent.tick()
+		s.window.tick()
+	}
+}
+
+// burnRate returns the rate at which the error budget is consumed, 1 being
+// the rate that exhausts it exactly at the end of the compliance window.
+func (t *tracker) burnRate(c counts) float64 {
+	if c.total == 0 {
+		return 0
+	}
+	return (1 - ratio(c.good, c.total)) / (1 - t.objective)
+}
+
+func ratio(good, total uint64) float64 {
+	return float64(good) / float64(total)
+}
+
+// formatWindow formats a window the way alerting rules usually name them,
+// e.g. 5m, 6h or 30d.
+func formatWindow(d time.Duration) string {
+	switch {
+	case d%(24*time.Hour) == 0:
+		return fmt.Sprintf("%dd", d/(24*time.Hour))
+	case d%time.Hour == 0:
+		return fmt.Sprintf("%dh", d/time.Hour)
+	case d%time.Minute == 0:
+		return fmt.Sprintf("%dm", d/time.Minute)
+	default:
+		return d.String()
+	}
+}
+
+// sloMetrics are the metrics emitted at each evaluation, shared by all the
+// SLOs of the connector.
+type sloMetrics struct {
+	md          pmetric.Metrics
+	sli         pmetric.NumberDataPointSlice
+	burnRate    pmetric.NumberDataPointSlice
+	errorBudget pmetric.NumberDataPointSlice
+	objective   pmetric.NumberDataPointSlice
+}
+
+func newSLOMetrics(scopeName string) *sloMetrics {
+	md := pmetric.NewMetrics()
+	sm := md.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty()
+	sm.Scope().SetName(scopeName)
+	gauge := func(name, desc string) pmetric.NumberDataPointSlice {
+		m := sm.Metrics().AppendEmpty()
+		m.SetName(name)
+		m.SetDescription(desc)
+		m.SetUnit("1")
+		return m.SetEmptyGauge().DataPoints()
+	}
+	return &sloMetrics{
+		md:          md,
+		sli:         gauge("slo.sli", "The ratio of good events over the window."),
+		burnRate:    gauge("slo.burn_rate", "The rate at which the error budget is consumed over the window."),
+		errorBudget: gauge("slo.error_budget.remaining", "The ratio of the error budget remaining over the compliance window."),
+		objective:   gauge("slo.objective", "The target ratio of good events."),
+	}
+}
+
+func (*sloMetrics) appendDataPoint(dps pmetric.NumberDataPointSlice, attrs pcommon.Map, timestamp pcommon.Timestamp) pmetric.NumberDataPoint {
+	dp := dps.AppendEmpty()
+	attrs.CopyTo(dp.Attributes())
+	dp.SetTimestamp(timestamp)
+	return dp
+}
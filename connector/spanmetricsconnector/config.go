@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"time"
 
+	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/config/configoptional"
 	"go.opentelemetry.io/collector/confmap/xconfmap"
 	"go.opentelemetry.io/collector/pdata/pmetric"
@@ -93,6 +94,11 @@ type Config struct {
 	IncludeInstrumentationScope []string `mapstructure:"include_instrumentation_scope"`
 
 	AggregationCardinalityLimit int `mapstructure:"aggregation_cardinality_limit"`
+
+	// StorageID is the ID of a storage extension used to persist the aggregated cumulative metrics,
+	// so that counters and histograms keep accumulating across collector restarts.
+	// Optional. Only supported with cumulative aggregation temporality.
+	StorageID *component.ID `mapstructure:"storage"`
 }
 
 type HistogramConfig struct {
@@ -168,6 +174,10 @@ func (c Config) Validate() error {
 		return fmt.Errorf("invalid aggregation_cardinality_limit: %v, the limit should be positive", c.AggregationCardinalityLimit)
 	}
 
+	if c.StorageID != nil && c.GetAggregationTemporality() == pmetric.AggregationTemporalityDelta {
+		return errors.New("storage is only supported with cumulative aggregation temporality")
+	}
+
 	if c.Exemplars.Enabled && c.Exemplars.MaxPerDataPoint < 0 {
 		return fmt.Errorf("invalid max_per_data_point: %v, the value should be positive", c.Exemplars.MaxPerDataPoint)
 	}
@@ -30,6 +30,7 @@ func TestLoadConfig(t *testing.T) {
 
 	defaultMethod := http.MethodGet
 	customTimestampCacheSize := 123
+	storageID := component.MustNewID("file_storage")
 	tests := []struct {
 		name            string
 		id              component.ID
@@ -185,6 +186,26 @@ func TestLoadConfig(t *testing.T) {
 			id:           component.NewIDWithName(metadata.Type, "invalid_delta_timestamp_cache_size"),
 			errorMessage: "invalid delta timestamp cache size: 0, the maximum number of the items in the cache should be positive",
 		},
+		{
+			name: "storage",
+			id:   component.NewIDWithName(metadata.Type, "storage"),
+			expected: &Config{
+				AggregationTemporality:   "AGGREGATION_TEMPORALITY_CUMULATIVE",
+				Histogram:                HistogramConfig{Disable: false, Unit: defaultUnit},
+				ResourceMetricsCacheSize: defaultResourceMetricsCacheSize,
+				MetricsFlushInterval:     60 * time.Second,
+				Exemplars: ExemplarsConfig{
+					MaxPerDataPoint: defaultMaxPerDatapoint,
+				},
+				Namespace: DefaultNamespace,
+				StorageID: &storageID,
+			},
+		},
+		{
+			name:         "invalid_storage_with_delta",
+			id:           component.NewIDWithName(metadata.Type, "invalid_storage_with_delta"),
+			errorMessage: "storage is only supported with cumulative aggregation temporality",
+		},
 		{
 			name: "separate_calls_and_duration_dimensions",
 			id:   component.NewIDWithName(metadata.Type, "separate_calls_and_duration_dimensions"),
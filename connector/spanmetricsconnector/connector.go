@@ -6,6 +6,7 @@ package spanmetricsconnector // import "github.com/open-telemetry/opentelemetry-
 import (
 	"bytes"
 	"context"
+	"errors"
 	"sync"
 	"time"
 
@@ -14,6 +15,7 @@ import (
 	"github.com/lightstep/go-expohisto/structure"
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/extension/xextension/storage"
 	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/pdata/ptrace"
@@ -22,6 +24,7 @@ import (
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/connector/spanmetricsconnector/internal/cache"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/connector/spanmetricsconnector/internal/metrics"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/storage/storageclient"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/traceutil"
 	utilattri "github.com/open-telemetry/opentelemetry-collector-contrib/internal/pdatautil"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/pdatautil"
@@ -54,6 +57,7 @@ type connectorImp struct {
 	lock   sync.Mutex
 	logger *zap.Logger
 	config Config
+	id     component.ID
 
 	metricsConsumer consumer.Metrics
 
@@ -86,6 +90,9 @@ type connectorImp struct {
 
 	// Tracks the last TimestampUnixNano for delta metrics so that they represent an uninterrupted series. Unused for cumulative span metrics.
 	lastDeltaTimestamps *simplelru.LRU[metrics.Key, pcommon.Timestamp]
+
+	// Persists the aggregated metrics across restarts when a storage extension is configured.
+	storageClient storage.Client
 }
 
 type resourceMetrics struct {
@@ -207,9 +214,20 @@ func durationsToUnits(vs []time.Duration, unitDivider int64) []float64 {
 }
 
 // Start implements the component.Component interface.
-func (p *connectorImp) Start(ctx context.Context, _ component.Host) error {
+func (p *connectorImp) Start(ctx context.Context, host component.Host) error {
 	p.logger.Info("Starting spanmetrics connector")
 
+	if p.config.StorageID != nil {
+		client, err := storageclient.Get(ctx, host, *p.config.StorageID, component.KindConnector, p.id, "")
+		if err != nil {
+			return err
+		}
+		p.storageClient = client
+		if err := p.loadState(ctx); err != nil {
+			p.logger.Warn("Failed to restore the persisted metrics, starting from scratch", zap.Error(err))
+		}
+	}
+
 	p.started = true
 	go func() {
 		for {
@@ -226,7 +244,8 @@ func (p *connectorImp) Start(ctx context.Context, _ component.Host) error {
 }
 
 // Shutdown implements the component.Component interface.
-func (p *connectorImp) Shutdown(context.Context) error {
+func (p *connectorImp) Shutdown(ctx context.Context) error {
+	var err error
 	p.shutdownOnce.Do(func() {
 		p.logger.Info("Shutting down spanmetrics connector")
 		if p.started {
@@ -235,8 +254,11 @@ func (p *connectorImp) Shutdown(context.Context) error {
 			p.done <- struct{}{}
 			p.started = false
 		}
+		if p.storageClient != nil {
+			err = errors.Join(p.saveState(ctx), p.storageClient.Close(ctx))
+		}
 	})
-	return nil
+	return err
 }
 
 // Capabilities implements the consumer interface.
@@ -266,6 +288,12 @@ func (p *connectorImp) exportMetrics(ctx context.Context) {
 		p.logger.Error("Failed ConsumeMetrics", zap.Error(err))
 		return
 	}
+
+	if p.storageClient != nil {
+		if err := p.saveState(ctx); err != nil {
+			p.logger.Warn("Failed to persist metrics", zap.Error(err))
+		}
+	}
 }
 
 // buildMetrics collects the computed raw metrics data and builds OTLP metrics.
@@ -412,7 +440,7 @@ func (p *connectorImp) aggregateMetrics(traces ptrace.Traces) {
 				// aggregate sums metrics
 				s, limitReached := sums.GetOrCreate(key, attributesFun, startTimestamp)
 				if !limitReached && p.config.Exemplars.Enabled && !span.TraceID().IsEmpty() {
-					s.AddExemplar(span.TraceID(), span.SpanID(), duration)
+					s.AddExemplar(span.TraceID(), span.SpanID(), duration, endTime)
 				}
 				s.Add(1)
 
@@ -454,7 +482,7 @@ func (p *connectorImp) aggregateMetrics(traces ptrace.Traces) {
 						}
 						e, eventLimitReached := events.GetOrCreate(eKey, attributesFun, startTimestamp)
 						if !eventLimitReached && p.config.Exemplars.Enabled && !span.TraceID().IsEmpty() {
-							e.AddExemplar(span.TraceID(), span.SpanID(), duration)
+							e.AddExemplar(span.TraceID(), span.SpanID(), duration, event.Timestamp())
 						}
 						e.Add(1)
 					}
@@ -472,7 +500,7 @@ func (p *connectorImp) addExemplar(span ptrace.Span, duration float64, h metrics
 		return
 	}
 
-	h.AddExemplar(span.TraceID(), span.SpanID(), duration)
+	h.AddExemplar(span.TraceID(), span.SpanID(), duration, span.EndTimestamp())
 }
 
 type resourceKey [16]byte
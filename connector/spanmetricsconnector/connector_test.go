@@ -115,6 +115,11 @@ func verifyExemplarsExist(tb testing.TB, input pmetric.Metrics) bool {
 				for dp := 0; dp < dps.Len(); dp++ {
 					d := dps.At(dp)
 					assert.Positive(tb, d.Exemplars().Len())
+					for e := 0; e < d.Exemplars().Len(); e++ {
+						exemplar := d.Exemplars().At(e)
+						assert.False(tb, exemplar.TraceID().IsEmpty())
+						assert.NotZero(tb, exemplar.Timestamp())
+					}
 				}
 			}
 		}
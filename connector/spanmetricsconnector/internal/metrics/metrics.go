@@ -20,11 +20,13 @@ type HistogramMetrics interface {
 	GetOrCreate(key Key, attributesFun BuildAttributesFun, startTimestamp pcommon.Timestamp) (Histogram, bool)
 	BuildMetrics(pmetric.Metric, pcommon.Timestamp, func(Key, pcommon.Timestamp) pcommon.Timestamp, pmetric.AggregationTemporality)
 	ClearExemplars()
+	Snapshot() []DataPointState
+	Restore([]DataPointState)
 }
 
 type Histogram interface {
 	Observe(value float64)
-	AddExemplar(traceID pcommon.TraceID, spanID pcommon.SpanID, value float64)
+	AddExemplar(traceID pcommon.TraceID, spanID pcommon.SpanID, value float64, timestamp pcommon.Timestamp)
 }
 
 type explicitHistogramMetrics struct {
@@ -269,7 +271,7 @@ func (h *explicitHistogram) Observe(value float64) {
 	h.bucketCounts[index]++
 }
 
-func (h *explicitHistogram) AddExemplar(traceID pcommon.TraceID, spanID pcommon.SpanID, value float64) {
+func (h *explicitHistogram) AddExemplar(traceID pcommon.TraceID, spanID pcommon.SpanID, value float64, timestamp pcommon.Timestamp) {
 	if h.exemplars.Len() >= h.maxExemplarCount {
 		return
 	}
@@ -277,13 +279,14 @@ func (h *explicitHistogram) AddExemplar(traceID pcommon.TraceID, spanID pcommon.
 	e.SetTraceID(traceID)
 	e.SetSpanID(spanID)
 	e.SetDoubleValue(value)
+	e.SetTimestamp(timestamp)
 }
 
 func (h *exponentialHistogram) Observe(value float64) {
 	h.histogram.Update(value)
 }
 
-func (h *exponentialHistogram) AddExemplar(traceID pcommon.TraceID, spanID pcommon.SpanID, value float64) {
+func (h *exponentialHistogram) AddExemplar(traceID pcommon.TraceID, spanID pcommon.SpanID, value float64, timestamp pcommon.Timestamp) {
 	if h.exemplars.Len() >= h.maxExemplarCount {
 		return
 	}
@@ -291,6 +294,7 @@ func (h *exponentialHistogram) AddExemplar(traceID pcommon.TraceID, spanID pcomm
 	e.SetTraceID(traceID)
 	e.SetSpanID(spanID)
 	e.SetDoubleValue(value)
+	e.SetTimestamp(timestamp)
 }
 
 type Sum struct {
@@ -365,7 +369,7 @@ func (m *SumMetrics) GetOrCreate(key Key, attributesFun BuildAttributesFun, star
 	return s, limitReached
 }
 
-func (s *Sum) AddExemplar(traceID pcommon.TraceID, spanID pcommon.SpanID, value float64) {
+func (s *Sum) AddExemplar(traceID pcommon.TraceID, spanID pcommon.SpanID, value float64, timestamp pcommon.Timestamp) {
 	if s.exemplars.Len() >= s.maxExemplarCount {
 		return
 	}
@@ -373,6 +377,7 @@ func (s *Sum) AddExemplar(traceID pcommon.TraceID, spanID pcommon.SpanID, value
 	e.SetTraceID(traceID)
 	e.SetSpanID(spanID)
 	e.SetDoubleValue(value)
+	e.SetTimestamp(timestamp)
 }
 
 func (m *SumMetrics) BuildMetrics(
@@ -8,6 +8,7 @@ import (
 
 	"github.com/lightstep/go-expohisto/structure"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/pmetric"
 )
@@ -160,12 +161,27 @@ func TestSum_AddExemplar(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			tt.input.AddExemplar(pcommon.TraceID{}, pcommon.SpanID{}, 4)
+			tt.input.AddExemplar(pcommon.TraceID{}, pcommon.SpanID{}, 4, 4)
 			assert.Equal(t, tt.want, tt.input.exemplars.Len())
 		})
 	}
 }
 
+func TestAddExemplarReferencesSpan(t *testing.T) {
+	traceID := pcommon.TraceID([16]byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16})
+	spanID := pcommon.SpanID([8]byte{1, 2, 3, 4, 5, 6, 7, 8})
+	h := explicitHistogram{exemplars: pmetric.NewExemplarSlice(), maxExemplarCount: 1}
+
+	h.AddExemplar(traceID, spanID, 42, 1000)
+
+	require.Equal(t, 1, h.exemplars.Len())
+	e := h.exemplars.At(0)
+	assert.Equal(t, traceID, e.TraceID())
+	assert.Equal(t, spanID, e.SpanID())
+	assert.Equal(t, 42.0, e.DoubleValue())
+	assert.Equal(t, pcommon.Timestamp(1000), e.Timestamp())
+}
+
 func TestExplicitHistogram_AddExemplar(t *testing.T) {
 	maxCount := 3
 	tests := []struct {
@@ -221,7 +237,7 @@ func TestExplicitHistogram_AddExemplar(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			tt.input.AddExemplar(pcommon.TraceID{}, pcommon.SpanID{}, 4)
+			tt.input.AddExemplar(pcommon.TraceID{}, pcommon.SpanID{}, 4, 4)
 			assert.Equal(t, tt.want, tt.input.exemplars.Len())
 		})
 	}
@@ -282,7 +298,7 @@ func TestExponentialHistogram_AddExemplar(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			tt.input.AddExemplar(pcommon.TraceID{}, pcommon.SpanID{}, 4)
+			tt.input.AddExemplar(pcommon.TraceID{}, pcommon.SpanID{}, 4, 4)
 			assert.Equal(t, tt.want, tt.input.exemplars.Len())
 		})
 	}
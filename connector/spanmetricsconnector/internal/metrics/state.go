@@ -0,0 +1,104 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package metrics // import "github.com/open-telemetry/opentelemetry-collector-contrib/connector/spanmetricsconnector/internal/metrics"
+
+import (
+	"slices"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+)
+
+// DataPointState is a serializable snapshot of the aggregated value of a single
+// sum or explicit bucket histogram data point. Exemplars are not part of the state.
+type DataPointState struct {
+	Key            Key            `json:"key"`
+	Attributes     map[string]any `json:"attributes,omitempty"`
+	StartTimestamp uint64         `json:"start_timestamp"`
+	Count          uint64         `json:"count"`
+	Sum            float64        `json:"sum,omitempty"`
+	Bounds         []float64      `json:"bounds,omitempty"`
+	BucketCounts   []uint64       `json:"bucket_counts,omitempty"`
+}
+
+// Snapshot returns the state of all the sums.
+func (m *SumMetrics) Snapshot() []DataPointState {
+	states := make([]DataPointState, 0, len(m.metrics))
+	for k, s := range m.metrics {
+		states = append(states, DataPointState{
+			Key:            k,
+			Attributes:     s.attributes.AsRaw(),
+			StartTimestamp: uint64(s.startTimestamp),
+			Count:          s.count,
+		})
+	}
+	return states
+}
+
+// Restore recreates the sums from a previously taken snapshot. Restored sums keep
+// their original start timestamp so that cumulative series are not reset.
+func (m *SumMetrics) Restore(states []DataPointState) {
+	for _, state := range states {
+		attributes := pcommon.NewMap()
+		if err := attributes.FromRaw(state.Attributes); err != nil {
+			continue
+		}
+		m.metrics[state.Key] = &Sum{
+			attributes:       attributes,
+			count:            state.Count,
+			exemplars:        pmetric.NewExemplarSlice(),
+			maxExemplarCount: m.maxExemplarCount,
+			startTimestamp:   pcommon.Timestamp(state.StartTimestamp),
+		}
+	}
+}
+
+// Snapshot returns the state of all the histograms.
+func (m *explicitHistogramMetrics) Snapshot() []DataPointState {
+	states := make([]DataPointState, 0, len(m.metrics))
+	for k, h := range m.metrics {
+		states = append(states, DataPointState{
+			Key:            k,
+			Attributes:     h.attributes.AsRaw(),
+			StartTimestamp: uint64(h.startTimestamp),
+			Count:          h.count,
+			Sum:            h.sum,
+			Bounds:         h.bounds,
+			BucketCounts:   h.bucketCounts,
+		})
+	}
+	return states
+}
+
+// Restore recreates the histograms from a previously taken snapshot. Histograms
+// recorded with different bucket boundaries than the current ones are dropped.
+func (m *explicitHistogramMetrics) Restore(states []DataPointState) {
+	for _, state := range states {
+		if !slices.Equal(state.Bounds, m.bounds) || len(state.BucketCounts) != len(m.bounds)+1 {
+			continue
+		}
+		attributes := pcommon.NewMap()
+		if err := attributes.FromRaw(state.Attributes); err != nil {
+			continue
+		}
+		m.metrics[state.Key] = &explicitHistogram{
+			attributes:       attributes,
+			exemplars:        pmetric.NewExemplarSlice(),
+			bucketCounts:     slices.Clone(state.BucketCounts),
+			count:            state.Count,
+			sum:              state.Sum,
+			bounds:           m.bounds,
+			maxExemplarCount: m.maxExemplarCount,
+			startTimestamp:   pcommon.Timestamp(state.StartTimestamp),
+		}
+	}
+}
+
+// Snapshot is not supported for exponential histograms, their state is not persisted.
+func (*exponentialHistogramMetrics) Snapshot() []DataPointState {
+	return nil
+}
+
+// Restore is a no-op for exponential histograms.
+func (*exponentialHistogramMetrics) Restore([]DataPointState) {}
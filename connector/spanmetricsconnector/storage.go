@@ -0,0 +1,84 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package spanmetricsconnector // import "github.com/open-telemetry/opentelemetry-collector-contrib/connector/spanmetricsconnector"
+
+import (
+	"context"
+	"encoding/json"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/connector/spanmetricsconnector/internal/metrics"
+)
+
+const resourceMetricsStorageKey = "resource_metrics"
+
+// resourceMetricsState is the persisted form of resourceMetrics.
+type resourceMetricsState struct {
+	Attributes map[string]any           `json:"attributes"`
+	Sums       []metrics.DataPointState `json:"sums,omitempty"`
+	Histograms []metrics.DataPointState `json:"histograms,omitempty"`
+	Events     []metrics.DataPointState `json:"events,omitempty"`
+}
+
+// marshalState serializes the aggregated metrics of all resources. Must be called with the lock held.
+func (p *connectorImp) marshalState() ([]byte, error) {
+	states := make([]resourceMetricsState, 0, p.resourceMetrics.Len())
+	p.resourceMetrics.ForEach(func(_ resourceKey, rm *resourceMetrics) {
+		state := resourceMetricsState{
+			Attributes: rm.attributes.AsRaw(),
+			Sums:       rm.sums.Snapshot(),
+			Events:     rm.events.Snapshot(),
+		}
+		if rm.histograms != nil {
+			state.Histograms = rm.histograms.Snapshot()
+		}
+		states = append(states, state)
+	})
+	return json.Marshal(states)
+}
+
+// unmarshalState restores the aggregated metrics of all resources. Must be called with the lock held.
+func (p *connectorImp) unmarshalState(data []byte) error {
+	var states []resourceMetricsState
+	if err := json.Unmarshal(data, &states); err != nil {
+		return err
+	}
+	for _, state := range states {
+		attributes := pcommon.NewMap()
+		if err := attributes.FromRaw(state.Attributes); err != nil {
+			return err
+		}
+		rm := p.getOrCreateResourceMetrics(attributes)
+		rm.sums.Restore(state.Sums)
+		rm.events.Restore(state.Events)
+		if rm.histograms != nil {
+			rm.histograms.Restore(state.Histograms)
+		}
+	}
+	return nil
+}
+
+// loadState restores the state persisted by a previous run of the connector, if any.
+func (p *connectorImp) loadState(ctx context.Context) error {
+	data, err := p.storageClient.Get(ctx, resourceMetricsStorageKey)
+	if err != nil || data == nil {
+		return err
+	}
+
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	return p.unmarshalState(data)
+}
+
+// saveState persists the current state so that it can be restored after a restart.
+func (p *connectorImp) saveState(ctx context.Context) error {
+	p.lock.Lock()
+	data, err := p.marshalState()
+	p.lock.Unlock()
+	if err != nil {
+		return err
+	}
+	return p.storageClient.Set(ctx, resourceMetricsStorageKey, data)
+}
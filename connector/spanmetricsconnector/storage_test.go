@@ -0,0 +1,108 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package spanmetricsconnector
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jonboulle/clockwork"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/connector/spanmetricsconnector/internal/metadata"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/storage/storagetest"
+)
+
+func newTestClient() *storagetest.TestClient {
+	return storagetest.NewInMemoryClient(component.KindConnector, component.NewID(metadata.Type), "")
+}
+
+// totals returns the sum of all calls and the total count of all duration histograms.
+func totals(md pmetric.Metrics) (calls int64, durations uint64) {
+	rms := md.ResourceMetrics()
+	for i := 0; i < rms.Len(); i++ {
+		ms := rms.At(i).ScopeMetrics().At(0).Metrics()
+		for j := 0; j < ms.Len(); j++ {
+			m := ms.At(j)
+			switch m.Type() {
+			case pmetric.MetricTypeSum:
+				for k := 0; k < m.Sum().DataPoints().Len(); k++ {
+					calls += m.Sum().DataPoints().At(k).IntValue()
+				}
+			case pmetric.MetricTypeHistogram:
+				for k := 0; k < m.Histogram().DataPoints().Len(); k++ {
+					durations += m.Histogram().DataPoints().At(k).Count()
+				}
+			}
+		}
+	}
+	return calls, durations
+}
+
+func TestStateIsRestoredFromStorage(t *testing.T) {
+	client := newTestClient()
+	ctx := context.Background()
+
+	p, err := newConnectorImp(stringp("defaultNullValue"), explicitHistogramsConfig, disabledExemplarsConfig, disabledEventsConfig, cumulative, 0, []string{}, 1000, clockwork.NewFakeClock())
+	require.NoError(t, err)
+	p.storageClient = client
+	require.NoError(t, p.ConsumeTraces(ctx, buildSampleTrace()))
+	require.NoError(t, p.ConsumeTraces(ctx, buildSampleTrace()))
+	// The first export of a cumulative sum is always zero, skip it.
+	p.buildMetrics()
+	expectedCalls, expectedDurations := totals(p.buildMetrics())
+	require.NoError(t, p.saveState(ctx))
+
+	restored, err := newConnectorImp(stringp("defaultNullValue"), explicitHistogramsConfig, disabledExemplarsConfig, disabledEventsConfig, cumulative, 0, []string{}, 1000, clockwork.NewFakeClock())
+	require.NoError(t, err)
+	restored.storageClient = client
+	require.NoError(t, restored.loadState(ctx))
+
+	assert.Equal(t, p.resourceMetrics.Len(), restored.resourceMetrics.Len())
+	calls, durations := totals(restored.buildMetrics())
+	assert.Equal(t, expectedCalls, calls)
+	assert.Equal(t, expectedDurations, durations)
+
+	// New spans keep accumulating on top of the restored values.
+	require.NoError(t, restored.ConsumeTraces(ctx, buildSampleTrace()))
+	calls, durations = totals(restored.buildMetrics())
+	assert.Equal(t, expectedCalls*3/2, calls)
+	assert.Equal(t, expectedDurations*3/2, durations)
+}
+
+func TestLoadStateWithoutPersistedData(t *testing.T) {
+	p, err := newConnectorImp(stringp("defaultNullValue"), explicitHistogramsConfig, disabledExemplarsConfig, disabledEventsConfig, cumulative, 0, []string{}, 1000, clockwork.NewFakeClock())
+	require.NoError(t, err)
+	p.storageClient = newTestClient()
+
+	require.NoError(t, p.loadState(context.Background()))
+	assert.Zero(t, p.resourceMetrics.Len())
+}
+
+func TestStartWithMissingStorageExtension(t *testing.T) {
+	p, err := newConnectorImp(stringp("defaultNullValue"), explicitHistogramsConfig, disabledExemplarsConfig, disabledEventsConfig, cumulative, 0, []string{}, 1000, clockwork.NewFakeClock())
+	require.NoError(t, err)
+	storageID := component.MustNewID("file_storage")
+	p.config.StorageID = &storageID
+
+	err = p.Start(context.Background(), componenttest.NewNopHost())
+	assert.EqualError(t, err, "storage extension 'file_storage' not found")
+}
+
+func TestStartWithStorageExtension(t *testing.T) {
+	p, err := newConnectorImp(stringp("defaultNullValue"), explicitHistogramsConfig, disabledExemplarsConfig, disabledEventsConfig, cumulative, 0, []string{}, 1000, clockwork.NewFakeClock())
+	require.NoError(t, err)
+	storageID := storagetest.NewStorageID("spanmetrics")
+	p.config.StorageID = &storageID
+
+	require.NoError(t, p.Start(context.Background(), storagetest.NewStorageHost().WithInMemoryStorageExtension("spanmetrics")))
+	creatorID, err := storagetest.CreatorID(context.Background(), p.storageClient)
+	require.NoError(t, err)
+	assert.Equal(t, storageID, creatorID)
+	require.NoError(t, p.Shutdown(context.Background()))
+}
@@ -0,0 +1,178 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package amqpexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/amqpexporter"
+
+import (
+	"context"
+	"crypto/tls"
+	"net/url"
+	"sync"
+
+	"github.com/Azure/go-amqp"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer/consumererror"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.uber.org/zap"
+)
+
+const (
+	contentTypeProtobuf = "application/x-protobuf"
+	contentTypeJSON     = "application/json"
+
+	// signalProperty is the application property holding the signal of the telemetry of the message,
+	// which allows to route the messages, e.g. with the filters of the Service Bus subscriptions.
+	signalProperty = "otlp_signal"
+	signalTraces   = "traces"
+	signalMetrics  = "metrics"
+	signalLogs     = "logs"
+)
+
+type amqpExporter struct {
+	cfg     *Config
+	signal  string
+	address string
+	logger  *zap.Logger
+
+	tracesMarshaler  ptrace.Marshaler
+	metricsMarshaler pmetric.Marshaler
+	logsMarshaler    plog.Marshaler
+	contentType      string
+
+	newSender senderFactory
+
+	mu     sync.Mutex
+	sender messageSender
+}
+
+func newAMQPExporter(cfg *Config, signal, address string, set component.TelemetrySettings) *amqpExporter {
+	e := &amqpExporter{
+		cfg:     cfg,
+		signal:  signal,
+		address: address,
+		logger:  set.Logger,
+	}
+	if cfg.Encoding == encodingOTLPJSON {
+		e.tracesMarshaler = &ptrace.JSONMarshaler{}
+		e.metricsMarshaler = &pmetric.JSONMarshaler{}
+		e.logsMarshaler = &plog.JSONMarshaler{}
+		e.contentType = contentTypeJSON
+	} else {
+		e.tracesMarshaler = &ptrace.ProtoMarshaler{}
+		e.metricsMarshaler = &pmetric.ProtoMarshaler{}
+		e.logsMarshaler = &plog.ProtoMarshaler{}
+		e.contentType = contentTypeProtobuf
+	}
+	return e
+}
+
+func (e *amqpExporter) start(ctx context.Context, _ component.Host) error {
+	var tlsConfig *tls.Config
+	if u, err := url.Parse(e.cfg.Endpoint); err == nil && u.Scheme == "amqps" {
+		if tlsConfig, err = e.cfg.TLS.LoadTLSConfig(ctx); err != nil {
+			return err
+		}
+	}
+	// The connection is opened by the first export, so that the collector starts when the broker is unavailable.
+	e.newSender = newAMQPSenderFactory(e.cfg, e.address, tlsConfig, e.logger)
+	return nil
+}
+
+func (e *amqpExporter) shutdown(ctx context.Context) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.sender == nil {
+		return nil
+	}
+	err := e.sender.close(ctx)
+	e.sender = nil
+	return err
+}
+
+func (e *amqpExporter) publishTraces(ctx context.Context, td ptrace.Traces) error {
+	payload, err := e.tracesMarshaler.MarshalTraces(td)
+	if err != nil {
+		return consumererror.NewPermanent(err)
+	}
+	return e.publish(ctx, payload)
+}
+
+func (e *amqpExporter) publishMetrics(ctx context.Context, md pmetric.Metrics) error {
+	payload, err := e.metricsMarshaler.MarshalMetrics(md)
+	if err != nil {
+		return consumererror.NewPermanent(err)
+	}
+	return e.publish(ctx, payload)
+}
+
+func (e *amqpExporter) publishLogs(ctx context.Context, ld plog.Logs) error {
+	payload, err := e.logsMarshaler.MarshalLogs(ld)
+	if err != nil {
+		return consumererror.NewPermanent(err)
+	}
+	return e.publish(ctx, payload)
+}
+
+func (e *amqpExporter) publish(ctx context.Context, payload []byte) error {
+	sender, err := e.getSender(ctx)
+	if err != nil {
+		return err
+	}
+
+	err = sender.send(ctx, e.newMessage(payload))
+	switch {
+	case err == nil:
+		return nil
+	case isConnectionError(err):
+		e.logger.Warn("AMQP connection lost, reconnecting on the next export", zap.Error(err))
+		e.resetSender(ctx, sender)
+		return err
+	case isPermanentRejection(err):
+		return consumererror.NewPermanent(err)
+	default:
+		return err
+	}
+}
+
+func (e *amqpExporter) newMessage(payload []byte) *amqp.Message {
+	msg := amqp.NewMessage(payload)
+	msg.Properties = &amqp.MessageProperties{
+		ContentType: &e.contentType,
+	}
+	msg.ApplicationProperties = map[string]any{
+		signalProperty: e.signal,
+	}
+	msg.Header = &amqp.MessageHeader{
+		Durable: e.cfg.Durable,
+		TTL:     e.cfg.TTL,
+	}
+	return msg
+}
+
+// getSender returns the sender of the current connection, connecting to the broker if needed.
+func (e *amqpExporter) getSender(ctx context.Context) (messageSender, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.sender != nil {
+		return e.sender, nil
+	}
+	sender, err := e.newSender(ctx)
+	if err != nil {
+		return nil, err
+	}
+	e.sender = sender
+	return sender, nil
+}
+
+// resetSender closes the broken sender, unless it was already replaced by another export.
+func (e *amqpExporter) resetSender(ctx context.Context, broken messageSender) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.sender != broken {
+		return
+	}
+	_ = broken.close(ctx)
+	e.sender = nil
+}
@@ -0,0 +1,155 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package amqpexporter
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Azure/go-amqp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/consumer/consumererror"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+type fakeSender struct {
+	messages []*amqp.Message
+	sendErr  error
+	closed   bool
+}
+
+func (s *fakeSender) send(_ context.Context, msg *amqp.Message) error {
+	if s.sendErr != nil {
+		return s.sendErr
+	}
+	s.messages = append(s.messages, msg)
+	return nil
+}
+
+func (s *fakeSender) close(context.Context) error {
+	s.closed = true
+	return nil
+}
+
+func newTestExporter(cfg *Config, senders ...*fakeSender) (*amqpExporter, *int) {
+	e := newAMQPExporter(cfg, signalTraces, defaultTracesAddress, componenttest.NewNopTelemetrySettings())
+	dials := 0
+	e.newSender = func(context.Context) (messageSender, error) {
+		if dials >= len(senders) {
+			return nil, errors.New("connection refused")
+		}
+		dials++
+		return senders[dials-1], nil
+	}
+	return e, &dials
+}
+
+func TestPublishTraces(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.TTL = time.Minute
+	sender := &fakeSender{}
+	e, dials := newTestExporter(cfg, sender)
+
+	traces := ptrace.NewTraces()
+	traces.ResourceSpans().AppendEmpty().ScopeSpans().AppendEmpty().Spans().AppendEmpty().SetName("span")
+	require.NoError(t, e.publishTraces(context.Background(), traces))
+	require.NoError(t, e.publishTraces(context.Background(), traces))
+	assert.Equal(t, 1, *dials, "the connection must be reused")
+
+	require.Len(t, sender.messages, 2)
+	msg := sender.messages[0]
+	assert.Equal(t, contentTypeProtobuf, *msg.Properties.ContentType)
+	assert.Equal(t, map[string]any{signalProperty: signalTraces}, msg.ApplicationProperties)
+	assert.True(t, msg.Header.Durable)
+	assert.Equal(t, time.Minute, msg.Header.TTL)
+	received, err := (&ptrace.ProtoUnmarshaler{}).UnmarshalTraces(msg.GetData())
+	require.NoError(t, err)
+	assert.Equal(t, traces, received)
+
+	require.NoError(t, e.shutdown(context.Background()))
+	assert.True(t, sender.closed)
+}
+
+func TestPublishJSON(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Encoding = encodingOTLPJSON
+	sender := &fakeSender{}
+	e, _ := newTestExporter(cfg, sender)
+
+	metrics := pmetric.NewMetrics()
+	metrics.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics().AppendEmpty().SetName("metric")
+	logs := plog.NewLogs()
+	logs.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords().AppendEmpty().Body().SetStr("log")
+	require.NoError(t, e.publishMetrics(context.Background(), metrics))
+	require.NoError(t, e.publishLogs(context.Background(), logs))
+
+	require.Len(t, sender.messages, 2)
+	assert.Equal(t, contentTypeJSON, *sender.messages[0].Properties.ContentType)
+	receivedMetrics, err := (&pmetric.JSONUnmarshaler{}).UnmarshalMetrics(sender.messages[0].GetData())
+	require.NoError(t, err)
+	assert.Equal(t, metrics, receivedMetrics)
+	receivedLogs, err := (&plog.JSONUnmarshaler{}).UnmarshalLogs(sender.messages[1].GetData())
+	require.NoError(t, err)
+	assert.Equal(t, logs, receivedLogs)
+}
+
+func TestPublishReconnectsAfterConnectionError(t *testing.T) {
+	broken := &fakeSender{sendErr: &amqp.ConnError{}}
+	healthy := &fakeSender{}
+	e, dials := newTestExporter(createDefaultConfig().(*Config), broken, healthy)
+
+	err := e.publishLogs(context.Background(), plog.NewLogs())
+	require.Error(t, err)
+	assert.False(t, consumererror.IsPermanent(err))
+	assert.True(t, broken.closed)
+
+	require.NoError(t, e.publishLogs(context.Background(), plog.NewLogs()))
+	assert.Equal(t, 2, *dials)
+	assert.Len(t, healthy.messages, 1)
+}
+
+func TestPublishDialError(t *testing.T) {
+	e, _ := newTestExporter(createDefaultConfig().(*Config))
+
+	err := e.publishLogs(context.Background(), plog.NewLogs())
+	require.EqualError(t, err, "connection refused")
+	assert.False(t, consumererror.IsPermanent(err))
+	require.NoError(t, e.shutdown(context.Background()))
+}
+
+func TestPublishRejected(t *testing.T) {
+	tests := []struct {
+		name      string
+		err       error
+		permanent bool
+	}{
+		{
+			name:      "message size exceeded",
+			err:       &amqp.Error{Condition: amqp.ErrCondMessageSizeExceeded},
+			permanent: true,
+		},
+		{
+			name:      "resource limit exceeded",
+			err:       &amqp.Error{Condition: amqp.ErrCondResourceLimitExceeded},
+			permanent: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sender := &fakeSender{sendErr: tt.err}
+			e, _ := newTestExporter(createDefaultConfig().(*Config), sender)
+
+			err := e.publishLogs(context.Background(), plog.NewLogs())
+			require.Error(t, err)
+			assert.Equal(t, tt.permanent, consumererror.IsPermanent(err))
+			assert.False(t, sender.closed)
+		})
+	}
+}
@@ -0,0 +1,112 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package amqpexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/amqpexporter"
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config/configopaque"
+	"go.opentelemetry.io/collector/config/configoptional"
+	"go.opentelemetry.io/collector/config/configretry"
+	"go.opentelemetry.io/collector/config/configtls"
+	"go.opentelemetry.io/collector/exporter/exporterhelper"
+)
+
+const (
+	encodingOTLPProto = "otlp_proto"
+	encodingOTLPJSON  = "otlp_json"
+)
+
+var (
+	errMissingEndpoint    = errors.New("endpoint is required")
+	errTooManyAuthDetails = errors.New("only one authentication method must be used")
+)
+
+// Config defines configuration for the AMQP exporter.
+type Config struct {
+	TimeoutSettings           exporterhelper.TimeoutConfig    `mapstructure:",squash"`
+	QueueSettings             exporterhelper.QueueBatchConfig `mapstructure:"sending_queue"`
+	configretry.BackOffConfig `mapstructure:"retry_on_failure"`
+
+	// Endpoint is the URL of the broker, e.g. amqp://localhost:5672 or amqps://<namespace>.servicebus.windows.net.
+	Endpoint string `mapstructure:"endpoint"`
+	// ContainerID identifies the connection on the broker. A random one is generated when empty.
+	ContainerID string `mapstructure:"container_id"`
+	// TLS configures the connection to amqps:// endpoints.
+	TLS configtls.ClientConfig `mapstructure:"tls"`
+	// Auth configures the SASL authentication of the connection. The ANONYMOUS mechanism is used when empty.
+	Auth Authentication `mapstructure:"auth"`
+	// ConnectionTimeout bounds the time spent establishing the connection to the broker.
+	ConnectionTimeout time.Duration `mapstructure:"connection_timeout"`
+
+	// Address is the queue or topic the messages are sent to (default otlp_spans for traces,
+	// otlp_metrics for metrics, otlp_logs for logs).
+	Address string `mapstructure:"address"`
+	// Encoding of the messages: otlp_proto or otlp_json.
+	Encoding string `mapstructure:"encoding"`
+	// Durable asks the broker to persist the messages.
+	Durable bool `mapstructure:"durable"`
+	// TTL is the time to live of the messages, zero means they do not expire.
+	TTL time.Duration `mapstructure:"ttl"`
+	// Presettled sends the messages without waiting for the broker to settle them. It is faster
+	// but the messages the broker fails to accept are lost.
+	Presettled bool `mapstructure:"presettled"`
+}
+
+// Authentication defines the SASL mechanisms used to authenticate on the broker.
+type Authentication struct {
+	// Plain authenticates with a user name and a password. With Azure Service Bus, the user name
+	// is the name of a shared access policy and the password its key.
+	Plain configoptional.Optional[SASLPlainConfig] `mapstructure:"sasl_plain"`
+	// External authenticates with the TLS client certificate.
+	External configoptional.Optional[SASLExternalConfig] `mapstructure:"sasl_external"`
+
+	// prevent unkeyed literal initialization
+	_ struct{}
+}
+
+// SASLPlainConfig defines SASL PLAIN authentication.
+type SASLPlainConfig struct {
+	Username string              `mapstructure:"username"`
+	Password configopaque.String `mapstructure:"password"`
+
+	// prevent unkeyed literal initialization
+	_ struct{}
+}
+
+// SASLExternalConfig defines SASL EXTERNAL authentication, used in conjunction with TLS client authentication.
+type SASLExternalConfig struct{}
+
+var _ component.Config = (*Config)(nil)
+
+// Validate checks the exporter configuration is valid
+func (cfg *Config) Validate() error {
+	if cfg.Endpoint == "" {
+		return errMissingEndpoint
+	}
+	u, err := url.Parse(cfg.Endpoint)
+	if err != nil {
+		return fmt.Errorf("invalid endpoint: %w", err)
+	}
+	if u.Scheme != "amqp" && u.Scheme != "amqps" {
+		return fmt.Errorf("unsupported endpoint scheme %q, must be amqp or amqps", u.Scheme)
+	}
+	if cfg.Auth.Plain.HasValue() && cfg.Auth.External.HasValue() {
+		return errTooManyAuthDetails
+	}
+	if cfg.Auth.Plain.HasValue() && cfg.Auth.Plain.Get().Username == "" {
+		return errors.New("auth.sasl_plain.username is required")
+	}
+	if cfg.Encoding != encodingOTLPProto && cfg.Encoding != encodingOTLPJSON {
+		return fmt.Errorf("unsupported encoding %q", cfg.Encoding)
+	}
+	if cfg.TTL < 0 {
+		return errors.New("ttl must not be negative")
+	}
+	return nil
+}
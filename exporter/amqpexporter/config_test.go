@@ -0,0 +1,91 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package amqpexporter
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config/configoptional"
+	"go.opentelemetry.io/collector/config/configretry"
+	"go.opentelemetry.io/collector/confmap/confmaptest"
+	"go.opentelemetry.io/collector/confmap/xconfmap"
+	"go.opentelemetry.io/collector/exporter/exporterhelper"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/amqpexporter/internal/metadata"
+)
+
+func TestLoadConfig(t *testing.T) {
+	cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
+	require.NoError(t, err)
+
+	queueSettings := exporterhelper.NewDefaultQueueConfig()
+	queueSettings.Enabled = false
+	retrySettings := configretry.NewDefaultBackOffConfig()
+	retrySettings.Enabled = false
+
+	tests := []struct {
+		id          component.ID
+		expected    component.Config
+		expectedErr string
+	}{
+		{
+			id:       component.NewID(metadata.Type),
+			expected: createDefaultConfig(),
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "servicebus"),
+			expected: &Config{
+				TimeoutSettings:   exporterhelper.TimeoutConfig{Timeout: 10 * time.Second},
+				QueueSettings:     queueSettings,
+				BackOffConfig:     retrySettings,
+				Endpoint:          "amqps://otel.servicebus.windows.net",
+				ContainerID:       "otelcol-1",
+				ConnectionTimeout: defaultConnectionTimeout,
+				Auth: Authentication{
+					Plain: configoptional.Some(SASLPlainConfig{Username: "RootManageSharedAccessKey", Password: "secret"}),
+				},
+				Address:    "telemetry",
+				Encoding:   encodingOTLPJSON,
+				Durable:    false,
+				TTL:        time.Hour,
+				Presettled: true,
+			},
+		},
+		{
+			id:          component.NewIDWithName(metadata.Type, "invalid_scheme"),
+			expectedErr: `unsupported endpoint scheme "http", must be amqp or amqps`,
+		},
+		{
+			id:          component.NewIDWithName(metadata.Type, "too_many_auth"),
+			expectedErr: errTooManyAuthDetails.Error(),
+		},
+		{
+			id:          component.NewIDWithName(metadata.Type, "invalid_encoding"),
+			expectedErr: `unsupported encoding "avro"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.id.String(), func(t *testing.T) {
+			factory := NewFactory()
+			cfg := factory.CreateDefaultConfig()
+
+			sub, err := cm.Sub(tt.id.String())
+			require.NoError(t, err)
+			require.NoError(t, sub.Unmarshal(cfg))
+
+			if tt.expectedErr != "" {
+				assert.ErrorContains(t, xconfmap.Validate(cfg), tt.expectedErr)
+				return
+			}
+			require.NoError(t, xconfmap.Validate(cfg))
+			assert.Equal(t, tt.expected, cfg)
+		})
+	}
+}
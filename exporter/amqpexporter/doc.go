@@ -0,0 +1,7 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:generate mdatagen metadata.yaml
+
+// Package amqpexporter sends traces, metrics and logs to the queues and topics of AMQP 1.0 brokers.
+package amqpexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/amqpexporter"
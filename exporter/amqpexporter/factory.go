@@ -0,0 +1,122 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package amqpexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/amqpexporter"
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config/configretry"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/exporter"
+	"go.opentelemetry.io/collector/exporter/exporterhelper"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/amqpexporter/internal/metadata"
+)
+
+const (
+	defaultEndpoint          = "amqp://localhost:5672"
+	defaultConnectionTimeout = 10 * time.Second
+
+	defaultTracesAddress  = "otlp_spans"
+	defaultMetricsAddress = "otlp_metrics"
+	defaultLogsAddress    = "otlp_logs"
+)
+
+// NewFactory creates a factory for the AMQP exporter.
+func NewFactory() exporter.Factory {
+	return exporter.NewFactory(
+		metadata.Type,
+		createDefaultConfig,
+		exporter.WithTraces(createTracesExporter, metadata.TracesStability),
+		exporter.WithMetrics(createMetricsExporter, metadata.MetricsStability),
+		exporter.WithLogs(createLogsExporter, metadata.LogsStability),
+	)
+}
+
+func createDefaultConfig() component.Config {
+	return &Config{
+		TimeoutSettings:   exporterhelper.NewDefaultTimeoutConfig(),
+		BackOffConfig:     configretry.NewDefaultBackOffConfig(),
+		QueueSettings:     exporterhelper.NewDefaultQueueConfig(),
+		Endpoint:          defaultEndpoint,
+		ConnectionTimeout: defaultConnectionTimeout,
+		Encoding:          encodingOTLPProto,
+		Durable:           true,
+	}
+}
+
+func createTracesExporter(
+	ctx context.Context,
+	set exporter.Settings,
+	cfg component.Config,
+) (exporter.Traces, error) {
+	config := cfg.(*Config)
+	e := newAMQPExporter(config, signalTraces, addressOrDefault(config, defaultTracesAddress), set.TelemetrySettings)
+
+	return exporterhelper.NewTraces(
+		ctx,
+		set,
+		cfg,
+		e.publishTraces,
+		exporterhelper.WithCapabilities(consumer.Capabilities{MutatesData: false}),
+		exporterhelper.WithTimeout(config.TimeoutSettings),
+		exporterhelper.WithRetry(config.BackOffConfig),
+		exporterhelper.WithQueue(config.QueueSettings),
+		exporterhelper.WithStart(e.start),
+		exporterhelper.WithShutdown(e.shutdown),
+	)
+}
+
+func createMetricsExporter(
+	ctx context.Context,
+	set exporter.Settings,
+	cfg component.Config,
+) (exporter.Metrics, error) {
+	config := cfg.(*Config)
+	e := newAMQPExporter(config, signalMetrics, addressOrDefault(config, defaultMetricsAddress), set.TelemetrySettings)
+
+	return exporterhelper.NewMetrics(
+		ctx,
+		set,
+		cfg,
+		e.publishMetrics,
+		exporterhelper.WithCapabilities(consumer.Capabilities{MutatesData: false}),
+		exporterhelper.WithTimeout(config.TimeoutSettings),
+		exporterhelper.WithRetry(config.BackOffConfig),
+		exporterhelper.WithQueue(config.QueueSettings),
+		exporterhelper.WithStart(e.start),
+		exporterhelper.WithShutdown(e.shutdown),
+	)
+}
+
+func createLogsExporter(
+	ctx context.Context,
+	set exporter.Settings,
+	cfg component.Config,
+) (exporter.Logs, error) {
+	config := cfg.(*Config)
+	e := newAMQPExporter(config, signalLogs, addressOrDefault(config, defaultLogsAddress), set.TelemetrySettings)
+
+	return exporterhelper.NewLogs(
+		ctx,
+		set,
+		cfg,
+		e.publishLogs,
+		exporterhelper.WithCapabilities(consumer.Capabilities{MutatesData: false}),
+		exporterhelper.WithTimeout(config.TimeoutSettings),
+		exporterhelper.WithRetry(config.BackOffConfig),
+		exporterhelper.WithQueue(config.QueueSettings),
+		exporterhelper.WithStart(e.start),
+		exporterhelper.WithShutdown(e.shutdown),
+	)
+}
+
+func addressOrDefault(config *Config, fallback string) string {
+	if config.Address != "" {
+		return config.Address
+	}
+	return fallback
+}
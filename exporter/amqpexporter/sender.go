@@ -0,0 +1,114 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package amqpexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/amqpexporter"
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+
+	"github.com/Azure/go-amqp"
+	"go.uber.org/zap"
+)
+
+// messageSender abstracts out the AMQP link the messages are sent over for unit testing.
+type messageSender interface {
+	send(ctx context.Context, msg *amqp.Message) error
+	close(ctx context.Context) error
+}
+
+// senderFactory opens a new connection to the broker and a sending link to the address.
+type senderFactory func(ctx context.Context) (messageSender, error)
+
+// dialFunc is abstracted out into a variable in order for substitutions
+var dialFunc = amqp.Dial
+
+type amqpSender struct {
+	conn    *amqp.Conn
+	session *amqp.Session
+	sender  *amqp.Sender
+}
+
+func newAMQPSenderFactory(cfg *Config, address string, tlsConfig *tls.Config, logger *zap.Logger) senderFactory {
+	return func(ctx context.Context) (messageSender, error) {
+		if cfg.ConnectionTimeout > 0 {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, cfg.ConnectionTimeout)
+			defer cancel()
+		}
+
+		opts := &amqp.ConnOptions{
+			ContainerID: cfg.ContainerID,
+			SASLType:    saslType(cfg.Auth),
+			TLSConfig:   tlsConfig,
+		}
+		logger.Debug("Dialing AMQP", zap.String("endpoint", cfg.Endpoint))
+		conn, err := dialFunc(ctx, cfg.Endpoint, opts)
+		if err != nil {
+			return nil, err
+		}
+		session, err := conn.NewSession(ctx, nil)
+		if err != nil {
+			_ = conn.Close()
+			return nil, err
+		}
+		settleMode := amqp.SenderSettleModeUnsettled
+		if cfg.Presettled {
+			settleMode = amqp.SenderSettleModeSettled
+		}
+		logger.Debug("Creating new AMQP Send Link", zap.String("target", address))
+		sender, err := session.NewSender(ctx, address, &amqp.SenderOptions{
+			SettlementMode: settleMode.Ptr(),
+		})
+		if err != nil {
+			_ = conn.Close()
+			return nil, err
+		}
+		return &amqpSender{conn: conn, session: session, sender: sender}, nil
+	}
+}
+
+func (s *amqpSender) send(ctx context.Context, msg *amqp.Message) error {
+	return s.sender.Send(ctx, msg, nil)
+}
+
+func (s *amqpSender) close(ctx context.Context) error {
+	return errors.Join(s.sender.Close(ctx), s.session.Close(ctx), s.conn.Close())
+}
+
+// saslType returns the SASL mechanism configured in auth.
+func saslType(auth Authentication) amqp.SASLType {
+	switch {
+	case auth.Plain.HasValue():
+		plain := auth.Plain.Get()
+		return amqp.SASLTypePlain(plain.Username, string(plain.Password))
+	case auth.External.HasValue():
+		return amqp.SASLTypeExternal("")
+	default:
+		return amqp.SASLTypeAnonymous()
+	}
+}
+
+// isConnectionError reports whether err means the connection, the session or the link
+// is no longer usable and must be reopened.
+func isConnectionError(err error) bool {
+	var connErr *amqp.ConnError
+	var sessionErr *amqp.SessionError
+	var linkErr *amqp.LinkError
+	return errors.As(err, &connErr) || errors.As(err, &sessionErr) || errors.As(err, &linkErr)
+}
+
+// isPermanentRejection reports whether the broker rejected a message because it can never accept it.
+func isPermanentRejection(err error) bool {
+	var amqpErr *amqp.Error
+	if !errors.As(err, &amqpErr) {
+		return false
+	}
+	switch amqpErr.Condition {
+	case amqp.ErrCondDecodeError, amqp.ErrCondMessageSizeExceeded, amqp.ErrCondNotAllowed, amqp.ErrCondInvalidField:
+		return true
+	default:
+		return false
+	}
+}
@@ -42,6 +42,10 @@ type Config struct {
 	// Values must be between 1-256 characters and follow the regex pattern: ^([\p{L}\p{Z}\p{N}_.:/=+\-@]*)$
 	Tags map[string]string `mapstructure:"tags"`
 
+	// DisableLogGroupCreation prevents the exporter from creating the missing log groups, with the LogRetention and Tags,
+	// for the log groups that are provisioned beforehand.
+	DisableLogGroupCreation bool `mapstructure:"disable_log_group_creation"`
+
 	// Queue settings frm the exporterhelper
 	QueueSettings exporterhelper.QueueBatchConfig `mapstructure:"sending_queue"`
 
@@ -76,6 +80,10 @@ func (config *Config) Validate() error {
 		return err
 	}
 
+	if config.DisableLogGroupCreation && (config.LogRetention != 0 || len(config.Tags) > 0) {
+		return errors.New("'log_retention' and 'tags' are only applied to the created log groups and can't be set with 'disable_log_group_creation'")
+	}
+
 	if retErr := cwlogs.ValidateRetentionValue(config.LogRetention); retErr != nil {
 		return retErr
 	}
@@ -147,6 +147,25 @@ func TestRetentionValidateWrong(t *testing.T) {
 	assert.Error(t, xconfmap.Validate(wrongcfg))
 }
 
+func TestValidateDisableLogGroupCreation(t *testing.T) {
+	cfg := &Config{
+		BackOffConfig:           configretry.NewDefaultBackOffConfig(),
+		LogGroupName:            "/app/{resource.k8s.namespace.name}",
+		LogStreamName:           "testing",
+		DisableLogGroupCreation: true,
+		AWSSessionSettings:      awsutil.CreateDefaultSessionConfig(),
+		QueueSettings: exporterhelper.QueueBatchConfig{
+			Enabled:      true,
+			NumConsumers: 1,
+			QueueSize:    exporterhelper.NewDefaultQueueConfig().QueueSize,
+		},
+	}
+	assert.NoError(t, xconfmap.Validate(cfg))
+
+	cfg.LogRetention = 365
+	assert.ErrorContains(t, xconfmap.Validate(cfg), "can't be set with 'disable_log_group_creation'")
+}
+
 func TestValidateTags(t *testing.T) {
 	defaultBackOffConfig := configretry.NewDefaultBackOffConfig()
 
@@ -59,7 +59,11 @@ func newCwLogsPusher(ctx context.Context, expConfig *Config, params exp.Settings
 	}
 
 	// create CWLogs client with aws session config
-	svcStructuredLog := cwlogs.NewClient(params.Logger, awsConfig, params.BuildInfo, expConfig.LogGroupName, expConfig.LogRetention, expConfig.Tags, metadata.Type.String())
+	var clientOptions []cwlogs.ClientOption
+	if expConfig.DisableLogGroupCreation {
+		clientOptions = append(clientOptions, cwlogs.WithoutLogGroupCreation())
+	}
+	svcStructuredLog := cwlogs.NewClient(params.Logger, awsConfig, params.BuildInfo, expConfig.LogGroupName, expConfig.LogRetention, expConfig.Tags, metadata.Type.String(), clientOptions...)
 	collectorIdentifier, err := uuid.NewRandom()
 	if err != nil {
 		return nil, err
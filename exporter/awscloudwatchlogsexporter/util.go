@@ -25,17 +25,25 @@ var patternKeyToAttributeMap = map[string]string{
 	"FaasVersion":          "faas.version",
 }
 
+// resourceAttributePatternPrefix is the prefix of the patterns that are replaced with the value of
+// the resource attribute named after the prefix, e.g. {resource.k8s.namespace.name}
+const resourceAttributePatternPrefix = "resource."
+
+var patternRegex = regexp.MustCompile(`\{([^{}]*)\}`)
+
 func isPatternValid(s string) (bool, string) {
 	if !strings.Contains(s, "{") && !strings.Contains(s, "}") {
 		return true, ""
 	}
 
-	re := regexp.MustCompile(`\{([^{}]*)\}`)
-	matches := re.FindAllStringSubmatch(s, -1)
+	matches := patternRegex.FindAllStringSubmatch(s, -1)
 
 	for _, match := range matches {
 		if len(match) > 1 {
 			key := match[1]
+			if isResourceAttributePattern(key) {
+				continue
+			}
 			if _, exists := patternKeyToAttributeMap[key]; !exists {
 				return false, key
 			}
@@ -44,6 +52,10 @@ func isPatternValid(s string) (bool, string) {
 	return true, ""
 }
 
+func isResourceAttributePattern(key string) bool {
+	return strings.HasPrefix(key, resourceAttributePatternPrefix) && len(key) > len(resourceAttributePatternPrefix)
+}
+
 func replacePatterns(s string, attrMap map[string]string, logger *zap.Logger) (string, bool) {
 	success := true
 	var foundAndReplaced bool
@@ -51,9 +63,26 @@ func replacePatterns(s string, attrMap map[string]string, logger *zap.Logger) (s
 		s, foundAndReplaced = replacePatternWithAttrValue(s, key, attrMap, logger)
 		success = success && foundAndReplaced
 	}
+	for _, match := range patternRegex.FindAllStringSubmatch(s, -1) {
+		if !isResourceAttributePattern(match[1]) {
+			continue
+		}
+		s, foundAndReplaced = replaceResourceAttributePattern(s, match[1], attrMap, logger)
+		success = success && foundAndReplaced
+	}
 	return s, success
 }
 
+// replaceResourceAttributePattern replaces the pattern with the value of the resource attribute it names
+func replaceResourceAttributePattern(s, patternKey string, attrMap map[string]string, logger *zap.Logger) (string, bool) {
+	pattern := "{" + patternKey + "}"
+	if value, ok := attrMap[strings.TrimPrefix(patternKey, resourceAttributePatternPrefix)]; ok {
+		return replace(s, pattern, value, logger)
+	}
+	logger.Debug("No resource attribute found for pattern " + pattern)
+	return strings.ReplaceAll(s, pattern, "undefined"), false
+}
+
 func replacePatternWithAttrValue(s, patternKey string, attrMap map[string]string, logger *zap.Logger) (string, bool) {
 	pattern := "{" + patternKey + "}"
 	if strings.Contains(s, pattern) {
@@ -180,6 +180,37 @@ func TestReplacePatternValidTaskDefinitionFamily(t *testing.T) {
 	assert.True(t, success)
 }
 
+func TestReplacePatternResourceAttribute(t *testing.T) {
+	logger := zap.NewNop()
+
+	input := "/app/{ClusterName}/{resource.k8s.namespace.name}"
+
+	attrMap := map[string]any{
+		"aws.ecs.cluster.name": "test-cluster-name",
+		"k8s.namespace.name":   "payments",
+	}
+
+	s, success := replacePatterns(input, anyMapToStringMap(attrMap), logger)
+
+	assert.Equal(t, "/app/test-cluster-name/payments", s)
+	assert.True(t, success)
+}
+
+func TestReplacePatternMissingResourceAttribute(t *testing.T) {
+	logger := zap.NewNop()
+
+	input := "/app/{resource.k8s.namespace.name}"
+
+	attrMap := map[string]any{
+		"aws.ecs.cluster.name": "test-cluster-name",
+	}
+
+	s, success := replacePatterns(input, anyMapToStringMap(attrMap), logger)
+
+	assert.Equal(t, "/app/undefined", s)
+	assert.False(t, success)
+}
+
 func TestIsPatternValid(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -216,6 +247,16 @@ func TestIsPatternValid(t *testing.T) {
 			pattern:  "prefix-{}-suffix",
 			expected: false,
 		},
+		{
+			name:     "resource attribute pattern",
+			pattern:  "/app/{resource.k8s.namespace.name}/{ClusterName}",
+			expected: true,
+		},
+		{
+			name:     "resource attribute pattern without attribute",
+			pattern:  "/app/{resource.}",
+			expected: false,
+		},
 	}
 
 	for _, tc := range tests {
@@ -4,6 +4,7 @@
 package awsemfexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/awsemfexporter"
 
 import (
+	"fmt"
 	"strings"
 
 	"go.opentelemetry.io/collector/component"
@@ -68,6 +69,17 @@ type Config struct {
 	// TODO: we can support directing output to a file (in the future) while customer specifies a file path here.
 	OutputDestination string `mapstructure:"output_destination"`
 
+	// OutputFormat is an option to specify the format of the exported log events. Default option is "emf"
+	// "emf" - Embedded Metric Format
+	// "metric_stream" - the JSON format of CloudWatch Metric Streams, with a record for each metric and dimension set,
+	// to replay the metrics into the pipelines ingesting Metric Streams
+	OutputFormat string `mapstructure:"output_format"`
+
+	// StorageResolution is the storage resolution of the exported metrics in seconds, either 1 for high-resolution metrics
+	// or 60 for standard resolution metrics. The default is 60. It is overridden by the storage resolution of the matching
+	// MetricDescriptors, and by the "aws.emf.storage_resolution" attribute of the data points.
+	StorageResolution int `mapstructure:"storage_resolution"`
+
 	// EKSFargateContainerInsightsEnabled is an option to reformat certin metric labels so that they take the form of a high level object
 	// The end result will make the labels look like those coming out of ECS and be more easily injected into cloudwatch
 	// Note that at the moment in order to use this feature the value "kubernetes" must also be added to the ParseJSONEncodedAttributeValues array in order to be used
@@ -99,6 +111,8 @@ type MetricDescriptor struct {
 	// Overwrite set to true means the existing metric descriptor will be overwritten or a new metric descriptor will be created; false means
 	// the descriptor will only be configured if empty.
 	Overwrite bool `mapstructure:"overwrite"`
+	// StorageResolution defines the override value of the storage resolution of the metric in seconds, either 1 or 60
+	StorageResolution int `mapstructure:"storage_resolution"`
 }
 
 var _ component.Config = (*Config)(nil)
@@ -121,14 +135,31 @@ func (config *Config) Validate() error {
 		if descriptor.MetricName == "" {
 			continue
 		}
-		if _, ok := eMFSupportedUnits[descriptor.Unit]; ok {
+		switch {
+		case descriptor.StorageResolution != 0 && !isValidStorageResolution(descriptor.StorageResolution):
+			config.logger.Warn("Dropped metric descriptor with unsupported storage resolution.", zap.Int("storage_resolution", descriptor.StorageResolution))
+		case descriptor.Unit == "" && descriptor.StorageResolution != 0:
 			validDescriptors = append(validDescriptors, descriptor)
-		} else {
-			config.logger.Warn("Dropped unsupported metric descriptor.", zap.String("unit", descriptor.Unit))
+		default:
+			if _, ok := eMFSupportedUnits[descriptor.Unit]; ok {
+				validDescriptors = append(validDescriptors, descriptor)
+			} else {
+				config.logger.Warn("Dropped unsupported metric descriptor.", zap.String("unit", descriptor.Unit))
+			}
 		}
 	}
 	config.MetricDescriptors = validDescriptors
 
+	if config.StorageResolution != 0 && !isValidStorageResolution(config.StorageResolution) {
+		return fmt.Errorf("invalid storage_resolution %d, it must be either %d or %d", config.StorageResolution, highStorageResolution, standardStorageResolution)
+	}
+
+	switch config.OutputFormat {
+	case "", outputFormatEMF, outputFormatMetricStream:
+	default:
+		return fmt.Errorf("invalid output_format %q, it must be either %q or %q", config.OutputFormat, outputFormatEMF, outputFormatMetricStream)
+	}
+
 	if retErr := cwlogs.ValidateRetentionValue(config.LogRetention); retErr != nil {
 		return retErr
 	}
@@ -148,6 +179,10 @@ func (config *Config) isAppSignalsEnabled() bool {
 	return false
 }
 
+func isValidStorageResolution(resolution int) bool {
+	return resolution == highStorageResolution || resolution == standardStorageResolution
+}
+
 func newEMFSupportedUnits() map[string]any {
 	unitIndexer := map[string]any{}
 	for _, unit := range []string{
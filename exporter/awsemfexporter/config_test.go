@@ -105,6 +105,33 @@ func TestLoadConfig(t *testing.T) {
 				logger: zap.NewNop(),
 			},
 		},
+		{
+			id: component.NewIDWithName(metadata.Type, "metric_stream"),
+			expected: &Config{
+				AWSSessionSettings: awsutil.AWSSessionSettings{
+					NumberOfWorkers:       8,
+					Endpoint:              "",
+					RequestTimeoutSeconds: 30,
+					MaxRetries:            2,
+					NoVerifySSL:           false,
+					ProxyAddress:          "",
+					Region:                "",
+					RoleARN:               "",
+				},
+				LogGroupName:          "",
+				LogStreamName:         "",
+				DimensionRollupOption: "ZeroAndSingleDimensionRollup",
+				OutputDestination:     "cloudwatch",
+				OutputFormat:          "metric_stream",
+				StorageResolution:     1,
+				Version:               "1",
+				MetricDescriptors: []MetricDescriptor{{
+					MetricName:        "memcached_current_items",
+					StorageResolution: 60,
+				}},
+				logger: zap.NewNop(),
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -148,6 +175,47 @@ func TestConfigValidate(t *testing.T) {
 	}, cfg.MetricDescriptors)
 }
 
+func TestConfigValidateStorageResolution(t *testing.T) {
+	cfg := &Config{
+		AWSSessionSettings: awsutil.AWSSessionSettings{
+			RequestTimeoutSeconds: 30,
+			MaxRetries:            1,
+		},
+		DimensionRollupOption: "ZeroAndSingleDimensionRollup",
+		StorageResolution:     1,
+		MetricDescriptors: []MetricDescriptor{
+			{MetricName: "latency", StorageResolution: 1},
+			{MetricName: "requests", Unit: "Count", StorageResolution: 60},
+			{MetricName: "errors", StorageResolution: 5},
+		},
+		logger: zap.NewNop(),
+	}
+	assert.NoError(t, xconfmap.Validate(cfg))
+	assert.Equal(t, []MetricDescriptor{
+		{MetricName: "latency", StorageResolution: 1},
+		{MetricName: "requests", Unit: "Count", StorageResolution: 60},
+	}, cfg.MetricDescriptors)
+
+	cfg.StorageResolution = 30
+	assert.ErrorContains(t, xconfmap.Validate(cfg), "invalid storage_resolution 30")
+}
+
+func TestConfigValidateOutputFormat(t *testing.T) {
+	cfg := &Config{
+		AWSSessionSettings: awsutil.AWSSessionSettings{
+			RequestTimeoutSeconds: 30,
+			MaxRetries:            1,
+		},
+		DimensionRollupOption: "ZeroAndSingleDimensionRollup",
+		OutputFormat:          "metric_stream",
+		logger:                zap.NewNop(),
+	}
+	assert.NoError(t, xconfmap.Validate(cfg))
+
+	cfg.OutputFormat = "opentelemetry"
+	assert.ErrorContains(t, xconfmap.Validate(cfg), `invalid output_format "opentelemetry"`)
+}
+
 func TestRetentionValidateCorrect(t *testing.T) {
 	cfg := &Config{
 		AWSSessionSettings: awsutil.AWSSessionSettings{
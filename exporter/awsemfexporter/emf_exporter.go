@@ -27,6 +27,10 @@ const (
 	outputDestinationCloudWatch = "cloudwatch"
 	outputDestinationStdout     = "stdout"
 
+	// OutputFormat Options
+	outputFormatEMF          = "emf"
+	outputFormatMetricStream = "metric_stream"
+
 	// AppSignals EMF config
 	appSignalsMetricNamespace    = "ApplicationSignals"
 	appSignalsLogGroupNamePrefix = "/aws/application-signals/"
@@ -119,22 +123,24 @@ func (emf *emfExporter) pushMetricsData(ctx context.Context, md pmetric.Metrics)
 	}
 
 	for _, groupedMetric := range groupedMetrics {
-		putLogEvent, err := translateGroupedMetricToEmf(groupedMetric, emf.config, defaultLogStream)
+		putLogEvents, err := emf.translateGroupedMetric(groupedMetric, defaultLogStream)
 		if err != nil {
 			return err
 		}
-		// Currently we only support two options for "OutputDestination".
-		if strings.EqualFold(outputDestination, outputDestinationStdout) {
-			if putLogEvent != nil &&
-				putLogEvent.InputLogEvent.Message != nil {
-				fmt.Println(*putLogEvent.InputLogEvent.Message)
-			}
-		} else if strings.EqualFold(outputDestination, outputDestinationCloudWatch) {
-			emfPusher := emf.getPusher(putLogEvent.StreamKey)
-			if emfPusher != nil {
-				returnError := emfPusher.AddLogEntry(ctx, putLogEvent)
-				if returnError != nil {
-					return wrapErrorIfBadRequest(returnError)
+		for _, putLogEvent := range putLogEvents {
+			// Currently we only support two options for "OutputDestination".
+			if strings.EqualFold(outputDestination, outputDestinationStdout) {
+				if putLogEvent != nil &&
+					putLogEvent.InputLogEvent.Message != nil {
+					fmt.Println(*putLogEvent.InputLogEvent.Message)
+				}
+			} else if strings.EqualFold(outputDestination, outputDestinationCloudWatch) {
+				emfPusher := emf.getPusher(putLogEvent.StreamKey)
+				if emfPusher != nil {
+					returnError := emfPusher.AddLogEntry(ctx, putLogEvent)
+					if returnError != nil {
+						return wrapErrorIfBadRequest(returnError)
+					}
 				}
 			}
 		}
@@ -159,6 +165,18 @@ func (emf *emfExporter) pushMetricsData(ctx context.Context, md pmetric.Metrics)
 	return nil
 }
 
+// translateGroupedMetric converts the grouped metric to the log events of the configured output format
+func (emf *emfExporter) translateGroupedMetric(groupedMetric *groupedMetric, defaultLogStream string) ([]*cwlogs.Event, error) {
+	if strings.EqualFold(emf.config.OutputFormat, outputFormatMetricStream) {
+		return translateGroupedMetricToMetricStream(groupedMetric, emf.config, defaultLogStream)
+	}
+	putLogEvent, err := translateGroupedMetricToEmf(groupedMetric, emf.config, defaultLogStream)
+	if err != nil {
+		return nil, err
+	}
+	return []*cwlogs.Event{putLogEvent}, nil
+}
+
 func (emf *emfExporter) getPusher(key cwlogs.StreamKey) cwlogs.Pusher {
 	var ok bool
 	if _, ok = emf.pusherMap[key]; !ok {
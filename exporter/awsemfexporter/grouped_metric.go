@@ -22,8 +22,9 @@ type groupedMetric struct {
 
 // metricInfo defines value and unit for OT Metrics
 type metricInfo struct {
-	value any
-	unit  string
+	value             any
+	unit              string
+	storageResolution int
 }
 
 // addToGroupedMetric processes OT metrics and adds them into GroupedMetric buckets
@@ -77,8 +78,9 @@ func addToGroupedMetric(
 			}
 
 			metric := &metricInfo{
-				value: dp.value,
-				unit:  translateUnit(pmd, descriptor),
+				value:             dp.value,
+				unit:              translateUnit(pmd, descriptor),
+				storageResolution: translateStorageResolution(pmd, descriptor, config),
 			}
 
 			if dp.timestampMs > 0 {
@@ -187,7 +189,7 @@ func mapGetHelper(labels map[string]string, key string) string {
 
 func translateUnit(metric pmetric.Metric, descriptor map[string]MetricDescriptor) string {
 	unit := metric.Unit()
-	if descriptor, exists := descriptor[metric.Name()]; exists {
+	if descriptor, exists := descriptor[metric.Name()]; exists && descriptor.Unit != "" {
 		if unit == "" || descriptor.Overwrite {
 			return descriptor.Unit
 		}
@@ -211,3 +213,12 @@ func translateUnit(metric pmetric.Metric, descriptor map[string]MetricDescriptor
 	}
 	return unit
 }
+
+// translateStorageResolution returns the storage resolution of the metric from its metric descriptor, or the
+// storage resolution of the exporter. Zero means the standard resolution.
+func translateStorageResolution(metric pmetric.Metric, descriptor map[string]MetricDescriptor, config *Config) int {
+	if descriptor, exists := descriptor[metric.Name()]; exists && descriptor.StorageResolution != 0 {
+		return descriptor.StorageResolution
+	}
+	return config.StorageResolution
+}
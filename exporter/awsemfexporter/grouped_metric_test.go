@@ -552,6 +552,35 @@ func TestTranslateUnit(t *testing.T) {
 	assert.Equal(t, "Count", v)
 }
 
+func TestTranslateStorageResolution(t *testing.T) {
+	descriptor := map[string]MetricDescriptor{
+		"highResolution": {
+			MetricName:        "highResolution",
+			StorageResolution: 1,
+		},
+		"unitOnly": {
+			MetricName: "unitOnly",
+			Unit:       "Count",
+		},
+	}
+
+	metric := pmetric.NewMetric()
+	for name, expected := range map[string]int{"highResolution": 1, "unitOnly": 0, "noDescriptor": 0} {
+		metric.SetName(name)
+		assert.Equal(t, expected, translateStorageResolution(metric, descriptor, &Config{}), name)
+	}
+
+	metric.SetName("unitOnly")
+	assert.Equal(t, 1, translateStorageResolution(metric, descriptor, &Config{StorageResolution: 1}))
+
+	metric.SetName("highResolution")
+	assert.Equal(t, 1, translateStorageResolution(metric, descriptor, &Config{StorageResolution: 60}))
+
+	// the unit of the metric is kept when the descriptor only sets the storage resolution
+	metric.SetUnit("ms")
+	assert.Equal(t, "Milliseconds", translateUnit(metric, descriptor))
+}
+
 func generateTestMetricMetadata(namespace string, timestamp int64, logGroup, logStreamName, instrumentationScopeName string, metricType pmetric.MetricType, batchIndex int) cWMetricMetadata {
 	return cWMetricMetadata{
 		receiver: prometheusReceiver,
@@ -0,0 +1,108 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package awsemfexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/awsemfexporter"
+
+import (
+	"encoding/json"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/aws/cwlogs"
+)
+
+// metricStreamNoneUnit is the unit of the Metric Streams records of the metrics without unit
+const metricStreamNoneUnit = "None"
+
+// metricStreamRecord is a record in the JSON output format of CloudWatch Metric Streams
+// https://docs.aws.amazon.com/AmazonCloudWatch/latest/monitoring/CloudWatch-metric-streams-formats-json.html
+type metricStreamRecord struct {
+	Region     string            `json:"region,omitempty"`
+	Namespace  string            `json:"namespace"`
+	MetricName string            `json:"metric_name"`
+	Dimensions map[string]string `json:"dimensions"`
+	Timestamp  int64             `json:"timestamp"`
+	Value      metricStreamValue `json:"value"`
+	Unit       string            `json:"unit"`
+}
+
+// metricStreamValue holds the statistics of a Metric Streams record
+type metricStreamValue struct {
+	Max   float64 `json:"max"`
+	Min   float64 `json:"min"`
+	Sum   float64 `json:"sum"`
+	Count float64 `json:"count"`
+}
+
+// translateGroupedMetricToMetricStream converts the grouped metric to Metric Streams records, one for each
+// metric and dimension set of the CloudWatch measurements that would be extracted from the EMF log event.
+func translateGroupedMetricToMetricStream(groupedMetric *groupedMetric, config *Config, defaultLogStream string) ([]*cwlogs.Event, error) {
+	cWMetric := translateGroupedMetricToCWMetric(groupedMetric, config)
+	labels := filterAWSEMFAttributes(groupedMetric.labels)
+
+	logStream := groupedMetric.metadata.logStream
+	if logStream == "" {
+		logStream = defaultLogStream
+	}
+
+	var events []*cwlogs.Event
+	for _, measurement := range cWMetric.measurements {
+		for _, metric := range measurement.Metrics {
+			info, ok := groupedMetric.metrics[metric.Name]
+			if !ok {
+				continue
+			}
+			value, ok := toMetricStreamValue(info.value)
+			if !ok {
+				config.logger.Debug("Dropped metric: unsupported value for metric streams", zap.String("Metric name", metric.Name))
+				continue
+			}
+			unit := metric.Unit
+			if unit == "" {
+				unit = metricStreamNoneUnit
+			}
+
+			for _, dimSet := range measurement.Dimensions {
+				dimensions := make(map[string]string, len(dimSet))
+				for _, dim := range dimSet {
+					dimensions[dim] = labels[dim]
+				}
+				record := metricStreamRecord{
+					Region:     config.Region,
+					Namespace:  measurement.Namespace,
+					MetricName: metric.Name,
+					Dimensions: dimensions,
+					Timestamp:  cWMetric.timestampMs,
+					Value:      value,
+					Unit:       unit,
+				}
+				msg, err := json.Marshal(record)
+				if err != nil {
+					return nil, err
+				}
+
+				event := cwlogs.NewEvent(cWMetric.timestampMs, string(msg))
+				event.GeneratedTime = time.Unix(0, cWMetric.timestampMs*int64(time.Millisecond))
+				event.LogGroupName = groupedMetric.metadata.logGroup
+				event.LogStreamName = logStream
+				events = append(events, event)
+			}
+		}
+	}
+	return events, nil
+}
+
+// toMetricStreamValue returns the statistics of the value of a grouped metric
+func toMetricStreamValue(value any) (metricStreamValue, bool) {
+	switch v := value.(type) {
+	case float64:
+		return metricStreamValue{Max: v, Min: v, Sum: v, Count: 1}, true
+	case *cWMetricStats:
+		return metricStreamValue{Max: v.Max, Min: v.Min, Sum: v.Sum, Count: float64(v.Count)}, true
+	case *cWMetricHistogram:
+		return metricStreamValue{Max: v.Max, Min: v.Min, Sum: v.Sum, Count: float64(v.Count)}, true
+	default:
+		return metricStreamValue{}, false
+	}
+}
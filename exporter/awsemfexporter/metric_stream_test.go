@@ -0,0 +1,93 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package awsemfexporter
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/aws/awsutil"
+)
+
+func TestTranslateGroupedMetricToMetricStream(t *testing.T) {
+	timestamp := int64(1596151098037)
+	groupedMetric := &groupedMetric{
+		labels: map[string]string{
+			"label1":                      "value1",
+			emfStorageResolutionAttribute: "1",
+		},
+		metrics: map[string]*metricInfo{
+			"requests": {
+				value: 5.0,
+				unit:  "Count",
+			},
+			"latency": {
+				value: &cWMetricStats{Max: 10, Min: 2, Count: 3, Sum: 18},
+			},
+		},
+		metadata: cWMetricMetadata{
+			groupedMetricMetadata: groupedMetricMetadata{
+				namespace:   "Namespace",
+				timestampMs: timestamp,
+				logGroup:    "log-group",
+			},
+		},
+	}
+	config := &Config{
+		AWSSessionSettings:    awsutil.AWSSessionSettings{Region: "us-west-2"},
+		DimensionRollupOption: zeroAndSingleDimensionRollup,
+		logger:                zap.NewNop(),
+	}
+
+	events, err := translateGroupedMetricToMetricStream(groupedMetric, config, "default-stream")
+	require.NoError(t, err)
+	// a record for each of the 2 metrics and each of the [label1] and [] dimension sets
+	require.Len(t, events, 4)
+
+	records := map[string]metricStreamRecord{}
+	for _, event := range events {
+		assert.Equal(t, "log-group", event.LogGroupName)
+		assert.Equal(t, "default-stream", event.LogStreamName)
+
+		var record metricStreamRecord
+		require.NoError(t, json.Unmarshal([]byte(*event.InputLogEvent.Message), &record))
+		dims, err := json.Marshal(record.Dimensions)
+		require.NoError(t, err)
+		records[record.MetricName+string(dims)] = record
+	}
+
+	assert.Equal(t, metricStreamRecord{
+		Region:     "us-west-2",
+		Namespace:  "Namespace",
+		MetricName: "requests",
+		Dimensions: map[string]string{"label1": "value1"},
+		Timestamp:  timestamp,
+		Value:      metricStreamValue{Max: 5, Min: 5, Sum: 5, Count: 1},
+		Unit:       "Count",
+	}, records[`requests{"label1":"value1"}`])
+	assert.Equal(t, metricStreamRecord{
+		Region:     "us-west-2",
+		Namespace:  "Namespace",
+		MetricName: "latency",
+		Dimensions: map[string]string{},
+		Timestamp:  timestamp,
+		Value:      metricStreamValue{Max: 10, Min: 2, Sum: 18, Count: 3},
+		Unit:       "None",
+	}, records[`latency{}`])
+	assert.Contains(t, records, `latency{"label1":"value1"}`)
+	assert.Contains(t, records, `requests{}`)
+}
+
+func TestToMetricStreamValue(t *testing.T) {
+	value, ok := toMetricStreamValue(&cWMetricHistogram{Values: []float64{1, 2}, Counts: []float64{3, 4}, Max: 2, Min: 1, Count: 7, Sum: 11})
+	assert.True(t, ok)
+	assert.Equal(t, metricStreamValue{Max: 2, Min: 1, Sum: 11, Count: 7}, value)
+
+	_, ok = toMetricStreamValue("unsupported")
+	assert.False(t, ok)
+}
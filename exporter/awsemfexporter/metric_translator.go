@@ -33,6 +33,10 @@ const (
 
 	// metric attributes for AWS EMF, not to be treated as metric labels
 	emfStorageResolutionAttribute = "aws.emf.storage_resolution"
+
+	// storage resolutions of the CloudWatch metrics in seconds
+	highStorageResolution     = 1
+	standardStorageResolution = 60
 )
 
 var fieldPrometheusTypes = map[pmetric.MetricType]string{
@@ -246,16 +250,11 @@ func groupedMetricToCWMeasurement(groupedMetric *groupedMetric, config *Config)
 	for metricName, metricInfo := range groupedMetric.metrics {
 		metrics[idx] = cWMetricInfo{
 			Name:              metricName,
-			StorageResolution: 60,
+			StorageResolution: storageResolution(metricInfo, groupedMetric.labels),
 		}
 		if metricInfo.unit != "" {
 			metrics[idx].Unit = metricInfo.unit
 		}
-		if storRes, ok := groupedMetric.labels[emfStorageResolutionAttribute]; ok {
-			if storResInt, err := strconv.Atoi(storRes); err == nil {
-				metrics[idx].StorageResolution = storResInt
-			}
-		}
 		idx++
 	}
 
@@ -320,16 +319,11 @@ func groupedMetricToCWMeasurementsWithFilters(groupedMetric *groupedMetric, conf
 
 		metric := cWMetricInfo{
 			Name:              metricName,
-			StorageResolution: 60,
+			StorageResolution: storageResolution(metricInfo, groupedMetric.labels),
 		}
 		if metricInfo.unit != "" {
 			metric.Unit = metricInfo.unit
 		}
-		if storRes, ok := groupedMetric.labels[emfStorageResolutionAttribute]; ok {
-			if storResInt, err := strconv.Atoi(storRes); err == nil {
-				metric.StorageResolution = storResInt
-			}
-		}
 		metricDeclKey := fmt.Sprint(metricDeclIdx)
 		if group, ok := metricDeclGroups[metricDeclKey]; ok {
 			group.metrics = append(group.metrics, metric)
@@ -491,6 +485,21 @@ func translateGroupedMetricToEmf(groupedMetric *groupedMetric, config *Config, d
 	return event, nil
 }
 
+// storageResolution returns the storage resolution of the metric, which the "aws.emf.storage_resolution"
+// attribute of its data points takes precedence over
+func storageResolution(metricInfo *metricInfo, labels map[string]string) int {
+	resolution := metricInfo.storageResolution
+	if resolution == 0 {
+		resolution = standardStorageResolution
+	}
+	if storRes, ok := labels[emfStorageResolutionAttribute]; ok {
+		if storResInt, err := strconv.Atoi(storRes); err == nil {
+			resolution = storResInt
+		}
+	}
+	return resolution
+}
+
 func filterAWSEMFAttributes(labels map[string]string) map[string]string {
 	// remove any labels that are attributes specific to AWS EMF Exporter
 	filteredLabels := make(map[string]string)
@@ -787,6 +787,13 @@ func TestTranslateGroupedMetricToCWMetric(t *testing.T) {
 	}
 }
 
+func TestStorageResolution(t *testing.T) {
+	assert.Equal(t, 60, storageResolution(&metricInfo{}, map[string]string{}))
+	assert.Equal(t, 1, storageResolution(&metricInfo{storageResolution: 1}, map[string]string{}))
+	assert.Equal(t, 60, storageResolution(&metricInfo{storageResolution: 1}, map[string]string{emfStorageResolutionAttribute: "60"}))
+	assert.Equal(t, 1, storageResolution(&metricInfo{storageResolution: 1}, map[string]string{emfStorageResolutionAttribute: "invalid"}))
+}
+
 func TestGroupedMetricToCWMeasurement(t *testing.T) {
 	timestamp := int64(1596151098037)
 	namespace := "Namespace"
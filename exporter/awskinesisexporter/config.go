@@ -34,6 +34,13 @@ type Config struct {
 	AWS                AWSConfig `mapstructure:"aws"`
 	MaxRecordsPerBatch int       `mapstructure:"max_records_per_batch"`
 	MaxRecordSize      int       `mapstructure:"max_record_size"`
+
+	// Aggregation packs the records into KPL aggregated records
+	Aggregation bool `mapstructure:"aggregation"`
+	// PartitionKeyAttributes are the resource attributes the partition key of the records is made of
+	PartitionKeyAttributes []string `mapstructure:"partition_key_attributes"`
+	// ShardRateLimit paces the writes to the write limits of the open shards of the stream
+	ShardRateLimit bool `mapstructure:"shard_rate_limit"`
 }
 
 var _ component.Config = (*Config)(nil)
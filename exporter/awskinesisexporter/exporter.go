@@ -19,6 +19,7 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/awskinesisexporter/internal/batch"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/awskinesisexporter/internal/key"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/awskinesisexporter/internal/producer"
 )
 
@@ -84,17 +85,23 @@ func createExporter(ctx context.Context, c component.Config, log *zap.Logger, op
 		options.NewKinesisClient(awsconf, kinesisOpts...),
 		conf.AWS.StreamName,
 		producer.WithLogger(log),
+		producer.WithShardRateLimit(conf.ShardRateLimit),
 	)
 	if err != nil {
 		return nil, err
 	}
 
-	encoder, err := batch.NewEncoder(
-		conf.Name,
+	batchOpts := []batch.Option{
 		batch.WithMaxRecordSize(conf.MaxRecordSize),
 		batch.WithMaxRecordsPerBatch(conf.MaxRecordsPerBatch),
 		batch.WithCompressionType(conf.Compression),
-	)
+		batch.WithAggregation(conf.Aggregation),
+	}
+	if len(conf.PartitionKeyAttributes) > 0 {
+		batchOpts = append(batchOpts, batch.WithPartitioner(key.FromResourceAttributes(conf.PartitionKeyAttributes)))
+	}
+
+	encoder, err := batch.NewEncoder(conf.Name, batchOpts...)
 	if err != nil {
 		return nil, err
 	}
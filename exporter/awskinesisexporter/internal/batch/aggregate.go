@@ -0,0 +1,125 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package batch // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/awskinesisexporter/internal/batch"
+
+import (
+	"crypto/md5" //#nosec G501 -- the KPL aggregation format requires a MD5 checksum
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/kinesis/types"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// aggregationMagic is the prefix of the records in the KPL aggregation format
+// https://github.com/awslabs/amazon-kinesis-producer/blob/master/aggregation-format.md
+var aggregationMagic = []byte{0xF3, 0x89, 0x9A, 0xC2}
+
+const (
+	// Field numbers of the AggregatedRecord message
+	partitionKeyTableField = 1
+	recordsField           = 3
+
+	// Field numbers of the Record message
+	partitionKeyIndexField = 1
+	dataField              = 3
+)
+
+// aggregator packs the records of a partition key into a KPL aggregated record
+type aggregator struct {
+	key  string
+	data [][]byte
+	// size is the size of the aggregated record with the current records
+	size int
+}
+
+func newAggregator(key string) *aggregator {
+	a := &aggregator{key: key}
+	a.reset()
+	return a
+}
+
+func (a *aggregator) reset() {
+	a.data = nil
+	a.size = len(aggregationMagic) + md5.Size +
+		protowire.SizeTag(partitionKeyTableField) + protowire.SizeBytes(len(a.key))
+}
+
+// recordSize returns the size taken by the given data in the aggregated record
+func recordSize(data []byte) int {
+	size := protowire.SizeTag(partitionKeyIndexField) + protowire.SizeVarint(0) +
+		protowire.SizeTag(dataField) + protowire.SizeBytes(len(data))
+	return protowire.SizeTag(recordsField) + protowire.SizeBytes(size)
+}
+
+func (a *aggregator) fits(data []byte, maxSize int) bool {
+	return a.size+recordSize(data) <= maxSize
+}
+
+func (a *aggregator) add(data []byte) {
+	a.data = append(a.data, data)
+	a.size += recordSize(data)
+}
+
+// record returns the aggregated record, or the record itself when there is a single one
+func (a *aggregator) record() types.PutRecordsRequestEntry {
+	if len(a.data) == 1 {
+		return types.PutRecordsRequestEntry{
+			Data:         a.data[0],
+			PartitionKey: aws.String(a.key),
+		}
+	}
+
+	msg := protowire.AppendTag(nil, partitionKeyTableField, protowire.BytesType)
+	msg = protowire.AppendString(msg, a.key)
+	for _, data := range a.data {
+		var record []byte
+		record = protowire.AppendTag(record, partitionKeyIndexField, protowire.VarintType)
+		record = protowire.AppendVarint(record, 0)
+		record = protowire.AppendTag(record, dataField, protowire.BytesType)
+		record = protowire.AppendBytes(record, data)
+
+		msg = protowire.AppendTag(msg, recordsField, protowire.BytesType)
+		msg = protowire.AppendBytes(msg, record)
+	}
+
+	checksum := md5.Sum(msg) //#nosec G401
+	out := make([]byte, 0, len(aggregationMagic)+len(msg)+len(checksum))
+	out = append(out, aggregationMagic...)
+	out = append(out, msg...)
+	out = append(out, checksum[:]...)
+	return types.PutRecordsRequestEntry{
+		Data:         out,
+		PartitionKey: aws.String(a.key),
+	}
+}
+
+// aggregate packs the records of the same partition key into KPL aggregated records no greater than maxSize,
+// so that the aggregated records are written to the same shard as their records.
+func aggregate(records []types.PutRecordsRequestEntry, maxSize int) []types.PutRecordsRequestEntry {
+	var (
+		aggregated []types.PutRecordsRequestEntry
+		keys       []string
+		pending    = make(map[string]*aggregator)
+	)
+	for _, record := range records {
+		key := aws.ToString(record.PartitionKey)
+		agg, ok := pending[key]
+		if !ok {
+			agg = newAggregator(key)
+			pending[key] = agg
+			keys = append(keys, key)
+		}
+		if len(agg.data) > 0 && !agg.fits(record.Data, maxSize) {
+			aggregated = append(aggregated, agg.record())
+			agg.reset()
+		}
+		agg.add(record.Data)
+	}
+	for _, key := range keys {
+		if agg := pending[key]; len(agg.data) > 0 {
+			aggregated = append(aggregated, agg.record())
+		}
+	}
+	return aggregated
+}
@@ -0,0 +1,104 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package batch
+
+import (
+	"bytes"
+	"crypto/md5" //#nosec G501
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// decodeAggregated returns the partition keys and the data of the records of a KPL aggregated record
+func decodeAggregated(t *testing.T, data []byte) (keys []string, records [][]byte) {
+	require.True(t, bytes.HasPrefix(data, aggregationMagic), "Must start with the aggregation magic")
+	msg := data[len(aggregationMagic) : len(data)-md5.Size]
+	checksum := md5.Sum(msg) //#nosec G401
+	require.Equal(t, checksum[:], data[len(data)-md5.Size:], "Must end with the checksum of the message")
+
+	for len(msg) > 0 {
+		num, typ, n := protowire.ConsumeTag(msg)
+		require.GreaterOrEqual(t, n, 0)
+		require.Equal(t, protowire.BytesType, typ)
+		msg = msg[n:]
+		field, n := protowire.ConsumeBytes(msg)
+		require.GreaterOrEqual(t, n, 0)
+		msg = msg[n:]
+
+		switch num {
+		case partitionKeyTableField:
+			keys = append(keys, string(field))
+		case recordsField:
+			for len(field) > 0 {
+				recordNum, recordTyp, m := protowire.ConsumeTag(field)
+				require.GreaterOrEqual(t, m, 0)
+				field = field[m:]
+				if recordTyp == protowire.VarintType {
+					_, m = protowire.ConsumeVarint(field)
+					require.GreaterOrEqual(t, m, 0)
+					field = field[m:]
+					continue
+				}
+				value, m := protowire.ConsumeBytes(field)
+				require.GreaterOrEqual(t, m, 0)
+				field = field[m:]
+				if recordNum == dataField {
+					records = append(records, value)
+				}
+			}
+		}
+	}
+	return keys, records
+}
+
+func TestAggregate(t *testing.T) {
+	t.Parallel()
+
+	bt := New(WithAggregation(true))
+	for i := 0; i < 10; i++ {
+		require.NoError(t, bt.AddRecord([]byte("foobar"), "key-a"))
+	}
+	require.NoError(t, bt.AddRecord([]byte("single"), "key-b"))
+
+	chunks := bt.Chunk()
+	require.Len(t, chunks, 1)
+	require.Len(t, chunks[0], 2, "Must have one record per partition key")
+
+	aggregated := chunks[0][0]
+	assert.Equal(t, "key-a", aws.ToString(aggregated.PartitionKey))
+	keys, records := decodeAggregated(t, aggregated.Data)
+	assert.Equal(t, []string{"key-a"}, keys)
+	require.Len(t, records, 10)
+	for _, record := range records {
+		assert.Equal(t, []byte("foobar"), record)
+	}
+
+	single := chunks[0][1]
+	assert.Equal(t, "key-b", aws.ToString(single.PartitionKey))
+	assert.Equal(t, []byte("single"), single.Data, "Must not aggregate a single record")
+}
+
+func TestAggregateMaxSize(t *testing.T) {
+	t.Parallel()
+
+	const maxSize = 100
+	bt := New(WithAggregation(true), WithMaxRecordSize(maxSize))
+	for i := 0; i < 20; i++ {
+		require.NoError(t, bt.AddRecord([]byte("foobar"), "key"))
+	}
+
+	total := 0
+	for _, chunk := range bt.Chunk() {
+		for _, record := range chunk {
+			assert.LessOrEqual(t, len(record.Data), maxSize, "Must not exceed the max record size")
+			_, records := decodeAggregated(t, record.Data)
+			total += len(records)
+		}
+	}
+	assert.Equal(t, 20, total, "Must have kept all the records")
+}
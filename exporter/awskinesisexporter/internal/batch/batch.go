@@ -11,6 +11,7 @@ import (
 	"go.opentelemetry.io/collector/consumer/consumererror"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/awskinesisexporter/internal/compress"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/awskinesisexporter/internal/key"
 )
 
 const (
@@ -31,6 +32,11 @@ type Batch struct {
 
 	compressionType string
 
+	// aggregate is whether the records are packed into KPL aggregated records
+	aggregate bool
+	// partitioner is used by the encoders in place of their own partitioner when set
+	partitioner key.Partition
+
 	records []types.PutRecordsRequestEntry
 }
 
@@ -60,6 +66,20 @@ func WithCompressionType(compressionType string) Option {
 	}
 }
 
+// WithAggregation packs the records of the same partition key into KPL aggregated records
+func WithAggregation(enabled bool) Option {
+	return func(bt *Batch) {
+		bt.aggregate = enabled
+	}
+}
+
+// WithPartitioner sets the partitioner of the records
+func WithPartitioner(partitioner key.Partition) Option {
+	return func(bt *Batch) {
+		bt.partitioner = partitioner
+	}
+}
+
 func New(opts ...Option) *Batch {
 	bt := &Batch{
 		maxBatchSize:    MaxBatchedRecords,
@@ -109,6 +129,9 @@ func (b *Batch) Chunk() (chunks [][]types.PutRecordsRequestEntry) {
 		slice = b.records
 		size  = b.maxBatchSize
 	)
+	if b.aggregate {
+		slice = aggregate(slice, b.maxRecordSize)
+	}
 	for len(slice) != 0 {
 		if len(slice) < size {
 			size = len(slice)
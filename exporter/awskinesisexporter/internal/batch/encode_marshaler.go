@@ -28,6 +28,7 @@ var _ Encoder = (*batchMarshaller)(nil)
 
 func (bm *batchMarshaller) Logs(ld plog.Logs) (*Batch, error) {
 	bt := New(bm.batchOptions...)
+	partitioner := bm.partitionerFor(bt)
 
 	// Due to kinesis limitations of only allowing 1Mb of data per record,
 	// the resource data is copied to the export variable then marshaled
@@ -50,7 +51,7 @@ func (bm *batchMarshaller) Logs(ld plog.Logs) (*Batch, error) {
 			continue
 		}
 
-		if err := bt.AddRecord(data, bm.partitioner(export)); err != nil {
+		if err := bt.AddRecord(data, partitioner(export)); err != nil {
 			errs = multierr.Append(errs, consumererror.NewLogs(err, export))
 		}
 	}
@@ -60,6 +61,7 @@ func (bm *batchMarshaller) Logs(ld plog.Logs) (*Batch, error) {
 
 func (bm *batchMarshaller) Traces(td ptrace.Traces) (*Batch, error) {
 	bt := New(bm.batchOptions...)
+	partitioner := bm.partitionerFor(bt)
 
 	// Due to kinesis limitations of only allowing 1Mb of data per record,
 	// the resource data is copied to the export variable then marshaled
@@ -82,7 +84,7 @@ func (bm *batchMarshaller) Traces(td ptrace.Traces) (*Batch, error) {
 			continue
 		}
 
-		if err := bt.AddRecord(data, bm.partitioner(span)); err != nil {
+		if err := bt.AddRecord(data, partitioner(span)); err != nil {
 			errs = multierr.Append(errs, consumererror.NewTraces(err, export))
 		}
 	}
@@ -92,6 +94,7 @@ func (bm *batchMarshaller) Traces(td ptrace.Traces) (*Batch, error) {
 
 func (bm *batchMarshaller) Metrics(md pmetric.Metrics) (*Batch, error) {
 	bt := New(bm.batchOptions...)
+	partitioner := bm.partitionerFor(bt)
 
 	// Due to kinesis limitations of only allowing 1Mb of data per record,
 	// the resource data is copied to the export variable then marshaled
@@ -114,10 +117,25 @@ func (bm *batchMarshaller) Metrics(md pmetric.Metrics) (*Batch, error) {
 			continue
 		}
 
-		if err := bt.AddRecord(data, bm.partitioner(export)); err != nil {
+		if err := bt.AddRecord(data, partitioner(export)); err != nil {
 			errs = multierr.Append(errs, consumererror.NewMetrics(err, export))
 		}
 	}
 
 	return bt, errs
 }
+
+// partitionerFor returns the partitioner of the records of the batch. With the aggregation and randomized keys,
+// the records of the batch share a single key so that they can be aggregated together.
+func (bm *batchMarshaller) partitionerFor(bt *Batch) key.Partition {
+	if bt.partitioner != nil {
+		return bt.partitioner
+	}
+	if bt.aggregate {
+		k := bm.partitioner(nil)
+		return func(any) string {
+			return k
+		}
+	}
+	return bm.partitioner
+}
@@ -4,9 +4,20 @@
 package key // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/awskinesisexporter/internal/key"
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+
 	"github.com/google/uuid"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
 )
 
+// maxLength is the maximum length of a kinesis partition key
+const maxLength = 256
+
 // Partition allows for switching our partitioning behavior
 // when sending data to kinesis.
 type Partition func(v any) string
@@ -14,3 +25,60 @@ type Partition func(v any) string
 func Randomized(_ any) string {
 	return uuid.NewString()
 }
+
+// FromResourceAttributes returns a Partition that joins the values of the given resource attributes,
+// so that the records of the same resource are written to the same shard.
+// The key is randomized when none of the attributes are set, and hashed when it exceeds the kinesis limit.
+func FromResourceAttributes(names []string) Partition {
+	return func(v any) string {
+		resource, ok := resourceOf(v)
+		if !ok {
+			return Randomized(v)
+		}
+
+		values := make([]string, 0, len(names))
+		found := false
+		for _, name := range names {
+			value, ok := resource.Attributes().Get(name)
+			if ok {
+				found = true
+				values = append(values, value.AsString())
+			} else {
+				values = append(values, "")
+			}
+		}
+		if !found {
+			return Randomized(v)
+		}
+
+		k := strings.Join(values, "/")
+		if len(k) > maxLength {
+			sum := sha256.Sum256([]byte(k))
+			return hex.EncodeToString(sum[:])
+		}
+		return k
+	}
+}
+
+// resourceOf returns the resource of the first resource data of the exported value
+func resourceOf(v any) (pcommon.Resource, bool) {
+	switch data := v.(type) {
+	case pcommon.Resource:
+		return data, true
+	case plog.Logs:
+		if data.ResourceLogs().Len() > 0 {
+			return data.ResourceLogs().At(0).Resource(), true
+		}
+	case pmetric.Metrics:
+		if data.ResourceMetrics().Len() > 0 {
+			return data.ResourceMetrics().At(0).Resource(), true
+		}
+	case ptrace.Traces:
+		if data.ResourceSpans().Len() > 0 {
+			return data.ResourceSpans().At(0).Resource(), true
+		}
+	case ptrace.ResourceSpans:
+		return data.Resource(), true
+	}
+	return pcommon.Resource{}, false
+}
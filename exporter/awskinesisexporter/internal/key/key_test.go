@@ -4,9 +4,13 @@
 package key_test
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/awskinesisexporter/internal/key"
 )
@@ -18,3 +22,31 @@ func TestEnsureDifferentKeys(t *testing.T) {
 	assert.NotEmpty(t, k, "Must have a string that has a value")
 	assert.NotEqual(t, k, key.Randomized(nil), "Must have different string values")
 }
+
+func TestFromResourceAttributes(t *testing.T) {
+	t.Parallel()
+
+	partition := key.FromResourceAttributes([]string{"service.name", "host.name"})
+
+	logs := plog.NewLogs()
+	resource := logs.ResourceLogs().AppendEmpty().Resource()
+	resource.Attributes().PutStr("service.name", "checkout")
+	resource.Attributes().PutStr("host.name", "host-1")
+	assert.Equal(t, "checkout/host-1", partition(logs))
+
+	resource.Attributes().Remove("host.name")
+	assert.Equal(t, "checkout/", partition(logs))
+
+	resource.Attributes().PutStr("host.name", strings.Repeat("a", 300))
+	assert.Len(t, partition(logs), 64, "Must hash the keys exceeding the kinesis limit")
+
+	metrics := pmetric.NewMetrics()
+	metrics.ResourceMetrics().AppendEmpty().Resource().Attributes().PutStr("other", "value")
+	k := partition(metrics)
+	assert.NotEmpty(t, k)
+	assert.NotEqual(t, k, partition(metrics), "Must randomize the key without any of the attributes")
+
+	rs := ptrace.NewResourceSpans()
+	rs.Resource().Attributes().PutStr("service.name", "cart")
+	assert.Equal(t, "cart/", partition(rs))
+}
@@ -6,21 +6,39 @@ package producer // import "github.com/open-telemetry/opentelemetry-collector-co
 import (
 	"context"
 	"errors"
+	"fmt"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/kinesis"
 	"github.com/aws/aws-sdk-go-v2/service/kinesis/types"
 	"go.opentelemetry.io/collector/consumer/consumererror"
 	"go.uber.org/zap"
+	"golang.org/x/time/rate"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/awskinesisexporter/internal/batch"
 )
 
+const (
+	// Write limits of a kinesis shard per second
+	shardRecordsPerSecond = 1000
+	shardBytesPerSecond   = 1 << 20
+
+	// maxFailedRecordsAttempts is the number of times the records rejected by kinesis are written again
+	maxFailedRecordsAttempts = 3
+	failedRecordsInterval    = 100 * time.Millisecond
+)
+
 type batcher struct {
 	stream *string
 
 	client Kinesis
 	log    *zap.Logger
+
+	// shardRateLimit paces the writes to the write limits of the open shards of the stream
+	shardRateLimit bool
+	recordsLimiter *rate.Limiter
+	bytesLimiter   *rate.Limiter
 }
 
 var _ Batcher = (*batcher)(nil)
@@ -46,6 +64,21 @@ func NewBatcher(kinesisAPI Kinesis, stream string, opts ...BatcherOptions) (Batc
 
 func (b *batcher) Put(ctx context.Context, bt *batch.Batch) error {
 	for _, records := range bt.Chunk() {
+		if err := b.putRecords(ctx, records); err != nil {
+			return err
+		}
+		b.log.Debug("Successfully wrote batch to kinesis", zap.Stringp("stream", b.stream))
+	}
+	return nil
+}
+
+// putRecords writes the records, and writes again the records rejected by kinesis such as the throttled ones
+func (b *batcher) putRecords(ctx context.Context, records []types.PutRecordsRequestEntry) error {
+	for attempt := 0; ; attempt++ {
+		if err := b.waitShardLimits(ctx, records); err != nil {
+			return err
+		}
+
 		out, err := b.client.PutRecords(ctx, &kinesis.PutRecordsInput{
 			StreamName: b.stream,
 			Records:    records,
@@ -64,14 +97,84 @@ func (b *batcher) Put(ctx context.Context, bt *batch.Batch) error {
 			return err
 		}
 
-		b.log.Debug("Successfully wrote batch to kinesis", zap.Stringp("stream", b.stream))
+		failed := failedRecords(records, out)
+		if len(failed) == 0 {
+			return nil
+		}
+		if attempt == maxFailedRecordsAttempts {
+			b.log.Error("Failed to write records to kinesis", zap.Int("failed-records", len(failed)))
+			return fmt.Errorf("failed to write %d records to kinesis", len(failed))
+		}
+
+		b.log.Debug("Writing again the records rejected by kinesis", zap.Int("failed-records", len(failed)))
+		records = failed
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(failedRecordsInterval << attempt):
+		}
 	}
-	return nil
+}
+
+// failedRecords returns the records that kinesis rejected, in the order of the request
+func failedRecords(records []types.PutRecordsRequestEntry, out *kinesis.PutRecordsOutput) []types.PutRecordsRequestEntry {
+	if out == nil || aws.ToInt32(out.FailedRecordCount) == 0 || len(out.Records) != len(records) {
+		return nil
+	}
+	var failed []types.PutRecordsRequestEntry
+	for i, result := range out.Records {
+		if result.ErrorCode != nil {
+			failed = append(failed, records[i])
+		}
+	}
+	return failed
+}
+
+// waitShardLimits waits until the records can be written within the write limits of the shards
+func (b *batcher) waitShardLimits(ctx context.Context, records []types.PutRecordsRequestEntry) error {
+	if b.recordsLimiter == nil || b.bytesLimiter == nil {
+		return nil
+	}
+	size := 0
+	for _, record := range records {
+		size += len(record.Data) + len(aws.ToString(record.PartitionKey))
+	}
+	if err := b.recordsLimiter.WaitN(ctx, min(len(records), b.recordsLimiter.Burst())); err != nil {
+		return err
+	}
+	return b.bytesLimiter.WaitN(ctx, min(size, b.bytesLimiter.Burst()))
 }
 
 func (b *batcher) Ready(ctx context.Context) error {
-	_, err := b.client.DescribeStream(ctx, &kinesis.DescribeStreamInput{
+	shards := 0
+	input := &kinesis.DescribeStreamInput{
 		StreamName: b.stream,
-	})
-	return err
+	}
+	for {
+		out, err := b.client.DescribeStream(ctx, input)
+		if err != nil {
+			return err
+		}
+		if !b.shardRateLimit || out == nil || out.StreamDescription == nil {
+			return nil
+		}
+
+		description := out.StreamDescription
+		for _, shard := range description.Shards {
+			if shard.SequenceNumberRange == nil || shard.SequenceNumberRange.EndingSequenceNumber == nil {
+				shards++
+			}
+		}
+		if !aws.ToBool(description.HasMoreShards) || len(description.Shards) == 0 {
+			break
+		}
+		input.ExclusiveStartShardId = description.Shards[len(description.Shards)-1].ShardId
+	}
+
+	if shards > 0 {
+		b.recordsLimiter = rate.NewLimiter(rate.Limit(shards*shardRecordsPerSecond), shards*shardRecordsPerSecond)
+		b.bytesLimiter = rate.NewLimiter(rate.Limit(shards*shardBytesPerSecond), shards*shardBytesPerSecond)
+		b.log.Debug("Pacing the writes to the write limits of the shards", zap.Int("shards", shards))
+	}
+	return nil
 }
@@ -21,3 +21,12 @@ func WithLogger(l *zap.Logger) BatcherOptions {
 		return nil
 	}
 }
+
+// WithShardRateLimit paces the writes of the Batcher to the write limits of the open shards of the stream,
+// which are counted when the Batcher gets ready
+func WithShardRateLimit(enabled bool) BatcherOptions {
+	return func(p *batcher) error {
+		p.shardRateLimit = enabled
+		return nil
+	}
+}
@@ -22,7 +22,12 @@ import (
 type MockKinesisAPI struct {
 	producer.Kinesis
 
-	op func(*kinesis.PutRecordsInput) (*kinesis.PutRecordsOutput, error)
+	op       func(*kinesis.PutRecordsInput) (*kinesis.PutRecordsOutput, error)
+	describe func(*kinesis.DescribeStreamInput) (*kinesis.DescribeStreamOutput, error)
+}
+
+func (mka *MockKinesisAPI) DescribeStream(_ context.Context, r *kinesis.DescribeStreamInput, _ ...func(*kinesis.Options)) (*kinesis.DescribeStreamOutput, error) {
+	return mka.describe(r)
 }
 
 func (mka *MockKinesisAPI) PutRecords(_ context.Context, r *kinesis.PutRecordsInput, _ ...func(*kinesis.Options)) (*kinesis.PutRecordsOutput, error) {
@@ -63,6 +68,23 @@ func TransientPutRecordsOperation(recoverAfter int) func(_ *kinesis.PutRecordsIn
 	}
 }
 
+func PartiallyFailedPutRecordsOperation(failures int) func(_ *kinesis.PutRecordsInput) (*kinesis.PutRecordsOutput, error) {
+	attempt := 0
+	return func(r *kinesis.PutRecordsInput) (*kinesis.PutRecordsOutput, error) {
+		out := &kinesis.PutRecordsOutput{
+			FailedRecordCount: aws.Int32(0),
+			Records:           make([]types.PutRecordsResultEntry, len(r.Records)),
+		}
+		if attempt < failures {
+			attempt++
+			// rejects the first record of the request
+			out.FailedRecordCount = aws.Int32(1)
+			out.Records[0].ErrorCode = aws.String("ProvisionedThroughputExceededException")
+		}
+		return out, nil
+	}
+}
+
 func TestBatchedExporter(t *testing.T) {
 	t.Parallel()
 
@@ -105,3 +127,85 @@ func TestBatchedExporter(t *testing.T) {
 		})
 	}
 }
+
+func TestBatchedExporterFailedRecords(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name      string
+		failures  int
+		shouldErr bool
+	}{
+		{name: "Rejected records written again", failures: 2, shouldErr: false},
+		{name: "Rejected records after all attempts", failures: 10, shouldErr: true},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			bt := batch.New()
+			for i := 0; i < 10; i++ {
+				assert.NoError(t, bt.AddRecord([]byte("foobar"), "fixed-key"))
+			}
+
+			var requests []int
+			op := PartiallyFailedPutRecordsOperation(tc.failures)
+			be, err := producer.NewBatcher(
+				SetPutRecordsOperation(func(r *kinesis.PutRecordsInput) (*kinesis.PutRecordsOutput, error) {
+					requests = append(requests, len(r.Records))
+					return op(r)
+				}),
+				tc.name,
+				producer.WithLogger(zaptest.NewLogger(t)),
+			)
+			require.NoError(t, err)
+
+			err = be.Put(context.Background(), bt)
+			if tc.shouldErr {
+				assert.Error(t, err, "Must error when records are still rejected")
+				assert.False(t, consumererror.IsPermanent(err), "Must not be a permanent error")
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, []int{10, 1, 1}, requests, "Must only write again the rejected records")
+		})
+	}
+}
+
+func TestBatcherReadyShardRateLimit(t *testing.T) {
+	t.Parallel()
+
+	var calls int
+	client := &MockKinesisAPI{
+		op: SuccessfulPutRecordsOperation,
+		describe: func(r *kinesis.DescribeStreamInput) (*kinesis.DescribeStreamOutput, error) {
+			calls++
+			if r.ExclusiveStartShardId == nil {
+				return &kinesis.DescribeStreamOutput{StreamDescription: &types.StreamDescription{
+					HasMoreShards: aws.Bool(true),
+					Shards: []types.Shard{
+						{ShardId: aws.String("shard-1"), SequenceNumberRange: &types.SequenceNumberRange{StartingSequenceNumber: aws.String("1")}},
+						{ShardId: aws.String("shard-2"), SequenceNumberRange: &types.SequenceNumberRange{StartingSequenceNumber: aws.String("1"), EndingSequenceNumber: aws.String("2")}},
+					},
+				}}, nil
+			}
+			assert.Equal(t, "shard-2", aws.ToString(r.ExclusiveStartShardId))
+			return &kinesis.DescribeStreamOutput{StreamDescription: &types.StreamDescription{
+				HasMoreShards: aws.Bool(false),
+				Shards: []types.Shard{
+					{ShardId: aws.String("shard-3"), SequenceNumberRange: &types.SequenceNumberRange{StartingSequenceNumber: aws.String("3")}},
+				},
+			}}, nil
+		},
+	}
+
+	be, err := producer.NewBatcher(client, "stream", producer.WithShardRateLimit(true))
+	require.NoError(t, err)
+	require.NoError(t, be.Ready(context.Background()))
+	assert.Equal(t, 2, calls, "Must have described all the shards of the stream")
+
+	bt := batch.New()
+	for i := 0; i < 10; i++ {
+		assert.NoError(t, bt.AddRecord([]byte("foobar"), "fixed-key"))
+	}
+	assert.NoError(t, be.Put(context.Background(), bt))
+}
@@ -13,7 +13,8 @@ import (
 
 // Batcher abstracts the raw kinesis client to reduce complexity with delivering dynamic encoded data.
 type Batcher interface {
-	// Put is a blocking operation that will attempt to write the data at most once to kinesis.
+	// Put is a blocking operation that will attempt to write the data at most once to kinesis,
+	// only writing again the individual records that kinesis rejected such as the throttled ones.
 	// Any unrecoverable errors such as misconfigured client or hard limits being exceeded
 	// will result in consumeerr.Permanent being returned to allow for existing retry patterns within
 	// the project to be used.
@@ -72,6 +72,7 @@ const (
 	OtlpJSON     MarshalerType = "otlp_json"
 	SumoIC       MarshalerType = "sumo_ic"
 	Body         MarshalerType = "body"
+	Parquet      MarshalerType = "parquet"
 )
 
 // ResourceAttrsToS3 defines the mapping of S3 uploading configuration values to resource attribute values.
@@ -80,6 +81,11 @@ type ResourceAttrsToS3 struct {
 	S3Bucket string `mapstructure:"s3_bucket"`
 	// S3Prefix indicates the mapping of the key (directory) prefix used for writing into the bucket to a specific resource attribute value.
 	S3Prefix string `mapstructure:"s3_prefix"`
+	// S3PartitionAttributes lists the resource attributes whose values are appended, in order, to the
+	// time partition as `<name>=<value>` levels, the name being the attribute name with the characters
+	// other than letters, digits and underscores replaced by underscores. Data is split in an object per
+	// combination of values.
+	S3PartitionAttributes []string `mapstructure:"s3_partition_attributes"`
 	// prevent unkeyed literal initialization
 	_ struct{}
 }
@@ -143,7 +149,7 @@ func (c *Config) Validate() error {
 			errs = multierr.Append(errs, errors.New("unknown compression type"))
 		}
 
-		if c.MarshalerName == SumoIC {
+		if c.MarshalerName == SumoIC || c.MarshalerName == Parquet {
 			errs = multierr.Append(errs, errors.New("marshaler does not support compression"))
 		}
 	}
@@ -155,5 +161,12 @@ func (c *Config) Validate() error {
 	if c.S3Uploader.UniqueKeyFuncName != "" && !validUniqueKeyFuncs[c.S3Uploader.UniqueKeyFuncName] {
 		errs = multierr.Append(errs, errors.New("invalid UniqueKeyFuncName"))
 	}
+
+	for _, attr := range c.ResourceAttrsToS3.S3PartitionAttributes {
+		if attr == "" {
+			errs = multierr.Append(errs, errors.New("s3_partition_attributes must not contain empty attribute names"))
+			break
+		}
+	}
 	return errs
 }
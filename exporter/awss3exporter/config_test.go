@@ -12,6 +12,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config/configcompression"
 	"go.opentelemetry.io/collector/exporter/exporterhelper"
 	"go.opentelemetry.io/collector/otelcol/otelcoltest"
 	"go.uber.org/multierr"
@@ -323,6 +324,27 @@ func TestConfig_Validate(t *testing.T) {
 			}(),
 			errExpected: errors.New("region is required"),
 		},
+		{
+			name: "parquet with compression",
+			config: func() *Config {
+				c := createDefaultConfig().(*Config)
+				c.S3Uploader.S3Bucket = "foo"
+				c.MarshalerName = Parquet
+				c.S3Uploader.Compression = configcompression.TypeGzip
+				return c
+			}(),
+			errExpected: errors.New("marshaler does not support compression"),
+		},
+		{
+			name: "empty partition attribute",
+			config: func() *Config {
+				c := createDefaultConfig().(*Config)
+				c.S3Uploader.S3Bucket = "foo"
+				c.ResourceAttrsToS3.S3PartitionAttributes = []string{"service.name", ""}
+				return c
+			}(),
+			errExpected: errors.New("s3_partition_attributes must not contain empty attribute names"),
+		},
 	}
 
 	for _, tt := range tests {
@@ -475,8 +497,9 @@ func TestResourceAttrsToS3(t *testing.T) {
 		},
 		MarshalerName: "otlp_json",
 		ResourceAttrsToS3: ResourceAttrsToS3{
-			S3Bucket: "com.awss3.bucket",
-			S3Prefix: "com.awss3.prefix",
+			S3Bucket:              "com.awss3.bucket",
+			S3Prefix:              "com.awss3.prefix",
+			S3PartitionAttributes: []string{"service.name"},
 		},
 	}, e,
 	)
@@ -6,6 +6,9 @@ package awss3exporter // import "github.com/open-telemetry/opentelemetry-collect
 import (
 	"context"
 	"fmt"
+	"net/url"
+	"path"
+	"strings"
 
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/consumer"
@@ -19,6 +22,10 @@ import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/awss3exporter/internal/upload"
 )
 
+// hiveDefaultPartition is the value of the partitions of missing attributes,
+// as used by Hive for null values.
+const hiveDefaultPartition = "__HIVE_DEFAULT_PARTITION__"
+
 type s3Exporter struct {
 	config     *Config
 	signalType string
@@ -54,12 +61,40 @@ func (e *s3Exporter) getUploadOpts(res pcommon.Resource) *upload.UploadOptions {
 		}
 	}
 	uploadOpts := &upload.UploadOptions{
-		OverrideBucket: s3Bucket,
-		OverridePrefix: s3Prefix,
+		OverrideBucket:      s3Bucket,
+		OverridePrefix:      s3Prefix,
+		AttributesPartition: attributesPartition(res, e.config.ResourceAttrsToS3.S3PartitionAttributes),
 	}
 	return uploadOpts
 }
 
+// attributesPartition builds the Hive style partition of the given resource attributes.
+func attributesPartition(res pcommon.Resource, attrs []string) string {
+	if len(attrs) == 0 {
+		return ""
+	}
+	levels := make([]string, len(attrs))
+	for i, attr := range attrs {
+		value := hiveDefaultPartition
+		if v, ok := res.Attributes().Get(attr); ok && v.AsString() != "" {
+			value = url.PathEscape(v.AsString())
+		}
+		levels[i] = partitionName(attr) + "=" + value
+	}
+	return path.Join(levels...)
+}
+
+// partitionName returns the name of the partition of an attribute, replacing the characters
+// other than letters, digits and underscores by underscores so that it is a valid column name.
+func partitionName(attr string) string {
+	return strings.Map(func(r rune) rune {
+		if r == '_' || ('a' <= r && r <= 'z') || ('A' <= r && r <= 'Z') || ('0' <= r && r <= '9') {
+			return r
+		}
+		return '_'
+	}, attr)
+}
+
 func (e *s3Exporter) start(ctx context.Context, host component.Host) error {
 	var m marshaler
 	var err error
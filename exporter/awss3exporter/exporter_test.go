@@ -9,6 +9,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/plog"
 	"go.uber.org/zap"
 
@@ -96,3 +97,40 @@ func TestLogWithBucketAndPrefixAttrs(t *testing.T) {
 	exporter := getLogExporterWithBucketAndPrefixAttrs(t)
 	assert.NoError(t, exporter.ConsumeLogs(context.Background(), logs))
 }
+
+func TestAttributesPartition(t *testing.T) {
+	res := pcommon.NewResource()
+	res.Attributes().PutStr("service.name", "checkout")
+	res.Attributes().PutStr("deployment.environment", "eu/prod")
+	res.Attributes().PutInt("shard", 3)
+
+	for _, tc := range []struct {
+		name   string
+		attrs  []string
+		expect string
+	}{
+		{
+			name:   "no attributes",
+			expect: "",
+		},
+		{
+			name:   "single attribute",
+			attrs:  []string{"service.name"},
+			expect: "service_name=checkout",
+		},
+		{
+			name:   "attributes in order",
+			attrs:  []string{"deployment.environment", "service.name", "shard"},
+			expect: "deployment_environment=eu%2Fprod/service_name=checkout/shard=3",
+		},
+		{
+			name:   "missing attribute",
+			attrs:  []string{"service.name", "k8s.namespace.name"},
+			expect: "service_name=checkout/k8s_namespace_name=__HIVE_DEFAULT_PARTITION__",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expect, attributesPartition(res, tc.attrs))
+		})
+	}
+}
@@ -87,13 +87,14 @@ func createLogsExporter(ctx context.Context,
 		return nil, err
 	}
 
-	if cfg.ResourceAttrsToS3.S3Prefix == "" {
+	batchKeys := resourceBatchKeys(cfg)
+	if len(batchKeys) == 0 {
 		return logsExporter, err
 	}
 
 	wrapped := &baseLogsExporter{
 		Component: logsExporter,
-		Logs:      batchperresourceattr.NewBatchPerResourceLogs(cfg.ResourceAttrsToS3.S3Prefix, logsExporter),
+		Logs:      batchperresourceattr.NewMultiBatchPerResourceLogs(batchKeys, logsExporter),
 	}
 	return wrapped, nil
 }
@@ -124,13 +125,14 @@ func createMetricsExporter(ctx context.Context,
 		return nil, err
 	}
 
-	if cfg.ResourceAttrsToS3.S3Prefix == "" {
+	batchKeys := resourceBatchKeys(cfg)
+	if len(batchKeys) == 0 {
 		return metricsExporter, err
 	}
 
 	wrapped := &baseMetricsExporter{
 		Component: metricsExporter,
-		Metrics:   batchperresourceattr.NewBatchPerResourceMetrics(cfg.ResourceAttrsToS3.S3Prefix, metricsExporter),
+		Metrics:   batchperresourceattr.NewMultiBatchPerResourceMetrics(batchKeys, metricsExporter),
 	}
 	return wrapped, nil
 }
@@ -150,6 +152,10 @@ func createTracesExporter(ctx context.Context,
 		return nil, errors.New("traces are not supported by sumo_ic output format")
 	}
 
+	if config.(*Config).MarshalerName == Parquet {
+		return nil, errors.New("traces are not supported by parquet output format")
+	}
+
 	tracesExporter, err := exporterhelper.NewTraces(ctx,
 		params,
 		config,
@@ -162,17 +168,28 @@ func createTracesExporter(ctx context.Context,
 		return nil, err
 	}
 
-	if cfg.ResourceAttrsToS3.S3Prefix == "" {
+	batchKeys := resourceBatchKeys(cfg)
+	if len(batchKeys) == 0 {
 		return tracesExporter, err
 	}
 
 	wrapped := &baseTracesExporter{
 		Component: tracesExporter,
-		Traces:    batchperresourceattr.NewBatchPerResourceTraces(cfg.ResourceAttrsToS3.S3Prefix, tracesExporter),
+		Traces:    batchperresourceattr.NewMultiBatchPerResourceTraces(batchKeys, tracesExporter),
 	}
 	return wrapped, nil
 }
 
+// resourceBatchKeys returns the resource attributes whose values must be the same
+// for all the data uploaded in an object.
+func resourceBatchKeys(cfg *Config) []string {
+	var keys []string
+	if cfg.ResourceAttrsToS3.S3Prefix != "" {
+		keys = append(keys, cfg.ResourceAttrsToS3.S3Prefix)
+	}
+	return append(keys, cfg.ResourceAttrsToS3.S3PartitionAttributes...)
+}
+
 // checkAndCastConfig checks the configuration type and casts it to the S3 exporter Config struct.
 func checkAndCastConfig(c component.Config) (*Config, error) {
 	cfg, ok := c.(*Config)
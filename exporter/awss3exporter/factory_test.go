@@ -67,4 +67,12 @@ func TestUnsupportedMarshalerOptions(t *testing.T) {
 		cfg)
 	assert.Error(t, err)
 	require.Nil(t, exp2)
+
+	cfg.(*Config).MarshalerName = Parquet
+	exp3, err := createTracesExporter(
+		context.Background(),
+		exportertest.NewNopSettings(metadata.Type),
+		cfg)
+	assert.EqualError(t, err, "traces are not supported by parquet output format")
+	require.Nil(t, exp3)
 }
@@ -45,7 +45,13 @@ type PartitionKeyBuilder struct {
 }
 
 func (pki *PartitionKeyBuilder) Build(ts time.Time, overridePrefix string) string {
-	return path.Join(pki.bucketKeyPrefix(ts, overridePrefix), pki.fileName())
+	return pki.BuildWithAttributes(ts, overridePrefix, "")
+}
+
+// BuildWithAttributes builds the key of an object whose attributes partition, if any,
+// is appended to its time partition.
+func (pki *PartitionKeyBuilder) BuildWithAttributes(ts time.Time, overridePrefix, attributesPartition string) string {
+	return path.Join(pki.bucketKeyPrefix(ts, overridePrefix), attributesPartition, pki.fileName())
 }
 
 func (pki *PartitionKeyBuilder) bucketKeyPrefix(ts time.Time, overridePrefix string) string {
@@ -113,6 +113,30 @@ func TestPartitionKeyInputsNewPartitionKey(t *testing.T) {
 	}
 }
 
+func TestPartitionKeyInputsBuildWithAttributes(t *testing.T) {
+	t.Parallel()
+
+	inputs := &PartitionKeyBuilder{
+		PartitionPrefix: "telemetry",
+		PartitionFormat: "year=%Y/month=%m/day=%d/hour=%H",
+		Metadata:        "logs",
+		FileFormat:      "parquet",
+		UniqueKeyFunc: func() string {
+			return "fixed"
+		},
+	}
+	ts := time.Date(2024, 0o1, 24, 6, 40, 20, 0, time.Local)
+
+	assert.Equal(t,
+		"telemetry/year=2024/month=01/day=24/hour=06/service_name=checkout/logs_fixed.parquet",
+		inputs.BuildWithAttributes(ts, "", "service_name=checkout"),
+	)
+	assert.Equal(t,
+		"telemetry/year=2024/month=01/day=24/hour=06/logs_fixed.parquet",
+		inputs.BuildWithAttributes(ts, "", ""),
+	)
+}
+
 func TestPartitionKeyInputsBucketPrefix(t *testing.T) {
 	t.Parallel()
 
@@ -25,6 +25,8 @@ type ManagerOpt func(Manager)
 type UploadOptions struct {
 	OverrideBucket string
 	OverridePrefix string
+	// AttributesPartition is appended to the time partition of the object.
+	AttributesPartition string
 }
 
 type s3manager struct {
@@ -72,16 +74,18 @@ func (sw *s3manager) Upload(ctx context.Context, data []byte, opts *UploadOption
 
 	overridePrefix := ""
 	overrideBucket := sw.bucket
+	attributesPartition := ""
 	if opts != nil {
 		overridePrefix = opts.OverridePrefix
 		if opts.OverrideBucket != "" {
 			overrideBucket = opts.OverrideBucket
 		}
+		attributesPartition = opts.AttributesPartition
 	}
 
 	_, err = sw.uploader.Upload(ctx, &s3.PutObjectInput{
 		Bucket:          aws.String(overrideBucket),
-		Key:             aws.String(sw.builder.Build(now, overridePrefix)),
+		Key:             aws.String(sw.builder.BuildWithAttributes(now, overridePrefix, attributesPartition)),
 		Body:            content,
 		ContentEncoding: aws.String(encoding),
 		StorageClass:    sw.storageClass,
@@ -58,6 +58,11 @@ func newMarshaler(mType MarshalerType, logger *zap.Logger) (marshaler, error) {
 		exportbodyMarshaler := newbodyMarshaler()
 		marshaler.logsMarshaler = &exportbodyMarshaler
 		marshaler.fileFormat = exportbodyMarshaler.format()
+	case Parquet:
+		pm := parquetMarshaler{}
+		marshaler.logsMarshaler = pm
+		marshaler.metricsMarshaler = pm
+		marshaler.fileFormat = pm.format()
 	default:
 		return nil, ErrUnknownMarshaler
 	}
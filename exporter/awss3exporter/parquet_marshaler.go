@@ -0,0 +1,379 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package awss3exporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/awss3exporter"
+
+import (
+	"bytes"
+
+	"github.com/apache/arrow-go/v18/arrow"
+	"github.com/apache/arrow-go/v18/arrow/array"
+	"github.com/apache/arrow-go/v18/arrow/memory"
+	"github.com/apache/arrow-go/v18/parquet"
+	"github.com/apache/arrow-go/v18/parquet/compress"
+	"github.com/apache/arrow-go/v18/parquet/pqarrow"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	conventions "go.opentelemetry.io/otel/semconv/v1.27.0"
+)
+
+// parquetMarshaler writes logs and metrics as Parquet files holding a row per log record
+// or metric data point, with their resource and scope denormalized into each row.
+type parquetMarshaler struct{}
+
+var (
+	attributesType = arrow.MapOf(arrow.BinaryTypes.String, arrow.BinaryTypes.String)
+	timestampType  = arrow.FixedWidthTypes.Timestamp_us
+)
+
+// The columns shared by the logs and metrics schemas, describing the scope and resource of a row.
+var commonFields = []arrow.Field{
+	{Name: "scope_name", Type: arrow.BinaryTypes.String},
+	{Name: "scope_version", Type: arrow.BinaryTypes.String},
+	{Name: "service_name", Type: arrow.BinaryTypes.String, Nullable: true},
+	{Name: "resource_attributes", Type: attributesType},
+}
+
+var logsSchema = arrow.NewSchema(append([]arrow.Field{
+	{Name: "timestamp", Type: timestampType, Nullable: true},
+	{Name: "observed_timestamp", Type: timestampType, Nullable: true},
+	{Name: "trace_id", Type: arrow.BinaryTypes.String, Nullable: true},
+	{Name: "span_id", Type: arrow.BinaryTypes.String, Nullable: true},
+	{Name: "flags", Type: arrow.PrimitiveTypes.Uint32},
+	{Name: "severity_text", Type: arrow.BinaryTypes.String},
+	{Name: "severity_number", Type: arrow.PrimitiveTypes.Int32},
+	{Name: "event_name", Type: arrow.BinaryTypes.String, Nullable: true},
+	{Name: "body", Type: arrow.BinaryTypes.String, Nullable: true},
+	{Name: "attributes", Type: attributesType},
+}, commonFields...), nil)
+
+var metricsSchema = arrow.NewSchema(append([]arrow.Field{
+	{Name: "timestamp", Type: timestampType, Nullable: true},
+	{Name: "start_timestamp", Type: timestampType, Nullable: true},
+	{Name: "metric_name", Type: arrow.BinaryTypes.String},
+	{Name: "metric_description", Type: arrow.BinaryTypes.String},
+	{Name: "metric_unit", Type: arrow.BinaryTypes.String},
+	{Name: "metric_type", Type: arrow.BinaryTypes.String},
+	{Name: "aggregation_temporality", Type: arrow.BinaryTypes.String, Nullable: true},
+	{Name: "is_monotonic", Type: arrow.FixedWidthTypes.Boolean, Nullable: true},
+	{Name: "value", Type: arrow.PrimitiveTypes.Float64, Nullable: true},
+	{Name: "count", Type: arrow.PrimitiveTypes.Uint64, Nullable: true},
+	{Name: "sum", Type: arrow.PrimitiveTypes.Float64, Nullable: true},
+	{Name: "min", Type: arrow.PrimitiveTypes.Float64, Nullable: true},
+	{Name: "max", Type: arrow.PrimitiveTypes.Float64, Nullable: true},
+	{Name: "bucket_counts", Type: arrow.ListOf(arrow.PrimitiveTypes.Uint64), Nullable: true},
+	{Name: "explicit_bounds", Type: arrow.ListOf(arrow.PrimitiveTypes.Float64), Nullable: true},
+	{Name: "quantiles", Type: arrow.ListOf(arrow.PrimitiveTypes.Float64), Nullable: true},
+	{Name: "quantile_values", Type: arrow.ListOf(arrow.PrimitiveTypes.Float64), Nullable: true},
+	{Name: "flags", Type: arrow.PrimitiveTypes.Uint32},
+	{Name: "attributes", Type: attributesType},
+}, commonFields...), nil)
+
+func (parquetMarshaler) format() string {
+	return "parquet"
+}
+
+func (m parquetMarshaler) MarshalLogs(ld plog.Logs) ([]byte, error) {
+	b := array.NewRecordBuilder(memory.DefaultAllocator, logsSchema)
+	defer b.Release()
+
+	rls := ld.ResourceLogs()
+	for i := 0; i < rls.Len(); i++ {
+		rl := rls.At(i)
+		sls := rl.ScopeLogs()
+		for j := 0; j < sls.Len(); j++ {
+			sl := sls.At(j)
+			lrs := sl.LogRecords()
+			for k := 0; k < lrs.Len(); k++ {
+				lr := lrs.At(k)
+				appendTimestamp(b.Field(0), lr.Timestamp())
+				appendTimestamp(b.Field(1), lr.ObservedTimestamp())
+				appendOptionalString(b.Field(2), lr.TraceID().String())
+				appendOptionalString(b.Field(3), lr.SpanID().String())
+				b.Field(4).(*array.Uint32Builder).Append(uint32(lr.Flags()))
+				b.Field(5).(*array.StringBuilder).Append(lr.SeverityText())
+				b.Field(6).(*array.Int32Builder).Append(int32(lr.SeverityNumber()))
+				appendOptionalString(b.Field(7), lr.EventName())
+				if lr.Body().Type() == pcommon.ValueTypeEmpty {
+					b.Field(8).AppendNull()
+				} else {
+					b.Field(8).(*array.StringBuilder).Append(lr.Body().AsString())
+				}
+				appendAttributes(b.Field(9), lr.Attributes())
+				appendCommonFields(b, 10, sl.Scope(), rl.Resource())
+			}
+		}
+	}
+	return m.write(b)
+}
+
+func (m parquetMarshaler) MarshalMetrics(md pmetric.Metrics) ([]byte, error) {
+	b := array.NewRecordBuilder(memory.DefaultAllocator, metricsSchema)
+	defer b.Release()
+
+	rms := md.ResourceMetrics()
+	for i := 0; i < rms.Len(); i++ {
+		rm := rms.At(i)
+		sms := rm.ScopeMetrics()
+		for j := 0; j < sms.Len(); j++ {
+			sm := sms.At(j)
+			metrics := sm.Metrics()
+			for k := 0; k < metrics.Len(); k++ {
+				appendMetric(b, metrics.At(k), sm.Scope(), rm.Resource())
+			}
+		}
+	}
+	return m.write(b)
+}
+
+// metricRow holds the values of the columns of a data point which depend on the metric type.
+type metricRow struct {
+	timestamp, startTimestamp    pcommon.Timestamp
+	temporality                  *pmetric.AggregationTemporality
+	isMonotonic                  *bool
+	value, sum, minimum, maximum *float64
+	count                        *uint64
+	bucketCounts                 []uint64
+	explicitBounds               []float64
+	quantiles, quantileValues    []float64
+	flags                        pmetric.DataPointFlags
+	attributes                   pcommon.Map
+}
+
+func appendMetric(b *array.RecordBuilder, metric pmetric.Metric, scope pcommon.InstrumentationScope, resource pcommon.Resource) {
+	appendRow := func(row metricRow) {
+		appendTimestamp(b.Field(0), row.timestamp)
+		appendTimestamp(b.Field(1), row.startTimestamp)
+		b.Field(2).(*array.StringBuilder).Append(metric.Name())
+		b.Field(3).(*array.StringBuilder).Append(metric.Description())
+		b.Field(4).(*array.StringBuilder).Append(metric.Unit())
+		b.Field(5).(*array.StringBuilder).Append(metric.Type().String())
+		if row.temporality != nil {
+			b.Field(6).(*array.StringBuilder).Append(row.temporality.String())
+		} else {
+			b.Field(6).AppendNull()
+		}
+		if row.isMonotonic != nil {
+			b.Field(7).(*array.BooleanBuilder).Append(*row.isMonotonic)
+		} else {
+			b.Field(7).AppendNull()
+		}
+		appendOptionalFloat(b.Field(8), row.value)
+		if row.count != nil {
+			b.Field(9).(*array.Uint64Builder).Append(*row.count)
+		} else {
+			b.Field(9).AppendNull()
+		}
+		appendOptionalFloat(b.Field(10), row.sum)
+		appendOptionalFloat(b.Field(11), row.minimum)
+		appendOptionalFloat(b.Field(12), row.maximum)
+		appendUint64List(b.Field(13), row.bucketCounts)
+		appendFloat64List(b.Field(14), row.explicitBounds)
+		appendFloat64List(b.Field(15), row.quantiles)
+		appendFloat64List(b.Field(16), row.quantileValues)
+		b.Field(17).(*array.Uint32Builder).Append(uint32(row.flags))
+		appendAttributes(b.Field(18), row.attributes)
+		appendCommonFields(b, 19, scope, resource)
+	}
+
+	switch metric.Type() {
+	case pmetric.MetricTypeGauge:
+		dps := metric.Gauge().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			appendRow(numberDataPointRow(dps.At(i)))
+		}
+	case pmetric.MetricTypeSum:
+		temporality := metric.Sum().AggregationTemporality()
+		isMonotonic := metric.Sum().IsMonotonic()
+		dps := metric.Sum().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			row := numberDataPointRow(dps.At(i))
+			row.temporality = &temporality
+			row.isMonotonic = &isMonotonic
+			appendRow(row)
+		}
+	case pmetric.MetricTypeHistogram:
+		temporality := metric.Histogram().AggregationTemporality()
+		dps := metric.Histogram().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			dp := dps.At(i)
+			count := dp.Count()
+			row := metricRow{
+				timestamp:      dp.Timestamp(),
+				startTimestamp: dp.StartTimestamp(),
+				temporality:    &temporality,
+				count:          &count,
+				bucketCounts:   dp.BucketCounts().AsRaw(),
+				explicitBounds: dp.ExplicitBounds().AsRaw(),
+				flags:          dp.Flags(),
+				attributes:     dp.Attributes(),
+			}
+			if dp.HasSum() {
+				row.sum = ptr(dp.Sum())
+			}
+			if dp.HasMin() {
+				row.minimum = ptr(dp.Min())
+			}
+			if dp.HasMax() {
+				row.maximum = ptr(dp.Max())
+			}
+			appendRow(row)
+		}
+	case pmetric.MetricTypeExponentialHistogram:
+		temporality := metric.ExponentialHistogram().AggregationTemporality()
+		dps := metric.ExponentialHistogram().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			dp := dps.At(i)
+			count := dp.Count()
+			row := metricRow{
+				timestamp:      dp.Timestamp(),
+				startTimestamp: dp.StartTimestamp(),
+				temporality:    &temporality,
+				count:          &count,
+				flags:          dp.Flags(),
+				attributes:     dp.Attributes(),
+			}
+			if dp.HasSum() {
+				row.sum = ptr(dp.Sum())
+			}
+			if dp.HasMin() {
+				row.minimum = ptr(dp.Min())
+			}
+			if dp.HasMax() {
+				row.maximum = ptr(dp.Max())
+			}
+			appendRow(row)
+		}
+	case pmetric.MetricTypeSummary:
+		dps := metric.Summary().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			dp := dps.At(i)
+			count := dp.Count()
+			row := metricRow{
+				timestamp:      dp.Timestamp(),
+				startTimestamp: dp.StartTimestamp(),
+				count:          &count,
+				sum:            ptr(dp.Sum()),
+				flags:          dp.Flags(),
+				attributes:     dp.Attributes(),
+			}
+			qvs := dp.QuantileValues()
+			for j := 0; j < qvs.Len(); j++ {
+				row.quantiles = append(row.quantiles, qvs.At(j).Quantile())
+				row.quantileValues = append(row.quantileValues, qvs.At(j).Value())
+			}
+			appendRow(row)
+		}
+	}
+}
+
+func numberDataPointRow(dp pmetric.NumberDataPoint) metricRow {
+	row := metricRow{
+		timestamp:      dp.Timestamp(),
+		startTimestamp: dp.StartTimestamp(),
+		flags:          dp.Flags(),
+		attributes:     dp.Attributes(),
+	}
+	switch dp.ValueType() {
+	case pmetric.NumberDataPointValueTypeInt:
+		row.value = ptr(float64(dp.IntValue()))
+	case pmetric.NumberDataPointValueTypeDouble:
+		row.value = ptr(dp.DoubleValue())
+	}
+	return row
+}
+
+func (parquetMarshaler) write(b *array.RecordBuilder) ([]byte, error) {
+	record := b.NewRecord()
+	defer record.Release()
+
+	buf := bytes.Buffer{}
+	props := parquet.NewWriterProperties(parquet.WithCompression(compress.Codecs.Snappy))
+	w, err := pqarrow.NewFileWriter(record.Schema(), &buf, props, pqarrow.DefaultWriterProps())
+	if err != nil {
+		return nil, err
+	}
+	if err := w.Write(record); err != nil {
+		_ = w.Close()
+		return nil, err
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// appendCommonFields appends the scope and resource columns, starting at the given column.
+func appendCommonFields(b *array.RecordBuilder, column int, scope pcommon.InstrumentationScope, resource pcommon.Resource) {
+	b.Field(column).(*array.StringBuilder).Append(scope.Name())
+	b.Field(column + 1).(*array.StringBuilder).Append(scope.Version())
+	if serviceName, ok := resource.Attributes().Get(string(conventions.ServiceNameKey)); ok {
+		b.Field(column + 2).(*array.StringBuilder).Append(serviceName.AsString())
+	} else {
+		b.Field(column + 2).AppendNull()
+	}
+	appendAttributes(b.Field(column+3), resource.Attributes())
+}
+
+func appendTimestamp(b array.Builder, ts pcommon.Timestamp) {
+	if ts == 0 {
+		b.AppendNull()
+		return
+	}
+	b.(*array.TimestampBuilder).Append(arrow.Timestamp(ts.AsTime().UnixMicro()))
+}
+
+func appendOptionalString(b array.Builder, s string) {
+	if s == "" {
+		b.AppendNull()
+		return
+	}
+	b.(*array.StringBuilder).Append(s)
+}
+
+func appendOptionalFloat(b array.Builder, f *float64) {
+	if f == nil {
+		b.AppendNull()
+		return
+	}
+	b.(*array.Float64Builder).Append(*f)
+}
+
+func appendUint64List(b array.Builder, values []uint64) {
+	lb := b.(*array.ListBuilder)
+	if values == nil {
+		lb.AppendNull()
+		return
+	}
+	lb.Append(true)
+	lb.ValueBuilder().(*array.Uint64Builder).AppendValues(values, nil)
+}
+
+func appendFloat64List(b array.Builder, values []float64) {
+	lb := b.(*array.ListBuilder)
+	if values == nil {
+		lb.AppendNull()
+		return
+	}
+	lb.Append(true)
+	lb.ValueBuilder().(*array.Float64Builder).AppendValues(values, nil)
+}
+
+// appendAttributes appends attributes as a map of strings, the values which are not strings
+// being converted with pcommon.Value.AsString.
+func appendAttributes(b array.Builder, attrs pcommon.Map) {
+	mb := b.(*array.MapBuilder)
+	mb.Append(true)
+	kb := mb.KeyBuilder().(*array.StringBuilder)
+	ib := mb.ItemBuilder().(*array.StringBuilder)
+	attrs.Range(func(k string, v pcommon.Value) bool {
+		kb.Append(k)
+		ib.Append(v.AsString())
+		return true
+	})
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
@@ -0,0 +1,168 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package awss3exporter
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/apache/arrow-go/v18/arrow"
+	"github.com/apache/arrow-go/v18/arrow/array"
+	"github.com/apache/arrow-go/v18/arrow/memory"
+	"github.com/apache/arrow-go/v18/parquet/pqarrow"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+)
+
+func readParquet(t *testing.T, buf []byte) arrow.Table {
+	table, err := pqarrow.ReadTable(context.Background(), bytes.NewReader(buf), nil, pqarrow.ArrowReadProperties{}, memory.DefaultAllocator)
+	require.NoError(t, err)
+	t.Cleanup(table.Release)
+	return table
+}
+
+func column(t *testing.T, table arrow.Table, name string) arrow.Array {
+	indices := table.Schema().FieldIndices(name)
+	require.Len(t, indices, 1, "column %q", name)
+	chunks := table.Column(indices[0]).Data().Chunks()
+	require.Len(t, chunks, 1)
+	return chunks[0]
+}
+
+func TestParquetMarshalLogs(t *testing.T) {
+	ts := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+
+	logs := plog.NewLogs()
+	rl := logs.ResourceLogs().AppendEmpty()
+	rl.Resource().Attributes().PutStr("service.name", "checkout")
+	rl.Resource().Attributes().PutStr("host.name", "host-1")
+	sl := rl.ScopeLogs().AppendEmpty()
+	sl.Scope().SetName("scope")
+	sl.Scope().SetVersion("1.0.0")
+	lr := sl.LogRecords().AppendEmpty()
+	lr.SetTimestamp(pcommon.NewTimestampFromTime(ts))
+	lr.SetSeverityText("ERROR")
+	lr.SetSeverityNumber(plog.SeverityNumberError)
+	lr.SetTraceID([16]byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16})
+	lr.Body().SetStr("payment failed")
+	lr.Attributes().PutInt("http.status_code", 500)
+	sl.LogRecords().AppendEmpty().Body().SetEmptyMap().PutStr("key", "value")
+
+	m := parquetMarshaler{}
+	assert.Equal(t, "parquet", m.format())
+	buf, err := m.MarshalLogs(logs)
+	require.NoError(t, err)
+
+	table := readParquet(t, buf)
+	require.EqualValues(t, 2, table.NumRows())
+
+	timestamps := column(t, table, "timestamp").(*array.Timestamp)
+	assert.Equal(t, arrow.Timestamp(ts.UnixMicro()), timestamps.Value(0))
+	assert.True(t, timestamps.IsNull(1))
+
+	traceIDs := column(t, table, "trace_id").(*array.String)
+	assert.Equal(t, "0102030405060708090a0b0c0d0e0f10", traceIDs.Value(0))
+	assert.True(t, traceIDs.IsNull(1))
+
+	assert.Equal(t, "ERROR", column(t, table, "severity_text").(*array.String).Value(0))
+	assert.EqualValues(t, plog.SeverityNumberError, column(t, table, "severity_number").(*array.Int32).Value(0))
+
+	bodies := column(t, table, "body").(*array.String)
+	assert.Equal(t, "payment failed", bodies.Value(0))
+	assert.JSONEq(t, `{"key":"value"}`, bodies.Value(1))
+
+	services := column(t, table, "service_name").(*array.String)
+	assert.Equal(t, "checkout", services.Value(0))
+	assert.Equal(t, "checkout", services.Value(1))
+	assert.Equal(t, "scope", column(t, table, "scope_name").(*array.String).Value(0))
+	assert.Equal(t, "1.0.0", column(t, table, "scope_version").(*array.String).Value(0))
+
+	attributes := column(t, table, "attributes").(*array.Map)
+	start, end := attributes.ValueOffsets(0)
+	require.EqualValues(t, 1, end-start)
+	assert.Equal(t, "http.status_code", attributes.Keys().(*array.String).Value(int(start)))
+	assert.Equal(t, "500", attributes.Items().(*array.String).Value(int(start)))
+}
+
+func TestParquetMarshalMetrics(t *testing.T) {
+	metrics := pmetric.NewMetrics()
+	rm := metrics.ResourceMetrics().AppendEmpty()
+	sm := rm.ScopeMetrics().AppendEmpty()
+
+	sum := sm.Metrics().AppendEmpty()
+	sum.SetName("requests")
+	sum.SetUnit("1")
+	sum.SetEmptySum().SetIsMonotonic(true)
+	sum.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	sum.Sum().DataPoints().AppendEmpty().SetIntValue(42)
+
+	histogram := sm.Metrics().AppendEmpty()
+	histogram.SetName("latency")
+	histogram.SetEmptyHistogram().SetAggregationTemporality(pmetric.AggregationTemporalityDelta)
+	dp := histogram.Histogram().DataPoints().AppendEmpty()
+	dp.SetCount(3)
+	dp.SetSum(6)
+	dp.SetMin(1)
+	dp.SetMax(3)
+	dp.BucketCounts().FromRaw([]uint64{1, 2})
+	dp.ExplicitBounds().FromRaw([]float64{2})
+
+	summary := sm.Metrics().AppendEmpty()
+	summary.SetName("duration")
+	sdp := summary.SetEmptySummary().DataPoints().AppendEmpty()
+	sdp.SetCount(2)
+	sdp.SetSum(10)
+	q := sdp.QuantileValues().AppendEmpty()
+	q.SetQuantile(0.5)
+	q.SetValue(4)
+
+	buf, err := parquetMarshaler{}.MarshalMetrics(metrics)
+	require.NoError(t, err)
+
+	table := readParquet(t, buf)
+	require.EqualValues(t, 3, table.NumRows())
+
+	names := column(t, table, "metric_name").(*array.String)
+	types := column(t, table, "metric_type").(*array.String)
+	assert.Equal(t, []string{"requests", "latency", "duration"}, []string{names.Value(0), names.Value(1), names.Value(2)})
+	assert.Equal(t, []string{"Sum", "Histogram", "Summary"}, []string{types.Value(0), types.Value(1), types.Value(2)})
+
+	temporalities := column(t, table, "aggregation_temporality").(*array.String)
+	assert.Equal(t, "Cumulative", temporalities.Value(0))
+	assert.Equal(t, "Delta", temporalities.Value(1))
+	assert.True(t, temporalities.IsNull(2))
+
+	monotonic := column(t, table, "is_monotonic").(*array.Boolean)
+	assert.True(t, monotonic.Value(0))
+	assert.True(t, monotonic.IsNull(1))
+
+	values := column(t, table, "value").(*array.Float64)
+	assert.Equal(t, float64(42), values.Value(0))
+	assert.True(t, values.IsNull(1))
+
+	counts := column(t, table, "count").(*array.Uint64)
+	assert.True(t, counts.IsNull(0))
+	assert.EqualValues(t, 3, counts.Value(1))
+	assert.EqualValues(t, 2, counts.Value(2))
+
+	mins := column(t, table, "min").(*array.Float64)
+	assert.Equal(t, float64(1), mins.Value(1))
+	assert.True(t, mins.IsNull(2))
+
+	bucketCounts := column(t, table, "bucket_counts").(*array.List)
+	assert.True(t, bucketCounts.IsNull(0))
+	start, end := bucketCounts.ValueOffsets(1)
+	assert.Equal(t, []uint64{1, 2}, bucketCounts.ListValues().(*array.Uint64).Uint64Values()[start:end])
+
+	quantiles := column(t, table, "quantile_values").(*array.List)
+	start, end = quantiles.ValueOffsets(2)
+	assert.Equal(t, []float64{4}, quantiles.ListValues().(*array.Float64).Float64Values()[start:end])
+
+	assert.True(t, column(t, table, "service_name").IsNull(0))
+}
@@ -68,6 +68,8 @@ const (
 	maxSegmentNameLength = 200
 	// rpc.system value for AWS service remotes
 	awsAPIRPCSystem = "aws-api"
+	// w3cTraceStateKey is the metadata and link attribute key the w3c tracestate is preserved under
+	w3cTraceStateKey = "w3c.tracestate"
 )
 
 const (
@@ -201,10 +203,10 @@ func MakeServiceSegmentForLocalRootDependencySpan(span ptrace.Span, resource pco
 	serviceSegment.AWS.TableName = nil
 	serviceSegment.AWS.TableNames = nil
 
-	// Delete all metadata that does not start with 'otel.resource.'
+	// Delete all metadata that does not start with 'otel.resource.', except the w3c tracestate
 	for _, metaDataEntry := range serviceSegment.Metadata {
 		for key := range metaDataEntry {
-			if !strings.HasPrefix(key, "otel.resource.") {
+			if !strings.HasPrefix(key, "otel.resource.") && key != w3cTraceStateKey {
 				delete(metaDataEntry, key)
 			}
 		}
@@ -352,6 +354,17 @@ func MakeSegment(span ptrace.Span, resource pcommon.Resource, indexedAttrs []str
 		return nil, makeSpanLinkErr
 	}
 
+	// Preserve the w3c tracestate so that it isn't lost when the trace is propagated back to OTLP
+	if traceState := span.TraceState().AsRaw(); traceState != "" {
+		if metadata == nil {
+			metadata = map[string]map[string]any{}
+		}
+		if metadata[defaultMetadataNamespace] == nil {
+			metadata[defaultMetadataNamespace] = map[string]any{}
+		}
+		metadata[defaultMetadataNamespace][w3cTraceStateKey] = traceState
+	}
+
 	// X-Ray segment names are service names, unlike span names which are methods. Try to find a service name.
 
 	// support x-ray specific service name attributes as segment name if it exists
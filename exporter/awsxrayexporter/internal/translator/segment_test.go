@@ -118,6 +118,21 @@ func TestClientSpanWithPeerService(t *testing.T) {
 	assert.Equal(t, "cats-table", *segment.Name)
 }
 
+func TestServerSpanWithTraceState(t *testing.T) {
+	spanName := "/api/locations"
+	parentSpanID := newSegmentID()
+	attributes := make(map[string]any)
+	resource := pcommon.NewResource()
+	span := constructServerSpan(parentSpanID, spanName, ptrace.StatusCodeOk, "OK", attributes)
+	span.TraceState().FromRaw("rojo=00f067aa0ba902b7")
+
+	segment, _ := MakeSegment(span, resource, nil, false, nil, false)
+	assert.Equal(t, "rojo=00f067aa0ba902b7", segment.Metadata["default"]["w3c.tracestate"])
+
+	jsonStr, _ := MakeSegmentDocumentString(span, resource, nil, false, nil, false)
+	assert.Contains(t, jsonStr, `"w3c.tracestate":"rojo=00f067aa0ba902b7"`)
+}
+
 func TestServerSpanWithInternalServerError(t *testing.T) {
 	spanName := "/api/locations"
 	parentSpanID := newSegmentID()
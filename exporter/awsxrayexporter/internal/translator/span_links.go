@@ -25,12 +25,16 @@ func makeSpanLinks(links ptrace.SpanLinkSlice, skipTimestampValidation bool) ([]
 		spanLinkData.SpanID = &spanID
 		spanLinkData.TraceID = &traceID
 
-		if link.Attributes().Len() > 0 {
+		traceState := link.TraceState().AsRaw()
+		if link.Attributes().Len() > 0 || traceState != "" {
 			spanLinkData.Attributes = make(map[string]any)
 
 			for k, v := range link.Attributes().All() {
 				spanLinkData.Attributes[k] = v.AsRaw()
 			}
+			if traceState != "" {
+				spanLinkData.Attributes[w3cTraceStateKey] = traceState
+			}
 		}
 
 		spanLinkDataArray = append(spanLinkDataArray, spanLinkData)
@@ -228,3 +228,22 @@ func TestSpanLinkComplexAttributes(t *testing.T) {
 	assert.Contains(t, jsonStr, "2.718")
 	assert.Contains(t, jsonStr, "1.618")
 }
+
+func TestSpanLinkTraceState(t *testing.T) {
+	spanName := "ProcessingMessage"
+	parentSpanID := newSegmentID()
+	attributes := make(map[string]any)
+	resource := constructDefaultResource()
+	span := constructServerSpan(parentSpanID, spanName, ptrace.StatusCodeOk, "OK", attributes)
+
+	spanLink := span.Links().AppendEmpty()
+	spanLink.SetTraceID(newTraceID())
+	spanLink.SetSpanID(newSegmentID())
+	spanLink.TraceState().FromRaw("rojo=00f067aa0ba902b7,congo=t61rcWkgMzE")
+
+	segment, _ := MakeSegment(span, resource, nil, false, nil, false)
+
+	assert.Len(t, segment.Links, 1)
+	assert.Len(t, segment.Links[0].Attributes, 1)
+	assert.Equal(t, "rojo=00f067aa0ba902b7,congo=t61rcWkgMzE", segment.Links[0].Attributes["w3c.tracestate"])
+}
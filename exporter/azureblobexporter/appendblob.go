@@ -0,0 +1,159 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package azureblobexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/azureblobexporter"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/bloberror"
+	"go.uber.org/zap"
+)
+
+// appendBuffer holds the data waiting to be appended to a blob.
+type appendBuffer struct {
+	container string
+	name      blobName
+	data      []byte
+}
+
+// startAppending chooses the serial number of the blobs of the exporter, so that it keeps appending
+// to the same blobs until their names change, and starts flushing the buffered data periodically.
+func (e *azureBlobExporter) startAppending() {
+	e.appendSerialNum = randomInRange(0, int(e.config.BlobNameFormat.SerialNumRange))
+	e.appendBuffers = map[string]*appendBuffer{}
+	if e.config.AppendBlob.FlushBytes <= 0 {
+		return
+	}
+
+	e.stopFlush = make(chan struct{})
+	e.flushWG.Add(1)
+	go func() {
+		defer e.flushWG.Done()
+		ticker := time.NewTicker(e.config.AppendBlob.FlushInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				if err := e.flushAll(context.Background()); err != nil {
+					e.logger.Warn("Failed to flush buffered data, it will be retried", zap.Error(err))
+				}
+			case <-e.stopFlush:
+				return
+			}
+		}
+	}()
+}
+
+func (e *azureBlobExporter) shutdown(ctx context.Context) error {
+	if e.stopFlush == nil {
+		return nil
+	}
+	close(e.stopFlush)
+	e.flushWG.Wait()
+	e.stopFlush = nil
+	return e.flushAll(ctx)
+}
+
+// appendData appends the data to the blob, or buffers it until enough data is buffered for the blob.
+func (e *azureBlobExporter) appendData(ctx context.Context, container string, name blobName, data []byte) error {
+	e.appendMu.Lock()
+	defer e.appendMu.Unlock()
+
+	if e.config.AppendBlob.FlushBytes <= 0 {
+		return e.appendBlock(ctx, container, name, data)
+	}
+
+	key := container + "/" + name.base + name.ext
+	buf, ok := e.appendBuffers[key]
+	if !ok {
+		buf = &appendBuffer{container: container, name: name}
+		e.appendBuffers[key] = buf
+	}
+
+	if len(buf.data) > 0 && len(buf.data)+len(data) > maxAppendBlockBytes {
+		// The data doesn't fit in the block, append the buffered data first.
+		if err := e.appendBlock(ctx, container, name, buf.data); err != nil {
+			return err
+		}
+		e.appendBufferedBytes -= len(buf.data)
+		buf.data = nil
+	}
+
+	if e.appendBufferedBytes > 0 && e.appendBufferedBytes+len(data) > e.config.AppendBlob.MaxBufferedBytes {
+		// The data buffered for the blobs failed to be appended so far, the data of the batch is
+		// rejected rather than buffered without bound.
+		if len(buf.data) == 0 {
+			delete(e.appendBuffers, key)
+		}
+		return fmt.Errorf("failed to buffer data: %d bytes are already buffered, at most %d bytes can be buffered",
+			e.appendBufferedBytes, e.config.AppendBlob.MaxBufferedBytes)
+	}
+
+	buf.data = append(buf.data, data...)
+	e.appendBufferedBytes += len(data)
+	if len(buf.data) < e.config.AppendBlob.FlushBytes {
+		return nil
+	}
+	if err := e.appendBlock(ctx, container, name, buf.data); err != nil {
+		// The data of the batch is retried by the caller, only the data buffered previously is kept.
+		buf.data = buf.data[:len(buf.data)-len(data)]
+		e.appendBufferedBytes -= len(data)
+		if len(buf.data) == 0 {
+			delete(e.appendBuffers, key)
+		}
+		return err
+	}
+	e.appendBufferedBytes -= len(buf.data)
+	delete(e.appendBuffers, key)
+	return nil
+}
+
+// flushAll appends the data buffered for all the blobs.
+func (e *azureBlobExporter) flushAll(ctx context.Context) error {
+	e.appendMu.Lock()
+	defer e.appendMu.Unlock()
+
+	var errs error
+	for key, buf := range e.appendBuffers {
+		if err := e.appendBlock(ctx, buf.container, buf.name, buf.data); err != nil {
+			errs = errors.Join(errs, err)
+			continue
+		}
+		e.appendBufferedBytes -= len(buf.data)
+		delete(e.appendBuffers, key)
+	}
+	return errs
+}
+
+// appendBlock appends a block to the blob. When the blob has reached its maximum number of blocks,
+// the serial number of the exporter is changed to continue appending to a new blob.
+func (e *azureBlobExporter) appendBlock(ctx context.Context, container string, name blobName, data []byte) error {
+	blob := name.withSerialNum(e.appendSerialNum)
+	err := e.client.AppendBlock(ctx, container, blob, data, nil)
+	if bloberror.HasCode(err, bloberror.BlockCountExceedsLimit) {
+		previous := e.appendSerialNum
+		for e.appendSerialNum == previous && e.config.BlobNameFormat.SerialNumRange > 1 {
+			e.appendSerialNum = randomInRange(0, int(e.config.BlobNameFormat.SerialNumRange))
+		}
+		e.logger.Info("Append blob reached its maximum number of blocks, continuing with a new blob",
+			zap.String("container", container),
+			zap.String("blob", blob),
+			zap.String("new_blob", name.withSerialNum(e.appendSerialNum)))
+		blob = name.withSerialNum(e.appendSerialNum)
+		err = e.client.AppendBlock(ctx, container, blob, data, nil)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to upload data: %w", err)
+	}
+
+	e.logger.Debug("Successfully exported data to Azure Blob Storage",
+		zap.String("account", e.client.URL()),
+		zap.String("container", container),
+		zap.String("blob", blob),
+		zap.Int("size", len(data)))
+	return nil
+}
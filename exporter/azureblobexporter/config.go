@@ -5,6 +5,8 @@ package azureblobexporter // import "github.com/open-telemetry/opentelemetry-col
 
 import (
 	"errors"
+	"fmt"
+	"time"
 
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/config/configretry"
@@ -34,8 +36,23 @@ type BlobNameFormat struct {
 type AppendBlob struct {
 	Enabled   bool   `mapstructure:"enabled"`
 	Separator string `mapstructure:"separator"`
+	// FlushBytes is the size above which the data buffered for a blob is appended as a single block.
+	// When 0, the data of each batch is appended as a block as soon as it is exported.
+	FlushBytes int `mapstructure:"flush_bytes"`
+	// FlushInterval is the maximum duration data is buffered before being appended. It is only used when FlushBytes is set.
+	FlushInterval time.Duration `mapstructure:"flush_interval"`
+	// MaxBufferedBytes is the maximum size of the data buffered for all the blobs. When it is reached,
+	// the exported data is rejected until the buffered data is appended. It is only used when FlushBytes is set.
+	MaxBufferedBytes int `mapstructure:"max_buffered_bytes"`
 }
 
+const (
+	// maxAppendBlockBytes is the maximum size of a block appended to an append blob.
+	maxAppendBlockBytes = 100 << 20
+	// defaultMaxBufferedBytes allows buffering two blocks of the maximum size.
+	defaultMaxBufferedBytes = 2 * maxAppendBlockBytes
+)
+
 type Authentication struct {
 	// Type is the authentication type. supported values are connection_string, service_principal, system_managed_identity and user_managed_identity
 	Type AuthType `mapstructure:"type"`
@@ -75,7 +92,8 @@ type Config struct {
 	Container TelemetryConfig `mapstructure:"container"`
 	Auth      Authentication  `mapstructure:"auth"`
 
-	// BlobNameFormat is the format of the blob name. It controls the uploaded blob name, e.g. "2006/01/02/metrics_15_04_05.json"
+	// BlobNameFormat is the format of the blob name. It controls the uploaded blob name, e.g. "2006/01/02/metrics_15_04_05.json".
+	// The "{resource.<attribute>}" placeholders are replaced by the values of the resource attributes.
 	BlobNameFormat BlobNameFormat `mapstructure:"blob_name_format"`
 
 	// FormatType is the format of encoded telemetry data. Supported values are json and proto.
@@ -118,5 +136,15 @@ func (c *Config) Validate() error {
 		return errors.New("unknown format type: " + c.FormatType)
 	}
 
+	if c.AppendBlob.FlushBytes < 0 || c.AppendBlob.FlushBytes > maxAppendBlockBytes {
+		return fmt.Errorf("append_blob::flush_bytes must be between 0 and %d", maxAppendBlockBytes)
+	}
+	if c.AppendBlob.FlushBytes > 0 && c.AppendBlob.FlushInterval <= 0 {
+		return errors.New("append_blob::flush_interval must be positive when append_blob::flush_bytes is set")
+	}
+	if c.AppendBlob.FlushBytes > 0 && c.AppendBlob.MaxBufferedBytes < c.AppendBlob.FlushBytes {
+		return errors.New("append_blob::max_buffered_bytes must be at least append_blob::flush_bytes")
+	}
+
 	return nil
 }
@@ -6,6 +6,7 @@ package azureblobexporter
 import (
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -54,8 +55,10 @@ func TestLoadConfig(t *testing.T) {
 				Encodings:     Encodings{},
 				BackOffConfig: configretry.NewDefaultBackOffConfig(),
 				AppendBlob: AppendBlob{
-					Enabled:   false,
-					Separator: "\n",
+					Enabled:          false,
+					Separator:        "\n",
+					FlushInterval:    30 * time.Second,
+					MaxBufferedBytes: defaultMaxBufferedBytes,
 				},
 			},
 		},
@@ -82,8 +85,10 @@ func TestLoadConfig(t *testing.T) {
 				Encodings:     Encodings{},
 				BackOffConfig: configretry.NewDefaultBackOffConfig(),
 				AppendBlob: AppendBlob{
-					Enabled:   false,
-					Separator: "\n",
+					Enabled:          false,
+					Separator:        "\n",
+					FlushInterval:    30 * time.Second,
+					MaxBufferedBytes: defaultMaxBufferedBytes,
 				},
 			},
 		},
@@ -111,8 +116,10 @@ func TestLoadConfig(t *testing.T) {
 				Encodings:     Encodings{},
 				BackOffConfig: configretry.NewDefaultBackOffConfig(),
 				AppendBlob: AppendBlob{
-					Enabled:   false,
-					Separator: "\n",
+					Enabled:          false,
+					Separator:        "\n",
+					FlushInterval:    30 * time.Second,
+					MaxBufferedBytes: defaultMaxBufferedBytes,
 				},
 			},
 		},
@@ -142,8 +149,10 @@ func TestLoadConfig(t *testing.T) {
 				Encodings:     Encodings{},
 				BackOffConfig: configretry.NewDefaultBackOffConfig(),
 				AppendBlob: AppendBlob{
-					Enabled:   false,
-					Separator: "\n",
+					Enabled:          false,
+					Separator:        "\n",
+					FlushInterval:    30 * time.Second,
+					MaxBufferedBytes: defaultMaxBufferedBytes,
 				},
 			},
 		},
@@ -170,8 +179,41 @@ func TestLoadConfig(t *testing.T) {
 				Encodings:     Encodings{},
 				BackOffConfig: configretry.NewDefaultBackOffConfig(),
 				AppendBlob: AppendBlob{
-					Enabled:   false,
-					Separator: "\n",
+					Enabled:          false,
+					Separator:        "\n",
+					FlushInterval:    30 * time.Second,
+					MaxBufferedBytes: defaultMaxBufferedBytes,
+				},
+			},
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "append"),
+			expected: &Config{
+				Auth: Authentication{
+					Type:             "connection_string",
+					ConnectionString: "DefaultEndpointsProtocol=https;AccountName=fakeaccount;AccountKey=ZmFrZWtleQ==;EndpointSuffix=core.windows.net",
+				},
+				Container: TelemetryConfig{
+					Metrics: "metrics",
+					Logs:    "logs",
+					Traces:  "traces",
+				},
+				BlobNameFormat: BlobNameFormat{
+					MetricsFormat:  "2006/01/02/metrics_15_04_05.json",
+					LogsFormat:     "{resource.service.name}/2006/01/02/15/logs.json",
+					TracesFormat:   "2006/01/02/traces_15_04_05.json",
+					SerialNumRange: 10000,
+					Params:         map[string]string{},
+				},
+				FormatType:    "json",
+				Encodings:     Encodings{},
+				BackOffConfig: configretry.NewDefaultBackOffConfig(),
+				AppendBlob: AppendBlob{
+					Enabled:          true,
+					Separator:        "\n",
+					FlushBytes:       4 << 20,
+					FlushInterval:    time.Minute,
+					MaxBufferedBytes: defaultMaxBufferedBytes,
 				},
 			},
 		},
@@ -199,6 +241,14 @@ func TestLoadConfig(t *testing.T) {
 			id:           component.NewIDWithName(metadata.Type, "err6"),
 			errorMessage: "tenant_id, client_id and federated_token_file cannot be empty when auth type is workload_identity",
 		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "err7"),
+			errorMessage: "append_blob::flush_bytes must be between 0 and 104857600",
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "err8"),
+			errorMessage: "append_blob::max_buffered_bytes must be at least append_blob::flush_bytes",
+		},
 	}
 
 	for _, tt := range tests {
@@ -11,7 +11,9 @@ import (
 	"io"
 	"math/rand/v2"
 	"path/filepath"
+	"regexp"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
@@ -20,6 +22,7 @@ import (
 	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/appendblob"
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/plog"
 	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/pdata/ptrace"
@@ -33,6 +36,15 @@ type azureBlobExporter struct {
 	client     azblobClient
 	signal     pipeline.Signal
 	marshaller *marshaller
+
+	// The state of the append blob mode.
+	appendMu        sync.Mutex
+	appendSerialNum int
+	appendBuffers   map[string]*appendBuffer
+	// appendBufferedBytes is the size of the data buffered for all the blobs.
+	appendBufferedBytes int
+	stopFlush           chan struct{}
+	flushWG             sync.WaitGroup
 }
 
 type azblobClient interface {
@@ -100,6 +112,10 @@ func (e *azureBlobExporter) start(_ context.Context, host component.Host) error
 		return err
 	}
 
+	if e.config.AppendBlob.Enabled {
+		e.startAppending()
+	}
+
 	// create client based on auth type
 	authType := e.config.Auth.Type
 	azblobClient := &azblobClientImpl{}
@@ -162,76 +178,195 @@ func (e *azureBlobExporter) start(_ context.Context, host component.Host) error
 	return nil
 }
 
-func (e *azureBlobExporter) generateBlobName(signal pipeline.Signal) (string, error) {
-	// Get current time
-	now := time.Now()
+// resourceAttributePattern matches the placeholders of resource attribute values in blob name formats.
+var resourceAttributePattern = regexp.MustCompile(`\{resource\.([^{}]+)\}`)
+
+// unknownAttributeValue replaces the placeholders of the resource attributes which are not set.
+const unknownAttributeValue = "unknown"
+
+// blobName is a blob name before the serial number is added.
+type blobName struct {
+	base string
+	ext  string
+}
+
+func (n blobName) withSerialNum(serialNum int) string {
+	return fmt.Sprintf("%s_%d%s", n.base, serialNum, n.ext)
+}
 
-	var format string
+func (e *azureBlobExporter) blobNameFormat(signal pipeline.Signal) (string, error) {
 	switch signal {
 	case pipeline.SignalMetrics:
-		format = e.config.BlobNameFormat.MetricsFormat
+		return e.config.BlobNameFormat.MetricsFormat, nil
 	case pipeline.SignalLogs:
-		format = e.config.BlobNameFormat.LogsFormat
+		return e.config.BlobNameFormat.LogsFormat, nil
 	case pipeline.SignalTraces:
-		format = e.config.BlobNameFormat.TracesFormat
+		return e.config.BlobNameFormat.TracesFormat, nil
 	default:
 		return "", fmt.Errorf("unsupported signal type: %v", signal)
 	}
-	var blobName string
+}
+
+// formatBlobName formats the blob name with the given time, and replaces its placeholders
+// with the values of the resource attributes.
+func formatBlobName(format string, now time.Time, res pcommon.Resource) string {
+	var sb strings.Builder
+	last := 0
+	for _, m := range resourceAttributePattern.FindAllStringSubmatchIndex(format, -1) {
+		sb.WriteString(now.Format(format[last:m[0]]))
+		value := unknownAttributeValue
+		if v, ok := res.Attributes().Get(format[m[2]:m[3]]); ok && v.AsString() != "" {
+			// Values are not allowed to add levels to the blob name.
+			value = strings.NewReplacer("/", "_", "\\", "_").Replace(v.AsString())
+		}
+		sb.WriteString(value)
+		last = m[1]
+	}
+	sb.WriteString(now.Format(format[last:]))
+	return sb.String()
+}
+
+func (e *azureBlobExporter) newBlobName(signal pipeline.Signal, res pcommon.Resource) (blobName, error) {
+	format, err := e.blobNameFormat(signal)
+	if err != nil {
+		return blobName{}, err
+	}
+	now := time.Now()
 	if e.config.BlobNameFormat.SerialNumBeforeExtension {
 		// Append a random number and do so before the file extension if there is one
 		ext := filepath.Ext(format)
-		formatWithoutExt := strings.TrimSuffix(format, ext)
-		randInt := randomInRange(0, int(e.config.BlobNameFormat.SerialNumRange))
-		blobName = fmt.Sprintf("%s_%d%s", now.Format(formatWithoutExt), randInt, ext)
-	} else {
-		// Appends the random number after any potential file extension to minimize performance impact when high throughput
-		blobName = fmt.Sprintf("%s_%d", now.Format(format), randomInRange(0, int(e.config.BlobNameFormat.SerialNumRange)))
+		if strings.ContainsAny(ext, "{}") {
+			ext = ""
+		}
+		return blobName{base: formatBlobName(strings.TrimSuffix(format, ext), now, res), ext: ext}, nil
+	}
+	// Appends the random number after any potential file extension to minimize performance impact when high throughput
+	return blobName{base: formatBlobName(format, now, res)}, nil
+}
+
+func (e *azureBlobExporter) generateBlobName(signal pipeline.Signal, res pcommon.Resource) (string, error) {
+	name, err := e.newBlobName(signal, res)
+	if err != nil {
+		return "", err
 	}
-	return blobName, nil
+	return name.withSerialNum(randomInRange(0, int(e.config.BlobNameFormat.SerialNumRange))), nil
+}
+
+// hasResourceAttributes returns whether the blob name format of the signal depends on resource attributes.
+func (e *azureBlobExporter) hasResourceAttributes(signal pipeline.Signal) bool {
+	format, err := e.blobNameFormat(signal)
+	return err == nil && resourceAttributePattern.MatchString(format)
 }
 
 func (*azureBlobExporter) Capabilities() consumer.Capabilities {
 	return consumer.Capabilities{MutatesData: false}
 }
 
+// resourceSlice is the slice of the resources of the data of a signal, e.g. pmetric.ResourceMetricsSlice.
+type resourceSlice[R any] interface {
+	Len() int
+	At(int) R
+	AppendEmpty() R
+}
+
+// resourceData is the data of a resource, e.g. pmetric.ResourceMetrics.
+type resourceData[R any] interface {
+	Resource() pcommon.Resource
+	CopyTo(R)
+}
+
+// consumeByResource consumes the data at once when the blob names of the signal don't depend on
+// the resources. Otherwise, the data is split by blob name, and each batch is consumed with the
+// resource its blob name is formatted with.
+func consumeByResource[T any, S resourceSlice[R], R resourceData[R]](
+	ctx context.Context,
+	e *azureBlobExporter,
+	signal pipeline.Signal,
+	data T,
+	newData func() T,
+	resources func(T) S,
+	consume func(context.Context, T, pcommon.Resource) error,
+) error {
+	if !e.hasResourceAttributes(signal) {
+		return consume(ctx, data, pcommon.NewResource())
+	}
+
+	names, batches := []string{}, map[string]T{}
+	for i := 0; i < resources(data).Len(); i++ {
+		rd := resources(data).At(i)
+		name := e.resourceKey(signal, rd.Resource())
+		batch, ok := batches[name]
+		if !ok {
+			batch = newData()
+			batches[name] = batch
+			names = append(names, name)
+		}
+		rd.CopyTo(resources(batch).AppendEmpty())
+	}
+	var errs error
+	for _, name := range names {
+		batch := batches[name]
+		errs = errors.Join(errs, consume(ctx, batch, resources(batch).At(0).Resource()))
+	}
+	return errs
+}
+
 func (e *azureBlobExporter) ConsumeMetrics(ctx context.Context, md pmetric.Metrics) error {
+	return consumeByResource(ctx, e, pipeline.SignalMetrics, md, pmetric.NewMetrics, pmetric.Metrics.ResourceMetrics, e.consumeMetrics)
+}
+
+func (e *azureBlobExporter) consumeMetrics(ctx context.Context, md pmetric.Metrics, res pcommon.Resource) error {
 	// Marshal the metrics data
 	data, err := e.marshaller.marshalMetrics(md)
 	if err != nil {
 		return fmt.Errorf("failed to marshal metrics: %w", err)
 	}
 
-	return e.consumeData(ctx, data, pipeline.SignalMetrics)
+	return e.consumeData(ctx, data, pipeline.SignalMetrics, res)
 }
 
 func (e *azureBlobExporter) ConsumeLogs(ctx context.Context, ld plog.Logs) error {
+	return consumeByResource(ctx, e, pipeline.SignalLogs, ld, plog.NewLogs, plog.Logs.ResourceLogs, e.consumeLogs)
+}
+
+func (e *azureBlobExporter) consumeLogs(ctx context.Context, ld plog.Logs, res pcommon.Resource) error {
 	// Marshal the logs data
 	data, err := e.marshaller.marshalLogs(ld)
 	if err != nil {
 		return fmt.Errorf("failed to marshal logs: %w", err)
 	}
 
-	return e.consumeData(ctx, data, pipeline.SignalLogs)
+	return e.consumeData(ctx, data, pipeline.SignalLogs, res)
 }
 
 func (e *azureBlobExporter) ConsumeTraces(ctx context.Context, td ptrace.Traces) error {
+	return consumeByResource(ctx, e, pipeline.SignalTraces, td, ptrace.NewTraces, ptrace.Traces.ResourceSpans, e.consumeTraces)
+}
+
+func (e *azureBlobExporter) consumeTraces(ctx context.Context, td ptrace.Traces, res pcommon.Resource) error {
 	// Marshal the trace data
 	data, err := e.marshaller.marshalTraces(td)
 	if err != nil {
 		return fmt.Errorf("failed to marshal traces: %w", err)
 	}
 
-	return e.consumeData(ctx, data, pipeline.SignalTraces)
+	return e.consumeData(ctx, data, pipeline.SignalTraces, res)
 }
 
-func (e *azureBlobExporter) consumeData(ctx context.Context, data []byte, signal pipeline.Signal) error {
-	// Generate a unique blob name
-	blobName, err := e.generateBlobName(signal)
-	if err != nil {
-		return fmt.Errorf("failed to generate blobname: %w", err)
+// resourceKey returns the values of the resource attributes used in the blob name format of the signal.
+func (e *azureBlobExporter) resourceKey(signal pipeline.Signal, res pcommon.Resource) string {
+	format, _ := e.blobNameFormat(signal)
+	var sb strings.Builder
+	for _, m := range resourceAttributePattern.FindAllStringSubmatch(format, -1) {
+		if v, ok := res.Attributes().Get(m[1]); ok {
+			sb.WriteString(v.AsString())
+		}
+		sb.WriteByte(0)
 	}
+	return sb.String()
+}
 
+func (e *azureBlobExporter) consumeData(ctx context.Context, data []byte, signal pipeline.Signal, res pcommon.Resource) error {
 	var containerName string
 	switch signal {
 	case pipeline.SignalMetrics:
@@ -245,16 +380,25 @@ func (e *azureBlobExporter) consumeData(ctx context.Context, data []byte, signal
 	}
 
 	if e.config.AppendBlob.Enabled {
+		name, err := e.newBlobName(signal, res)
+		if err != nil {
+			return fmt.Errorf("failed to generate blobname: %w", err)
+		}
 		// Add separator if configured
 		if e.config.AppendBlob.Separator != "" {
 			data = append(data, []byte(e.config.AppendBlob.Separator)...)
 		}
-		err = e.client.AppendBlock(ctx, containerName, blobName, data, nil)
-	} else {
-		blobContentReader := bytes.NewReader(data)
-		_, err = e.client.UploadStream(ctx, containerName, blobName, blobContentReader, nil)
+		return e.appendData(ctx, containerName, name, data)
+	}
+
+	// Generate a unique blob name
+	blobName, err := e.generateBlobName(signal, res)
+	if err != nil {
+		return fmt.Errorf("failed to generate blobname: %w", err)
 	}
 
+	blobContentReader := bytes.NewReader(data)
+	_, err = e.client.UploadStream(ctx, containerName, blobName, blobContentReader, nil)
 	if err != nil {
 		return fmt.Errorf("failed to upload data: %w", err)
 	}
@@ -12,14 +12,18 @@ import (
 	"testing"
 	"time"
 
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
 	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob"
 	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/appendblob"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/bloberror"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"github.com/tj/assert"
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/component/componenttest"
 	"go.opentelemetry.io/collector/confmap/confmaptest"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
 	"go.opentelemetry.io/collector/pipeline"
 	"go.uber.org/zap/zaptest"
 
@@ -150,15 +154,15 @@ func TestGenerateBlobName(t *testing.T) {
 	ae := newAzureBlobExporter(c, zaptest.NewLogger(t), pipeline.SignalMetrics)
 
 	now := time.Now()
-	metricsBlobName, err := ae.generateBlobName(pipeline.SignalMetrics)
+	metricsBlobName, err := ae.generateBlobName(pipeline.SignalMetrics, pcommon.NewResource())
 	assert.NoError(t, err)
 	assert.True(t, strings.HasPrefix(metricsBlobName, now.Format(c.BlobNameFormat.MetricsFormat)))
 
-	logsBlobName, err := ae.generateBlobName(pipeline.SignalLogs)
+	logsBlobName, err := ae.generateBlobName(pipeline.SignalLogs, pcommon.NewResource())
 	assert.NoError(t, err)
 	assert.True(t, strings.HasPrefix(logsBlobName, now.Format(c.BlobNameFormat.LogsFormat)))
 
-	tracesBlobName, err := ae.generateBlobName(pipeline.SignalTraces)
+	tracesBlobName, err := ae.generateBlobName(pipeline.SignalTraces, pcommon.NewResource())
 	assert.NoError(t, err)
 	assert.True(t, strings.HasPrefix(tracesBlobName, now.Format(c.BlobNameFormat.TracesFormat)))
 }
@@ -187,15 +191,15 @@ func TestGenerateBlobNameSerialNumBefore(t *testing.T) {
 	}
 
 	now := time.Now()
-	metricsBlobName, err := ae.generateBlobName(pipeline.SignalMetrics)
+	metricsBlobName, err := ae.generateBlobName(pipeline.SignalMetrics, pcommon.NewResource())
 	assert.NoError(t, err)
 	assertFormat(metricsBlobName, now.Format(c.BlobNameFormat.MetricsFormat))
 
-	logsBlobName, err := ae.generateBlobName(pipeline.SignalLogs)
+	logsBlobName, err := ae.generateBlobName(pipeline.SignalLogs, pcommon.NewResource())
 	assert.NoError(t, err)
 	assertFormat(logsBlobName, now.Format(c.BlobNameFormat.LogsFormat))
 
-	tracesBlobName, err := ae.generateBlobName(pipeline.SignalTraces)
+	tracesBlobName, err := ae.generateBlobName(pipeline.SignalTraces, pcommon.NewResource())
 	assert.NoError(t, err)
 	assertFormat(tracesBlobName, now.Format(c.BlobNameFormat.TracesFormat))
 }
@@ -318,3 +322,201 @@ func TestExporterAppendBlobError(t *testing.T) {
 	assert.Contains(t, err.Error(), "failed to upload data: append error")
 	mockClient.AssertExpectations(t)
 }
+
+func TestFormatBlobName(t *testing.T) {
+	now := time.Date(2024, 5, 1, 10, 30, 0, 0, time.UTC)
+	res := pcommon.NewResource()
+	res.Attributes().PutStr("service.name", "checkout/v2")
+	res.Attributes().PutStr("k8s.pod.name", "pod-12345")
+
+	assert.Equal(t,
+		"checkout_v2/2024/05/01/10/unknown/logs_pod-12345.json",
+		formatBlobName("{resource.service.name}/2006/01/02/15/{resource.missing}/logs_{resource.k8s.pod.name}.json", now, res))
+	assert.Equal(t, "2024/05/01/logs_10_30_00.json", formatBlobName("2006/01/02/logs_15_04_05.json", now, res))
+}
+
+func newAppendBlobTestExporter(t *testing.T, appendBlob AppendBlob, logsFormat string) *azureBlobExporter {
+	c := &Config{
+		Auth: Authentication{
+			Type:             ConnectionString,
+			ConnectionString: "DefaultEndpointsProtocol=https;AccountName=fakeaccount;AccountKey=ZmFrZWtleQ==;EndpointSuffix=core.windows.net",
+		},
+		Container: TelemetryConfig{
+			Logs: "logs",
+		},
+		BlobNameFormat: BlobNameFormat{
+			LogsFormat:     logsFormat,
+			SerialNumRange: 10000,
+		},
+		FormatType: formatTypeJSON,
+		AppendBlob: appendBlob,
+	}
+	ae := newAzureBlobExporter(c, zaptest.NewLogger(t), pipeline.SignalLogs)
+	require.NoError(t, ae.start(context.Background(), componenttest.NewNopHost()))
+	t.Cleanup(func() {
+		assert.NoError(t, ae.shutdown(context.Background()))
+	})
+	return ae
+}
+
+func TestExporterAppendBlobSameBlob(t *testing.T) {
+	ae := newAppendBlobTestExporter(t, AppendBlob{Enabled: true, Separator: "\n"}, "2006/01/02/logs.json")
+
+	var blobs []string
+	mockClient := &mockAzBlobClient{url: "http://mock"}
+	mockClient.On("AppendBlock", mock.Anything, "logs", mock.Anything, mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		blobs = append(blobs, args.String(2))
+	}).Return(nil)
+	ae.client = mockClient
+
+	logs := testdata.GenerateLogsTwoLogRecordsSameResource()
+	require.NoError(t, ae.ConsumeLogs(context.Background(), logs))
+	require.NoError(t, ae.ConsumeLogs(context.Background(), logs))
+
+	// The exporter keeps appending to the same blob.
+	require.Len(t, blobs, 2)
+	assert.Equal(t, blobs[0], blobs[1])
+}
+
+func TestExporterAppendBlobFlushBytes(t *testing.T) {
+	logs := testdata.GenerateLogsTwoLogRecordsSameResource()
+	data, err := (&plog.JSONMarshaler{}).MarshalLogs(logs)
+	require.NoError(t, err)
+	batchSize := len(data) + 1
+
+	ae := newAppendBlobTestExporter(t, AppendBlob{
+		Enabled:          true,
+		Separator:        "\n",
+		FlushBytes:       2*batchSize + 1,
+		FlushInterval:    time.Hour,
+		MaxBufferedBytes: defaultMaxBufferedBytes,
+	}, "2006/01/02/logs.json")
+
+	var blocks [][]byte
+	mockClient := &mockAzBlobClient{url: "http://mock"}
+	mockClient.On("AppendBlock", mock.Anything, "logs", mock.Anything, mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		blocks = append(blocks, args.Get(3).([]byte))
+	}).Return(nil)
+	ae.client = mockClient
+
+	require.NoError(t, ae.ConsumeLogs(context.Background(), logs))
+	require.NoError(t, ae.ConsumeLogs(context.Background(), logs))
+	assert.Empty(t, blocks)
+
+	// The third batch reaches the threshold, the three batches are appended as a single block.
+	require.NoError(t, ae.ConsumeLogs(context.Background(), logs))
+	require.Len(t, blocks, 1)
+	assert.Len(t, blocks[0], 3*batchSize)
+
+	// The remaining data is appended on shutdown.
+	require.NoError(t, ae.ConsumeLogs(context.Background(), logs))
+	require.NoError(t, ae.shutdown(context.Background()))
+	require.Len(t, blocks, 2)
+	assert.Len(t, blocks[1], batchSize)
+}
+
+func TestExporterAppendBlobFlushError(t *testing.T) {
+	logs := testdata.GenerateLogsTwoLogRecordsSameResource()
+	ae := newAppendBlobTestExporter(t, AppendBlob{
+		Enabled:       true,
+		FlushBytes:    1,
+		FlushInterval: time.Hour,
+	}, "2006/01/02/logs.json")
+
+	mockClient := &mockAzBlobClient{url: "http://mock"}
+	mockClient.On("AppendBlock", mock.Anything, "logs", mock.Anything, mock.Anything, mock.Anything).Return(errors.New("append error"))
+	ae.client = mockClient
+
+	// The data of the failed batch is not kept, as it is retried by the caller.
+	err := ae.ConsumeLogs(context.Background(), logs)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "append error")
+	assert.Empty(t, ae.appendBuffers)
+}
+
+func TestExporterAppendBlobMaxBufferedBytes(t *testing.T) {
+	logs := testdata.GenerateLogsTwoLogRecordsSameResource()
+	data, err := (&plog.JSONMarshaler{}).MarshalLogs(logs)
+	require.NoError(t, err)
+	batchSize := len(data) + 1
+
+	ae := newAppendBlobTestExporter(t, AppendBlob{
+		Enabled:          true,
+		Separator:        "\n",
+		FlushBytes:       10 * batchSize,
+		FlushInterval:    time.Hour,
+		MaxBufferedBytes: 2 * batchSize,
+	}, "2006/01/02/logs.json")
+
+	var blocks [][]byte
+	mockClient := &mockAzBlobClient{url: "http://mock"}
+	mockClient.On("AppendBlock", mock.Anything, "logs", mock.Anything, mock.Anything, mock.Anything).Return(errors.New("append error")).Once()
+	mockClient.On("AppendBlock", mock.Anything, "logs", mock.Anything, mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		blocks = append(blocks, args.Get(3).([]byte))
+	}).Return(nil)
+	ae.client = mockClient
+
+	require.NoError(t, ae.ConsumeLogs(context.Background(), logs))
+	require.NoError(t, ae.ConsumeLogs(context.Background(), logs))
+	require.ErrorContains(t, ae.flushAll(context.Background()), "append error")
+
+	// The buffered data failed to be appended and the buffer is full, the batch is rejected.
+	err = ae.ConsumeLogs(context.Background(), logs)
+	assert.ErrorContains(t, err, "failed to buffer data")
+	assert.Equal(t, 2*batchSize, ae.appendBufferedBytes)
+
+	// Once the buffered data is appended, the batch is accepted again.
+	require.NoError(t, ae.flushAll(context.Background()))
+	require.Len(t, blocks, 1)
+	assert.Len(t, blocks[0], 2*batchSize)
+	require.NoError(t, ae.ConsumeLogs(context.Background(), logs))
+	assert.Equal(t, batchSize, ae.appendBufferedBytes)
+}
+
+func TestExporterAppendBlobBlockCountExceeded(t *testing.T) {
+	ae := newAppendBlobTestExporter(t, AppendBlob{Enabled: true}, "2006/01/02/logs.json")
+
+	var blobs []string
+	mockClient := &mockAzBlobClient{url: "http://mock"}
+	mockClient.On("AppendBlock", mock.Anything, "logs", mock.Anything, mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		blobs = append(blobs, args.String(2))
+	}).Return(&azcore.ResponseError{ErrorCode: string(bloberror.BlockCountExceedsLimit)}).Once()
+	mockClient.On("AppendBlock", mock.Anything, "logs", mock.Anything, mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		blobs = append(blobs, args.String(2))
+	}).Return(nil)
+	ae.client = mockClient
+
+	require.NoError(t, ae.ConsumeLogs(context.Background(), testdata.GenerateLogsTwoLogRecordsSameResource()))
+	require.Len(t, blobs, 2)
+	assert.NotEqual(t, blobs[0], blobs[1])
+}
+
+func TestExporterSplitByResourceAttributes(t *testing.T) {
+	c := createDefaultConfig().(*Config)
+	c.Auth.ConnectionString = "DefaultEndpointsProtocol=https;AccountName=fakeaccount;AccountKey=ZmFrZWtleQ==;EndpointSuffix=core.windows.net"
+	c.BlobNameFormat.LogsFormat = "{resource.service.name}/logs.json"
+	ae := newAzureBlobExporter(c, zaptest.NewLogger(t), pipeline.SignalLogs)
+	require.NoError(t, ae.start(context.Background(), componenttest.NewNopHost()))
+
+	blobs := map[string]int{}
+	mockClient := &mockAzBlobClient{url: "http://mock"}
+	mockClient.On("UploadStream", mock.Anything, "logs", mock.Anything, mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		data, err := io.ReadAll(args.Get(3).(io.Reader))
+		require.NoError(t, err)
+		logs, err := (&plog.JSONUnmarshaler{}).UnmarshalLogs(data)
+		require.NoError(t, err)
+		blobs[strings.Split(args.String(2), "/")[0]] += logs.LogRecordCount()
+	}).Return(azblob.UploadStreamResponse{}, nil)
+	ae.client = mockClient
+
+	logs := plog.NewLogs()
+	for _, service := range []string{"checkout", "cart", "checkout"} {
+		rl := logs.ResourceLogs().AppendEmpty()
+		rl.Resource().Attributes().PutStr("service.name", service)
+		rl.ScopeLogs().AppendEmpty().LogRecords().AppendEmpty().Body().SetStr("log")
+	}
+	logs.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords().AppendEmpty()
+
+	require.NoError(t, ae.ConsumeLogs(context.Background(), logs))
+	assert.Equal(t, map[string]int{"checkout": 2, "cart": 1, "unknown": 1}, blobs)
+}
@@ -5,6 +5,7 @@ package azureblobexporter // import "github.com/open-telemetry/opentelemetry-col
 
 import (
 	"context"
+	"time"
 
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/config/configretry"
@@ -51,8 +52,10 @@ func createDefaultConfig() component.Config {
 		},
 		FormatType: formatTypeJSON,
 		AppendBlob: AppendBlob{
-			Enabled:   false,
-			Separator: "\n",
+			Enabled:          false,
+			Separator:        "\n",
+			FlushInterval:    30 * time.Second,
+			MaxBufferedBytes: defaultMaxBufferedBytes,
 		},
 		Encodings:     Encodings{},
 		BackOffConfig: configretry.NewDefaultBackOffConfig(),
@@ -70,6 +73,7 @@ func createLogsExporter(ctx context.Context,
 		config,
 		azBlobExporter.ConsumeLogs,
 		exporterhelper.WithStart(azBlobExporter.start),
+		exporterhelper.WithShutdown(azBlobExporter.shutdown),
 		exporterhelper.WithRetry(cfg.BackOffConfig))
 }
 
@@ -84,6 +88,7 @@ func createMetricsExporter(ctx context.Context,
 		config,
 		azBlobExporter.ConsumeMetrics,
 		exporterhelper.WithStart(azBlobExporter.start),
+		exporterhelper.WithShutdown(azBlobExporter.shutdown),
 		exporterhelper.WithRetry(cfg.BackOffConfig))
 }
 
@@ -99,5 +104,6 @@ func createTracesExporter(ctx context.Context,
 		config,
 		azBlobExporter.ConsumeTraces,
 		exporterhelper.WithStart(azBlobExporter.start),
+		exporterhelper.WithShutdown(azBlobExporter.shutdown),
 		exporterhelper.WithRetry(cfg.BackOffConfig))
 }
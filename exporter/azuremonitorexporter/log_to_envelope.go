@@ -24,6 +24,9 @@ func (*logPacker) initEnvelope(logRecord plog.LogRecord) (*contracts.Envelope, *
 	envelope := contracts.NewEnvelope()
 	envelope.Tags = make(map[string]string)
 	envelope.Time = toTime(timestampFromLogRecord(logRecord)).Format(time.RFC3339Nano)
+	if rate, ok := sampleRateFromAttributes(logRecord.Attributes()); ok {
+		envelope.SampleRate = rate
+	}
 	return envelope, contracts.NewData()
 }
 
@@ -0,0 +1,84 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package azuremonitorexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/azuremonitorexporter"
+
+import (
+	"strconv"
+	"strings"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+)
+
+/*
+	This file extracts the sampling probability of the telemetry, which Application Insights
+	expects as the sample rate of the envelopes to compute the itemCount of the sampled items.
+*/
+
+const (
+	// attributeMicrosoftSampleRate is the sample rate, in percent, set by the Azure Monitor distros
+	attributeMicrosoftSampleRate string = "microsoft.sample_rate"
+
+	// The OpenTelemetry entry of the W3C tracestate and its rejection threshold sub-key
+	// https://opentelemetry.io/docs/specs/otel/trace/tracestate-probability-sampling/
+	traceStateOTelKey      = "ot"
+	traceStateThresholdKey = "th"
+
+	// thresholdDigits is the number of hexadecimal digits of a full rejection threshold
+	thresholdDigits = 14
+	// maxThreshold is the number of distinct randomness values, 2^56
+	maxThreshold = float64(1 << 56)
+
+	fullSampleRate = 100.0
+)
+
+// sampleRateFromAttributes returns the sample rate of the microsoft.sample_rate attribute
+func sampleRateFromAttributes(attributes pcommon.Map) (float64, bool) {
+	val, ok := attributes.Get(attributeMicrosoftSampleRate)
+	if !ok {
+		return 0, false
+	}
+	var rate float64
+	switch val.Type() {
+	case pcommon.ValueTypeDouble:
+		rate = val.Double()
+	case pcommon.ValueTypeInt:
+		rate = float64(val.Int())
+	case pcommon.ValueTypeStr:
+		parsed, err := strconv.ParseFloat(val.Str(), 64)
+		if err != nil {
+			return 0, false
+		}
+		rate = parsed
+	default:
+		return 0, false
+	}
+	if rate <= 0 || rate > fullSampleRate {
+		return 0, false
+	}
+	return rate, true
+}
+
+// sampleRateFromTraceState returns the sample rate of the rejection threshold of the OpenTelemetry
+// entry of the W3C tracestate, such as `ot=th:c` for a 25% sampling probability
+func sampleRateFromTraceState(traceState string) (float64, bool) {
+	for _, member := range strings.Split(traceState, ",") {
+		key, value, ok := strings.Cut(strings.TrimSpace(member), "=")
+		if !ok || key != traceStateOTelKey {
+			continue
+		}
+		for _, field := range strings.Split(value, ";") {
+			subKey, threshold, ok := strings.Cut(field, ":")
+			if !ok || subKey != traceStateThresholdKey || threshold == "" || len(threshold) > thresholdDigits {
+				continue
+			}
+			// the threshold is the prefix of the 14 hex digits value, the trailing zeros being omitted
+			rejected, err := strconv.ParseUint(threshold+strings.Repeat("0", thresholdDigits-len(threshold)), 16, 64)
+			if err != nil {
+				return 0, false
+			}
+			return fullSampleRate * (1 - float64(rejected)/maxThreshold), true
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,65 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package azuremonitorexporter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+)
+
+func TestSampleRateFromAttributes(t *testing.T) {
+	tests := []struct {
+		name  string
+		value any
+		rate  float64
+		ok    bool
+	}{
+		{name: "double", value: 25.0, rate: 25, ok: true},
+		{name: "int", value: 10, rate: 10, ok: true},
+		{name: "string", value: "50", rate: 50, ok: true},
+		{name: "invalid string", value: "half", ok: false},
+		{name: "zero", value: 0, ok: false},
+		{name: "above 100", value: 200, ok: false},
+		{name: "bool", value: true, ok: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			attributes := pcommon.NewMap()
+			assert.NoError(t, attributes.FromRaw(map[string]any{attributeMicrosoftSampleRate: tt.value}))
+			rate, ok := sampleRateFromAttributes(attributes)
+			assert.Equal(t, tt.ok, ok)
+			assert.Equal(t, tt.rate, rate)
+		})
+	}
+
+	_, ok := sampleRateFromAttributes(pcommon.NewMap())
+	assert.False(t, ok)
+}
+
+func TestSampleRateFromTraceState(t *testing.T) {
+	tests := []struct {
+		name       string
+		traceState string
+		rate       float64
+		ok         bool
+	}{
+		{name: "always sampled", traceState: "ot=th:0", rate: 100, ok: true},
+		{name: "half", traceState: "ot=th:8", rate: 50, ok: true},
+		{name: "quarter", traceState: "ot=th:c", rate: 25, ok: true},
+		{name: "with randomness and other vendors", traceState: "vendor=value,ot=rv:abcdef01234567;th:c", rate: 25, ok: true},
+		{name: "no threshold", traceState: "ot=rv:abcdef01234567", ok: false},
+		{name: "no ot entry", traceState: "vendor=th:8", ok: false},
+		{name: "invalid threshold", traceState: "ot=th:xyz", ok: false},
+		{name: "empty", traceState: "", ok: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rate, ok := sampleRateFromTraceState(tt.traceState)
+			assert.Equal(t, tt.ok, ok)
+			assert.InDelta(t, tt.rate, rate, 1e-9)
+		})
+	}
+}
@@ -78,6 +78,7 @@ func spanToEnvelopes(
 
 	// First map the span itself
 	envelope := newEnvelope(span, toTime(span.StartTimestamp()).Format(time.RFC3339Nano))
+	envelope.SampleRate = spanSampleRate(span)
 
 	data := contracts.NewData()
 
@@ -143,19 +144,32 @@ func spanToEnvelopes(
 
 		spanEventEnvelope := newEnvelope(span, toTime(spanEvent.Timestamp()).Format(time.RFC3339Nano))
 		spanEventEnvelope.Tags[contracts.OperationParentId] = traceutil.SpanIDToHexOrEmptyString(span.SpanID())
+		// The events are correlated to the operation of their span, and sampled along with it
+		if operationName, ok := envelope.Tags[contracts.OperationName]; ok {
+			spanEventEnvelope.Tags[contracts.OperationName] = operationName
+		}
+		spanEventEnvelope.SampleRate = envelope.SampleRate
 
 		data := contracts.NewData()
 
 		// Exceptions are a special case of span event.
 		// See https://opentelemetry.io/docs/reference/specification/trace/semantic_conventions/exceptions/#recording-an-exception
-		if spanEvent.Name() == exceptionSpanEventName {
+		switch {
+		case spanEvent.Name() == exceptionSpanEventName:
 			exceptionData := spanEventToExceptionData(spanEvent)
 			dataSanitizeFunc = exceptionData.Sanitize
 			dataProperties = exceptionData.Properties
 			data.BaseData = exceptionData
 			data.BaseType = exceptionData.BaseType()
 			spanEventEnvelope.Name = exceptionData.EnvelopeName("")
-		} else {
+		case isEventData(spanEvent.Attributes()):
+			eventData := spanEventToEventData(spanEvent)
+			dataSanitizeFunc = eventData.Sanitize
+			dataProperties = eventData.Properties
+			data.BaseData = eventData
+			data.BaseType = eventData.BaseType()
+			spanEventEnvelope.Name = eventData.EnvelopeName("")
+		default:
 			messageData := spanEventToMessageData(spanEvent)
 			dataSanitizeFunc = messageData.Sanitize
 			dataProperties = messageData.Properties
@@ -169,7 +183,7 @@ func spanToEnvelopes(
 		applyResourcesToDataProperties(dataProperties, resourceAttributes)
 		applyInstrumentationScopeValueToDataProperties(dataProperties, instrumentationScope)
 		applyCloudTagsToEnvelope(spanEventEnvelope, resourceAttributes)
-		applyInternalSdkVersionTagToEnvelope(envelope)
+		applyInternalSdkVersionTagToEnvelope(spanEventEnvelope)
 
 		// Sanitize the base data, the envelope and envelope tags
 		sanitize(dataSanitizeFunc, logger)
@@ -206,6 +220,18 @@ func applyLinksToDataProperties(dataProperties map[string]string, spanLinkSlice
 	}
 }
 
+// spanSampleRate returns the sample rate of the span from the microsoft.sample_rate attribute,
+// or else from the sampling threshold of its W3C tracestate
+func spanSampleRate(span ptrace.Span) float64 {
+	if rate, ok := sampleRateFromAttributes(span.Attributes()); ok {
+		return rate
+	}
+	if rate, ok := sampleRateFromTraceState(span.TraceState().AsRaw()); ok {
+		return rate
+	}
+	return fullSampleRate
+}
+
 // Creates a new envelope with some basic tags populated
 func newEnvelope(span ptrace.Span, time string) *contracts.Envelope {
 	envelope := contracts.NewEnvelope()
@@ -287,6 +313,20 @@ func spanEventToExceptionData(spanEvent ptrace.SpanEvent) *contracts.ExceptionDa
 	return data
 }
 
+// Maps SpanEvent with the microsoft.custom_event.name attribute to AppInsights EventData
+func spanEventToEventData(spanEvent ptrace.SpanEvent) *contracts.EventData {
+	data := contracts.NewEventData()
+	data.Name = spanEvent.Name()
+	if val, ok := spanEvent.Attributes().Get(attributeMicrosoftCustomEventName); ok {
+		data.Name = val.AsString()
+	} else if val, ok := spanEvent.Attributes().Get(attributeApplicationInsightsEventMarkerAttribute); ok {
+		data.Name = val.AsString()
+	}
+	data.Properties = make(map[string]string)
+	copyAttributesWithoutMapping(spanEvent.Attributes(), data.Properties)
+	return data
+}
+
 // Maps SpanEvent to AppInsights MessageData
 func spanEventToMessageData(spanEvent ptrace.SpanEvent) *contracts.MessageData {
 	data := contracts.NewMessageData()
@@ -539,6 +539,46 @@ func TestSpanWithEventsToEnvelopes(t *testing.T) {
 	assert.Equal(t, exceptionStackTrace, exceptionDetails.Stack)
 }
 
+func TestSpanWithCustomEventToEnvelopes(t *testing.T) {
+	span := getDefaultHTTPServerSpan()
+	span.TraceState().FromRaw("ot=th:c")
+
+	customEvent := getSpanEvent("checkout", map[string]any{
+		attributeMicrosoftCustomEventName: "CheckoutCompleted",
+		"cart.items":                      3,
+	})
+	customEvent.CopyTo(span.Events().AppendEmpty())
+
+	envelopes, _ := spanToEnvelopes(defaultResource, defaultInstrumentationLibrary, span, true, zap.NewNop())
+	assert.Len(t, envelopes, 2)
+
+	requestEnvelope := envelopes[0]
+	assert.Equal(t, 25.0, requestEnvelope.SampleRate)
+
+	eventEnvelope := envelopes[1]
+	assert.Equal(t, "Microsoft.ApplicationInsights.Event", eventEnvelope.Name)
+	assert.Equal(t, 25.0, eventEnvelope.SampleRate)
+	assert.Equal(t, defaultTraceIDAsHex, eventEnvelope.Tags[contracts.OperationId])
+	assert.Equal(t, defaultSpanIDAsHex, eventEnvelope.Tags[contracts.OperationParentId])
+	assert.Equal(t, requestEnvelope.Tags[contracts.OperationName], eventEnvelope.Tags[contracts.OperationName])
+	assert.NotEmpty(t, eventEnvelope.Tags[contracts.InternalSdkVersion])
+
+	eventData := eventEnvelope.Data.(*contracts.Data).BaseData.(*contracts.EventData)
+	assert.Equal(t, "CheckoutCompleted", eventData.Name)
+	assert.Equal(t, "3", eventData.Properties["cart.items"])
+}
+
+func TestSpanSampleRate(t *testing.T) {
+	span := getDefaultRPCClientSpan()
+	assert.Equal(t, 100.0, spanSampleRate(span))
+
+	span.TraceState().FromRaw("ot=th:8")
+	assert.Equal(t, 50.0, spanSampleRate(span))
+
+	span.Attributes().PutDouble(attributeMicrosoftSampleRate, 10)
+	assert.Equal(t, 10.0, spanSampleRate(span), "Must prefer the microsoft.sample_rate attribute")
+}
+
 func TestSanitize(t *testing.T) {
 	sanitizeFunc := func() []string {
 		warnings := [4]string{
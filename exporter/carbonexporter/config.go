@@ -15,6 +15,13 @@ import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/resourcetotelemetry"
 )
 
+const (
+	// protocolLine is the Carbon plaintext, aka "line", protocol.
+	protocolLine = "line"
+	// protocolPickle is the Carbon pickle protocol.
+	protocolPickle = "pickle"
+)
+
 // Config defines configuration for Carbon exporter.
 type Config struct {
 	// Specifies the connection endpoint config. The default value is "localhost:2003".
@@ -23,6 +30,11 @@ type Config struct {
 	// If `sending_queue` is enabled, it is recommended to use same value as `sending_queue::num_consumers`.
 	MaxIdleConns int `mapstructure:"max_idle_conns"`
 
+	// Protocol is the Carbon protocol used to send the data, either "line"
+	// (the default) for the plaintext protocol or "pickle" for the pickle
+	// protocol. Carbon listens for the pickle protocol on port 2004 by default.
+	Protocol string `mapstructure:"protocol"`
+
 	// Timeout is the maximum duration allowed to connecting and sending the
 	// data to the Carbon/Graphite backend. The default value is 5s.
 	TimeoutSettings exporterhelper.TimeoutConfig    `mapstructure:",squash"` // squash ensures fields are correctly decoded in embedded struct.
@@ -31,6 +43,28 @@ type Config struct {
 
 	// ResourceToTelemetrySettings defines configuration for converting resource attributes to metric labels.
 	ResourceToTelemetryConfig resourcetotelemetry.Settings `mapstructure:"resource_to_telemetry_conversion"`
+
+	// Tags defines how the attributes of the data points are mapped to the tags
+	// of the Graphite tagged series. By default every attribute is sent as a tag
+	// of the same name.
+	Tags TagsConfig `mapstructure:"tags"`
+}
+
+// TagsConfig defines the mapping of the attributes to Graphite tags.
+type TagsConfig struct {
+	// Mappings rename the attributes to tags, or drop them when the tag is empty.
+	Mappings []TagMapping `mapstructure:"mappings"`
+	// DropUnmapped drops the attributes without mapping instead of sending them
+	// as tags of the same name.
+	DropUnmapped bool `mapstructure:"drop_unmapped"`
+}
+
+// TagMapping maps an attribute to a Graphite tag.
+type TagMapping struct {
+	// Attribute is the name of the attribute.
+	Attribute string `mapstructure:"attribute"`
+	// Tag is the name of the tag, the attribute is dropped when it is empty.
+	Tag string `mapstructure:"tag"`
 }
 
 func (cfg *Config) Validate() error {
@@ -49,5 +83,22 @@ func (cfg *Config) Validate() error {
 		return errors.New("'max_idle_conns' must be non-negative")
 	}
 
+	attributes := make(map[string]struct{}, len(cfg.Tags.Mappings))
+	for _, m := range cfg.Tags.Mappings {
+		if m.Attribute == "" {
+			return errors.New("'tags::mappings' must have a non-empty 'attribute'")
+		}
+		if _, ok := attributes[m.Attribute]; ok {
+			return fmt.Errorf("'tags::mappings' has duplicate mappings for attribute %q", m.Attribute)
+		}
+		attributes[m.Attribute] = struct{}{}
+	}
+
+	switch cfg.Protocol {
+	case "", protocolLine, protocolPickle:
+	default:
+		return fmt.Errorf("unsupported 'protocol' %q, must be either %q or %q", cfg.Protocol, protocolLine, protocolPickle)
+	}
+
 	return nil
 }
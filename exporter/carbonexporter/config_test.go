@@ -43,6 +43,7 @@ func TestLoadConfig(t *testing.T) {
 					Endpoint: "localhost:8080",
 				},
 				MaxIdleConns: 15,
+				Protocol:     protocolPickle,
 				TimeoutSettings: exporterhelper.TimeoutConfig{
 					Timeout: 10 * time.Second,
 				},
@@ -63,6 +64,13 @@ func TestLoadConfig(t *testing.T) {
 				ResourceToTelemetryConfig: resourcetotelemetry.Settings{
 					Enabled: true,
 				},
+				Tags: TagsConfig{
+					Mappings: []TagMapping{
+						{Attribute: "service.name", Tag: "service"},
+						{Attribute: "http.request.method", Tag: "method"},
+						{Attribute: "url.full"},
+					},
+				},
 			},
 		},
 	}
@@ -119,6 +127,37 @@ func TestValidateConfig(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "tag_mapping_without_attribute",
+			config: &Config{
+				TCPAddrConfig: confignet.TCPAddrConfig{Endpoint: defaultEndpoint},
+				Tags: TagsConfig{
+					Mappings: []TagMapping{{Tag: "service"}},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "duplicate_tag_mappings",
+			config: &Config{
+				TCPAddrConfig: confignet.TCPAddrConfig{Endpoint: defaultEndpoint},
+				Tags: TagsConfig{
+					Mappings: []TagMapping{
+						{Attribute: "service.name", Tag: "service"},
+						{Attribute: "service.name", Tag: "app"},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid_protocol",
+			config: &Config{
+				TCPAddrConfig: confignet.TCPAddrConfig{Endpoint: defaultEndpoint},
+				Protocol:      "json",
+			},
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
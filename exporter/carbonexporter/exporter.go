@@ -20,7 +20,18 @@ import (
 
 // newCarbonExporter returns a new Carbon exporter.
 func newCarbonExporter(ctx context.Context, cfg *Config, set exporter.Settings) (exporter.Metrics, error) {
+	tags := newTagMapping(cfg.Tags)
+	marshal := func(md pmetric.Metrics) []byte {
+		return []byte(metricDataToPlaintext(md, tags))
+	}
+	if cfg.Protocol == protocolPickle {
+		marshal = func(md pmetric.Metrics) []byte {
+			return metricDataToPickle(md, tags)
+		}
+	}
+
 	sender := carbonSender{
+		marshal:      marshal,
 		writeTimeout: cfg.TimeoutSettings.Timeout,
 		conns:        newConnPool(cfg.TCPAddrConfig, cfg.TimeoutSettings.Timeout, cfg.MaxIdleConns),
 	}
@@ -45,12 +56,13 @@ func newCarbonExporter(ctx context.Context, cfg *Config, set exporter.Settings)
 // connections into an implementations of exporterhelper.PushMetricsData so
 // the exporter can leverage the helper and get consistent observability.
 type carbonSender struct {
+	marshal      func(pmetric.Metrics) []byte
 	writeTimeout time.Duration
 	conns        connPool
 }
 
 func (cs *carbonSender) pushMetricsData(_ context.Context, md pmetric.Metrics) error {
-	lines := metricDataToPlaintext(md)
+	data := cs.marshal(md)
 
 	// There is no way to do a call equivalent to recvfrom with an empty buffer
 	// to check if the connection was terminated (if the size of the buffer is
@@ -81,7 +93,7 @@ func (cs *carbonSender) pushMetricsData(_ context.Context, md pmetric.Metrics) e
 	}
 
 	// If we did not write all bytes will get an error, so no need to check for that.
-	_, err = conn.Write([]byte(lines))
+	_, err = conn.Write(data)
 	if err != nil {
 		// Do not re-enqueue the connection since it failed to write.
 		return multierr.Append(err, conn.Close())
@@ -34,6 +34,7 @@ func createDefaultConfig() component.Config {
 			Endpoint: defaultEndpoint,
 		},
 		MaxIdleConns:    100,
+		Protocol:        protocolLine,
 		TimeoutSettings: exporterhelper.NewDefaultTimeoutConfig(),
 		QueueConfig:     exporterhelper.NewDefaultQueueConfig(),
 		RetryConfig:     configretry.NewDefaultBackOffConfig(),
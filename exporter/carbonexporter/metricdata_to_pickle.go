@@ -0,0 +1,125 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package carbonexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/carbonexporter"
+
+import (
+	"bytes"
+	"encoding/binary"
+	"math"
+	"strconv"
+	"strings"
+
+	"go.opentelemetry.io/collector/pdata/pmetric"
+)
+
+const (
+	// maxPicklePointsPerMessage is the maximum number of metric points sent in
+	// a single pickle message, it matches the default batch size used by the
+	// Carbon relays.
+	maxPicklePointsPerMessage = 500
+
+	// Pickle opcodes used by the encoder, see the CPython "pickletools" module.
+	pickleProto      = 0x80
+	pickleEmptyList  = ']'
+	pickleMark       = '('
+	pickleAppends    = 'e'
+	pickleBinUnicode = 'X'
+	pickleBinInt     = 'J'
+	pickleLong1      = 0x8a
+	pickleBinFloat   = 'G'
+	pickleTuple2     = 0x86
+	pickleStop       = '.'
+)
+
+// metricDataToPickle converts internal metrics data to messages of the Carbon
+// pickle protocol as defined in https://graphite.readthedocs.io/en/latest/feeding-carbon.html#the-pickle-protocol.
+//
+// The metric points are converted in the same way as metricDataToPlaintext and
+// then grouped into messages of at most maxPicklePointsPerMessage points. Each
+// message is a pickled (protocol 2) list in the following format:
+//
+//	[(<path>, (<timestamp>, <value>)), ...]
+//
+// and is prefixed by its length as a 4 bytes big-endian unsigned integer. The
+// returned slice holds all messages concatenated.
+func metricDataToPickle(md pmetric.Metrics, tags *tagMapping) []byte {
+	lines := metricDataToPlaintext(md, tags)
+	if lines == "" {
+		return nil
+	}
+
+	var out bytes.Buffer
+	msg := writerPool.Get().(*bytes.Buffer)
+	defer writerPool.Put(msg)
+
+	points := 0
+	flush := func() {
+		if points == 0 {
+			return
+		}
+		msg.WriteByte(pickleAppends)
+		msg.WriteByte(pickleStop)
+		_ = binary.Write(&out, binary.BigEndian, uint32(msg.Len()))
+		out.Write(msg.Bytes())
+		points = 0
+	}
+
+	for _, line := range strings.Split(lines, tagLineNewLine) {
+		// Lines have the format "<path> <value> <timestamp>", any line that
+		// can't be split in those fields is dropped.
+		fields := strings.Split(line, tagLineEmptySpace)
+		if len(fields) != 3 {
+			continue
+		}
+		value, err := strconv.ParseFloat(fields[1], 64)
+		if err != nil {
+			continue
+		}
+		timestamp, err := strconv.ParseInt(fields[2], 10, 64)
+		if err != nil {
+			continue
+		}
+
+		if points == 0 {
+			msg.Reset()
+			msg.Write([]byte{pickleProto, 2, pickleEmptyList, pickleMark})
+		}
+		writePicklePoint(msg, fields[0], timestamp, value)
+		points++
+		if points == maxPicklePointsPerMessage {
+			flush()
+		}
+	}
+	flush()
+
+	return out.Bytes()
+}
+
+// writePicklePoint writes the pickled (<path>, (<timestamp>, <value>)) tuple.
+func writePicklePoint(buf *bytes.Buffer, path string, timestamp int64, value float64) {
+	var scratch [8]byte
+
+	buf.WriteByte(pickleBinUnicode)
+	binary.LittleEndian.PutUint32(scratch[:4], uint32(len(path)))
+	buf.Write(scratch[:4])
+	buf.WriteString(path)
+
+	if timestamp >= math.MinInt32 && timestamp <= math.MaxInt32 {
+		buf.WriteByte(pickleBinInt)
+		binary.LittleEndian.PutUint32(scratch[:4], uint32(int32(timestamp)))
+		buf.Write(scratch[:4])
+	} else {
+		buf.WriteByte(pickleLong1)
+		buf.WriteByte(8)
+		binary.LittleEndian.PutUint64(scratch[:], uint64(timestamp))
+		buf.Write(scratch[:])
+	}
+
+	buf.WriteByte(pickleBinFloat)
+	binary.BigEndian.PutUint64(scratch[:], math.Float64bits(value))
+	buf.Write(scratch[:])
+
+	buf.WriteByte(pickleTuple2)
+	buf.WriteByte(pickleTuple2)
+}
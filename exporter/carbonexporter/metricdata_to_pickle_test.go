@@ -0,0 +1,65 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package carbonexporter
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+)
+
+func TestToPickle(t *testing.T) {
+	ts := pcommon.NewTimestampFromTime(time.Unix(1582230020, 0))
+
+	md := pmetric.NewMetrics()
+	dp := md.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics().AppendEmpty().SetEmptyGauge().DataPoints().AppendEmpty()
+	md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0).SetName("test.metric")
+	dp.SetTimestamp(ts)
+	dp.SetDoubleValue(1.5)
+
+	// pickle.loads(want[4:]) == [("test.metric", (1582230020, 1.5))]
+	payload := []byte("\x80\x02](X\x0b\x00\x00\x00test.metricJ\x04\xeaN^G?\xf8\x00\x00\x00\x00\x00\x00\x86\x86e.")
+	want := binary.BigEndian.AppendUint32(nil, uint32(len(payload)))
+	want = append(want, payload...)
+
+	assert.Equal(t, want, metricDataToPickle(md, nil))
+}
+
+func TestToPickleEmpty(t *testing.T) {
+	assert.Empty(t, metricDataToPickle(pmetric.NewMetrics(), nil))
+}
+
+func TestToPickleBatches(t *testing.T) {
+	md := generateMetricsBatch(maxPicklePointsPerMessage + 1)
+
+	got := metricDataToPickle(md, nil)
+
+	var messages int
+	for len(got) > 0 {
+		require.GreaterOrEqual(t, len(got), 4)
+		size := int(binary.BigEndian.Uint32(got[:4]))
+		require.GreaterOrEqual(t, len(got), 4+size)
+		msg := got[4 : 4+size]
+		assert.True(t, bytes.HasPrefix(msg, []byte{pickleProto, 2, pickleEmptyList, pickleMark}))
+		assert.True(t, bytes.HasSuffix(msg, []byte{pickleAppends, pickleStop}))
+		got = got[4+size:]
+		messages++
+	}
+	assert.Equal(t, 2, messages)
+}
+
+func TestWritePicklePointLargeTimestamp(t *testing.T) {
+	var buf bytes.Buffer
+	writePicklePoint(&buf, "a", 5000000000, 2)
+
+	// pickle.loads(b"\x80\x02](" + buf + b"e.") == [("a", (5000000000, 2.0))]
+	want := []byte("X\x01\x00\x00\x00a\x8a\x08\x00\xf2\x05*\x01\x00\x00\x00G@\x00\x00\x00\x00\x00\x00\x00\x86\x86")
+	assert.Equal(t, want, buf.Bytes())
+}
@@ -79,7 +79,9 @@ var writerPool = sync.Pool{
 //     a single Carbon metric.
 //   - number of time series successfully converted to carbon.
 //   - number of time series that could not be converted to Carbon.
-func metricDataToPlaintext(md pmetric.Metrics) string {
+//
+// The attributes are sent as tags according to the given tag mapping.
+func metricDataToPlaintext(md pmetric.Metrics, tags *tagMapping) string {
 	if md.DataPointCount() == 0 {
 		return ""
 	}
@@ -100,13 +102,13 @@ func metricDataToPlaintext(md pmetric.Metrics) string {
 				}
 				switch metric.Type() {
 				case pmetric.MetricTypeGauge:
-					writeNumberDataPoints(buf, metric.Name(), metric.Gauge().DataPoints())
+					writeNumberDataPoints(buf, metric.Name(), tags, metric.Gauge().DataPoints())
 				case pmetric.MetricTypeSum:
-					writeNumberDataPoints(buf, metric.Name(), metric.Sum().DataPoints())
+					writeNumberDataPoints(buf, metric.Name(), tags, metric.Sum().DataPoints())
 				case pmetric.MetricTypeHistogram:
-					formatHistogramDataPoints(buf, metric.Name(), metric.Histogram().DataPoints())
+					formatHistogramDataPoints(buf, metric.Name(), tags, metric.Histogram().DataPoints())
 				case pmetric.MetricTypeSummary:
-					formatSummaryDataPoints(buf, metric.Name(), metric.Summary().DataPoints())
+					formatSummaryDataPoints(buf, metric.Name(), tags, metric.Summary().DataPoints())
 				}
 			}
 		}
@@ -115,7 +117,7 @@ func metricDataToPlaintext(md pmetric.Metrics) string {
 	return buf.String()
 }
 
-func writeNumberDataPoints(buf *bytes.Buffer, metricName string, dps pmetric.NumberDataPointSlice) {
+func writeNumberDataPoints(buf *bytes.Buffer, metricName string, tags *tagMapping, dps pmetric.NumberDataPointSlice) {
 	for i := 0; i < dps.Len(); i++ {
 		dp := dps.At(i)
 		var valueStr string
@@ -129,7 +131,7 @@ func writeNumberDataPoints(buf *bytes.Buffer, metricName string, dps pmetric.Num
 		}
 		writeLine(
 			buf,
-			buildPath(metricName, dp.Attributes()),
+			buildPath(metricName, dp.Attributes(), tags),
 			valueStr,
 			formatTimestamp(dp.Timestamp()))
 	}
@@ -152,13 +154,14 @@ func writeNumberDataPoints(buf *bytes.Buffer, metricName string, dps pmetric.Num
 func formatHistogramDataPoints(
 	buf *bytes.Buffer,
 	metricName string,
+	tags *tagMapping,
 	dps pmetric.HistogramDataPointSlice,
 ) {
 	for i := 0; i < dps.Len(); i++ {
 		dp := dps.At(i)
 
 		timestampStr := formatTimestamp(dp.Timestamp())
-		formatCountAndSum(buf, metricName, dp.Attributes(), dp.Count(), dp.Sum(), timestampStr)
+		formatCountAndSum(buf, metricName, tags, dp.Attributes(), dp.Count(), dp.Sum(), timestampStr)
 		if dp.ExplicitBounds().Len() == 0 {
 			continue
 		}
@@ -170,7 +173,7 @@ func formatHistogramDataPoints(
 		}
 		carbonBounds[len(carbonBounds)-1] = infinityCarbonValue
 
-		bucketPath := buildPath(metricName+distributionBucketSuffix, dp.Attributes())
+		bucketPath := buildPath(metricName+distributionBucketSuffix, dp.Attributes(), tags)
 		for j := 0; j < dp.BucketCounts().Len(); j++ {
 			writeLine(
 				buf,
@@ -196,19 +199,20 @@ func formatHistogramDataPoints(
 func formatSummaryDataPoints(
 	buf *bytes.Buffer,
 	metricName string,
+	tags *tagMapping,
 	dps pmetric.SummaryDataPointSlice,
 ) {
 	for i := 0; i < dps.Len(); i++ {
 		dp := dps.At(i)
 
 		timestampStr := formatTimestamp(dp.Timestamp())
-		formatCountAndSum(buf, metricName, dp.Attributes(), dp.Count(), dp.Sum(), timestampStr)
+		formatCountAndSum(buf, metricName, tags, dp.Attributes(), dp.Count(), dp.Sum(), timestampStr)
 
 		if dp.QuantileValues().Len() == 0 {
 			continue
 		}
 
-		quantilePath := buildPath(metricName+summaryQuantileSuffix, dp.Attributes())
+		quantilePath := buildPath(metricName+summaryQuantileSuffix, dp.Attributes(), tags)
 		for j := 0; j < dp.QuantileValues().Len(); j++ {
 			writeLine(
 				buf,
@@ -229,6 +233,7 @@ func formatSummaryDataPoints(
 func formatCountAndSum(
 	buf *bytes.Buffer,
 	metricName string,
+	tags *tagMapping,
 	attributes pcommon.Map,
 	count uint64,
 	sum float64,
@@ -237,19 +242,20 @@ func formatCountAndSum(
 	// Write count and sum metrics.
 	writeLine(
 		buf,
-		buildPath(metricName+countSuffix, attributes),
+		buildPath(metricName+countSuffix, attributes, tags),
 		formatUint64(count),
 		timestampStr)
 
 	writeLine(
 		buf,
-		buildPath(metricName, attributes),
+		buildPath(metricName, attributes, tags),
 		formatFloatForValue(sum),
 		timestampStr)
 }
 
-// buildPath is used to build the <metric_path> per description above.
-func buildPath(name string, attributes pcommon.Map) string {
+// buildPath is used to build the <metric_path> per description above, the
+// attributes being mapped to tags by the given tag mapping.
+func buildPath(name string, attributes pcommon.Map, tags *tagMapping) string {
 	if attributes.Len() == 0 {
 		return name
 	}
@@ -260,14 +266,18 @@ func buildPath(name string, attributes pcommon.Map) string {
 
 	buf.WriteString(name)
 	for k, v := range attributes.All() {
+		tag, ok := tags.tag(k)
+		if !ok {
+			continue
+		}
 		value := v.AsString()
 		if value == "" {
 			value = tagValueEmptyPlaceholder
 		}
 		buf.WriteString(tagPrefix)
-		buf.WriteString(sanitizeTagKey(k))
+		buf.WriteString(sanitizeTagKey(tag))
 		buf.WriteString(tagKeyValueSeparator)
-		buf.WriteString(value)
+		buf.WriteString(sanitizeTagValue(value))
 	}
 
 	return buf.String()
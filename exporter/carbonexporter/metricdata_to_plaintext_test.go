@@ -106,12 +106,55 @@ func TestBuildPath(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got := buildPath(tt.name, tt.attributes)
+			got := buildPath(tt.name, tt.attributes, nil)
 			assert.Equal(t, tt.want, got)
 		})
 	}
 }
 
+func TestBuildPathWithTagMapping(t *testing.T) {
+	attributes := pcommon.NewMap()
+	attributes.PutStr("service.name", "checkout")
+	attributes.PutStr("url.full", "https://example.com/cart")
+	attributes.PutStr("region", "eu;west")
+
+	tests := []struct {
+		name string
+		cfg  TagsConfig
+		want string
+	}{
+		{
+			name: "no_mapping",
+			want: "metric;service.name=checkout;url.full=https://example.com/cart;region=eu_west",
+		},
+		{
+			name: "rename_and_drop",
+			cfg: TagsConfig{
+				Mappings: []TagMapping{
+					{Attribute: "service.name", Tag: "service"},
+					{Attribute: "url.full"},
+				},
+			},
+			want: "metric;service=checkout;region=eu_west",
+		},
+		{
+			name: "drop_unmapped",
+			cfg: TagsConfig{
+				Mappings: []TagMapping{
+					{Attribute: "service.name", Tag: "service"},
+				},
+				DropUnmapped: true,
+			},
+			want: "metric;service=checkout",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, buildPath("metric", attributes, newTagMapping(tt.cfg)))
+		})
+	}
+}
+
 func TestToPlaintext(t *testing.T) {
 	unixSecs := int64(1574092046)
 	expectedUnixSecsStr := strconv.FormatInt(unixSecs, 10)
@@ -270,7 +313,7 @@ func TestToPlaintext(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			gotLines := metricDataToPlaintext(tt.metricsDataFn())
+			gotLines := metricDataToPlaintext(tt.metricsDataFn(), nil)
 			got := strings.Split(gotLines, "\n")
 			got = got[:len(got)-1]
 			assert.Len(t, got, len(tt.wantLines)+tt.wantExtraLinesCount)
@@ -328,6 +371,6 @@ func BenchmarkConsumeMetricsDefault(b *testing.B) {
 	b.ResetTimer()
 	b.ReportAllocs()
 	for n := 0; n < b.N; n++ {
-		assert.Len(b, metricDataToPlaintext(md), 62)
+		assert.Len(b, metricDataToPlaintext(md, nil), 62)
 	}
 }
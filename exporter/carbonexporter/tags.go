@@ -0,0 +1,40 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package carbonexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/carbonexporter"
+
+// tagMapping maps the attributes of the data points to the tags of the Graphite
+// tagged series. A nil tagMapping sends every attribute as a tag of the same name.
+type tagMapping struct {
+	// tags maps the attribute names to tag names, an empty tag name dropping the attribute.
+	tags map[string]string
+	// dropUnmapped drops the attributes without mapping.
+	dropUnmapped bool
+}
+
+func newTagMapping(cfg TagsConfig) *tagMapping {
+	if len(cfg.Mappings) == 0 && !cfg.DropUnmapped {
+		return nil
+	}
+
+	tm := &tagMapping{
+		tags:         make(map[string]string, len(cfg.Mappings)),
+		dropUnmapped: cfg.DropUnmapped,
+	}
+	for _, m := range cfg.Mappings {
+		tm.tags[m.Attribute] = m.Tag
+	}
+	return tm
+}
+
+// tag returns the name of the tag of the attribute, and false when the
+// attribute is not sent as a tag.
+func (tm *tagMapping) tag(attribute string) (string, bool) {
+	if tm == nil {
+		return attribute, true
+	}
+	if tag, ok := tm.tags[attribute]; ok {
+		return tag, tag != ""
+	}
+	return attribute, !tm.dropUnmapped
+}
@@ -64,6 +64,29 @@ type Config struct {
 	AsyncInsert bool `mapstructure:"async_insert"`
 	// MetricsTables defines the table names for metric types.
 	MetricsTables MetricTablesConfig `mapstructure:"metrics_tables"`
+	// LogsTableSchema overrides the storage clauses of the logs table.
+	LogsTableSchema TableSchemaConfig `mapstructure:"logs_table_schema"`
+	// TracesTableSchema overrides the storage clauses of the traces table.
+	TracesTableSchema TableSchemaConfig `mapstructure:"traces_table_schema"`
+	// MetricsTableSchema overrides the storage clauses of the metrics tables.
+	MetricsTableSchema TableSchemaConfig `mapstructure:"metrics_table_schema"`
+	// DDLDryRun if set to true will log the DDL for creating the database and tables instead of running it.
+	// Only applies when CreateSchema is true.
+	DDLDryRun bool `mapstructure:"ddl_dry_run"`
+}
+
+// TableSchemaConfig overrides the storage clauses of the tables created by the exporter.
+// Empty values keep the clauses of the default schema.
+type TableSchemaConfig struct {
+	// PartitionBy is the PARTITION BY expression, for example `toYYYYMM(Timestamp)`.
+	PartitionBy string `mapstructure:"partition_by"`
+	// OrderBy is the ORDER BY expression. Setting it drops the default PRIMARY KEY,
+	// as it may not be a prefix of the new sorting key.
+	OrderBy string `mapstructure:"order_by"`
+	// PrimaryKey is the PRIMARY KEY expression. It must be a prefix of the ORDER BY expression.
+	PrimaryKey string `mapstructure:"primary_key"`
+	// TTL is the TTL expression, for example `toDateTime(Timestamp) + INTERVAL 7 DAY`. Takes precedence over the `ttl` option.
+	TTL string `mapstructure:"ttl"`
 }
 
 type MetricTablesConfig struct {
@@ -97,8 +120,9 @@ const (
 )
 
 var (
-	errConfigNoEndpoint      = errors.New("endpoint must be specified")
-	errConfigInvalidEndpoint = errors.New("endpoint must be url format")
+	errConfigNoEndpoint          = errors.New("endpoint must be specified")
+	errConfigInvalidEndpoint     = errors.New("endpoint must be url format")
+	errConfigDryRunWithoutSchema = errors.New("ddl_dry_run requires create_schema to be enabled")
 )
 
 func createDefaultConfig() component.Config {
@@ -138,6 +162,10 @@ func (cfg *Config) Validate() (err error) {
 
 	cfg.buildMetricTableNames()
 
+	if cfg.DDLDryRun && !cfg.CreateSchema {
+		err = errors.Join(err, errConfigDryRunWithoutSchema)
+	}
+
 	// Validate DSN with clickhouse driver.
 	// Last chance to catch invalid config.
 	if _, e := clickhouse.ParseDSN(dsn); e != nil {
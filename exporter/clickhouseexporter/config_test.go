@@ -84,6 +84,11 @@ func TestLoadConfig(t *testing.T) {
 					Sizer:        exporterhelper.RequestSizerTypeRequests,
 				},
 				AsyncInsert: true,
+				LogsTableSchema: TableSchemaConfig{
+					PartitionBy: "toYYYYMM(TimestampTime)",
+					OrderBy:     "(ServiceName, SeverityText, TimestampTime)",
+					TTL:         "TimestampTime + INTERVAL 30 DAY",
+				},
 			},
 		},
 	}
@@ -46,11 +46,12 @@ func (e *logsExporter) start(ctx context.Context, _ component.Host) error {
 	}
 
 	if e.cfg.shouldCreateSchema() {
-		if err := internal.CreateDatabase(ctx, e.db, e.cfg.database(), e.cfg.clusterString()); err != nil {
+		db := e.cfg.schemaConn(e.db, e.logger)
+		if err := internal.CreateDatabase(ctx, db, e.cfg.database(), e.cfg.clusterString()); err != nil {
 			return err
 		}
 
-		if err := createLogsTable(ctx, e.cfg, e.db); err != nil {
+		if err := createLogsTable(ctx, e.cfg, db); err != nil {
 			return err
 		}
 	}
@@ -158,11 +159,10 @@ func renderInsertLogsSQL(cfg *Config) string {
 }
 
 func renderCreateLogsTableSQL(cfg *Config) string {
-	ttlExpr := internal.GenerateTTLExpr(cfg.TTL, "TimestampTime")
 	return fmt.Sprintf(sqltemplates.LogsCreateTable,
 		cfg.database(), cfg.LogsTableName, cfg.clusterString(),
 		cfg.tableEngineString(),
-		ttlExpr,
+		cfg.tableClauses(cfg.LogsTableSchema, logsTableClauses, "TimestampTime"),
 	)
 }
 
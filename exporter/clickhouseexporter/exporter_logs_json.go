@@ -45,11 +45,12 @@ func (e *logsJSONExporter) start(ctx context.Context, _ component.Host) error {
 	}
 
 	if e.cfg.shouldCreateSchema() {
-		if err := internal.CreateDatabase(ctx, e.db, e.cfg.database(), e.cfg.clusterString()); err != nil {
+		db := e.cfg.schemaConn(e.db, e.logger)
+		if err := internal.CreateDatabase(ctx, db, e.cfg.database(), e.cfg.clusterString()); err != nil {
 			return err
 		}
 
-		if err := createLogsJSONTable(ctx, e.cfg, e.db); err != nil {
+		if err := createLogsJSONTable(ctx, e.cfg, db); err != nil {
 			return err
 		}
 	}
@@ -169,11 +170,10 @@ func renderInsertLogsJSONSQL(cfg *Config) string {
 }
 
 func renderCreateLogsJSONTableSQL(cfg *Config) string {
-	ttlExpr := internal.GenerateTTLExpr(cfg.TTL, "Timestamp")
 	return fmt.Sprintf(sqltemplates.LogsJSONCreateTable,
 		cfg.database(), cfg.LogsTableName, cfg.clusterString(),
 		cfg.tableEngineString(),
-		ttlExpr,
+		cfg.tableClauses(cfg.LogsTableSchema, logsJSONTableClauses, "Timestamp"),
 	)
 }
 
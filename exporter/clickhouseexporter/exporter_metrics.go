@@ -48,14 +48,15 @@ func (e *metricsExporter) start(ctx context.Context, _ component.Host) error {
 	}
 
 	if e.cfg.shouldCreateSchema() {
+		db := e.cfg.schemaConn(e.db, e.logger)
 		database := e.cfg.database()
 		clusterStr := e.cfg.clusterString()
-		if err := internal.CreateDatabase(ctx, e.db, database, clusterStr); err != nil {
+		if err := internal.CreateDatabase(ctx, db, database, clusterStr); err != nil {
 			return err
 		}
 
-		ttlExpr := internal.GenerateTTLExpr(e.cfg.TTL, "toDateTime(TimeUnix)")
-		err := metrics.NewMetricsTable(ctx, e.tablesConfig, database, clusterStr, e.cfg.tableEngineString(), ttlExpr, e.db)
+		clauses := e.cfg.tableClauses(e.cfg.MetricsTableSchema, metricsTableClauses, "toDateTime(TimeUnix)")
+		err := metrics.NewMetricsTable(ctx, e.tablesConfig, database, clusterStr, e.cfg.tableEngineString(), clauses, db)
 		if err != nil {
 			return err
 		}
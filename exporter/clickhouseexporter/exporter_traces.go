@@ -47,11 +47,12 @@ func (e *tracesExporter) start(ctx context.Context, _ component.Host) error {
 	}
 
 	if e.cfg.shouldCreateSchema() {
-		if err := internal.CreateDatabase(ctx, e.db, e.cfg.database(), e.cfg.clusterString()); err != nil {
+		db := e.cfg.schemaConn(e.db, e.logger)
+		if err := internal.CreateDatabase(ctx, db, e.cfg.database(), e.cfg.clusterString()); err != nil {
 			return err
 		}
 
-		if err := createTraceTables(ctx, e.cfg, e.db); err != nil {
+		if err := createTraceTables(ctx, e.cfg, db); err != nil {
 			return err
 		}
 	}
@@ -186,20 +187,19 @@ func renderInsertTracesSQL(cfg *Config) string {
 }
 
 func renderCreateTracesTableSQL(cfg *Config) string {
-	ttlExpr := internal.GenerateTTLExpr(cfg.TTL, "toDateTime(Timestamp)")
 	return fmt.Sprintf(sqltemplates.TracesCreateTable,
 		cfg.database(), cfg.TracesTableName, cfg.clusterString(),
 		cfg.tableEngineString(),
-		ttlExpr,
+		cfg.tableClauses(cfg.TracesTableSchema, tracesTableClauses, "toDateTime(Timestamp)"),
 	)
 }
 
 func renderCreateTraceIDTsTableSQL(cfg *Config) string {
-	ttlExpr := internal.GenerateTTLExpr(cfg.TTL, "toDateTime(Start)")
+	// the lookup table has its own columns, so the traces table schema doesn't apply to it.
 	return fmt.Sprintf(sqltemplates.TracesCreateTsTable,
 		cfg.database(), cfg.TracesTableName, cfg.clusterString(),
 		cfg.tableEngineString(),
-		ttlExpr,
+		cfg.tableClauses(TableSchemaConfig{}, traceIDTsTableClauses, "toDateTime(Start)"),
 	)
 }
 
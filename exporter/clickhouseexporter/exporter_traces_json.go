@@ -45,11 +45,12 @@ func (e *tracesJSONExporter) start(ctx context.Context, _ component.Host) error
 	}
 
 	if e.cfg.shouldCreateSchema() {
-		if err := internal.CreateDatabase(ctx, e.db, e.cfg.database(), e.cfg.clusterString()); err != nil {
+		db := e.cfg.schemaConn(e.db, e.logger)
+		if err := internal.CreateDatabase(ctx, db, e.cfg.database(), e.cfg.clusterString()); err != nil {
 			return err
 		}
 
-		if err := createTraceJSONTables(ctx, e.cfg, e.db); err != nil {
+		if err := createTraceJSONTables(ctx, e.cfg, db); err != nil {
 			return err
 		}
 	}
@@ -208,11 +209,10 @@ func renderInsertTracesJSONSQL(cfg *Config) string {
 }
 
 func renderCreateTracesJSONTableSQL(cfg *Config) string {
-	ttlExpr := internal.GenerateTTLExpr(cfg.TTL, "toDateTime(Timestamp)")
 	return fmt.Sprintf(sqltemplates.TracesJSONCreateTable,
 		cfg.database(), cfg.TracesTableName, cfg.clusterString(),
 		cfg.tableEngineString(),
-		ttlExpr,
+		cfg.tableClauses(cfg.TracesTableSchema, tracesJSONTableClauses, "toDateTime(Timestamp)"),
 	)
 }
 
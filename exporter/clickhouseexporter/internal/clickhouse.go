@@ -6,6 +6,7 @@ package internal // import "github.com/open-telemetry/opentelemetry-collector-co
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/ClickHouse/clickhouse-go/v2"
@@ -76,3 +77,30 @@ func CreateDatabase(ctx context.Context, db driver.Conn, database, clusterStr st
 
 	return nil
 }
+
+// TableClauses holds the storage clauses following the ENGINE of a CREATE TABLE statement.
+type TableClauses struct {
+	PartitionBy string
+	PrimaryKey  string
+	OrderBy     string
+	// TTL is the complete TTL clause, as generated by GenerateTTLExpr.
+	TTL string
+}
+
+// String renders the non-empty clauses in the order expected by ClickHouse.
+func (c TableClauses) String() string {
+	var clauses []string
+	if c.PartitionBy != "" {
+		clauses = append(clauses, "PARTITION BY "+c.PartitionBy)
+	}
+	if c.PrimaryKey != "" {
+		clauses = append(clauses, "PRIMARY KEY "+c.PrimaryKey)
+	}
+	if c.OrderBy != "" {
+		clauses = append(clauses, "ORDER BY "+c.OrderBy)
+	}
+	if c.TTL != "" {
+		clauses = append(clauses, c.TTL)
+	}
+	return strings.Join(clauses, "\n")
+}
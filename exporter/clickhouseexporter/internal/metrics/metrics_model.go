@@ -61,10 +61,10 @@ func SetLogger(l *zap.Logger) {
 	logger = l
 }
 
-// NewMetricsTable create metric tables with an expiry time to storage metric telemetry data
-func NewMetricsTable(ctx context.Context, tablesConfig MetricTablesConfigMapper, database, cluster, engine, ttlExpr string, db driver.Conn) error {
+// NewMetricsTable create metric tables with the given storage clauses to storage metric telemetry data
+func NewMetricsTable(ctx context.Context, tablesConfig MetricTablesConfigMapper, database, cluster, engine, clauses string, db driver.Conn) error {
 	for key, ddlTemplate := range supportedMetricTypes {
-		query := fmt.Sprintf(ddlTemplate, database, tablesConfig[key].Name, cluster, engine, ttlExpr)
+		query := fmt.Sprintf(ddlTemplate, database, tablesConfig[key].Name, cluster, engine, clauses)
 		if err := db.Exec(ctx, query); err != nil {
 			return fmt.Errorf("exec create metrics table sql: %w", err)
 		}
@@ -17,8 +17,5 @@ CREATE TABLE IF NOT EXISTS "%s"."%s" %s (
 
     INDEX idx_body Body TYPE tokenbf_v1(32768, 3, 0) GRANULARITY 8
 ) ENGINE = %s
-PARTITION BY toDate(Timestamp)
-PRIMARY KEY (ServiceName, toDateTime(Timestamp))
-ORDER BY (ServiceName, toDateTime(Timestamp), Timestamp)
 %s
 SETTINGS index_granularity = 8192, ttl_only_drop_parts = 1
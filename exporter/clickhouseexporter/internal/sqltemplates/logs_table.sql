@@ -25,8 +25,5 @@ CREATE TABLE IF NOT EXISTS "%s"."%s" %s (
     INDEX idx_log_attr_value mapValues(LogAttributes) TYPE bloom_filter(0.01) GRANULARITY 1,
     INDEX idx_body Body TYPE tokenbf_v1(32768, 3, 0) GRANULARITY 8
     ) ENGINE = %s
-    PARTITION BY toDate(TimestampTime)
-    PRIMARY KEY (ServiceName, TimestampTime)
-    ORDER BY (ServiceName, TimestampTime, Timestamp)
     %s
     SETTINGS index_granularity = 8192, ttl_only_drop_parts = 1
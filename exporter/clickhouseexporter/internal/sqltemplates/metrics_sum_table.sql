@@ -32,6 +32,4 @@ CREATE TABLE IF NOT EXISTS "%s"."%s" %s (
     INDEX idx_attr_value mapValues(Attributes) TYPE bloom_filter(0.01) GRANULARITY 1
     ) ENGINE = %s
     %s
-    PARTITION BY toDate(TimeUnix)
-    ORDER BY (ServiceName, MetricName, Attributes, toUnixTimestamp64Nano(TimeUnix))
     SETTINGS index_granularity=8192, ttl_only_drop_parts = 1
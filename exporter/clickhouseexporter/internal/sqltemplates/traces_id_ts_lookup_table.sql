@@ -4,7 +4,5 @@ CREATE TABLE IF NOT EXISTS "%s"."%s_trace_id_ts" %s (
     End DateTime CODEC(Delta, ZSTD(1)),
     INDEX idx_trace_id TraceId TYPE bloom_filter(0.01) GRANULARITY 1
 ) ENGINE = %s
-    PARTITION BY toDate(Start)
-    ORDER BY (TraceId, Start)
     %s
     SETTINGS index_granularity=8192, ttl_only_drop_parts = 1
\ No newline at end of file
@@ -27,7 +27,5 @@ CREATE TABLE IF NOT EXISTS "%s"."%s" %s (
     ) CODEC(ZSTD(1)),
     INDEX idx_duration Duration TYPE minmax GRANULARITY 1
 ) ENGINE = %s
-PARTITION BY toDate(Timestamp)
-ORDER BY (ServiceName, SpanName, toDateTime(Timestamp), Timestamp)
 %s
 SETTINGS index_granularity=8192, ttl_only_drop_parts = 1
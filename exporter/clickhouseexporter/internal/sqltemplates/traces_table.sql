@@ -32,7 +32,5 @@ CREATE TABLE IF NOT EXISTS "%s"."%s" %s (
     INDEX idx_span_attr_value mapValues(SpanAttributes) TYPE bloom_filter(0.01) GRANULARITY 1,
     INDEX idx_duration Duration TYPE minmax GRANULARITY 1
 ) ENGINE = %s
-PARTITION BY toDate(Timestamp)
-ORDER BY (ServiceName, SpanName, toDateTime(Timestamp))
 %s
 SETTINGS index_granularity=8192, ttl_only_drop_parts = 1
@@ -0,0 +1,84 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package clickhouseexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/clickhouseexporter"
+
+import (
+	"context"
+
+	"github.com/ClickHouse/clickhouse-go/v2/lib/driver"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/clickhouseexporter/internal"
+)
+
+// Default storage clauses of the tables, the TTL clause is generated from the `ttl` option.
+var (
+	logsTableClauses = internal.TableClauses{
+		PartitionBy: "toDate(TimestampTime)",
+		PrimaryKey:  "(ServiceName, TimestampTime)",
+		OrderBy:     "(ServiceName, TimestampTime, Timestamp)",
+	}
+	logsJSONTableClauses = internal.TableClauses{
+		PartitionBy: "toDate(Timestamp)",
+		PrimaryKey:  "(ServiceName, toDateTime(Timestamp))",
+		OrderBy:     "(ServiceName, toDateTime(Timestamp), Timestamp)",
+	}
+	tracesTableClauses = internal.TableClauses{
+		PartitionBy: "toDate(Timestamp)",
+		OrderBy:     "(ServiceName, SpanName, toDateTime(Timestamp))",
+	}
+	tracesJSONTableClauses = internal.TableClauses{
+		PartitionBy: "toDate(Timestamp)",
+		OrderBy:     "(ServiceName, SpanName, toDateTime(Timestamp), Timestamp)",
+	}
+	traceIDTsTableClauses = internal.TableClauses{
+		PartitionBy: "toDate(Start)",
+		OrderBy:     "(TraceId, Start)",
+	}
+	metricsTableClauses = internal.TableClauses{
+		PartitionBy: "toDate(TimeUnix)",
+		OrderBy:     "(ServiceName, MetricName, Attributes, toUnixTimestamp64Nano(TimeUnix))",
+	}
+)
+
+// tableClauses renders the storage clauses of a table, applying the schema overrides to its defaults.
+func (cfg *Config) tableClauses(schema TableSchemaConfig, defaults internal.TableClauses, ttlField string) string {
+	clauses := defaults
+	clauses.TTL = internal.GenerateTTLExpr(cfg.TTL, ttlField)
+
+	if schema.PartitionBy != "" {
+		clauses.PartitionBy = schema.PartitionBy
+	}
+	if schema.OrderBy != "" {
+		clauses.OrderBy = schema.OrderBy
+		clauses.PrimaryKey = schema.PrimaryKey
+	} else if schema.PrimaryKey != "" {
+		clauses.PrimaryKey = schema.PrimaryKey
+	}
+	if schema.TTL != "" {
+		clauses.TTL = "TTL " + schema.TTL
+	}
+
+	return clauses.String()
+}
+
+// schemaConn returns the connection used to create the database and tables.
+// In dry-run mode the DDL is logged instead of being run.
+func (cfg *Config) schemaConn(db driver.Conn, logger *zap.Logger) driver.Conn {
+	if cfg.DDLDryRun {
+		return dryRunConn{Conn: db, logger: logger}
+	}
+	return db
+}
+
+// dryRunConn logs the statements passed to Exec instead of running them.
+type dryRunConn struct {
+	driver.Conn
+	logger *zap.Logger
+}
+
+func (c dryRunConn) Exec(_ context.Context, query string, _ ...any) error {
+	c.logger.Info("ClickHouse DDL dry run", zap.String("ddl", query))
+	return nil
+}
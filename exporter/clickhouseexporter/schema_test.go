@@ -0,0 +1,99 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package clickhouseexporter
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/confmap/xconfmap"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+func TestTableClauses(t *testing.T) {
+	tests := []struct {
+		name     string
+		ttl      time.Duration
+		schema   TableSchemaConfig
+		expected string
+	}{
+		{
+			name:     "defaults",
+			expected: "PARTITION BY toDate(TimestampTime)\nPRIMARY KEY (ServiceName, TimestampTime)\nORDER BY (ServiceName, TimestampTime, Timestamp)",
+		},
+		{
+			name:     "defaults with ttl",
+			ttl:      72 * time.Hour,
+			expected: "PARTITION BY toDate(TimestampTime)\nPRIMARY KEY (ServiceName, TimestampTime)\nORDER BY (ServiceName, TimestampTime, Timestamp)\nTTL TimestampTime + toIntervalDay(3)",
+		},
+		{
+			name:     "partition by",
+			schema:   TableSchemaConfig{PartitionBy: "toYYYYMM(TimestampTime)"},
+			expected: "PARTITION BY toYYYYMM(TimestampTime)\nPRIMARY KEY (ServiceName, TimestampTime)\nORDER BY (ServiceName, TimestampTime, Timestamp)",
+		},
+		{
+			name:     "order by drops default primary key",
+			schema:   TableSchemaConfig{OrderBy: "(SeverityText, TimestampTime)"},
+			expected: "PARTITION BY toDate(TimestampTime)\nORDER BY (SeverityText, TimestampTime)",
+		},
+		{
+			name:     "order by with primary key",
+			schema:   TableSchemaConfig{OrderBy: "(SeverityText, TimestampTime, Timestamp)", PrimaryKey: "(SeverityText, TimestampTime)"},
+			expected: "PARTITION BY toDate(TimestampTime)\nPRIMARY KEY (SeverityText, TimestampTime)\nORDER BY (SeverityText, TimestampTime, Timestamp)",
+		},
+		{
+			name:     "ttl expression takes precedence",
+			ttl:      72 * time.Hour,
+			schema:   TableSchemaConfig{TTL: "TimestampTime + INTERVAL 1 DAY DELETE WHERE SeverityNumber < 9"},
+			expected: "PARTITION BY toDate(TimestampTime)\nPRIMARY KEY (ServiceName, TimestampTime)\nORDER BY (ServiceName, TimestampTime, Timestamp)\nTTL TimestampTime + INTERVAL 1 DAY DELETE WHERE SeverityNumber < 9",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := withDefaultConfig(func(cfg *Config) {
+				cfg.TTL = tt.ttl
+			})
+			assert.Equal(t, tt.expected, cfg.tableClauses(tt.schema, logsTableClauses, "TimestampTime"))
+		})
+	}
+}
+
+func TestRenderCreateTableWithSchema(t *testing.T) {
+	cfg := withDefaultConfig(func(cfg *Config) {
+		cfg.TracesTableSchema.PartitionBy = "toYYYYMM(Timestamp)"
+	})
+	sql := renderCreateTracesTableSQL(cfg)
+	assert.Contains(t, sql, "PARTITION BY toYYYYMM(Timestamp)\nORDER BY (ServiceName, SpanName, toDateTime(Timestamp))")
+	assert.NotContains(t, renderCreateTraceIDTsTableSQL(cfg), "toYYYYMM")
+}
+
+func TestDDLDryRun(t *testing.T) {
+	core, logs := observer.New(zapcore.InfoLevel)
+	cfg := withDefaultConfig(func(cfg *Config) {
+		cfg.DDLDryRun = true
+	})
+
+	// the connection is never used in dry-run mode.
+	db := cfg.schemaConn(nil, zap.New(core))
+	require.NoError(t, createLogsTable(context.Background(), cfg, db))
+	require.Equal(t, 1, logs.Len())
+	assert.Equal(t, renderCreateLogsTableSQL(cfg), logs.All()[0].ContextMap()["ddl"])
+
+	cfg.DDLDryRun = false
+	assert.Nil(t, cfg.schemaConn(nil, zap.NewNop()))
+}
+
+func TestDDLDryRunRequiresCreateSchema(t *testing.T) {
+	cfg := withDefaultConfig(func(cfg *Config) {
+		cfg.Endpoint = defaultEndpoint
+		cfg.DDLDryRun = true
+		cfg.CreateSchema = false
+	})
+	assert.ErrorIs(t, xconfmap.Validate(cfg), errConfigDryRunWithoutSchema)
+}
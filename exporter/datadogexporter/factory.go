@@ -389,7 +389,11 @@ func (f *factory) createMetricsExporter(
 			},
 			HostMetadata: cfg.HostMetadata,
 		}
-		return sf.CreateMetrics(ctx, set, ex)
+		exp, createErr := sf.CreateMetrics(ctx, set, ex)
+		if createErr != nil {
+			return nil, createErr
+		}
+		return wrapResourceAttributesMapping(cfg.Metrics.ResourceAttributesMapping, exp), nil
 	default:
 		exp, metricsErr := newMetricsExporter(ctx, set, cfg, acfg, &f.onceMetadata, attrsTranslator, hostProvider, metadataReporter, statsIn, f.gatewayUsage)
 		if metricsErr != nil {
@@ -426,8 +430,9 @@ func (f *factory) createMetricsExporter(
 	if err != nil {
 		return nil, err
 	}
-	return resourcetotelemetry.WrapMetricsExporter(
-		resourcetotelemetry.Settings{Enabled: cfg.Metrics.ExporterConfig.ResourceAttributesAsTags}, exporter), nil
+	return wrapResourceAttributesMapping(cfg.Metrics.ResourceAttributesMapping,
+		resourcetotelemetry.WrapMetricsExporter(
+			resourcetotelemetry.Settings{Enabled: cfg.Metrics.ExporterConfig.ResourceAttributesAsTags}, exporter)), nil
 }
 
 // createTracesExporter creates a trace exporter based on this config.
@@ -0,0 +1,141 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package datadogexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/datadogexporter"
+
+import (
+	"context"
+	"regexp"
+	"strings"
+
+	"github.com/DataDog/opentelemetry-mapping-go/pkg/otlp/attributes"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/exporter"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	conventions "go.opentelemetry.io/otel/semconv/v1.6.1"
+
+	datadogconfig "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/datadog/config"
+)
+
+// resourceAttributesMapper applies the resource attributes mapping overrides
+// of the metrics configuration to the resources of a batch of metrics.
+type resourceAttributesMapper struct {
+	hostAttributes []string
+	hostAliases    map[string]string
+	include        *regexp.Regexp
+	exclude        *regexp.Regexp
+}
+
+func newResourceAttributesMapper(cfg datadogconfig.ResourceAttributesMappingConfig) *resourceAttributesMapper {
+	return &resourceAttributesMapper{
+		hostAttributes: cfg.HostAttributes,
+		hostAliases:    cfg.HostAliases,
+		include:        compileAnyOf(cfg.IncludeTags),
+		exclude:        compileAnyOf(cfg.ExcludeTags),
+	}
+}
+
+// compileAnyOf compiles a regular expression fully matching any of the given
+// expressions. The expressions are checked when the configuration is validated.
+func compileAnyOf(exprs []string) *regexp.Regexp {
+	if len(exprs) == 0 {
+		return nil
+	}
+	return regexp.MustCompile("^(?:" + strings.Join(exprs, ")$|^(?:") + ")$")
+}
+
+// sourceAttributes are the resource attributes used to resolve the hostname and
+// the source of the metrics. They are never dropped, as the metrics would then be
+// assigned to the host of the collector.
+var sourceAttributes = map[string]struct{}{
+	attributes.AttributeDatadogHostname:     {},
+	string(conventions.HostNameKey):         {},
+	string(conventions.HostIDKey):           {},
+	string(conventions.K8SNodeNameKey):      {},
+	string(conventions.K8SClusterNameKey):   {},
+	string(conventions.AWSECSLaunchtypeKey): {},
+	string(conventions.AWSECSTaskARNKey):    {},
+}
+
+// sourceAttributePrefixes are the prefixes of the cloud provider attributes used
+// to resolve the hostname.
+var sourceAttributePrefixes = []string{"cloud.", "azure."}
+
+func isSourceAttribute(key string) bool {
+	if _, ok := sourceAttributes[key]; ok {
+		return true
+	}
+	for _, prefix := range sourceAttributePrefixes {
+		if strings.HasPrefix(key, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+func (m *resourceAttributesMapper) mapResource(res pcommon.Resource) {
+	attrs := res.Attributes()
+
+	// The hostname is resolved before any attribute is dropped so that the
+	// attributes used for host resolution don't need to be kept as tags.
+	for _, key := range m.hostAttributes {
+		if v, ok := attrs.Get(key); ok && v.AsString() != "" {
+			attrs.PutStr(attributes.AttributeDatadogHostname, v.AsString())
+			break
+		}
+	}
+	for _, key := range []string{attributes.AttributeDatadogHostname, string(conventions.HostNameKey)} {
+		v, ok := attrs.Get(key)
+		if !ok {
+			continue
+		}
+		if alias, ok := m.hostAliases[v.AsString()]; ok {
+			attrs.PutStr(key, alias)
+		}
+	}
+
+	if m.include == nil && m.exclude == nil {
+		return
+	}
+	attrs.RemoveIf(func(key string, _ pcommon.Value) bool {
+		if isSourceAttribute(key) {
+			return false
+		}
+		if m.exclude != nil && m.exclude.MatchString(key) {
+			return true
+		}
+		return m.include != nil && !m.include.MatchString(key)
+	})
+}
+
+func (m *resourceAttributesMapper) mapMetrics(md pmetric.Metrics) pmetric.Metrics {
+	rms := md.ResourceMetrics()
+	for i := 0; i < rms.Len(); i++ {
+		m.mapResource(rms.At(i).Resource())
+	}
+	return md
+}
+
+type resourceAttributesMappingExporter struct {
+	exporter.Metrics
+	mapper *resourceAttributesMapper
+}
+
+func (e *resourceAttributesMappingExporter) ConsumeMetrics(ctx context.Context, md pmetric.Metrics) error {
+	return e.Metrics.ConsumeMetrics(ctx, e.mapper.mapMetrics(md))
+}
+
+func (*resourceAttributesMappingExporter) Capabilities() consumer.Capabilities {
+	return consumer.Capabilities{MutatesData: true}
+}
+
+// wrapResourceAttributesMapping wraps the given metrics exporter so that the
+// resource attributes mapping overrides are applied before any other
+// processing, including the conversion of resource attributes to metric tags.
+func wrapResourceAttributesMapping(cfg datadogconfig.ResourceAttributesMappingConfig, exp exporter.Metrics) exporter.Metrics {
+	if !cfg.Enabled() {
+		return exp
+	}
+	return &resourceAttributesMappingExporter{Metrics: exp, mapper: newResourceAttributesMapper(cfg)}
+}
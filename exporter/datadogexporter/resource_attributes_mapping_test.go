@@ -0,0 +1,134 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package datadogexporter
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/collector/exporter/exportertest"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+
+	datadogconfig "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/datadog/config"
+)
+
+func TestResourceAttributesMapper(t *testing.T) {
+	tests := []struct {
+		name  string
+		cfg   datadogconfig.ResourceAttributesMappingConfig
+		attrs map[string]any
+		want  map[string]any
+	}{
+		{
+			name: "host attributes",
+			cfg: datadogconfig.ResourceAttributesMappingConfig{
+				HostAttributes: []string{"custom.host", "k8s.node.name"},
+			},
+			attrs: map[string]any{"host.name": "ip-10-0-0-1", "k8s.node.name": "node-1"},
+			want:  map[string]any{"host.name": "ip-10-0-0-1", "k8s.node.name": "node-1", "datadog.host.name": "node-1"},
+		},
+		{
+			name: "host aliases",
+			cfg: datadogconfig.ResourceAttributesMappingConfig{
+				HostAliases: map[string]string{"ip-10-0-0-1": "web-1", "node-1": "worker-1"},
+			},
+			attrs: map[string]any{"host.name": "ip-10-0-0-1", "k8s.node.name": "node-1"},
+			want:  map[string]any{"host.name": "web-1", "k8s.node.name": "node-1"},
+		},
+		{
+			name: "host attributes with aliases",
+			cfg: datadogconfig.ResourceAttributesMappingConfig{
+				HostAttributes: []string{"k8s.node.name"},
+				HostAliases:    map[string]string{"node-1": "worker-1"},
+			},
+			attrs: map[string]any{"k8s.node.name": "node-1"},
+			want:  map[string]any{"k8s.node.name": "node-1", "datadog.host.name": "worker-1"},
+		},
+		{
+			name: "include and exclude tags",
+			cfg: datadogconfig.ResourceAttributesMappingConfig{
+				IncludeTags: []string{`k8s\..*`, "service.name"},
+				ExcludeTags: []string{`k8s\.pod\.uid`},
+			},
+			attrs: map[string]any{
+				"service.name":      "svc",
+				"k8s.pod.name":      "pod",
+				"k8s.pod.uid":       "7b0c6e8f",
+				"process.pid":       int64(1),
+				"datadog.host.name": "host",
+			},
+			want: map[string]any{"service.name": "svc", "k8s.pod.name": "pod", "datadog.host.name": "host"},
+		},
+		{
+			name: "excluded host attribute",
+			cfg: datadogconfig.ResourceAttributesMappingConfig{
+				HostAttributes: []string{"k8s.node.name"},
+				ExcludeTags:    []string{"k8s.node.name"},
+			},
+			attrs: map[string]any{"k8s.node.name": "node-1", "k8s.pod.name": "pod"},
+			want:  map[string]any{"k8s.node.name": "node-1", "k8s.pod.name": "pod", "datadog.host.name": "node-1"},
+		},
+		{
+			name: "excluded source attributes",
+			cfg: datadogconfig.ResourceAttributesMappingConfig{
+				IncludeTags: []string{"service.name"},
+				ExcludeTags: []string{`host\..*`, `cloud\..*`, `k8s\..*`, `aws\..*`},
+			},
+			attrs: map[string]any{
+				"service.name":       "svc",
+				"host.name":          "ip-10-0-0-1",
+				"host.id":            "i-0123",
+				"cloud.provider":     "aws",
+				"cloud.region":       "us-east-1",
+				"k8s.node.name":      "node-1",
+				"k8s.cluster.name":   "cluster",
+				"k8s.pod.name":       "pod",
+				"aws.ecs.launchtype": "ec2",
+				"aws.ecs.task.arn":   "task-arn",
+				"process.pid":        int64(1),
+			},
+			want: map[string]any{
+				"service.name":       "svc",
+				"host.name":          "ip-10-0-0-1",
+				"host.id":            "i-0123",
+				"cloud.provider":     "aws",
+				"cloud.region":       "us-east-1",
+				"k8s.node.name":      "node-1",
+				"k8s.cluster.name":   "cluster",
+				"aws.ecs.launchtype": "ec2",
+				"aws.ecs.task.arn":   "task-arn",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res := pcommon.NewResource()
+			assert.NoError(t, res.Attributes().FromRaw(tt.attrs))
+			newResourceAttributesMapper(tt.cfg).mapResource(res)
+			assert.Equal(t, tt.want, res.Attributes().AsRaw())
+		})
+	}
+}
+
+func TestWrapResourceAttributesMapping(t *testing.T) {
+	next := exportertest.NewNopFactory()
+	exp, err := next.CreateMetrics(context.Background(), exportertest.NewNopSettings(next.Type()), next.CreateDefaultConfig())
+	assert.NoError(t, err)
+
+	assert.Same(t, exp, wrapResourceAttributesMapping(datadogconfig.ResourceAttributesMappingConfig{}, exp))
+
+	wrapped := wrapResourceAttributesMapping(datadogconfig.ResourceAttributesMappingConfig{
+		ExcludeTags: []string{"process.pid"},
+	}, exp)
+	assert.True(t, wrapped.Capabilities().MutatesData)
+
+	md := pmetric.NewMetrics()
+	attrs := md.ResourceMetrics().AppendEmpty().Resource().Attributes()
+	attrs.PutStr("service.name", "svc")
+	attrs.PutInt("process.pid", 1)
+	assert.NoError(t, wrapped.ConsumeMetrics(context.Background(), md))
+	assert.Equal(t, map[string]any{"service.name": "svc"}, attrs.AsRaw())
+}
@@ -673,6 +673,13 @@ func (b *bulkIndexers) start(
 		return err
 	}
 
+	if cfg.Templates.Enabled {
+		// Documents can still be indexed without the templates, so failing to install them doesn't prevent the start.
+		if err := installTemplates(ctx, esClient, cfg, allowedMappingModes, set.Logger); err != nil {
+			set.Logger.Warn("Failed to install index templates", zap.Error(err))
+		}
+	}
+
 	for _, mode := range allowedMappingModes {
 		var bi bulkIndexer
 		bi, err = newBulkIndexer(esClient, cfg, mode == MappingOTel, b.telemetryBuilder, set.Logger)
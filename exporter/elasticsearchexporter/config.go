@@ -77,6 +77,7 @@ type Config struct {
 	Flush                   FlushSettings          `mapstructure:"flush"`
 	Mapping                 MappingsSettings       `mapstructure:"mapping"`
 	LogstashFormat          LogstashFormatSettings `mapstructure:"logstash_format"`
+	Templates               TemplatesSettings      `mapstructure:"templates"`
 
 	// TelemetrySettings contains settings useful for testing/debugging purposes.
 	// This is experimental and may change at any time.
@@ -167,6 +168,33 @@ type LogstashFormatSettings struct {
 	_ struct{}
 }
 
+// TemplatesSettings configures the index templates installed on start
+// for the data streams the exporter routes documents to.
+type TemplatesSettings struct {
+	// Enabled enables the installation of the templates.
+	Enabled bool `mapstructure:"enabled"`
+
+	// Overwrite replaces index templates that already exist.
+	// By default existing templates are left untouched.
+	Overwrite bool `mapstructure:"overwrite"`
+
+	// NamePrefix is prepended to the data stream type to name the templates.
+	NamePrefix string `mapstructure:"name_prefix"`
+
+	// Priority of the index templates. It must be higher than the priority of
+	// the built-in templates of Elasticsearch for the templates to take effect.
+	Priority int `mapstructure:"priority"`
+
+	// ILMPolicy is the name of the index lifecycle management policy applied to the data streams.
+	ILMPolicy string `mapstructure:"ilm_policy"`
+
+	// DataRetention is the retention of the data stream lifecycle applied to the data streams.
+	DataRetention time.Duration `mapstructure:"data_retention"`
+
+	// prevent unkeyed literal initialization
+	_ struct{}
+}
+
 type DynamicIndexSetting struct {
 	// Enabled enables dynamic index routing.
 	//
@@ -373,6 +401,21 @@ func (cfg *Config) Validate() error {
 		return errors.New("must not specify both traces_index and traces_dynamic_index; traces_index should be empty unless all documents should be sent to the same index")
 	}
 
+	if cfg.Templates.Enabled {
+		if cfg.Templates.NamePrefix == "" {
+			return errors.New("templates::name_prefix must not be empty")
+		}
+		if cfg.Templates.Priority < 0 {
+			return errors.New("templates::priority should be non-negative")
+		}
+		if cfg.Templates.DataRetention < 0 {
+			return errors.New("templates::data_retention should be non-negative")
+		}
+		if cfg.Templates.ILMPolicy != "" && cfg.Templates.DataRetention != 0 {
+			return errors.New("must not specify both templates::ilm_policy and templates::data_retention")
+		}
+	}
+
 	uniq := map[string]struct{}{}
 	for i, k := range cfg.MetadataKeys {
 		kl := strings.ToLower(k)
@@ -119,6 +119,10 @@ func TestConfig(t *testing.T) {
 					PrefixSeparator: "-",
 					DateFormat:      "%Y.%m.%d",
 				},
+				Templates: TemplatesSettings{
+					NamePrefix: "otel-",
+					Priority:   200,
+				},
 				Batcher: BatcherConfig{
 					FlushTimeout: 10 * time.Second,
 					Sizer:        exporterhelper.RequestSizerTypeItems,
@@ -195,6 +199,10 @@ func TestConfig(t *testing.T) {
 					PrefixSeparator: "-",
 					DateFormat:      "%Y.%m.%d",
 				},
+				Templates: TemplatesSettings{
+					NamePrefix: "otel-",
+					Priority:   200,
+				},
 				Batcher: BatcherConfig{
 					FlushTimeout: 10 * time.Second,
 					Sizer:        exporterhelper.RequestSizerTypeItems,
@@ -271,6 +279,10 @@ func TestConfig(t *testing.T) {
 					PrefixSeparator: "-",
 					DateFormat:      "%Y.%m.%d",
 				},
+				Templates: TemplatesSettings{
+					NamePrefix: "otel-",
+					Priority:   200,
+				},
 				Batcher: BatcherConfig{
 					FlushTimeout: 10 * time.Second,
 					Sizer:        exporterhelper.RequestSizerTypeItems,
@@ -361,6 +373,18 @@ func TestConfig(t *testing.T) {
 				cfg.MetadataKeys = []string{"x-test-1", "x-test-2"}
 			}),
 		},
+		{
+			id:         component.NewIDWithName(metadata.Type, "templates"),
+			configFile: "config.yaml",
+			expected: withDefaultConfig(func(cfg *Config) {
+				cfg.Endpoint = "https://elastic.example.com:9200"
+
+				cfg.Templates.Enabled = true
+				cfg.Templates.Overwrite = true
+				cfg.Templates.Priority = 250
+				cfg.Templates.ILMPolicy = "otel-policy"
+			}),
+		},
 		{
 			id:         component.NewIDWithName(metadata.Type, "queuebatch_enabled"),
 			configFile: "config.yaml",
@@ -497,6 +521,23 @@ func TestConfig_Validate(t *testing.T) {
 			}),
 			err: `metadata_keys must be case-insenstive and unique, found duplicate: x-test-1`,
 		},
+		"templates with both ilm_policy and data_retention": {
+			config: withDefaultConfig(func(cfg *Config) {
+				cfg.Endpoints = []string{"http://test:9200"}
+				cfg.Templates.Enabled = true
+				cfg.Templates.ILMPolicy = "otel-policy"
+				cfg.Templates.DataRetention = 24 * time.Hour
+			}),
+			err: `must not specify both templates::ilm_policy and templates::data_retention`,
+		},
+		"templates with empty name_prefix": {
+			config: withDefaultConfig(func(cfg *Config) {
+				cfg.Endpoints = []string{"http://test:9200"}
+				cfg.Templates.Enabled = true
+				cfg.Templates.NamePrefix = ""
+			}),
+			err: `templates::name_prefix must not be empty`,
+		},
 	}
 
 	for name, tt := range tests {
@@ -76,6 +76,10 @@ func createDefaultConfig() component.Config {
 			PrefixSeparator: "-",
 			DateFormat:      "%Y.%m.%d",
 		},
+		Templates: TemplatesSettings{
+			NamePrefix: "otel-",
+			Priority:   200,
+		},
 		TelemetrySettings: TelemetrySettings{
 			LogRequestBody:              false,
 			LogResponseBody:             false,
@@ -0,0 +1,168 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package elasticsearchexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/elasticsearchexporter"
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/elastic/go-elasticsearch/v8/esapi"
+	"go.uber.org/zap"
+)
+
+// templateMeta is set on the templates installed by the exporter, so they can be told apart from other templates.
+var templateMeta = map[string]any{"managed_by": "opentelemetry-collector"}
+
+// dataStreamTemplate describes the templates installed for one data stream type.
+type dataStreamTemplate struct {
+	dsType        string
+	indexPatterns []string
+}
+
+// dataStreamTemplates returns the data stream types the exporter routes documents to,
+// along with the index patterns matching the data streams of the allowed mapping modes.
+func dataStreamTemplates(cfg *Config, allowedMappingModes map[string]MappingMode) []dataStreamTemplate {
+	if cfg.LogstashFormat.Enabled {
+		// documents are written to dated indices rather than to data streams.
+		return nil
+	}
+
+	otelOnly := true
+	for _, mode := range allowedMappingModes {
+		if mode != MappingOTel {
+			otelOnly = false
+		}
+	}
+	patterns := func(dsType string) []string {
+		if otelOnly {
+			// the dataset of every document ends with .otel, see routeRecord.
+			return []string{dsType + "-*.otel-*"}
+		}
+		return []string{dsType + "-*-*"}
+	}
+
+	var templates []dataStreamTemplate
+	// span events are routed to logs data streams in OTel mapping mode.
+	if cfg.LogsIndex == "" || cfg.TracesIndex == "" {
+		templates = append(templates, dataStreamTemplate{dsType: defaultDataStreamTypeLogs, indexPatterns: patterns(defaultDataStreamTypeLogs)})
+	}
+	if cfg.MetricsIndex == "" {
+		templates = append(templates, dataStreamTemplate{dsType: defaultDataStreamTypeMetrics, indexPatterns: patterns(defaultDataStreamTypeMetrics)})
+	}
+	if cfg.TracesIndex == "" {
+		templates = append(templates, dataStreamTemplate{dsType: defaultDataStreamTypeTraces, indexPatterns: patterns(defaultDataStreamTypeTraces)})
+	}
+	return templates
+}
+
+func (t dataStreamTemplate) indexTemplateName(cfg *TemplatesSettings) string {
+	return cfg.NamePrefix + t.dsType
+}
+
+func (t dataStreamTemplate) componentTemplateName(cfg *TemplatesSettings) string {
+	return cfg.NamePrefix + t.dsType + "@settings"
+}
+
+// componentTemplate returns the body of the component template holding the lifecycle settings.
+func (t dataStreamTemplate) componentTemplate(cfg *TemplatesSettings) map[string]any {
+	template := map[string]any{}
+	if cfg.ILMPolicy != "" {
+		template["settings"] = map[string]any{"index.lifecycle.name": cfg.ILMPolicy}
+	}
+	if cfg.DataRetention > 0 {
+		template["lifecycle"] = map[string]any{
+			"data_retention": strconv.FormatInt(int64(cfg.DataRetention.Seconds()), 10) + "s",
+		}
+	}
+	return map[string]any{"template": template, "_meta": templateMeta}
+}
+
+// indexTemplate returns the body of the index template. It is composed of the built-in component
+// templates of the data stream type when they exist, so the mappings of Elasticsearch are kept,
+// followed by the component template of the exporter and the custom component template of the type.
+func (t dataStreamTemplate) indexTemplate(cfg *TemplatesSettings) map[string]any {
+	builtin := []string{t.dsType + "@mappings", t.dsType + "@settings", t.dsType + "@custom"}
+	composedOf := []string{builtin[0], builtin[1], t.componentTemplateName(cfg), builtin[2]}
+	return map[string]any{
+		"index_patterns":                     t.indexPatterns,
+		"data_stream":                        map[string]any{},
+		"priority":                           cfg.Priority,
+		"composed_of":                        composedOf,
+		"ignore_missing_component_templates": builtin,
+		"_meta":                              templateMeta,
+	}
+}
+
+// installTemplates installs the component and index templates of the data streams written by the exporter.
+// Existing index templates are left untouched unless templates::overwrite is set.
+func installTemplates(ctx context.Context, client esapi.Transport, cfg *Config, allowedMappingModes map[string]MappingMode, logger *zap.Logger) error {
+	settings := &cfg.Templates
+	for _, t := range dataStreamTemplates(cfg, allowedMappingModes) {
+		name := t.indexTemplateName(settings)
+		if !settings.Overwrite {
+			exists, err := indexTemplateExists(ctx, client, name)
+			if err != nil {
+				return err
+			}
+			if exists {
+				logger.Debug("Index template already exists, skipping", zap.String("template", name))
+				continue
+			}
+		}
+
+		body, err := json.Marshal(t.componentTemplate(settings))
+		if err != nil {
+			return err
+		}
+		componentName := t.componentTemplateName(settings)
+		resp, err := esapi.ClusterPutComponentTemplateRequest{Name: componentName, Body: bytes.NewReader(body)}.Do(ctx, client)
+		if err := checkTemplateResponse(resp, err, "component template", componentName); err != nil {
+			return err
+		}
+
+		body, err = json.Marshal(t.indexTemplate(settings))
+		if err != nil {
+			return err
+		}
+		resp, err = esapi.IndicesPutIndexTemplateRequest{Name: name, Body: bytes.NewReader(body)}.Do(ctx, client)
+		if err := checkTemplateResponse(resp, err, "index template", name); err != nil {
+			return err
+		}
+		logger.Info("Installed index template", zap.String("template", name), zap.Strings("index_patterns", t.indexPatterns))
+	}
+	return nil
+}
+
+func indexTemplateExists(ctx context.Context, client esapi.Transport, name string) (bool, error) {
+	resp, err := esapi.IndicesExistsIndexTemplateRequest{Name: name}.Do(ctx, client)
+	if err != nil {
+		return false, fmt.Errorf("failed to check index template %q: %w", name, err)
+	}
+	defer resp.Body.Close()
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return true, nil
+	case http.StatusNotFound:
+		return false, nil
+	default:
+		return false, fmt.Errorf("failed to check index template %q: %s", name, resp.Status())
+	}
+}
+
+func checkTemplateResponse(resp *esapi.Response, err error, kind, name string) error {
+	if err != nil {
+		return fmt.Errorf("failed to install %s %q: %w", kind, name, err)
+	}
+	defer resp.Body.Close()
+	if resp.IsError() {
+		body, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("failed to install %s %q: %s: %s", kind, name, resp.Status(), body)
+	}
+	return nil
+}
@@ -0,0 +1,156 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package elasticsearchexporter
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+type templateRequest struct {
+	method string
+	path   string
+	body   map[string]any
+}
+
+// fakeTemplateTransport records the requests sent to it and answers HEAD requests
+// with 200 for the index templates in existing.
+type fakeTemplateTransport struct {
+	existing map[string]bool
+	status   int
+	requests []templateRequest
+}
+
+func (f *fakeTemplateTransport) Perform(req *http.Request) (*http.Response, error) {
+	r := templateRequest{method: req.Method, path: req.URL.Path}
+	if req.Body != nil {
+		b, err := io.ReadAll(req.Body)
+		if err != nil {
+			return nil, err
+		}
+		if len(b) > 0 {
+			if err := json.Unmarshal(b, &r.body); err != nil {
+				return nil, err
+			}
+		}
+	}
+	f.requests = append(f.requests, r)
+
+	status := http.StatusOK
+	switch {
+	case req.Method == http.MethodHead:
+		if !f.existing[strings.TrimPrefix(req.URL.Path, "/_index_template/")] {
+			status = http.StatusNotFound
+		}
+	case f.status != 0:
+		status = f.status
+	}
+	return &http.Response{
+		StatusCode: status,
+		Body:       io.NopCloser(strings.NewReader(`{}`)),
+		Header:     http.Header{"X-Elastic-Product": []string{"Elasticsearch"}},
+	}, nil
+}
+
+func TestDataStreamTemplates(t *testing.T) {
+	otelOnly := map[string]MappingMode{"otel": MappingOTel}
+
+	cfg := withDefaultConfig()
+	templates := dataStreamTemplates(cfg, cfg.allowedMappingModes())
+	assert.Equal(t, []dataStreamTemplate{
+		{dsType: "logs", indexPatterns: []string{"logs-*-*"}},
+		{dsType: "metrics", indexPatterns: []string{"metrics-*-*"}},
+		{dsType: "traces", indexPatterns: []string{"traces-*-*"}},
+	}, templates)
+
+	cfg = withDefaultConfig(func(cfg *Config) {
+		cfg.MetricsIndex = "metrics"
+		cfg.TracesIndex = "traces"
+	})
+	assert.Equal(t, []dataStreamTemplate{
+		{dsType: "logs", indexPatterns: []string{"logs-*.otel-*"}},
+	}, dataStreamTemplates(cfg, otelOnly))
+
+	cfg = withDefaultConfig(func(cfg *Config) {
+		cfg.LogstashFormat.Enabled = true
+	})
+	assert.Empty(t, dataStreamTemplates(cfg, otelOnly))
+}
+
+func TestInstallTemplates(t *testing.T) {
+	cfg := withDefaultConfig(func(cfg *Config) {
+		cfg.LogsIndex = "logs"
+		cfg.TracesIndex = "traces"
+		cfg.Templates.Enabled = true
+		cfg.Templates.DataRetention = 7 * 24 * time.Hour
+	})
+	otelOnly := map[string]MappingMode{"otel": MappingOTel}
+
+	transport := &fakeTemplateTransport{}
+	require.NoError(t, installTemplates(context.Background(), transport, cfg, otelOnly, zap.NewNop()))
+	require.Len(t, transport.requests, 3)
+
+	assert.Equal(t, http.MethodHead, transport.requests[0].method)
+	assert.Equal(t, "/_index_template/otel-metrics", transport.requests[0].path)
+
+	assert.Equal(t, http.MethodPut, transport.requests[1].method)
+	assert.Equal(t, "/_component_template/otel-metrics@settings", transport.requests[1].path)
+	assert.Equal(t, map[string]any{
+		"template": map[string]any{"lifecycle": map[string]any{"data_retention": "604800s"}},
+		"_meta":    map[string]any{"managed_by": "opentelemetry-collector"},
+	}, transport.requests[1].body)
+
+	assert.Equal(t, http.MethodPut, transport.requests[2].method)
+	assert.Equal(t, "/_index_template/otel-metrics", transport.requests[2].path)
+	assert.Equal(t, map[string]any{
+		"index_patterns":                     []any{"metrics-*.otel-*"},
+		"data_stream":                        map[string]any{},
+		"priority":                           float64(200),
+		"composed_of":                        []any{"metrics@mappings", "metrics@settings", "otel-metrics@settings", "metrics@custom"},
+		"ignore_missing_component_templates": []any{"metrics@mappings", "metrics@settings", "metrics@custom"},
+		"_meta":                              map[string]any{"managed_by": "opentelemetry-collector"},
+	}, transport.requests[2].body)
+}
+
+func TestInstallTemplatesExisting(t *testing.T) {
+	cfg := withDefaultConfig(func(cfg *Config) {
+		cfg.Templates.Enabled = true
+		cfg.Templates.ILMPolicy = "otel-policy"
+	})
+	otelOnly := map[string]MappingMode{"otel": MappingOTel}
+
+	transport := &fakeTemplateTransport{existing: map[string]bool{"otel-logs": true, "otel-traces": true}}
+	require.NoError(t, installTemplates(context.Background(), transport, cfg, otelOnly, zap.NewNop()))
+	var puts []string
+	for _, r := range transport.requests {
+		if r.method == http.MethodPut {
+			puts = append(puts, r.path)
+		}
+	}
+	assert.Equal(t, []string{"/_component_template/otel-metrics@settings", "/_index_template/otel-metrics"}, puts)
+
+	cfg.Templates.Overwrite = true
+	transport = &fakeTemplateTransport{existing: map[string]bool{"otel-logs": true}}
+	require.NoError(t, installTemplates(context.Background(), transport, cfg, otelOnly, zap.NewNop()))
+	require.Len(t, transport.requests, 6)
+	assert.Equal(t, map[string]any{"index.lifecycle.name": "otel-policy"}, transport.requests[0].body["template"].(map[string]any)["settings"])
+}
+
+func TestInstallTemplatesError(t *testing.T) {
+	cfg := withDefaultConfig(func(cfg *Config) {
+		cfg.Templates.Enabled = true
+	})
+	transport := &fakeTemplateTransport{status: http.StatusForbidden}
+	err := installTemplates(context.Background(), transport, cfg, map[string]MappingMode{"otel": MappingOTel}, zap.NewNop())
+	assert.ErrorContains(t, err, `failed to install component template "otel-logs@settings"`)
+}
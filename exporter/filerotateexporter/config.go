@@ -0,0 +1,128 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package filerotateexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/filerotateexporter"
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/collector/confmap/xconfmap"
+)
+
+const (
+	// the format of encoded telemetry data
+	formatTypeJSON  = "json"
+	formatTypeProto = "proto"
+
+	// the compression codec of the files
+	compressionNone = "none"
+	compressionGzip = "gzip"
+	compressionZstd = "zstd"
+)
+
+// Config defines configuration for the file rotate exporter.
+type Config struct {
+	// Directory is the directory the files are written to. It is created if it does not exist.
+	Directory string `mapstructure:"directory"`
+
+	// FilePrefix is the prefix of the names of the files, followed by the signal
+	// and the time the file was created.
+	FilePrefix string `mapstructure:"file_prefix"`
+
+	// FormatType defines the data format of encoded telemetry data.
+	// Options:
+	// - json[default]: OTLP JSON, one batch per line.
+	// - proto: OTLP binary protobuf, each batch preceded by its size.
+	FormatType string `mapstructure:"format"`
+
+	// Compression is the compression codec of the files.
+	// Options: gzip[default], zstd or none.
+	Compression string `mapstructure:"compression"`
+
+	// Rotation defines when the file being written is closed and a new one started.
+	Rotation RotationConfig `mapstructure:"rotation"`
+
+	// Manifest configures the index of the files written.
+	Manifest ManifestConfig `mapstructure:"manifest"`
+
+	// prevent unkeyed literal initialization
+	_ struct{}
+}
+
+// RotationConfig defines the policies triggering the rotation of a file.
+// The file is rotated as soon as any of the enabled policies is met.
+type RotationConfig struct {
+	// MaxMegabytes is the maximum size in megabytes of a file, after compression.
+	// Zero disables rotation by size.
+	MaxMegabytes int `mapstructure:"max_megabytes"`
+
+	// Interval is the maximum time a file is written to, starting from its first write.
+	// Zero disables rotation by time.
+	Interval time.Duration `mapstructure:"interval"`
+
+	// MaxRecords is the maximum number of spans, metric data points or log records of a file.
+	// Zero disables rotation by records.
+	MaxRecords int64 `mapstructure:"max_records"`
+
+	// prevent unkeyed literal initialization
+	_ struct{}
+}
+
+// ManifestConfig defines the configuration of the manifest, which lists the files
+// once they are complete.
+type ManifestConfig struct {
+	// Enabled enables appending an entry to the manifest for each rotated file.
+	Enabled bool `mapstructure:"enabled"`
+
+	// FileName is the name of the manifest file, in the output directory.
+	FileName string `mapstructure:"file_name"`
+
+	// prevent unkeyed literal initialization
+	_ struct{}
+}
+
+var _ xconfmap.Validator = (*Config)(nil)
+
+// Validate checks if the exporter configuration is valid.
+func (cfg *Config) Validate() error {
+	if cfg.Directory == "" {
+		return errors.New("directory must be non-empty")
+	}
+	if strings.ContainsAny(cfg.FilePrefix, `/\`) {
+		return errors.New("file_prefix must not contain path separators")
+	}
+	if cfg.FormatType != formatTypeJSON && cfg.FormatType != formatTypeProto {
+		return fmt.Errorf("format type %q is not supported", cfg.FormatType)
+	}
+	switch cfg.Compression {
+	case compressionNone, compressionGzip, compressionZstd:
+	default:
+		return fmt.Errorf("compression %q is not supported", cfg.Compression)
+	}
+
+	if cfg.Rotation.MaxMegabytes < 0 {
+		return errors.New("rotation::max_megabytes must not be negative")
+	}
+	if cfg.Rotation.Interval < 0 {
+		return errors.New("rotation::interval must not be negative")
+	}
+	if cfg.Rotation.MaxRecords < 0 {
+		return errors.New("rotation::max_records must not be negative")
+	}
+	if cfg.Rotation.MaxMegabytes == 0 && cfg.Rotation.Interval == 0 && cfg.Rotation.MaxRecords == 0 {
+		return errors.New("at least one of rotation::max_megabytes, rotation::interval or rotation::max_records must be set")
+	}
+
+	if cfg.Manifest.Enabled {
+		if cfg.Manifest.FileName == "" {
+			return errors.New("manifest::file_name must be non-empty when the manifest is enabled")
+		}
+		if strings.ContainsAny(cfg.Manifest.FileName, `/\`) {
+			return errors.New("manifest::file_name must not contain path separators")
+		}
+	}
+	return nil
+}
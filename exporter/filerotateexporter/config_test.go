@@ -0,0 +1,126 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package filerotateexporter
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/confmap/confmaptest"
+	"go.opentelemetry.io/collector/confmap/xconfmap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/filerotateexporter/internal/metadata"
+)
+
+func TestLoadConfig(t *testing.T) {
+	t.Parallel()
+
+	cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
+	require.NoError(t, err)
+
+	tests := []struct {
+		id           component.ID
+		expected     component.Config
+		errorMessage string
+	}{
+		{
+			id: component.NewID(metadata.Type),
+			expected: &Config{
+				Directory:   "./archive",
+				FilePrefix:  defaultFilePrefix,
+				FormatType:  formatTypeJSON,
+				Compression: compressionGzip,
+				Rotation: RotationConfig{
+					MaxMegabytes: defaultMaxMegabytes,
+					Interval:     defaultInterval,
+				},
+				Manifest: ManifestConfig{
+					Enabled:  true,
+					FileName: defaultManifestFile,
+				},
+			},
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "2"),
+			expected: &Config{
+				Directory:   "./archive",
+				FilePrefix:  "gateway",
+				FormatType:  formatTypeProto,
+				Compression: compressionZstd,
+				Rotation: RotationConfig{
+					MaxMegabytes: 10,
+					Interval:     15 * time.Minute,
+					MaxRecords:   100000,
+				},
+				Manifest: ManifestConfig{
+					Enabled:  true,
+					FileName: "index.jsonl",
+				},
+			},
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "no_manifest"),
+			expected: &Config{
+				Directory:   "./archive",
+				FilePrefix:  defaultFilePrefix,
+				FormatType:  formatTypeJSON,
+				Compression: compressionGzip,
+				Rotation: RotationConfig{
+					MaxMegabytes: defaultMaxMegabytes,
+					Interval:     defaultInterval,
+				},
+				Manifest: ManifestConfig{
+					FileName: defaultManifestFile,
+				},
+			},
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "no_directory"),
+			errorMessage: "directory must be non-empty",
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "invalid_format"),
+			errorMessage: `format type "text" is not supported`,
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "invalid_compression"),
+			errorMessage: `compression "lz4" is not supported`,
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "no_rotation"),
+			errorMessage: "at least one of rotation::max_megabytes, rotation::interval or rotation::max_records must be set",
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "negative_records"),
+			errorMessage: "rotation::max_records must not be negative",
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "invalid_manifest"),
+			errorMessage: "manifest::file_name must not contain path separators",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.id.String(), func(t *testing.T) {
+			factory := NewFactory()
+			cfg := factory.CreateDefaultConfig()
+
+			sub, err := cm.Sub(tt.id.String())
+			require.NoError(t, err)
+			require.NoError(t, sub.Unmarshal(cfg))
+
+			if tt.expected == nil {
+				assert.EqualError(t, xconfmap.Validate(cfg), tt.errorMessage)
+				return
+			}
+
+			assert.NoError(t, xconfmap.Validate(cfg))
+			assert.Equal(t, tt.expected, cfg)
+		})
+	}
+}
@@ -0,0 +1,7 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:generate mdatagen metadata.yaml
+
+// Package filerotateexporter exports data to size and time rotated files, suitable for archiving.
+package filerotateexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/filerotateexporter"
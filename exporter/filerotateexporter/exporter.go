@@ -0,0 +1,145 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package filerotateexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/filerotateexporter"
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.uber.org/zap"
+)
+
+const (
+	signalTraces  = "traces"
+	signalMetrics = "metrics"
+	signalLogs    = "logs"
+
+	// rotationCheckInterval is the interval at which files are checked for rotation by time,
+	// so that they are rotated even when no data is written to them.
+	rotationCheckInterval = time.Second
+)
+
+// Marshaler configuration used for marshaling Protobuf
+var tracesMarshalers = map[string]ptrace.Marshaler{
+	formatTypeJSON:  &ptrace.JSONMarshaler{},
+	formatTypeProto: &ptrace.ProtoMarshaler{},
+}
+
+var metricsMarshalers = map[string]pmetric.Marshaler{
+	formatTypeJSON:  &pmetric.JSONMarshaler{},
+	formatTypeProto: &pmetric.ProtoMarshaler{},
+}
+
+var logsMarshalers = map[string]plog.Marshaler{
+	formatTypeJSON:  &plog.JSONMarshaler{},
+	formatTypeProto: &plog.ProtoMarshaler{},
+}
+
+// fileRotateExporter writes the telemetry of a signal to rotated files.
+type fileRotateExporter struct {
+	config *Config
+	signal string
+	logger *zap.Logger
+
+	writer *rotatingWriter
+
+	shutdownCh chan struct{}
+	wg         sync.WaitGroup
+}
+
+func newFileRotateExporter(config *Config, signal string, logger *zap.Logger) *fileRotateExporter {
+	return &fileRotateExporter{
+		config: config,
+		signal: signal,
+		logger: logger,
+	}
+}
+
+func (e *fileRotateExporter) consumeTraces(_ context.Context, td ptrace.Traces) error {
+	buf, err := tracesMarshalers[e.config.FormatType].MarshalTraces(td)
+	if err != nil {
+		return err
+	}
+	return e.writer.write(buf, int64(td.SpanCount()))
+}
+
+func (e *fileRotateExporter) consumeMetrics(_ context.Context, md pmetric.Metrics) error {
+	buf, err := metricsMarshalers[e.config.FormatType].MarshalMetrics(md)
+	if err != nil {
+		return err
+	}
+	return e.writer.write(buf, int64(md.DataPointCount()))
+}
+
+func (e *fileRotateExporter) consumeLogs(_ context.Context, ld plog.Logs) error {
+	buf, err := logsMarshalers[e.config.FormatType].MarshalLogs(ld)
+	if err != nil {
+		return err
+	}
+	return e.writer.write(buf, int64(ld.LogRecordCount()))
+}
+
+// Start creates the output directory and starts the rotation of the files by time.
+func (e *fileRotateExporter) Start(context.Context, component.Host) error {
+	if err := os.MkdirAll(e.config.Directory, 0o755); err != nil {
+		return fmt.Errorf("failed to create directory %q: %w", e.config.Directory, err)
+	}
+
+	var m *manifest
+	if e.config.Manifest.Enabled {
+		m = &manifest{path: filepath.Join(e.config.Directory, e.config.Manifest.FileName)}
+	}
+	e.writer = newRotatingWriter(e.config, e.signal, m)
+
+	removed, err := e.writer.removeStaleFiles()
+	for _, name := range removed {
+		e.logger.Warn("Removed incomplete file left by a previous run", zap.String("file", name))
+	}
+	if err != nil {
+		e.logger.Warn("Failed to remove incomplete files left by a previous run", zap.Error(err))
+	}
+
+	e.shutdownCh = make(chan struct{})
+	if e.config.Rotation.Interval > 0 {
+		e.wg.Add(1)
+		go e.rotateExpiredFiles(min(e.config.Rotation.Interval, rotationCheckInterval))
+	}
+	return nil
+}
+
+func (e *fileRotateExporter) rotateExpiredFiles(interval time.Duration) {
+	defer e.wg.Done()
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-e.shutdownCh:
+			return
+		case <-ticker.C:
+			if err := e.writer.rotateIfExpired(); err != nil {
+				e.logger.Error("Failed to rotate file", zap.Error(err))
+			}
+		}
+	}
+}
+
+// Shutdown stops the rotation of the files by time and completes the file being written.
+func (e *fileRotateExporter) Shutdown(context.Context) error {
+	if e.writer == nil {
+		return nil
+	}
+	close(e.shutdownCh)
+	e.wg.Wait()
+	w := e.writer
+	e.writer = nil
+	return w.close()
+}
@@ -0,0 +1,112 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package filerotateexporter
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/exporter/exportertest"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/filerotateexporter/internal/metadata"
+)
+
+func TestExportAllSignals(t *testing.T) {
+	factory := NewFactory()
+	cfg := factory.CreateDefaultConfig().(*Config)
+	cfg.Directory = filepath.Join(t.TempDir(), "archive")
+	set := exportertest.NewNopSettings(metadata.Type)
+	host := componenttest.NewNopHost()
+
+	te, err := factory.CreateTraces(context.Background(), set, cfg)
+	require.NoError(t, err)
+	me, err := factory.CreateMetrics(context.Background(), set, cfg)
+	require.NoError(t, err)
+	le, err := factory.CreateLogs(context.Background(), set, cfg)
+	require.NoError(t, err)
+	require.NoError(t, te.Start(context.Background(), host))
+	require.NoError(t, me.Start(context.Background(), host))
+	require.NoError(t, le.Start(context.Background(), host))
+
+	td := ptrace.NewTraces()
+	spans := td.ResourceSpans().AppendEmpty().ScopeSpans().AppendEmpty().Spans()
+	spans.AppendEmpty().SetName("span1")
+	spans.AppendEmpty().SetName("span2")
+	require.NoError(t, te.ConsumeTraces(context.Background(), td))
+
+	md := pmetric.NewMetrics()
+	gauge := md.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics().AppendEmpty()
+	gauge.SetName("gauge")
+	gauge.SetEmptyGauge().DataPoints().AppendEmpty().SetIntValue(1)
+	require.NoError(t, me.ConsumeMetrics(context.Background(), md))
+
+	ld := plog.NewLogs()
+	ld.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords().AppendEmpty().Body().SetStr("log")
+	require.NoError(t, le.ConsumeLogs(context.Background(), ld))
+
+	require.NoError(t, te.Shutdown(context.Background()))
+	require.NoError(t, me.Shutdown(context.Background()))
+	require.NoError(t, le.Shutdown(context.Background()))
+
+	w := newRotatingWriter(cfg, signalLogs, &manifest{path: filepath.Join(cfg.Directory, defaultManifestFile)})
+	entries := readManifest(t, w)
+	require.Len(t, entries, 3)
+
+	records := map[string]int64{}
+	for _, entry := range entries {
+		records[entry.Signal] = entry.Records
+
+		content := bytes.TrimSuffix(readFile(t, w, entry.File), []byte("\n"))
+		switch entry.Signal {
+		case signalTraces:
+			got, err := (&ptrace.JSONUnmarshaler{}).UnmarshalTraces(content)
+			require.NoError(t, err)
+			assert.Equal(t, td, got)
+		case signalMetrics:
+			got, err := (&pmetric.JSONUnmarshaler{}).UnmarshalMetrics(content)
+			require.NoError(t, err)
+			assert.Equal(t, md, got)
+		case signalLogs:
+			got, err := (&plog.JSONUnmarshaler{}).UnmarshalLogs(content)
+			require.NoError(t, err)
+			assert.Equal(t, ld, got)
+		}
+	}
+	assert.Equal(t, map[string]int64{signalTraces: 2, signalMetrics: 1, signalLogs: 1}, records)
+}
+
+func TestRotateIdleFile(t *testing.T) {
+	factory := NewFactory()
+	cfg := factory.CreateDefaultConfig().(*Config)
+	cfg.Directory = t.TempDir()
+	cfg.Rotation.Interval = 50 * time.Millisecond
+
+	le, err := factory.CreateLogs(context.Background(), exportertest.NewNopSettings(metadata.Type), cfg)
+	require.NoError(t, err)
+	require.NoError(t, le.Start(context.Background(), componenttest.NewNopHost()))
+	defer func() {
+		assert.NoError(t, le.Shutdown(context.Background()))
+	}()
+
+	ld := plog.NewLogs()
+	ld.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords().AppendEmpty().Body().SetStr("log")
+	require.NoError(t, le.ConsumeLogs(context.Background(), ld))
+
+	// The file is rotated without waiting for more data or the shutdown.
+	manifestPath := filepath.Join(cfg.Directory, defaultManifestFile)
+	require.Eventually(t, func() bool {
+		_, statErr := os.Stat(manifestPath)
+		return statErr == nil
+	}, 5*time.Second, 10*time.Millisecond)
+}
@@ -0,0 +1,101 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package filerotateexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/filerotateexporter"
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/exporter"
+	"go.opentelemetry.io/collector/exporter/exporterhelper"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/filerotateexporter/internal/metadata"
+)
+
+const (
+	defaultFilePrefix   = "otel"
+	defaultMaxMegabytes = 100
+	defaultInterval     = time.Hour
+	defaultManifestFile = "manifest.jsonl"
+)
+
+// NewFactory creates a factory for the file rotate exporter.
+func NewFactory() exporter.Factory {
+	return exporter.NewFactory(
+		metadata.Type,
+		createDefaultConfig,
+		exporter.WithTraces(createTracesExporter, metadata.TracesStability),
+		exporter.WithMetrics(createMetricsExporter, metadata.MetricsStability),
+		exporter.WithLogs(createLogsExporter, metadata.LogsStability),
+	)
+}
+
+func createDefaultConfig() component.Config {
+	return &Config{
+		FilePrefix:  defaultFilePrefix,
+		FormatType:  formatTypeJSON,
+		Compression: compressionGzip,
+		Rotation: RotationConfig{
+			MaxMegabytes: defaultMaxMegabytes,
+			Interval:     defaultInterval,
+		},
+		Manifest: ManifestConfig{
+			Enabled:  true,
+			FileName: defaultManifestFile,
+		},
+	}
+}
+
+func createTracesExporter(
+	ctx context.Context,
+	set exporter.Settings,
+	cfg component.Config,
+) (exporter.Traces, error) {
+	fe := newFileRotateExporter(cfg.(*Config), signalTraces, set.Logger)
+	return exporterhelper.NewTraces(
+		ctx,
+		set,
+		cfg,
+		fe.consumeTraces,
+		exporterhelper.WithStart(fe.Start),
+		exporterhelper.WithShutdown(fe.Shutdown),
+		exporterhelper.WithCapabilities(consumer.Capabilities{MutatesData: false}),
+	)
+}
+
+func createMetricsExporter(
+	ctx context.Context,
+	set exporter.Settings,
+	cfg component.Config,
+) (exporter.Metrics, error) {
+	fe := newFileRotateExporter(cfg.(*Config), signalMetrics, set.Logger)
+	return exporterhelper.NewMetrics(
+		ctx,
+		set,
+		cfg,
+		fe.consumeMetrics,
+		exporterhelper.WithStart(fe.Start),
+		exporterhelper.WithShutdown(fe.Shutdown),
+		exporterhelper.WithCapabilities(consumer.Capabilities{MutatesData: false}),
+	)
+}
+
+func createLogsExporter(
+	ctx context.Context,
+	set exporter.Settings,
+	cfg component.Config,
+) (exporter.Logs, error) {
+	fe := newFileRotateExporter(cfg.(*Config), signalLogs, set.Logger)
+	return exporterhelper.NewLogs(
+		ctx,
+		set,
+		cfg,
+		fe.consumeLogs,
+		exporterhelper.WithStart(fe.Start),
+		exporterhelper.WithShutdown(fe.Shutdown),
+		exporterhelper.WithCapabilities(consumer.Capabilities{MutatesData: false}),
+	)
+}
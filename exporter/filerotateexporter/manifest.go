@@ -0,0 +1,45 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package filerotateexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/filerotateexporter"
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"time"
+)
+
+// manifestEntry describes a complete file.
+type manifestEntry struct {
+	File        string    `json:"file"`
+	Signal      string    `json:"signal"`
+	Format      string    `json:"format"`
+	Compression string    `json:"compression"`
+	Records     int64     `json:"records"`
+	SizeBytes   int64     `json:"size_bytes"`
+	SHA256      string    `json:"sha256"`
+	CreatedAt   time.Time `json:"created_at"`
+	RotatedAt   time.Time `json:"rotated_at"`
+}
+
+// manifest is a JSON lines file listing the complete files. The exporters of all the signals
+// append to the same manifest, each entry being written at once.
+type manifest struct {
+	path string
+}
+
+func (m *manifest) append(entry manifestEntry) error {
+	line, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	line = append(line, '\n')
+
+	f, err := os.OpenFile(m.path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0o644)
+	if err != nil {
+		return err
+	}
+	_, err = f.Write(line)
+	return errors.Join(err, f.Sync(), f.Close())
+}
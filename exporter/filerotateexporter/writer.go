@@ -0,0 +1,286 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package filerotateexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/filerotateexporter"
+
+import (
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/klauspost/compress/zstd"
+)
+
+const (
+	// tmpSuffix is the suffix of the file being written, removed when the file is rotated.
+	tmpSuffix = ".tmp"
+
+	// fileTimeFormat is the format of the creation time in the names of the files.
+	fileTimeFormat = "20060102T150405.000000000Z"
+)
+
+// rotatingWriter writes the telemetry of a signal to a file, and rotates it once any of
+// the rotation policies is met. It is safe for concurrent use.
+type rotatingWriter struct {
+	config    *Config
+	signal    string
+	extension string
+	manifest  *manifest
+	now       func() time.Time
+
+	mu      sync.Mutex
+	current *segment
+}
+
+// segment is a file being written.
+type segment struct {
+	name      string
+	file      *os.File
+	counter   *countingWriter
+	encoder   io.WriteCloser
+	createdAt time.Time
+	records   int64
+}
+
+func newRotatingWriter(config *Config, signal string, m *manifest) *rotatingWriter {
+	return &rotatingWriter{
+		config:    config,
+		signal:    signal,
+		extension: fileExtension(config),
+		manifest:  m,
+		now:       time.Now,
+	}
+}
+
+func fileExtension(config *Config) string {
+	ext := ".jsonl"
+	if config.FormatType == formatTypeProto {
+		ext = ".binpb"
+	}
+	switch config.Compression {
+	case compressionGzip:
+		ext += ".gz"
+	case compressionZstd:
+		ext += ".zst"
+	}
+	return ext
+}
+
+// write writes an encoded batch holding the given number of records.
+func (w *rotatingWriter) write(buf []byte, records int64) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	now := w.now()
+	if w.current != nil && w.expired(now) {
+		if err := w.rotate(now); err != nil {
+			return err
+		}
+	}
+	if w.current == nil {
+		if err := w.open(now); err != nil {
+			return err
+		}
+	}
+
+	if err := w.writeMessage(buf); err != nil {
+		return err
+	}
+	w.current.records += records
+
+	if w.full() {
+		return w.rotate(now)
+	}
+	return nil
+}
+
+func (w *rotatingWriter) writeMessage(buf []byte) error {
+	if w.config.FormatType == formatTypeProto {
+		// each encoded batch is preceded by its size as an unsigned 32 bit integer,
+		// as written by the file exporter. https://developers.google.com/protocol-buffers/docs/techniques
+		var size [4]byte
+		binary.BigEndian.PutUint32(size[:], uint32(len(buf)))
+		if _, err := w.current.encoder.Write(size[:]); err != nil {
+			return err
+		}
+		_, err := w.current.encoder.Write(buf)
+		return err
+	}
+
+	if _, err := w.current.encoder.Write(buf); err != nil {
+		return err
+	}
+	_, err := w.current.encoder.Write([]byte{'\n'})
+	return err
+}
+
+// rotateIfExpired rotates the file being written if it was created more than the rotation interval ago.
+func (w *rotatingWriter) rotateIfExpired() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	now := w.now()
+	if w.current == nil || !w.expired(now) {
+		return nil
+	}
+	return w.rotate(now)
+}
+
+// close completes the file being written, if any.
+func (w *rotatingWriter) close() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.current == nil {
+		return nil
+	}
+	return w.rotate(w.now())
+}
+
+func (w *rotatingWriter) expired(now time.Time) bool {
+	return w.config.Rotation.Interval > 0 && now.Sub(w.current.createdAt) >= w.config.Rotation.Interval
+}
+
+// full reports whether the file being written reached its maximum size or number of records.
+// The size only accounts for the data flushed by the compression codec, so files may slightly
+// exceed the maximum size.
+func (w *rotatingWriter) full() bool {
+	rotation := w.config.Rotation
+	if rotation.MaxMegabytes > 0 && w.current.counter.n >= int64(rotation.MaxMegabytes)*1024*1024 {
+		return true
+	}
+	return rotation.MaxRecords > 0 && w.current.records >= rotation.MaxRecords
+}
+
+// removeStaleFiles removes the temporary files of the signal left by a previous run interrupted
+// before completing them, and returns their names. Their content may be truncated, so they are
+// never completed.
+func (w *rotatingWriter) removeStaleFiles() ([]string, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	entries, err := os.ReadDir(w.config.Directory)
+	if err != nil {
+		return nil, err
+	}
+	prefix := w.namePrefix()
+	var removed []string
+	var errs error
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasPrefix(name, prefix) || !strings.HasSuffix(name, tmpSuffix) {
+			continue
+		}
+		if w.current != nil && name == w.current.name+tmpSuffix {
+			continue
+		}
+		if err := os.Remove(filepath.Join(w.config.Directory, name)); err != nil {
+			errs = errors.Join(errs, err)
+			continue
+		}
+		removed = append(removed, name)
+	}
+	return removed, errs
+}
+
+// namePrefix returns the prefix of the names of the files of the signal.
+func (w *rotatingWriter) namePrefix() string {
+	if w.config.FilePrefix != "" {
+		return w.config.FilePrefix + "-" + w.signal + "-"
+	}
+	return w.signal + "-"
+}
+
+func (w *rotatingWriter) open(now time.Time) error {
+	name := w.namePrefix() + now.UTC().Format(fileTimeFormat) + w.extension
+
+	file, err := os.OpenFile(filepath.Join(w.config.Directory, name+tmpSuffix), os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o644)
+	if err != nil {
+		return err
+	}
+
+	counter := &countingWriter{w: file, hash: sha256.New()}
+	var encoder io.WriteCloser
+	switch w.config.Compression {
+	case compressionGzip:
+		encoder = gzip.NewWriter(counter)
+	case compressionZstd:
+		encoder, err = zstd.NewWriter(counter)
+		if err != nil {
+			return errors.Join(err, file.Close(), os.Remove(file.Name()))
+		}
+	default:
+		encoder = nopWriteCloser{counter}
+	}
+
+	w.current = &segment{
+		name:      name,
+		file:      file,
+		counter:   counter,
+		encoder:   encoder,
+		createdAt: now,
+	}
+	return nil
+}
+
+// rotate completes the file being written: it flushes the compression codec, renames the file
+// to remove its temporary suffix and appends it to the manifest.
+func (w *rotatingWriter) rotate(now time.Time) error {
+	s := w.current
+	w.current = nil
+
+	if err := errors.Join(s.encoder.Close(), s.file.Sync(), s.file.Close()); err != nil {
+		return fmt.Errorf("failed to complete file %q: %w", s.name, err)
+	}
+	path := filepath.Join(w.config.Directory, s.name)
+	if err := os.Rename(path+tmpSuffix, path); err != nil {
+		return err
+	}
+
+	if w.manifest == nil {
+		return nil
+	}
+	return w.manifest.append(manifestEntry{
+		File:        s.name,
+		Signal:      w.signal,
+		Format:      w.config.FormatType,
+		Compression: w.config.Compression,
+		Records:     s.records,
+		SizeBytes:   s.counter.n,
+		SHA256:      hex.EncodeToString(s.counter.hash.Sum(nil)),
+		CreatedAt:   s.createdAt.UTC(),
+		RotatedAt:   now.UTC(),
+	})
+}
+
+// countingWriter counts and hashes the bytes written to a file.
+type countingWriter struct {
+	w    io.Writer
+	hash hash.Hash
+	n    int64
+}
+
+func (c *countingWriter) Write(p []byte) (int, error) {
+	n, err := c.w.Write(p)
+	c.hash.Write(p[:n])
+	c.n += int64(n)
+	return n, err
+}
+
+type nopWriteCloser struct {
+	io.Writer
+}
+
+func (nopWriteCloser) Close() error {
+	return nil
+}
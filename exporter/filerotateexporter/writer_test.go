@@ -0,0 +1,242 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package filerotateexporter
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestWriter(t *testing.T, modify func(*Config)) (*rotatingWriter, *time.Time) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Directory = t.TempDir()
+	modify(cfg)
+	require.NoError(t, cfg.Validate())
+
+	now := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	w := newRotatingWriter(cfg, signalLogs, &manifest{path: filepath.Join(cfg.Directory, cfg.Manifest.FileName)})
+	w.now = func() time.Time { return now }
+	return w, &now
+}
+
+func readManifest(t *testing.T, w *rotatingWriter) []manifestEntry {
+	f, err := os.Open(w.manifest.path)
+	require.NoError(t, err)
+	defer f.Close()
+
+	var entries []manifestEntry
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var entry manifestEntry
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &entry))
+		entries = append(entries, entry)
+	}
+	require.NoError(t, scanner.Err())
+	return entries
+}
+
+func readFile(t *testing.T, w *rotatingWriter, name string) []byte {
+	f, err := os.Open(filepath.Join(w.config.Directory, name))
+	require.NoError(t, err)
+	defer f.Close()
+
+	var r io.Reader = f
+	switch w.config.Compression {
+	case compressionGzip:
+		gr, err := gzip.NewReader(f)
+		require.NoError(t, err)
+		r = gr
+	case compressionZstd:
+		zr, err := zstd.NewReader(f)
+		require.NoError(t, err)
+		defer zr.Close()
+		r = zr
+	}
+	content, err := io.ReadAll(r)
+	require.NoError(t, err)
+	return content
+}
+
+func TestRotateByRecords(t *testing.T) {
+	w, _ := newTestWriter(t, func(cfg *Config) {
+		cfg.Rotation.MaxRecords = 3
+	})
+
+	require.NoError(t, w.write([]byte(`{"batch":1}`), 2))
+	require.NoError(t, w.write([]byte(`{"batch":2}`), 2))
+	require.NoError(t, w.write([]byte(`{"batch":3}`), 1))
+	require.NoError(t, w.close())
+
+	entries := readManifest(t, w)
+	require.Len(t, entries, 2)
+	assert.Equal(t, "otel-logs-20240501T100000.000000000Z.jsonl.gz", entries[0].File)
+	assert.EqualValues(t, 4, entries[0].Records)
+	assert.EqualValues(t, 1, entries[1].Records)
+	assert.Equal(t, "{\"batch\":1}\n{\"batch\":2}\n", string(readFile(t, w, entries[0].File)))
+	assert.Equal(t, "{\"batch\":3}\n", string(readFile(t, w, entries[1].File)))
+}
+
+func TestRotateBySize(t *testing.T) {
+	w, _ := newTestWriter(t, func(cfg *Config) {
+		cfg.Compression = compressionNone
+		cfg.Rotation.MaxMegabytes = 1
+	})
+
+	batch := bytes.Repeat([]byte("a"), 600*1024)
+	require.NoError(t, w.write(batch, 1))
+	require.NoError(t, w.write(batch, 1))
+	require.NoError(t, w.write(batch, 1))
+	require.NoError(t, w.close())
+
+	entries := readManifest(t, w)
+	require.Len(t, entries, 2)
+	assert.EqualValues(t, 2*(len(batch)+1), entries[0].SizeBytes)
+	assert.EqualValues(t, 2, entries[0].Records)
+	assert.EqualValues(t, len(batch)+1, entries[1].SizeBytes)
+}
+
+func TestRotateByInterval(t *testing.T) {
+	w, now := newTestWriter(t, func(cfg *Config) {
+		cfg.Rotation.Interval = time.Minute
+	})
+
+	require.NoError(t, w.write([]byte(`{"batch":1}`), 1))
+	*now = now.Add(30 * time.Second)
+	require.NoError(t, w.rotateIfExpired())
+	require.NoError(t, w.write([]byte(`{"batch":2}`), 1))
+	assert.NoFileExists(t, w.manifest.path)
+
+	*now = now.Add(30 * time.Second)
+	require.NoError(t, w.rotateIfExpired())
+	require.NoError(t, w.rotateIfExpired())
+
+	entries := readManifest(t, w)
+	require.Len(t, entries, 1)
+	assert.EqualValues(t, 2, entries[0].Records)
+	assert.Equal(t, time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC), entries[0].CreatedAt)
+	assert.Equal(t, time.Date(2024, 5, 1, 10, 1, 0, 0, time.UTC), entries[0].RotatedAt)
+
+	// The next file is created by the next write.
+	*now = now.Add(time.Hour)
+	require.NoError(t, w.write([]byte(`{"batch":3}`), 1))
+	require.NoError(t, w.close())
+	entries = readManifest(t, w)
+	require.Len(t, entries, 2)
+	assert.Equal(t, "otel-logs-20240501T110100.000000000Z.jsonl.gz", entries[1].File)
+}
+
+func TestTemporaryFile(t *testing.T) {
+	w, _ := newTestWriter(t, func(cfg *Config) {
+		cfg.FilePrefix = ""
+	})
+
+	require.NoError(t, w.write([]byte(`{"batch":1}`), 1))
+	name := "logs-20240501T100000.000000000Z.jsonl.gz"
+	assert.FileExists(t, filepath.Join(w.config.Directory, name+tmpSuffix))
+	assert.NoFileExists(t, filepath.Join(w.config.Directory, name))
+
+	require.NoError(t, w.close())
+	assert.NoFileExists(t, filepath.Join(w.config.Directory, name+tmpSuffix))
+	assert.FileExists(t, filepath.Join(w.config.Directory, name))
+}
+
+func TestRemoveStaleFiles(t *testing.T) {
+	w, _ := newTestWriter(t, func(*Config) {})
+
+	stale := "otel-logs-20240501T090000.000000000Z.jsonl.gz" + tmpSuffix
+	kept := []string{
+		// a complete file
+		"otel-logs-20240501T080000.000000000Z.jsonl.gz",
+		// the file of another signal, written by another exporter
+		"otel-traces-20240501T090000.000000000Z.jsonl.gz" + tmpSuffix,
+	}
+	for _, name := range append(kept, stale) {
+		require.NoError(t, os.WriteFile(filepath.Join(w.config.Directory, name), []byte("partial"), 0o600))
+	}
+
+	removed, err := w.removeStaleFiles()
+	require.NoError(t, err)
+	assert.Equal(t, []string{stale}, removed)
+	assert.NoFileExists(t, filepath.Join(w.config.Directory, stale))
+	for _, name := range kept {
+		assert.FileExists(t, filepath.Join(w.config.Directory, name))
+	}
+}
+
+func TestCompressionAndFormat(t *testing.T) {
+	tests := []struct {
+		name        string
+		format      string
+		compression string
+		extension   string
+	}{
+		{name: "json_none", format: formatTypeJSON, compression: compressionNone, extension: ".jsonl"},
+		{name: "json_gzip", format: formatTypeJSON, compression: compressionGzip, extension: ".jsonl.gz"},
+		{name: "proto_zstd", format: formatTypeProto, compression: compressionZstd, extension: ".binpb.zst"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w, _ := newTestWriter(t, func(cfg *Config) {
+				cfg.FormatType = tt.format
+				cfg.Compression = tt.compression
+			})
+
+			require.NoError(t, w.write([]byte("first"), 1))
+			require.NoError(t, w.write([]byte("second"), 1))
+			require.NoError(t, w.close())
+
+			entries := readManifest(t, w)
+			require.Len(t, entries, 1)
+			entry := entries[0]
+			assert.Equal(t, "otel-logs-20240501T100000.000000000Z"+tt.extension, entry.File)
+			assert.Equal(t, signalLogs, entry.Signal)
+			assert.Equal(t, tt.format, entry.Format)
+			assert.Equal(t, tt.compression, entry.Compression)
+
+			raw, err := os.ReadFile(filepath.Join(w.config.Directory, entry.File))
+			require.NoError(t, err)
+			assert.EqualValues(t, len(raw), entry.SizeBytes)
+			sum := sha256.Sum256(raw)
+			assert.Equal(t, hex.EncodeToString(sum[:]), entry.SHA256)
+
+			content := readFile(t, w, entry.File)
+			if tt.format == formatTypeJSON {
+				assert.Equal(t, "first\nsecond\n", string(content))
+				return
+			}
+			assert.EqualValues(t, 5, binary.BigEndian.Uint32(content[:4]))
+			assert.Equal(t, "first", string(content[4:9]))
+			assert.EqualValues(t, 6, binary.BigEndian.Uint32(content[9:13]))
+			assert.Equal(t, "second", string(content[13:]))
+		})
+	}
+}
+
+func TestNoManifest(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Directory = t.TempDir()
+	w := newRotatingWriter(cfg, signalTraces, nil)
+
+	require.NoError(t, w.write([]byte(`{"batch":1}`), 1))
+	require.NoError(t, w.close())
+
+	files, err := os.ReadDir(cfg.Directory)
+	require.NoError(t, err)
+	require.Len(t, files, 1)
+	assert.Regexp(t, `^otel-traces-\d{8}T\d{6}\.\d{9}Z\.jsonl\.gz$`, files[0].Name())
+}
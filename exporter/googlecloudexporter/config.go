@@ -8,6 +8,8 @@ import (
 
 	"github.com/GoogleCloudPlatform/opentelemetry-operations-go/exporter/collector"
 	"go.opentelemetry.io/collector/exporter/exporterhelper"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/googlecloudexporter/internal/resourcemapping"
 )
 
 // Config defines configuration for Google Cloud exporter.
@@ -17,11 +19,27 @@ type Config struct {
 	// Timeout for all API calls. If not set, defaults to 12 seconds.
 	TimeoutSettings exporterhelper.TimeoutConfig    `mapstructure:",squash"` // squash ensures fields are correctly decoded in embedded struct.
 	QueueSettings   exporterhelper.QueueBatchConfig `mapstructure:"sending_queue"`
+
+	// MetricDescriptors are the metric descriptors created when the metrics exporter starts.
+	MetricDescriptors []MetricDescriptorConfig `mapstructure:"metric_descriptors"`
+	// ResourceMappings map the resources of metrics and logs to monitored resources, the first
+	// mapping the resource has all the attributes of being used.
+	ResourceMappings []resourcemapping.Mapping `mapstructure:"resource_mappings"`
 }
 
 func (cfg *Config) Validate() error {
 	if err := collector.ValidateConfig(cfg.Config); err != nil {
 		return fmt.Errorf("googlecloud exporter settings are invalid :%w", err)
 	}
+	for i, d := range cfg.MetricDescriptors {
+		if err := d.validate(); err != nil {
+			return fmt.Errorf("metric_descriptors[%d] is invalid: %w", i, err)
+		}
+	}
+	for i, m := range cfg.ResourceMappings {
+		if m.Type == "" {
+			return fmt.Errorf("resource_mappings[%d] is invalid: type must be set", i)
+		}
+	}
 	return nil
 }
@@ -16,6 +16,7 @@ import (
 	"go.opentelemetry.io/collector/exporter/exporterhelper"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/googlecloudexporter/internal/metadata"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/googlecloudexporter/internal/resourcemapping"
 )
 
 func TestLoadConfig(t *testing.T) {
@@ -74,6 +75,85 @@ func TestLoadConfig(t *testing.T) {
 		sanitize(cfg.(*Config)))
 }
 
+func TestLoadConfigDescriptorsAndMappings(t *testing.T) {
+	cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
+	require.NoError(t, err)
+	factory := NewFactory()
+	cfg := factory.CreateDefaultConfig().(*Config)
+
+	sub, err := cm.Sub(component.NewIDWithName(metadata.Type, "descriptors").String())
+	require.NoError(t, err)
+	require.NoError(t, sub.Unmarshal(cfg))
+	require.NoError(t, cfg.Validate())
+
+	assert.Equal(t, []MetricDescriptorConfig{
+		{
+			Name:        "app.requests",
+			Kind:        "cumulative",
+			ValueType:   "int64",
+			Unit:        "{request}",
+			Description: "Number of requests served.",
+			Labels:      []string{"route"},
+		},
+	}, cfg.MetricDescriptors)
+	assert.Equal(t, []resourcemapping.Mapping{
+		{
+			Type: "k8s_container",
+			Labels: map[string]string{
+				"location":       "cloud.region",
+				"cluster_name":   "k8s.cluster.name",
+				"namespace_name": "k8s.namespace.name",
+				"pod_name":       "k8s.pod.name",
+				"container_name": "k8s.container.name",
+			},
+		},
+	}, cfg.ResourceMappings)
+}
+
+func TestValidateDescriptorsAndMappings(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(cfg *Config)
+		errMsg string
+	}{
+		{
+			name: "descriptor without name",
+			modify: func(cfg *Config) {
+				cfg.MetricDescriptors = []MetricDescriptorConfig{{Unit: "s"}}
+			},
+			errMsg: "metric_descriptors[0] is invalid: name must be set",
+		},
+		{
+			name: "descriptor with invalid kind",
+			modify: func(cfg *Config) {
+				cfg.MetricDescriptors = []MetricDescriptorConfig{{Name: "app.requests", Kind: "delta"}}
+			},
+			errMsg: `metric_descriptors[0] is invalid: invalid kind "delta", must be gauge or cumulative`,
+		},
+		{
+			name: "descriptor with invalid value type",
+			modify: func(cfg *Config) {
+				cfg.MetricDescriptors = []MetricDescriptorConfig{{Name: "app.requests", ValueType: "string"}}
+			},
+			errMsg: `metric_descriptors[0] is invalid: invalid value_type "string", must be double, int64 or distribution`,
+		},
+		{
+			name: "mapping without type",
+			modify: func(cfg *Config) {
+				cfg.ResourceMappings = []resourcemapping.Mapping{{Type: "k8s_node"}, {}}
+			},
+			errMsg: "resource_mappings[1] is invalid: type must be set",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := NewFactory().CreateDefaultConfig().(*Config)
+			tt.modify(cfg)
+			assert.EqualError(t, cfg.Validate(), tt.errMsg)
+		})
+	}
+}
+
 func sanitize(cfg *Config) *Config {
 	cfg.MetricConfig.MapMonitoredResource = nil
 	cfg.LogConfig.MapMonitoredResource = nil
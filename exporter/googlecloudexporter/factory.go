@@ -61,6 +61,9 @@ func createLogsExporter(
 	if customMonitoredResourcesGate.IsEnabled() {
 		eCfg.LogConfig.MapMonitoredResource = resourcemapping.CustomLoggingMonitoredResourceMapping
 	}
+	if len(eCfg.ResourceMappings) > 0 {
+		eCfg.LogConfig.MapMonitoredResource = resourcemapping.ConfiguredMonitoredResourceMapping(eCfg.ResourceMappings, eCfg.LogConfig.MapMonitoredResource)
+	}
 	logsExporter, err := collector.NewGoogleCloudLogsExporter(ctx, eCfg.Config, params, eCfg.TimeoutSettings.Timeout)
 	if err != nil {
 		return nil, err
@@ -116,6 +119,9 @@ func createMetricsExporter(
 	if customMonitoredResourcesGate.IsEnabled() {
 		eCfg.MetricConfig.MapMonitoredResource = resourcemapping.CustomMetricMonitoredResourceMapping
 	}
+	if len(eCfg.ResourceMappings) > 0 {
+		eCfg.MetricConfig.MapMonitoredResource = resourcemapping.ConfiguredMonitoredResourceMapping(eCfg.ResourceMappings, eCfg.MetricConfig.MapMonitoredResource)
+	}
 	mExp, err := collector.NewGoogleCloudMetricsExporter(ctx, eCfg.Config, params, eCfg.TimeoutSettings.Timeout)
 	if err != nil {
 		return nil, err
@@ -125,7 +131,12 @@ func createMetricsExporter(
 		params,
 		cfg,
 		mExp.PushMetrics,
-		exporterhelper.WithStart(mExp.Start),
+		exporterhelper.WithStart(func(ctx context.Context, host component.Host) error {
+			if err := mExp.Start(ctx, host); err != nil {
+				return err
+			}
+			return createMetricDescriptors(ctx, eCfg, params.BuildInfo.Version, params.Logger)
+		}),
 		exporterhelper.WithShutdown(mExp.Shutdown),
 		// Disable exporterhelper Timeout, since we are using a custom mechanism
 		// within exporter itself
@@ -0,0 +1,47 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package resourcemapping // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/googlecloudexporter/internal/resourcemapping"
+
+import (
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	monitoredrespb "google.golang.org/genproto/googleapis/api/monitoredres"
+)
+
+// Mapping maps the resources having all the attributes of its labels to a monitored resource type
+type Mapping struct {
+	// Type is the monitored resource type, such as `k8s_container`.
+	Type string `mapstructure:"type"`
+	// Labels maps the labels of the monitored resource to the resource attributes holding their values.
+	Labels map[string]string `mapstructure:"labels"`
+}
+
+// monitoredResource returns the monitored resource of the resource, if it has all the attributes of the labels
+func (m Mapping) monitoredResource(r pcommon.Resource) (*monitoredrespb.MonitoredResource, bool) {
+	labels := make(map[string]string, len(m.Labels))
+	for label, attribute := range m.Labels {
+		v, ok := r.Attributes().Get(attribute)
+		if !ok {
+			return nil, false
+		}
+		labels[label] = v.AsString()
+	}
+	return &monitoredrespb.MonitoredResource{
+		Type:   m.Type,
+		Labels: labels,
+	}, true
+}
+
+// ConfiguredMonitoredResourceMapping maps OTel resources to the monitored resource of the first of the
+// mappings the resource has all the attributes of, and falls back to mmrFunc for the other resources
+// instead of the generic_node or generic_task monitored resources.
+func ConfiguredMonitoredResourceMapping(mappings []Mapping, mmrFunc func(pcommon.Resource) *monitoredrespb.MonitoredResource) func(pcommon.Resource) *monitoredrespb.MonitoredResource {
+	return func(r pcommon.Resource) *monitoredrespb.MonitoredResource {
+		for _, m := range mappings {
+			if mr, ok := m.monitoredResource(r); ok {
+				return mr
+			}
+		}
+		return mmrFunc(r)
+	}
+}
@@ -0,0 +1,101 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package resourcemapping
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	monitoredrespb "google.golang.org/genproto/googleapis/api/monitoredres"
+)
+
+func TestConfiguredMonitoredResourceMapping(t *testing.T) {
+	mappings := []Mapping{
+		{
+			Type: "k8s_container",
+			Labels: map[string]string{
+				"location":       "cloud.region",
+				"cluster_name":   "k8s.cluster.name",
+				"namespace_name": "k8s.namespace.name",
+				"pod_name":       "k8s.pod.name",
+				"container_name": "k8s.container.name",
+			},
+		},
+		{
+			Type: "generic_task",
+			Labels: map[string]string{
+				"location":  "cloud.region",
+				"namespace": "service.namespace",
+				"job":       "service.name",
+				"task_id":   "service.instance.id",
+			},
+		},
+	}
+	fallback := &monitoredrespb.MonitoredResource{Type: "fallback"}
+	mapping := ConfiguredMonitoredResourceMapping(mappings, func(pcommon.Resource) *monitoredrespb.MonitoredResource {
+		return fallback
+	})
+
+	tests := []struct {
+		name       string
+		attributes map[string]any
+		want       *monitoredrespb.MonitoredResource
+	}{
+		{
+			name: "First mapping",
+			attributes: map[string]any{
+				"cloud.region":       "us-east1",
+				"k8s.cluster.name":   "cluster",
+				"k8s.namespace.name": "namespace",
+				"k8s.pod.name":       "pod",
+				"k8s.container.name": "container",
+				"service.name":       "service",
+			},
+			want: &monitoredrespb.MonitoredResource{
+				Type: "k8s_container",
+				Labels: map[string]string{
+					"location":       "us-east1",
+					"cluster_name":   "cluster",
+					"namespace_name": "namespace",
+					"pod_name":       "pod",
+					"container_name": "container",
+				},
+			},
+		},
+		{
+			name: "Second mapping when an attribute of the first one is missing",
+			attributes: map[string]any{
+				"cloud.region":        "us-east1",
+				"k8s.cluster.name":    "cluster",
+				"service.namespace":   "shop",
+				"service.name":        "checkout",
+				"service.instance.id": 1,
+			},
+			want: &monitoredrespb.MonitoredResource{
+				Type: "generic_task",
+				Labels: map[string]string{
+					"location":  "us-east1",
+					"namespace": "shop",
+					"job":       "checkout",
+					"task_id":   "1",
+				},
+			},
+		},
+		{
+			name: "Fallback when no mapping matches",
+			attributes: map[string]any{
+				"service.name": "checkout",
+			},
+			want: fallback,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			r := pcommon.NewResource()
+			assert.NoError(t, r.Attributes().FromRaw(test.attributes))
+			assert.Equal(t, test.want, mapping(r))
+		})
+	}
+}
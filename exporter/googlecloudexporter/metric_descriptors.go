@@ -0,0 +1,152 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package googlecloudexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/googlecloudexporter"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path"
+	"strings"
+
+	monitoring "cloud.google.com/go/monitoring/apiv3/v2"
+	"cloud.google.com/go/monitoring/apiv3/v2/monitoringpb"
+	"github.com/GoogleCloudPlatform/opentelemetry-operations-go/exporter/collector"
+	"go.uber.org/zap"
+	"golang.org/x/oauth2/google"
+	"google.golang.org/api/option"
+	labelpb "google.golang.org/genproto/googleapis/api/label"
+	metricpb "google.golang.org/genproto/googleapis/api/metric"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// MetricDescriptorConfig defines a metric descriptor created in Cloud Monitoring when the exporter starts,
+// so that the metric has its unit and description before any of its time series is written.
+type MetricDescriptorConfig struct {
+	// Name is the name of the OTel metric, which is prefixed like the exported metrics.
+	Name string `mapstructure:"name"`
+	// Kind is the metric kind, either `gauge` (default) or `cumulative`.
+	Kind string `mapstructure:"kind"`
+	// ValueType is the value type, one of `double` (default), `int64` or `distribution`.
+	ValueType   string `mapstructure:"value_type"`
+	Unit        string `mapstructure:"unit"`
+	Description string `mapstructure:"description"`
+	DisplayName string `mapstructure:"display_name"`
+	// Labels are the keys of the metric labels.
+	Labels []string `mapstructure:"labels"`
+}
+
+var (
+	metricKinds = map[string]metricpb.MetricDescriptor_MetricKind{
+		"":           metricpb.MetricDescriptor_GAUGE,
+		"gauge":      metricpb.MetricDescriptor_GAUGE,
+		"cumulative": metricpb.MetricDescriptor_CUMULATIVE,
+	}
+	valueTypes = map[string]metricpb.MetricDescriptor_ValueType{
+		"":             metricpb.MetricDescriptor_DOUBLE,
+		"double":       metricpb.MetricDescriptor_DOUBLE,
+		"int64":        metricpb.MetricDescriptor_INT64,
+		"distribution": metricpb.MetricDescriptor_DISTRIBUTION,
+	}
+)
+
+func (d MetricDescriptorConfig) validate() error {
+	if d.Name == "" {
+		return errors.New("name must be set")
+	}
+	if _, ok := metricKinds[d.Kind]; !ok {
+		return fmt.Errorf("invalid kind %q, must be gauge or cumulative", d.Kind)
+	}
+	if _, ok := valueTypes[d.ValueType]; !ok {
+		return fmt.Errorf("invalid value_type %q, must be double, int64 or distribution", d.ValueType)
+	}
+	return nil
+}
+
+// metricDescriptor returns the Cloud Monitoring metric descriptor of the metric
+func (d MetricDescriptorConfig) metricDescriptor(cfg collector.MetricConfig) *metricpb.MetricDescriptor {
+	metricType := metricType(cfg, d.Name)
+	labels := make([]*labelpb.LabelDescriptor, 0, len(d.Labels))
+	for _, key := range d.Labels {
+		labels = append(labels, &labelpb.LabelDescriptor{Key: key})
+	}
+	displayName := d.DisplayName
+	if displayName == "" {
+		displayName = d.Name
+	}
+	return &metricpb.MetricDescriptor{
+		Name:        metricType,
+		Type:        metricType,
+		Labels:      labels,
+		MetricKind:  metricKinds[d.Kind],
+		ValueType:   valueTypes[d.ValueType],
+		Unit:        d.Unit,
+		Description: d.Description,
+		DisplayName: displayName,
+	}
+}
+
+// metricType returns the type of the metric in Cloud Monitoring, the metrics of the known domains
+// being exported without prefix
+func metricType(cfg collector.MetricConfig, name string) string {
+	for _, domain := range cfg.KnownDomains {
+		if strings.Contains(name, domain) {
+			return name
+		}
+	}
+	return path.Join(cfg.Prefix, name)
+}
+
+// createMetricDescriptors creates the configured metric descriptors. The descriptors that can't be created
+// are only logged, as the exporter still creates the descriptors of the exported metrics on the fly.
+func createMetricDescriptors(ctx context.Context, cfg *Config, version string, logger *zap.Logger) error {
+	if len(cfg.MetricDescriptors) == 0 {
+		return nil
+	}
+
+	projectID := cfg.ProjectID
+	if projectID == "" {
+		creds, err := google.FindDefaultCredentials(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to find the project of the metric descriptors: %w", err)
+		}
+		projectID = creds.ProjectID
+	}
+
+	opts := []option.ClientOption{
+		option.WithUserAgent(strings.ReplaceAll(cfg.UserAgent, "{{version}}", version)),
+	}
+	if endpoint := cfg.MetricConfig.ClientConfig.Endpoint; endpoint != "" {
+		opts = append(opts, option.WithEndpoint(endpoint))
+		if cfg.MetricConfig.ClientConfig.UseInsecure {
+			conn, err := grpc.NewClient(endpoint, grpc.WithTransportCredentials(insecure.NewCredentials()))
+			if err != nil {
+				return err
+			}
+			defer conn.Close()
+			opts = append(opts, option.WithGRPCConn(conn))
+		}
+	}
+
+	client, err := monitoring.NewMetricClient(ctx, opts...)
+	if err != nil {
+		return fmt.Errorf("failed to create the metric descriptors client: %w", err)
+	}
+	defer client.Close()
+
+	for _, d := range cfg.MetricDescriptors {
+		descriptor := d.metricDescriptor(cfg.MetricConfig)
+		_, err := client.CreateMetricDescriptor(ctx, &monitoringpb.CreateMetricDescriptorRequest{
+			Name:             "projects/" + projectID,
+			MetricDescriptor: descriptor,
+		})
+		if err != nil {
+			logger.Warn("Failed to create metric descriptor", zap.String("metric_type", descriptor.Type), zap.Error(err))
+			continue
+		}
+		logger.Debug("Created metric descriptor", zap.String("metric_type", descriptor.Type))
+	}
+	return nil
+}
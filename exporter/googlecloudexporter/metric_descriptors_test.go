@@ -0,0 +1,52 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package googlecloudexporter
+
+import (
+	"testing"
+
+	"github.com/GoogleCloudPlatform/opentelemetry-operations-go/exporter/collector"
+	"github.com/stretchr/testify/assert"
+	labelpb "google.golang.org/genproto/googleapis/api/label"
+	metricpb "google.golang.org/genproto/googleapis/api/metric"
+)
+
+func TestMetricType(t *testing.T) {
+	cfg := collector.DefaultConfig().MetricConfig
+	assert.Equal(t, "workload.googleapis.com/app.requests", metricType(cfg, "app.requests"))
+	assert.Equal(t, "kubernetes.io/container/cpu", metricType(cfg, "kubernetes.io/container/cpu"))
+
+	cfg.Prefix = "custom.googleapis.com"
+	assert.Equal(t, "custom.googleapis.com/app.requests", metricType(cfg, "app.requests"))
+}
+
+func TestMetricDescriptor(t *testing.T) {
+	cfg := collector.DefaultConfig().MetricConfig
+
+	d := MetricDescriptorConfig{
+		Name:        "app.requests",
+		Kind:        "cumulative",
+		ValueType:   "int64",
+		Unit:        "{request}",
+		Description: "Number of requests served.",
+		Labels:      []string{"route"},
+	}
+	assert.Equal(t, &metricpb.MetricDescriptor{
+		Name:        "workload.googleapis.com/app.requests",
+		Type:        "workload.googleapis.com/app.requests",
+		Labels:      []*labelpb.LabelDescriptor{{Key: "route"}},
+		MetricKind:  metricpb.MetricDescriptor_CUMULATIVE,
+		ValueType:   metricpb.MetricDescriptor_INT64,
+		Unit:        "{request}",
+		Description: "Number of requests served.",
+		DisplayName: "app.requests",
+	}, d.metricDescriptor(cfg))
+
+	defaults := MetricDescriptorConfig{Name: "app.queue.size", DisplayName: "Queue size"}
+	descriptor := defaults.metricDescriptor(cfg)
+	assert.Equal(t, metricpb.MetricDescriptor_GAUGE, descriptor.MetricKind)
+	assert.Equal(t, metricpb.MetricDescriptor_DOUBLE, descriptor.ValueType)
+	assert.Equal(t, "Queue size", descriptor.DisplayName)
+	assert.Empty(t, descriptor.Labels)
+}
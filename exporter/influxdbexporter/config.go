@@ -4,6 +4,7 @@
 package influxdbexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/influxdbexporter"
 
 import (
+	"errors"
 	"fmt"
 	"strings"
 
@@ -26,6 +27,30 @@ type V1Compatibility struct {
 	Password configopaque.String `mapstructure:"password"`
 }
 
+// V3 is used to specify if the exporter should use the InfluxDB 3.0 write API.
+type V3 struct {
+	// Enabled is used to specify if the exporter should use the InfluxDB 3.0 write API (/api/v3/write_lp).
+	Enabled bool `mapstructure:"enabled"`
+	// Database is used to specify the name of the InfluxDB 3.0 database that telemetry will be written to.
+	Database string `mapstructure:"database"`
+	// AcceptPartial is used to specify if InfluxDB should accept the valid lines of a request that contains invalid lines.
+	AcceptPartial bool `mapstructure:"accept_partial"`
+	// NoSync is used to specify if InfluxDB should acknowledge writes before they are persisted to the WAL.
+	NoSync bool `mapstructure:"no_sync"`
+	// TableNames maps the measurement names produced by the exporter to InfluxDB 3.0 table names.
+	TableNames map[string]string `mapstructure:"table_names"`
+}
+
+// SchemaRule overrides whether attributes are written as line protocol tags or fields.
+type SchemaRule struct {
+	// Measurement restricts the rule to points of the given measurement; if empty, the rule applies to all points.
+	Measurement string `mapstructure:"measurement"`
+	// Tags are keys that are written as tags, even if the conversion schema would write them as fields.
+	Tags []string `mapstructure:"tags"`
+	// Fields are keys that are written as fields, even if the conversion schema would write them as tags.
+	Fields []string `mapstructure:"fields"`
+}
+
 // Config defines configuration for the InfluxDB exporter.
 type Config struct {
 	confighttp.ClientConfig   `mapstructure:",squash"`
@@ -40,6 +65,8 @@ type Config struct {
 	Token configopaque.String `mapstructure:"token"`
 	// V1Compatibility is used to specify if the exporter should use the v1.X InfluxDB API schema.
 	V1Compatibility V1Compatibility `mapstructure:"v1_compatibility"`
+	// V3 is used to specify if the exporter should use the InfluxDB 3.0 write API.
+	V3 V3 `mapstructure:"v3"`
 
 	// SpanDimensions are span attributes to be used as line protocol tags.
 	// These are always included as tags:
@@ -70,6 +97,9 @@ type Config struct {
 	// - telegraf-prometheus-v2
 	MetricsSchema string `mapstructure:"metrics_schema"`
 
+	// Schema overrides the tag vs field mapping of the conversion schema, per measurement and key.
+	Schema []SchemaRule `mapstructure:"schema"`
+
 	// PayloadMaxLines is the maximum number of line protocol lines to POST in a single request.
 	PayloadMaxLines int `mapstructure:"payload_max_lines"`
 	// PayloadMaxBytes is the maximum number of line protocol bytes to POST in a single request.
@@ -77,6 +107,25 @@ type Config struct {
 }
 
 func (cfg *Config) Validate() error {
+	if cfg.V1Compatibility.Enabled && cfg.V3.Enabled {
+		return errors.New("v1_compatibility and v3 cannot be enabled at the same time")
+	}
+	if cfg.V3.Enabled && cfg.V3.Database == "" {
+		return errors.New("v3.database must be specified when v3 is enabled")
+	}
+
+	for i, rule := range cfg.Schema {
+		tags := make(map[string]struct{}, len(rule.Tags))
+		for _, k := range rule.Tags {
+			tags[k] = struct{}{}
+		}
+		for _, k := range rule.Fields {
+			if _, found := tags[k]; found {
+				return fmt.Errorf("schema[%d]: key %q configured as both tag and field", i, k)
+			}
+		}
+	}
+
 	spanDimensions := make(map[string]struct{}, len(cfg.SpanDimensions))
 	duplicateSpanDimensions := make(map[string]struct{})
 	for _, k := range cfg.SpanDimensions {
@@ -68,6 +68,30 @@ func TestLoadConfig(t *testing.T) {
 				PayloadMaxBytes:     27,
 			},
 		},
+		{
+			id: component.NewIDWithName(metadata.Type, "v3-config"),
+			expected: func() component.Config {
+				cfg := createDefaultConfig().(*Config)
+				cfg.Endpoint = "http://localhost:8181"
+				cfg.Token = "my-token"
+				cfg.V3 = V3{
+					Enabled:       true,
+					Database:      "my-db",
+					AcceptPartial: true,
+					TableNames:    map[string]string{"spans": "otel_spans"},
+				}
+				cfg.Schema = []SchemaRule{
+					{
+						Tags: []string{"host.name"},
+					},
+					{
+						Measurement: "spans",
+						Fields:      []string{"span.name"},
+					},
+				}
+				return cfg
+			}(),
+		},
 	}
 
 	for _, tt := range tests {
@@ -0,0 +1,109 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package influxdbexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/influxdbexporter"
+
+import (
+	"fmt"
+)
+
+// schemaOverrides holds the keys that must be moved between tags and fields.
+type schemaOverrides struct {
+	tags   map[string]struct{}
+	fields map[string]struct{}
+}
+
+// schemaMapping applies the configured schema rules to line protocol points.
+type schemaMapping struct {
+	// global applies to every measurement.
+	global schemaOverrides
+	// byMeasurement applies to points of a single measurement, in addition to global.
+	byMeasurement map[string]schemaOverrides
+}
+
+func newSchemaMapping(rules []SchemaRule) schemaMapping {
+	m := schemaMapping{
+		global:        newSchemaOverrides(),
+		byMeasurement: make(map[string]schemaOverrides),
+	}
+	for _, rule := range rules {
+		overrides := m.global
+		if rule.Measurement != "" {
+			var found bool
+			if overrides, found = m.byMeasurement[rule.Measurement]; !found {
+				overrides = newSchemaOverrides()
+				m.byMeasurement[rule.Measurement] = overrides
+			}
+		}
+		for _, k := range rule.Tags {
+			overrides.tags[k] = struct{}{}
+		}
+		for _, k := range rule.Fields {
+			overrides.fields[k] = struct{}{}
+		}
+	}
+	return m
+}
+
+func newSchemaOverrides() schemaOverrides {
+	return schemaOverrides{
+		tags:   make(map[string]struct{}),
+		fields: make(map[string]struct{}),
+	}
+}
+
+// apply moves the configured keys between tags and fields of a point.
+// Measurement specific rules take precedence over global rules.
+func (m schemaMapping) apply(measurement string, tags map[string]string, fields map[string]any) (map[string]string, map[string]any) {
+	if len(m.global.tags) == 0 && len(m.global.fields) == 0 && len(m.byMeasurement) == 0 {
+		return tags, fields
+	}
+	overrides, found := m.byMeasurement[measurement]
+
+	isTag := func(k string) bool {
+		if found {
+			if _, ok := overrides.tags[k]; ok {
+				return true
+			}
+			if _, ok := overrides.fields[k]; ok {
+				return false
+			}
+		}
+		_, ok := m.global.tags[k]
+		return ok
+	}
+	isField := func(k string) bool {
+		if found {
+			if _, ok := overrides.fields[k]; ok {
+				return true
+			}
+			if _, ok := overrides.tags[k]; ok {
+				return false
+			}
+		}
+		_, ok := m.global.fields[k]
+		return ok
+	}
+
+	newTags := make(map[string]string, len(tags))
+	newFields := make(map[string]any, len(fields))
+	for k, v := range tags {
+		if isField(k) {
+			newFields[k] = v
+		} else {
+			newTags[k] = v
+		}
+	}
+	for k, v := range fields {
+		if isTag(k) {
+			newTags[k] = fmt.Sprint(v)
+		} else {
+			newFields[k] = v
+		}
+	}
+	if len(newFields) == 0 {
+		// Line protocol requires at least one field, keep the point as it is.
+		return tags, fields
+	}
+	return newTags, newFields
+}
@@ -0,0 +1,73 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package influxdbexporter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_schemaMapping_apply(t *testing.T) {
+	mapping := newSchemaMapping([]SchemaRule{
+		{
+			Tags:   []string{"host.name"},
+			Fields: []string{"http.url"},
+		},
+		{
+			Measurement: "spans",
+			Tags:        []string{"http.url"},
+			Fields:      []string{"span.name"},
+		},
+	})
+
+	tests := []struct {
+		name           string
+		measurement    string
+		tags           map[string]string
+		fields         map[string]any
+		expectedTags   map[string]string
+		expectedFields map[string]any
+	}{
+		{
+			name:           "global rules",
+			measurement:    "logs",
+			tags:           map[string]string{"http.url": "/a", "service.name": "svc"},
+			fields:         map[string]any{"host.name": "h1", "body": "hello"},
+			expectedTags:   map[string]string{"host.name": "h1", "service.name": "svc"},
+			expectedFields: map[string]any{"http.url": "/a", "body": "hello"},
+		},
+		{
+			name:           "measurement rules take precedence",
+			measurement:    "spans",
+			tags:           map[string]string{"span.name": "GET", "service.name": "svc"},
+			fields:         map[string]any{"http.url": "/a", "host.name": "h1", "duration_nano": int64(10)},
+			expectedTags:   map[string]string{"http.url": "/a", "host.name": "h1", "service.name": "svc"},
+			expectedFields: map[string]any{"span.name": "GET", "duration_nano": int64(10)},
+		},
+		{
+			name:           "non-string field converted to tag",
+			measurement:    "cpu",
+			tags:           map[string]string{},
+			fields:         map[string]any{"host.name": int64(7), "gauge": 1.5},
+			expectedTags:   map[string]string{"host.name": "7"},
+			expectedFields: map[string]any{"gauge": 1.5},
+		},
+		{
+			name:           "point without remaining fields is kept as is",
+			measurement:    "cpu",
+			tags:           map[string]string{"k": "v"},
+			fields:         map[string]any{"host.name": "h1"},
+			expectedTags:   map[string]string{"k": "v"},
+			expectedFields: map[string]any{"host.name": "h1"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tags, fields := mapping.apply(tt.measurement, tt.tags, tt.fields)
+			assert.Equal(t, tt.expectedTags, tags)
+			assert.Equal(t, tt.expectedFields, fields)
+		})
+	}
+}
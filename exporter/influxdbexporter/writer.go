@@ -12,6 +12,7 @@ import (
 	"net/http"
 	"net/url"
 	"sort"
+	"strconv"
 	"sync"
 	"time"
 
@@ -35,6 +36,8 @@ type influxHTTPWriter struct {
 	writeURL           string
 	payloadMaxLines    int
 	payloadMaxBytes    int
+	tableNames         map[string]string
+	schema             schemaMapping
 
 	logger common.Logger
 }
@@ -45,6 +48,11 @@ func newInfluxHTTPWriter(logger common.Logger, config *Config, telemetrySettings
 		return nil, err
 	}
 
+	var tableNames map[string]string
+	if config.V3.Enabled {
+		tableNames = config.V3.TableNames
+	}
+
 	return &influxHTTPWriter{
 		encoderPool: sync.Pool{
 			New: func() any {
@@ -59,6 +67,8 @@ func newInfluxHTTPWriter(logger common.Logger, config *Config, telemetrySettings
 		writeURL:           writeURL,
 		payloadMaxLines:    config.PayloadMaxLines,
 		payloadMaxBytes:    config.PayloadMaxBytes,
+		tableNames:         tableNames,
+		schema:             newSchemaMapping(config.Schema),
 		logger:             logger,
 	}, nil
 }
@@ -69,12 +79,18 @@ func composeWriteURL(config *Config) (string, error) {
 		return "", err
 	}
 	if writeURL.Path == "" || writeURL.Path == "/" {
-		if config.V1Compatibility.Enabled {
+		switch {
+		case config.V3.Enabled:
+			writeURL, err = writeURL.Parse("api/v3/write_lp")
+			if err != nil {
+				return "", err
+			}
+		case config.V1Compatibility.Enabled:
 			writeURL, err = writeURL.Parse("write")
 			if err != nil {
 				return "", err
 			}
-		} else {
+		default:
 			writeURL, err = writeURL.Parse("api/v2/write")
 			if err != nil {
 				return "", err
@@ -82,9 +98,22 @@ func composeWriteURL(config *Config) (string, error) {
 		}
 	}
 	queryValues := writeURL.Query()
-	queryValues.Set("precision", "ns")
 
-	if config.V1Compatibility.Enabled {
+	switch {
+	case config.V3.Enabled:
+		queryValues.Set("precision", "nanosecond")
+		queryValues.Set("db", config.V3.Database)
+		queryValues.Set("accept_partial", strconv.FormatBool(config.V3.AcceptPartial))
+		queryValues.Set("no_sync", strconv.FormatBool(config.V3.NoSync))
+
+		if config.Token != "" {
+			if config.Headers == nil {
+				config.Headers = make(map[string]configopaque.String, 1)
+			}
+			config.Headers["Authorization"] = "Bearer " + config.Token
+		}
+	case config.V1Compatibility.Enabled:
+		queryValues.Set("precision", "ns")
 		queryValues.Set("db", config.V1Compatibility.DB)
 
 		if config.V1Compatibility.Username != "" && config.V1Compatibility.Password != "" {
@@ -95,7 +124,8 @@ func composeWriteURL(config *Config) (string, error) {
 			}
 			config.Headers["Authorization"] = configopaque.String("Basic " + basicAuth)
 		}
-	} else {
+	default:
+		queryValues.Set("precision", "ns")
 		queryValues.Set("org", config.Org)
 		queryValues.Set("bucket", config.Bucket)
 
@@ -148,6 +178,11 @@ func (b *influxHTTPWriterBatch) EnqueuePoint(ctx context.Context, measurement st
 		b.encoder = b.encoderPool.Get().(*lineprotocol.Encoder)
 	}
 
+	tags, fields = b.schema.apply(measurement, tags, fields)
+	if table, ok := b.tableNames[measurement]; ok {
+		measurement = table
+	}
+
 	b.encoder.StartLine(measurement)
 	for _, tag := range b.optimizeTags(tags) {
 		b.encoder.AddTag(tag.k, tag.v)
@@ -207,3 +207,63 @@ func Test_composeWriteURL_doesNotPanic(t *testing.T) {
 		assert.NoError(t, err)
 	})
 }
+
+func Test_composeWriteURL_v3(t *testing.T) {
+	cfg := &Config{
+		ClientConfig: confighttp.ClientConfig{
+			Endpoint: "http://localhost:8181",
+		},
+		Token: "my-token",
+		V3: V3{
+			Enabled:       true,
+			Database:      "my-db",
+			AcceptPartial: true,
+		},
+	}
+	writeURL, err := composeWriteURL(cfg)
+	require.NoError(t, err)
+	assert.Equal(t, "http://localhost:8181/api/v3/write_lp?accept_partial=true&db=my-db&no_sync=false&precision=nanosecond", writeURL)
+	assert.Equal(t, "Bearer my-token", string(cfg.Headers["Authorization"]))
+}
+
+func Test_influxHTTPWriterBatch_EnqueuePoint_v3TableNames(t *testing.T) {
+	var recordedRequestBody []byte
+	noopHTTPServer := httptest.NewServer(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		recordedRequestBody, _ = io.ReadAll(r.Body)
+	}))
+	t.Cleanup(noopHTTPServer.Close)
+
+	clientConfig := confighttp.NewDefaultClientConfig()
+	clientConfig.Endpoint = noopHTTPServer.URL
+
+	influxWriter, err := newInfluxHTTPWriter(
+		new(common.NoopLogger),
+		&Config{
+			ClientConfig: clientConfig,
+			V3: V3{
+				Enabled:    true,
+				Database:   "my-db",
+				TableNames: map[string]string{"m": "my_table"},
+			},
+			Schema: []SchemaRule{{Fields: []string{"k"}}},
+		},
+		componenttest.NewNopTelemetrySettings())
+	require.NoError(t, err)
+	influxWriter.httpClient = noopHTTPServer.Client()
+	influxWriterBatch := influxWriter.NewBatch()
+
+	err = influxWriterBatch.EnqueuePoint(
+		context.Background(),
+		"m",
+		map[string]string{"k": "v", "t": "w"},
+		map[string]any{"f": int64(1)},
+		time.Unix(1000, 2000),
+		common.InfluxMetricValueTypeUntyped)
+	require.NoError(t, err)
+	require.NoError(t, influxWriterBatch.WriteBatch(context.Background()))
+
+	line := strings.TrimSpace(string(recordedRequestBody))
+	assert.True(t, strings.HasPrefix(line, "my_table,t=w "), line)
+	assert.Contains(t, line, `k="v"`)
+	assert.Contains(t, line, "f=1i")
+}
@@ -50,6 +50,16 @@ type Config struct {
 	// Supports all attributes available (both resource and span), as well as the pseudo attributes "span.kind" and
 	// "span.name".
 	RoutingAttributes []string `mapstructure:"routing_attributes"`
+
+	// Weights sets the relative weight of specific endpoints in the hash ring, keyed by endpoint. Endpoints
+	// without an explicit weight get a weight of 100, so an endpoint with a weight of 200 receives twice
+	// its share of the data.
+	Weights map[string]int `mapstructure:"weights"`
+
+	// SlowStart is the duration over which the weight of a newly discovered endpoint ramps up linearly
+	// to its full value, so that new backends are not immediately flooded with their full share of the data.
+	// Endpoints resolved when the exporter starts get their full weight right away. Disabled by default.
+	SlowStart time.Duration `mapstructure:"slow_start"`
 }
 
 // Protocol holds the individual protocol-specific settings. Only OTLP is supported at the moment.
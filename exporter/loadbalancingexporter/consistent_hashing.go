@@ -39,6 +39,15 @@ func newHashRing(endpoints []string) *hashRing {
 	}
 }
 
+// newWeightedHashRing builds a new immutable consistent hash ring based on the given endpoints, where
+// each endpoint gets the number of points in the ring returned by weightFor.
+func newWeightedHashRing(endpoints []string, weightFor func(endpoint string) int) *hashRing {
+	items := positionsForWeightedEndpoints(endpoints, weightFor)
+	return &hashRing{
+		items: items,
+	}
+}
+
 // endpointFor calculates which backend is responsible for the given traceID
 func (h *hashRing) endpointFor(identifier []byte) string {
 	if h == nil {
@@ -121,11 +130,18 @@ func positionsFor(endpoint string, numPoints int) []position {
 
 // positionsForEndpoints calculates all the positions for all the given endpoints
 func positionsForEndpoints(endpoints []string, weight int) []ringItem {
+	return positionsForWeightedEndpoints(endpoints, func(string) int { return weight })
+}
+
+// positionsForWeightedEndpoints calculates all the positions for all the given endpoints, using weightFor
+// to determine the number of positions of each endpoint. As the positions of an endpoint with a lower weight
+// are a subset of the positions it gets with a higher weight, changing the weight of a single endpoint only
+// moves the identifiers between that endpoint and its neighbors.
+func positionsForWeightedEndpoints(endpoints []string, weightFor func(endpoint string) int) []ringItem {
 	var items []ringItem
 	positions := map[position]bool{} // tracking the used positions
 	for _, endpoint := range endpoints {
-		// for this initial implementation, we don't allow endpoints to have custom weights
-		for _, pos := range positionsFor(endpoint, weight) {
+		for _, pos := range positionsFor(endpoint, weightFor(endpoint)) {
 			// if this position is occupied already, look ahead in the array for a free position
 			actualPos := pos
 			positionsProbed := 0
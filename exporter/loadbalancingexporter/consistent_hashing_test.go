@@ -21,6 +21,34 @@ func TestNewHashRing(t *testing.T) {
 	assert.Len(t, ring.items, 2*defaultWeight)
 }
 
+func TestNewWeightedHashRing(t *testing.T) {
+	// prepare
+	endpoints := []string{"endpoint-1", "endpoint-2"}
+	weights := map[string]int{"endpoint-1": 200}
+
+	// test
+	ring := newWeightedHashRing(endpoints, func(endpoint string) int {
+		if w, ok := weights[endpoint]; ok {
+			return w
+		}
+		return defaultWeight
+	})
+
+	// verify
+	assert.Equal(t, 200, countItemsFor(ring, "endpoint-1"))
+	assert.Equal(t, defaultWeight, countItemsFor(ring, "endpoint-2"))
+}
+
+func countItemsFor(ring *hashRing, endpoint string) int {
+	count := 0
+	for _, item := range ring.items {
+		if item.endpoint == endpoint {
+			count++
+		}
+	}
+	return count
+}
+
 func TestEndpointFor(t *testing.T) {
 	// prepare
 	endpoints := []string{"endpoint-1", "endpoint-2"}
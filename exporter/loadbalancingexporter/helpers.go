@@ -4,6 +4,9 @@
 package loadbalancingexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/loadbalancingexporter"
 
 import (
+	"strings"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/ptrace"
 )
 
@@ -12,3 +15,15 @@ func mergeTraces(t1, t2 ptrace.Traces) ptrace.Traces {
 	t2.ResourceSpans().MoveAndAppendTo(t1.ResourceSpans())
 	return t1
 }
+
+// routingKeyFromAttributes composes a routing key out of the values of the given attribute keys.
+// Missing attributes contribute an empty value, so there is always a key (even if that key is "").
+func routingKeyFromAttributes(attrs pcommon.Map, keys []string) string {
+	var rKey strings.Builder
+	for _, k := range keys {
+		if v, ok := attrs.Get(k); ok {
+			rKey.WriteString(v.AsString())
+		}
+	}
+	return rKey.String()
+}
@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"strings"
 	"sync"
+	"time"
 
 	"go.opentelemetry.io/collector/component"
 	"go.uber.org/zap"
@@ -18,6 +19,11 @@ import (
 
 const (
 	defaultPort = "4317"
+
+	// slowStartSteps is the number of times the ring is rebuilt while the weight of new endpoints ramps up
+	slowStartSteps = 10
+	// minSlowStart is the shortest slow start, so that the ring is rebuilt at most every millisecond
+	minSlowStart = slowStartSteps * time.Millisecond
 )
 
 var (
@@ -37,6 +43,16 @@ type loadBalancer struct {
 	componentFactory componentFactory
 	exporters        map[string]*wrappedExporter
 
+	// weights holds the configured weight of specific endpoints
+	weights map[string]int
+	// slowStart is the duration over which the weight of new endpoints ramps up
+	slowStart time.Duration
+	// endpoints holds the last resolved endpoints
+	endpoints []string
+	// discoveredAt holds the time at which endpoints still in their slow start window were discovered
+	discoveredAt map[string]time.Time
+	stopCh       chan struct{}
+
 	stopped    bool
 	updateLock sync.RWMutex
 }
@@ -135,27 +151,61 @@ func newLoadBalancer(logger *zap.Logger, cfg component.Config, factory component
 		return nil, errNoResolver
 	}
 
+	for endpoint, weight := range oCfg.Weights {
+		if weight <= 0 {
+			return nil, fmt.Errorf("invalid weight %d for endpoint %q: must be greater than zero", weight, endpoint)
+		}
+	}
+	if oCfg.SlowStart < 0 {
+		return nil, errors.New("slow_start must not be negative")
+	}
+	if oCfg.SlowStart > 0 && oCfg.SlowStart < minSlowStart {
+		return nil, fmt.Errorf("slow_start must be at least %v when enabled, got %v", minSlowStart, oCfg.SlowStart)
+	}
+
 	return &loadBalancer{
 		logger:           logger,
 		res:              res,
 		componentFactory: factory,
 		exporters:        map[string]*wrappedExporter{},
+		weights:          oCfg.Weights,
+		slowStart:        oCfg.SlowStart,
+		discoveredAt:     map[string]time.Time{},
 	}, nil
 }
 
 func (lb *loadBalancer) Start(ctx context.Context, host component.Host) error {
 	lb.res.onChange(lb.onBackendChanges)
 	lb.host = host
+	if lb.slowStart > 0 {
+		lb.stopCh = make(chan struct{})
+		go lb.rampUpWeights(lb.slowStart / slowStartSteps)
+	}
 	return lb.res.start(ctx)
 }
 
 func (lb *loadBalancer) onBackendChanges(resolved []string) {
-	newRing := newHashRing(resolved)
+	lb.updateLock.Lock()
+	defer lb.updateLock.Unlock()
 
-	if !newRing.equal(lb.ring) {
-		lb.updateLock.Lock()
-		defer lb.updateLock.Unlock()
+	now := time.Now()
+	for endpoint := range lb.discoveredAt {
+		if !endpointFound(endpoint, resolved) {
+			delete(lb.discoveredAt, endpoint)
+		}
+	}
+	// endpoints resolved when starting get their full weight right away
+	if lb.slowStart > 0 && lb.ring != nil {
+		for _, endpoint := range resolved {
+			if !endpointFound(endpoint, lb.endpoints) {
+				lb.discoveredAt[endpoint] = now
+			}
+		}
+	}
+	lb.endpoints = resolved
 
+	newRing := lb.buildRing(now)
+	if !newRing.equal(lb.ring) {
 		lb.ring = newRing
 
 		// TODO: set a timeout?
@@ -167,6 +217,55 @@ func (lb *loadBalancer) onBackendChanges(resolved []string) {
 	}
 }
 
+// rampUpWeights periodically rebuilds the ring, so that endpoints in their slow start window
+// gradually receive more data.
+func (lb *loadBalancer) rampUpWeights(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			lb.updateLock.Lock()
+			if len(lb.discoveredAt) > 0 {
+				lb.ring = lb.buildRing(time.Now())
+			}
+			lb.updateLock.Unlock()
+		case <-lb.stopCh:
+			return
+		}
+	}
+}
+
+// buildRing builds the hash ring for the current endpoints, taking their weights and slow start into account.
+// It must be called while holding the update lock.
+func (lb *loadBalancer) buildRing(now time.Time) *hashRing {
+	if len(lb.weights) == 0 && len(lb.discoveredAt) == 0 {
+		return newHashRing(lb.endpoints)
+	}
+
+	return newWeightedHashRing(lb.endpoints, func(endpoint string) int {
+		weight, ok := lb.weights[endpoint]
+		if !ok {
+			weight, ok = lb.weights[endpointWithPort(endpoint)]
+		}
+		if !ok {
+			weight = defaultWeight
+		}
+
+		discoveredAt, ok := lb.discoveredAt[endpoint]
+		if !ok {
+			return weight
+		}
+		elapsed := now.Sub(discoveredAt)
+		if elapsed >= lb.slowStart {
+			delete(lb.discoveredAt, endpoint)
+			return weight
+		}
+		// an endpoint always gets at least one position, so that it starts receiving data
+		return max(1, int(int64(weight)*int64(elapsed)/int64(lb.slowStart)))
+	})
+}
+
 func (lb *loadBalancer) addMissingExporters(ctx context.Context, endpoints []string) {
 	for _, endpoint := range endpoints {
 		endpoint = endpointWithPort(endpoint)
@@ -224,6 +323,10 @@ func endpointFound(endpoint string, endpoints []string) bool {
 func (lb *loadBalancer) Shutdown(ctx context.Context) error {
 	err := lb.res.shutdown(ctx)
 	lb.stopped = true
+	if lb.stopCh != nil {
+		close(lb.stopCh)
+		lb.stopCh = nil
+	}
 
 	for _, e := range lb.exporters {
 		err = errors.Join(err, e.Shutdown(ctx))
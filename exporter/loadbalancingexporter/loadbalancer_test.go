@@ -7,6 +7,7 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -238,6 +239,100 @@ func TestOnBackendChanges(t *testing.T) {
 	assert.Len(t, p.ring.items, 2*defaultWeight)
 }
 
+func TestOnBackendChangesWithWeights(t *testing.T) {
+	// prepare
+	ts, tb := getTelemetryAssets(t)
+	cfg := simpleConfig()
+	cfg.Weights = map[string]int{"endpoint-2:4317": 50}
+	componentFactory := func(_ context.Context, _ string) (component.Component, error) {
+		return newNopMockExporter(), nil
+	}
+
+	p, err := newLoadBalancer(ts.Logger, cfg, componentFactory, tb)
+	require.NotNil(t, p)
+	require.NoError(t, err)
+
+	// test
+	p.onBackendChanges([]string{"endpoint-1", "endpoint-2"})
+
+	// verify
+	assert.Equal(t, defaultWeight, countItemsFor(p.ring, "endpoint-1"))
+	assert.Equal(t, 50, countItemsFor(p.ring, "endpoint-2"))
+}
+
+func TestInvalidWeight(t *testing.T) {
+	ts, tb := getTelemetryAssets(t)
+	cfg := simpleConfig()
+	cfg.Weights = map[string]int{"endpoint-1": 0}
+
+	p, err := newLoadBalancer(ts.Logger, cfg, nil, tb)
+	require.Nil(t, p)
+	require.EqualError(t, err, `invalid weight 0 for endpoint "endpoint-1": must be greater than zero`)
+}
+
+func TestInvalidSlowStart(t *testing.T) {
+	for _, tt := range []struct {
+		name        string
+		slowStart   time.Duration
+		expectedErr string
+	}{
+		{
+			name:        "negative",
+			slowStart:   -time.Second,
+			expectedErr: "slow_start must not be negative",
+		},
+		{
+			name:        "too short",
+			slowStart:   5 * time.Nanosecond,
+			expectedErr: "slow_start must be at least 10ms when enabled, got 5ns",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ts, tb := getTelemetryAssets(t)
+			cfg := simpleConfig()
+			cfg.SlowStart = tt.slowStart
+
+			p, err := newLoadBalancer(ts.Logger, cfg, nil, tb)
+			require.Nil(t, p)
+			require.EqualError(t, err, tt.expectedErr)
+		})
+	}
+}
+
+func TestOnBackendChangesSlowStart(t *testing.T) {
+	// prepare
+	ts, tb := getTelemetryAssets(t)
+	cfg := simpleConfig()
+	cfg.SlowStart = time.Hour
+	componentFactory := func(_ context.Context, _ string) (component.Component, error) {
+		return newNopMockExporter(), nil
+	}
+
+	p, err := newLoadBalancer(ts.Logger, cfg, componentFactory, tb)
+	require.NotNil(t, p)
+	require.NoError(t, err)
+
+	// endpoints resolved initially get their full weight
+	p.onBackendChanges([]string{"endpoint-1"})
+	require.Len(t, p.ring.items, defaultWeight)
+
+	// a new endpoint starts with a minimal weight
+	p.onBackendChanges([]string{"endpoint-1", "endpoint-2"})
+	assert.Equal(t, defaultWeight, countItemsFor(p.ring, "endpoint-1"))
+	assert.Equal(t, 1, countItemsFor(p.ring, "endpoint-2"))
+
+	// halfway through the slow start window, it gets half of its weight
+	p.discoveredAt["endpoint-2"] = time.Now().Add(-30 * time.Minute)
+	p.ring = p.buildRing(time.Now())
+	assert.InDelta(t, defaultWeight/2, countItemsFor(p.ring, "endpoint-2"), 1)
+
+	// after the slow start window, it gets its full weight
+	p.discoveredAt["endpoint-2"] = time.Now().Add(-2 * time.Hour)
+	p.ring = p.buildRing(time.Now())
+	assert.Equal(t, defaultWeight, countItemsFor(p.ring, "endpoint-2"))
+	assert.Empty(t, p.discoveredAt)
+}
+
 func TestRemoveExtraExporters(t *testing.T) {
 	// prepare
 	ts, tb := getTelemetryAssets(t)
@@ -27,6 +27,8 @@ var _ exporter.Logs = (*logExporterImp)(nil)
 
 type logExporterImp struct {
 	loadBalancer *loadBalancer
+	routingKey   routingKey
+	routingAttrs []string
 
 	logger     *zap.Logger
 	started    bool
@@ -53,11 +55,19 @@ func newLogsExporter(params exporter.Settings, cfg component.Config) (*logExport
 		return nil, err
 	}
 
-	return &logExporterImp{
+	logExporter := logExporterImp{
 		loadBalancer: lb,
+		routingKey:   traceIDRouting,
 		telemetry:    telemetry,
 		logger:       params.Logger,
-	}, nil
+	}
+
+	// Other routing keys are not supported for logs, which keep being routed by trace ID.
+	if cfg.(*Config).RoutingKey == attrRoutingStr {
+		logExporter.routingKey = attrRouting
+		logExporter.routingAttrs = cfg.(*Config).RoutingAttributes
+	}
+	return &logExporter, nil
 }
 
 func (*logExporterImp) Capabilities() consumer.Capabilities {
@@ -80,6 +90,10 @@ func (e *logExporterImp) Shutdown(ctx context.Context) error {
 }
 
 func (e *logExporterImp) ConsumeLogs(ctx context.Context, ld plog.Logs) error {
+	if e.routingKey == attrRouting {
+		return e.consumeLogsByAttributes(ctx, ld)
+	}
+
 	var errs error
 	batches := batchpersignal.SplitLogs(ld)
 	for _, batch := range batches {
@@ -89,6 +103,29 @@ func (e *logExporterImp) ConsumeLogs(ctx context.Context, ld plog.Logs) error {
 	return errs
 }
 
+// consumeLogsByAttributes routes each resource to the backend owning the value of its routing attributes.
+func (e *logExporterImp) consumeLogsByAttributes(ctx context.Context, ld plog.Logs) error {
+	batches := map[string]plog.Logs{}
+	for i := 0; i < ld.ResourceLogs().Len(); i++ {
+		rl := ld.ResourceLogs().At(i)
+
+		key := routingKeyFromAttributes(rl.Resource().Attributes(), e.routingAttrs)
+		batch, ok := batches[key]
+		if !ok {
+			batch = plog.NewLogs()
+			batches[key] = batch
+		}
+		rl.CopyTo(batch.ResourceLogs().AppendEmpty())
+	}
+
+	var errs error
+	for key, batch := range batches {
+		errs = multierr.Append(errs, e.consumeLogWithKey(ctx, batch, []byte(key)))
+	}
+
+	return errs
+}
+
 func (e *logExporterImp) consumeLog(ctx context.Context, ld plog.Logs) error {
 	traceID := traceIDFromLogs(ld)
 	balancingKey := traceID
@@ -99,7 +136,11 @@ func (e *logExporterImp) consumeLog(ctx context.Context, ld plog.Logs) error {
 		balancingKey = random()
 	}
 
-	le, _, err := e.loadBalancer.exporterAndEndpoint(balancingKey[:])
+	return e.consumeLogWithKey(ctx, ld, balancingKey[:])
+}
+
+func (e *logExporterImp) consumeLogWithKey(ctx context.Context, ld plog.Logs, balancingKey []byte) error {
+	le, _, err := e.loadBalancer.exporterAndEndpoint(balancingKey)
 	if err != nil {
 		return err
 	}
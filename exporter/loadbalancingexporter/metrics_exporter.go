@@ -30,6 +30,7 @@ var _ exporter.Metrics = (*metricExporterImp)(nil)
 type metricExporterImp struct {
 	loadBalancer *loadBalancer
 	routingKey   routingKey
+	routingAttrs []string
 
 	logger     *zap.Logger
 	stopped    bool
@@ -72,6 +73,9 @@ func newMetricsExporter(params exporter.Settings, cfg component.Config) (*metric
 		metricExporter.routingKey = metricNameRouting
 	case streamIDRoutingStr:
 		metricExporter.routingKey = streamIDRouting
+	case attrRoutingStr:
+		metricExporter.routingKey = attrRouting
+		metricExporter.routingAttrs = cfg.(*Config).RoutingAttributes
 	default:
 		return nil, fmt.Errorf("unsupported routing_key: %q", cfg.(*Config).RoutingKey)
 	}
@@ -109,6 +113,8 @@ func (e *metricExporterImp) ConsumeMetrics(ctx context.Context, md pmetric.Metri
 		batches = splitMetricsByMetricName(md)
 	case streamIDRouting:
 		batches = splitMetricsByStreamID(md)
+	case attrRouting:
+		batches = splitMetricsByResourceAttributes(md, e.routingAttrs)
 	}
 
 	// Now assign each batch to an exporter, and merge as we go
@@ -179,6 +185,28 @@ func splitMetricsByResourceServiceName(md pmetric.Metrics) (map[string]pmetric.M
 	return results, nil
 }
 
+func splitMetricsByResourceAttributes(md pmetric.Metrics, attrs []string) map[string]pmetric.Metrics {
+	results := map[string]pmetric.Metrics{}
+
+	for i := 0; i < md.ResourceMetrics().Len(); i++ {
+		rm := md.ResourceMetrics().At(i)
+
+		newMD := pmetric.NewMetrics()
+		rmClone := newMD.ResourceMetrics().AppendEmpty()
+		rm.CopyTo(rmClone)
+
+		key := routingKeyFromAttributes(rm.Resource().Attributes(), attrs)
+		existing, ok := results[key]
+		if ok {
+			metrics.Merge(existing, newMD)
+		} else {
+			results[key] = newMD
+		}
+	}
+
+	return results
+}
+
 func splitMetricsByResourceID(md pmetric.Metrics) map[string]pmetric.Metrics {
 	results := map[string]pmetric.Metrics{}
 
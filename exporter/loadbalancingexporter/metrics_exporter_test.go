@@ -254,6 +254,25 @@ func TestSplitMetricsByResourceServiceNameFailsIfMissingServiceNameAttribute(t *
 	require.Error(t, err)
 }
 
+func TestSplitMetricsByResourceAttributes(t *testing.T) {
+	t.Parallel()
+
+	md := pmetric.NewMetrics()
+	for _, tenant := range []string{"tenant-a", "tenant-b", "tenant-a", ""} {
+		rm := md.ResourceMetrics().AppendEmpty()
+		if tenant != "" {
+			rm.Resource().Attributes().PutStr("tenant.id", tenant)
+		}
+		rm.ScopeMetrics().AppendEmpty().Metrics().AppendEmpty().SetName("m")
+	}
+
+	output := splitMetricsByResourceAttributes(md, []string{"tenant.id"})
+	require.Len(t, output, 3)
+	assert.Equal(t, 2, output["tenant-a"].ResourceMetrics().Len())
+	assert.Equal(t, 1, output["tenant-b"].ResourceMetrics().Len())
+	assert.Equal(t, 1, output[""].ResourceMetrics().Len())
+}
+
 func TestSplitMetrics(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,102 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package opensearchexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/opensearchexporter"
+
+import (
+	"context"
+	"sync"
+
+	"github.com/opensearch-project/opensearch-go/v4/opensearchapi"
+)
+
+// rejectedExecutionErrorType is the error type OpenSearch reports for bulk items
+// rejected because the write thread pool queue of a node is full.
+const rejectedExecutionErrorType = "es_rejected_execution_exception"
+
+// adaptiveLimiter bounds the number of in-flight bulk requests using an
+// additive-increase/multiplicative-decrease (AIMD) strategy. Every bulk request
+// that was throttled by OpenSearch shrinks the limit by the configured ratio,
+// every successful one grows it by roughly one slot per limit worth of requests.
+type adaptiveLimiter struct {
+	mu            sync.Mutex
+	limit         float64
+	minLimit      float64
+	maxLimit      float64
+	decreaseRatio float64
+	inFlight      int
+	// changed is closed and replaced every time a slot is released or the
+	// limit changes, waking up the goroutines blocked in acquire.
+	changed chan struct{}
+}
+
+func newAdaptiveLimiter(cfg AdaptiveConcurrencySettings) *adaptiveLimiter {
+	if !cfg.Enabled {
+		return nil
+	}
+	return &adaptiveLimiter{
+		limit:         float64(cfg.InitialLimit),
+		minLimit:      float64(cfg.MinLimit),
+		maxLimit:      float64(cfg.MaxLimit),
+		decreaseRatio: cfg.DecreaseRatio,
+		changed:       make(chan struct{}),
+	}
+}
+
+// acquire blocks until an in-flight slot is available or ctx is done.
+// A nil limiter never blocks.
+func (l *adaptiveLimiter) acquire(ctx context.Context) error {
+	if l == nil {
+		return nil
+	}
+	for {
+		l.mu.Lock()
+		if l.inFlight < int(l.limit) {
+			l.inFlight++
+			l.mu.Unlock()
+			return nil
+		}
+		changed := l.changed
+		l.mu.Unlock()
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-changed:
+		}
+	}
+}
+
+// release returns an in-flight slot and adjusts the limit depending on
+// whether the bulk request was throttled by the cluster.
+func (l *adaptiveLimiter) release(throttled bool) {
+	if l == nil {
+		return
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.inFlight--
+	if throttled {
+		l.limit = max(l.minLimit, l.limit*l.decreaseRatio)
+	} else {
+		l.limit = min(l.maxLimit, l.limit+1/l.limit)
+	}
+	close(l.changed)
+	l.changed = make(chan struct{})
+}
+
+// currentLimit returns the current number of allowed in-flight bulk requests.
+func (l *adaptiveLimiter) currentLimit() int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return int(l.limit)
+}
+
+// isThrottled reports whether a bulk item failed because the cluster is overloaded.
+func isThrottled(resp opensearchapi.BulkRespItem) bool {
+	if resp.Status == 429 {
+		return true
+	}
+	return resp.Error != nil && resp.Error.Type == rejectedExecutionErrorType
+}
@@ -0,0 +1,110 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package opensearchexporter
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/opensearch-project/opensearch-go/v4/opensearchapi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestLimiter() *adaptiveLimiter {
+	return newAdaptiveLimiter(AdaptiveConcurrencySettings{
+		Enabled:       true,
+		InitialLimit:  4,
+		MinLimit:      1,
+		MaxLimit:      5,
+		DecreaseRatio: 0.5,
+	})
+}
+
+func TestAdaptiveLimiterDisabled(t *testing.T) {
+	l := newAdaptiveLimiter(AdaptiveConcurrencySettings{})
+	assert.Nil(t, l)
+	require.NoError(t, l.acquire(context.Background()))
+	l.release(true)
+}
+
+func TestAdaptiveLimiterDecreasesOnThrottle(t *testing.T) {
+	l := newTestLimiter()
+
+	require.NoError(t, l.acquire(context.Background()))
+	l.release(true)
+	assert.Equal(t, 2, l.currentLimit())
+
+	require.NoError(t, l.acquire(context.Background()))
+	l.release(true)
+	require.NoError(t, l.acquire(context.Background()))
+	l.release(true)
+	assert.Equal(t, 1, l.currentLimit(), "limit must not shrink below min_limit")
+}
+
+func TestAdaptiveLimiterIncreasesOnSuccess(t *testing.T) {
+	l := newTestLimiter()
+
+	for i := 0; i < 100; i++ {
+		require.NoError(t, l.acquire(context.Background()))
+		l.release(false)
+	}
+	assert.Equal(t, 5, l.currentLimit(), "limit must not grow above max_limit")
+}
+
+func TestAdaptiveLimiterBlocksWhenFull(t *testing.T) {
+	l := newTestLimiter()
+	for i := 0; i < 4; i++ {
+		require.NoError(t, l.acquire(context.Background()))
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	assert.ErrorIs(t, l.acquire(ctx), context.DeadlineExceeded)
+
+	acquired := make(chan error)
+	go func() {
+		acquired <- l.acquire(context.Background())
+	}()
+	l.release(false)
+	require.NoError(t, <-acquired)
+}
+
+func TestIsThrottled(t *testing.T) {
+	tests := []struct {
+		name     string
+		response string
+		expected bool
+	}{
+		{
+			name:     "too many requests",
+			response: `{"status":429}`,
+			expected: true,
+		},
+		{
+			name:     "rejected execution",
+			response: `{"status":503,"error":{"type":"es_rejected_execution_exception","reason":"rejected execution"}}`,
+			expected: true,
+		},
+		{
+			name:     "mapping error",
+			response: `{"status":400,"error":{"type":"mapper_parsing_exception","reason":"failed to parse"}}`,
+			expected: false,
+		},
+		{
+			name:     "success",
+			response: `{"status":201}`,
+			expected: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var resp opensearchapi.BulkRespItem
+			require.NoError(t, json.Unmarshal([]byte(tt.response), &resp))
+			assert.Equal(t, tt.expected, isThrottled(resp))
+		})
+	}
+}
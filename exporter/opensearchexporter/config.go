@@ -24,6 +24,18 @@ const (
 
 	// defaultMappingMode value is used when component.Config.MappingSettings.Mode is not set.
 	defaultMappingMode = "ss4o"
+
+	// defaultBulkConcurrencyInitialLimit value is used when component.Config.BulkConcurrency.InitialLimit is not set.
+	defaultBulkConcurrencyInitialLimit = 4
+
+	// defaultBulkConcurrencyMinLimit value is used when component.Config.BulkConcurrency.MinLimit is not set.
+	defaultBulkConcurrencyMinLimit = 1
+
+	// defaultBulkConcurrencyMaxLimit value is used when component.Config.BulkConcurrency.MaxLimit is not set.
+	defaultBulkConcurrencyMaxLimit = 16
+
+	// defaultBulkConcurrencyDecreaseRatio value is used when component.Config.BulkConcurrency.DecreaseRatio is not set.
+	defaultBulkConcurrencyDecreaseRatio = 0.5
 )
 
 // Config defines configuration for OpenSearch exporter.
@@ -50,6 +62,31 @@ type Config struct {
 	// BulkAction configures the action for ingesting data. Only `create` and `index` are allowed here.
 	// If not specified, the default value `create` will be used.
 	BulkAction string `mapstructure:"bulk_action"`
+
+	// BulkConcurrency configures the adaptive concurrency control of bulk requests.
+	BulkConcurrency AdaptiveConcurrencySettings `mapstructure:"bulk_concurrency"`
+}
+
+// AdaptiveConcurrencySettings configures how many bulk requests may be in flight
+// at the same time. When enabled, the limit is shrunk whenever OpenSearch rejects
+// documents with 429 or es_rejected_execution_exception, and slowly grows back
+// while bulk requests succeed.
+type AdaptiveConcurrencySettings struct {
+	// Enabled turns on the adaptive concurrency control. Disabled by default.
+	Enabled bool `mapstructure:"enabled"`
+
+	// InitialLimit is the number of in-flight bulk requests allowed at startup.
+	InitialLimit int `mapstructure:"initial_limit"`
+
+	// MinLimit is the lower bound the limit can shrink to.
+	MinLimit int `mapstructure:"min_limit"`
+
+	// MaxLimit is the upper bound the limit can grow to.
+	MaxLimit int `mapstructure:"max_limit"`
+
+	// DecreaseRatio is the factor the limit is multiplied by after a throttled bulk request.
+	// Must be in the (0, 1) range.
+	DecreaseRatio float64 `mapstructure:"decrease_ratio"`
 }
 
 var (
@@ -60,6 +97,8 @@ var (
 	errMappingModeInvalid          = errors.New("mapping.mode is invalid")
 	errLogsIndexInvalidPlaceholder = errors.New("logs_index can only have one attribute or context key placeholder")
 	errLogsIndexTimeFormatInvalid  = errors.New("logs_index_time_format contains unsupported or invalid tokens")
+	errBulkConcurrencyLimits       = errors.New("bulk_concurrency limits must satisfy 0 < min_limit <= initial_limit <= max_limit")
+	errBulkConcurrencyRatio        = errors.New("bulk_concurrency.decrease_ratio must be between 0 and 1 exclusive")
 )
 
 type MappingsSettings struct {
@@ -179,5 +218,14 @@ func (cfg *Config) Validate() error {
 		multiErr = append(multiErr, errMappingModeInvalid)
 	}
 
+	if bc := cfg.BulkConcurrency; bc.Enabled {
+		if bc.MinLimit <= 0 || bc.MinLimit > bc.InitialLimit || bc.InitialLimit > bc.MaxLimit {
+			multiErr = append(multiErr, errBulkConcurrencyLimits)
+		}
+		if bc.DecreaseRatio <= 0 || bc.DecreaseRatio >= 1 {
+			multiErr = append(multiErr, errBulkConcurrencyRatio)
+		}
+	}
+
 	return errors.Join(multiErr...)
 }
@@ -80,6 +80,12 @@ func TestLoadConfig(t *testing.T) {
 				MappingsSettings: MappingsSettings{
 					Mode: "ss4o",
 				},
+				BulkConcurrency: AdaptiveConcurrencySettings{
+					InitialLimit:  defaultBulkConcurrencyInitialLimit,
+					MinLimit:      defaultBulkConcurrencyMinLimit,
+					MaxLimit:      defaultBulkConcurrencyMaxLimit,
+					DecreaseRatio: defaultBulkConcurrencyDecreaseRatio,
+				},
 			},
 			configValidateAssert: assert.NoError,
 		},
@@ -115,6 +121,37 @@ func TestLoadConfig(t *testing.T) {
 				return assert.ErrorContains(t, err, errBulkActionInvalid.Error())
 			},
 		},
+		{
+			id: component.NewIDWithName(metadata.Type, "bulk_concurrency"),
+			expected: withDefaultConfig(func(config *Config) {
+				config.Endpoint = sampleEndpoint
+				config.BulkConcurrency = AdaptiveConcurrencySettings{
+					Enabled:       true,
+					InitialLimit:  8,
+					MinLimit:      2,
+					MaxLimit:      32,
+					DecreaseRatio: 0.75,
+				}
+			}),
+			configValidateAssert: assert.NoError,
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "invalid_bulk_concurrency"),
+			expected: withDefaultConfig(func(config *Config) {
+				config.Endpoint = sampleEndpoint
+				config.BulkConcurrency = AdaptiveConcurrencySettings{
+					Enabled:       true,
+					InitialLimit:  8,
+					MinLimit:      10,
+					MaxLimit:      4,
+					DecreaseRatio: 1.5,
+				}
+			}),
+			configValidateAssert: func(t assert.TestingT, err error, _ ...any) bool {
+				return assert.ErrorContains(t, err, errBulkConcurrencyLimits.Error()) &&
+					assert.ErrorContains(t, err, errBulkConcurrencyRatio.Error())
+			},
+		},
 		{
 			id: component.NewIDWithName(metadata.Type, "dynamic_log_indexing"),
 			expected: withDefaultConfig(func(config *Config) {
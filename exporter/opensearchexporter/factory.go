@@ -36,6 +36,12 @@ func newDefaultConfig() component.Config {
 		BulkAction:       defaultBulkAction,
 		BackOffConfig:    configretry.NewDefaultBackOffConfig(),
 		MappingsSettings: MappingsSettings{Mode: defaultMappingMode},
+		BulkConcurrency: AdaptiveConcurrencySettings{
+			InitialLimit:  defaultBulkConcurrencyInitialLimit,
+			MinLimit:      defaultBulkConcurrencyMinLimit,
+			MaxLimit:      defaultBulkConcurrencyMaxLimit,
+			DecreaseRatio: defaultBulkConcurrencyDecreaseRatio,
+		},
 	}
 }
 
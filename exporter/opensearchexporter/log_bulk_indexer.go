@@ -21,10 +21,12 @@ type logBulkIndexer struct {
 	model       mappingModel
 	errs        []error
 	bulkIndexer opensearchutil.BulkIndexer
+	// throttled is set when at least one item was rejected because the cluster is overloaded.
+	throttled bool
 }
 
 func newLogBulkIndexer(index, bulkAction string, model mappingModel) *logBulkIndexer {
-	return &logBulkIndexer{index, bulkAction, model, nil, nil}
+	return &logBulkIndexer{index, bulkAction, model, nil, nil, false}
 }
 
 func (lbi *logBulkIndexer) start(client *opensearchapi.Client) error {
@@ -96,6 +98,9 @@ func makeLog(resource pcommon.Resource, resourceSchemaURL string, scope pcommon.
 }
 
 func (lbi *logBulkIndexer) processItemFailure(resp opensearchapi.BulkRespItem, itemErr error, logs plog.Logs) {
+	if isThrottled(resp) {
+		lbi.throttled = true
+	}
 	switch {
 	case shouldRetryEvent(resp.Status):
 		// Recoverable OpenSearch error
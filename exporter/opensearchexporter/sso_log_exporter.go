@@ -25,6 +25,7 @@ type logExporter struct {
 	httpSettings confighttp.ClientConfig
 	telemetry    component.TelemetrySettings
 	config       *Config // add config reference
+	limiter      *adaptiveLimiter
 }
 
 func newLogExporter(cfg *Config, set exporter.Settings) *logExporter {
@@ -46,6 +47,7 @@ func newLogExporter(cfg *Config, set exporter.Settings) *logExporter {
 		httpSettings: cfg.ClientConfig,
 		model:        model,
 		config:       cfg, // set config
+		limiter:      newAdaptiveLimiter(cfg.BulkConcurrency),
 	}
 }
 
@@ -65,9 +67,13 @@ func (l *logExporter) Start(ctx context.Context, host component.Host) error {
 }
 
 func (l *logExporter) pushLogData(ctx context.Context, ld plog.Logs) error {
+	if err := l.limiter.acquire(ctx); err != nil {
+		return err
+	}
 	indexer := newLogBulkIndexer("", l.bulkAction, l.model)
 	startErr := indexer.start(l.client)
 	if startErr != nil {
+		l.limiter.release(false)
 		return startErr
 	}
 
@@ -86,6 +92,7 @@ func (l *logExporter) pushLogData(ctx context.Context, ld plog.Logs) error {
 	indexer.index = indexName
 	indexer.submit(ctx, ld)
 	indexer.close(ctx)
+	l.limiter.release(indexer.throttled)
 	return indexer.joinedError()
 }
 
@@ -24,6 +24,7 @@ type ssoTracesExporter struct {
 	model        mappingModel
 	httpSettings confighttp.ClientConfig
 	telemetry    component.TelemetrySettings
+	limiter      *adaptiveLimiter
 }
 
 func newSSOTracesExporter(cfg *Config, set exporter.Settings) *ssoTracesExporter {
@@ -39,6 +40,7 @@ func newSSOTracesExporter(cfg *Config, set exporter.Settings) *ssoTracesExporter
 		bulkAction:   cfg.BulkAction,
 		model:        model,
 		httpSettings: cfg.ClientConfig,
+		limiter:      newAdaptiveLimiter(cfg.BulkConcurrency),
 	}
 }
 
@@ -58,13 +60,18 @@ func (s *ssoTracesExporter) Start(ctx context.Context, host component.Host) erro
 }
 
 func (s *ssoTracesExporter) pushTraceData(ctx context.Context, td ptrace.Traces) error {
+	if err := s.limiter.acquire(ctx); err != nil {
+		return err
+	}
 	indexer := newTraceBulkIndexer(s.Dataset, s.Namespace, s.bulkAction, s.model)
 	startErr := indexer.start(s.client)
 	if startErr != nil {
+		s.limiter.release(false)
 		return startErr
 	}
 	indexer.submit(ctx, td)
 	indexer.close(ctx)
+	s.limiter.release(indexer.throttled)
 	return indexer.joinedError()
 }
 
@@ -24,10 +24,12 @@ type traceBulkIndexer struct {
 	model       mappingModel
 	errs        []error
 	bulkIndexer opensearchutil.BulkIndexer
+	// throttled is set when at least one item was rejected because the cluster is overloaded.
+	throttled bool
 }
 
 func newTraceBulkIndexer(dataset, namespace, bulkAction string, model mappingModel) *traceBulkIndexer {
-	return &traceBulkIndexer{dataset, namespace, bulkAction, model, nil, nil}
+	return &traceBulkIndexer{dataset, namespace, bulkAction, model, nil, nil, false}
 }
 
 func (tbi *traceBulkIndexer) joinedError() error {
@@ -99,6 +101,9 @@ func makeTrace(resource pcommon.Resource, resourceSchemaURL string, scope pcommo
 }
 
 func (tbi *traceBulkIndexer) processItemFailure(resp opensearchapi.BulkRespItem, itemErr error, traces ptrace.Traces) {
+	if isThrottled(resp) {
+		tbi.throttled = true
+	}
 	switch {
 	case shouldRetryEvent(resp.Status):
 		// Recoverable OpenSearch error
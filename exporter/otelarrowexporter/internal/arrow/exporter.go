@@ -213,6 +213,24 @@ func (e *Exporter) startArrowStream(ctx context.Context, ws *streamWorkState) {
 	go e.runArrowStream(ctx, dc, ws)
 }
 
+// restartArrowStreamAfter restarts a stream after a delay, unless the
+// context is canceled first.
+func (e *Exporter) restartArrowStreamAfter(ctx context.Context, ws *streamWorkState, delay time.Duration) {
+	e.wg.Add(1)
+	go func() {
+		defer e.wg.Done()
+
+		timer := time.NewTimer(delay)
+		defer timer.Stop()
+
+		select {
+		case <-timer.C:
+			e.startArrowStream(ctx, ws)
+		case <-ctx.Done():
+		}
+	}()
+}
+
 // runStreamController starts the initial set of streams, then waits for streams to
 // terminate one at a time and restarts them.  If streams come back with a nil
 // client (meaning that OTel-Arrow was not supported by the endpoint), it will
@@ -226,6 +244,12 @@ func (e *Exporter) runStreamController(exportCtx, downCtx context.Context, downD
 	for {
 		select {
 		case stream := <-e.returning:
+			if stream.restartDelay > 0 {
+				// The receiver rejected the stream because of
+				// admission control, wait as requested.
+				e.restartArrowStreamAfter(downCtx, stream.workState, stream.restartDelay)
+				continue
+			}
 			if stream.client != nil || e.disableDowngrade {
 				// The stream closed or broken.  Restart it.
 				e.startArrowStream(downCtx, stream.workState)
@@ -24,6 +24,7 @@ import (
 	"go.uber.org/multierr"
 	"go.uber.org/zap"
 	"golang.org/x/net/http2/hpack"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -61,6 +62,11 @@ type Stream struct {
 	// the stream.  All of this state will be inherited by the successor
 	// stream.
 	workState *streamWorkState
+
+	// restartDelay is set when the receiver rejected the stream
+	// with ResourceExhausted and asked for a retry delay.  The
+	// stream is restarted after this delay.
+	restartDelay time.Duration
 }
 
 // streamWorkState contains the state assigned to an Arrow stream.  When
@@ -155,6 +161,23 @@ func (s *Stream) logStreamError(where string, err error) {
 	}
 }
 
+// admissionRetryDelay returns the delay requested by a receiver that
+// rejected the stream with ResourceExhausted and a RetryInfo detail,
+// which OTel-Arrow receivers return when admission control is
+// configured with a retry delay.
+func admissionRetryDelay(err error) time.Duration {
+	st, ok := status.FromError(err)
+	if !ok || st.Code() != codes.ResourceExhausted {
+		return 0
+	}
+	for _, detail := range st.Details() {
+		if info, ok := detail.(*errdetails.RetryInfo); ok && info.RetryDelay != nil {
+			return info.RetryDelay.AsDuration()
+		}
+	}
+	return 0
+}
+
 // run blocks the calling goroutine while executing stream logic.  run
 // will return when the reader and writer are finished.  errors will be logged.
 func (s *Stream) run(ctx context.Context, dc doneCancel, streamClient StreamClientFunc, grpcOptions []grpc.CallOption) {
@@ -204,6 +227,8 @@ func (s *Stream) run(ctx context.Context, dc doneCancel, streamClient StreamClie
 	dc.cancel()
 	ww.Wait()
 
+	s.restartDelay = admissionRetryDelay(err)
+
 	if err != nil {
 		// This branch is reached with an unimplemented status
 		// with or without the WaitForReady flag.
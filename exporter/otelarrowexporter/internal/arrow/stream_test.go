@@ -14,9 +14,11 @@ import (
 	arrowRecordMock "github.com/open-telemetry/otel-arrow/go/pkg/otel/arrow_record/mock"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/otelarrow/netstats"
 )
@@ -346,3 +348,15 @@ func TestStreamSendError(t *testing.T) {
 		})
 	}
 }
+
+func TestAdmissionRetryDelay(t *testing.T) {
+	withInfo, err := status.New(codes.ResourceExhausted, "too much pending data").WithDetails(&errdetails.RetryInfo{
+		RetryDelay: durationpb.New(3 * time.Second),
+	})
+	require.NoError(t, err)
+	require.Equal(t, 3*time.Second, admissionRetryDelay(withInfo.Err()))
+
+	require.Zero(t, admissionRetryDelay(nil))
+	require.Zero(t, admissionRetryDelay(status.Error(codes.ResourceExhausted, "too much pending data")))
+	require.Zero(t, admissionRetryDelay(status.Error(codes.Unavailable, "unavailable")))
+}
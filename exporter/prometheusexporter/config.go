@@ -4,6 +4,7 @@
 package prometheusexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/prometheusexporter"
 
 import (
+	"errors"
 	"fmt"
 	"time"
 
@@ -37,6 +38,10 @@ type Config struct {
 	// EnableOpenMetrics enables the use of the OpenMetrics encoding option for the prometheus exporter.
 	EnableOpenMetrics bool `mapstructure:"enable_open_metrics"`
 
+	// EnableCreatedTimestamps enables the `_created` series of counters, histograms and summaries,
+	// holding the start timestamp of their data points. Requires EnableOpenMetrics.
+	EnableCreatedTimestamps bool `mapstructure:"enable_created_timestamps"`
+
 	// AddMetricSuffixes controls whether suffixes are added to metric names. Defaults to true.
 	// Deprecated: Use TranslationStrategy instead. This setting is ignored when TranslationStrategy is explicitly set.
 	AddMetricSuffixes bool `mapstructure:"add_metric_suffixes"`
@@ -50,6 +55,10 @@ var _ component.Config = (*Config)(nil)
 
 // Validate checks if the exporter configuration is valid
 func (cfg *Config) Validate() error {
+	if cfg.EnableCreatedTimestamps && !cfg.EnableOpenMetrics {
+		return errors.New("enable_created_timestamps requires enable_open_metrics to be enabled")
+	}
+
 	// Validate translation strategy if set
 	if cfg.TranslationStrategy != "" {
 		switch cfg.TranslationStrategy {
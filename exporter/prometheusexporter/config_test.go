@@ -73,3 +73,12 @@ func TestLoadConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateCreatedTimestamps(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.EnableCreatedTimestamps = true
+	assert.EqualError(t, cfg.Validate(), "enable_created_timestamps requires enable_open_metrics to be enabled")
+
+	cfg.EnableOpenMetrics = true
+	assert.NoError(t, cfg.Validate())
+}
@@ -441,6 +441,56 @@ func TestPrometheusExporter_endToEndWithResource(t *testing.T) {
 	}
 }
 
+func TestPrometheusExporter_endToEndOpenMetrics(t *testing.T) {
+	addr := testutil.GetAvailableLocalAddress(t)
+	cfg := &Config{
+		Namespace: "test",
+		ServerConfig: confighttp.ServerConfig{
+			Endpoint: addr,
+		},
+		MetricExpiration:        120 * time.Minute,
+		EnableOpenMetrics:       true,
+		EnableCreatedTimestamps: true,
+	}
+
+	factory := NewFactory()
+	set := exportertest.NewNopSettings(metadata.Type)
+	exp, err := factory.CreateMetrics(context.Background(), set, cfg)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, exp.Shutdown(context.Background()))
+	})
+	require.NoError(t, exp.Start(context.Background(), componenttest.NewNopHost()))
+
+	md := metricBuilder(0, "metric_", "cpu-exporter", "localhost:8080")
+	dp := md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0).Sum().DataPoints().At(0)
+	exemplar := dp.Exemplars().AppendEmpty()
+	exemplar.SetTraceID([16]byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16})
+	exemplar.SetSpanID([8]byte{1, 2, 3, 4, 5, 6, 7, 8})
+	exemplar.SetIntValue(42)
+	exemplar.SetTimestamp(pcommon.NewTimestampFromTime(time.Unix(1543160298, 0)))
+	require.NoError(t, exp.ConsumeMetrics(context.Background(), md))
+
+	req, err := http.NewRequest(http.MethodGet, "http://"+addr+"/metrics", http.NoBody)
+	require.NoError(t, err)
+	req.Header.Set("Accept", "application/openmetrics-text;version=1.0.0")
+	res, err := http.DefaultClient.Do(req)
+	require.NoError(t, err, "Failed to perform a scrape")
+	blob, _ := io.ReadAll(res.Body)
+	_ = res.Body.Close()
+
+	assert.Equal(t, http.StatusOK, res.StatusCode, "Mismatched HTTP response status code")
+	assert.Contains(t, res.Header.Get("Content-Type"), "application/openmetrics-text")
+	want := []string{
+		`# TYPE test_metric_this_one_there_where counter`,
+		`test_metric_this_one_there_where_total{arch="x86",instance="localhost:8080",job="cpu-exporter",os="windows",otel_scope_name="",otel_scope_schema_url="",otel_scope_version=""} 99.0 # {span_id="0102030405060708",trace_id="0102030405060708090a0b0c0d0e0f10"} 42.0 1.543160298e+09`,
+		`test_metric_this_one_there_where_created{arch="x86",instance="localhost:8080",job="cpu-exporter",os="windows",otel_scope_name="",otel_scope_schema_url="",otel_scope_version=""} 1.543160298`,
+	}
+	for _, w := range want {
+		assert.Contains(t, string(blob), w, "Missing %v from response:\n%v", w, string(blob))
+	}
+}
+
 func metricBuilder(delta int64, prefix, job, instance string) pmetric.Metrics {
 	md := pmetric.NewMetrics()
 	rms := md.ResourceMetrics().AppendEmpty()
@@ -29,6 +29,9 @@ type Config struct {
 	Encoding string `mapstructure:"encoding"`
 	// Producer configuration of the Pulsar producer
 	Producer Producer `mapstructure:"producer"`
+	// MessageKeyAttribute is the resource attribute used as message key, so that consumers
+	// of a key_shared subscription receive all telemetry of a resource in order.
+	MessageKeyAttribute string `mapstructure:"message_key_attribute"`
 	// Set the path to the trusted TLS certificate file
 	TLSTrustCertsFilePath string `mapstructure:"tls_trust_certs_file_path"`
 	// Configure whether the Pulsar client accept untrusted TLS certificate from broker (default: false)
@@ -0,0 +1,64 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package pulsarexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/pulsarexporter"
+
+import (
+	"github.com/apache/pulsar-client-go/pulsar"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+// setMessageKey sets the key of the messages that were not already keyed by the marshaler.
+func setMessageKey(messages []*pulsar.ProducerMessage, key string) {
+	if key == "" {
+		return
+	}
+	for _, message := range messages {
+		if message.Key == "" {
+			message.Key = key
+		}
+	}
+}
+
+// tracesMessageKey returns the value of the attribute on the first resource that has it.
+func tracesMessageKey(td ptrace.Traces, attribute string) string {
+	rss := td.ResourceSpans()
+	for i := 0; i < rss.Len(); i++ {
+		if key := resourceMessageKey(rss.At(i).Resource(), attribute); key != "" {
+			return key
+		}
+	}
+	return ""
+}
+
+// metricsMessageKey returns the value of the attribute on the first resource that has it.
+func metricsMessageKey(md pmetric.Metrics, attribute string) string {
+	rms := md.ResourceMetrics()
+	for i := 0; i < rms.Len(); i++ {
+		if key := resourceMessageKey(rms.At(i).Resource(), attribute); key != "" {
+			return key
+		}
+	}
+	return ""
+}
+
+// logsMessageKey returns the value of the attribute on the first resource that has it.
+func logsMessageKey(ld plog.Logs, attribute string) string {
+	rls := ld.ResourceLogs()
+	for i := 0; i < rls.Len(); i++ {
+		if key := resourceMessageKey(rls.At(i).Resource(), attribute); key != "" {
+			return key
+		}
+	}
+	return ""
+}
+
+func resourceMessageKey(resource pcommon.Resource, attribute string) string {
+	if v, ok := resource.Attributes().Get(attribute); ok {
+		return v.AsString()
+	}
+	return ""
+}
@@ -0,0 +1,44 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package pulsarexporter
+
+import (
+	"testing"
+
+	"github.com/apache/pulsar-client-go/pulsar"
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+func TestMessageKey(t *testing.T) {
+	td := ptrace.NewTraces()
+	td.ResourceSpans().AppendEmpty()
+	td.ResourceSpans().AppendEmpty().Resource().Attributes().PutStr("service.name", "traces-svc")
+	assert.Equal(t, "traces-svc", tracesMessageKey(td, "service.name"))
+	assert.Empty(t, tracesMessageKey(td, "host.name"))
+
+	md := pmetric.NewMetrics()
+	md.ResourceMetrics().AppendEmpty().Resource().Attributes().PutInt("shard", 3)
+	assert.Equal(t, "3", metricsMessageKey(md, "shard"))
+
+	ld := plog.NewLogs()
+	ld.ResourceLogs().AppendEmpty().Resource().Attributes().PutStr("service.name", "logs-svc")
+	assert.Equal(t, "logs-svc", logsMessageKey(ld, "service.name"))
+}
+
+func TestSetMessageKey(t *testing.T) {
+	messages := []*pulsar.ProducerMessage{
+		{Payload: []byte("a")},
+		{Payload: []byte("b"), Key: "trace-id"},
+	}
+	setMessageKey(messages, "svc")
+	assert.Equal(t, "svc", messages[0].Key)
+	assert.Equal(t, "trace-id", messages[1].Key, "keys set by the marshaler are kept")
+
+	messages = []*pulsar.ProducerMessage{{Payload: []byte("a")}}
+	setMessageKey(messages, "")
+	assert.Empty(t, messages[0].Key)
+}
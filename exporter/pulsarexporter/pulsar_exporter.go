@@ -34,6 +34,9 @@ func (e *PulsarTracesProducer) tracesPusher(ctx context.Context, td ptrace.Trace
 	if err != nil {
 		return consumererror.NewPermanent(err)
 	}
+	if e.cfg.MessageKeyAttribute != "" {
+		setMessageKey(messages, tracesMessageKey(td, e.cfg.MessageKeyAttribute))
+	}
 
 	var errs error
 	for _, message := range messages {
@@ -80,6 +83,9 @@ func (e *PulsarMetricsProducer) metricsDataPusher(ctx context.Context, md pmetri
 	if err != nil {
 		return consumererror.NewPermanent(err)
 	}
+	if e.cfg.MessageKeyAttribute != "" {
+		setMessageKey(messages, metricsMessageKey(md, e.cfg.MessageKeyAttribute))
+	}
 
 	var errs error
 	for _, message := range messages {
@@ -126,6 +132,9 @@ func (e *PulsarLogsProducer) logsDataPusher(ctx context.Context, ld plog.Logs) e
 	if err != nil {
 		return consumererror.NewPermanent(err)
 	}
+	if e.cfg.MessageKeyAttribute != "" {
+		setMessageKey(messages, logsMessageKey(ld, e.cfg.MessageKeyAttribute))
+	}
 
 	var errs error
 	for _, message := range messages {
@@ -17,9 +17,18 @@ type Config struct {
 	Routing             RoutingConfig             `mapstructure:"routing"`
 	EncodingExtensionID *component.ID             `mapstructure:"encoding_extension"`
 	Durable             bool                      `mapstructure:"durable"`
+	Publish             PublishConfig             `mapstructure:"publish"`
 	RetrySettings       configretry.BackOffConfig `mapstructure:"retry_on_failure"`
 }
 
+type PublishConfig struct {
+	// MaxInFlight bounds the number of published messages awaiting a publisher confirm from RabbitMQ.
+	// Zero means no bound.
+	MaxInFlight int `mapstructure:"max_in_flight"`
+	// FailOnUnroutable treats messages returned by RabbitMQ because no queue is bound to the routing key as errors.
+	FailOnUnroutable bool `mapstructure:"fail_on_unroutable"`
+}
+
 type ConnectionConfig struct {
 	Endpoint                   string                  `mapstructure:"endpoint"`
 	VHost                      string                  `mapstructure:"vhost"`
@@ -60,5 +69,9 @@ func (cfg *Config) Validate() error {
 		return errors.New("connection.auth.plain.username is required")
 	}
 
+	if cfg.Publish.MaxInFlight < 0 {
+		return errors.New("publish.max_in_flight must not be negative")
+	}
+
 	return nil
 }
@@ -41,6 +41,10 @@ func TestLoadConfig(t *testing.T) {
 			id:           component.NewIDWithName(metadata.Type, "missing_plainauth_username"),
 			errorMessage: "connection.auth.plain.username is required",
 		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "negative_max_in_flight"),
+			errorMessage: "publish.max_in_flight must not be negative",
+		},
 		{
 			id: component.NewIDWithName(metadata.Type, "all_fields"),
 			expected: &Config{
@@ -69,6 +73,10 @@ func TestLoadConfig(t *testing.T) {
 				},
 				EncodingExtensionID: &encodingComponentID,
 				Durable:             false,
+				Publish: PublishConfig{
+					MaxInFlight:      16,
+					FailOnUnroutable: true,
+				},
 				RetrySettings: configretry.BackOffConfig{
 					Enabled: true,
 				},
@@ -19,6 +19,11 @@ type DialConfig struct {
 	otelrabbitmq.DialConfig
 	Durable                    bool
 	PublishConfirmationTimeout time.Duration
+	// MaxInFlight bounds the number of published messages awaiting a confirmation from the broker.
+	// Zero means no bound.
+	MaxInFlight int
+	// FailOnUnroutable makes messages returned by the broker because they could not be routed to any queue fail.
+	FailOnUnroutable bool
 }
 
 type Message struct {
@@ -33,6 +38,9 @@ func NewConnection(logger *zap.Logger, client otelrabbitmq.AmqpClient, config Di
 		client: client,
 		config: config,
 	}
+	if config.MaxInFlight > 0 {
+		p.inFlight = make(chan struct{}, config.MaxInFlight)
+	}
 
 	conn, err := p.client.DialConfig(p.config.DialConfig)
 	if err != nil {
@@ -53,9 +61,20 @@ type publisher struct {
 	client     otelrabbitmq.AmqpClient
 	config     DialConfig
 	connection otelrabbitmq.Connection
+	// inFlight holds a token for every message awaiting a confirmation, nil if unbounded.
+	inFlight chan struct{}
 }
 
 func (p *publisher) Publish(ctx context.Context, message Message) error {
+	if p.inFlight != nil {
+		select {
+		case p.inFlight <- struct{}{}:
+			defer func() { <-p.inFlight }()
+		case <-ctx.Done():
+			return fmt.Errorf("waiting for in-flight publish window: %w", ctx.Err())
+		}
+	}
+
 	err := p.connection.ReconnectIfUnhealthy()
 	if err != nil {
 		return err
@@ -83,6 +102,13 @@ func (p *publisher) Publish(ctx context.Context, message Message) error {
 		return err
 	}
 
+	// The broker returns mandatory messages that could not be routed before confirming them,
+	// so a buffered channel is enough to observe the return once the confirmation is received.
+	var returns chan amqp.Return
+	if p.config.FailOnUnroutable {
+		returns = channel.NotifyReturn(make(chan amqp.Return, 1))
+	}
+
 	// Send the message
 	deliveryMode := amqp.Transient
 	if p.config.Durable {
@@ -102,6 +128,12 @@ func (p *publisher) Publish(ctx context.Context, message Message) error {
 	select {
 	case <-confirmation.Done():
 		if confirmation.Acked() {
+			select {
+			case returned := <-returns:
+				p.logger.Warn("Message returned by rabbitmq as unroutable", zap.String("exchange", returned.Exchange), zap.String("routing_key", returned.RoutingKey))
+				return fmt.Errorf("message returned as unroutable: %d %s", returned.ReplyCode, returned.ReplyText)
+			default:
+			}
 			p.logger.Debug("Received ack")
 			return nil
 		}
@@ -128,6 +128,67 @@ func TestPublishTimeoutBeforeAck(t *testing.T) {
 	confirmation.AssertExpectations(t)
 }
 
+func TestPublishReturnedAsUnroutable(t *testing.T) {
+	client, connection, channel, confirmation := setupMocksForSuccessfulPublish()
+	// Simulate the broker returning the message before confirming it
+	channel.On("NotifyReturn", mock.Anything).Return().Run(func(args mock.Arguments) {
+		args.Get(0).(chan amqp.Return) <- amqp.Return{ReplyCode: amqp.NoRoute, ReplyText: "NO_ROUTE", Exchange: exchange, RoutingKey: routingKey}
+	})
+
+	config := makeDialConfig()
+	config.FailOnUnroutable = true
+	publisher, err := NewConnection(zap.NewNop(), client, config)
+	require.NoError(t, err)
+
+	err = publisher.Publish(context.Background(), makePublishMessage())
+
+	assert.EqualError(t, err, "message returned as unroutable: 312 NO_ROUTE")
+	client.AssertExpectations(t)
+	connection.AssertExpectations(t)
+	channel.AssertExpectations(t)
+	confirmation.AssertExpectations(t)
+}
+
+func TestPublishNotReturnedWithFailOnUnroutable(t *testing.T) {
+	client, connection, channel, confirmation := setupMocksForSuccessfulPublish()
+	channel.On("NotifyReturn", mock.Anything).Return()
+
+	config := makeDialConfig()
+	config.FailOnUnroutable = true
+	publisher, err := NewConnection(zap.NewNop(), client, config)
+	require.NoError(t, err)
+
+	err = publisher.Publish(context.Background(), makePublishMessage())
+
+	require.NoError(t, err)
+	client.AssertExpectations(t)
+	connection.AssertExpectations(t)
+	channel.AssertExpectations(t)
+	confirmation.AssertExpectations(t)
+}
+
+func TestPublishInFlightWindowFull(t *testing.T) {
+	client, _, _, _ := setupMocksForSuccessfulPublish()
+
+	config := makeDialConfig()
+	config.MaxInFlight = 1
+	pub, err := NewConnection(zap.NewNop(), client, config)
+	require.NoError(t, err)
+
+	// Simulate a message awaiting its confirmation
+	pub.(*publisher).inFlight <- struct{}{}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	err = pub.Publish(ctx, makePublishMessage())
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+	<-pub.(*publisher).inFlight
+	err = pub.Publish(context.Background(), makePublishMessage())
+	require.NoError(t, err)
+	assert.Empty(t, pub.(*publisher).inFlight, "the in-flight token must be released after the confirmation")
+}
+
 func TestPublishTwiceReusingSameConnection(t *testing.T) {
 	client, connection, channel, confirmation := setupMocksForSuccessfulPublish()
 
@@ -361,6 +422,21 @@ func (m *mockChannel) PublishWithDeferredConfirmWithContext(ctx context.Context,
 	return nil, args.Error(1)
 }
 
+func (m *mockChannel) NotifyReturn(receiver chan amqp.Return) chan amqp.Return {
+	m.Called(receiver)
+	return receiver
+}
+
+func (m *mockChannel) Qos(prefetchCount, prefetchSize int, global bool) error {
+	args := m.Called(prefetchCount, prefetchSize, global)
+	return args.Error(0)
+}
+
+func (m *mockChannel) ConsumeWithContext(ctx context.Context, queue, consumer string, autoAck, exclusive, noLocal, noWait bool, args amqp.Table) (<-chan amqp.Delivery, error) {
+	called := m.Called(ctx, queue, consumer, autoAck, exclusive, noLocal, noWait, args)
+	return called.Get(0).(<-chan amqp.Delivery), called.Error(1)
+}
+
 func (m *mockChannel) IsClosed() bool {
 	args := m.Called()
 	return args.Bool(0)
@@ -55,6 +55,8 @@ func (e *rabbitmqExporter) start(ctx context.Context, host component.Host) error
 	dialConfig := publisher.DialConfig{
 		Durable:                    e.config.Durable,
 		PublishConfirmationTimeout: e.config.Connection.PublishConfirmationTimeout,
+		MaxInFlight:                e.config.Publish.MaxInFlight,
+		FailOnUnroutable:           e.config.Publish.FailOnUnroutable,
 		DialConfig: rabbitmq.DialConfig{
 			URL:   e.config.Connection.Endpoint,
 			Vhost: e.config.Connection.VHost,
@@ -0,0 +1,41 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ringbufferexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/ringbufferexporter"
+
+import (
+	"errors"
+	"fmt"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config/confighttp"
+)
+
+// Config defines configuration for the ring buffer exporter.
+type Config struct {
+	confighttp.ServerConfig `mapstructure:",squash"` // squash ensures fields are correctly decoded in embedded struct
+
+	// Size is the number of spans, data points and log records kept for each signal.
+	// The oldest items are overwritten once the buffer of a signal is full.
+	Size int `mapstructure:"size"`
+
+	// SamplingPercentage is the percentage of the spans, data points and log records
+	// kept in the buffers. Defaults to 100.
+	SamplingPercentage float64 `mapstructure:"sampling_percentage"`
+
+	// prevent unkeyed literal initialization
+	_ struct{}
+}
+
+var _ component.Config = (*Config)(nil)
+
+// Validate checks the exporter configuration is valid
+func (cfg *Config) Validate() error {
+	if cfg.Size <= 0 {
+		return errors.New("size must be positive")
+	}
+	if cfg.SamplingPercentage <= 0 || cfg.SamplingPercentage > 100 {
+		return fmt.Errorf("sampling_percentage must be greater than 0 and at most 100, got %v", cfg.SamplingPercentage)
+	}
+	return nil
+}
@@ -0,0 +1,7 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:generate mdatagen metadata.yaml
+
+// Package ringbufferexporter keeps the last spans, data points and log records in memory, and serves them over HTTP for inspection.
+package ringbufferexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/ringbufferexporter"
@@ -0,0 +1,108 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ringbufferexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/ringbufferexporter"
+
+import (
+	"context"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config/confighttp"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/exporter"
+	"go.opentelemetry.io/collector/exporter/exporterhelper"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/ringbufferexporter/internal/metadata"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/common/testutil"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/sharedcomponent"
+)
+
+const (
+	defaultPort = 12003
+	defaultSize = 100
+)
+
+// exporters shares the buffers and the HTTP server between the traces, metrics and
+// logs exporters of the same configuration.
+var exporters = sharedcomponent.NewSharedComponents()
+
+// NewFactory creates a factory for the ring buffer exporter.
+func NewFactory() exporter.Factory {
+	return exporter.NewFactory(
+		metadata.Type,
+		createDefaultConfig,
+		exporter.WithTraces(createTracesExporter, metadata.TracesStability),
+		exporter.WithMetrics(createMetricsExporter, metadata.MetricsStability),
+		exporter.WithLogs(createLogsExporter, metadata.LogsStability),
+	)
+}
+
+func createDefaultConfig() component.Config {
+	return &Config{
+		ServerConfig: confighttp.ServerConfig{
+			Endpoint: testutil.EndpointForPort(defaultPort),
+		},
+		Size:               defaultSize,
+		SamplingPercentage: 100,
+	}
+}
+
+func getOrAddExporter(cfg *Config, set exporter.Settings) *sharedcomponent.SharedComponent {
+	return exporters.GetOrAdd(cfg, func() component.Component {
+		return newRingBufferExporter(cfg, set.TelemetrySettings)
+	})
+}
+
+func createTracesExporter(
+	ctx context.Context,
+	set exporter.Settings,
+	cfg component.Config,
+) (exporter.Traces, error) {
+	e := getOrAddExporter(cfg.(*Config), set)
+
+	return exporterhelper.NewTraces(
+		ctx,
+		set,
+		cfg,
+		e.Unwrap().(*ringBufferExporter).pushTraces,
+		exporterhelper.WithCapabilities(consumer.Capabilities{MutatesData: false}),
+		exporterhelper.WithStart(e.Start),
+		exporterhelper.WithShutdown(e.Shutdown),
+	)
+}
+
+func createMetricsExporter(
+	ctx context.Context,
+	set exporter.Settings,
+	cfg component.Config,
+) (exporter.Metrics, error) {
+	e := getOrAddExporter(cfg.(*Config), set)
+
+	return exporterhelper.NewMetrics(
+		ctx,
+		set,
+		cfg,
+		e.Unwrap().(*ringBufferExporter).pushMetrics,
+		exporterhelper.WithCapabilities(consumer.Capabilities{MutatesData: false}),
+		exporterhelper.WithStart(e.Start),
+		exporterhelper.WithShutdown(e.Shutdown),
+	)
+}
+
+func createLogsExporter(
+	ctx context.Context,
+	set exporter.Settings,
+	cfg component.Config,
+) (exporter.Logs, error) {
+	e := getOrAddExporter(cfg.(*Config), set)
+
+	return exporterhelper.NewLogs(
+		ctx,
+		set,
+		cfg,
+		e.Unwrap().(*ringBufferExporter).pushLogs,
+		exporterhelper.WithCapabilities(consumer.Capabilities{MutatesData: false}),
+		exporterhelper.WithStart(e.Start),
+		exporterhelper.WithShutdown(e.Shutdown),
+	)
+}
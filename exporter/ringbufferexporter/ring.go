@@ -0,0 +1,49 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ringbufferexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/ringbufferexporter"
+
+import "sync"
+
+// ring keeps the last items pushed to it, overwriting the oldest item once it is
+// full. Pushing and reading the items is synchronized.
+type ring[T any] struct {
+	mu    sync.Mutex
+	items []T
+	next  int
+	total uint64
+}
+
+func newRing[T any](size int) *ring[T] {
+	return &ring[T]{items: make([]T, 0, size)}
+}
+
+func (r *ring[T]) push(item T) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.total++
+	if len(r.items) < cap(r.items) {
+		r.items = append(r.items, item)
+		return
+	}
+	r.items[r.next] = item
+	r.next = (r.next + 1) % len(r.items)
+}
+
+// snapshot returns the items from the oldest to the most recent, and the number of
+// items pushed since the ring was created.
+func (r *ring[T]) snapshot() ([]T, uint64) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	items := make([]T, 0, len(r.items))
+	items = append(items, r.items[r.next:]...)
+	items = append(items, r.items[:r.next]...)
+	return items, r.total
+}
+
+// size returns the number of items the ring can hold.
+func (r *ring[T]) size() int {
+	return cap(r.items)
+}
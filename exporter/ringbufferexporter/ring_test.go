@@ -0,0 +1,38 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ringbufferexporter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRing(t *testing.T) {
+	r := newRing[int](3)
+	assert.Equal(t, 3, r.size())
+
+	items, total := r.snapshot()
+	assert.Empty(t, items)
+	assert.Zero(t, total)
+
+	r.push(1)
+	r.push(2)
+	items, total = r.snapshot()
+	assert.Equal(t, []int{1, 2}, items)
+	assert.Equal(t, uint64(2), total)
+
+	r.push(3)
+	r.push(4)
+	r.push(5)
+	items, total = r.snapshot()
+	assert.Equal(t, []int{3, 4, 5}, items)
+	assert.Equal(t, uint64(5), total)
+
+	r.push(6)
+	r.push(7)
+	items, total = r.snapshot()
+	assert.Equal(t, []int{5, 6, 7}, items)
+	assert.Equal(t, uint64(7), total)
+}
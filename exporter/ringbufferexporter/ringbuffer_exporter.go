@@ -0,0 +1,217 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ringbufferexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/ringbufferexporter"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"net/http"
+	"sync"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componentstatus"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+type ringBufferExporter struct {
+	config     *Config
+	settings   component.TelemetrySettings
+	server     *http.Server
+	shutdownWG sync.WaitGroup
+
+	// The buffers hold one span, data point or log record per item, along with its
+	// resource, scope and, for the data points, metric.
+	spans      *ring[ptrace.ResourceSpans]
+	dataPoints *ring[pmetric.ResourceMetrics]
+	logs       *ring[plog.ResourceLogs]
+}
+
+func newRingBufferExporter(config *Config, set component.TelemetrySettings) *ringBufferExporter {
+	return &ringBufferExporter{
+		config:     config,
+		settings:   set,
+		spans:      newRing[ptrace.ResourceSpans](config.Size),
+		dataPoints: newRing[pmetric.ResourceMetrics](config.Size),
+		logs:       newRing[plog.ResourceLogs](config.Size),
+	}
+}
+
+func (e *ringBufferExporter) Start(ctx context.Context, host component.Host) error {
+	ln, err := e.config.ToListener(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to bind to address %s: %w", e.config.Endpoint, err)
+	}
+	e.server, err = e.config.ToServer(ctx, host, e.settings, e.handler())
+	if err != nil {
+		return errors.Join(err, ln.Close())
+	}
+	e.shutdownWG.Add(1)
+	go func() {
+		defer e.shutdownWG.Done()
+		if errHTTP := e.server.Serve(ln); !errors.Is(errHTTP, http.ErrServerClosed) && errHTTP != nil {
+			componentstatus.ReportStatus(host, componentstatus.NewFatalErrorEvent(errHTTP))
+		}
+	}()
+	return nil
+}
+
+func (e *ringBufferExporter) Shutdown(ctx context.Context) error {
+	if e.server == nil {
+		return nil
+	}
+	err := e.server.Shutdown(ctx)
+	e.shutdownWG.Wait()
+	return err
+}
+
+// sampled returns whether an item is kept according to the sampling percentage.
+func (e *ringBufferExporter) sampled() bool {
+	return e.config.SamplingPercentage >= 100 || rand.Float64()*100 < e.config.SamplingPercentage
+}
+
+func (e *ringBufferExporter) pushTraces(_ context.Context, td ptrace.Traces) error {
+	for i := 0; i < td.ResourceSpans().Len(); i++ {
+		rs := td.ResourceSpans().At(i)
+		for j := 0; j < rs.ScopeSpans().Len(); j++ {
+			ss := rs.ScopeSpans().At(j)
+			for k := 0; k < ss.Spans().Len(); k++ {
+				if !e.sampled() {
+					continue
+				}
+				item := ptrace.NewResourceSpans()
+				rs.Resource().CopyTo(item.Resource())
+				item.SetSchemaUrl(rs.SchemaUrl())
+				scope := item.ScopeSpans().AppendEmpty()
+				ss.Scope().CopyTo(scope.Scope())
+				scope.SetSchemaUrl(ss.SchemaUrl())
+				ss.Spans().At(k).CopyTo(scope.Spans().AppendEmpty())
+				e.spans.push(item)
+			}
+		}
+	}
+	return nil
+}
+
+func (e *ringBufferExporter) pushMetrics(_ context.Context, md pmetric.Metrics) error {
+	for i := 0; i < md.ResourceMetrics().Len(); i++ {
+		rm := md.ResourceMetrics().At(i)
+		for j := 0; j < rm.ScopeMetrics().Len(); j++ {
+			sm := rm.ScopeMetrics().At(j)
+			for k := 0; k < sm.Metrics().Len(); k++ {
+				e.sampledDataPoints(sm.Metrics().At(k), func(metric pmetric.Metric) {
+					item := pmetric.NewResourceMetrics()
+					rm.Resource().CopyTo(item.Resource())
+					item.SetSchemaUrl(rm.SchemaUrl())
+					scope := item.ScopeMetrics().AppendEmpty()
+					sm.Scope().CopyTo(scope.Scope())
+					scope.SetSchemaUrl(sm.SchemaUrl())
+					metric.MoveTo(scope.Metrics().AppendEmpty())
+					e.dataPoints.push(item)
+				})
+			}
+		}
+	}
+	return nil
+}
+
+// sampledDataPoints calls fn with a copy of the metric holding a single data point,
+// for each of the sampled data points of the metric.
+func (e *ringBufferExporter) sampledDataPoints(metric pmetric.Metric, fn func(pmetric.Metric)) {
+	newMetric := func() pmetric.Metric {
+		m := pmetric.NewMetric()
+		m.SetName(metric.Name())
+		m.SetDescription(metric.Description())
+		m.SetUnit(metric.Unit())
+		metric.Metadata().CopyTo(m.Metadata())
+		return m
+	}
+
+	switch metric.Type() {
+	case pmetric.MetricTypeGauge:
+		dps := metric.Gauge().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			if !e.sampled() {
+				continue
+			}
+			m := newMetric()
+			dps.At(i).CopyTo(m.SetEmptyGauge().DataPoints().AppendEmpty())
+			fn(m)
+		}
+	case pmetric.MetricTypeSum:
+		dps := metric.Sum().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			if !e.sampled() {
+				continue
+			}
+			m := newMetric()
+			sum := m.SetEmptySum()
+			sum.SetAggregationTemporality(metric.Sum().AggregationTemporality())
+			sum.SetIsMonotonic(metric.Sum().IsMonotonic())
+			dps.At(i).CopyTo(sum.DataPoints().AppendEmpty())
+			fn(m)
+		}
+	case pmetric.MetricTypeHistogram:
+		dps := metric.Histogram().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			if !e.sampled() {
+				continue
+			}
+			m := newMetric()
+			histogram := m.SetEmptyHistogram()
+			histogram.SetAggregationTemporality(metric.Histogram().AggregationTemporality())
+			dps.At(i).CopyTo(histogram.DataPoints().AppendEmpty())
+			fn(m)
+		}
+	case pmetric.MetricTypeExponentialHistogram:
+		dps := metric.ExponentialHistogram().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			if !e.sampled() {
+				continue
+			}
+			m := newMetric()
+			histogram := m.SetEmptyExponentialHistogram()
+			histogram.SetAggregationTemporality(metric.ExponentialHistogram().AggregationTemporality())
+			dps.At(i).CopyTo(histogram.DataPoints().AppendEmpty())
+			fn(m)
+		}
+	case pmetric.MetricTypeSummary:
+		dps := metric.Summary().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			if !e.sampled() {
+				continue
+			}
+			m := newMetric()
+			dps.At(i).CopyTo(m.SetEmptySummary().DataPoints().AppendEmpty())
+			fn(m)
+		}
+	case pmetric.MetricTypeEmpty:
+	}
+}
+
+func (e *ringBufferExporter) pushLogs(_ context.Context, ld plog.Logs) error {
+	for i := 0; i < ld.ResourceLogs().Len(); i++ {
+		rl := ld.ResourceLogs().At(i)
+		for j := 0; j < rl.ScopeLogs().Len(); j++ {
+			sl := rl.ScopeLogs().At(j)
+			for k := 0; k < sl.LogRecords().Len(); k++ {
+				if !e.sampled() {
+					continue
+				}
+				item := plog.NewResourceLogs()
+				rl.Resource().CopyTo(item.Resource())
+				item.SetSchemaUrl(rl.SchemaUrl())
+				scope := item.ScopeLogs().AppendEmpty()
+				sl.Scope().CopyTo(scope.Scope())
+				scope.SetSchemaUrl(sl.SchemaUrl())
+				sl.LogRecords().At(k).CopyTo(scope.LogRecords().AppendEmpty())
+				e.logs.push(item)
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,150 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ringbufferexporter
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/common/testutil"
+)
+
+func newExporter(size int) *ringBufferExporter {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Size = size
+	return newRingBufferExporter(cfg, componenttest.NewNopTelemetrySettings())
+}
+
+func get(t *testing.T, e *ringBufferExporter, path string) *httptest.ResponseRecorder {
+	rec := httptest.NewRecorder()
+	e.handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, http.NoBody))
+	require.Equal(t, http.StatusOK, rec.Code)
+	return rec
+}
+
+func TestPushTraces(t *testing.T) {
+	e := newExporter(10)
+
+	td := ptrace.NewTraces()
+	rs := td.ResourceSpans().AppendEmpty()
+	rs.Resource().Attributes().PutStr("service.name", "cart")
+	ss := rs.ScopeSpans().AppendEmpty()
+	ss.Scope().SetName("tracer")
+	ss.Spans().AppendEmpty().SetName("foo")
+	ss.Spans().AppendEmpty().SetName("bar")
+	require.NoError(t, e.pushTraces(t.Context(), td))
+
+	rec := get(t, e, pagePath+"/traces")
+	assert.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+	resource := `"resource":{"attributes":[{"key":"service.name","value":{"stringValue":"cart"}}]}`
+	assert.JSONEq(t, `{"resourceSpans":[
+		{`+resource+`,"scopeSpans":[{"scope":{"name":"tracer"},"spans":[{"name":"foo","status":{}}]}]},
+		{`+resource+`,"scopeSpans":[{"scope":{"name":"tracer"},"spans":[{"name":"bar","status":{}}]}]}
+	]}`, rec.Body.String())
+}
+
+func TestPushMetrics(t *testing.T) {
+	e := newExporter(10)
+
+	md := pmetric.NewMetrics()
+	metrics := md.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics()
+	sum := metrics.AppendEmpty()
+	sum.SetName("requests")
+	sum.SetUnit("1")
+	sum.SetEmptySum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	sum.Sum().SetIsMonotonic(true)
+	sum.Sum().DataPoints().AppendEmpty().SetIntValue(1)
+	sum.Sum().DataPoints().AppendEmpty().SetIntValue(2)
+	gauge := metrics.AppendEmpty()
+	gauge.SetName("temperature")
+	gauge.SetEmptyGauge().DataPoints().AppendEmpty().SetDoubleValue(21.5)
+	require.NoError(t, e.pushMetrics(t.Context(), md))
+
+	rec := get(t, e, pagePath+"/metrics")
+	sumMetric := func(value string) string {
+		return `{"resource":{},"scopeMetrics":[{"scope":{},"metrics":[{"name":"requests","unit":"1","sum":{
+			"dataPoints":[{"asInt":"` + value + `"}],"aggregationTemporality":2,"isMonotonic":true}}]}]}`
+	}
+	assert.JSONEq(t, `{"resourceMetrics":[`+sumMetric("1")+`,`+sumMetric("2")+`,
+		{"resource":{},"scopeMetrics":[{"scope":{},"metrics":[{"name":"temperature","gauge":{"dataPoints":[{"asDouble":21.5}]}}]}]}
+	]}`, rec.Body.String())
+}
+
+func newLogs(bodies ...string) plog.Logs {
+	ld := plog.NewLogs()
+	records := ld.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords()
+	for _, body := range bodies {
+		records.AppendEmpty().Body().SetStr(body)
+	}
+	return ld
+}
+
+func TestPushLogs(t *testing.T) {
+	e := newExporter(2)
+
+	require.NoError(t, e.pushLogs(t.Context(), newLogs("foo", "bar")))
+	require.NoError(t, e.pushLogs(t.Context(), newLogs("baz")))
+
+	rec := get(t, e, pagePath+"/logs")
+	assert.JSONEq(t, `{"resourceLogs":[
+		{"resource":{},"scopeLogs":[{"scope":{},"logRecords":[{"body":{"stringValue":"bar"}}]}]},
+		{"resource":{},"scopeLogs":[{"scope":{},"logRecords":[{"body":{"stringValue":"baz"}}]}]}
+	]}`, rec.Body.String())
+}
+
+func TestPushSampling(t *testing.T) {
+	e := newExporter(10)
+	e.config.SamplingPercentage = 1e-9
+
+	require.NoError(t, e.pushLogs(t.Context(), newLogs("foo", "bar")))
+
+	items, total := e.logs.snapshot()
+	assert.Empty(t, items)
+	assert.Zero(t, total)
+}
+
+func TestPage(t *testing.T) {
+	e := newExporter(2)
+	require.NoError(t, e.pushLogs(t.Context(), newLogs("foo", "bar", "baz")))
+
+	rec := get(t, e, pagePath)
+	assert.Equal(t, "text/html; charset=utf-8", rec.Header().Get("Content-Type"))
+	body := rec.Body.String()
+	assert.Contains(t, body, `<tr><td><a href="/debug/ringbufferz/traces">traces</a></td><td>0</td><td>2</td><td>0</td></tr>`)
+	assert.Contains(t, body, `<tr><td><a href="/debug/ringbufferz/logs">logs</a></td><td>2</td><td>2</td><td>3</td></tr>`)
+}
+
+func TestStartShutdown(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Endpoint = testutil.GetAvailableLocalAddress(t)
+	e := newRingBufferExporter(cfg, componenttest.NewNopTelemetrySettings())
+	require.NoError(t, e.Start(t.Context(), componenttest.NewNopHost()))
+	t.Cleanup(func() {
+		assert.NoError(t, e.Shutdown(context.Background()))
+	})
+
+	require.NoError(t, e.pushLogs(t.Context(), newLogs("foo")))
+
+	resp, err := http.Get("http://" + cfg.Endpoint + pagePath + "/logs")
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.JSONEq(t, `{"resourceLogs":[{"resource":{},"scopeLogs":[{"scope":{},"logRecords":[{"body":{"stringValue":"foo"}}]}]}]}`, string(body))
+}
+
+func TestShutdownWithoutStart(t *testing.T) {
+	assert.NoError(t, newExporter(1).Shutdown(context.Background()))
+}
@@ -0,0 +1,125 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ringbufferexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/ringbufferexporter"
+
+import (
+	"html/template"
+	"net/http"
+
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.uber.org/zap"
+)
+
+// pagePath is the path of the page summarizing the buffers. The items of the
+// buffers are dumped as OTLP JSON under pagePath/traces, pagePath/metrics and
+// pagePath/logs.
+const pagePath = "/debug/ringbufferz"
+
+var (
+	logMarshaler    = &plog.JSONMarshaler{}
+	metricMarshaler = &pmetric.JSONMarshaler{}
+	traceMarshaler  = &ptrace.JSONMarshaler{}
+)
+
+var pageTemplate = template.Must(template.New("ringbufferz").Parse(`<!DOCTYPE html>
+<html>
+<head><title>Ring Buffer</title></head>
+<body>
+<h1>Ring Buffer</h1>
+<table border="1">
+<tr><th>Signal</th><th>Items</th><th>Size</th><th>Received</th></tr>
+{{- range .}}
+<tr><td><a href="{{.Path}}">{{.Signal}}</a></td><td>{{.Items}}</td><td>{{.Size}}</td><td>{{.Received}}</td></tr>
+{{- end}}
+</table>
+</body>
+</html>
+`))
+
+// bufferSummary is a row of the page, summarizing the buffer of a signal.
+type bufferSummary struct {
+	Signal string
+	Path   string
+	// Items is the number of items in the buffer, and Received the number of items
+	// pushed to it since the exporter started.
+	Items    int
+	Size     int
+	Received uint64
+}
+
+func summarize[T any](signal string, r *ring[T]) bufferSummary {
+	items, received := r.snapshot()
+	return bufferSummary{
+		Signal:   signal,
+		Path:     pagePath + "/" + signal,
+		Items:    len(items),
+		Size:     r.size(),
+		Received: received,
+	}
+}
+
+func (e *ringBufferExporter) handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc(pagePath, e.handlePage)
+	mux.HandleFunc(pagePath+"/traces", e.handleTraces)
+	mux.HandleFunc(pagePath+"/metrics", e.handleMetrics)
+	mux.HandleFunc(pagePath+"/logs", e.handleLogs)
+	return mux
+}
+
+func (e *ringBufferExporter) handlePage(w http.ResponseWriter, _ *http.Request) {
+	summaries := []bufferSummary{
+		summarize("traces", e.spans),
+		summarize("metrics", e.dataPoints),
+		summarize("logs", e.logs),
+	}
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	if err := pageTemplate.Execute(w, summaries); err != nil {
+		e.settings.Logger.Debug("Error rendering the page", zap.Error(err))
+	}
+}
+
+func (e *ringBufferExporter) handleTraces(w http.ResponseWriter, _ *http.Request) {
+	items, _ := e.spans.snapshot()
+	td := ptrace.NewTraces()
+	for _, item := range items {
+		item.CopyTo(td.ResourceSpans().AppendEmpty())
+	}
+	b, err := traceMarshaler.MarshalTraces(td)
+	e.writeJSON(w, b, err)
+}
+
+func (e *ringBufferExporter) handleMetrics(w http.ResponseWriter, _ *http.Request) {
+	items, _ := e.dataPoints.snapshot()
+	md := pmetric.NewMetrics()
+	for _, item := range items {
+		item.CopyTo(md.ResourceMetrics().AppendEmpty())
+	}
+	b, err := metricMarshaler.MarshalMetrics(md)
+	e.writeJSON(w, b, err)
+}
+
+func (e *ringBufferExporter) handleLogs(w http.ResponseWriter, _ *http.Request) {
+	items, _ := e.logs.snapshot()
+	ld := plog.NewLogs()
+	for _, item := range items {
+		item.CopyTo(ld.ResourceLogs().AppendEmpty())
+	}
+	b, err := logMarshaler.MarshalLogs(ld)
+	e.writeJSON(w, b, err)
+}
+
+func (e *ringBufferExporter) writeJSON(w http.ResponseWriter, b []byte, err error) {
+	if err != nil {
+		e.settings.Logger.Debug("Error serializing to JSON", zap.Error(err))
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if _, err := w.Write(b); err != nil {
+		e.settings.Logger.Debug("Error writing the response", zap.Error(err))
+	}
+}
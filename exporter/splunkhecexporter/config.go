@@ -131,6 +131,8 @@ type Config struct {
 	// HecToOtelAttrs creates a mapping from attributes to HEC specific metadata: source, sourcetype, index and host.
 	// Deprecated: [v0.113.0] Use OtelAttrsToHec instead.
 	HecToOtelAttrs splunk.HecToOtelAttrs `mapstructure:"hec_metadata_to_otel_attrs"`
+	// MetadataRouting selects the HEC index, source and sourcetype of each event from attributes.
+	MetadataRouting MetadataRouting `mapstructure:"metadata_routing"`
 	// HecFields creates a mapping from attributes to HEC fields.
 	HecFields OtelToHecFields `mapstructure:"otel_to_hec_fields"`
 
@@ -226,5 +228,9 @@ func (cfg *Config) Validate() error {
 		return fmt.Errorf(`requires "max_event_size" <= %d`, maxMaxEventSize)
 	}
 
+	if cfg.ExportRaw && !cfg.MetadataRouting.isEmpty() {
+		return errMetadataRoutingRaw
+	}
+
 	return nil
 }
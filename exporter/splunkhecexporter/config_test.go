@@ -112,6 +112,10 @@ func TestLoadConfig(t *testing.T) {
 					Index:      "myindex",
 					Host:       "myhost",
 				},
+				MetadataRouting: MetadataRouting{
+					Index:      []string{"splunk.index", "k8s.namespace.name"},
+					SourceType: []string{"log.type"},
+				},
 				HecFields: OtelToHecFields{
 					SeverityText:   "myseverityfield",
 					SeverityNumber: "myseveritynumfield",
@@ -236,6 +240,29 @@ func TestConfig_Validate(t *testing.T) {
 			}(),
 			wantErr: "sending_queue: `queue_size` must be positive",
 		},
+		{
+			name: "empty routing attribute",
+			cfg: func() *Config {
+				cfg := createDefaultConfig().(*Config)
+				cfg.Endpoint = "http://foo_bar.com"
+				cfg.Token = "foo"
+				cfg.MetadataRouting.Source = []string{"service.name", ""}
+				return cfg
+			}(),
+			wantErr: "\"metadata_routing::source\" contains an empty attribute name",
+		},
+		{
+			name: "routing with raw export",
+			cfg: func() *Config {
+				cfg := createDefaultConfig().(*Config)
+				cfg.Endpoint = "http://foo_bar.com"
+				cfg.Token = "foo"
+				cfg.ExportRaw = true
+				cfg.MetadataRouting.Index = []string{"splunk.index"}
+				return cfg
+			}(),
+			wantErr: "\"metadata_routing\" can't be used with \"export_raw\"",
+		},
 	}
 
 	for _, tt := range tests {
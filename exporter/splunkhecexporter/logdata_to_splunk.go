@@ -123,7 +123,7 @@ func mapLogRecordToSplunkEvent(res pcommon.Resource, lr plog.LogRecord, config *
 		ts = lr.ObservedTimestamp()
 	}
 
-	return &splunk.Event{
+	event := &splunk.Event{
 		Time:       nanoTimestampToEpochMilliseconds(ts),
 		Host:       host,
 		Source:     source,
@@ -132,6 +132,8 @@ func mapLogRecordToSplunkEvent(res pcommon.Resource, lr plog.LogRecord, config *
 		Event:      body,
 		Fields:     fields,
 	}
+	config.MetadataRouting.route(event, attributesLookup(lr.Attributes(), res.Attributes()))
+	return event
 }
 
 // nanoTimestampToEpochMilliseconds transforms nanoseconds into <sec>.<ms>. For example, 1433188255.500 indicates 1433188255 seconds and 500 milliseconds after epoch.
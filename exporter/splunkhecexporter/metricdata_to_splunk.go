@@ -52,6 +52,17 @@ func sanitizeFloat(value float64) any {
 }
 
 func mapMetricToSplunkEvent(res pcommon.Resource, m pmetric.Metric, config *Config, logger *zap.Logger) []*splunk.Event {
+	events := metricToSplunkEvents(res, m, config, logger)
+	if !config.MetadataRouting.isEmpty() {
+		// the fields of each event hold both the resource and the data point attributes.
+		for _, event := range events {
+			config.MetadataRouting.route(event, fieldsLookup(event.Fields))
+		}
+	}
+	return events
+}
+
+func metricToSplunkEvents(res pcommon.Resource, m pmetric.Metric, config *Config, logger *zap.Logger) []*splunk.Event {
 	sourceKey := config.HecToOtelAttrs.Source
 	sourceTypeKey := config.HecToOtelAttrs.SourceType
 	indexKey := config.HecToOtelAttrs.Index
@@ -0,0 +1,94 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package splunkhecexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/splunkhecexporter"
+
+import (
+	"errors"
+	"fmt"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/splunk"
+)
+
+// MetadataRouting selects the HEC index, source and sourcetype of each event from attributes.
+// For every field the attributes are checked in order and the first one present is used.
+// When none is present, the metadata set by otel_attrs_to_hec_metadata or the defaults apply.
+type MetadataRouting struct {
+	// Index lists the attributes to select the index of an event from.
+	Index []string `mapstructure:"index"`
+	// Source lists the attributes to select the source of an event from.
+	Source []string `mapstructure:"source"`
+	// SourceType lists the attributes to select the sourcetype of an event from.
+	SourceType []string `mapstructure:"sourcetype"`
+}
+
+func (r MetadataRouting) Validate() error {
+	return errors.Join(
+		validateRoutingAttributes("index", r.Index),
+		validateRoutingAttributes("source", r.Source),
+		validateRoutingAttributes("sourcetype", r.SourceType),
+	)
+}
+
+func validateRoutingAttributes(field string, attributes []string) error {
+	for _, attribute := range attributes {
+		if attribute == "" {
+			return fmt.Errorf(`"metadata_routing::%s" contains an empty attribute name`, field)
+		}
+	}
+	return nil
+}
+
+func (r MetadataRouting) isEmpty() bool {
+	return len(r.Index) == 0 && len(r.Source) == 0 && len(r.SourceType) == 0
+}
+
+// route sets the metadata of the event from the first attribute of each routing list found by lookup.
+func (r MetadataRouting) route(event *splunk.Event, lookup func(string) (string, bool)) {
+	if value, ok := firstRoutingValue(r.Index, lookup); ok {
+		event.Index = value
+	}
+	if value, ok := firstRoutingValue(r.Source, lookup); ok {
+		event.Source = value
+	}
+	if value, ok := firstRoutingValue(r.SourceType, lookup); ok {
+		event.SourceType = value
+	}
+}
+
+func firstRoutingValue(attributes []string, lookup func(string) (string, bool)) (string, bool) {
+	for _, attribute := range attributes {
+		if value, ok := lookup(attribute); ok && value != "" {
+			return value, true
+		}
+	}
+	return "", false
+}
+
+// attributesLookup looks up an attribute in the given maps, the first map containing it wins.
+func attributesLookup(maps ...pcommon.Map) func(string) (string, bool) {
+	return func(key string) (string, bool) {
+		for _, m := range maps {
+			if v, ok := m.Get(key); ok {
+				return v.AsString(), true
+			}
+		}
+		return "", false
+	}
+}
+
+// fieldsLookup looks up an attribute in the fields of an event.
+func fieldsLookup(fields map[string]any) func(string) (string, bool) {
+	return func(key string) (string, bool) {
+		v, ok := fields[key]
+		if !ok {
+			return "", false
+		}
+		s, ok := v.(string)
+		return s, ok
+	}
+}
+
+var errMetadataRoutingRaw = errors.New(`"metadata_routing" can't be used with "export_raw", as raw events have no metadata`)
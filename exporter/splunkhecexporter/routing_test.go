@@ -0,0 +1,140 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package splunkhecexporter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/splunk"
+)
+
+func routingConfig() *Config {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Index = "default_index"
+	cfg.Source = "default_source"
+	cfg.SourceType = "default_sourcetype"
+	cfg.MetadataRouting = MetadataRouting{
+		Index:      []string{"tenant.index", "k8s.namespace.name"},
+		Source:     []string{"log.file.path"},
+		SourceType: []string{"log.type"},
+	}
+	return cfg
+}
+
+func TestMetadataRoutingLogs(t *testing.T) {
+	tests := []struct {
+		name           string
+		resourceAttrs  map[string]any
+		recordAttrs    map[string]any
+		wantIndex      string
+		wantSource     string
+		wantSourceType string
+	}{
+		{
+			name:           "defaults",
+			wantIndex:      "default_index",
+			wantSource:     "default_source",
+			wantSourceType: "default_sourcetype",
+		},
+		{
+			name:           "resource attributes",
+			resourceAttrs:  map[string]any{"k8s.namespace.name": "payments", "log.type": "access"},
+			wantIndex:      "payments",
+			wantSource:     "default_source",
+			wantSourceType: "access",
+		},
+		{
+			name:           "record attributes take precedence",
+			resourceAttrs:  map[string]any{"k8s.namespace.name": "payments", "log.type": "access"},
+			recordAttrs:    map[string]any{"k8s.namespace.name": "billing", "log.file.path": "/var/log/app.log"},
+			wantIndex:      "billing",
+			wantSource:     "/var/log/app.log",
+			wantSourceType: "access",
+		},
+		{
+			name:           "first attribute of the rule wins",
+			resourceAttrs:  map[string]any{"k8s.namespace.name": "payments"},
+			recordAttrs:    map[string]any{"tenant.index": "tenant_a"},
+			wantIndex:      "tenant_a",
+			wantSource:     "default_source",
+			wantSourceType: "default_sourcetype",
+		},
+		{
+			name:           "empty values fall back",
+			resourceAttrs:  map[string]any{"tenant.index": "", "k8s.namespace.name": "payments"},
+			wantIndex:      "payments",
+			wantSource:     "default_source",
+			wantSourceType: "default_sourcetype",
+		},
+		{
+			name:           "routing overrides hec metadata attributes",
+			resourceAttrs:  map[string]any{splunk.DefaultIndexLabel: "legacy", "k8s.namespace.name": "payments"},
+			wantIndex:      "payments",
+			wantSource:     "default_source",
+			wantSourceType: "default_sourcetype",
+		},
+		{
+			name:           "hec metadata attributes without routing match",
+			resourceAttrs:  map[string]any{splunk.DefaultIndexLabel: "legacy"},
+			wantIndex:      "legacy",
+			wantSource:     "default_source",
+			wantSourceType: "default_sourcetype",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res := pcommon.NewResource()
+			require.NoError(t, res.Attributes().FromRaw(tt.resourceAttrs))
+			lr := plog.NewLogRecord()
+			lr.Body().SetStr("message")
+			require.NoError(t, lr.Attributes().FromRaw(tt.recordAttrs))
+
+			event := mapLogRecordToSplunkEvent(res, lr, routingConfig())
+			assert.Equal(t, tt.wantIndex, event.Index)
+			assert.Equal(t, tt.wantSource, event.Source)
+			assert.Equal(t, tt.wantSourceType, event.SourceType)
+		})
+	}
+}
+
+func TestMetadataRoutingTraces(t *testing.T) {
+	res := pcommon.NewResource()
+	res.Attributes().PutStr("k8s.namespace.name", "payments")
+	res.Attributes().PutStr("log.type", "spans")
+	span := ptrace.NewSpan()
+	span.Attributes().PutStr("k8s.namespace.name", "billing")
+
+	event := mapSpanToSplunkEvent(res, span, routingConfig())
+	assert.Equal(t, "billing", event.Index)
+	assert.Equal(t, "default_source", event.Source)
+	assert.Equal(t, "spans", event.SourceType)
+}
+
+func TestMetadataRoutingMetrics(t *testing.T) {
+	res := pcommon.NewResource()
+	res.Attributes().PutStr("k8s.namespace.name", "payments")
+	m := pmetric.NewMetric()
+	m.SetName("requests")
+	dps := m.SetEmptyGauge().DataPoints()
+	dp := dps.AppendEmpty()
+	dp.SetIntValue(1)
+	dp = dps.AppendEmpty()
+	dp.SetIntValue(2)
+	dp.Attributes().PutStr("tenant.index", "tenant_a")
+
+	events := mapMetricToSplunkEvent(res, m, routingConfig(), zap.NewNop())
+	require.Len(t, events, 2)
+	assert.Equal(t, "payments", events[0].Index)
+	assert.Equal(t, "tenant_a", events[1].Index)
+	assert.Equal(t, "default_source", events[1].Source)
+	assert.Equal(t, "default_sourcetype", events[1].SourceType)
+}
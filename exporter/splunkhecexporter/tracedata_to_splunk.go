@@ -84,6 +84,7 @@ func mapSpanToSplunkEvent(resource pcommon.Resource, span ptrace.Span, config *C
 		Event:      toHecSpan(span),
 		Fields:     commonFields,
 	}
+	config.MetadataRouting.route(se, attributesLookup(span.Attributes(), resource.Attributes()))
 
 	return se
 }
@@ -0,0 +1,155 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package sqlexporter
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config/configretry"
+	"go.opentelemetry.io/collector/confmap/confmaptest"
+	"go.opentelemetry.io/collector/confmap/xconfmap"
+	"go.opentelemetry.io/collector/exporter/exporterhelper"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/sqlexporter/internal/metadata"
+)
+
+func TestLoadConfig(t *testing.T) {
+	cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
+	require.NoError(t, err)
+
+	queueSettings := exporterhelper.NewDefaultQueueConfig()
+	queueSettings.Enabled = false
+	retrySettings := configretry.NewDefaultBackOffConfig()
+	retrySettings.Enabled = false
+
+	tests := []struct {
+		id          component.ID
+		expected    component.Config
+		expectedErr string
+	}{
+		{
+			id:          component.NewID(metadata.Type),
+			expectedErr: "driver is required",
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "sqlite"),
+			expected: &Config{
+				TimeoutSettings: exporterhelper.TimeoutConfig{Timeout: 10 * time.Second},
+				QueueSettings:   queueSettings,
+				BackOffConfig:   retrySettings,
+				Driver:          driverSQLite,
+				DataSource:      "file:/var/lib/otelcol/telemetry.db",
+				InitStatements:  []string{"CREATE TABLE IF NOT EXISTS logs (ts TIMESTAMP, service TEXT, body TEXT)"},
+				MaxOpenConns:    1,
+				Traces:          TableConfig{Table: "spans"},
+				Metrics:         TableConfig{Table: defaultMetricsTable},
+				Logs: TableConfig{
+					Table:     defaultLogsTable,
+					Statement: "INSERT INTO logs (ts, service, body) VALUES (?, ?, ?)",
+					Values:    []string{"timestamp", "resource.attributes.service.name", "body"},
+				},
+			},
+		},
+		{
+			id:          component.NewIDWithName(metadata.Type, "missing_driver"),
+			expectedErr: "driver is required",
+		},
+		{
+			id:          component.NewIDWithName(metadata.Type, "invalid_driver"),
+			expectedErr: `unsupported driver "oracle", must be one of postgres, mysql or sqlite`,
+		},
+		{
+			id:          component.NewIDWithName(metadata.Type, "missing_datasource"),
+			expectedErr: "datasource is required",
+		},
+		{
+			id:          component.NewIDWithName(metadata.Type, "unknown_value"),
+			expectedErr: `metrics: unknown value "exemplars"`,
+		},
+		{
+			id:          component.NewIDWithName(metadata.Type, "values_without_statement"),
+			expectedErr: "traces: values can only be set with statement",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.id.String(), func(t *testing.T) {
+			factory := NewFactory()
+			cfg := factory.CreateDefaultConfig()
+
+			sub, err := cm.Sub(tt.id.String())
+			require.NoError(t, err)
+			require.NoError(t, sub.Unmarshal(cfg))
+
+			if tt.expectedErr != "" {
+				assert.ErrorContains(t, xconfmap.Validate(cfg), tt.expectedErr)
+				return
+			}
+			require.NoError(t, xconfmap.Validate(cfg))
+			assert.Equal(t, tt.expected, cfg)
+		})
+	}
+}
+
+func TestValidateTable(t *testing.T) {
+	tests := []struct {
+		name        string
+		table       TableConfig
+		expectedErr string
+	}{
+		{
+			name:  "default statement",
+			table: TableConfig{Table: "spans"},
+		},
+		{
+			name:        "no table",
+			table:       TableConfig{},
+			expectedErr: "table is required when statement is empty",
+		},
+		{
+			name:        "statement without values",
+			table:       TableConfig{Statement: "INSERT INTO spans (name) VALUES (?)"},
+			expectedErr: "values are required with statement",
+		},
+		{
+			name: "attribute values",
+			table: TableConfig{
+				Statement: "INSERT INTO spans (name, route, service) VALUES (?, ?, ?)",
+				Values:    []string{"name", "attributes.http.route", "resource.attributes.service.name"},
+			},
+		},
+		{
+			name: "missing attribute key",
+			table: TableConfig{
+				Statement: "INSERT INTO spans (route) VALUES (?)",
+				Values:    []string{"attributes."},
+			},
+			expectedErr: `missing attribute key in value "attributes."`,
+		},
+		{
+			name: "value of another signal",
+			table: TableConfig{
+				Statement: "INSERT INTO spans (body) VALUES (?)",
+				Values:    []string{"body"},
+			},
+			expectedErr: `unknown value "body"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateTable(tt.table, spanValues)
+			if tt.expectedErr != "" {
+				assert.EqualError(t, err, tt.expectedErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,197 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package sqlexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/sqlexporter"
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	_ "github.com/go-sql-driver/mysql" // MySQL driver
+	_ "github.com/jackc/pgx/v5/stdlib" // Postgres driver
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.uber.org/zap"
+	_ "modernc.org/sqlite" // SQLite driver
+)
+
+// driverNames are the names the drivers are registered with in database/sql.
+var driverNames = map[string]string{
+	driverPostgres: "pgx",
+	driverMySQL:    "mysql",
+	driverSQLite:   "sqlite",
+}
+
+type sqlExporter struct {
+	config *Config
+	logger *zap.Logger
+	db     *sql.DB
+
+	spans      *tableWriter[spanRow]
+	dataPoints *tableWriter[dataPointRow]
+	logs       *tableWriter[logRow]
+}
+
+// tableWriter inserts the rows of a signal with a statement.
+type tableWriter[R row] struct {
+	statement string
+	values    []valueFunc[R]
+}
+
+func newTableWriter[R row](cfg TableConfig, driver string, defaultValues []string, funcs map[string]valueFunc[R]) (*tableWriter[R], error) {
+	statement, names := cfg.Statement, cfg.Values
+	if statement == "" {
+		statement, names = insertStatement(driver, cfg.Table, defaultValues), defaultValues
+	}
+	values, err := parseValues(names, funcs)
+	if err != nil {
+		return nil, err
+	}
+	return &tableWriter[R]{statement: statement, values: values}, nil
+}
+
+func (w *tableWriter[R]) args(r R) []any {
+	args := make([]any, len(w.values))
+	for i, value := range w.values {
+		args[i] = value(r)
+	}
+	return args
+}
+
+// insertStatement returns an INSERT statement of the columns into the table, with
+// the placeholders of the driver.
+func insertStatement(driver, table string, columns []string) string {
+	placeholders := make([]string, len(columns))
+	for i := range columns {
+		if driver == driverPostgres {
+			placeholders[i] = fmt.Sprintf("$%d", i+1)
+		} else {
+			placeholders[i] = "?"
+		}
+	}
+	return fmt.Sprintf("INSERT INTO %s (%s) VALUES (%s)", table, strings.Join(columns, ", "), strings.Join(placeholders, ", "))
+}
+
+func newSQLExporter(config *Config, set component.TelemetrySettings) (*sqlExporter, error) {
+	spans, err := newTableWriter(config.Traces, config.Driver, defaultSpanValues, spanValues)
+	if err != nil {
+		return nil, fmt.Errorf("traces: %w", err)
+	}
+	dataPoints, err := newTableWriter(config.Metrics, config.Driver, defaultDataPointValues, dataPointValues)
+	if err != nil {
+		return nil, fmt.Errorf("metrics: %w", err)
+	}
+	logs, err := newTableWriter(config.Logs, config.Driver, defaultLogValues, logValues)
+	if err != nil {
+		return nil, fmt.Errorf("logs: %w", err)
+	}
+	return &sqlExporter{
+		config:     config,
+		logger:     set.Logger,
+		spans:      spans,
+		dataPoints: dataPoints,
+		logs:       logs,
+	}, nil
+}
+
+func (e *sqlExporter) start(ctx context.Context, _ component.Host) error {
+	db, err := sql.Open(driverNames[e.config.Driver], string(e.config.DataSource))
+	if err != nil {
+		return err
+	}
+	db.SetMaxOpenConns(e.config.MaxOpenConns)
+	if err := db.PingContext(ctx); err != nil {
+		return errors.Join(fmt.Errorf("failed to connect to the database: %w", err), db.Close())
+	}
+	for i, statement := range e.config.InitStatements {
+		if _, err := db.ExecContext(ctx, statement); err != nil {
+			return errors.Join(fmt.Errorf("failed to execute init_statements[%d]: %w", i, err), db.Close())
+		}
+	}
+	e.db = db
+	return nil
+}
+
+func (e *sqlExporter) shutdown(context.Context) error {
+	if e.db == nil {
+		return nil
+	}
+	return e.db.Close()
+}
+
+func (e *sqlExporter) pushTraces(ctx context.Context, td ptrace.Traces) error {
+	var rows [][]any
+	for i := 0; i < td.ResourceSpans().Len(); i++ {
+		rs := td.ResourceSpans().At(i)
+		for j := 0; j < rs.ScopeSpans().Len(); j++ {
+			ss := rs.ScopeSpans().At(j)
+			for k := 0; k < ss.Spans().Len(); k++ {
+				rows = append(rows, e.spans.args(spanRow{res: rs.Resource(), scp: ss.Scope(), span: ss.Spans().At(k)}))
+			}
+		}
+	}
+	return e.insert(ctx, e.spans.statement, rows)
+}
+
+func (e *sqlExporter) pushMetrics(ctx context.Context, md pmetric.Metrics) error {
+	var rows [][]any
+	for i := 0; i < md.ResourceMetrics().Len(); i++ {
+		rm := md.ResourceMetrics().At(i)
+		for j := 0; j < rm.ScopeMetrics().Len(); j++ {
+			sm := rm.ScopeMetrics().At(j)
+			for k := 0; k < sm.Metrics().Len(); k++ {
+				dataPointRows(rm.Resource(), sm.Scope(), sm.Metrics().At(k), func(r dataPointRow) {
+					rows = append(rows, e.dataPoints.args(r))
+				})
+			}
+		}
+	}
+	return e.insert(ctx, e.dataPoints.statement, rows)
+}
+
+func (e *sqlExporter) pushLogs(ctx context.Context, ld plog.Logs) error {
+	var rows [][]any
+	for i := 0; i < ld.ResourceLogs().Len(); i++ {
+		rl := ld.ResourceLogs().At(i)
+		for j := 0; j < rl.ScopeLogs().Len(); j++ {
+			sl := rl.ScopeLogs().At(j)
+			for k := 0; k < sl.LogRecords().Len(); k++ {
+				rows = append(rows, e.logs.args(logRow{res: rl.Resource(), scp: sl.Scope(), record: sl.LogRecords().At(k)}))
+			}
+		}
+	}
+	return e.insert(ctx, e.logs.statement, rows)
+}
+
+// insert executes the statement for each row in a single transaction, so that
+// either all or none of the rows of a batch are written.
+func (e *sqlExporter) insert(ctx context.Context, statement string, rows [][]any) error {
+	if len(rows) == 0 {
+		return nil
+	}
+	tx, err := e.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	stmt, err := tx.PrepareContext(ctx, statement)
+	if err != nil {
+		return errors.Join(fmt.Errorf("failed to prepare statement: %w", err), tx.Rollback())
+	}
+	defer stmt.Close()
+
+	for _, args := range rows {
+		if _, err := stmt.ExecContext(ctx, args...); err != nil {
+			return errors.Join(fmt.Errorf("failed to insert row: %w", err), tx.Rollback())
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	e.logger.Debug("Inserted rows", zap.Int("rows", len(rows)))
+	return nil
+}
@@ -0,0 +1,203 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package sqlexporter
+
+import (
+	"context"
+	"database/sql"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/config/configopaque"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+var testTime = time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+
+func newTestConfig(t *testing.T, initStatements ...string) *Config {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Driver = driverSQLite
+	cfg.DataSource = configopaque.String("file:" + filepath.Join(t.TempDir(), "telemetry.db"))
+	cfg.InitStatements = initStatements
+	return cfg
+}
+
+func startExporter(t *testing.T, cfg *Config) *sqlExporter {
+	e, err := newSQLExporter(cfg, componenttest.NewNopTelemetrySettings())
+	require.NoError(t, err)
+	require.NoError(t, e.start(t.Context(), componenttest.NewNopHost()))
+	t.Cleanup(func() {
+		assert.NoError(t, e.shutdown(context.Background()))
+	})
+	return e
+}
+
+func queryRows(t *testing.T, db *sql.DB, query string) [][]any {
+	rows, err := db.QueryContext(t.Context(), query)
+	require.NoError(t, err)
+	defer rows.Close()
+
+	columns, err := rows.Columns()
+	require.NoError(t, err)
+	var got [][]any
+	for rows.Next() {
+		values := make([]any, len(columns))
+		dest := make([]any, len(columns))
+		for i := range values {
+			dest[i] = &values[i]
+		}
+		require.NoError(t, rows.Scan(dest...))
+		got = append(got, values)
+	}
+	require.NoError(t, rows.Err())
+	return got
+}
+
+func TestPushLogs(t *testing.T) {
+	e := startExporter(t, newTestConfig(t,
+		`CREATE TABLE otel_logs (timestamp TEXT, observed_timestamp TEXT, trace_id TEXT, span_id TEXT,
+			severity_number INTEGER, severity_text TEXT, body TEXT, attributes TEXT, resource_attributes TEXT, scope_name TEXT)`,
+	))
+
+	ld := plog.NewLogs()
+	rl := ld.ResourceLogs().AppendEmpty()
+	rl.Resource().Attributes().PutStr("service.name", "checkout")
+	sl := rl.ScopeLogs().AppendEmpty()
+	sl.Scope().SetName("checkout")
+	record := sl.LogRecords().AppendEmpty()
+	record.SetTimestamp(pcommon.NewTimestampFromTime(testTime))
+	record.SetTraceID(pcommon.TraceID{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16})
+	record.SetSeverityNumber(plog.SeverityNumberError)
+	record.SetSeverityText("ERROR")
+	record.Body().SetStr("payment declined")
+	record.Attributes().PutStr("user.id", "42")
+	sl.LogRecords().AppendEmpty().Body().SetStr("retrying")
+
+	require.NoError(t, e.pushLogs(t.Context(), ld))
+
+	assert.Equal(t, [][]any{
+		{"0102030405060708090a0b0c0d0e0f10", int64(17), "ERROR", "payment declined", `{"user.id":"42"}`, `{"service.name":"checkout"}`, "checkout"},
+		{nil, int64(0), "", "retrying", `{}`, `{"service.name":"checkout"}`, "checkout"},
+	}, queryRows(t, e.db, "SELECT trace_id, severity_number, severity_text, body, attributes, resource_attributes, scope_name FROM otel_logs"))
+}
+
+func TestPushTraces(t *testing.T) {
+	e := startExporter(t, newTestConfig(t,
+		`CREATE TABLE otel_traces (trace_id TEXT, span_id TEXT, parent_span_id TEXT, name TEXT, kind TEXT,
+			start_timestamp TEXT, end_timestamp TEXT, duration INTEGER, status_code TEXT, status_message TEXT,
+			attributes TEXT, resource_attributes TEXT, scope_name TEXT)`,
+	))
+
+	td := ptrace.NewTraces()
+	span := td.ResourceSpans().AppendEmpty().ScopeSpans().AppendEmpty().Spans().AppendEmpty()
+	span.SetTraceID(pcommon.TraceID{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16})
+	span.SetSpanID(pcommon.SpanID{1, 2, 3, 4, 5, 6, 7, 8})
+	span.SetName("GET /cart")
+	span.SetKind(ptrace.SpanKindServer)
+	span.SetStartTimestamp(pcommon.NewTimestampFromTime(testTime))
+	span.SetEndTimestamp(pcommon.NewTimestampFromTime(testTime.Add(250 * time.Millisecond)))
+	span.Status().SetCode(ptrace.StatusCodeError)
+	span.Status().SetMessage("timeout")
+
+	require.NoError(t, e.pushTraces(t.Context(), td))
+
+	assert.Equal(t, [][]any{
+		{"0102030405060708090a0b0c0d0e0f10", "0102030405060708", nil, "GET /cart", "Server", int64(250 * time.Millisecond), "Error", "timeout"},
+	}, queryRows(t, e.db, "SELECT trace_id, span_id, parent_span_id, name, kind, duration, status_code, status_message FROM otel_traces"))
+}
+
+func TestPushMetrics(t *testing.T) {
+	e := startExporter(t, newTestConfig(t,
+		`CREATE TABLE otel_metrics (metric_name TEXT, metric_type TEXT, metric_unit TEXT, start_timestamp TEXT,
+			timestamp TEXT, value REAL, count INTEGER, sum REAL, attributes TEXT, resource_attributes TEXT, scope_name TEXT)`,
+	))
+
+	md := pmetric.NewMetrics()
+	metrics := md.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics()
+	gauge := metrics.AppendEmpty()
+	gauge.SetName("queue.size")
+	gauge.SetUnit("{item}")
+	dp := gauge.SetEmptyGauge().DataPoints().AppendEmpty()
+	dp.SetTimestamp(pcommon.NewTimestampFromTime(testTime))
+	dp.SetIntValue(5)
+	dp.Attributes().PutStr("queue", "orders")
+	histogram := metrics.AppendEmpty()
+	histogram.SetName("request.duration")
+	histogram.SetUnit("s")
+	hdp := histogram.SetEmptyHistogram().DataPoints().AppendEmpty()
+	hdp.SetCount(3)
+	hdp.SetSum(1.5)
+
+	require.NoError(t, e.pushMetrics(t.Context(), md))
+
+	assert.Equal(t, [][]any{
+		{"queue.size", "Gauge", "{item}", float64(5), nil, nil, `{"queue":"orders"}`},
+		{"request.duration", "Histogram", "s", nil, int64(3), 1.5, `{}`},
+	}, queryRows(t, e.db, "SELECT metric_name, metric_type, metric_unit, value, count, sum, attributes FROM otel_metrics"))
+}
+
+func TestPushLogsStatement(t *testing.T) {
+	cfg := newTestConfig(t, "CREATE TABLE logs (service TEXT, body TEXT)")
+	cfg.Logs = TableConfig{
+		Statement: "INSERT INTO logs (service, body) VALUES (?, ?)",
+		Values:    []string{"resource.attributes.service.name", "body"},
+	}
+	e := startExporter(t, cfg)
+
+	ld := plog.NewLogs()
+	rl := ld.ResourceLogs().AppendEmpty()
+	rl.Resource().Attributes().PutStr("service.name", "checkout")
+	rl.ScopeLogs().AppendEmpty().LogRecords().AppendEmpty().Body().SetStr("payment declined")
+
+	require.NoError(t, e.pushLogs(t.Context(), ld))
+
+	assert.Equal(t, [][]any{{"checkout", "payment declined"}}, queryRows(t, e.db, "SELECT service, body FROM logs"))
+}
+
+func TestPushLogsError(t *testing.T) {
+	e := startExporter(t, newTestConfig(t, "CREATE TABLE otel_logs (body TEXT NOT NULL)"))
+	e.logs.statement = "INSERT INTO otel_logs (body) VALUES (?)"
+	e.logs.values = []valueFunc[logRow]{func(r logRow) any {
+		if r.record.Body().Type() == pcommon.ValueTypeEmpty {
+			return nil
+		}
+		return r.record.Body().AsString()
+	}}
+
+	ld := plog.NewLogs()
+	records := ld.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords()
+	records.AppendEmpty().Body().SetStr("payment declined")
+	records.AppendEmpty()
+
+	assert.ErrorContains(t, e.pushLogs(t.Context(), ld), "failed to insert row")
+	// The rows of a batch are inserted in a single transaction.
+	assert.Empty(t, queryRows(t, e.db, "SELECT body FROM otel_logs"))
+}
+
+func TestErrors(t *testing.T) {
+	t.Run("missing table", func(t *testing.T) {
+		e := startExporter(t, newTestConfig(t))
+		assert.ErrorContains(t, e.pushLogs(t.Context(), newLogs()), "failed to prepare statement")
+	})
+
+	t.Run("init statement", func(t *testing.T) {
+		e, err := newSQLExporter(newTestConfig(t, "CREATE TABLE logs (body TEXT)", "CREATE TABL logs"), componenttest.NewNopTelemetrySettings())
+		require.NoError(t, err)
+		assert.ErrorContains(t, e.start(t.Context(), componenttest.NewNopHost()), "failed to execute init_statements[1]")
+		assert.NoError(t, e.shutdown(context.Background()))
+	})
+}
+
+func newLogs() plog.Logs {
+	ld := plog.NewLogs()
+	ld.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords().AppendEmpty().Body().SetStr("payment declined")
+	return ld
+}
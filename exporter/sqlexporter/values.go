@@ -0,0 +1,288 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package sqlexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/sqlexporter"
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+const (
+	attributesPrefix         = "attributes."
+	resourceAttributesPrefix = "resource.attributes."
+)
+
+// row is the telemetry item written to a row of a table.
+type row interface {
+	resource() pcommon.Resource
+	scope() pcommon.InstrumentationScope
+	attributes() pcommon.Map
+}
+
+// valueFunc returns the value bound to a placeholder of the statement for a row.
+type valueFunc[R row] func(R) any
+
+type logRow struct {
+	res    pcommon.Resource
+	scp    pcommon.InstrumentationScope
+	record plog.LogRecord
+}
+
+func (r logRow) resource() pcommon.Resource          { return r.res }
+func (r logRow) scope() pcommon.InstrumentationScope { return r.scp }
+func (r logRow) attributes() pcommon.Map             { return r.record.Attributes() }
+
+type spanRow struct {
+	res  pcommon.Resource
+	scp  pcommon.InstrumentationScope
+	span ptrace.Span
+}
+
+func (r spanRow) resource() pcommon.Resource          { return r.res }
+func (r spanRow) scope() pcommon.InstrumentationScope { return r.scp }
+func (r spanRow) attributes() pcommon.Map             { return r.span.Attributes() }
+
+// dataPointRow is a data point of any metric type. The value is only set for
+// gauges and sums, and the count and sum for histograms and summaries.
+type dataPointRow struct {
+	res            pcommon.Resource
+	scp            pcommon.InstrumentationScope
+	metric         pmetric.Metric
+	attrs          pcommon.Map
+	startTimestamp pcommon.Timestamp
+	timestamp      pcommon.Timestamp
+	value          any
+	count          any
+	sum            any
+}
+
+func (r dataPointRow) resource() pcommon.Resource          { return r.res }
+func (r dataPointRow) scope() pcommon.InstrumentationScope { return r.scp }
+func (r dataPointRow) attributes() pcommon.Map             { return r.attrs }
+
+var (
+	logValues = map[string]valueFunc[logRow]{
+		"timestamp":          func(r logRow) any { return timestampValue(r.record.Timestamp()) },
+		"observed_timestamp": func(r logRow) any { return timestampValue(r.record.ObservedTimestamp()) },
+		"trace_id":           func(r logRow) any { return traceIDValue(r.record.TraceID()) },
+		"span_id":            func(r logRow) any { return spanIDValue(r.record.SpanID()) },
+		"flags":              func(r logRow) any { return int64(r.record.Flags()) },
+		"severity_number":    func(r logRow) any { return int64(r.record.SeverityNumber()) },
+		"severity_text":      func(r logRow) any { return r.record.SeverityText() },
+		"body":               func(r logRow) any { return r.record.Body().AsString() },
+	}
+	spanValues = map[string]valueFunc[spanRow]{
+		"trace_id":        func(r spanRow) any { return traceIDValue(r.span.TraceID()) },
+		"span_id":         func(r spanRow) any { return spanIDValue(r.span.SpanID()) },
+		"parent_span_id":  func(r spanRow) any { return spanIDValue(r.span.ParentSpanID()) },
+		"trace_state":     func(r spanRow) any { return r.span.TraceState().AsRaw() },
+		"name":            func(r spanRow) any { return r.span.Name() },
+		"kind":            func(r spanRow) any { return r.span.Kind().String() },
+		"start_timestamp": func(r spanRow) any { return timestampValue(r.span.StartTimestamp()) },
+		"end_timestamp":   func(r spanRow) any { return timestampValue(r.span.EndTimestamp()) },
+		"duration": func(r spanRow) any {
+			return int64(r.span.EndTimestamp()) - int64(r.span.StartTimestamp())
+		},
+		"status_code":    func(r spanRow) any { return r.span.Status().Code().String() },
+		"status_message": func(r spanRow) any { return r.span.Status().Message() },
+		"events":         func(r spanRow) any { return eventsValue(r.span.Events()) },
+	}
+	dataPointValues = map[string]valueFunc[dataPointRow]{
+		"metric_name":        func(r dataPointRow) any { return r.metric.Name() },
+		"metric_description": func(r dataPointRow) any { return r.metric.Description() },
+		"metric_unit":        func(r dataPointRow) any { return r.metric.Unit() },
+		"metric_type":        func(r dataPointRow) any { return r.metric.Type().String() },
+		"start_timestamp":    func(r dataPointRow) any { return timestampValue(r.startTimestamp) },
+		"timestamp":          func(r dataPointRow) any { return timestampValue(r.timestamp) },
+		"value":              func(r dataPointRow) any { return r.value },
+		"count":              func(r dataPointRow) any { return r.count },
+		"sum":                func(r dataPointRow) any { return r.sum },
+	}
+)
+
+// The values inserted in the default tables, which are also the names of their columns.
+var (
+	defaultLogValues = []string{
+		"timestamp", "observed_timestamp", "trace_id", "span_id", "severity_number", "severity_text",
+		"body", "attributes", "resource_attributes", "scope_name",
+	}
+	defaultSpanValues = []string{
+		"trace_id", "span_id", "parent_span_id", "name", "kind", "start_timestamp", "end_timestamp",
+		"duration", "status_code", "status_message", "attributes", "resource_attributes", "scope_name",
+	}
+	defaultDataPointValues = []string{
+		"metric_name", "metric_type", "metric_unit", "start_timestamp", "timestamp", "value", "count",
+		"sum", "attributes", "resource_attributes", "scope_name",
+	}
+)
+
+// parseValues returns the functions computing the values of the rows, by name.
+// On top of the values of the signal, all signals support the attributes,
+// resource_attributes, scope_name and scope_version values, and attributes.<key>
+// and resource.attributes.<key> for the value of a single attribute.
+func parseValues[R row](names []string, funcs map[string]valueFunc[R]) ([]valueFunc[R], error) {
+	parsed := make([]valueFunc[R], 0, len(names))
+	for _, name := range names {
+		if f, ok := funcs[name]; ok {
+			parsed = append(parsed, f)
+			continue
+		}
+		f, err := commonValue[R](name)
+		if err != nil {
+			return nil, err
+		}
+		parsed = append(parsed, f)
+	}
+	return parsed, nil
+}
+
+func commonValue[R row](name string) (valueFunc[R], error) {
+	switch name {
+	case "attributes":
+		return func(r R) any { return mapValue(r.attributes()) }, nil
+	case "resource_attributes":
+		return func(r R) any { return mapValue(r.resource().Attributes()) }, nil
+	case "scope_name":
+		return func(r R) any { return r.scope().Name() }, nil
+	case "scope_version":
+		return func(r R) any { return r.scope().Version() }, nil
+	}
+
+	var get func(R) pcommon.Map
+	var key string
+	switch {
+	case strings.HasPrefix(name, resourceAttributesPrefix):
+		get, key = func(r R) pcommon.Map { return r.resource().Attributes() }, strings.TrimPrefix(name, resourceAttributesPrefix)
+	case strings.HasPrefix(name, attributesPrefix):
+		get, key = func(r R) pcommon.Map { return r.attributes() }, strings.TrimPrefix(name, attributesPrefix)
+	default:
+		return nil, fmt.Errorf("unknown value %q", name)
+	}
+	if key == "" {
+		return nil, fmt.Errorf("missing attribute key in value %q", name)
+	}
+	return func(r R) any {
+		v, ok := get(r).Get(key)
+		if !ok {
+			return nil
+		}
+		return v.AsString()
+	}, nil
+}
+
+// timestampValue returns the timestamp as a UTC time, or NULL if it is not set.
+func timestampValue(ts pcommon.Timestamp) any {
+	if ts == 0 {
+		return nil
+	}
+	return ts.AsTime().UTC()
+}
+
+func traceIDValue(id pcommon.TraceID) any {
+	if id.IsEmpty() {
+		return nil
+	}
+	return id.String()
+}
+
+func spanIDValue(id pcommon.SpanID) any {
+	if id.IsEmpty() {
+		return nil
+	}
+	return id.String()
+}
+
+// mapValue returns the attributes as a JSON object.
+func mapValue(m pcommon.Map) any {
+	b, err := json.Marshal(m.AsRaw())
+	if err != nil {
+		return nil
+	}
+	return string(b)
+}
+
+// eventsValue returns the events as a JSON array of objects with their name,
+// timestamp and attributes.
+func eventsValue(events ptrace.SpanEventSlice) any {
+	raw := make([]map[string]any, 0, events.Len())
+	for i := 0; i < events.Len(); i++ {
+		event := events.At(i)
+		raw = append(raw, map[string]any{
+			"name":       event.Name(),
+			"timestamp":  event.Timestamp().AsTime().UTC(),
+			"attributes": event.Attributes().AsRaw(),
+		})
+	}
+	b, err := json.Marshal(raw)
+	if err != nil {
+		return nil
+	}
+	return string(b)
+}
+
+// dataPointRows calls fn with a row for each data point of the metric.
+func dataPointRows(res pcommon.Resource, scp pcommon.InstrumentationScope, metric pmetric.Metric, fn func(dataPointRow)) {
+	newRow := func(attrs pcommon.Map, start, ts pcommon.Timestamp) dataPointRow {
+		return dataPointRow{res: res, scp: scp, metric: metric, attrs: attrs, startTimestamp: start, timestamp: ts}
+	}
+	numberRows := func(dps pmetric.NumberDataPointSlice) {
+		for i := 0; i < dps.Len(); i++ {
+			dp := dps.At(i)
+			r := newRow(dp.Attributes(), dp.StartTimestamp(), dp.Timestamp())
+			switch dp.ValueType() {
+			case pmetric.NumberDataPointValueTypeInt:
+				r.value = dp.IntValue()
+			case pmetric.NumberDataPointValueTypeDouble:
+				r.value = dp.DoubleValue()
+			case pmetric.NumberDataPointValueTypeEmpty:
+			}
+			fn(r)
+		}
+	}
+
+	switch metric.Type() {
+	case pmetric.MetricTypeGauge:
+		numberRows(metric.Gauge().DataPoints())
+	case pmetric.MetricTypeSum:
+		numberRows(metric.Sum().DataPoints())
+	case pmetric.MetricTypeHistogram:
+		dps := metric.Histogram().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			dp := dps.At(i)
+			r := newRow(dp.Attributes(), dp.StartTimestamp(), dp.Timestamp())
+			r.count = int64(dp.Count())
+			if dp.HasSum() {
+				r.sum = dp.Sum()
+			}
+			fn(r)
+		}
+	case pmetric.MetricTypeExponentialHistogram:
+		dps := metric.ExponentialHistogram().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			dp := dps.At(i)
+			r := newRow(dp.Attributes(), dp.StartTimestamp(), dp.Timestamp())
+			r.count = int64(dp.Count())
+			if dp.HasSum() {
+				r.sum = dp.Sum()
+			}
+			fn(r)
+		}
+	case pmetric.MetricTypeSummary:
+		dps := metric.Summary().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			dp := dps.At(i)
+			r := newRow(dp.Attributes(), dp.StartTimestamp(), dp.Timestamp())
+			r.count = int64(dp.Count())
+			r.sum = dp.Sum()
+			fn(r)
+		}
+	case pmetric.MetricTypeEmpty:
+	}
+}
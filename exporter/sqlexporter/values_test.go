@@ -0,0 +1,98 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package sqlexporter
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+)
+
+func TestParseValues(t *testing.T) {
+	res := pcommon.NewResource()
+	res.Attributes().PutStr("service.name", "checkout")
+	scp := pcommon.NewInstrumentationScope()
+	scp.SetName("github.com/example/checkout")
+	scp.SetVersion("1.2.0")
+	record := plog.NewLogRecord()
+	record.SetTimestamp(pcommon.NewTimestampFromTime(time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)))
+	record.SetSeverityNumber(plog.SeverityNumberWarn)
+	record.Body().SetStr("payment declined")
+	record.Attributes().PutInt("http.status_code", 402)
+
+	values, err := parseValues([]string{
+		"timestamp", "observed_timestamp", "trace_id", "severity_number", "body",
+		"attributes", "resource_attributes", "scope_name", "scope_version",
+		"attributes.http.status_code", "resource.attributes.service.name", "attributes.missing",
+	}, logValues)
+	require.NoError(t, err)
+
+	r := logRow{res: res, scp: scp, record: record}
+	args := make([]any, len(values))
+	for i, value := range values {
+		args[i] = value(r)
+	}
+	assert.Equal(t, []any{
+		time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC),
+		nil,
+		nil,
+		int64(plog.SeverityNumberWarn),
+		"payment declined",
+		`{"http.status_code":402}`,
+		`{"service.name":"checkout"}`,
+		"github.com/example/checkout",
+		"1.2.0",
+		"402",
+		"checkout",
+		nil,
+	}, args)
+}
+
+func TestInsertStatement(t *testing.T) {
+	columns := []string{"name", "kind", "duration"}
+	assert.Equal(t, "INSERT INTO spans (name, kind, duration) VALUES ($1, $2, $3)", insertStatement(driverPostgres, "spans", columns))
+	assert.Equal(t, "INSERT INTO spans (name, kind, duration) VALUES (?, ?, ?)", insertStatement(driverMySQL, "spans", columns))
+	assert.Equal(t, "INSERT INTO spans (name, kind, duration) VALUES (?, ?, ?)", insertStatement(driverSQLite, "spans", columns))
+}
+
+func TestDataPointRows(t *testing.T) {
+	metrics := pmetric.NewMetricSlice()
+
+	gauge := metrics.AppendEmpty()
+	gauge.SetName("queue.size")
+	gauge.SetEmptyGauge().DataPoints().AppendEmpty().SetIntValue(5)
+	gauge.Gauge().DataPoints().AppendEmpty().SetDoubleValue(2.5)
+
+	histogram := metrics.AppendEmpty()
+	histogram.SetName("request.duration")
+	dp := histogram.SetEmptyHistogram().DataPoints().AppendEmpty()
+	dp.SetCount(3)
+	dp.SetSum(1.5)
+	histogram.Histogram().DataPoints().AppendEmpty().SetCount(1)
+
+	summary := metrics.AppendEmpty()
+	summary.SetName("response.size")
+	sdp := summary.SetEmptySummary().DataPoints().AppendEmpty()
+	sdp.SetCount(2)
+	sdp.SetSum(300)
+
+	var got [][]any
+	for i := 0; i < metrics.Len(); i++ {
+		dataPointRows(pcommon.NewResource(), pcommon.NewInstrumentationScope(), metrics.At(i), func(r dataPointRow) {
+			got = append(got, []any{r.metric.Name(), r.value, r.count, r.sum})
+		})
+	}
+	assert.Equal(t, [][]any{
+		{"queue.size", int64(5), nil, nil},
+		{"queue.size", 2.5, nil, nil},
+		{"request.duration", nil, int64(3), 1.5},
+		{"request.duration", nil, int64(1), nil},
+		{"response.size", nil, int64(2), float64(300)},
+	}, got)
+}
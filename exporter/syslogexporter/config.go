@@ -19,6 +19,8 @@ var (
 	errUnsupportedNetwork  = errors.New("unsupported network: network is required, only tcp/udp/unix supported")
 	errUnsupportedProtocol = errors.New("unsupported protocol: Only rfc5424 and rfc3164 supported")
 	errOctetCounting       = errors.New("octet counting is only supported for rfc5424 protocol")
+	errStructuredData      = errors.New("structured data mapping is only supported for rfc5424 protocol")
+	errInvalidSDID         = errors.New("invalid sd_id: must be 1 to 32 printable ASCII characters, without '=', ' ', ']' or '\"'")
 )
 
 // Config defines configuration for Syslog exporter.
@@ -37,6 +39,9 @@ type Config struct {
 	// Whether or not to enable RFC 6587 Octet Counting.
 	EnableOctetCounting bool `mapstructure:"enable_octet_counting"`
 
+	// Maps log record attributes into a structured data element, rfc5424 only.
+	StructuredData *StructuredDataConfig `mapstructure:"structured_data"`
+
 	// TLS struct exposes TLS client configuration.
 	TLS configtls.ClientConfig `mapstructure:"tls"`
 
@@ -45,6 +50,15 @@ type Config struct {
 	TimeoutSettings           exporterhelper.TimeoutConfig `mapstructure:",squash"` // squash ensures fields are correctly decoded in embedded struct
 }
 
+// StructuredDataConfig maps log record attributes into a RFC 5424 SD-ELEMENT.
+type StructuredDataConfig struct {
+	// SD-ID of the element, e.g. "otel@32473".
+	SDID string `mapstructure:"sd_id"`
+	// Attributes mapped to the SD-PARAMs of the element. When empty, all attributes are mapped
+	// except the ones used for the syslog header and message.
+	Attributes []string `mapstructure:"attributes"`
+}
+
 // Validate the configuration for errors. This is required by component.Config.
 func (cfg *Config) Validate() error {
 	invalidFields := []error{}
@@ -75,6 +89,15 @@ func (cfg *Config) Validate() error {
 		invalidFields = append(invalidFields, errOctetCounting)
 	}
 
+	if cfg.StructuredData != nil {
+		if cfg.Protocol != protocolRFC5424Str {
+			invalidFields = append(invalidFields, errStructuredData)
+		}
+		if !isValidSDName(cfg.StructuredData.SDID) {
+			invalidFields = append(invalidFields, errInvalidSDID)
+		}
+	}
+
 	if len(invalidFields) > 0 {
 		return errors.Join(invalidFields...)
 	}
@@ -56,6 +56,38 @@ func TestValidate(t *testing.T) {
 			},
 			err: "unsupported protocol: Only rfc5424 and rfc3164 supported",
 		},
+		{
+			name: "structured data with rfc3164",
+			cfg: &Config{
+				Port:           514,
+				Endpoint:       "host.domain.com",
+				Network:        "udp",
+				Protocol:       "rfc3164",
+				StructuredData: &StructuredDataConfig{SDID: "otel@32473"},
+			},
+			err: "structured data mapping is only supported for rfc5424 protocol",
+		},
+		{
+			name: "invalid structured data SD-ID",
+			cfg: &Config{
+				Port:           514,
+				Endpoint:       "host.domain.com",
+				Network:        "udp",
+				Protocol:       "rfc5424",
+				StructuredData: &StructuredDataConfig{SDID: "otel 32473"},
+			},
+			err: "invalid sd_id: must be 1 to 32 printable ASCII characters, without '=', ' ', ']' or '\"'",
+		},
+		{
+			name: "valid structured data",
+			cfg: &Config{
+				Port:           514,
+				Endpoint:       "host.domain.com",
+				Network:        "udp",
+				Protocol:       "rfc5424",
+				StructuredData: &StructuredDataConfig{SDID: "otel@32473", Attributes: []string{"user.id"}},
+			},
+		},
 		{
 			name: "invalid Unix Socket",
 			cfg: &Config{
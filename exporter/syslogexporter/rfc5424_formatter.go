@@ -5,6 +5,7 @@ package syslogexporter // import "github.com/open-telemetry/opentelemetry-collec
 
 import (
 	"fmt"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
@@ -13,13 +14,25 @@ import (
 	"go.opentelemetry.io/collector/pdata/plog"
 )
 
+// maxSDNameLength is the maximum length of a SD-ID or PARAM-NAME.
+const maxSDNameLength = 32
+
+// headerAttributes are the attributes used for the syslog header and message, these aren't
+// mapped to structured data when no attributes are configured.
+var headerAttributes = []string{priority, version, hostname, app, pid, msgID, structuredData, message}
+
+// sdParamValueEscaper escapes the characters RFC 5424 requires to be escaped in a PARAM-VALUE.
+var sdParamValueEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, `]`, `\]`)
+
 type rfc5424Formatter struct {
-	octetCounting bool
+	octetCounting  bool
+	structuredData *StructuredDataConfig
 }
 
-func newRFC5424Formatter(octetCounting bool) *rfc5424Formatter {
+func newRFC5424Formatter(octetCounting bool, structuredData *StructuredDataConfig) *rfc5424Formatter {
 	return &rfc5424Formatter{
-		octetCounting: octetCounting,
+		octetCounting:  octetCounting,
+		structuredData: structuredData,
 	}
 }
 
@@ -70,32 +83,120 @@ func (*rfc5424Formatter) formatMessageID(logRecord plog.LogRecord) string {
 	return getAttributeValueOrDefault(logRecord, msgID, emptyValue)
 }
 
-func (*rfc5424Formatter) formatStructuredData(logRecord plog.LogRecord) string {
-	structuredDataAttributeValue, found := logRecord.Attributes().Get(structuredData)
-	if !found {
-		return emptyValue
+func (f *rfc5424Formatter) formatStructuredData(logRecord plog.LogRecord) string {
+	var sdBuilder strings.Builder
+	if structuredDataAttributeValue, found := logRecord.Attributes().Get(structuredData); found && structuredDataAttributeValue.Type() == pcommon.ValueTypeMap {
+		sdMap := structuredDataAttributeValue.Map()
+		for _, key := range sortedKeys(sdMap) {
+			val, _ := sdMap.Get(key)
+			if val.Type() != pcommon.ValueTypeMap {
+				continue
+			}
+			params := val.Map()
+			var sdParams []string
+			for _, k := range sortedKeys(params) {
+				v, _ := params.Get(k)
+				if v.Type() != pcommon.ValueTypeStr {
+					continue
+				}
+				sdParams = append(sdParams, k)
+			}
+			writeSDElement(&sdBuilder, key, sdParams, params)
+		}
+	}
+	if f.structuredData != nil {
+		f.writeMappedSDElement(&sdBuilder, logRecord)
 	}
-	if structuredDataAttributeValue.Type() != pcommon.ValueTypeMap {
+	if sdBuilder.Len() == 0 {
 		return emptyValue
 	}
+	return sdBuilder.String()
+}
 
-	var sdBuilder strings.Builder
-	for key, val := range structuredDataAttributeValue.Map().AsRaw() {
-		sdElements := []string{key}
-		vval, ok := val.(map[string]any)
-		if !ok {
-			continue
+// writeMappedSDElement writes the log record attributes mapped by the structured data configuration
+// as a SD-ELEMENT. Nothing is written when none of the attributes are present.
+func (f *rfc5424Formatter) writeMappedSDElement(sdBuilder *strings.Builder, logRecord plog.LogRecord) {
+	attrs := logRecord.Attributes()
+	var sdParams []string
+	if len(f.structuredData.Attributes) > 0 {
+		for _, name := range f.structuredData.Attributes {
+			if _, ok := attrs.Get(name); ok {
+				sdParams = append(sdParams, name)
+			}
 		}
-		for k, v := range vval {
-			vv, ok := v.(string)
-			if !ok {
-				continue
+	} else {
+		for _, name := range sortedKeys(attrs) {
+			if !slices.Contains(headerAttributes, name) {
+				sdParams = append(sdParams, name)
 			}
-			sdElements = append(sdElements, fmt.Sprintf("%s=%q", k, vv))
 		}
-		sdBuilder.WriteString(fmt.Sprint(sdElements))
 	}
-	return sdBuilder.String()
+	if len(sdParams) == 0 {
+		return
+	}
+	writeSDElement(sdBuilder, f.structuredData.SDID, sdParams, attrs)
+}
+
+// writeSDElement writes a SD-ELEMENT with the given params, taking their values from the map.
+func writeSDElement(sdBuilder *strings.Builder, sdID string, params []string, values pcommon.Map) {
+	sdBuilder.WriteString("[")
+	sdBuilder.WriteString(sdID)
+	for _, name := range params {
+		value, _ := values.Get(name)
+		sdBuilder.WriteString(" ")
+		sdBuilder.WriteString(sanitizeSDName(name))
+		sdBuilder.WriteString(`="`)
+		sdBuilder.WriteString(sdParamValueEscaper.Replace(value.AsString()))
+		sdBuilder.WriteString(`"`)
+	}
+	sdBuilder.WriteString("]")
+}
+
+func sortedKeys(m pcommon.Map) []string {
+	keys := make([]string, 0, m.Len())
+	for k := range m.All() {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	return keys
+}
+
+// isValidSDName reports if name is a valid RFC 5424 SD-NAME.
+func isValidSDName(name string) bool {
+	if name == "" || len(name) > maxSDNameLength {
+		return false
+	}
+	for i := 0; i < len(name); i++ {
+		if !isSDNameChar(name[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// sanitizeSDName replaces the characters not allowed in a SD-NAME with '_' and truncates it
+// to the maximum length.
+func sanitizeSDName(name string) string {
+	if isValidSDName(name) {
+		return name
+	}
+	sanitized := []byte(name)
+	for i := range sanitized {
+		if !isSDNameChar(sanitized[i]) {
+			sanitized[i] = '_'
+		}
+	}
+	if len(sanitized) > maxSDNameLength {
+		sanitized = sanitized[:maxSDNameLength]
+	}
+	if len(sanitized) == 0 {
+		return "_"
+	}
+	return string(sanitized)
+}
+
+func isSDNameChar(c byte) bool {
+	return c >= 33 && c <= 126 && c != '=' && c != ']' && c != '"'
 }
 
 func (*rfc5424Formatter) formatMessage(logRecord plog.LogRecord) string {
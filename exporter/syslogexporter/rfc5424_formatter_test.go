@@ -29,9 +29,9 @@ func TestRFC5424Formatter(t *testing.T) {
 	require.NoError(t, err)
 	logRecord.SetTimestamp(pcommon.NewTimestampFromTime(timestamp))
 
-	actual := newRFC5424Formatter(false).format(logRecord)
+	actual := newRFC5424Formatter(false, nil).format(logRecord)
 	assert.Equal(t, expected, actual)
-	octetCounting := newRFC5424Formatter(true).format(logRecord)
+	octetCounting := newRFC5424Formatter(true, nil).format(logRecord)
 	assert.Equal(t, fmt.Sprintf("%d %s", len(expected), expected), octetCounting)
 
 	expected = "<165>1 2003-10-11T22:14:15.003Z mymachine.example.com evntslog 111 ID47 - BOMAn application event log entry...\n"
@@ -47,9 +47,9 @@ func TestRFC5424Formatter(t *testing.T) {
 	require.NoError(t, err)
 	logRecord.SetTimestamp(pcommon.NewTimestampFromTime(timestamp))
 
-	actual = newRFC5424Formatter(false).format(logRecord)
+	actual = newRFC5424Formatter(false, nil).format(logRecord)
 	assert.Equal(t, expected, actual)
-	octetCounting = newRFC5424Formatter(true).format(logRecord)
+	octetCounting = newRFC5424Formatter(true, nil).format(logRecord)
 	assert.Equal(t, fmt.Sprintf("%d %s", len(expected), expected), octetCounting)
 
 	// Test structured data
@@ -76,7 +76,7 @@ func TestRFC5424Formatter(t *testing.T) {
 	require.NoError(t, err)
 	logRecord.SetTimestamp(pcommon.NewTimestampFromTime(timestamp))
 
-	actual = newRFC5424Formatter(false).format(logRecord)
+	actual = newRFC5424Formatter(false, nil).format(logRecord)
 	assert.NoError(t, err)
 	matched, err := regexp.MatchString(expectedRegex, actual)
 	assert.NoError(t, err)
@@ -116,7 +116,7 @@ func TestRFC5424Formatter(t *testing.T) {
 	require.NoError(t, err)
 	logRecord.SetTimestamp(pcommon.NewTimestampFromTime(timestamp))
 
-	actual = newRFC5424Formatter(false).format(logRecord)
+	actual = newRFC5424Formatter(false, nil).format(logRecord)
 	assert.NoError(t, err)
 
 	// check that the output message is of the right form
@@ -155,6 +155,82 @@ func TestRFC5424Formatter(t *testing.T) {
 	require.NoError(t, err)
 	logRecord.SetTimestamp(pcommon.NewTimestampFromTime(timestamp))
 
-	actual = newRFC5424Formatter(false).format(logRecord)
+	actual = newRFC5424Formatter(false, nil).format(logRecord)
 	assert.Equal(t, expected, actual)
 }
+
+func TestRFC5424FormatterStructuredDataMapping(t *testing.T) {
+	timestamp, err := time.Parse(time.RFC3339Nano, "2003-08-24T05:14:15.000003Z")
+	require.NoError(t, err)
+	newLogRecord := func() plog.LogRecord {
+		logRecord := plog.NewLogRecord()
+		logRecord.SetTimestamp(pcommon.NewTimestampFromTime(timestamp))
+		logRecord.Attributes().PutStr("appname", "myproc")
+		logRecord.Attributes().PutStr("hostname", "192.0.2.1")
+		logRecord.Attributes().PutStr("message", "user logged in")
+		logRecord.Attributes().PutInt("priority", 165)
+		logRecord.Attributes().PutStr("user.id", "tester")
+		logRecord.Attributes().PutInt("http.status_code", 200)
+		logRecord.Attributes().PutStr("note", `say "hi" [or] \bye]`)
+		return logRecord
+	}
+
+	tests := []struct {
+		name           string
+		structuredData *StructuredDataConfig
+		logRecord      func() plog.LogRecord
+		expected       string
+	}{
+		{
+			name:           "all attributes",
+			structuredData: &StructuredDataConfig{SDID: "otel@32473"},
+			logRecord:      newLogRecord,
+			expected: "<165>1 2003-08-24T05:14:15.000003Z 192.0.2.1 myproc - - " +
+				`[otel@32473 http.status_code="200" note="say \"hi\" [or\] \\bye\]" user.id="tester"] user logged in` + "\n",
+		},
+		{
+			name:           "configured attributes",
+			structuredData: &StructuredDataConfig{SDID: "otel@32473", Attributes: []string{"user.id", "missing", "http.status_code"}},
+			logRecord:      newLogRecord,
+			expected: "<165>1 2003-08-24T05:14:15.000003Z 192.0.2.1 myproc - - " +
+				`[otel@32473 user.id="tester" http.status_code="200"] user logged in` + "\n",
+		},
+		{
+			name:           "no mapped attributes present",
+			structuredData: &StructuredDataConfig{SDID: "otel@32473", Attributes: []string{"missing"}},
+			logRecord:      newLogRecord,
+			expected:       "<165>1 2003-08-24T05:14:15.000003Z 192.0.2.1 myproc - - - user logged in\n",
+		},
+		{
+			name:           "invalid param names are sanitized",
+			structuredData: &StructuredDataConfig{SDID: "otel@32473"},
+			logRecord: func() plog.LogRecord {
+				logRecord := plog.NewLogRecord()
+				logRecord.SetTimestamp(pcommon.NewTimestampFromTime(timestamp))
+				logRecord.Attributes().PutStr("user name", "tester")
+				return logRecord
+			},
+			expected: "<165>1 2003-08-24T05:14:15.000003Z - - - - " +
+				`[otel@32473 user_name="tester"]` + "\n",
+		},
+		{
+			name:           "appended after structured data attribute",
+			structuredData: &StructuredDataConfig{SDID: "otel@32473", Attributes: []string{"user.id"}},
+			logRecord: func() plog.LogRecord {
+				logRecord := newLogRecord()
+				sd := logRecord.Attributes().PutEmptyMap("structured_data")
+				sd.PutEmptyMap("B@321").PutStr("B", "321")
+				sd.PutEmptyMap("A@123").PutStr("A", "123")
+				return logRecord
+			},
+			expected: "<165>1 2003-08-24T05:14:15.000003Z 192.0.2.1 myproc - - " +
+				`[A@123 A="123"][B@321 B="321"][otel@32473 user.id="tester"] user logged in` + "\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual := newRFC5424Formatter(false, tt.structuredData).format(tt.logRecord())
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
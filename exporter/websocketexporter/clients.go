@@ -0,0 +1,93 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package websocketexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/websocketexporter"
+
+import "sync"
+
+// clientSet is the set of the connected clients, each with a buffered channel of
+// the messages to send to it.
+type clientSet struct {
+	mu      sync.Mutex
+	next    int
+	clients map[int]chan []byte
+	closed  bool
+
+	// handlers tracks the connection handlers, so that shutdown waits for them.
+	handlers sync.WaitGroup
+}
+
+func newClientSet() *clientSet {
+	return &clientSet{
+		clients: map[int]chan []byte{},
+	}
+}
+
+// add adds the channel of a client and returns the key used to remove it. It
+// returns false once the set is shut down. The handler of the client must call
+// done when it returns.
+func (c *clientSet) add(ch chan []byte) (int, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.closed {
+		return 0, false
+	}
+	key := c.next
+	c.next++
+	c.clients[key] = ch
+	c.handlers.Add(1)
+	return key, true
+}
+
+// done signals a handler added with add returned.
+func (c *clientSet) done() {
+	c.handlers.Done()
+}
+
+// remove closes and removes the channel of a client. It is a no-op if the
+// channel was already removed.
+func (c *clientSet) remove(key int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if ch, ok := c.clients[key]; ok {
+		close(ch)
+		delete(c.clients, key)
+	}
+}
+
+// len returns the number of connected clients.
+func (c *clientSet) len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.clients)
+}
+
+// broadcast queues the message for all the clients, without blocking. It returns
+// the number of clients whose buffer is full and which miss the message.
+func (c *clientSet) broadcast(msg []byte) int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	dropped := 0
+	for _, ch := range c.clients {
+		select {
+		case ch <- msg:
+		default:
+			dropped++
+		}
+	}
+	return dropped
+}
+
+// shutdown closes the channels of all the clients and waits for their handlers
+// to return.
+func (c *clientSet) shutdown() {
+	c.mu.Lock()
+	c.closed = true
+	for key, ch := range c.clients {
+		close(ch)
+		delete(c.clients, key)
+	}
+	c.mu.Unlock()
+
+	c.handlers.Wait()
+}
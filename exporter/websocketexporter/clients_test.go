@@ -0,0 +1,43 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package websocketexporter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClientSetBroadcast(t *testing.T) {
+	cs := newClientSet()
+	fast := make(chan []byte, 2)
+	slow := make(chan []byte, 1)
+	_, ok := cs.add(fast)
+	require.True(t, ok)
+	slowKey, ok := cs.add(slow)
+	require.True(t, ok)
+	assert.Equal(t, 2, cs.len())
+
+	assert.Equal(t, 0, cs.broadcast([]byte("first")))
+	// The buffer of the slow client is full, so it misses the second message.
+	assert.Equal(t, 1, cs.broadcast([]byte("second")))
+	assert.Equal(t, []byte("first"), <-fast)
+	assert.Equal(t, []byte("second"), <-fast)
+	assert.Equal(t, []byte("first"), <-slow)
+
+	cs.remove(slowKey)
+	cs.remove(slowKey)
+	_, open := <-slow
+	assert.False(t, open)
+	assert.Equal(t, 1, cs.len())
+
+	cs.done()
+	cs.done()
+	cs.shutdown()
+	_, open = <-fast
+	assert.False(t, open)
+	_, ok = cs.add(make(chan []byte))
+	assert.False(t, ok)
+}
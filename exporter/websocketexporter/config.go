@@ -0,0 +1,94 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package websocketexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/websocketexporter"
+
+import (
+	"errors"
+	"fmt"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config/confighttp"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/filter/filterottl"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+// Config defines configuration for the WebSocket exporter.
+type Config struct {
+	confighttp.ServerConfig `mapstructure:",squash"` // squash ensures fields are correctly decoded in embedded struct
+
+	// SamplingPercentage is the percentage of the spans, data points and log records
+	// streamed to the clients. Defaults to 100.
+	SamplingPercentage float64 `mapstructure:"sampling_percentage"`
+
+	// BufferSize is the number of messages buffered for each client. The messages are
+	// dropped for the clients which don't read them as fast as they are exported.
+	BufferSize int `mapstructure:"buffer_size"`
+
+	// ErrorMode determines how the exporter reacts to errors returned by the conditions.
+	// `ignore` ignores the errors, and `propagate` fails the export. Defaults to `ignore`.
+	ErrorMode ottl.ErrorMode `mapstructure:"error_mode"`
+
+	// Traces selects the spans streamed to the clients.
+	Traces TracesConfig `mapstructure:"traces"`
+	// Metrics selects the data points streamed to the clients.
+	Metrics MetricsConfig `mapstructure:"metrics"`
+	// Logs selects the log records streamed to the clients.
+	Logs LogsConfig `mapstructure:"logs"`
+
+	// prevent unkeyed literal initialization
+	_ struct{}
+}
+
+// TracesConfig defines the conditions of the spans streamed to the clients.
+type TracesConfig struct {
+	// SpanConditions are OTTL conditions for the span context. When set, only the spans
+	// matching any of the conditions are streamed.
+	SpanConditions []string `mapstructure:"span"`
+}
+
+// MetricsConfig defines the conditions of the data points streamed to the clients.
+type MetricsConfig struct {
+	// DataPointConditions are OTTL conditions for the datapoint context. When set, only
+	// the data points matching any of the conditions are streamed.
+	DataPointConditions []string `mapstructure:"datapoint"`
+}
+
+// LogsConfig defines the conditions of the log records streamed to the clients.
+type LogsConfig struct {
+	// LogConditions are OTTL conditions for the log context. When set, only the log
+	// records matching any of the conditions are streamed.
+	LogConditions []string `mapstructure:"log_record"`
+}
+
+var _ component.Config = (*Config)(nil)
+
+// Validate checks the exporter configuration is valid
+func (cfg *Config) Validate() error {
+	if cfg.SamplingPercentage <= 0 || cfg.SamplingPercentage > 100 {
+		return fmt.Errorf("sampling_percentage must be greater than 0 and at most 100, got %v", cfg.SamplingPercentage)
+	}
+	if cfg.BufferSize <= 0 {
+		return errors.New("buffer_size must be positive")
+	}
+
+	set := component.TelemetrySettings{Logger: zap.NewNop()}
+	if len(cfg.Traces.SpanConditions) > 0 {
+		if _, err := filterottl.NewBoolExprForSpan(cfg.Traces.SpanConditions, filterottl.StandardSpanFuncs(), ottl.PropagateError, set); err != nil {
+			return fmt.Errorf("traces: %w", err)
+		}
+	}
+	if len(cfg.Metrics.DataPointConditions) > 0 {
+		if _, err := filterottl.NewBoolExprForDataPoint(cfg.Metrics.DataPointConditions, filterottl.StandardDataPointFuncs(), ottl.PropagateError, set); err != nil {
+			return fmt.Errorf("metrics: %w", err)
+		}
+	}
+	if len(cfg.Logs.LogConditions) > 0 {
+		if _, err := filterottl.NewBoolExprForLog(cfg.Logs.LogConditions, filterottl.StandardLogFuncs(), ottl.PropagateError, set); err != nil {
+			return fmt.Errorf("logs: %w", err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,85 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package websocketexporter
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config/confighttp"
+	"go.opentelemetry.io/collector/confmap/confmaptest"
+	"go.opentelemetry.io/collector/confmap/xconfmap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/websocketexporter/internal/metadata"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func TestLoadConfig(t *testing.T) {
+	cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
+	require.NoError(t, err)
+
+	tests := []struct {
+		id          component.ID
+		expected    component.Config
+		expectedErr string
+	}{
+		{
+			id:       component.NewID(metadata.Type),
+			expected: createDefaultConfig(),
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "custom"),
+			expected: &Config{
+				ServerConfig: confighttp.ServerConfig{
+					Endpoint: "0.0.0.0:12002",
+				},
+				SamplingPercentage: 10,
+				BufferSize:         20,
+				ErrorMode:          ottl.PropagateError,
+				Traces: TracesConfig{
+					SpanConditions: []string{`attributes["http.route"] == "/cart"`},
+				},
+				Metrics: MetricsConfig{
+					DataPointConditions: []string{`metric.name == "http.server.request.duration"`},
+				},
+				Logs: LogsConfig{
+					LogConditions: []string{"severity_number >= SEVERITY_NUMBER_WARN", `IsMatch(body, "timeout")`},
+				},
+			},
+		},
+		{
+			id:          component.NewIDWithName(metadata.Type, "invalid_sampling_percentage"),
+			expectedErr: "sampling_percentage must be greater than 0 and at most 100, got 150",
+		},
+		{
+			id:          component.NewIDWithName(metadata.Type, "invalid_buffer_size"),
+			expectedErr: "buffer_size must be positive",
+		},
+		{
+			id:          component.NewIDWithName(metadata.Type, "invalid_condition"),
+			expectedErr: "logs: ",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.id.String(), func(t *testing.T) {
+			factory := NewFactory()
+			cfg := factory.CreateDefaultConfig()
+
+			sub, err := cm.Sub(tt.id.String())
+			require.NoError(t, err)
+			require.NoError(t, sub.Unmarshal(cfg))
+
+			if tt.expectedErr != "" {
+				assert.ErrorContains(t, xconfmap.Validate(cfg), tt.expectedErr)
+				return
+			}
+			require.NoError(t, xconfmap.Validate(cfg))
+			assert.Equal(t, tt.expected, cfg)
+		})
+	}
+}
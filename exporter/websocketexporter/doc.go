@@ -0,0 +1,7 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:generate mdatagen metadata.yaml
+
+// Package websocketexporter streams traces, metrics and logs as OTLP JSON to the clients connected to a WebSocket endpoint.
+package websocketexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/websocketexporter"
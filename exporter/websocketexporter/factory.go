@@ -0,0 +1,110 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package websocketexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/websocketexporter"
+
+import (
+	"context"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config/confighttp"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/exporter"
+	"go.opentelemetry.io/collector/exporter/exporterhelper"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/websocketexporter/internal/metadata"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/common/testutil"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/sharedcomponent"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+const (
+	defaultPort       = 12002
+	defaultBufferSize = 100
+)
+
+// exporters shares the WebSocket server between the traces, metrics and logs
+// exporters of the same configuration.
+var exporters = sharedcomponent.NewSharedComponents()
+
+// NewFactory creates a factory for the WebSocket exporter.
+func NewFactory() exporter.Factory {
+	return exporter.NewFactory(
+		metadata.Type,
+		createDefaultConfig,
+		exporter.WithTraces(createTracesExporter, metadata.TracesStability),
+		exporter.WithMetrics(createMetricsExporter, metadata.MetricsStability),
+		exporter.WithLogs(createLogsExporter, metadata.LogsStability),
+	)
+}
+
+func createDefaultConfig() component.Config {
+	return &Config{
+		ServerConfig: confighttp.ServerConfig{
+			Endpoint: testutil.EndpointForPort(defaultPort),
+		},
+		SamplingPercentage: 100,
+		BufferSize:         defaultBufferSize,
+		ErrorMode:          ottl.IgnoreError,
+	}
+}
+
+func getOrAddExporter(cfg *Config, set exporter.Settings) *sharedcomponent.SharedComponent {
+	return exporters.GetOrAdd(cfg, func() component.Component {
+		return newWebSocketExporter(cfg, set.TelemetrySettings)
+	})
+}
+
+func createTracesExporter(
+	ctx context.Context,
+	set exporter.Settings,
+	cfg component.Config,
+) (exporter.Traces, error) {
+	e := getOrAddExporter(cfg.(*Config), set)
+
+	return exporterhelper.NewTraces(
+		ctx,
+		set,
+		cfg,
+		e.Unwrap().(*wsExporter).pushTraces,
+		exporterhelper.WithCapabilities(consumer.Capabilities{MutatesData: false}),
+		exporterhelper.WithStart(e.Start),
+		exporterhelper.WithShutdown(e.Shutdown),
+	)
+}
+
+func createMetricsExporter(
+	ctx context.Context,
+	set exporter.Settings,
+	cfg component.Config,
+) (exporter.Metrics, error) {
+	e := getOrAddExporter(cfg.(*Config), set)
+
+	return exporterhelper.NewMetrics(
+		ctx,
+		set,
+		cfg,
+		e.Unwrap().(*wsExporter).pushMetrics,
+		exporterhelper.WithCapabilities(consumer.Capabilities{MutatesData: false}),
+		exporterhelper.WithStart(e.Start),
+		exporterhelper.WithShutdown(e.Shutdown),
+	)
+}
+
+func createLogsExporter(
+	ctx context.Context,
+	set exporter.Settings,
+	cfg component.Config,
+) (exporter.Logs, error) {
+	e := getOrAddExporter(cfg.(*Config), set)
+
+	return exporterhelper.NewLogs(
+		ctx,
+		set,
+		cfg,
+		e.Unwrap().(*wsExporter).pushLogs,
+		exporterhelper.WithCapabilities(consumer.Capabilities{MutatesData: false}),
+		exporterhelper.WithStart(e.Start),
+		exporterhelper.WithShutdown(e.Shutdown),
+	)
+}
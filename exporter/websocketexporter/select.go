@@ -0,0 +1,64 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package websocketexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/websocketexporter"
+
+import (
+	"context"
+	"errors"
+	"math/rand/v2"
+
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/filter/filterottl"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+// sampled returns whether an item is kept according to the sampling percentage.
+func (e *wsExporter) sampled() bool {
+	return e.config.SamplingPercentage >= 100 || rand.Float64()*100 < e.config.SamplingPercentage
+}
+
+// unselected returns a function telling whether an item isn't sampled or doesn't
+// match the conditions, if any. The errors evaluating the conditions are joined to
+// errs, and the items they occur for are kept.
+func unselected[K any](ctx context.Context, e *wsExporter, conditions *ottl.ConditionSequence[K], errs *error) func(K) bool {
+	return func(tCtx K) bool {
+		if !e.sampled() {
+			return true
+		}
+		if conditions == nil {
+			return false
+		}
+		match, err := conditions.Eval(ctx, tCtx)
+		if err != nil {
+			*errs = errors.Join(*errs, err)
+			return false
+		}
+		return !match
+	}
+}
+
+// selectSpans removes the spans which aren't sampled or don't match the conditions.
+func (e *wsExporter) selectSpans(ctx context.Context, td ptrace.Traces) error {
+	var errs error
+	filterottl.RemoveSpans(td, unselected(ctx, e, e.spanConditions, &errs))
+	return errs
+}
+
+// selectDataPoints removes the data points which aren't sampled or don't match the
+// conditions, and the metrics left without data points.
+func (e *wsExporter) selectDataPoints(ctx context.Context, md pmetric.Metrics) error {
+	var errs error
+	filterottl.RemoveDataPoints(md, unselected(ctx, e, e.dataPointConditions, &errs))
+	return errs
+}
+
+// selectLogs removes the log records which aren't sampled or don't match the conditions.
+func (e *wsExporter) selectLogs(ctx context.Context, ld plog.Logs) error {
+	var errs error
+	filterottl.RemoveLogRecords(ld, unselected(ctx, e, e.logConditions, &errs))
+	return errs
+}
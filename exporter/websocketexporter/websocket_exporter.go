@@ -0,0 +1,231 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package websocketexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/websocketexporter"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componentstatus"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.uber.org/zap"
+	"golang.org/x/net/websocket"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/filter/filterottl"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottldatapoint"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottllog"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlspan"
+)
+
+// writeTimeout bounds the time spent writing a message to a client, so that a
+// stalled client can't block its handler forever.
+const writeTimeout = 10 * time.Second
+
+var (
+	logMarshaler    = &plog.JSONMarshaler{}
+	metricMarshaler = &pmetric.JSONMarshaler{}
+	traceMarshaler  = &ptrace.JSONMarshaler{}
+)
+
+type wsExporter struct {
+	config     *Config
+	settings   component.TelemetrySettings
+	server     *http.Server
+	shutdownWG sync.WaitGroup
+	clients    *clientSet
+
+	spanConditions      *ottl.ConditionSequence[ottlspan.TransformContext]
+	dataPointConditions *ottl.ConditionSequence[ottldatapoint.TransformContext]
+	logConditions       *ottl.ConditionSequence[ottllog.TransformContext]
+}
+
+func newWebSocketExporter(config *Config, set component.TelemetrySettings) *wsExporter {
+	return &wsExporter{
+		config:   config,
+		settings: set,
+		clients:  newClientSet(),
+	}
+}
+
+func (e *wsExporter) Start(ctx context.Context, host component.Host) error {
+	if err := e.parseConditions(); err != nil {
+		return err
+	}
+
+	ln, err := e.config.ToListener(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to bind to address %s: %w", e.config.Endpoint, err)
+	}
+	e.server, err = e.config.ToServer(ctx, host, e.settings, websocket.Server{Handler: e.handleConn})
+	if err != nil {
+		return errors.Join(err, ln.Close())
+	}
+	e.shutdownWG.Add(1)
+	go func() {
+		defer e.shutdownWG.Done()
+		if errHTTP := e.server.Serve(ln); !errors.Is(errHTTP, http.ErrServerClosed) && errHTTP != nil {
+			componentstatus.ReportStatus(host, componentstatus.NewFatalErrorEvent(errHTTP))
+		}
+	}()
+	return nil
+}
+
+func (e *wsExporter) parseConditions() error {
+	var err error
+	if len(e.config.Traces.SpanConditions) > 0 {
+		e.spanConditions, err = filterottl.NewBoolExprForSpan(e.config.Traces.SpanConditions, filterottl.StandardSpanFuncs(), e.config.ErrorMode, e.settings)
+		if err != nil {
+			return err
+		}
+	}
+	if len(e.config.Metrics.DataPointConditions) > 0 {
+		e.dataPointConditions, err = filterottl.NewBoolExprForDataPoint(e.config.Metrics.DataPointConditions, filterottl.StandardDataPointFuncs(), e.config.ErrorMode, e.settings)
+		if err != nil {
+			return err
+		}
+	}
+	if len(e.config.Logs.LogConditions) > 0 {
+		e.logConditions, err = filterottl.NewBoolExprForLog(e.config.Logs.LogConditions, filterottl.StandardLogFuncs(), e.config.ErrorMode, e.settings)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// handleConn streams the messages to a client until it disconnects or the
+// exporter shuts down.
+func (e *wsExporter) handleConn(conn *websocket.Conn) {
+	ch := make(chan []byte, e.config.BufferSize)
+	key, ok := e.clients.add(ch)
+	if !ok {
+		return
+	}
+	defer e.clients.done()
+	e.settings.Logger.Debug("Client connected", zap.String("remote_addr", conn.Request().RemoteAddr))
+
+	readDone := make(chan struct{})
+	go func() {
+		defer close(readDone)
+		// The clients aren't expected to send anything, reading only detects
+		// when they disconnect.
+		_, _ = io.Copy(io.Discard, conn)
+		e.clients.remove(key)
+	}()
+	defer func() {
+		_ = conn.Close()
+		<-readDone
+		e.settings.Logger.Debug("Client disconnected", zap.String("remote_addr", conn.Request().RemoteAddr))
+	}()
+
+	for msg := range ch {
+		if err := conn.SetWriteDeadline(time.Now().Add(writeTimeout)); err != nil {
+			e.settings.Logger.Debug("Error setting write deadline", zap.Error(err))
+			return
+		}
+		if _, err := conn.Write(msg); err != nil {
+			e.settings.Logger.Debug("WebSocket write error", zap.Error(err))
+			return
+		}
+	}
+}
+
+func (e *wsExporter) Shutdown(ctx context.Context) error {
+	var err error
+
+	if e.server != nil {
+		err = e.server.Shutdown(ctx)
+		e.shutdownWG.Wait()
+	}
+
+	// The connections are hijacked from the server, so they are closed by closing
+	// the channels of their handlers.
+	e.clients.shutdown()
+
+	return err
+}
+
+func (e *wsExporter) pushTraces(ctx context.Context, td ptrace.Traces) error {
+	if e.clients.len() == 0 {
+		return nil
+	}
+	if e.spanConditions != nil || e.config.SamplingPercentage < 100 {
+		selected := ptrace.NewTraces()
+		td.CopyTo(selected)
+		if err := e.selectSpans(ctx, selected); err != nil {
+			return err
+		}
+		if selected.SpanCount() == 0 {
+			return nil
+		}
+		td = selected
+	}
+	b, err := traceMarshaler.MarshalTraces(td)
+	if err != nil {
+		return err
+	}
+	e.broadcast(b)
+	return nil
+}
+
+func (e *wsExporter) pushMetrics(ctx context.Context, md pmetric.Metrics) error {
+	if e.clients.len() == 0 {
+		return nil
+	}
+	if e.dataPointConditions != nil || e.config.SamplingPercentage < 100 {
+		selected := pmetric.NewMetrics()
+		md.CopyTo(selected)
+		if err := e.selectDataPoints(ctx, selected); err != nil {
+			return err
+		}
+		if selected.DataPointCount() == 0 {
+			return nil
+		}
+		md = selected
+	}
+	b, err := metricMarshaler.MarshalMetrics(md)
+	if err != nil {
+		return err
+	}
+	e.broadcast(b)
+	return nil
+}
+
+func (e *wsExporter) pushLogs(ctx context.Context, ld plog.Logs) error {
+	if e.clients.len() == 0 {
+		return nil
+	}
+	if e.logConditions != nil || e.config.SamplingPercentage < 100 {
+		selected := plog.NewLogs()
+		ld.CopyTo(selected)
+		if err := e.selectLogs(ctx, selected); err != nil {
+			return err
+		}
+		if selected.LogRecordCount() == 0 {
+			return nil
+		}
+		ld = selected
+	}
+	b, err := logMarshaler.MarshalLogs(ld)
+	if err != nil {
+		return err
+	}
+	e.broadcast(b)
+	return nil
+}
+
+func (e *wsExporter) broadcast(msg []byte) {
+	if dropped := e.clients.broadcast(msg); dropped > 0 {
+		e.settings.Logger.Debug("Dropped message for slow clients", zap.Int("clients", dropped))
+	}
+}
@@ -0,0 +1,193 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package websocketexporter
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"golang.org/x/net/websocket"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/common/testutil"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func startExporter(t *testing.T, cfg *Config) *wsExporter {
+	cfg.Endpoint = testutil.GetAvailableLocalAddress(t)
+	e := newWebSocketExporter(cfg, componenttest.NewNopTelemetrySettings())
+	require.NoError(t, e.Start(t.Context(), componenttest.NewNopHost()))
+	t.Cleanup(func() {
+		assert.NoError(t, e.Shutdown(context.Background()))
+	})
+	return e
+}
+
+// connect connects a client and waits for the exporter to register it.
+func connect(t *testing.T, e *wsExporter) *websocket.Conn {
+	clients := e.clients.len()
+	conn, err := websocket.Dial("ws://"+e.config.Endpoint, "", "http://localhost")
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = conn.Close()
+	})
+	require.Eventually(t, func() bool {
+		return e.clients.len() == clients+1
+	}, 5*time.Second, 10*time.Millisecond)
+	return conn
+}
+
+func receive(t *testing.T, conn *websocket.Conn) string {
+	require.NoError(t, conn.SetReadDeadline(time.Now().Add(5*time.Second)))
+	var msg string
+	require.NoError(t, websocket.Message.Receive(conn, &msg))
+	return msg
+}
+
+func newLogs(bodies ...string) plog.Logs {
+	ld := plog.NewLogs()
+	records := ld.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords()
+	for _, body := range bodies {
+		records.AppendEmpty().Body().SetStr(body)
+	}
+	return ld
+}
+
+func TestPushLogs(t *testing.T) {
+	e := startExporter(t, createDefaultConfig().(*Config))
+	first := connect(t, e)
+	second := connect(t, e)
+
+	require.NoError(t, e.pushLogs(t.Context(), newLogs("foo")))
+
+	expected := `{"resourceLogs":[{"resource":{},"scopeLogs":[{"scope":{},"logRecords":[{"body":{"stringValue":"foo"}}]}]}]}`
+	assert.JSONEq(t, expected, receive(t, first))
+	assert.JSONEq(t, expected, receive(t, second))
+}
+
+func TestPushLogsConditions(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Logs.LogConditions = []string{`IsMatch(body, "timeout")`}
+	e := startExporter(t, cfg)
+	conn := connect(t, e)
+
+	ld := newLogs("request timeout", "request served")
+	require.NoError(t, e.pushLogs(t.Context(), ld))
+	require.NoError(t, e.pushLogs(t.Context(), newLogs("request served")))
+	require.NoError(t, e.pushLogs(t.Context(), newLogs("connection timeout")))
+
+	assert.JSONEq(t, `{"resourceLogs":[{"resource":{},"scopeLogs":[{"scope":{},"logRecords":[{"body":{"stringValue":"request timeout"}}]}]}]}`, receive(t, conn))
+	assert.JSONEq(t, `{"resourceLogs":[{"resource":{},"scopeLogs":[{"scope":{},"logRecords":[{"body":{"stringValue":"connection timeout"}}]}]}]}`, receive(t, conn))
+	// The exported logs are left untouched.
+	assert.Equal(t, 2, ld.LogRecordCount())
+}
+
+func TestPushTracesConditions(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Traces.SpanConditions = []string{`kind == SPAN_KIND_SERVER`}
+	e := startExporter(t, cfg)
+	conn := connect(t, e)
+
+	td := ptrace.NewTraces()
+	spans := td.ResourceSpans().AppendEmpty().ScopeSpans().AppendEmpty().Spans()
+	server := spans.AppendEmpty()
+	server.SetName("GET /cart")
+	server.SetKind(ptrace.SpanKindServer)
+	spans.AppendEmpty().SetName("SELECT carts")
+	require.NoError(t, e.pushTraces(t.Context(), td))
+
+	assert.JSONEq(t, `{"resourceSpans":[{"resource":{},"scopeSpans":[{"scope":{},"spans":[{"name":"GET /cart","kind":2,"status":{}}]}]}]}`, receive(t, conn))
+}
+
+func TestPushMetricsConditions(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Metrics.DataPointConditions = []string{`attributes["queue"] == "orders"`}
+	e := startExporter(t, cfg)
+	conn := connect(t, e)
+
+	md := pmetric.NewMetrics()
+	metrics := md.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics()
+	gauge := metrics.AppendEmpty()
+	gauge.SetName("queue.size")
+	dps := gauge.SetEmptyGauge().DataPoints()
+	dp := dps.AppendEmpty()
+	dp.SetIntValue(5)
+	dp.Attributes().PutStr("queue", "orders")
+	dps.AppendEmpty().Attributes().PutStr("queue", "payments")
+	sum := metrics.AppendEmpty()
+	sum.SetName("requests")
+	sum.SetEmptySum().DataPoints().AppendEmpty().SetIntValue(1)
+	require.NoError(t, e.pushMetrics(t.Context(), md))
+
+	assert.JSONEq(t, `{"resourceMetrics":[{"resource":{},"scopeMetrics":[{"scope":{},"metrics":[{"name":"queue.size","gauge":{"dataPoints":[{"attributes":[{"key":"queue","value":{"stringValue":"orders"}}],"asInt":"5"}]}}]}]}]}`, receive(t, conn))
+}
+
+func TestSelectLogsSampling(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.SamplingPercentage = 50
+	e := newWebSocketExporter(cfg, componenttest.NewNopTelemetrySettings())
+
+	bodies := make([]string, 1000)
+	ld := newLogs(bodies...)
+	require.NoError(t, e.selectLogs(t.Context(), ld))
+	assert.InDelta(t, 500, ld.LogRecordCount(), 100)
+}
+
+func TestSelectLogsErrorMode(t *testing.T) {
+	for _, tt := range []struct {
+		errorMode ottl.ErrorMode
+		expectErr bool
+		expected  int
+	}{
+		{errorMode: ottl.IgnoreError, expected: 0},
+		{errorMode: ottl.PropagateError, expectErr: true, expected: 1},
+	} {
+		t.Run(string(tt.errorMode), func(t *testing.T) {
+			cfg := createDefaultConfig().(*Config)
+			cfg.ErrorMode = tt.errorMode
+			cfg.Logs.LogConditions = []string{`ParseJSON(body)["level"] == "error"`}
+			e := newWebSocketExporter(cfg, componenttest.NewNopTelemetrySettings())
+			require.NoError(t, e.parseConditions())
+
+			ld := newLogs("not a number")
+			err := e.selectLogs(t.Context(), ld)
+			if tt.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.expected, ld.LogRecordCount())
+		})
+	}
+}
+
+func TestClientDisconnect(t *testing.T) {
+	e := startExporter(t, createDefaultConfig().(*Config))
+	conn := connect(t, e)
+
+	require.NoError(t, conn.Close())
+	require.Eventually(t, func() bool {
+		return e.clients.len() == 0
+	}, 5*time.Second, 10*time.Millisecond)
+	require.NoError(t, e.pushLogs(t.Context(), newLogs("foo")))
+}
+
+func TestShutdownClosesConnections(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Endpoint = testutil.GetAvailableLocalAddress(t)
+	e := newWebSocketExporter(cfg, componenttest.NewNopTelemetrySettings())
+	require.NoError(t, e.Start(t.Context(), componenttest.NewNopHost()))
+	conn := connect(t, e)
+
+	require.NoError(t, e.Shutdown(t.Context()))
+
+	var msg string
+	assert.Error(t, websocket.Message.Receive(conn, &msg))
+}
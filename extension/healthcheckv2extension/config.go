@@ -5,6 +5,7 @@ package healthcheckv2extension // import "github.com/open-telemetry/opentelemetr
 
 import (
 	"errors"
+	"fmt"
 	"strings"
 
 	"go.opentelemetry.io/collector/component"
@@ -25,6 +26,7 @@ var (
 	errGRPCEndpointRequired = errors.New("grpc endpoint required")
 	errHTTPEndpointRequired = errors.New("http endpoint required")
 	errInvalidPath          = errors.New("path must start with /")
+	errDuplicatePath        = errors.New("paths of the enabled http endpoints must be distinct")
 )
 
 // Config has the configuration for the extension enabling the health check
@@ -65,11 +67,24 @@ func (c *Config) Validate() error {
 		if c.HTTPConfig.Endpoint == "" {
 			return errHTTPEndpointRequired
 		}
-		if c.HTTPConfig.Status.Enabled && !strings.HasPrefix(c.HTTPConfig.Status.Path, "/") {
-			return errInvalidPath
-		}
-		if c.HTTPConfig.Config.Enabled && !strings.HasPrefix(c.HTTPConfig.Config.Path, "/") {
-			return errInvalidPath
+		// The paths are registered on the same mux, which does not accept a path twice.
+		paths := make(map[string]struct{})
+		for _, pathConfig := range []http.PathConfig{
+			c.HTTPConfig.Status,
+			c.HTTPConfig.Config,
+			c.HTTPConfig.Readiness,
+			c.HTTPConfig.Liveness,
+		} {
+			if !pathConfig.Enabled {
+				continue
+			}
+			if !strings.HasPrefix(pathConfig.Path, "/") {
+				return errInvalidPath
+			}
+			if _, ok := paths[pathConfig.Path]; ok {
+				return fmt.Errorf("%w: %q", errDuplicatePath, pathConfig.Path)
+			}
+			paths[pathConfig.Path] = struct{}{}
 		}
 	}
 
@@ -99,6 +99,14 @@ func TestLoadConfig(t *testing.T) {
 						Enabled: false,
 						Path:    "/config",
 					},
+					Readiness: http.PathConfig{
+						Enabled: false,
+						Path:    "/ready",
+					},
+					Liveness: http.PathConfig{
+						Enabled: false,
+						Path:    "/live",
+					},
 				},
 				GRPCConfig: &grpc.Config{
 					ServerConfig: configgrpc.ServerConfig{
@@ -137,9 +145,62 @@ func TestLoadConfig(t *testing.T) {
 						Enabled: true,
 						Path:    "/conf",
 					},
+					Readiness: http.PathConfig{
+						Enabled: false,
+						Path:    "/ready",
+					},
+					Liveness: http.PathConfig{
+						Enabled: false,
+						Path:    "/live",
+					},
 				},
 			},
 		},
+		{
+			id: component.NewIDWithName(metadata.Type, "v2httpprobes"),
+			expected: &Config{
+				LegacyConfig: http.LegacyConfig{
+					UseV2: true,
+					ServerConfig: confighttp.ServerConfig{
+						Endpoint: testutil.EndpointForPort(defaultHTTPPort),
+					},
+					Path: "/",
+				},
+				HTTPConfig: &http.Config{
+					ServerConfig: confighttp.ServerConfig{
+						Endpoint: "localhost:13",
+					},
+					Status: http.PathConfig{
+						Enabled: true,
+						Path:    "/status",
+					},
+					Config: http.PathConfig{
+						Enabled: false,
+						Path:    "/config",
+					},
+					Readiness: http.PathConfig{
+						Enabled: true,
+						Path:    "/readyz",
+					},
+					Liveness: http.PathConfig{
+						Enabled: true,
+						Path:    "/livez",
+					},
+				},
+			},
+		},
+		{
+			id:          component.NewIDWithName(metadata.Type, "v2httpinvalidprobepath"),
+			expectedErr: errInvalidPath,
+		},
+		{
+			id:          component.NewIDWithName(metadata.Type, "v2httpprobeonstatuspath"),
+			expectedErr: errDuplicatePath,
+		},
+		{
+			id:          component.NewIDWithName(metadata.Type, "v2httpprobesamepath"),
+			expectedErr: errDuplicatePath,
+		},
 		{
 			id:          component.NewIDWithName(metadata.Type, "v2httpmissingendpoint"),
 			expectedErr: errHTTPEndpointRequired,
@@ -55,6 +55,14 @@ func createDefaultConfig() component.Config {
 				Enabled: false,
 				Path:    "/config",
 			},
+			Readiness: http.PathConfig{
+				Enabled: false,
+				Path:    "/ready",
+			},
+			Liveness: http.PathConfig{
+				Enabled: false,
+				Path:    "/live",
+			},
 		},
 		GRPCConfig: &grpc.Config{
 			ServerConfig: configgrpc.ServerConfig{
@@ -41,6 +41,14 @@ func TestCreateDefaultConfig(t *testing.T) {
 				Enabled: false,
 				Path:    "/config",
 			},
+			Readiness: http.PathConfig{
+				Enabled: false,
+				Path:    "/ready",
+			},
+			Liveness: http.PathConfig{
+				Enabled: false,
+				Path:    "/live",
+			},
 		},
 		GRPCConfig: &grpc.Config{
 			ServerConfig: configgrpc.ServerConfig{
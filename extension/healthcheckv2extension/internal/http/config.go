@@ -9,8 +9,10 @@ import "go.opentelemetry.io/collector/config/confighttp"
 type Config struct {
 	confighttp.ServerConfig `mapstructure:",squash"`
 
-	Config PathConfig `mapstructure:"config"`
-	Status PathConfig `mapstructure:"status"`
+	Config    PathConfig `mapstructure:"config"`
+	Status    PathConfig `mapstructure:"status"`
+	Readiness PathConfig `mapstructure:"readiness"`
+	Liveness  PathConfig `mapstructure:"liveness"`
 }
 
 type PathConfig struct {
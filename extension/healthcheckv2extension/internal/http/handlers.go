@@ -26,6 +26,24 @@ func (s *Server) statusHandler() http.Handler {
 	})
 }
 
+// probeHandler responds with the detailed status of the collector, or of the
+// pipeline passed as a query parameter, for the readiness and liveness probes.
+func (s *Server) probeHandler(r responder) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		pipeline := req.URL.Query().Get("pipeline")
+		st, ok := s.aggregator.AggregateStatus(status.Scope(pipeline), status.Verbose)
+
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		if err := r.respond(st, w); err != nil {
+			s.telemetry.Logger.Warn(err.Error())
+		}
+	})
+}
+
 func (s *Server) configHandler() http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
 		conf := s.colconf.Load()
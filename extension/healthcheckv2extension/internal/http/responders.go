@@ -62,23 +62,26 @@ func defaultResponder(startTimestamp *time.Time) responderFunc {
 	}
 }
 
+// componentHealthFunc returns whether an event is healthy according to the
+// component health config.
+func componentHealthFunc(config *common.ComponentHealthConfig, now *time.Time) healthyFunc {
+	return func(ev status.Event) bool {
+		if ev.Status() == componentstatus.StatusPermanentError {
+			return !config.IncludePermanent
+		}
+
+		if ev.Status() == componentstatus.StatusRecoverableError && config.IncludeRecoverable {
+			return now.Before(ev.Timestamp().Add(config.RecoveryDuration))
+		}
+
+		return ev.Status() != componentstatus.StatusFatalError
+	}
+}
+
 func componentHealthResponder(
 	startTimestamp *time.Time,
 	config *common.ComponentHealthConfig,
 ) responderFunc {
-	healthyFunc := func(now *time.Time) func(status.Event) bool {
-		return func(ev status.Event) bool {
-			if ev.Status() == componentstatus.StatusPermanentError {
-				return !config.IncludePermanent
-			}
-
-			if ev.Status() == componentstatus.StatusRecoverableError && config.IncludeRecoverable {
-				return now.Before(ev.Timestamp().Add(config.RecoveryDuration))
-			}
-
-			return ev.Status() != componentstatus.StatusFatalError
-		}
-	}
 	return func(st *status.AggregateStatus, w http.ResponseWriter) error {
 		now := time.Now()
 		sst := toSerializableStatus(
@@ -86,7 +89,7 @@ func componentHealthResponder(
 			&serializationOptions{
 				includeStartTime: true,
 				startTimestamp:   startTimestamp,
-				healthyFunc:      healthyFunc(&now),
+				healthyFunc:      componentHealthFunc(config, &now),
 			},
 		)
 
@@ -99,6 +102,88 @@ func componentHealthResponder(
 	}
 }
 
+// readyStatuses are the statuses of the collector, or of a pipeline, which is
+// ready to process telemetry as long as it is healthy. While it is starting or
+// stopping, it is alive but not ready.
+var readyStatuses = map[componentstatus.Status]bool{
+	componentstatus.StatusOK:               true,
+	componentstatus.StatusRecoverableError: true,
+	componentstatus.StatusPermanentError:   true,
+}
+
+type readinessResponse struct {
+	Ready bool `json:"ready"`
+	*serializableStatus
+}
+
+type livenessResponse struct {
+	Alive bool `json:"alive"`
+	*serializableStatus
+}
+
+// probeStatus returns the detailed status for the readiness and liveness probes.
+// Without component health config, only fatal errors are unhealthy.
+func probeStatus(
+	st *status.AggregateStatus,
+	startTimestamp *time.Time,
+	config *common.ComponentHealthConfig,
+) *serializableStatus {
+	if config == nil {
+		config = &common.ComponentHealthConfig{}
+	}
+	now := time.Now()
+	return toSerializableStatus(
+		st,
+		&serializationOptions{
+			includeStartTime: true,
+			startTimestamp:   startTimestamp,
+			healthyFunc:      componentHealthFunc(config, &now),
+		},
+	)
+}
+
+// readinessResponder responds with 200 once the collector, or pipeline, has
+// started and is healthy, and with 503 otherwise.
+func readinessResponder(
+	startTimestamp *time.Time,
+	config *common.ComponentHealthConfig,
+) responderFunc {
+	return func(st *status.AggregateStatus, w http.ResponseWriter) error {
+		sst := probeStatus(st, startTimestamp, config)
+		resp := readinessResponse{
+			Ready:              readyStatuses[st.Status()] && sst.Healthy,
+			serializableStatus: sst,
+		}
+
+		code := http.StatusOK
+		if !resp.Ready {
+			code = http.StatusServiceUnavailable
+		}
+		return respondWithJSON(code, resp, w)
+	}
+}
+
+// livenessResponder responds with 500 when the collector, or pipeline, is
+// unhealthy, and with 200 otherwise, including while it is starting.
+func livenessResponder(
+	startTimestamp *time.Time,
+	config *common.ComponentHealthConfig,
+) responderFunc {
+	return func(st *status.AggregateStatus, w http.ResponseWriter) error {
+		sst := probeStatus(st, startTimestamp, config)
+		resp := livenessResponse{
+			Alive:              sst.Healthy,
+			serializableStatus: sst,
+		}
+
+		code := http.StatusOK
+		if !resp.Alive {
+			code = http.StatusInternalServerError
+		}
+		return respondWithJSON(code, resp, w)
+	}
+}
+
 // Below are responders ported from the original healthcheck extension. We will
 // keep them for backwards compatibility, but eventually deprecate and remove
 // them.
@@ -68,6 +68,12 @@ func NewServer(
 		if config.Config.Enabled {
 			srv.mux.Handle(config.Config.Path, srv.configHandler())
 		}
+		if config.Readiness.Enabled {
+			srv.mux.Handle(config.Readiness.Path, srv.probeHandler(readinessResponder(&now, componentHealthConfig)))
+		}
+		if config.Liveness.Enabled {
+			srv.mux.Handle(config.Liveness.Path, srv.probeHandler(livenessResponder(&now, componentHealthConfig)))
+		}
 	} else {
 		srv.httpConfig = legacyConfig.ServerConfig
 		if legacyConfig.ResponseBody != nil {
@@ -3143,3 +3143,184 @@ func TestConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestProbes(t *testing.T) {
+	traces := testhelpers.NewPipelineMetadata(pipeline.SignalTraces)
+	metrics := testhelpers.NewPipelineMetadata(pipeline.SignalMetrics)
+
+	config := &Config{
+		ServerConfig: confighttp.ServerConfig{
+			Endpoint: testutil.GetAvailableLocalAddress(t),
+		},
+		Readiness: PathConfig{
+			Enabled: true,
+			Path:    "/ready",
+		},
+		Liveness: PathConfig{
+			Enabled: true,
+			Path:    "/live",
+		},
+	}
+	componentHealthConfig := &common.ComponentHealthConfig{IncludePermanent: true}
+	server := NewServer(
+		config,
+		LegacyConfig{UseV2: true},
+		componentHealthConfig,
+		componenttest.NewNopTelemetrySettings(),
+		status.NewAggregator(internalhelpers.ErrPriority(componentHealthConfig)),
+	)
+
+	require.NoError(t, server.Start(context.Background(), componenttest.NewNopHost()))
+	defer func() { require.NoError(t, server.Shutdown(context.Background())) }()
+
+	client := &http.Client{}
+
+	type probeResponse struct {
+		Ready *bool `json:"ready"`
+		Alive *bool `json:"alive"`
+		serializableStatus
+	}
+
+	probe := func(path string) (int, *probeResponse) {
+		resp, err := client.Get(fmt.Sprintf("http://%s%s", config.Endpoint, path))
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		if resp.StatusCode == http.StatusNotFound {
+			return resp.StatusCode, nil
+		}
+		pr := &probeResponse{}
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(pr))
+		return resp.StatusCode, pr
+	}
+
+	// The collector is alive, but not ready, while it is starting.
+	testhelpers.SeedAggregator(server.aggregator, traces.InstanceIDs(), componentstatus.StatusStarting)
+	testhelpers.SeedAggregator(server.aggregator, metrics.InstanceIDs(), componentstatus.StatusStarting)
+
+	code, pr := probe("/ready")
+	assert.Equal(t, http.StatusServiceUnavailable, code)
+	assert.False(t, *pr.Ready)
+	assert.Nil(t, pr.Alive)
+	assertNestedStatus(t, map[string]*componentStatusExpectation{
+		"pipeline:traces": {
+			healthy:      true,
+			status:       componentstatus.StatusStarting,
+			nestedStatus: componentStatusPipelineTracesStarting,
+		},
+		"pipeline:metrics": {
+			healthy:      true,
+			status:       componentstatus.StatusStarting,
+			nestedStatus: componentStatusPipelineMetricsStarting,
+		},
+	}, pr.ComponentStatuses)
+
+	code, pr = probe("/live")
+	assert.Equal(t, http.StatusOK, code)
+	assert.True(t, *pr.Alive)
+	assert.Nil(t, pr.Ready)
+
+	// A pipeline is ready once its components have started.
+	testhelpers.SeedAggregator(server.aggregator, traces.InstanceIDs(), componentstatus.StatusOK)
+
+	code, pr = probe("/ready?pipeline=traces")
+	assert.Equal(t, http.StatusOK, code)
+	assert.True(t, *pr.Ready)
+	assertNestedStatus(t, componentStatusPipelineTracesOK, pr.ComponentStatuses)
+
+	code, _ = probe("/ready")
+	assert.Equal(t, http.StatusServiceUnavailable, code)
+
+	testhelpers.SeedAggregator(server.aggregator, metrics.InstanceIDs(), componentstatus.StatusOK)
+
+	code, pr = probe("/ready")
+	assert.Equal(t, http.StatusOK, code)
+	assert.True(t, *pr.Ready)
+
+	// A permanent error of an exporter makes its pipeline, and the collector, neither
+	// ready nor alive when permanent errors are included.
+	server.aggregator.RecordStatus(metrics.ExporterID, componentstatus.NewPermanentErrorEvent(assert.AnError))
+
+	code, pr = probe("/ready")
+	assert.Equal(t, http.StatusServiceUnavailable, code)
+	assert.False(t, *pr.Ready)
+
+	code, pr = probe("/live")
+	assert.Equal(t, http.StatusInternalServerError, code)
+	assert.False(t, *pr.Alive)
+	assertNestedStatus(t, map[string]*componentStatusExpectation{
+		"pipeline:traces": {
+			healthy: true,
+			status:  componentstatus.StatusOK,
+		},
+		"pipeline:metrics": {
+			healthy: false,
+			status:  componentstatus.StatusPermanentError,
+			err:     assert.AnError,
+			nestedStatus: map[string]*componentStatusExpectation{
+				"exporter:metrics/out": {
+					healthy: false,
+					status:  componentstatus.StatusPermanentError,
+					err:     assert.AnError,
+				},
+			},
+		},
+	}, pr.ComponentStatuses)
+
+	code, pr = probe("/live?pipeline=traces")
+	assert.Equal(t, http.StatusOK, code)
+	assert.True(t, *pr.Alive)
+
+	code, _ = probe("/live?pipeline=logs")
+	assert.Equal(t, http.StatusNotFound, code)
+}
+
+func TestProbesDefaultComponentHealth(t *testing.T) {
+	metrics := testhelpers.NewPipelineMetadata(pipeline.SignalMetrics)
+
+	config := &Config{
+		ServerConfig: confighttp.ServerConfig{
+			Endpoint: testutil.GetAvailableLocalAddress(t),
+		},
+		Readiness: PathConfig{
+			Enabled: true,
+			Path:    "/ready",
+		},
+		Liveness: PathConfig{
+			Enabled: true,
+			Path:    "/live",
+		},
+	}
+	server := NewServer(
+		config,
+		LegacyConfig{UseV2: true},
+		nil,
+		componenttest.NewNopTelemetrySettings(),
+		status.NewAggregator(internalhelpers.ErrPriority(nil)),
+	)
+
+	require.NoError(t, server.Start(context.Background(), componenttest.NewNopHost()))
+	defer func() { require.NoError(t, server.Shutdown(context.Background())) }()
+
+	client := &http.Client{}
+	get := func(path string) int {
+		resp, err := client.Get(fmt.Sprintf("http://%s%s", config.Endpoint, path))
+		require.NoError(t, err)
+		require.NoError(t, resp.Body.Close())
+		return resp.StatusCode
+	}
+
+	// Without component health config, errors are healthy unless they are fatal.
+	testhelpers.SeedAggregator(server.aggregator, metrics.InstanceIDs(), componentstatus.StatusOK)
+	server.aggregator.RecordStatus(metrics.ExporterID, componentstatus.NewPermanentErrorEvent(assert.AnError))
+	assert.Equal(t, http.StatusOK, get("/ready"))
+	assert.Equal(t, http.StatusOK, get("/live"))
+
+	server.aggregator.RecordStatus(metrics.ReceiverID, componentstatus.NewFatalErrorEvent(assert.AnError))
+	assert.Equal(t, http.StatusServiceUnavailable, get("/ready"))
+	assert.Equal(t, http.StatusInternalServerError, get("/live"))
+
+	// The collector is alive, but no longer ready, while it is stopping.
+	testhelpers.SeedAggregator(server.aggregator, metrics.InstanceIDs(), componentstatus.StatusStopping)
+	assert.Equal(t, http.StatusServiceUnavailable, get("/ready"))
+	assert.Equal(t, http.StatusOK, get("/live"))
+}
@@ -0,0 +1,164 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package oauth2clientauthextension // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/oauth2clientauthextension"
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+)
+
+// clientAssertionType is the assertion type of the private_key_jwt client authentication.
+// See https://datatracker.ietf.org/doc/html/rfc7523#section-2.2
+const clientAssertionType = "urn:ietf:params:oauth:client-assertion-type:jwt-bearer"
+
+// defaultClientAssertionLifetime is the lifetime of the signed client assertions.
+const defaultClientAssertionLifetime = 5 * time.Minute
+
+type jwtHeader struct {
+	Algorithm string `json:"alg"`
+	Type      string `json:"typ"`
+	KeyID     string `json:"kid,omitempty"`
+}
+
+type jwtClaims struct {
+	Issuer    string `json:"iss"`
+	Subject   string `json:"sub"`
+	Audience  string `json:"aud"`
+	ID        string `json:"jti"`
+	IssuedAt  int64  `json:"iat"`
+	ExpiresAt int64  `json:"exp"`
+}
+
+// readPrivateKey reads a PEM encoded RSA or ECDSA P-256 private key.
+func readPrivateKey(path string) (crypto.Signer, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read client assertion private key file %q: %w", path, err)
+	}
+	block, _ := pem.Decode(content)
+	if block == nil {
+		return nil, fmt.Errorf("no PEM data found in client assertion private key file %q", path)
+	}
+
+	var key any
+	switch block.Type {
+	case "RSA PRIVATE KEY":
+		key, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "EC PRIVATE KEY":
+		key, err = x509.ParseECPrivateKey(block.Bytes)
+	default:
+		key, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse client assertion private key: %w", err)
+	}
+
+	switch k := key.(type) {
+	case *rsa.PrivateKey:
+		return k, nil
+	case *ecdsa.PrivateKey:
+		if k.Curve != elliptic.P256() {
+			return nil, errors.New("unsupported client assertion private key: only the P-256 curve is supported for ECDSA keys")
+		}
+		return k, nil
+	default:
+		return nil, fmt.Errorf("unsupported client assertion private key type %T", key)
+	}
+}
+
+// signClientAssertion creates the JWT authenticating the client to the token endpoint,
+// signed with RS256 or ES256 depending on the type of the private key.
+// See https://datatracker.ietf.org/doc/html/rfc7523#section-3
+func signClientAssertion(cfg *ClientAssertionConfig, clientID, tokenURL string, now time.Time) (string, error) {
+	key, err := readPrivateKey(cfg.PrivateKeyFile)
+	if err != nil {
+		return "", err
+	}
+
+	header := jwtHeader{Type: "JWT", KeyID: cfg.KeyID}
+	switch key.(type) {
+	case *rsa.PrivateKey:
+		header.Algorithm = "RS256"
+	case *ecdsa.PrivateKey:
+		header.Algorithm = "ES256"
+	}
+
+	jti := make([]byte, 16)
+	if _, err = rand.Read(jti); err != nil {
+		return "", err
+	}
+	audience := cfg.Audience
+	if audience == "" {
+		audience = tokenURL
+	}
+	lifetime := cfg.Lifetime
+	if lifetime <= 0 {
+		lifetime = defaultClientAssertionLifetime
+	}
+	claims := jwtClaims{
+		Issuer:    clientID,
+		Subject:   clientID,
+		Audience:  audience,
+		ID:        hex.EncodeToString(jti),
+		IssuedAt:  now.Unix(),
+		ExpiresAt: now.Add(lifetime).Unix(),
+	}
+
+	encodedHeader, err := encodeSegment(header)
+	if err != nil {
+		return "", err
+	}
+	encodedClaims, err := encodeSegment(claims)
+	if err != nil {
+		return "", err
+	}
+	signingInput := encodedHeader + "." + encodedClaims
+
+	digest := sha256.Sum256([]byte(signingInput))
+	var signature []byte
+	switch k := key.(type) {
+	case *rsa.PrivateKey:
+		signature, err = rsa.SignPKCS1v15(rand.Reader, k, crypto.SHA256, digest[:])
+	case *ecdsa.PrivateKey:
+		signature, err = signES256(k, digest[:])
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to sign client assertion: %w", err)
+	}
+	return signingInput + "." + base64.RawURLEncoding.EncodeToString(signature), nil
+}
+
+// signES256 returns the ECDSA signature in the fixed size R || S format required by JWS.
+// See https://datatracker.ietf.org/doc/html/rfc7518#section-3.4
+func signES256(key *ecdsa.PrivateKey, digest []byte) ([]byte, error) {
+	r, s, err := ecdsa.Sign(rand.Reader, key, digest)
+	if err != nil {
+		return nil, err
+	}
+	signature := make([]byte, 64)
+	r.FillBytes(signature[:32])
+	s.FillBytes(signature[32:])
+	return signature, nil
+}
+
+func encodeSegment(v any) (string, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
@@ -0,0 +1,189 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package oauth2clientauthextension
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func writePrivateKey(t *testing.T, key crypto.Signer) string {
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	require.NoError(t, err)
+	path := filepath.Join(t.TempDir(), "key.pem")
+	require.NoError(t, os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}), 0o600))
+	return path
+}
+
+func decodeSegment(t *testing.T, segment string, v any) {
+	b, err := base64.RawURLEncoding.DecodeString(segment)
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal(b, v))
+}
+
+func TestSignClientAssertion(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name      string
+		key       crypto.Signer
+		algorithm string
+		verify    func(t *testing.T, digest, signature []byte)
+	}{
+		{
+			name:      "rsa",
+			key:       rsaKey,
+			algorithm: "RS256",
+			verify: func(t *testing.T, digest, signature []byte) {
+				assert.NoError(t, rsa.VerifyPKCS1v15(&rsaKey.PublicKey, crypto.SHA256, digest, signature))
+			},
+		},
+		{
+			name:      "ecdsa",
+			key:       ecKey,
+			algorithm: "ES256",
+			verify: func(t *testing.T, digest, signature []byte) {
+				require.Len(t, signature, 64)
+				r := new(big.Int).SetBytes(signature[:32])
+				s := new(big.Int).SetBytes(signature[32:])
+				assert.True(t, ecdsa.Verify(&ecKey.PublicKey, digest, r, s))
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &ClientAssertionConfig{
+				PrivateKeyFile: writePrivateKey(t, tt.key),
+				KeyID:          "keyid",
+			}
+			now := time.Unix(1700000000, 0)
+			assertion, err := signClientAssertion(cfg, "clientid", "https://example.com/token", now)
+			require.NoError(t, err)
+
+			segments := strings.Split(assertion, ".")
+			require.Len(t, segments, 3)
+
+			var header jwtHeader
+			decodeSegment(t, segments[0], &header)
+			assert.Equal(t, jwtHeader{Algorithm: tt.algorithm, Type: "JWT", KeyID: "keyid"}, header)
+
+			var claims jwtClaims
+			decodeSegment(t, segments[1], &claims)
+			assert.Equal(t, "clientid", claims.Issuer)
+			assert.Equal(t, "clientid", claims.Subject)
+			assert.Equal(t, "https://example.com/token", claims.Audience)
+			assert.NotEmpty(t, claims.ID)
+			assert.Equal(t, now.Unix(), claims.IssuedAt)
+			assert.Equal(t, now.Add(defaultClientAssertionLifetime).Unix(), claims.ExpiresAt)
+
+			signature, err := base64.RawURLEncoding.DecodeString(segments[2])
+			require.NoError(t, err)
+			digest := sha256.Sum256([]byte(segments[0] + "." + segments[1]))
+			tt.verify(t, digest[:], signature)
+		})
+	}
+}
+
+func TestSignClientAssertionErrors(t *testing.T) {
+	_, err := signClientAssertion(&ClientAssertionConfig{PrivateKeyFile: "testdata/missing.pem"}, "id", "url", time.Now())
+	assert.ErrorContains(t, err, "failed to read client assertion private key file")
+
+	_, err = signClientAssertion(&ClientAssertionConfig{PrivateKeyFile: "testdata/test-cred.txt"}, "id", "url", time.Now())
+	assert.ErrorContains(t, err, "no PEM data found")
+
+	p384Key, err := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	require.NoError(t, err)
+	_, err = signClientAssertion(&ClientAssertionConfig{PrivateKeyFile: writePrivateKey(t, p384Key)}, "id", "url", time.Now())
+	assert.ErrorContains(t, err, "only the P-256 curve is supported")
+}
+
+func TestTokenRequestClientAuthentication(t *testing.T) {
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		settings *Config
+		verify   func(t *testing.T, r *http.Request)
+	}{
+		{
+			name: "client_assertion",
+			settings: &Config{
+				ClientID:        "clientid",
+				EndpointParams:  map[string][]string{"audience": {"someaudience"}},
+				ClientAssertion: &ClientAssertionConfig{PrivateKeyFile: writePrivateKey(t, ecKey)},
+			},
+			verify: func(t *testing.T, r *http.Request) {
+				_, _, hasBasicAuth := r.BasicAuth()
+				assert.False(t, hasBasicAuth)
+				assert.Equal(t, "clientid", r.PostForm.Get("client_id"))
+				assert.Empty(t, r.PostForm.Get("client_secret"))
+				assert.Equal(t, "someaudience", r.PostForm.Get("audience"))
+				assert.Equal(t, clientAssertionType, r.PostForm.Get("client_assertion_type"))
+				assert.Len(t, strings.Split(r.PostForm.Get("client_assertion"), "."), 3)
+			},
+		},
+		{
+			name: "tls_client_auth",
+			settings: &Config{
+				ClientID:      "clientid",
+				TLSClientAuth: true,
+			},
+			verify: func(t *testing.T, r *http.Request) {
+				_, _, hasBasicAuth := r.BasicAuth()
+				assert.False(t, hasBasicAuth)
+				assert.Equal(t, "clientid", r.PostForm.Get("client_id"))
+				assert.Empty(t, r.PostForm.Get("client_secret"))
+				assert.Empty(t, r.PostForm.Get("client_assertion"))
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.NoError(t, r.ParseForm())
+				tt.verify(t, r)
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(`{"access_token":"token","token_type":"Bearer","expires_in":3600}`))
+			}))
+			defer server.Close()
+
+			tt.settings.TokenURL = server.URL
+			authenticator, err := newClientAuthenticator(tt.settings, zap.NewNop())
+			require.NoError(t, err)
+
+			cfg, err := authenticator.clientCredentials.createConfig()
+			require.NoError(t, err)
+			token, err := cfg.Token(context.Background())
+			require.NoError(t, err)
+			assert.Equal(t, "token", token.AccessToken)
+		})
+	}
+}
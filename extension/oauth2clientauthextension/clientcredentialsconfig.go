@@ -6,6 +6,7 @@ package oauth2clientauthextension // import "github.com/open-telemetry/opentelem
 import (
 	"context"
 	"fmt"
+	"net/url"
 	"os"
 	"strings"
 	"time"
@@ -38,6 +39,8 @@ type clientCredentialsConfig struct {
 	ClientIDFile     string
 	ClientSecretFile string
 	ExpiryBuffer     time.Duration
+	ClientAssertion  *ClientAssertionConfig
+	TLSClientAuth    bool
 }
 
 type clientCredentialsTokenSource struct {
@@ -77,6 +80,37 @@ func (c *clientCredentialsConfig) createConfig() (*clientcredentials.Config, err
 		return nil, multierr.Combine(errNoClientIDProvided, err)
 	}
 
+	switch {
+	case c.ClientAssertion != nil:
+		// a new assertion is signed for each token request, as the token endpoint may reject reused ones
+		assertion, err := signClientAssertion(c.ClientAssertion, clientID, c.TokenURL, time.Now())
+		if err != nil {
+			return nil, err
+		}
+		params := url.Values{}
+		for k, v := range c.EndpointParams {
+			params[k] = v
+		}
+		params.Set("client_assertion_type", clientAssertionType)
+		params.Set("client_assertion", assertion)
+		return &clientcredentials.Config{
+			ClientID:       clientID,
+			TokenURL:       c.TokenURL,
+			Scopes:         c.Scopes,
+			EndpointParams: params,
+			AuthStyle:      oauth2.AuthStyleInParams,
+		}, nil
+	case c.TLSClientAuth:
+		// the client is authenticated by its TLS certificate, only its ID is sent to the token endpoint
+		return &clientcredentials.Config{
+			ClientID:       clientID,
+			TokenURL:       c.TokenURL,
+			Scopes:         c.Scopes,
+			EndpointParams: c.EndpointParams,
+			AuthStyle:      oauth2.AuthStyleInParams,
+		}, nil
+	}
+
 	clientSecret, err := getActualValue(c.ClientSecret, c.ClientSecretFile)
 	if err != nil {
 		return nil, multierr.Combine(errNoClientSecretProvided, err)
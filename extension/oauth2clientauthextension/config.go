@@ -17,6 +17,9 @@ var (
 	errNoClientIDProvided     = errors.New("no ClientID provided in the OAuth2 exporter configuration")
 	errNoTokenURLProvided     = errors.New("no TokenURL provided in OAuth Client Credentials configuration")
 	errNoClientSecretProvided = errors.New("no ClientSecret provided in OAuth Client Credentials configuration")
+	errNoPrivateKeyProvided   = errors.New("no PrivateKeyFile provided in OAuth Client Credentials client assertion configuration")
+	errNoClientCertProvided   = errors.New("no client certificate provided in the TLS configuration, required by TLSClientAuth")
+	errMultipleClientAuth     = errors.New("only one of ClientSecret, ClientAssertion and TLSClientAuth can be used to authenticate the client")
 )
 
 // Config stores the configuration for OAuth2 Client Credentials (2-legged OAuth2 flow) setup.
@@ -56,6 +59,32 @@ type Config struct {
 
 	// ExpiryBuffer specifies the time buffer before token expiry to refresh it.
 	ExpiryBuffer time.Duration `mapstructure:"expiry_buffer,omitempty"`
+
+	// ClientAssertion authenticates the client with a JWT signed by its private key (private_key_jwt)
+	// instead of a client secret.
+	// See https://datatracker.ietf.org/doc/html/rfc7523#section-2.2
+	ClientAssertion *ClientAssertionConfig `mapstructure:"client_assertion,omitempty"`
+
+	// TLSClientAuth authenticates the client with the certificate of the TLS configuration
+	// instead of a client secret.
+	// See https://datatracker.ietf.org/doc/html/rfc8705#section-2
+	TLSClientAuth bool `mapstructure:"tls_client_auth,omitempty"`
+}
+
+// ClientAssertionConfig stores the configuration of the private_key_jwt client authentication.
+type ClientAssertionConfig struct {
+	// PrivateKeyFile is the path of the PEM encoded RSA or ECDSA P-256 private key signing the assertions.
+	// The file is read whenever a new token is requested to allow rotating the key.
+	PrivateKeyFile string `mapstructure:"private_key_file"`
+
+	// KeyID is the optional identifier of the key, set as the "kid" header of the assertions.
+	KeyID string `mapstructure:"key_id,omitempty"`
+
+	// Audience is the "aud" claim of the assertions. Defaults to the token URL.
+	Audience string `mapstructure:"audience,omitempty"`
+
+	// Lifetime is the validity period of the assertions. Defaults to 5m.
+	Lifetime time.Duration `mapstructure:"lifetime,omitempty"`
 }
 
 var _ component.Config = (*Config)(nil)
@@ -65,7 +94,26 @@ func (cfg *Config) Validate() error {
 	if cfg.ClientID == "" && cfg.ClientIDFile == "" {
 		return errNoClientIDProvided
 	}
-	if cfg.ClientSecret == "" && cfg.ClientSecretFile == "" {
+	hasSecret := cfg.ClientSecret != "" || cfg.ClientSecretFile != ""
+	authMethods := 0
+	for _, configured := range []bool{hasSecret, cfg.ClientAssertion != nil, cfg.TLSClientAuth} {
+		if configured {
+			authMethods++
+		}
+	}
+	if authMethods > 1 {
+		return errMultipleClientAuth
+	}
+	switch {
+	case cfg.ClientAssertion != nil:
+		if cfg.ClientAssertion.PrivateKeyFile == "" {
+			return errNoPrivateKeyProvided
+		}
+	case cfg.TLSClientAuth:
+		if cfg.TLS.CertFile == "" && cfg.TLS.CertPem == "" {
+			return errNoClientCertProvided
+		}
+	case !hasSecret:
 		return errNoClientSecretProvided
 	}
 	if cfg.TokenURL == "" {
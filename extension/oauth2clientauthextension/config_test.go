@@ -60,6 +60,47 @@ func TestLoadConfig(t *testing.T) {
 				ExpiryBuffer: 15 * time.Second,
 			},
 		},
+		{
+			id: component.NewIDWithName(metadata.Type, "clientassertion"),
+			expected: &Config{
+				ClientID: "someclientid",
+				TokenURL: "https://example.com/oauth2/default/v1/token",
+				ClientAssertion: &ClientAssertionConfig{
+					PrivateKeyFile: "keyfile",
+					KeyID:          "somekeyid",
+					Audience:       "https://example.com",
+					Lifetime:       time.Minute,
+				},
+				ExpiryBuffer: 5 * time.Minute,
+			},
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "tlsclientauth"),
+			expected: &Config{
+				ClientID:      "someclientid",
+				TokenURL:      "https://example.com/oauth2/default/v1/token",
+				TLSClientAuth: true,
+				TLS: configtls.ClientConfig{
+					Config: configtls.Config{
+						CertFile: "certfile",
+						KeyFile:  "keyfile",
+					},
+				},
+				ExpiryBuffer: 5 * time.Minute,
+			},
+		},
+		{
+			id:          component.NewIDWithName(metadata.Type, "missingprivatekey"),
+			expectedErr: errNoPrivateKeyProvided,
+		},
+		{
+			id:          component.NewIDWithName(metadata.Type, "missingclientcert"),
+			expectedErr: errNoClientCertProvided,
+		},
+		{
+			id:          component.NewIDWithName(metadata.Type, "multipleauth"),
+			expectedErr: errMultipleClientAuth,
+		},
 		{
 			id:          component.NewIDWithName(metadata.Type, "missingurl"),
 			expectedErr: errNoTokenURLProvided,
@@ -69,6 +69,8 @@ func newClientAuthenticator(cfg *Config, logger *zap.Logger) (*clientAuthenticat
 			ClientIDFile:     cfg.ClientIDFile,
 			ClientSecretFile: cfg.ClientSecretFile,
 			ExpiryBuffer:     cfg.ExpiryBuffer,
+			ClientAssertion:  cfg.ClientAssertion,
+			TLSClientAuth:    cfg.TLSClientAuth,
 		},
 		logger: logger,
 		client: &http.Client{
@@ -0,0 +1,60 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package nomadobserver // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/observer/nomadobserver"
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	"go.opentelemetry.io/collector/config/confighttp"
+	"go.opentelemetry.io/collector/config/configopaque"
+)
+
+const (
+	defaultEndpoint        = "http://127.0.0.1:4646"
+	defaultNamespace       = "*"
+	defaultRefreshInterval = 30 * time.Second
+	defaultTimeout         = 10 * time.Second
+)
+
+// Config defines configuration for the Nomad observer.
+type Config struct {
+	confighttp.ClientConfig `mapstructure:",squash"`
+
+	// Token is the ACL token sent to the Nomad API. It requires the read-job
+	// capability on the observed namespaces.
+	Token configopaque.String `mapstructure:"token"`
+
+	// Namespace is the Nomad namespace allocations are listed from.
+	// Default: "*", meaning all namespaces.
+	Namespace string `mapstructure:"namespace"`
+
+	// NodeID restricts the observed allocations to the ones placed on the given
+	// Nomad client node. When the collector runs as a system job this is
+	// typically the `${node.unique.id}` of the node.
+	NodeID string `mapstructure:"node_id"`
+
+	// RefreshInterval determines the frequency at which the observer
+	// needs to poll for collecting new information about allocations.
+	// Default: "30s"
+	RefreshInterval time.Duration `mapstructure:"refresh_interval"`
+}
+
+func (c *Config) Validate() error {
+	if c.Endpoint == "" {
+		return errors.New("endpoint must be specified")
+	}
+	if _, err := url.Parse(c.Endpoint); err != nil {
+		return fmt.Errorf("failed to parse nomad endpoint %q: %w", c.Endpoint, err)
+	}
+	if c.RefreshInterval <= 0 {
+		return errors.New("refresh_interval must be positive")
+	}
+	if c.Timeout <= 0 {
+		return errors.New("timeout must be positive")
+	}
+	return nil
+}
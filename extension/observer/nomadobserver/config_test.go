@@ -0,0 +1,69 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package nomadobserver
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/confmap/confmaptest"
+	"go.opentelemetry.io/collector/confmap/xconfmap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/observer/nomadobserver/internal/metadata"
+)
+
+func TestLoadConfig(t *testing.T) {
+	t.Parallel()
+
+	allSettings := createDefaultConfig().(*Config)
+	allSettings.Endpoint = "https://nomad.example.com:4646"
+	allSettings.Token = "secret"
+	allSettings.Namespace = "apps"
+	allSettings.NodeID = "5d5a8c0e-4f0f-4b3a-9d2f-0b4b1f0c6f8a"
+	allSettings.RefreshInterval = 10 * time.Second
+	allSettings.Timeout = 5 * time.Second
+
+	tests := []struct {
+		id          component.ID
+		expected    component.Config
+		expectedErr string
+	}{
+		{
+			id:       component.NewID(metadata.Type),
+			expected: createDefaultConfig(),
+		},
+		{
+			id:       component.NewIDWithName(metadata.Type, "all-settings"),
+			expected: allSettings,
+		},
+		{
+			id:          component.NewIDWithName(metadata.Type, "invalid-refresh-interval"),
+			expectedErr: "refresh_interval must be positive",
+		},
+		{
+			id:          component.NewIDWithName(metadata.Type, "invalid-timeout"),
+			expectedErr: "timeout must be positive",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.id.String(), func(t *testing.T) {
+			cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
+			require.NoError(t, err)
+			cfg := NewFactory().CreateDefaultConfig()
+			sub, err := cm.Sub(tt.id.String())
+			require.NoError(t, err)
+			require.NoError(t, sub.Unmarshal(cfg))
+			if tt.expectedErr != "" {
+				assert.EqualError(t, xconfmap.Validate(cfg), tt.expectedErr)
+				return
+			}
+			assert.NoError(t, xconfmap.Validate(cfg))
+			assert.Equal(t, tt.expected, cfg)
+		})
+	}
+}
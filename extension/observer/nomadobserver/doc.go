@@ -0,0 +1,6 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:generate mdatagen metadata.yaml
+
+package nomadobserver // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/observer/nomadobserver"
@@ -0,0 +1,254 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package nomadobserver // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/observer/nomadobserver"
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"math"
+	"net"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/extension"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/observer"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/observer/endpointswatcher"
+)
+
+const (
+	runningStatus = "running"
+	tokenHeader   = "X-Nomad-Token"
+)
+
+var (
+	_ extension.Extension = (*nomadObserver)(nil)
+	_ observer.Observable = (*nomadObserver)(nil)
+)
+
+type nomadObserver struct {
+	*endpointswatcher.EndpointsWatcher
+	config    *Config
+	telemetry component.TelemetrySettings
+	client    *http.Client
+
+	mu sync.Mutex
+	// lastEndpoints are reported when the Nomad API can't be reached so that
+	// transient errors don't stop the receivers of running allocations.
+	lastEndpoints []observer.Endpoint
+}
+
+func (o *nomadObserver) Start(ctx context.Context, host component.Host) error {
+	client, err := o.config.ToClient(ctx, host, o.telemetry)
+	if err != nil {
+		return err
+	}
+	o.mu.Lock()
+	o.client = client
+	o.mu.Unlock()
+	return nil
+}
+
+func (o *nomadObserver) Shutdown(context.Context) error {
+	o.StopListAndWatch()
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	if o.client != nil {
+		o.client.CloseIdleConnections()
+	}
+	return nil
+}
+
+// ListEndpoints is invoked by an observer.EndpointsWatcher helper to report allocation port endpoints.
+// It's required to implement observer.EndpointsLister
+func (o *nomadObserver) ListEndpoints() []observer.Endpoint {
+	o.mu.Lock()
+	client := o.client
+	o.mu.Unlock()
+	if client == nil {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), o.config.Timeout)
+	defer cancel()
+	allocations, err := o.fetchAllocations(ctx, client)
+
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	if err != nil {
+		o.telemetry.Logger.Warn("error fetching nomad allocations", zap.Error(err))
+		return o.lastEndpoints
+	}
+	o.lastEndpoints = endpointsFromAllocations(allocations)
+	return o.lastEndpoints
+}
+
+func (o *nomadObserver) fetchAllocations(ctx context.Context, client *http.Client) ([]allocation, error) {
+	path := "/v1/allocations"
+	if o.config.NodeID != "" {
+		path = "/v1/node/" + url.PathEscape(o.config.NodeID) + "/allocations"
+	}
+	query := url.Values{}
+	query.Set("resources", "true")
+	query.Set("namespace", o.config.Namespace)
+	reqURL := strings.TrimSuffix(o.config.Endpoint, "/") + path + "?" + query.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, reqURL, http.NoBody)
+	if err != nil {
+		return nil, err
+	}
+	if o.config.Token != "" {
+		req.Header.Set(tokenHeader, string(o.config.Token))
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code %d listing allocations", resp.StatusCode)
+	}
+
+	var allocations []allocation
+	if err := json.NewDecoder(resp.Body).Decode(&allocations); err != nil {
+		return nil, fmt.Errorf("failed to decode allocations: %w", err)
+	}
+	return allocations, nil
+}
+
+// endpointsFromAllocations returns an observer Endpoint for each port of the
+// running allocations. We only need to report running ones since state is
+// maintained by our EndpointsWatcher.
+func endpointsFromAllocations(allocations []allocation) []observer.Endpoint {
+	var endpoints []observer.Endpoint
+	for _, alloc := range allocations {
+		if alloc.ClientStatus != runningStatus || alloc.AllocatedResources == nil {
+			continue
+		}
+		for _, p := range alloc.AllocatedResources.ports() {
+			if p.host == "" || !validPort(p.value) {
+				continue
+			}
+			// The port is mapped to the same port in the allocation when To
+			// isn't set, or set to -1 for the host network mode.
+			alternatePort := p.value
+			if validPort(p.to) {
+				alternatePort = p.to
+			}
+			endpoints = append(endpoints, observer.Endpoint{
+				ID:     observer.EndpointID(fmt.Sprintf("%s/%s", alloc.ID, p.label)),
+				Target: net.JoinHostPort(p.host, strconv.Itoa(p.value)),
+				Details: &observer.Container{
+					Name:          alloc.JobID,
+					ContainerID:   alloc.ID,
+					Host:          p.host,
+					Port:          uint16(p.value),
+					AlternatePort: uint16(alternatePort),
+					Transport:     observer.ProtocolTCP,
+					Labels: map[string]string{
+						"nomad.namespace":  alloc.Namespace,
+						"nomad.job":        alloc.JobID,
+						"nomad.task_group": alloc.TaskGroup,
+						"nomad.alloc_name": alloc.Name,
+						"nomad.node_name":  alloc.NodeName,
+						"nomad.port_label": p.label,
+					},
+				},
+			})
+		}
+	}
+	return endpoints
+}
+
+func validPort(port int) bool {
+	return port > 0 && port <= math.MaxUint16
+}
+
+// allocation holds the fields of the Nomad allocations API used by the observer,
+// see https://developer.hashicorp.com/nomad/api-docs/allocations.
+type allocation struct {
+	ID                 string
+	Name               string
+	Namespace          string
+	NodeName           string
+	JobID              string
+	TaskGroup          string
+	ClientStatus       string
+	AllocatedResources *allocatedResources
+}
+
+type allocatedResources struct {
+	Shared struct {
+		Ports    []portMapping
+		Networks []network
+	}
+	Tasks map[string]struct {
+		Networks []network
+	}
+}
+
+// portMapping is a port of a group network, with its mapping to the host.
+type portMapping struct {
+	Label  string
+	Value  int
+	To     int
+	HostIP string
+}
+
+// network is a group or task network with its reserved and dynamic ports.
+type network struct {
+	IP            string
+	ReservedPorts []networkPort
+	DynamicPorts  []networkPort
+}
+
+type networkPort struct {
+	Label string
+	Value int
+	To    int
+}
+
+type allocPort struct {
+	label string
+	host  string
+	value int
+	to    int
+}
+
+// ports returns the ports of the group network, falling back to the ports of
+// the networks for the allocations that don't report them.
+func (r *allocatedResources) ports() []allocPort {
+	seen := map[string]bool{}
+	var ports []allocPort
+	add := func(p allocPort) {
+		if seen[p.label] {
+			return
+		}
+		seen[p.label] = true
+		ports = append(ports, p)
+	}
+
+	for _, p := range r.Shared.Ports {
+		add(allocPort{label: p.Label, host: p.HostIP, value: p.Value, to: p.To})
+	}
+	networks := slices.Clone(r.Shared.Networks)
+	for _, name := range slices.Sorted(maps.Keys(r.Tasks)) {
+		networks = append(networks, r.Tasks[name].Networks...)
+	}
+	for _, n := range networks {
+		for _, p := range slices.Concat(n.ReservedPorts, n.DynamicPorts) {
+			add(allocPort{label: p.Label, host: n.IP, value: p.Value, to: p.To})
+		}
+	}
+	return ports
+}
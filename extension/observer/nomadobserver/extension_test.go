@@ -0,0 +1,206 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package nomadobserver
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/extension/extensiontest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/observer"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/observer/nomadobserver/internal/metadata"
+)
+
+const allocationsResponse = `[
+  {
+    "ID": "alloc-1",
+    "Name": "web.app[0]",
+    "Namespace": "default",
+    "NodeName": "node-1",
+    "JobID": "web",
+    "TaskGroup": "app",
+    "ClientStatus": "running",
+    "AllocatedResources": {
+      "Shared": {
+        "Ports": [
+          {"Label": "http", "Value": 23456, "To": 8080, "HostIP": "10.0.0.1"},
+          {"Label": "metrics", "Value": 9100, "To": -1, "HostIP": "10.0.0.1"}
+        ]
+      }
+    }
+  },
+  {
+    "ID": "alloc-2",
+    "Name": "legacy.app[0]",
+    "Namespace": "default",
+    "NodeName": "node-1",
+    "JobID": "legacy",
+    "TaskGroup": "app",
+    "ClientStatus": "running",
+    "AllocatedResources": {
+      "Tasks": {
+        "redis": {
+          "Networks": [
+            {"IP": "10.0.0.1", "DynamicPorts": [{"Label": "db", "Value": 26379}]}
+          ]
+        }
+      }
+    }
+  },
+  {
+    "ID": "alloc-3",
+    "JobID": "done",
+    "ClientStatus": "complete",
+    "AllocatedResources": {
+      "Shared": {
+        "Ports": [{"Label": "http", "Value": 23457, "HostIP": "10.0.0.1"}]
+      }
+    }
+  }
+]`
+
+func newTestObserver(t *testing.T, handler http.HandlerFunc, configure func(*Config)) *nomadObserver {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.Endpoint = server.URL
+	if configure != nil {
+		configure(cfg)
+	}
+	ext, err := createExtension(context.Background(), extensiontest.NewNopSettings(metadata.Type), cfg)
+	require.NoError(t, err)
+	o := ext.(*nomadObserver)
+	require.NoError(t, o.Start(context.Background(), componenttest.NewNopHost()))
+	t.Cleanup(func() { assert.NoError(t, o.Shutdown(context.Background())) })
+	return o
+}
+
+func TestListEndpoints(t *testing.T) {
+	requests := make(chan *http.Request, 1)
+	o := newTestObserver(t, func(w http.ResponseWriter, r *http.Request) {
+		requests <- r.Clone(context.Background())
+		_, _ = w.Write([]byte(allocationsResponse))
+	}, func(cfg *Config) {
+		cfg.Token = "secret"
+	})
+
+	endpoints := o.ListEndpoints()
+	req := <-requests
+	assert.Equal(t, "/v1/allocations", req.URL.Path)
+	assert.Equal(t, "*", req.URL.Query().Get("namespace"))
+	assert.Equal(t, "true", req.URL.Query().Get("resources"))
+	assert.Equal(t, "secret", req.Header.Get(tokenHeader))
+
+	assert.Equal(t, []observer.Endpoint{
+		{
+			ID:     "alloc-1/http",
+			Target: "10.0.0.1:23456",
+			Details: &observer.Container{
+				Name:          "web",
+				ContainerID:   "alloc-1",
+				Host:          "10.0.0.1",
+				Port:          23456,
+				AlternatePort: 8080,
+				Transport:     observer.ProtocolTCP,
+				Labels: map[string]string{
+					"nomad.namespace":  "default",
+					"nomad.job":        "web",
+					"nomad.task_group": "app",
+					"nomad.alloc_name": "web.app[0]",
+					"nomad.node_name":  "node-1",
+					"nomad.port_label": "http",
+				},
+			},
+		},
+		{
+			ID:     "alloc-1/metrics",
+			Target: "10.0.0.1:9100",
+			Details: &observer.Container{
+				Name:          "web",
+				ContainerID:   "alloc-1",
+				Host:          "10.0.0.1",
+				Port:          9100,
+				AlternatePort: 9100,
+				Transport:     observer.ProtocolTCP,
+				Labels: map[string]string{
+					"nomad.namespace":  "default",
+					"nomad.job":        "web",
+					"nomad.task_group": "app",
+					"nomad.alloc_name": "web.app[0]",
+					"nomad.node_name":  "node-1",
+					"nomad.port_label": "metrics",
+				},
+			},
+		},
+		{
+			ID:     "alloc-2/db",
+			Target: "10.0.0.1:26379",
+			Details: &observer.Container{
+				Name:          "legacy",
+				ContainerID:   "alloc-2",
+				Host:          "10.0.0.1",
+				Port:          26379,
+				AlternatePort: 26379,
+				Transport:     observer.ProtocolTCP,
+				Labels: map[string]string{
+					"nomad.namespace":  "default",
+					"nomad.job":        "legacy",
+					"nomad.task_group": "app",
+					"nomad.alloc_name": "legacy.app[0]",
+					"nomad.node_name":  "node-1",
+					"nomad.port_label": "db",
+				},
+			},
+		},
+	}, endpoints)
+}
+
+func TestListEndpointsNode(t *testing.T) {
+	paths := make(chan string, 1)
+	o := newTestObserver(t, func(w http.ResponseWriter, r *http.Request) {
+		paths <- r.URL.Path
+		_, _ = w.Write([]byte("[]"))
+	}, func(cfg *Config) {
+		cfg.NodeID = "node-id"
+	})
+
+	assert.Empty(t, o.ListEndpoints())
+	assert.Equal(t, "/v1/node/node-id/allocations", <-paths)
+}
+
+func TestListEndpointsKeepsLastOnError(t *testing.T) {
+	var fail atomic.Bool
+	o := newTestObserver(t, func(w http.ResponseWriter, _ *http.Request) {
+		if fail.Load() {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		_, _ = w.Write([]byte(allocationsResponse))
+	}, nil)
+
+	endpoints := o.ListEndpoints()
+	require.Len(t, endpoints, 3)
+
+	fail.Store(true)
+	assert.Equal(t, endpoints, o.ListEndpoints())
+}
+
+func TestListEndpointsTimeout(t *testing.T) {
+	o := newTestObserver(t, func(_ http.ResponseWriter, r *http.Request) {
+		<-r.Context().Done()
+	}, func(cfg *Config) {
+		cfg.Timeout = 10 * time.Millisecond
+	})
+
+	assert.Empty(t, o.ListEndpoints())
+}
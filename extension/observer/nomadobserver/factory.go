@@ -0,0 +1,50 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package nomadobserver // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/observer/nomadobserver"
+
+import (
+	"context"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config/confighttp"
+	"go.opentelemetry.io/collector/extension"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/observer/endpointswatcher"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/observer/nomadobserver/internal/metadata"
+)
+
+// NewFactory creates a factory for the Nomad observer extension.
+func NewFactory() extension.Factory {
+	return extension.NewFactory(
+		metadata.Type,
+		createDefaultConfig,
+		createExtension,
+		metadata.ExtensionStability,
+	)
+}
+
+func createDefaultConfig() component.Config {
+	clientConfig := confighttp.NewDefaultClientConfig()
+	clientConfig.Endpoint = defaultEndpoint
+	clientConfig.Timeout = defaultTimeout
+	return &Config{
+		ClientConfig:    clientConfig,
+		Namespace:       defaultNamespace,
+		RefreshInterval: defaultRefreshInterval,
+	}
+}
+
+func createExtension(
+	_ context.Context,
+	params extension.Settings,
+	cfg component.Config,
+) (extension.Extension, error) {
+	obsCfg := cfg.(*Config)
+	o := &nomadObserver{
+		config:    obsCfg,
+		telemetry: params.TelemetrySettings,
+	}
+	o.EndpointsWatcher = endpointswatcher.New(o, obsCfg.RefreshInterval, params.Logger)
+	return o, nil
+}
@@ -0,0 +1,22 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package nomadobserver
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/extension/extensiontest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/observer/endpointswatcher"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/observer/nomadobserver/internal/metadata"
+)
+
+func TestFactoryCreatedExtensionIsEndpointsLister(t *testing.T) {
+	factory := NewFactory()
+	ext, err := factory.Create(context.Background(), extensiontest.NewNopSettings(metadata.Type), factory.CreateDefaultConfig())
+	require.NoError(t, err)
+	require.Implements(t, (*endpointswatcher.EndpointsLister)(nil), ext)
+}
@@ -25,6 +25,18 @@ type AssumeRole struct {
 	SessionName          string `mapstructure:"session_name,omitempty"`
 	STSRegion            string `mapstructure:"sts_region,omitempty"`
 	WebIdentityTokenFile string `mapstructure:"web_identity_token_file,omitempty"`
+	// ExternalID is the external ID required by the trust policy of the role, if any
+	ExternalID string `mapstructure:"external_id,omitempty"`
+	// Chain holds the roles assumed in order after the role above, each one
+	// with the credentials of the previous one
+	Chain []ChainedRole `mapstructure:"chain,omitempty"`
+}
+
+// ChainedRole holds the configuration needed to assume a role with the credentials of another role
+type ChainedRole struct {
+	ARN         string `mapstructure:"arn,omitempty"`
+	SessionName string `mapstructure:"session_name,omitempty"`
+	ExternalID  string `mapstructure:"external_id,omitempty"`
 }
 
 // compile time check that the Config struct satisfies the component.Config interface
@@ -38,14 +50,26 @@ func (cfg *Config) Validate() error {
 		cfg.AssumeRole.STSRegion = cfg.Region
 	}
 
+	for i, role := range cfg.AssumeRole.Chain {
+		if role.ARN == "" {
+			return fmt.Errorf("must specify ARN of chained role %d", i)
+		}
+	}
+
 	var credsProvider *aws.CredentialsProvider
 	var err error
 	if cfg.AssumeRole.WebIdentityTokenFile != "" {
 		if cfg.AssumeRole.ARN == "" {
 			return errors.New("must specify ARN when using WebIdentityTokenFile")
 		}
+		if cfg.AssumeRole.ExternalID != "" {
+			return errors.New("ExternalID cannot be used with WebIdentityTokenFile, use a chained role instead")
+		}
 		credsProvider, err = getCredsProviderFromWebIdentityConfig(cfg)
 	} else {
+		if cfg.AssumeRole.ExternalID != "" && cfg.AssumeRole.ARN == "" {
+			return errors.New("must specify ARN when using ExternalID")
+		}
 		credsProvider, err = getCredsProviderFromConfig(cfg)
 	}
 	if err != nil {
@@ -77,3 +77,71 @@ func TestLoadConfigError(t *testing.T) {
 	require.NoError(t, sub.Unmarshal(cfg))
 	assert.Error(t, xconfmap.Validate(cfg))
 }
+
+func TestValidateAssumeRoleErrors(t *testing.T) {
+	tests := []struct {
+		name         string
+		assumeRole   AssumeRole
+		errorMessage string
+	}{
+		{
+			name:         "external_id_without_arn",
+			assumeRole:   AssumeRole{ExternalID: "external_id"},
+			errorMessage: "must specify ARN when using ExternalID",
+		},
+		{
+			name: "external_id_with_web_identity",
+			assumeRole: AssumeRole{
+				ARN:                  "arn:aws:iam::123456789012:role/my_role",
+				WebIdentityTokenFile: "testdata/token_file",
+				ExternalID:           "external_id",
+			},
+			errorMessage: "ExternalID cannot be used with WebIdentityTokenFile, use a chained role instead",
+		},
+		{
+			name: "chained_role_without_arn",
+			assumeRole: AssumeRole{
+				ARN:                  "arn:aws:iam::123456789012:role/my_role",
+				WebIdentityTokenFile: "testdata/token_file",
+				Chain:                []ChainedRole{{ARN: "arn:aws:iam::210987654321:role/other_role"}, {ExternalID: "external_id"}},
+			},
+			errorMessage: "must specify ARN of chained role 1",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &Config{Region: "region", Service: "service", AssumeRole: tt.assumeRole}
+			assert.EqualError(t, cfg.Validate(), tt.errorMessage)
+		})
+	}
+}
+
+func TestLoadWebIdentityChainConfig(t *testing.T) {
+	cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
+	require.NoError(t, err)
+	factory := NewFactory()
+	cfg := factory.CreateDefaultConfig()
+	sub, err := cm.Sub(component.NewIDWithName(metadata.Type, "web_identity_chain").String())
+	require.NoError(t, err)
+	require.NoError(t, sub.Unmarshal(cfg))
+
+	assert.NoError(t, xconfmap.Validate(cfg))
+	assert.Equal(t, &Config{
+		Region:  "region",
+		Service: "service",
+		AssumeRole: AssumeRole{
+			ARN:                  "arn:aws:iam::12345678910:role/my_role",
+			SessionName:          "collector",
+			WebIdentityTokenFile: "testdata/token_file",
+			STSRegion:            "region",
+			Chain: []ChainedRole{
+				{
+					ARN:         "arn:aws:iam::10987654321:role/other_account_role",
+					SessionName: "collector",
+					ExternalID:  "external_id",
+				},
+			},
+		},
+		credsProvider: cfg.(*Config).credsProvider,
+	}, cfg)
+}
@@ -75,11 +75,13 @@ func getCredsProviderFromConfig(cfg *Config) (*aws.CredentialsProvider, error) {
 		return nil, err
 	}
 	if cfg.AssumeRole.ARN != "" {
-		stsSvc := sts.NewFromConfig(awscfg)
-
-		provider := stscreds.NewAssumeRoleProvider(stsSvc, cfg.AssumeRole.ARN)
-		awscfg.Credentials = aws.NewCredentialsCache(provider)
+		awscfg = assumeRole(awscfg, ChainedRole{
+			ARN:         cfg.AssumeRole.ARN,
+			SessionName: cfg.AssumeRole.SessionName,
+			ExternalID:  cfg.AssumeRole.ExternalID,
+		})
 	}
+	awscfg = chainRoles(awscfg, cfg.AssumeRole.Chain)
 
 	_, err = awscfg.Credentials.Retrieve(context.Background())
 	if err != nil {
@@ -112,8 +114,41 @@ func getCredsProviderFromWebIdentityConfig(cfg *Config) (*aws.CredentialsProvide
 	}
 	stsSvc := sts.NewFromConfig(awscfg)
 
-	provider := stscreds.NewWebIdentityRoleProvider(stsSvc, cfg.AssumeRole.ARN, tokenRetriever)
+	provider := stscreds.NewWebIdentityRoleProvider(stsSvc, cfg.AssumeRole.ARN, tokenRetriever,
+		func(options *stscreds.WebIdentityRoleOptions) {
+			if cfg.AssumeRole.SessionName != "" {
+				options.RoleSessionName = cfg.AssumeRole.SessionName
+			}
+		},
+	)
 	awscfg.Credentials = aws.NewCredentialsCache(provider)
+	awscfg = chainRoles(awscfg, cfg.AssumeRole.Chain)
 
 	return &awscfg.Credentials, nil
 }
+
+// assumeRole() returns a copy of awscfg whose credentials are the ones of the
+// given role, assumed with the credentials of awscfg.
+func assumeRole(awscfg aws.Config, role ChainedRole) aws.Config {
+	stsSvc := sts.NewFromConfig(awscfg)
+
+	provider := stscreds.NewAssumeRoleProvider(stsSvc, role.ARN, func(options *stscreds.AssumeRoleOptions) {
+		if role.SessionName != "" {
+			options.RoleSessionName = role.SessionName
+		}
+		if role.ExternalID != "" {
+			options.ExternalID = aws.String(role.ExternalID)
+		}
+	})
+	awscfg.Credentials = aws.NewCredentialsCache(provider)
+	return awscfg
+}
+
+// chainRoles() assumes the given roles in order, each one with the credentials
+// of the previous one, starting with the credentials of awscfg.
+func chainRoles(awscfg aws.Config, roles []ChainedRole) aws.Config {
+	for _, role := range roles {
+		awscfg = assumeRole(awscfg, role)
+	}
+	return awscfg
+}
@@ -5,7 +5,11 @@ package sigv4authextension
 
 import (
 	"context"
+	"fmt"
 	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
 	"testing"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -130,6 +134,53 @@ func TestGetCredsProviderFromWebIdentityConfig(t *testing.T) {
 	}
 }
 
+func TestChainRoles(t *testing.T) {
+	var mu sync.Mutex
+	var requests []url.Values
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.NoError(t, r.ParseForm())
+		mu.Lock()
+		requests = append(requests, r.PostForm)
+		n := len(requests)
+		mu.Unlock()
+		w.Header().Set("Content-Type", "text/xml")
+		fmt.Fprintf(w, `<AssumeRoleResponse xmlns="https://sts.amazonaws.com/doc/2011-06-15/">
+  <AssumeRoleResult>
+    <Credentials>
+      <AccessKeyId>AccessKeyID%d</AccessKeyId>
+      <SecretAccessKey>SecretAccessKey</SecretAccessKey>
+      <SessionToken>SessionToken</SessionToken>
+      <Expiration>2100-01-01T00:00:00Z</Expiration>
+    </Credentials>
+  </AssumeRoleResult>
+  <ResponseMetadata><RequestId>request</RequestId></ResponseMetadata>
+</AssumeRoleResponse>`, n)
+	}))
+	defer server.Close()
+
+	awscfg := aws.Config{
+		Region:       "us-east-1",
+		Credentials:  credentials.NewStaticCredentialsProvider("AccessKeyID", "SecretAccessKey", ""),
+		BaseEndpoint: aws.String(server.URL),
+	}
+	awscfg = chainRoles(awscfg, []ChainedRole{
+		{ARN: "arn:aws:iam::123456789012:role/first", SessionName: "first_session"},
+		{ARN: "arn:aws:iam::210987654321:role/second", ExternalID: "external_id"},
+	})
+
+	creds, err := awscfg.Credentials.Retrieve(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "AccessKeyID2", creds.AccessKeyID)
+
+	require.Len(t, requests, 2)
+	assert.Equal(t, "AssumeRole", requests[0].Get("Action"))
+	assert.Equal(t, "arn:aws:iam::123456789012:role/first", requests[0].Get("RoleArn"))
+	assert.Equal(t, "first_session", requests[0].Get("RoleSessionName"))
+	assert.Empty(t, requests[0].Get("ExternalId"))
+	assert.Equal(t, "arn:aws:iam::210987654321:role/second", requests[1].Get("RoleArn"))
+	assert.Equal(t, "external_id", requests[1].Get("ExternalId"))
+}
+
 func TestCloneRequest(t *testing.T) {
 	req1, err := http.NewRequest(http.MethodGet, "https://example.com", http.NoBody)
 	assert.NoError(t, err)
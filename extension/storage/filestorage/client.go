@@ -5,6 +5,7 @@ package filestorage // import "github.com/open-telemetry/opentelemetry-collector
 
 import (
 	"context"
+	"crypto/cipher"
 	"errors"
 	"fmt"
 	"os"
@@ -37,6 +38,8 @@ type fileStorageClient struct {
 	openTimeout     time.Duration
 	cancel          context.CancelFunc
 	closed          bool
+	// aead encrypts the stored values when encryption is enabled
+	aead cipher.AEAD
 }
 
 func bboltOptions(timeout time.Duration, noSync bool) *bbolt.Options {
@@ -106,7 +109,10 @@ func (c *fileStorageClient) Batch(_ context.Context, ops ...*storage.Operation)
 			switch op.Type {
 			case storage.Get:
 				value := bucket.Get([]byte(op.Key))
-				if value != nil {
+				if value != nil && c.aead != nil {
+					// decryption allocates a new slice, no need to copy the value
+					op.Value, err = decryptValue(c.aead, op.Key, value)
+				} else if value != nil {
 					// the output of Bucket.Get is only valid within a transaction, so we need to make a copy
 					// to be able to return the value
 					op.Value = make([]byte, len(value))
@@ -115,7 +121,13 @@ func (c *fileStorageClient) Batch(_ context.Context, ops ...*storage.Operation)
 					op.Value = nil
 				}
 			case storage.Set:
-				err = bucket.Put([]byte(op.Key), op.Value)
+				value := op.Value
+				if c.aead != nil {
+					if value, err = encryptValue(c.aead, op.Key, op.Value); err != nil {
+						return err
+					}
+				}
+				err = bucket.Put([]byte(op.Key), value)
 			case storage.Delete:
 				err = bucket.Delete([]byte(op.Key))
 			default:
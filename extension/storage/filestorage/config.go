@@ -13,8 +13,10 @@ import (
 )
 
 var (
-	errInvalidOctal          = errors.New("directory_permissions value must be a valid octal representation")
-	errInvalidPermissionBits = errors.New("directory_permissions contain invalid bits for file access")
+	errInvalidOctal           = errors.New("directory_permissions value must be a valid octal representation")
+	errInvalidPermissionBits  = errors.New("directory_permissions contain invalid bits for file access")
+	errMissingEncryptionKey   = errors.New("encryption requires either key_file or key_env to be set")
+	errMultipleEncryptionKeys = errors.New("only one of encryption key_file and key_env can be set")
 )
 
 // Config defines configuration for file storage extension.
@@ -33,6 +35,18 @@ type Config struct {
 	directoryPermissionsParsed int64  `mapstructure:"-,omitempty"`
 
 	Recreate bool `mapstructure:"recreate,omitempty"`
+
+	// Encryption enables the encryption at rest of the stored values
+	Encryption *EncryptionConfig `mapstructure:"encryption,omitempty"`
+}
+
+// EncryptionConfig defines configuration for the optional AES-GCM encryption of the stored values.
+// The key must be base64 encoded and decode to 16, 24 or 32 bytes, selecting AES-128, AES-192 or AES-256.
+type EncryptionConfig struct {
+	// KeyFile is the path of a file containing the encryption key
+	KeyFile string `mapstructure:"key_file,omitempty"`
+	// KeyEnv is the name of an environment variable containing the encryption key
+	KeyEnv string `mapstructure:"key_env,omitempty"`
 }
 
 // CompactionConfig defines configuration for optional file storage compaction.
@@ -104,5 +118,14 @@ func (cfg *Config) Validate() error {
 		cfg.directoryPermissionsParsed = permissions
 	}
 
+	if cfg.Encryption != nil {
+		if cfg.Encryption.KeyFile == "" && cfg.Encryption.KeyEnv == "" {
+			return errMissingEncryptionKey
+		}
+		if cfg.Encryption.KeyFile != "" && cfg.Encryption.KeyEnv != "" {
+			return errMultipleEncryptionKeys
+		}
+	}
+
 	return nil
 }
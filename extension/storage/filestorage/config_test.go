@@ -272,3 +272,38 @@ func TestCompactionDirectory(t *testing.T) {
 		})
 	}
 }
+
+func TestEncryptionConfig(t *testing.T) {
+	tests := []struct {
+		name       string
+		encryption *EncryptionConfig
+		err        error
+	}{
+		{
+			name:       "key file",
+			encryption: &EncryptionConfig{KeyFile: "key"},
+		},
+		{
+			name:       "key env",
+			encryption: &EncryptionConfig{KeyEnv: "FILE_STORAGE_KEY"},
+		},
+		{
+			name:       "missing key",
+			encryption: &EncryptionConfig{},
+			err:        errMissingEncryptionKey,
+		},
+		{
+			name:       "multiple keys",
+			encryption: &EncryptionConfig{KeyFile: "key", KeyEnv: "FILE_STORAGE_KEY"},
+			err:        errMultipleEncryptionKeys,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := NewFactory().CreateDefaultConfig().(*Config)
+			cfg.Directory = t.TempDir()
+			cfg.Encryption = tt.encryption
+			require.ErrorIs(t, cfg.Validate(), tt.err)
+		})
+	}
+}
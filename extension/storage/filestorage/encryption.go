@@ -0,0 +1,77 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package filestorage // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/storage/filestorage"
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+)
+
+var errDecrypt = errors.New("failed to decrypt stored value, the encryption key may have changed")
+
+// loadEncryptionKey reads the base64 encoded AES key from the configured file or environment variable.
+func loadEncryptionKey(cfg *EncryptionConfig) ([]byte, error) {
+	var encoded string
+	if cfg.KeyFile != "" {
+		content, err := os.ReadFile(cfg.KeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read encryption key file: %w", err)
+		}
+		encoded = string(content)
+	} else {
+		var ok bool
+		encoded, ok = os.LookupEnv(cfg.KeyEnv)
+		if !ok {
+			return nil, fmt.Errorf("encryption key environment variable %q is not set", cfg.KeyEnv)
+		}
+	}
+
+	key, err := base64.StdEncoding.DecodeString(strings.TrimSpace(encoded))
+	if err != nil {
+		return nil, fmt.Errorf("encryption key must be base64 encoded: %w", err)
+	}
+	return key, nil
+}
+
+// newAEAD creates the AES-GCM cipher used to encrypt the stored values.
+func newAEAD(cfg *EncryptionConfig) (cipher.AEAD, error) {
+	key, err := loadEncryptionKey(cfg)
+	if err != nil {
+		return nil, err
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("invalid encryption key, it must be 16, 24 or 32 bytes long: %w", err)
+	}
+	return cipher.NewGCM(block)
+}
+
+// encryptValue seals value with a random nonce, which is prepended to the result.
+// The storage key is used as additional data so that values cannot be swapped between keys.
+func encryptValue(aead cipher.AEAD, key string, value []byte) ([]byte, error) {
+	nonce := make([]byte, aead.NonceSize(), aead.NonceSize()+len(value)+aead.Overhead())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	return aead.Seal(nonce, nonce, value, []byte(key)), nil
+}
+
+// decryptValue opens a value sealed by encryptValue.
+func decryptValue(aead cipher.AEAD, key string, sealed []byte) ([]byte, error) {
+	if len(sealed) < aead.NonceSize() {
+		return nil, errDecrypt
+	}
+	nonce, ciphertext := sealed[:aead.NonceSize()], sealed[aead.NonceSize():]
+	value, err := aead.Open(nil, nonce, ciphertext, []byte(key))
+	if err != nil {
+		return nil, errDecrypt
+	}
+	return value, nil
+}
@@ -0,0 +1,115 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package filestorage
+
+import (
+	"bytes"
+	"context"
+	"crypto/cipher"
+	"encoding/base64"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/extension/extensiontest"
+	"go.opentelemetry.io/collector/extension/xextension/storage"
+)
+
+const testEncryptionKeyEnv = "FILE_STORAGE_TEST_ENCRYPTION_KEY"
+
+func newTestEncryptedExtension(t *testing.T, dir string, key []byte) storage.Extension {
+	t.Setenv(testEncryptionKeyEnv, base64.StdEncoding.EncodeToString(key))
+
+	f := NewFactory()
+	cfg := f.CreateDefaultConfig().(*Config)
+	cfg.Directory = dir
+	cfg.Encryption = &EncryptionConfig{KeyEnv: testEncryptionKeyEnv}
+
+	extension, err := f.Create(context.Background(), extensiontest.NewNopSettings(f.Type()), cfg)
+	require.NoError(t, err)
+	return extension.(storage.Extension)
+}
+
+func TestEncryptedClient(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	key := bytes.Repeat([]byte{1}, 32)
+	value := []byte("sensitive telemetry")
+
+	se := newTestEncryptedExtension(t, dir, key)
+	client, err := se.GetClient(ctx, component.KindExporter, newTestEntity("queue"), "")
+	require.NoError(t, err)
+	require.NoError(t, client.Set(ctx, "key", value))
+
+	data, err := client.Get(ctx, "key")
+	require.NoError(t, err)
+	assert.Equal(t, value, data)
+
+	data, err = client.Get(ctx, "missing")
+	require.NoError(t, err)
+	assert.Nil(t, data)
+	require.NoError(t, client.Close(ctx))
+
+	// The value is not written in clear text to the database file
+	raw, err := os.ReadFile(filepath.Join(dir, "exporter_nop_queue"))
+	require.NoError(t, err)
+	assert.False(t, bytes.Contains(raw, value))
+
+	// The value cannot be read back with another key
+	se = newTestEncryptedExtension(t, dir, bytes.Repeat([]byte{2}, 32))
+	client, err = se.GetClient(ctx, component.KindExporter, newTestEntity("queue"), "")
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, client.Close(ctx))
+	})
+	_, err = client.Get(ctx, "key")
+	assert.ErrorIs(t, err, errDecrypt)
+}
+
+func TestEncryptedValueBoundToKey(t *testing.T) {
+	aead, err := newAEADFromKey(t, bytes.Repeat([]byte{1}, 16))
+	require.NoError(t, err)
+
+	sealed, err := encryptValue(aead, "a", []byte("value"))
+	require.NoError(t, err)
+
+	value, err := decryptValue(aead, "a", sealed)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("value"), value)
+
+	_, err = decryptValue(aead, "b", sealed)
+	assert.ErrorIs(t, err, errDecrypt)
+
+	_, err = decryptValue(aead, "a", sealed[:4])
+	assert.ErrorIs(t, err, errDecrypt)
+}
+
+func TestLoadEncryptionKey(t *testing.T) {
+	keyFile := filepath.Join(t.TempDir(), "key")
+	require.NoError(t, os.WriteFile(keyFile, []byte(base64.StdEncoding.EncodeToString(bytes.Repeat([]byte{1}, 24))+"\n"), 0o600))
+	_, err := newAEAD(&EncryptionConfig{KeyFile: keyFile})
+	require.NoError(t, err)
+
+	_, err = newAEAD(&EncryptionConfig{KeyFile: filepath.Join(t.TempDir(), "missing")})
+	assert.ErrorContains(t, err, "failed to read encryption key file")
+
+	t.Setenv(testEncryptionKeyEnv, "not base64!")
+	_, err = newAEAD(&EncryptionConfig{KeyEnv: testEncryptionKeyEnv})
+	assert.ErrorContains(t, err, "encryption key must be base64 encoded")
+
+	t.Setenv(testEncryptionKeyEnv, base64.StdEncoding.EncodeToString([]byte("short")))
+	_, err = newAEAD(&EncryptionConfig{KeyEnv: testEncryptionKeyEnv})
+	assert.ErrorContains(t, err, "invalid encryption key")
+
+	_, err = newAEAD(&EncryptionConfig{KeyEnv: "FILE_STORAGE_TEST_UNSET_KEY"})
+	assert.ErrorContains(t, err, "is not set")
+}
+
+func newAEADFromKey(t *testing.T, key []byte) (cipher.AEAD, error) {
+	t.Setenv(testEncryptionKeyEnv, base64.StdEncoding.EncodeToString(key))
+	return newAEAD(&EncryptionConfig{KeyEnv: testEncryptionKeyEnv})
+}
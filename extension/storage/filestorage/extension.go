@@ -5,6 +5,7 @@ package filestorage // import "github.com/open-telemetry/opentelemetry-collector
 
 import (
 	"context"
+	"crypto/cipher"
 	"errors"
 	"fmt"
 	"os"
@@ -20,6 +21,7 @@ import (
 type localFileStorage struct {
 	cfg    *Config
 	logger *zap.Logger
+	aead   cipher.AEAD
 }
 
 // Ensure this storage extension implements the appropriate interface
@@ -39,10 +41,18 @@ func newLocalFileStorage(logger *zap.Logger, config *Config) (extension.Extensio
 			}
 		}
 	}
-	return &localFileStorage{
+	lfs := &localFileStorage{
 		cfg:    config,
 		logger: logger,
-	}, nil
+	}
+	if config.Encryption != nil {
+		aead, err := newAEAD(config.Encryption)
+		if err != nil {
+			return nil, err
+		}
+		lfs.aead = aead
+	}
+	return lfs, nil
 }
 
 // Start runs cleanup if configured
@@ -80,6 +90,7 @@ func (lfs *localFileStorage) GetClient(_ context.Context, kind component.Kind, e
 	if err != nil {
 		return nil, err
 	}
+	client.aead = lfs.aead
 
 	// return if compaction is not required
 	if lfs.cfg.Compaction.OnStart {
@@ -4,12 +4,26 @@
 package redisstorageextension // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/storage/redisstorageextension"
 
 import (
+	"errors"
+	"fmt"
 	"time"
 
 	"go.opentelemetry.io/collector/config/configopaque"
 	"go.opentelemetry.io/collector/config/configtls"
 )
 
+// Mode is the type of Redis deployment the extension connects to.
+type Mode string
+
+const (
+	// ModeStandalone connects to a single Redis instance.
+	ModeStandalone Mode = "standalone"
+	// ModeSentinel connects to the master of a Redis Sentinel deployment, following failovers.
+	ModeSentinel Mode = "sentinel"
+	// ModeCluster connects to a Redis Cluster.
+	ModeCluster Mode = "cluster"
+)
+
 // Config defines configuration for the Redis storage extension.
 type Config struct {
 	Endpoint   string                 `mapstructure:"endpoint"`
@@ -18,4 +32,39 @@ type Config struct {
 	Expiration time.Duration          `mapstructure:"expiration"`
 	Prefix     string                 `mapstructure:"prefix"`
 	TLS        configtls.ClientConfig `mapstructure:"tls,omitempty"`
+
+	// Mode is the type of Redis deployment: standalone, sentinel or cluster.
+	Mode Mode `mapstructure:"mode"`
+	// Endpoints are the addresses of the sentinels in sentinel mode, or of the seed nodes in cluster mode.
+	Endpoints []string `mapstructure:"endpoints"`
+	// MasterName is the name of the master monitored by the sentinels in sentinel mode.
+	MasterName string `mapstructure:"master_name"`
+	// SentinelPassword is the password used to authenticate to the sentinels in sentinel mode.
+	SentinelPassword configopaque.String `mapstructure:"sentinel_password"`
+}
+
+func (cfg *Config) Validate() error {
+	switch cfg.Mode {
+	case ModeStandalone:
+		if cfg.Endpoint == "" {
+			return errors.New("endpoint is required in standalone mode")
+		}
+	case ModeSentinel:
+		if len(cfg.Endpoints) == 0 {
+			return errors.New("endpoints are required in sentinel mode")
+		}
+		if cfg.MasterName == "" {
+			return errors.New("master_name is required in sentinel mode")
+		}
+	case ModeCluster:
+		if len(cfg.Endpoints) == 0 {
+			return errors.New("endpoints are required in cluster mode")
+		}
+		if cfg.DB != 0 {
+			return errors.New("db cannot be set in cluster mode")
+		}
+	default:
+		return fmt.Errorf("unsupported mode %q", cfg.Mode)
+	}
+	return nil
 }
@@ -44,6 +44,26 @@ func TestLoadConfig(t *testing.T) {
 				TLS: configtls.ClientConfig{
 					Insecure: true,
 				},
+				Mode: ModeStandalone,
+			},
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "sentinel"),
+			expected: &Config{
+				Endpoint:         "localhost:6379",
+				Password:         "passwd",
+				Mode:             ModeSentinel,
+				Endpoints:        []string{"sentinel-1:26379", "sentinel-2:26379"},
+				MasterName:       "mymaster",
+				SentinelPassword: "sentinel_passwd",
+			},
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "cluster"),
+			expected: &Config{
+				Endpoint:  "localhost:6379",
+				Mode:      ModeCluster,
+				Endpoints: []string{"node-1:6379", "node-2:6379"},
 			},
 		},
 	}
@@ -62,3 +82,47 @@ func TestLoadConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateConfig(t *testing.T) {
+	tests := []struct {
+		name         string
+		config       *Config
+		errorMessage string
+	}{
+		{
+			name:         "missing endpoint",
+			config:       &Config{Mode: ModeStandalone},
+			errorMessage: "endpoint is required in standalone mode",
+		},
+		{
+			name:         "missing sentinel endpoints",
+			config:       &Config{Mode: ModeSentinel, MasterName: "mymaster"},
+			errorMessage: "endpoints are required in sentinel mode",
+		},
+		{
+			name:         "missing master name",
+			config:       &Config{Mode: ModeSentinel, Endpoints: []string{"localhost:26379"}},
+			errorMessage: "master_name is required in sentinel mode",
+		},
+		{
+			name:         "missing cluster endpoints",
+			config:       &Config{Mode: ModeCluster},
+			errorMessage: "endpoints are required in cluster mode",
+		},
+		{
+			name:         "db in cluster mode",
+			config:       &Config{Mode: ModeCluster, Endpoints: []string{"localhost:7000"}, DB: 1},
+			errorMessage: "db cannot be set in cluster mode",
+		},
+		{
+			name:         "unsupported mode",
+			config:       &Config{Mode: "replica", Endpoint: "localhost:6379"},
+			errorMessage: `unsupported mode "replica"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.EqualError(t, tt.config.Validate(), tt.errorMessage)
+		})
+	}
+}
@@ -19,7 +19,7 @@ import (
 type redisStorage struct {
 	cfg    *Config
 	logger *zap.Logger
-	client *redis.Client
+	client redis.UniversalClient
 }
 
 // Ensure this storage extension implements the appropriate interface
@@ -38,13 +38,30 @@ func (rs *redisStorage) Start(ctx context.Context, _ component.Host) error {
 	if err != nil {
 		return err
 	}
-	c := redis.NewClient(&redis.Options{
-		Addr:      rs.cfg.Endpoint,
-		Password:  string(rs.cfg.Password),
-		DB:        rs.cfg.DB,
-		TLSConfig: tlsConfig,
-	})
-	rs.client = c
+	switch rs.cfg.Mode {
+	case ModeSentinel:
+		rs.client = redis.NewFailoverClient(&redis.FailoverOptions{
+			MasterName:       rs.cfg.MasterName,
+			SentinelAddrs:    rs.cfg.Endpoints,
+			SentinelPassword: string(rs.cfg.SentinelPassword),
+			Password:         string(rs.cfg.Password),
+			DB:               rs.cfg.DB,
+			TLSConfig:        tlsConfig,
+		})
+	case ModeCluster:
+		rs.client = redis.NewClusterClient(&redis.ClusterOptions{
+			Addrs:     rs.cfg.Endpoints,
+			Password:  string(rs.cfg.Password),
+			TLSConfig: tlsConfig,
+		})
+	default:
+		rs.client = redis.NewClient(&redis.Options{
+			Addr:      rs.cfg.Endpoint,
+			Password:  string(rs.cfg.Password),
+			DB:        rs.cfg.DB,
+			TLSConfig: tlsConfig,
+		})
+	}
 	return nil
 }
 
@@ -57,7 +74,7 @@ func (rs *redisStorage) Shutdown(context.Context) error {
 }
 
 type redisClient struct {
-	client     *redis.Client
+	client     redis.UniversalClient
 	prefix     string
 	expiration time.Duration
 }
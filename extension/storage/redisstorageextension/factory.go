@@ -25,6 +25,7 @@ func NewFactory() extension.Factory {
 func createDefaultConfig() component.Config {
 	return &Config{
 		Endpoint: "localhost:6379",
+		Mode:     ModeStandalone,
 		TLS: configtls.ClientConfig{
 			Insecure: false,
 		},
@@ -31,6 +31,21 @@ func TestFactory(t *testing.T) {
 				}
 			}(),
 		},
+		{
+			name: "Sentinel",
+			config: &Config{
+				Mode:       ModeSentinel,
+				Endpoints:  []string{"localhost:26379"},
+				MasterName: "mymaster",
+			},
+		},
+		{
+			name: "Cluster",
+			config: &Config{
+				Mode:      ModeCluster,
+				Endpoints: []string{"localhost:7000", "localhost:7001"},
+			},
+		},
 	}
 
 	for _, test := range tests {
@@ -0,0 +1,30 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package storageclient gets the storage clients of components from the storage
+// extension configured for them.
+package storageclient // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/storage/storageclient"
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/extension/xextension/storage"
+)
+
+// Get returns a client of the storage extension with the given ID for the component
+// of the given kind and ID. The name distinguishes several clients of the same component.
+func Get(ctx context.Context, host component.Host, storageID component.ID, kind component.Kind, componentID component.ID, name string) (storage.Client, error) {
+	ext, ok := host.GetExtensions()[storageID]
+	if !ok {
+		return nil, fmt.Errorf("storage extension '%s' not found", storageID)
+	}
+
+	storageExt, ok := ext.(storage.Extension)
+	if !ok {
+		return nil, fmt.Errorf("non-storage extension '%s' found", storageID)
+	}
+
+	return storageExt.GetClient(ctx, kind, componentID, name)
+}
@@ -0,0 +1,34 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package storageclient
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/storage/storagetest"
+)
+
+func TestGet(t *testing.T) {
+	host := storagetest.NewStorageHost().
+		WithInMemoryStorageExtension("one").
+		WithNonStorageExtension("two")
+	id := component.MustNewID("test")
+
+	client, err := Get(context.Background(), host, storagetest.NewStorageID("one"), component.KindProcessor, id, "")
+	require.NoError(t, err)
+	creatorID, err := storagetest.CreatorID(context.Background(), client)
+	require.NoError(t, err)
+	assert.Equal(t, storagetest.NewStorageID("one"), creatorID)
+
+	_, err = Get(context.Background(), host, storagetest.NewStorageID("missing"), component.KindProcessor, id, "")
+	assert.EqualError(t, err, "storage extension 'test_storage/missing' not found")
+
+	_, err = Get(context.Background(), host, storagetest.NewNonStorageID("two"), component.KindProcessor, id, "")
+	assert.EqualError(t, err, "non-storage extension 'non_storage/two' found")
+}
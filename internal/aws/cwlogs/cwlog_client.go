@@ -45,12 +45,15 @@ type Client struct {
 	logRetention int32
 	tags         map[string]string
 	logger       *zap.Logger
+	// createLogGroup is whether the missing log groups are created with the retention and tags of the client
+	createLogGroup bool
 }
 
 type ClientOption func(*cwLogClientConfig)
 
 type cwLogClientConfig struct {
-	userAgentExtras []string
+	userAgentExtras         []string
+	disableLogGroupCreation bool
 }
 
 func WithUserAgentExtras(userAgentExtras ...string) ClientOption {
@@ -59,13 +62,22 @@ func WithUserAgentExtras(userAgentExtras ...string) ClientOption {
 	}
 }
 
+// WithoutLogGroupCreation prevents the client from creating the missing log groups, for the log groups
+// that are provisioned beforehand
+func WithoutLogGroupCreation() ClientOption {
+	return func(config *cwLogClientConfig) {
+		config.disableLogGroupCreation = true
+	}
+}
+
 // Create a log client based on the actual cloudwatch logs client.
 func newCloudWatchLogClient(svc cloudWatchClient, logRetention int32, tags map[string]string, logger *zap.Logger) *Client {
 	logClient := &Client{
-		svc:          svc,
-		logRetention: logRetention,
-		tags:         tags,
-		logger:       logger,
+		svc:            svc,
+		logRetention:   logRetention,
+		tags:           tags,
+		logger:         logger,
+		createLogGroup: true,
 	}
 	return logClient
 }
@@ -101,7 +113,14 @@ func NewClient(logger *zap.Logger, awsConfig aws.Config, buildInfo component.Bui
 		AddToUserAgentHeader("otel.collector.UserAgentHandler", newCollectorUserAgent(buildInfo, logGroupName, componentName, opts...), middleware.Before),
 	)
 
-	return newCloudWatchLogClient(client, logRetention, tags, logger)
+	option := &cwLogClientConfig{}
+	for _, opt := range opts {
+		opt(option)
+	}
+
+	logClient := newCloudWatchLogClient(client, logRetention, tags, logger)
+	logClient.createLogGroup = !option.disableLogGroupCreation
+	return logClient
 }
 
 // PutLogEvents mainly handles different possible error could be returned from server side, and retries them
@@ -196,7 +215,7 @@ func (client *Client) CreateStream(ctx context.Context, logGroup, streamName *st
 	if err != nil {
 		client.logger.Debug("cwlog_client: creating stream fail", zap.Error(err))
 		var rnf *types.ResourceNotFoundException
-		if errors.As(err, &rnf) {
+		if errors.As(err, &rnf) && client.createLogGroup {
 			// Create Log Group with tags if they exist and were specified in the config
 			_, err = client.svc.CreateLogGroup(ctx, &cloudwatchlogs.CreateLogGroupInput{
 				LogGroupName: logGroup,
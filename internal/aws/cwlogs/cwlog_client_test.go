@@ -379,6 +379,24 @@ func TestCreateStream(t *testing.T) {
 	}
 }
 
+func TestCreateStreamWithoutLogGroupCreation(t *testing.T) {
+	svc := &mockCloudWatchClient{
+		createLogGroup: func(_ context.Context, _ *cloudwatchlogs.CreateLogGroupInput, _ ...func(*cloudwatchlogs.Options)) (*cloudwatchlogs.CreateLogGroupOutput, error) {
+			return &cloudwatchlogs.CreateLogGroupOutput{}, nil
+		},
+		createLogStream: func(_ context.Context, _ *cloudwatchlogs.CreateLogStreamInput, _ ...func(*cloudwatchlogs.Options)) (*cloudwatchlogs.CreateLogStreamOutput, error) {
+			return nil, &types.ResourceNotFoundException{}
+		},
+	}
+	client := newCloudWatchLogClient(svc, 0, nil, zap.NewNop())
+	client.createLogGroup = false
+
+	err := client.CreateStream(context.Background(), &logGroup, &logStreamName)
+	var rnf *types.ResourceNotFoundException
+	assert.ErrorAs(t, err, &rnf)
+	assert.Zero(t, svc.createLogGroupCount.Load())
+}
+
 type UnknownError struct {
 	otherField string
 }
@@ -202,6 +202,13 @@ func (dc *Client) toStatsJSON(
 	return &statsJSON, nil
 }
 
+// ServerVersion returns the version information of the daemon.
+func (dc *Client) ServerVersion(ctx context.Context) (dtypes.Version, error) {
+	versionCtx, cancel := context.WithTimeout(ctx, dc.config.Timeout)
+	defer cancel()
+	return dc.client.ServerVersion(versionCtx)
+}
+
 // Events exposes the underlying Docker clients Events channel.
 // Caller should close the events channel by canceling the context.
 // If an error occurs, processing stops and caller must reinvoke this method.
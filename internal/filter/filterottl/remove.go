@@ -0,0 +1,83 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package filterottl // import "github.com/open-telemetry/opentelemetry-collector-contrib/internal/filter/filterottl"
+
+import (
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottldatapoint"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottllog"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlspan"
+)
+
+// RemoveSpans removes the spans for which drop returns true, and the scopes and
+// resources left without spans.
+func RemoveSpans(td ptrace.Traces, drop func(ottlspan.TransformContext) bool) {
+	td.ResourceSpans().RemoveIf(func(rs ptrace.ResourceSpans) bool {
+		rs.ScopeSpans().RemoveIf(func(ss ptrace.ScopeSpans) bool {
+			ss.Spans().RemoveIf(func(span ptrace.Span) bool {
+				return drop(ottlspan.NewTransformContext(span, ss.Scope(), rs.Resource(), ss, rs))
+			})
+			return ss.Spans().Len() == 0
+		})
+		return rs.ScopeSpans().Len() == 0
+	})
+}
+
+// RemoveDataPoints removes the data points for which drop returns true, and the
+// metrics, scopes and resources left without data points.
+func RemoveDataPoints(md pmetric.Metrics, drop func(ottldatapoint.TransformContext) bool) {
+	md.ResourceMetrics().RemoveIf(func(rm pmetric.ResourceMetrics) bool {
+		rm.ScopeMetrics().RemoveIf(func(sm pmetric.ScopeMetrics) bool {
+			sm.Metrics().RemoveIf(func(metric pmetric.Metric) bool {
+				dropDataPoint := func(dp any) bool {
+					return drop(ottldatapoint.NewTransformContext(dp, metric, sm.Metrics(), sm.Scope(), rm.Resource(), sm, rm))
+				}
+
+				switch metric.Type() {
+				case pmetric.MetricTypeGauge:
+					dps := metric.Gauge().DataPoints()
+					dps.RemoveIf(func(dp pmetric.NumberDataPoint) bool { return dropDataPoint(dp) })
+					return dps.Len() == 0
+				case pmetric.MetricTypeSum:
+					dps := metric.Sum().DataPoints()
+					dps.RemoveIf(func(dp pmetric.NumberDataPoint) bool { return dropDataPoint(dp) })
+					return dps.Len() == 0
+				case pmetric.MetricTypeHistogram:
+					dps := metric.Histogram().DataPoints()
+					dps.RemoveIf(func(dp pmetric.HistogramDataPoint) bool { return dropDataPoint(dp) })
+					return dps.Len() == 0
+				case pmetric.MetricTypeExponentialHistogram:
+					dps := metric.ExponentialHistogram().DataPoints()
+					dps.RemoveIf(func(dp pmetric.ExponentialHistogramDataPoint) bool { return dropDataPoint(dp) })
+					return dps.Len() == 0
+				case pmetric.MetricTypeSummary:
+					dps := metric.Summary().DataPoints()
+					dps.RemoveIf(func(dp pmetric.SummaryDataPoint) bool { return dropDataPoint(dp) })
+					return dps.Len() == 0
+				case pmetric.MetricTypeEmpty:
+				}
+				return true
+			})
+			return sm.Metrics().Len() == 0
+		})
+		return rm.ScopeMetrics().Len() == 0
+	})
+}
+
+// RemoveLogRecords removes the log records for which drop returns true, and the
+// scopes and resources left without log records.
+func RemoveLogRecords(ld plog.Logs, drop func(ottllog.TransformContext) bool) {
+	ld.ResourceLogs().RemoveIf(func(rl plog.ResourceLogs) bool {
+		rl.ScopeLogs().RemoveIf(func(sl plog.ScopeLogs) bool {
+			sl.LogRecords().RemoveIf(func(lr plog.LogRecord) bool {
+				return drop(ottllog.NewTransformContext(lr, sl.Scope(), rl.Resource(), sl, rl))
+			})
+			return sl.LogRecords().Len() == 0
+		})
+		return rl.ScopeLogs().Len() == 0
+	})
+}
@@ -0,0 +1,87 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package filterottl
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottldatapoint"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottllog"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlspan"
+)
+
+func TestRemoveSpans(t *testing.T) {
+	td := ptrace.NewTraces()
+	kept := td.ResourceSpans().AppendEmpty()
+	kept.Resource().Attributes().PutStr("service.name", "kept")
+	spans := kept.ScopeSpans().AppendEmpty().Spans()
+	spans.AppendEmpty().SetName("keep")
+	spans.AppendEmpty().SetName("drop")
+	dropped := td.ResourceSpans().AppendEmpty()
+	dropped.ScopeSpans().AppendEmpty().Spans().AppendEmpty().SetName("drop")
+
+	RemoveSpans(td, func(tCtx ottlspan.TransformContext) bool {
+		return tCtx.GetSpan().Name() == "drop"
+	})
+
+	require.Equal(t, 1, td.ResourceSpans().Len())
+	rs := td.ResourceSpans().At(0)
+	assert.Equal(t, map[string]any{"service.name": "kept"}, rs.Resource().Attributes().AsRaw())
+	require.Equal(t, 1, rs.ScopeSpans().At(0).Spans().Len())
+	assert.Equal(t, "keep", rs.ScopeSpans().At(0).Spans().At(0).Name())
+}
+
+func TestRemoveDataPoints(t *testing.T) {
+	md := pmetric.NewMetrics()
+	ms := md.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics()
+	gauge := ms.AppendEmpty()
+	gauge.SetName("gauge")
+	gauge.SetEmptyGauge().DataPoints().AppendEmpty().SetIntValue(1)
+	gauge.Gauge().DataPoints().AppendEmpty().SetIntValue(2)
+	sum := ms.AppendEmpty()
+	sum.SetName("sum")
+	sum.SetEmptySum().DataPoints().AppendEmpty().SetIntValue(2)
+	histogram := ms.AppendEmpty()
+	histogram.SetName("histogram")
+	histogram.SetEmptyHistogram().DataPoints().AppendEmpty().SetCount(1)
+	ms.AppendEmpty().SetName("empty")
+
+	RemoveDataPoints(md, func(tCtx ottldatapoint.TransformContext) bool {
+		dp, ok := tCtx.GetDataPoint().(pmetric.NumberDataPoint)
+		return ok && dp.IntValue() == 2
+	})
+
+	// The sum is left without data points, and the metric without a type is removed.
+	require.Equal(t, 2, ms.Len())
+	assert.Equal(t, "gauge", ms.At(0).Name())
+	require.Equal(t, 1, ms.At(0).Gauge().DataPoints().Len())
+	assert.Equal(t, int64(1), ms.At(0).Gauge().DataPoints().At(0).IntValue())
+	assert.Equal(t, "histogram", ms.At(1).Name())
+
+	RemoveDataPoints(md, func(ottldatapoint.TransformContext) bool { return true })
+	assert.Equal(t, 0, md.ResourceMetrics().Len())
+}
+
+func TestRemoveLogRecords(t *testing.T) {
+	ld := plog.NewLogs()
+	lrs := ld.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords()
+	lrs.AppendEmpty().Body().SetStr("keep")
+	lrs.AppendEmpty().Body().SetStr("drop")
+
+	RemoveLogRecords(ld, func(tCtx ottllog.TransformContext) bool {
+		return tCtx.GetLogRecord().Body().Str() == "drop"
+	})
+
+	require.Equal(t, 1, ld.LogRecordCount())
+	assert.Equal(t, "keep", lrs.At(0).Body().Str())
+
+	RemoveLogRecords(ld, func(ottllog.TransformContext) bool { return true })
+	assert.Equal(t, 0, ld.ResourceLogs().Len())
+}
@@ -0,0 +1,195 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package fpe implements the FF1 format-preserving encryption mode specified
+// in NIST SP 800-38G.
+package fpe // import "github.com/open-telemetry/opentelemetry-collector-contrib/internal/fpe"
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/big"
+)
+
+const (
+	// minDomain is the minimum size of the domain of the numeral strings, radix^len,
+	// required by NIST SP 800-38G Rev. 1.
+	minDomain = 1000000
+	maxRadix  = 1 << 16
+	rounds    = 10
+)
+
+// ErrTooShort is returned when the numeral string is too short to be encrypted securely.
+var ErrTooShort = errors.New("numeral string is too short")
+
+// FF1 encrypts and decrypts numeral strings of a given radix while preserving their length.
+type FF1 struct {
+	block cipher.Block
+	radix int
+	tweak []byte
+}
+
+// NewFF1 creates an FF1 cipher with an AES key of 16, 24 or 32 bytes.
+func NewFF1(key []byte, radix int, tweak []byte) (*FF1, error) {
+	if radix < 2 || radix > maxRadix {
+		return nil, fmt.Errorf("radix must be between 2 and %d", maxRadix)
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return &FF1{block: block, radix: radix, tweak: tweak}, nil
+}
+
+// MinLen returns the minimum length of the numeral strings that can be encrypted.
+func (f *FF1) MinLen() int {
+	n, domain := 0, 1
+	for domain < minDomain {
+		domain *= f.radix
+		n++
+	}
+	return max(n, 2)
+}
+
+// Encrypt encrypts the numeral string x.
+func (f *FF1) Encrypt(x []int) ([]int, error) {
+	return f.cipher(x, true)
+}
+
+// Decrypt decrypts the numeral string x.
+func (f *FF1) Decrypt(x []int) ([]int, error) {
+	return f.cipher(x, false)
+}
+
+func (f *FF1) cipher(x []int, encrypt bool) ([]int, error) {
+	n := len(x)
+	if n < f.MinLen() {
+		return nil, ErrTooShort
+	}
+	for _, numeral := range x {
+		if numeral < 0 || numeral >= f.radix {
+			return nil, fmt.Errorf("numeral %d is out of range for radix %d", numeral, f.radix)
+		}
+	}
+
+	u := n / 2
+	v := n - u
+	a, b := x[:u], x[u:]
+
+	radix := big.NewInt(int64(f.radix))
+	// byteLen is the number of bytes needed to represent a numeral string of length v.
+	maxB := new(big.Int).Exp(radix, big.NewInt(int64(v)), nil)
+	byteLen := (maxB.Sub(maxB, big.NewInt(1)).BitLen() + 7) / 8
+	d := 4*((byteLen+3)/4) + 4
+
+	p := make([]byte, aes.BlockSize)
+	p[0], p[1], p[2] = 1, 2, 1
+	p[3], p[4], p[5] = byte(f.radix>>16), byte(f.radix>>8), byte(f.radix)
+	p[6] = 10
+	p[7] = byte(u)
+	binary.BigEndian.PutUint32(p[8:12], uint32(n))
+	binary.BigEndian.PutUint32(p[12:16], uint32(len(f.tweak)))
+
+	padding := (16 - (len(f.tweak)+byteLen+1)%16) % 16
+	q := make([]byte, len(f.tweak)+padding+1+byteLen)
+	copy(q, f.tweak)
+
+	modU := new(big.Int).Exp(radix, big.NewInt(int64(u)), nil)
+	modV := new(big.Int).Exp(radix, big.NewInt(int64(v)), nil)
+
+	y, c := new(big.Int), new(big.Int)
+	for round := range rounds {
+		i := round
+		if !encrypt {
+			i = rounds - 1 - round
+		}
+
+		q[len(f.tweak)+padding] = byte(i)
+		num := b
+		if !encrypt {
+			num = a
+		}
+		numBytes := num2big(num, radix).Bytes()
+		clear(q[len(q)-byteLen:])
+		copy(q[len(q)-len(numBytes):], numBytes)
+
+		y.SetBytes(f.expand(f.prf(p, q), d))
+
+		m := modU
+		length := u
+		if i%2 == 1 {
+			m = modV
+			length = v
+		}
+
+		if encrypt {
+			c.Add(num2big(a, radix), y)
+		} else {
+			c.Sub(num2big(b, radix), y)
+		}
+		c.Mod(c, m)
+		next := big2num(c, radix, length)
+
+		if encrypt {
+			a, b = b, next
+		} else {
+			a, b = next, a
+		}
+	}
+
+	return append(append(make([]int, 0, n), a...), b...), nil
+}
+
+// prf computes the CBC-MAC of p || q with a zero IV.
+func (f *FF1) prf(p, q []byte) []byte {
+	r := make([]byte, aes.BlockSize)
+	f.block.Encrypt(r, p)
+	for j := 0; j < len(q); j += aes.BlockSize {
+		for k := range aes.BlockSize {
+			r[k] ^= q[j+k]
+		}
+		f.block.Encrypt(r, r)
+	}
+	return r
+}
+
+// expand extends r to d bytes by encrypting r xor-ed with a counter.
+func (f *FF1) expand(r []byte, d int) []byte {
+	s := make([]byte, 0, d+aes.BlockSize)
+	s = append(s, r...)
+	block := make([]byte, aes.BlockSize)
+	for j := 1; len(s) < d; j++ {
+		copy(block, r)
+		var counter [aes.BlockSize]byte
+		binary.BigEndian.PutUint64(counter[8:], uint64(j))
+		for k := range aes.BlockSize {
+			block[k] ^= counter[k]
+		}
+		f.block.Encrypt(block, block)
+		s = append(s, block...)
+	}
+	return s[:d]
+}
+
+func num2big(x []int, radix *big.Int) *big.Int {
+	n := new(big.Int)
+	for _, numeral := range x {
+		n.Mul(n, radix)
+		n.Add(n, big.NewInt(int64(numeral)))
+	}
+	return n
+}
+
+func big2num(n *big.Int, radix *big.Int, length int) []int {
+	x := make([]int, length)
+	n = new(big.Int).Set(n)
+	mod := new(big.Int)
+	for i := length - 1; i >= 0; i-- {
+		n.DivMod(n, radix, mod)
+		x[i] = int(mod.Int64())
+	}
+	return x
+}
@@ -0,0 +1,115 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package fpe
+
+import (
+	"encoding/hex"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Test vectors from the NIST FF1 samples.
+func TestFF1(t *testing.T) {
+	tests := []struct {
+		name       string
+		key        string
+		tweak      string
+		radix      int
+		plaintext  string
+		ciphertext string
+	}{
+		{
+			name:       "sample 1",
+			key:        "2B7E151628AED2A6ABF7158809CF4F3C",
+			radix:      10,
+			plaintext:  "0123456789",
+			ciphertext: "2433477484",
+		},
+		{
+			name:       "sample 2",
+			key:        "2B7E151628AED2A6ABF7158809CF4F3C",
+			tweak:      "39383736353433323130",
+			radix:      10,
+			plaintext:  "0123456789",
+			ciphertext: "6124200773",
+		},
+		{
+			name:       "sample 3",
+			key:        "2B7E151628AED2A6ABF7158809CF4F3C",
+			tweak:      "3737373770717273373737",
+			radix:      36,
+			plaintext:  "0123456789abcdefghi",
+			ciphertext: "a9tv40mll9kdu509eum",
+		},
+		{
+			name:       "sample 7",
+			key:        "2B7E151628AED2A6ABF7158809CF4F3CEF4359D8D580AA4F7F036D6F04FC6A94",
+			radix:      10,
+			plaintext:  "0123456789",
+			ciphertext: "6657667009",
+		},
+		{
+			name:       "sample 9",
+			key:        "2B7E151628AED2A6ABF7158809CF4F3CEF4359D8D580AA4F7F036D6F04FC6A94",
+			tweak:      "3737373770717273373737",
+			radix:      36,
+			plaintext:  "0123456789abcdefghi",
+			ciphertext: "xs8a0azh2avyalyzuwd",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			key, err := hex.DecodeString(tt.key)
+			require.NoError(t, err)
+			tweak, err := hex.DecodeString(tt.tweak)
+			require.NoError(t, err)
+			ff1, err := NewFF1(key, tt.radix, tweak)
+			require.NoError(t, err)
+
+			ciphertext, err := ff1.Encrypt(numerals(tt.plaintext, tt.radix))
+			require.NoError(t, err)
+			assert.Equal(t, tt.ciphertext, str(ciphertext, tt.radix))
+
+			plaintext, err := ff1.Decrypt(ciphertext)
+			require.NoError(t, err)
+			assert.Equal(t, tt.plaintext, str(plaintext, tt.radix))
+		})
+	}
+}
+
+func TestFF1Invalid(t *testing.T) {
+	_, err := NewFF1(make([]byte, 15), 10, nil)
+	assert.Error(t, err)
+	_, err = NewFF1(make([]byte, 16), 1, nil)
+	assert.Error(t, err)
+
+	ff1, err := NewFF1(make([]byte, 16), 10, nil)
+	require.NoError(t, err)
+	assert.Equal(t, 6, ff1.MinLen())
+	_, err = ff1.Encrypt([]int{1, 2, 3, 4, 5})
+	assert.ErrorIs(t, err, ErrTooShort)
+	_, err = ff1.Encrypt([]int{1, 2, 3, 4, 5, 10})
+	assert.Error(t, err)
+}
+
+func numerals(s string, radix int) []int {
+	x := make([]int, len(s))
+	for i, r := range s {
+		n, _ := strconv.ParseInt(string(r), radix, 64)
+		x[i] = int(n)
+	}
+	return x
+}
+
+func str(x []int, radix int) string {
+	var sb strings.Builder
+	for _, n := range x {
+		sb.WriteString(strconv.FormatInt(int64(n), radix))
+	}
+	return sb.String()
+}
@@ -0,0 +1,139 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ibmcloud // import "github.com/open-telemetry/opentelemetry-collector-contrib/internal/metadataproviders/ibmcloud"
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+const (
+	// IBM Cloud VPC metadata service, see https://cloud.ibm.com/docs/vpc?topic=vpc-imd-about
+	metadataEndpoint = "http://169.254.169.254"
+	apiVersion       = "2022-03-01"
+)
+
+// Provider gets metadata from the IBM Cloud VPC instance metadata service.
+type Provider interface {
+	Metadata(context.Context) (*InstanceMetadata, error)
+}
+
+type ibmCloudProviderImpl struct {
+	endpoint string
+	client   *http.Client
+}
+
+// NewProvider creates a new metadata provider
+func NewProvider() Provider {
+	return &ibmCloudProviderImpl{
+		endpoint: metadataEndpoint,
+		client:   &http.Client{},
+	}
+}
+
+// Reference is a reference to another resource in the instance metadata response
+type Reference struct {
+	ID   string `json:"id,omitempty"`
+	Name string `json:"name"`
+}
+
+// InstanceMetadata is the IBM Cloud VPC instance metadata response format
+type InstanceMetadata struct {
+	ID            string    `json:"id"`
+	CRN           string    `json:"crn"`
+	Name          string    `json:"name"`
+	Profile       Reference `json:"profile"`
+	Zone          Reference `json:"zone"`
+	Image         Reference `json:"image"`
+	VPC           Reference `json:"vpc"`
+	ResourceGroup Reference `json:"resource_group"`
+}
+
+// AccountID returns the account ID found in the CRN of the instance,
+// crn:v1:bluemix:public:is:<zone>:a/<account ID>::instance:<instance ID>
+func (m *InstanceMetadata) AccountID() string {
+	parts := strings.Split(m.CRN, ":")
+	if len(parts) < 7 {
+		return ""
+	}
+	return strings.TrimPrefix(parts[6], "a/")
+}
+
+// Region returns the region of the zone of the instance, e.g. us-south for us-south-1
+func (m *InstanceMetadata) Region() string {
+	if i := strings.LastIndexByte(m.Zone.Name, '-'); i > 0 {
+		return m.Zone.Name[:i]
+	}
+	return m.Zone.Name
+}
+
+type tokenResponse struct {
+	AccessToken string `json:"access_token"`
+}
+
+// Metadata gets an instance identity access token and uses it to query the instance metadata
+func (p *ibmCloudProviderImpl) Metadata(ctx context.Context) (*InstanceMetadata, error) {
+	token, err := p.token(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, p.endpoint+"/metadata/v1/instance?version="+apiVersion, http.NoBody)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Add("Authorization", "Bearer "+token)
+
+	var metadata *InstanceMetadata
+	if err := p.do(req, &metadata); err != nil {
+		return nil, err
+	}
+	return metadata, nil
+}
+
+func (p *ibmCloudProviderImpl) token(ctx context.Context) (string, error) {
+	body := bytes.NewBufferString(`{"expires_in": 300}`)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, p.endpoint+"/instance_identity/v1/token?version="+apiVersion, body)
+	if err != nil {
+		return "", fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Add("Metadata-Flavor", "ibm")
+	req.Header.Add("Content-Type", "application/json")
+
+	var token tokenResponse
+	if err := p.do(req, &token); err != nil {
+		return "", err
+	}
+	if token.AccessToken == "" {
+		return "", errors.New("IBM Cloud metadata service replied with an empty access token")
+	}
+	return token.AccessToken, nil
+}
+
+func (p *ibmCloudProviderImpl) do(req *http.Request, v any) error {
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to query IBM Cloud metadata service: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated {
+		return fmt.Errorf("IBM Cloud metadata service replied with status code: %s", resp.Status)
+	}
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("failed to read IBM Cloud metadata service reply: %w", err)
+	}
+
+	if err := json.Unmarshal(respBody, v); err != nil {
+		return fmt.Errorf("failed to decode IBM Cloud metadata service reply: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,115 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ibmcloud
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewProvider(t *testing.T) {
+	provider := NewProvider()
+	assert.NotNil(t, provider)
+}
+
+func TestQueryEndpointFailed(t *testing.T) {
+	ts := httptest.NewServer(http.NotFoundHandler())
+	defer ts.Close()
+
+	provider := &ibmCloudProviderImpl{
+		endpoint: ts.URL,
+		client:   &http.Client{},
+	}
+
+	_, err := provider.Metadata(context.Background())
+	assert.Error(t, err)
+}
+
+func TestQueryEndpointMalformed(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, err := fmt.Fprintln(w, "{")
+		assert.NoError(t, err)
+	}))
+	defer ts.Close()
+
+	provider := &ibmCloudProviderImpl{
+		endpoint: ts.URL,
+		client:   &http.Client{},
+	}
+
+	_, err := provider.Metadata(context.Background())
+	assert.Error(t, err)
+}
+
+func TestQueryEndpointEmptyToken(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, err := fmt.Fprintln(w, "{}")
+		assert.NoError(t, err)
+	}))
+	defer ts.Close()
+
+	provider := &ibmCloudProviderImpl{
+		endpoint: ts.URL,
+		client:   &http.Client{},
+	}
+
+	_, err := provider.Metadata(context.Background())
+	assert.EqualError(t, err, "IBM Cloud metadata service replied with an empty access token")
+}
+
+func TestQueryEndpointCorrect(t *testing.T) {
+	sentMetadata := &InstanceMetadata{
+		ID:            "0717_e21b7391-2ca2-4ab5-84a8-b92157a633b0",
+		CRN:           "crn:v1:bluemix:public:is:us-south-1:a/123456::instance:0717_e21b7391-2ca2-4ab5-84a8-b92157a633b0",
+		Name:          "my-instance",
+		Profile:       Reference{Name: "bx2-2x8"},
+		Zone:          Reference{Name: "us-south-1"},
+		Image:         Reference{ID: "r006-ed3f775f-ad7e-4e37-ae62-7199b4988b00", Name: "ibm-ubuntu-22-04"},
+		VPC:           Reference{ID: "r006-4727d842-f94f-4a2d-824a-9bc9b02c523b", Name: "my-vpc"},
+		ResourceGroup: Reference{ID: "fee82deba12e4c0fb69c3b09d1f12345", Name: "default"},
+	}
+	marshalledMetadata, err := json.Marshal(sentMetadata)
+	require.NoError(t, err)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("PUT /instance_identity/v1/token", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "ibm", r.Header.Get("Metadata-Flavor"))
+		assert.Equal(t, apiVersion, r.URL.Query().Get("version"))
+		_, err := fmt.Fprintln(w, `{"access_token": "token"}`)
+		assert.NoError(t, err)
+	})
+	mux.HandleFunc("GET /metadata/v1/instance", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer token", r.Header.Get("Authorization"))
+		assert.Equal(t, apiVersion, r.URL.Query().Get("version"))
+		_, err := w.Write(marshalledMetadata)
+		assert.NoError(t, err)
+	})
+	ts := httptest.NewServer(mux)
+	defer ts.Close()
+
+	provider := &ibmCloudProviderImpl{
+		endpoint: ts.URL,
+		client:   &http.Client{},
+	}
+
+	recvMetadata, err := provider.Metadata(context.Background())
+
+	require.NoError(t, err)
+	assert.Equal(t, *sentMetadata, *recvMetadata)
+	assert.Equal(t, "123456", recvMetadata.AccountID())
+	assert.Equal(t, "us-south", recvMetadata.Region())
+}
+
+func TestAccountIDAndRegionFallbacks(t *testing.T) {
+	m := &InstanceMetadata{CRN: "invalid", Zone: Reference{Name: "zone"}}
+	assert.Empty(t, m.AccountID())
+	assert.Equal(t, "zone", m.Region())
+}
@@ -0,0 +1,24 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ibmcloud // import "github.com/open-telemetry/opentelemetry-collector-contrib/internal/metadataproviders/ibmcloud"
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+)
+
+type MockProvider struct {
+	mock.Mock
+}
+
+func (m *MockProvider) Metadata(_ context.Context) (*InstanceMetadata, error) {
+	args := m.MethodCalled("Metadata")
+	arg := args.Get(0)
+	var im *InstanceMetadata
+	if arg != nil {
+		im = arg.(*InstanceMetadata)
+	}
+	return im, args.Error(1)
+}
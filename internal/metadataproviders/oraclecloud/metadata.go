@@ -0,0 +1,82 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package oraclecloud // import "github.com/open-telemetry/opentelemetry-collector-contrib/internal/metadataproviders/oraclecloud"
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+const (
+	// OCI IMDS v2 instance endpoint, see https://docs.oracle.com/en-us/iaas/Content/Compute/Tasks/gettingmetadata.htm
+	metadataEndpoint = "http://169.254.169.254/opc/v2/instance/"
+)
+
+// Provider gets metadata from the Oracle Cloud Infrastructure IMDS.
+type Provider interface {
+	Metadata(context.Context) (*ComputeMetadata, error)
+}
+
+type oracleCloudProviderImpl struct {
+	endpoint string
+	client   *http.Client
+}
+
+// NewProvider creates a new metadata provider
+func NewProvider() Provider {
+	return &oracleCloudProviderImpl{
+		endpoint: metadataEndpoint,
+		client:   &http.Client{},
+	}
+}
+
+// ComputeMetadata is the OCI IMDS instance metadata response format
+type ComputeMetadata struct {
+	ID                  string `json:"id"`
+	DisplayName         string `json:"displayName"`
+	Hostname            string `json:"hostname"`
+	CanonicalRegionName string `json:"canonicalRegionName"`
+	AvailabilityDomain  string `json:"availabilityDomain"`
+	FaultDomain         string `json:"faultDomain"`
+	CompartmentID       string `json:"compartmentId"`
+	TenantID            string `json:"tenantId"`
+	Shape               string `json:"shape"`
+	Image               string `json:"image"`
+}
+
+// Metadata queries a given endpoint and parses the output to the OCI IMDS format
+func (p *oracleCloudProviderImpl) Metadata(ctx context.Context) (*ComputeMetadata, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, p.endpoint, http.NoBody)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	// IMDS v2 requires this static authorization header
+	req.Header.Add("Authorization", "Bearer Oracle")
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query OCI IMDS: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("OCI IMDS replied with status code: %s", resp.Status)
+	}
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read OCI IMDS reply: %w", err)
+	}
+
+	var metadata *ComputeMetadata
+	err = json.Unmarshal(respBody, &metadata)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode OCI IMDS reply: %w", err)
+	}
+
+	return metadata, nil
+}
@@ -0,0 +1,84 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package oraclecloud
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewProvider(t *testing.T) {
+	provider := NewProvider()
+	assert.NotNil(t, provider)
+}
+
+func TestQueryEndpointFailed(t *testing.T) {
+	ts := httptest.NewServer(http.NotFoundHandler())
+	defer ts.Close()
+
+	provider := &oracleCloudProviderImpl{
+		endpoint: ts.URL,
+		client:   &http.Client{},
+	}
+
+	_, err := provider.Metadata(context.Background())
+	assert.Error(t, err)
+}
+
+func TestQueryEndpointMalformed(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, err := fmt.Fprintln(w, "{")
+		assert.NoError(t, err)
+	}))
+	defer ts.Close()
+
+	provider := &oracleCloudProviderImpl{
+		endpoint: ts.URL,
+		client:   &http.Client{},
+	}
+
+	_, err := provider.Metadata(context.Background())
+	assert.Error(t, err)
+}
+
+func TestQueryEndpointCorrect(t *testing.T) {
+	sentMetadata := &ComputeMetadata{
+		ID:                  "ocid1.instance.oc1.iad.example",
+		DisplayName:         "my-instance",
+		Hostname:            "my-instance",
+		CanonicalRegionName: "us-ashburn-1",
+		AvailabilityDomain:  "Uocm:US-ASHBURN-AD-1",
+		FaultDomain:         "FAULT-DOMAIN-2",
+		CompartmentID:       "ocid1.compartment.oc1..example",
+		TenantID:            "ocid1.tenancy.oc1..example",
+		Shape:               "VM.Standard.E4.Flex",
+		Image:               "ocid1.image.oc1.iad.example",
+	}
+	marshalledMetadata, err := json.Marshal(sentMetadata)
+	require.NoError(t, err)
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer Oracle", r.Header.Get("Authorization"))
+		_, err = w.Write(marshalledMetadata)
+		assert.NoError(t, err)
+	}))
+	defer ts.Close()
+
+	provider := &oracleCloudProviderImpl{
+		endpoint: ts.URL,
+		client:   &http.Client{},
+	}
+
+	recvMetadata, err := provider.Metadata(context.Background())
+
+	require.NoError(t, err)
+	assert.Equal(t, *sentMetadata, *recvMetadata)
+}
@@ -6,6 +6,7 @@ package admission2 // import "github.com/open-telemetry/opentelemetry-collector-
 import (
 	"container/list"
 	"context"
+	"errors"
 	"sync"
 
 	"go.opentelemetry.io/collector/component"
@@ -24,6 +25,16 @@ var (
 	ErrRequestTooLarge = status.Errorf(grpccodes.InvalidArgument, "rejecting request, request is too large")
 )
 
+// Reasons recorded in the rejected requests metric.
+const (
+	reasonTooMuchWaiting  = "too_much_waiting"
+	reasonRequestTooLarge = "request_too_large"
+	reasonCanceled        = "canceled"
+
+	// ReasonKey is the attribute key used for the admission rejection reason.
+	ReasonKey = "reason"
+)
+
 // BoundedQueue is a LIFO-oriented admission-controlled Queue.
 type BoundedQueue struct {
 	maxLimitAdmit    uint64
@@ -31,6 +42,10 @@ type BoundedQueue struct {
 	tracer           trace.Tracer
 	telemetryBuilder *internalmetadata.TelemetryBuilder
 
+	// attrs are the attributes of every metric reported by this
+	// queue, these identify the receiver and optionally the tenant.
+	attrs []attribute.KeyValue
+
 	// lock protects currentAdmitted, currentWaiting, and waiters
 
 	lock            sync.Mutex
@@ -51,13 +66,18 @@ type waiter struct {
 // admits `maxLimitAdmit` bytes concurrently and allows up to
 // `maxLimitWait` bytes to wait for admission.
 func NewBoundedQueue(id component.ID, ts component.TelemetrySettings, maxLimitAdmit, maxLimitWait uint64) (Queue, error) {
+	return newBoundedQueue(ts, maxLimitAdmit, maxLimitWait, attribute.String(netstats.ReceiverKey, id.String()))
+}
+
+func newBoundedQueue(ts component.TelemetrySettings, maxLimitAdmit, maxLimitWait uint64, attrs ...attribute.KeyValue) (*BoundedQueue, error) {
 	bq := &BoundedQueue{
 		maxLimitAdmit: maxLimitAdmit,
 		maxLimitWait:  maxLimitWait,
 		waiters:       list.New(),
 		tracer:        ts.TracerProvider.Tracer("github.com/open-telemetry/opentelemetry-collector-contrib/internal/otelarrow"),
+		attrs:         attrs,
 	}
-	attr := metric.WithAttributes(attribute.String(netstats.ReceiverKey, id.String()))
+	attr := metric.WithAttributes(attrs...)
 	telemetryBuilder, err := internalmetadata.NewTelemetryBuilder(ts)
 	if err != nil {
 		return nil, err
@@ -80,6 +100,12 @@ func NewBoundedQueue(id component.ID, ts component.TelemetrySettings, maxLimitAd
 	return bq, nil
 }
 
+// recordRejection counts a request that was not admitted.
+func (bq *BoundedQueue) recordRejection(ctx context.Context, reason string) {
+	attrs := append([]attribute.KeyValue{attribute.String(ReasonKey, reason)}, bq.attrs...)
+	bq.telemetryBuilder.OtelarrowAdmissionRejectedRequests.Add(ctx, 1, metric.WithAttributes(attrs...))
+}
+
 func (bq *BoundedQueue) inFlightCB() int64 {
 	// Note, see https://github.com/open-telemetry/otel-arrow/issues/270
 	bq.lock.Lock()
@@ -133,6 +159,11 @@ func (bq *BoundedQueue) Acquire(ctx context.Context, pending uint64) (ReleaseFun
 
 	if err != nil {
 		parentSpan.AddEvent("admission rejected (fast path)", pendingAttr)
+		if errors.Is(err, ErrRequestTooLarge) {
+			bq.recordRejection(ctx, reasonRequestTooLarge)
+		} else {
+			bq.recordRejection(ctx, reasonTooMuchWaiting)
+		}
 		return noopRelease, err
 	} else if element == nil {
 		parentSpan.AddEvent("admission accepted (fast path)", pendingAttr)
@@ -168,6 +199,7 @@ func (bq *BoundedQueue) Acquire(ctx context.Context, pending uint64) (ReleaseFun
 		}
 
 		parentSpan.AddEvent("admission rejected (canceled)", pendingAttr)
+		bq.recordRejection(context.WithoutCancel(ctx), reasonCanceled)
 		return noopRelease, status.Error(grpccodes.Canceled, context.Cause(ctx).Error())
 	}
 }
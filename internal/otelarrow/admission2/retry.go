@@ -0,0 +1,51 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package admission2 // import "github.com/open-telemetry/opentelemetry-collector-contrib/internal/otelarrow/admission2"
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	grpccodes "google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// retryDelayQueue attaches a RetryInfo to ResourceExhausted errors.
+type retryDelayQueue struct {
+	Queue
+	delay time.Duration
+}
+
+// WithRetryDelay returns a Queue that adds a RetryInfo with the given
+// delay to the ResourceExhausted errors returned by q.  OTLP exporters
+// treat ResourceExhausted as a permanent error unless the server
+// supplies RetryInfo, in which case they wait for the delay before
+// retrying.  When delay is zero, q is returned unmodified.
+func WithRetryDelay(q Queue, delay time.Duration) Queue {
+	if delay <= 0 {
+		return q
+	}
+	return retryDelayQueue{Queue: q, delay: delay}
+}
+
+// Acquire implements Queue.
+func (rq retryDelayQueue) Acquire(ctx context.Context, weight uint64) (ReleaseFunc, error) {
+	release, err := rq.Queue.Acquire(ctx, weight)
+	if err == nil {
+		return release, nil
+	}
+	st, ok := status.FromError(err)
+	if !ok || st.Code() != grpccodes.ResourceExhausted {
+		return release, err
+	}
+	withInfo, detailErr := st.WithDetails(&errdetails.RetryInfo{
+		RetryDelay: durationpb.New(rq.delay),
+	})
+	if detailErr != nil {
+		return release, err
+	}
+	return release, withInfo.Err()
+}
@@ -0,0 +1,39 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package admission2
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestWithRetryDelay(t *testing.T) {
+	bq := newBQTest(t, 10, 0)
+	assert.Equal(t, Queue(bq.BoundedQueue), WithRetryDelay(bq.BoundedQueue, 0))
+
+	q := WithRetryDelay(bq.BoundedQueue, 2*time.Second)
+	rel, err := q.Acquire(context.Background(), 10)
+	require.NoError(t, err)
+	defer rel()
+
+	_, err = q.Acquire(context.Background(), 1)
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	assert.Equal(t, codes.ResourceExhausted, st.Code())
+	require.Len(t, st.Details(), 1)
+	info, ok := st.Details()[0].(*errdetails.RetryInfo)
+	require.True(t, ok)
+	assert.Equal(t, 2*time.Second, info.RetryDelay.AsDuration())
+
+	// Other errors are returned unmodified.
+	_, err = q.Acquire(context.Background(), 11)
+	assert.Equal(t, ErrRequestTooLarge, err)
+}
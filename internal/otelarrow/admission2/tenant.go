@@ -0,0 +1,136 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package admission2 // import "github.com/open-telemetry/opentelemetry-collector-contrib/internal/otelarrow/admission2"
+
+import (
+	"context"
+	"sync"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/otelarrow/netstats"
+)
+
+const (
+	// TenantKey is the attribute key used for the tenant of a
+	// per-tenant admission queue.
+	TenantKey = "tenant"
+
+	// OverflowTenant is the name of the queue shared by tenants
+	// that arrive after the tenant limit has been reached.
+	OverflowTenant = "_overflow"
+)
+
+// TenantLimits are the admission limits applied to one tenant, in
+// bytes.  When RequestLimit is zero the tenant is not limited.
+type TenantLimits struct {
+	RequestLimit uint64
+	WaitingLimit uint64
+}
+
+// TenantSettings configures a TenantQueue.
+type TenantSettings struct {
+	// Tenant returns the tenant of a request, or the empty
+	// string for requests that are not subject to per-tenant
+	// limits.
+	Tenant func(ctx context.Context) string
+
+	// Default limits apply to tenants without an override.
+	Default TenantLimits
+
+	// Overrides are the limits of specific tenants.
+	Overrides map[string]TenantLimits
+
+	// MaxTenants limits the number of distinct tenant queues.
+	// Tenants beyond this limit share the OverflowTenant queue.
+	MaxTenants int
+}
+
+// TenantQueue is a Queue that admits requests through a per-tenant
+// BoundedQueue before admitting them through a shared Queue, so that
+// a single tenant cannot use the whole of the shared limit.
+type TenantQueue struct {
+	id       component.ID
+	ts       component.TelemetrySettings
+	shared   Queue
+	settings TenantSettings
+
+	// lock protects tenants
+	lock    sync.Mutex
+	tenants map[string]Queue
+}
+
+var _ Queue = &TenantQueue{}
+
+// NewTenantQueue returns a Queue that applies the per-tenant limits
+// of settings in front of the shared queue.
+func NewTenantQueue(id component.ID, ts component.TelemetrySettings, shared Queue, settings TenantSettings) *TenantQueue {
+	return &TenantQueue{
+		id:       id,
+		ts:       ts,
+		shared:   shared,
+		settings: settings,
+		tenants:  map[string]Queue{},
+	}
+}
+
+// Acquire implements Queue.
+func (tq *TenantQueue) Acquire(ctx context.Context, weight uint64) (ReleaseFunc, error) {
+	tenant := tq.settings.Tenant(ctx)
+	if tenant == "" {
+		return tq.shared.Acquire(ctx, weight)
+	}
+	q, err := tq.tenantQueue(tenant)
+	if err != nil {
+		return noopRelease, err
+	}
+	tenantRelease, err := q.Acquire(ctx, weight)
+	if err != nil {
+		return noopRelease, err
+	}
+	sharedRelease, err := tq.shared.Acquire(ctx, weight)
+	if err != nil {
+		tenantRelease()
+		return noopRelease, err
+	}
+	return func() {
+		sharedRelease()
+		tenantRelease()
+	}, nil
+}
+
+// tenantQueue returns the queue of a tenant, creating it on first use.
+func (tq *TenantQueue) tenantQueue(tenant string) (Queue, error) {
+	tq.lock.Lock()
+	defer tq.lock.Unlock()
+
+	if q, ok := tq.tenants[tenant]; ok {
+		return q, nil
+	}
+	limits, ok := tq.settings.Overrides[tenant]
+	if !ok {
+		if tq.settings.MaxTenants > 0 && len(tq.tenants) >= tq.settings.MaxTenants {
+			tenant = OverflowTenant
+			if q, ok := tq.tenants[tenant]; ok {
+				return q, nil
+			}
+		}
+		limits = tq.settings.Default
+	}
+
+	var q Queue = noopController{}
+	if limits.RequestLimit != 0 {
+		bq, err := newBoundedQueue(tq.ts, limits.RequestLimit, limits.WaitingLimit,
+			attribute.String(netstats.ReceiverKey, tq.id.String()),
+			attribute.String(TenantKey, tenant),
+		)
+		if err != nil {
+			return nil, err
+		}
+		q = bq
+	}
+	tq.tenants[tenant] = q
+	return q, nil
+}
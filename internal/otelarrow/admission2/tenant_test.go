@@ -0,0 +1,143 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package admission2
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+const expectRejectedName = "otelcol_otelarrow_admission_rejected_requests"
+
+type tenantCtxKey struct{}
+
+func withTenant(tenant string) context.Context {
+	return context.WithValue(context.Background(), tenantCtxKey{}, tenant)
+}
+
+func tenantFromContext(ctx context.Context) string {
+	tenant, _ := ctx.Value(tenantCtxKey{}).(string)
+	return tenant
+}
+
+func newTenantTest(t *testing.T, sharedAdmit, sharedWait uint64, settings TenantSettings) (bqTest, *TenantQueue) {
+	bq := newBQTest(t, sharedAdmit, sharedWait)
+	settings.Tenant = tenantFromContext
+	ts := componenttest.NewNopTelemetrySettings()
+	ts.MeterProvider = bq.provider
+	return bq, NewTenantQueue(component.MustNewID("admission_testing"), ts, bq.BoundedQueue, settings)
+}
+
+func TestTenantQueueLimits(t *testing.T) {
+	bq, tq := newTenantTest(t, 100, 0, TenantSettings{
+		Default: TenantLimits{RequestLimit: 40},
+		Overrides: map[string]TenantLimits{
+			"big": {RequestLimit: 60},
+		},
+	})
+
+	// The default tenant limit applies to each tenant separately.
+	relA, err := tq.Acquire(withTenant("a"), 40)
+	require.NoError(t, err)
+	_, err = tq.Acquire(withTenant("a"), 1)
+	assert.ErrorIs(t, err, ErrTooMuchWaiting)
+
+	// The override allows more than the default.
+	relBig, err := tq.Acquire(withTenant("big"), 60)
+	require.NoError(t, err)
+
+	// The shared limit is reached although tenant b is within its limit.
+	_, err = tq.Acquire(withTenant("b"), 10)
+	assert.ErrorIs(t, err, ErrTooMuchWaiting)
+	bq.waitForPending(100, 0)
+
+	// Tenant b was released when the shared queue rejected it, so
+	// it may use its whole limit once there is room.
+	relA()
+	relB, err := tq.Acquire(withTenant("b"), 40)
+	require.NoError(t, err)
+
+	relBig()
+	relB()
+	bq.waitForPending(0, 0)
+}
+
+func TestTenantQueueWithoutTenant(t *testing.T) {
+	bq, tq := newTenantTest(t, 100, 0, TenantSettings{
+		Default: TenantLimits{RequestLimit: 10},
+	})
+	rel, err := tq.Acquire(context.Background(), 100)
+	require.NoError(t, err)
+	bq.waitForPending(100, 0)
+	rel()
+	bq.waitForPending(0, 0)
+	assert.Empty(t, tq.tenants)
+}
+
+func TestTenantQueueOverflow(t *testing.T) {
+	_, tq := newTenantTest(t, 100, 0, TenantSettings{
+		Default:    TenantLimits{RequestLimit: 10},
+		Overrides:  map[string]TenantLimits{"special": {RequestLimit: 20}},
+		MaxTenants: 1,
+	})
+
+	rel1, err := tq.Acquire(withTenant("a"), 10)
+	require.NoError(t, err)
+	defer rel1()
+
+	// Tenants beyond the limit share one queue.
+	rel2, err := tq.Acquire(withTenant("b"), 10)
+	require.NoError(t, err)
+	defer rel2()
+	_, err = tq.Acquire(withTenant("c"), 1)
+	assert.ErrorIs(t, err, ErrTooMuchWaiting)
+
+	// Overrides are not subject to the tenant limit.
+	rel3, err := tq.Acquire(withTenant("special"), 20)
+	require.NoError(t, err)
+	defer rel3()
+
+	assert.Len(t, tq.tenants, 3)
+	assert.Contains(t, tq.tenants, OverflowTenant)
+}
+
+func TestTenantQueueRejectedMetric(t *testing.T) {
+	bq, tq := newTenantTest(t, 100, 0, TenantSettings{
+		Default: TenantLimits{RequestLimit: 10},
+	})
+
+	_, err := tq.Acquire(withTenant("a"), 11)
+	assert.ErrorIs(t, err, ErrRequestTooLarge)
+	_, err = tq.Acquire(context.Background(), 101)
+	assert.ErrorIs(t, err, ErrRequestTooLarge)
+
+	var rm metricdata.ResourceMetrics
+	require.NoError(t, bq.reader.Collect(context.Background(), &rm))
+
+	found := false
+	for _, sm := range rm.ScopeMetrics {
+		for _, m := range sm.Metrics {
+			if m.Name != expectRejectedName {
+				continue
+			}
+			found = true
+			sum, ok := m.Data.(metricdata.Sum[int64])
+			require.True(t, ok)
+			require.Len(t, sum.DataPoints, 2)
+			for _, dp := range sum.DataPoints {
+				assert.Equal(t, int64(1), dp.Value)
+				reason, _ := dp.Attributes.Value(attribute.Key(ReasonKey))
+				assert.Equal(t, reasonRequestTooLarge, reason.AsString())
+			}
+		}
+	}
+	assert.True(t, found)
+}
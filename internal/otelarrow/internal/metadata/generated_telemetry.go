@@ -25,11 +25,12 @@ func Tracer(settings component.TelemetrySettings) trace.Tracer {
 // TelemetryBuilder provides an interface for components to report telemetry
 // as defined in metadata and user config.
 type TelemetryBuilder struct {
-	meter                           metric.Meter
-	mu                              sync.Mutex
-	registrations                   []metric.Registration
-	OtelarrowAdmissionInFlightBytes metric.Int64ObservableUpDownCounter
-	OtelarrowAdmissionWaitingBytes  metric.Int64ObservableUpDownCounter
+	meter                              metric.Meter
+	mu                                 sync.Mutex
+	registrations                      []metric.Registration
+	OtelarrowAdmissionInFlightBytes    metric.Int64ObservableUpDownCounter
+	OtelarrowAdmissionRejectedRequests metric.Int64Counter
+	OtelarrowAdmissionWaitingBytes     metric.Int64ObservableUpDownCounter
 }
 
 // TelemetryBuilderOption applies changes to default builder.
@@ -107,6 +108,12 @@ func NewTelemetryBuilder(settings component.TelemetrySettings, options ...Teleme
 		metric.WithUnit("By"),
 	)
 	errs = errors.Join(errs, err)
+	builder.OtelarrowAdmissionRejectedRequests, err = builder.meter.Int64Counter(
+		"otelcol_otelarrow_admission_rejected_requests",
+		metric.WithDescription("Number of requests rejected by admission control."),
+		metric.WithUnit("{requests}"),
+	)
+	errs = errors.Join(errs, err)
 	builder.OtelarrowAdmissionWaitingBytes, err = builder.meter.Int64ObservableUpDownCounter(
 		"otelcol_otelarrow_admission_waiting_bytes",
 		metric.WithDescription("Number of items waiting to start processing."),
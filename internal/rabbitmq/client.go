@@ -29,6 +29,9 @@ type Connection interface {
 type Channel interface {
 	Confirm(noWait bool) error
 	PublishWithDeferredConfirmWithContext(ctx context.Context, exchange, key string, mandatory, immediate bool, msg amqp.Publishing) (DeferredConfirmation, error)
+	NotifyReturn(receiver chan amqp.Return) chan amqp.Return
+	Qos(prefetchCount, prefetchSize int, global bool) error
+	ConsumeWithContext(ctx context.Context, queue, consumer string, autoAck, exclusive, noLocal, noWait bool, args amqp.Table) (<-chan amqp.Delivery, error)
 	IsClosed() bool
 	Close() error
 }
@@ -185,6 +188,18 @@ func (c *channelHolder) PublishWithDeferredConfirmWithContext(ctx context.Contex
 	return &deferredConfirmationHolder{confirmation: confirmation}, nil
 }
 
+func (c *channelHolder) NotifyReturn(receiver chan amqp.Return) chan amqp.Return {
+	return c.channel.NotifyReturn(receiver)
+}
+
+func (c *channelHolder) Qos(prefetchCount, prefetchSize int, global bool) error {
+	return c.channel.Qos(prefetchCount, prefetchSize, global)
+}
+
+func (c *channelHolder) ConsumeWithContext(ctx context.Context, queue, consumer string, autoAck, exclusive, noLocal, noWait bool, args amqp.Table) (<-chan amqp.Delivery, error) {
+	return c.channel.ConsumeWithContext(ctx, queue, consumer, autoAck, exclusive, noLocal, noWait, args)
+}
+
 func (c *channelHolder) IsClosed() bool {
 	return c.channel.IsClosed()
 }
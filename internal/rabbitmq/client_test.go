@@ -60,6 +60,21 @@ func (m *MockChannel) PublishWithDeferredConfirmWithContext(ctx context.Context,
 	return args.Get(0).(DeferredConfirmation), args.Error(1)
 }
 
+func (m *MockChannel) NotifyReturn(receiver chan amqp.Return) chan amqp.Return {
+	args := m.Called(receiver)
+	return args.Get(0).(chan amqp.Return)
+}
+
+func (m *MockChannel) Qos(prefetchCount, prefetchSize int, global bool) error {
+	args := m.Called(prefetchCount, prefetchSize, global)
+	return args.Error(0)
+}
+
+func (m *MockChannel) ConsumeWithContext(ctx context.Context, queue, consumer string, autoAck, exclusive, noLocal, noWait bool, args amqp.Table) (<-chan amqp.Delivery, error) {
+	called := m.Called(ctx, queue, consumer, autoAck, exclusive, noLocal, noWait, args)
+	return called.Get(0).(<-chan amqp.Delivery), called.Error(1)
+}
+
 func (m *MockChannel) IsClosed() bool {
 	args := m.Called()
 	return args.Bool(0)
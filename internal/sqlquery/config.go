@@ -88,6 +88,11 @@ type Query struct {
 	Logs               []LogsCfg   `mapstructure:"logs"`
 	TrackingColumn     string      `mapstructure:"tracking_column"`
 	TrackingStartValue string      `mapstructure:"tracking_start_value"`
+	// TrackingColumns defines the columns of a composite tracking value, their values
+	// are passed as query parameters in the same order. Can't be used with TrackingColumn.
+	TrackingColumns []string `mapstructure:"tracking_columns"`
+	// TrackingStartValues defines the initial values of the TrackingColumns.
+	TrackingStartValues []string `mapstructure:"tracking_start_values"`
 }
 
 func (q Query) Validate() error {
@@ -98,6 +103,15 @@ func (q Query) Validate() error {
 	if len(q.Logs) == 0 && len(q.Metrics) == 0 {
 		errs = append(errs, errors.New("at least one of 'query.logs' and 'query.metrics' must not be empty"))
 	}
+	if q.TrackingColumn != "" && len(q.TrackingColumns) > 0 {
+		errs = append(errs, errors.New("'tracking_column' and 'tracking_columns' cannot be used together"))
+	}
+	if q.TrackingStartValue != "" && len(q.TrackingStartValues) > 0 {
+		errs = append(errs, errors.New("'tracking_start_value' and 'tracking_start_values' cannot be used together"))
+	}
+	if len(q.TrackingStartValues) > 0 && len(q.TrackingStartValues) != len(q.TrackingColumns) {
+		errs = append(errs, fmt.Errorf("'tracking_start_values' must have the same length as 'tracking_columns' (%d), got %d", len(q.TrackingColumns), len(q.TrackingStartValues)))
+	}
 	for _, logs := range q.Logs {
 		if err := logs.Validate(); err != nil {
 			errs = append(errs, err)
@@ -114,6 +128,9 @@ func (q Query) Validate() error {
 type LogsCfg struct {
 	BodyColumn       string   `mapstructure:"body_column"`
 	AttributeColumns []string `mapstructure:"attribute_columns"`
+	// JSONAttributesColumn is a column holding a JSON object, whose fields are
+	// added to the attributes of the log record.
+	JSONAttributesColumn string `mapstructure:"json_attributes_column"`
 }
 
 func (config LogsCfg) Validate() error {
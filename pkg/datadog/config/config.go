@@ -148,6 +148,10 @@ func (c *Config) Validate() error {
 		return err
 	}
 
+	if err := c.Metrics.ResourceAttributesMapping.validate(); err != nil {
+		return err
+	}
+
 	if c.HostMetadata.ReporterPeriod < 5*time.Minute {
 		return errors.New("reporter_period must be 5 minutes or higher")
 	}
@@ -114,6 +114,47 @@ func TestValidate(t *testing.T) {
 			},
 			err: "'[123' is not valid resource filter regular expression",
 		},
+		{
+			name: "resource attributes mapping valid",
+			cfg: &Config{
+				API: APIConfig{Key: "aaaaaaa"},
+				Metrics: MetricsConfig{
+					ResourceAttributesMapping: ResourceAttributesMappingConfig{
+						HostAttributes: []string{"k8s.node.name"},
+						HostAliases:    map[string]string{"ip-10-0-0-1": "web-1"},
+						IncludeTags:    []string{`k8s\..*`},
+						ExcludeTags:    []string{"k8s.pod.uid"},
+					},
+				},
+				HostMetadata: HostMetadataConfig{Enabled: true, ReporterPeriod: 10 * time.Minute},
+			},
+		},
+		{
+			name: "resource attributes mapping empty alias",
+			cfg: &Config{
+				API: APIConfig{Key: "aaaaaaa"},
+				Metrics: MetricsConfig{
+					ResourceAttributesMapping: ResourceAttributesMappingConfig{
+						HostAliases: map[string]string{"ip-10-0-0-1": ""},
+					},
+				},
+				HostMetadata: HostMetadataConfig{Enabled: true, ReporterPeriod: 10 * time.Minute},
+			},
+			err: `'host_aliases' has an empty alias for host "ip-10-0-0-1"`,
+		},
+		{
+			name: "resource attributes mapping invalid exclude",
+			cfg: &Config{
+				API: APIConfig{Key: "aaaaaaa"},
+				Metrics: MetricsConfig{
+					ResourceAttributesMapping: ResourceAttributesMappingConfig{
+						ExcludeTags: []string{"k8s.(pod"},
+					},
+				},
+				HostMetadata: HostMetadataConfig{Enabled: true, ReporterPeriod: 10 * time.Minute},
+			},
+			err: `'exclude_tags' has an invalid regular expression "k8s.(pod"`,
+		},
 		{
 			name: "invalid histogram settings",
 			cfg: &Config{
@@ -7,6 +7,7 @@ import (
 	"encoding"
 	"errors"
 	"fmt"
+	"regexp"
 
 	otlpmetrics "github.com/DataDog/opentelemetry-mapping-go/pkg/otlp/metrics"
 	"go.opentelemetry.io/collector/config/confignet"
@@ -32,6 +33,10 @@ type MetricsConfig struct {
 
 	// SummaryConfig defines the export for OTLP Summaries.
 	SummaryConfig SummaryConfig `mapstructure:"summaries"`
+
+	// ResourceAttributesMapping overrides how resource attributes are mapped to
+	// the Datadog host and tags of metrics.
+	ResourceAttributesMapping ResourceAttributesMappingConfig `mapstructure:"resource_attributes_mapping"`
 }
 
 type HistogramMode string
@@ -197,6 +202,62 @@ type SummaryConfig struct {
 	Mode SummaryMode `mapstructure:"mode"`
 }
 
+// ResourceAttributesMappingConfig customizes how the resource attributes of metrics
+// are mapped to a Datadog host and tags.
+type ResourceAttributesMappingConfig struct {
+	// HostAttributes is the list of resource attributes, in order of precedence, whose
+	// value is used as the Datadog hostname. The first attribute set on a resource takes
+	// precedence over the default hostname resolution. Attributes in this list are used
+	// for host resolution even if they are excluded from tags.
+	HostAttributes []string `mapstructure:"host_attributes"`
+
+	// HostAliases maps hostnames to the hostname reported to Datadog. It applies to the
+	// hostname resolved from HostAttributes and to the `datadog.host.name` and `host.name`
+	// resource attributes.
+	HostAliases map[string]string `mapstructure:"host_aliases"`
+
+	// IncludeTags is a list of regular expressions. If set, only resource attributes whose
+	// key fully matches one of them are kept.
+	IncludeTags []string `mapstructure:"include_tags"`
+
+	// ExcludeTags is a list of regular expressions. Resource attributes whose key fully
+	// matches one of them are dropped. It takes precedence over IncludeTags.
+	//
+	// The attributes used to resolve the hostname and the source of the metrics, such as
+	// `host.name`, `k8s.node.name` and `cloud.*`, are never dropped by IncludeTags and
+	// ExcludeTags.
+	ExcludeTags []string `mapstructure:"exclude_tags"`
+}
+
+func (c *ResourceAttributesMappingConfig) validate() error {
+	for _, attr := range c.HostAttributes {
+		if attr == "" {
+			return errors.New("'host_attributes' must not contain empty attribute names")
+		}
+	}
+	for host, alias := range c.HostAliases {
+		if alias == "" {
+			return fmt.Errorf("'host_aliases' has an empty alias for host %q", host)
+		}
+	}
+	for _, expr := range c.IncludeTags {
+		if _, err := regexp.Compile(expr); err != nil {
+			return fmt.Errorf("'include_tags' has an invalid regular expression %q: %w", expr, err)
+		}
+	}
+	for _, expr := range c.ExcludeTags {
+		if _, err := regexp.Compile(expr); err != nil {
+			return fmt.Errorf("'exclude_tags' has an invalid regular expression %q: %w", expr, err)
+		}
+	}
+	return nil
+}
+
+// Enabled returns true if any of the resource attributes mapping overrides is set.
+func (c ResourceAttributesMappingConfig) Enabled() bool {
+	return len(c.HostAttributes) > 0 || len(c.HostAliases) > 0 || len(c.IncludeTags) > 0 || len(c.ExcludeTags) > 0
+}
+
 // MetricsExporterConfig provides options for a user to customize the behavior of the
 // metrics exporter
 type MetricsExporterConfig struct {
@@ -170,6 +170,7 @@ func (c Config) Build(set component.TelemetrySettings, emit emit.Callback, opts
 		IncludeFileRecordNumber: c.IncludeFileRecordNumber,
 		Compression:             c.Compression,
 		AcquireFSLock:           c.AcquireFSLock,
+		MaxEmitAttempts:         o.maxEmitAttempts,
 	}
 
 	telemetryBuilder, err := metadata.NewTelemetryBuilder(set)
@@ -251,8 +252,9 @@ func (c Config) validate() error {
 }
 
 type options struct {
-	splitFunc  bufio.SplitFunc
-	noTracking bool
+	splitFunc       bufio.SplitFunc
+	noTracking      bool
+	maxEmitAttempts int
 }
 
 type Option func(*options)
@@ -271,3 +273,13 @@ func WithNoTracking() Option {
 		o.noTracking = true
 	}
 }
+
+// WithRetryOnEmitError stops reading a file when the emit function returns an error, without advancing
+// the offset past the tokens that failed to be emitted, so they are emitted again on the next poll.
+// Tokens emitted before the failure in the same batch may be emitted again. The tokens are skipped
+// once they failed to be emitted maxAttempts times in a row.
+func WithRetryOnEmitError(maxAttempts int) Option {
+	return func(o *options) {
+		o.maxEmitAttempts = maxAttempts
+	}
+}
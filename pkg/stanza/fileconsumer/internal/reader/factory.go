@@ -50,6 +50,7 @@ type Factory struct {
 	IncludeFileRecordOffset bool
 	Compression             string
 	AcquireFSLock           bool
+	MaxEmitAttempts         int
 }
 
 func (f *Factory) NewFingerprint(file *os.File) (*fingerprint.Fingerprint, error) {
@@ -94,6 +95,7 @@ func (f *Factory) NewReaderFromMetadata(file *os.File, m *Metadata) (r *Reader,
 		acquireFSLock:     f.AcquireFSLock,
 		maxBatchSize:      DefaultMaxBatchSize,
 		emitFunc:          f.EmitFunc,
+		maxEmitAttempts:   f.MaxEmitAttempts,
 	}
 	r.set.Logger = r.set.Logger.With(zap.String("path", r.fileName))
 
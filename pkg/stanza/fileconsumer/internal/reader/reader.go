@@ -36,6 +36,8 @@ type Metadata struct {
 	FlushState      flush.State
 	TokenLenState   tokenlen.State
 	FileType        string
+	// EmitAttempts is the number of times in a row the tokens at the offset failed to be emitted.
+	EmitAttempts int
 }
 
 // Reader manages a single file
@@ -59,6 +61,7 @@ type Reader struct {
 	compression            string
 	acquireFSLock          bool
 	maxBatchSize           int
+	maxEmitAttempts        int
 }
 
 // ReadToEnd will read until the end of the file
@@ -70,8 +73,10 @@ func (r *Reader) ReadToEnd(ctx context.Context) {
 		defer r.unlockFile()
 	}
 
+	var emitErr error
 	switch r.compression {
 	case "gzip":
+		startOffset := r.Offset
 		currentEOF, err := r.createGzipReader()
 		if err != nil {
 			return
@@ -79,11 +84,12 @@ func (r *Reader) ReadToEnd(ctx context.Context) {
 		// Offset tracking in an uncompressed file is based on the length of emitted tokens, but in this case
 		// we need to set the offset to the end of the file.
 		defer func() {
-			r.Offset = currentEOF
+			r.Offset = compressedOffset(startOffset, currentEOF, emitErr)
 		}()
 	case "auto":
 		// Identifying a filename by its extension may not always be correct. We could have a compressed file without the .gz extension
 		if r.FileType == gzipExtension {
+			startOffset := r.Offset
 			currentEOF, err := r.createGzipReader()
 			if err != nil {
 				return
@@ -91,7 +97,7 @@ func (r *Reader) ReadToEnd(ctx context.Context) {
 			// Offset tracking in an uncompressed file is based on the length of emitted tokens, but in this case
 			// we need to set the offset to the end of the file.
 			defer func() {
-				r.Offset = currentEOF
+				r.Offset = compressedOffset(startOffset, currentEOF, emitErr)
 			}()
 		} else {
 			r.reader = r.file
@@ -117,7 +123,16 @@ func (r *Reader) ReadToEnd(ctx context.Context) {
 		}
 	}
 
-	r.readContents(ctx)
+	emitErr = r.readContents(ctx)
+}
+
+// compressedOffset returns the offset to resume reading a compressed file from. A compressed file can only be
+// resumed from the start of a compressed member, so the whole read is retried when it could not be emitted.
+func compressedOffset(startOffset, currentEOF int64, emitErr error) int64 {
+	if emitErr != nil {
+		return startOffset
+	}
+	return currentEOF
 }
 
 // createGzipReader creates gzip reader and returns the file offset
@@ -205,7 +220,10 @@ func (r *Reader) readHeader(ctx context.Context) (doneReadingFile bool) {
 	return false
 }
 
-func (r *Reader) readContents(ctx context.Context) {
+// readContents reads and emits the tokens of the file from the current offset. When retrying on emit errors,
+// it stops at the first batch that cannot be emitted and returns the error, leaving the offset at the start of
+// that batch so it is read again by the next poll.
+func (r *Reader) readContents(ctx context.Context) error {
 	var buf []byte
 	if r.TokenLenState.MinimumLength <= r.initialBufferSize {
 		bufPtr := r.getBufPtrFromPool()
@@ -228,26 +246,28 @@ func (r *Reader) readContents(ctx context.Context) {
 	for {
 		select {
 		case <-ctx.Done():
-			return
+			return nil
 		default:
 		}
 
 		ok := s.Scan()
 		if !ok {
-			if err := s.Error(); err != nil {
-				r.set.Logger.Error("failed during scan", zap.Error(err))
-			} else if r.deleteAtEOF {
-				r.delete()
+			scanErr := s.Error()
+			if scanErr != nil {
+				r.set.Logger.Error("failed during scan", zap.Error(scanErr))
 			}
 
 			if numTokensBatched > 0 {
-				err := r.emitFunc(ctx, tokenBodies[:numTokensBatched], r.FileAttributes, r.RecordNum, tokenOffsets)
-				if err != nil {
-					r.set.Logger.Error("failed to emit token", zap.Error(err))
+				if err := r.emit(ctx, tokenBodies[:numTokensBatched], tokenOffsets); err != nil {
+					return err
 				}
 				r.Offset = s.Pos()
 			}
-			return
+
+			if scanErr == nil && r.deleteAtEOF {
+				r.delete()
+			}
+			return nil
 		}
 
 		var err error
@@ -262,8 +282,8 @@ func (r *Reader) readContents(ctx context.Context) {
 
 		r.RecordNum++
 		if r.maxBatchSize > 0 && numTokensBatched >= r.maxBatchSize {
-			if err = r.emitFunc(ctx, tokenBodies[:numTokensBatched], r.FileAttributes, r.RecordNum, tokenOffsets); err != nil {
-				r.set.Logger.Error("failed to emit token", zap.Error(err))
+			if err = r.emit(ctx, tokenBodies[:numTokensBatched], tokenOffsets); err != nil {
+				return err
 			}
 			numTokensBatched = 0
 			r.Offset, tokenOffsets[0] = s.Pos(), s.Pos()
@@ -271,6 +291,30 @@ func (r *Reader) readContents(ctx context.Context) {
 	}
 }
 
+// emit emits a batch of tokens. Errors are only returned when retrying on emit errors, after rewinding
+// the offset and record number to the start of the batch.
+func (r *Reader) emit(ctx context.Context, tokens [][]byte, offsets []int64) error {
+	err := r.emitFunc(ctx, tokens, r.FileAttributes, r.RecordNum, offsets)
+	if err == nil {
+		r.EmitAttempts = 0
+		return nil
+	}
+	if r.maxEmitAttempts <= 0 {
+		r.set.Logger.Error("failed to emit token", zap.Error(err))
+		return nil
+	}
+	r.EmitAttempts++
+	if r.EmitAttempts >= r.maxEmitAttempts {
+		r.set.Logger.Error("failed to emit token, dropping it", zap.Int("attempts", r.EmitAttempts), zap.Error(err))
+		r.EmitAttempts = 0
+		return nil
+	}
+	r.set.Logger.Warn("failed to emit token, will retry", zap.Int("attempts", r.EmitAttempts), zap.Error(err))
+	r.Offset = offsets[0]
+	r.RecordNum -= int64(len(tokens))
+	return err
+}
+
 // Delete will close and delete the file
 func (r *Reader) delete() {
 	r.close()
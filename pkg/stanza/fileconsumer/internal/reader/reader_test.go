@@ -5,6 +5,7 @@ package reader
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
 	"strings"
@@ -332,6 +333,74 @@ func TestUntermintedLogEntryGrows(t *testing.T) {
 	sink.ExpectNoCalls(t)
 }
 
+func TestRetryOnEmitError(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name        string
+		maxAttempts int
+		failures    int
+		expected    []string
+	}{
+		{
+			name:        "no_retry",
+			maxAttempts: 0,
+			failures:    1,
+			expected:    []string{"testlog3", "testlog4"},
+		},
+		{
+			name:        "retry",
+			maxAttempts: 3,
+			failures:    1,
+			expected:    []string{"testlog1", "testlog2", "testlog3", "testlog4"},
+		},
+		{
+			name:        "attempts_exhausted",
+			maxAttempts: 2,
+			failures:    2,
+			expected:    []string{"testlog4"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			tempDir := t.TempDir()
+			temp := filetest.OpenTemp(t, tempDir)
+			filetest.WriteString(t, temp, "testlog1\ntestlog2\n")
+
+			calls := 0
+			var emitted []string
+			f := newTestFactory(t, func(_ context.Context, tokens [][]byte, _ map[string]any, _ int64, _ []int64) error {
+				calls++
+				if calls <= tc.failures {
+					return errors.New("consumer refused data")
+				}
+				for _, token := range tokens {
+					emitted = append(emitted, string(token))
+				}
+				return nil
+			})
+			f.MaxEmitAttempts = tc.maxAttempts
+
+			fp, err := f.NewFingerprint(temp)
+			require.NoError(t, err)
+			reader, err := f.NewReader(filetest.OpenFile(t, temp.Name()), fp)
+			require.NoError(t, err)
+			defer reader.Close()
+
+			reader.ReadToEnd(context.Background())
+			filetest.WriteString(t, temp, "testlog3\n")
+			reader.ReadToEnd(context.Background())
+			filetest.WriteString(t, temp, "testlog4\n")
+			reader.ReadToEnd(context.Background())
+			assert.Equal(t, tc.expected, emitted)
+			assert.Zero(t, reader.EmitAttempts)
+		})
+	}
+}
+
 func BenchmarkFileRead(b *testing.B) {
 	tempDir := b.TempDir()
 
@@ -49,6 +49,7 @@ func (c Config) Build(set component.TelemetrySettings) (operator.Operator, error
 			// journalctl is an executable that is required for this operator to function
 		},
 		convertMessageBytes: c.ConvertMessageBytes,
+		convertBinaryFields: c.ConvertBinaryFields,
 		json:                jsoniter.ConfigFastest,
 	}, nil
 }
@@ -15,6 +15,7 @@ import (
 	"strconv"
 	"sync"
 	"time"
+	"unicode/utf8"
 
 	jsoniter "github.com/json-iterator/go"
 	"go.uber.org/zap"
@@ -33,6 +34,7 @@ type Input struct {
 	persister           operator.Persister
 	json                jsoniter.API
 	convertMessageBytes bool
+	convertBinaryFields bool
 	cancel              context.CancelFunc
 	wg                  sync.WaitGroup
 	errChan             chan error
@@ -190,12 +192,14 @@ func (operator *Input) runJournalctl(ctx context.Context, jctl *journalctl) erro
 				operator.Logger().Warn("Failed to parse journal entry", zap.Error(err))
 				continue
 			}
-			if err = operator.persister.Set(ctx, lastReadCursorKey, []byte(cursor)); err != nil {
-				operator.Logger().Warn("Failed to set offset", zap.Error(err))
-			}
 			if err = operator.Write(ctx, entry); err != nil {
 				operator.Logger().Error("failed to write entry", zap.Error(err))
 			}
+			// The cursor is saved once the entry is written, so an entry is read
+			// again after a restart rather than lost.
+			if err = operator.persister.Set(ctx, lastReadCursorKey, []byte(cursor)); err != nil {
+				operator.Logger().Warn("Failed to set offset", zap.Error(err))
+			}
 		}
 	}()
 
@@ -243,6 +247,12 @@ func (operator *Input) parseJournalEntry(line []byte) (*entry.Entry, string, err
 		}
 	}
 
+	if operator.convertBinaryFields {
+		for key, value := range body {
+			body[key] = decodeBinaryField(value)
+		}
+	}
+
 	delete(body, "__REALTIME_TIMESTAMP")
 
 	cursor, ok := body["__CURSOR"]
@@ -264,6 +274,48 @@ func (operator *Input) parseJournalEntry(line []byte) (*entry.Entry, string, err
 	return entry, cursorString, nil
 }
 
+// decodeBinaryField decodes the fields journalctl exports as arrays of bytes,
+// which it does for the values that are not printable or not valid UTF-8. Valid
+// UTF-8 values are converted to strings and the others to byte slices. The
+// fields with several values are exported as arrays of values, each value is
+// decoded separately. Other values are returned unchanged.
+func decodeBinaryField(value any) any {
+	values, ok := value.([]any)
+	if !ok || len(values) == 0 {
+		return value
+	}
+	if b, ok := bytesValue(values); ok {
+		if utf8.Valid(b) {
+			return string(b)
+		}
+		return b
+	}
+	decoded := make([]any, len(values))
+	for i, v := range values {
+		if _, isString := v.(string); !isString {
+			if b, isBytes := v.([]any); !isBytes || len(b) == 0 {
+				return value
+			}
+		}
+		decoded[i] = decodeBinaryField(v)
+	}
+	return decoded
+}
+
+// bytesValue returns the bytes of an array of numbers, if all the numbers are
+// bytes.
+func bytesValue(values []any) ([]byte, bool) {
+	b := make([]byte, 0, len(values))
+	for _, v := range values {
+		f, ok := v.(float64)
+		if !ok || f < 0 || f > 255 || f != float64(int(f)) {
+			return nil, false
+		}
+		b = append(b, byte(f))
+	}
+	return b, true
+}
+
 // Stop will stop generating logs.
 func (operator *Input) Stop() error {
 	if operator.cancel != nil {
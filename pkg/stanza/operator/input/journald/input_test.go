@@ -269,3 +269,111 @@ func TestInputJournaldError(t *testing.T) {
 	assert.EqualError(t, err, "journalctl command failed: start journalctl: fail to start")
 	require.NoError(t, op.Stop())
 }
+
+func TestDecodeBinaryField(t *testing.T) {
+	testCases := []struct {
+		name     string
+		value    any
+		expected any
+	}{
+		{
+			name:     "string",
+			value:    "message",
+			expected: "message",
+		},
+		{
+			name:     "utf8 bytes",
+			value:    []any{float64('h'), float64('i'), float64('\n')},
+			expected: "hi\n",
+		},
+		{
+			name:     "invalid utf8 bytes",
+			value:    []any{float64(0xff), float64(0xfe), float64(1)},
+			expected: []byte{0xff, 0xfe, 1},
+		},
+		{
+			name:     "multiple values",
+			value:    []any{"first", []any{float64('o'), float64('k')}, []any{float64(0xff)}},
+			expected: []any{"first", "ok", []byte{0xff}},
+		},
+		{
+			name:     "not bytes",
+			value:    []any{float64(1), float64(256)},
+			expected: []any{float64(1), float64(256)},
+		},
+		{
+			name:     "mixed values",
+			value:    []any{"first", float64(1)},
+			expected: []any{"first", float64(1)},
+		},
+		{
+			name:     "empty",
+			value:    []any{},
+			expected: []any{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, decodeBinaryField(tc.value))
+		})
+	}
+}
+
+func TestParseJournalEntryBinaryFields(t *testing.T) {
+	line := []byte(`{"__REALTIME_TIMESTAMP": "1587047866229555", "__CURSOR": "s=b1e713b587ae4001a9ca482c4b12c005", "MESSAGE": [104, 105], "DATA": [255, 0], "TAG": ["a", [98]]}`)
+
+	testCases := []struct {
+		name                string
+		convertMessageBytes bool
+		convertBinaryFields bool
+		expected            map[string]any
+	}{
+		{
+			name: "no conversion",
+			expected: map[string]any{
+				"__CURSOR": "s=b1e713b587ae4001a9ca482c4b12c005",
+				"MESSAGE":  []any{float64(104), float64(105)},
+				"DATA":     []any{float64(255), float64(0)},
+				"TAG":      []any{"a", []any{float64(98)}},
+			},
+		},
+		{
+			name:                "message bytes",
+			convertMessageBytes: true,
+			expected: map[string]any{
+				"__CURSOR": "s=b1e713b587ae4001a9ca482c4b12c005",
+				"MESSAGE":  "hi",
+				"DATA":     []any{float64(255), float64(0)},
+				"TAG":      []any{"a", []any{float64(98)}},
+			},
+		},
+		{
+			name:                "binary fields",
+			convertBinaryFields: true,
+			expected: map[string]any{
+				"__CURSOR": "s=b1e713b587ae4001a9ca482c4b12c005",
+				"MESSAGE":  "hi",
+				"DATA":     []byte{255, 0},
+				"TAG":      []any{"a", "b"},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := NewConfigWithID("my_journald_input")
+			cfg.ConvertMessageBytes = tc.convertMessageBytes
+			cfg.ConvertBinaryFields = tc.convertBinaryFields
+
+			op, err := cfg.Build(componenttest.NewNopTelemetrySettings())
+			require.NoError(t, err)
+
+			e, cursor, err := op.(*Input).parseJournalEntry(line)
+			require.NoError(t, err)
+			require.Equal(t, "s=b1e713b587ae4001a9ca482c4b12c005", cursor)
+			require.Equal(t, tc.expected, e.Body)
+			require.Equal(t, time.Unix(0, 1587047866229555*1000), e.Timestamp)
+		})
+	}
+}
@@ -69,6 +69,9 @@ func (c Config) Build(set component.TelemetrySettings) (operator.Operator, error
 		if syslogParserCfg.EnableOctetCounting {
 			tcpInputCfg.SplitFuncBuilder = OctetSplitFuncBuilder
 		}
+		if syslogParserCfg.DetectOctetCounting {
+			tcpInputCfg.SplitFuncBuilder = detectOctetSplitFuncBuilder(*c.TCP)
+		}
 
 		tcpInput, err := tcpInputCfg.Build(set)
 		if err != nil {
@@ -94,7 +97,7 @@ func (c Config) Build(set component.TelemetrySettings) (operator.Operator, error
 		udpInputCfg.BaseConfig = *c.UDP
 
 		// Octet counting and Non-Transparent-Framing are invalid for UDP connections
-		if syslogParserCfg.EnableOctetCounting || syslogParserCfg.NonTransparentFramingTrailer != nil {
+		if syslogParserCfg.EnableOctetCounting || syslogParserCfg.DetectOctetCounting || syslogParserCfg.NonTransparentFramingTrailer != nil {
 			return nil, errors.New("octet_counting and non_transparent_framing is not compatible with UDP")
 		}
 
@@ -51,6 +51,56 @@ func OctetSplitFuncBuilder(_ encoding.Encoding) (bufio.SplitFunc, error) {
 	return newOctetFrameSplitFunc(true), nil
 }
 
+// detectOctetSplitFuncBuilder splits octet counted messages by their length, and other messages
+// using the configured multiline settings of the tcp input.
+func detectOctetSplitFuncBuilder(tcpCfg tcp.BaseConfig) tcp.SplitFuncBuilder {
+	return func(enc encoding.Encoding) (bufio.SplitFunc, error) {
+		maxLogSize := int(tcpCfg.MaxLogSize)
+		if maxLogSize == 0 {
+			maxLogSize = tcp.DefaultMaxLogSize
+		}
+		lineSplitFunc, err := tcpCfg.SplitConfig.Func(enc, true, maxLogSize)
+		if err != nil {
+			return nil, err
+		}
+		return newDetectOctetFrameSplitFunc(lineSplitFunc), nil
+	}
+}
+
+// newDetectOctetFrameSplitFunc checks the start of every message to detect if it is octet counted,
+// so each connection can use either framing.
+func newDetectOctetFrameSplitFunc(lineSplitFunc bufio.SplitFunc) bufio.SplitFunc {
+	octetSplitFunc := newOctetFrameSplitFunc(true)
+	return func(data []byte, atEOF bool) (int, []byte, error) {
+		octetCounted, complete := isOctetCounted(data, atEOF)
+		if !complete {
+			// Request more data
+			return 0, nil, nil
+		}
+		if octetCounted {
+			return octetSplitFunc(data, atEOF)
+		}
+		return lineSplitFunc(data, atEOF)
+	}
+}
+
+// isOctetCounted reports if the data starts with a message length followed by a space and the
+// start of a syslog message. complete is false when more data is needed to decide.
+func isOctetCounted(data []byte, atEOF bool) (octetCounted, complete bool) {
+	digits := 0
+	for digits < len(data) && data[digits] >= '0' && data[digits] <= '9' {
+		digits++
+	}
+	if digits == 0 || data[0] == '0' {
+		return false, true
+	}
+	if digits+1 >= len(data) {
+		// The length, or the space after it, is incomplete
+		return false, atEOF
+	}
+	return data[digits] == ' ' && data[digits+1] == '<', true
+}
+
 func newOctetFrameSplitFunc(flushAtEOF bool) bufio.SplitFunc {
 	frameRegex := regexp.MustCompile(`^[1-9]\d*\s`)
 	return func(data []byte, atEOF bool) (int, []byte, error) {
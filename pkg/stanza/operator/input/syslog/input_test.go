@@ -11,6 +11,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/component/componenttest"
+	"golang.org/x/text/encoding/unicode"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/entry"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator"
@@ -328,3 +329,57 @@ func TestOctetFramingSplitFunc(t *testing.T) {
 		t.Run(tc.name, splittest.New(splitFunc, tc.input, tc.steps...))
 	}
 }
+
+func TestDetectOctetFramingSplitFunc(t *testing.T) {
+	testCases := []struct {
+		name  string
+		input []byte
+		steps []splittest.Step
+	}{
+		{
+			name:  "OctetCounted",
+			input: []byte(`9 <13>1 - -9 <13>1 - -`),
+			steps: []splittest.Step{
+				splittest.ExpectToken(`9 <13>1 - -`),
+				splittest.ExpectToken(`9 <13>1 - -`),
+			},
+		},
+		{
+			name:  "NonTransparent",
+			input: []byte("<13>1 - -\n<13>1 - -\n"),
+			steps: []splittest.Step{
+				splittest.ExpectAdvanceToken(10, `<13>1 - -`),
+				splittest.ExpectAdvanceToken(10, `<13>1 - -`),
+			},
+		},
+		{
+			name:  "NonTransparentStartingWithDigits",
+			input: []byte("123 not octet counted\n"),
+			steps: []splittest.Step{
+				splittest.ExpectAdvanceToken(22, `123 not octet counted`),
+			},
+		},
+		{
+			name:  "Mixed",
+			input: []byte("9 <13>1 - -<13>1 - -\n9 <13>1 - -"),
+			steps: []splittest.Step{
+				splittest.ExpectToken(`9 <13>1 - -`),
+				splittest.ExpectAdvanceToken(10, `<13>1 - -`),
+				splittest.ExpectToken(`9 <13>1 - -`),
+			},
+		},
+		{
+			name:  "LengthOnly",
+			input: []byte(`215`),
+			steps: []splittest.Step{
+				splittest.ExpectToken(`215`),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		splitFunc, err := detectOctetSplitFuncBuilder(tcp.NewConfig().BaseConfig)(unicode.UTF8)
+		require.NoError(t, err)
+		t.Run(tc.name, splittest.New(splitFunc, tc.input, tc.steps...))
+	}
+}
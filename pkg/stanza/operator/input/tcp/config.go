@@ -63,15 +63,16 @@ type Config struct {
 
 // BaseConfig is the detailed configuration of a tcp input operator.
 type BaseConfig struct {
-	MaxLogSize       helper.ByteSize         `mapstructure:"max_log_size,omitempty"`
-	ListenAddress    string                  `mapstructure:"listen_address,omitempty"`
-	TLS              *configtls.ServerConfig `mapstructure:"tls,omitempty"`
-	AddAttributes    bool                    `mapstructure:"add_attributes,omitempty"`
-	OneLogPerPacket  bool                    `mapstructure:"one_log_per_packet,omitempty"`
-	Encoding         string                  `mapstructure:"encoding,omitempty"`
-	SplitConfig      split.Config            `mapstructure:"multiline,omitempty"`
-	TrimConfig       trim.Config             `mapstructure:",squash"`
-	SplitFuncBuilder SplitFuncBuilder        `mapstructure:"-"`
+	MaxLogSize         helper.ByteSize         `mapstructure:"max_log_size,omitempty"`
+	ListenAddress      string                  `mapstructure:"listen_address,omitempty"`
+	TLS                *configtls.ServerConfig `mapstructure:"tls,omitempty"`
+	AllowedClientNames []string                `mapstructure:"allowed_client_names,omitempty"`
+	AddAttributes      bool                    `mapstructure:"add_attributes,omitempty"`
+	OneLogPerPacket    bool                    `mapstructure:"one_log_per_packet,omitempty"`
+	Encoding           string                  `mapstructure:"encoding,omitempty"`
+	SplitConfig        split.Config            `mapstructure:"multiline,omitempty"`
+	TrimConfig         trim.Config             `mapstructure:",squash"`
+	SplitFuncBuilder   SplitFuncBuilder        `mapstructure:"-"`
 }
 
 type SplitFuncBuilder func(enc encoding.Encoding) (bufio.SplitFunc, error)
@@ -140,11 +141,18 @@ func (c Config) Build(set component.TelemetrySettings) (operator.Operator, error
 		resolver: resolver,
 	}
 
+	if len(c.AllowedClientNames) > 0 && (c.TLS == nil || c.TLS.ClientCAFile == "") {
+		return nil, errors.New("'allowed_client_names' requires 'tls' with 'client_ca_file' to verify client certificates")
+	}
+
 	if c.TLS != nil {
 		tcpInput.tls, err = c.TLS.LoadTLSConfig(context.Background())
 		if err != nil {
 			return nil, err
 		}
+		if len(c.AllowedClientNames) > 0 {
+			tcpInput.tls.VerifyConnection = verifyClientName(c.AllowedClientNames)
+		}
 	}
 
 	return tcpInput, nil
@@ -9,9 +9,11 @@ import (
 	"context"
 	"crypto/rand"
 	"crypto/tls"
+	"errors"
 	"fmt"
 	"io"
 	"net"
+	"slices"
 	"strconv"
 	"sync"
 	"time"
@@ -186,6 +188,12 @@ func (i *Input) handleMessage(ctx context.Context, conn net.Conn, dec *encoding.
 			entry.AddAttribute("net.host.port", strconv.FormatInt(int64(addr.Port), 10))
 			entry.AddAttribute("net.host.name", i.resolver.GetHostFromIP(ip))
 		}
+
+		if tlsConn, ok := conn.(*tls.Conn); ok {
+			if certs := tlsConn.ConnectionState().PeerCertificates; len(certs) > 0 {
+				entry.AddAttribute("tls.client.subject", certs[0].Subject.String())
+			}
+		}
 	}
 
 	err = i.Write(ctx, entry)
@@ -194,6 +202,26 @@ func (i *Input) handleMessage(ctx context.Context, conn net.Conn, dec *encoding.
 	}
 }
 
+// verifyClientName rejects connections of which the client certificate doesn't have one of the
+// allowed names as common name or DNS subject alternative name.
+func verifyClientName(allowedNames []string) func(tls.ConnectionState) error {
+	return func(state tls.ConnectionState) error {
+		if len(state.PeerCertificates) == 0 {
+			return errors.New("no client certificate provided")
+		}
+		cert := state.PeerCertificates[0]
+		if slices.Contains(allowedNames, cert.Subject.CommonName) {
+			return nil
+		}
+		for _, name := range cert.DNSNames {
+			if slices.Contains(allowedNames, name) {
+				return nil
+			}
+		}
+		return fmt.Errorf("client certificate %q is not allowed", cert.Subject.String())
+	}
+}
+
 func truncateMaxLog(data []byte, maxLogSize int) (token []byte) {
 	if len(data) >= maxLogSize {
 		return data[:maxLogSize]
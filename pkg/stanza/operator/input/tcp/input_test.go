@@ -9,6 +9,7 @@ import (
 	"math/rand/v2"
 	"net"
 	"os"
+	"path/filepath"
 	"strconv"
 	"testing"
 	"time"
@@ -325,6 +326,16 @@ func TestBuild(t *testing.T) {
 			},
 			true,
 		},
+		{
+			"allowed-client-names-without-client-ca",
+			Config{
+				BaseConfig: BaseConfig{
+					ListenAddress:      "10.0.0.1:9000",
+					AllowedClientNames: []string{"client"},
+				},
+			},
+			true,
+		},
 		{
 			"tls-enabled-with-no-such-file-error",
 			Config{
@@ -349,6 +360,7 @@ func TestBuild(t *testing.T) {
 			cfg.ListenAddress = tc.inputBody.ListenAddress
 			cfg.MaxLogSize = tc.inputBody.MaxLogSize
 			cfg.TLS = tc.inputBody.TLS
+			cfg.AllowedClientNames = tc.inputBody.AllowedClientNames
 			set := componenttest.NewNopTelemetrySettings()
 			_, err := cfg.Build(set)
 			if tc.expectErr {
@@ -375,6 +387,93 @@ func TestTLSTCPInput(t *testing.T) {
 	t.Run("CarriageReturn", tlsInputTest([]byte("message\r\n"), []string{"message"}))
 }
 
+func TestMutualTLSTCPInput(t *testing.T) {
+	dir := t.TempDir()
+	certFile := filepath.Join(dir, "test.crt")
+	keyFile := filepath.Join(dir, "test.key")
+	require.NoError(t, os.WriteFile(certFile, []byte(testTLSCertificate+"\n"), 0o600))
+	require.NoError(t, os.WriteFile(keyFile, []byte(testTLSPrivateKey+"\n"), 0o600))
+	clientCert, err := tls.LoadX509KeyPair(certFile, keyFile)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name         string
+		allowedNames []string
+		expectEntry  bool
+	}{
+		{
+			name:        "NoAllowedNames",
+			expectEntry: true,
+		},
+		{
+			name:         "AllowedName",
+			allowedNames: []string{"other", "Stanza"},
+			expectEntry:  true,
+		},
+		{
+			name:         "NotAllowedName",
+			allowedNames: []string{"other"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := NewConfigWithID("test_id")
+			cfg.ListenAddress = ":0"
+			cfg.AddAttributes = true
+			cfg.AllowedClientNames = tc.allowedNames
+			// the self-signed test certificate is used by both the server and the client
+			cfg.TLS = &configtls.ServerConfig{
+				Config: configtls.Config{
+					CertFile: certFile,
+					KeyFile:  keyFile,
+				},
+				ClientCAFile: certFile,
+			}
+
+			op, err := cfg.Build(componenttest.NewNopTelemetrySettings())
+			require.NoError(t, err)
+
+			mockOutput := testutil.Operator{}
+			tcpInput := op.(*Input)
+			tcpInput.OutputOperators = []operator.Operator{&mockOutput}
+
+			entryChan := make(chan *entry.Entry, 1)
+			mockOutput.On("Process", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+				entryChan <- args.Get(1).(*entry.Entry)
+			}).Return(nil)
+
+			require.NoError(t, tcpInput.Start(testutil.NewUnscopedMockPersister()))
+			defer func() {
+				require.NoError(t, tcpInput.Stop(), "expected to stop tcp input operator without error")
+			}()
+
+			conn, err := tls.Dial("tcp", tcpInput.listener.Addr().String(), &tls.Config{
+				InsecureSkipVerify: true,
+				Certificates:       []tls.Certificate{clientCert},
+			})
+			if !tc.expectEntry && err != nil {
+				// the handshake failed on the rejected client certificate
+				return
+			}
+			require.NoError(t, err)
+			defer conn.Close()
+
+			// the write can fail when the server already rejected the client certificate
+			_, _ = conn.Write([]byte("message\n"))
+
+			select {
+			case e := <-entryChan:
+				require.True(t, tc.expectEntry, "Unexpected entry: %s", e)
+				require.Equal(t, "message", e.Body)
+				require.Contains(t, e.Attributes["tls.client.subject"], "CN=Stanza")
+			case <-time.After(time.Second):
+				require.False(t, tc.expectEntry, "Timed out waiting for message to be written")
+			}
+		})
+	}
+}
+
 func TestFailToBind(t *testing.T) {
 	ip := "localhost"
 	port := 0
@@ -41,6 +41,9 @@ type Config struct {
 	ExcludeProviders         []string      `mapstructure:"exclude_providers,omitempty"`
 	Remote                   RemoteConfig  `mapstructure:"remote,omitempty"`
 	Query                    *string       `mapstructure:"query,omitempty"`
+	// DataAttributes maps the names of the fields of the event data and the
+	// user data to the names of the log attributes they are copied to.
+	DataAttributes map[string]string `mapstructure:"data_attributes,omitempty"`
 }
 
 // RemoteConfig is the configuration for a remote server.
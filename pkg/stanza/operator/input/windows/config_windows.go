@@ -45,6 +45,12 @@ func (c *Config) Build(set component.TelemetrySettings) (operator.Operator, erro
 		return nil, errors.New("remote configuration must have non-empty `username` and `password`")
 	}
 
+	for field, attribute := range c.DataAttributes {
+		if field == "" || attribute == "" {
+			return nil, errors.New("the `data_attributes` field must map non-empty field names to non-empty attribute names")
+		}
+	}
+
 	input := &Input{
 		InputOperator:            inputOperator,
 		buffer:                   NewBuffer(),
@@ -59,6 +65,7 @@ func (c *Config) Build(set component.TelemetrySettings) (operator.Operator, erro
 		excludeProviders:         excludeProvidersSet(c.ExcludeProviders),
 		remote:                   c.Remote,
 		query:                    c.Query,
+		dataAttributes:           c.DataAttributes,
 	}
 	input.startRemoteSession = input.defaultStartRemoteSession
 
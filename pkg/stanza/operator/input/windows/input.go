@@ -46,6 +46,7 @@ type Input struct {
 	remoteSessionHandle      windows.Handle
 	startRemoteSession       func() error
 	processEvent             func(context.Context, Event) error
+	dataAttributes           map[string]string
 }
 
 // newInput creates a new Input operator.
@@ -344,6 +345,10 @@ func (i *Input) sendEvent(ctx context.Context, eventXML *EventXML) error {
 		e.AddAttribute("server.address", i.remote.Server)
 	}
 
+	for attribute, value := range dataAttributes(eventXML, i.dataAttributes) {
+		e.AddAttribute(attribute, value)
+	}
+
 	if i.includeLogRecordOriginal {
 		e.AddAttribute(string(semconv.LogRecordOriginalKey), eventXML.Original)
 	}
@@ -32,6 +32,7 @@ type EventXML struct {
 	Security         *Security   `xml:"System>Security"`
 	Execution        *Execution  `xml:"System>Execution"`
 	EventData        EventData   `xml:"EventData"`
+	UserData         *UserData   `xml:"UserData"`
 }
 
 // parseTimestamp will parse the timestamp of the event.
@@ -121,6 +122,10 @@ func formattedBody(e *EventXML) map[string]any {
 		body["details"] = details
 	}
 
+	if e.UserData != nil {
+		body["user_data"] = parseUserData(e.UserData)
+	}
+
 	if e.Security != nil && e.Security.UserID != "" {
 		body["security"] = map[string]any{
 			"user_id": e.Security.UserID,
@@ -171,6 +176,50 @@ func parseEventData(eventData EventData) map[string]any {
 	return outputMap
 }
 
+// parseUserData parses the user data in the same format as the event data, the
+// name is the name of the element defined by the provider.
+func parseUserData(userData *UserData) map[string]any {
+	outputMap := map[string]any{
+		"name": userData.Content.XMLName.Local,
+	}
+	if len(userData.Content.Fields) == 0 {
+		return outputMap
+	}
+
+	dataMaps := make([]any, len(userData.Content.Fields))
+	for i, field := range userData.Content.Fields {
+		dataMaps[i] = map[string]any{
+			field.XMLName.Local: field.Value,
+		}
+	}
+	outputMap["data"] = dataMaps
+
+	return outputMap
+}
+
+// dataAttributes returns the attributes mapped from the named fields of the
+// event data and the user data, keyed by attribute name. The fields missing from
+// the event are skipped.
+func dataAttributes(e *EventXML, mapping map[string]string) map[string]string {
+	if len(mapping) == 0 {
+		return nil
+	}
+	attributes := map[string]string{}
+	for _, data := range e.EventData.Data {
+		if attribute, ok := mapping[data.Name]; ok && data.Name != "" {
+			attributes[attribute] = data.Value
+		}
+	}
+	if e.UserData != nil {
+		for _, field := range e.UserData.Content.Fields {
+			if attribute, ok := mapping[field.XMLName.Local]; ok {
+				attributes[attribute] = field.Value
+			}
+		}
+	}
+	return attributes
+}
+
 // EventID is the identifier of the event.
 type EventID struct {
 	Qualifiers uint16 `xml:"Qualifiers,attr"`
@@ -203,6 +252,25 @@ type Data struct {
 	Value string `xml:",chardata"`
 }
 
+// UserData is the content of the events whose schema is defined by the provider
+// rather than with EventData. It holds a single element with the fields of the event.
+// https://learn.microsoft.com/en-us/windows/win32/wes/eventschema-userdatatype-complextype
+type UserData struct {
+	Content UserDataContent `xml:",any"`
+}
+
+// UserDataContent is the element defined by the provider in the user data.
+type UserDataContent struct {
+	XMLName xml.Name
+	Fields  []UserDataField `xml:",any"`
+}
+
+// UserDataField is a field of the user data. Nested elements are not supported.
+type UserDataField struct {
+	XMLName xml.Name
+	Value   string `xml:",chardata"`
+}
+
 // Security contains info pertaining to the user triggering the event.
 type Security struct {
 	UserID string `xml:"UserID,attr"`
@@ -4,6 +4,7 @@
 package windows
 
 import (
+	"encoding/xml"
 	"os"
 	"path/filepath"
 	"testing"
@@ -538,8 +539,71 @@ func TestUnmarshalWithUserData(t *testing.T) {
 			ProcessID: 1472,
 			ThreadID:  7784,
 		},
+		UserData: &UserData{
+			Content: UserDataContent{
+				XMLName: xml.Name{Space: "http://manifests.microsoft.com/win/2004/08/windows/eventlog", Local: "LogFileCleared"},
+				Fields: []UserDataField{
+					userDataField("SubjectUserSid", "S-1-5-21-1148437859-4135665037-1195073887-1000"),
+					userDataField("SubjectUserName", "test_user"),
+					userDataField("SubjectDomainName", "TEST"),
+					userDataField("SubjectLogonId", "0xa8bb72"),
+					userDataField("ClientProcessId", "4536"),
+					userDataField("ClientProcessStartKey", "17732923532772643"),
+				},
+			},
+		},
 		Original: string(data),
 	}
 
 	require.Equal(t, xml, event)
 }
+
+func userDataField(name, value string) UserDataField {
+	return UserDataField{
+		XMLName: xml.Name{Space: "http://manifests.microsoft.com/win/2004/08/windows/eventlog", Local: name},
+		Value:   value,
+	}
+}
+
+func TestParseUserData(t *testing.T) {
+	data, err := os.ReadFile(filepath.Join("testdata", "xmlSampleUserData.xml"))
+	require.NoError(t, err)
+
+	event, err := unmarshalEventXML(data)
+	require.NoError(t, err)
+
+	expected := map[string]any{
+		"name": "LogFileCleared",
+		"data": []any{
+			map[string]any{"SubjectUserSid": "S-1-5-21-1148437859-4135665037-1195073887-1000"},
+			map[string]any{"SubjectUserName": "test_user"},
+			map[string]any{"SubjectDomainName": "TEST"},
+			map[string]any{"SubjectLogonId": "0xa8bb72"},
+			map[string]any{"ClientProcessId": "4536"},
+			map[string]any{"ClientProcessStartKey": "17732923532772643"},
+		},
+	}
+	require.Equal(t, expected, formattedBody(event)["user_data"])
+}
+
+func TestDataAttributes(t *testing.T) {
+	eventData, err := os.ReadFile(filepath.Join("testdata", "xmlSample.xml"))
+	require.NoError(t, err)
+	eventDataEvent, err := unmarshalEventXML(eventData)
+	require.NoError(t, err)
+
+	userData, err := os.ReadFile(filepath.Join("testdata", "xmlSampleUserData.xml"))
+	require.NoError(t, err)
+	userDataEvent, err := unmarshalEventXML(userData)
+	require.NoError(t, err)
+
+	mapping := map[string]string{
+		"Time":            "event.time",
+		"SubjectUserName": "user.name",
+		"Missing":         "missing",
+	}
+
+	require.Equal(t, map[string]string{"event.time": "2022-04-28T19:48:52Z"}, dataAttributes(eventDataEvent, mapping))
+	require.Equal(t, map[string]string{"user.name": "test_user"}, dataAttributes(userDataEvent, mapping))
+	require.Nil(t, dataAttributes(userDataEvent, nil))
+}
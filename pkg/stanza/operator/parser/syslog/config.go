@@ -53,6 +53,7 @@ type BaseConfig struct {
 	Protocol                     string  `mapstructure:"protocol,omitempty"`
 	Location                     string  `mapstructure:"location,omitempty"`
 	EnableOctetCounting          bool    `mapstructure:"enable_octet_counting,omitempty"`
+	DetectOctetCounting          bool    `mapstructure:"detect_octet_counting,omitempty"`
 	AllowSkipPriHeader           bool    `mapstructure:"allow_skip_pri_header,omitempty"`
 	NonTransparentFramingTrailer *string `mapstructure:"non_transparent_framing_trailer,omitempty"`
 	MaxOctets                    int     `mapstructure:"max_octets,omitempty"`
@@ -80,8 +81,12 @@ func (c Config) Build(set component.TelemetrySettings) (operator.Operator, error
 		return nil, errors.New("missing field 'protocol'")
 	case proto != RFC5424 && (c.NonTransparentFramingTrailer != nil || c.EnableOctetCounting):
 		return nil, errors.New("octet_counting and non_transparent_framing are only compatible with protocol rfc5424")
+	case proto != RFC5424 && c.DetectOctetCounting:
+		return nil, errors.New("detect_octet_counting is only compatible with protocol rfc5424")
 	case proto == RFC5424 && (c.NonTransparentFramingTrailer != nil && c.EnableOctetCounting):
 		return nil, errors.New("only one of octet_counting or non_transparent_framing can be enabled")
+	case proto == RFC5424 && c.DetectOctetCounting && (c.NonTransparentFramingTrailer != nil || c.EnableOctetCounting):
+		return nil, errors.New("detect_octet_counting can't be combined with octet_counting or non_transparent_framing")
 	case proto == RFC5424 && c.NonTransparentFramingTrailer != nil:
 		if *c.NonTransparentFramingTrailer != NULTrailer && *c.NonTransparentFramingTrailer != LFTrailer {
 			return nil, fmt.Errorf("invalid non_transparent_framing_trailer '%s'. Must be either 'LF' or 'NUL'", *c.NonTransparentFramingTrailer)
@@ -104,6 +109,7 @@ func (c Config) Build(set component.TelemetrySettings) (operator.Operator, error
 		protocol:                     proto,
 		location:                     location,
 		enableOctetCounting:          c.EnableOctetCounting,
+		detectOctetCounting:          c.DetectOctetCounting,
 		allowSkipPriHeader:           c.AllowSkipPriHeader,
 		nonTransparentFramingTrailer: c.NonTransparentFramingTrailer,
 		maxOctets:                    c.MaxOctets,
@@ -197,6 +197,40 @@ func TestRFC6587ConfigOptions(t *testing.T) {
 			},
 			errContents: "",
 		},
+		{
+			desc: "Octet Counting detection with RFC3164",
+			cfg: &Config{
+				ParserConfig: helper.NewParserConfig(operatorType, operatorType),
+				BaseConfig: BaseConfig{
+					Protocol:            RFC3164,
+					DetectOctetCounting: true,
+				},
+			},
+			errContents: "detect_octet_counting is only compatible with protocol rfc5424",
+		},
+		{
+			desc: "Octet Counting detection and Octet Counting both enabled",
+			cfg: &Config{
+				ParserConfig: helper.NewParserConfig(operatorType, operatorType),
+				BaseConfig: BaseConfig{
+					Protocol:            RFC5424,
+					DetectOctetCounting: true,
+					EnableOctetCounting: true,
+				},
+			},
+			errContents: "detect_octet_counting can't be combined with octet_counting or non_transparent_framing",
+		},
+		{
+			desc: "Valid Octet Counting detection",
+			cfg: &Config{
+				ParserConfig: helper.NewParserConfig(operatorType, operatorType),
+				BaseConfig: BaseConfig{
+					Protocol:            RFC5424,
+					DetectOctetCounting: true,
+				},
+			},
+			errContents: "",
+		},
 		{
 			desc: "Invalid Non-Transparent-Framing Trailer",
 			cfg: &Config{
@@ -21,7 +21,11 @@ import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator/helper"
 )
 
-var priRegex = regexp.MustCompile(`<\d{1,3}>`)
+var (
+	priRegex = regexp.MustCompile(`<\d{1,3}>`)
+	// octetCountedRegex matches the start of a RFC6587 octet counted message
+	octetCountedRegex = regexp.MustCompile(`^[1-9]\d* <`)
+)
 
 // parseFunc a parseFunc determines how the raw input is to be parsed into a syslog message
 type parseFunc func(input []byte) (sl.Message, error)
@@ -32,6 +36,7 @@ type Parser struct {
 	protocol                     string
 	location                     *time.Location
 	enableOctetCounting          bool
+	detectOctetCounting          bool
 	allowSkipPriHeader           bool
 	nonTransparentFramingTrailer *string
 	maxOctets                    int
@@ -101,6 +106,15 @@ func (p *Parser) buildParseFunc() (parseFunc, error) {
 		// Octet Counting Parsing RFC6587
 		case p.enableOctetCounting:
 			return newOctetCountingParseFunc(p.maxOctets), nil
+		// Octet Counting or Non-Transparent-Framing, detected for every message
+		case p.detectOctetCounting:
+			octetCounting := newOctetCountingParseFunc(p.maxOctets)
+			return func(input []byte) (sl.Message, error) {
+				if octetCountedRegex.Match(input) {
+					return octetCounting(input)
+				}
+				return p.parseRawRFC5424(input)
+			}, nil
 		// Non-Transparent-Framing Parsing RFC6587
 		case p.nonTransparentFramingTrailer != nil && *p.nonTransparentFramingTrailer == LFTrailer:
 			return newNonTransparentFramingParseFunc(nontransparent.LF), nil
@@ -108,12 +122,7 @@ func (p *Parser) buildParseFunc() (parseFunc, error) {
 			return newNonTransparentFramingParseFunc(nontransparent.NUL), nil
 		// Raw RFC5424 parsing
 		default:
-			return func(input []byte) (sl.Message, error) {
-				if p.allowSkipPriHeader && !priRegex.Match(input) {
-					input = append([]byte("<0>"), input...)
-				}
-				return rfc5424.NewMachine().Parse(input)
-			}, nil
+			return p.parseRawRFC5424, nil
 		}
 
 	default:
@@ -121,12 +130,19 @@ func (p *Parser) buildParseFunc() (parseFunc, error) {
 	}
 }
 
+func (p *Parser) parseRawRFC5424(input []byte) (sl.Message, error) {
+	if p.allowSkipPriHeader && !priRegex.Match(input) {
+		input = append([]byte("<0>"), input...)
+	}
+	return rfc5424.NewMachine().Parse(input)
+}
+
 func (p *Parser) shouldSkipPriorityValues(value []byte) bool {
 	if !p.enableOctetCounting && p.allowSkipPriHeader {
 		// check if entry starts with '<'.
 		// if not it means that the pre header was missing from the body and hence we should skip it.
 		if len(value) > 1 && value[0] != '<' {
-			return true
+			return !p.detectOctetCounting || !octetCountedRegex.Match(value)
 		}
 	}
 	return false
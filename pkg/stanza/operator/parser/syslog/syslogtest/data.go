@@ -344,6 +344,82 @@ func CreateCases(basicConfig func() *syslog.Config) ([]Case, error) {
 			true,
 			false,
 		},
+		{
+			"RFC6587 Detected Octet Counting",
+			func() *syslog.Config {
+				cfg := basicConfig()
+				cfg.Protocol = syslog.RFC5424
+				cfg.DetectOctetCounting = true
+				return cfg
+			}(),
+			&entry.Entry{
+				Body: `215 <86>1 2015-08-05T21:58:59.693Z 192.168.2.132 SecureAuth0 23108 ID52020 [SecureAuth@27389 UserHostAddress="192.168.2.132" Realm="SecureAuth0" UserID="Tester2" PEN="27389"] Found the user for retrieving user's profile`,
+			},
+			&entry.Entry{
+				Timestamp:    time.Date(2015, 8, 5, 21, 58, 59, 693000000, time.UTC),
+				Severity:     entry.Info,
+				SeverityText: "info",
+				Attributes: map[string]any{
+					"appname":  "SecureAuth0",
+					"facility": 10,
+					"hostname": "192.168.2.132",
+					"message":  "Found the user for retrieving user's profile",
+					"msg_id":   "ID52020",
+					"priority": 86,
+					"proc_id":  "23108",
+					"structured_data": map[string]any{
+						"SecureAuth@27389": map[string]any{
+							"PEN":             "27389",
+							"Realm":           "SecureAuth0",
+							"UserHostAddress": "192.168.2.132",
+							"UserID":          "Tester2",
+						},
+					},
+					"version": 1,
+				},
+				Body: `215 <86>1 2015-08-05T21:58:59.693Z 192.168.2.132 SecureAuth0 23108 ID52020 [SecureAuth@27389 UserHostAddress="192.168.2.132" Realm="SecureAuth0" UserID="Tester2" PEN="27389"] Found the user for retrieving user's profile`,
+			},
+			true,
+			false,
+		},
+		{
+			"RFC6587 Detected Non-Transparent-Framing",
+			func() *syslog.Config {
+				cfg := basicConfig()
+				cfg.Protocol = syslog.RFC5424
+				cfg.DetectOctetCounting = true
+				return cfg
+			}(),
+			&entry.Entry{
+				Body: `<86>1 2015-08-05T21:58:59.693Z 192.168.2.132 SecureAuth0 23108 ID52020 [SecureAuth@27389 UserHostAddress="192.168.2.132" Realm="SecureAuth0" UserID="Tester2" PEN="27389"] Found the user for retrieving user's profile`,
+			},
+			&entry.Entry{
+				Timestamp:    time.Date(2015, 8, 5, 21, 58, 59, 693000000, time.UTC),
+				Severity:     entry.Info,
+				SeverityText: "info",
+				Attributes: map[string]any{
+					"appname":  "SecureAuth0",
+					"facility": 10,
+					"hostname": "192.168.2.132",
+					"message":  "Found the user for retrieving user's profile",
+					"msg_id":   "ID52020",
+					"priority": 86,
+					"proc_id":  "23108",
+					"structured_data": map[string]any{
+						"SecureAuth@27389": map[string]any{
+							"PEN":             "27389",
+							"Realm":           "SecureAuth0",
+							"UserHostAddress": "192.168.2.132",
+							"UserID":          "Tester2",
+						},
+					},
+					"version": 1,
+				},
+				Body: `<86>1 2015-08-05T21:58:59.693Z 192.168.2.132 SecureAuth0 23108 ID52020 [SecureAuth@27389 UserHostAddress="192.168.2.132" Realm="SecureAuth0" UserID="Tester2" PEN="27389"] Found the user for retrieving user's profile`,
+			},
+			true,
+			false,
+		},
 		{
 			RFC6587OctetCountingPreserveSpaceTest,
 			func() *syslog.Config {
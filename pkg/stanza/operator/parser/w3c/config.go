@@ -0,0 +1,97 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package w3c // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator/parser/w3c"
+
+import (
+	"errors"
+	"maps"
+
+	"go.opentelemetry.io/collector/component"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator/helper"
+)
+
+const operatorType = "w3c_parser"
+
+func init() {
+	operator.Register(operatorType, func() operator.Builder { return NewConfig() })
+}
+
+// defaultFields are the fields logged by IIS by default, used until a #Fields
+// directive is read.
+var defaultFields = []string{
+	"date", "time", "s-ip", "cs-method", "cs-uri-stem", "cs-uri-query", "s-port", "cs-username",
+	"c-ip", "cs(User-Agent)", "cs(Referer)", "sc-status", "sc-substatus", "sc-win32-status", "time-taken",
+}
+
+// defaultFieldMapping maps the W3C fields to the semantic conventions.
+var defaultFieldMapping = map[string]string{
+	"s-ip":           "network.local.address",
+	"s-port":         "server.port",
+	"cs-host":        "server.address",
+	"s-computername": "host.name",
+	"c-ip":           "client.address",
+	"cs-method":      "http.request.method",
+	"cs-uri-stem":    "url.path",
+	"cs-uri-query":   "url.query",
+	"cs-version":     "network.protocol.version",
+	"cs-username":    "user.name",
+	"cs(User-Agent)": "user_agent.original",
+	"cs(Referer)":    "http.request.header.referer",
+	"sc-status":      "http.response.status_code",
+	"sc-bytes":       "http.response.size",
+	"cs-bytes":       "http.request.size",
+}
+
+// NewConfig creates a new W3C parser config with default values
+func NewConfig() *Config {
+	return NewConfigWithID(operatorType)
+}
+
+// NewConfigWithID creates a new W3C parser config with default values
+func NewConfigWithID(operatorID string) *Config {
+	return &Config{
+		ParserConfig: helper.NewParserConfig(operatorID, operatorType),
+		Fields:       defaultFields,
+	}
+}
+
+// Config is the configuration of a W3C parser operator.
+type Config struct {
+	helper.ParserConfig `mapstructure:",squash"`
+
+	// Fields are the fields of the lines read before a #Fields directive.
+	Fields []string `mapstructure:"fields"`
+	// FieldMapping renames the fields, it is merged with the default mapping to
+	// the semantic conventions.
+	FieldMapping map[string]string `mapstructure:"field_mapping"`
+}
+
+// Build will build a W3C parser operator.
+func (c Config) Build(set component.TelemetrySettings) (operator.Operator, error) {
+	parserOperator, err := c.ParserConfig.Build(set)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(c.Fields) == 0 {
+		return nil, errors.New("fields is a required parameter")
+	}
+
+	mapping := maps.Clone(defaultFieldMapping)
+	for field, name := range c.FieldMapping {
+		if name == "" {
+			return nil, errors.New("field_mapping cannot map a field to an empty name")
+		}
+		mapping[field] = name
+	}
+
+	return &Parser{
+		ParserOperator: parserOperator,
+		defaultFields:  c.Fields,
+		mapping:        mapping,
+		fields:         map[string][]string{},
+	}, nil
+}
@@ -0,0 +1,171 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package w3c // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator/parser/w3c"
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/entry"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator/helper"
+)
+
+// logFilePathAttribute is the attribute set by the file input when
+// include_file_path is enabled, the fields are tracked per file with it.
+const logFilePathAttribute = "log.file.path"
+
+// maxTrackedFiles bounds the number of files whose fields are tracked, the
+// state is reset when it is reached since the log files are rotated.
+const maxTrackedFiles = 1024
+
+// intFields are the fields whose values are converted to integers.
+var intFields = map[string]bool{
+	"s-port":          true,
+	"sc-status":       true,
+	"sc-substatus":    true,
+	"sc-win32-status": true,
+	"sc-bytes":        true,
+	"cs-bytes":        true,
+	"time-taken":      true,
+}
+
+// Parser is an operator that parses the lines of the W3C extended log format,
+// using the fields declared by the #Fields directive.
+type Parser struct {
+	helper.ParserOperator
+	defaultFields []string
+	mapping       map[string]string
+
+	mu sync.Mutex
+	// fields holds the fields declared by the last #Fields directive, keyed by
+	// the path of the file.
+	fields map[string][]string
+}
+
+func (p *Parser) ProcessBatch(ctx context.Context, entries []*entry.Entry) error {
+	return p.TransformerOperator.ProcessBatchWith(ctx, entries, p.Process)
+}
+
+// Process will parse an entry as a W3C line. The directive lines update the
+// fields of their file and are dropped.
+func (p *Parser) Process(ctx context.Context, e *entry.Entry) error {
+	skip, err := p.Skip(ctx, e)
+	if err != nil {
+		return p.HandleEntryError(ctx, e, err)
+	}
+	if skip {
+		return p.Write(ctx, e)
+	}
+
+	file := filePath(e)
+	if value, ok := e.Get(p.ParseFrom); ok {
+		if line, isString := value.(string); isString && strings.HasPrefix(line, "#") {
+			if directive, ok := strings.CutPrefix(line, "#Fields:"); ok {
+				p.setFields(file, strings.Fields(directive))
+			}
+			return nil
+		}
+	}
+
+	var timestamp time.Time
+	parse := func(value any) (any, error) {
+		var parsed map[string]any
+		var err error
+		parsed, timestamp, err = p.parse(value, p.fieldsOf(file))
+		return parsed, err
+	}
+	return p.ProcessWithCallback(ctx, e, parse, func(e *entry.Entry) error {
+		if p.TimeParser == nil && !timestamp.IsZero() {
+			e.Timestamp = timestamp
+		}
+		return nil
+	})
+}
+
+func (p *Parser) setFields(file string, fields []string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if _, ok := p.fields[file]; !ok && len(p.fields) >= maxTrackedFiles {
+		p.fields = map[string][]string{}
+	}
+	p.fields[file] = fields
+}
+
+func (p *Parser) fieldsOf(file string) []string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if fields, ok := p.fields[file]; ok {
+		return fields
+	}
+	return p.defaultFields
+}
+
+// parse will parse a W3C line with the given fields. The date and time fields
+// are returned as the timestamp and the fields without value are omitted.
+func (p *Parser) parse(value any, fields []string) (map[string]any, time.Time, error) {
+	line, ok := value.(string)
+	if !ok {
+		return nil, time.Time{}, fmt.Errorf("type %T cannot be parsed as W3C", value)
+	}
+
+	values := strings.Fields(line)
+	if len(values) != len(fields) {
+		return nil, time.Time{}, fmt.Errorf("the line has %d values but %d fields are declared", len(values), len(fields))
+	}
+
+	parsed := make(map[string]any, len(fields))
+	var date, clock string
+	for i, field := range fields {
+		v := values[i]
+		switch {
+		case field == "date":
+			date = v
+			continue
+		case field == "time":
+			clock = v
+			continue
+		case v == "-":
+			continue
+		}
+
+		var attr any = v
+		switch {
+		case intFields[field]:
+			if n, err := strconv.ParseInt(v, 10, 64); err == nil {
+				attr = n
+			}
+		case field == "cs-version":
+			attr = strings.TrimPrefix(v, "HTTP/")
+		case strings.HasPrefix(field, "cs(") || strings.HasPrefix(field, "sc("):
+			// Spaces in the header values are written as plus signs.
+			attr = strings.ReplaceAll(v, "+", " ")
+		}
+
+		name := field
+		if mapped, ok := p.mapping[field]; ok {
+			name = mapped
+		}
+		parsed[name] = attr
+	}
+
+	var timestamp time.Time
+	if date != "" && clock != "" {
+		// The timestamps are in UTC.
+		t, err := time.Parse(time.DateTime, date+" "+clock)
+		if err != nil {
+			return nil, time.Time{}, fmt.Errorf("parse timestamp: %w", err)
+		}
+		timestamp = t
+	}
+	return parsed, timestamp, nil
+}
+
+func filePath(e *entry.Entry) string {
+	path, _ := e.Attributes[logFilePathAttribute].(string)
+	return path
+}
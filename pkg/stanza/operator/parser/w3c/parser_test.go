@@ -0,0 +1,127 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package w3c
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/entry"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/testutil"
+)
+
+func newTestParser(t *testing.T, cfg *Config) (*Parser, *testutil.FakeOutput) {
+	cfg.ParseTo = entry.RootableField{Field: entry.NewAttributeField()}
+	cfg.OutputIDs = []string{"fake"}
+	op, err := cfg.Build(componenttest.NewNopTelemetrySettings())
+	require.NoError(t, err)
+
+	fake := testutil.NewFakeOutput(t)
+	require.NoError(t, op.SetOutputs([]operator.Operator{fake}))
+	return op.(*Parser), fake
+}
+
+func newTestEntry(file, line string) *entry.Entry {
+	e := entry.New()
+	e.Body = line
+	if file != "" {
+		e.Attributes = map[string]any{logFilePathAttribute: file}
+	}
+	return e
+}
+
+func receiveEntry(t *testing.T, fake *testutil.FakeOutput) *entry.Entry {
+	select {
+	case e := <-fake.Received:
+		return e
+	case <-time.After(time.Second):
+		require.FailNow(t, "Timed out waiting for entry")
+		return nil
+	}
+}
+
+func TestInit(t *testing.T) {
+	builder, ok := operator.DefaultRegistry.Lookup("w3c_parser")
+	require.True(t, ok, "expected w3c_parser to be registered")
+	require.Equal(t, "w3c_parser", builder().Type())
+}
+
+func TestBuildFailure(t *testing.T) {
+	cfg := NewConfigWithID("test")
+	cfg.Fields = nil
+	_, err := cfg.Build(componenttest.NewNopTelemetrySettings())
+	require.ErrorContains(t, err, "fields is a required parameter")
+
+	cfg = NewConfigWithID("test")
+	cfg.FieldMapping = map[string]string{"s-sitename": ""}
+	_, err = cfg.Build(componenttest.NewNopTelemetrySettings())
+	require.ErrorContains(t, err, "field_mapping cannot map a field to an empty name")
+}
+
+func TestParserDefaultFields(t *testing.T) {
+	parser, fake := newTestParser(t, NewConfigWithID("test"))
+
+	e := newTestEntry("", "2024-05-06 07:08:09 10.0.0.1 GET /index.html q=1 443 - 192.168.1.2 Mozilla/5.0+(Windows+NT+10.0) - 200 0 0 15")
+	require.NoError(t, parser.Process(context.Background(), e))
+
+	got := receiveEntry(t, fake)
+	require.Equal(t, time.Date(2024, 5, 6, 7, 8, 9, 0, time.UTC), got.Timestamp)
+	require.Equal(t, map[string]any{
+		"network.local.address":     "10.0.0.1",
+		"http.request.method":       "GET",
+		"url.path":                  "/index.html",
+		"url.query":                 "q=1",
+		"server.port":               int64(443),
+		"client.address":            "192.168.1.2",
+		"user_agent.original":       "Mozilla/5.0 (Windows NT 10.0)",
+		"http.response.status_code": int64(200),
+		"sc-substatus":              int64(0),
+		"sc-win32-status":           int64(0),
+		"time-taken":                int64(15),
+	}, got.Attributes)
+}
+
+func TestParserFieldsDirective(t *testing.T) {
+	cfg := NewConfigWithID("test")
+	cfg.FieldMapping = map[string]string{"s-sitename": "iis.site.name"}
+	parser, fake := newTestParser(t, cfg)
+	ctx := context.Background()
+
+	require.NoError(t, parser.Process(ctx, newTestEntry("W3SVC1/u_ex240506.log", "#Software: Microsoft Internet Information Services 10.0")))
+	require.NoError(t, parser.Process(ctx, newTestEntry("W3SVC1/u_ex240506.log", "#Fields: date time s-sitename cs-method cs-uri-stem cs-version sc-status sc-bytes")))
+	fake.ExpectNoEntry(t, 10*time.Millisecond)
+
+	require.NoError(t, parser.Process(ctx, newTestEntry("W3SVC1/u_ex240506.log", "2024-05-06 07:08:09.250 W3SVC1 POST /api HTTP/1.1 201 512")))
+	got := receiveEntry(t, fake)
+	require.Equal(t, time.Date(2024, 5, 6, 7, 8, 9, 250000000, time.UTC), got.Timestamp)
+	require.Equal(t, map[string]any{
+		logFilePathAttribute:        "W3SVC1/u_ex240506.log",
+		"iis.site.name":             "W3SVC1",
+		"http.request.method":       "POST",
+		"url.path":                  "/api",
+		"network.protocol.version":  "1.1",
+		"http.response.status_code": int64(201),
+		"http.response.size":        int64(512),
+	}, got.Attributes)
+
+	// The other files still use the default fields.
+	err := parser.Process(ctx, newTestEntry("W3SVC2/u_ex240506.log", "2024-05-06 07:08:09 POST /api HTTP/1.1 201 512"))
+	require.ErrorContains(t, err, "the line has 7 values but 15 fields are declared")
+}
+
+func TestParserErrors(t *testing.T) {
+	parser, _ := newTestParser(t, NewConfigWithID("test"))
+	ctx := context.Background()
+
+	require.ErrorContains(t, parser.Process(ctx, newTestEntry("", "2024-05-06 07:08:09 GET")), "the line has 3 values but 15 fields are declared")
+
+	e := entry.New()
+	e.Body = map[string]any{"line": "value"}
+	require.ErrorContains(t, parser.Process(ctx, e), "cannot be parsed as W3C")
+}
@@ -73,7 +73,7 @@ func (c *prometheusConverterV2) addResourceTargetInfoV2(resource pcommon.Resourc
 }
 
 // addSampleWithLabels is a helper function to create and add a sample with labels
-func (c *prometheusConverterV2) addSampleWithLabels(sampleValue float64, timestamp int64, noRecordedValue bool,
+func (c *prometheusConverterV2) addSampleWithLabels(sampleValue float64, timestamp int64, startTimestamp pcommon.Timestamp, noRecordedValue bool,
 	baseName string, baseLabels []prompb.Label, labelName, labelValue string, metadata metadata,
 ) {
 	sample := &writev2.Sample{
@@ -83,11 +83,13 @@ func (c *prometheusConverterV2) addSampleWithLabels(sampleValue float64, timesta
 	if noRecordedValue {
 		sample.Value = math.Float64frombits(value.StaleNaN)
 	}
+	var ts *writev2.TimeSeries
 	if labelName != "" && labelValue != "" {
-		c.addSample(sample, createLabels(baseName, baseLabels, labelName, labelValue), metadata)
+		ts = c.addSample(sample, createLabels(baseName, baseLabels, labelName, labelValue), metadata)
 	} else {
-		c.addSample(sample, createLabels(baseName, baseLabels), metadata)
+		ts = c.addSample(sample, createLabels(baseName, baseLabels), metadata)
 	}
+	setCreatedTimestamp(ts, startTimestamp)
 }
 
 func (c *prometheusConverterV2) addSummaryDataPoints(dataPoints pmetric.SummaryDataPointSlice, resource pcommon.Resource,
@@ -100,14 +102,14 @@ func (c *prometheusConverterV2) addSummaryDataPoints(dataPoints pmetric.SummaryD
 		noRecordedValue := pt.Flags().NoRecordedValue()
 
 		// Add sum and count samples
-		c.addSampleWithLabels(pt.Sum(), timestamp, noRecordedValue, baseName+sumStr, baseLabels, "", "", metadata)
-		c.addSampleWithLabels(float64(pt.Count()), timestamp, noRecordedValue, baseName+countStr, baseLabels, "", "", metadata)
+		c.addSampleWithLabels(pt.Sum(), timestamp, pt.StartTimestamp(), noRecordedValue, baseName+sumStr, baseLabels, "", "", metadata)
+		c.addSampleWithLabels(float64(pt.Count()), timestamp, pt.StartTimestamp(), noRecordedValue, baseName+countStr, baseLabels, "", "", metadata)
 
 		// Process quantiles
 		for i := 0; i < pt.QuantileValues().Len(); i++ {
 			qt := pt.QuantileValues().At(i)
 			percentileStr := strconv.FormatFloat(qt.Quantile(), 'f', -1, 64)
-			c.addSampleWithLabels(qt.Value(), timestamp, noRecordedValue, baseName, baseLabels, quantileStr, percentileStr, metadata)
+			c.addSampleWithLabels(qt.Value(), timestamp, pt.StartTimestamp(), noRecordedValue, baseName, baseLabels, quantileStr, percentileStr, metadata)
 		}
 	}
 }
@@ -124,11 +126,11 @@ func (c *prometheusConverterV2) addHistogramDataPoints(dataPoints pmetric.Histog
 		// If the sum is unset, it indicates the _sum metric point should be
 		// omitted
 		if pt.HasSum() {
-			c.addSampleWithLabels(pt.Sum(), timestamp, noRecordedValue, baseName+sumStr, baseLabels, "", "", metadata)
+			c.addSampleWithLabels(pt.Sum(), timestamp, pt.StartTimestamp(), noRecordedValue, baseName+sumStr, baseLabels, "", "", metadata)
 		}
 
 		// treat count as a sample in an individual TimeSeries
-		c.addSampleWithLabels(float64(pt.Count()), timestamp, noRecordedValue, baseName+countStr, baseLabels, "", "", metadata)
+		c.addSampleWithLabels(float64(pt.Count()), timestamp, pt.StartTimestamp(), noRecordedValue, baseName+countStr, baseLabels, "", "", metadata)
 
 		// cumulative count for conversion to cumulative histogram
 		var cumulativeCount uint64
@@ -138,10 +140,10 @@ func (c *prometheusConverterV2) addHistogramDataPoints(dataPoints pmetric.Histog
 			bound := pt.ExplicitBounds().At(i)
 			cumulativeCount += pt.BucketCounts().At(i)
 			boundStr := strconv.FormatFloat(bound, 'f', -1, 64)
-			c.addSampleWithLabels(float64(cumulativeCount), timestamp, noRecordedValue, baseName+bucketStr, baseLabels, leStr, boundStr, metadata)
+			c.addSampleWithLabels(float64(cumulativeCount), timestamp, pt.StartTimestamp(), noRecordedValue, baseName+bucketStr, baseLabels, leStr, boundStr, metadata)
 		}
 		// add le=+Inf bucket
-		c.addSampleWithLabels(float64(pt.Count()), timestamp, noRecordedValue, baseName+bucketStr, baseLabels, leStr, pInfStr, metadata)
+		c.addSampleWithLabels(float64(pt.Count()), timestamp, pt.StartTimestamp(), noRecordedValue, baseName+bucketStr, baseLabels, leStr, pInfStr, metadata)
 
 		// TODO implement exemplars support
 	}
@@ -194,6 +194,7 @@ func TestPrometheusConverterV2_AddSummaryDataPoints(t *testing.T) {
 							Type:    writev2.Metadata_METRIC_TYPE_SUMMARY,
 							HelpRef: 0,
 						},
+						CreatedTimestamp: convertTimeStamp(ts),
 					},
 					timeSeriesSignature(sumLabels): {
 						LabelsRefs: []uint32{1, 2},
@@ -204,6 +205,7 @@ func TestPrometheusConverterV2_AddSummaryDataPoints(t *testing.T) {
 							Type:    writev2.Metadata_METRIC_TYPE_SUMMARY,
 							HelpRef: 0,
 						},
+						CreatedTimestamp: convertTimeStamp(ts),
 					},
 				}
 			},
@@ -317,6 +319,7 @@ func TestPrometheusConverterV2_AddHistogramDataPoints(t *testing.T) {
 							Type:    writev2.Metadata_METRIC_TYPE_HISTOGRAM,
 							HelpRef: 0,
 						},
+						CreatedTimestamp: convertTimeStamp(ts),
 					},
 					timeSeriesSignature(labels): {
 						LabelsRefs: []uint32{1, 2},
@@ -327,6 +330,7 @@ func TestPrometheusConverterV2_AddHistogramDataPoints(t *testing.T) {
 							Type:    writev2.Metadata_METRIC_TYPE_HISTOGRAM,
 							HelpRef: 0,
 						},
+						CreatedTimestamp: convertTimeStamp(ts),
 					},
 				}
 			},
@@ -0,0 +1,74 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package prometheusremotewrite // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/translator/prometheusremotewrite"
+
+import (
+	"github.com/prometheus/common/model"
+	"github.com/prometheus/prometheus/prompb"
+	writev2 "github.com/prometheus/prometheus/prompb/io/prometheus/write/v2"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+)
+
+func (c *prometheusConverterV2) addExponentialHistogramDataPoints(dataPoints pmetric.ExponentialHistogramDataPointSlice,
+	resource pcommon.Resource, settings Settings, baseName string, metadata metadata,
+) error {
+	for x := 0; x < dataPoints.Len(); x++ {
+		pt := dataPoints.At(x)
+		lbls := createAttributes(
+			resource,
+			pt.Attributes(),
+			settings.ExternalLabels,
+			nil,
+			true,
+			c.labelNamer,
+			model.MetricNameLabel,
+			baseName,
+		)
+
+		histogram, err := exponentialToNativeHistogram(pt)
+		if err != nil {
+			return err
+		}
+
+		ts, _ := c.getOrCreateTimeSeries(lbls, metadata)
+		ts.Histograms = append(ts.Histograms, nativeHistogramToV2(histogram))
+		setCreatedTimestamp(ts, pt.StartTimestamp())
+
+		exemplars := getPromExemplarsV2[pmetric.ExponentialHistogramDataPoint](pt)
+		ts.Exemplars = append(ts.Exemplars, exemplars...)
+	}
+
+	return nil
+}
+
+// nativeHistogramToV2 converts a Remote Write 1.0 native histogram, as built by
+// exponentialToNativeHistogram, into its Remote Write 2.0 representation.
+func nativeHistogramToV2(h prompb.Histogram) writev2.Histogram {
+	return writev2.Histogram{
+		Count:          &writev2.Histogram_CountInt{CountInt: h.GetCountInt()},
+		Sum:            h.Sum,
+		Schema:         h.Schema,
+		ZeroThreshold:  h.ZeroThreshold,
+		ZeroCount:      &writev2.Histogram_ZeroCountInt{ZeroCountInt: h.GetZeroCountInt()},
+		NegativeSpans:  bucketSpansToV2(h.NegativeSpans),
+		NegativeDeltas: h.NegativeDeltas,
+		PositiveSpans:  bucketSpansToV2(h.PositiveSpans),
+		PositiveDeltas: h.PositiveDeltas,
+		// See exponentialToNativeHistogram on why the reset hint is never set.
+		ResetHint: writev2.Histogram_RESET_HINT_UNSPECIFIED,
+		Timestamp: h.Timestamp,
+	}
+}
+
+func bucketSpansToV2(spans []prompb.BucketSpan) []writev2.BucketSpan {
+	if len(spans) == 0 {
+		return nil
+	}
+	out := make([]writev2.BucketSpan, len(spans))
+	for i, s := range spans {
+		out[i] = writev2.BucketSpan{Offset: s.Offset, Length: s.Length}
+	}
+	return out
+}
@@ -118,7 +118,11 @@ func (c *prometheusConverterV2) fromMetrics(md pmetric.Metrics, settings Setting
 					}
 					c.addHistogramDataPoints(dataPoints, resource, settings, promName, m)
 				case pmetric.MetricTypeExponentialHistogram:
-					// TODO implement
+					dataPoints := metric.ExponentialHistogram().DataPoints()
+					if dataPoints.Len() == 0 {
+						break
+					}
+					errs = multierr.Append(errs, c.addExponentialHistogramDataPoints(dataPoints, resource, settings, promName, m))
 				case pmetric.MetricTypeSummary:
 					dataPoints := metric.Summary().DataPoints()
 					if dataPoints.Len() == 0 {
@@ -150,12 +154,12 @@ func (c *prometheusConverterV2) timeSeries() []writev2.TimeSeries {
 	return allTS
 }
 
-func (c *prometheusConverterV2) addSample(sample *writev2.Sample, lbls []prompb.Label, metadata metadata) {
-	ts, isNewMetric := c.getOrCreateTimeSeries(lbls, metadata, sample)
-	// If the time series is not new, we can just append the sample to the existing time series.
-	if !isNewMetric {
-		ts.Samples = append(ts.Samples, *sample)
-	}
+// addSample appends the sample to the time series identified by lbls and
+// returns that time series.
+func (c *prometheusConverterV2) addSample(sample *writev2.Sample, lbls []prompb.Label, metadata metadata) *writev2.TimeSeries {
+	ts, _ := c.getOrCreateTimeSeries(lbls, metadata)
+	ts.Samples = append(ts.Samples, *sample)
+	return ts
 }
 
 // isSameMetricV2 checks if two time series are the same metric
@@ -172,7 +176,7 @@ func isSameMetricV2(ts1, ts2 *writev2.TimeSeries) bool {
 }
 
 // getOrCreateTimeSeries returns the time series corresponding to the label set, and a boolean indicating if the metric is new or not.
-func (c *prometheusConverterV2) getOrCreateTimeSeries(lbls []prompb.Label, metadata metadata, sample *writev2.Sample) (*writev2.TimeSeries, bool) {
+func (c *prometheusConverterV2) getOrCreateTimeSeries(lbls []prompb.Label, metadata metadata) (*writev2.TimeSeries, bool) {
 	signature := timeSeriesSignature(lbls)
 	ts := c.unique[signature]
 	buf := make([]uint32, 0, len(lbls)*2)
@@ -186,7 +190,6 @@ func (c *prometheusConverterV2) getOrCreateTimeSeries(lbls []prompb.Label, metad
 
 	ts2 := &writev2.TimeSeries{
 		LabelsRefs: buf,
-		Samples:    []writev2.Sample{*sample},
 		Metadata: writev2.Metadata{
 			Type:    metadata.Type,
 			HelpRef: c.symbolTable.Symbolize(metadata.Help),
@@ -218,3 +221,12 @@ func (c *prometheusConverterV2) getOrCreateTimeSeries(lbls []prompb.Label, metad
 	c.unique[signature] = ts2
 	return ts2, true
 }
+
+// setCreatedTimestamp records the start timestamp of a cumulative data point
+// as the created timestamp of the time series, if it is set.
+func setCreatedTimestamp(ts *writev2.TimeSeries, start pcommon.Timestamp) {
+	if start == 0 {
+		return
+	}
+	ts.CreatedTimestamp = convertTimeStamp(start)
+}
@@ -78,7 +78,8 @@ func (c *prometheusConverterV2) addSumNumberDataPoints(dataPoints pmetric.Number
 			sample.Value = math.Float64frombits(value.StaleNaN)
 		}
 		// TODO: properly add exemplars to the TimeSeries
-		c.addSample(sample, lbls, metadata)
+		ts := c.addSample(sample, lbls, metadata)
+		setCreatedTimestamp(ts, pt.StartTimestamp())
 	}
 }
 
@@ -0,0 +1,182 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package anomalyprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/anomalyprocessor"
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/filter/filterset"
+)
+
+const (
+	methodEWMA = "ewma"
+	methodESD  = "esd"
+
+	outputAttributes = "attributes"
+	outputMetric     = "metric"
+	outputBoth       = "both"
+)
+
+// Config defines the configuration for the processor.
+type Config struct {
+	// Method is the detection method, ewma or esd.
+	Method string `mapstructure:"method"`
+
+	// EWMA configures the ewma method.
+	EWMA EWMAConfig `mapstructure:"ewma"`
+
+	// ESD configures the esd method.
+	ESD ESDConfig `mapstructure:"esd"`
+
+	// Seasonality keeps a separate baseline for each phase of a period.
+	Seasonality SeasonalityConfig `mapstructure:"seasonality"`
+
+	// MinSamples is the number of values a baseline needs before values are
+	// flagged as anomalous.
+	MinSamples int `mapstructure:"min_samples"`
+
+	// Output is how anomalies are reported: attributes, metric or both.
+	//
+	//   - attributes: (default) the anomalous data points get FlagAttribute
+	//     set to true and ScoreAttribute set to their score
+	//   - metric: a gauge named after the metric with MetricSuffix gets a
+	//     data point with the score of each anomalous data point
+	//   - both: both of the above
+	Output string `mapstructure:"output"`
+
+	FlagAttribute  string `mapstructure:"flag_attribute"`
+	ScoreAttribute string `mapstructure:"score_attribute"`
+	MetricSuffix   string `mapstructure:"metric_suffix"`
+
+	// MaxSeries limits the number of series with a baseline. The data
+	// points of additional series are not checked.
+	MaxSeries int `mapstructure:"max_series"`
+
+	// MaxStaleness is the time after which the baseline of a series that
+	// received no data point is dropped.
+	MaxStaleness time.Duration `mapstructure:"max_staleness"`
+
+	// Include specifies a filter on the metrics that should be checked.
+	// Exclude specifies a filter on the metrics that should not be checked.
+	// If neither `include` nor `exclude` are set, all gauges and sums are
+	// checked.
+	Include MatchMetrics `mapstructure:"include"`
+	Exclude MatchMetrics `mapstructure:"exclude"`
+}
+
+// EWMAConfig configures the exponentially weighted moving average method.
+type EWMAConfig struct {
+	// Smoothing is the weight of a new value in the average, between 0 and 1.
+	Smoothing float64 `mapstructure:"smoothing"`
+
+	// Threshold is the number of standard deviations from the average
+	// beyond which a value is anomalous.
+	Threshold float64 `mapstructure:"threshold"`
+}
+
+// ESDConfig configures the extreme studentized deviate method.
+type ESDConfig struct {
+	// Window is the number of previous values a value is tested against.
+	Window int `mapstructure:"window"`
+
+	// Alpha is the significance level of the test, lower values flag fewer
+	// anomalies.
+	Alpha float64 `mapstructure:"alpha"`
+}
+
+// minBucketWidth is the shortest phase of a season with its own baseline.
+const minBucketWidth = time.Second
+
+// SeasonalityConfig configures seasonal baselines.
+type SeasonalityConfig struct {
+	// Period is the length of the season, e.g. 24h for a daily pattern. Zero
+	// disables seasonality.
+	Period time.Duration `mapstructure:"period"`
+
+	// Buckets is the number of phases of the period with their own baseline,
+	// e.g. 24 for hourly baselines of a daily period.
+	Buckets int `mapstructure:"buckets"`
+}
+
+type MatchMetrics struct {
+	filterset.Config `mapstructure:",squash"`
+
+	Metrics []string `mapstructure:"metrics"`
+}
+
+var _ component.Config = (*Config)(nil)
+
+// Validate checks whether the input configuration has all of the required fields for the processor.
+// An error is returned if there are any invalid inputs.
+func (cfg *Config) Validate() error {
+	switch cfg.Method {
+	case methodEWMA:
+		if cfg.EWMA.Smoothing <= 0 || cfg.EWMA.Smoothing >= 1 {
+			return errors.New("ewma.smoothing must be between 0 and 1, exclusive")
+		}
+		if cfg.EWMA.Threshold <= 0 {
+			return errors.New("ewma.threshold must be positive")
+		}
+	case methodESD:
+		if cfg.ESD.Window < 3 {
+			return errors.New("esd.window must be at least 3")
+		}
+		if cfg.ESD.Alpha <= 0 || cfg.ESD.Alpha >= 1 {
+			return errors.New("esd.alpha must be between 0 and 1, exclusive")
+		}
+	default:
+		return fmt.Errorf("unsupported method %q, must be %q or %q", cfg.Method, methodEWMA, methodESD)
+	}
+
+	if cfg.Seasonality.Period < 0 {
+		return errors.New("seasonality.period must not be negative")
+	}
+	if cfg.Seasonality.Period > 0 && cfg.Seasonality.Buckets <= 0 {
+		return errors.New("seasonality.buckets must be positive")
+	}
+	if cfg.Seasonality.Period > 0 && cfg.Seasonality.Period/time.Duration(cfg.Seasonality.Buckets) < minBucketWidth {
+		return fmt.Errorf("seasonality.period must be at least %v per bucket", minBucketWidth)
+	}
+	if cfg.MinSamples < 0 {
+		return errors.New("min_samples must not be negative")
+	}
+
+	switch cfg.Output {
+	case outputAttributes, outputMetric, outputBoth:
+	default:
+		return fmt.Errorf("unsupported output %q, must be %q, %q or %q", cfg.Output, outputAttributes, outputMetric, outputBoth)
+	}
+	if cfg.Output != outputMetric {
+		if cfg.FlagAttribute == "" || cfg.ScoreAttribute == "" {
+			return errors.New("flag_attribute and score_attribute are required")
+		}
+		if cfg.FlagAttribute == cfg.ScoreAttribute {
+			return errors.New("flag_attribute and score_attribute must be different")
+		}
+	}
+	if cfg.Output != outputAttributes && cfg.MetricSuffix == "" {
+		return errors.New("metric_suffix is required")
+	}
+
+	if cfg.MaxSeries <= 0 {
+		return errors.New("max_series must be positive")
+	}
+	if cfg.MaxStaleness < 0 {
+		return errors.New("max_staleness must not be negative")
+	}
+
+	if (len(cfg.Include.Metrics) > 0 && len(cfg.Include.MatchType) == 0) ||
+		(len(cfg.Exclude.Metrics) > 0 && len(cfg.Exclude.MatchType) == 0) {
+		return errors.New("match_type must be set if metrics are supplied")
+	}
+	if (len(cfg.Include.MatchType) > 0 && len(cfg.Include.Metrics) == 0) ||
+		(len(cfg.Exclude.MatchType) > 0 && len(cfg.Exclude.Metrics) == 0) {
+		return errors.New("metrics must be supplied if match_type is set")
+	}
+	return nil
+}
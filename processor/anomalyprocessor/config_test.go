@@ -0,0 +1,164 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package anomalyprocessor
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/confmap/confmaptest"
+	"go.opentelemetry.io/collector/confmap/xconfmap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/filter/filterset"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/anomalyprocessor/internal/metadata"
+)
+
+func TestLoadConfig(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		id           component.ID
+		expected     component.Config
+		errorMessage string
+	}{
+		{
+			id:       component.NewIDWithName(metadata.Type, ""),
+			expected: createDefaultConfig(),
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "esd"),
+			expected: func() component.Config {
+				cfg := createDefaultConfig().(*Config)
+				cfg.Method = methodESD
+				cfg.ESD = ESDConfig{Window: 120, Alpha: 0.01}
+				cfg.Seasonality = SeasonalityConfig{Period: 24 * time.Hour, Buckets: 48}
+				cfg.MinSamples = 30
+				cfg.Output = outputBoth
+				cfg.Include = MatchMetrics{
+					Config:  filterset.Config{MatchType: "regexp"},
+					Metrics: []string{`^http\.server\..*`},
+				}
+				cfg.Exclude = MatchMetrics{
+					Config:  filterset.Config{MatchType: "strict"},
+					Metrics: []string{"http.server.active_requests"},
+				}
+				return cfg
+			}(),
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "invalid_method"),
+			errorMessage: `unsupported method "zscore", must be "ewma" or "esd"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.id.String(), func(t *testing.T) {
+			cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
+			require.NoError(t, err)
+
+			factory := NewFactory()
+			cfg := factory.CreateDefaultConfig()
+
+			sub, err := cm.Sub(tt.id.String())
+			require.NoError(t, err)
+			require.NoError(t, sub.Unmarshal(cfg))
+
+			if tt.expected == nil {
+				assert.EqualError(t, xconfmap.Validate(cfg), tt.errorMessage)
+				return
+			}
+			assert.NoError(t, xconfmap.Validate(cfg))
+			assert.Equal(t, tt.expected, cfg)
+		})
+	}
+}
+
+func TestValidateConfig(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(*Config)
+		error  string
+	}{
+		{
+			name:   "smoothing out of range",
+			modify: func(cfg *Config) { cfg.EWMA.Smoothing = 1 },
+			error:  "ewma.smoothing must be between 0 and 1, exclusive",
+		},
+		{
+			name:   "no threshold",
+			modify: func(cfg *Config) { cfg.EWMA.Threshold = 0 },
+			error:  "ewma.threshold must be positive",
+		},
+		{
+			name: "esd window too small",
+			modify: func(cfg *Config) {
+				cfg.Method = methodESD
+				cfg.ESD.Window = 2
+			},
+			error: "esd.window must be at least 3",
+		},
+		{
+			name: "esd alpha out of range",
+			modify: func(cfg *Config) {
+				cfg.Method = methodESD
+				cfg.ESD.Alpha = 0
+			},
+			error: "esd.alpha must be between 0 and 1, exclusive",
+		},
+		{
+			name: "seasonality without buckets",
+			modify: func(cfg *Config) {
+				cfg.Seasonality = SeasonalityConfig{Period: time.Hour}
+			},
+			error: "seasonality.buckets must be positive",
+		},
+		{
+			name: "seasonality buckets too short",
+			modify: func(cfg *Config) {
+				cfg.Seasonality = SeasonalityConfig{Period: time.Minute, Buckets: 120}
+			},
+			error: "seasonality.period must be at least 1s per bucket",
+		},
+		{
+			name:   "invalid output",
+			modify: func(cfg *Config) { cfg.Output = "log" },
+			error:  `unsupported output "log", must be "attributes", "metric" or "both"`,
+		},
+		{
+			name:   "same attributes",
+			modify: func(cfg *Config) { cfg.ScoreAttribute = cfg.FlagAttribute },
+			error:  "flag_attribute and score_attribute must be different",
+		},
+		{
+			name: "metric output without suffix",
+			modify: func(cfg *Config) {
+				cfg.Output = outputMetric
+				cfg.MetricSuffix = ""
+			},
+			error: "metric_suffix is required",
+		},
+		{
+			name:   "no max series",
+			modify: func(cfg *Config) { cfg.MaxSeries = 0 },
+			error:  "max_series must be positive",
+		},
+		{
+			name:   "metrics without match type",
+			modify: func(cfg *Config) { cfg.Include.Metrics = []string{"a"} },
+			error:  "match_type must be set if metrics are supplied",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := createDefaultConfig().(*Config)
+			tt.modify(cfg)
+			assert.EqualError(t, xconfmap.Validate(cfg), tt.error)
+		})
+	}
+}
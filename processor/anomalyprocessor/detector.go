@@ -0,0 +1,144 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package anomalyprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/anomalyprocessor"
+
+import (
+	"math"
+	"slices"
+)
+
+// madScale makes the median absolute deviation a consistent estimator of
+// the standard deviation of normally distributed values.
+const madScale = 1.4826
+
+// detector keeps the baseline of a series.
+type detector interface {
+	// observe returns the anomaly score of x against the baseline and
+	// whether x is anomalous, then adds x to the baseline. A value is never
+	// anomalous while the baseline has fewer than the minimum samples.
+	observe(x float64) (score float64, anomalous bool)
+}
+
+// ewmaDetector scores values by their distance to an exponentially weighted
+// moving average, in exponentially weighted standard deviations.
+type ewmaDetector struct {
+	smoothing  float64
+	threshold  float64
+	minSamples int
+
+	samples  int
+	mean     float64
+	variance float64
+}
+
+func newEWMADetector(cfg EWMAConfig, minSamples int) *ewmaDetector {
+	return &ewmaDetector{
+		smoothing:  cfg.Smoothing,
+		threshold:  cfg.Threshold,
+		minSamples: minSamples,
+	}
+}
+
+func (d *ewmaDetector) observe(x float64) (score float64, anomalous bool) {
+	if d.samples == 0 {
+		d.samples++
+		d.mean = x
+		return 0, false
+	}
+
+	diff := x - d.mean
+	if stddev := math.Sqrt(d.variance); stddev > 0 {
+		score = math.Abs(diff) / stddev
+		anomalous = d.samples >= d.minSamples && score > d.threshold
+	}
+
+	d.samples++
+	incr := d.smoothing * diff
+	d.mean += incr
+	d.variance = (1 - d.smoothing) * (d.variance + diff*incr)
+	return score, anomalous
+}
+
+// esdDetector applies the generalized extreme studentized deviate test to
+// each value against a sliding window of the previous values. As in the
+// seasonal hybrid ESD, the median and the median absolute deviation are
+// used instead of the mean and the standard deviation, so that the past
+// anomalies in the window do not mask new ones.
+type esdDetector struct {
+	alpha      float64
+	minSamples int
+
+	window []float64
+	next   int
+	full   bool
+
+	// scratch is reused to compute the medians.
+	scratch []float64
+}
+
+func newESDDetector(cfg ESDConfig, minSamples int) *esdDetector {
+	return &esdDetector{
+		alpha:      cfg.Alpha,
+		minSamples: max(minSamples, 3),
+		window:     make([]float64, cfg.Window),
+		scratch:    make([]float64, 0, cfg.Window),
+	}
+}
+
+func (d *esdDetector) observe(x float64) (score float64, anomalous bool) {
+	values := d.window
+	if !d.full {
+		values = d.window[:d.next]
+	}
+
+	if len(values) >= d.minSamples {
+		d.scratch = append(d.scratch[:0], values...)
+		median := medianOf(d.scratch)
+		for i, v := range d.scratch {
+			d.scratch[i] = math.Abs(v - median)
+		}
+		if mad := medianOf(d.scratch) * madScale; mad > 0 {
+			score = math.Abs(x-median) / mad
+			anomalous = score > esdCriticalValue(len(values)+1, d.alpha)
+		}
+	}
+
+	d.window[d.next] = x
+	d.next++
+	if d.next == len(d.window) {
+		d.next = 0
+		d.full = true
+	}
+	return score, anomalous
+}
+
+// medianOf returns the median of values, which it sorts.
+func medianOf(values []float64) float64 {
+	slices.Sort(values)
+	n := len(values)
+	if n%2 == 1 {
+		return values[n/2]
+	}
+	return (values[n/2-1] + values[n/2]) / 2
+}
+
+// esdCriticalValue returns the critical value of the first step of the
+// generalized ESD test for n values at the significance level alpha.
+func esdCriticalValue(n int, alpha float64) float64 {
+	p := 1 - alpha/(2*float64(n))
+	t := studentTQuantile(p, float64(n-2))
+	return float64(n-1) * t / math.Sqrt((float64(n-2)+t*t)*float64(n))
+}
+
+// studentTQuantile approximates the quantile function of the Student's t
+// distribution with the Cornish-Fisher expansion around the normal
+// distribution, which is accurate enough for the window sizes in use.
+func studentTQuantile(p, df float64) float64 {
+	z := math.Sqrt2 * math.Erfinv(2*p-1)
+	z2 := z * z
+	g1 := (z2 + 1) * z / 4
+	g2 := ((5*z2+16)*z2 + 3) * z / 96
+	g3 := (((3*z2+19)*z2+17)*z2 - 15) * z / 384
+	return z + g1/df + g2/(df*df) + g3/(df*df*df)
+}
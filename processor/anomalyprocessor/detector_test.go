@@ -0,0 +1,72 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package anomalyprocessor
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// noisy returns a series oscillating around base.
+func noisy(base float64, n int) []float64 {
+	values := make([]float64, n)
+	for i := range values {
+		values[i] = base + float64(i%5) - 2
+	}
+	return values
+}
+
+func TestEWMADetector(t *testing.T) {
+	d := newEWMADetector(EWMAConfig{Smoothing: 0.1, Threshold: 3}, 10)
+
+	for i, v := range noisy(100, 50) {
+		_, anomalous := d.observe(v)
+		assert.False(t, anomalous, "value %d", i)
+	}
+
+	score, anomalous := d.observe(150)
+	assert.True(t, anomalous)
+	assert.Greater(t, score, 3.0)
+
+	_, anomalous = d.observe(100)
+	assert.False(t, anomalous)
+}
+
+func TestEWMADetectorMinSamples(t *testing.T) {
+	d := newEWMADetector(EWMAConfig{Smoothing: 0.1, Threshold: 3}, 10)
+	for _, v := range noisy(100, 5) {
+		d.observe(v)
+	}
+	score, anomalous := d.observe(1000)
+	assert.Greater(t, score, 3.0)
+	assert.False(t, anomalous)
+}
+
+func TestESDDetector(t *testing.T) {
+	d := newESDDetector(ESDConfig{Window: 30, Alpha: 0.05}, 10)
+
+	for i, v := range noisy(100, 40) {
+		_, anomalous := d.observe(v)
+		assert.False(t, anomalous, "value %d", i)
+	}
+
+	score, anomalous := d.observe(130)
+	assert.True(t, anomalous)
+	assert.Greater(t, score, esdCriticalValue(31, 0.05))
+
+	// The anomaly in the window does not mask the next one.
+	_, anomalous = d.observe(130)
+	assert.True(t, anomalous)
+
+	_, anomalous = d.observe(101)
+	assert.False(t, anomalous)
+}
+
+func TestESDCriticalValue(t *testing.T) {
+	// Critical values from Rosner (1983) for alpha = 0.05.
+	assert.InDelta(t, 2.29, esdCriticalValue(10, 0.05), 0.01)
+	assert.InDelta(t, 2.71, esdCriticalValue(20, 0.05), 0.01)
+	assert.InDelta(t, 2.91, esdCriticalValue(30, 0.05), 0.01)
+}
@@ -0,0 +1,8 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:generate mdatagen metadata.yaml
+
+// Package anomalyprocessor implements a processor which tracks a baseline
+// of each metric series and flags the anomalous data points.
+package anomalyprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/anomalyprocessor"
@@ -0,0 +1,76 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package anomalyprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/anomalyprocessor"
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/processor"
+	"go.opentelemetry.io/collector/processor/processorhelper"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/anomalyprocessor/internal/metadata"
+)
+
+var processorCapabilities = consumer.Capabilities{MutatesData: true}
+
+// NewFactory returns a new factory for the anomaly processor.
+func NewFactory() processor.Factory {
+	return processor.NewFactory(
+		metadata.Type,
+		createDefaultConfig,
+		processor.WithMetrics(createMetricsProcessor, metadata.MetricsStability))
+}
+
+func createDefaultConfig() component.Config {
+	return &Config{
+		Method: methodEWMA,
+		EWMA: EWMAConfig{
+			Smoothing: 0.1,
+			Threshold: 3,
+		},
+		ESD: ESDConfig{
+			Window: 60,
+			Alpha:  0.05,
+		},
+		Seasonality: SeasonalityConfig{
+			Buckets: 24,
+		},
+		MinSamples:     10,
+		Output:         outputAttributes,
+		FlagAttribute:  "anomaly",
+		ScoreAttribute: "anomaly.score",
+		MetricSuffix:   ".anomaly_score",
+		MaxSeries:      10000,
+		MaxStaleness:   time.Hour,
+	}
+}
+
+func createMetricsProcessor(
+	ctx context.Context,
+	set processor.Settings,
+	cfg component.Config,
+	nextConsumer consumer.Metrics,
+) (processor.Metrics, error) {
+	processorConfig, ok := cfg.(*Config)
+	if !ok {
+		return nil, errors.New("configuration parsing error")
+	}
+
+	metricsProcessor, err := newAnomalyProcessor(processorConfig, set.Logger)
+	if err != nil {
+		return nil, err
+	}
+
+	return processorhelper.NewMetrics(
+		ctx,
+		set,
+		cfg,
+		nextConsumer,
+		metricsProcessor.processMetrics,
+		processorhelper.WithCapabilities(processorCapabilities))
+}
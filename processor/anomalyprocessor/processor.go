@@ -0,0 +1,240 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package anomalyprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/anomalyprocessor"
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/filter/filterset"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/pdatautil"
+)
+
+type seriesKey struct {
+	metric   string
+	resource [16]byte
+	scope    string
+	attrs    [16]byte
+}
+
+type series struct {
+	// detectors has one baseline per phase of the season, created on
+	// first use.
+	detectors []detector
+	lastSeen  time.Time
+
+	// The previous value of a cumulative sum, whose deltas are checked.
+	prev      float64
+	prevStart pcommon.Timestamp
+	hasPrev   bool
+}
+
+type anomalyProcessor struct {
+	cfg       *Config
+	includeFS filterset.FilterSet
+	excludeFS filterset.FilterSet
+	logger    *zap.Logger
+	now       func() time.Time
+
+	mu        sync.Mutex
+	series    map[seriesKey]*series
+	lastSweep time.Time
+}
+
+func newAnomalyProcessor(cfg *Config, logger *zap.Logger) (*anomalyProcessor, error) {
+	p := &anomalyProcessor{
+		cfg:    cfg,
+		logger: logger,
+		now:    time.Now,
+		series: make(map[seriesKey]*series),
+	}
+	var err error
+	if len(cfg.Include.Metrics) > 0 {
+		if p.includeFS, err = filterset.CreateFilterSet(cfg.Include.Metrics, &cfg.Include.Config); err != nil {
+			return nil, err
+		}
+	}
+	if len(cfg.Exclude.Metrics) > 0 {
+		if p.excludeFS, err = filterset.CreateFilterSet(cfg.Exclude.Metrics, &cfg.Exclude.Config); err != nil {
+			return nil, err
+		}
+	}
+	return p, nil
+}
+
+// processMetrics implements the ProcessMetricsFunc type.
+func (p *anomalyProcessor) processMetrics(_ context.Context, md pmetric.Metrics) (pmetric.Metrics, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	now := p.now()
+	p.sweep(now)
+
+	for i := 0; i < md.ResourceMetrics().Len(); i++ {
+		rm := md.ResourceMetrics().At(i)
+		resourceHash := pdatautil.MapHash(rm.Resource().Attributes())
+		for j := 0; j < rm.ScopeMetrics().Len(); j++ {
+			sm := rm.ScopeMetrics().At(j)
+			// Only the metrics received are checked, not the score
+			// metrics appended below.
+			metrics := sm.Metrics()
+			n := metrics.Len()
+			for k := 0; k < n; k++ {
+				m := metrics.At(k)
+				if !p.shouldCheckMetric(m) {
+					continue
+				}
+				var dps pmetric.NumberDataPointSlice
+				cumulative := false
+				switch m.Type() {
+				case pmetric.MetricTypeGauge:
+					dps = m.Gauge().DataPoints()
+				case pmetric.MetricTypeSum:
+					dps = m.Sum().DataPoints()
+					cumulative = m.Sum().AggregationTemporality() == pmetric.AggregationTemporalityCumulative
+				default:
+					continue
+				}
+				base := seriesKey{
+					metric:   m.Name(),
+					resource: resourceHash,
+					scope:    sm.Scope().Name(),
+				}
+				scores := p.checkDataPoints(dps, base, cumulative, now)
+				if scores.Len() > 0 {
+					score := metrics.AppendEmpty()
+					score.SetName(m.Name() + p.cfg.MetricSuffix)
+					score.SetDescription("Anomaly score of " + m.Name() + ".")
+					score.SetUnit("1")
+					scores.MoveAndAppendTo(score.SetEmptyGauge().DataPoints())
+				}
+			}
+		}
+	}
+	return md, nil
+}
+
+// checkDataPoints checks each data point against the baseline of its series
+// and reports the anomalous ones. It returns the score data points to emit.
+func (p *anomalyProcessor) checkDataPoints(dps pmetric.NumberDataPointSlice, base seriesKey, cumulative bool, now time.Time) pmetric.NumberDataPointSlice {
+	scores := pmetric.NewNumberDataPointSlice()
+	for i := 0; i < dps.Len(); i++ {
+		dp := dps.At(i)
+		if dp.Flags().NoRecordedValue() {
+			continue
+		}
+		var value float64
+		switch dp.ValueType() {
+		case pmetric.NumberDataPointValueTypeDouble:
+			value = dp.DoubleValue()
+		case pmetric.NumberDataPointValueTypeInt:
+			value = float64(dp.IntValue())
+		default:
+			continue
+		}
+		if math.IsNaN(value) || math.IsInf(value, 0) {
+			continue
+		}
+
+		key := base
+		key.attrs = pdatautil.MapHash(dp.Attributes())
+		s, ok := p.series[key]
+		if !ok {
+			if len(p.series) >= p.cfg.MaxSeries {
+				continue
+			}
+			s = &series{}
+			p.series[key] = s
+		}
+		s.lastSeen = now
+
+		if cumulative {
+			prev, hasPrev := s.prev, s.hasPrev && s.prevStart == dp.StartTimestamp() && value >= s.prev
+			s.prev, s.prevStart, s.hasPrev = value, dp.StartTimestamp(), true
+			if !hasPrev {
+				// The first point, or a reset, only sets the base of the
+				// next delta.
+				continue
+			}
+			value -= prev
+		}
+
+		score, anomalous := p.detector(s, dp.Timestamp(), now).observe(value)
+		if !anomalous {
+			continue
+		}
+		if p.cfg.Output != outputMetric {
+			dp.Attributes().PutBool(p.cfg.FlagAttribute, true)
+			dp.Attributes().PutDouble(p.cfg.ScoreAttribute, score)
+		}
+		if p.cfg.Output != outputAttributes {
+			scoreDP := scores.AppendEmpty()
+			dp.Attributes().CopyTo(scoreDP.Attributes())
+			scoreDP.Attributes().Remove(p.cfg.FlagAttribute)
+			scoreDP.Attributes().Remove(p.cfg.ScoreAttribute)
+			scoreDP.SetStartTimestamp(dp.StartTimestamp())
+			scoreDP.SetTimestamp(dp.Timestamp())
+			scoreDP.SetDoubleValue(score)
+		}
+	}
+	return scores
+}
+
+// detector returns the baseline of a series for the phase of the season
+// the timestamp falls in.
+func (p *anomalyProcessor) detector(s *series, timestamp pcommon.Timestamp, now time.Time) detector {
+	phase := 0
+	if period := p.cfg.Seasonality.Period; period > 0 {
+		t := timestamp.AsTime()
+		if timestamp == 0 {
+			t = now
+		}
+		// The offset is negative for timestamps before the epoch.
+		offset := time.Duration(t.UnixNano()) % period
+		if offset < 0 {
+			offset += period
+		}
+		bucket := period / time.Duration(p.cfg.Seasonality.Buckets)
+		phase = min(int(offset/bucket), p.cfg.Seasonality.Buckets-1)
+		if s.detectors == nil {
+			s.detectors = make([]detector, p.cfg.Seasonality.Buckets)
+		}
+	} else if s.detectors == nil {
+		s.detectors = make([]detector, 1)
+	}
+
+	if s.detectors[phase] == nil {
+		switch p.cfg.Method {
+		case methodESD:
+			s.detectors[phase] = newESDDetector(p.cfg.ESD, p.cfg.MinSamples)
+		default:
+			s.detectors[phase] = newEWMADetector(p.cfg.EWMA, p.cfg.MinSamples)
+		}
+	}
+	return s.detectors[phase]
+}
+
+// sweep drops the series that have not been seen for MaxStaleness.
+func (p *anomalyProcessor) sweep(now time.Time) {
+	if p.cfg.MaxStaleness == 0 || now.Sub(p.lastSweep) < p.cfg.MaxStaleness {
+		return
+	}
+	p.lastSweep = now
+	for key, s := range p.series {
+		if now.Sub(s.lastSeen) >= p.cfg.MaxStaleness {
+			delete(p.series, key)
+		}
+	}
+}
+
+func (p *anomalyProcessor) shouldCheckMetric(metric pmetric.Metric) bool {
+	return (p.includeFS == nil || p.includeFS.Matches(metric.Name())) &&
+		(p.excludeFS == nil || !p.excludeFS.Matches(metric.Name()))
+}
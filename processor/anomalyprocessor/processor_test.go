@@ -0,0 +1,217 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package anomalyprocessor
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/processor/processortest"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/pdatautil"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/anomalyprocessor/internal/metadata"
+)
+
+var startTime = time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// newGauge returns a gauge with a data point for each host.
+func newGauge(name string, ts time.Time, values map[string]float64) pmetric.Metrics {
+	md := pmetric.NewMetrics()
+	m := md.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics().AppendEmpty()
+	m.SetName(name)
+	dps := m.SetEmptyGauge().DataPoints()
+	for host, v := range values {
+		dp := dps.AppendEmpty()
+		dp.Attributes().PutStr("host", host)
+		dp.SetTimestamp(pcommon.NewTimestampFromTime(ts))
+		dp.SetDoubleValue(v)
+	}
+	return md
+}
+
+func newCumulativeSum(name string, ts time.Time, value int64) pmetric.Metrics {
+	md := pmetric.NewMetrics()
+	m := md.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics().AppendEmpty()
+	m.SetName(name)
+	sum := m.SetEmptySum()
+	sum.SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	sum.SetIsMonotonic(true)
+	dp := sum.DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(pcommon.NewTimestampFromTime(startTime))
+	dp.SetTimestamp(pcommon.NewTimestampFromTime(ts))
+	dp.SetIntValue(value)
+	return md
+}
+
+func newTestProcessor(t *testing.T, modify func(*Config)) *anomalyProcessor {
+	cfg := createDefaultConfig().(*Config)
+	if modify != nil {
+		modify(cfg)
+	}
+	require.NoError(t, cfg.Validate())
+	p, err := newAnomalyProcessor(cfg, zap.NewNop())
+	require.NoError(t, err)
+	p.now = func() time.Time { return startTime }
+	return p
+}
+
+// anomalies returns the scores of the data points flagged as anomalous,
+// keyed by host.
+func anomalies(md pmetric.Metrics) map[string]float64 {
+	flagged := make(map[string]float64)
+	m := md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0)
+	var dps pmetric.NumberDataPointSlice
+	if m.Type() == pmetric.MetricTypeSum {
+		dps = m.Sum().DataPoints()
+	} else {
+		dps = m.Gauge().DataPoints()
+	}
+	for i := 0; i < dps.Len(); i++ {
+		dp := dps.At(i)
+		if flag, ok := dp.Attributes().Get("anomaly"); ok && flag.Bool() {
+			host, _ := dp.Attributes().Get("host")
+			score, _ := dp.Attributes().Get("anomaly.score")
+			flagged[host.Str()] = score.Double()
+		}
+	}
+	return flagged
+}
+
+func TestProcessGauge(t *testing.T) {
+	p := newTestProcessor(t, nil)
+	for i := 0; i < 30; i++ {
+		v := 50 + float64(i%3)
+		md, err := p.processMetrics(context.Background(), newGauge("cpu", startTime.Add(time.Duration(i)*time.Minute), map[string]float64{"a": v, "b": v}))
+		require.NoError(t, err)
+		assert.Empty(t, anomalies(md))
+	}
+
+	md, err := p.processMetrics(context.Background(), newGauge("cpu", startTime.Add(30*time.Minute), map[string]float64{"a": 90, "b": 51}))
+	require.NoError(t, err)
+	flagged := anomalies(md)
+	assert.Len(t, flagged, 1)
+	assert.Greater(t, flagged["a"], 3.0)
+	assert.Equal(t, 1, md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().Len())
+}
+
+func TestProcessMetricOutput(t *testing.T) {
+	p := newTestProcessor(t, func(cfg *Config) {
+		cfg.Method = methodESD
+		cfg.Output = outputMetric
+	})
+	for i := 0; i < 30; i++ {
+		_, err := p.processMetrics(context.Background(), newGauge("latency", startTime.Add(time.Duration(i)*time.Minute), map[string]float64{"a": 10 + float64(i%4)}))
+		require.NoError(t, err)
+	}
+
+	md, err := p.processMetrics(context.Background(), newGauge("latency", startTime.Add(30*time.Minute), map[string]float64{"a": 100}))
+	require.NoError(t, err)
+	assert.Empty(t, anomalies(md))
+
+	metrics := md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	require.Equal(t, 2, metrics.Len())
+	score := metrics.At(1)
+	assert.Equal(t, "latency.anomaly_score", score.Name())
+	require.Equal(t, 1, score.Gauge().DataPoints().Len())
+	dp := score.Gauge().DataPoints().At(0)
+	host, _ := dp.Attributes().Get("host")
+	assert.Equal(t, "a", host.Str())
+	assert.Greater(t, dp.DoubleValue(), esdCriticalValue(31, 0.05))
+	assert.Equal(t, pcommon.NewTimestampFromTime(startTime.Add(30*time.Minute)), dp.Timestamp())
+}
+
+func TestProcessCumulativeSum(t *testing.T) {
+	p := newTestProcessor(t, nil)
+	var total int64
+	for i := 0; i < 30; i++ {
+		total += 100 + int64(i%3)
+		md, err := p.processMetrics(context.Background(), newCumulativeSum("requests", startTime.Add(time.Duration(i)*time.Minute), total))
+		require.NoError(t, err)
+		assert.Empty(t, anomalies(md))
+	}
+
+	// A reset only sets the base of the next delta.
+	md, err := p.processMetrics(context.Background(), newCumulativeSum("requests", startTime.Add(30*time.Minute), 5))
+	require.NoError(t, err)
+	assert.Empty(t, anomalies(md))
+	md, err = p.processMetrics(context.Background(), newCumulativeSum("requests", startTime.Add(31*time.Minute), 106))
+	require.NoError(t, err)
+	assert.Empty(t, anomalies(md))
+
+	md, err = p.processMetrics(context.Background(), newCumulativeSum("requests", startTime.Add(32*time.Minute), 1106))
+	require.NoError(t, err)
+	assert.Len(t, anomalies(md), 1)
+}
+
+func TestProcessSeasonality(t *testing.T) {
+	p := newTestProcessor(t, func(cfg *Config) {
+		cfg.Seasonality = SeasonalityConfig{Period: 2 * time.Hour, Buckets: 2}
+	})
+	// The value alternates between two levels every hour, which is only
+	// normal with a baseline for each hour of the period.
+	for i := 0; i < 60; i++ {
+		ts := startTime.Add(time.Duration(i) * 30 * time.Minute)
+		v := 10 + float64(i%3)
+		if ts.Hour()%2 == 1 {
+			v += 1000
+		}
+		md, err := p.processMetrics(context.Background(), newGauge("queue", ts, map[string]float64{"a": v}))
+		require.NoError(t, err)
+		assert.Empty(t, anomalies(md), "value %d", i)
+	}
+}
+
+func TestDetectorPhaseBeforeEpoch(t *testing.T) {
+	p := newTestProcessor(t, func(cfg *Config) {
+		cfg.Seasonality = SeasonalityConfig{Period: 2 * time.Hour, Buckets: 2}
+	})
+	s := &series{}
+	// 30 minutes before the epoch falls in the second hour of the period.
+	before := pcommon.NewTimestampFromTime(time.Unix(0, 0).Add(-30 * time.Minute))
+	d := p.detector(s, before, startTime)
+	require.Len(t, s.detectors, 2)
+	assert.Nil(t, s.detectors[0])
+	assert.Same(t, s.detectors[1], d)
+}
+
+func TestMaxSeriesAndStaleness(t *testing.T) {
+	p := newTestProcessor(t, func(cfg *Config) {
+		cfg.MaxSeries = 1
+		cfg.MaxStaleness = time.Hour
+	})
+	_, err := p.processMetrics(context.Background(), newGauge("cpu", startTime, map[string]float64{"a": 1}))
+	require.NoError(t, err)
+	_, err = p.processMetrics(context.Background(), newGauge("cpu", startTime, map[string]float64{"b": 1}))
+	require.NoError(t, err)
+	assert.Len(t, p.series, 1)
+
+	p.now = func() time.Time { return startTime.Add(2 * time.Hour) }
+	_, err = p.processMetrics(context.Background(), newGauge("cpu", startTime, map[string]float64{"b": 1}))
+	require.NoError(t, err)
+	require.Len(t, p.series, 1)
+	attrs := pcommon.NewMap()
+	attrs.PutStr("host", "b")
+	for key := range p.series {
+		assert.Equal(t, pdatautil.MapHash(attrs), key.attrs)
+	}
+}
+
+func TestProcessorThroughFactory(t *testing.T) {
+	sink := &consumertest.MetricsSink{}
+	proc, err := NewFactory().CreateMetrics(context.Background(), processortest.NewNopSettings(metadata.Type), createDefaultConfig(), sink)
+	require.NoError(t, err)
+	assert.True(t, proc.Capabilities().MutatesData)
+	require.NoError(t, proc.Start(context.Background(), componenttest.NewNopHost()))
+	require.NoError(t, proc.ConsumeMetrics(context.Background(), newGauge("cpu", startTime, map[string]float64{"a": 1})))
+	require.NoError(t, proc.Shutdown(context.Background()))
+	assert.Equal(t, 1, sink.DataPointCount())
+}
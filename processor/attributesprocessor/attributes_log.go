@@ -18,16 +18,18 @@ type logAttributesProcessor struct {
 	logger   *zap.Logger
 	attrProc *attraction.AttrProc
 	skipExpr expr.BoolExpr[ottllog.TransformContext]
+	target   Target
 }
 
 // newLogAttributesProcessor returns a processor that modifies attributes of a
 // log record. To construct the attributes processors, the use of the factory
 // methods are required in order to validate the inputs.
-func newLogAttributesProcessor(logger *zap.Logger, attrProc *attraction.AttrProc, skipExpr expr.BoolExpr[ottllog.TransformContext]) *logAttributesProcessor {
+func newLogAttributesProcessor(logger *zap.Logger, attrProc *attraction.AttrProc, skipExpr expr.BoolExpr[ottllog.TransformContext], target Target) *logAttributesProcessor {
 	return &logAttributesProcessor{
 		logger:   logger,
 		attrProc: attrProc,
 		skipExpr: skipExpr,
+		target:   target,
 	}
 }
 
@@ -53,6 +55,11 @@ func (a *logAttributesProcessor) processLogs(ctx context.Context, ld plog.Logs)
 					}
 				}
 
+				if a.target == TargetScope {
+					// the scope matches, no need to look at its other log records
+					a.attrProc.Process(ctx, a.logger, library.Attributes())
+					break
+				}
 				a.attrProc.Process(ctx, a.logger, lr.Attributes())
 			}
 		}
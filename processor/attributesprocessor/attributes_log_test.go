@@ -467,3 +467,43 @@ func BenchmarkAttributes_FilterLogsByName(b *testing.B) {
 		require.NoError(b, plogtest.CompareLogs(generateLogData(tt.name, tt.expectedAttributes), td))
 	}
 }
+
+func TestAttributes_ScopeTarget_Logs(t *testing.T) {
+	generateLogs := func(sdkScopeAttrs map[string]any) plog.Logs {
+		ld := plog.NewLogs()
+		rl := ld.ResourceLogs().AppendEmpty()
+		for _, name := range []string{"io.opentelemetry.sdk.logs", "app"} {
+			sl := rl.ScopeLogs().AppendEmpty()
+			sl.Scope().SetName(name)
+			attrs := map[string]any{"sdk.version": "1.2.3", "keep": true}
+			if name != "app" {
+				attrs = sdkScopeAttrs
+			}
+			//nolint:errcheck
+			sl.Scope().Attributes().FromRaw(attrs)
+			for range 2 {
+				//nolint:errcheck
+				sl.LogRecords().AppendEmpty().Attributes().FromRaw(map[string]any{"sdk.internal": true})
+			}
+		}
+		return ld
+	}
+
+	factory := NewFactory()
+	cfg := factory.CreateDefaultConfig()
+	oCfg := cfg.(*Config)
+	oCfg.Target = TargetScope
+	oCfg.Actions = []attraction.ActionKeyValue{
+		{RegexPattern: `^sdk\.`, Action: attraction.DELETE},
+	}
+	oCfg.Include = &filterconfig.MatchProperties{
+		Libraries: []filterconfig.InstrumentationLibrary{{Name: `^io\.opentelemetry\.sdk\.`}},
+		Config:    *createConfig(filterset.Regexp),
+	}
+	tp, err := factory.CreateLogs(context.Background(), processortest.NewNopSettings(metadata.Type), cfg, consumertest.NewNop())
+	require.NoError(t, err)
+
+	ld := generateLogs(map[string]any{"sdk.version": "1.2.3", "keep": true})
+	require.NoError(t, tp.ConsumeLogs(context.Background(), ld))
+	assert.NoError(t, plogtest.CompareLogs(generateLogs(map[string]any{"keep": true}), ld))
+}
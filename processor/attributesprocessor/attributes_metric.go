@@ -18,16 +18,18 @@ type metricAttributesProcessor struct {
 	logger   *zap.Logger
 	attrProc *attraction.AttrProc
 	skipExpr expr.BoolExpr[ottlmetric.TransformContext]
+	target   Target
 }
 
 // newMetricAttributesProcessor returns a processor that modifies attributes of a
 // metric record. To construct the attributes processors, the use of the factory
 // methods are required in order to validate the inputs.
-func newMetricAttributesProcessor(logger *zap.Logger, attrProc *attraction.AttrProc, skipExpr expr.BoolExpr[ottlmetric.TransformContext]) *metricAttributesProcessor {
+func newMetricAttributesProcessor(logger *zap.Logger, attrProc *attraction.AttrProc, skipExpr expr.BoolExpr[ottlmetric.TransformContext], target Target) *metricAttributesProcessor {
 	return &metricAttributesProcessor{
 		logger:   logger,
 		attrProc: attrProc,
 		skipExpr: skipExpr,
+		target:   target,
 	}
 }
 
@@ -52,6 +54,11 @@ func (a *metricAttributesProcessor) processMetrics(ctx context.Context, md pmetr
 						continue
 					}
 				}
+				if a.target == TargetScope {
+					// the scope matches, no need to look at its other metrics
+					a.attrProc.Process(ctx, a.logger, scope.Attributes())
+					break
+				}
 				a.processMetricAttributes(ctx, m)
 			}
 		}
@@ -459,3 +459,46 @@ func BenchmarkAttributes_FilterMetricsByName(b *testing.B) {
 		require.NoError(b, pmetrictest.CompareMetrics(generateMetricData(tc.name, tc.expectedAttributes), md))
 	}
 }
+
+func TestAttributes_ScopeTarget_Metrics(t *testing.T) {
+	generateMetrics := func(sdkScopeAttrs map[string]any) pmetric.Metrics {
+		md := pmetric.NewMetrics()
+		rm := md.ResourceMetrics().AppendEmpty()
+		for _, name := range []string{"io.opentelemetry.sdk.metrics", "app"} {
+			sm := rm.ScopeMetrics().AppendEmpty()
+			sm.Scope().SetName(name)
+			attrs := map[string]any{"sdk.version": "1.2.3", "keep": true}
+			if name != "app" {
+				attrs = sdkScopeAttrs
+			}
+			//nolint:errcheck
+			sm.Scope().Attributes().FromRaw(attrs)
+			for range 2 {
+				m := sm.Metrics().AppendEmpty()
+				m.SetName(name + ".metric")
+				//nolint:errcheck
+				m.SetEmptyGauge().DataPoints().AppendEmpty().Attributes().FromRaw(map[string]any{"sdk.internal": true})
+			}
+		}
+		return md
+	}
+
+	factory := NewFactory()
+	cfg := factory.CreateDefaultConfig()
+	oCfg := cfg.(*Config)
+	oCfg.Target = TargetScope
+	oCfg.Actions = []attraction.ActionKeyValue{
+		{RegexPattern: `^sdk\.`, Action: attraction.DELETE},
+	}
+	// metrics can't be matched by their scope, match their names instead
+	oCfg.Include = &filterconfig.MatchProperties{
+		MetricNames: []string{`^io\.opentelemetry\.sdk\.`},
+		Config:      *createConfig(filterset.Regexp),
+	}
+	tp, err := factory.CreateMetrics(context.Background(), processortest.NewNopSettings(metadata.Type), cfg, consumertest.NewNop())
+	require.NoError(t, err)
+
+	md := generateMetrics(map[string]any{"sdk.version": "1.2.3", "keep": true})
+	require.NoError(t, tp.ConsumeMetrics(context.Background(), md))
+	assert.NoError(t, pmetrictest.CompareMetrics(generateMetrics(map[string]any{"keep": true}), md))
+}
@@ -18,16 +18,18 @@ type spanAttributesProcessor struct {
 	logger   *zap.Logger
 	attrProc *attraction.AttrProc
 	skipExpr expr.BoolExpr[ottlspan.TransformContext]
+	target   Target
 }
 
 // newTracesProcessor returns a processor that modifies attributes of a span.
 // To construct the attributes processors, the use of the factory methods are required
 // in order to validate the inputs.
-func newSpanAttributesProcessor(logger *zap.Logger, attrProc *attraction.AttrProc, skipExpr expr.BoolExpr[ottlspan.TransformContext]) *spanAttributesProcessor {
+func newSpanAttributesProcessor(logger *zap.Logger, attrProc *attraction.AttrProc, skipExpr expr.BoolExpr[ottlspan.TransformContext], target Target) *spanAttributesProcessor {
 	return &spanAttributesProcessor{
 		logger:   logger,
 		attrProc: attrProc,
 		skipExpr: skipExpr,
+		target:   target,
 	}
 }
 
@@ -52,6 +54,11 @@ func (a *spanAttributesProcessor) processTraces(ctx context.Context, td ptrace.T
 						continue
 					}
 				}
+				if a.target == TargetScope {
+					// the scope matches, no need to look at its other spans
+					a.attrProc.Process(ctx, a.logger, scope.Attributes())
+					break
+				}
 				a.attrProc.Process(ctx, a.logger, span.Attributes())
 			}
 		}
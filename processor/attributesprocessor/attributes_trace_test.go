@@ -521,3 +521,43 @@ func createConfig(matchType filterset.MatchType) *filterset.Config {
 		MatchType: matchType,
 	}
 }
+
+func TestAttributes_ScopeTarget(t *testing.T) {
+	generateTraces := func(sdkScopeAttrs map[string]any) ptrace.Traces {
+		td := ptrace.NewTraces()
+		rs := td.ResourceSpans().AppendEmpty()
+		for _, name := range []string{"io.opentelemetry.sdk.trace", "app"} {
+			ss := rs.ScopeSpans().AppendEmpty()
+			ss.Scope().SetName(name)
+			attrs := map[string]any{"sdk.version": "1.2.3", "keep": true}
+			if name != "app" {
+				attrs = sdkScopeAttrs
+			}
+			//nolint:errcheck
+			ss.Scope().Attributes().FromRaw(attrs)
+			for range 2 {
+				//nolint:errcheck
+				ss.Spans().AppendEmpty().Attributes().FromRaw(map[string]any{"sdk.internal": true})
+			}
+		}
+		return td
+	}
+
+	factory := NewFactory()
+	cfg := factory.CreateDefaultConfig()
+	oCfg := cfg.(*Config)
+	oCfg.Target = TargetScope
+	oCfg.Actions = []attraction.ActionKeyValue{
+		{RegexPattern: `^sdk\.`, Action: attraction.DELETE},
+	}
+	oCfg.Include = &filterconfig.MatchProperties{
+		Libraries: []filterconfig.InstrumentationLibrary{{Name: `^io\.opentelemetry\.sdk\.`}},
+		Config:    *createConfig(filterset.Regexp),
+	}
+	tp, err := factory.CreateTraces(context.Background(), processortest.NewNopSettings(metadata.Type), cfg, consumertest.NewNop())
+	require.NoError(t, err)
+
+	td := generateTraces(map[string]any{"sdk.version": "1.2.3", "keep": true})
+	require.NoError(t, tp.ConsumeTraces(context.Background(), td))
+	assert.NoError(t, ptracetest.CompareTraces(generateTraces(map[string]any{"keep": true}), td))
+}
@@ -5,6 +5,7 @@ package attributesprocessor // import "github.com/open-telemetry/opentelemetry-c
 
 import (
 	"errors"
+	"fmt"
 
 	"go.opentelemetry.io/collector/component"
 
@@ -26,8 +27,25 @@ type Config struct {
 	// The set of actions are {INSERT, UPDATE, UPSERT, DELETE, HASH, EXTRACT}.
 	// This is a required field.
 	attraction.Settings `mapstructure:",squash"`
+
+	// Target specifies the attributes the actions are applied to.
+	// The set of targets are {record, scope}; the default is record.
+	Target Target `mapstructure:"target"`
 }
 
+// Target is the attributes the actions of the processor are applied to.
+type Target string
+
+const (
+	// TargetRecord applies the actions to the attributes of the spans, log
+	// records and metric data points.
+	TargetRecord Target = "record"
+	// TargetScope applies the actions to the attributes of the instrumentation
+	// scopes. A scope is processed if at least one of its spans, log records
+	// or metrics is matched by the include/exclude properties.
+	TargetScope Target = "scope"
+)
+
 var _ component.Config = (*Config)(nil)
 
 // Validate checks if the processor configuration is valid
@@ -35,5 +53,10 @@ func (cfg *Config) Validate() error {
 	if len(cfg.Actions) == 0 {
 		return errors.New("missing required field \"actions\"")
 	}
+	switch cfg.Target {
+	case "", TargetRecord, TargetScope:
+	default:
+		return fmt.Errorf("unsupported target %q, must be one of %q or %q", cfg.Target, TargetRecord, TargetScope)
+	}
 	return nil
 }
@@ -201,6 +201,24 @@ func TestLoadConfig(t *testing.T) {
 				},
 			},
 		},
+		{
+			id: component.NewIDWithName(metadata.Type, "scope"),
+			expected: &Config{
+				MatchConfig: filterconfig.MatchConfig{
+					Include: &filterconfig.MatchProperties{
+						Config:    *createConfig(filterset.Regexp),
+						Libraries: []filterconfig.InstrumentationLibrary{{Name: `^io\.opentelemetry\.sdk\.`}},
+					},
+				},
+				Settings: attraction.Settings{
+					Actions: []attraction.ActionKeyValue{
+						{Key: "otel.scope.internal", Action: attraction.DELETE},
+						{RegexPattern: "^sdk\\.", Action: attraction.DELETE},
+					},
+				},
+				Target: TargetScope,
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -56,7 +56,7 @@ func createTracesProcessor(
 		set,
 		cfg,
 		nextConsumer,
-		newSpanAttributesProcessor(set.Logger, attrProc, skipExpr).processTraces,
+		newSpanAttributesProcessor(set.Logger, attrProc, skipExpr, oCfg.Target).processTraces,
 		processorhelper.WithCapabilities(processorCapabilities))
 }
 
@@ -82,7 +82,7 @@ func createLogsProcessor(
 		set,
 		cfg,
 		nextConsumer,
-		newLogAttributesProcessor(set.Logger, attrProc, skipExpr).processLogs,
+		newLogAttributesProcessor(set.Logger, attrProc, skipExpr, oCfg.Target).processLogs,
 		processorhelper.WithCapabilities(processorCapabilities))
 }
 
@@ -120,6 +120,6 @@ func createMetricsProcessor(
 		set,
 		cfg,
 		nextConsumer,
-		newMetricAttributesProcessor(set.Logger, attrProc, skipExpr).processMetrics,
+		newMetricAttributesProcessor(set.Logger, attrProc, skipExpr, oCfg.Target).processMetrics,
 		processorhelper.WithCapabilities(processorCapabilities))
 }
@@ -34,6 +34,11 @@ func TestValidateConfig(t *testing.T) {
 	factory := NewFactory()
 	cfg := factory.CreateDefaultConfig()
 	assert.Error(t, xconfmap.Validate(cfg))
+
+	oCfg := cfg.(*Config)
+	oCfg.Actions = []attraction.ActionKeyValue{{Key: "attribute1", Action: attraction.DELETE}}
+	oCfg.Target = "entity"
+	assert.EqualError(t, xconfmap.Validate(cfg), `unsupported target "entity", must be one of "record" or "scope"`)
 }
 
 func TestFactoryCreateTraces_InvalidActions(t *testing.T) {
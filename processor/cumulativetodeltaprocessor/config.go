@@ -34,8 +34,12 @@ type Config struct {
 	//   - auto: (default) send the first point iff the startime is set AND the starttime happens after the component started AND the starttime is different from the timestamp
 	//   - keep: always send the first point
 	//   - drop: don't send the first point, but store it for subsequent delta calculations
+	//   - zero: send the first point with a zero value, and store it for subsequent delta calculations
 	InitialValue tracking.InitialValue `mapstructure:"initial_value"`
 
+	// ResetDetection configures how the resets of cumulative values are detected and handled.
+	ResetDetection ResetDetectionConfig `mapstructure:"reset_detection"`
+
 	// Include specifies a filter on the metrics that should be converted.
 	// Exclude specifies a filter on the metrics that should not be converted.
 	// If neither `include` nor `exclude` are set, all metrics will be converted.
@@ -44,6 +48,23 @@ type Config struct {
 	Exclude MatchMetrics `mapstructure:"exclude"`
 }
 
+type ResetDetectionConfig struct {
+	// OnReset determines how the first point after a reset is handled. Valid values:
+	//
+	//   - auto: (default) don't send the point of a sum, send the point of a histogram as the delta
+	//   - keep: send the point as the delta, as the value accumulated since the reset
+	//   - drop: don't send the point, but store it for subsequent delta calculations
+	OnReset tracking.OnReset `mapstructure:"on_reset"`
+
+	// Tolerance is the decrease of a sum, relative to its previous value, that is not considered a reset,
+	// e.g. 0.001 to ignore the rounding errors of floating point sums. Such decreases are sent as a zero delta.
+	Tolerance float64 `mapstructure:"tolerance"`
+
+	// IgnoreStartTimestamp doesn't consider a change of the start timestamp of a series as a reset,
+	// for sources that don't keep the start timestamp of their cumulative values.
+	IgnoreStartTimestamp bool `mapstructure:"ignore_start_timestamp"`
+}
+
 type MatchMetrics struct {
 	filterset.Config `mapstructure:",squash"`
 
@@ -66,6 +87,10 @@ func (config *Config) Validate() error {
 		return errors.New("metrics must be supplied if match_type is set")
 	}
 
+	if config.ResetDetection.Tolerance < 0 || config.ResetDetection.Tolerance >= 1 {
+		return errors.New("reset_detection.tolerance must be between 0 and 1")
+	}
+
 	for _, metricType := range config.Exclude.MetricTypes {
 		if valid := validMetricTypes[strings.ToLower(metricType)]; !valid {
 			return fmt.Errorf(
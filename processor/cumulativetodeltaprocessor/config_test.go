@@ -149,6 +149,26 @@ func TestLoadConfig(t *testing.T) {
 				InitialValue: tracking.InitialValueDrop,
 			},
 		},
+		{
+			id: component.NewIDWithName(metadata.Type, "zero"),
+			expected: &Config{
+				InitialValue: tracking.InitialValueZero,
+			},
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "reset_detection"),
+			expected: &Config{
+				ResetDetection: ResetDetectionConfig{
+					OnReset:              tracking.OnResetKeep,
+					Tolerance:            0.001,
+					IgnoreStartTimestamp: true,
+				},
+			},
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "invalid_tolerance"),
+			errorMessage: "reset_detection.tolerance must be between 0 and 1",
+		},
 	}
 
 	for _, tt := range tests {
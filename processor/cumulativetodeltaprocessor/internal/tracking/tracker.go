@@ -25,6 +25,7 @@ const (
 	InitialValueAuto InitialValue = iota
 	InitialValueKeep
 	InitialValueDrop
+	InitialValueZero
 )
 
 func (i *InitialValue) String() string {
@@ -35,6 +36,8 @@ func (i *InitialValue) String() string {
 		return "keep"
 	case InitialValueDrop:
 		return "drop"
+	case InitialValueZero:
+		return "zero"
 	}
 	return "unknown"
 }
@@ -47,12 +50,59 @@ func (i *InitialValue) UnmarshalText(text []byte) error {
 		*i = InitialValueKeep
 	case "drop":
 		*i = InitialValueDrop
+	case "zero":
+		*i = InitialValueZero
 	default:
 		return fmt.Errorf("unknown initial_value: %s", text)
 	}
 	return nil
 }
 
+type OnReset int
+
+const (
+	OnResetAuto OnReset = iota
+	OnResetKeep
+	OnResetDrop
+)
+
+func (r *OnReset) String() string {
+	switch *r {
+	case OnResetAuto:
+		return "auto"
+	case OnResetKeep:
+		return "keep"
+	case OnResetDrop:
+		return "drop"
+	}
+	return "unknown"
+}
+
+func (r *OnReset) UnmarshalText(text []byte) error {
+	switch string(text) {
+	case "auto":
+		*r = OnResetAuto
+	case "keep":
+		*r = OnResetKeep
+	case "drop":
+		*r = OnResetDrop
+	default:
+		return fmt.Errorf("unknown on_reset: %s", text)
+	}
+	return nil
+}
+
+// ResetDetection configures how the resets of cumulative values are detected and handled.
+type ResetDetection struct {
+	// OnReset determines how the first point after a reset is handled.
+	OnReset OnReset
+	// Tolerance is the decrease of a sum, relative to its previous value, that is not considered a reset.
+	Tolerance float64
+	// IgnoreStartTimestamp excludes the start timestamp from the identity of the tracked series,
+	// so that a change of start timestamp does not start a new series.
+	IgnoreStartTimestamp bool
+}
+
 var identityBufferPool = sync.Pool{
 	New: func() any {
 		return bytes.NewBuffer(make([]byte, initialBytes))
@@ -71,12 +121,13 @@ type DeltaValue struct {
 	HistogramValue *HistogramPoint
 }
 
-func NewMetricTracker(ctx context.Context, logger *zap.Logger, maxStaleness time.Duration, initalValue InitialValue) *MetricTracker {
+func NewMetricTracker(ctx context.Context, logger *zap.Logger, maxStaleness time.Duration, initalValue InitialValue, resetDetection ResetDetection) *MetricTracker {
 	t := &MetricTracker{
-		logger:       logger,
-		maxStaleness: maxStaleness,
-		initialValue: initalValue,
-		startTime:    pcommon.NewTimestampFromTime(time.Now()),
+		logger:         logger,
+		maxStaleness:   maxStaleness,
+		initialValue:   initalValue,
+		resetDetection: resetDetection,
+		startTime:      pcommon.NewTimestampFromTime(time.Now()),
 	}
 	if maxStaleness > 0 {
 		go t.sweeper(ctx, t.removeStale)
@@ -85,11 +136,12 @@ func NewMetricTracker(ctx context.Context, logger *zap.Logger, maxStaleness time
 }
 
 type MetricTracker struct {
-	logger       *zap.Logger
-	maxStaleness time.Duration
-	states       sync.Map
-	initialValue InitialValue
-	startTime    pcommon.Timestamp
+	logger         *zap.Logger
+	maxStaleness   time.Duration
+	states         sync.Map
+	initialValue   InitialValue
+	resetDetection ResetDetection
+	startTime      pcommon.Timestamp
 }
 
 func (t *MetricTracker) Convert(in MetricPoint) (out DeltaValue, valid bool) {
@@ -108,7 +160,11 @@ func (t *MetricTracker) Convert(in MetricPoint) (out DeltaValue, valid bool) {
 
 	b := identityBufferPool.Get().(*bytes.Buffer)
 	b.Reset()
-	metricID.Write(b)
+	stateID := metricID
+	if t.resetDetection.IgnoreStartTimestamp {
+		stateID.StartTimestamp = 0
+	}
+	stateID.Write(b)
 	hashableID := b.String()
 	identityBufferPool.Put(b)
 
@@ -135,6 +191,14 @@ func (t *MetricTracker) Convert(in MetricPoint) (out DeltaValue, valid bool) {
 		case InitialValueKeep:
 			valid = true
 		case InitialValueDrop:
+		case InitialValueZero:
+			// Send an empty delta, so that the series is reported from its first point
+			// without the total accumulated before.
+			out = DeltaValue{StartTimestamp: metricPoint.ObservedTimestamp}
+			if metricID.MetricType == pmetric.MetricTypeHistogram {
+				out.HistogramValue = &HistogramPoint{Buckets: make([]uint64, len(metricPoint.HistogramValue.Buckets))}
+			}
+			valid = true
 		}
 		return
 	}
@@ -162,13 +226,17 @@ func (t *MetricTracker) Convert(in MetricPoint) (out DeltaValue, valid bool) {
 		delta := value.Clone()
 
 		// Calculate deltas unless histogram count was reset
-		if valid && delta.Count >= prevValue.Count {
+		reset := delta.Count < prevValue.Count
+		if valid && !reset {
 			delta.Count -= prevValue.Count
 			delta.Sum -= prevValue.Sum
 			for index, prevBucket := range prevValue.Buckets {
 				delta.Buckets[index] -= prevBucket
 			}
 		}
+		if reset && t.resetDetection.OnReset == OnResetDrop {
+			valid = false
+		}
 
 		out.HistogramValue = &delta
 	case pmetric.MetricTypeSum:
@@ -179,7 +247,14 @@ func (t *MetricTracker) Convert(in MetricPoint) (out DeltaValue, valid bool) {
 
 			// Detect reset (non-monotonic sums are not converted)
 			if value < prevValue {
-				valid = false
+				if t.withinTolerance(prevValue-value, prevValue) {
+					// Keep the previous value as the base of the next delta.
+					delta = 0
+					metricPoint.FloatValue = prevValue
+				} else {
+					delta = value
+					valid = t.resetDetection.OnReset == OnResetKeep
+				}
 			}
 
 			out.FloatValue = delta
@@ -190,7 +265,14 @@ func (t *MetricTracker) Convert(in MetricPoint) (out DeltaValue, valid bool) {
 
 			// Detect reset (non-monotonic sums are not converted)
 			if value < prevValue {
-				valid = false
+				if t.withinTolerance(float64(prevValue-value), float64(prevValue)) {
+					// Keep the previous value as the base of the next delta.
+					delta = 0
+					metricPoint.IntValue = prevValue
+				} else {
+					delta = value
+					valid = t.resetDetection.OnReset == OnResetKeep
+				}
 			}
 
 			out.IntValue = delta
@@ -202,6 +284,12 @@ func (t *MetricTracker) Convert(in MetricPoint) (out DeltaValue, valid bool) {
 	return
 }
 
+// withinTolerance reports whether the decrease of a sum from its previous value is small enough
+// not to be considered a reset.
+func (t *MetricTracker) withinTolerance(decrease, prevValue float64) bool {
+	return decrease <= t.resetDetection.Tolerance*math.Abs(prevValue)
+}
+
 func (t *MetricTracker) removeStale(staleBefore pcommon.Timestamp) {
 	t.states.Range(func(key, value any) bool {
 		s := value.(*State)
@@ -194,7 +194,7 @@ func TestMetricTracker_Convert(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.initValue.String(), func(t *testing.T) {
-			m := NewMetricTracker(context.Background(), zap.NewNop(), 0, tt.initValue)
+			m := NewMetricTracker(context.Background(), zap.NewNop(), 0, tt.initValue, ResetDetection{})
 
 			miSum := miSum
 			miSum.StartTimestamp = tt.metricStartTime
@@ -231,7 +231,7 @@ func TestMetricTracker_Convert(t *testing.T) {
 	}
 
 	t.Run("Invalid metric identity", func(t *testing.T) {
-		m := NewMetricTracker(context.Background(), zap.NewNop(), 0, InitialValueAuto)
+		m := NewMetricTracker(context.Background(), zap.NewNop(), 0, InitialValueAuto, ResetDetection{})
 		invalidID := miIntSum
 		invalidID.MetricType = pmetric.MetricTypeGauge
 		_, valid := m.Convert(MetricPoint{
@@ -246,6 +246,151 @@ func TestMetricTracker_Convert(t *testing.T) {
 	})
 }
 
+func TestMetricTracker_InitialValueZero(t *testing.T) {
+	m := NewMetricTracker(context.Background(), zap.NewNop(), 0, InitialValueZero, ResetDetection{})
+	id := MetricIdentity{
+		Resource:               pcommon.NewResource(),
+		InstrumentationLibrary: pcommon.NewInstrumentationScope(),
+		MetricType:             pmetric.MetricTypeSum,
+		MetricIsMonotonic:      true,
+		MetricValueType:        pmetric.NumberDataPointValueTypeInt,
+		Attributes:             pcommon.NewMap(),
+		StartTimestamp:         1,
+	}
+
+	out, valid := m.Convert(MetricPoint{Identity: id, Value: ValuePoint{ObservedTimestamp: 10, IntValue: 100}})
+	require.True(t, valid)
+	assert.Equal(t, DeltaValue{StartTimestamp: 10}, out)
+
+	out, valid = m.Convert(MetricPoint{Identity: id, Value: ValuePoint{ObservedTimestamp: 20, IntValue: 130}})
+	require.True(t, valid)
+	assert.Equal(t, DeltaValue{StartTimestamp: 10, IntValue: 30}, out)
+
+	histogramID := id
+	histogramID.MetricType = pmetric.MetricTypeHistogram
+	out, valid = m.Convert(MetricPoint{Identity: histogramID, Value: ValuePoint{
+		ObservedTimestamp: 10,
+		HistogramValue:    &HistogramPoint{Count: 3, Sum: 6, Buckets: []uint64{1, 2}},
+	}})
+	require.True(t, valid)
+	assert.Equal(t, DeltaValue{StartTimestamp: 10, HistogramValue: &HistogramPoint{Buckets: []uint64{0, 0}}}, out)
+}
+
+func TestMetricTracker_ResetDetection(t *testing.T) {
+	id := MetricIdentity{
+		Resource:               pcommon.NewResource(),
+		InstrumentationLibrary: pcommon.NewInstrumentationScope(),
+		MetricType:             pmetric.MetricTypeSum,
+		MetricIsMonotonic:      true,
+		MetricValueType:        pmetric.NumberDataPointValueTypeDouble,
+		Attributes:             pcommon.NewMap(),
+	}
+
+	type point struct {
+		startTimestamp pcommon.Timestamp
+		timestamp      pcommon.Timestamp
+		value          float64
+		wantStart      pcommon.Timestamp
+		wantDelta      float64
+		wantValid      bool
+	}
+	tests := []struct {
+		name           string
+		resetDetection ResetDetection
+		points         []point
+	}{
+		{
+			name: "auto drops the point after a reset",
+			points: []point{
+				{timestamp: 10, value: 100},
+				{timestamp: 20, value: 40},
+				{timestamp: 30, value: 50, wantStart: 20, wantDelta: 10, wantValid: true},
+			},
+		},
+		{
+			name:           "keep sends the point after a reset",
+			resetDetection: ResetDetection{OnReset: OnResetKeep},
+			points: []point{
+				{timestamp: 10, value: 100},
+				{timestamp: 20, value: 40, wantStart: 10, wantDelta: 40, wantValid: true},
+				{timestamp: 30, value: 50, wantStart: 20, wantDelta: 10, wantValid: true},
+			},
+		},
+		{
+			name:           "decrease within tolerance",
+			resetDetection: ResetDetection{Tolerance: 0.01},
+			points: []point{
+				{timestamp: 10, value: 100},
+				{timestamp: 20, value: 99.5, wantStart: 10, wantDelta: 0, wantValid: true},
+				{timestamp: 30, value: 101, wantStart: 20, wantDelta: 1, wantValid: true},
+				{timestamp: 40, value: 50},
+			},
+		},
+		{
+			name: "new start timestamp starts a new series",
+			points: []point{
+				{startTimestamp: 1, timestamp: 10, value: 100},
+				{startTimestamp: 1, timestamp: 20, value: 110, wantStart: 10, wantDelta: 10, wantValid: true},
+				{startTimestamp: 15, timestamp: 30, value: 120},
+			},
+		},
+		{
+			name:           "ignore start timestamp",
+			resetDetection: ResetDetection{IgnoreStartTimestamp: true},
+			points: []point{
+				{startTimestamp: 1, timestamp: 10, value: 100},
+				{startTimestamp: 15, timestamp: 20, value: 110, wantStart: 10, wantDelta: 10, wantValid: true},
+				{startTimestamp: 25, timestamp: 30, value: 5},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := NewMetricTracker(context.Background(), zap.NewNop(), 0, InitialValueDrop, tt.resetDetection)
+			for _, p := range tt.points {
+				pointID := id
+				pointID.StartTimestamp = p.startTimestamp
+				out, valid := m.Convert(MetricPoint{
+					Identity: pointID,
+					Value:    ValuePoint{ObservedTimestamp: p.timestamp, FloatValue: p.value},
+				})
+				require.Equal(t, p.wantValid, valid, "point at %d", p.timestamp)
+				if valid {
+					assert.Equal(t, p.wantStart, out.StartTimestamp)
+					assert.Equal(t, p.wantDelta, out.FloatValue)
+				}
+			}
+		})
+	}
+}
+
+func TestMetricTracker_HistogramReset(t *testing.T) {
+	id := MetricIdentity{
+		Resource:               pcommon.NewResource(),
+		InstrumentationLibrary: pcommon.NewInstrumentationScope(),
+		MetricType:             pmetric.MetricTypeHistogram,
+		MetricIsMonotonic:      true,
+		MetricValueType:        pmetric.NumberDataPointValueTypeInt,
+		Attributes:             pcommon.NewMap(),
+	}
+	first := ValuePoint{ObservedTimestamp: 10, HistogramValue: &HistogramPoint{Count: 5, Sum: 10, Buckets: []uint64{2, 3}}}
+	reset := ValuePoint{ObservedTimestamp: 20, HistogramValue: &HistogramPoint{Count: 1, Sum: 1, Buckets: []uint64{1, 0}}}
+
+	m := NewMetricTracker(context.Background(), zap.NewNop(), 0, InitialValueDrop, ResetDetection{})
+	_, valid := m.Convert(MetricPoint{Identity: id, Value: first})
+	require.False(t, valid)
+	out, valid := m.Convert(MetricPoint{Identity: id, Value: reset})
+	require.True(t, valid)
+	assert.Equal(t, &HistogramPoint{Count: 1, Sum: 1, Buckets: []uint64{1, 0}}, out.HistogramValue)
+
+	m = NewMetricTracker(context.Background(), zap.NewNop(), 0, InitialValueDrop, ResetDetection{OnReset: OnResetDrop})
+	_, valid = m.Convert(MetricPoint{Identity: id, Value: first})
+	require.False(t, valid)
+	_, valid = m.Convert(MetricPoint{Identity: id, Value: reset})
+	assert.False(t, valid)
+}
+
 func Test_metricTracker_removeStale(t *testing.T) {
 	currentTime := pcommon.Timestamp(100)
 	freshPoint := ValuePoint{
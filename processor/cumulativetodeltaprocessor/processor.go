@@ -56,7 +56,11 @@ func newCumulativeToDeltaProcessor(config *Config, logger *zap.Logger) (*cumulat
 		p.excludeMetricTypes = excludeMetricTypeFilter
 	}
 
-	p.deltaCalculator = tracking.NewMetricTracker(ctx, logger, config.MaxStaleness, config.InitialValue)
+	p.deltaCalculator = tracking.NewMetricTracker(ctx, logger, config.MaxStaleness, config.InitialValue, tracking.ResetDetection{
+		OnReset:              config.ResetDetection.OnReset,
+		Tolerance:            config.ResetDetection.Tolerance,
+		IgnoreStartTimestamp: config.ResetDetection.IgnoreStartTimestamp,
+	})
 
 	return p, nil
 }
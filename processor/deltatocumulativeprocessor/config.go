@@ -20,6 +20,10 @@ var _ xconfmap.Validator = (*Config)(nil)
 type Config struct {
 	MaxStale   time.Duration `mapstructure:"max_stale"`
 	MaxStreams int           `mapstructure:"max_streams"`
+
+	// Storage is the ID of a storage extension the state of the streams
+	// exceeding max_streams is spilled to, instead of rejecting them.
+	Storage *component.ID `mapstructure:"storage"`
 }
 
 func (c *Config) Validate() error {
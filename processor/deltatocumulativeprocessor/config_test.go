@@ -24,6 +24,8 @@ func TestLoadConfig(t *testing.T) {
 	cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
 	require.NoError(t, err)
 
+	storageID := component.MustNewID("file_storage")
+
 	tests := []struct {
 		id       component.ID
 		expected component.Config
@@ -49,6 +51,14 @@ func TestLoadConfig(t *testing.T) {
 				MaxStreams: 20,
 			},
 		},
+		{
+			id: component.NewIDWithName(metadata.Type, "set-valid-storage"),
+			expected: &Config{
+				MaxStale:   5 * time.Minute,
+				MaxStreams: 20,
+				Storage:    &storageID,
+			},
+		},
 	}
 
 	for _, tt := range tests {
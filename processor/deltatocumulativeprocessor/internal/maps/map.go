@@ -95,6 +95,11 @@ func (m *Parallel[K, V]) LoadOrStore(k K, def V) (_ V, loaded bool) {
 	return m.elems.Load(k)
 }
 
+// Load returns m[k], if it exists
+func (m *Parallel[K, V]) Load(k K) (_ V, loaded bool) {
+	return m.elems.Load(k)
+}
+
 // LoadAndDelete deletes m[k], returning the value it had if it existed
 func (m *Parallel[K, V]) LoadAndDelete(k K) (_ V, loaded bool) {
 	v, loaded := m.elems.LoadAndDelete(k)
@@ -27,9 +27,11 @@ var _ processor.Metrics = (*deltaToCumulativeProcessor)(nil)
 type deltaToCumulativeProcessor struct {
 	next consumer.Metrics
 	cfg  Config
+	id   component.ID
 
-	last state
-	aggr data.Aggregator
+	last  state
+	spill *spill
+	aggr  data.Aggregator
 
 	ctx    context.Context
 	cancel context.CancelFunc
@@ -38,13 +40,14 @@ type deltaToCumulativeProcessor struct {
 	tel   telemetry.Metrics
 }
 
-func newProcessor(cfg *Config, tel telemetry.Metrics, next consumer.Metrics) *deltaToCumulativeProcessor {
+func newProcessor(cfg *Config, id component.ID, tel telemetry.Metrics, next consumer.Metrics) *deltaToCumulativeProcessor {
 	ctx, cancel := context.WithCancel(context.Background())
 
 	limit := maps.Limit(int64(cfg.MaxStreams))
 	proc := deltaToCumulativeProcessor{
 		next: next,
 		cfg:  *cfg,
+		id:   id,
 		last: state{
 			ctx:  limit,
 			nums: maps.New[identity.Stream, *mutex[pmetric.NumberDataPoint]](limit),
@@ -59,12 +62,21 @@ func newProcessor(cfg *Config, tel telemetry.Metrics, next consumer.Metrics) *de
 		tel:   tel,
 	}
 
-	tel.WithTracked(proc.last.Size)
+	tel.WithTracked(proc.size)
 	cfg.Metrics(tel)
 
 	return &proc
 }
 
+// size returns the number of tracked streams, in memory and spilled to storage.
+func (p *deltaToCumulativeProcessor) size() int {
+	size := p.last.Size()
+	if p.spill != nil {
+		size += p.spill.Size()
+	}
+	return size
+}
+
 type vals struct {
 	nums *mutex[pmetric.NumberDataPoint]
 	hist *mutex[pmetric.HistogramDataPoint]
@@ -102,11 +114,27 @@ func (p *deltaToCumulativeProcessor) ConsumeMetrics(ctx context.Context, md pmet
 			var err error
 			switch dp := dp.(type) {
 			case pmetric.NumberDataPoint:
+				if _, ok := p.last.nums.Load(id); !ok && p.spill != nil {
+					// a spilled stream stays in storage until it goes stale,
+					// even once there is room in memory again
+					var spilled bool
+					spilled, err = aggregate(ctx, p.spill, numbers, id, dp, p.aggr.Numbers, now, false)
+					if spilled || err != nil {
+						break
+					}
+				}
+
 				last, loaded := p.last.nums.LoadOrStore(id, zero.nums)
 				if maps.Exceeded(last, loaded) {
-					// state is full, reject stream
-					attrs.Set(telemetry.Error("limit"))
-					return drop
+					if p.spill == nil {
+						// state is full, reject stream
+						attrs.Set(telemetry.Error("limit"))
+						return drop
+					}
+
+					// state is full, aggregate in storage instead
+					_, err = aggregate(ctx, p.spill, numbers, id, dp, p.aggr.Numbers, now, true)
+					break
 				}
 
 				// stream is ok and active, update stale tracker
@@ -122,11 +150,27 @@ func (p *deltaToCumulativeProcessor) ConsumeMetrics(ctx context.Context, md pmet
 					last.CopyTo(dp)
 				})
 			case pmetric.HistogramDataPoint:
+				if _, ok := p.last.hist.Load(id); !ok && p.spill != nil {
+					// a spilled stream stays in storage until it goes stale,
+					// even once there is room in memory again
+					var spilled bool
+					spilled, err = aggregate(ctx, p.spill, histograms, id, dp, p.aggr.Histograms, now, false)
+					if spilled || err != nil {
+						break
+					}
+				}
+
 				last, loaded := p.last.hist.LoadOrStore(id, zero.hist)
 				if maps.Exceeded(last, loaded) {
-					// state is full, reject stream
-					attrs.Set(telemetry.Error("limit"))
-					return drop
+					if p.spill == nil {
+						// state is full, reject stream
+						attrs.Set(telemetry.Error("limit"))
+						return drop
+					}
+
+					// state is full, aggregate in storage instead
+					_, err = aggregate(ctx, p.spill, histograms, id, dp, p.aggr.Histograms, now, true)
+					break
 				}
 
 				// stream is ok and active, update stale tracker
@@ -142,11 +186,27 @@ func (p *deltaToCumulativeProcessor) ConsumeMetrics(ctx context.Context, md pmet
 					last.CopyTo(dp)
 				})
 			case pmetric.ExponentialHistogramDataPoint:
+				if _, ok := p.last.expo.Load(id); !ok && p.spill != nil {
+					// a spilled stream stays in storage until it goes stale,
+					// even once there is room in memory again
+					var spilled bool
+					spilled, err = aggregate(ctx, p.spill, exponentials, id, dp, p.aggr.Exponential, now, false)
+					if spilled || err != nil {
+						break
+					}
+				}
+
 				last, loaded := p.last.expo.LoadOrStore(id, zero.expo)
 				if maps.Exceeded(last, loaded) {
-					// state is full, reject stream
-					attrs.Set(telemetry.Error("limit"))
-					return drop
+					if p.spill == nil {
+						// state is full, reject stream
+						attrs.Set(telemetry.Error("limit"))
+						return drop
+					}
+
+					// state is full, aggregate in storage instead
+					_, err = aggregate(ctx, p.spill, exponentials, id, dp, p.aggr.Exponential, now, true)
+					break
 				}
 
 				// stream is ok and active, update stale tracker
@@ -184,7 +244,15 @@ func (p *deltaToCumulativeProcessor) ConsumeMetrics(ctx context.Context, md pmet
 	return p.next.ConsumeMetrics(ctx, md)
 }
 
-func (p *deltaToCumulativeProcessor) Start(_ context.Context, _ component.Host) error {
+func (p *deltaToCumulativeProcessor) Start(ctx context.Context, host component.Host) error {
+	if p.cfg.Storage != nil {
+		spill, err := newSpill(ctx, host, *p.cfg.Storage, p.id)
+		if err != nil {
+			return err
+		}
+		p.spill = spill
+	}
+
 	if p.cfg.MaxStale != 0 {
 		// delete stale streams once per minute
 		go func() {
@@ -195,16 +263,7 @@ func (p *deltaToCumulativeProcessor) Start(_ context.Context, _ component.Host)
 				case <-p.ctx.Done():
 					return
 				case <-tick.C:
-					now := time.Now()
-					p.stale.Range(func(id identity.Stream, last time.Time) bool {
-						if now.Sub(last) > p.cfg.MaxStale {
-							p.last.nums.LoadAndDelete(id)
-							p.last.hist.LoadAndDelete(id)
-							p.last.expo.LoadAndDelete(id)
-							p.stale.Delete(id)
-						}
-						return true
-					})
+					p.sweep(time.Now())
 				}
 			}
 		}()
@@ -213,8 +272,27 @@ func (p *deltaToCumulativeProcessor) Start(_ context.Context, _ component.Host)
 	return nil
 }
 
-func (p *deltaToCumulativeProcessor) Shutdown(_ context.Context) error {
+// sweep deletes the streams not seen for longer than max_stale.
+func (p *deltaToCumulativeProcessor) sweep(now time.Time) {
+	p.stale.Range(func(id identity.Stream, last time.Time) bool {
+		if now.Sub(last) > p.cfg.MaxStale {
+			p.last.nums.LoadAndDelete(id)
+			p.last.hist.LoadAndDelete(id)
+			p.last.expo.LoadAndDelete(id)
+			p.stale.Delete(id)
+		}
+		return true
+	})
+	if p.spill != nil {
+		_ = p.spill.sweep(p.ctx, now, p.cfg.MaxStale)
+	}
+}
+
+func (p *deltaToCumulativeProcessor) Shutdown(ctx context.Context) error {
 	p.cancel()
+	if p.spill != nil {
+		return p.spill.close(ctx)
+	}
 	return nil
 }
 
@@ -0,0 +1,257 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package deltatocumulativeprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/deltatocumulativeprocessor"
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"strconv"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/extension/xextension/storage"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/storage/storageclient"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/exp/metrics/identity"
+)
+
+// spill keeps the state of the streams exceeding max_streams in a storage
+// extension, instead of rejecting them.
+//
+// Spilled streams are read from and written back to the storage for every
+// sample, one at a time, and stay spilled until they go stale. Nothing is kept
+// in memory per spilled stream: the storage also holds the time each stream was
+// last seen, and an index of the spilled streams under indexKey, so that they can
+// be swept once stale. The storage only extends the in-memory state: it is
+// cleared on start and shutdown, as the in-memory state is lost.
+type spill struct {
+	client storage.Client
+
+	mtx   sync.Mutex
+	count int // number of spilled streams, each having a slot in the index
+}
+
+const indexKey = "index"
+
+func slotKey(slot int) string {
+	return indexKey + "/" + strconv.Itoa(slot)
+}
+
+func newSpill(ctx context.Context, host component.Host, storageID, id component.ID) (*spill, error) {
+	client, err := storageclient.Get(ctx, host, storageID, component.KindProcessor, id, "")
+	if err != nil {
+		return nil, err
+	}
+	s := &spill{client: client}
+
+	// the state left by a previous run that didn't shut down is stale.
+	buf, err := client.Get(ctx, indexKey)
+	if err == nil && buf != nil {
+		s.count, err = strconv.Atoi(string(buf))
+	}
+	if err == nil {
+		err = s.clear(ctx)
+	}
+	if err != nil {
+		return nil, errors.Join(err, client.Close(ctx))
+	}
+	return s, nil
+}
+
+// Size returns the number of spilled streams.
+func (s *spill) Size() int {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	return s.count
+}
+
+// sweep removes the state of the spilled streams not seen for longer than
+// maxStale, and compacts the index.
+func (s *spill) sweep(ctx context.Context, now time.Time, maxStale time.Duration) error {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	kept := 0
+	for slot := range s.count {
+		id, err := s.client.Get(ctx, slotKey(slot))
+		if err != nil {
+			return err
+		}
+		if id == nil {
+			continue
+		}
+		buf, err := s.client.Get(ctx, string(id))
+		if err != nil {
+			return err
+		}
+		if seen, _, ok := decodeState(buf); ok && now.Sub(seen) <= maxStale {
+			if kept != slot {
+				if err := s.client.Set(ctx, slotKey(kept), id); err != nil {
+					return err
+				}
+			}
+			kept++
+			continue
+		}
+		if err := s.client.Delete(ctx, string(id)); err != nil {
+			return err
+		}
+	}
+
+	ops := make([]*storage.Operation, 0, s.count-kept+1)
+	for slot := kept; slot < s.count; slot++ {
+		ops = append(ops, storage.DeleteOperation(slotKey(slot)))
+	}
+	ops = append(ops, storage.SetOperation(indexKey, []byte(strconv.Itoa(kept))))
+	if err := s.client.Batch(ctx, ops...); err != nil {
+		return err
+	}
+	s.count = kept
+	return nil
+}
+
+// clear removes the state of all spilled streams and the index.
+func (s *spill) clear(ctx context.Context) error {
+	for slot := range s.count {
+		id, err := s.client.Get(ctx, slotKey(slot))
+		if err != nil {
+			return err
+		}
+		ops := []*storage.Operation{storage.DeleteOperation(slotKey(slot))}
+		if id != nil {
+			ops = append(ops, storage.DeleteOperation(string(id)))
+		}
+		if err := s.client.Batch(ctx, ops...); err != nil {
+			return err
+		}
+	}
+	if err := s.client.Delete(ctx, indexKey); err != nil {
+		return err
+	}
+	s.count = 0
+	return nil
+}
+
+// close removes the state of all spilled streams and closes the storage client.
+func (s *spill) close(ctx context.Context) error {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	return errors.Join(s.clear(ctx), s.client.Close(ctx))
+}
+
+// encodeState prefixes the serialized state of a stream with the time it was
+// last seen.
+func encodeState(seen time.Time, md pmetric.Metrics) ([]byte, error) {
+	buf, err := (&pmetric.ProtoMarshaler{}).MarshalMetrics(md)
+	if err != nil {
+		return nil, err
+	}
+	return append(binary.BigEndian.AppendUint64(nil, uint64(seen.UnixNano())), buf...), nil
+}
+
+// decodeState returns the time a stream was last seen and its serialized state.
+func decodeState(buf []byte) (seen time.Time, state []byte, ok bool) {
+	if len(buf) < 8 {
+		return time.Time{}, nil, false
+	}
+	return time.Unix(0, int64(binary.BigEndian.Uint64(buf))), buf[8:], true
+}
+
+// codec stores a datapoint of type T as the single datapoint of a metric.
+type codec[T any] struct {
+	put func(pmetric.Metric) T
+	get func(pmetric.Metric) T
+}
+
+var (
+	numbers = codec[pmetric.NumberDataPoint]{
+		put: func(m pmetric.Metric) pmetric.NumberDataPoint { return m.SetEmptySum().DataPoints().AppendEmpty() },
+		get: func(m pmetric.Metric) pmetric.NumberDataPoint { return m.Sum().DataPoints().At(0) },
+	}
+	histograms = codec[pmetric.HistogramDataPoint]{
+		put: func(m pmetric.Metric) pmetric.HistogramDataPoint {
+			return m.SetEmptyHistogram().DataPoints().AppendEmpty()
+		},
+		get: func(m pmetric.Metric) pmetric.HistogramDataPoint { return m.Histogram().DataPoints().At(0) },
+	}
+	exponentials = codec[pmetric.ExponentialHistogramDataPoint]{
+		put: func(m pmetric.Metric) pmetric.ExponentialHistogramDataPoint {
+			return m.SetEmptyExponentialHistogram().DataPoints().AppendEmpty()
+		},
+		get: func(m pmetric.Metric) pmetric.ExponentialHistogramDataPoint {
+			return m.ExponentialHistogram().DataPoints().At(0)
+		},
+	}
+)
+
+var errSpillCorrupted = errors.New("spilled state is corrupted")
+
+// aggregate loads the spilled state of a stream, aggregates dp into it using
+// aggr and stores it back. As with the in-memory state, dp is set to the
+// aggregated value.
+//
+// If the stream isn't spilled yet, it is only spilled when create is set: the
+// returned bool reports whether dp was aggregated in the storage.
+func aggregate[T interface{ CopyTo(T) }](ctx context.Context, s *spill, c codec[T], id identity.Stream, dp T, aggr func(state, dp T) error, now time.Time, create bool) (bool, error) {
+	key := id.String()
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	buf, err := s.client.Get(ctx, key)
+	if err != nil {
+		return false, err
+	}
+	if buf == nil && !create {
+		return false, nil
+	}
+
+	var (
+		md    pmetric.Metrics
+		state T
+		ops   []*storage.Operation
+	)
+	if buf == nil {
+		md = pmetric.NewMetrics()
+		state = c.put(md.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics().AppendEmpty())
+		ops = append(ops,
+			storage.SetOperation(slotKey(s.count), []byte(key)),
+			storage.SetOperation(indexKey, []byte(strconv.Itoa(s.count+1))),
+		)
+	} else {
+		_, raw, ok := decodeState(buf)
+		if !ok {
+			return true, errSpillCorrupted
+		}
+		md, err = (&pmetric.ProtoUnmarshaler{}).UnmarshalMetrics(raw)
+		if err != nil {
+			return true, err
+		}
+		if md.DataPointCount() != 1 {
+			return true, errSpillCorrupted
+		}
+		state = c.get(md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0))
+	}
+
+	if err := aggr(state, dp); err != nil {
+		return true, err
+	}
+	state.CopyTo(dp)
+
+	buf, err = encodeState(now, md)
+	if err != nil {
+		return true, err
+	}
+	ops = append(ops, storage.SetOperation(key, buf))
+	if err := s.client.Batch(ctx, ops...); err != nil {
+		return true, err
+	}
+	if len(ops) > 1 {
+		s.count++
+	}
+	return true, nil
+}
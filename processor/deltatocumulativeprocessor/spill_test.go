@@ -0,0 +1,261 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package deltatocumulativeprocessor
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/extension/xextension/storage"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/storage/storagetest"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/exp/metrics/identity"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/deltatocumulativeprocessor/internal/metadata"
+)
+
+func newTestClient(dir string) *storagetest.TestClient {
+	return storagetest.NewFileBackedClient(component.KindProcessor, component.NewID(metadata.Type), "", dir)
+}
+
+// spilledKeys returns the storage keys of the state of the spilled streams.
+func spilledKeys(t *testing.T, s *spill) []string {
+	t.Helper()
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	keys := make([]string, 0, s.count)
+	for slot := range s.count {
+		id, err := s.client.Get(context.Background(), slotKey(slot))
+		require.NoError(t, err)
+		keys = append(keys, string(id))
+	}
+	return keys
+}
+
+// assertStored asserts whether each of the keys holds a value in the storage.
+func assertStored(t *testing.T, client storage.Client, keys []string, stored bool) {
+	t.Helper()
+	for _, key := range keys {
+		value, err := client.Get(context.Background(), key)
+		require.NoError(t, err)
+		assert.Equal(t, stored, value != nil, key)
+	}
+}
+
+func spilled(t *testing.T, client storage.Client) *deltaToCumulativeProcessor {
+	iface, _ := setup(t, &Config{MaxStale: 5 * time.Minute, MaxStreams: 1}, new(consumertest.MetricsSink))
+	proc := iface.(*deltaToCumulativeProcessor)
+	proc.spill = &spill{client: client}
+	return proc
+}
+
+func TestSpillNumbers(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	client := newTestClient(dir)
+	proc := spilled(t, client)
+	sink := proc.next.(*consumertest.MetricsSink)
+
+	start := time.Now()
+	for i := range 3 {
+		md := pmetric.NewMetrics()
+		ms := md.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics()
+		for _, name := range []string{"a", "b"} {
+			m := ms.AppendEmpty()
+			m.SetName(name)
+			sum := m.SetEmptySum()
+			sum.SetAggregationTemporality(pmetric.AggregationTemporalityDelta)
+			dp := sum.DataPoints().AppendEmpty()
+			dp.SetStartTimestamp(pcommon.NewTimestampFromTime(start))
+			dp.SetTimestamp(pcommon.NewTimestampFromTime(start.Add(time.Duration(i+1) * time.Second)))
+			dp.SetIntValue(1)
+		}
+		require.NoError(t, proc.ConsumeMetrics(ctx, md))
+	}
+
+	// one stream is kept in memory, the other one is spilled to the storage.
+	assert.Equal(t, 1, proc.last.Size())
+	assert.Equal(t, 1, proc.spill.Size())
+	assert.Equal(t, 2, proc.size())
+	keys := spilledKeys(t, proc.spill)
+	require.Len(t, keys, 1)
+	assertStored(t, client, keys, true)
+
+	all := sink.AllMetrics()
+	require.Len(t, all, 3)
+	for i, md := range all {
+		ms := md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+		require.Equal(t, 2, ms.Len())
+		for j := range ms.Len() {
+			sum := ms.At(j).Sum()
+			assert.Equal(t, pmetric.AggregationTemporalityCumulative, sum.AggregationTemporality())
+			assert.Equal(t, int64(i+1), sum.DataPoints().At(0).IntValue(), ms.At(j).Name())
+		}
+	}
+
+	// the state of the spilled streams is removed from the storage on shutdown.
+	require.NoError(t, proc.Shutdown(ctx))
+	assertStored(t, newTestClient(dir), keys, false)
+}
+
+func TestSpillHistograms(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	client := newTestClient(dir)
+	proc := spilled(t, client)
+	sink := proc.next.(*consumertest.MetricsSink)
+
+	start := time.Now()
+	for i := range 2 {
+		md := pmetric.NewMetrics()
+		ms := md.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics()
+		for _, name := range []string{"a", "b"} {
+			m := ms.AppendEmpty()
+			m.SetName(name)
+			hist := m.SetEmptyHistogram()
+			hist.SetAggregationTemporality(pmetric.AggregationTemporalityDelta)
+			dp := hist.DataPoints().AppendEmpty()
+			dp.SetStartTimestamp(pcommon.NewTimestampFromTime(start))
+			dp.SetTimestamp(pcommon.NewTimestampFromTime(start.Add(time.Duration(i+1) * time.Second)))
+			dp.ExplicitBounds().FromRaw([]float64{1, 10})
+			dp.BucketCounts().FromRaw([]uint64{1, 2, 3})
+			dp.SetCount(6)
+			dp.SetSum(42)
+		}
+		require.NoError(t, proc.ConsumeMetrics(ctx, md))
+	}
+
+	assert.Equal(t, 1, proc.spill.Size())
+	keys := spilledKeys(t, proc.spill)
+
+	all := sink.AllMetrics()
+	require.Len(t, all, 2)
+	ms := all[1].ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	require.Equal(t, 2, ms.Len())
+	for j := range ms.Len() {
+		dp := ms.At(j).Histogram().DataPoints().At(0)
+		assert.Equal(t, uint64(12), dp.Count(), ms.At(j).Name())
+		assert.Equal(t, []uint64{2, 4, 6}, dp.BucketCounts().AsRaw(), ms.At(j).Name())
+		assert.Equal(t, 84.0, dp.Sum(), ms.At(j).Name())
+	}
+
+	// the state of the spilled streams is removed from the storage on shutdown.
+	require.NoError(t, proc.Shutdown(ctx))
+	assertStored(t, newTestClient(dir), keys, false)
+}
+
+func TestSpillRoutesSpilledStreams(t *testing.T) {
+	ctx := context.Background()
+	proc := spilled(t, storagetest.NewInMemoryClient(component.KindProcessor, component.NewID(metadata.Type), ""))
+	sink := proc.next.(*consumertest.MetricsSink)
+
+	start := time.Now()
+	consume := func(i int, names ...string) {
+		md := pmetric.NewMetrics()
+		ms := md.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics()
+		for _, name := range names {
+			m := ms.AppendEmpty()
+			m.SetName(name)
+			sum := m.SetEmptySum()
+			sum.SetAggregationTemporality(pmetric.AggregationTemporalityDelta)
+			dp := sum.DataPoints().AppendEmpty()
+			dp.SetStartTimestamp(pcommon.NewTimestampFromTime(start))
+			dp.SetTimestamp(pcommon.NewTimestampFromTime(start.Add(time.Duration(i+1) * time.Second)))
+			dp.SetIntValue(1)
+		}
+		require.NoError(t, proc.ConsumeMetrics(ctx, md))
+	}
+
+	// "a" is kept in memory, "b" is spilled.
+	consume(0, "a", "b")
+	require.Equal(t, 1, proc.last.Size())
+	require.Equal(t, 1, proc.spill.Size())
+
+	// "a" goes stale, freeing up the memory.
+	proc.stale.Range(func(id identity.Stream, _ time.Time) bool {
+		proc.last.nums.LoadAndDelete(id)
+		proc.stale.Delete(id)
+		return true
+	})
+	require.Equal(t, 0, proc.last.Size())
+
+	// "b" keeps being aggregated in the storage, rather than restarting from zero
+	// in memory.
+	consume(1, "b")
+	assert.Equal(t, 0, proc.last.Size())
+	assert.Equal(t, 1, proc.spill.Size())
+	all := sink.AllMetrics()
+	require.Len(t, all, 2)
+	assert.Equal(t, int64(2), all[1].ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0).Sum().DataPoints().At(0).IntValue())
+
+	// new streams still use the memory freed up.
+	consume(2, "c")
+	assert.Equal(t, 1, proc.last.Size())
+	assert.Equal(t, 1, proc.spill.Size())
+}
+
+func TestSpillSweep(t *testing.T) {
+	ctx := context.Background()
+	client := storagetest.NewInMemoryClient(component.KindProcessor, component.NewID(metadata.Type), "")
+	s := &spill{client: client}
+
+	now := time.Now()
+	ids := make([]identity.Stream, 3)
+	for i := range ids {
+		m := pmetric.NewMetric()
+		m.SetName(fmt.Sprintf("m%d", i))
+		ids[i] = identity.OfStream(identity.OfMetric(identity.Scope{}, m), pmetric.NewNumberDataPoint())
+
+		dp := pmetric.NewNumberDataPoint()
+		dp.SetIntValue(1)
+		spilled, err := aggregate(ctx, s, numbers, ids[i], dp, func(state, dp pmetric.NumberDataPoint) error {
+			dp.CopyTo(state)
+			return nil
+		}, now.Add(time.Duration(i)*time.Minute), true)
+		require.NoError(t, err)
+		require.True(t, spilled)
+	}
+	assert.Equal(t, 3, s.Size())
+
+	// streams which aren't spilled are left alone.
+	m := pmetric.NewMetric()
+	m.SetName("memory")
+	spilled, err := aggregate(ctx, s, numbers, identity.OfStream(identity.OfMetric(identity.Scope{}, m), pmetric.NewNumberDataPoint()), pmetric.NewNumberDataPoint(), nil, now, false)
+	require.NoError(t, err)
+	assert.False(t, spilled)
+
+	// only the last stream was seen in the last minute.
+	require.NoError(t, s.sweep(ctx, now.Add(2*time.Minute), time.Minute))
+	assert.Equal(t, 1, s.Size())
+	assert.Equal(t, []string{ids[2].String()}, spilledKeys(t, s))
+	assertStored(t, client, []string{ids[0].String(), ids[1].String(), slotKey(1), slotKey(2)}, false)
+
+	require.NoError(t, s.close(ctx))
+}
+
+func TestStartWithMissingStorageExtension(t *testing.T) {
+	storageID := component.MustNewID("file_storage")
+	iface, _ := setup(t, &Config{MaxStale: 5 * time.Minute, MaxStreams: 1, Storage: &storageID}, new(consumertest.MetricsSink))
+
+	err := iface.Start(context.Background(), componenttest.NewNopHost())
+	assert.EqualError(t, err, "storage extension 'file_storage' not found")
+}
+
+func TestStartWithStorageExtension(t *testing.T) {
+	ctx := context.Background()
+	storageID := storagetest.NewStorageID("spill")
+	iface, _ := setup(t, &Config{MaxStale: 5 * time.Minute, MaxStreams: 1, Storage: &storageID}, new(consumertest.MetricsSink))
+
+	require.NoError(t, iface.Start(ctx, storagetest.NewStorageHost().WithInMemoryStorageExtension("spill")))
+	assert.NotNil(t, iface.(*deltaToCumulativeProcessor).spill)
+	require.NoError(t, iface.Shutdown(ctx))
+}
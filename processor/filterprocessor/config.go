@@ -9,6 +9,7 @@ import (
 	"strings"
 
 	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/confmap"
 	"go.opentelemetry.io/collector/pdata/plog"
 	"go.uber.org/multierr"
 	"go.uber.org/zap"
@@ -29,6 +30,11 @@ type Config struct {
 	// The default value is `propagate`.
 	ErrorMode ottl.ErrorMode `mapstructure:"error_mode"`
 
+	// DryRun evaluates the OTTL conditions and counts the telemetry matched by each of them
+	// in the `otelcol_processor_filter_conditions.matched` metric, without dropping anything.
+	// This allows validating new conditions against production traffic before enforcing them.
+	DryRun bool `mapstructure:"dry_run"`
+
 	Metrics MetricFilters `mapstructure:"metrics"`
 
 	Logs LogFilters `mapstructure:"logs"`
@@ -36,6 +42,103 @@ type Config struct {
 	Spans filterconfig.MatchConfig `mapstructure:"spans"`
 
 	Traces TraceFilters `mapstructure:"traces"`
+
+	// conditionNames are the names given to the OTTL conditions, by condition list.
+	// Unnamed conditions have an empty name.
+	conditionNames map[string][]string
+}
+
+// The paths of the OTTL condition lists, whose conditions can be named.
+const (
+	spanConditionsKey      = "traces::span"
+	spanEventConditionsKey = "traces::spanevent"
+	metricConditionsKey    = "metrics::metric"
+	dataPointConditionsKey = "metrics::datapoint"
+	logConditionsKey       = "logs::log_record"
+)
+
+// Unmarshal is used internally by mapstructure to parse the filterprocessor configuration (Config),
+// adding support for named conditions. Each condition of a list is either an OTTL condition or
+// a map with its `name` and `condition`. The names identify the conditions in dry run mode.
+//
+// Example of named conditions:
+//
+//	logs:
+//	  log_record:
+//	    - name: debug
+//	      condition: 'severity_number < SEVERITY_NUMBER_INFO'
+//	    - 'IsMatch(body, ".*healthcheck.*")'
+func (cfg *Config) Unmarshal(conf *confmap.Conf) error {
+	if conf == nil {
+		return nil
+	}
+
+	patch := map[string]map[string]any{}
+	for _, key := range []string{spanConditionsKey, spanEventConditionsKey, metricConditionsKey, dataPointConditionsKey, logConditionsKey} {
+		values, ok := conf.Get(key).([]any)
+		if !ok {
+			continue
+		}
+
+		conditions := make([]any, len(values))
+		names := make([]string, len(values))
+		seen := map[string]bool{}
+		named := false
+		for i, value := range values {
+			m, ok := value.(map[string]any)
+			if !ok {
+				conditions[i] = value
+				continue
+			}
+			for field := range m {
+				if field != "name" && field != "condition" {
+					return fmt.Errorf("%s[%d]: unknown field %q", key, i, field)
+				}
+			}
+			condition, ok := m["condition"].(string)
+			if !ok || condition == "" {
+				return fmt.Errorf("%s[%d]: condition is required", key, i)
+			}
+			name, ok := m["name"].(string)
+			if !ok && m["name"] != nil {
+				return fmt.Errorf("%s[%d]: name must be a string", key, i)
+			}
+			if name != "" && seen[name] {
+				return fmt.Errorf("%s[%d]: duplicate condition name %q", key, i, name)
+			}
+			seen[name] = true
+			conditions[i] = condition
+			names[i] = name
+			named = true
+		}
+		if !named {
+			continue
+		}
+
+		if cfg.conditionNames == nil {
+			cfg.conditionNames = map[string][]string{}
+		}
+		cfg.conditionNames[key] = names
+		conf.Delete(key)
+		filters, list, _ := strings.Cut(key, confmap.KeyDelimiter)
+		lists, ok := patch[filters]
+		if !ok {
+			lists = map[string]any{}
+			patch[filters] = lists
+		}
+		lists[list] = conditions
+	}
+
+	if len(patch) > 0 {
+		conditions := make(map[string]any, len(patch))
+		for filters, lists := range patch {
+			conditions[filters] = lists
+		}
+		if err := conf.Merge(confmap.NewFromStringMap(conditions)); err != nil {
+			return err
+		}
+	}
+	return conf.Unmarshal(cfg)
 }
 
 // MetricFilters filters by Metric properties.
@@ -275,6 +378,9 @@ func (cfg *Config) Validate() error {
 	if cfg.Logs.LogConditions != nil && (cfg.Logs.Include != nil || cfg.Logs.Exclude != nil) {
 		return errors.New("cannot use ottl conditions and include/exclude for logs at the same time")
 	}
+	if cfg.DryRun && (cfg.Spans.Include != nil || cfg.Spans.Exclude != nil || cfg.Metrics.Include != nil || cfg.Metrics.Exclude != nil || cfg.Logs.Include != nil || cfg.Logs.Exclude != nil) {
+		return errors.New("dry_run is only supported with ottl conditions")
+	}
 
 	var errors error
 
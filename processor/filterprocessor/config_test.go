@@ -10,6 +10,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/confmap"
 	"go.opentelemetry.io/collector/confmap/confmaptest"
 	"go.opentelemetry.io/collector/confmap/xconfmap"
 	"go.opentelemetry.io/collector/pdata/plog"
@@ -876,6 +877,24 @@ func TestLoadingConfigOTTL(t *testing.T) {
 				},
 			},
 		},
+		{
+			id: component.MustNewIDWithName("filter", "dry_run"),
+			expected: &Config{
+				ErrorMode: ottl.PropagateError,
+				DryRun:    true,
+				Logs: LogFilters{
+					LogConditions: []string{
+						`attributes["test"] == "pass"`,
+						`attributes["test"] == "fail"`,
+					},
+				},
+				conditionNames: map[string][]string{logConditionsKey: {"test_pass", ""}},
+			},
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "dry_run_include_exclude"),
+			errorMessage: "dry_run is only supported with ottl conditions",
+		},
 		{
 			id:           component.NewIDWithName(metadata.Type, "spans_mix_config"),
 			errorMessage: "cannot use ottl conditions and include/exclude for spans at the same time",
@@ -927,3 +946,55 @@ func TestLoadingConfigOTTL(t *testing.T) {
 		})
 	}
 }
+
+func TestUnmarshalNamedConditions(t *testing.T) {
+	tests := []struct {
+		name          string
+		conditions    []any
+		expectedNames []string
+		errorMessage  string
+	}{
+		{
+			name:       "unnamed",
+			conditions: []any{`name == "a"`},
+		},
+		{
+			name:          "named",
+			conditions:    []any{map[string]any{"name": "a", "condition": `name == "a"`}, `name == "b"`},
+			expectedNames: []string{"a", ""},
+		},
+		{
+			name:         "missing condition",
+			conditions:   []any{map[string]any{"name": "a"}},
+			errorMessage: "traces::span[0]: condition is required",
+		},
+		{
+			name:         "unknown field",
+			conditions:   []any{map[string]any{"name": "a", "condition": `name == "a"`, "context": "span"}},
+			errorMessage: `traces::span[0]: unknown field "context"`,
+		},
+		{
+			name: "duplicate name",
+			conditions: []any{
+				map[string]any{"name": "a", "condition": `name == "a"`},
+				map[string]any{"name": "a", "condition": `name == "b"`},
+			},
+			errorMessage: `traces::span[1]: duplicate condition name "a"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &Config{}
+			err := confmap.NewFromStringMap(map[string]any{
+				"traces": map[string]any{"span": tt.conditions},
+			}).Unmarshal(cfg)
+			if tt.errorMessage != "" {
+				assert.ErrorContains(t, err, tt.errorMessage)
+				return
+			}
+			require.NoError(t, err)
+			assert.Len(t, cfg.Traces.SpanConditions, len(tt.conditions))
+			assert.Equal(t, tt.expectedNames, cfg.conditionNames[spanConditionsKey])
+		})
+	}
+}
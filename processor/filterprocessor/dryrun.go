@@ -0,0 +1,67 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package filterprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/filterprocessor"
+
+import (
+	"context"
+	"strconv"
+
+	"go.opentelemetry.io/otel/metric"
+	"go.uber.org/multierr"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/filter/expr"
+)
+
+// conditionCounter is a single condition evaluated in dry run mode.
+type conditionCounter[K any] struct {
+	expr expr.BoolExpr[K]
+	attr metric.MeasurementOption
+}
+
+// newConditionCounters creates an expression for each condition so the telemetry
+// matched by each of them can be counted separately. The conditions are identified
+// by their name, or by their index in the list when they aren't named.
+func newConditionCounters[K any](conditions, names []string, ottlContext string, fpt *filterTelemetry, newExpr func([]string) (expr.BoolExpr[K], error)) ([]conditionCounter[K], error) {
+	counters := make([]conditionCounter[K], 0, len(conditions))
+	for i, condition := range conditions {
+		boolExpr, err := newExpr([]string{condition})
+		if err != nil {
+			return nil, err
+		}
+		name := strconv.Itoa(i)
+		if i < len(names) && names[i] != "" {
+			name = names[i]
+		}
+		counters = append(counters, conditionCounter[K]{
+			expr: boolExpr,
+			attr: fpt.conditionAttr(ottlContext, name),
+		})
+	}
+	return counters, nil
+}
+
+// evalConditions evaluates every condition against the context and increments
+// the matched count of the conditions that match.
+func evalConditions[K any](ctx context.Context, counters []conditionCounter[K], tCtx K, matched []int64) error {
+	var errors error
+	for i, counter := range counters {
+		match, err := counter.expr.Eval(ctx, tCtx)
+		if err != nil {
+			errors = multierr.Append(errors, err)
+			continue
+		}
+		if match {
+			matched[i]++
+		}
+	}
+	return errors
+}
+
+// recordConditions records the matched counts, including the conditions that did not
+// match anything so that they are visible as well.
+func recordConditions[K any](ctx context.Context, fpt *filterTelemetry, counters []conditionCounter[K], matched []int64) {
+	for i, counter := range counters {
+		fpt.recordMatched(ctx, matched[i], counter.attr)
+	}
+}
@@ -26,6 +26,7 @@ type TelemetryBuilder struct {
 	meter                             metric.Meter
 	mu                                sync.Mutex
 	registrations                     []metric.Registration
+	ProcessorFilterConditionsMatched  metric.Int64Counter
 	ProcessorFilterDatapointsFiltered metric.Int64Counter
 	ProcessorFilterLogsFiltered       metric.Int64Counter
 	ProcessorFilterSpansFiltered      metric.Int64Counter
@@ -60,6 +61,12 @@ func NewTelemetryBuilder(settings component.TelemetrySettings, options ...Teleme
 	}
 	builder.meter = Meter(settings)
 	var err, errs error
+	builder.ProcessorFilterConditionsMatched, err = builder.meter.Int64Counter(
+		"otelcol_processor_filter_conditions.matched",
+		metric.WithDescription("Number of telemetry items matched by each filter condition in dry run mode"),
+		metric.WithUnit("1"),
+	)
+	errs = errors.Join(errs, err)
 	builder.ProcessorFilterDatapointsFiltered, err = builder.meter.Int64Counter(
 		"otelcol_processor_filter_datapoints.filtered",
 		metric.WithDescription("Number of metric data points dropped by the filter processor"),
@@ -20,9 +20,13 @@ func TestSetupTelemetry(t *testing.T) {
 	tb, err := metadata.NewTelemetryBuilder(testTel.NewTelemetrySettings())
 	require.NoError(t, err)
 	defer tb.Shutdown()
+	tb.ProcessorFilterConditionsMatched.Add(context.Background(), 1)
 	tb.ProcessorFilterDatapointsFiltered.Add(context.Background(), 1)
 	tb.ProcessorFilterLogsFiltered.Add(context.Background(), 1)
 	tb.ProcessorFilterSpansFiltered.Add(context.Background(), 1)
+	AssertEqualProcessorFilterConditionsMatched(t, testTel,
+		[]metricdata.DataPoint[int64]{{Value: 1}},
+		metricdatatest.IgnoreTimestamp())
 	AssertEqualProcessorFilterDatapointsFiltered(t, testTel,
 		[]metricdata.DataPoint[int64]{{Value: 1}},
 		metricdatatest.IgnoreTimestamp())
@@ -22,9 +22,10 @@ import (
 )
 
 type filterLogProcessor struct {
-	skipExpr  expr.BoolExpr[ottllog.TransformContext]
-	telemetry *filterTelemetry
-	logger    *zap.Logger
+	skipExpr      expr.BoolExpr[ottllog.TransformContext]
+	logConditions []conditionCounter[ottllog.TransformContext]
+	telemetry     *filterTelemetry
+	logger        *zap.Logger
 }
 
 func newFilterLogsProcessor(set processor.Settings, cfg *Config) (*filterLogProcessor, error) {
@@ -38,6 +39,18 @@ func newFilterLogsProcessor(set processor.Settings, cfg *Config) (*filterLogProc
 	}
 	flp.telemetry = fpt
 
+	if cfg.DryRun {
+		if cfg.Logs.LogConditions != nil {
+			flp.logConditions, err = newConditionCounters(cfg.Logs.LogConditions, cfg.conditionNames[logConditionsKey], "log", fpt, func(conditions []string) (expr.BoolExpr[ottllog.TransformContext], error) {
+				return filterottl.NewBoolExprForLog(conditions, filterottl.StandardLogFuncs(), cfg.ErrorMode, set.TelemetrySettings)
+			})
+			if err != nil {
+				return nil, err
+			}
+		}
+		return flp, nil
+	}
+
 	if cfg.Logs.LogConditions != nil {
 		skipExpr, errBoolExpr := filterottl.NewBoolExprForLog(cfg.Logs.LogConditions, filterottl.StandardLogFuncs(), cfg.ErrorMode, set.TelemetrySettings)
 		if errBoolExpr != nil {
@@ -66,6 +79,10 @@ func newFilterLogsProcessor(set processor.Settings, cfg *Config) (*filterLogProc
 }
 
 func (flp *filterLogProcessor) processLogs(ctx context.Context, ld plog.Logs) (plog.Logs, error) {
+	if flp.logConditions != nil {
+		flp.countLogs(ctx, ld)
+		return ld, nil
+	}
 	if flp.skipExpr == nil {
 		return ld, nil
 	}
@@ -104,3 +121,26 @@ func (flp *filterLogProcessor) processLogs(ctx context.Context, ld plog.Logs) (p
 	}
 	return ld, nil
 }
+
+// countLogs counts the log records matched by each condition without dropping them.
+func (flp *filterLogProcessor) countLogs(ctx context.Context, ld plog.Logs) {
+	matched := make([]int64, len(flp.logConditions))
+
+	var errors error
+	for i := range ld.ResourceLogs().Len() {
+		rl := ld.ResourceLogs().At(i)
+		for j := range rl.ScopeLogs().Len() {
+			sl := rl.ScopeLogs().At(j)
+			for k := range sl.LogRecords().Len() {
+				tCtx := ottllog.NewTransformContext(sl.LogRecords().At(k), sl.Scope(), rl.Resource(), sl, rl)
+				errors = multierr.Append(errors, evalConditions(ctx, flp.logConditions, tCtx, matched))
+			}
+		}
+	}
+
+	recordConditions(ctx, flp.telemetry, flp.logConditions, matched)
+
+	if errors != nil {
+		flp.logger.Error("failed evaluating log conditions in dry run mode", zap.Error(errors))
+	}
+}
@@ -788,6 +788,43 @@ func TestFilterLogProcessorTelemetry(t *testing.T) {
 	}, metricdatatest.IgnoreTimestamp())
 }
 
+func TestFilterLogProcessorDryRun(t *testing.T) {
+	tel := componenttest.NewTelemetry()
+	t.Cleanup(func() { require.NoError(t, tel.Shutdown(context.Background())) })
+	processor, err := newFilterLogsProcessor(metadatatest.NewSettings(tel), &Config{
+		DryRun: true,
+		Logs: LogFilters{LogConditions: []string{
+			`IsMatch(body, "operationA")`,
+			`body == "operationC"`,
+		}},
+		conditionNames: map[string][]string{logConditionsKey: {"operation_a", ""}},
+	})
+	require.NoError(t, err)
+
+	got, err := processor.processLogs(context.Background(), constructLogs())
+	require.NoError(t, err)
+	assert.Equal(t, 4, got.LogRecordCount())
+
+	metadatatest.AssertEqualProcessorFilterConditionsMatched(t, tel, []metricdata.DataPoint[int64]{
+		{
+			Value: 2,
+			Attributes: attribute.NewSet(
+				attribute.String("filter", "filter"),
+				attribute.String("context", "log"),
+				attribute.String("condition", "operation_a"),
+			),
+		},
+		{
+			Value: 0,
+			Attributes: attribute.NewSet(
+				attribute.String("filter", "filter"),
+				attribute.String("context", "log"),
+				attribute.String("condition", "1"),
+			),
+		},
+	}, metricdatatest.IgnoreTimestamp())
+}
+
 func constructLogs() plog.Logs {
 	td := plog.NewLogs()
 	rs0 := td.ResourceLogs().AppendEmpty()
@@ -27,11 +27,13 @@ import (
 )
 
 type filterMetricProcessor struct {
-	skipResourceExpr  expr.BoolExpr[ottlresource.TransformContext]
-	skipMetricExpr    expr.BoolExpr[ottlmetric.TransformContext]
-	skipDataPointExpr expr.BoolExpr[ottldatapoint.TransformContext]
-	telemetry         *filterTelemetry
-	logger            *zap.Logger
+	skipResourceExpr    expr.BoolExpr[ottlresource.TransformContext]
+	skipMetricExpr      expr.BoolExpr[ottlmetric.TransformContext]
+	skipDataPointExpr   expr.BoolExpr[ottldatapoint.TransformContext]
+	metricConditions    []conditionCounter[ottlmetric.TransformContext]
+	dataPointConditions []conditionCounter[ottldatapoint.TransformContext]
+	telemetry           *filterTelemetry
+	logger              *zap.Logger
 }
 
 func newFilterMetricProcessor(set processor.Settings, cfg *Config) (*filterMetricProcessor, error) {
@@ -46,6 +48,22 @@ func newFilterMetricProcessor(set processor.Settings, cfg *Config) (*filterMetri
 	}
 	fsp.telemetry = fpt
 
+	if cfg.DryRun {
+		fsp.metricConditions, err = newConditionCounters(cfg.Metrics.MetricConditions, cfg.conditionNames[metricConditionsKey], "metric", fpt, func(conditions []string) (expr.BoolExpr[ottlmetric.TransformContext], error) {
+			return filterottl.NewBoolExprForMetric(conditions, filterottl.StandardMetricFuncs(), cfg.ErrorMode, set.TelemetrySettings)
+		})
+		if err != nil {
+			return nil, err
+		}
+		fsp.dataPointConditions, err = newConditionCounters(cfg.Metrics.DataPointConditions, cfg.conditionNames[dataPointConditionsKey], "datapoint", fpt, func(conditions []string) (expr.BoolExpr[ottldatapoint.TransformContext], error) {
+			return filterottl.NewBoolExprForDataPoint(conditions, filterottl.StandardDataPointFuncs(), cfg.ErrorMode, set.TelemetrySettings)
+		})
+		if err != nil {
+			return nil, err
+		}
+		return fsp, nil
+	}
+
 	if cfg.Metrics.MetricConditions != nil || cfg.Metrics.DataPointConditions != nil {
 		if cfg.Metrics.MetricConditions != nil {
 			fsp.skipMetricExpr, err = filterottl.NewBoolExprForMetric(cfg.Metrics.MetricConditions, filterottl.StandardMetricFuncs(), cfg.ErrorMode, set.TelemetrySettings)
@@ -113,6 +131,10 @@ func newFilterMetricProcessor(set processor.Settings, cfg *Config) (*filterMetri
 
 // processMetrics filters the given metrics based off the filterMetricProcessor's filters.
 func (fmp *filterMetricProcessor) processMetrics(ctx context.Context, md pmetric.Metrics) (pmetric.Metrics, error) {
+	if len(fmp.metricConditions) > 0 || len(fmp.dataPointConditions) > 0 {
+		fmp.countMetrics(ctx, md)
+		return md, nil
+	}
 	if fmp.skipResourceExpr == nil && fmp.skipMetricExpr == nil && fmp.skipDataPointExpr == nil {
 		return md, nil
 	}
@@ -308,3 +330,63 @@ func (fmp *filterMetricProcessor) handleSummaryDataPoints(ctx context.Context, d
 	})
 	return errors
 }
+
+// countMetrics counts the metrics and data points matched by each condition without dropping them.
+// Unlike when filtering, the data point conditions are also evaluated for the metrics matched by
+// a metric condition.
+func (fmp *filterMetricProcessor) countMetrics(ctx context.Context, md pmetric.Metrics) {
+	metricsMatched := make([]int64, len(fmp.metricConditions))
+	dataPointsMatched := make([]int64, len(fmp.dataPointConditions))
+
+	var errors error
+	for i := range md.ResourceMetrics().Len() {
+		rmetrics := md.ResourceMetrics().At(i)
+		for j := range rmetrics.ScopeMetrics().Len() {
+			smetrics := rmetrics.ScopeMetrics().At(j)
+			for k := range smetrics.Metrics().Len() {
+				metric := smetrics.Metrics().At(k)
+				if len(fmp.metricConditions) > 0 {
+					tCtx := ottlmetric.NewTransformContext(metric, smetrics.Metrics(), smetrics.Scope(), rmetrics.Resource(), smetrics, rmetrics)
+					errors = multierr.Append(errors, evalConditions(ctx, fmp.metricConditions, tCtx, metricsMatched))
+				}
+				if len(fmp.dataPointConditions) > 0 {
+					evalDataPoint := func(dataPoint any) {
+						tCtx := ottldatapoint.NewTransformContext(dataPoint, metric, smetrics.Metrics(), smetrics.Scope(), rmetrics.Resource(), smetrics, rmetrics)
+						errors = multierr.Append(errors, evalConditions(ctx, fmp.dataPointConditions, tCtx, dataPointsMatched))
+					}
+					//exhaustive:enforce
+					switch metric.Type() {
+					case pmetric.MetricTypeSum:
+						for l := range metric.Sum().DataPoints().Len() {
+							evalDataPoint(metric.Sum().DataPoints().At(l))
+						}
+					case pmetric.MetricTypeGauge:
+						for l := range metric.Gauge().DataPoints().Len() {
+							evalDataPoint(metric.Gauge().DataPoints().At(l))
+						}
+					case pmetric.MetricTypeHistogram:
+						for l := range metric.Histogram().DataPoints().Len() {
+							evalDataPoint(metric.Histogram().DataPoints().At(l))
+						}
+					case pmetric.MetricTypeExponentialHistogram:
+						for l := range metric.ExponentialHistogram().DataPoints().Len() {
+							evalDataPoint(metric.ExponentialHistogram().DataPoints().At(l))
+						}
+					case pmetric.MetricTypeSummary:
+						for l := range metric.Summary().DataPoints().Len() {
+							evalDataPoint(metric.Summary().DataPoints().At(l))
+						}
+					case pmetric.MetricTypeEmpty:
+					}
+				}
+			}
+		}
+	}
+
+	recordConditions(ctx, fmp.telemetry, fmp.metricConditions, metricsMatched)
+	recordConditions(ctx, fmp.telemetry, fmp.dataPointConditions, dataPointsMatched)
+
+	if errors != nil {
+		fmp.logger.Error("failed evaluating metric conditions in dry run mode", zap.Error(errors))
+	}
+}
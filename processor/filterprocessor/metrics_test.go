@@ -403,6 +403,48 @@ func TestFilterMetricProcessorTelemetry(t *testing.T) {
 	require.NoError(t, tel.Shutdown(context.Background()))
 }
 
+func TestFilterMetricProcessorDryRun(t *testing.T) {
+	tel := componenttest.NewTelemetry()
+	t.Cleanup(func() { require.NoError(t, tel.Shutdown(context.Background())) })
+	fmp, err := newFilterMetricProcessor(metadatatest.NewSettings(tel), &Config{
+		DryRun: true,
+		Metrics: MetricFilters{
+			MetricConditions: []string{
+				`name == "operationA"`,
+			},
+			DataPointConditions: []string{
+				`metric.name == "operationA"`,
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	md := constructMetrics()
+	dataPointCount := md.DataPointCount()
+	got, err := fmp.processMetrics(context.Background(), md)
+	require.NoError(t, err)
+	assert.Equal(t, dataPointCount, got.DataPointCount())
+
+	metadatatest.AssertEqualProcessorFilterConditionsMatched(t, tel, []metricdata.DataPoint[int64]{
+		{
+			Value: 1,
+			Attributes: attribute.NewSet(
+				attribute.String("filter", "filter"),
+				attribute.String("context", "metric"),
+				attribute.String("condition", "0"),
+			),
+		},
+		{
+			Value: 2,
+			Attributes: attribute.NewSet(
+				attribute.String("filter", "filter"),
+				attribute.String("context", "datapoint"),
+				attribute.String("condition", "0"),
+			),
+		},
+	}, metricdatatest.IgnoreTimestamp())
+}
+
 func testResourceMetrics(mwrs []metricWithResource) pmetric.Metrics {
 	md := pmetric.NewMetrics()
 	now := time.Now()
@@ -16,8 +16,10 @@ import (
 )
 
 type filterTelemetry struct {
+	id      string
 	attr    metric.MeasurementOption
 	counter metric.Int64Counter
+	matched metric.Int64Counter
 }
 
 func newFilterTelemetry(set processor.Settings, signal pipeline.Signal) (*filterTelemetry, error) {
@@ -39,11 +41,26 @@ func newFilterTelemetry(set processor.Settings, signal pipeline.Signal) (*filter
 	}
 
 	return &filterTelemetry{
+		id:      set.ID.String(),
 		attr:    metric.WithAttributeSet(attribute.NewSet(attribute.String(metadata.Type.String(), set.ID.String()))),
 		counter: counter,
+		matched: telemetryBuilder.ProcessorFilterConditionsMatched,
 	}, nil
 }
 
 func (fpt *filterTelemetry) record(ctx context.Context, dropped int64) {
 	fpt.counter.Add(ctx, dropped, fpt.attr)
 }
+
+// conditionAttr returns the attributes identifying a condition in the matched metric.
+func (fpt *filterTelemetry) conditionAttr(ottlContext, name string) metric.MeasurementOption {
+	return metric.WithAttributeSet(attribute.NewSet(
+		attribute.String(metadata.Type.String(), fpt.id),
+		attribute.String("context", ottlContext),
+		attribute.String("condition", name),
+	))
+}
+
+func (fpt *filterTelemetry) recordMatched(ctx context.Context, matched int64, attr metric.MeasurementOption) {
+	fpt.matched.Add(ctx, matched, attr)
+}
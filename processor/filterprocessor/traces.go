@@ -22,10 +22,12 @@ import (
 )
 
 type filterSpanProcessor struct {
-	skipSpanExpr      expr.BoolExpr[ottlspan.TransformContext]
-	skipSpanEventExpr expr.BoolExpr[ottlspanevent.TransformContext]
-	telemetry         *filterTelemetry
-	logger            *zap.Logger
+	skipSpanExpr        expr.BoolExpr[ottlspan.TransformContext]
+	skipSpanEventExpr   expr.BoolExpr[ottlspanevent.TransformContext]
+	spanConditions      []conditionCounter[ottlspan.TransformContext]
+	spanEventConditions []conditionCounter[ottlspanevent.TransformContext]
+	telemetry           *filterTelemetry
+	logger              *zap.Logger
 }
 
 func newFilterSpansProcessor(set processor.Settings, cfg *Config) (*filterSpanProcessor, error) {
@@ -40,6 +42,22 @@ func newFilterSpansProcessor(set processor.Settings, cfg *Config) (*filterSpanPr
 	}
 	fsp.telemetry = fpt
 
+	if cfg.DryRun {
+		fsp.spanConditions, err = newConditionCounters(cfg.Traces.SpanConditions, cfg.conditionNames[spanConditionsKey], "span", fpt, func(conditions []string) (expr.BoolExpr[ottlspan.TransformContext], error) {
+			return filterottl.NewBoolExprForSpan(conditions, filterottl.StandardSpanFuncs(), cfg.ErrorMode, set.TelemetrySettings)
+		})
+		if err != nil {
+			return nil, err
+		}
+		fsp.spanEventConditions, err = newConditionCounters(cfg.Traces.SpanEventConditions, cfg.conditionNames[spanEventConditionsKey], "spanevent", fpt, func(conditions []string) (expr.BoolExpr[ottlspanevent.TransformContext], error) {
+			return filterottl.NewBoolExprForSpanEvent(conditions, filterottl.StandardSpanEventFuncs(), cfg.ErrorMode, set.TelemetrySettings)
+		})
+		if err != nil {
+			return nil, err
+		}
+		return fsp, nil
+	}
+
 	if cfg.Traces.SpanConditions != nil || cfg.Traces.SpanEventConditions != nil {
 		if cfg.Traces.SpanConditions != nil {
 			fsp.skipSpanExpr, err = filterottl.NewBoolExprForSpan(cfg.Traces.SpanConditions, filterottl.StandardSpanFuncs(), cfg.ErrorMode, set.TelemetrySettings)
@@ -81,6 +99,10 @@ func newFilterSpansProcessor(set processor.Settings, cfg *Config) (*filterSpanPr
 
 // processTraces filters the given spans of a traces based off the filterSpanProcessor's filters.
 func (fsp *filterSpanProcessor) processTraces(ctx context.Context, td ptrace.Traces) (ptrace.Traces, error) {
+	if len(fsp.spanConditions) > 0 || len(fsp.spanEventConditions) > 0 {
+		fsp.countTraces(ctx, td)
+		return td, nil
+	}
 	if fsp.skipSpanExpr == nil && fsp.skipSpanEventExpr == nil {
 		return td, nil
 	}
@@ -132,3 +154,39 @@ func (fsp *filterSpanProcessor) processTraces(ctx context.Context, td ptrace.Tra
 	}
 	return td, nil
 }
+
+// countTraces counts the spans and span events matched by each condition without dropping them.
+// Unlike when filtering, the span event conditions are also evaluated for the spans matched by
+// a span condition.
+func (fsp *filterSpanProcessor) countTraces(ctx context.Context, td ptrace.Traces) {
+	spansMatched := make([]int64, len(fsp.spanConditions))
+	spanEventsMatched := make([]int64, len(fsp.spanEventConditions))
+
+	var errors error
+	for i := range td.ResourceSpans().Len() {
+		rs := td.ResourceSpans().At(i)
+		for j := range rs.ScopeSpans().Len() {
+			ss := rs.ScopeSpans().At(j)
+			for k := range ss.Spans().Len() {
+				span := ss.Spans().At(k)
+				if len(fsp.spanConditions) > 0 {
+					tCtx := ottlspan.NewTransformContext(span, ss.Scope(), rs.Resource(), ss, rs)
+					errors = multierr.Append(errors, evalConditions(ctx, fsp.spanConditions, tCtx, spansMatched))
+				}
+				if len(fsp.spanEventConditions) > 0 {
+					for l := range span.Events().Len() {
+						tCtx := ottlspanevent.NewTransformContext(span.Events().At(l), span, ss.Scope(), rs.Resource(), ss, rs)
+						errors = multierr.Append(errors, evalConditions(ctx, fsp.spanEventConditions, tCtx, spanEventsMatched))
+					}
+				}
+			}
+		}
+	}
+
+	recordConditions(ctx, fsp.telemetry, fsp.spanConditions, spansMatched)
+	recordConditions(ctx, fsp.telemetry, fsp.spanEventConditions, spanEventsMatched)
+
+	if errors != nil {
+		fsp.logger.Error("failed evaluating trace conditions in dry run mode", zap.Error(errors))
+	}
+}
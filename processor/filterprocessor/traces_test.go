@@ -308,6 +308,46 @@ func TestFilterTraceProcessorTelemetry(t *testing.T) {
 	}, metricdatatest.IgnoreTimestamp())
 }
 
+func TestFilterTraceProcessorDryRun(t *testing.T) {
+	tel := componenttest.NewTelemetry()
+	t.Cleanup(func() { require.NoError(t, tel.Shutdown(context.Background())) })
+	processor, err := newFilterSpansProcessor(metadatatest.NewSettings(tel), &Config{
+		DryRun: true,
+		Traces: TraceFilters{
+			SpanConditions: []string{
+				`name == "operationA"`,
+			},
+			SpanEventConditions: []string{
+				`name == "spanEventA"`,
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	got, err := processor.processTraces(context.Background(), constructTraces())
+	require.NoError(t, err)
+	assert.Equal(t, 4, got.SpanCount())
+
+	metadatatest.AssertEqualProcessorFilterConditionsMatched(t, tel, []metricdata.DataPoint[int64]{
+		{
+			Value: 2,
+			Attributes: attribute.NewSet(
+				attribute.String("filter", "filter"),
+				attribute.String("context", "span"),
+				attribute.String("condition", "0"),
+			),
+		},
+		{
+			Value: 2,
+			Attributes: attribute.NewSet(
+				attribute.String("filter", "filter"),
+				attribute.String("context", "spanevent"),
+				attribute.String("condition", "0"),
+			),
+		},
+	}, metricdatatest.IgnoreTimestamp())
+}
+
 func constructTraces() ptrace.Traces {
 	td := ptrace.NewTraces()
 	rs0 := td.ResourceSpans().AppendEmpty()
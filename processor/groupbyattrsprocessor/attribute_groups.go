@@ -15,10 +15,11 @@ import (
 type tracesGroup struct {
 	traces         ptrace.Traces
 	resourceHashes [][16]byte
+	preserveOrder  bool
 }
 
-func newTracesGroup() *tracesGroup {
-	return &tracesGroup{traces: ptrace.NewTraces()}
+func newTracesGroup(preserveOrder bool) *tracesGroup {
+	return &tracesGroup{traces: ptrace.NewTraces(), preserveOrder: preserveOrder}
 }
 
 // findOrCreateResource searches for a Resource with matching attributes and returns it. If nothing is found, it is being created
@@ -27,7 +28,7 @@ func (tg *tracesGroup) findOrCreateResourceSpans(originResource pcommon.Resource
 	referenceResourceHash := pdatautil.MapHash(referenceResource.Attributes())
 
 	rss := tg.traces.ResourceSpans()
-	for i := 0; i < rss.Len(); i++ {
+	for i := firstCandidate(tg.preserveOrder, rss.Len()); i < rss.Len(); i++ {
 		if tg.resourceHashes[i] == referenceResourceHash {
 			return rss.At(i)
 		}
@@ -42,10 +43,11 @@ func (tg *tracesGroup) findOrCreateResourceSpans(originResource pcommon.Resource
 type metricsGroup struct {
 	metrics        pmetric.Metrics
 	resourceHashes [][16]byte
+	preserveOrder  bool
 }
 
-func newMetricsGroup() *metricsGroup {
-	return &metricsGroup{metrics: pmetric.NewMetrics()}
+func newMetricsGroup(preserveOrder bool) *metricsGroup {
+	return &metricsGroup{metrics: pmetric.NewMetrics(), preserveOrder: preserveOrder}
 }
 
 // findOrCreateResourceMetrics searches for a Resource with matching attributes and returns it. If nothing is found, it is being created
@@ -54,7 +56,7 @@ func (mg *metricsGroup) findOrCreateResourceMetrics(originResource pcommon.Resou
 	referenceResourceHash := pdatautil.MapHash(referenceResource.Attributes())
 
 	rms := mg.metrics.ResourceMetrics()
-	for i := 0; i < rms.Len(); i++ {
+	for i := firstCandidate(mg.preserveOrder, rms.Len()); i < rms.Len(); i++ {
 		if mg.resourceHashes[i] == referenceResourceHash {
 			return rms.At(i)
 		}
@@ -69,11 +71,12 @@ func (mg *metricsGroup) findOrCreateResourceMetrics(originResource pcommon.Resou
 type logsGroup struct {
 	logs           plog.Logs
 	resourceHashes [][16]byte
+	preserveOrder  bool
 }
 
 // newLogsGroup returns new logsGroup with predefined capacity
-func newLogsGroup() *logsGroup {
-	return &logsGroup{logs: plog.NewLogs()}
+func newLogsGroup(preserveOrder bool) *logsGroup {
+	return &logsGroup{logs: plog.NewLogs(), preserveOrder: preserveOrder}
 }
 
 // findOrCreateResourceLogs searches for a Resource with matching attributes and returns it. If nothing is found, it is being created
@@ -82,7 +85,7 @@ func (lg *logsGroup) findOrCreateResourceLogs(originResource pcommon.Resource, r
 	referenceResourceHash := pdatautil.MapHash(referenceResource.Attributes())
 
 	rls := lg.logs.ResourceLogs()
-	for i := 0; i < rls.Len(); i++ {
+	for i := firstCandidate(lg.preserveOrder, rls.Len()); i < rls.Len(); i++ {
 		if lg.resourceHashes[i] == referenceResourceHash {
 			return rls.At(i)
 		}
@@ -100,9 +103,9 @@ func instrumentationLibrariesEqual(il1, il2 pcommon.InstrumentationScope) bool {
 
 // matchingScopeSpans searches for a ptrace.ScopeSpans instance matching
 // given InstrumentationScope. If nothing is found, it creates a new one
-func matchingScopeSpans(rl ptrace.ResourceSpans, library pcommon.InstrumentationScope) ptrace.ScopeSpans {
+func matchingScopeSpans(rl ptrace.ResourceSpans, library pcommon.InstrumentationScope, preserveOrder bool) ptrace.ScopeSpans {
 	ilss := rl.ScopeSpans()
-	for i := 0; i < ilss.Len(); i++ {
+	for i := firstCandidate(preserveOrder, ilss.Len()); i < ilss.Len(); i++ {
 		ils := ilss.At(i)
 		if instrumentationLibrariesEqual(ils.Scope(), library) {
 			return ils
@@ -116,9 +119,9 @@ func matchingScopeSpans(rl ptrace.ResourceSpans, library pcommon.Instrumentation
 
 // matchingScopeLogs searches for a plog.ScopeLogs instance matching
 // given InstrumentationScope. If nothing is found, it creates a new one
-func matchingScopeLogs(rl plog.ResourceLogs, library pcommon.InstrumentationScope) plog.ScopeLogs {
+func matchingScopeLogs(rl plog.ResourceLogs, library pcommon.InstrumentationScope, preserveOrder bool) plog.ScopeLogs {
 	ills := rl.ScopeLogs()
-	for i := 0; i < ills.Len(); i++ {
+	for i := firstCandidate(preserveOrder, ills.Len()); i < ills.Len(); i++ {
 		sl := ills.At(i)
 		if instrumentationLibrariesEqual(sl.Scope(), library) {
 			return sl
@@ -132,9 +135,9 @@ func matchingScopeLogs(rl plog.ResourceLogs, library pcommon.InstrumentationScop
 
 // matchingScopeMetrics searches for a pmetric.ScopeMetrics instance matching
 // given InstrumentationScope. If nothing is found, it creates a new one
-func matchingScopeMetrics(rm pmetric.ResourceMetrics, library pcommon.InstrumentationScope) pmetric.ScopeMetrics {
+func matchingScopeMetrics(rm pmetric.ResourceMetrics, library pcommon.InstrumentationScope, preserveOrder bool) pmetric.ScopeMetrics {
 	ilms := rm.ScopeMetrics()
-	for i := 0; i < ilms.Len(); i++ {
+	for i := firstCandidate(preserveOrder, ilms.Len()); i < ilms.Len(); i++ {
 		ilm := ilms.At(i)
 		if instrumentationLibrariesEqual(ilm.Scope(), library) {
 			return ilm
@@ -146,6 +149,16 @@ func matchingScopeMetrics(rm pmetric.ResourceMetrics, library pcommon.Instrument
 	return ilm
 }
 
+// firstCandidate returns the index of the first of n entries that records can be
+// grouped into. When the order is preserved, only the last entry is a candidate so
+// that records are never moved before records received after them.
+func firstCandidate(preserveOrder bool, n int) int {
+	if preserveOrder && n > 0 {
+		return n - 1
+	}
+	return 0
+}
+
 // buildReferenceResource returns a new resource that we'll be looking for in existing Resources
 // as a merge of the Attributes of the original Resource with the requested Attributes.
 func buildReferenceResource(originResource pcommon.Resource, requiredAttributes pcommon.Map) pcommon.Resource {
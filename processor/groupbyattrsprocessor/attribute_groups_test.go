@@ -95,7 +95,7 @@ func TestResourceAttributeScenarios(t *testing.T) {
 		},
 	}
 
-	lg := newLogsGroup()
+	lg := newLogsGroup(false)
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			recordAttributeMap := pcommon.NewMap()
@@ -124,30 +124,30 @@ func TestInstrumentationLibraryMatching(t *testing.T) {
 	il2 := pcommon.NewInstrumentationScope()
 	il2.SetName("Name2")
 
-	ill1 := matchingScopeLogs(rl, il1)
-	ils1 := matchingScopeSpans(rs, il1)
-	ilm1 := matchingScopeMetrics(rm, il1)
+	ill1 := matchingScopeLogs(rl, il1, false)
+	ils1 := matchingScopeSpans(rs, il1, false)
+	ilm1 := matchingScopeMetrics(rm, il1, false)
 	assert.Equal(t, il1, ill1.Scope())
 	assert.Equal(t, il1, ils1.Scope())
 	assert.Equal(t, il1, ilm1.Scope())
 
-	ill2 := matchingScopeLogs(rl, il2)
-	ils2 := matchingScopeSpans(rs, il2)
-	ilm2 := matchingScopeMetrics(rm, il2)
+	ill2 := matchingScopeLogs(rl, il2, false)
+	ils2 := matchingScopeSpans(rs, il2, false)
+	ilm2 := matchingScopeMetrics(rm, il2, false)
 	assert.Equal(t, il2, ill2.Scope())
 	assert.Equal(t, il2, ils2.Scope())
 	assert.Equal(t, il2, ilm2.Scope())
 
-	ill1 = matchingScopeLogs(rl, il1)
-	ils1 = matchingScopeSpans(rs, il1)
-	ilm1 = matchingScopeMetrics(rm, il1)
+	ill1 = matchingScopeLogs(rl, il1, false)
+	ils1 = matchingScopeSpans(rs, il1, false)
+	ilm1 = matchingScopeMetrics(rm, il1, false)
 	assert.Equal(t, il1, ill1.Scope())
 	assert.Equal(t, il1, ils1.Scope())
 	assert.Equal(t, il1, ilm1.Scope())
 }
 
 func BenchmarkAttrGrouping(b *testing.B) {
-	lg := newLogsGroup()
+	lg := newLogsGroup(false)
 	b.ReportAllocs()
 	for n := 0; n < b.N; n++ {
 		lg.findOrCreateResourceLogs(res, groups[rand.IntN(count)])
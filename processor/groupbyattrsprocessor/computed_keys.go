@@ -0,0 +1,88 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package groupbyattrsprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/groupbyattrsprocessor"
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+)
+
+// computedKey is a grouping key whose value is computed by an OTTL value expression.
+type computedKey[K any] struct {
+	name       string
+	expression *ottl.ValueExpression[K]
+}
+
+// parserFactory creates an OTTL parser for a context.
+type parserFactory[K any] func(map[string]ottl.Factory[K], component.TelemetrySettings, ...ottl.Option[K]) (ottl.Parser[K], error)
+
+// parseComputedKeys parses the OTTL value expressions of the computed keys.
+func parseComputedKeys[K any](keys []ComputedKey, newParser parserFactory[K], set component.TelemetrySettings) ([]computedKey[K], error) {
+	if len(keys) == 0 {
+		return nil, nil
+	}
+	parser, err := newParser(ottlfuncs.StandardConverters[K](), set)
+	if err != nil {
+		return nil, err
+	}
+	computed := make([]computedKey[K], 0, len(keys))
+	for _, key := range keys {
+		expr, err := parser.ParseValueExpression(key.Expression)
+		if err != nil {
+			return nil, fmt.Errorf("computed key %q: %w", key.Name, err)
+		}
+		computed = append(computed, computedKey[K]{name: key.Name, expression: expr})
+	}
+	return computed, nil
+}
+
+// evalComputedKeys evaluates the computed keys against the record and puts the
+// non-nil values in the grouping attributes. It returns whether any value was set.
+func evalComputedKeys[K any](ctx context.Context, logger *zap.Logger, keys []computedKey[K], tCtx K, groupingAttributes pcommon.Map) bool {
+	foundMatch := false
+	for _, key := range keys {
+		val, err := key.expression.Eval(ctx, tCtx)
+		if err != nil {
+			logger.Debug("Failed to compute grouping key", zap.String("key", key.name), zap.Error(err))
+			continue
+		}
+		if val == nil {
+			continue
+		}
+		putValue(groupingAttributes, key.name, val)
+		foundMatch = true
+	}
+	return foundMatch
+}
+
+// putValue sets an attribute to the result of an OTTL value expression.
+func putValue(m pcommon.Map, key string, val any) {
+	switch v := val.(type) {
+	case string:
+		m.PutStr(key, v)
+	case int64:
+		m.PutInt(key, v)
+	case float64:
+		m.PutDouble(key, v)
+	case bool:
+		m.PutBool(key, v)
+	case []byte:
+		m.PutEmptyBytes(key).FromRaw(v)
+	case pcommon.Value:
+		v.CopyTo(m.PutEmpty(key))
+	case pcommon.Map:
+		v.CopyTo(m.PutEmptyMap(key))
+	case pcommon.Slice:
+		v.CopyTo(m.PutEmptySlice(key))
+	default:
+		m.PutStr(key, fmt.Sprint(v))
+	}
+}
@@ -3,12 +3,65 @@
 
 package groupbyattrsprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/groupbyattrsprocessor"
 
+import (
+	"fmt"
+
+	"go.opentelemetry.io/collector/component"
+)
+
 // Config is the configuration for the processor.
 type Config struct {
 	// GroupByKeys describes the attribute names that are going to be used for grouping.
 	// Empty value is allowed, since processor in such case can compact data
 	GroupByKeys []string `mapstructure:"keys"`
 
+	// ComputedKeys describes grouping keys whose values are computed by OTTL value
+	// expressions evaluated against each span, log record or metric data point.
+	// Unlike GroupByKeys, the record attributes are left untouched. The expressions
+	// are parsed with the OTTL context of each signal the processor is used for.
+	ComputedKeys []ComputedKey `mapstructure:"computed_keys"`
+
+	// PreserveOrder keeps the records in the order they were received, only grouping
+	// consecutive records sharing the same Resource and InstrumentationScope.
+	PreserveOrder bool `mapstructure:"preserve_order"`
+
 	// prevent unkeyed literal initialization
 	_ struct{}
 }
+
+// ComputedKey is a grouping key computed by an OTTL value expression.
+type ComputedKey struct {
+	// Name is the name of the Resource attribute set to the computed value.
+	Name string `mapstructure:"name"`
+
+	// Expression is the OTTL value expression computing the value, e.g.
+	// `Substring(attributes["k8s.pod.name"], 0, 8)`. Records for which it
+	// evaluates to nil are not grouped by this key.
+	Expression string `mapstructure:"expression"`
+
+	// prevent unkeyed literal initialization
+	_ struct{}
+}
+
+var _ component.Config = (*Config)(nil)
+
+// Validate checks if the processor configuration is valid
+func (cfg *Config) Validate() error {
+	names := make(map[string]struct{}, len(cfg.GroupByKeys)+len(cfg.ComputedKeys))
+	for _, key := range cfg.GroupByKeys {
+		names[key] = struct{}{}
+	}
+	for i, key := range cfg.ComputedKeys {
+		if key.Name == "" {
+			return fmt.Errorf("computed_keys[%d]: name must not be empty", i)
+		}
+		if key.Expression == "" {
+			return fmt.Errorf("computed_keys[%d]: expression must not be empty", i)
+		}
+		if _, ok := names[key.Name]; ok {
+			return fmt.Errorf("computed_keys[%d]: grouping key %q is already present", i, key.Name)
+		}
+		names[key.Name] = struct{}{}
+	}
+	return nil
+}
@@ -20,8 +20,9 @@ func TestLoadingConfig(t *testing.T) {
 	t.Parallel()
 
 	tests := []struct {
-		id       component.ID
-		expected component.Config
+		id           component.ID
+		expected     component.Config
+		errorMessage string
 	}{
 		{
 			id: component.NewIDWithName(metadata.Type, "grouping"),
@@ -35,6 +36,21 @@ func TestLoadingConfig(t *testing.T) {
 				GroupByKeys: []string{},
 			},
 		},
+		{
+			id: component.NewIDWithName(metadata.Type, "computed"),
+			expected: &Config{
+				GroupByKeys: []string{"host.name"},
+				ComputedKeys: []ComputedKey{{
+					Name:       "app",
+					Expression: `Substring(attributes["k8s.pod.name"], 0, 8)`,
+				}},
+				PreserveOrder: true,
+			},
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "duplicate_computed_key"),
+			errorMessage: `computed_keys[0]: grouping key "host.name" is already present`,
+		},
 	}
 
 	for _, tt := range tests {
@@ -49,6 +65,10 @@ func TestLoadingConfig(t *testing.T) {
 			require.NoError(t, err)
 			require.NoError(t, sub.Unmarshal(cfg))
 
+			if tt.expected == nil {
+				assert.EqualError(t, xconfmap.Validate(cfg), tt.errorMessage)
+				return
+			}
 			assert.NoError(t, xconfmap.Validate(cfg))
 			assert.Equal(t, tt.expected, cfg)
 		})
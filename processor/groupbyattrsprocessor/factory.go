@@ -12,6 +12,9 @@ import (
 	"go.opentelemetry.io/collector/processor/processorhelper"
 	"go.uber.org/zap"
 
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottldatapoint"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottllog"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlspan"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/groupbyattrsprocessor/internal/metadata"
 )
 
@@ -69,6 +72,10 @@ func createTracesProcessor(
 	if err != nil {
 		return nil, err
 	}
+	gap.preserveOrder = oCfg.PreserveOrder
+	if gap.spanKeys, err = parseComputedKeys(oCfg.ComputedKeys, ottlspan.NewParser, set.TelemetrySettings); err != nil {
+		return nil, err
+	}
 
 	return processorhelper.NewTraces(
 		ctx,
@@ -91,6 +98,10 @@ func createLogsProcessor(
 	if err != nil {
 		return nil, err
 	}
+	gap.preserveOrder = oCfg.PreserveOrder
+	if gap.logKeys, err = parseComputedKeys(oCfg.ComputedKeys, ottllog.NewParser, set.TelemetrySettings); err != nil {
+		return nil, err
+	}
 
 	return processorhelper.NewLogs(
 		ctx,
@@ -113,6 +124,10 @@ func createMetricsProcessor(
 	if err != nil {
 		return nil, err
 	}
+	gap.preserveOrder = oCfg.PreserveOrder
+	if gap.dataPointKeys, err = parseComputedKeys(oCfg.ComputedKeys, ottldatapoint.NewParser, set.TelemetrySettings); err != nil {
+		return nil, err
+	}
 
 	return processorhelper.NewMetrics(
 		ctx,
@@ -54,3 +54,17 @@ func TestDuplicateKeys(t *testing.T) {
 	assert.NotNil(t, gbap)
 	assert.Equal(t, []string{"foo"}, gbap.groupByKeys)
 }
+
+func TestCreateProcessorInvalidComputedKey(t *testing.T) {
+	// body is only a valid path of the log context
+	cfg := &Config{
+		ComputedKeys: []ComputedKey{{Name: "message", Expression: "body"}},
+	}
+
+	lp, err := createLogsProcessor(context.Background(), processortest.NewNopSettings(metadata.Type), cfg, consumertest.NewNop())
+	assert.NoError(t, err)
+	assert.NotNil(t, lp)
+
+	_, err = createTracesProcessor(context.Background(), processortest.NewNopSettings(metadata.Type), cfg, consumertest.NewNop())
+	assert.ErrorContains(t, err, `computed key "message"`)
+}
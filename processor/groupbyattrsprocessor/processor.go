@@ -12,19 +12,26 @@ import (
 	"go.opentelemetry.io/collector/pdata/ptrace"
 	"go.uber.org/zap"
 
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottldatapoint"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottllog"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlspan"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/groupbyattrsprocessor/internal/metadata"
 )
 
 type groupByAttrsProcessor struct {
 	logger           *zap.Logger
 	groupByKeys      []string
+	spanKeys         []computedKey[ottlspan.TransformContext]
+	logKeys          []computedKey[ottllog.TransformContext]
+	dataPointKeys    []computedKey[ottldatapoint.TransformContext]
+	preserveOrder    bool
 	telemetryBuilder *metadata.TelemetryBuilder
 }
 
 // ProcessTraces process traces and groups traces by attribute.
 func (gap *groupByAttrsProcessor) processTraces(ctx context.Context, td ptrace.Traces) (ptrace.Traces, error) {
 	rss := td.ResourceSpans()
-	tg := newTracesGroup()
+	tg := newTracesGroup(gap.preserveOrder)
 
 	for i := 0; i < rss.Len(); i++ {
 		rs := rss.At(i)
@@ -36,11 +43,19 @@ func (gap *groupByAttrsProcessor) processTraces(ctx context.Context, td ptrace.T
 				span := ils.Spans().At(k)
 
 				toBeGrouped, requiredAttributes := gap.extractGroupingAttributes(span.Attributes())
+				computedAttributes := pcommon.NewMap()
+				if len(gap.spanKeys) > 0 {
+					tCtx := ottlspan.NewTransformContext(span, ils.Scope(), rs.Resource(), ils, rs)
+					if evalComputedKeys(ctx, gap.logger, gap.spanKeys, tCtx, computedAttributes) {
+						toBeGrouped = true
+					}
+				}
 				if toBeGrouped {
 					gap.telemetryBuilder.ProcessorGroupbyattrsNumGroupedSpans.Add(ctx, 1)
 					// Some attributes are going to be moved from span to resource level,
 					// so we can delete those on the record level
 					deleteAttributes(requiredAttributes, span.Attributes())
+					mergeAttributes(computedAttributes, requiredAttributes)
 				} else {
 					gap.telemetryBuilder.ProcessorGroupbyattrsNumNonGroupedSpans.Add(ctx, 1)
 				}
@@ -48,7 +63,7 @@ func (gap *groupByAttrsProcessor) processTraces(ctx context.Context, td ptrace.T
 				// Lets combine the base resource attributes + the extracted (grouped) attributes
 				// and keep them in the grouping entry
 				groupedResourceSpans := tg.findOrCreateResourceSpans(rs.Resource(), requiredAttributes)
-				sp := matchingScopeSpans(groupedResourceSpans, ils.Scope()).Spans().AppendEmpty()
+				sp := matchingScopeSpans(groupedResourceSpans, ils.Scope(), gap.preserveOrder).Spans().AppendEmpty()
 				span.CopyTo(sp)
 			}
 		}
@@ -62,7 +77,7 @@ func (gap *groupByAttrsProcessor) processTraces(ctx context.Context, td ptrace.T
 
 func (gap *groupByAttrsProcessor) processLogs(ctx context.Context, ld plog.Logs) (plog.Logs, error) {
 	rl := ld.ResourceLogs()
-	lg := newLogsGroup()
+	lg := newLogsGroup(gap.preserveOrder)
 
 	for i := 0; i < rl.Len(); i++ {
 		ls := rl.At(i)
@@ -74,11 +89,19 @@ func (gap *groupByAttrsProcessor) processLogs(ctx context.Context, ld plog.Logs)
 				log := sl.LogRecords().At(k)
 
 				toBeGrouped, requiredAttributes := gap.extractGroupingAttributes(log.Attributes())
+				computedAttributes := pcommon.NewMap()
+				if len(gap.logKeys) > 0 {
+					tCtx := ottllog.NewTransformContext(log, sl.Scope(), ls.Resource(), sl, ls)
+					if evalComputedKeys(ctx, gap.logger, gap.logKeys, tCtx, computedAttributes) {
+						toBeGrouped = true
+					}
+				}
 				if toBeGrouped {
 					gap.telemetryBuilder.ProcessorGroupbyattrsNumGroupedLogs.Add(ctx, 1)
 					// Some attributes are going to be moved from log record to resource level,
 					// so we can delete those on the record level
 					deleteAttributes(requiredAttributes, log.Attributes())
+					mergeAttributes(computedAttributes, requiredAttributes)
 				} else {
 					gap.telemetryBuilder.ProcessorGroupbyattrsNumNonGroupedLogs.Add(ctx, 1)
 				}
@@ -86,7 +109,7 @@ func (gap *groupByAttrsProcessor) processLogs(ctx context.Context, ld plog.Logs)
 				// Lets combine the base resource attributes + the extracted (grouped) attributes
 				// and keep them in the grouping entry
 				groupedResourceLogs := lg.findOrCreateResourceLogs(ls.Resource(), requiredAttributes)
-				lr := matchingScopeLogs(groupedResourceLogs, sl.Scope()).LogRecords().AppendEmpty()
+				lr := matchingScopeLogs(groupedResourceLogs, sl.Scope(), gap.preserveOrder).LogRecords().AppendEmpty()
 				log.CopyTo(lr)
 			}
 		}
@@ -100,7 +123,7 @@ func (gap *groupByAttrsProcessor) processLogs(ctx context.Context, ld plog.Logs)
 
 func (gap *groupByAttrsProcessor) processMetrics(ctx context.Context, md pmetric.Metrics) (pmetric.Metrics, error) {
 	rms := md.ResourceMetrics()
-	mg := newMetricsGroup()
+	mg := newMetricsGroup(gap.preserveOrder)
 
 	for i := 0; i < rms.Len(); i++ {
 		rm := rms.At(i)
@@ -116,35 +139,35 @@ func (gap *groupByAttrsProcessor) processMetrics(ctx context.Context, md pmetric
 				case pmetric.MetricTypeGauge:
 					for pointIndex := 0; pointIndex < metric.Gauge().DataPoints().Len(); pointIndex++ {
 						dataPoint := metric.Gauge().DataPoints().At(pointIndex)
-						groupedMetric := gap.getGroupedMetricsFromAttributes(ctx, mg, rm, ilm, metric, dataPoint.Attributes())
+						groupedMetric := gap.getGroupedMetricsFromAttributes(ctx, mg, rm, ilm, metric, dataPoint, dataPoint.Attributes())
 						dataPoint.CopyTo(groupedMetric.Gauge().DataPoints().AppendEmpty())
 					}
 
 				case pmetric.MetricTypeSum:
 					for pointIndex := 0; pointIndex < metric.Sum().DataPoints().Len(); pointIndex++ {
 						dataPoint := metric.Sum().DataPoints().At(pointIndex)
-						groupedMetric := gap.getGroupedMetricsFromAttributes(ctx, mg, rm, ilm, metric, dataPoint.Attributes())
+						groupedMetric := gap.getGroupedMetricsFromAttributes(ctx, mg, rm, ilm, metric, dataPoint, dataPoint.Attributes())
 						dataPoint.CopyTo(groupedMetric.Sum().DataPoints().AppendEmpty())
 					}
 
 				case pmetric.MetricTypeSummary:
 					for pointIndex := 0; pointIndex < metric.Summary().DataPoints().Len(); pointIndex++ {
 						dataPoint := metric.Summary().DataPoints().At(pointIndex)
-						groupedMetric := gap.getGroupedMetricsFromAttributes(ctx, mg, rm, ilm, metric, dataPoint.Attributes())
+						groupedMetric := gap.getGroupedMetricsFromAttributes(ctx, mg, rm, ilm, metric, dataPoint, dataPoint.Attributes())
 						dataPoint.CopyTo(groupedMetric.Summary().DataPoints().AppendEmpty())
 					}
 
 				case pmetric.MetricTypeHistogram:
 					for pointIndex := 0; pointIndex < metric.Histogram().DataPoints().Len(); pointIndex++ {
 						dataPoint := metric.Histogram().DataPoints().At(pointIndex)
-						groupedMetric := gap.getGroupedMetricsFromAttributes(ctx, mg, rm, ilm, metric, dataPoint.Attributes())
+						groupedMetric := gap.getGroupedMetricsFromAttributes(ctx, mg, rm, ilm, metric, dataPoint, dataPoint.Attributes())
 						dataPoint.CopyTo(groupedMetric.Histogram().DataPoints().AppendEmpty())
 					}
 
 				case pmetric.MetricTypeExponentialHistogram:
 					for pointIndex := 0; pointIndex < metric.ExponentialHistogram().DataPoints().Len(); pointIndex++ {
 						dataPoint := metric.ExponentialHistogram().DataPoints().At(pointIndex)
-						groupedMetric := gap.getGroupedMetricsFromAttributes(ctx, mg, rm, ilm, metric, dataPoint.Attributes())
+						groupedMetric := gap.getGroupedMetricsFromAttributes(ctx, mg, rm, ilm, metric, dataPoint, dataPoint.Attributes())
 						dataPoint.CopyTo(groupedMetric.ExponentialHistogram().DataPoints().AppendEmpty())
 					}
 
@@ -165,6 +188,13 @@ func deleteAttributes(attrsForRemoval, targetAttrs pcommon.Map) {
 	}
 }
 
+// mergeAttributes copies the computed grouping attributes into the extracted ones
+func mergeAttributes(computedAttrs, targetAttrs pcommon.Map) {
+	for key, val := range computedAttrs.All() {
+		val.CopyTo(targetAttrs.PutEmpty(key))
+	}
+}
+
 // extractGroupingAttributes extracts the keys and values of the specified Attributes
 // that match with the attributes keys that is used for grouping
 // Returns:
@@ -186,10 +216,10 @@ func (gap *groupByAttrsProcessor) extractGroupingAttributes(attrMap pcommon.Map)
 }
 
 // Searches for metric with same name in the specified InstrumentationLibrary and returns it. If nothing is found, create it.
-func getMetricInInstrumentationLibrary(ilm pmetric.ScopeMetrics, searchedMetric pmetric.Metric) pmetric.Metric {
+func getMetricInInstrumentationLibrary(ilm pmetric.ScopeMetrics, searchedMetric pmetric.Metric, preserveOrder bool) pmetric.Metric {
 	// Loop through all metrics and try to find the one that matches with the one we search for
 	// (name and type)
-	for i := 0; i < ilm.Metrics().Len(); i++ {
+	for i := firstCandidate(preserveOrder, ilm.Metrics().Len()); i < ilm.Metrics().Len(); i++ {
 		metric := ilm.Metrics().At(i)
 		if metric.Name() == searchedMetric.Name() && metric.Type() == searchedMetric.Type() {
 			return metric
@@ -235,14 +265,23 @@ func (gap *groupByAttrsProcessor) getGroupedMetricsFromAttributes(
 	originResourceMetrics pmetric.ResourceMetrics,
 	ilm pmetric.ScopeMetrics,
 	metric pmetric.Metric,
+	dataPoint any,
 	attributes pcommon.Map,
 ) pmetric.Metric {
 	toBeGrouped, requiredAttributes := gap.extractGroupingAttributes(attributes)
+	computedAttributes := pcommon.NewMap()
+	if len(gap.dataPointKeys) > 0 {
+		tCtx := ottldatapoint.NewTransformContext(dataPoint, metric, ilm.Metrics(), ilm.Scope(), originResourceMetrics.Resource(), ilm, originResourceMetrics)
+		if evalComputedKeys(ctx, gap.logger, gap.dataPointKeys, tCtx, computedAttributes) {
+			toBeGrouped = true
+		}
+	}
 	if toBeGrouped {
 		gap.telemetryBuilder.ProcessorGroupbyattrsNumGroupedMetrics.Add(ctx, 1)
 		// These attributes are going to be moved from datapoint to resource level,
 		// so we can delete those on the datapoint
 		deleteAttributes(requiredAttributes, attributes)
+		mergeAttributes(computedAttributes, requiredAttributes)
 	} else {
 		gap.telemetryBuilder.ProcessorGroupbyattrsNumNonGroupedMetrics.Add(ctx, 1)
 	}
@@ -251,8 +290,8 @@ func (gap *groupByAttrsProcessor) getGroupedMetricsFromAttributes(
 	groupedResourceMetrics := mg.findOrCreateResourceMetrics(originResourceMetrics.Resource(), requiredAttributes)
 
 	// Get the corresponding instrumentation library
-	groupedInstrumentationLibrary := matchingScopeMetrics(groupedResourceMetrics, ilm.Scope())
+	groupedInstrumentationLibrary := matchingScopeMetrics(groupedResourceMetrics, ilm.Scope(), gap.preserveOrder)
 
 	// Return the metric in this resource
-	return getMetricInInstrumentationLibrary(groupedInstrumentationLibrary, metric)
+	return getMetricInInstrumentationLibrary(groupedInstrumentationLibrary, metric, gap.preserveOrder)
 }
@@ -23,6 +23,8 @@ import (
 	"go.opentelemetry.io/otel/sdk/metric/metricdata"
 	"go.opentelemetry.io/otel/sdk/metric/metricdata/metricdatatest"
 
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottldatapoint"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottllog"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/groupbyattrsprocessor/internal/metadata"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/groupbyattrsprocessor/internal/metadatatest"
 )
@@ -1004,7 +1006,7 @@ func Test_GetMetricInInstrumentationLibrary(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			require.Equal(t, tt.want, getMetricInInstrumentationLibrary(tt.ilm, tt.searched))
+			require.Equal(t, tt.want, getMetricInInstrumentationLibrary(tt.ilm, tt.searched, false))
 		})
 	}
 }
@@ -1044,3 +1046,120 @@ func BenchmarkCompacting(bb *testing.B) {
 		})
 	}
 }
+
+func TestComputedKeys(t *testing.T) {
+	logs := plog.NewLogs()
+	rl := logs.ResourceLogs().AppendEmpty()
+	rl.Resource().Attributes().PutStr("host.name", "localhost")
+	lrs := rl.ScopeLogs().AppendEmpty().LogRecords()
+	for _, pod := range []string{"checkout-7f9c-abc", "payments-5d2e-abc", "checkout-7f9c-def", ""} {
+		lr := lrs.AppendEmpty()
+		lr.Body().SetStr(pod)
+		if pod != "" {
+			lr.Attributes().PutStr("k8s.pod.name", pod)
+		}
+	}
+
+	gap, err := createGroupByAttrsProcessor(processortest.NewNopSettings(metadata.Type), []string{})
+	require.NoError(t, err)
+	gap.logKeys, err = parseComputedKeys([]ComputedKey{{
+		Name:       "app",
+		Expression: `Substring(attributes["k8s.pod.name"], 0, 8)`,
+	}}, ottllog.NewParser, componenttest.NewNopTelemetrySettings())
+	require.NoError(t, err)
+
+	processedLogs, err := gap.processLogs(context.Background(), logs)
+	require.NoError(t, err)
+
+	groups := map[string][]string{}
+	rls := processedLogs.ResourceLogs()
+	for i := 0; i < rls.Len(); i++ {
+		app := ""
+		if v, ok := rls.At(i).Resource().Attributes().Get("app"); ok {
+			app = v.Str()
+		}
+		assert.Equal(t, "localhost", rls.At(i).Resource().Attributes().AsRaw()["host.name"])
+		records := rls.At(i).ScopeLogs().At(0).LogRecords()
+		for j := 0; j < records.Len(); j++ {
+			groups[app] = append(groups[app], records.At(j).Body().Str())
+			// the attributes used by the expression are left untouched
+			if pod := records.At(j).Body().Str(); pod != "" {
+				assert.Equal(t, map[string]any{"k8s.pod.name": pod}, records.At(j).Attributes().AsRaw())
+			}
+		}
+	}
+	assert.Equal(t, map[string][]string{
+		"checkout": {"checkout-7f9c-abc", "checkout-7f9c-def"},
+		"payments": {"payments-5d2e-abc"},
+		"":         {""},
+	}, groups)
+}
+
+func TestComputedKeysDataPoints(t *testing.T) {
+	metrics := pmetric.NewMetrics()
+	ms := metrics.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics()
+	for _, name := range []string{"http.server.duration", "http.client.duration", "db.client.duration"} {
+		m := ms.AppendEmpty()
+		m.SetName(name)
+		m.SetEmptyGauge().DataPoints().AppendEmpty().SetIntValue(1)
+	}
+
+	gap, err := createGroupByAttrsProcessor(processortest.NewNopSettings(metadata.Type), []string{})
+	require.NoError(t, err)
+	gap.dataPointKeys, err = parseComputedKeys([]ComputedKey{{
+		Name:       "namespace",
+		Expression: `Split(metric.name, ".")[0]`,
+	}}, ottldatapoint.NewParser, componenttest.NewNopTelemetrySettings())
+	require.NoError(t, err)
+
+	processedMetrics, err := gap.processMetrics(context.Background(), metrics)
+	require.NoError(t, err)
+
+	rms := processedMetrics.ResourceMetrics()
+	require.Equal(t, 2, rms.Len())
+	assert.Equal(t, map[string]any{"namespace": "http"}, rms.At(0).Resource().Attributes().AsRaw())
+	assert.Equal(t, 2, rms.At(0).ScopeMetrics().At(0).Metrics().Len())
+	assert.Equal(t, map[string]any{"namespace": "db"}, rms.At(1).Resource().Attributes().AsRaw())
+	assert.Equal(t, 1, rms.At(1).ScopeMetrics().At(0).Metrics().Len())
+}
+
+func TestPreserveOrder(t *testing.T) {
+	newLogs := func() plog.Logs {
+		logs := plog.NewLogs()
+		lrs := logs.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords()
+		for i, key := range []string{"a", "b", "a", "a", "b"} {
+			lr := lrs.AppendEmpty()
+			lr.Body().SetInt(int64(i))
+			lr.Attributes().PutStr("key", key)
+		}
+		return logs
+	}
+	flatten := func(logs plog.Logs) (keys []string, bodies []int64) {
+		rls := logs.ResourceLogs()
+		for i := 0; i < rls.Len(); i++ {
+			key, _ := rls.At(i).Resource().Attributes().Get("key")
+			keys = append(keys, key.Str())
+			records := rls.At(i).ScopeLogs().At(0).LogRecords()
+			for j := 0; j < records.Len(); j++ {
+				bodies = append(bodies, records.At(j).Body().Int())
+			}
+		}
+		return keys, bodies
+	}
+
+	gap, err := createGroupByAttrsProcessor(processortest.NewNopSettings(metadata.Type), []string{"key"})
+	require.NoError(t, err)
+
+	processedLogs, err := gap.processLogs(context.Background(), newLogs())
+	require.NoError(t, err)
+	keys, bodies := flatten(processedLogs)
+	assert.Equal(t, []string{"a", "b"}, keys)
+	assert.Equal(t, []int64{0, 2, 3, 1, 4}, bodies)
+
+	gap.preserveOrder = true
+	processedLogs, err = gap.processLogs(context.Background(), newLogs())
+	require.NoError(t, err)
+	keys, bodies = flatten(processedLogs)
+	assert.Equal(t, []string{"a", "b", "a", "b"}, keys)
+	assert.Equal(t, []int64{0, 1, 2, 3, 4}, bodies)
+}
@@ -5,6 +5,8 @@ package intervalprocessor // import "github.com/open-telemetry/opentelemetry-col
 
 import (
 	"errors"
+	"fmt"
+	"regexp"
 	"time"
 
 	"go.opentelemetry.io/collector/component"
@@ -21,6 +23,20 @@ type Config struct {
 	// PassThrough is a configuration that determines whether gauge and summary metrics should be passed through
 	// as they are or aggregated.
 	PassThrough PassThrough `mapstructure:"pass_through"`
+	// Overrides are the intervals at which the metrics matching their name patterns are aggregated,
+	// instead of Interval. The first matching override is used.
+	Overrides []IntervalOverride `mapstructure:"overrides"`
+	// AlignToWallClock is a flag that determines whether the metrics are exported at the multiples
+	// of the interval since the Unix epoch, with the timestamps of their data points set to the
+	// boundary of the interval.
+	AlignToWallClock bool `mapstructure:"align_to_wall_clock"`
+}
+
+type IntervalOverride struct {
+	// Metrics is a list of regular expressions matched against the metric names.
+	Metrics []string `mapstructure:"metrics"`
+	// Interval is the time interval at which the processor will aggregate the matching metrics.
+	Interval time.Duration `mapstructure:"interval"`
 }
 
 type PassThrough struct {
@@ -39,5 +55,19 @@ func (config *Config) Validate() error {
 		return ErrInvalidIntervalValue
 	}
 
+	for i, override := range config.Overrides {
+		if override.Interval <= 0 {
+			return fmt.Errorf("overrides[%d]: %w", i, ErrInvalidIntervalValue)
+		}
+		if len(override.Metrics) == 0 {
+			return fmt.Errorf("overrides[%d]: metrics must not be empty", i)
+		}
+		for _, pattern := range override.Metrics {
+			if _, err := regexp.Compile(pattern); err != nil {
+				return fmt.Errorf("overrides[%d]: invalid metric name pattern %q: %w", i, pattern, err)
+			}
+		}
+	}
+
 	return nil
 }
@@ -0,0 +1,72 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package intervalprocessor
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name   string
+		config *Config
+		err    string
+	}{
+		{
+			name:   "default",
+			config: createDefaultConfig().(*Config),
+		},
+		{
+			name:   "invalid interval",
+			config: &Config{},
+			err:    "invalid interval value",
+		},
+		{
+			name: "overrides",
+			config: &Config{
+				Interval:         time.Minute,
+				Overrides:        []IntervalOverride{{Metrics: []string{`^http\.`}, Interval: 10 * time.Second}},
+				AlignToWallClock: true,
+			},
+		},
+		{
+			name: "invalid override interval",
+			config: &Config{
+				Interval:  time.Minute,
+				Overrides: []IntervalOverride{{Metrics: []string{`^http\.`}}},
+			},
+			err: "overrides[0]: invalid interval value",
+		},
+		{
+			name: "missing override metrics",
+			config: &Config{
+				Interval:  time.Minute,
+				Overrides: []IntervalOverride{{Interval: 10 * time.Second}},
+			},
+			err: "overrides[0]: metrics must not be empty",
+		},
+		{
+			name: "invalid override pattern",
+			config: &Config{
+				Interval:  time.Minute,
+				Overrides: []IntervalOverride{{Metrics: []string{"http("}, Interval: 10 * time.Second}},
+			},
+			err: "overrides[0]: invalid metric name pattern \"http(\": error parsing regexp: missing closing ): `http(`",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.config.Validate()
+			if tt.err == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.err)
+			}
+		})
+	}
+}
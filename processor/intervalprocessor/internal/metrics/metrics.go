@@ -15,6 +15,7 @@ type DataPointSlice[DP DataPoint[DP]] interface {
 
 type DataPoint[Self any] interface {
 	Timestamp() pcommon.Timestamp
+	SetTimestamp(pcommon.Timestamp)
 	Attributes() pcommon.Map
 	CopyTo(dest Self)
 }
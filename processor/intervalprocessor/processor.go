@@ -7,11 +7,13 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"regexp"
 	"sync"
 	"time"
 
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/processor"
 	"go.uber.org/zap"
@@ -29,6 +31,19 @@ type intervalProcessor struct {
 
 	stateLock sync.Mutex
 
+	// the aggregation of the metrics not matching any override
+	*aggregation
+	overrides []override
+
+	config *Config
+
+	nextConsumer consumer.Metrics
+}
+
+// aggregation holds the metrics aggregated over an interval.
+type aggregation struct {
+	interval time.Duration
+
 	md                 pmetric.Metrics
 	rmLookup           map[identity.Resource]pmetric.ResourceMetrics
 	smLookup           map[identity.Scope]pmetric.ScopeMetrics
@@ -37,15 +52,41 @@ type intervalProcessor struct {
 	histogramLookup    map[identity.Stream]pmetric.HistogramDataPoint
 	expHistogramLookup map[identity.Stream]pmetric.ExponentialHistogramDataPoint
 	summaryLookup      map[identity.Stream]pmetric.SummaryDataPoint
+}
 
-	config *Config
+type override struct {
+	metrics []*regexp.Regexp
+	*aggregation
+}
 
-	nextConsumer consumer.Metrics
+func newAggregation(interval time.Duration) *aggregation {
+	return &aggregation{
+		interval: interval,
+
+		md:                 pmetric.NewMetrics(),
+		rmLookup:           map[identity.Resource]pmetric.ResourceMetrics{},
+		smLookup:           map[identity.Scope]pmetric.ScopeMetrics{},
+		mLookup:            map[identity.Metric]pmetric.Metric{},
+		numberLookup:       map[identity.Stream]pmetric.NumberDataPoint{},
+		histogramLookup:    map[identity.Stream]pmetric.HistogramDataPoint{},
+		expHistogramLookup: map[identity.Stream]pmetric.ExponentialHistogramDataPoint{},
+		summaryLookup:      map[identity.Stream]pmetric.SummaryDataPoint{},
+	}
 }
 
 func newProcessor(config *Config, log *zap.Logger, nextConsumer consumer.Metrics) *intervalProcessor {
 	ctx, cancel := context.WithCancel(context.Background())
 
+	overrides := make([]override, 0, len(config.Overrides))
+	for _, cfg := range config.Overrides {
+		o := override{aggregation: newAggregation(cfg.Interval)}
+		for _, pattern := range cfg.Metrics {
+			// The patterns have been validated with the config
+			o.metrics = append(o.metrics, regexp.MustCompile(pattern))
+		}
+		overrides = append(overrides, o)
+	}
+
 	return &intervalProcessor{
 		ctx:    ctx,
 		cancel: cancel,
@@ -53,14 +94,8 @@ func newProcessor(config *Config, log *zap.Logger, nextConsumer consumer.Metrics
 
 		stateLock: sync.Mutex{},
 
-		md:                 pmetric.NewMetrics(),
-		rmLookup:           map[identity.Resource]pmetric.ResourceMetrics{},
-		smLookup:           map[identity.Scope]pmetric.ScopeMetrics{},
-		mLookup:            map[identity.Metric]pmetric.Metric{},
-		numberLookup:       map[identity.Stream]pmetric.NumberDataPoint{},
-		histogramLookup:    map[identity.Stream]pmetric.HistogramDataPoint{},
-		expHistogramLookup: map[identity.Stream]pmetric.ExponentialHistogramDataPoint{},
-		summaryLookup:      map[identity.Stream]pmetric.SummaryDataPoint{},
+		aggregation: newAggregation(config.Interval),
+		overrides:   overrides,
 
 		config: config,
 
@@ -68,21 +103,60 @@ func newProcessor(config *Config, log *zap.Logger, nextConsumer consumer.Metrics
 	}
 }
 
+// aggregationOf returns the aggregation of the metrics with the given name.
+func (p *intervalProcessor) aggregationOf(name string) *aggregation {
+	for _, o := range p.overrides {
+		for _, pattern := range o.metrics {
+			if pattern.MatchString(name) {
+				return o.aggregation
+			}
+		}
+	}
+	return p.aggregation
+}
+
 func (p *intervalProcessor) Start(_ context.Context, _ component.Host) error {
-	exportTicker := time.NewTicker(p.config.Interval)
-	go func() {
+	go p.schedule(p.aggregation)
+	for _, o := range p.overrides {
+		go p.schedule(o.aggregation)
+	}
+
+	return nil
+}
+
+// schedule exports the metrics of the aggregation at every interval, until the processor is shut down.
+func (p *intervalProcessor) schedule(a *aggregation) {
+	if !p.config.AlignToWallClock {
+		exportTicker := time.NewTicker(a.interval)
+		defer exportTicker.Stop()
 		for {
 			select {
 			case <-p.ctx.Done():
-				exportTicker.Stop()
 				return
-			case <-exportTicker.C:
-				p.exportMetrics()
+			case now := <-exportTicker.C:
+				p.exportMetrics(a, now)
 			}
 		}
-	}()
+	}
 
-	return nil
+	// A timer is set for every boundary, so that the exports don't drift away from them
+	for {
+		now := time.Now()
+		exportTimer := time.NewTimer(time.Duration(boundary(now, a.interval) + int64(a.interval) - now.UnixNano()))
+		select {
+		case <-p.ctx.Done():
+			exportTimer.Stop()
+			return
+		case now := <-exportTimer.C:
+			p.exportMetrics(a, now)
+		}
+	}
+}
+
+// boundary returns the latest multiple of the interval since the Unix epoch, in nanoseconds, before t.
+func boundary(t time.Time, interval time.Duration) int64 {
+	nanos := t.UnixNano()
+	return nanos - nanos%int64(interval)
 }
 
 func (p *intervalProcessor) Shutdown(_ context.Context) error {
@@ -103,22 +177,24 @@ func (p *intervalProcessor) ConsumeMetrics(ctx context.Context, md pmetric.Metri
 	md.ResourceMetrics().RemoveIf(func(rm pmetric.ResourceMetrics) bool {
 		rm.ScopeMetrics().RemoveIf(func(sm pmetric.ScopeMetrics) bool {
 			sm.Metrics().RemoveIf(func(m pmetric.Metric) bool {
+				agg := p.aggregationOf(m.Name())
+
 				switch m.Type() {
 				case pmetric.MetricTypeSummary:
 					if p.config.PassThrough.Summary {
 						return false
 					}
 
-					mClone, metricID := p.getOrCloneMetric(rm, sm, m)
-					aggregateDataPoints(m.Summary().DataPoints(), mClone.Summary().DataPoints(), metricID, p.summaryLookup)
+					mClone, metricID := agg.getOrCloneMetric(rm, sm, m)
+					aggregateDataPoints(m.Summary().DataPoints(), mClone.Summary().DataPoints(), metricID, agg.summaryLookup)
 					return true
 				case pmetric.MetricTypeGauge:
 					if p.config.PassThrough.Gauge {
 						return false
 					}
 
-					mClone, metricID := p.getOrCloneMetric(rm, sm, m)
-					aggregateDataPoints(m.Gauge().DataPoints(), mClone.Gauge().DataPoints(), metricID, p.numberLookup)
+					mClone, metricID := agg.getOrCloneMetric(rm, sm, m)
+					aggregateDataPoints(m.Gauge().DataPoints(), mClone.Gauge().DataPoints(), metricID, agg.numberLookup)
 					return true
 				case pmetric.MetricTypeSum:
 					// Check if we care about this value
@@ -132,10 +208,10 @@ func (p *intervalProcessor) ConsumeMetrics(ctx context.Context, md pmetric.Metri
 						return false
 					}
 
-					mClone, metricID := p.getOrCloneMetric(rm, sm, m)
+					mClone, metricID := agg.getOrCloneMetric(rm, sm, m)
 					cloneSum := mClone.Sum()
 
-					aggregateDataPoints(sum.DataPoints(), cloneSum.DataPoints(), metricID, p.numberLookup)
+					aggregateDataPoints(sum.DataPoints(), cloneSum.DataPoints(), metricID, agg.numberLookup)
 					return true
 				case pmetric.MetricTypeHistogram:
 					histogram := m.Histogram()
@@ -144,10 +220,10 @@ func (p *intervalProcessor) ConsumeMetrics(ctx context.Context, md pmetric.Metri
 						return false
 					}
 
-					mClone, metricID := p.getOrCloneMetric(rm, sm, m)
+					mClone, metricID := agg.getOrCloneMetric(rm, sm, m)
 					cloneHistogram := mClone.Histogram()
 
-					aggregateDataPoints(histogram.DataPoints(), cloneHistogram.DataPoints(), metricID, p.histogramLookup)
+					aggregateDataPoints(histogram.DataPoints(), cloneHistogram.DataPoints(), metricID, agg.histogramLookup)
 					return true
 				case pmetric.MetricTypeExponentialHistogram:
 					expHistogram := m.ExponentialHistogram()
@@ -156,10 +232,10 @@ func (p *intervalProcessor) ConsumeMetrics(ctx context.Context, md pmetric.Metri
 						return false
 					}
 
-					mClone, metricID := p.getOrCloneMetric(rm, sm, m)
+					mClone, metricID := agg.getOrCloneMetric(rm, sm, m)
 					cloneExpHistogram := mClone.ExponentialHistogram()
 
-					aggregateDataPoints(expHistogram.DataPoints(), cloneExpHistogram.DataPoints(), metricID, p.expHistogramLookup)
+					aggregateDataPoints(expHistogram.DataPoints(), cloneExpHistogram.DataPoints(), metricID, agg.expHistogramLookup)
 					return true
 				default:
 					errs = errors.Join(fmt.Errorf("invalid MetricType %d", m.Type()))
@@ -201,24 +277,32 @@ func aggregateDataPoints[DPS metrics.DataPointSlice[DP], DP metrics.DataPoint[DP
 	}
 }
 
-func (p *intervalProcessor) exportMetrics() {
+func (p *intervalProcessor) exportMetrics(a *aggregation, now time.Time) {
 	md := func() pmetric.Metrics {
 		p.stateLock.Lock()
 		defer p.stateLock.Unlock()
 
+		if p.config.AlignToWallClock {
+			timestamp := pcommon.Timestamp(boundary(now, a.interval))
+			alignTimestamps(a.numberLookup, timestamp)
+			alignTimestamps(a.histogramLookup, timestamp)
+			alignTimestamps(a.expHistogramLookup, timestamp)
+			alignTimestamps(a.summaryLookup, timestamp)
+		}
+
 		// ConsumeMetrics() has prepared our own pmetric.Metrics instance ready for us to use
 		// Take it and clear replace it with a new empty one
-		out := p.md
-		p.md = pmetric.NewMetrics()
+		out := a.md
+		a.md = pmetric.NewMetrics()
 
 		// Clear all the lookup references
-		clear(p.rmLookup)
-		clear(p.smLookup)
-		clear(p.mLookup)
-		clear(p.numberLookup)
-		clear(p.histogramLookup)
-		clear(p.expHistogramLookup)
-		clear(p.summaryLookup)
+		clear(a.rmLookup)
+		clear(a.smLookup)
+		clear(a.mLookup)
+		clear(a.numberLookup)
+		clear(a.histogramLookup)
+		clear(a.expHistogramLookup)
+		clear(a.summaryLookup)
 
 		return out
 	}()
@@ -228,32 +312,38 @@ func (p *intervalProcessor) exportMetrics() {
 	}
 }
 
-func (p *intervalProcessor) getOrCloneMetric(rm pmetric.ResourceMetrics, sm pmetric.ScopeMetrics, m pmetric.Metric) (pmetric.Metric, identity.Metric) {
+func alignTimestamps[DP metrics.DataPoint[DP]](dpLookup map[identity.Stream]DP, timestamp pcommon.Timestamp) {
+	for _, dp := range dpLookup {
+		dp.SetTimestamp(timestamp)
+	}
+}
+
+func (a *aggregation) getOrCloneMetric(rm pmetric.ResourceMetrics, sm pmetric.ScopeMetrics, m pmetric.Metric) (pmetric.Metric, identity.Metric) {
 	// Find the ResourceMetrics
 	resID := identity.OfResource(rm.Resource())
-	rmClone, ok := p.rmLookup[resID]
+	rmClone, ok := a.rmLookup[resID]
 	if !ok {
 		// We need to clone it *without* the ScopeMetricsSlice data
-		rmClone = p.md.ResourceMetrics().AppendEmpty()
+		rmClone = a.md.ResourceMetrics().AppendEmpty()
 		rm.Resource().CopyTo(rmClone.Resource())
 		rmClone.SetSchemaUrl(rm.SchemaUrl())
-		p.rmLookup[resID] = rmClone
+		a.rmLookup[resID] = rmClone
 	}
 
 	// Find the ScopeMetrics
 	scopeID := identity.OfScope(resID, sm.Scope())
-	smClone, ok := p.smLookup[scopeID]
+	smClone, ok := a.smLookup[scopeID]
 	if !ok {
 		// We need to clone it *without* the MetricSlice data
 		smClone = rmClone.ScopeMetrics().AppendEmpty()
 		sm.Scope().CopyTo(smClone.Scope())
 		smClone.SetSchemaUrl(sm.SchemaUrl())
-		p.smLookup[scopeID] = smClone
+		a.smLookup[scopeID] = smClone
 	}
 
 	// Find the Metric
 	metricID := identity.OfMetric(scopeID, m)
-	mClone, ok := p.mLookup[metricID]
+	mClone, ok := a.mLookup[metricID]
 	if !ok {
 		// We need to clone it *without* the datapoint data
 		mClone = smClone.Metrics().AppendEmpty()
@@ -284,7 +374,7 @@ func (p *intervalProcessor) getOrCloneMetric(rm pmetric.ResourceMetrics, sm pmet
 			dest.SetAggregationTemporality(src.AggregationTemporality())
 		}
 
-		p.mLookup[metricID] = mClone
+		a.mLookup[metricID] = mClone
 	}
 
 	return mClone, metricID
@@ -9,10 +9,13 @@ import (
 	"testing"
 	"time"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/processor/processortest"
+	"go.uber.org/zap"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/golden"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/pdatatest/pmetrictest"
@@ -70,7 +73,7 @@ func TestAggregation(t *testing.T) {
 			processor := mgp.(*intervalProcessor)
 
 			// Pretend we hit the interval timer and call export
-			processor.exportMetrics()
+			processor.exportMetrics(processor.aggregation, time.Now())
 
 			// All the lookup tables should now be empty
 			require.Empty(t, processor.rmLookup)
@@ -82,7 +85,7 @@ func TestAggregation(t *testing.T) {
 			require.Empty(t, processor.summaryLookup)
 
 			// Exporting again should return nothing
-			processor.exportMetrics()
+			processor.exportMetrics(processor.aggregation, time.Now())
 
 			// Next should have gotten three data sets:
 			// 1. Anything left over from ConsumeMetrics()
@@ -107,3 +110,93 @@ func TestAggregation(t *testing.T) {
 		})
 	}
 }
+
+func cumulativeSum(ms pmetric.MetricSlice, name string, timestamp pcommon.Timestamp, value float64) {
+	m := ms.AppendEmpty()
+	m.SetName(name)
+	sum := m.SetEmptySum()
+	sum.SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	sum.SetIsMonotonic(true)
+	dp := sum.DataPoints().AppendEmpty()
+	dp.SetTimestamp(timestamp)
+	dp.SetDoubleValue(value)
+}
+
+func metricNames(md pmetric.Metrics) []string {
+	var names []string
+	rms := md.ResourceMetrics()
+	for i := 0; i < rms.Len(); i++ {
+		sms := rms.At(i).ScopeMetrics()
+		for j := 0; j < sms.Len(); j++ {
+			ms := sms.At(j).Metrics()
+			for k := 0; k < ms.Len(); k++ {
+				names = append(names, ms.At(k).Name())
+			}
+		}
+	}
+	return names
+}
+
+func TestIntervalOverrides(t *testing.T) {
+	next := &consumertest.MetricsSink{}
+	config := &Config{
+		Interval: time.Minute,
+		Overrides: []IntervalOverride{
+			{Metrics: []string{`^http\.`}, Interval: 10 * time.Second},
+			{Metrics: []string{`^system\.cpu\.`, `^system\.memory\.`}, Interval: 30 * time.Second},
+			{Metrics: []string{`^http\.server\.`}, Interval: 5 * time.Second},
+		},
+	}
+	processor := newProcessor(config, zap.NewNop(), next)
+	require.Len(t, processor.overrides, 3)
+
+	md := pmetric.NewMetrics()
+	ms := md.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics()
+	cumulativeSum(ms, "http.server.requests", 10, 1)
+	cumulativeSum(ms, "system.cpu.time", 10, 2)
+	cumulativeSum(ms, "system.memory.usage", 10, 3)
+	cumulativeSum(ms, "process.cpu.time", 10, 4)
+	require.NoError(t, processor.ConsumeMetrics(context.Background(), md))
+
+	processor.exportMetrics(processor.overrides[0].aggregation, time.Now())
+	processor.exportMetrics(processor.overrides[1].aggregation, time.Now())
+	processor.exportMetrics(processor.overrides[2].aggregation, time.Now())
+	processor.exportMetrics(processor.aggregation, time.Now())
+
+	allMetrics := next.AllMetrics()
+	require.Len(t, allMetrics, 5)
+	assert.Empty(t, metricNames(allMetrics[0]), "all the metrics should be aggregated")
+	// The first matching override is used
+	assert.Equal(t, []string{"http.server.requests"}, metricNames(allMetrics[1]))
+	assert.Equal(t, []string{"system.cpu.time", "system.memory.usage"}, metricNames(allMetrics[2]))
+	assert.Empty(t, metricNames(allMetrics[3]))
+	assert.Equal(t, []string{"process.cpu.time"}, metricNames(allMetrics[4]))
+}
+
+func TestAlignToWallClock(t *testing.T) {
+	next := &consumertest.MetricsSink{}
+	config := &Config{Interval: time.Minute, AlignToWallClock: true}
+	processor := newProcessor(config, zap.NewNop(), next)
+
+	now := time.Date(2024, 5, 17, 10, 42, 1, 500, time.UTC)
+	md := pmetric.NewMetrics()
+	ms := md.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics()
+	cumulativeSum(ms, "cumulative.monotonic.sum", pcommon.NewTimestampFromTime(now.Add(-20*time.Second)), 1)
+	require.NoError(t, processor.ConsumeMetrics(context.Background(), md))
+
+	processor.exportMetrics(processor.aggregation, now)
+
+	allMetrics := next.AllMetrics()
+	require.Len(t, allMetrics, 2)
+	dp := allMetrics[1].ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0).Sum().DataPoints().At(0)
+	assert.Equal(t, time.Date(2024, 5, 17, 10, 42, 0, 0, time.UTC), dp.Timestamp().AsTime())
+	assert.Equal(t, 1.0, dp.DoubleValue())
+}
+
+func TestBoundary(t *testing.T) {
+	now := time.Date(2024, 5, 17, 10, 42, 31, 0, time.UTC)
+
+	assert.Equal(t, time.Date(2024, 5, 17, 10, 42, 30, 0, time.UTC).UnixNano(), boundary(now, 15*time.Second))
+	assert.Equal(t, time.Date(2024, 5, 17, 10, 40, 0, 0, time.UTC).UnixNano(), boundary(now, 5*time.Minute))
+	assert.Equal(t, time.Date(2024, 5, 17, 10, 0, 0, 0, time.UTC).UnixNano(), boundary(now, time.Hour))
+}
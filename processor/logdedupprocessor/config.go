@@ -37,6 +37,7 @@ var (
 	errInvalidInterval          = errors.New("interval must be greater than 0")
 	errCannotExcludeBody        = errors.New("cannot exclude the entire body")
 	errCannotIncludeBody        = errors.New("cannot include the entire body")
+	errInvalidPassthroughCount  = errors.New("passthrough_count must not be negative")
 )
 
 // Config is the config of the processor.
@@ -47,6 +48,9 @@ type Config struct {
 	ExcludeFields     []string      `mapstructure:"exclude_fields"`
 	IncludeFields     []string      `mapstructure:"include_fields"`
 	Conditions        []string      `mapstructure:"conditions"`
+	// PassthroughCount is the number of occurrences of each distinct log forwarded
+	// unmodified at the beginning of each interval, before the next ones are aggregated.
+	PassthroughCount int64 `mapstructure:"passthrough_count"`
 }
 
 // createDefaultConfig returns the default config for the processor.
@@ -71,6 +75,10 @@ func (c Config) Validate() error {
 		return errInvalidLogCountAttribute
 	}
 
+	if c.PassthroughCount < 0 {
+		return errInvalidPassthroughCount
+	}
+
 	_, err := time.LoadLocation(c.Timezone)
 	if err != nil {
 		return fmt.Errorf("timezone is invalid: %w", err)
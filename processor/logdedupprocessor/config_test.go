@@ -64,6 +64,17 @@ func TestValidateConfig(t *testing.T) {
 			},
 			expectedErr: errCannotExcludeBody,
 		},
+		{
+			desc: "invalid PassthroughCount config",
+			cfg: &Config{
+				LogCountAttribute: defaultLogCountAttribute,
+				Interval:          defaultInterval,
+				Timezone:          defaultTimezone,
+				ExcludeFields:     []string{},
+				PassthroughCount:  -1,
+			},
+			expectedErr: errInvalidPassthroughCount,
+		},
 		{
 			desc: "invalid exclude field body",
 			cfg: &Config{
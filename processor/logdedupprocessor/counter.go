@@ -30,16 +30,18 @@ type logAggregator struct {
 	timezone          *time.Location
 	telemetryBuilder  *metadata.TelemetryBuilder
 	dedupFields       []string
+	passthroughCount  int64
 }
 
 // newLogAggregator creates a new LogCounter.
-func newLogAggregator(logCountAttribute string, timezone *time.Location, telemetryBuilder *metadata.TelemetryBuilder, dedupFields []string) *logAggregator {
+func newLogAggregator(logCountAttribute string, timezone *time.Location, telemetryBuilder *metadata.TelemetryBuilder, dedupFields []string, passthroughCount int64) *logAggregator {
 	return &logAggregator{
 		resources:         make(map[uint64]*resourceAggregator),
 		logCountAttribute: logCountAttribute,
 		timezone:          timezone,
 		telemetryBuilder:  telemetryBuilder,
 		dedupFields:       dedupFields,
+		passthroughCount:  passthroughCount,
 	}
 }
 
@@ -56,6 +58,11 @@ func (l *logAggregator) Export(ctx context.Context) plog.Logs {
 			scopeAggregator.scope.CopyTo(sl.Scope())
 
 			for _, logAggregator := range scopeAggregator.logCounters {
+				// Skip the logs that were only passed through
+				if logAggregator.count == 0 {
+					continue
+				}
+
 				// Record aggregated logs records
 				l.telemetryBuilder.DedupProcessorAggregatedLogs.Record(ctx, logAggregator.count)
 
@@ -80,15 +87,16 @@ func (l *logAggregator) Export(ctx context.Context) plog.Logs {
 	return logs
 }
 
-// Add adds the logRecord to the resource aggregator that is identified by the resource attributes
-func (l *logAggregator) Add(resource pcommon.Resource, scope pcommon.InstrumentationScope, logRecord plog.LogRecord) {
+// Add adds the logRecord to the resource aggregator that is identified by the resource attributes.
+// It returns false if the logRecord was not aggregated and must be passed through.
+func (l *logAggregator) Add(resource pcommon.Resource, scope pcommon.InstrumentationScope, logRecord plog.LogRecord) bool {
 	key := getResourceKey(resource)
 	resourceAggregator, ok := l.resources[key]
 	if !ok {
-		resourceAggregator = newResourceAggregator(resource, l.dedupFields)
+		resourceAggregator = newResourceAggregator(resource, l.dedupFields, l.passthroughCount)
 		l.resources[key] = resourceAggregator
 	}
-	resourceAggregator.Add(scope, logRecord)
+	return resourceAggregator.Add(scope, logRecord)
 }
 
 // Reset resets the counter.
@@ -98,56 +106,68 @@ func (l *logAggregator) Reset() {
 
 // resourceAggregator dimensions the counter by resource.
 type resourceAggregator struct {
-	resource      pcommon.Resource
-	scopeCounters map[uint64]*scopeAggregator
-	dedupFields   []string
+	resource         pcommon.Resource
+	scopeCounters    map[uint64]*scopeAggregator
+	dedupFields      []string
+	passthroughCount int64
 }
 
 // newResourceAggregator creates a new ResourceCounter.
-func newResourceAggregator(resource pcommon.Resource, dedupFields []string) *resourceAggregator {
+func newResourceAggregator(resource pcommon.Resource, dedupFields []string, passthroughCount int64) *resourceAggregator {
 	return &resourceAggregator{
-		resource:      resource,
-		scopeCounters: make(map[uint64]*scopeAggregator),
-		dedupFields:   dedupFields,
+		resource:         resource,
+		scopeCounters:    make(map[uint64]*scopeAggregator),
+		dedupFields:      dedupFields,
+		passthroughCount: passthroughCount,
 	}
 }
 
 // Add increments the counter that the logRecord matches.
-func (r *resourceAggregator) Add(scope pcommon.InstrumentationScope, logRecord plog.LogRecord) {
+// It returns false if the logRecord was not aggregated and must be passed through.
+func (r *resourceAggregator) Add(scope pcommon.InstrumentationScope, logRecord plog.LogRecord) bool {
 	key := getScopeKey(scope)
 	scopeAggregator, ok := r.scopeCounters[key]
 	if !ok {
-		scopeAggregator = newScopeAggregator(scope, r.dedupFields)
+		scopeAggregator = newScopeAggregator(scope, r.dedupFields, r.passthroughCount)
 		r.scopeCounters[key] = scopeAggregator
 	}
-	scopeAggregator.Add(logRecord)
+	return scopeAggregator.Add(logRecord)
 }
 
 // scopeAggregator dimensions the counter by scope.
 type scopeAggregator struct {
-	scope       pcommon.InstrumentationScope
-	logCounters map[uint64]*logCounter
-	dedupFields []string
+	scope            pcommon.InstrumentationScope
+	logCounters      map[uint64]*logCounter
+	dedupFields      []string
+	passthroughCount int64
 }
 
 // newScopeAggregator creates a new ScopeCounter.
-func newScopeAggregator(scope pcommon.InstrumentationScope, dedupFields []string) *scopeAggregator {
+func newScopeAggregator(scope pcommon.InstrumentationScope, dedupFields []string, passthroughCount int64) *scopeAggregator {
 	return &scopeAggregator{
-		scope:       scope,
-		logCounters: make(map[uint64]*logCounter),
-		dedupFields: dedupFields,
+		scope:            scope,
+		logCounters:      make(map[uint64]*logCounter),
+		dedupFields:      dedupFields,
+		passthroughCount: passthroughCount,
 	}
 }
 
-// Add increments the counter that the logRecord matches.
-func (s *scopeAggregator) Add(logRecord plog.LogRecord) {
+// Add increments the counter that the logRecord matches, once the first
+// passthroughCount occurrences of the log have been passed through.
+// It returns false if the logRecord was not aggregated and must be passed through.
+func (s *scopeAggregator) Add(logRecord plog.LogRecord) bool {
 	key := getLogKey(logRecord, s.dedupFields)
 	lc, ok := s.logCounters[key]
 	if !ok {
 		lc = newLogCounter(logRecord)
 		s.logCounters[key] = lc
 	}
+	if lc.passedThrough < s.passthroughCount {
+		lc.passedThrough++
+		return false
+	}
 	lc.Increment()
+	return true
 }
 
 // logCounter is a counter for a log record.
@@ -156,6 +176,7 @@ type logCounter struct {
 	firstObservedTimestamp time.Time
 	lastObservedTimestamp  time.Time
 	count                  int64
+	passedThrough          int64
 }
 
 // newLogCounter creates a new AttributeCounter.
@@ -22,7 +22,7 @@ func Test_newLogAggregator(t *testing.T) {
 	telemetryBuilder, err := metadata.NewTelemetryBuilder(componenttest.NewNopTelemetrySettings())
 	require.NoError(t, err)
 
-	aggregator := newLogAggregator(cfg.LogCountAttribute, time.UTC, telemetryBuilder, cfg.IncludeFields)
+	aggregator := newLogAggregator(cfg.LogCountAttribute, time.UTC, telemetryBuilder, cfg.IncludeFields, 0)
 	require.Equal(t, cfg.LogCountAttribute, aggregator.logCountAttribute)
 	require.Equal(t, time.UTC, aggregator.timezone)
 	require.NotNil(t, aggregator.resources)
@@ -44,7 +44,7 @@ func Test_logAggregatorAdd(t *testing.T) {
 	require.NoError(t, err)
 
 	// Setup aggregator
-	aggregator := newLogAggregator("log_count", time.UTC, telemetryBuilder, nil)
+	aggregator := newLogAggregator("log_count", time.UTC, telemetryBuilder, nil, 0)
 	logRecord := plog.NewLogRecord()
 
 	resource := pcommon.NewResource()
@@ -90,16 +90,47 @@ func Test_logAggregatorAdd(t *testing.T) {
 	require.Equal(t, secondExpectedTimestamp, lc.lastObservedTimestamp)
 }
 
+func Test_logAggregatorAddPassthrough(t *testing.T) {
+	telemetryBuilder, err := metadata.NewTelemetryBuilder(componenttest.NewNopTelemetrySettings())
+	require.NoError(t, err)
+
+	aggregator := newLogAggregator("log_count", time.UTC, telemetryBuilder, nil, 2)
+	logRecord := plog.NewLogRecord()
+	logRecord.Body().SetStr("crash")
+	resource := pcommon.NewResource()
+	scope := pcommon.NewInstrumentationScope()
+
+	// The first two occurrences are passed through
+	require.False(t, aggregator.Add(resource, scope, logRecord))
+	require.False(t, aggregator.Add(resource, scope, logRecord))
+	require.Equal(t, 0, aggregator.Export(context.Background()).LogRecordCount())
+
+	// The next ones are aggregated
+	require.True(t, aggregator.Add(resource, scope, logRecord))
+	require.True(t, aggregator.Add(resource, scope, logRecord))
+	require.True(t, aggregator.Add(resource, scope, logRecord))
+
+	logs := aggregator.Export(context.Background())
+	require.Equal(t, 1, logs.LogRecordCount())
+	count, ok := logs.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0).Attributes().Get("log_count")
+	require.True(t, ok)
+	require.Equal(t, int64(3), count.Int())
+
+	// The occurrences are passed through again after a reset
+	aggregator.Reset()
+	require.False(t, aggregator.Add(resource, scope, logRecord))
+}
+
 func Test_logAggregatorReset(t *testing.T) {
 	telemetryBuilder, err := metadata.NewTelemetryBuilder(componenttest.NewNopTelemetrySettings())
 	require.NoError(t, err)
 
-	aggregator := newLogAggregator("log_count", time.UTC, telemetryBuilder, nil)
+	aggregator := newLogAggregator("log_count", time.UTC, telemetryBuilder, nil, 0)
 	for i := 0; i < 2; i++ {
 		resource := pcommon.NewResource()
 		resource.Attributes().PutInt("i", int64(i))
 		key := getResourceKey(resource)
-		aggregator.resources[key] = newResourceAggregator(resource, nil)
+		aggregator.resources[key] = newResourceAggregator(resource, nil, 0)
 	}
 
 	require.Len(t, aggregator.resources, 2)
@@ -129,7 +160,7 @@ func Test_logAggregatorExport(t *testing.T) {
 	telemetryBuilder, err := metadata.NewTelemetryBuilder(componenttest.NewNopTelemetrySettings())
 	require.NoError(t, err)
 
-	aggregator := newLogAggregator(defaultLogCountAttribute, location, telemetryBuilder, nil)
+	aggregator := newLogAggregator(defaultLogCountAttribute, location, telemetryBuilder, nil, 0)
 	resource := pcommon.NewResource()
 	resource.Attributes().PutStr("one", "two")
 	expectedHash := pdatautil.MapHash(resource.Attributes())
@@ -188,7 +219,7 @@ func Test_logAggregatorExport(t *testing.T) {
 func Test_newResourceAggregator(t *testing.T) {
 	resource := pcommon.NewResource()
 	resource.Attributes().PutStr("one", "two")
-	aggregator := newResourceAggregator(resource, nil)
+	aggregator := newResourceAggregator(resource, nil, 0)
 	require.NotNil(t, aggregator.scopeCounters)
 	require.Equal(t, resource, aggregator.resource)
 }
@@ -196,7 +227,7 @@ func Test_newResourceAggregator(t *testing.T) {
 func Test_newScopeCounter(t *testing.T) {
 	scope := pcommon.NewInstrumentationScope()
 	scope.Attributes().PutStr("one", "two")
-	sc := newScopeAggregator(scope, nil)
+	sc := newScopeAggregator(scope, nil, 0)
 	require.Equal(t, scope, sc.scope)
 	require.NotNil(t, sc.logCounters)
 }
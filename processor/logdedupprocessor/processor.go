@@ -23,15 +23,16 @@ import (
 
 // logDedupProcessor is a logDedupProcessor that counts duplicate instances of logs.
 type logDedupProcessor struct {
-	emitInterval time.Duration
-	conditions   *ottl.ConditionSequence[ottllog.TransformContext]
-	aggregator   *logAggregator
-	remover      *fieldRemover
-	nextConsumer consumer.Logs
-	logger       *zap.Logger
-	cancel       context.CancelFunc
-	wg           sync.WaitGroup
-	mux          sync.Mutex
+	emitInterval     time.Duration
+	conditions       *ottl.ConditionSequence[ottllog.TransformContext]
+	aggregator       *logAggregator
+	remover          *fieldRemover
+	passthroughCount int64
+	nextConsumer     consumer.Logs
+	logger           *zap.Logger
+	cancel           context.CancelFunc
+	wg               sync.WaitGroup
+	mux              sync.Mutex
 }
 
 func newProcessor(cfg *Config, nextConsumer consumer.Logs, settings processor.Settings) (*logDedupProcessor, error) {
@@ -47,11 +48,12 @@ func newProcessor(cfg *Config, nextConsumer consumer.Logs, settings processor.Se
 	}
 
 	return &logDedupProcessor{
-		emitInterval: cfg.Interval,
-		aggregator:   newLogAggregator(cfg.LogCountAttribute, timezone, telemetryBuilder, cfg.IncludeFields),
-		remover:      newFieldRemover(cfg.ExcludeFields),
-		nextConsumer: nextConsumer,
-		logger:       settings.Logger,
+		emitInterval:     cfg.Interval,
+		aggregator:       newLogAggregator(cfg.LogCountAttribute, timezone, telemetryBuilder, cfg.IncludeFields, cfg.PassthroughCount),
+		remover:          newFieldRemover(cfg.ExcludeFields),
+		passthroughCount: cfg.PassthroughCount,
+		nextConsumer:     nextConsumer,
+		logger:           settings.Logger,
 	}, nil
 }
 
@@ -97,8 +99,7 @@ func (p *logDedupProcessor) ConsumeLogs(ctx context.Context, pl plog.Logs) error
 
 			logs.RemoveIf(func(logRecord plog.LogRecord) bool {
 				if p.conditions == nil {
-					p.aggregateLog(logRecord, scope, resource)
-					return true
+					return p.aggregateLog(logRecord, scope, resource)
 				}
 
 				logCtx := ottllog.NewTransformContext(logRecord, scope, resource, sl, rl)
@@ -108,14 +109,14 @@ func (p *logDedupProcessor) ConsumeLogs(ctx context.Context, pl plog.Logs) error
 					return false
 				}
 				if logMatch {
-					p.aggregateLog(logRecord, scope, resource)
+					return p.aggregateLog(logRecord, scope, resource)
 				}
-				return logMatch
+				return false
 			})
 		}
 	}
 
-	// immediately consume any logs that didn't match any conditions
+	// immediately consume any logs that didn't match any conditions or were passed through
 	if pl.LogRecordCount() > 0 {
 		err := p.nextConsumer.ConsumeLogs(ctx, pl)
 		if err != nil {
@@ -126,9 +127,17 @@ func (p *logDedupProcessor) ConsumeLogs(ctx context.Context, pl plog.Logs) error
 	return nil
 }
 
-func (p *logDedupProcessor) aggregateLog(logRecord plog.LogRecord, scope pcommon.InstrumentationScope, resource pcommon.Resource) {
+// aggregateLog adds the logRecord to the aggregator. It returns false if the logRecord
+// was not aggregated and must be passed through unmodified.
+func (p *logDedupProcessor) aggregateLog(logRecord plog.LogRecord, scope pcommon.InstrumentationScope, resource pcommon.Resource) bool {
+	if p.passthroughCount > 0 {
+		// The fields are removed from a copy, to pass through the original log
+		aggregated := plog.NewLogRecord()
+		logRecord.CopyTo(aggregated)
+		logRecord = aggregated
+	}
 	p.remover.RemoveFields(logRecord)
-	p.aggregator.Add(resource, scope, logRecord)
+	return p.aggregator.Add(resource, scope, logRecord)
 }
 
 // handleExportInterval sends metrics at the configured interval.
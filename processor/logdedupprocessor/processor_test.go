@@ -410,3 +410,45 @@ func TestProcessorConfigValidate(t *testing.T) {
 	_, err = createLogsProcessor(context.Background(), processortest.NewNopSettings(metadata.Type), validCfg, consumertest.NewNop())
 	require.NoError(t, err)
 }
+
+func TestProcessorPassthrough(t *testing.T) {
+	logsSink := &consumertest.LogsSink{}
+	cfg := &Config{
+		LogCountAttribute: defaultLogCountAttribute,
+		Interval:          defaultInterval,
+		Timezone:          defaultTimezone,
+		ExcludeFields:     []string{"attributes.pod"},
+		PassthroughCount:  2,
+	}
+	p, err := newProcessor(cfg, logsSink, processortest.NewNopSettings(metadata.Type))
+	require.NoError(t, err)
+
+	logs := plog.NewLogs()
+	lrs := logs.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords()
+	for i := 0; i < 5; i++ {
+		lr := lrs.AppendEmpty()
+		lr.Body().SetStr("container crashed")
+		lr.Attributes().PutInt("pod", int64(i))
+	}
+	require.NoError(t, p.ConsumeLogs(context.Background(), logs))
+
+	// The first occurrences are passed through unmodified
+	require.Len(t, logsSink.AllLogs(), 1)
+	passedThrough := logsSink.AllLogs()[0].ResourceLogs().At(0).ScopeLogs().At(0).LogRecords()
+	require.Equal(t, 2, passedThrough.Len())
+	for i := 0; i < passedThrough.Len(); i++ {
+		require.Equal(t, map[string]any{"pod": int64(i)}, passedThrough.At(i).Attributes().AsRaw())
+	}
+
+	// The next ones are summarized at the end of the interval
+	p.exportLogs(context.Background())
+	require.Len(t, logsSink.AllLogs(), 2)
+	summary := logsSink.AllLogs()[1].ResourceLogs().At(0).ScopeLogs().At(0).LogRecords()
+	require.Equal(t, 1, summary.Len())
+	require.Equal(t, "container crashed", summary.At(0).Body().Str())
+	count, ok := summary.At(0).Attributes().Get(defaultLogCountAttribute)
+	require.True(t, ok)
+	require.Equal(t, int64(3), count.Int())
+	_, ok = summary.At(0).Attributes().Get("pod")
+	require.False(t, ok)
+}
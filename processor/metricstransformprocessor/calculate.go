@@ -0,0 +1,104 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package metricstransformprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/metricstransformprocessor"
+
+import (
+	"math"
+
+	"go.opentelemetry.io/collector/pdata/pmetric"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/pdatautil"
+)
+
+// calculate computes a new gauge from the metrics referenced by the transform expression.
+// The data points of the referenced metrics are joined on their attributes: the new metric has a data point
+// for each attribute set found in all the referenced metrics, with the latest of their timestamps.
+// An invalid metric is returned if a referenced metric is missing or is not a gauge or a sum,
+// or if no data point could be computed.
+func calculate(transform internalTransform, metrics pmetric.MetricSlice) pmetric.Metric {
+	expr := transform.Expression
+	operands := make([]pmetric.NumberDataPointSlice, len(expr.metrics))
+	for i, name := range expr.metrics {
+		dps, ok := findNumberDataPoints(metrics, name)
+		if !ok {
+			return pmetric.Metric{}
+		}
+		operands[i] = dps
+	}
+
+	// The data points of the first metric are matched against the ones of the other metrics.
+	byAttrs := make([]map[[16]byte]pmetric.NumberDataPoint, len(operands))
+	for i := 1; i < len(operands); i++ {
+		byAttrs[i] = make(map[[16]byte]pmetric.NumberDataPoint, operands[i].Len())
+		for j := 0; j < operands[i].Len(); j++ {
+			dp := operands[i].At(j)
+			byAttrs[i][pdatautil.MapHash(dp.Attributes())] = dp
+		}
+	}
+
+	calculatedMetric := pmetric.NewMetric()
+	calculatedMetric.SetName(transform.NewName)
+	dps := calculatedMetric.SetEmptyGauge().DataPoints()
+	values := make([]float64, len(operands))
+	for j := 0; j < operands[0].Len(); j++ {
+		first := operands[0].At(j)
+		if first.Flags().NoRecordedValue() {
+			continue
+		}
+		key := pdatautil.MapHash(first.Attributes())
+		timestamp := first.Timestamp()
+		values[0] = numberValue(first)
+		matched := true
+		for i := 1; i < len(operands); i++ {
+			dp, ok := byAttrs[i][key]
+			if !ok || dp.Flags().NoRecordedValue() {
+				matched = false
+				break
+			}
+			values[i] = numberValue(dp)
+			timestamp = max(timestamp, dp.Timestamp())
+		}
+		if !matched {
+			continue
+		}
+
+		value := expr.eval(values)
+		if math.IsNaN(value) || math.IsInf(value, 0) {
+			continue
+		}
+		dp := dps.AppendEmpty()
+		first.Attributes().CopyTo(dp.Attributes())
+		dp.SetTimestamp(timestamp)
+		dp.SetDoubleValue(value)
+	}
+
+	if dps.Len() == 0 {
+		return pmetric.Metric{}
+	}
+	return calculatedMetric
+}
+
+// findNumberDataPoints returns the data points of the first gauge or sum with the given name.
+func findNumberDataPoints(metrics pmetric.MetricSlice, name string) (pmetric.NumberDataPointSlice, bool) {
+	for i := 0; i < metrics.Len(); i++ {
+		metric := metrics.At(i)
+		if metric.Name() != name {
+			continue
+		}
+		switch metric.Type() {
+		case pmetric.MetricTypeGauge:
+			return metric.Gauge().DataPoints(), true
+		case pmetric.MetricTypeSum:
+			return metric.Sum().DataPoints(), true
+		}
+	}
+	return pmetric.NumberDataPointSlice{}, false
+}
+
+func numberValue(dp pmetric.NumberDataPoint) float64 {
+	if dp.ValueType() == pmetric.NumberDataPointValueTypeInt {
+		return float64(dp.IntValue())
+	}
+	return dp.DoubleValue()
+}
@@ -38,6 +38,12 @@ const (
 
 	// submatchCaseFieldName is the mapstructure field name for submatchCase field
 	submatchCaseFieldName = "submatch_case"
+
+	// expressionFieldName is the mapstructure field name for Expression field
+	expressionFieldName = "expression"
+
+	// valueFieldName is the mapstructure field name for Value field
+	valueFieldName = "value"
 )
 
 // Config defines configuration for Resource processor.
@@ -54,15 +60,16 @@ type transform struct {
 	// --- SPECIFY WHICH METRIC(S) TO MATCH ---
 
 	// MetricIncludeFilter is used to select the metric(s) to operate on.
-	// REQUIRED
+	// REQUIRED unless Action is CALCULATE.
 	MetricIncludeFilter filterConfig `mapstructure:",squash"`
 
 	// --- SPECIFY THE ACTION TO TAKE ON THE MATCHED METRIC(S) ---
 
 	// Action specifies the action performed on the matched metric. Action specifies
 	// if the operations (specified below) are performed on metrics in place (update),
-	// on an inserted clone (insert), or on a new combined metric that includes all
-	// data points from the set of matching metrics (combine).
+	// on an inserted clone (insert), on a new combined metric that includes all
+	// data points from the set of matching metrics (combine), or on a new metric
+	// computed from other metrics (calculate).
 	// REQUIRED
 	Action ConfigAction `mapstructure:"action"`
 
@@ -83,6 +90,10 @@ type transform struct {
 	// SubmatchCase specifies what case to use for label values created from regexp submatches.
 	SubmatchCase submatchCase `mapstructure:"submatch_case"`
 
+	// Expression specifies the arithmetic expression over metrics that computes the new metric.
+	// REQUIRED only if Action is CALCULATE.
+	Expression string `mapstructure:"expression"`
+
 	// Operations contains a list of operations that will be performed on the resulting metric(s).
 	Operations []operation `mapstructure:"operations"`
 }
@@ -138,12 +149,16 @@ type operation struct {
 
 // valueAction renames label values.
 type valueAction struct {
-	// Value specifies the current label value.
+	// Value specifies the current label value, or a regexp matching it if MatchType is regexp.
 	Value string `mapstructure:"value"`
 
-	// NewValue specifies the label value to rename to.
+	// NewValue specifies the label value to rename to. If MatchType is regexp, capturing groups
+	// of Value can be referenced, e.g. $1 or ${name}.
 	NewValue string `mapstructure:"new_value"`
 
+	// MatchType determines how Value is matched: <strict|regexp>. Defaults to strict.
+	MatchType matchType `mapstructure:"match_type"`
+
 	// prevent unkeyed literal initialization
 	_ struct{}
 }
@@ -163,9 +178,12 @@ const (
 
 	// Group groups multiple metrics matching the predicate into multiple ResourceMetrics messages
 	Group ConfigAction = "group"
+
+	// Calculate inserts a new metric computed by an arithmetic expression over other metrics.
+	Calculate ConfigAction = "calculate"
 )
 
-var actions = []ConfigAction{Insert, Update, Combine, Group}
+var actions = []ConfigAction{Insert, Update, Combine, Group, Calculate}
 
 func (ca ConfigAction) isValid() bool {
 	for _, configAction := range actions {
@@ -93,6 +93,7 @@ func TestLoadConfig(t *testing.T) {
 								NewLabel: "new_label_key",
 								ValueActions: []valueAction{
 									{Value: "label1", NewValue: "new_label1"},
+									{Value: `^label(\d+)$`, NewValue: "new_label${1}", MatchType: "regexp"},
 								},
 							},
 							{
@@ -140,6 +141,11 @@ func TestLoadConfig(t *testing.T) {
 						Action:              "group",
 						GroupResourceLabels: map[string]string{"metric_group": "2"},
 					},
+					{
+						Action:     "calculate",
+						NewName:    "error_ratio",
+						Expression: "errors / requests",
+					},
 				},
 			},
 		},
@@ -0,0 +1,226 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package metricstransformprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/metricstransformprocessor"
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+)
+
+// expression is an arithmetic expression over metrics, e.g. `errors / requests * 100`.
+// Metrics are referenced by name, names that are not identifiers can be double-quoted.
+type expression struct {
+	root exprNode
+	// metrics holds the names of the referenced metrics, each once, in order of appearance.
+	metrics []string
+}
+
+// exprNode is a node of the expression tree, evaluated with the values of the referenced metrics.
+type exprNode interface {
+	eval(values []float64) float64
+}
+
+type exprNumber float64
+
+func (n exprNumber) eval([]float64) float64 {
+	return float64(n)
+}
+
+// exprMetric is the index of a referenced metric in expression.metrics.
+type exprMetric int
+
+func (m exprMetric) eval(values []float64) float64 {
+	return values[m]
+}
+
+type exprNegation struct {
+	operand exprNode
+}
+
+func (n exprNegation) eval(values []float64) float64 {
+	return -n.operand.eval(values)
+}
+
+type exprBinary struct {
+	operator    byte
+	left, right exprNode
+}
+
+func (b exprBinary) eval(values []float64) float64 {
+	left, right := b.left.eval(values), b.right.eval(values)
+	switch b.operator {
+	case '+':
+		return left + right
+	case '-':
+		return left - right
+	case '*':
+		return left * right
+	default:
+		return left / right
+	}
+}
+
+// eval returns the value of the expression for the given values of expression.metrics.
+// Dividing by zero results in an infinite or NaN value.
+func (e *expression) eval(values []float64) float64 {
+	return e.root.eval(values)
+}
+
+// parseExpression parses an expression made of numbers, metric names, the
+// operators +, -, *, / and parentheses.
+func parseExpression(input string) (*expression, error) {
+	p := &exprParser{input: input, indexes: map[string]int{}}
+	root, err := p.parseSum()
+	if err != nil {
+		return nil, err
+	}
+	p.skipSpaces()
+	if p.pos < len(p.input) {
+		return nil, fmt.Errorf("unexpected %q at position %d", p.input[p.pos], p.pos)
+	}
+	if len(p.metrics) == 0 {
+		return nil, errors.New("expression must reference at least one metric")
+	}
+	return &expression{root: root, metrics: p.metrics}, nil
+}
+
+type exprParser struct {
+	input   string
+	pos     int
+	metrics []string
+	indexes map[string]int
+}
+
+func (p *exprParser) skipSpaces() {
+	for p.pos < len(p.input) && (p.input[p.pos] == ' ' || p.input[p.pos] == '\t' || p.input[p.pos] == '\n') {
+		p.pos++
+	}
+}
+
+// peek returns the next non-space character, or 0 at the end of the input.
+func (p *exprParser) peek() byte {
+	p.skipSpaces()
+	if p.pos == len(p.input) {
+		return 0
+	}
+	return p.input[p.pos]
+}
+
+// parseSum parses terms separated by + and -.
+func (p *exprParser) parseSum() (exprNode, error) {
+	left, err := p.parseProduct()
+	if err != nil {
+		return nil, err
+	}
+	for c := p.peek(); c == '+' || c == '-'; c = p.peek() {
+		p.pos++
+		right, err := p.parseProduct()
+		if err != nil {
+			return nil, err
+		}
+		left = exprBinary{operator: c, left: left, right: right}
+	}
+	return left, nil
+}
+
+// parseProduct parses factors separated by * and /.
+func (p *exprParser) parseProduct() (exprNode, error) {
+	left, err := p.parseFactor()
+	if err != nil {
+		return nil, err
+	}
+	for c := p.peek(); c == '*' || c == '/'; c = p.peek() {
+		p.pos++
+		right, err := p.parseFactor()
+		if err != nil {
+			return nil, err
+		}
+		left = exprBinary{operator: c, left: left, right: right}
+	}
+	return left, nil
+}
+
+// parseFactor parses a number, a metric name, a negation or a parenthesized expression.
+func (p *exprParser) parseFactor() (exprNode, error) {
+	c := p.peek()
+	switch {
+	case c == 0:
+		return nil, errors.New("unexpected end of expression")
+	case c == '-':
+		p.pos++
+		operand, err := p.parseFactor()
+		if err != nil {
+			return nil, err
+		}
+		return exprNegation{operand: operand}, nil
+	case c == '(':
+		p.pos++
+		node, err := p.parseSum()
+		if err != nil {
+			return nil, err
+		}
+		if p.peek() != ')' {
+			return nil, fmt.Errorf("missing closing parenthesis at position %d", p.pos)
+		}
+		p.pos++
+		return node, nil
+	case c == '"':
+		start := p.pos + 1
+		end := start
+		for end < len(p.input) && p.input[end] != '"' {
+			end++
+		}
+		if end == len(p.input) {
+			return nil, fmt.Errorf("unterminated metric name at position %d", p.pos)
+		}
+		p.pos = end + 1
+		return p.metric(p.input[start:end]), nil
+	case isDigit(c) || c == '.':
+		start := p.pos
+		for p.pos < len(p.input) && (isDigit(p.input[p.pos]) || p.input[p.pos] == '.') {
+			p.pos++
+		}
+		if p.pos < len(p.input) && (p.input[p.pos] == 'e' || p.input[p.pos] == 'E') {
+			p.pos++
+			if p.pos < len(p.input) && (p.input[p.pos] == '+' || p.input[p.pos] == '-') {
+				p.pos++
+			}
+			for p.pos < len(p.input) && isDigit(p.input[p.pos]) {
+				p.pos++
+			}
+		}
+		value, err := strconv.ParseFloat(p.input[start:p.pos], 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid number %q at position %d", p.input[start:p.pos], start)
+		}
+		return exprNumber(value), nil
+	case isNameStart(c):
+		start := p.pos
+		for p.pos < len(p.input) && (isNameStart(p.input[p.pos]) || isDigit(p.input[p.pos]) || p.input[p.pos] == '.' || p.input[p.pos] == ':') {
+			p.pos++
+		}
+		return p.metric(p.input[start:p.pos]), nil
+	}
+	return nil, fmt.Errorf("unexpected %q at position %d", c, p.pos)
+}
+
+// metric returns the node referencing the metric with the given name.
+func (p *exprParser) metric(name string) exprNode {
+	index, ok := p.indexes[name]
+	if !ok {
+		index = len(p.metrics)
+		p.indexes[name] = index
+		p.metrics = append(p.metrics, name)
+	}
+	return exprMetric(index)
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+func isNameStart(c byte) bool {
+	return c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
@@ -0,0 +1,97 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package metricstransformprocessor
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseExpression(t *testing.T) {
+	tests := []struct {
+		input   string
+		metrics []string
+		values  []float64
+		want    float64
+	}{
+		{
+			input:   "errors / requests",
+			metrics: []string{"errors", "requests"},
+			values:  []float64{1, 4},
+			want:    0.25,
+		},
+		{
+			input:   "1 - errors / requests",
+			metrics: []string{"errors", "requests"},
+			values:  []float64{1, 4},
+			want:    0.75,
+		},
+		{
+			input:   "(system.memory.usage - cached) * 2",
+			metrics: []string{"system.memory.usage", "cached"},
+			values:  []float64{10, 4},
+			want:    12,
+		},
+		{
+			input:   `"http.server.request-count" + -up:total * 1e2`,
+			metrics: []string{"http.server.request-count", "up:total"},
+			values:  []float64{3, 0.5},
+			want:    -47,
+		},
+		{
+			input:   "a / b / a",
+			metrics: []string{"a", "b"},
+			values:  []float64{8, 2},
+			want:    0.5,
+		},
+		{
+			input:   "a - 1.5 - a",
+			metrics: []string{"a"},
+			values:  []float64{8},
+			want:    -1.5,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			expr, err := parseExpression(tt.input)
+			require.NoError(t, err)
+			assert.Equal(t, tt.metrics, expr.metrics)
+			assert.Equal(t, tt.want, expr.eval(tt.values))
+		})
+	}
+}
+
+func TestParseExpressionDivisionByZero(t *testing.T) {
+	expr, err := parseExpression("a / b")
+	require.NoError(t, err)
+	assert.True(t, math.IsInf(expr.eval([]float64{1, 0}), 1))
+	assert.True(t, math.IsNaN(expr.eval([]float64{0, 0})))
+}
+
+func TestParseExpressionErrors(t *testing.T) {
+	tests := []struct {
+		input string
+		err   string
+	}{
+		{input: "", err: "unexpected end of expression"},
+		{input: "a +", err: "unexpected end of expression"},
+		{input: "a b", err: `unexpected 'b' at position 2`},
+		{input: "(a + b", err: "missing closing parenthesis at position 6"},
+		{input: `"a + b`, err: "unterminated metric name at position 0"},
+		{input: "1.2.3 * a", err: `invalid number "1.2.3" at position 0`},
+		{input: "a % b", err: `unexpected '%' at position 2`},
+		{input: "1 + 2", err: "expression must reference at least one metric"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			_, err := parseExpression(tt.input)
+			assert.EqualError(t, err, tt.err)
+		})
+	}
+}
@@ -62,7 +62,7 @@ func createMetricsProcessor(
 // An error is returned if there are any invalid inputs.
 func validateConfiguration(config *Config) error {
 	for _, transform := range config.Transforms {
-		if transform.MetricIncludeFilter.Include == "" {
+		if transform.MetricIncludeFilter.Include == "" && transform.Action != Calculate {
 			return fmt.Errorf("missing required field %q", includeFieldName)
 		}
 
@@ -89,6 +89,18 @@ func validateConfiguration(config *Config) error {
 			return fmt.Errorf("missing required field %q while %q is %v", groupResourceLabelsFieldName, actionFieldName, Group)
 		}
 
+		if transform.Action == Calculate {
+			if transform.NewName == "" {
+				return fmt.Errorf("missing required field %q while %q is %v", newNameFieldName, actionFieldName, Calculate)
+			}
+			if transform.Expression == "" {
+				return fmt.Errorf("missing required field %q while %q is %v", expressionFieldName, actionFieldName, Calculate)
+			}
+			if _, err := parseExpression(transform.Expression); err != nil {
+				return fmt.Errorf("%q, %w", expressionFieldName, err)
+			}
+		}
+
 		if transform.AggregationType != "" && !transform.AggregationType.IsValid() {
 			return fmt.Errorf("%q must be in %q", aggregationTypeFieldName, aggregateutil.AggregationTypes)
 		}
@@ -118,6 +130,17 @@ func validateConfiguration(config *Config) error {
 			if op.AggregationType != "" && !op.AggregationType.IsValid() {
 				return fmt.Errorf("operation %v: %q must be in %q", i+1, aggregationTypeFieldName, aggregateutil.AggregationTypes)
 			}
+
+			for _, va := range op.ValueActions {
+				if va.MatchType != "" && !va.MatchType.isValid() {
+					return fmt.Errorf("operation %v: %q must be in %q", i+1, matchTypeFieldName, matchTypes)
+				}
+				if va.MatchType == regexpMatchType {
+					if _, err := regexp.Compile(va.Value); err != nil {
+						return fmt.Errorf("operation %v: %q, %w", i+1, valueFieldName, err)
+					}
+				}
+			}
 		}
 	}
 	return nil
@@ -145,6 +168,12 @@ func buildHelperConfig(config *Config, version string) ([]internalTransform, err
 			Operations:          make([]internalOperation, len(t.Operations)),
 		}
 
+		if t.Action == Calculate {
+			if helperT.Expression, err = parseExpression(t.Expression); err != nil {
+				return nil, err
+			}
+		}
+
 		for j, op := range t.Operations {
 			op.NewValue = strings.ReplaceAll(op.NewValue, "{{version}}", version)
 
@@ -152,7 +181,7 @@ func buildHelperConfig(config *Config, version string) ([]internalTransform, err
 				configOperation: op,
 			}
 			if len(op.ValueActions) > 0 {
-				mtpOp.valueActionsMapping = createLabelValueMapping(op.ValueActions, version)
+				mtpOp.valueActionsMapping, mtpOp.valueActionsRegexps = createLabelValueMapping(op.ValueActions, version)
 			}
 			switch op.Action {
 			case aggregateLabels:
@@ -186,14 +215,23 @@ func createFilter(filterConfig filterConfig) (internalFilter, error) {
 	return nil, fmt.Errorf("invalid match type: %v", filterConfig.MatchType)
 }
 
-// createLabelValueMapping creates the labelValue rename mappings based on the valueActions
-func createLabelValueMapping(valueActions []valueAction, version string) map[string]string {
+// createLabelValueMapping creates the labelValue rename mappings based on the valueActions,
+// followed by the regexp rename rules in the order of the valueActions
+func createLabelValueMapping(valueActions []valueAction, version string) (map[string]string, []valueRegexp) {
 	mapping := make(map[string]string)
+	var regexps []valueRegexp
 	for i := 0; i < len(valueActions); i++ {
 		valueActions[i].NewValue = strings.ReplaceAll(valueActions[i].NewValue, "{{version}}", version)
+		if valueActions[i].MatchType == regexpMatchType {
+			regexps = append(regexps, valueRegexp{
+				pattern:  regexp.MustCompile(valueActions[i].Value),
+				template: valueActions[i].NewValue,
+			})
+			continue
+		}
 		mapping[valueActions[i].Value] = valueActions[i].NewValue
 	}
-	return mapping
+	return mapping, regexps
 }
 
 // sliceToSet converts slice of strings to set of strings
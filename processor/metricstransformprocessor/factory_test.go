@@ -100,6 +100,16 @@ func TestCreateProcessors(t *testing.T) {
 			succeed:      false,
 			errorMessage: fmt.Sprintf("operation %v: %q must be in %q", 1, aggregationTypeFieldName, aggregateutil.AggregationTypes),
 		},
+		{
+			configName:   "config_invalid_expression.yaml",
+			succeed:      false,
+			errorMessage: fmt.Sprintf("%q, missing closing parenthesis at position 18", expressionFieldName),
+		},
+		{
+			configName:   "config_invalid_value_regexp.yaml",
+			succeed:      false,
+			errorMessage: fmt.Sprintf("operation %v: %q, error parsing regexp: missing closing ]: `[\\da`", 1, valueFieldName),
+		},
 		{
 			configName:   "config_invalid_submatchcase.yaml",
 			succeed:      false,
@@ -187,6 +197,18 @@ func TestFactory_validateConfiguration(t *testing.T) {
 
 	err = validateConfiguration(&v2)
 	assert.EqualError(t, err, "operation 1: missing required field \"new_value\" while \"action\" is add_label")
+
+	v3 := Config{
+		Transforms: []transform{
+			{
+				Action:  Calculate,
+				NewName: "ratio",
+			},
+		},
+	}
+
+	err = validateConfiguration(&v3)
+	assert.EqualError(t, err, "missing required field \"expression\" while \"action\" is calculate")
 }
 
 func TestCreateProcessorsFilledData(t *testing.T) {
@@ -27,16 +27,24 @@ type internalTransform struct {
 	GroupResourceLabels map[string]string
 	AggregationType     aggregateutil.AggregationType
 	SubmatchCase        submatchCase
+	Expression          *expression
 	Operations          []internalOperation
 }
 
 type internalOperation struct {
 	configOperation     operation
 	valueActionsMapping map[string]string
+	valueActionsRegexps []valueRegexp
 	labelSetMap         map[string]bool
 	aggregatedValuesSet map[string]bool
 }
 
+// valueRegexp renames the label values matching pattern to the expansion of template.
+type valueRegexp struct {
+	pattern  *regexp.Regexp
+	template string
+}
+
 type internalFilter interface {
 	getSubexpNames() []string
 	matchMetric(pmetric.Metric) bool
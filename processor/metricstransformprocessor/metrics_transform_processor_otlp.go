@@ -259,6 +259,14 @@ func (mtp *metricsTransformProcessor) processMetrics(_ context.Context, md pmetr
 					if transformMetric(combinedMetric, transform) {
 						combinedMetric.MoveTo(metrics.AppendEmpty())
 					}
+				case Calculate:
+					calculatedMetric := calculate(transform, metrics)
+					if calculatedMetric == (pmetric.Metric{}) {
+						continue
+					}
+					if transformMetric(calculatedMetric, transform) {
+						calculatedMetric.MoveTo(metrics.AppendEmpty())
+					}
 				case Insert:
 					// Save len, so we don't iterate over the newly generated metrics that are appended at the end.
 					mLen := metrics.Len()
@@ -184,6 +184,42 @@ var standardTests = []metricsTransformTest{
 				addIntDatapoint(1, 2, 3, "new/label1-value1").build(),
 		},
 	},
+	{
+		name: "metric_label_value_update_with_regexp",
+		transforms: []internalTransform{
+			{
+				MetricIncludeFilter: internalFilterStrict{include: "metric1"},
+				Action:              Update,
+				Operations: []internalOperation{
+					{
+						configOperation: operation{
+							Action: updateLabel,
+							Label:  "label1",
+						},
+						valueActionsMapping: map[string]string{"/users/me": "/users/me"},
+						valueActionsRegexps: []valueRegexp{
+							{pattern: regexp.MustCompile(`^/users/\d+/(?P<resource>\w+)$`), template: "/users/{id}/${resource}"},
+							{pattern: regexp.MustCompile(`^/users/\w+$`), template: "/users/{id}"},
+						},
+					},
+				},
+			},
+		},
+		in: []pmetric.Metric{
+			metricBuilder(pmetric.MetricTypeGauge, "metric1", "label1").
+				addIntDatapoint(1, 2, 1, "/users/42/orders").
+				addIntDatapoint(1, 2, 2, "/users/42").
+				addIntDatapoint(1, 2, 3, "/users/me").
+				addIntDatapoint(1, 2, 4, "/orders").build(),
+		},
+		out: []pmetric.Metric{
+			metricBuilder(pmetric.MetricTypeGauge, "metric1", "label1").
+				addIntDatapoint(1, 2, 1, "/users/{id}/orders").
+				addIntDatapoint(1, 2, 2, "/users/{id}").
+				addIntDatapoint(1, 2, 3, "/users/me").
+				addIntDatapoint(1, 2, 4, "/orders").build(),
+		},
+	},
 	{
 		name: "metric_label_update_with_regexp_filter",
 		transforms: []internalTransform{
@@ -2063,4 +2099,121 @@ var standardTests = []metricsTransformTest{
 		},
 		out: []pmetric.Metric{},
 	},
+	// CALCULATE
+	{
+		name: "calculate_ratio",
+		transforms: []internalTransform{
+			{
+				MetricIncludeFilter: internalFilterStrict{},
+				Action:              Calculate,
+				NewName:             "error.ratio",
+				Expression:          mustParseExpression("errors / requests * 100"),
+			},
+		},
+		in: []pmetric.Metric{
+			metricBuilder(pmetric.MetricTypeSum, "errors", "method").
+				addIntDatapoint(1, 2, 1, "get").
+				addIntDatapoint(1, 3, 4, "post").build(),
+			metricBuilder(pmetric.MetricTypeSum, "requests", "method").
+				addIntDatapoint(1, 2, 10, "get").
+				addIntDatapoint(1, 2, 8, "post").
+				addIntDatapoint(1, 2, 5, "put").build(),
+		},
+		out: []pmetric.Metric{
+			metricBuilder(pmetric.MetricTypeSum, "errors", "method").
+				addIntDatapoint(1, 2, 1, "get").
+				addIntDatapoint(1, 3, 4, "post").build(),
+			metricBuilder(pmetric.MetricTypeSum, "requests", "method").
+				addIntDatapoint(1, 2, 10, "get").
+				addIntDatapoint(1, 2, 8, "post").
+				addIntDatapoint(1, 2, 5, "put").build(),
+			metricBuilder(pmetric.MetricTypeGauge, "error.ratio", "method").
+				addDoubleDatapoint(0, 2, 10, "get").
+				addDoubleDatapoint(0, 3, 50, "post").build(),
+		},
+	},
+	{
+		name: "calculate_with_operations",
+		transforms: []internalTransform{
+			{
+				MetricIncludeFilter: internalFilterStrict{},
+				Action:              Calculate,
+				NewName:             "memory.free",
+				Expression:          mustParseExpression(`"memory.total" - memory.used`),
+				Operations: []internalOperation{
+					{
+						configOperation: operation{
+							Action:   addLabel,
+							NewLabel: "computed",
+							NewValue: "true",
+						},
+					},
+				},
+			},
+		},
+		in: []pmetric.Metric{
+			metricBuilder(pmetric.MetricTypeGauge, "memory.total").
+				addDoubleDatapoint(1, 2, 16).build(),
+			metricBuilder(pmetric.MetricTypeGauge, "memory.used").
+				addDoubleDatapoint(1, 2, 6.5).build(),
+		},
+		out: []pmetric.Metric{
+			metricBuilder(pmetric.MetricTypeGauge, "memory.total").
+				addDoubleDatapoint(1, 2, 16).build(),
+			metricBuilder(pmetric.MetricTypeGauge, "memory.used").
+				addDoubleDatapoint(1, 2, 6.5).build(),
+			metricBuilder(pmetric.MetricTypeGauge, "memory.free", "computed").
+				addDoubleDatapoint(0, 2, 9.5, "true").build(),
+		},
+	},
+	{
+		name: "calculate_division_by_zero",
+		transforms: []internalTransform{
+			{
+				MetricIncludeFilter: internalFilterStrict{},
+				Action:              Calculate,
+				NewName:             "error.ratio",
+				Expression:          mustParseExpression("errors / requests"),
+			},
+		},
+		in: []pmetric.Metric{
+			metricBuilder(pmetric.MetricTypeSum, "errors").
+				addIntDatapoint(1, 2, 0).build(),
+			metricBuilder(pmetric.MetricTypeSum, "requests").
+				addIntDatapoint(1, 2, 0).build(),
+		},
+		out: []pmetric.Metric{
+			metricBuilder(pmetric.MetricTypeSum, "errors").
+				addIntDatapoint(1, 2, 0).build(),
+			metricBuilder(pmetric.MetricTypeSum, "requests").
+				addIntDatapoint(1, 2, 0).build(),
+		},
+	},
+	{
+		name: "calculate_missing_metric",
+		transforms: []internalTransform{
+			{
+				MetricIncludeFilter: internalFilterStrict{},
+				Action:              Calculate,
+				NewName:             "error.ratio",
+				Expression:          mustParseExpression("errors / requests"),
+			},
+		},
+		in: []pmetric.Metric{
+			metricBuilder(pmetric.MetricTypeSum, "errors").
+				addIntDatapoint(1, 2, 1).build(),
+		},
+		out: []pmetric.Metric{
+			metricBuilder(pmetric.MetricTypeSum, "errors").
+				addIntDatapoint(1, 2, 1).build(),
+		},
+	},
+}
+
+func mustParseExpression(input string) *expression {
+	expr, err := parseExpression(input)
+	if err != nil {
+		panic(err)
+	}
+	return expr
 }
@@ -30,7 +30,19 @@ func updateLabelOp(metric pmetric.Metric, mtpOp internalOperation, f internalFil
 
 		if newValue, ok := mtpOp.valueActionsMapping[attrVal.Str()]; ok {
 			attrs.PutStr(attrKey, newValue)
+		} else if newValue, ok := rewriteLabelValue(mtpOp.valueActionsRegexps, attrVal.Str()); ok {
+			attrs.PutStr(attrKey, newValue)
 		}
 		return true
 	})
 }
+
+// rewriteLabelValue returns the label value renamed by the first regexp value action matching it.
+func rewriteLabelValue(valueRegexps []valueRegexp, value string) (string, bool) {
+	for _, vr := range valueRegexps {
+		if submatches := vr.pattern.FindStringSubmatchIndex(value); submatches != nil {
+			return string(vr.pattern.ExpandString(nil, vr.template, value, submatches)), true
+		}
+	}
+	return "", false
+}
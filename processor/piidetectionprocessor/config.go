@@ -0,0 +1,123 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package piidetectionprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/piidetectionprocessor"
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config/configopaque"
+)
+
+const (
+	actionRedact = "redact"
+	actionHash   = "hash"
+	actionDrop   = "drop"
+	actionTag    = "tag"
+)
+
+// Config defines the configuration for the processor.
+type Config struct {
+	// Detectors is the list of built-in detectors to run: credit_card, email,
+	// iban, uk_nino and us_ssn. All of them are run by default.
+	Detectors []string `mapstructure:"detectors"`
+
+	// CustomDetectors are additional detectors matching a regular expression.
+	CustomDetectors []CustomDetector `mapstructure:"custom_detectors"`
+
+	// Action is what is done with the detected PII, unless overridden for the
+	// detector in Actions.
+	//
+	//   - redact: (default) the PII is replaced with Mask
+	//   - hash: the PII is replaced with its SHA-256 hash, salted with HashSalt
+	//   - drop: the log record or span containing the PII is dropped
+	//   - tag: the PII is left untouched
+	//
+	// In all cases but drop, the names of the detectors which found PII are
+	// added to the TagAttribute attribute of the log record or span.
+	Action string `mapstructure:"action"`
+
+	// Actions overrides Action for some detectors, by detector name.
+	Actions map[string]string `mapstructure:"actions"`
+
+	// Mask is the string replacing the PII with the redact action.
+	Mask string `mapstructure:"mask"`
+
+	// HashSalt is prepended to the PII before hashing it with the hash action.
+	HashSalt configopaque.String `mapstructure:"hash_salt"`
+
+	// TagAttribute is the attribute listing the detectors which found PII.
+	// No attribute is added if empty.
+	TagAttribute string `mapstructure:"tag_attribute"`
+
+	// IgnoredKeys is a list of attribute keys whose values are not scanned.
+	IgnoredKeys []string `mapstructure:"ignored_keys"`
+}
+
+// CustomDetector detects PII with a regular expression.
+type CustomDetector struct {
+	// Name identifies the detector in Actions and in the TagAttribute attribute.
+	Name string `mapstructure:"name"`
+
+	// Pattern is the regular expression matching the PII.
+	Pattern string `mapstructure:"pattern"`
+}
+
+var _ component.Config = (*Config)(nil)
+
+// Validate checks whether the configuration is valid.
+func (cfg *Config) Validate() error {
+	names := make(map[string]struct{}, len(cfg.Detectors)+len(cfg.CustomDetectors))
+	for _, name := range cfg.Detectors {
+		if _, ok := builtinDetectors[name]; !ok {
+			return fmt.Errorf("unknown detector %q", name)
+		}
+		names[name] = struct{}{}
+	}
+	for i, custom := range cfg.CustomDetectors {
+		if custom.Name == "" {
+			return fmt.Errorf("custom_detectors[%d]: name must not be empty", i)
+		}
+		if _, ok := builtinDetectors[custom.Name]; ok {
+			return fmt.Errorf("custom_detectors[%d]: name %q is the name of a built-in detector", i, custom.Name)
+		}
+		if _, ok := names[custom.Name]; ok {
+			return fmt.Errorf("custom_detectors[%d]: duplicate name %q", i, custom.Name)
+		}
+		if custom.Pattern == "" {
+			return fmt.Errorf("custom_detectors[%d]: pattern must not be empty", i)
+		}
+		if _, err := regexp.Compile(custom.Pattern); err != nil {
+			return fmt.Errorf("custom_detectors[%d]: invalid pattern: %w", i, err)
+		}
+		names[custom.Name] = struct{}{}
+	}
+	if len(names) == 0 {
+		return errors.New("at least one detector must be configured")
+	}
+
+	if err := validateAction(cfg.Action); err != nil {
+		return err
+	}
+	for name, action := range cfg.Actions {
+		if _, ok := names[name]; !ok {
+			return fmt.Errorf("actions: detector %q is not configured", name)
+		}
+		if err := validateAction(action); err != nil {
+			return fmt.Errorf("actions: detector %q: %w", name, err)
+		}
+	}
+	return nil
+}
+
+func validateAction(action string) error {
+	switch action {
+	case actionRedact, actionHash, actionDrop, actionTag:
+		return nil
+	default:
+		return fmt.Errorf("invalid action %q, must be one of %s, %s, %s or %s", action, actionRedact, actionHash, actionDrop, actionTag)
+	}
+}
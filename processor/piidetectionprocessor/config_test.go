@@ -0,0 +1,137 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package piidetectionprocessor
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/confmap/confmaptest"
+	"go.opentelemetry.io/collector/confmap/xconfmap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/piidetectionprocessor/internal/metadata"
+)
+
+func TestLoadConfig(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		id           component.ID
+		expected     component.Config
+		errorMessage string
+	}{
+		{
+			id:       component.NewIDWithName(metadata.Type, ""),
+			expected: createDefaultConfig(),
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "custom"),
+			expected: func() component.Config {
+				cfg := createDefaultConfig().(*Config)
+				cfg.Detectors = []string{detectorCreditCard, detectorEmail}
+				cfg.CustomDetectors = []CustomDetector{{Name: "employee_id", Pattern: `EMP-\d{6}`}}
+				cfg.Action = actionHash
+				cfg.Actions = map[string]string{detectorCreditCard: actionDrop, "employee_id": actionTag}
+				cfg.HashSalt = "s3cr3t"
+				cfg.TagAttribute = "compliance.pii"
+				cfg.IgnoredKeys = []string{"user.id"}
+				return cfg
+			}(),
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "unknown_detector"),
+			errorMessage: `unknown detector "passport"`,
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "no_detectors"),
+			errorMessage: "at least one detector must be configured",
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "builtin_name"),
+			errorMessage: `custom_detectors[0]: name "email" is the name of a built-in detector`,
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "invalid_pattern"),
+			errorMessage: "custom_detectors[0]: invalid pattern: error parsing regexp: missing closing ): `EMP-(\\d{6}`",
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "invalid_action"),
+			errorMessage: `invalid action "encrypt", must be one of redact, hash, drop or tag`,
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "unconfigured_action"),
+			errorMessage: `actions: detector "credit_card" is not configured`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.id.String(), func(t *testing.T) {
+			cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
+			require.NoError(t, err)
+
+			factory := NewFactory()
+			cfg := factory.CreateDefaultConfig()
+
+			sub, err := cm.Sub(tt.id.String())
+			require.NoError(t, err)
+			require.NoError(t, sub.Unmarshal(cfg))
+
+			if tt.expected == nil {
+				assert.EqualError(t, xconfmap.Validate(cfg), tt.errorMessage)
+				return
+			}
+			assert.NoError(t, xconfmap.Validate(cfg))
+			assert.Equal(t, tt.expected, cfg)
+		})
+	}
+}
+
+func TestValidateConfig(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(*Config)
+		error  string
+	}{
+		{
+			name: "custom detector without name",
+			modify: func(cfg *Config) {
+				cfg.CustomDetectors = []CustomDetector{{Pattern: `EMP-\d{6}`}}
+			},
+			error: "custom_detectors[0]: name must not be empty",
+		},
+		{
+			name: "custom detector without pattern",
+			modify: func(cfg *Config) {
+				cfg.CustomDetectors = []CustomDetector{{Name: "employee_id"}}
+			},
+			error: "custom_detectors[0]: pattern must not be empty",
+		},
+		{
+			name: "duplicate custom detector",
+			modify: func(cfg *Config) {
+				cfg.CustomDetectors = []CustomDetector{
+					{Name: "employee_id", Pattern: `EMP-\d{6}`},
+					{Name: "employee_id", Pattern: `E\d{8}`},
+				}
+			},
+			error: `custom_detectors[1]: duplicate name "employee_id"`,
+		},
+		{
+			name:   "invalid detector action",
+			modify: func(cfg *Config) { cfg.Actions = map[string]string{detectorEmail: "mask"} },
+			error:  `actions: detector "email": invalid action "mask", must be one of redact, hash, drop or tag`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := createDefaultConfig().(*Config)
+			tt.modify(cfg)
+			assert.EqualError(t, xconfmap.Validate(cfg), tt.error)
+		})
+	}
+}
@@ -0,0 +1,156 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package piidetectionprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/piidetectionprocessor"
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+)
+
+const (
+	detectorCreditCard = "credit_card"
+	detectorEmail      = "email"
+	detectorIBAN       = "iban"
+	detectorUKNINO     = "uk_nino"
+	detectorUSSSN      = "us_ssn"
+)
+
+// builtinDetector matches a kind of PII with a regular expression, and
+// validates the matches to rule out false positives.
+type builtinDetector struct {
+	pattern  *regexp.Regexp
+	validate func(string) bool
+}
+
+var builtinDetectors = map[string]builtinDetector{
+	// 13 to 19 digits starting with a major network prefix, either contiguous
+	// or in groups of 4 (or 4-6-5 for American Express), with a valid Luhn checksum.
+	detectorCreditCard: {
+		pattern:  regexp.MustCompile(`\b(?:[2-6]\d{12,18}|[2-6]\d{3}(?:[ -]\d{4}){3}(?:[ -]\d{1,3})?|3[47]\d{2}[ -]\d{6}[ -]\d{5})\b`),
+		validate: validLuhn,
+	},
+	detectorEmail: {
+		pattern: regexp.MustCompile(`\b[A-Za-z0-9._%+-]+@[A-Za-z0-9-]+(?:\.[A-Za-z0-9-]+)*\.[A-Za-z]{2,}\b`),
+	},
+	// International Bank Account Numbers, either contiguous or in groups of 4,
+	// with valid ISO 13616 check digits.
+	detectorIBAN: {
+		pattern:  regexp.MustCompile(`\b[A-Z]{2}\d{2}(?:[A-Z0-9]{11,30}|(?: [A-Z0-9]{4}){2,7}(?: [A-Z0-9]{1,3})?)\b`),
+		validate: validIBAN,
+	},
+	// UK National Insurance numbers, excluding the prefixes which are never allocated.
+	detectorUKNINO: {
+		pattern:  regexp.MustCompile(`\b[A-CEGHJ-PR-TW-Z][A-CEGHJ-NPR-TW-Z] ?\d{2} ?\d{2} ?\d{2} ?[A-D]\b`),
+		validate: validNINO,
+	},
+	// US Social Security numbers in the dashed format, excluding the area,
+	// group and serial numbers which are never assigned.
+	detectorUSSSN: {
+		pattern:  regexp.MustCompile(`\b\d{3}-\d{2}-\d{4}\b`),
+		validate: validSSN,
+	},
+}
+
+// detector finds a kind of PII in strings.
+type detector struct {
+	name     string
+	pattern  *regexp.Regexp
+	validate func(string) bool
+	action   string
+}
+
+// match is PII found in a string.
+type match struct {
+	start, end int
+	detector   *detector
+}
+
+// findMatches returns the PII found in s, ordered by position. When matches
+// of different detectors overlap, the first and then the longest is kept.
+func findMatches(detectors []*detector, s string) []match {
+	var matches []match
+	for _, d := range detectors {
+		for _, loc := range d.pattern.FindAllStringIndex(s, -1) {
+			if loc[0] == loc[1] {
+				continue
+			}
+			if d.validate != nil && !d.validate(s[loc[0]:loc[1]]) {
+				continue
+			}
+			matches = append(matches, match{start: loc[0], end: loc[1], detector: d})
+		}
+	}
+	if len(matches) <= 1 {
+		return matches
+	}
+
+	sort.SliceStable(matches, func(i, j int) bool {
+		if matches[i].start != matches[j].start {
+			return matches[i].start < matches[j].start
+		}
+		return matches[i].end > matches[j].end
+	})
+	kept := matches[:1]
+	for _, m := range matches[1:] {
+		if m.start >= kept[len(kept)-1].end {
+			kept = append(kept, m)
+		}
+	}
+	return kept
+}
+
+// validLuhn reports whether the digits of s have a valid Luhn checksum.
+func validLuhn(s string) bool {
+	sum, digits := 0, 0
+	for i := len(s) - 1; i >= 0; i-- {
+		if s[i] < '0' || s[i] > '9' {
+			continue
+		}
+		d := int(s[i] - '0')
+		if digits%2 == 1 {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+		digits++
+	}
+	return digits >= 13 && digits <= 19 && sum%10 == 0
+}
+
+// validIBAN reports whether the check digits of the IBAN are valid, i.e. the
+// IBAN with its first 4 characters moved to the end is 1 modulo 97.
+func validIBAN(s string) bool {
+	s = strings.ReplaceAll(s, " ", "")
+	if len(s) < 15 || len(s) > 34 {
+		return false
+	}
+	remainder := 0
+	for _, c := range s[4:] + s[:4] {
+		switch {
+		case c >= '0' && c <= '9':
+			remainder = (remainder*10 + int(c-'0')) % 97
+		case c >= 'A' && c <= 'Z':
+			remainder = (remainder*100 + int(c-'A'+10)) % 97
+		default:
+			return false
+		}
+	}
+	return remainder == 1
+}
+
+func validNINO(s string) bool {
+	switch s[:2] {
+	case "BG", "GB", "KN", "NK", "NT", "TN", "ZZ":
+		return false
+	}
+	return true
+}
+
+func validSSN(s string) bool {
+	area, group, serial := s[0:3], s[4:6], s[7:11]
+	return area != "000" && area != "666" && area[0] != '9' && group != "00" && serial != "0000"
+}
@@ -0,0 +1,137 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package piidetectionprocessor
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newBuiltinDetectors() []*detector {
+	names := []string{detectorCreditCard, detectorEmail, detectorIBAN, detectorUKNINO, detectorUSSSN}
+	detectors := make([]*detector, 0, len(names))
+	for _, name := range names {
+		builtin := builtinDetectors[name]
+		detectors = append(detectors, &detector{name: name, pattern: builtin.pattern, validate: builtin.validate})
+	}
+	return detectors
+}
+
+func TestFindMatches(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected map[string]string
+	}{
+		{
+			name:     "credit card",
+			input:    "paid with 4111111111111111 yesterday",
+			expected: map[string]string{"4111111111111111": detectorCreditCard},
+		},
+		{
+			name:     "grouped credit card",
+			input:    "card: 4111 1111 1111 1111.",
+			expected: map[string]string{"4111 1111 1111 1111": detectorCreditCard},
+		},
+		{
+			name:     "american express",
+			input:    "amex 3782 822463 10005",
+			expected: map[string]string{"3782 822463 10005": detectorCreditCard},
+		},
+		{
+			name:  "invalid luhn checksum",
+			input: "paid with 4111111111111112",
+		},
+		{
+			name:  "timestamp",
+			input: "ts=1700000000000",
+		},
+		{
+			name:     "email",
+			input:    "sent to john.doe+news@mail.example.co.uk, bounced",
+			expected: map[string]string{"john.doe+news@mail.example.co.uk": detectorEmail},
+		},
+		{
+			name:     "iban",
+			input:    "transfer to DE89 3704 0044 0532 0130 00 done",
+			expected: map[string]string{"DE89 3704 0044 0532 0130 00": detectorIBAN},
+		},
+		{
+			name:     "contiguous iban",
+			input:    "iban=GB82WEST12345698765432",
+			expected: map[string]string{"GB82WEST12345698765432": detectorIBAN},
+		},
+		{
+			name:  "invalid iban check digits",
+			input: "iban=GB83WEST12345698765432",
+		},
+		{
+			name:     "uk national insurance number",
+			input:    "nino AB 12 34 56 C and JG103759A",
+			expected: map[string]string{"AB 12 34 56 C": detectorUKNINO, "JG103759A": detectorUKNINO},
+		},
+		{
+			name:  "unallocated uk national insurance number prefix",
+			input: "nino GB123456C",
+		},
+		{
+			name:     "us social security number",
+			input:    "ssn 123-45-6789",
+			expected: map[string]string{"123-45-6789": detectorUSSSN},
+		},
+		{
+			name:  "unassigned us social security numbers",
+			input: "000-12-3456 666-12-3456 900-12-3456 123-00-4567 123-45-0000",
+		},
+		{
+			name:  "several kinds",
+			input: "order 5105105105105100 placed by a@b.io",
+			expected: map[string]string{
+				"5105105105105100": detectorCreditCard,
+				"a@b.io":           detectorEmail,
+			},
+		},
+	}
+
+	detectors := newBuiltinDetectors()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			found := map[string]string{}
+			for _, m := range findMatches(detectors, tt.input) {
+				found[tt.input[m.start:m.end]] = m.detector.name
+			}
+			if tt.expected == nil {
+				assert.Empty(t, found)
+				return
+			}
+			assert.Equal(t, tt.expected, found)
+		})
+	}
+}
+
+func TestFindMatchesOverlap(t *testing.T) {
+	detectors := []*detector{
+		{name: "short", pattern: regexp.MustCompile(`ID-\d{3}`)},
+		{name: "long", pattern: regexp.MustCompile(`ID-\d{3}-[A-Z]+`)},
+		{name: "digits", pattern: regexp.MustCompile(`\d{3}-[A-Z]`)},
+	}
+
+	matches := findMatches(detectors, "ID-123-ABC and ID-456")
+	assert.Len(t, matches, 2)
+	assert.Equal(t, "long", matches[0].detector.name)
+	assert.Equal(t, 0, matches[0].start)
+	assert.Equal(t, 10, matches[0].end)
+	assert.Equal(t, "short", matches[1].detector.name)
+	assert.Equal(t, 15, matches[1].start)
+}
+
+func TestValidLuhn(t *testing.T) {
+	assert.True(t, validLuhn("4111111111111111"))
+	assert.True(t, validLuhn("4111-1111-1111-1111"))
+	assert.True(t, validLuhn("378282246310005"))
+	assert.False(t, validLuhn("4111111111111121"))
+	assert.False(t, validLuhn("0000000000"))
+}
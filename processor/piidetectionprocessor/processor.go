@@ -0,0 +1,220 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package piidetectionprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/piidetectionprocessor"
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"regexp"
+	"sort"
+	"strings"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.opentelemetry.io/collector/processor/processorhelper"
+)
+
+type piiDetectionProcessor struct {
+	detectors    []*detector
+	ignoredKeys  map[string]struct{}
+	mask         string
+	hashSalt     string
+	tagAttribute string
+}
+
+func newPIIDetectionProcessor(cfg *Config) (*piiDetectionProcessor, error) {
+	actionFor := func(name string) string {
+		if action, ok := cfg.Actions[name]; ok {
+			return action
+		}
+		return cfg.Action
+	}
+
+	detectors := make([]*detector, 0, len(cfg.Detectors)+len(cfg.CustomDetectors))
+	for _, name := range cfg.Detectors {
+		builtin := builtinDetectors[name]
+		detectors = append(detectors, &detector{
+			name:     name,
+			pattern:  builtin.pattern,
+			validate: builtin.validate,
+			action:   actionFor(name),
+		})
+	}
+	for _, custom := range cfg.CustomDetectors {
+		pattern, err := regexp.Compile(custom.Pattern)
+		if err != nil {
+			return nil, err
+		}
+		detectors = append(detectors, &detector{
+			name:    custom.Name,
+			pattern: pattern,
+			action:  actionFor(custom.Name),
+		})
+	}
+
+	ignoredKeys := make(map[string]struct{}, len(cfg.IgnoredKeys))
+	for _, key := range cfg.IgnoredKeys {
+		ignoredKeys[key] = struct{}{}
+	}
+
+	return &piiDetectionProcessor{
+		detectors:    detectors,
+		ignoredKeys:  ignoredKeys,
+		mask:         cfg.Mask,
+		hashSalt:     string(cfg.HashSalt),
+		tagAttribute: cfg.TagAttribute,
+	}, nil
+}
+
+// findings collects the PII found in a log record or span.
+type findings struct {
+	detectors map[string]struct{}
+	drop      bool
+}
+
+func (f *findings) add(d *detector) {
+	if f.detectors == nil {
+		f.detectors = make(map[string]struct{})
+	}
+	f.detectors[d.name] = struct{}{}
+	if d.action == actionDrop {
+		f.drop = true
+	}
+}
+
+func (p *piiDetectionProcessor) processLogs(_ context.Context, ld plog.Logs) (plog.Logs, error) {
+	ld.ResourceLogs().RemoveIf(func(rl plog.ResourceLogs) bool {
+		rl.ScopeLogs().RemoveIf(func(sl plog.ScopeLogs) bool {
+			sl.LogRecords().RemoveIf(func(lr plog.LogRecord) bool {
+				var f findings
+				p.scanAttributes(lr.Attributes(), &f)
+				p.scanValue(lr.Body(), &f)
+				if f.drop {
+					return true
+				}
+				p.tag(lr.Attributes(), &f)
+				return false
+			})
+			return sl.LogRecords().Len() == 0
+		})
+		return rl.ScopeLogs().Len() == 0
+	})
+
+	if ld.ResourceLogs().Len() == 0 {
+		return ld, processorhelper.ErrSkipProcessingData
+	}
+	return ld, nil
+}
+
+func (p *piiDetectionProcessor) processTraces(_ context.Context, td ptrace.Traces) (ptrace.Traces, error) {
+	td.ResourceSpans().RemoveIf(func(rs ptrace.ResourceSpans) bool {
+		rs.ScopeSpans().RemoveIf(func(ss ptrace.ScopeSpans) bool {
+			ss.Spans().RemoveIf(func(span ptrace.Span) bool {
+				var f findings
+				p.scanAttributes(span.Attributes(), &f)
+				for i := 0; i < span.Events().Len(); i++ {
+					p.scanAttributes(span.Events().At(i).Attributes(), &f)
+				}
+				if f.drop {
+					return true
+				}
+				p.tag(span.Attributes(), &f)
+				return false
+			})
+			return ss.Spans().Len() == 0
+		})
+		return rs.ScopeSpans().Len() == 0
+	})
+
+	if td.ResourceSpans().Len() == 0 {
+		return td, processorhelper.ErrSkipProcessingData
+	}
+	return td, nil
+}
+
+// scanAttributes scans the attribute values, except the ignored ones and the tag attribute.
+func (p *piiDetectionProcessor) scanAttributes(attrs pcommon.Map, f *findings) {
+	for k, v := range attrs.All() {
+		if k == p.tagAttribute {
+			continue
+		}
+		if _, ignored := p.ignoredKeys[k]; ignored {
+			continue
+		}
+		p.scanValue(v, f)
+	}
+}
+
+// scanValue scans a string value, or the string values nested in a map or slice.
+func (p *piiDetectionProcessor) scanValue(value pcommon.Value, f *findings) {
+	switch value.Type() {
+	case pcommon.ValueTypeStr:
+		if scanned, changed := p.scanString(value.Str(), f); changed {
+			value.SetStr(scanned)
+		}
+	case pcommon.ValueTypeMap:
+		p.scanAttributes(value.Map(), f)
+	case pcommon.ValueTypeSlice:
+		for i := 0; i < value.Slice().Len(); i++ {
+			p.scanValue(value.Slice().At(i), f)
+		}
+	default:
+	}
+}
+
+// scanString applies the action of the detectors to the PII they find in s.
+func (p *piiDetectionProcessor) scanString(s string, f *findings) (string, bool) {
+	matches := findMatches(p.detectors, s)
+	if len(matches) == 0 {
+		return s, false
+	}
+
+	var sb strings.Builder
+	changed := false
+	last := 0
+	for _, m := range matches {
+		f.add(m.detector)
+		sb.WriteString(s[last:m.start])
+		switch m.detector.action {
+		case actionRedact:
+			sb.WriteString(p.mask)
+			changed = true
+		case actionHash:
+			sb.WriteString(p.hash(s[m.start:m.end]))
+			changed = true
+		default:
+			sb.WriteString(s[m.start:m.end])
+		}
+		last = m.end
+	}
+	sb.WriteString(s[last:])
+	return sb.String(), changed
+}
+
+func (p *piiDetectionProcessor) hash(pii string) string {
+	hasher := sha256.New()
+	hasher.Write([]byte(p.hashSalt))
+	hasher.Write([]byte(pii))
+	return hex.EncodeToString(hasher.Sum(nil))
+}
+
+// tag adds the names of the detectors which found PII to the tag attribute.
+func (p *piiDetectionProcessor) tag(attrs pcommon.Map, f *findings) {
+	if p.tagAttribute == "" || len(f.detectors) == 0 {
+		return
+	}
+	names := make([]string, 0, len(f.detectors))
+	for name := range f.detectors {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	tags := attrs.PutEmptySlice(p.tagAttribute)
+	tags.EnsureCapacity(len(names))
+	for _, name := range names {
+		tags.AppendEmpty().SetStr(name)
+	}
+}
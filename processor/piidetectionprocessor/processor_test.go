@@ -0,0 +1,226 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package piidetectionprocessor
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.opentelemetry.io/collector/processor/processorhelper"
+	"go.opentelemetry.io/collector/processor/processortest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/piidetectionprocessor/internal/metadata"
+)
+
+func newTestProcessor(t *testing.T, modify func(*Config)) *piiDetectionProcessor {
+	cfg := createDefaultConfig().(*Config)
+	if modify != nil {
+		modify(cfg)
+	}
+	require.NoError(t, cfg.Validate())
+	p, err := newPIIDetectionProcessor(cfg)
+	require.NoError(t, err)
+	return p
+}
+
+func newLogs(bodies ...string) plog.Logs {
+	ld := plog.NewLogs()
+	lrs := ld.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords()
+	for _, body := range bodies {
+		lrs.AppendEmpty().Body().SetStr(body)
+	}
+	return ld
+}
+
+func detected(attrs pcommon.Map, key string) []any {
+	tags, ok := attrs.Get(key)
+	if !ok {
+		return nil
+	}
+	return tags.Slice().AsRaw()
+}
+
+func TestProcessLogsRedact(t *testing.T) {
+	p := newTestProcessor(t, nil)
+
+	ld := newLogs("payment by john@example.com with card 4111 1111 1111 1111 failed", "nothing to see here")
+	lr := ld.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0)
+	lr.Attributes().PutStr("ssn", "123-45-6789")
+	lr.Attributes().PutInt("status", 402)
+
+	ld, err := p.processLogs(context.Background(), ld)
+	require.NoError(t, err)
+
+	lrs := ld.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords()
+	require.Equal(t, 2, lrs.Len())
+
+	lr = lrs.At(0)
+	assert.Equal(t, "payment by **** with card **** failed", lr.Body().Str())
+	ssn, _ := lr.Attributes().Get("ssn")
+	assert.Equal(t, "****", ssn.Str())
+	status, _ := lr.Attributes().Get("status")
+	assert.Equal(t, int64(402), status.Int())
+	assert.Equal(t, []any{detectorCreditCard, detectorEmail, detectorUSSSN}, detected(lr.Attributes(), "pii.detected"))
+
+	lr = lrs.At(1)
+	assert.Equal(t, "nothing to see here", lr.Body().Str())
+	_, ok := lr.Attributes().Get("pii.detected")
+	assert.False(t, ok)
+}
+
+func TestProcessLogsStructuredBody(t *testing.T) {
+	p := newTestProcessor(t, nil)
+
+	ld := plog.NewLogs()
+	lr := ld.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords().AppendEmpty()
+	require.NoError(t, lr.Body().SetEmptyMap().FromRaw(map[string]any{
+		"user": map[string]any{
+			"email":  "jane@example.org",
+			"emails": []any{"jane.doe@example.org", "unknown"},
+		},
+		"iban": "GB82WEST12345698765432",
+	}))
+
+	ld, err := p.processLogs(context.Background(), ld)
+	require.NoError(t, err)
+
+	lr = ld.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0)
+	assert.Equal(t, map[string]any{
+		"user": map[string]any{
+			"email":  "****",
+			"emails": []any{"****", "unknown"},
+		},
+		"iban": "****",
+	}, lr.Body().Map().AsRaw())
+	assert.Equal(t, []any{detectorEmail, detectorIBAN}, detected(lr.Attributes(), "pii.detected"))
+}
+
+func TestProcessLogsHash(t *testing.T) {
+	p := newTestProcessor(t, func(cfg *Config) {
+		cfg.Action = actionHash
+		cfg.HashSalt = "s3cr3t"
+	})
+
+	ld, err := p.processLogs(context.Background(), newLogs("login by john@example.com"))
+	require.NoError(t, err)
+
+	lr := ld.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0)
+	assert.Equal(t, "login by a2a93d3771ac80adb992167773465a8c2083704f4db95c1c6c89ff5a44871470", lr.Body().Str())
+}
+
+func TestProcessLogsDrop(t *testing.T) {
+	p := newTestProcessor(t, func(cfg *Config) {
+		cfg.Actions = map[string]string{detectorCreditCard: actionDrop}
+	})
+
+	ld, err := p.processLogs(context.Background(), newLogs("card 4111111111111111", "login by john@example.com"))
+	require.NoError(t, err)
+
+	lrs := ld.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords()
+	require.Equal(t, 1, lrs.Len())
+	assert.Equal(t, "login by ****", lrs.At(0).Body().Str())
+
+	_, err = p.processLogs(context.Background(), newLogs("card 4111111111111111"))
+	assert.ErrorIs(t, err, processorhelper.ErrSkipProcessingData)
+}
+
+func TestProcessLogsTag(t *testing.T) {
+	p := newTestProcessor(t, func(cfg *Config) {
+		cfg.Action = actionTag
+		cfg.TagAttribute = "compliance.pii"
+	})
+
+	ld, err := p.processLogs(context.Background(), newLogs("ssn 123-45-6789"))
+	require.NoError(t, err)
+
+	lr := ld.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0)
+	assert.Equal(t, "ssn 123-45-6789", lr.Body().Str())
+	assert.Equal(t, []any{detectorUSSSN}, detected(lr.Attributes(), "compliance.pii"))
+}
+
+func TestProcessLogsIgnoredKeys(t *testing.T) {
+	p := newTestProcessor(t, func(cfg *Config) {
+		cfg.IgnoredKeys = []string{"user.email"}
+	})
+
+	ld := newLogs("signed up")
+	lr := ld.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0)
+	lr.Attributes().PutStr("user.email", "john@example.com")
+
+	ld, err := p.processLogs(context.Background(), ld)
+	require.NoError(t, err)
+
+	lr = ld.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0)
+	email, _ := lr.Attributes().Get("user.email")
+	assert.Equal(t, "john@example.com", email.Str())
+	_, ok := lr.Attributes().Get("pii.detected")
+	assert.False(t, ok)
+}
+
+func TestProcessLogsCustomDetector(t *testing.T) {
+	p := newTestProcessor(t, func(cfg *Config) {
+		cfg.Detectors = []string{detectorEmail}
+		cfg.CustomDetectors = []CustomDetector{{Name: "employee_id", Pattern: `EMP-\d{6}`}}
+		cfg.Mask = "[REDACTED]"
+	})
+
+	ld, err := p.processLogs(context.Background(), newLogs("EMP-004211 (john@example.com) paid with 4111111111111111"))
+	require.NoError(t, err)
+
+	lr := ld.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0)
+	assert.Equal(t, "[REDACTED] ([REDACTED]) paid with 4111111111111111", lr.Body().Str())
+	assert.Equal(t, []any{detectorEmail, "employee_id"}, detected(lr.Attributes(), "pii.detected"))
+}
+
+func TestProcessTraces(t *testing.T) {
+	p := newTestProcessor(t, func(cfg *Config) {
+		cfg.Actions = map[string]string{detectorUSSSN: actionDrop}
+	})
+
+	td := ptrace.NewTraces()
+	spans := td.ResourceSpans().AppendEmpty().ScopeSpans().AppendEmpty().Spans()
+	span := spans.AppendEmpty()
+	span.SetName("checkout")
+	span.Attributes().PutStr("customer", "john@example.com")
+	event := span.Events().AppendEmpty()
+	event.Attributes().PutStr("exception.message", "card 5105105105105100 declined")
+	dropped := spans.AppendEmpty()
+	dropped.SetName("verify")
+	dropped.Attributes().PutStr("ssn", "123-45-6789")
+
+	td, err := p.processTraces(context.Background(), td)
+	require.NoError(t, err)
+
+	spans = td.ResourceSpans().At(0).ScopeSpans().At(0).Spans()
+	require.Equal(t, 1, spans.Len())
+	span = spans.At(0)
+	assert.Equal(t, "checkout", span.Name())
+	customer, _ := span.Attributes().Get("customer")
+	assert.Equal(t, "****", customer.Str())
+	message, _ := span.Events().At(0).Attributes().Get("exception.message")
+	assert.Equal(t, "card **** declined", message.Str())
+	assert.Equal(t, []any{detectorCreditCard, detectorEmail}, detected(span.Attributes(), "pii.detected"))
+}
+
+func TestProcessorPipeline(t *testing.T) {
+	factory := NewFactory()
+	sink := new(consumertest.LogsSink)
+	lp, err := factory.CreateLogs(context.Background(), processortest.NewNopSettings(metadata.Type), factory.CreateDefaultConfig(), sink)
+	require.NoError(t, err)
+	require.NoError(t, lp.Start(context.Background(), componenttest.NewNopHost()))
+	defer func() { require.NoError(t, lp.Shutdown(context.Background())) }()
+
+	require.NoError(t, lp.ConsumeLogs(context.Background(), newLogs("contact john@example.com")))
+
+	require.Len(t, sink.AllLogs(), 1)
+	lr := sink.AllLogs()[0].ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0)
+	assert.Equal(t, "contact ****", lr.Body().Str())
+}
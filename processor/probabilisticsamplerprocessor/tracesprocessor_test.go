@@ -5,6 +5,7 @@ package probabilisticsamplerprocessor
 
 import (
 	"context"
+	"encoding/binary"
 	"encoding/hex"
 	"fmt"
 	"math"
@@ -905,6 +906,74 @@ func Test_tracesamplerprocessor_TraceState(t *testing.T) {
 	}
 }
 
+// Test_tracesamplerprocessor_MultipleTiers checks that consistent
+// sampling decisions compose across collector tiers: two tiers of 50%
+// proportional sampling select the same spans as a single tier of 25%,
+// and the adjusted counts encoded in the tracestate account for the
+// spans that were dropped.
+func Test_tracesamplerprocessor_MultipleTiers(t *testing.T) {
+	const numSpans = 10000
+	rnd := rand.New(rand.NewPCG(1, 2))
+
+	tids := make([]pcommon.TraceID, numSpans)
+	for i := range tids {
+		var tid pcommon.TraceID
+		binary.BigEndian.PutUint64(tid[:8], rnd.Uint64())
+		binary.BigEndian.PutUint64(tid[8:], rnd.Uint64())
+		tids[i] = tid
+	}
+
+	set := processortest.NewNopSettings(metadata.Type)
+	sid := idutils.UInt64ToSpanID(0xfefefefe)
+
+	tieredSink := new(consumertest.TracesSink)
+	tier2, err := newTracesProcessor(context.Background(), set, &Config{
+		SamplingPercentage: 50,
+		Mode:               Proportional,
+		SamplingPrecision:  defaultPrecision,
+	}, tieredSink)
+	require.NoError(t, err)
+	tier1, err := newTracesProcessor(context.Background(), set, &Config{
+		SamplingPercentage: 50,
+		Mode:               Proportional,
+		SamplingPrecision:  defaultPrecision,
+	}, tier2)
+	require.NoError(t, err)
+
+	singleSink := new(consumertest.TracesSink)
+	single, err := newTracesProcessor(context.Background(), set, &Config{
+		SamplingPercentage: 25,
+		Mode:               Proportional,
+		SamplingPrecision:  defaultPrecision,
+	}, singleSink)
+	require.NoError(t, err)
+
+	for _, tid := range tids {
+		require.NoError(t, tier1.ConsumeTraces(context.Background(), makeSingleSpanWithAttrib(tid, sid, "", "", pcommon.NewValueEmpty())))
+		require.NoError(t, single.ConsumeTraces(context.Background(), makeSingleSpanWithAttrib(tid, sid, "", "", pcommon.NewValueEmpty())))
+	}
+
+	sampledIDs := func(sink *consumertest.TracesSink) []pcommon.TraceID {
+		var ids []pcommon.TraceID
+		for _, td := range sink.AllTraces() {
+			ids = append(ids, td.ResourceSpans().At(0).ScopeSpans().At(0).Spans().At(0).TraceID())
+		}
+		return ids
+	}
+	require.NotEmpty(t, sampledIDs(singleSink))
+	assert.Equal(t, sampledIDs(singleSink), sampledIDs(tieredSink))
+
+	var adjusted float64
+	for _, td := range tieredSink.AllTraces() {
+		span := td.ResourceSpans().At(0).ScopeSpans().At(0).Spans().At(0)
+		require.Equal(t, "ot=th:c", span.TraceState().AsRaw())
+		ts, err := sampling.NewW3CTraceState(span.TraceState().AsRaw())
+		require.NoError(t, err)
+		adjusted += ts.OTelValue().AdjustedCount()
+	}
+	assert.InEpsilon(t, numSpans, adjusted, 0.05)
+}
+
 // Test_tracesamplerprocessor_TraceStateErrors checks that when
 // FailClosed is true, certain spans do not pass, with errors.
 func Test_tracesamplerprocessor_TraceStateErrors(t *testing.T) {
@@ -1081,7 +1150,7 @@ func Test_tracesamplerprocessor_HashSeedTraceState(t *testing.T) {
 			for {
 				sink.Reset()
 				tid := idutils.UInt64ToTraceID(rand.Uint64(), rand.Uint64())
-				td := makeSingleSpanWithAttrib(tid, sid, "", "", pcommon.Value{})
+				td := makeSingleSpanWithAttrib(tid, sid, "", "", pcommon.NewValueEmpty())
 
 				err = tsp.ConsumeTraces(context.Background(), td)
 				require.NoError(t, err)
@@ -0,0 +1,105 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ratelimitprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/ratelimitprocessor"
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"go.opentelemetry.io/collector/confmap/xconfmap"
+)
+
+// Strategy defines what happens to the items exceeding the rate limit of their key.
+type Strategy string
+
+const (
+	// StrategyDrop drops the items exceeding the rate limit.
+	StrategyDrop Strategy = "drop"
+	// StrategyTailQueue holds the items exceeding the rate limit in a bounded queue,
+	// and forwards them once their key has tokens available again.
+	StrategyTailQueue Strategy = "tail_queue"
+	// StrategyDownsample forwards a fixed ratio of the items exceeding the rate limit
+	// and drops the others.
+	StrategyDownsample Strategy = "downsample"
+)
+
+// Config defines the configuration for the rate limit processor.
+type Config struct {
+	// KeyAttributes are the resource attributes whose values identify the key being rate limited,
+	// e.g. a tenant or service name. All the data shares a single rate limit when empty.
+	KeyAttributes []string `mapstructure:"key_attributes"`
+
+	// Rate is the number of items (spans, metric data points or log records) per second
+	// allowed for each key.
+	Rate float64 `mapstructure:"rate"`
+
+	// Burst is the maximum number of items allowed at once for each key.
+	// Defaults to the rate, rounded up.
+	Burst int `mapstructure:"burst"`
+
+	// KeyExpiration is the time after which the state of a key that received no items
+	// is dropped, so that the memory used does not grow with every key ever seen.
+	KeyExpiration time.Duration `mapstructure:"key_expiration"`
+
+	// Strategy defines what happens to the items exceeding the rate limit.
+	Strategy Strategy `mapstructure:"strategy"`
+
+	// TailQueue configures the queue used by the tail_queue strategy.
+	TailQueue TailQueueConfig `mapstructure:"tail_queue"`
+
+	// DownsampleRatio is the ratio of the items exceeding the rate limit that are
+	// forwarded by the downsample strategy.
+	DownsampleRatio float64 `mapstructure:"downsample_ratio"`
+
+	// prevent unkeyed literal initialization
+	_ struct{}
+}
+
+// TailQueueConfig defines the configuration of the tail queue.
+type TailQueueConfig struct {
+	// MaxSize is the maximum number of items held in the queue of each key.
+	// Items exceeding the rate limit when the queue is full are dropped.
+	MaxSize int `mapstructure:"max_size"`
+
+	// FlushInterval is the interval at which queued items are forwarded,
+	// within the rate limit of their key.
+	FlushInterval time.Duration `mapstructure:"flush_interval"`
+
+	// prevent unkeyed literal initialization
+	_ struct{}
+}
+
+var _ xconfmap.Validator = (*Config)(nil)
+
+// Validate checks if the processor configuration is valid.
+func (c *Config) Validate() error {
+	if c.Rate <= 0 {
+		return errors.New("rate must be greater than zero")
+	}
+	if c.Burst < 0 {
+		return errors.New("burst cannot be negative")
+	}
+	if c.KeyExpiration <= 0 {
+		return errors.New("key_expiration must be greater than zero")
+	}
+
+	switch c.Strategy {
+	case StrategyDrop:
+	case StrategyTailQueue:
+		if c.TailQueue.MaxSize <= 0 {
+			return errors.New("tail_queue::max_size must be greater than zero")
+		}
+		if c.TailQueue.FlushInterval <= 0 {
+			return errors.New("tail_queue::flush_interval must be greater than zero")
+		}
+	case StrategyDownsample:
+		if c.DownsampleRatio <= 0 || c.DownsampleRatio > 1 {
+			return fmt.Errorf("invalid downsample_ratio %v: must be in the (0, 1] range", c.DownsampleRatio)
+		}
+	default:
+		return fmt.Errorf("unsupported strategy %q", c.Strategy)
+	}
+	return nil
+}
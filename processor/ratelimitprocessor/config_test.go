@@ -0,0 +1,113 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ratelimitprocessor
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/confmap/confmaptest"
+	"go.opentelemetry.io/collector/confmap/xconfmap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/ratelimitprocessor/internal/metadata"
+)
+
+func TestLoadConfig(t *testing.T) {
+	defaultTailQueue := TailQueueConfig{
+		MaxSize:       defaultTailQueueMaxSize,
+		FlushInterval: defaultTailQueueFlushInterval,
+	}
+
+	tests := []struct {
+		id           component.ID
+		expected     component.Config
+		errorMessage string
+	}{
+		{
+			id:       component.NewID(metadata.Type),
+			expected: createDefaultConfig(),
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "drop"),
+			expected: &Config{
+				KeyAttributes:   []string{"tenant"},
+				Rate:            500,
+				Burst:           1000,
+				KeyExpiration:   time.Minute,
+				Strategy:        StrategyDrop,
+				TailQueue:       defaultTailQueue,
+				DownsampleRatio: defaultDownsampleRatio,
+			},
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "tail_queue"),
+			expected: &Config{
+				KeyAttributes: []string{"service.name"},
+				Rate:          100,
+				KeyExpiration: defaultKeyExpiration,
+				Strategy:      StrategyTailQueue,
+				TailQueue: TailQueueConfig{
+					MaxSize:       5000,
+					FlushInterval: 500 * time.Millisecond,
+				},
+				DownsampleRatio: defaultDownsampleRatio,
+			},
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "downsample"),
+			expected: &Config{
+				Rate:            100,
+				KeyExpiration:   defaultKeyExpiration,
+				Strategy:        StrategyDownsample,
+				TailQueue:       defaultTailQueue,
+				DownsampleRatio: 0.25,
+			},
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "invalid_rate"),
+			errorMessage: "rate must be greater than zero",
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "invalid_key_expiration"),
+			errorMessage: "key_expiration must be greater than zero",
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "invalid_strategy"),
+			errorMessage: `unsupported strategy "reject"`,
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "invalid_downsample_ratio"),
+			errorMessage: "invalid downsample_ratio 1.5: must be in the (0, 1] range",
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "invalid_tail_queue"),
+			errorMessage: "tail_queue::max_size must be greater than zero",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.id.String(), func(t *testing.T) {
+			cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
+			require.NoError(t, err)
+
+			factory := NewFactory()
+			cfg := factory.CreateDefaultConfig()
+
+			sub, err := cm.Sub(tt.id.String())
+			require.NoError(t, err)
+			require.NoError(t, sub.Unmarshal(cfg))
+
+			if tt.expected == nil {
+				assert.ErrorContains(t, xconfmap.Validate(cfg), tt.errorMessage)
+				return
+			}
+			assert.NoError(t, xconfmap.Validate(cfg))
+			assert.Equal(t, tt.expected, cfg)
+		})
+	}
+}
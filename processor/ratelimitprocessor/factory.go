@@ -0,0 +1,118 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ratelimitprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/ratelimitprocessor"
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/processor"
+	"go.opentelemetry.io/collector/processor/processorhelper"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/ratelimitprocessor/internal/metadata"
+)
+
+const (
+	defaultRate                   = 1000
+	defaultKeyExpiration          = 5 * time.Minute
+	defaultTailQueueMaxSize       = 10000
+	defaultTailQueueFlushInterval = time.Second
+	defaultDownsampleRatio        = 0.1
+)
+
+var consumerCapabilities = consumer.Capabilities{MutatesData: true}
+
+// NewFactory returns a new factory for the rate limit processor.
+func NewFactory() processor.Factory {
+	return processor.NewFactory(
+		metadata.Type,
+		createDefaultConfig,
+		processor.WithTraces(createTracesProcessor, metadata.TracesStability),
+		processor.WithMetrics(createMetricsProcessor, metadata.MetricsStability),
+		processor.WithLogs(createLogsProcessor, metadata.LogsStability))
+}
+
+func createDefaultConfig() component.Config {
+	return &Config{
+		Rate:          defaultRate,
+		KeyExpiration: defaultKeyExpiration,
+		Strategy:      StrategyDrop,
+		TailQueue: TailQueueConfig{
+			MaxSize:       defaultTailQueueMaxSize,
+			FlushInterval: defaultTailQueueFlushInterval,
+		},
+		DownsampleRatio: defaultDownsampleRatio,
+	}
+}
+
+func createTracesProcessor(
+	ctx context.Context,
+	set processor.Settings,
+	cfg component.Config,
+	nextConsumer consumer.Traces,
+) (processor.Traces, error) {
+	p, err := newRateLimitProcessor(set, cfg.(*Config))
+	if err != nil {
+		return nil, err
+	}
+	p.flush = p.flushTraces(nextConsumer)
+
+	return processorhelper.NewTraces(
+		ctx,
+		set,
+		cfg,
+		nextConsumer,
+		p.processTraces,
+		processorhelper.WithCapabilities(consumerCapabilities),
+		processorhelper.WithStart(p.start),
+		processorhelper.WithShutdown(p.shutdown))
+}
+
+func createMetricsProcessor(
+	ctx context.Context,
+	set processor.Settings,
+	cfg component.Config,
+	nextConsumer consumer.Metrics,
+) (processor.Metrics, error) {
+	p, err := newRateLimitProcessor(set, cfg.(*Config))
+	if err != nil {
+		return nil, err
+	}
+	p.flush = p.flushMetrics(nextConsumer)
+
+	return processorhelper.NewMetrics(
+		ctx,
+		set,
+		cfg,
+		nextConsumer,
+		p.processMetrics,
+		processorhelper.WithCapabilities(consumerCapabilities),
+		processorhelper.WithStart(p.start),
+		processorhelper.WithShutdown(p.shutdown))
+}
+
+func createLogsProcessor(
+	ctx context.Context,
+	set processor.Settings,
+	cfg component.Config,
+	nextConsumer consumer.Logs,
+) (processor.Logs, error) {
+	p, err := newRateLimitProcessor(set, cfg.(*Config))
+	if err != nil {
+		return nil, err
+	}
+	p.flush = p.flushLogs(nextConsumer)
+
+	return processorhelper.NewLogs(
+		ctx,
+		set,
+		cfg,
+		nextConsumer,
+		p.processLogs,
+		processorhelper.WithCapabilities(consumerCapabilities),
+		processorhelper.WithStart(p.start),
+		processorhelper.WithShutdown(p.shutdown))
+}
@@ -0,0 +1,107 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ratelimitprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/ratelimitprocessor"
+
+import (
+	"math"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"golang.org/x/time/rate"
+)
+
+const keySeparator = "\x00"
+
+// keyedLimiter keeps a token bucket per rate limiting key, dropping the keys that
+// have not been seen for the expiration. It is not safe for concurrent use.
+type keyedLimiter struct {
+	limit    rate.Limit
+	burst    int
+	limiters map[string]*rate.Limiter
+
+	// fraction of an item accumulated by each key towards the next
+	// downsampled item to forward
+	carry map[string]float64
+
+	expiration time.Duration
+	lastSeen   map[string]time.Time
+	lastSweep  time.Time
+}
+
+func newKeyedLimiter(ratePerSecond float64, burst int, expiration time.Duration) *keyedLimiter {
+	if burst == 0 {
+		burst = int(math.Ceil(ratePerSecond))
+	}
+	return &keyedLimiter{
+		limit:      rate.Limit(ratePerSecond),
+		burst:      burst,
+		limiters:   make(map[string]*rate.Limiter),
+		carry:      make(map[string]float64),
+		expiration: expiration,
+		lastSeen:   make(map[string]time.Time),
+	}
+}
+
+// take consumes up to n tokens from the bucket of key and returns how many were available.
+func (l *keyedLimiter) take(key string, n int, now time.Time) int {
+	l.sweep(now)
+	l.lastSeen[key] = now
+
+	limiter, ok := l.limiters[key]
+	if !ok {
+		limiter = rate.NewLimiter(l.limit, l.burst)
+		l.limiters[key] = limiter
+	}
+
+	allowed := min(n, int(limiter.TokensAt(now)))
+	if allowed <= 0 {
+		return 0
+	}
+	limiter.AllowN(now, allowed)
+	return allowed
+}
+
+// sample reports whether the next item of key exceeding the rate limit must be forwarded,
+// so that the given ratio of those items is forwarded.
+func (l *keyedLimiter) sample(key string, ratio float64) bool {
+	carry := l.carry[key] + ratio
+	if carry >= 1 {
+		l.carry[key] = carry - 1
+		return true
+	}
+	l.carry[key] = carry
+	return false
+}
+
+// sweep drops the keys that have not been seen for the expiration, at most once per expiration.
+// The bucket of an expired key is full again when it comes back, as long as the expiration is
+// longer than the time needed to refill it.
+func (l *keyedLimiter) sweep(now time.Time) {
+	if now.Sub(l.lastSweep) < l.expiration {
+		return
+	}
+	l.lastSweep = now
+	for key, seen := range l.lastSeen {
+		if now.Sub(seen) >= l.expiration {
+			delete(l.lastSeen, key)
+			delete(l.limiters, key)
+			delete(l.carry, key)
+		}
+	}
+}
+
+// keyFromAttributes builds the rate limiting key from the values of the given resource attributes.
+func keyFromAttributes(attrs pcommon.Map, keys []string) string {
+	if len(keys) == 0 {
+		return ""
+	}
+	values := make([]string, len(keys))
+	for i, k := range keys {
+		if v, ok := attrs.Get(k); ok {
+			values[i] = v.AsString()
+		}
+	}
+	return strings.Join(values, keySeparator)
+}
@@ -0,0 +1,103 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ratelimitprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/ratelimitprocessor"
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/processor/processorhelper"
+	"go.uber.org/zap"
+)
+
+func (p *rateLimitProcessor) processLogs(ctx context.Context, ld plog.Logs) (plog.Logs, error) {
+	var s stats
+	p.mu.Lock()
+	p.limitLogs(ld, time.Now(), false, &s)
+	p.mu.Unlock()
+	p.record(ctx, s)
+
+	if ld.ResourceLogs().Len() == 0 {
+		return ld, processorhelper.ErrSkipProcessingData
+	}
+	return ld, nil
+}
+
+// limitLogs removes the log records exceeding the rate limit of their key. Must be called with the lock held.
+func (p *rateLimitProcessor) limitLogs(ld plog.Logs, now time.Time, draining bool, s *stats) {
+	ld.ResourceLogs().RemoveIf(func(rl plog.ResourceLogs) bool {
+		key := keyFromAttributes(rl.Resource().Attributes(), p.config.KeyAttributes)
+		handle := p.itemHandler(key, logRecordCount(rl), now, draining, s)
+
+		var queuedRL plog.ResourceLogs
+		hasQueuedRL := false
+		rl.ScopeLogs().RemoveIf(func(sl plog.ScopeLogs) bool {
+			var queuedSL plog.ScopeLogs
+			hasQueuedSL := false
+			sl.LogRecords().RemoveIf(func(lr plog.LogRecord) bool {
+				return handle(func() {
+					if !hasQueuedRL {
+						queuedRL = p.logsQueue(key).ResourceLogs().AppendEmpty()
+						rl.Resource().CopyTo(queuedRL.Resource())
+						queuedRL.SetSchemaUrl(rl.SchemaUrl())
+						hasQueuedRL = true
+					}
+					if !hasQueuedSL {
+						queuedSL = queuedRL.ScopeLogs().AppendEmpty()
+						sl.Scope().CopyTo(queuedSL.Scope())
+						queuedSL.SetSchemaUrl(sl.SchemaUrl())
+						hasQueuedSL = true
+					}
+					lr.CopyTo(queuedSL.LogRecords().AppendEmpty())
+				})
+			})
+			return sl.LogRecords().Len() == 0
+		})
+		return rl.ScopeLogs().Len() == 0
+	})
+}
+
+func (p *rateLimitProcessor) logsQueue(key string) plog.Logs {
+	ld, ok := p.queuedLogs[key]
+	if !ok {
+		ld = plog.NewLogs()
+		p.queuedLogs[key] = ld
+	}
+	return ld
+}
+
+func (p *rateLimitProcessor) flushLogs(next consumer.Logs) func(context.Context, bool) {
+	return func(ctx context.Context, force bool) {
+		p.mu.Lock()
+		queued := p.queuedLogs
+		p.queuedLogs = make(map[string]plog.Logs)
+		clear(p.queuedItems)
+		if !force {
+			now := time.Now()
+			for _, ld := range queued {
+				p.limitLogs(ld, now, true, &stats{})
+			}
+		}
+		p.mu.Unlock()
+
+		for _, ld := range queued {
+			if ld.ResourceLogs().Len() == 0 {
+				continue
+			}
+			if err := next.ConsumeLogs(ctx, ld); err != nil {
+				p.logger.Error("Failed to forward queued log records", zap.Error(err))
+			}
+		}
+	}
+}
+
+func logRecordCount(rl plog.ResourceLogs) int {
+	count := 0
+	for i := 0; i < rl.ScopeLogs().Len(); i++ {
+		count += rl.ScopeLogs().At(i).LogRecords().Len()
+	}
+	return count
+}
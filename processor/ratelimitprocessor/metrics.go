@@ -0,0 +1,178 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ratelimitprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/ratelimitprocessor"
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/processor/processorhelper"
+	"go.uber.org/zap"
+)
+
+func (p *rateLimitProcessor) processMetrics(ctx context.Context, md pmetric.Metrics) (pmetric.Metrics, error) {
+	var s stats
+	p.mu.Lock()
+	p.limitMetrics(md, time.Now(), false, &s)
+	p.mu.Unlock()
+	p.record(ctx, s)
+
+	if md.ResourceMetrics().Len() == 0 {
+		return md, processorhelper.ErrSkipProcessingData
+	}
+	return md, nil
+}
+
+// limitMetrics removes the data points exceeding the rate limit of their key. Must be called with the lock held.
+func (p *rateLimitProcessor) limitMetrics(md pmetric.Metrics, now time.Time, draining bool, s *stats) {
+	md.ResourceMetrics().RemoveIf(func(rm pmetric.ResourceMetrics) bool {
+		key := keyFromAttributes(rm.Resource().Attributes(), p.config.KeyAttributes)
+		handle := p.itemHandler(key, dataPointCount(rm), now, draining, s)
+
+		var queuedRM pmetric.ResourceMetrics
+		hasQueuedRM := false
+		rm.ScopeMetrics().RemoveIf(func(sm pmetric.ScopeMetrics) bool {
+			var queuedSM pmetric.ScopeMetrics
+			hasQueuedSM := false
+			sm.Metrics().RemoveIf(func(m pmetric.Metric) bool {
+				var queuedM pmetric.Metric
+				hasQueuedM := false
+				queuedMetric := func() pmetric.Metric {
+					if !hasQueuedRM {
+						queuedRM = p.metricsQueue(key).ResourceMetrics().AppendEmpty()
+						rm.Resource().CopyTo(queuedRM.Resource())
+						queuedRM.SetSchemaUrl(rm.SchemaUrl())
+						hasQueuedRM = true
+					}
+					if !hasQueuedSM {
+						queuedSM = queuedRM.ScopeMetrics().AppendEmpty()
+						sm.Scope().CopyTo(queuedSM.Scope())
+						queuedSM.SetSchemaUrl(sm.SchemaUrl())
+						hasQueuedSM = true
+					}
+					if !hasQueuedM {
+						queuedM = queuedSM.Metrics().AppendEmpty()
+						copyMetricDescription(m, queuedM)
+						hasQueuedM = true
+					}
+					return queuedM
+				}
+
+				switch m.Type() {
+				case pmetric.MetricTypeGauge:
+					m.Gauge().DataPoints().RemoveIf(func(dp pmetric.NumberDataPoint) bool {
+						return handle(func() { dp.CopyTo(queuedMetric().Gauge().DataPoints().AppendEmpty()) })
+					})
+				case pmetric.MetricTypeSum:
+					m.Sum().DataPoints().RemoveIf(func(dp pmetric.NumberDataPoint) bool {
+						return handle(func() { dp.CopyTo(queuedMetric().Sum().DataPoints().AppendEmpty()) })
+					})
+				case pmetric.MetricTypeHistogram:
+					m.Histogram().DataPoints().RemoveIf(func(dp pmetric.HistogramDataPoint) bool {
+						return handle(func() { dp.CopyTo(queuedMetric().Histogram().DataPoints().AppendEmpty()) })
+					})
+				case pmetric.MetricTypeExponentialHistogram:
+					m.ExponentialHistogram().DataPoints().RemoveIf(func(dp pmetric.ExponentialHistogramDataPoint) bool {
+						return handle(func() { dp.CopyTo(queuedMetric().ExponentialHistogram().DataPoints().AppendEmpty()) })
+					})
+				case pmetric.MetricTypeSummary:
+					m.Summary().DataPoints().RemoveIf(func(dp pmetric.SummaryDataPoint) bool {
+						return handle(func() { dp.CopyTo(queuedMetric().Summary().DataPoints().AppendEmpty()) })
+					})
+				default:
+					return false
+				}
+				return metricDataPointCount(m) == 0
+			})
+			return sm.Metrics().Len() == 0
+		})
+		return rm.ScopeMetrics().Len() == 0
+	})
+}
+
+func (p *rateLimitProcessor) metricsQueue(key string) pmetric.Metrics {
+	md, ok := p.queuedMetrics[key]
+	if !ok {
+		md = pmetric.NewMetrics()
+		p.queuedMetrics[key] = md
+	}
+	return md
+}
+
+func (p *rateLimitProcessor) flushMetrics(next consumer.Metrics) func(context.Context, bool) {
+	return func(ctx context.Context, force bool) {
+		p.mu.Lock()
+		queued := p.queuedMetrics
+		p.queuedMetrics = make(map[string]pmetric.Metrics)
+		clear(p.queuedItems)
+		if !force {
+			now := time.Now()
+			for _, md := range queued {
+				p.limitMetrics(md, now, true, &stats{})
+			}
+		}
+		p.mu.Unlock()
+
+		for _, md := range queued {
+			if md.ResourceMetrics().Len() == 0 {
+				continue
+			}
+			if err := next.ConsumeMetrics(ctx, md); err != nil {
+				p.logger.Error("Failed to forward queued data points", zap.Error(err))
+			}
+		}
+	}
+}
+
+// copyMetricDescription copies everything but the data points of src to dst.
+func copyMetricDescription(src, dst pmetric.Metric) {
+	dst.SetName(src.Name())
+	dst.SetDescription(src.Description())
+	dst.SetUnit(src.Unit())
+	src.Metadata().CopyTo(dst.Metadata())
+
+	switch src.Type() {
+	case pmetric.MetricTypeGauge:
+		dst.SetEmptyGauge()
+	case pmetric.MetricTypeSum:
+		sum := dst.SetEmptySum()
+		sum.SetAggregationTemporality(src.Sum().AggregationTemporality())
+		sum.SetIsMonotonic(src.Sum().IsMonotonic())
+	case pmetric.MetricTypeHistogram:
+		dst.SetEmptyHistogram().SetAggregationTemporality(src.Histogram().AggregationTemporality())
+	case pmetric.MetricTypeExponentialHistogram:
+		dst.SetEmptyExponentialHistogram().SetAggregationTemporality(src.ExponentialHistogram().AggregationTemporality())
+	case pmetric.MetricTypeSummary:
+		dst.SetEmptySummary()
+	}
+}
+
+func dataPointCount(rm pmetric.ResourceMetrics) int {
+	count := 0
+	for i := 0; i < rm.ScopeMetrics().Len(); i++ {
+		metrics := rm.ScopeMetrics().At(i).Metrics()
+		for j := 0; j < metrics.Len(); j++ {
+			count += metricDataPointCount(metrics.At(j))
+		}
+	}
+	return count
+}
+
+func metricDataPointCount(m pmetric.Metric) int {
+	switch m.Type() {
+	case pmetric.MetricTypeGauge:
+		return m.Gauge().DataPoints().Len()
+	case pmetric.MetricTypeSum:
+		return m.Sum().DataPoints().Len()
+	case pmetric.MetricTypeHistogram:
+		return m.Histogram().DataPoints().Len()
+	case pmetric.MetricTypeExponentialHistogram:
+		return m.ExponentialHistogram().DataPoints().Len()
+	case pmetric.MetricTypeSummary:
+		return m.Summary().DataPoints().Len()
+	}
+	return 0
+}
@@ -0,0 +1,145 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ratelimitprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/ratelimitprocessor"
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.opentelemetry.io/collector/processor"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/ratelimitprocessor/internal/metadata"
+)
+
+type rateLimitProcessor struct {
+	config           *Config
+	logger           *zap.Logger
+	telemetryBuilder *metadata.TelemetryBuilder
+
+	mu      sync.Mutex
+	limiter *keyedLimiter
+	// number of items held in the tail queue of each key
+	queuedItems   map[string]int
+	queuedTraces  map[string]ptrace.Traces
+	queuedMetrics map[string]pmetric.Metrics
+	queuedLogs    map[string]plog.Logs
+
+	// flush forwards the queued items within the rate limit of their key,
+	// or all of them when force is set.
+	flush func(ctx context.Context, force bool)
+
+	shutdownCh   chan struct{}
+	shutdownOnce sync.Once
+	wg           sync.WaitGroup
+}
+
+// stats counts the items impacted by the rate limit while processing a batch.
+type stats struct {
+	rejected int64
+	queued   int64
+}
+
+func newRateLimitProcessor(set processor.Settings, cfg *Config) (*rateLimitProcessor, error) {
+	telemetryBuilder, err := metadata.NewTelemetryBuilder(set.TelemetrySettings)
+	if err != nil {
+		return nil, err
+	}
+	return &rateLimitProcessor{
+		config:           cfg,
+		logger:           set.Logger,
+		telemetryBuilder: telemetryBuilder,
+		limiter:          newKeyedLimiter(cfg.Rate, cfg.Burst, cfg.KeyExpiration),
+		queuedItems:      make(map[string]int),
+		queuedTraces:     make(map[string]ptrace.Traces),
+		queuedMetrics:    make(map[string]pmetric.Metrics),
+		queuedLogs:       make(map[string]plog.Logs),
+		shutdownCh:       make(chan struct{}),
+	}, nil
+}
+
+func (p *rateLimitProcessor) start(_ context.Context, _ component.Host) error {
+	if p.config.Strategy != StrategyTailQueue || p.flush == nil {
+		return nil
+	}
+
+	p.wg.Add(1)
+	go func() {
+		defer p.wg.Done()
+		ticker := time.NewTicker(p.config.TailQueue.FlushInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-p.shutdownCh:
+				return
+			case <-ticker.C:
+				p.flush(context.Background(), false)
+			}
+		}
+	}()
+	return nil
+}
+
+func (p *rateLimitProcessor) shutdown(ctx context.Context) error {
+	p.shutdownOnce.Do(func() { close(p.shutdownCh) })
+	p.wg.Wait()
+	if p.flush != nil {
+		// Queued items are forwarded regardless of the rate limits rather than lost.
+		p.flush(ctx, true)
+	}
+	p.telemetryBuilder.Shutdown()
+	return nil
+}
+
+// itemHandler returns the function to call, in order, for each of the n items of a resource
+// of the given key. The function reports whether the item must be removed from the batch,
+// after calling enqueue when the item must be held in the tail queue instead.
+// When draining, the items are coming from the tail queue and go back to it when they still
+// exceed the rate limit. Must be called with the lock held.
+func (p *rateLimitProcessor) itemHandler(key string, n int, now time.Time, draining bool, s *stats) func(enqueue func()) bool {
+	allowed := p.limiter.take(key, n, now)
+	seen := 0
+	return func(enqueue func()) bool {
+		seen++
+		if seen <= allowed {
+			return false
+		}
+
+		if draining {
+			enqueue()
+			p.queuedItems[key]++
+			return true
+		}
+
+		switch p.config.Strategy {
+		case StrategyDownsample:
+			if p.limiter.sample(key, p.config.DownsampleRatio) {
+				return false
+			}
+		case StrategyTailQueue:
+			if p.queuedItems[key] < p.config.TailQueue.MaxSize {
+				enqueue()
+				p.queuedItems[key]++
+				s.queued++
+				return true
+			}
+		}
+		s.rejected++
+		return true
+	}
+}
+
+func (p *rateLimitProcessor) record(ctx context.Context, s stats) {
+	if s.rejected > 0 {
+		p.telemetryBuilder.ProcessorRatelimitRejectedItems.Add(ctx, s.rejected)
+	}
+	if s.queued > 0 {
+		p.telemetryBuilder.ProcessorRatelimitQueuedItems.Add(ctx, s.queued)
+	}
+}
@@ -0,0 +1,263 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ratelimitprocessor
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.opentelemetry.io/collector/processor/processortest"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata/metricdatatest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/ratelimitprocessor/internal/metadata"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/ratelimitprocessor/internal/metadatatest"
+)
+
+func newTestConfig(strategy Strategy) *Config {
+	cfg := createDefaultConfig().(*Config)
+	cfg.KeyAttributes = []string{"tenant"}
+	cfg.Rate = 1
+	cfg.Burst = 3
+	cfg.Strategy = strategy
+	return cfg
+}
+
+func newTestProcessor(t *testing.T, cfg *Config) *rateLimitProcessor {
+	p, err := newRateLimitProcessor(processortest.NewNopSettings(metadata.Type), cfg)
+	require.NoError(t, err)
+	return p
+}
+
+// generateTraces creates a resource per tenant, each holding spanCount spans.
+func generateTraces(spanCount int, tenants ...string) ptrace.Traces {
+	td := ptrace.NewTraces()
+	for _, tenant := range tenants {
+		rs := td.ResourceSpans().AppendEmpty()
+		rs.Resource().Attributes().PutStr("tenant", tenant)
+		ss := rs.ScopeSpans().AppendEmpty()
+		for range spanCount {
+			ss.Spans().AppendEmpty().SetName("span")
+		}
+	}
+	return td
+}
+
+func generateLogs(recordCount int, tenants ...string) plog.Logs {
+	ld := plog.NewLogs()
+	for _, tenant := range tenants {
+		rl := ld.ResourceLogs().AppendEmpty()
+		rl.Resource().Attributes().PutStr("tenant", tenant)
+		sl := rl.ScopeLogs().AppendEmpty()
+		for range recordCount {
+			sl.LogRecords().AppendEmpty().Body().SetStr("record")
+		}
+	}
+	return ld
+}
+
+func generateMetrics(dataPointCount int, tenants ...string) pmetric.Metrics {
+	md := pmetric.NewMetrics()
+	for _, tenant := range tenants {
+		rm := md.ResourceMetrics().AppendEmpty()
+		rm.Resource().Attributes().PutStr("tenant", tenant)
+		sm := rm.ScopeMetrics().AppendEmpty()
+		m := sm.Metrics().AppendEmpty()
+		m.SetName("requests")
+		sum := m.SetEmptySum()
+		sum.SetIsMonotonic(true)
+		sum.SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+		for i := range dataPointCount {
+			sum.DataPoints().AppendEmpty().SetIntValue(int64(i))
+		}
+	}
+	return md
+}
+
+func TestDropStrategy(t *testing.T) {
+	p := newTestProcessor(t, newTestConfig(StrategyDrop))
+	now := time.Now()
+
+	td := generateTraces(5, "a")
+	var s stats
+	p.limitTraces(td, now, false, &s)
+	assert.Equal(t, 3, td.SpanCount())
+	assert.Equal(t, stats{rejected: 2}, s)
+
+	// The bucket is empty until tokens are replenished.
+	td = generateTraces(5, "a")
+	s = stats{}
+	p.limitTraces(td, now, false, &s)
+	assert.Equal(t, 0, td.ResourceSpans().Len())
+	assert.Equal(t, stats{rejected: 5}, s)
+
+	td = generateTraces(5, "a")
+	s = stats{}
+	p.limitTraces(td, now.Add(2*time.Second), false, &s)
+	assert.Equal(t, 2, td.SpanCount())
+	assert.Equal(t, stats{rejected: 3}, s)
+}
+
+func TestKeyIsolation(t *testing.T) {
+	p := newTestProcessor(t, newTestConfig(StrategyDrop))
+	now := time.Now()
+
+	var s stats
+	p.limitLogs(generateLogs(3, "a"), now, false, &s)
+	require.Equal(t, stats{}, s)
+
+	ld := generateLogs(4, "a", "b")
+	p.limitLogs(ld, now, false, &s)
+	require.Equal(t, 1, ld.ResourceLogs().Len())
+	tenant, _ := ld.ResourceLogs().At(0).Resource().Attributes().Get("tenant")
+	assert.Equal(t, "b", tenant.Str())
+	assert.Equal(t, 3, ld.LogRecordCount())
+	assert.Equal(t, stats{rejected: 5}, s)
+}
+
+func TestDownsampleStrategy(t *testing.T) {
+	cfg := newTestConfig(StrategyDownsample)
+	cfg.DownsampleRatio = 0.25
+	p := newTestProcessor(t, cfg)
+
+	td := generateTraces(11, "a")
+	var s stats
+	p.limitTraces(td, time.Now(), false, &s)
+	// 3 spans within the burst, then 1 of every 4 of the 8 remaining ones.
+	assert.Equal(t, 5, td.SpanCount())
+	assert.Equal(t, stats{rejected: 6}, s)
+}
+
+func TestTailQueueStrategy(t *testing.T) {
+	cfg := newTestConfig(StrategyTailQueue)
+	cfg.TailQueue.MaxSize = 4
+	p := newTestProcessor(t, cfg)
+	sink := new(consumertest.TracesSink)
+	p.flush = p.flushTraces(sink)
+
+	td := generateTraces(10, "a")
+	var s stats
+	p.limitTraces(td, time.Now(), false, &s)
+	assert.Equal(t, 3, td.SpanCount())
+	assert.Equal(t, stats{rejected: 3, queued: 4}, s)
+
+	// Queued spans are held back until tokens are available for their key.
+	p.flush(context.Background(), false)
+	assert.Empty(t, sink.AllTraces())
+	assert.Equal(t, 4, p.queuedItems["a"])
+
+	p.flush(context.Background(), true)
+	require.Len(t, sink.AllTraces(), 1)
+	assert.Equal(t, 4, sink.SpanCount())
+	assert.Empty(t, p.queuedItems)
+}
+
+func TestTailQueueFlushWithinRateLimit(t *testing.T) {
+	cfg := newTestConfig(StrategyTailQueue)
+	cfg.Rate = 1000
+	cfg.Burst = 2
+	p := newTestProcessor(t, cfg)
+	sink := new(consumertest.LogsSink)
+	p.flush = p.flushLogs(sink)
+
+	ld := generateLogs(4, "a")
+	var s stats
+	p.limitLogs(ld, time.Now(), false, &s)
+	assert.Equal(t, 2, ld.LogRecordCount())
+	assert.Equal(t, stats{queued: 2}, s)
+
+	require.Eventually(t, func() bool {
+		p.flush(context.Background(), false)
+		return sink.LogRecordCount() == 2
+	}, time.Second, 10*time.Millisecond)
+	assert.Empty(t, p.queuedItems)
+}
+
+func TestMetricsDataPoints(t *testing.T) {
+	cfg := newTestConfig(StrategyTailQueue)
+	p := newTestProcessor(t, cfg)
+	sink := new(consumertest.MetricsSink)
+	p.flush = p.flushMetrics(sink)
+
+	md := generateMetrics(5, "a")
+	var s stats
+	p.limitMetrics(md, time.Now(), false, &s)
+	assert.Equal(t, 3, md.DataPointCount())
+	assert.Equal(t, stats{queued: 2}, s)
+
+	p.flush(context.Background(), true)
+	require.Len(t, sink.AllMetrics(), 1)
+	queued := sink.AllMetrics()[0]
+	assert.Equal(t, 2, queued.DataPointCount())
+	m := queued.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0)
+	assert.Equal(t, "requests", m.Name())
+	assert.True(t, m.Sum().IsMonotonic())
+	assert.Equal(t, pmetric.AggregationTemporalityCumulative, m.Sum().AggregationTemporality())
+	assert.Equal(t, int64(3), m.Sum().DataPoints().At(0).IntValue())
+}
+
+func TestProcessorTelemetry(t *testing.T) {
+	tel := componenttest.NewTelemetry()
+	t.Cleanup(func() { require.NoError(t, tel.Shutdown(context.Background())) })
+
+	sink := new(consumertest.TracesSink)
+	tp, err := createTracesProcessor(context.Background(), metadatatest.NewSettings(tel), newTestConfig(StrategyDrop), sink)
+	require.NoError(t, err)
+	require.NoError(t, tp.Start(context.Background(), componenttest.NewNopHost()))
+
+	require.NoError(t, tp.ConsumeTraces(context.Background(), generateTraces(5, "a")))
+	assert.Equal(t, 3, sink.SpanCount())
+
+	// Batches left empty are not forwarded.
+	require.NoError(t, tp.ConsumeTraces(context.Background(), generateTraces(5, "a")))
+	assert.Len(t, sink.AllTraces(), 1)
+	require.NoError(t, tp.Shutdown(context.Background()))
+
+	metadatatest.AssertEqualProcessorRatelimitRejectedItems(t, tel, []metricdata.DataPoint[int64]{
+		{Value: 7},
+	}, metricdatatest.IgnoreTimestamp())
+}
+
+func TestKeyExpiration(t *testing.T) {
+	cfg := newTestConfig(StrategyDrop)
+	cfg.KeyExpiration = time.Minute
+	p := newTestProcessor(t, cfg)
+	now := time.Now()
+
+	p.limitTraces(generateTraces(1, "a", "b"), now, false, &stats{})
+	p.limitTraces(generateTraces(1, "b"), now.Add(30*time.Second), false, &stats{})
+	assert.Len(t, p.limiter.limiters, 2)
+
+	p.limitTraces(generateTraces(1, "b"), now.Add(time.Minute), false, &stats{})
+	assert.Len(t, p.limiter.limiters, 1)
+	assert.Contains(t, p.limiter.limiters, "b")
+	assert.NotContains(t, p.limiter.lastSeen, "a")
+}
+
+func TestShutdownTwice(t *testing.T) {
+	p := newTestProcessor(t, newTestConfig(StrategyTailQueue))
+	p.flush = p.flushTraces(&consumertest.TracesSink{})
+	require.NoError(t, p.start(context.Background(), componenttest.NewNopHost()))
+	require.NoError(t, p.shutdown(context.Background()))
+	require.NoError(t, p.shutdown(context.Background()))
+}
+
+func TestKeyFromAttributes(t *testing.T) {
+	td := generateTraces(1, "a")
+	attrs := td.ResourceSpans().At(0).Resource().Attributes()
+	attrs.PutInt("shard", 2)
+
+	assert.Empty(t, keyFromAttributes(attrs, nil))
+	assert.Equal(t, "a", keyFromAttributes(attrs, []string{"tenant"}))
+	assert.Equal(t, "a\x002", keyFromAttributes(attrs, []string{"tenant", "shard"}))
+	assert.Equal(t, "\x00a", keyFromAttributes(attrs, []string{"missing", "tenant"}))
+}
@@ -0,0 +1,103 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ratelimitprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/ratelimitprocessor"
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.opentelemetry.io/collector/processor/processorhelper"
+	"go.uber.org/zap"
+)
+
+func (p *rateLimitProcessor) processTraces(ctx context.Context, td ptrace.Traces) (ptrace.Traces, error) {
+	var s stats
+	p.mu.Lock()
+	p.limitTraces(td, time.Now(), false, &s)
+	p.mu.Unlock()
+	p.record(ctx, s)
+
+	if td.ResourceSpans().Len() == 0 {
+		return td, processorhelper.ErrSkipProcessingData
+	}
+	return td, nil
+}
+
+// limitTraces removes the spans exceeding the rate limit of their key. Must be called with the lock held.
+func (p *rateLimitProcessor) limitTraces(td ptrace.Traces, now time.Time, draining bool, s *stats) {
+	td.ResourceSpans().RemoveIf(func(rs ptrace.ResourceSpans) bool {
+		key := keyFromAttributes(rs.Resource().Attributes(), p.config.KeyAttributes)
+		handle := p.itemHandler(key, spanCount(rs), now, draining, s)
+
+		var queuedRS ptrace.ResourceSpans
+		hasQueuedRS := false
+		rs.ScopeSpans().RemoveIf(func(ss ptrace.ScopeSpans) bool {
+			var queuedSS ptrace.ScopeSpans
+			hasQueuedSS := false
+			ss.Spans().RemoveIf(func(span ptrace.Span) bool {
+				return handle(func() {
+					if !hasQueuedRS {
+						queuedRS = p.tracesQueue(key).ResourceSpans().AppendEmpty()
+						rs.Resource().CopyTo(queuedRS.Resource())
+						queuedRS.SetSchemaUrl(rs.SchemaUrl())
+						hasQueuedRS = true
+					}
+					if !hasQueuedSS {
+						queuedSS = queuedRS.ScopeSpans().AppendEmpty()
+						ss.Scope().CopyTo(queuedSS.Scope())
+						queuedSS.SetSchemaUrl(ss.SchemaUrl())
+						hasQueuedSS = true
+					}
+					span.CopyTo(queuedSS.Spans().AppendEmpty())
+				})
+			})
+			return ss.Spans().Len() == 0
+		})
+		return rs.ScopeSpans().Len() == 0
+	})
+}
+
+func (p *rateLimitProcessor) tracesQueue(key string) ptrace.Traces {
+	td, ok := p.queuedTraces[key]
+	if !ok {
+		td = ptrace.NewTraces()
+		p.queuedTraces[key] = td
+	}
+	return td
+}
+
+func (p *rateLimitProcessor) flushTraces(next consumer.Traces) func(context.Context, bool) {
+	return func(ctx context.Context, force bool) {
+		p.mu.Lock()
+		queued := p.queuedTraces
+		p.queuedTraces = make(map[string]ptrace.Traces)
+		clear(p.queuedItems)
+		if !force {
+			now := time.Now()
+			for _, td := range queued {
+				p.limitTraces(td, now, true, &stats{})
+			}
+		}
+		p.mu.Unlock()
+
+		for _, td := range queued {
+			if td.ResourceSpans().Len() == 0 {
+				continue
+			}
+			if err := next.ConsumeTraces(ctx, td); err != nil {
+				p.logger.Error("Failed to forward queued spans", zap.Error(err))
+			}
+		}
+	}
+}
+
+func spanCount(rs ptrace.ResourceSpans) int {
+	count := 0
+	for i := 0; i < rs.ScopeSpans().Len(); i++ {
+		count += rs.ScopeSpans().At(i).Spans().Len()
+	}
+	return count
+}
@@ -5,10 +5,14 @@ package redactionprocessor // import "github.com/open-telemetry/opentelemetry-co
 
 import (
 	"encoding"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"strings"
 
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config/configopaque"
+
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/redactionprocessor/internal/db"
 )
 
@@ -17,10 +21,11 @@ var _ encoding.TextUnmarshaler = (*HashFunction)(nil)
 type HashFunction string
 
 const (
-	None HashFunction = ""
-	SHA1 HashFunction = "sha1"
-	SHA3 HashFunction = "sha3"
-	MD5  HashFunction = "md5"
+	None   HashFunction = ""
+	SHA1   HashFunction = "sha1"
+	SHA3   HashFunction = "sha3"
+	SHA256 HashFunction = "sha256"
+	MD5    HashFunction = "md5"
 )
 
 type Config struct {
@@ -43,6 +48,17 @@ type Config struct {
 	// and masking with a fixed string is performed.
 	HashFunction HashFunction `mapstructure:"hash_function"`
 
+	// HashSalt is prepended to the values before hashing them, so that the
+	// hashes cannot be reversed with precomputed tables while equal values
+	// still produce equal hashes.
+	HashSalt configopaque.String `mapstructure:"hash_salt"`
+
+	// Tokenization replaces the values with format-preserving tokens instead
+	// of masking or hashing them. The tokens keep the length and the
+	// separators of the values, numbers are tokenized to numbers, and equal
+	// values produce equal tokens.
+	Tokenization TokenizationConfig `mapstructure:"tokenization"`
+
 	// IgnoredKeys is a list of span attribute keys that are not redacted.
 	// Span attributes in this list are allowed to pass through the filter
 	// without being changed or removed.
@@ -71,6 +87,38 @@ type Config struct {
 	Summary string `mapstructure:"summary"`
 }
 
+// TokenizationConfig configures the format-preserving tokenization of values.
+type TokenizationConfig struct {
+	// Key is the hex-encoded AES key, of 16, 24 or 32 bytes, used to
+	// tokenize the values with FF1 format-preserving encryption.
+	// Tokenization is enabled when the key is set.
+	Key configopaque.String `mapstructure:"key"`
+
+	// Tweak is an optional, non-secret value mixed into the tokenization,
+	// so that the same key produces different tokens in different contexts.
+	Tweak string `mapstructure:"tweak"`
+}
+
+var _ component.Config = (*Config)(nil)
+
+// Validate checks if the processor configuration is valid
+func (cfg *Config) Validate() error {
+	if cfg.Tokenization.Key == "" {
+		return nil
+	}
+	if cfg.HashFunction != None {
+		return errors.New("hash_function and tokenization cannot be used at the same time")
+	}
+	key, err := hex.DecodeString(string(cfg.Tokenization.Key))
+	if err != nil {
+		return fmt.Errorf("tokenization key must be hex-encoded: %w", err)
+	}
+	if len(key) != 16 && len(key) != 24 && len(key) != 32 {
+		return fmt.Errorf("tokenization key must be 16, 24 or 32 bytes long, got %d", len(key))
+	}
+	return nil
+}
+
 func (u HashFunction) String() string {
 	return string(u)
 }
@@ -92,9 +140,12 @@ func (u *HashFunction) UnmarshalText(text []byte) error {
 	case strings.ToLower(SHA3.String()):
 		*u = SHA3
 		return nil
+	case strings.ToLower(SHA256.String()):
+		*u = SHA256
+		return nil
 	case strings.ToLower(None.String()):
 		*u = None
 		return nil
 	}
-	return fmt.Errorf("unknown HashFunction %s, allowed functions are %s, %s and %s", str, SHA1, SHA3, MD5)
+	return fmt.Errorf("unknown HashFunction %s, allowed functions are %s, %s, %s and %s", str, SHA1, SHA3, SHA256, MD5)
 }
@@ -53,6 +53,26 @@ func TestLoadConfig(t *testing.T) {
 			id:       component.NewIDWithName(metadata.Type, "empty"),
 			expected: createDefaultConfig(),
 		},
+		{
+			id: component.NewIDWithName(metadata.Type, "hash_salt"),
+			expected: &Config{
+				AllowAllKeys:  true,
+				BlockedValues: []string{"4[0-9]{12}(?:[0-9]{3})?"},
+				HashFunction:  SHA256,
+				HashSalt:      "s3cr3t",
+			},
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "tokenization"),
+			expected: &Config{
+				AllowAllKeys:  true,
+				BlockedValues: []string{"4[0-9]{12}(?:[0-9]{3})?"},
+				Tokenization: TokenizationConfig{
+					Key:   "2b7e151628aed2a6abf7158809cf4f3c",
+					Tweak: "payments",
+				},
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -83,6 +103,10 @@ func TestValidateConfig(t *testing.T) {
 			name: "valid",
 			hash: MD5,
 		},
+		{
+			name: "sha256",
+			hash: SHA256,
+		},
 		{
 			name: "empty",
 			hash: None,
@@ -90,7 +114,7 @@ func TestValidateConfig(t *testing.T) {
 		{
 			name:     "invalid",
 			hash:     "hash",
-			expected: errors.New("unknown HashFunction hash, allowed functions are sha1, sha3 and md5"),
+			expected: errors.New("unknown HashFunction hash, allowed functions are sha1, sha3, sha256 and md5"),
 		},
 	}
 
@@ -107,3 +131,55 @@ func TestValidateConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateTokenization(t *testing.T) {
+	tests := []struct {
+		name     string
+		config   *Config
+		expected string
+	}{
+		{
+			name:   "disabled",
+			config: &Config{},
+		},
+		{
+			name: "valid",
+			config: &Config{
+				Tokenization: TokenizationConfig{Key: "2b7e151628aed2a6abf7158809cf4f3c"},
+			},
+		},
+		{
+			name: "with hash function",
+			config: &Config{
+				HashFunction: SHA256,
+				Tokenization: TokenizationConfig{Key: "2b7e151628aed2a6abf7158809cf4f3c"},
+			},
+			expected: "hash_function and tokenization cannot be used at the same time",
+		},
+		{
+			name: "not hex",
+			config: &Config{
+				Tokenization: TokenizationConfig{Key: "not a key"},
+			},
+			expected: "tokenization key must be hex-encoded: encoding/hex: invalid byte: U+006E 'n'",
+		},
+		{
+			name: "invalid length",
+			config: &Config{
+				Tokenization: TokenizationConfig{Key: "2b7e1516"},
+			},
+			expected: "tokenization key must be 16, 24 or 32 bytes long, got 4",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := xconfmap.Validate(tt.config)
+			if tt.expected != "" {
+				assert.EqualError(t, err, tt.expected)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -4,9 +4,11 @@ go 1.23.0
 
 require (
 	github.com/DataDog/datadog-agent/pkg/obfuscate v0.68.3
+	github.com/open-telemetry/opentelemetry-collector-contrib/internal/fpe v0.132.0
 	github.com/stretchr/testify v1.10.0
 	go.opentelemetry.io/collector/component v1.38.0
 	go.opentelemetry.io/collector/component/componenttest v0.132.0
+	go.opentelemetry.io/collector/config/configopaque v1.38.0
 	go.opentelemetry.io/collector/confmap v1.38.0
 	go.opentelemetry.io/collector/confmap/xconfmap v0.132.0
 	go.opentelemetry.io/collector/consumer v1.38.0
@@ -83,3 +85,5 @@ retract (
 	v0.76.1
 	v0.65.0
 )
+
+replace github.com/open-telemetry/opentelemetry-collector-contrib/internal/fpe => ../../internal/fpe
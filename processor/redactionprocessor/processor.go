@@ -8,6 +8,7 @@ import (
 	"context"
 	"crypto/md5"
 	"crypto/sha1"
+	"crypto/sha256"
 	"encoding/hex"
 	"fmt"
 	"hash"
@@ -40,6 +41,10 @@ type redaction struct {
 	blockKeyRegexList map[string]*regexp.Regexp
 	// Hash function to hash blocked values
 	hashFunction HashFunction
+	// Salt prepended to the values before hashing them
+	hashSalt string
+	// Tokenizer replacing blocked values with format-preserving tokens
+	tokenizer *tokenizer
 	// Redaction processor configuration
 	config *Config
 	// Logger
@@ -69,6 +74,14 @@ func newRedaction(ctx context.Context, config *Config, logger *zap.Logger) (*red
 		return nil, fmt.Errorf("failed to process allow list: %w", err)
 	}
 
+	var tokenizer *tokenizer
+	if config.Tokenization.Key != "" {
+		tokenizer, err = newTokenizer(config.Tokenization)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create tokenizer: %w", err)
+		}
+	}
+
 	dbObfuscator := db.NewObfuscator(config.DBSanitizer)
 
 	return &redaction{
@@ -78,6 +91,8 @@ func newRedaction(ctx context.Context, config *Config, logger *zap.Logger) (*red
 		allowRegexList:    allowRegexList,
 		blockKeyRegexList: blockKeysRegexList,
 		hashFunction:      config.HashFunction,
+		hashSalt:          string(config.HashSalt),
+		tokenizer:         tokenizer,
 		config:            config,
 		logger:            logger,
 		dbObfuscator:      dbObfuscator,
@@ -315,10 +330,11 @@ func (s *redaction) processAttrs(_ context.Context, attributes pcommon.Map) {
 			redactedKeys = append(redactedKeys, k)
 			continue
 		}
-		strVal := value.Str()
-		if s.config.RedactAllTypes {
-			strVal = value.AsString()
+		if isStructured(value) && !s.shouldMaskKey(k) {
+			s.processNestedValue(k, k, value, &maskedKeys, &allowedKeys, &ignoredKeys)
+			continue
 		}
+		strVal := s.attributeString(value)
 
 		if s.shouldAllowValue(strVal) {
 			allowedKeys = append(allowedKeys, k)
@@ -348,16 +364,74 @@ func (s *redaction) processAttrs(_ context.Context, attributes pcommon.Map) {
 	s.addMetaAttrs(ignoredKeys, attributes, "", redactionIgnoredCount)
 }
 
+// processNestedValue redacts the values nested in a map or slice attribute.
+// The keys of the nested values are reported with their path, e.g. `user.email`
+// or `emails.[0]`. The allowed keys only apply to the top-level attributes.
+func (s *redaction) processNestedValue(path, key string, value pcommon.Value, maskedKeys, allowedKeys, ignoredKeys *[]string) {
+	switch value.Type() {
+	case pcommon.ValueTypeMap:
+		for k, v := range value.Map().All() {
+			keyWithPath := fmt.Sprintf("%s.%s", path, k)
+			if s.shouldIgnoreKey(k) {
+				*ignoredKeys = append(*ignoredKeys, keyWithPath)
+				continue
+			}
+			if s.shouldMaskKey(k) {
+				*maskedKeys = append(*maskedKeys, keyWithPath)
+				v.SetStr(s.maskValue(s.attributeString(v), regexp.MustCompile(".*")))
+				continue
+			}
+			s.processNestedValue(keyWithPath, k, v, maskedKeys, allowedKeys, ignoredKeys)
+		}
+	case pcommon.ValueTypeSlice:
+		for i := 0; i < value.Slice().Len(); i++ {
+			keyWithPath := fmt.Sprintf("%s.[%d]", path, i)
+			s.processNestedValue(keyWithPath, key, value.Slice().At(i), maskedKeys, allowedKeys, ignoredKeys)
+		}
+	default:
+		strVal := s.attributeString(value)
+		if s.shouldAllowValue(strVal) {
+			*allowedKeys = append(*allowedKeys, path)
+			return
+		}
+		processedString := s.processStringValueForAttribute(strVal, key)
+		if processedString != strVal {
+			*maskedKeys = append(*maskedKeys, path)
+			value.SetStr(processedString)
+		}
+	}
+}
+
+// attributeString returns the string representation of an attribute value to redact
+func (s *redaction) attributeString(value pcommon.Value) string {
+	if s.config.RedactAllTypes {
+		return value.AsString()
+	}
+	return value.Str()
+}
+
+func isStructured(value pcommon.Value) bool {
+	return value.Type() == pcommon.ValueTypeMap || value.Type() == pcommon.ValueTypeSlice
+}
+
 //nolint:gosec
 func (s *redaction) maskValue(val string, regex *regexp.Regexp) string {
 	hashFunc := func(match string) string {
+		if s.tokenizer != nil {
+			if token, ok := s.tokenizer.tokenize(match); ok {
+				return token
+			}
+			return "****"
+		}
 		switch s.hashFunction {
 		case SHA1:
-			return hashString(match, sha1.New())
+			return hashString(match, s.hashSalt, sha1.New())
 		case SHA3:
-			return hashString(match, sha3.New256())
+			return hashString(match, s.hashSalt, sha3.New256())
+		case SHA256:
+			return hashString(match, s.hashSalt, sha256.New())
 		case MD5:
-			return hashString(match, md5.New())
+			return hashString(match, s.hashSalt, md5.New())
 		default:
 			return "****"
 		}
@@ -365,7 +439,8 @@ func (s *redaction) maskValue(val string, regex *regexp.Regexp) string {
 	return regex.ReplaceAllStringFunc(val, hashFunc)
 }
 
-func hashString(input string, hasher hash.Hash) string {
+func hashString(input, salt string, hasher hash.Hash) string {
+	hasher.Write([]byte(salt))
 	hasher.Write([]byte(input))
 	return hex.EncodeToString(hasher.Sum(nil))
 }
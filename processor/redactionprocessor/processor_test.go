@@ -801,6 +801,108 @@ func TestRedactAllTypesTrue(t *testing.T) {
 	}
 }
 
+// TestRedactNestedAttributes validates that the values nested in map and slice attributes are redacted
+func TestRedactNestedAttributes(t *testing.T) {
+	config := &Config{
+		AllowAllKeys:       true,
+		IgnoredKeys:        []string{"safe_attribute"},
+		BlockedKeyPatterns: []string{".*token.*"},
+		BlockedValues:      []string{"4[0-9]{12}(?:[0-9]{3})?"},
+		AllowedValues:      []string{".+@mycompany.com"},
+		Summary:            "debug",
+	}
+	processor, err := newRedaction(context.TODO(), config, zaptest.NewLogger(t))
+	require.NoError(t, err)
+
+	attrs := pcommon.NewMap()
+	assert.NoError(t, attrs.FromRaw(map[string]any{
+		"user": map[string]any{
+			"email":          "john@mycompany.com",
+			"card":           "card 4111111111111111",
+			"api_token":      "abc123",
+			"safe_attribute": "4111111111111111",
+			"id":             5,
+		},
+		"cards": []any{"4111111111111111", "none"},
+	}))
+	processor.processAttrs(context.TODO(), attrs)
+
+	user, ok := attrs.Get("user")
+	require.True(t, ok)
+	assert.Equal(t, map[string]any{
+		"email":          "john@mycompany.com",
+		"card":           "card ****",
+		"api_token":      "****",
+		"safe_attribute": "4111111111111111",
+		"id":             int64(5),
+	}, user.Map().AsRaw())
+
+	cards, ok := attrs.Get("cards")
+	require.True(t, ok)
+	assert.Equal(t, []any{"****", "none"}, cards.Slice().AsRaw())
+
+	val, ok := attrs.Get(redactionMaskedKeys)
+	require.True(t, ok)
+	assert.Equal(t, "cards.[0],user.api_token,user.card", val.Str())
+	val, ok = attrs.Get(redactionAllowedKeys)
+	require.True(t, ok)
+	assert.Equal(t, "user.email", val.Str())
+	val, ok = attrs.Get(redactionIgnoredCount)
+	require.True(t, ok)
+	assert.Equal(t, int64(1), val.Int())
+}
+
+// TestRedactHashSalt validates that the hash salt is prepended to the values before hashing them
+func TestRedactHashSalt(t *testing.T) {
+	config := &Config{
+		AllowAllKeys:  true,
+		BlockedValues: []string{"4[0-9]{12}(?:[0-9]{3})?"},
+		HashFunction:  SHA256,
+		HashSalt:      "s3cr3t",
+	}
+	processor, err := newRedaction(context.TODO(), config, zaptest.NewLogger(t))
+	require.NoError(t, err)
+
+	attrs := pcommon.NewMap()
+	attrs.PutStr("credit_card", "4111111111111111")
+	processor.processAttrs(context.TODO(), attrs)
+
+	val, ok := attrs.Get("credit_card")
+	require.True(t, ok)
+	assert.Equal(t, "1ecb17b7bcf5ff33498e0783ac969b8059992b20cb6856523151cb9d95f37583", val.Str())
+}
+
+// TestRedactTokenization validates that the blocked values are replaced by format-preserving tokens
+func TestRedactTokenization(t *testing.T) {
+	config := &Config{
+		AllowAllKeys:       true,
+		BlockedKeyPatterns: []string{".*pin.*"},
+		BlockedValues:      []string{"4[0-9]{3}(-?[0-9]{4}){3}"},
+		Tokenization: TokenizationConfig{
+			Key: "2b7e151628aed2a6abf7158809cf4f3c",
+		},
+	}
+	processor, err := newRedaction(context.TODO(), config, zaptest.NewLogger(t))
+	require.NoError(t, err)
+
+	attrs := pcommon.NewMap()
+	attrs.PutStr("credit_card", "4111111111111111")
+	attrs.PutStr("description", "paid with 4111-1111-1111-1111")
+	attrs.PutStr("pin", "1234")
+	processor.processAttrs(context.TODO(), attrs)
+
+	val, ok := attrs.Get("credit_card")
+	require.True(t, ok)
+	assert.Equal(t, "3662311239797070", val.Str())
+	val, ok = attrs.Get("description")
+	require.True(t, ok)
+	assert.Equal(t, "paid with 3662-3112-3979-7070", val.Str())
+	// values too short to be tokenized are masked
+	val, ok = attrs.Get("pin")
+	require.True(t, ok)
+	assert.Equal(t, "****", val.Str())
+}
+
 func TestSpanEventRedacted(t *testing.T) {
 	inBatch := ptrace.NewTraces()
 	rs := inBatch.ResourceSpans().AppendEmpty()
@@ -0,0 +1,75 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package redactionprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/redactionprocessor"
+
+import (
+	"encoding/hex"
+	"strings"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/fpe"
+)
+
+const (
+	digits        = "0123456789"
+	alphanumerics = digits + "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ"
+)
+
+// tokenizer replaces values with format-preserving tokens. Values made of
+// digits are tokenized to digits and other values to alphanumeric characters,
+// while the remaining characters, such as separators, are kept in place.
+type tokenizer struct {
+	digits        *fpe.FF1
+	alphanumerics *fpe.FF1
+}
+
+func newTokenizer(cfg TokenizationConfig) (*tokenizer, error) {
+	key, err := hex.DecodeString(string(cfg.Key))
+	if err != nil {
+		return nil, err
+	}
+	digitsFF1, err := fpe.NewFF1(key, len(digits), []byte(cfg.Tweak))
+	if err != nil {
+		return nil, err
+	}
+	alphanumericsFF1, err := fpe.NewFF1(key, len(alphanumerics), []byte(cfg.Tweak))
+	if err != nil {
+		return nil, err
+	}
+	return &tokenizer{digits: digitsFF1, alphanumerics: alphanumericsFF1}, nil
+}
+
+// tokenize returns the token of the value, or false if the value has too few
+// alphanumeric characters to be tokenized securely.
+func (t *tokenizer) tokenize(val string) (string, bool) {
+	runes := []rune(val)
+	positions := make([]int, 0, len(runes))
+	onlyDigits := true
+	for i, r := range runes {
+		if !strings.ContainsRune(alphanumerics, r) {
+			continue
+		}
+		positions = append(positions, i)
+		if r < '0' || r > '9' {
+			onlyDigits = false
+		}
+	}
+
+	ff1, alphabet := t.alphanumerics, alphanumerics
+	if onlyDigits {
+		ff1, alphabet = t.digits, digits
+	}
+
+	numerals := make([]int, len(positions))
+	for i, pos := range positions {
+		numerals[i] = strings.IndexRune(alphabet, runes[pos])
+	}
+	encrypted, err := ff1.Encrypt(numerals)
+	if err != nil {
+		return "", false
+	}
+	for i, pos := range positions {
+		runes[pos] = rune(alphabet[encrypted[i]])
+	}
+	return string(runes), true
+}
@@ -0,0 +1,46 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package redactionprocessor
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTokenize(t *testing.T) {
+	tokenizer, err := newTokenizer(TokenizationConfig{Key: "2b7e151628aed2a6abf7158809cf4f3c"})
+	require.NoError(t, err)
+
+	tests := []struct {
+		value    string
+		expected string
+	}{
+		{value: "4111111111111111", expected: "3662311239797070"},
+		{value: "4111-1111-1111-1111", expected: "3662-3112-3979-7070"},
+		{value: "user-42@example.com", expected: "BV3U-b0@q7Yo6P3.osP"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			token, ok := tokenizer.tokenize(tt.value)
+			require.True(t, ok)
+			assert.Equal(t, tt.expected, token)
+		})
+	}
+
+	_, ok := tokenizer.tokenize("12345")
+	assert.False(t, ok)
+	_, ok = tokenizer.tokenize("abc")
+	assert.False(t, ok)
+}
+
+func TestTokenizeTweak(t *testing.T) {
+	tokenizer, err := newTokenizer(TokenizationConfig{Key: "2b7e151628aed2a6abf7158809cf4f3c", Tweak: "payments"})
+	require.NoError(t, err)
+
+	token, ok := tokenizer.tokenize("4111111111111111")
+	require.True(t, ok)
+	assert.Equal(t, "5414459731670730", token)
+}
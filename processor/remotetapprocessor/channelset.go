@@ -5,46 +5,46 @@ package remotetapprocessor // import "github.com/open-telemetry/opentelemetry-co
 
 import "sync"
 
-// channelSet is a collection of byte channels where adding, removing, and writing to
-// the channels is synchronized.
+// channelSet is a collection of the clients, whose byte channels are added, removed,
+// and written to in a synchronized way.
 type channelSet struct {
 	i       int
 	mu      sync.RWMutex
-	chanmap map[int]chan []byte
+	chanmap map[int]*client
 }
 
 func newChannelSet() *channelSet {
 	return &channelSet{
-		chanmap: map[int]chan []byte{},
+		chanmap: map[int]*client{},
 	}
 }
 
-// add adds the channel to the channelSet and returns a key (just an int) used to
-// remove the channel later.
-func (c *channelSet) add(ch chan []byte) int {
+// add adds the client to the channelSet and returns a key (just an int) used to
+// remove the client later.
+func (c *channelSet) add(cl *client) int {
 	c.mu.Lock()
 	idx := c.i
-	c.chanmap[idx] = ch
+	c.chanmap[idx] = cl
 	c.i++
 	c.mu.Unlock()
 	return idx
 }
 
-// writeBytes writes the passed in bytes to all of the channels in the
-// channelSet.
-func (c *channelSet) writeBytes(bytes []byte) {
+// forEach calls fn for each of the clients in the channelSet. The channels of the
+// clients can be written to by fn.
+func (c *channelSet) forEach(fn func(cl *client)) {
 	c.mu.RLock()
-	for _, ch := range c.chanmap {
-		ch <- bytes
+	for _, cl := range c.chanmap {
+		fn(cl)
 	}
 	c.mu.RUnlock()
 }
 
-// closeAndRemove closes then removes the channel associated with the passed in
-// key. Panics if an invalid key is passed in.
+// closeAndRemove closes the channel of the client associated with the passed in
+// key, then removes it. Panics if an invalid key is passed in.
 func (c *channelSet) closeAndRemove(key int) {
 	c.mu.Lock()
-	close(c.chanmap[key])
+	close(c.chanmap[key].ch)
 	delete(c.chanmap, key)
 	c.mu.Unlock()
 }
@@ -53,17 +53,8 @@ func (c *channelSet) shutdown() {
 	c.mu.Lock()
 	defer c.mu.Unlock()
 
-	// Get all keys before deletion, so the map is not
-	// being modified while iterating.
-	keys := make([]int, len(c.chanmap))
-	i := 0
-	for key := range c.chanmap {
-		keys[i] = key
-		i++
-	}
-
-	for key := range keys {
-		close(c.chanmap[key])
+	for key, cl := range c.chanmap {
+		close(cl.ch)
 		delete(c.chanmap, key)
 	}
 }
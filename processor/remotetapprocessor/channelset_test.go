@@ -12,13 +12,15 @@ import (
 
 func TestChannelset(t *testing.T) {
 	cs := newChannelSet()
-	ch := make(chan []byte)
-	key := cs.add(ch)
+	c := &client{ch: make(chan []byte)}
+	key := cs.add(c)
 	go func() {
-		cs.writeBytes([]byte("hello"))
+		cs.forEach(func(cl *client) {
+			cl.ch <- []byte("hello")
+		})
 	}()
 	assert.Eventually(t, func() bool {
-		return assert.Equal(t, []byte("hello"), <-ch)
+		return assert.Equal(t, []byte("hello"), <-c.ch)
 	}, time.Second, time.Millisecond*10)
 	cs.closeAndRemove(key)
 }
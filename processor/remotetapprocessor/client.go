@@ -0,0 +1,159 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package remotetapprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/remotetapprocessor"
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"golang.org/x/time/rate"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/filter/filterottl"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottldatapoint"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottllog"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlspan"
+)
+
+const (
+	encodingJSON  = "json"
+	encodingProto = "proto"
+)
+
+// The query parameters of the WebSocket URL a client connects to.
+const (
+	limitParam     = "limit"
+	encodingParam  = "encoding"
+	spanParam      = "span"
+	dataPointParam = "datapoint"
+	logParam       = "log_record"
+)
+
+// client is a client connected to the tap, which receives the telemetry matching
+// its conditions, within its own rate limit and in its own encoding.
+type client struct {
+	ch       chan []byte
+	limiter  *rate.Limiter
+	encoding string
+
+	spanConditions      *ottl.ConditionSequence[ottlspan.TransformContext]
+	dataPointConditions *ottl.ConditionSequence[ottldatapoint.TransformContext]
+	logConditions       *ottl.ConditionSequence[ottllog.TransformContext]
+
+	tracesMarshaler  ptrace.Marshaler
+	metricsMarshaler pmetric.Marshaler
+	logsMarshaler    plog.Marshaler
+}
+
+// newClient creates a client from the query parameters of the URL it connects to.
+// The limit of the client defaults to, and can't exceed, the limit of the processor.
+func newClient(query url.Values, limit rate.Limit, set component.TelemetrySettings) (*client, error) {
+	c := &client{
+		ch:       make(chan []byte),
+		encoding: encodingJSON,
+	}
+
+	if s := query.Get(limitParam); s != "" {
+		l, err := strconv.ParseFloat(s, 64)
+		if err != nil || l <= 0 || rate.Limit(l) > limit {
+			return nil, fmt.Errorf("%s must be a number greater than 0 and at most %v, got %q", limitParam, limit, s)
+		}
+		limit = rate.Limit(l)
+	}
+	burst := int(limit)
+	if limit > 0 && burst == 0 {
+		// Allow limits below one message per second.
+		burst = 1
+	}
+	c.limiter = rate.NewLimiter(limit, burst)
+
+	if s := query.Get(encodingParam); s != "" {
+		c.encoding = s
+	}
+	switch c.encoding {
+	case encodingJSON:
+		c.tracesMarshaler, c.metricsMarshaler, c.logsMarshaler = &ptrace.JSONMarshaler{}, &pmetric.JSONMarshaler{}, &plog.JSONMarshaler{}
+	case encodingProto:
+		c.tracesMarshaler, c.metricsMarshaler, c.logsMarshaler = &ptrace.ProtoMarshaler{}, &pmetric.ProtoMarshaler{}, &plog.ProtoMarshaler{}
+	default:
+		return nil, fmt.Errorf("unsupported %s %q, must be %s or %s", encodingParam, c.encoding, encodingJSON, encodingProto)
+	}
+
+	// The errors of the conditions of the clients are silenced, so that a client
+	// can't flood the logs of the collector.
+	var err error
+	if conditions := query[spanParam]; len(conditions) > 0 {
+		if c.spanConditions, err = filterottl.NewBoolExprForSpan(conditions, filterottl.StandardSpanFuncs(), ottl.SilentError, set); err != nil {
+			return nil, fmt.Errorf("invalid %s conditions: %w", spanParam, err)
+		}
+	}
+	if conditions := query[dataPointParam]; len(conditions) > 0 {
+		if c.dataPointConditions, err = filterottl.NewBoolExprForDataPoint(conditions, filterottl.StandardDataPointFuncs(), ottl.SilentError, set); err != nil {
+			return nil, fmt.Errorf("invalid %s conditions: %w", dataPointParam, err)
+		}
+	}
+	if conditions := query[logParam]; len(conditions) > 0 {
+		if c.logConditions, err = filterottl.NewBoolExprForLog(conditions, filterottl.StandardLogFuncs(), ottl.SilentError, set); err != nil {
+			return nil, fmt.Errorf("invalid %s conditions: %w", logParam, err)
+		}
+	}
+	return c, nil
+}
+
+// The marshal functions return the telemetry matching the conditions of the
+// client in its encoding, or nil if nothing matches.
+
+func (c *client) marshalTraces(ctx context.Context, td ptrace.Traces) ([]byte, error) {
+	if c.spanConditions != nil {
+		selected := ptrace.NewTraces()
+		td.CopyTo(selected)
+		filterottl.RemoveSpans(selected, unmatched(ctx, c.spanConditions))
+		if selected.SpanCount() == 0 {
+			return nil, nil
+		}
+		td = selected
+	}
+	return c.tracesMarshaler.MarshalTraces(td)
+}
+
+func (c *client) marshalMetrics(ctx context.Context, md pmetric.Metrics) ([]byte, error) {
+	if c.dataPointConditions != nil {
+		selected := pmetric.NewMetrics()
+		md.CopyTo(selected)
+		filterottl.RemoveDataPoints(selected, unmatched(ctx, c.dataPointConditions))
+		if selected.DataPointCount() == 0 {
+			return nil, nil
+		}
+		md = selected
+	}
+	return c.metricsMarshaler.MarshalMetrics(md)
+}
+
+func (c *client) marshalLogs(ctx context.Context, ld plog.Logs) ([]byte, error) {
+	if c.logConditions != nil {
+		selected := plog.NewLogs()
+		ld.CopyTo(selected)
+		filterottl.RemoveLogRecords(selected, unmatched(ctx, c.logConditions))
+		if selected.LogRecordCount() == 0 {
+			return nil, nil
+		}
+		ld = selected
+	}
+	return c.logsMarshaler.MarshalLogs(ld)
+}
+
+// unmatched returns a function telling whether an item doesn't match the conditions.
+// The conditions are silent, so evaluating them never fails.
+func unmatched[K any](ctx context.Context, conditions *ottl.ConditionSequence[K]) func(K) bool {
+	return func(tCtx K) bool {
+		match, _ := conditions.Eval(ctx, tCtx)
+		return !match
+	}
+}
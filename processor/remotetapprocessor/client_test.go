@@ -0,0 +1,141 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package remotetapprocessor
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"golang.org/x/time/rate"
+)
+
+func TestNewClient(t *testing.T) {
+	tests := []struct {
+		name          string
+		query         string
+		expectedLimit rate.Limit
+		expectedErr   string
+	}{
+		{
+			name:          "default",
+			expectedLimit: 10,
+		},
+		{
+			name:          "lower limit",
+			query:         "limit=0.5&encoding=proto",
+			expectedLimit: 0.5,
+		},
+		{
+			name:        "limit above the processor limit",
+			query:       "limit=20",
+			expectedErr: `limit must be a number greater than 0 and at most 10, got "20"`,
+		},
+		{
+			name:        "invalid limit",
+			query:       "limit=fast",
+			expectedErr: `limit must be a number greater than 0 and at most 10, got "fast"`,
+		},
+		{
+			name:        "invalid encoding",
+			query:       "encoding=xml",
+			expectedErr: `unsupported encoding "xml", must be json or proto`,
+		},
+		{
+			name:        "invalid condition",
+			query:       "span=" + url.QueryEscape(`name ==`),
+			expectedErr: "invalid span conditions",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			query, err := url.ParseQuery(tt.query)
+			require.NoError(t, err)
+			c, err := newClient(query, 10, componenttest.NewNopTelemetrySettings())
+			if tt.expectedErr != "" {
+				assert.ErrorContains(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedLimit, c.limiter.Limit())
+			assert.True(t, c.limiter.Allow())
+		})
+	}
+}
+
+func TestClientMarshalLogs(t *testing.T) {
+	query := url.Values{
+		logParam:      {`severity_number >= SEVERITY_NUMBER_WARN`, `IsMatch(body, "timeout")`},
+		encodingParam: {encodingProto},
+	}
+	c, err := newClient(query, 1, componenttest.NewNopTelemetrySettings())
+	require.NoError(t, err)
+
+	ld := plog.NewLogs()
+	records := ld.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords()
+	records.AppendEmpty().Body().SetStr("request served")
+	records.AppendEmpty().Body().SetStr("request timeout")
+	warn := records.AppendEmpty()
+	warn.Body().SetStr("slow request")
+	warn.SetSeverityNumber(plog.SeverityNumberWarn)
+
+	b, err := c.marshalLogs(t.Context(), ld)
+	require.NoError(t, err)
+	received, err := (&plog.ProtoUnmarshaler{}).UnmarshalLogs(b)
+	require.NoError(t, err)
+	require.Equal(t, 2, received.LogRecordCount())
+	receivedRecords := received.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords()
+	assert.Equal(t, "request timeout", receivedRecords.At(0).Body().Str())
+	assert.Equal(t, "slow request", receivedRecords.At(1).Body().Str())
+	// The consumed logs are left untouched.
+	assert.Equal(t, 3, ld.LogRecordCount())
+
+	b, err = c.marshalLogs(t.Context(), plog.NewLogs())
+	require.NoError(t, err)
+	assert.Nil(t, b)
+}
+
+func TestClientMarshalTraces(t *testing.T) {
+	query := url.Values{spanParam: {`kind == SPAN_KIND_SERVER`}}
+	c, err := newClient(query, 1, componenttest.NewNopTelemetrySettings())
+	require.NoError(t, err)
+
+	td := ptrace.NewTraces()
+	spans := td.ResourceSpans().AppendEmpty().ScopeSpans().AppendEmpty().Spans()
+	server := spans.AppendEmpty()
+	server.SetName("GET /cart")
+	server.SetKind(ptrace.SpanKindServer)
+	spans.AppendEmpty().SetName("SELECT carts")
+
+	b, err := c.marshalTraces(t.Context(), td)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"resourceSpans":[{"resource":{},"scopeSpans":[{"scope":{},"spans":[{"name":"GET /cart","kind":2,"status":{}}]}]}]}`, string(b))
+}
+
+func TestClientMarshalMetrics(t *testing.T) {
+	query := url.Values{dataPointParam: {`attributes["queue"] == "orders"`}}
+	c, err := newClient(query, 1, componenttest.NewNopTelemetrySettings())
+	require.NoError(t, err)
+
+	md := pmetric.NewMetrics()
+	metrics := md.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics()
+	gauge := metrics.AppendEmpty()
+	gauge.SetName("queue.size")
+	dps := gauge.SetEmptyGauge().DataPoints()
+	dp := dps.AppendEmpty()
+	dp.SetIntValue(5)
+	dp.Attributes().PutStr("queue", "orders")
+	dps.AppendEmpty().Attributes().PutStr("queue", "payments")
+	metrics.AppendEmpty().SetName("requests")
+
+	b, err := c.marshalMetrics(t.Context(), md)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"resourceMetrics":[{"resource":{},"scopeMetrics":[{"scope":{},"metrics":[{"name":"queue.size","gauge":{"dataPoints":[{"attributes":[{"key":"queue","value":{"stringValue":"orders"}}],"asInt":"5"}]}}]}]}]}`, string(b))
+}
@@ -20,7 +20,6 @@ import (
 	"go.opentelemetry.io/collector/processor"
 	"go.uber.org/zap"
 	"golang.org/x/net/websocket"
-	"golang.org/x/time/rate"
 )
 
 type wsprocessor struct {
@@ -29,21 +28,13 @@ type wsprocessor struct {
 	server            *http.Server
 	shutdownWG        sync.WaitGroup
 	cs                *channelSet
-	limiter           *rate.Limiter
 }
 
-var (
-	logMarshaler    = &plog.JSONMarshaler{}
-	metricMarshaler = &pmetric.JSONMarshaler{}
-	traceMarshaler  = &ptrace.JSONMarshaler{}
-)
-
 func newProcessor(settings processor.Settings, config *Config) *wsprocessor {
 	return &wsprocessor{
 		config:            config,
 		telemetrySettings: settings.TelemetrySettings,
 		cs:                newChannelSet(),
-		limiter:           rate.NewLimiter(config.Limit, int(config.Limit)),
 	}
 }
 
@@ -54,7 +45,7 @@ func (w *wsprocessor) Start(ctx context.Context, host component.Host) error {
 	if err != nil {
 		return fmt.Errorf("failed to bind to address %s: %w", w.config.Endpoint, err)
 	}
-	w.server, err = w.config.ToServer(ctx, host, w.telemetrySettings, websocket.Server{Handler: w.handleConn})
+	w.server, err = w.config.ToServer(ctx, host, w.telemetrySettings, http.HandlerFunc(w.handleRequest))
 	if err != nil {
 		return err
 	}
@@ -68,15 +59,30 @@ func (w *wsprocessor) Start(ctx context.Context, host component.Host) error {
 	return nil
 }
 
-func (w *wsprocessor) handleConn(conn *websocket.Conn) {
+// handleRequest creates the client from the query parameters of the request, and
+// upgrades the connection to a WebSocket.
+func (w *wsprocessor) handleRequest(rw http.ResponseWriter, req *http.Request) {
+	c, err := newClient(req.URL.Query(), w.config.Limit, w.telemetrySettings)
+	if err != nil {
+		http.Error(rw, err.Error(), http.StatusBadRequest)
+		return
+	}
+	websocket.Server{Handler: func(conn *websocket.Conn) {
+		w.handleConn(conn, c)
+	}}.ServeHTTP(rw, req)
+}
+
+func (w *wsprocessor) handleConn(conn *websocket.Conn, c *client) {
 	err := conn.SetDeadline(time.Time{})
 	if err != nil {
 		w.telemetrySettings.Logger.Debug("Error setting deadline", zap.Error(err))
 		return
 	}
-	ch := make(chan []byte)
-	idx := w.cs.add(ch)
-	for bytes := range ch {
+	if c.encoding == encodingProto {
+		conn.PayloadType = websocket.BinaryFrame
+	}
+	idx := w.cs.add(c)
+	for bytes := range c.ch {
 		_, err := conn.Write(bytes)
 		if err != nil {
 			w.telemetrySettings.Logger.Debug("websocket write error: %w", zap.Error(err))
@@ -103,41 +109,58 @@ func (w *wsprocessor) Shutdown(ctx context.Context) error {
 	return err
 }
 
-func (w *wsprocessor) ConsumeMetrics(_ context.Context, md pmetric.Metrics) (pmetric.Metrics, error) {
-	if w.limiter.Allow() {
-		b, err := metricMarshaler.MarshalMetrics(md)
+func (w *wsprocessor) ConsumeMetrics(ctx context.Context, md pmetric.Metrics) (pmetric.Metrics, error) {
+	w.cs.forEach(func(c *client) {
+		// The tokens are checked before and only taken after filtering, so that
+		// the clients aren't limited by the telemetry they filter out.
+		if c.limiter.Tokens() < 1 {
+			return
+		}
+		b, err := c.marshalMetrics(ctx, md)
 		if err != nil {
-			w.telemetrySettings.Logger.Debug("Error serializing to JSON", zap.Error(err))
-		} else {
-			w.cs.writeBytes(b)
+			w.telemetrySettings.Logger.Debug("Error serializing metrics", zap.Error(err))
+			return
 		}
-	}
+		if b != nil && c.limiter.Allow() {
+			c.ch <- b
+		}
+	})
 
 	return md, nil
 }
 
-func (w *wsprocessor) ConsumeLogs(_ context.Context, ld plog.Logs) (plog.Logs, error) {
-	if w.limiter.Allow() {
-		b, err := logMarshaler.MarshalLogs(ld)
+func (w *wsprocessor) ConsumeLogs(ctx context.Context, ld plog.Logs) (plog.Logs, error) {
+	w.cs.forEach(func(c *client) {
+		if c.limiter.Tokens() < 1 {
+			return
+		}
+		b, err := c.marshalLogs(ctx, ld)
 		if err != nil {
-			w.telemetrySettings.Logger.Debug("Error serializing to JSON", zap.Error(err))
-		} else {
-			w.cs.writeBytes(b)
+			w.telemetrySettings.Logger.Debug("Error serializing logs", zap.Error(err))
+			return
 		}
-	}
+		if b != nil && c.limiter.Allow() {
+			c.ch <- b
+		}
+	})
 
 	return ld, nil
 }
 
-func (w *wsprocessor) ConsumeTraces(_ context.Context, td ptrace.Traces) (ptrace.Traces, error) {
-	if w.limiter.Allow() {
-		b, err := traceMarshaler.MarshalTraces(td)
+func (w *wsprocessor) ConsumeTraces(ctx context.Context, td ptrace.Traces) (ptrace.Traces, error) {
+	w.cs.forEach(func(c *client) {
+		if c.limiter.Tokens() < 1 {
+			return
+		}
+		b, err := c.marshalTraces(ctx, td)
 		if err != nil {
-			w.telemetrySettings.Logger.Debug("Error serializing to JSON", zap.Error(err))
-		} else {
-			w.cs.writeBytes(b)
+			w.telemetrySettings.Logger.Debug("Error serializing traces", zap.Error(err))
+			return
 		}
-	}
+		if b != nil && c.limiter.Allow() {
+			c.ch <- b
+		}
+	})
 
 	return td, nil
 }
@@ -10,6 +10,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/pdata/plog"
 	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/pdata/ptrace"
@@ -44,14 +45,15 @@ func TestConsumeMetrics(t *testing.T) {
 
 			processor := newProcessor(processortest.NewNopSettings(metadata.Type), conf)
 
-			ch := make(chan []byte)
-			idx := processor.cs.add(ch)
+			c, err := newClient(nil, conf.Limit, processor.telemetrySettings)
+			require.NoError(t, err)
+			idx := processor.cs.add(c)
 			receiveNum := 0
 			wg := &sync.WaitGroup{}
 			wg.Add(1)
 			go func() {
 				defer wg.Done()
-				for range ch {
+				for range c.ch {
 					receiveNum++
 				}
 			}()
@@ -95,14 +97,15 @@ func TestConsumeLogs(t *testing.T) {
 
 			processor := newProcessor(processortest.NewNopSettings(metadata.Type), conf)
 
-			ch := make(chan []byte)
-			idx := processor.cs.add(ch)
+			c, err := newClient(nil, conf.Limit, processor.telemetrySettings)
+			require.NoError(t, err)
+			idx := processor.cs.add(c)
 			receiveNum := 0
 			wg := &sync.WaitGroup{}
 			wg.Add(1)
 			go func() {
 				defer wg.Done()
-				for range ch {
+				for range c.ch {
 					receiveNum++
 				}
 			}()
@@ -148,14 +151,15 @@ func TestConsumeTraces(t *testing.T) {
 
 			processor := newProcessor(processortest.NewNopSettings(metadata.Type), conf)
 
-			ch := make(chan []byte)
-			idx := processor.cs.add(ch)
+			c, err := newClient(nil, conf.Limit, processor.telemetrySettings)
+			require.NoError(t, err)
+			idx := processor.cs.add(c)
 			receiveNum := 0
 			wg := &sync.WaitGroup{}
 			wg.Add(1)
 			go func() {
 				defer wg.Done()
-				for range ch {
+				for range c.ch {
 					receiveNum++
 				}
 			}()
@@ -6,9 +6,11 @@ package remotetapprocessor
 import (
 	"context"
 	"net"
+	"net/url"
 	"testing"
 	"time"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/component/componenttest"
 	"go.opentelemetry.io/collector/config/confighttp"
@@ -19,6 +21,7 @@ import (
 	"go.opentelemetry.io/collector/processor/processortest"
 	"golang.org/x/net/websocket"
 
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/common/testutil"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/remotetapprocessor/internal/metadata"
 )
 
@@ -131,3 +134,48 @@ func TestSocketConnectionTraces(t *testing.T) {
 	err = rawConn.Close()
 	require.NoError(t, err)
 }
+
+func TestSocketConnectionClientParameters(t *testing.T) {
+	endpoint := testutil.GetAvailableLocalAddress(t)
+	cfg := &Config{
+		ServerConfig: confighttp.ServerConfig{
+			Endpoint: endpoint,
+		},
+		Limit: 10,
+	}
+	processor, err := NewFactory().CreateLogs(context.Background(), processortest.NewNopSettings(metadata.Type), cfg,
+		&consumertest.LogsSink{})
+	require.NoError(t, err)
+	require.NoError(t, processor.Start(context.Background(), componenttest.NewNopHost()))
+	defer func() {
+		require.NoError(t, processor.Shutdown(context.Background()))
+	}()
+
+	_, err = websocket.Dial("ws://"+endpoint+"/?encoding=xml", "", "http://localhost")
+	require.ErrorContains(t, err, "bad status")
+
+	query := url.Values{
+		"encoding":   {"proto"},
+		"limit":      {"5"},
+		"log_record": {`IsMatch(body, "timeout")`},
+	}
+	wsConn, err := websocket.Dial("ws://"+endpoint+"/?"+query.Encode(), "", "http://localhost")
+	require.NoError(t, err)
+	defer wsConn.Close()
+
+	logs := plog.NewLogs()
+	records := logs.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords()
+	records.AppendEmpty().Body().SetStr("request served")
+	records.AppendEmpty().Body().SetStr("request timeout")
+	var msg []byte
+	require.Eventuallyf(t, func() bool {
+		require.NoError(t, processor.ConsumeLogs(context.Background(), logs))
+		require.NoError(t, wsConn.SetReadDeadline(time.Now().Add(100*time.Millisecond)))
+		return websocket.Message.Receive(wsConn, &msg) == nil
+	}, 2*time.Second, 100*time.Millisecond, "received message")
+
+	received, err := (&plog.ProtoUnmarshaler{}).UnmarshalLogs(msg)
+	require.NoError(t, err)
+	require.Equal(t, 1, received.LogRecordCount())
+	assert.Equal(t, "request timeout", received.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0).Body().Str())
+}
@@ -18,9 +18,12 @@ import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/resourcedetectionprocessor/internal/docker"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/resourcedetectionprocessor/internal/gcp"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/resourcedetectionprocessor/internal/heroku"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/resourcedetectionprocessor/internal/httpjson"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/resourcedetectionprocessor/internal/ibmcloud"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/resourcedetectionprocessor/internal/k8snode"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/resourcedetectionprocessor/internal/kubeadm"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/resourcedetectionprocessor/internal/openshift"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/resourcedetectionprocessor/internal/oraclecloud"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/resourcedetectionprocessor/internal/system"
 )
 
@@ -78,6 +81,15 @@ type DetectorConfig struct {
 	// HerokuConfig contains user-specified configurations for the heroku detector
 	HerokuConfig heroku.Config `mapstructure:"heroku"`
 
+	// HTTPJSONConfig contains user-specified configurations for the httpjson detector
+	HTTPJSONConfig httpjson.Config `mapstructure:"httpjson"`
+
+	// IBMCloudConfig contains user-specified configurations for the IBM Cloud detector
+	IBMCloudConfig ibmcloud.Config `mapstructure:"ibmcloud"`
+
+	// OracleCloudConfig contains user-specified configurations for the Oracle Cloud detector
+	OracleCloudConfig oraclecloud.Config `mapstructure:"oraclecloud"`
+
 	// SystemConfig contains user-specified configurations for the System detector
 	SystemConfig system.Config `mapstructure:"system"`
 
@@ -104,6 +116,9 @@ func detectorCreateDefaultConfig() DetectorConfig {
 		DockerConfig:           docker.CreateDefaultConfig(),
 		GcpConfig:              gcp.CreateDefaultConfig(),
 		HerokuConfig:           heroku.CreateDefaultConfig(),
+		HTTPJSONConfig:         httpjson.CreateDefaultConfig(),
+		IBMCloudConfig:         ibmcloud.CreateDefaultConfig(),
+		OracleCloudConfig:      oraclecloud.CreateDefaultConfig(),
 		SystemConfig:           system.CreateDefaultConfig(),
 		OpenShiftConfig:        openshift.CreateDefaultConfig(),
 		K8SNodeConfig:          k8snode.CreateDefaultConfig(),
@@ -135,6 +150,12 @@ func (d *DetectorConfig) GetConfigFromType(detectorType internal.DetectorType) i
 		return d.GcpConfig
 	case heroku.TypeStr:
 		return d.HerokuConfig
+	case httpjson.TypeStr:
+		return d.HTTPJSONConfig
+	case ibmcloud.TypeStr:
+		return d.IBMCloudConfig
+	case oraclecloud.TypeStr:
+		return d.OracleCloudConfig
 	case system.TypeStr:
 		return d.SystemConfig
 	case openshift.TypeStr:
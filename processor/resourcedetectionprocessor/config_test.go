@@ -12,6 +12,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/config/confighttp"
+	"go.opentelemetry.io/collector/config/configopaque"
 	"go.opentelemetry.io/collector/config/configtls"
 	"go.opentelemetry.io/collector/confmap/confmaptest"
 	"go.opentelemetry.io/collector/confmap/xconfmap"
@@ -20,8 +21,11 @@ import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/resourcedetectionprocessor/internal/aws/ec2"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/resourcedetectionprocessor/internal/aws/lambda"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/resourcedetectionprocessor/internal/heroku"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/resourcedetectionprocessor/internal/httpjson"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/resourcedetectionprocessor/internal/ibmcloud"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/resourcedetectionprocessor/internal/metadata"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/resourcedetectionprocessor/internal/openshift"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/resourcedetectionprocessor/internal/oraclecloud"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/resourcedetectionprocessor/internal/system"
 )
 
@@ -54,6 +58,16 @@ func TestLoadConfig(t *testing.T) {
 		ResourceAttributes: system.CreateDefaultConfig().ResourceAttributes,
 	}
 
+	httpJSONConfig := detectorCreateDefaultConfig()
+	httpJSONConfig.HTTPJSONConfig = httpjson.Config{
+		Endpoint: "http://metadata.internal/v1/instance",
+		Headers:  map[string]configopaque.String{"Authorization": "Bearer token"},
+		Attributes: map[string]string{
+			"host.id":      "/instance/id",
+			"cloud.region": "/instance/region",
+		},
+	}
+
 	resourceAttributesConfig := detectorCreateDefaultConfig()
 	ec2ResourceAttributesConfig := ec2.CreateDefaultConfig()
 	ec2ResourceAttributesConfig.ResourceAttributes.HostName.Enabled = false
@@ -124,6 +138,15 @@ func TestLoadConfig(t *testing.T) {
 				DetectorConfig: detectorCreateDefaultConfig(),
 			},
 		},
+		{
+			id: component.NewIDWithName(metadata.Type, "httpjson"),
+			expected: &Config{
+				Detectors:      []string{"env", "httpjson"},
+				ClientConfig:   cfg,
+				Override:       false,
+				DetectorConfig: httpJSONConfig,
+			},
+		},
 		{
 			id: component.NewIDWithName(metadata.Type, "resourceattributes"),
 			expected: &Config{
@@ -137,6 +160,10 @@ func TestLoadConfig(t *testing.T) {
 			id:           component.NewIDWithName(metadata.Type, "invalid"),
 			errorMessage: "hostname_sources contains invalid value: \"invalid_source\"",
 		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "httpjson_invalid"),
+			errorMessage: "attribute \"host.id\": JSON pointer \"instance.id\" must start with \"/\"",
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.id.String(), func(t *testing.T) {
@@ -163,6 +190,8 @@ func TestLoadConfig(t *testing.T) {
 func TestGetConfigFromType(t *testing.T) {
 	herokuDetectorConfig := DetectorConfig{HerokuConfig: heroku.CreateDefaultConfig()}
 	lambdaDetectorConfig := DetectorConfig{LambdaConfig: lambda.CreateDefaultConfig()}
+	oracleCloudDetectorConfig := DetectorConfig{OracleCloudConfig: oraclecloud.CreateDefaultConfig()}
+	ibmCloudDetectorConfig := DetectorConfig{IBMCloudConfig: ibmcloud.CreateDefaultConfig()}
 	ec2DetectorConfig := DetectorConfig{
 		EC2Config: ec2.Config{
 			Tags: []string{"tag1", "tag2"},
@@ -210,6 +239,26 @@ func TestGetConfigFromType(t *testing.T) {
 			inputDetectorConfig: lambdaDetectorConfig,
 			expectedConfig:      lambdaDetectorConfig.LambdaConfig,
 		},
+		{
+			name:                "Get Oracle Cloud Config",
+			detectorType:        oraclecloud.TypeStr,
+			inputDetectorConfig: oracleCloudDetectorConfig,
+			expectedConfig:      oracleCloudDetectorConfig.OracleCloudConfig,
+		},
+		{
+			name:         "Get HTTP JSON Config",
+			detectorType: httpjson.TypeStr,
+			inputDetectorConfig: DetectorConfig{
+				HTTPJSONConfig: httpjson.Config{Endpoint: "http://metadata.internal"},
+			},
+			expectedConfig: httpjson.Config{Endpoint: "http://metadata.internal"},
+		},
+		{
+			name:                "Get IBM Cloud Config",
+			detectorType:        ibmcloud.TypeStr,
+			inputDetectorConfig: ibmCloudDetectorConfig,
+			expectedConfig:      ibmCloudDetectorConfig.IBMCloudConfig,
+		},
 	}
 
 	for _, tt := range tests {
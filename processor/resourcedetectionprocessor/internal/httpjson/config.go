@@ -0,0 +1,53 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package httpjson // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/resourcedetectionprocessor/internal/httpjson"
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"go.opentelemetry.io/collector/config/configopaque"
+)
+
+// Config defines user-specified configurations unique to the httpjson detector
+type Config struct {
+	// Endpoint is the URL of the metadata document to fetch, e.g. `http://169.254.169.254/metadata/instance`.
+	Endpoint string `mapstructure:"endpoint"`
+
+	// Headers are added to the metadata request, e.g. to authenticate against the metadata service.
+	Headers map[string]configopaque.String `mapstructure:"headers"`
+
+	// Attributes maps resource attribute names to the location of their value in the
+	// metadata document, given as a JSON pointer (RFC 6901), e.g. `/compute/zone`.
+	Attributes map[string]string `mapstructure:"attributes"`
+}
+
+// Validate config
+func (cfg *Config) Validate() error {
+	// the detector config is always present, only validate it when it is used.
+	if cfg.Endpoint == "" && len(cfg.Attributes) == 0 {
+		return nil
+	}
+	if cfg.Endpoint == "" {
+		return errors.New("endpoint must be specified")
+	}
+	if _, err := url.ParseRequestURI(cfg.Endpoint); err != nil {
+		return fmt.Errorf("invalid endpoint %q: %w", cfg.Endpoint, err)
+	}
+	if len(cfg.Attributes) == 0 {
+		return errors.New("attributes must not be empty")
+	}
+	for name, pointer := range cfg.Attributes {
+		if !strings.HasPrefix(pointer, "/") {
+			return fmt.Errorf("attribute %q: JSON pointer %q must start with \"/\"", name, pointer)
+		}
+	}
+	return nil
+}
+
+func CreateDefaultConfig() Config {
+	return Config{}
+}
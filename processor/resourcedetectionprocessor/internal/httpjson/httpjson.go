@@ -0,0 +1,160 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package httpjson // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/resourcedetectionprocessor/internal/httpjson"
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/processor"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/resourcedetectionprocessor/internal"
+)
+
+const (
+	// TypeStr is type of detector.
+	TypeStr = "httpjson"
+)
+
+var _ internal.Detector = (*Detector)(nil)
+
+// Detector fetches a JSON metadata document over HTTP and maps its fields to resource attributes
+type Detector struct {
+	cfg    Config
+	logger *zap.Logger
+}
+
+// NewDetector creates a new httpjson detector
+func NewDetector(p processor.Settings, dcfg internal.DetectorConfig) (internal.Detector, error) {
+	cfg := dcfg.(Config)
+	if cfg.Endpoint == "" {
+		return nil, errors.New("the httpjson detector requires an endpoint")
+	}
+	return &Detector{cfg: cfg, logger: p.Logger}, nil
+}
+
+// Detect fetches the metadata document and returns a resource with the mapped attributes
+func (d *Detector) Detect(ctx context.Context) (resource pcommon.Resource, schemaURL string, err error) {
+	res := pcommon.NewResource()
+
+	doc, err := d.fetch(ctx)
+	if err != nil {
+		return res, "", fmt.Errorf("failed to get metadata from %q: %w", d.cfg.Endpoint, err)
+	}
+
+	attrs := res.Attributes()
+	for name, pointer := range d.cfg.Attributes {
+		value, ok := lookup(doc, pointer)
+		if !ok || value == nil {
+			d.logger.Debug("Metadata field not found", zap.String("attribute", name), zap.String("pointer", pointer))
+			continue
+		}
+		if err := putValue(attrs, name, value); err != nil {
+			return pcommon.NewResource(), "", fmt.Errorf("failed to set attribute %q: %w", name, err)
+		}
+	}
+
+	return res, "", nil
+}
+
+func (d *Detector) fetch(ctx context.Context) (any, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, d.cfg.Endpoint, http.NoBody)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+	for key, value := range d.cfg.Headers {
+		req.Header.Set(key, string(value))
+	}
+
+	client, err := internal.ClientFromContext(ctx)
+	if err != nil {
+		client = http.DefaultClient
+		d.logger.Debug("Error retrieving client from context thus creating default", zap.Error(err))
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return nil, fmt.Errorf("unexpected status code %d: %s", resp.StatusCode, body)
+	}
+
+	decoder := json.NewDecoder(resp.Body)
+	decoder.UseNumber()
+	var doc any
+	if err := decoder.Decode(&doc); err != nil {
+		return nil, fmt.Errorf("failed to decode metadata: %w", err)
+	}
+	return doc, nil
+}
+
+// lookup resolves a JSON pointer (RFC 6901) in the decoded document.
+func lookup(doc any, pointer string) (any, bool) {
+	if pointer == "" {
+		return doc, true
+	}
+	if !strings.HasPrefix(pointer, "/") {
+		return nil, false
+	}
+	for _, token := range strings.Split(pointer[1:], "/") {
+		token = strings.ReplaceAll(strings.ReplaceAll(token, "~1", "/"), "~0", "~")
+		switch v := doc.(type) {
+		case map[string]any:
+			var ok bool
+			if doc, ok = v[token]; !ok {
+				return nil, false
+			}
+		case []any:
+			i, err := strconv.Atoi(token)
+			if err != nil || i < 0 || i >= len(v) {
+				return nil, false
+			}
+			doc = v[i]
+		default:
+			return nil, false
+		}
+	}
+	return doc, true
+}
+
+// putValue sets the attribute with the type matching the JSON value. Objects
+// and arrays are set as their JSON encoding.
+func putValue(attrs pcommon.Map, name string, value any) error {
+	switch v := value.(type) {
+	case string:
+		attrs.PutStr(name, v)
+	case bool:
+		attrs.PutBool(name, v)
+	case json.Number:
+		if i, err := v.Int64(); err == nil {
+			attrs.PutInt(name, i)
+			return nil
+		}
+		f, err := v.Float64()
+		if err != nil {
+			return err
+		}
+		attrs.PutDouble(name, f)
+	default:
+		b, err := json.Marshal(v)
+		if err != nil {
+			return err
+		}
+		attrs.PutStr(name, string(b))
+	}
+	return nil
+}
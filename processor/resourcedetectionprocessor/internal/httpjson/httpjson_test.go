@@ -0,0 +1,168 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package httpjson
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/config/configopaque"
+	"go.opentelemetry.io/collector/processor/processortest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/resourcedetectionprocessor/internal"
+)
+
+const metadataDocument = `{
+  "instance": {
+    "id": "i-0123456789",
+    "zone": "dc1-rack2",
+    "cores": 8,
+    "load": 0.75,
+    "spot": false,
+    "tags": ["a", "b"],
+    "labels": {"app/name": "checkout", "team~id": "t-42"},
+    "owner": null
+  },
+  "disks": [{"name": "sda"}, {"name": "sdb"}]
+}`
+
+func newServer(t *testing.T) *httptest.Server {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Token") != "secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_, _ = w.Write([]byte(metadataDocument))
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestNewDetector(t *testing.T) {
+	_, err := NewDetector(processortest.NewNopSettings(processortest.NopType), CreateDefaultConfig())
+	assert.EqualError(t, err, "the httpjson detector requires an endpoint")
+
+	d, err := NewDetector(processortest.NewNopSettings(processortest.NopType), Config{Endpoint: "http://localhost"})
+	require.NoError(t, err)
+	assert.NotNil(t, d)
+}
+
+func TestDetect(t *testing.T) {
+	server := newServer(t)
+	d, err := NewDetector(processortest.NewNopSettings(processortest.NopType), Config{
+		Endpoint: server.URL,
+		Headers:  map[string]configopaque.String{"X-Token": "secret"},
+		Attributes: map[string]string{
+			"host.id":            "/instance/id",
+			"cloud.region":       "/instance/zone",
+			"host.cpu.cores":     "/instance/cores",
+			"host.load":          "/instance/load",
+			"host.spot":          "/instance/spot",
+			"host.tags":          "/instance/tags",
+			"app.name":           "/instance/labels/app~1name",
+			"team.id":            "/instance/labels/team~0id",
+			"host.owner":         "/instance/owner",
+			"host.disk.name":     "/disks/1/name",
+			"host.missing":       "/instance/missing",
+			"host.missing_index": "/disks/5/name",
+		},
+	})
+	require.NoError(t, err)
+
+	ctx := internal.ContextWithClient(context.Background(), server.Client())
+	res, schemaURL, err := d.Detect(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, schemaURL)
+	assert.Equal(t, map[string]any{
+		"host.id":        "i-0123456789",
+		"cloud.region":   "dc1-rack2",
+		"host.cpu.cores": int64(8),
+		"host.load":      0.75,
+		"host.spot":      false,
+		"host.tags":      `["a","b"]`,
+		"app.name":       "checkout",
+		"team.id":        "t-42",
+		"host.disk.name": "sdb",
+	}, res.Attributes().AsRaw())
+}
+
+func TestDetectError(t *testing.T) {
+	server := newServer(t)
+	d, err := NewDetector(processortest.NewNopSettings(processortest.NopType), Config{
+		Endpoint:   server.URL,
+		Attributes: map[string]string{"host.id": "/instance/id"},
+	})
+	require.NoError(t, err)
+
+	res, _, err := d.Detect(context.Background())
+	assert.ErrorContains(t, err, "unexpected status code 401")
+	assert.Equal(t, 0, res.Attributes().Len())
+}
+
+func TestDetectInvalidDocument(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte("not json"))
+	}))
+	defer server.Close()
+
+	d, err := NewDetector(processortest.NewNopSettings(processortest.NopType), Config{
+		Endpoint:   server.URL,
+		Attributes: map[string]string{"host.id": "/instance/id"},
+	})
+	require.NoError(t, err)
+
+	_, _, err = d.Detect(context.Background())
+	assert.ErrorContains(t, err, "failed to decode metadata")
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name   string
+		cfg    Config
+		errMsg string
+	}{
+		{
+			name: "default",
+			cfg:  CreateDefaultConfig(),
+		},
+		{
+			name: "valid",
+			cfg:  Config{Endpoint: "http://localhost/metadata", Attributes: map[string]string{"host.id": "/id"}},
+		},
+		{
+			name:   "missing endpoint",
+			cfg:    Config{Attributes: map[string]string{"host.id": "/id"}},
+			errMsg: "endpoint must be specified",
+		},
+		{
+			name:   "invalid endpoint",
+			cfg:    Config{Endpoint: "localhost", Attributes: map[string]string{"host.id": "/id"}},
+			errMsg: `invalid endpoint "localhost"`,
+		},
+		{
+			name:   "missing attributes",
+			cfg:    Config{Endpoint: "http://localhost/metadata"},
+			errMsg: "attributes must not be empty",
+		},
+		{
+			name:   "invalid pointer",
+			cfg:    Config{Endpoint: "http://localhost/metadata", Attributes: map[string]string{"host.id": "id"}},
+			errMsg: `attribute "host.id": JSON pointer "id" must start with "/"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.cfg.Validate()
+			if tt.errMsg == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorContains(t, err, tt.errMsg)
+		})
+	}
+}
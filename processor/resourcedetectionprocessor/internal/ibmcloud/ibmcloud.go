@@ -0,0 +1,69 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ibmcloud // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/resourcedetectionprocessor/internal/ibmcloud"
+
+import (
+	"context"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/processor"
+	conventions "go.opentelemetry.io/otel/semconv/v1.6.1"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/metadataproviders/ibmcloud"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/resourcedetectionprocessor/internal"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/resourcedetectionprocessor/internal/ibmcloud/internal/metadata"
+)
+
+const (
+	// TypeStr is type of detector.
+	TypeStr = "ibmcloud"
+
+	// cloud.provider value of IBM Cloud, which is not part of the semantic
+	// conventions version used by this detector.
+	cloudProviderIBMCloud = "ibm_cloud"
+)
+
+var _ internal.Detector = (*Detector)(nil)
+
+// Detector is an IBM Cloud VPC metadata detector
+type Detector struct {
+	provider ibmcloud.Provider
+	logger   *zap.Logger
+	rb       *metadata.ResourceBuilder
+}
+
+// NewDetector creates a new IBM Cloud VPC metadata detector
+func NewDetector(p processor.Settings, dcfg internal.DetectorConfig) (internal.Detector, error) {
+	cfg := dcfg.(Config)
+
+	return &Detector{
+		provider: ibmcloud.NewProvider(),
+		logger:   p.Logger,
+		rb:       metadata.NewResourceBuilder(cfg.ResourceAttributes),
+	}, nil
+}
+
+// Detect detects IBM Cloud VPC instance metadata and returns a resource with the available ones
+func (d *Detector) Detect(ctx context.Context) (resource pcommon.Resource, schemaURL string, err error) {
+	instance, err := d.provider.Metadata(ctx)
+	if err != nil {
+		d.logger.Debug("IBM Cloud detector metadata retrieval failed", zap.Error(err))
+		// return an empty Resource and no error
+		return pcommon.NewResource(), "", nil
+	}
+
+	d.rb.SetCloudProvider(cloudProviderIBMCloud)
+	d.rb.SetCloudRegion(instance.Region())
+	d.rb.SetCloudAvailabilityZone(instance.Zone.Name)
+	d.rb.SetCloudAccountID(instance.AccountID())
+	d.rb.SetHostID(instance.ID)
+	d.rb.SetHostName(instance.Name)
+	d.rb.SetHostType(instance.Profile.Name)
+	d.rb.SetHostImageID(instance.Image.ID)
+	d.rb.SetIbmCloudResourceGroupName(instance.ResourceGroup.Name)
+	d.rb.SetIbmCloudVpcName(instance.VPC.Name)
+
+	return d.rb.Emit(), conventions.SchemaURL, nil
+}
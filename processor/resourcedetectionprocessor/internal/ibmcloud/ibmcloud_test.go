@@ -0,0 +1,77 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ibmcloud
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/processor/processortest"
+	conventions "go.opentelemetry.io/otel/semconv/v1.6.1"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/metadataproviders/ibmcloud"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/resourcedetectionprocessor/internal"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/resourcedetectionprocessor/internal/ibmcloud/internal/metadata"
+)
+
+func TestNewDetector(t *testing.T) {
+	dcfg := CreateDefaultConfig()
+	d, err := NewDetector(processortest.NewNopSettings(processortest.NopType), dcfg)
+	require.NoError(t, err)
+	assert.NotNil(t, d)
+}
+
+func TestDetectIBMCloudAvailable(t *testing.T) {
+	mp := &ibmcloud.MockProvider{}
+	mp.On("Metadata").Return(&ibmcloud.InstanceMetadata{
+		ID:            "0717_e21b7391",
+		CRN:           "crn:v1:bluemix:public:is:eu-de-2:a/123456::instance:0717_e21b7391",
+		Name:          "my-instance",
+		Profile:       ibmcloud.Reference{Name: "bx2-2x8"},
+		Zone:          ibmcloud.Reference{Name: "eu-de-2"},
+		Image:         ibmcloud.Reference{ID: "r010-image", Name: "ibm-ubuntu-22-04"},
+		VPC:           ibmcloud.Reference{ID: "r010-vpc", Name: "my-vpc"},
+		ResourceGroup: ibmcloud.Reference{ID: "fee82deba12e", Name: "default"},
+	}, nil)
+
+	detector := &Detector{
+		provider: mp,
+		logger:   zap.NewNop(),
+		rb:       metadata.NewResourceBuilder(metadata.DefaultResourceAttributesConfig()),
+	}
+	res, schemaURL, err := detector.Detect(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, conventions.SchemaURL, schemaURL)
+	mp.AssertExpectations(t)
+
+	expected := map[string]any{
+		string(conventions.CloudProviderKey):         "ibm_cloud",
+		string(conventions.CloudRegionKey):           "eu-de",
+		string(conventions.CloudAvailabilityZoneKey): "eu-de-2",
+		string(conventions.CloudAccountIDKey):        "123456",
+		string(conventions.HostIDKey):                "0717_e21b7391",
+		string(conventions.HostNameKey):              "my-instance",
+		string(conventions.HostTypeKey):              "bx2-2x8",
+		string(conventions.HostImageIDKey):           "r010-image",
+	}
+
+	assert.Equal(t, expected, res.Attributes().AsRaw())
+}
+
+func TestDetectError(t *testing.T) {
+	mp := &ibmcloud.MockProvider{}
+	mp.On("Metadata").Return(&ibmcloud.InstanceMetadata{}, errors.New("mock error"))
+	detector := &Detector{
+		provider: mp,
+		logger:   zap.NewNop(),
+		rb:       metadata.NewResourceBuilder(metadata.DefaultResourceAttributesConfig()),
+	}
+	res, _, err := detector.Detect(context.Background())
+	assert.NoError(t, err)
+	assert.True(t, internal.IsEmptyResource(res))
+}
@@ -0,0 +1,83 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package oraclecloud
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/processor/processortest"
+	conventions "go.opentelemetry.io/otel/semconv/v1.6.1"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/metadataproviders/oraclecloud"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/resourcedetectionprocessor/internal"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/resourcedetectionprocessor/internal/oraclecloud/internal/metadata"
+)
+
+func TestNewDetector(t *testing.T) {
+	dcfg := CreateDefaultConfig()
+	d, err := NewDetector(processortest.NewNopSettings(processortest.NopType), dcfg)
+	require.NoError(t, err)
+	assert.NotNil(t, d)
+}
+
+func TestDetectOracleCloudAvailable(t *testing.T) {
+	mp := &oraclecloud.MockProvider{}
+	mp.On("Metadata").Return(&oraclecloud.ComputeMetadata{
+		ID:                  "ocid1.instance.oc1.iad.example",
+		DisplayName:         "my-instance",
+		Hostname:            "my-hostname",
+		CanonicalRegionName: "us-ashburn-1",
+		AvailabilityDomain:  "Uocm:US-ASHBURN-AD-1",
+		FaultDomain:         "FAULT-DOMAIN-2",
+		CompartmentID:       "ocid1.compartment.oc1..example",
+		TenantID:            "ocid1.tenancy.oc1..example",
+		Shape:               "VM.Standard.E4.Flex",
+		Image:               "ocid1.image.oc1.iad.example",
+	}, nil)
+
+	cfg := metadata.DefaultResourceAttributesConfig()
+	cfg.OracleCloudCompartmentID.Enabled = true
+	detector := &Detector{
+		provider: mp,
+		logger:   zap.NewNop(),
+		rb:       metadata.NewResourceBuilder(cfg),
+	}
+	res, schemaURL, err := detector.Detect(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, conventions.SchemaURL, schemaURL)
+	mp.AssertExpectations(t)
+
+	expected := map[string]any{
+		string(conventions.CloudProviderKey):         "oracle_cloud",
+		string(conventions.CloudPlatformKey):         "oracle_cloud_compute",
+		string(conventions.CloudRegionKey):           "us-ashburn-1",
+		string(conventions.CloudAvailabilityZoneKey): "Uocm:US-ASHBURN-AD-1",
+		string(conventions.CloudAccountIDKey):        "ocid1.tenancy.oc1..example",
+		string(conventions.HostIDKey):                "ocid1.instance.oc1.iad.example",
+		string(conventions.HostNameKey):              "my-hostname",
+		string(conventions.HostTypeKey):              "VM.Standard.E4.Flex",
+		string(conventions.HostImageIDKey):           "ocid1.image.oc1.iad.example",
+		"oracle_cloud.compartment.id":                "ocid1.compartment.oc1..example",
+	}
+
+	assert.Equal(t, expected, res.Attributes().AsRaw())
+}
+
+func TestDetectError(t *testing.T) {
+	mp := &oraclecloud.MockProvider{}
+	mp.On("Metadata").Return(&oraclecloud.ComputeMetadata{}, errors.New("mock error"))
+	detector := &Detector{
+		provider: mp,
+		logger:   zap.NewNop(),
+		rb:       metadata.NewResourceBuilder(metadata.DefaultResourceAttributesConfig()),
+	}
+	res, _, err := detector.Detect(context.Background())
+	assert.NoError(t, err)
+	assert.True(t, internal.IsEmptyResource(res))
+}
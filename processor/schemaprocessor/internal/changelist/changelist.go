@@ -47,7 +47,25 @@ func (c ChangeList) Do(ss migrate.StateSelector, signal any) error {
 				}
 				continue
 			}
+			// metric transformers act on each metric of a slice, so that they
+			// are applied in order with the transformers acting on the slice
+			if metrics, ok := signal.(pmetric.MetricSlice); ok {
+				for m := 0; m < metrics.Len(); m++ {
+					if err := thisMigrator.Do(ss, metrics.At(m)); err != nil {
+						return err
+					}
+				}
+				continue
+			}
 			return fmt.Errorf("metric Transformer %T can't act on %T", thisMigrator, signal)
+		case transformer.Transformer[pmetric.MetricSlice]:
+			if metrics, ok := signal.(pmetric.MetricSlice); ok {
+				if err := thisMigrator.Do(ss, metrics); err != nil {
+					return err
+				}
+				continue
+			}
+			return fmt.Errorf("metric slice Transformer %T can't act on %T", thisMigrator, signal)
 		case transformer.Transformer[plog.LogRecord]:
 			if log, ok := signal.(plog.LogRecord); ok {
 				if err := thisMigrator.Do(ss, log); err != nil {
@@ -13,5 +13,6 @@ var (
 	_ Migrator = (*MultiConditionalAttributeSet)(nil)
 	_ Migrator = (*SignalNameChange)(nil)
 	_ Migrator = (*ConditionalAttributeSet)(nil)
+	_ Migrator = (*MetricSplit)(nil)
 	_ Migrator = (*SignalNameChange)(nil)
 )
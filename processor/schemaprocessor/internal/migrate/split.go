@@ -0,0 +1,248 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package migrate // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/schemaprocessor/internal/migrate"
+
+import (
+	"fmt"
+	"sort"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+)
+
+// MetricSplit represents a split type operation.
+// Applying it splits a metric into one metric per value of an attribute,
+// and removes the attribute from the data points.
+// Rolling it back merges the metrics into the original one
+// and adds the attribute back to the data points.
+type MetricSplit struct {
+	metric    string
+	attribute string
+	// The keys are the names of the new metrics, the values are the
+	// attribute values of the original metric they are split from.
+	metrics map[string]pcommon.Value
+	// names are the sorted keys of metrics, so that the new metrics are
+	// always created in the same order.
+	names []string
+}
+
+// NewMetricSplit allows for typed strings and attribute values to be used as part
+// of the invocation that will be converted into the default types.
+func NewMetricSplit[Metric, Attribute, Name SignalType, Value any](metric Metric, attribute Attribute, metrics map[Name]Value) MetricSplit {
+	split := MetricSplit{
+		metric:    string(metric),
+		attribute: string(attribute),
+		metrics:   make(map[string]pcommon.Value, len(metrics)),
+		names:     make([]string, 0, len(metrics)),
+	}
+	for name, value := range metrics {
+		split.metrics[string(name)] = newValue(value)
+		split.names = append(split.names, string(name))
+	}
+	sort.Strings(split.names)
+	return split
+}
+
+func (MetricSplit) IsMigrator() {}
+
+func (s *MetricSplit) Do(ss StateSelector, metrics pmetric.MetricSlice) {
+	switch ss {
+	case StateSelectorApply:
+		s.split(metrics)
+	case StateSelectorRollback:
+		s.merge(metrics)
+	}
+}
+
+// split moves the data points of the original metric to the new metrics, by
+// attribute value. Data points without a matching value are left in the original
+// metric, which is removed if none is left.
+func (s *MetricSplit) split(metrics pmetric.MetricSlice) {
+	created := pmetric.NewMetricSlice()
+	for i := 0; i < metrics.Len(); i++ {
+		metric := metrics.At(i)
+		if metric.Name() != s.metric {
+			continue
+		}
+		for _, name := range s.names {
+			value := s.metrics[name]
+			target := newMetricLike(metric, name)
+			moveDataPoints(metric, target, func(attrs pcommon.Map) bool {
+				v, ok := attrs.Get(s.attribute)
+				if !ok || !v.Equal(value) {
+					return false
+				}
+				attrs.Remove(s.attribute)
+				return true
+			})
+			if dataPointCount(target) > 0 {
+				target.MoveTo(created.AppendEmpty())
+			}
+		}
+	}
+	if created.Len() == 0 {
+		return
+	}
+	metrics.RemoveIf(func(metric pmetric.Metric) bool {
+		return metric.Name() == s.metric && dataPointCount(metric) == 0
+	})
+	created.MoveAndAppendTo(metrics)
+}
+
+// merge moves the data points of the new metrics back to the original metric,
+// with the attribute value of the metric they are in.
+func (s *MetricSplit) merge(metrics pmetric.MetricSlice) {
+	var merged pmetric.Metric
+	found := false
+	for i := 0; i < metrics.Len(); i++ {
+		if metric := metrics.At(i); metric.Name() == s.metric {
+			merged, found = metric, true
+			break
+		}
+	}
+
+	created := false
+	for i := 0; i < metrics.Len(); i++ {
+		metric := metrics.At(i)
+		value, ok := s.metrics[metric.Name()]
+		if !ok {
+			continue
+		}
+		if !found {
+			merged, found, created = newMetricLike(metric, s.metric), true, true
+		}
+		if merged.Type() != metric.Type() {
+			continue
+		}
+		moveDataPoints(metric, merged, func(attrs pcommon.Map) bool {
+			value.CopyTo(attrs.PutEmpty(s.attribute))
+			return true
+		})
+	}
+	if !found {
+		return
+	}
+
+	metrics.RemoveIf(func(metric pmetric.Metric) bool {
+		_, ok := s.metrics[metric.Name()]
+		return ok && dataPointCount(metric) == 0
+	})
+	if created {
+		merged.MoveTo(metrics.AppendEmpty())
+	}
+}
+
+// newValue converts an attribute value of a schema file into a pcommon.Value.
+func newValue(value any) pcommon.Value {
+	switch v := value.(type) {
+	case string:
+		return pcommon.NewValueStr(v)
+	case bool:
+		return pcommon.NewValueBool(v)
+	case int:
+		return pcommon.NewValueInt(int64(v))
+	case int64:
+		return pcommon.NewValueInt(v)
+	case float64:
+		return pcommon.NewValueDouble(v)
+	default:
+		return pcommon.NewValueStr(fmt.Sprint(v))
+	}
+}
+
+// newMetricLike returns an empty metric with the given name, and the same
+// description, unit and type as metric.
+func newMetricLike(metric pmetric.Metric, name string) pmetric.Metric {
+	m := pmetric.NewMetric()
+	m.SetName(name)
+	m.SetDescription(metric.Description())
+	m.SetUnit(metric.Unit())
+	metric.Metadata().CopyTo(m.Metadata())
+	switch metric.Type() {
+	case pmetric.MetricTypeGauge:
+		m.SetEmptyGauge()
+	case pmetric.MetricTypeSum:
+		m.SetEmptySum().SetAggregationTemporality(metric.Sum().AggregationTemporality())
+		m.Sum().SetIsMonotonic(metric.Sum().IsMonotonic())
+	case pmetric.MetricTypeHistogram:
+		m.SetEmptyHistogram().SetAggregationTemporality(metric.Histogram().AggregationTemporality())
+	case pmetric.MetricTypeExponentialHistogram:
+		m.SetEmptyExponentialHistogram().SetAggregationTemporality(metric.ExponentialHistogram().AggregationTemporality())
+	case pmetric.MetricTypeSummary:
+		m.SetEmptySummary()
+	case pmetric.MetricTypeEmpty:
+	}
+	return m
+}
+
+// moveDataPoints moves the data points of from for which move returns true to
+// to, which must be of the same type. move may modify the attributes of the
+// data point before it is moved.
+func moveDataPoints(from, to pmetric.Metric, move func(pcommon.Map) bool) {
+	switch from.Type() {
+	case pmetric.MetricTypeGauge:
+		dps := to.Gauge().DataPoints()
+		from.Gauge().DataPoints().RemoveIf(func(dp pmetric.NumberDataPoint) bool {
+			if !move(dp.Attributes()) {
+				return false
+			}
+			dp.MoveTo(dps.AppendEmpty())
+			return true
+		})
+	case pmetric.MetricTypeSum:
+		dps := to.Sum().DataPoints()
+		from.Sum().DataPoints().RemoveIf(func(dp pmetric.NumberDataPoint) bool {
+			if !move(dp.Attributes()) {
+				return false
+			}
+			dp.MoveTo(dps.AppendEmpty())
+			return true
+		})
+	case pmetric.MetricTypeHistogram:
+		dps := to.Histogram().DataPoints()
+		from.Histogram().DataPoints().RemoveIf(func(dp pmetric.HistogramDataPoint) bool {
+			if !move(dp.Attributes()) {
+				return false
+			}
+			dp.MoveTo(dps.AppendEmpty())
+			return true
+		})
+	case pmetric.MetricTypeExponentialHistogram:
+		dps := to.ExponentialHistogram().DataPoints()
+		from.ExponentialHistogram().DataPoints().RemoveIf(func(dp pmetric.ExponentialHistogramDataPoint) bool {
+			if !move(dp.Attributes()) {
+				return false
+			}
+			dp.MoveTo(dps.AppendEmpty())
+			return true
+		})
+	case pmetric.MetricTypeSummary:
+		dps := to.Summary().DataPoints()
+		from.Summary().DataPoints().RemoveIf(func(dp pmetric.SummaryDataPoint) bool {
+			if !move(dp.Attributes()) {
+				return false
+			}
+			dp.MoveTo(dps.AppendEmpty())
+			return true
+		})
+	case pmetric.MetricTypeEmpty:
+	}
+}
+
+func dataPointCount(metric pmetric.Metric) int {
+	switch metric.Type() {
+	case pmetric.MetricTypeGauge:
+		return metric.Gauge().DataPoints().Len()
+	case pmetric.MetricTypeSum:
+		return metric.Sum().DataPoints().Len()
+	case pmetric.MetricTypeHistogram:
+		return metric.Histogram().DataPoints().Len()
+	case pmetric.MetricTypeExponentialHistogram:
+		return metric.ExponentialHistogram().DataPoints().Len()
+	case pmetric.MetricTypeSummary:
+		return metric.Summary().DataPoints().Len()
+	default:
+		return 0
+	}
+}
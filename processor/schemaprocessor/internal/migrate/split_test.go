@@ -0,0 +1,142 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package migrate
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+)
+
+func newPagingSplit() MetricSplit {
+	return NewMetricSplit("system.paging.operations", "direction", map[string]any{
+		"system.paging.operations.in":  "in",
+		"system.paging.operations.out": "out",
+	})
+}
+
+// dataPoints returns the attributes of the data points of each histogram, by metric name.
+func dataPoints(metrics pmetric.MetricSlice) map[string][]map[string]any {
+	points := make(map[string][]map[string]any)
+	for i := 0; i < metrics.Len(); i++ {
+		metric := metrics.At(i)
+		dps := metric.Histogram().DataPoints()
+		for j := 0; j < dps.Len(); j++ {
+			points[metric.Name()] = append(points[metric.Name()], dps.At(j).Attributes().AsRaw())
+		}
+	}
+	return points
+}
+
+func newHistogram(metrics pmetric.MetricSlice, name string, attrs ...map[string]any) {
+	m := metrics.AppendEmpty()
+	m.SetName(name)
+	m.SetUnit("s")
+	m.SetEmptyHistogram().SetAggregationTemporality(pmetric.AggregationTemporalityDelta)
+	for _, a := range attrs {
+		dp := m.Histogram().DataPoints().AppendEmpty()
+		_ = dp.Attributes().FromRaw(a)
+		dp.SetCount(1)
+	}
+}
+
+func TestMetricSplitApply(t *testing.T) {
+	t.Parallel()
+
+	metrics := pmetric.NewMetricSlice()
+	newHistogram(metrics, "system.paging.operations",
+		map[string]any{"direction": "in", "device": "sda"},
+		map[string]any{"direction": "out", "device": "sda"},
+		map[string]any{"direction": "in", "device": "sdb"},
+	)
+	newHistogram(metrics, "system.uptime", map[string]any{"direction": "in"})
+
+	split := newPagingSplit()
+	split.Do(StateSelectorApply, metrics)
+
+	require.Equal(t, 3, metrics.Len())
+	assert.Equal(t, map[string][]map[string]any{
+		"system.uptime":                {{"direction": "in"}},
+		"system.paging.operations.in":  {{"device": "sda"}, {"device": "sdb"}},
+		"system.paging.operations.out": {{"device": "sda"}},
+	}, dataPoints(metrics))
+
+	for i := 0; i < metrics.Len(); i++ {
+		assert.Equal(t, "s", metrics.At(i).Unit())
+		assert.Equal(t, pmetric.AggregationTemporalityDelta, metrics.At(i).Histogram().AggregationTemporality())
+	}
+}
+
+func TestMetricSplitApplyUnmatchedValues(t *testing.T) {
+	t.Parallel()
+
+	metrics := pmetric.NewMetricSlice()
+	newHistogram(metrics, "system.paging.operations",
+		map[string]any{"direction": "in"},
+		map[string]any{"direction": "unknown"},
+		map[string]any{"device": "sda"},
+	)
+
+	split := newPagingSplit()
+	split.Do(StateSelectorApply, metrics)
+
+	assert.Equal(t, map[string][]map[string]any{
+		"system.paging.operations":    {{"direction": "unknown"}, {"device": "sda"}},
+		"system.paging.operations.in": {{}},
+	}, dataPoints(metrics))
+}
+
+func TestMetricSplitRollback(t *testing.T) {
+	t.Parallel()
+
+	metrics := pmetric.NewMetricSlice()
+	newHistogram(metrics, "system.paging.operations.in", map[string]any{"device": "sda"})
+	newHistogram(metrics, "system.uptime", map[string]any{"device": "sda"})
+	newHistogram(metrics, "system.paging.operations.out", map[string]any{"device": "sda"}, map[string]any{"device": "sdb"})
+
+	split := newPagingSplit()
+	split.Do(StateSelectorRollback, metrics)
+
+	require.Equal(t, 2, metrics.Len())
+	assert.Equal(t, map[string][]map[string]any{
+		"system.uptime": {{"device": "sda"}},
+		"system.paging.operations": {
+			{"device": "sda", "direction": "in"},
+			{"device": "sda", "direction": "out"},
+			{"device": "sdb", "direction": "out"},
+		},
+	}, dataPoints(metrics))
+}
+
+func TestMetricSplitRollbackExistingMetric(t *testing.T) {
+	t.Parallel()
+
+	metrics := pmetric.NewMetricSlice()
+	newHistogram(metrics, "system.paging.operations", map[string]any{"direction": "unknown"})
+	newHistogram(metrics, "system.paging.operations.in", map[string]any{})
+
+	split := newPagingSplit()
+	split.Do(StateSelectorRollback, metrics)
+
+	assert.Equal(t, map[string][]map[string]any{
+		"system.paging.operations": {{"direction": "unknown"}, {"direction": "in"}},
+	}, dataPoints(metrics))
+}
+
+func TestNewMetricSplitValues(t *testing.T) {
+	t.Parallel()
+
+	split := NewMetricSplit("http.requests", "status", map[string]any{
+		"http.requests.ok":     200,
+		"http.requests.failed": false,
+		"http.requests.ratio":  0.5,
+	})
+	assert.Equal(t, []string{"http.requests.failed", "http.requests.ok", "http.requests.ratio"}, split.names)
+	assert.Equal(t, pcommon.NewValueInt(200), split.metrics["http.requests.ok"])
+	assert.Equal(t, pcommon.NewValueBool(false), split.metrics["http.requests.failed"])
+	assert.Equal(t, pcommon.NewValueDouble(0.5), split.metrics["http.requests.ratio"])
+}
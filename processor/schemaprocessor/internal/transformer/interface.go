@@ -11,6 +11,6 @@ import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/schemaprocessor/internal/migrate"
 )
 
-type Transformer[T pmetric.Metric | plog.LogRecord | ptrace.Span | pcommon.Resource] interface {
+type Transformer[T pmetric.Metric | pmetric.MetricSlice | plog.LogRecord | ptrace.Span | pcommon.Resource] interface {
 	Do(ss migrate.StateSelector, data T) error
 }
@@ -38,3 +38,16 @@ func (c MetricSignalNameChange) Do(ss migrate.StateSelector, metric pmetric.Metr
 	c.SignalNameChange.Do(ss, metric)
 	return nil
 }
+
+// MetricSplit is an transformer that powers the [Metric's split] change.
+// [Metric's split]: https://opentelemetry.io/docs/specs/otel/schemas/file_format_v1.1.0/#split-transformation
+type MetricSplit struct {
+	MetricSplit migrate.MetricSplit
+}
+
+func (MetricSplit) IsMigrator() {}
+
+func (c MetricSplit) Do(ss migrate.StateSelector, metrics pmetric.MetricSlice) error {
+	c.MetricSplit.Do(ss, metrics)
+	return nil
+}
@@ -32,3 +32,24 @@ func TestMetricSignalNameChangeTransformer(t *testing.T) {
 	require.NoError(t, c.Do(migrate.StateSelectorApply, s))
 	require.Equal(t, "event_name", s.Name())
 }
+
+func TestMetricSplitTransformer(t *testing.T) {
+	s := pmetric.NewMetricSlice()
+	m := s.AppendEmpty()
+	m.SetName("system.paging.operations")
+	m.SetEmptyGauge().DataPoints().AppendEmpty().Attributes().PutStr("direction", "in")
+	c := MetricSplit{MetricSplit: migrate.NewMetricSplit("system.paging.operations", "direction", map[string]string{
+		"system.paging.operations.in": "in",
+	})}
+	require.NoError(t, c.Do(migrate.StateSelectorApply, s))
+	require.Equal(t, 1, s.Len())
+	require.Equal(t, "system.paging.operations.in", s.At(0).Name())
+	require.Equal(t, 0, s.At(0).Gauge().DataPoints().At(0).Attributes().Len())
+
+	require.NoError(t, c.Do(migrate.StateSelectorRollback, s))
+	require.Equal(t, 1, s.Len())
+	require.Equal(t, "system.paging.operations", s.At(0).Name())
+	direction, ok := s.At(0).Gauge().DataPoints().At(0).Attributes().Get("direction")
+	require.True(t, ok)
+	require.Equal(t, "in", direction.Str())
+}
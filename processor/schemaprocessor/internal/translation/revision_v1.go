@@ -13,8 +13,7 @@ import (
 )
 
 // RevisionV1 represents all changes that are to be applied to a signal at a given version.  V1 represents the fact
-// that this struct only support the Schema Files version 1.0 - not 1.1 which contains split.
-// todo(ankit) implement split and rest of otel schema
+// that this struct supports the Schema Files version 1.x, that is 1.0 and 1.1 which adds split.
 type RevisionV1 struct {
 	ver        *Version
 	all        *changelist.ChangeList
@@ -95,8 +94,10 @@ func newMetricChangeList(metrics ast11.Metrics) *changelist.ChangeList {
 			values = append(values, signalNameChange)
 		}
 		if splitMetrics := at.Split; splitMetrics != nil {
-			// TODO: Implement split
-			continue
+			metricSplit := transformer.MetricSplit{
+				MetricSplit: migrate.NewMetricSplit(splitMetrics.ApplyToMetric, splitMetrics.ByAttribute, splitMetrics.MetricsFromAttributes),
+			}
+			values = append(values, metricSplit)
 		}
 	}
 	return &changelist.ChangeList{Migrators: values}
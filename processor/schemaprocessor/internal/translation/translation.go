@@ -273,21 +273,25 @@ func (t *translator) ApplyScopeMetricChanges(scopeMetrics pmetric.ScopeMetrics,
 	}
 	it, status := t.iterator(ver)
 	for rev, more := it(); more; rev, more = it() {
-		for i := 0; i < scopeMetrics.Metrics().Len(); i++ {
-			metric := scopeMetrics.Metrics().At(i)
-			switch status {
-			case Update:
-				if err := rev.all.Apply(metric); err != nil {
-					return err
-				}
-				if err := rev.metrics.Apply(metric); err != nil {
-					return err
-				}
-			case Revert:
-				if err := rev.metrics.Rollback(metric); err != nil {
+		// The metric changes act on the whole slice of metrics, since split
+		// changes add and remove metrics.
+		metrics := scopeMetrics.Metrics()
+		switch status {
+		case Update:
+			for i := 0; i < metrics.Len(); i++ {
+				if err := rev.all.Apply(metrics.At(i)); err != nil {
 					return err
 				}
-				if err := rev.all.Rollback(metric); err != nil {
+			}
+			if err := rev.metrics.Apply(metrics); err != nil {
+				return err
+			}
+		case Revert:
+			if err := rev.metrics.Rollback(metrics); err != nil {
+				return err
+			}
+			for i := 0; i < metrics.Len(); i++ {
+				if err := rev.all.Rollback(metrics.At(i)); err != nil {
 					return err
 				}
 			}
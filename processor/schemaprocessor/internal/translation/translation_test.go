@@ -357,6 +357,87 @@ func TestTranslationMetricChanges(t *testing.T) {
 	}
 }
 
+// newPagingMetrics returns the paging operations of a device, either as a single
+// metric with a direction attribute, or split into a metric per direction.
+func newPagingMetrics(split bool, deviceKey string) pmetric.Metrics {
+	metrics := pmetric.NewMetrics()
+	ms := metrics.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics()
+	newSum := func(name string) pmetric.NumberDataPointSlice {
+		m := ms.AppendEmpty()
+		m.SetName(name)
+		m.SetUnit("{operation}")
+		m.SetEmptySum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+		m.Sum().SetIsMonotonic(true)
+		return m.Sum().DataPoints()
+	}
+	if split {
+		in := newSum("system.paging.operations.in").AppendEmpty()
+		in.Attributes().PutStr(deviceKey, "sda")
+		in.SetIntValue(10)
+		out := newSum("system.paging.operations.out").AppendEmpty()
+		out.Attributes().PutStr(deviceKey, "sda")
+		out.SetIntValue(20)
+		return metrics
+	}
+	dps := newSum("system.paging.operations")
+	for _, v := range []struct {
+		direction string
+		value     int64
+	}{{"in", 10}, {"out", 20}} {
+		dp := dps.AppendEmpty()
+		dp.Attributes().PutStr(deviceKey, "sda")
+		dp.Attributes().PutStr("direction", v.direction)
+		dp.SetIntValue(v.value)
+	}
+	return metrics
+}
+
+func TestTranslationMetricSplit(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		scenario string
+		target   Version
+		income   Version
+		in       pmetric.Metrics
+		expect   pmetric.Metrics
+	}{
+		{
+			scenario: "Upgrade splits the metric",
+			income:   Version{1, 0, 0},
+			target:   Version{1, 1, 0},
+			in:       newPagingMetrics(false, "device"),
+			expect:   newPagingMetrics(true, "system.device"),
+		},
+		{
+			scenario: "Downgrade merges the metrics",
+			income:   Version{1, 1, 0},
+			target:   Version{1, 0, 0},
+			in:       newPagingMetrics(true, "system.device"),
+			expect:   newPagingMetrics(false, "device"),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.scenario, func(t *testing.T) {
+			tn, err := newTranslator(
+				zaptest.NewLogger(t),
+				joinSchemaFamilyAndVersion("https://example.com/", &tc.target),
+				LoadTranslationVersion(t, "split_changeset.yml"),
+			)
+			require.NoError(t, err, "Must not error creating translator")
+
+			inSchemaURL := joinSchemaFamilyAndVersion("https://example.com/", &tc.income)
+			scopeMetrics := tc.in.ResourceMetrics().At(0).ScopeMetrics().At(0)
+			scopeMetrics.SetSchemaUrl(inSchemaURL)
+			require.NoError(t, tn.ApplyScopeMetricChanges(scopeMetrics, inSchemaURL), "Must not error when applying scope metric changes")
+
+			tc.expect.ResourceMetrics().At(0).ScopeMetrics().At(0).SetSchemaUrl(joinSchemaFamilyAndVersion("https://example.com/", &tc.target))
+			assert.NoError(t, pmetrictest.CompareMetrics(tc.expect, tc.in), "Must match the expected values")
+		})
+	}
+}
+
 func TestTranslationEquvialance_Logs(t *testing.T) {
 	t.Parallel()
 
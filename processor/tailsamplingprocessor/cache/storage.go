@@ -0,0 +1,50 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package cache // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/tailsamplingprocessor/cache"
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+
+	"go.opentelemetry.io/collector/extension/xextension/storage"
+)
+
+// Save writes the trace IDs held by c to the storage client under the given key, from
+// the least to the most recently used. Caches that are not LRU decision caches hold
+// nothing worth persisting and are ignored.
+func Save(ctx context.Context, client storage.Client, key string, c Cache[bool]) error {
+	lc, ok := c.(*lruDecisionCache[bool])
+	if !ok {
+		return nil
+	}
+
+	keys := lc.cache.Keys()
+	buf := make([]byte, 8*len(keys))
+	for i, k := range keys {
+		binary.LittleEndian.PutUint64(buf[8*i:], k)
+	}
+	return client.Set(ctx, key, buf)
+}
+
+// Load restores into c the trace IDs previously saved under the given key with Save.
+// Nothing is restored when the key is not found or when c is not an LRU decision cache.
+func Load(ctx context.Context, client storage.Client, key string, c Cache[bool]) error {
+	lc, ok := c.(*lruDecisionCache[bool])
+	if !ok {
+		return nil
+	}
+
+	buf, err := client.Get(ctx, key)
+	if err != nil {
+		return err
+	}
+	if len(buf)%8 != 0 {
+		return fmt.Errorf("invalid decision cache %q: unexpected length %d", key, len(buf))
+	}
+	for i := 0; i < len(buf); i += 8 {
+		_ = lc.cache.Add(binary.LittleEndian.Uint64(buf[i:]), true)
+	}
+	return nil
+}
@@ -0,0 +1,74 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package cache
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/storage/storagetest"
+)
+
+func newTestClient() *storagetest.TestClient {
+	return storagetest.NewInMemoryClient(component.KindProcessor, component.MustNewID("tail_sampling"), "")
+}
+
+func TestSaveAndLoad(t *testing.T) {
+	client := newTestClient()
+
+	c, err := NewLRUDecisionCache[bool](2)
+	require.NoError(t, err)
+	id1, err := traceIDFromHex("12341234123412341234123412341231")
+	require.NoError(t, err)
+	id2, err := traceIDFromHex("12341234123412341234123412341232")
+	require.NoError(t, err)
+	id3, err := traceIDFromHex("12341234123412341234123412341233")
+	require.NoError(t, err)
+	c.Put(id1, true)
+	c.Put(id2, true)
+	require.NoError(t, Save(context.Background(), client, "sampled", c))
+
+	restored, err := NewLRUDecisionCache[bool](2)
+	require.NoError(t, err)
+	require.NoError(t, Load(context.Background(), client, "sampled", restored))
+
+	_, ok := restored.Get(id1)
+	assert.True(t, ok)
+	_, ok = restored.Get(id2)
+	assert.True(t, ok)
+
+	// Recency is preserved, so id1 is the first to be evicted
+	restored.Put(id3, true)
+	_, ok = restored.Get(id1)
+	assert.False(t, ok)
+	_, ok = restored.Get(id2)
+	assert.True(t, ok)
+}
+
+func TestLoadMissingKey(t *testing.T) {
+	c, err := NewLRUDecisionCache[bool](2)
+	require.NoError(t, err)
+	require.NoError(t, Load(context.Background(), newTestClient(), "sampled", c))
+}
+
+func TestLoadInvalid(t *testing.T) {
+	client := newTestClient()
+	require.NoError(t, client.Set(context.Background(), "sampled", []byte{1, 2, 3}))
+
+	c, err := NewLRUDecisionCache[bool](2)
+	require.NoError(t, err)
+	require.EqualError(t, Load(context.Background(), client, "sampled", c), `invalid decision cache "sampled": unexpected length 3`)
+}
+
+func TestSaveNopCache(t *testing.T) {
+	client := newTestClient()
+	require.NoError(t, Save(context.Background(), client, "sampled", NewNopDecisionCache[bool]()))
+	data, err := client.Get(context.Background(), "sampled")
+	require.NoError(t, err)
+	assert.Nil(t, data)
+}
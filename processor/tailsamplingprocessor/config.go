@@ -6,6 +6,8 @@ package tailsamplingprocessor // import "github.com/open-telemetry/opentelemetry
 import (
 	"time"
 
+	"go.opentelemetry.io/collector/component"
+
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
 )
 
@@ -35,6 +37,9 @@ const (
 	And PolicyType = "and"
 	// Drop allows defining a Drop policy, combining one or more policies to drop traces.
 	Drop PolicyType = "drop"
+	// ServiceBudget allows defining a ServiceBudget policy, sharing a per-service spans per second
+	// budget between weighted sub-policies.
+	ServiceBudget PolicyType = "service_budget"
 	// SpanCount sample traces that are have more spans per Trace than a given threshold.
 	SpanCount PolicyType = "span_count"
 	// TraceState sample traces with specified values by the given key
@@ -122,6 +127,26 @@ type RateAllocationCfg struct {
 	Percent int64  `mapstructure:"percent"`
 }
 
+// ServiceBudgetSubPolicyCfg holds the common configuration to all policies under service budget policy.
+type ServiceBudgetSubPolicyCfg struct {
+	sharedPolicyCfg `mapstructure:",squash"` // squash ensures fields are correctly decoded in embedded struct
+
+	// Weight is the share of each service budget this sub-policy may consume, relative to the
+	// weights of the other sub-policies. Defaults to 1.
+	Weight int64 `mapstructure:"weight"`
+}
+
+// ServiceBudgetCfg holds the configurable settings to create a service budget
+// sampling policy evaluator.
+type ServiceBudgetCfg struct {
+	// SpansPerSecond is the maximum number of spans per second sampled for each service.
+	SpansPerSecond int64 `mapstructure:"spans_per_second"`
+	// ServiceAttribute is the resource attribute identifying the service owning a trace.
+	// Defaults to "service.name".
+	ServiceAttribute string                      `mapstructure:"service_attribute"`
+	SubPolicyCfg     []ServiceBudgetSubPolicyCfg `mapstructure:"service_budget_sub_policy"`
+}
+
 // PolicyCfg holds the common configuration to all policies.
 type PolicyCfg struct {
 	sharedPolicyCfg `mapstructure:",squash"` // squash ensures fields are correctly decoded in embedded struct
@@ -132,6 +157,8 @@ type PolicyCfg struct {
 	AndCfg AndCfg `mapstructure:"and"`
 	// Configs for defining drop policy
 	DropCfg DropCfg `mapstructure:"drop"`
+	// Configs for defining service budget policy
+	ServiceBudgetCfg ServiceBudgetCfg `mapstructure:"service_budget"`
 }
 
 // LatencyCfg holds the configurable settings to create a latency filter sampling policy
@@ -244,6 +271,14 @@ type DecisionCacheConfig struct {
 	// For effective use, this value should be at least an order of magnitude greater than Config.NumTraces.
 	// If left as default 0, a no-op DecisionCache will be used.
 	NonSampledCacheSize int `mapstructure:"non_sampled_cache_size"`
+	// StorageID is the ID of a storage extension used to persist the decision caches across restarts,
+	// so that spans arriving late after a restart get the same decision as the rest of their trace.
+	// If left unset, decisions are only kept in memory.
+	StorageID *component.ID `mapstructure:"storage"`
+	// PersistInterval is the interval at which the decision caches are saved to the storage extension,
+	// so that the decisions aren't lost if the collector crashes. The caches are also saved on shutdown.
+	// If set to 0, the caches are only saved on shutdown.
+	PersistInterval time.Duration `mapstructure:"persist_interval"`
 }
 
 // Config holds the configuration for tail-based sampling.
@@ -30,12 +30,14 @@ func TestLoadConfig(t *testing.T) {
 	require.NoError(t, err)
 	require.NoError(t, sub.Unmarshal(cfg))
 
+	storageID := component.MustNewID("file_storage")
+
 	assert.Equal(t,
 		&Config{
 			DecisionWait:            10 * time.Second,
 			NumTraces:               100,
 			ExpectedNewTracesPerSec: 10,
-			DecisionCache:           DecisionCacheConfig{SampledCacheSize: 1_000, NonSampledCacheSize: 10_000},
+			DecisionCache:           DecisionCacheConfig{SampledCacheSize: 1_000, NonSampledCacheSize: 10_000, StorageID: &storageID, PersistInterval: time.Minute},
 			PolicyCfgs: []PolicyCfg{
 				{
 					sharedPolicyCfg: sharedPolicyCfg{
@@ -183,6 +185,33 @@ func TestLoadConfig(t *testing.T) {
 						},
 					},
 				},
+				{
+					sharedPolicyCfg: sharedPolicyCfg{
+						Name: "service-budget-policy-1",
+						Type: ServiceBudget,
+					},
+					ServiceBudgetCfg: ServiceBudgetCfg{
+						SpansPerSecond: 500,
+						SubPolicyCfg: []ServiceBudgetSubPolicyCfg{
+							{
+								sharedPolicyCfg: sharedPolicyCfg{
+									Name:          "test-service-budget-policy-1",
+									Type:          StatusCode,
+									StatusCodeCfg: StatusCodeCfg{StatusCodes: []string{"ERROR"}},
+								},
+								Weight: 3,
+							},
+							{
+								sharedPolicyCfg: sharedPolicyCfg{
+									Name:             "test-service-budget-policy-2",
+									Type:             Probabilistic,
+									ProbabilisticCfg: ProbabilisticCfg{SamplingPercentage: 10},
+								},
+								Weight: 1,
+							},
+						},
+					},
+				},
 			},
 		}, cfg)
 }
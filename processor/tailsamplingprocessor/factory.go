@@ -30,6 +30,9 @@ func createDefaultConfig() component.Config {
 		DecisionWait:       30 * time.Second,
 		NumTraces:          50000,
 		SampleOnFirstMatch: false,
+		DecisionCache: DecisionCacheConfig{
+			PersistInterval: 30 * time.Second,
+		},
 	}
 }
 
@@ -0,0 +1,169 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package sampling // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/tailsamplingprocessor/internal/sampling"
+
+import (
+	"context"
+	"errors"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.uber.org/zap"
+)
+
+type budgetSubpolicy struct {
+	// the subpolicy evaluator
+	evaluator PolicyEvaluator
+
+	// relative share of each service budget that this subpolicy may consume
+	weight int64
+
+	name string
+}
+
+// ServiceBudget evaluator and its internal data
+type ServiceBudget struct {
+	// the subpolicy evaluators
+	subpolicies []*budgetSubpolicy
+
+	// sum of the weights of all subpolicies
+	totalWeight int64
+
+	// maximum spans per second that may be sampled for a single service
+	spansPerSecond int64
+
+	// resource attribute identifying the service owning a trace
+	serviceAttribute string
+
+	// spans sampled in the current second, by service and then by subpolicy index
+	sampledSPS map[string][]int64
+
+	// current unix timestamp second
+	currentSecond int64
+
+	// The time provider (can be different from clock for testing purposes)
+	timeProvider TimeProvider
+
+	logger          *zap.Logger
+	recordSubPolicy bool
+}
+
+var _ PolicyEvaluator = (*ServiceBudget)(nil)
+
+// BudgetSubPolicyEvalParams defines the evaluator and weight for a service budget sub-policy
+type BudgetSubPolicyEvalParams struct {
+	Evaluator PolicyEvaluator
+	Weight    int64
+	Name      string
+}
+
+// NewServiceBudget creates a policy evaluator that samples traces accepted by its subpolicies
+// as long as the service owning the trace stays within its spans per second budget. The budget
+// is split between the subpolicies proportionally to their weights.
+func NewServiceBudget(
+	logger *zap.Logger,
+	spansPerSecond int64,
+	serviceAttribute string,
+	subPolicyParams []BudgetSubPolicyEvalParams,
+	timeProvider TimeProvider,
+	recordSubPolicy bool,
+) (PolicyEvaluator, error) {
+	if spansPerSecond <= 0 {
+		return nil, errors.New("spans_per_second must be greater than zero")
+	}
+	if len(subPolicyParams) == 0 {
+		return nil, errors.New("at least one sub-policy is required")
+	}
+
+	var totalWeight int64
+	subpolicies := make([]*budgetSubpolicy, 0, len(subPolicyParams))
+	for _, params := range subPolicyParams {
+		if params.Weight < 0 {
+			return nil, errors.New("sub-policy weight cannot be negative")
+		}
+		weight := params.Weight
+		if weight == 0 {
+			// Unweighted subpolicies get an equal share
+			weight = 1
+		}
+		totalWeight += weight
+		subpolicies = append(subpolicies, &budgetSubpolicy{
+			evaluator: params.Evaluator,
+			weight:    weight,
+			name:      params.Name,
+		})
+	}
+
+	return &ServiceBudget{
+		subpolicies:      subpolicies,
+		totalWeight:      totalWeight,
+		spansPerSecond:   spansPerSecond,
+		serviceAttribute: serviceAttribute,
+		sampledSPS:       make(map[string][]int64),
+		timeProvider:     timeProvider,
+		logger:           logger,
+		recordSubPolicy:  recordSubPolicy,
+	}, nil
+}
+
+// Evaluate looks at the trace data and returns a corresponding SamplingDecision.
+func (s *ServiceBudget) Evaluate(ctx context.Context, traceID pcommon.TraceID, trace *TraceData) (Decision, error) {
+	// Works like the composite policy, except that the counters are kept per
+	// service. Dropping all the counters at the beginning of each second also
+	// bounds the memory used to the services seen within a single second.
+	currSecond := s.timeProvider.getCurSecond()
+	if s.currentSecond != currSecond {
+		s.currentSecond = currSecond
+		clear(s.sampledSPS)
+	}
+
+	service := s.serviceName(trace)
+	counters, ok := s.sampledSPS[service]
+	if !ok {
+		counters = make([]int64, len(s.subpolicies))
+		s.sampledSPS[service] = counters
+	}
+
+	for i, sub := range s.subpolicies {
+		decision, err := sub.evaluator.Evaluate(ctx, traceID, trace)
+		if err != nil {
+			return Unspecified, err
+		}
+
+		if decision != Sampled && decision != InvertSampled {
+			continue
+		}
+
+		allocatedSPS := s.spansPerSecond * sub.weight / s.totalWeight
+		spansInSecondIfSampled := counters[i] + trace.SpanCount.Load()
+		if spansInSecondIfSampled <= allocatedSPS {
+			counters[i] = spansInSecondIfSampled
+			if s.recordSubPolicy {
+				SetAttrOnScopeSpans(trace, "tailsampling.service_budget_policy", sub.name)
+			}
+			return Sampled, nil
+		}
+
+		s.logger.Debug("Service exceeded its sampling budget",
+			zap.String("service", service),
+			zap.String("sub_policy", sub.name))
+		return NotSampled, nil
+	}
+
+	return NotSampled, nil
+}
+
+// serviceName returns the value of the service attribute of the first resource
+// carrying it, or an empty string when no resource does.
+func (s *ServiceBudget) serviceName(trace *TraceData) string {
+	trace.Lock()
+	defer trace.Unlock()
+
+	rss := trace.ReceivedBatches.ResourceSpans()
+	for i := 0; i < rss.Len(); i++ {
+		if v, ok := rss.At(i).Resource().Attributes().Get(s.serviceAttribute); ok {
+			return v.AsString()
+		}
+	}
+	return ""
+}
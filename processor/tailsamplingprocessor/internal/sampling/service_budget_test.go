@@ -0,0 +1,107 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package sampling
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.uber.org/zap"
+)
+
+func newTraceForService(service string, spans int64) *TraceData {
+	traces := ptrace.NewTraces()
+	rs := traces.ResourceSpans().AppendEmpty()
+	if service != "" {
+		rs.Resource().Attributes().PutStr("service.name", service)
+	}
+	span := rs.ScopeSpans().AppendEmpty().Spans().AppendEmpty()
+	span.SetTraceID(traceID)
+
+	spanCount := &atomic.Int64{}
+	spanCount.Store(spans)
+	return &TraceData{
+		ReceivedBatches: traces,
+		SpanCount:       spanCount,
+	}
+}
+
+func TestNewServiceBudgetInvalid(t *testing.T) {
+	always := NewAlwaysSample(componenttest.NewNopTelemetrySettings())
+
+	_, err := NewServiceBudget(zap.NewNop(), 0, "service.name", []BudgetSubPolicyEvalParams{{always, 1, "eval-1"}}, FakeTimeProvider{}, false)
+	require.EqualError(t, err, "spans_per_second must be greater than zero")
+
+	_, err = NewServiceBudget(zap.NewNop(), 10, "service.name", nil, FakeTimeProvider{}, false)
+	require.EqualError(t, err, "at least one sub-policy is required")
+
+	_, err = NewServiceBudget(zap.NewNop(), 10, "service.name", []BudgetSubPolicyEvalParams{{always, -1, "eval-1"}}, FakeTimeProvider{}, false)
+	require.EqualError(t, err, "sub-policy weight cannot be negative")
+}
+
+func TestServiceBudgetIsolatesServices(t *testing.T) {
+	timeProvider := &FakeTimeProvider{second: 0}
+	always := NewAlwaysSample(componenttest.NewNopTelemetrySettings())
+	s, err := NewServiceBudget(zap.NewNop(), 10, "service.name", []BudgetSubPolicyEvalParams{{always, 1, "eval-1"}}, timeProvider, false)
+	require.NoError(t, err)
+
+	// The noisy service consumes its whole budget
+	decision, err := s.Evaluate(context.Background(), traceID, newTraceForService("noisy", 10))
+	require.NoError(t, err)
+	assert.Equal(t, Sampled, decision)
+
+	decision, err = s.Evaluate(context.Background(), traceID, newTraceForService("noisy", 1))
+	require.NoError(t, err)
+	assert.Equal(t, NotSampled, decision)
+
+	// Other services still have their own budget
+	decision, err = s.Evaluate(context.Background(), traceID, newTraceForService("quiet", 5))
+	require.NoError(t, err)
+	assert.Equal(t, Sampled, decision)
+
+	decision, err = s.Evaluate(context.Background(), traceID, newTraceForService("", 5))
+	require.NoError(t, err)
+	assert.Equal(t, Sampled, decision)
+
+	// The budget is restored on the next second
+	timeProvider.second = 1
+	decision, err = s.Evaluate(context.Background(), traceID, newTraceForService("noisy", 10))
+	require.NoError(t, err)
+	assert.Equal(t, Sampled, decision)
+}
+
+func TestServiceBudgetWeightedSubPolicies(t *testing.T) {
+	timeProvider := &FakeTimeProvider{second: 0}
+	min0 := int64(0)
+	max100 := int64(100)
+	n1 := NewNumericAttributeFilter(componenttest.NewNopTelemetrySettings(), "tag", &min0, &max100, false)
+	n2 := NewAlwaysSample(componenttest.NewNopTelemetrySettings())
+	s, err := NewServiceBudget(zap.NewNop(), 100, "service.name", []BudgetSubPolicyEvalParams{{n1, 3, "eval-1"}, {n2, 1, "eval-2"}}, timeProvider, true)
+	require.NoError(t, err)
+
+	// Traces without the tag fall through to the second sub-policy, which owns a quarter of the budget
+	trace := newTraceForService("svc", 25)
+	decision, err := s.Evaluate(context.Background(), traceID, trace)
+	require.NoError(t, err)
+	assert.Equal(t, Sampled, decision)
+	val, ok := trace.ReceivedBatches.ResourceSpans().At(0).ScopeSpans().At(0).Scope().Attributes().Get("tailsampling.service_budget_policy")
+	assert.True(t, ok, "Did not find expected key")
+	assert.Equal(t, "eval-2", val.AsString())
+
+	decision, err = s.Evaluate(context.Background(), traceID, newTraceForService("svc", 1))
+	require.NoError(t, err)
+	assert.Equal(t, NotSampled, decision)
+
+	// The first sub-policy still has its own three quarters of the budget
+	trace = newTraceForService("svc", 75)
+	trace.ReceivedBatches.ResourceSpans().At(0).ScopeSpans().At(0).Spans().At(0).Attributes().PutInt("tag", 10)
+	decision, err = s.Evaluate(context.Background(), traceID, trace)
+	require.NoError(t, err)
+	assert.Equal(t, Sampled, decision)
+}
@@ -16,6 +16,7 @@ import (
 
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/extension/xextension/storage"
 	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/ptrace"
 	"go.opentelemetry.io/collector/processor"
@@ -23,6 +24,7 @@ import (
 	"go.opentelemetry.io/otel/metric"
 	"go.uber.org/zap"
 
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/storage/storageclient"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/timeutils"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/tailsamplingprocessor/cache"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/tailsamplingprocessor/internal/idbatcher"
@@ -60,6 +62,11 @@ type tailSamplingSpanProcessor struct {
 	decisionBatcher    idbatcher.Batcher
 	sampledIDCache     cache.Cache[bool]
 	nonSampledIDCache  cache.Cache[bool]
+	storageID          *component.ID
+	storageClient      storage.Client
+	persistInterval    time.Duration
+	persistDone        chan struct{}
+	persistWG          sync.WaitGroup
 	deleteChan         chan pcommon.TraceID
 	numTracesOnMap     *atomic.Uint64
 	recordPolicy       bool
@@ -126,6 +133,8 @@ func newTracesProcessor(ctx context.Context, set processor.Settings, nextConsume
 		maxNumTraces:       cfg.NumTraces,
 		sampledIDCache:     sampledDecisions,
 		nonSampledIDCache:  nonSampledDecisions,
+		storageID:          cfg.DecisionCache.StorageID,
+		persistInterval:    cfg.DecisionCache.PersistInterval,
 		logger:             telemetrySettings.Logger,
 		numTracesOnMap:     &atomic.Uint64{},
 		deleteChan:         make(chan pcommon.TraceID, cfg.NumTraces),
@@ -211,6 +220,8 @@ func getPolicyEvaluator(settings component.TelemetrySettings, cfg *PolicyCfg) (s
 		return getNewAndPolicy(settings, &cfg.AndCfg)
 	case Drop:
 		return getNewDropPolicy(settings, &cfg.DropCfg)
+	case ServiceBudget:
+		return getNewServiceBudgetPolicy(settings, &cfg.ServiceBudgetCfg)
 	default:
 		return getSharedPolicyEvaluator(settings, &cfg.sharedPolicyCfg)
 	}
@@ -610,17 +621,89 @@ func (*tailSamplingSpanProcessor) Capabilities() consumer.Capabilities {
 	return consumer.Capabilities{MutatesData: false}
 }
 
+const (
+	sampledDecisionsStorageKey    = "sampled_decisions"
+	nonSampledDecisionsStorageKey = "non_sampled_decisions"
+)
+
 // Start is invoked during service startup.
-func (tsp *tailSamplingSpanProcessor) Start(context.Context, component.Host) error {
+func (tsp *tailSamplingSpanProcessor) Start(ctx context.Context, host component.Host) error {
+	if tsp.storageID != nil {
+		if err := tsp.loadDecisionCaches(ctx, host); err != nil {
+			return err
+		}
+		if tsp.persistInterval > 0 {
+			tsp.persistDecisionCaches()
+		}
+	}
 	tsp.policyTicker.Start(tsp.tickerFrequency)
 	return nil
 }
 
+// loadDecisionCaches connects to the configured storage extension and restores the
+// decisions persisted by a previous run.
+func (tsp *tailSamplingSpanProcessor) loadDecisionCaches(ctx context.Context, host component.Host) error {
+	client, err := storageclient.Get(ctx, host, *tsp.storageID, component.KindProcessor, tsp.set.ID, "")
+	if err != nil {
+		return err
+	}
+	tsp.storageClient = client
+
+	if err := cache.Load(ctx, client, sampledDecisionsStorageKey, tsp.sampledIDCache); err != nil {
+		tsp.logger.Warn("Failed to restore sampled decision cache", zap.Error(err))
+	}
+	if err := cache.Load(ctx, client, nonSampledDecisionsStorageKey, tsp.nonSampledIDCache); err != nil {
+		tsp.logger.Warn("Failed to restore non-sampled decision cache", zap.Error(err))
+	}
+	return nil
+}
+
+// persistDecisionCaches periodically saves the decision caches, so that the decisions taken
+// since startup aren't all lost when the collector crashes.
+func (tsp *tailSamplingSpanProcessor) persistDecisionCaches() {
+	tsp.persistDone = make(chan struct{})
+	tsp.persistWG.Add(1)
+	go func() {
+		defer tsp.persistWG.Done()
+		ticker := time.NewTicker(tsp.persistInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-tsp.persistDone:
+				return
+			case <-ticker.C:
+				if err := tsp.saveDecisionCaches(context.Background()); err != nil {
+					tsp.logger.Warn("Failed to persist decision caches", zap.Error(err))
+				}
+			}
+		}
+	}()
+}
+
+// saveDecisionCaches writes the decision caches to the storage extension.
+func (tsp *tailSamplingSpanProcessor) saveDecisionCaches(ctx context.Context) error {
+	return errors.Join(
+		cache.Save(ctx, tsp.storageClient, sampledDecisionsStorageKey, tsp.sampledIDCache),
+		cache.Save(ctx, tsp.storageClient, nonSampledDecisionsStorageKey, tsp.nonSampledIDCache),
+	)
+}
+
 // Shutdown is invoked during service shutdown.
-func (tsp *tailSamplingSpanProcessor) Shutdown(context.Context) error {
+func (tsp *tailSamplingSpanProcessor) Shutdown(ctx context.Context) error {
 	tsp.decisionBatcher.Stop()
 	tsp.policyTicker.Stop()
-	return nil
+
+	if tsp.persistDone != nil {
+		close(tsp.persistDone)
+		tsp.persistWG.Wait()
+	}
+	if tsp.storageClient == nil {
+		return nil
+	}
+	return errors.Join(
+		tsp.saveDecisionCaches(ctx),
+		tsp.storageClient.Close(ctx),
+	)
 }
 
 func (tsp *tailSamplingSpanProcessor) dropTrace(traceID pcommon.TraceID, deletionTime time.Time) {
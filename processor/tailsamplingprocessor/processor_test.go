@@ -14,6 +14,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/component/componenttest"
 	"go.opentelemetry.io/collector/consumer/consumertest"
 	"go.opentelemetry.io/collector/pdata/pcommon"
@@ -24,6 +25,7 @@ import (
 	"go.uber.org/zap"
 	"go.uber.org/zap/zaptest/observer"
 
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/storage/storagetest"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/tailsamplingprocessor/internal/idbatcher"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/tailsamplingprocessor/internal/metadata"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/tailsamplingprocessor/internal/sampling"
@@ -629,6 +631,70 @@ func TestDuplicatePolicyName(t *testing.T) {
 	assert.Equal(t, err, errors.New(`duplicate policy name "always_sample"`))
 }
 
+func TestDecisionCacheStorageNotFound(t *testing.T) {
+	storageID := component.MustNewID("file_storage")
+	p, err := newTracesProcessor(context.Background(), processortest.NewNopSettings(metadata.Type), consumertest.NewNop(), Config{
+		DecisionWait:  defaultTestDecisionWait,
+		NumTraces:     defaultNumTraces,
+		PolicyCfgs:    testPolicy,
+		DecisionCache: DecisionCacheConfig{SampledCacheSize: 10, StorageID: &storageID},
+	})
+	require.NoError(t, err)
+
+	err = p.Start(context.Background(), componenttest.NewNopHost())
+	require.EqualError(t, err, "storage extension 'file_storage' not found")
+	require.NoError(t, p.Shutdown(context.Background()))
+}
+
+func TestDecisionCachePersisted(t *testing.T) {
+	host := storagetest.NewStorageHost().WithFileBackedStorageExtension("decisions", t.TempDir())
+	storageID := storagetest.NewStorageID("decisions")
+	cfg := Config{
+		DecisionWait:  defaultTestDecisionWait,
+		NumTraces:     defaultNumTraces,
+		PolicyCfgs:    testPolicy,
+		DecisionCache: DecisionCacheConfig{SampledCacheSize: 10, StorageID: &storageID},
+	}
+	id := pcommon.TraceID([16]byte{1, 2, 3, 4})
+
+	p, err := newTracesProcessor(context.Background(), processortest.NewNopSettings(metadata.Type), consumertest.NewNop(), cfg)
+	require.NoError(t, err)
+	require.NoError(t, p.Start(context.Background(), host))
+	p.(*tailSamplingSpanProcessor).sampledIDCache.Put(id, true)
+	require.NoError(t, p.Shutdown(context.Background()))
+
+	p, err = newTracesProcessor(context.Background(), processortest.NewNopSettings(metadata.Type), consumertest.NewNop(), cfg)
+	require.NoError(t, err)
+	require.NoError(t, p.Start(context.Background(), host))
+	_, ok := p.(*tailSamplingSpanProcessor).sampledIDCache.Get(id)
+	assert.True(t, ok)
+	require.NoError(t, p.Shutdown(context.Background()))
+}
+
+func TestDecisionCachePersistedPeriodically(t *testing.T) {
+	host := storagetest.NewStorageHost().WithFileBackedStorageExtension("decisions", t.TempDir())
+	storageID := storagetest.NewStorageID("decisions")
+	p, err := newTracesProcessor(context.Background(), processortest.NewNopSettings(metadata.Type), consumertest.NewNop(), Config{
+		DecisionWait:  defaultTestDecisionWait,
+		NumTraces:     defaultNumTraces,
+		PolicyCfgs:    testPolicy,
+		DecisionCache: DecisionCacheConfig{SampledCacheSize: 10, StorageID: &storageID, PersistInterval: 10 * time.Millisecond},
+	})
+	require.NoError(t, err)
+	require.NoError(t, p.Start(context.Background(), host))
+	defer func() {
+		require.NoError(t, p.Shutdown(context.Background()))
+	}()
+
+	// The decision is saved without waiting for the shutdown.
+	tsp := p.(*tailSamplingSpanProcessor)
+	tsp.sampledIDCache.Put(pcommon.TraceID([16]byte{1, 2, 3, 4}), true)
+	assert.Eventually(t, func() bool {
+		buf, err := tsp.storageClient.Get(context.Background(), sampledDecisionsStorageKey)
+		return err == nil && len(buf) == 8
+	}, time.Second, 10*time.Millisecond)
+}
+
 func TestDecisionPolicyMetrics(t *testing.T) {
 	traceIDs, batches := generateIDsAndBatches(10)
 	policy := []PolicyCfg{
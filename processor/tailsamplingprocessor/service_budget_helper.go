@@ -0,0 +1,36 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package tailsamplingprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/tailsamplingprocessor"
+
+import (
+	"go.opentelemetry.io/collector/component"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/tailsamplingprocessor/internal/sampling"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/tailsamplingprocessor/internal/telemetry"
+)
+
+const defaultServiceAttribute = "service.name"
+
+func getNewServiceBudgetPolicy(settings component.TelemetrySettings, config *ServiceBudgetCfg) (sampling.PolicyEvaluator, error) {
+	subPolicyEvalParams := make([]sampling.BudgetSubPolicyEvalParams, len(config.SubPolicyCfg))
+	for i := range config.SubPolicyCfg {
+		policyCfg := &config.SubPolicyCfg[i]
+		policy, err := getSharedPolicyEvaluator(settings, &policyCfg.sharedPolicyCfg)
+		if err != nil {
+			return nil, err
+		}
+
+		subPolicyEvalParams[i] = sampling.BudgetSubPolicyEvalParams{
+			Evaluator: policy,
+			Weight:    policyCfg.Weight,
+			Name:      policyCfg.Name,
+		}
+	}
+
+	serviceAttribute := config.ServiceAttribute
+	if serviceAttribute == "" {
+		serviceAttribute = defaultServiceAttribute
+	}
+	return sampling.NewServiceBudget(settings.Logger, config.SpansPerSecond, serviceAttribute, subPolicyEvalParams, sampling.MonotonicClock{}, telemetry.IsRecordPolicyEnabled())
+}
@@ -0,0 +1,70 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package tailsamplingprocessor
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/tailsamplingprocessor/internal/sampling"
+)
+
+func TestServiceBudgetHelper(t *testing.T) {
+	t.Run("valid", func(t *testing.T) {
+		actual, err := getNewServiceBudgetPolicy(componenttest.NewNopTelemetrySettings(), &ServiceBudgetCfg{
+			SpansPerSecond: 1000,
+			SubPolicyCfg: []ServiceBudgetSubPolicyCfg{
+				{
+					sharedPolicyCfg: sharedPolicyCfg{
+						Name:       "test-service-budget-policy-1",
+						Type:       Latency,
+						LatencyCfg: LatencyCfg{ThresholdMs: 100},
+					},
+					Weight: 3,
+				},
+				{
+					sharedPolicyCfg: sharedPolicyCfg{
+						Name:             "test-service-budget-policy-2",
+						Type:             Probabilistic,
+						ProbabilisticCfg: ProbabilisticCfg{SamplingPercentage: 10},
+					},
+				},
+			},
+		})
+		require.NoError(t, err)
+
+		expected, err := sampling.NewServiceBudget(zap.NewNop(), 1000, "service.name", []sampling.BudgetSubPolicyEvalParams{
+			{
+				Evaluator: sampling.NewLatency(componenttest.NewNopTelemetrySettings(), 100, 0),
+				Weight:    3,
+				Name:      "test-service-budget-policy-1",
+			},
+			{
+				Evaluator: sampling.NewProbabilisticSampler(componenttest.NewNopTelemetrySettings(), "", 10),
+				Name:      "test-service-budget-policy-2",
+			},
+		}, sampling.MonotonicClock{}, false)
+		require.NoError(t, err)
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("unsupported sampling policy type", func(t *testing.T) {
+		_, err := getNewServiceBudgetPolicy(componenttest.NewNopTelemetrySettings(), &ServiceBudgetCfg{
+			SpansPerSecond: 1000,
+			SubPolicyCfg: []ServiceBudgetSubPolicyCfg{
+				{
+					sharedPolicyCfg: sharedPolicyCfg{
+						Name: "test-service-budget-policy-1",
+						Type: ServiceBudget, // nested service budget is not allowed
+					},
+				},
+			},
+		})
+		require.EqualError(t, err, "unknown sampling policy type service_budget")
+	})
+}
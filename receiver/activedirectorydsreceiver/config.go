@@ -4,6 +4,13 @@
 package activedirectorydsreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/activedirectorydsreceiver"
 
 import (
+	"errors"
+	"fmt"
+	"net"
+	"time"
+
+	"go.opentelemetry.io/collector/config/configopaque"
+	"go.opentelemetry.io/collector/config/configtls"
 	"go.opentelemetry.io/collector/scraper/scraperhelper"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/activedirectorydsreceiver/internal/metadata"
@@ -12,4 +19,44 @@ import (
 type Config struct {
 	scraperhelper.ControllerConfig `mapstructure:",squash"`
 	metadata.MetricsBuilderConfig  `mapstructure:",squash"`
+
+	// LDAPBind configures the synthetic LDAP bind measured at each scrape. The
+	// bind is not performed when no endpoint is set.
+	LDAPBind LDAPBindConfig `mapstructure:"ldap_bind"`
+}
+
+// LDAPBindConfig configures the synthetic LDAP simple bind.
+type LDAPBindConfig struct {
+	// Endpoint is the host:port of the LDAP server, such as localhost:636.
+	Endpoint string `mapstructure:"endpoint"`
+	// Username is the name used for the bind, the bind is anonymous when it is empty.
+	Username string              `mapstructure:"username"`
+	Password configopaque.String `mapstructure:"password"`
+	// Timeout bounds the connection and the bind.
+	Timeout time.Duration `mapstructure:"timeout"`
+	// TLS configures the connection to the server, which uses LDAPS unless
+	// StartTLS is set. The bind is only sent in plaintext when TLS.Insecure is set.
+	TLS configtls.ClientConfig `mapstructure:"tls"`
+	// StartTLS upgrades a plain connection to TLS with the StartTLS extended
+	// operation before the bind, instead of connecting with LDAPS.
+	StartTLS bool `mapstructure:"start_tls"`
+}
+
+func (cfg *Config) Validate() error {
+	if cfg.LDAPBind.Endpoint == "" {
+		return nil
+	}
+	if _, _, err := net.SplitHostPort(cfg.LDAPBind.Endpoint); err != nil {
+		return fmt.Errorf("invalid ldap_bind endpoint %q: %w", cfg.LDAPBind.Endpoint, err)
+	}
+	if cfg.LDAPBind.Timeout <= 0 {
+		return errors.New("ldap_bind timeout must be greater than 0")
+	}
+	if cfg.LDAPBind.Username == "" && cfg.LDAPBind.Password != "" {
+		return errors.New("ldap_bind password requires a username")
+	}
+	if cfg.LDAPBind.StartTLS && cfg.LDAPBind.TLS.Insecure {
+		return errors.New("ldap_bind start_tls can't be used with tls::insecure")
+	}
+	return nil
 }
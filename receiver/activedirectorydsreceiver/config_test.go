@@ -13,6 +13,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config/configtls"
 	"go.opentelemetry.io/collector/confmap/confmaptest"
 	"go.opentelemetry.io/collector/confmap/xconfmap"
 	"go.opentelemetry.io/collector/scraper/scraperhelper"
@@ -44,8 +45,28 @@ func TestLoadConfig(t *testing.T) {
 					InitialDelay:       time.Second,
 				},
 				MetricsBuilderConfig: overriddenMetricsBuilderConfig,
+				LDAPBind: LDAPBindConfig{
+					Timeout: defaultLDAPBindTimeout,
+				},
 			},
 		},
+		{
+			id: component.NewIDWithName(metadata.Type, "ldap_bind"),
+			expected: func() component.Config {
+				cfg := createDefaultConfig().(*Config)
+				cfg.LDAPBind = LDAPBindConfig{
+					Endpoint: "localhost:389",
+					Username: "monitor@example.com",
+					Password: "secret",
+					Timeout:  2 * time.Second,
+					TLS: configtls.ClientConfig{
+						Config: configtls.Config{CAFile: "ca.pem"},
+					},
+					StartTLS: true,
+				}
+				return cfg
+			}(),
+		},
 	}
 
 	for _, tt := range tests {
@@ -64,3 +85,57 @@ func TestLoadConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name        string
+		ldapBind    LDAPBindConfig
+		expectedErr string
+	}{
+		{
+			name:     "no ldap bind",
+			ldapBind: LDAPBindConfig{Timeout: defaultLDAPBindTimeout},
+		},
+		{
+			name:     "anonymous bind",
+			ldapBind: LDAPBindConfig{Endpoint: "localhost:389", Timeout: defaultLDAPBindTimeout},
+		},
+		{
+			name:        "invalid endpoint",
+			ldapBind:    LDAPBindConfig{Endpoint: "localhost", Timeout: defaultLDAPBindTimeout},
+			expectedErr: `invalid ldap_bind endpoint "localhost"`,
+		},
+		{
+			name:        "invalid timeout",
+			ldapBind:    LDAPBindConfig{Endpoint: "localhost:389"},
+			expectedErr: "ldap_bind timeout must be greater than 0",
+		},
+		{
+			name:        "password without username",
+			ldapBind:    LDAPBindConfig{Endpoint: "localhost:389", Password: "secret", Timeout: defaultLDAPBindTimeout},
+			expectedErr: "ldap_bind password requires a username",
+		},
+		{
+			name:     "insecure",
+			ldapBind: LDAPBindConfig{Endpoint: "localhost:389", Timeout: defaultLDAPBindTimeout, TLS: configtls.ClientConfig{Insecure: true}},
+		},
+		{
+			name:        "start_tls with insecure",
+			ldapBind:    LDAPBindConfig{Endpoint: "localhost:389", Timeout: defaultLDAPBindTimeout, TLS: configtls.ClientConfig{Insecure: true}, StartTLS: true},
+			expectedErr: "ldap_bind start_tls can't be used with tls::insecure",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := createDefaultConfig().(*Config)
+			cfg.LDAPBind = tt.ldapBind
+			err := cfg.Validate()
+			if tt.expectedErr == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorContains(t, err, tt.expectedErr)
+		})
+	}
+}
@@ -46,6 +46,7 @@ const (
 	ldapBindTime                           = "LDAP Bind Time"
 	ldapSuccessfulBinds                    = "LDAP Successful Binds/sec"
 	ldapSearches                           = "LDAP Searches/sec"
+	draPendingReplicationSynchronizations  = "DRA Pending Replication Synchronizations"
 )
 
 type watchers struct {
@@ -216,6 +217,10 @@ func getWatchers(wc watcherCreator) (*watchers, error) {
 		return nil, err
 	}
 
+	if w.counterNameToWatcher[draPendingReplicationSynchronizations], err = wc.Create(draPendingReplicationSynchronizations); err != nil {
+		return nil, err
+	}
+
 	return w, nil
 }
 
@@ -15,6 +15,7 @@ import (
 
 const (
 	defaultCollectionInterval = 10 * time.Second
+	defaultLDAPBindTimeout    = 5 * time.Second
 )
 
 func NewFactory() receiver.Factory {
@@ -31,5 +32,8 @@ func createDefaultConfig() component.Config {
 	return &Config{
 		ControllerConfig:     cfg,
 		MetricsBuilderConfig: metadata.DefaultMetricsBuilderConfig(),
+		LDAPBind: LDAPBindConfig{
+			Timeout: defaultLDAPBindTimeout,
+		},
 	}
 }
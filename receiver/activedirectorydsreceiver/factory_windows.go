@@ -31,7 +31,7 @@ func createMetricsReceiver(
 		return nil, errConfigNotActiveDirectory
 	}
 
-	adds := newActiveDirectoryDSScraper(c.MetricsBuilderConfig, params)
+	adds := newActiveDirectoryDSScraper(c, params)
 	s, err := scraper.NewMetrics(
 		adds.scrape,
 		scraper.WithStart(adds.start),
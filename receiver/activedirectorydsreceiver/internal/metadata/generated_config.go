@@ -28,8 +28,10 @@ func (ms *MetricConfig) Unmarshal(parser *confmap.Conf) error {
 // MetricsConfig provides config for active_directory_ds metrics.
 type MetricsConfig struct {
 	ActiveDirectoryDsBindRate                                  MetricConfig `mapstructure:"active_directory.ds.bind.rate"`
+	ActiveDirectoryDsLdapBindDuration                          MetricConfig `mapstructure:"active_directory.ds.ldap.bind.duration"`
 	ActiveDirectoryDsLdapBindLastSuccessfulTime                MetricConfig `mapstructure:"active_directory.ds.ldap.bind.last_successful.time"`
 	ActiveDirectoryDsLdapBindRate                              MetricConfig `mapstructure:"active_directory.ds.ldap.bind.rate"`
+	ActiveDirectoryDsLdapBindStatus                            MetricConfig `mapstructure:"active_directory.ds.ldap.bind.status"`
 	ActiveDirectoryDsLdapClientSessionCount                    MetricConfig `mapstructure:"active_directory.ds.ldap.client.session.count"`
 	ActiveDirectoryDsLdapSearchRate                            MetricConfig `mapstructure:"active_directory.ds.ldap.search.rate"`
 	ActiveDirectoryDsNameCacheHitRate                          MetricConfig `mapstructure:"active_directory.ds.name_cache.hit_rate"`
@@ -38,8 +40,11 @@ type MetricsConfig struct {
 	ActiveDirectoryDsReplicationNetworkIo                      MetricConfig `mapstructure:"active_directory.ds.replication.network.io"`
 	ActiveDirectoryDsReplicationObjectRate                     MetricConfig `mapstructure:"active_directory.ds.replication.object.rate"`
 	ActiveDirectoryDsReplicationOperationPending               MetricConfig `mapstructure:"active_directory.ds.replication.operation.pending"`
+	ActiveDirectoryDsReplicationPartnerSyncAge                 MetricConfig `mapstructure:"active_directory.ds.replication.partner.sync.age"`
+	ActiveDirectoryDsReplicationPartnerSyncFailures            MetricConfig `mapstructure:"active_directory.ds.replication.partner.sync.failures"`
 	ActiveDirectoryDsReplicationPropertyRate                   MetricConfig `mapstructure:"active_directory.ds.replication.property.rate"`
 	ActiveDirectoryDsReplicationSyncObjectPending              MetricConfig `mapstructure:"active_directory.ds.replication.sync.object.pending"`
+	ActiveDirectoryDsReplicationSyncPending                    MetricConfig `mapstructure:"active_directory.ds.replication.sync.pending"`
 	ActiveDirectoryDsReplicationSyncRequestCount               MetricConfig `mapstructure:"active_directory.ds.replication.sync.request.count"`
 	ActiveDirectoryDsReplicationValueRate                      MetricConfig `mapstructure:"active_directory.ds.replication.value.rate"`
 	ActiveDirectoryDsSecurityDescriptorPropagationsEventQueued MetricConfig `mapstructure:"active_directory.ds.security_descriptor_propagations_event.queued"`
@@ -52,12 +57,18 @@ func DefaultMetricsConfig() MetricsConfig {
 		ActiveDirectoryDsBindRate: MetricConfig{
 			Enabled: true,
 		},
+		ActiveDirectoryDsLdapBindDuration: MetricConfig{
+			Enabled: true,
+		},
 		ActiveDirectoryDsLdapBindLastSuccessfulTime: MetricConfig{
 			Enabled: true,
 		},
 		ActiveDirectoryDsLdapBindRate: MetricConfig{
 			Enabled: true,
 		},
+		ActiveDirectoryDsLdapBindStatus: MetricConfig{
+			Enabled: true,
+		},
 		ActiveDirectoryDsLdapClientSessionCount: MetricConfig{
 			Enabled: true,
 		},
@@ -82,12 +93,21 @@ func DefaultMetricsConfig() MetricsConfig {
 		ActiveDirectoryDsReplicationOperationPending: MetricConfig{
 			Enabled: true,
 		},
+		ActiveDirectoryDsReplicationPartnerSyncAge: MetricConfig{
+			Enabled: false,
+		},
+		ActiveDirectoryDsReplicationPartnerSyncFailures: MetricConfig{
+			Enabled: false,
+		},
 		ActiveDirectoryDsReplicationPropertyRate: MetricConfig{
 			Enabled: true,
 		},
 		ActiveDirectoryDsReplicationSyncObjectPending: MetricConfig{
 			Enabled: true,
 		},
+		ActiveDirectoryDsReplicationSyncPending: MetricConfig{
+			Enabled: true,
+		},
 		ActiveDirectoryDsReplicationSyncRequestCount: MetricConfig{
 			Enabled: true,
 		},
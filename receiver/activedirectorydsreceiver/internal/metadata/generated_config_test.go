@@ -28,8 +28,10 @@ func TestMetricsBuilderConfig(t *testing.T) {
 			want: MetricsBuilderConfig{
 				Metrics: MetricsConfig{
 					ActiveDirectoryDsBindRate:                                  MetricConfig{Enabled: true},
+					ActiveDirectoryDsLdapBindDuration:                          MetricConfig{Enabled: true},
 					ActiveDirectoryDsLdapBindLastSuccessfulTime:                MetricConfig{Enabled: true},
 					ActiveDirectoryDsLdapBindRate:                              MetricConfig{Enabled: true},
+					ActiveDirectoryDsLdapBindStatus:                            MetricConfig{Enabled: true},
 					ActiveDirectoryDsLdapClientSessionCount:                    MetricConfig{Enabled: true},
 					ActiveDirectoryDsLdapSearchRate:                            MetricConfig{Enabled: true},
 					ActiveDirectoryDsNameCacheHitRate:                          MetricConfig{Enabled: true},
@@ -38,8 +40,11 @@ func TestMetricsBuilderConfig(t *testing.T) {
 					ActiveDirectoryDsReplicationNetworkIo:                      MetricConfig{Enabled: true},
 					ActiveDirectoryDsReplicationObjectRate:                     MetricConfig{Enabled: true},
 					ActiveDirectoryDsReplicationOperationPending:               MetricConfig{Enabled: true},
+					ActiveDirectoryDsReplicationPartnerSyncAge:                 MetricConfig{Enabled: true},
+					ActiveDirectoryDsReplicationPartnerSyncFailures:            MetricConfig{Enabled: true},
 					ActiveDirectoryDsReplicationPropertyRate:                   MetricConfig{Enabled: true},
 					ActiveDirectoryDsReplicationSyncObjectPending:              MetricConfig{Enabled: true},
+					ActiveDirectoryDsReplicationSyncPending:                    MetricConfig{Enabled: true},
 					ActiveDirectoryDsReplicationSyncRequestCount:               MetricConfig{Enabled: true},
 					ActiveDirectoryDsReplicationValueRate:                      MetricConfig{Enabled: true},
 					ActiveDirectoryDsSecurityDescriptorPropagationsEventQueued: MetricConfig{Enabled: true},
@@ -53,8 +58,10 @@ func TestMetricsBuilderConfig(t *testing.T) {
 			want: MetricsBuilderConfig{
 				Metrics: MetricsConfig{
 					ActiveDirectoryDsBindRate:                                  MetricConfig{Enabled: false},
+					ActiveDirectoryDsLdapBindDuration:                          MetricConfig{Enabled: false},
 					ActiveDirectoryDsLdapBindLastSuccessfulTime:                MetricConfig{Enabled: false},
 					ActiveDirectoryDsLdapBindRate:                              MetricConfig{Enabled: false},
+					ActiveDirectoryDsLdapBindStatus:                            MetricConfig{Enabled: false},
 					ActiveDirectoryDsLdapClientSessionCount:                    MetricConfig{Enabled: false},
 					ActiveDirectoryDsLdapSearchRate:                            MetricConfig{Enabled: false},
 					ActiveDirectoryDsNameCacheHitRate:                          MetricConfig{Enabled: false},
@@ -63,8 +70,11 @@ func TestMetricsBuilderConfig(t *testing.T) {
 					ActiveDirectoryDsReplicationNetworkIo:                      MetricConfig{Enabled: false},
 					ActiveDirectoryDsReplicationObjectRate:                     MetricConfig{Enabled: false},
 					ActiveDirectoryDsReplicationOperationPending:               MetricConfig{Enabled: false},
+					ActiveDirectoryDsReplicationPartnerSyncAge:                 MetricConfig{Enabled: false},
+					ActiveDirectoryDsReplicationPartnerSyncFailures:            MetricConfig{Enabled: false},
 					ActiveDirectoryDsReplicationPropertyRate:                   MetricConfig{Enabled: false},
 					ActiveDirectoryDsReplicationSyncObjectPending:              MetricConfig{Enabled: false},
+					ActiveDirectoryDsReplicationSyncPending:                    MetricConfig{Enabled: false},
 					ActiveDirectoryDsReplicationSyncRequestCount:               MetricConfig{Enabled: false},
 					ActiveDirectoryDsReplicationValueRate:                      MetricConfig{Enabled: false},
 					ActiveDirectoryDsSecurityDescriptorPropagationsEventQueued: MetricConfig{Enabled: false},
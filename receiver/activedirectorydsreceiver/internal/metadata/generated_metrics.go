@@ -205,12 +205,18 @@ var MetricsInfo = metricsInfo{
 	ActiveDirectoryDsBindRate: metricInfo{
 		Name: "active_directory.ds.bind.rate",
 	},
+	ActiveDirectoryDsLdapBindDuration: metricInfo{
+		Name: "active_directory.ds.ldap.bind.duration",
+	},
 	ActiveDirectoryDsLdapBindLastSuccessfulTime: metricInfo{
 		Name: "active_directory.ds.ldap.bind.last_successful.time",
 	},
 	ActiveDirectoryDsLdapBindRate: metricInfo{
 		Name: "active_directory.ds.ldap.bind.rate",
 	},
+	ActiveDirectoryDsLdapBindStatus: metricInfo{
+		Name: "active_directory.ds.ldap.bind.status",
+	},
 	ActiveDirectoryDsLdapClientSessionCount: metricInfo{
 		Name: "active_directory.ds.ldap.client.session.count",
 	},
@@ -235,12 +241,21 @@ var MetricsInfo = metricsInfo{
 	ActiveDirectoryDsReplicationOperationPending: metricInfo{
 		Name: "active_directory.ds.replication.operation.pending",
 	},
+	ActiveDirectoryDsReplicationPartnerSyncAge: metricInfo{
+		Name: "active_directory.ds.replication.partner.sync.age",
+	},
+	ActiveDirectoryDsReplicationPartnerSyncFailures: metricInfo{
+		Name: "active_directory.ds.replication.partner.sync.failures",
+	},
 	ActiveDirectoryDsReplicationPropertyRate: metricInfo{
 		Name: "active_directory.ds.replication.property.rate",
 	},
 	ActiveDirectoryDsReplicationSyncObjectPending: metricInfo{
 		Name: "active_directory.ds.replication.sync.object.pending",
 	},
+	ActiveDirectoryDsReplicationSyncPending: metricInfo{
+		Name: "active_directory.ds.replication.sync.pending",
+	},
 	ActiveDirectoryDsReplicationSyncRequestCount: metricInfo{
 		Name: "active_directory.ds.replication.sync.request.count",
 	},
@@ -260,8 +275,10 @@ var MetricsInfo = metricsInfo{
 
 type metricsInfo struct {
 	ActiveDirectoryDsBindRate                                  metricInfo
+	ActiveDirectoryDsLdapBindDuration                          metricInfo
 	ActiveDirectoryDsLdapBindLastSuccessfulTime                metricInfo
 	ActiveDirectoryDsLdapBindRate                              metricInfo
+	ActiveDirectoryDsLdapBindStatus                            metricInfo
 	ActiveDirectoryDsLdapClientSessionCount                    metricInfo
 	ActiveDirectoryDsLdapSearchRate                            metricInfo
 	ActiveDirectoryDsNameCacheHitRate                          metricInfo
@@ -270,8 +287,11 @@ type metricsInfo struct {
 	ActiveDirectoryDsReplicationNetworkIo                      metricInfo
 	ActiveDirectoryDsReplicationObjectRate                     metricInfo
 	ActiveDirectoryDsReplicationOperationPending               metricInfo
+	ActiveDirectoryDsReplicationPartnerSyncAge                 metricInfo
+	ActiveDirectoryDsReplicationPartnerSyncFailures            metricInfo
 	ActiveDirectoryDsReplicationPropertyRate                   metricInfo
 	ActiveDirectoryDsReplicationSyncObjectPending              metricInfo
+	ActiveDirectoryDsReplicationSyncPending                    metricInfo
 	ActiveDirectoryDsReplicationSyncRequestCount               metricInfo
 	ActiveDirectoryDsReplicationValueRate                      metricInfo
 	ActiveDirectoryDsSecurityDescriptorPropagationsEventQueued metricInfo
@@ -336,6 +356,55 @@ func newMetricActiveDirectoryDsBindRate(cfg MetricConfig) metricActiveDirectoryD
 	return m
 }
 
+type metricActiveDirectoryDsLdapBindDuration struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills active_directory.ds.ldap.bind.duration metric with initial data.
+func (m *metricActiveDirectoryDsLdapBindDuration) init() {
+	m.data.SetName("active_directory.ds.ldap.bind.duration")
+	m.data.SetDescription("The time taken by the synthetic LDAP bind.")
+	m.data.SetUnit("ms")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricActiveDirectoryDsLdapBindDuration) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricActiveDirectoryDsLdapBindDuration) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricActiveDirectoryDsLdapBindDuration) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricActiveDirectoryDsLdapBindDuration(cfg MetricConfig) metricActiveDirectoryDsLdapBindDuration {
+	m := metricActiveDirectoryDsLdapBindDuration{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 type metricActiveDirectoryDsLdapBindLastSuccessfulTime struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
@@ -436,6 +505,55 @@ func newMetricActiveDirectoryDsLdapBindRate(cfg MetricConfig) metricActiveDirect
 	return m
 }
 
+type metricActiveDirectoryDsLdapBindStatus struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills active_directory.ds.ldap.bind.status metric with initial data.
+func (m *metricActiveDirectoryDsLdapBindStatus) init() {
+	m.data.SetName("active_directory.ds.ldap.bind.status")
+	m.data.SetDescription("Whether the synthetic LDAP bind succeeded (1) or failed (0).")
+	m.data.SetUnit("1")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricActiveDirectoryDsLdapBindStatus) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricActiveDirectoryDsLdapBindStatus) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricActiveDirectoryDsLdapBindStatus) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricActiveDirectoryDsLdapBindStatus(cfg MetricConfig) metricActiveDirectoryDsLdapBindStatus {
+	m := metricActiveDirectoryDsLdapBindStatus{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 type metricActiveDirectoryDsLdapClientSessionCount struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
@@ -849,6 +967,110 @@ func newMetricActiveDirectoryDsReplicationOperationPending(cfg MetricConfig) met
 	return m
 }
 
+type metricActiveDirectoryDsReplicationPartnerSyncAge struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills active_directory.ds.replication.partner.sync.age metric with initial data.
+func (m *metricActiveDirectoryDsReplicationPartnerSyncAge) init() {
+	m.data.SetName("active_directory.ds.replication.partner.sync.age")
+	m.data.SetDescription("The time since the last successful replication of the naming context from the replication partner.")
+	m.data.SetUnit("s")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricActiveDirectoryDsReplicationPartnerSyncAge) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, partnerAttributeValue string, namingContextAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("partner", partnerAttributeValue)
+	dp.Attributes().PutStr("naming_context", namingContextAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricActiveDirectoryDsReplicationPartnerSyncAge) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricActiveDirectoryDsReplicationPartnerSyncAge) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricActiveDirectoryDsReplicationPartnerSyncAge(cfg MetricConfig) metricActiveDirectoryDsReplicationPartnerSyncAge {
+	m := metricActiveDirectoryDsReplicationPartnerSyncAge{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricActiveDirectoryDsReplicationPartnerSyncFailures struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills active_directory.ds.replication.partner.sync.failures metric with initial data.
+func (m *metricActiveDirectoryDsReplicationPartnerSyncFailures) init() {
+	m.data.SetName("active_directory.ds.replication.partner.sync.failures")
+	m.data.SetDescription("The number of consecutive failed replications of the naming context from the replication partner.")
+	m.data.SetUnit("{failures}")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricActiveDirectoryDsReplicationPartnerSyncFailures) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, partnerAttributeValue string, namingContextAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("partner", partnerAttributeValue)
+	dp.Attributes().PutStr("naming_context", namingContextAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricActiveDirectoryDsReplicationPartnerSyncFailures) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricActiveDirectoryDsReplicationPartnerSyncFailures) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricActiveDirectoryDsReplicationPartnerSyncFailures(cfg MetricConfig) metricActiveDirectoryDsReplicationPartnerSyncFailures {
+	m := metricActiveDirectoryDsReplicationPartnerSyncFailures{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 type metricActiveDirectoryDsReplicationPropertyRate struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
@@ -953,6 +1175,57 @@ func newMetricActiveDirectoryDsReplicationSyncObjectPending(cfg MetricConfig) me
 	return m
 }
 
+type metricActiveDirectoryDsReplicationSyncPending struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills active_directory.ds.replication.sync.pending metric with initial data.
+func (m *metricActiveDirectoryDsReplicationSyncPending) init() {
+	m.data.SetName("active_directory.ds.replication.sync.pending")
+	m.data.SetDescription("The number of directory synchronizations queued for the Directory Replication Agent.")
+	m.data.SetUnit("{synchronizations}")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(false)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+}
+
+func (m *metricActiveDirectoryDsReplicationSyncPending) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricActiveDirectoryDsReplicationSyncPending) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricActiveDirectoryDsReplicationSyncPending) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricActiveDirectoryDsReplicationSyncPending(cfg MetricConfig) metricActiveDirectoryDsReplicationSyncPending {
+	m := metricActiveDirectoryDsReplicationSyncPending{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 type metricActiveDirectoryDsReplicationSyncRequestCount struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
@@ -1224,8 +1497,10 @@ type MetricsBuilder struct {
 	metricsBuffer                                                    pmetric.Metrics      // accumulates metrics data before emitting.
 	buildInfo                                                        component.BuildInfo  // contains version information.
 	metricActiveDirectoryDsBindRate                                  metricActiveDirectoryDsBindRate
+	metricActiveDirectoryDsLdapBindDuration                          metricActiveDirectoryDsLdapBindDuration
 	metricActiveDirectoryDsLdapBindLastSuccessfulTime                metricActiveDirectoryDsLdapBindLastSuccessfulTime
 	metricActiveDirectoryDsLdapBindRate                              metricActiveDirectoryDsLdapBindRate
+	metricActiveDirectoryDsLdapBindStatus                            metricActiveDirectoryDsLdapBindStatus
 	metricActiveDirectoryDsLdapClientSessionCount                    metricActiveDirectoryDsLdapClientSessionCount
 	metricActiveDirectoryDsLdapSearchRate                            metricActiveDirectoryDsLdapSearchRate
 	metricActiveDirectoryDsNameCacheHitRate                          metricActiveDirectoryDsNameCacheHitRate
@@ -1234,8 +1509,11 @@ type MetricsBuilder struct {
 	metricActiveDirectoryDsReplicationNetworkIo                      metricActiveDirectoryDsReplicationNetworkIo
 	metricActiveDirectoryDsReplicationObjectRate                     metricActiveDirectoryDsReplicationObjectRate
 	metricActiveDirectoryDsReplicationOperationPending               metricActiveDirectoryDsReplicationOperationPending
+	metricActiveDirectoryDsReplicationPartnerSyncAge                 metricActiveDirectoryDsReplicationPartnerSyncAge
+	metricActiveDirectoryDsReplicationPartnerSyncFailures            metricActiveDirectoryDsReplicationPartnerSyncFailures
 	metricActiveDirectoryDsReplicationPropertyRate                   metricActiveDirectoryDsReplicationPropertyRate
 	metricActiveDirectoryDsReplicationSyncObjectPending              metricActiveDirectoryDsReplicationSyncObjectPending
+	metricActiveDirectoryDsReplicationSyncPending                    metricActiveDirectoryDsReplicationSyncPending
 	metricActiveDirectoryDsReplicationSyncRequestCount               metricActiveDirectoryDsReplicationSyncRequestCount
 	metricActiveDirectoryDsReplicationValueRate                      metricActiveDirectoryDsReplicationValueRate
 	metricActiveDirectoryDsSecurityDescriptorPropagationsEventQueued metricActiveDirectoryDsSecurityDescriptorPropagationsEventQueued
@@ -1262,13 +1540,15 @@ func WithStartTime(startTime pcommon.Timestamp) MetricBuilderOption {
 }
 func NewMetricsBuilder(mbc MetricsBuilderConfig, settings receiver.Settings, options ...MetricBuilderOption) *MetricsBuilder {
 	mb := &MetricsBuilder{
-		config:                          mbc,
-		startTime:                       pcommon.NewTimestampFromTime(time.Now()),
-		metricsBuffer:                   pmetric.NewMetrics(),
-		buildInfo:                       settings.BuildInfo,
-		metricActiveDirectoryDsBindRate: newMetricActiveDirectoryDsBindRate(mbc.Metrics.ActiveDirectoryDsBindRate),
+		config:                                  mbc,
+		startTime:                               pcommon.NewTimestampFromTime(time.Now()),
+		metricsBuffer:                           pmetric.NewMetrics(),
+		buildInfo:                               settings.BuildInfo,
+		metricActiveDirectoryDsBindRate:         newMetricActiveDirectoryDsBindRate(mbc.Metrics.ActiveDirectoryDsBindRate),
+		metricActiveDirectoryDsLdapBindDuration: newMetricActiveDirectoryDsLdapBindDuration(mbc.Metrics.ActiveDirectoryDsLdapBindDuration),
 		metricActiveDirectoryDsLdapBindLastSuccessfulTime:                newMetricActiveDirectoryDsLdapBindLastSuccessfulTime(mbc.Metrics.ActiveDirectoryDsLdapBindLastSuccessfulTime),
 		metricActiveDirectoryDsLdapBindRate:                              newMetricActiveDirectoryDsLdapBindRate(mbc.Metrics.ActiveDirectoryDsLdapBindRate),
+		metricActiveDirectoryDsLdapBindStatus:                            newMetricActiveDirectoryDsLdapBindStatus(mbc.Metrics.ActiveDirectoryDsLdapBindStatus),
 		metricActiveDirectoryDsLdapClientSessionCount:                    newMetricActiveDirectoryDsLdapClientSessionCount(mbc.Metrics.ActiveDirectoryDsLdapClientSessionCount),
 		metricActiveDirectoryDsLdapSearchRate:                            newMetricActiveDirectoryDsLdapSearchRate(mbc.Metrics.ActiveDirectoryDsLdapSearchRate),
 		metricActiveDirectoryDsNameCacheHitRate:                          newMetricActiveDirectoryDsNameCacheHitRate(mbc.Metrics.ActiveDirectoryDsNameCacheHitRate),
@@ -1277,8 +1557,11 @@ func NewMetricsBuilder(mbc MetricsBuilderConfig, settings receiver.Settings, opt
 		metricActiveDirectoryDsReplicationNetworkIo:                      newMetricActiveDirectoryDsReplicationNetworkIo(mbc.Metrics.ActiveDirectoryDsReplicationNetworkIo),
 		metricActiveDirectoryDsReplicationObjectRate:                     newMetricActiveDirectoryDsReplicationObjectRate(mbc.Metrics.ActiveDirectoryDsReplicationObjectRate),
 		metricActiveDirectoryDsReplicationOperationPending:               newMetricActiveDirectoryDsReplicationOperationPending(mbc.Metrics.ActiveDirectoryDsReplicationOperationPending),
+		metricActiveDirectoryDsReplicationPartnerSyncAge:                 newMetricActiveDirectoryDsReplicationPartnerSyncAge(mbc.Metrics.ActiveDirectoryDsReplicationPartnerSyncAge),
+		metricActiveDirectoryDsReplicationPartnerSyncFailures:            newMetricActiveDirectoryDsReplicationPartnerSyncFailures(mbc.Metrics.ActiveDirectoryDsReplicationPartnerSyncFailures),
 		metricActiveDirectoryDsReplicationPropertyRate:                   newMetricActiveDirectoryDsReplicationPropertyRate(mbc.Metrics.ActiveDirectoryDsReplicationPropertyRate),
 		metricActiveDirectoryDsReplicationSyncObjectPending:              newMetricActiveDirectoryDsReplicationSyncObjectPending(mbc.Metrics.ActiveDirectoryDsReplicationSyncObjectPending),
+		metricActiveDirectoryDsReplicationSyncPending:                    newMetricActiveDirectoryDsReplicationSyncPending(mbc.Metrics.ActiveDirectoryDsReplicationSyncPending),
 		metricActiveDirectoryDsReplicationSyncRequestCount:               newMetricActiveDirectoryDsReplicationSyncRequestCount(mbc.Metrics.ActiveDirectoryDsReplicationSyncRequestCount),
 		metricActiveDirectoryDsReplicationValueRate:                      newMetricActiveDirectoryDsReplicationValueRate(mbc.Metrics.ActiveDirectoryDsReplicationValueRate),
 		metricActiveDirectoryDsSecurityDescriptorPropagationsEventQueued: newMetricActiveDirectoryDsSecurityDescriptorPropagationsEventQueued(mbc.Metrics.ActiveDirectoryDsSecurityDescriptorPropagationsEventQueued),
@@ -1350,8 +1633,10 @@ func (mb *MetricsBuilder) EmitForResource(options ...ResourceMetricsOption) {
 	ils.Scope().SetVersion(mb.buildInfo.Version)
 	ils.Metrics().EnsureCapacity(mb.metricsCapacity)
 	mb.metricActiveDirectoryDsBindRate.emit(ils.Metrics())
+	mb.metricActiveDirectoryDsLdapBindDuration.emit(ils.Metrics())
 	mb.metricActiveDirectoryDsLdapBindLastSuccessfulTime.emit(ils.Metrics())
 	mb.metricActiveDirectoryDsLdapBindRate.emit(ils.Metrics())
+	mb.metricActiveDirectoryDsLdapBindStatus.emit(ils.Metrics())
 	mb.metricActiveDirectoryDsLdapClientSessionCount.emit(ils.Metrics())
 	mb.metricActiveDirectoryDsLdapSearchRate.emit(ils.Metrics())
 	mb.metricActiveDirectoryDsNameCacheHitRate.emit(ils.Metrics())
@@ -1360,8 +1645,11 @@ func (mb *MetricsBuilder) EmitForResource(options ...ResourceMetricsOption) {
 	mb.metricActiveDirectoryDsReplicationNetworkIo.emit(ils.Metrics())
 	mb.metricActiveDirectoryDsReplicationObjectRate.emit(ils.Metrics())
 	mb.metricActiveDirectoryDsReplicationOperationPending.emit(ils.Metrics())
+	mb.metricActiveDirectoryDsReplicationPartnerSyncAge.emit(ils.Metrics())
+	mb.metricActiveDirectoryDsReplicationPartnerSyncFailures.emit(ils.Metrics())
 	mb.metricActiveDirectoryDsReplicationPropertyRate.emit(ils.Metrics())
 	mb.metricActiveDirectoryDsReplicationSyncObjectPending.emit(ils.Metrics())
+	mb.metricActiveDirectoryDsReplicationSyncPending.emit(ils.Metrics())
 	mb.metricActiveDirectoryDsReplicationSyncRequestCount.emit(ils.Metrics())
 	mb.metricActiveDirectoryDsReplicationValueRate.emit(ils.Metrics())
 	mb.metricActiveDirectoryDsSecurityDescriptorPropagationsEventQueued.emit(ils.Metrics())
@@ -1393,6 +1681,11 @@ func (mb *MetricsBuilder) RecordActiveDirectoryDsBindRateDataPoint(ts pcommon.Ti
 	mb.metricActiveDirectoryDsBindRate.recordDataPoint(mb.startTime, ts, val, bindTypeAttributeValue.String())
 }
 
+// RecordActiveDirectoryDsLdapBindDurationDataPoint adds a data point to active_directory.ds.ldap.bind.duration metric.
+func (mb *MetricsBuilder) RecordActiveDirectoryDsLdapBindDurationDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricActiveDirectoryDsLdapBindDuration.recordDataPoint(mb.startTime, ts, val)
+}
+
 // RecordActiveDirectoryDsLdapBindLastSuccessfulTimeDataPoint adds a data point to active_directory.ds.ldap.bind.last_successful.time metric.
 func (mb *MetricsBuilder) RecordActiveDirectoryDsLdapBindLastSuccessfulTimeDataPoint(ts pcommon.Timestamp, val int64) {
 	mb.metricActiveDirectoryDsLdapBindLastSuccessfulTime.recordDataPoint(mb.startTime, ts, val)
@@ -1403,6 +1696,11 @@ func (mb *MetricsBuilder) RecordActiveDirectoryDsLdapBindRateDataPoint(ts pcommo
 	mb.metricActiveDirectoryDsLdapBindRate.recordDataPoint(mb.startTime, ts, val)
 }
 
+// RecordActiveDirectoryDsLdapBindStatusDataPoint adds a data point to active_directory.ds.ldap.bind.status metric.
+func (mb *MetricsBuilder) RecordActiveDirectoryDsLdapBindStatusDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricActiveDirectoryDsLdapBindStatus.recordDataPoint(mb.startTime, ts, val)
+}
+
 // RecordActiveDirectoryDsLdapClientSessionCountDataPoint adds a data point to active_directory.ds.ldap.client.session.count metric.
 func (mb *MetricsBuilder) RecordActiveDirectoryDsLdapClientSessionCountDataPoint(ts pcommon.Timestamp, val int64) {
 	mb.metricActiveDirectoryDsLdapClientSessionCount.recordDataPoint(mb.startTime, ts, val)
@@ -1443,6 +1741,16 @@ func (mb *MetricsBuilder) RecordActiveDirectoryDsReplicationOperationPendingData
 	mb.metricActiveDirectoryDsReplicationOperationPending.recordDataPoint(mb.startTime, ts, val)
 }
 
+// RecordActiveDirectoryDsReplicationPartnerSyncAgeDataPoint adds a data point to active_directory.ds.replication.partner.sync.age metric.
+func (mb *MetricsBuilder) RecordActiveDirectoryDsReplicationPartnerSyncAgeDataPoint(ts pcommon.Timestamp, val int64, partnerAttributeValue string, namingContextAttributeValue string) {
+	mb.metricActiveDirectoryDsReplicationPartnerSyncAge.recordDataPoint(mb.startTime, ts, val, partnerAttributeValue, namingContextAttributeValue)
+}
+
+// RecordActiveDirectoryDsReplicationPartnerSyncFailuresDataPoint adds a data point to active_directory.ds.replication.partner.sync.failures metric.
+func (mb *MetricsBuilder) RecordActiveDirectoryDsReplicationPartnerSyncFailuresDataPoint(ts pcommon.Timestamp, val int64, partnerAttributeValue string, namingContextAttributeValue string) {
+	mb.metricActiveDirectoryDsReplicationPartnerSyncFailures.recordDataPoint(mb.startTime, ts, val, partnerAttributeValue, namingContextAttributeValue)
+}
+
 // RecordActiveDirectoryDsReplicationPropertyRateDataPoint adds a data point to active_directory.ds.replication.property.rate metric.
 func (mb *MetricsBuilder) RecordActiveDirectoryDsReplicationPropertyRateDataPoint(ts pcommon.Timestamp, val float64, directionAttributeValue AttributeDirection) {
 	mb.metricActiveDirectoryDsReplicationPropertyRate.recordDataPoint(mb.startTime, ts, val, directionAttributeValue.String())
@@ -1453,6 +1761,11 @@ func (mb *MetricsBuilder) RecordActiveDirectoryDsReplicationSyncObjectPendingDat
 	mb.metricActiveDirectoryDsReplicationSyncObjectPending.recordDataPoint(mb.startTime, ts, val)
 }
 
+// RecordActiveDirectoryDsReplicationSyncPendingDataPoint adds a data point to active_directory.ds.replication.sync.pending metric.
+func (mb *MetricsBuilder) RecordActiveDirectoryDsReplicationSyncPendingDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricActiveDirectoryDsReplicationSyncPending.recordDataPoint(mb.startTime, ts, val)
+}
+
 // RecordActiveDirectoryDsReplicationSyncRequestCountDataPoint adds a data point to active_directory.ds.replication.sync.request.count metric.
 func (mb *MetricsBuilder) RecordActiveDirectoryDsReplicationSyncRequestCountDataPoint(ts pcommon.Timestamp, val int64, syncResultAttributeValue AttributeSyncResult) {
 	mb.metricActiveDirectoryDsReplicationSyncRequestCount.recordDataPoint(mb.startTime, ts, val, syncResultAttributeValue.String())
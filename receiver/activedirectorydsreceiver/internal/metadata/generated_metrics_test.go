@@ -63,6 +63,10 @@ func TestMetricsBuilder(t *testing.T) {
 			allMetricsCount++
 			mb.RecordActiveDirectoryDsBindRateDataPoint(ts, 1, AttributeBindTypeServer)
 
+			defaultMetricsCount++
+			allMetricsCount++
+			mb.RecordActiveDirectoryDsLdapBindDurationDataPoint(ts, 1)
+
 			defaultMetricsCount++
 			allMetricsCount++
 			mb.RecordActiveDirectoryDsLdapBindLastSuccessfulTimeDataPoint(ts, 1)
@@ -71,6 +75,10 @@ func TestMetricsBuilder(t *testing.T) {
 			allMetricsCount++
 			mb.RecordActiveDirectoryDsLdapBindRateDataPoint(ts, 1)
 
+			defaultMetricsCount++
+			allMetricsCount++
+			mb.RecordActiveDirectoryDsLdapBindStatusDataPoint(ts, 1)
+
 			defaultMetricsCount++
 			allMetricsCount++
 			mb.RecordActiveDirectoryDsLdapClientSessionCountDataPoint(ts, 1)
@@ -103,6 +111,12 @@ func TestMetricsBuilder(t *testing.T) {
 			allMetricsCount++
 			mb.RecordActiveDirectoryDsReplicationOperationPendingDataPoint(ts, 1)
 
+			allMetricsCount++
+			mb.RecordActiveDirectoryDsReplicationPartnerSyncAgeDataPoint(ts, 1, "partner-val", "naming_context-val")
+
+			allMetricsCount++
+			mb.RecordActiveDirectoryDsReplicationPartnerSyncFailuresDataPoint(ts, 1, "partner-val", "naming_context-val")
+
 			defaultMetricsCount++
 			allMetricsCount++
 			mb.RecordActiveDirectoryDsReplicationPropertyRateDataPoint(ts, 1, AttributeDirectionSent)
@@ -111,6 +125,10 @@ func TestMetricsBuilder(t *testing.T) {
 			allMetricsCount++
 			mb.RecordActiveDirectoryDsReplicationSyncObjectPendingDataPoint(ts, 1)
 
+			defaultMetricsCount++
+			allMetricsCount++
+			mb.RecordActiveDirectoryDsReplicationSyncPendingDataPoint(ts, 1)
+
 			defaultMetricsCount++
 			allMetricsCount++
 			mb.RecordActiveDirectoryDsReplicationSyncRequestCountDataPoint(ts, 1, AttributeSyncResultSuccess)
@@ -170,6 +188,18 @@ func TestMetricsBuilder(t *testing.T) {
 					attrVal, ok := dp.Attributes().Get("type")
 					assert.True(t, ok)
 					assert.Equal(t, "server", attrVal.Str())
+				case "active_directory.ds.ldap.bind.duration":
+					assert.False(t, validatedMetrics["active_directory.ds.ldap.bind.duration"], "Found a duplicate in the metrics slice: active_directory.ds.ldap.bind.duration")
+					validatedMetrics["active_directory.ds.ldap.bind.duration"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "The time taken by the synthetic LDAP bind.", ms.At(i).Description())
+					assert.Equal(t, "ms", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
 				case "active_directory.ds.ldap.bind.last_successful.time":
 					assert.False(t, validatedMetrics["active_directory.ds.ldap.bind.last_successful.time"], "Found a duplicate in the metrics slice: active_directory.ds.ldap.bind.last_successful.time")
 					validatedMetrics["active_directory.ds.ldap.bind.last_successful.time"] = true
@@ -196,6 +226,18 @@ func TestMetricsBuilder(t *testing.T) {
 					assert.Equal(t, ts, dp.Timestamp())
 					assert.Equal(t, pmetric.NumberDataPointValueTypeDouble, dp.ValueType())
 					assert.InDelta(t, float64(1), dp.DoubleValue(), 0.01)
+				case "active_directory.ds.ldap.bind.status":
+					assert.False(t, validatedMetrics["active_directory.ds.ldap.bind.status"], "Found a duplicate in the metrics slice: active_directory.ds.ldap.bind.status")
+					validatedMetrics["active_directory.ds.ldap.bind.status"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Whether the synthetic LDAP bind succeeded (1) or failed (0).", ms.At(i).Description())
+					assert.Equal(t, "1", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
 				case "active_directory.ds.ldap.client.session.count":
 					assert.False(t, validatedMetrics["active_directory.ds.ldap.client.session.count"], "Found a duplicate in the metrics slice: active_directory.ds.ldap.client.session.count")
 					validatedMetrics["active_directory.ds.ldap.client.session.count"] = true
@@ -318,6 +360,42 @@ func TestMetricsBuilder(t *testing.T) {
 					assert.Equal(t, ts, dp.Timestamp())
 					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
 					assert.Equal(t, int64(1), dp.IntValue())
+				case "active_directory.ds.replication.partner.sync.age":
+					assert.False(t, validatedMetrics["active_directory.ds.replication.partner.sync.age"], "Found a duplicate in the metrics slice: active_directory.ds.replication.partner.sync.age")
+					validatedMetrics["active_directory.ds.replication.partner.sync.age"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "The time since the last successful replication of the naming context from the replication partner.", ms.At(i).Description())
+					assert.Equal(t, "s", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("partner")
+					assert.True(t, ok)
+					assert.Equal(t, "partner-val", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("naming_context")
+					assert.True(t, ok)
+					assert.Equal(t, "naming_context-val", attrVal.Str())
+				case "active_directory.ds.replication.partner.sync.failures":
+					assert.False(t, validatedMetrics["active_directory.ds.replication.partner.sync.failures"], "Found a duplicate in the metrics slice: active_directory.ds.replication.partner.sync.failures")
+					validatedMetrics["active_directory.ds.replication.partner.sync.failures"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "The number of consecutive failed replications of the naming context from the replication partner.", ms.At(i).Description())
+					assert.Equal(t, "{failures}", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("partner")
+					assert.True(t, ok)
+					assert.Equal(t, "partner-val", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("naming_context")
+					assert.True(t, ok)
+					assert.Equal(t, "naming_context-val", attrVal.Str())
 				case "active_directory.ds.replication.property.rate":
 					assert.False(t, validatedMetrics["active_directory.ds.replication.property.rate"], "Found a duplicate in the metrics slice: active_directory.ds.replication.property.rate")
 					validatedMetrics["active_directory.ds.replication.property.rate"] = true
@@ -349,6 +427,20 @@ func TestMetricsBuilder(t *testing.T) {
 					assert.Equal(t, ts, dp.Timestamp())
 					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
 					assert.Equal(t, int64(1), dp.IntValue())
+				case "active_directory.ds.replication.sync.pending":
+					assert.False(t, validatedMetrics["active_directory.ds.replication.sync.pending"], "Found a duplicate in the metrics slice: active_directory.ds.replication.sync.pending")
+					validatedMetrics["active_directory.ds.replication.sync.pending"] = true
+					assert.Equal(t, pmetric.MetricTypeSum, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Sum().DataPoints().Len())
+					assert.Equal(t, "The number of directory synchronizations queued for the Directory Replication Agent.", ms.At(i).Description())
+					assert.Equal(t, "{synchronizations}", ms.At(i).Unit())
+					assert.False(t, ms.At(i).Sum().IsMonotonic())
+					assert.Equal(t, pmetric.AggregationTemporalityCumulative, ms.At(i).Sum().AggregationTemporality())
+					dp := ms.At(i).Sum().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
 				case "active_directory.ds.replication.sync.request.count":
 					assert.False(t, validatedMetrics["active_directory.ds.replication.sync.request.count"], "Found a duplicate in the metrics slice: active_directory.ds.replication.sync.request.count")
 					validatedMetrics["active_directory.ds.replication.sync.request.count"] = true
@@ -0,0 +1,223 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package activedirectorydsreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/activedirectorydsreceiver"
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"time"
+)
+
+// BER tags of the LDAP messages, see RFC 4511.
+const (
+	berTagInteger           = 0x02
+	berTagOctetString       = 0x04
+	berTagEnumerated        = 0x0a
+	berTagSequence          = 0x30
+	ldapTagBindRequest      = 0x60
+	ldapTagBindResponse     = 0x61
+	ldapTagExtendedRequest  = 0x77
+	ldapTagExtendedResponse = 0x78
+	ldapTagSimpleAuth       = 0x80
+	ldapTagRequestName      = 0x80
+
+	ldapVersion         = 3
+	ldapStartTLSMessage = 1
+	ldapBindMessage     = 2
+	// ldapStartTLSOID is the name of the StartTLS extended operation, see RFC 4511.
+	ldapStartTLSOID = "1.3.6.1.4.1.1466.20037"
+	// maxLDAPResponseSize bounds the size of the responses read.
+	maxLDAPResponseSize = 64 << 10
+)
+
+var errMalformedLDAPResponse = errors.New("malformed LDAP response")
+
+// ldapBind performs a simple bind and returns its duration, which includes the
+// connection to the server and the TLS handshake.
+func ldapBind(ctx context.Context, cfg LDAPBindConfig) (time.Duration, error) {
+	ctx, cancel := context.WithTimeout(ctx, cfg.Timeout)
+	defer cancel()
+
+	// The bind is only sent in plaintext when explicitly configured to.
+	var tlsConfig *tls.Config
+	if !cfg.TLS.Insecure {
+		var err error
+		if tlsConfig, err = cfg.TLS.LoadTLSConfig(ctx); err != nil {
+			return 0, fmt.Errorf("failed to load TLS config: %w", err)
+		}
+		if tlsConfig.ServerName == "" {
+			tlsConfig.ServerName, _, _ = net.SplitHostPort(cfg.Endpoint)
+		}
+	}
+
+	start := time.Now()
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "tcp", cfg.Endpoint)
+	if err != nil {
+		return 0, err
+	}
+	defer func() { conn.Close() }()
+	if deadline, ok := ctx.Deadline(); ok {
+		if err = conn.SetDeadline(deadline); err != nil {
+			return 0, err
+		}
+	}
+
+	if tlsConfig != nil {
+		if cfg.StartTLS {
+			if _, err = conn.Write(startTLSRequest(ldapStartTLSMessage)); err != nil {
+				return 0, err
+			}
+			// The server doesn't send anything else before the handshake, so the
+			// buffered reader can't consume any of it.
+			if err = readResponse(bufio.NewReader(conn), ldapStartTLSMessage, ldapTagExtendedResponse, "StartTLS"); err != nil {
+				return 0, err
+			}
+		}
+		tlsConn := tls.Client(conn, tlsConfig)
+		conn = tlsConn
+		if err = tlsConn.HandshakeContext(ctx); err != nil {
+			return 0, err
+		}
+	}
+
+	if _, err = conn.Write(bindRequest(ldapBindMessage, cfg.Username, string(cfg.Password))); err != nil {
+		return 0, err
+	}
+	if err = readResponse(bufio.NewReader(conn), ldapBindMessage, ldapTagBindResponse, "bind"); err != nil {
+		return 0, err
+	}
+	return time.Since(start), nil
+}
+
+// startTLSRequest encodes the LDAP message of the StartTLS extended operation.
+func startTLSRequest(messageID int) []byte {
+	message := berEncode(berTagInteger, []byte{byte(messageID)})
+	message = append(message, berEncode(ldapTagExtendedRequest, berEncode(ldapTagRequestName, []byte(ldapStartTLSOID)))...)
+	return berEncode(berTagSequence, message)
+}
+
+// bindRequest encodes the LDAP message of a simple bind.
+func bindRequest(messageID int, name, password string) []byte {
+	bind := berEncode(berTagInteger, []byte{ldapVersion})
+	bind = append(bind, berEncode(berTagOctetString, []byte(name))...)
+	bind = append(bind, berEncode(ldapTagSimpleAuth, []byte(password))...)
+
+	message := berEncode(berTagInteger, []byte{byte(messageID)})
+	message = append(message, berEncode(ldapTagBindRequest, bind)...)
+	return berEncode(berTagSequence, message)
+}
+
+// readResponse reads the LDAP message of the response to the operation, and
+// returns an error if the operation failed.
+func readResponse(r *bufio.Reader, messageID int, responseTag byte, operation string) error {
+	tag, err := r.ReadByte()
+	if err != nil {
+		return err
+	}
+	if tag != berTagSequence {
+		return errMalformedLDAPResponse
+	}
+	length, err := readBERLength(r)
+	if err != nil {
+		return err
+	}
+	if length > maxLDAPResponseSize {
+		return fmt.Errorf("LDAP %s response of %d bytes is too large", operation, length)
+	}
+	message := make([]byte, length)
+	if _, err = io.ReadFull(r, message); err != nil {
+		return err
+	}
+
+	_, id, rest, err := berDecode(message)
+	if err != nil || len(id) != 1 || int(id[0]) != messageID {
+		return errMalformedLDAPResponse
+	}
+	tag, response, _, err := berDecode(rest)
+	if err != nil || tag != responseTag {
+		return errMalformedLDAPResponse
+	}
+	tag, resultCode, rest, err := berDecode(response)
+	if err != nil || tag != berTagEnumerated || len(resultCode) != 1 {
+		return errMalformedLDAPResponse
+	}
+	if resultCode[0] == 0 {
+		return nil
+	}
+
+	// The matched DN is followed by the diagnostic message.
+	var diagnostic []byte
+	if _, _, rest, err = berDecode(rest); err == nil {
+		_, diagnostic, _, _ = berDecode(rest)
+	}
+	if len(diagnostic) > 0 {
+		return fmt.Errorf("LDAP %s failed with result code %d: %s", operation, resultCode[0], diagnostic)
+	}
+	return fmt.Errorf("LDAP %s failed with result code %d", operation, resultCode[0])
+}
+
+// berEncode encodes a value with its tag and definite length.
+func berEncode(tag byte, value []byte) []byte {
+	b := []byte{tag}
+	switch n := len(value); {
+	case n < 0x80:
+		b = append(b, byte(n))
+	case n <= 0xff:
+		b = append(b, 0x81, byte(n))
+	default:
+		b = append(b, 0x82, byte(n>>8), byte(n))
+	}
+	return append(b, value...)
+}
+
+// berDecode decodes the first value of b and returns the remaining bytes.
+func berDecode(b []byte) (byte, []byte, []byte, error) {
+	if len(b) < 2 {
+		return 0, nil, nil, errMalformedLDAPResponse
+	}
+	tag := b[0]
+	length, err := readBERLength(bytes.NewReader(b[1:]))
+	if err != nil {
+		return 0, nil, nil, err
+	}
+	header := 2
+	if b[1]&0x80 != 0 {
+		header += int(b[1] & 0x7f)
+	}
+	if length > len(b)-header {
+		return 0, nil, nil, errMalformedLDAPResponse
+	}
+	return tag, b[header : header+length], b[header+length:], nil
+}
+
+// readBERLength reads a definite length, in its short or long form.
+func readBERLength(r io.ByteReader) (int, error) {
+	first, err := r.ReadByte()
+	if err != nil {
+		return 0, err
+	}
+	if first&0x80 == 0 {
+		return int(first), nil
+	}
+	n := int(first & 0x7f)
+	if n == 0 || n > 4 {
+		return 0, errMalformedLDAPResponse
+	}
+	length := 0
+	for i := 0; i < n; i++ {
+		b, err := r.ReadByte()
+		if err != nil {
+			return 0, err
+		}
+		length = length<<8 | int(b)
+	}
+	return length, nil
+}
@@ -0,0 +1,211 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package activedirectorydsreceiver
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"io"
+	"math/big"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/config/configtls"
+)
+
+func TestBindRequest(t *testing.T) {
+	expected := []byte{
+		0x30, 0x1a, // LDAPMessage
+		0x02, 0x01, 0x01, // messageID
+		0x60, 0x15, // BindRequest
+		0x02, 0x01, 0x03, // version
+		0x04, 0x08, 'c', 'n', '=', 'a', 'd', 'm', 'i', 'n', // name
+		0x80, 0x06, 's', 'e', 'c', 'r', 'e', 't', // simple authentication
+	}
+	require.Equal(t, expected, bindRequest(1, "cn=admin", "secret"))
+
+	long := bindRequest(1, string(bytes.Repeat([]byte("a"), 300)), "")
+	require.Equal(t, []byte{0x30, 0x82, 0x01, 0x3c}, long[:4])
+}
+
+func TestReadBindResponse(t *testing.T) {
+	tests := []struct {
+		name        string
+		response    []byte
+		expectedErr string
+	}{
+		{
+			name:     "success",
+			response: []byte{0x30, 0x0c, 0x02, 0x01, 0x01, 0x61, 0x07, 0x0a, 0x01, 0x00, 0x04, 0x00, 0x04, 0x00},
+		},
+		{
+			name:        "invalid credentials",
+			response:    []byte{0x30, 0x10, 0x02, 0x01, 0x01, 0x61, 0x0b, 0x0a, 0x01, 0x31, 0x04, 0x00, 0x04, 0x04, 'n', 'o', 'p', 'e'},
+			expectedErr: "LDAP bind failed with result code 49: nope",
+		},
+		{
+			name:        "unexpected message",
+			response:    []byte{0x30, 0x0c, 0x02, 0x01, 0x02, 0x61, 0x07, 0x0a, 0x01, 0x00, 0x04, 0x00, 0x04, 0x00},
+			expectedErr: errMalformedLDAPResponse.Error(),
+		},
+		{
+			name:        "truncated",
+			response:    []byte{0x30, 0x0c, 0x02, 0x01, 0x01},
+			expectedErr: io.ErrUnexpectedEOF.Error(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := readResponse(bufio.NewReader(bytes.NewReader(tt.response)), 1, ldapTagBindResponse, "bind")
+			if tt.expectedErr == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.EqualError(t, err, tt.expectedErr)
+		})
+	}
+}
+
+// bindSuccess is the response to a successful bind.
+var bindSuccess = []byte{0x30, 0x0c, 0x02, 0x01, ldapBindMessage, 0x61, 0x07, 0x0a, 0x01, 0x00, 0x04, 0x00, 0x04, 0x00}
+
+// serveBind accepts a connection and replies to the expected bind request.
+func serveBind(listener net.Listener, wrap func(net.Conn) (net.Conn, error)) {
+	conn, err := listener.Accept()
+	if err != nil {
+		return
+	}
+	defer conn.Close()
+	if conn, err = wrap(conn); err != nil {
+		return
+	}
+	request := bindRequest(ldapBindMessage, "monitor", "secret")
+	received := make([]byte, len(request))
+	if _, err := io.ReadFull(conn, received); err != nil || !bytes.Equal(received, request) {
+		return
+	}
+	_, _ = conn.Write(bindSuccess)
+}
+
+func TestLDAPBind(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer listener.Close()
+
+	go serveBind(listener, func(conn net.Conn) (net.Conn, error) { return conn, nil })
+
+	duration, err := ldapBind(context.Background(), LDAPBindConfig{
+		Endpoint: listener.Addr().String(),
+		Username: "monitor",
+		Password: "secret",
+		Timeout:  5 * time.Second,
+		TLS:      configtls.ClientConfig{Insecure: true},
+	})
+	require.NoError(t, err)
+	require.Positive(t, duration)
+}
+
+func TestLDAPBindTLS(t *testing.T) {
+	serverConfig := &tls.Config{Certificates: []tls.Certificate{newTestCertificate(t)}, MinVersion: tls.VersionTLS12}
+
+	tests := []struct {
+		name     string
+		startTLS bool
+		wrap     func(net.Conn) (net.Conn, error)
+	}{
+		{
+			name: "ldaps",
+			wrap: func(conn net.Conn) (net.Conn, error) {
+				tlsConn := tls.Server(conn, serverConfig)
+				return tlsConn, tlsConn.Handshake()
+			},
+		},
+		{
+			name:     "start_tls",
+			startTLS: true,
+			wrap: func(conn net.Conn) (net.Conn, error) {
+				request := startTLSRequest(ldapStartTLSMessage)
+				received := make([]byte, len(request))
+				if _, err := io.ReadFull(conn, received); err != nil || !bytes.Equal(received, request) {
+					return nil, errors.New("unexpected StartTLS request")
+				}
+				if _, err := conn.Write([]byte{0x30, 0x0c, 0x02, 0x01, ldapStartTLSMessage, 0x78, 0x07, 0x0a, 0x01, 0x00, 0x04, 0x00, 0x04, 0x00}); err != nil {
+					return nil, err
+				}
+				tlsConn := tls.Server(conn, serverConfig)
+				return tlsConn, tlsConn.Handshake()
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			listener, err := net.Listen("tcp", "127.0.0.1:0")
+			require.NoError(t, err)
+			defer listener.Close()
+
+			go serveBind(listener, tt.wrap)
+
+			duration, err := ldapBind(context.Background(), LDAPBindConfig{
+				Endpoint: listener.Addr().String(),
+				Username: "monitor",
+				Password: "secret",
+				Timeout:  5 * time.Second,
+				TLS:      configtls.ClientConfig{InsecureSkipVerify: true},
+				StartTLS: tt.startTLS,
+			})
+			require.NoError(t, err)
+			require.Positive(t, duration)
+		})
+	}
+}
+
+// newTestCertificate returns a self-signed certificate for 127.0.0.1.
+func newTestCertificate(t *testing.T) tls.Certificate {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		IPAddresses:  []net.IP{net.IPv4(127, 0, 0, 1)},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	return tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key}
+}
+
+func TestLDAPBindTimeout(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer listener.Close()
+
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		<-done
+	}()
+
+	_, err = ldapBind(context.Background(), LDAPBindConfig{
+		Endpoint: listener.Addr().String(),
+		Timeout:  50 * time.Millisecond,
+		TLS:      configtls.ClientConfig{Insecure: true},
+	})
+	require.ErrorContains(t, err, "timeout")
+}
@@ -0,0 +1,112 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build windows
+
+package activedirectorydsreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/activedirectorydsreceiver"
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"syscall"
+	"time"
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+var (
+	ntdsapi               = windows.NewLazySystemDLL("ntdsapi.dll")
+	procDsBindW           = ntdsapi.NewProc("DsBindW")
+	procDsUnBindW         = ntdsapi.NewProc("DsUnBindW")
+	procDsReplicaGetInfoW = ntdsapi.NewProc("DsReplicaGetInfoW")
+	procDsReplicaFreeInfo = ntdsapi.NewProc("DsReplicaFreeInfo")
+)
+
+// dsReplInfoNeighbors is the DS_REPL_INFO_NEIGHBORS info type, the inbound
+// replication partners of the naming contexts.
+const dsReplInfoNeighbors = 0
+
+// dsReplNeighbor is the DS_REPL_NEIGHBORW structure.
+// https://learn.microsoft.com/en-us/windows/win32/api/ntdsapi/ns-ntdsapi-ds_repl_neighborw
+type dsReplNeighbor struct {
+	NamingContext                  *uint16
+	SourceDsaDN                    *uint16
+	SourceDsaAddress               *uint16
+	AsyncIntersiteTransportDN      *uint16
+	ReplicaFlags                   uint32
+	Reserved                       uint32
+	NamingContextObjGUID           windows.GUID
+	SourceDsaObjGUID               windows.GUID
+	SourceDsaInvocationID          windows.GUID
+	AsyncIntersiteTransportObjGUID windows.GUID
+	USNLastObjChangeSynced         int64
+	USNAttributeFilter             int64
+	LastSyncSuccess                windows.Filetime
+	LastSyncAttempt                windows.Filetime
+	LastSyncResult                 uint32
+	NumConsecutiveSyncFailures     uint32
+}
+
+// dsReplNeighbors is the header of the DS_REPL_NEIGHBORSW structure, followed
+// by the neighbors.
+type dsReplNeighbors struct {
+	NumNeighbors uint32
+	Reserved     uint32
+}
+
+// replicationNeighbors returns the inbound replication partners of the naming
+// contexts of the local domain controller.
+func replicationNeighbors() ([]replicationNeighbor, error) {
+	hostname, err := os.Hostname()
+	if err != nil {
+		return nil, err
+	}
+	dc, err := windows.UTF16PtrFromString(hostname)
+	if err != nil {
+		return nil, err
+	}
+
+	var handle windows.Handle
+	if r, _, _ := procDsBindW.Call(uintptr(unsafe.Pointer(dc)), 0, uintptr(unsafe.Pointer(&handle))); r != 0 {
+		return nil, fmt.Errorf("DsBind: %w", syscall.Errno(r))
+	}
+	defer procDsUnBindW.Call(uintptr(unsafe.Pointer(&handle))) //nolint:errcheck
+
+	var info *dsReplNeighbors
+	if r, _, _ := procDsReplicaGetInfoW.Call(uintptr(handle), dsReplInfoNeighbors, 0, 0, uintptr(unsafe.Pointer(&info))); r != 0 {
+		return nil, fmt.Errorf("DsReplicaGetInfo: %w", syscall.Errno(r))
+	}
+	defer procDsReplicaFreeInfo.Call(dsReplInfoNeighbors, uintptr(unsafe.Pointer(info))) //nolint:errcheck
+
+	if info.NumNeighbors == 0 {
+		return nil, nil
+	}
+	first := (*dsReplNeighbor)(unsafe.Add(unsafe.Pointer(info), unsafe.Sizeof(*info)))
+	neighbors := make([]replicationNeighbor, 0, info.NumNeighbors)
+	for _, n := range unsafe.Slice(first, info.NumNeighbors) {
+		neighbor := replicationNeighbor{
+			partner:             partnerName(windows.UTF16PtrToString(n.SourceDsaDN)),
+			namingContext:       windows.UTF16PtrToString(n.NamingContext),
+			consecutiveFailures: int64(n.NumConsecutiveSyncFailures),
+		}
+		if n.LastSyncSuccess.Nanoseconds() > 0 {
+			neighbor.lastSuccess = time.Unix(0, n.LastSyncSuccess.Nanoseconds())
+		}
+		neighbors = append(neighbors, neighbor)
+	}
+	return neighbors, nil
+}
+
+// partnerName returns the name of the domain controller from the distinguished
+// name of its NTDS settings, such as CN=NTDS Settings,CN=DC2,CN=Servers,....
+func partnerName(dn string) string {
+	rdns := strings.Split(dn, ",")
+	if len(rdns) > 1 && strings.EqualFold(rdns[0], "CN=NTDS Settings") {
+		if name, ok := strings.CutPrefix(rdns[1], "CN="); ok {
+			return name
+		}
+	}
+	return dn
+}
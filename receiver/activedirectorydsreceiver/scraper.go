@@ -21,13 +21,28 @@ import (
 )
 
 type activeDirectoryDSScraper struct {
-	mb *metadata.MetricsBuilder
-	w  *watchers
+	mb  *metadata.MetricsBuilder
+	w   *watchers
+	cfg *Config
+
+	neighbors func() ([]replicationNeighbor, error)
+	bind      func(ctx context.Context, cfg LDAPBindConfig) (time.Duration, error)
+}
+
+// replicationNeighbor is an inbound replication partner of a naming context.
+type replicationNeighbor struct {
+	partner             string
+	namingContext       string
+	lastSuccess         time.Time
+	consecutiveFailures int64
 }
 
-func newActiveDirectoryDSScraper(mbc metadata.MetricsBuilderConfig, params receiver.Settings) *activeDirectoryDSScraper {
+func newActiveDirectoryDSScraper(cfg *Config, params receiver.Settings) *activeDirectoryDSScraper {
 	return &activeDirectoryDSScraper{
-		mb: metadata.NewMetricsBuilder(mbc, params),
+		mb:        metadata.NewMetricsBuilder(cfg.MetricsBuilderConfig, params),
+		cfg:       cfg,
+		neighbors: replicationNeighbors,
+		bind:      ldapBind,
 	}
 }
 
@@ -44,7 +59,7 @@ func (a *activeDirectoryDSScraper) start(_ context.Context, _ component.Host) er
 	return nil
 }
 
-func (a *activeDirectoryDSScraper) scrape(_ context.Context) (pmetric.Metrics, error) {
+func (a *activeDirectoryDSScraper) scrape(ctx context.Context) (pmetric.Metrics, error) {
 	var multiErr error
 	now := pcommon.NewTimestampFromTime(time.Now())
 
@@ -245,6 +260,15 @@ func (a *activeDirectoryDSScraper) scrape(_ context.Context) (pmetric.Metrics, e
 		a.mb.RecordActiveDirectoryDsLdapSearchRateDataPoint(now, ldapSearches)
 	}
 
+	draPendingReplicationSynchronizations, err := a.w.Scrape(draPendingReplicationSynchronizations)
+	multiErr = multierr.Append(multiErr, err)
+	if err == nil {
+		a.mb.RecordActiveDirectoryDsReplicationSyncPendingDataPoint(now, int64(draPendingReplicationSynchronizations))
+	}
+
+	multiErr = multierr.Append(multiErr, a.scrapeReplicationPartners(now))
+	multiErr = multierr.Append(multiErr, a.scrapeLDAPBind(ctx, now))
+
 	if multiErr != nil {
 		return a.mb.Emit(), scrapererror.NewPartialScrapeError(multiErr, len(multierr.Errors(multiErr)))
 	}
@@ -252,6 +276,44 @@ func (a *activeDirectoryDSScraper) scrape(_ context.Context) (pmetric.Metrics, e
 	return a.mb.Emit(), nil
 }
 
+// scrapeReplicationPartners records the replication state of the naming
+// contexts for each inbound replication partner, if the metrics are enabled.
+func (a *activeDirectoryDSScraper) scrapeReplicationPartners(now pcommon.Timestamp) error {
+	metrics := a.cfg.Metrics
+	if !metrics.ActiveDirectoryDsReplicationPartnerSyncAge.Enabled && !metrics.ActiveDirectoryDsReplicationPartnerSyncFailures.Enabled {
+		return nil
+	}
+
+	neighbors, err := a.neighbors()
+	if err != nil {
+		return fmt.Errorf("failed to get the replication partners: %w", err)
+	}
+	for _, n := range neighbors {
+		if !n.lastSuccess.IsZero() {
+			age := now.AsTime().Sub(n.lastSuccess)
+			a.mb.RecordActiveDirectoryDsReplicationPartnerSyncAgeDataPoint(now, int64(age.Seconds()), n.partner, n.namingContext)
+		}
+		a.mb.RecordActiveDirectoryDsReplicationPartnerSyncFailuresDataPoint(now, n.consecutiveFailures, n.partner, n.namingContext)
+	}
+	return nil
+}
+
+// scrapeLDAPBind performs the synthetic LDAP bind, if an endpoint is configured.
+func (a *activeDirectoryDSScraper) scrapeLDAPBind(ctx context.Context, now pcommon.Timestamp) error {
+	if a.cfg.LDAPBind.Endpoint == "" {
+		return nil
+	}
+
+	duration, err := a.bind(ctx, a.cfg.LDAPBind)
+	if err != nil {
+		a.mb.RecordActiveDirectoryDsLdapBindStatusDataPoint(now, 0)
+		return fmt.Errorf("synthetic LDAP bind to %s failed: %w", a.cfg.LDAPBind.Endpoint, err)
+	}
+	a.mb.RecordActiveDirectoryDsLdapBindStatusDataPoint(now, 1)
+	a.mb.RecordActiveDirectoryDsLdapBindDurationDataPoint(now, duration.Milliseconds())
+	return nil
+}
+
 func (a *activeDirectoryDSScraper) shutdown(context.Context) error {
 	return a.w.Close()
 }
@@ -10,8 +10,11 @@ import (
 	"errors"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/receiver/receivertest"
 	"go.opentelemetry.io/collector/scraper/scrapererror"
 
@@ -36,8 +39,9 @@ func TestScrape(t *testing.T) {
 		require.NoError(t, err)
 
 		scraper := &activeDirectoryDSScraper{
-			mb: metadata.NewMetricsBuilder(metadata.DefaultMetricsBuilderConfig(), receivertest.NewNopSettings(metadata.Type)),
-			w:  mockWatchers,
+			mb:  metadata.NewMetricsBuilder(metadata.DefaultMetricsBuilderConfig(), receivertest.NewNopSettings(metadata.Type)),
+			w:   mockWatchers,
+			cfg: createDefaultConfig().(*Config),
 		}
 
 		scrapeData, err := scraper.scrape(context.Background())
@@ -68,8 +72,9 @@ func TestScrape(t *testing.T) {
 		mockWatchers.counterNameToWatcher[draInboundValuesDNs].(*mockPerfCounterWatcher).scrapeErr = draInboundValuesDNErr
 
 		scraper := &activeDirectoryDSScraper{
-			mb: metadata.NewMetricsBuilder(metadata.DefaultMetricsBuilderConfig(), receivertest.NewNopSettings(metadata.Type)),
-			w:  mockWatchers,
+			mb:  metadata.NewMetricsBuilder(metadata.DefaultMetricsBuilderConfig(), receivertest.NewNopSettings(metadata.Type)),
+			w:   mockWatchers,
+			cfg: createDefaultConfig().(*Config),
 		}
 
 		scrapeData, err := scraper.scrape(context.Background())
@@ -103,8 +108,9 @@ func TestScrape(t *testing.T) {
 		mockWatchers.counterNameToWatcher[draInboundValuesDNs].(*mockPerfCounterWatcher).closeErr = draInboundValuesDNErr
 
 		scraper := &activeDirectoryDSScraper{
-			mb: metadata.NewMetricsBuilder(metadata.DefaultMetricsBuilderConfig(), receivertest.NewNopSettings(metadata.Type)),
-			w:  mockWatchers,
+			mb:  metadata.NewMetricsBuilder(metadata.DefaultMetricsBuilderConfig(), receivertest.NewNopSettings(metadata.Type)),
+			w:   mockWatchers,
+			cfg: createDefaultConfig().(*Config),
 		}
 
 		err = scraper.shutdown(context.Background())
@@ -121,8 +127,9 @@ func TestScrape(t *testing.T) {
 		require.NoError(t, err)
 
 		scraper := &activeDirectoryDSScraper{
-			mb: metadata.NewMetricsBuilder(metadata.DefaultMetricsBuilderConfig(), receivertest.NewNopSettings(metadata.Type)),
-			w:  mockWatchers,
+			mb:  metadata.NewMetricsBuilder(metadata.DefaultMetricsBuilderConfig(), receivertest.NewNopSettings(metadata.Type)),
+			w:   mockWatchers,
+			cfg: createDefaultConfig().(*Config),
 		}
 
 		err = scraper.shutdown(context.Background())
@@ -133,6 +140,77 @@ func TestScrape(t *testing.T) {
 	})
 }
 
+func TestScrapeReplicationPartnersAndLDAPBind(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Metrics.ActiveDirectoryDsReplicationPartnerSyncAge.Enabled = true
+	cfg.Metrics.ActiveDirectoryDsReplicationPartnerSyncFailures.Enabled = true
+	cfg.LDAPBind.Endpoint = "localhost:389"
+
+	now := time.Now()
+	bindErr := errors.New("connection refused")
+	bindCalls := 0
+	scraper := &activeDirectoryDSScraper{
+		mb:  metadata.NewMetricsBuilder(cfg.MetricsBuilderConfig, receivertest.NewNopSettings(metadata.Type)),
+		cfg: cfg,
+		neighbors: func() ([]replicationNeighbor, error) {
+			return []replicationNeighbor{
+				{partner: "DC2", namingContext: "DC=example,DC=com", lastSuccess: now.Add(-90 * time.Second)},
+				{partner: "DC3", namingContext: "DC=example,DC=com", consecutiveFailures: 4},
+			}, nil
+		},
+		bind: func(_ context.Context, bindCfg LDAPBindConfig) (time.Duration, error) {
+			require.Equal(t, "localhost:389", bindCfg.Endpoint)
+			bindCalls++
+			if bindCalls > 1 {
+				return 0, bindErr
+			}
+			return 12 * time.Millisecond, nil
+		},
+	}
+
+	ts := pcommon.NewTimestampFromTime(now)
+	require.NoError(t, scraper.scrapeReplicationPartners(ts))
+	require.NoError(t, scraper.scrapeLDAPBind(context.Background(), ts))
+	values := metricValues(scraper.mb.Emit())
+	require.Equal(t, map[string]int64{
+		"active_directory.ds.replication.partner.sync.age/DC2":      90,
+		"active_directory.ds.replication.partner.sync.failures/DC2": 0,
+		"active_directory.ds.replication.partner.sync.failures/DC3": 4,
+		"active_directory.ds.ldap.bind.duration":                    12,
+		"active_directory.ds.ldap.bind.status":                      1,
+	}, values)
+
+	require.ErrorIs(t, scraper.scrapeLDAPBind(context.Background(), ts), bindErr)
+	values = metricValues(scraper.mb.Emit())
+	require.Equal(t, map[string]int64{
+		"active_directory.ds.ldap.bind.status": 0,
+	}, values)
+}
+
+// metricValues returns the int values of the data points keyed by metric name
+// and partner.
+func metricValues(metrics pmetric.Metrics) map[string]int64 {
+	values := map[string]int64{}
+	rms := metrics.ResourceMetrics()
+	for i := 0; i < rms.Len(); i++ {
+		sms := rms.At(i).ScopeMetrics()
+		for j := 0; j < sms.Len(); j++ {
+			ms := sms.At(j).Metrics()
+			for k := 0; k < ms.Len(); k++ {
+				dps := ms.At(k).Gauge().DataPoints()
+				for l := 0; l < dps.Len(); l++ {
+					key := ms.At(k).Name()
+					if partner, ok := dps.At(l).Attributes().Get("partner"); ok {
+						key += "/" + partner.Str()
+					}
+					values[key] = dps.At(l).IntValue()
+				}
+			}
+		}
+	}
+	return values
+}
+
 type mockPerfCounterWatcher struct {
 	val       float64
 	scrapeErr error
@@ -0,0 +1,273 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package amqpreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/amqpreceiver"
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/Azure/go-amqp"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/consumer/consumererror"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.opentelemetry.io/collector/receiver"
+	"go.opentelemetry.io/collector/receiver/receiverhelper"
+	"go.uber.org/zap"
+)
+
+const (
+	transport = "amqp"
+
+	contentTypeProtobuf = "application/x-protobuf"
+	contentTypeJSON     = "application/json"
+
+	// closeTimeout bounds the time spent detaching the link and closing the connection.
+	closeTimeout = 5 * time.Second
+)
+
+var errUnmarshal = errors.New("failed to unmarshal message")
+
+type amqpReceiver struct {
+	cfg      *Config
+	settings receiver.Settings
+	obsrecv  *receiverhelper.ObsReport
+
+	// consume decodes the payload in the encoding and passes the telemetry to the next consumer.
+	consume func(ctx context.Context, encoding string, payload []byte) error
+
+	newService messagingServiceFactory
+	cancel     context.CancelFunc
+	wg         sync.WaitGroup
+
+	// releaseDelay is the delay before releasing the last message the next consumer failed
+	// to consume, reset once a message is settled otherwise.
+	releaseDelay time.Duration
+}
+
+func newReceiver(cfg *Config, set receiver.Settings) (*amqpReceiver, error) {
+	obsrecv, err := receiverhelper.NewObsReport(receiverhelper.ObsReportSettings{
+		ReceiverID:             set.ID,
+		Transport:              transport,
+		ReceiverCreateSettings: set,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &amqpReceiver{
+		cfg:      cfg,
+		settings: set,
+		obsrecv:  obsrecv,
+	}, nil
+}
+
+func newTracesReceiver(cfg *Config, set receiver.Settings, nextConsumer consumer.Traces) (*amqpReceiver, error) {
+	r, err := newReceiver(cfg, set)
+	if err != nil {
+		return nil, err
+	}
+	r.consume = func(ctx context.Context, encoding string, payload []byte) error {
+		obsCtx := r.obsrecv.StartTracesOp(ctx)
+		var unmarshaler ptrace.Unmarshaler = &ptrace.ProtoUnmarshaler{}
+		if encoding == encodingOTLPJSON {
+			unmarshaler = &ptrace.JSONUnmarshaler{}
+		}
+		traces, err := unmarshaler.UnmarshalTraces(payload)
+		if err != nil {
+			r.obsrecv.EndTracesOp(obsCtx, encoding, 0, err)
+			return consumererror.NewPermanent(errors.Join(errUnmarshal, err))
+		}
+		err = nextConsumer.ConsumeTraces(obsCtx, traces)
+		r.obsrecv.EndTracesOp(obsCtx, encoding, traces.SpanCount(), err)
+		return err
+	}
+	return r, nil
+}
+
+func newMetricsReceiver(cfg *Config, set receiver.Settings, nextConsumer consumer.Metrics) (*amqpReceiver, error) {
+	r, err := newReceiver(cfg, set)
+	if err != nil {
+		return nil, err
+	}
+	r.consume = func(ctx context.Context, encoding string, payload []byte) error {
+		obsCtx := r.obsrecv.StartMetricsOp(ctx)
+		var unmarshaler pmetric.Unmarshaler = &pmetric.ProtoUnmarshaler{}
+		if encoding == encodingOTLPJSON {
+			unmarshaler = &pmetric.JSONUnmarshaler{}
+		}
+		metrics, err := unmarshaler.UnmarshalMetrics(payload)
+		if err != nil {
+			r.obsrecv.EndMetricsOp(obsCtx, encoding, 0, err)
+			return consumererror.NewPermanent(errors.Join(errUnmarshal, err))
+		}
+		err = nextConsumer.ConsumeMetrics(obsCtx, metrics)
+		r.obsrecv.EndMetricsOp(obsCtx, encoding, metrics.DataPointCount(), err)
+		return err
+	}
+	return r, nil
+}
+
+func newLogsReceiver(cfg *Config, set receiver.Settings, nextConsumer consumer.Logs) (*amqpReceiver, error) {
+	r, err := newReceiver(cfg, set)
+	if err != nil {
+		return nil, err
+	}
+	r.consume = func(ctx context.Context, encoding string, payload []byte) error {
+		obsCtx := r.obsrecv.StartLogsOp(ctx)
+		var unmarshaler plog.Unmarshaler = &plog.ProtoUnmarshaler{}
+		if encoding == encodingOTLPJSON {
+			unmarshaler = &plog.JSONUnmarshaler{}
+		}
+		logs, err := unmarshaler.UnmarshalLogs(payload)
+		if err != nil {
+			r.obsrecv.EndLogsOp(obsCtx, encoding, 0, err)
+			return consumererror.NewPermanent(errors.Join(errUnmarshal, err))
+		}
+		err = nextConsumer.ConsumeLogs(obsCtx, logs)
+		r.obsrecv.EndLogsOp(obsCtx, encoding, logs.LogRecordCount(), err)
+		return err
+	}
+	return r, nil
+}
+
+func (r *amqpReceiver) Start(ctx context.Context, _ component.Host) error {
+	if r.newService == nil {
+		var tlsConfig *tls.Config
+		if u, err := url.Parse(r.cfg.Endpoint); err == nil && u.Scheme == "amqps" {
+			if tlsConfig, err = r.cfg.TLS.LoadTLSConfig(ctx); err != nil {
+				return err
+			}
+		}
+		r.newService = newAMQPMessagingServiceFactory(r.cfg, tlsConfig, r.settings.Logger)
+	}
+
+	var receiveCtx context.Context
+	receiveCtx, r.cancel = context.WithCancel(context.Background())
+	r.wg.Add(1)
+	go func() {
+		defer r.wg.Done()
+		r.connectAndReceive(receiveCtx)
+	}()
+	return nil
+}
+
+func (r *amqpReceiver) Shutdown(context.Context) error {
+	if r.cancel == nil {
+		return nil
+	}
+	r.cancel()
+	r.wg.Wait()
+	return nil
+}
+
+// connectAndReceive receives the messages of the address until ctx is done,
+// reconnecting to the broker whenever the connection fails.
+func (r *amqpReceiver) connectAndReceive(ctx context.Context) {
+	for {
+		service := r.newService()
+		err := service.dial(ctx)
+		if err == nil {
+			err = r.receiveMessages(ctx, service)
+		}
+		closeCtx, cancel := context.WithTimeout(context.Background(), closeTimeout)
+		service.close(closeCtx)
+		cancel()
+		if ctx.Err() != nil {
+			return
+		}
+		r.settings.Logger.Warn("AMQP connection failed, reconnecting",
+			zap.String("address", r.cfg.Address), zap.Duration("delay", r.cfg.ReconnectDelay), zap.Error(err))
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(r.cfg.ReconnectDelay):
+		}
+	}
+}
+
+func (r *amqpReceiver) receiveMessages(ctx context.Context, service messagingService) error {
+	for {
+		msg, err := service.receive(ctx)
+		if err != nil {
+			return err
+		}
+		if err := r.handleMessage(ctx, service, msg); err != nil {
+			return err
+		}
+	}
+}
+
+// handleMessage consumes the message and settles it: the message is accepted once consumed,
+// rejected when it can never be consumed and released to be delivered again otherwise.
+func (r *amqpReceiver) handleMessage(ctx context.Context, service messagingService, msg *amqp.Message) error {
+	err := r.consume(ctx, r.encoding(msg), payload(msg))
+	switch {
+	case err == nil:
+		r.releaseDelay = 0
+		return service.accept(ctx, msg)
+	case consumererror.IsPermanent(err):
+		r.releaseDelay = 0
+		r.settings.Logger.Error("Rejecting AMQP message", zap.String("address", r.cfg.Address), zap.Error(err))
+		condition := amqp.ErrCondInternalError
+		if errors.Is(err, errUnmarshal) {
+			condition = amqp.ErrCondDecodeError
+		}
+		return service.reject(ctx, msg, &amqp.Error{Condition: condition, Description: err.Error()})
+	default:
+		r.waitBeforeRelease(ctx)
+		r.settings.Logger.Debug("Releasing AMQP message", zap.String("address", r.cfg.Address), zap.Error(err))
+		return service.release(ctx, msg)
+	}
+}
+
+// waitBeforeRelease waits before a message is released, doubling the delay for each consecutive
+// failure, so that the messages the next consumer keeps failing to consume aren't redelivered
+// by the broker in a tight loop.
+func (r *amqpReceiver) waitBeforeRelease(ctx context.Context) {
+	if r.releaseDelay == 0 {
+		r.releaseDelay = min(r.cfg.ReleaseBackOff.InitialInterval, r.cfg.ReleaseBackOff.MaxInterval)
+	} else {
+		r.releaseDelay = min(2*r.releaseDelay, r.cfg.ReleaseBackOff.MaxInterval)
+	}
+	r.settings.Logger.Debug("Backing off before releasing AMQP message", zap.Duration("delay", r.releaseDelay))
+	select {
+	case <-ctx.Done():
+	case <-time.After(r.releaseDelay):
+	}
+}
+
+// encoding returns the encoding of the message from its content type, or the configured one.
+func (r *amqpReceiver) encoding(msg *amqp.Message) string {
+	if msg.Properties != nil && msg.Properties.ContentType != nil {
+		switch *msg.Properties.ContentType {
+		case contentTypeProtobuf:
+			return encodingOTLPProto
+		case contentTypeJSON:
+			return encodingOTLPJSON
+		}
+	}
+	return r.cfg.Encoding
+}
+
+// payload returns the body of the message, sent either as a data section or as a binary or string value.
+func payload(msg *amqp.Message) []byte {
+	if data := msg.GetData(); data != nil {
+		return data
+	}
+	switch v := msg.Value.(type) {
+	case []byte:
+		return v
+	case string:
+		return []byte(v)
+	default:
+		return nil
+	}
+}
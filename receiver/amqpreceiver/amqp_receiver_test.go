@@ -0,0 +1,245 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package amqpreceiver
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Azure/go-amqp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/consumer/consumererror"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/amqpreceiver/internal/metadata"
+)
+
+type settlement struct {
+	outcome   string
+	condition amqp.ErrCond
+}
+
+// fakeService delivers the messages it holds, then fails the connection.
+type fakeService struct {
+	dialErr     error
+	messages    chan *amqp.Message
+	settlements chan settlement
+	closed      bool
+}
+
+func newFakeService(messages ...*amqp.Message) *fakeService {
+	s := &fakeService{
+		messages:    make(chan *amqp.Message, len(messages)),
+		settlements: make(chan settlement, len(messages)),
+	}
+	for _, msg := range messages {
+		s.messages <- msg
+	}
+	return s
+}
+
+func (s *fakeService) dial(context.Context) error {
+	return s.dialErr
+}
+
+func (s *fakeService) close(context.Context) {
+	s.closed = true
+}
+
+func (s *fakeService) receive(ctx context.Context) (*amqp.Message, error) {
+	select {
+	case msg := <-s.messages:
+		return msg, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+func (s *fakeService) accept(context.Context, *amqp.Message) error {
+	s.settlements <- settlement{outcome: "accepted"}
+	return nil
+}
+
+func (s *fakeService) reject(_ context.Context, _ *amqp.Message, cause *amqp.Error) error {
+	s.settlements <- settlement{outcome: "rejected", condition: cause.Condition}
+	return nil
+}
+
+func (s *fakeService) release(context.Context, *amqp.Message) error {
+	s.settlements <- settlement{outcome: "released"}
+	return nil
+}
+
+func newTracesMessage(t *testing.T, contentType string) (*amqp.Message, ptrace.Traces) {
+	traces := ptrace.NewTraces()
+	traces.ResourceSpans().AppendEmpty().ScopeSpans().AppendEmpty().Spans().AppendEmpty().SetName("span")
+	var marshaler ptrace.Marshaler = &ptrace.ProtoMarshaler{}
+	if contentType == contentTypeJSON {
+		marshaler = &ptrace.JSONMarshaler{}
+	}
+	payload, err := marshaler.MarshalTraces(traces)
+	require.NoError(t, err)
+	msg := amqp.NewMessage(payload)
+	if contentType != "" {
+		msg.Properties = &amqp.MessageProperties{ContentType: &contentType}
+	}
+	return msg, traces
+}
+
+func startReceiver(t *testing.T, r *amqpReceiver, services ...*fakeService) {
+	next := 0
+	r.newService = func() messagingService {
+		if next < len(services) {
+			next++
+			return services[next-1]
+		}
+		return &fakeService{dialErr: errors.New("connection refused")}
+	}
+	require.NoError(t, r.Start(context.Background(), componenttest.NewNopHost()))
+	t.Cleanup(func() {
+		require.NoError(t, r.Shutdown(context.Background()))
+	})
+}
+
+func receiveSettlement(t *testing.T, service *fakeService) settlement {
+	select {
+	case s := <-service.settlements:
+		return s
+	case <-time.After(5 * time.Second):
+		require.FailNow(t, "message not settled")
+		return settlement{}
+	}
+}
+
+func TestReceiveTraces(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Address = "otlp_spans"
+	sink := new(consumertest.TracesSink)
+	r, err := newTracesReceiver(cfg, receivertest.NewNopSettings(metadata.Type), sink)
+	require.NoError(t, err)
+
+	protoMsg, traces := newTracesMessage(t, contentTypeProtobuf)
+	jsonMsg, _ := newTracesMessage(t, contentTypeJSON)
+	defaultMsg, _ := newTracesMessage(t, "")
+	service := newFakeService(protoMsg, jsonMsg, defaultMsg)
+	startReceiver(t, r, service)
+
+	for i := 0; i < 3; i++ {
+		assert.Equal(t, settlement{outcome: "accepted"}, receiveSettlement(t, service))
+	}
+	require.Len(t, sink.AllTraces(), 3)
+	for _, received := range sink.AllTraces() {
+		assert.Equal(t, traces, received)
+	}
+}
+
+func TestRejectInvalidMessage(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Address = "otlp_logs"
+	sink := new(consumertest.LogsSink)
+	r, err := newLogsReceiver(cfg, receivertest.NewNopSettings(metadata.Type), sink)
+	require.NoError(t, err)
+
+	contentType := contentTypeJSON
+	msg := amqp.NewMessage([]byte("not json"))
+	msg.Properties = &amqp.MessageProperties{ContentType: &contentType}
+	service := newFakeService(msg)
+	startReceiver(t, r, service)
+
+	assert.Equal(t, settlement{outcome: "rejected", condition: amqp.ErrCondDecodeError}, receiveSettlement(t, service))
+	assert.Empty(t, sink.AllLogs())
+}
+
+func TestSettleConsumerErrors(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		expected settlement
+	}{
+		{
+			name:     "retryable",
+			err:      errors.New("memory limit exceeded"),
+			expected: settlement{outcome: "released"},
+		},
+		{
+			name:     "permanent",
+			err:      consumererror.NewPermanent(errors.New("invalid data")),
+			expected: settlement{outcome: "rejected", condition: amqp.ErrCondInternalError},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := createDefaultConfig().(*Config)
+			cfg.Address = "otlp_logs"
+			cfg.ReleaseBackOff.InitialInterval = time.Millisecond
+			next, err := consumer.NewLogs(func(context.Context, plog.Logs) error { return tt.err })
+			require.NoError(t, err)
+			r, err := newLogsReceiver(cfg, receivertest.NewNopSettings(metadata.Type), next)
+			require.NoError(t, err)
+
+			payload, err := (&plog.ProtoMarshaler{}).MarshalLogs(plog.NewLogs())
+			require.NoError(t, err)
+			service := newFakeService(amqp.NewMessage(payload))
+			startReceiver(t, r, service)
+
+			assert.Equal(t, tt.expected, receiveSettlement(t, service))
+		})
+	}
+}
+
+func TestWaitBeforeRelease(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Address = "otlp_spans"
+	cfg.ReleaseBackOff = BackOffConfig{InitialInterval: time.Millisecond, MaxInterval: 4 * time.Millisecond}
+	r, err := newTracesReceiver(cfg, receivertest.NewNopSettings(metadata.Type), new(consumertest.TracesSink))
+	require.NoError(t, err)
+
+	// The delay is doubled for each consecutive failure, up to the max interval.
+	var delays []time.Duration
+	for range 4 {
+		r.waitBeforeRelease(context.Background())
+		delays = append(delays, r.releaseDelay)
+	}
+	assert.Equal(t, []time.Duration{time.Millisecond, 2 * time.Millisecond, 4 * time.Millisecond, 4 * time.Millisecond}, delays)
+
+	// The delay is reset once a message is accepted.
+	msg, _ := newTracesMessage(t, contentTypeProtobuf)
+	service := newFakeService(msg)
+	require.NoError(t, r.handleMessage(context.Background(), service, msg))
+	assert.Equal(t, settlement{outcome: "accepted"}, receiveSettlement(t, service))
+	assert.Zero(t, r.releaseDelay)
+}
+
+func TestReconnect(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Address = "otlp_spans"
+	cfg.ReconnectDelay = time.Millisecond
+	sink := new(consumertest.TracesSink)
+	r, err := newTracesReceiver(cfg, receivertest.NewNopSettings(metadata.Type), sink)
+	require.NoError(t, err)
+
+	msg, _ := newTracesMessage(t, contentTypeProtobuf)
+	failing := &fakeService{dialErr: errors.New("connection refused")}
+	service := newFakeService(msg)
+	startReceiver(t, r, failing, service)
+
+	assert.Equal(t, settlement{outcome: "accepted"}, receiveSettlement(t, service))
+	assert.True(t, failing.closed)
+	assert.Len(t, sink.AllTraces(), 1)
+}
+
+func TestPayload(t *testing.T) {
+	assert.Equal(t, []byte("data"), payload(amqp.NewMessage([]byte("data"))))
+	assert.Equal(t, []byte("value"), payload(&amqp.Message{Value: "value"}))
+	assert.Equal(t, []byte("binary"), payload(&amqp.Message{Value: []byte("binary")}))
+	assert.Nil(t, payload(&amqp.Message{Value: 42}))
+}
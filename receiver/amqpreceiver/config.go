@@ -0,0 +1,127 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package amqpreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/amqpreceiver"
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config/configopaque"
+	"go.opentelemetry.io/collector/config/configoptional"
+	"go.opentelemetry.io/collector/config/configtls"
+)
+
+const (
+	encodingOTLPProto = "otlp_proto"
+	encodingOTLPJSON  = "otlp_json"
+)
+
+var (
+	errMissingEndpoint    = errors.New("endpoint is required")
+	errMissingAddress     = errors.New("address is required")
+	errTooManyAuthDetails = errors.New("only one authentication method must be used")
+)
+
+// Config defines configuration for the AMQP receiver.
+type Config struct {
+	// Endpoint is the URL of the broker, e.g. amqp://localhost:5672 or amqps://<namespace>.servicebus.windows.net.
+	Endpoint string `mapstructure:"endpoint"`
+	// ContainerID identifies the connection on the broker. A random one is generated when empty.
+	ContainerID string `mapstructure:"container_id"`
+	// TLS configures the connection to amqps:// endpoints.
+	TLS configtls.ClientConfig `mapstructure:"tls"`
+	// Auth configures the SASL authentication of the connection. The ANONYMOUS mechanism is used when empty.
+	Auth Authentication `mapstructure:"auth"`
+	// ConnectionTimeout bounds the time spent establishing the connection to the broker.
+	ConnectionTimeout time.Duration `mapstructure:"connection_timeout"`
+	// ReconnectDelay is the time waited before reconnecting after the connection failed or was lost.
+	ReconnectDelay time.Duration `mapstructure:"reconnect_delay"`
+
+	// Address is the queue or subscription the messages are received from,
+	// e.g. "telemetry" or "telemetry/Subscriptions/otelcol" with Azure Service Bus.
+	Address string `mapstructure:"address"`
+	// Encoding of the messages without a content type: otlp_proto or otlp_json.
+	Encoding string `mapstructure:"encoding"`
+	// Credit is the maximum number of messages the broker delivers before they are settled.
+	Credit int32 `mapstructure:"credit"`
+	// ReleaseBackOff configures the delay before releasing the messages the next consumer failed to consume.
+	ReleaseBackOff BackOffConfig `mapstructure:"release_backoff"`
+}
+
+// BackOffConfig defines the delay before releasing a message, doubled for each consecutive
+// failure of the next consumer.
+type BackOffConfig struct {
+	// InitialInterval is the delay after the first failure.
+	InitialInterval time.Duration `mapstructure:"initial_interval"`
+	// MaxInterval is the upper bound of the delay.
+	MaxInterval time.Duration `mapstructure:"max_interval"`
+
+	// prevent unkeyed literal initialization
+	_ struct{}
+}
+
+// Authentication defines the SASL mechanisms used to authenticate on the broker.
+type Authentication struct {
+	// Plain authenticates with a user name and a password. With Azure Service Bus, the user name
+	// is the name of a shared access policy and the password its key.
+	Plain configoptional.Optional[SASLPlainConfig] `mapstructure:"sasl_plain"`
+	// External authenticates with the TLS client certificate.
+	External configoptional.Optional[SASLExternalConfig] `mapstructure:"sasl_external"`
+
+	// prevent unkeyed literal initialization
+	_ struct{}
+}
+
+// SASLPlainConfig defines SASL PLAIN authentication.
+type SASLPlainConfig struct {
+	Username string              `mapstructure:"username"`
+	Password configopaque.String `mapstructure:"password"`
+
+	// prevent unkeyed literal initialization
+	_ struct{}
+}
+
+// SASLExternalConfig defines SASL EXTERNAL authentication, used in conjunction with TLS client authentication.
+type SASLExternalConfig struct{}
+
+var _ component.Config = (*Config)(nil)
+
+// Validate checks the receiver configuration is valid
+func (cfg *Config) Validate() error {
+	if cfg.Endpoint == "" {
+		return errMissingEndpoint
+	}
+	u, err := url.Parse(cfg.Endpoint)
+	if err != nil {
+		return fmt.Errorf("invalid endpoint: %w", err)
+	}
+	if u.Scheme != "amqp" && u.Scheme != "amqps" {
+		return fmt.Errorf("unsupported endpoint scheme %q, must be amqp or amqps", u.Scheme)
+	}
+	if cfg.Auth.Plain.HasValue() && cfg.Auth.External.HasValue() {
+		return errTooManyAuthDetails
+	}
+	if cfg.Auth.Plain.HasValue() && cfg.Auth.Plain.Get().Username == "" {
+		return errors.New("auth.sasl_plain.username is required")
+	}
+	if cfg.Address == "" {
+		return errMissingAddress
+	}
+	if cfg.Encoding != encodingOTLPProto && cfg.Encoding != encodingOTLPJSON {
+		return fmt.Errorf("unsupported encoding %q", cfg.Encoding)
+	}
+	if cfg.Credit <= 0 {
+		return errors.New("credit must be greater than 0")
+	}
+	if cfg.ReconnectDelay <= 0 {
+		return errors.New("reconnect_delay must be greater than 0")
+	}
+	if cfg.ReleaseBackOff.InitialInterval <= 0 || cfg.ReleaseBackOff.MaxInterval <= 0 {
+		return errors.New("release_backoff intervals must be greater than 0")
+	}
+	return nil
+}
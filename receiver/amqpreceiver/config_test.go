@@ -0,0 +1,95 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package amqpreceiver
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config/configoptional"
+	"go.opentelemetry.io/collector/confmap/confmaptest"
+	"go.opentelemetry.io/collector/confmap/xconfmap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/amqpreceiver/internal/metadata"
+)
+
+func TestLoadConfig(t *testing.T) {
+	cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
+	require.NoError(t, err)
+
+	tests := []struct {
+		id          component.ID
+		expected    component.Config
+		expectedErr string
+	}{
+		{
+			id: component.NewID(metadata.Type),
+			expected: &Config{
+				Endpoint:          defaultEndpoint,
+				ConnectionTimeout: defaultConnectionTimeout,
+				ReconnectDelay:    defaultReconnectDelay,
+				Address:           "otlp_spans",
+				Encoding:          encodingOTLPProto,
+				Credit:            defaultCredit,
+				ReleaseBackOff: BackOffConfig{
+					InitialInterval: defaultReleaseInitialInterval,
+					MaxInterval:     defaultReleaseMaxInterval,
+				},
+			},
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "servicebus"),
+			expected: &Config{
+				Endpoint:    "amqps://otel.servicebus.windows.net",
+				ContainerID: "otelcol-1",
+				Auth: Authentication{
+					Plain: configoptional.Some(SASLPlainConfig{Username: "RootManageSharedAccessKey", Password: "secret"}),
+				},
+				ConnectionTimeout: 30 * time.Second,
+				ReconnectDelay:    time.Minute,
+				Address:           "telemetry/Subscriptions/otelcol",
+				Encoding:          encodingOTLPJSON,
+				Credit:            500,
+				ReleaseBackOff: BackOffConfig{
+					InitialInterval: 2 * time.Second,
+					MaxInterval:     time.Minute,
+				},
+			},
+		},
+		{
+			id:          component.NewIDWithName(metadata.Type, "missing_address"),
+			expectedErr: errMissingAddress.Error(),
+		},
+		{
+			id:          component.NewIDWithName(metadata.Type, "invalid_credit"),
+			expectedErr: "credit must be greater than 0",
+		},
+		{
+			id:          component.NewIDWithName(metadata.Type, "invalid_release_backoff"),
+			expectedErr: "release_backoff intervals must be greater than 0",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.id.String(), func(t *testing.T) {
+			factory := NewFactory()
+			cfg := factory.CreateDefaultConfig()
+
+			sub, err := cm.Sub(tt.id.String())
+			require.NoError(t, err)
+			require.NoError(t, sub.Unmarshal(cfg))
+
+			if tt.expectedErr != "" {
+				assert.ErrorContains(t, xconfmap.Validate(cfg), tt.expectedErr)
+				return
+			}
+			require.NoError(t, xconfmap.Validate(cfg))
+			assert.Equal(t, tt.expected, cfg)
+		})
+	}
+}
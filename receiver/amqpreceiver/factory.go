@@ -0,0 +1,77 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package amqpreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/amqpreceiver"
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/receiver"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/amqpreceiver/internal/metadata"
+)
+
+const (
+	defaultEndpoint          = "amqp://localhost:5672"
+	defaultConnectionTimeout = 10 * time.Second
+	defaultReconnectDelay    = 5 * time.Second
+	defaultCredit            = 100
+
+	defaultReleaseInitialInterval = time.Second
+	defaultReleaseMaxInterval     = 30 * time.Second
+)
+
+// NewFactory creates a factory for the AMQP receiver.
+func NewFactory() receiver.Factory {
+	return receiver.NewFactory(
+		metadata.Type,
+		createDefaultConfig,
+		receiver.WithTraces(createTracesReceiver, metadata.TracesStability),
+		receiver.WithMetrics(createMetricsReceiver, metadata.MetricsStability),
+		receiver.WithLogs(createLogsReceiver, metadata.LogsStability),
+	)
+}
+
+func createDefaultConfig() component.Config {
+	return &Config{
+		Endpoint:          defaultEndpoint,
+		ConnectionTimeout: defaultConnectionTimeout,
+		ReconnectDelay:    defaultReconnectDelay,
+		Encoding:          encodingOTLPProto,
+		Credit:            defaultCredit,
+		ReleaseBackOff: BackOffConfig{
+			InitialInterval: defaultReleaseInitialInterval,
+			MaxInterval:     defaultReleaseMaxInterval,
+		},
+	}
+}
+
+func createTracesReceiver(
+	_ context.Context,
+	set receiver.Settings,
+	cfg component.Config,
+	nextConsumer consumer.Traces,
+) (receiver.Traces, error) {
+	return newTracesReceiver(cfg.(*Config), set, nextConsumer)
+}
+
+func createMetricsReceiver(
+	_ context.Context,
+	set receiver.Settings,
+	cfg component.Config,
+	nextConsumer consumer.Metrics,
+) (receiver.Metrics, error) {
+	return newMetricsReceiver(cfg.(*Config), set, nextConsumer)
+}
+
+func createLogsReceiver(
+	_ context.Context,
+	set receiver.Settings,
+	cfg component.Config,
+	nextConsumer consumer.Logs,
+) (receiver.Logs, error) {
+	return newLogsReceiver(cfg.(*Config), set, nextConsumer)
+}
@@ -0,0 +1,127 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package amqpreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/amqpreceiver"
+
+import (
+	"context"
+	"crypto/tls"
+
+	"github.com/Azure/go-amqp"
+	"go.uber.org/zap"
+)
+
+// messagingService abstracts out the AMQP transport capabilities for unit testing
+type messagingService interface {
+	dial(ctx context.Context) error
+	close(ctx context.Context)
+	receive(ctx context.Context) (*amqp.Message, error)
+	// accept settles a message that was consumed.
+	accept(ctx context.Context, msg *amqp.Message) error
+	// reject settles a message that can never be consumed, the broker discards or dead-letters it.
+	reject(ctx context.Context, msg *amqp.Message, cause *amqp.Error) error
+	// release settles a message that failed to be consumed, the broker delivers it again.
+	release(ctx context.Context, msg *amqp.Message) error
+}
+
+// messagingServiceFactory is a factory to create new messagingService instances
+type messagingServiceFactory func() messagingService
+
+// dialFunc is abstracted out into a variable in order for substitutions
+var dialFunc = amqp.Dial
+
+type amqpMessagingService struct {
+	cfg       *Config
+	tlsConfig *tls.Config
+	logger    *zap.Logger
+
+	conn     *amqp.Conn
+	session  *amqp.Session
+	receiver *amqp.Receiver
+}
+
+func newAMQPMessagingServiceFactory(cfg *Config, tlsConfig *tls.Config, logger *zap.Logger) messagingServiceFactory {
+	return func() messagingService {
+		return &amqpMessagingService{
+			cfg:       cfg,
+			tlsConfig: tlsConfig,
+			logger:    logger,
+		}
+	}
+}
+
+func (m *amqpMessagingService) dial(ctx context.Context) (err error) {
+	if m.cfg.ConnectionTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, m.cfg.ConnectionTimeout)
+		defer cancel()
+	}
+
+	m.logger.Debug("Dialing AMQP", zap.String("endpoint", m.cfg.Endpoint))
+	m.conn, err = dialFunc(ctx, m.cfg.Endpoint, &amqp.ConnOptions{
+		ContainerID: m.cfg.ContainerID,
+		SASLType:    saslType(m.cfg.Auth),
+		TLSConfig:   m.tlsConfig,
+	})
+	if err != nil {
+		return err
+	}
+	m.session, err = m.conn.NewSession(ctx, nil)
+	if err != nil {
+		return err
+	}
+	m.logger.Debug("Creating new AMQP Receive Link", zap.String("source", m.cfg.Address))
+	m.receiver, err = m.session.NewReceiver(ctx, m.cfg.Address, &amqp.ReceiverOptions{
+		Credit: m.cfg.Credit,
+	})
+	return err
+}
+
+func (m *amqpMessagingService) close(ctx context.Context) {
+	if m.receiver != nil {
+		if err := m.receiver.Close(ctx); err != nil {
+			m.logger.Debug("Receiver close failed", zap.Error(err))
+		}
+	}
+	if m.session != nil {
+		if err := m.session.Close(ctx); err != nil {
+			m.logger.Debug("Session close failed", zap.Error(err))
+		}
+	}
+	if m.conn != nil {
+		if err := m.conn.Close(); err != nil {
+			m.logger.Debug("Connection close failed", zap.Error(err))
+		}
+	}
+}
+
+func (m *amqpMessagingService) receive(ctx context.Context) (*amqp.Message, error) {
+	return m.receiver.Receive(ctx, nil)
+}
+
+func (m *amqpMessagingService) accept(ctx context.Context, msg *amqp.Message) error {
+	return m.receiver.AcceptMessage(ctx, msg)
+}
+
+func (m *amqpMessagingService) reject(ctx context.Context, msg *amqp.Message, cause *amqp.Error) error {
+	return m.receiver.RejectMessage(ctx, msg, cause)
+}
+
+func (m *amqpMessagingService) release(ctx context.Context, msg *amqp.Message) error {
+	return m.receiver.ModifyMessage(ctx, msg, &amqp.ModifyMessageOptions{
+		DeliveryFailed: true,
+	})
+}
+
+// saslType returns the SASL mechanism configured in auth.
+func saslType(auth Authentication) amqp.SASLType {
+	switch {
+	case auth.Plain.HasValue():
+		plain := auth.Plain.Get()
+		return amqp.SASLTypePlain(plain.Username, string(plain.Password))
+	case auth.External.HasValue():
+		return amqp.SASLTypeExternal("")
+	default:
+		return amqp.SASLTypeAnonymous()
+	}
+}
@@ -54,6 +54,12 @@ func newCadvisorMetric(mType string, logger *zap.Logger) *CAdvisorMetric {
 	return metric
 }
 
+// NewCAdvisorMetric creates a metric of the given type for the stats not collected by the embedded cAdvisor,
+// such as the stats of the kubelet Summary API
+func NewCAdvisorMetric(mType string, logger *zap.Logger) *CAdvisorMetric {
+	return newCadvisorMetric(mType, logger)
+}
+
 func (c *CAdvisorMetric) GetTags() map[string]string {
 	return c.tags
 }
@@ -6,6 +6,7 @@ package host // import "github.com/open-telemetry/opentelemetry-collector-contri
 import (
 	"context"
 	"os"
+	"runtime"
 
 	"github.com/shirou/gopsutil/v4/common"
 	"github.com/shirou/gopsutil/v4/cpu"
@@ -43,6 +44,14 @@ func newNodeCapacity(logger *zap.Logger, options ...nodeCapacityOption) (nodeCap
 		opt(nc)
 	}
 
+	// The host /proc doesn't exist on Windows, where gopsutil gets the capacity from the system APIs
+	if runtime.GOOS == "windows" {
+		ctx := context.Background()
+		nc.parseCPU(ctx)
+		nc.parseMemory(ctx)
+		return nc, nil
+	}
+
 	actualHostProc, ok := os.LookupEnv(string(common.HostProcEnvKey))
 	if !ok {
 		actualHostProc = hostProc
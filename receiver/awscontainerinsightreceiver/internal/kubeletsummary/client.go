@@ -0,0 +1,51 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package kubeletsummary // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/awscontainerinsightreceiver/internal/kubeletsummary"
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"k8s.io/client-go/kubernetes"
+	stats "k8s.io/kubelet/pkg/apis/stats/v1alpha1"
+)
+
+// SummaryClient returns the node, pod and container stats of the kubelet Summary API
+type SummaryClient interface {
+	Summary() (*stats.Summary, error)
+}
+
+// apiServerClient gets the stats of a node through the API server proxy, for the nodes
+// whose kubelet can't be reached directly such as Fargate nodes
+type apiServerClient struct {
+	ctx       context.Context
+	clientSet kubernetes.Interface
+	nodeName  string
+}
+
+// NewAPIServerClient creates a SummaryClient getting the stats of the node through the API server proxy
+func NewAPIServerClient(ctx context.Context, clientSet kubernetes.Interface, nodeName string) SummaryClient {
+	return &apiServerClient{
+		ctx:       ctx,
+		clientSet: clientSet,
+		nodeName:  nodeName,
+	}
+}
+
+func (c *apiServerClient) Summary() (*stats.Summary, error) {
+	b, err := c.clientSet.CoreV1().RESTClient().Get().
+		AbsPath("/api/v1/nodes", c.nodeName, "proxy", "stats", "summary").
+		DoRaw(c.ctx)
+	if err != nil {
+		return nil, fmt.Errorf("call to the stats summary proxy of node %s failed: %w", c.nodeName, err)
+	}
+
+	summary := &stats.Summary{}
+	err = json.Unmarshal(b, summary)
+	if err != nil {
+		return nil, fmt.Errorf("parsing response failed: %w", err)
+	}
+	return summary, nil
+}
@@ -0,0 +1,69 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package kubeletsummary // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/awscontainerinsightreceiver/internal/kubeletsummary"
+
+import (
+	"errors"
+	"os"
+	"strings"
+)
+
+// Mode is the kind of node the stats are collected from the kubelet Summary API for
+type Mode string
+
+const (
+	// ModeNone means the node stats are collected by the embedded cAdvisor
+	ModeNone Mode = ""
+	// ModeWindows is used on Windows nodes, where the kubelet reports the stats of the
+	// pods and containers from the Host Compute Service (HCS)
+	ModeWindows Mode = "windows"
+	// ModeFargate is used on Fargate nodes, where neither /proc nor cAdvisor is available
+	// and the kubelet is only reachable through the API server proxy
+	ModeFargate Mode = "fargate"
+)
+
+// fargateNodePrefix is the prefix of the names of the nodes EKS runs the Fargate pods on
+const fargateNodePrefix = "fargate-"
+
+// DetectMode returns the mode to collect the node stats with from the operating system and the
+// name of the node the collector runs on
+func DetectMode(goos, nodeName string) Mode {
+	switch {
+	case goos == "windows":
+		return ModeWindows
+	case strings.HasPrefix(nodeName, fargateNodePrefix):
+		return ModeFargate
+	default:
+		return ModeNone
+	}
+}
+
+// FargateInfo provides the cluster name on Fargate nodes, which have no EC2 instance metadata
+type FargateInfo struct {
+	clusterName string
+}
+
+// NewFargateInfo creates a FargateInfo from the CLUSTER_NAME environment variable
+func NewFargateInfo() (*FargateInfo, error) {
+	clusterName := os.Getenv("CLUSTER_NAME")
+	if clusterName == "" {
+		return nil, errors.New("environment variable CLUSTER_NAME is not set in the Fargate deployment config")
+	}
+	return &FargateInfo{clusterName: clusterName}, nil
+}
+
+// GetClusterName returns the name of the cluster
+func (f *FargateInfo) GetClusterName() string {
+	return f.clusterName
+}
+
+// GetInstanceID returns an empty ID as Fargate nodes aren't EC2 instances
+func (*FargateInfo) GetInstanceID() string {
+	return ""
+}
+
+// GetInstanceType returns an empty type as Fargate nodes aren't EC2 instances
+func (*FargateInfo) GetInstanceType() string {
+	return ""
+}
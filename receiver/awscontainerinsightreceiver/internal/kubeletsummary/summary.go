@@ -0,0 +1,345 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package kubeletsummary // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/awscontainerinsightreceiver/internal/kubeletsummary"
+
+import (
+	"encoding/json"
+	"errors"
+	"runtime"
+	"strconv"
+	"time"
+
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.uber.org/zap"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	stats "k8s.io/kubelet/pkg/apis/stats/v1alpha1"
+
+	ci "github.com/open-telemetry/opentelemetry-collector-contrib/internal/aws/containerinsight"
+	awsmetrics "github.com/open-telemetry/opentelemetry-collector-contrib/internal/aws/metrics"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/awscontainerinsightreceiver/internal/cadvisor/extractors"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/awscontainerinsightreceiver/internal/stores"
+)
+
+const (
+	decimalToMillicores   = 1000
+	nanoCoresToMillicores = 1e6
+)
+
+type hostInfo interface {
+	GetClusterName() string
+	GetInstanceID() string
+	GetInstanceType() string
+}
+
+// Decorator adds the Kubernetes metadata of the pods to the metrics
+type Decorator interface {
+	Decorate(*extractors.CAdvisorMetric) *extractors.CAdvisorMetric
+	Shutdown() error
+}
+
+// Option is a function that can be used to configure Provider struct
+type Option func(*Provider)
+
+// WithDecorator constructs an option for configuring the metric decorator
+func WithDecorator(d Decorator) Option {
+	return func(p *Provider) {
+		p.decorator = d
+	}
+}
+
+// Provider generates the Container Insights node, pod and container metrics from the kubelet
+// Summary API, for the nodes the embedded cAdvisor can't run on
+type Provider struct {
+	logger         *zap.Logger
+	mode           Mode
+	nodeName       string
+	hostInfo       hostInfo
+	client         SummaryClient
+	decorator      Decorator
+	numCores       int64
+	rateCalculator awsmetrics.MetricCalculator
+}
+
+// New creates a Provider collecting the stats of the node with the given client
+func New(mode Mode, nodeName string, hostInfo hostInfo, client SummaryClient, logger *zap.Logger, options ...Option) (*Provider, error) {
+	if nodeName == "" {
+		return nil, errors.New("missing environment variable HOST_NAME. Please check your deployment YAML config")
+	}
+
+	p := &Provider{
+		logger:         logger,
+		mode:           mode,
+		nodeName:       nodeName,
+		hostInfo:       hostInfo,
+		client:         client,
+		numCores:       int64(runtime.NumCPU()),
+		rateCalculator: newFloat64RateCalculator(),
+	}
+	for _, option := range options {
+		option(p)
+	}
+	return p, nil
+}
+
+// GetMetrics generates metrics from the kubelet Summary API
+func (p *Provider) GetMetrics() []pmetric.Metrics {
+	p.logger.Debug("collect data from the kubelet summary...", zap.String("mode", string(p.mode)))
+	var result []pmetric.Metrics
+
+	clusterName := p.hostInfo.GetClusterName()
+	if clusterName == "" {
+		p.logger.Warn("Failed to detect cluster name. Drop all metrics")
+		return result
+	}
+
+	summary, err := p.client.Summary()
+	if err != nil {
+		p.logger.Warn("Failed to get the kubelet stats summary", zap.Error(err))
+		return result
+	}
+
+	for _, metric := range p.decorateMetrics(p.convert(summary), clusterName) {
+		md := ci.ConvertToOTLPMetrics(metric.GetFields(), metric.GetTags(), p.logger)
+		result = append(result, md)
+	}
+	return result
+}
+
+func (p *Provider) Shutdown() error {
+	errs := p.rateCalculator.Shutdown()
+	if p.decorator != nil {
+		errs = errors.Join(errs, p.decorator.Shutdown())
+	}
+	return errs
+}
+
+// convert converts the stats of the node, its pods and their containers to metrics
+func (p *Provider) convert(summary *stats.Summary) []*extractors.CAdvisorMetric {
+	var metrics []*extractors.CAdvisorMetric
+	memoryCapacity := nodeMemoryCapacity(summary.Node.Memory)
+
+	node := extractors.NewCAdvisorMetric(ci.TypeNode, p.logger)
+	p.addCPU(node, ci.TypeNode, summary.Node.CPU)
+	p.addMemory(node, ci.TypeNode, "node", summary.Node.Memory, memoryCapacity)
+	p.addNetwork(node, ci.TypeNode, "node", summary.Node.Network)
+	node.AddField(ci.MetricName(ci.TypeNode, ci.CPULimit), p.numCores*decimalToMillicores)
+	if memoryCapacity > 0 {
+		node.AddField(ci.MetricName(ci.TypeNode, ci.MemLimit), memoryCapacity)
+	}
+	metrics = append(metrics, node)
+
+	if nodeFS := p.fsMetric(summary.Node.Fs); nodeFS != nil {
+		metrics = append(metrics, nodeFS)
+	}
+
+	for i := range summary.Pods {
+		pod := &summary.Pods[i]
+		podMetric := extractors.NewCAdvisorMetric(ci.TypePod, p.logger)
+		addPodTags(podMetric, pod.PodRef)
+		p.addCPU(podMetric, ci.TypePod, pod.CPU)
+		p.addMemory(podMetric, ci.TypePod, pod.PodRef.UID, pod.Memory, memoryCapacity)
+		p.addNetwork(podMetric, ci.TypePod, pod.PodRef.UID, pod.Network)
+		metrics = append(metrics, podMetric)
+
+		for j := range pod.Containers {
+			container := &pod.Containers[j]
+			containerMetric := extractors.NewCAdvisorMetric(ci.TypeContainer, p.logger)
+			addPodTags(containerMetric, pod.PodRef)
+			containerMetric.AddTag(ci.ContainerNamekey, container.Name)
+			p.addCPU(containerMetric, ci.TypeContainer, container.CPU)
+			p.addMemory(containerMetric, ci.TypeContainer, pod.PodRef.UID+container.Name, container.Memory, memoryCapacity)
+			metrics = append(metrics, containerMetric)
+		}
+	}
+	return metrics
+}
+
+// decorateMetrics adds the node and Kubernetes metadata to the metrics
+func (p *Provider) decorateMetrics(metrics []*extractors.CAdvisorMetric, clusterName string) []*extractors.CAdvisorMetric {
+	var result []*extractors.CAdvisorMetric
+	for _, m := range metrics {
+		m.AddTag(ci.Version, "0")
+		m.AddTag(ci.ClusterNameKey, clusterName)
+		m.AddTag(ci.NodeNameKey, p.nodeName)
+		if instanceID := p.hostInfo.GetInstanceID(); instanceID != "" {
+			m.AddTag(ci.InstanceID, instanceID)
+		}
+		if instanceType := p.hostInfo.GetInstanceType(); instanceType != "" {
+			m.AddTag(ci.InstanceType, instanceType)
+		}
+
+		if p.decorator != nil {
+			out := p.decorator.Decorate(m)
+			if out != nil {
+				p.tagMetricSource(out)
+				result = append(result, out)
+			}
+			continue
+		}
+
+		// Without the pod store, the pods are named after themselves rather than their controllers
+		if podName := m.GetTag(ci.K8sPodNameKey); podName != "" {
+			m.AddTag(ci.PodNameKey, podName)
+		}
+		stores.AddKubernetesInfo(m, map[string]any{})
+		p.tagMetricSource(m)
+		result = append(result, m)
+	}
+	return result
+}
+
+func (p *Provider) tagMetricSource(metric *extractors.CAdvisorMetric) {
+	sources := []string{"kubelet", "calculated"}
+	switch metric.GetMetricType() {
+	case ci.TypeNode, ci.TypePod, ci.TypeContainer:
+		if p.decorator != nil {
+			sources = []string{"kubelet", "pod", "calculated"}
+		}
+	}
+	sourcesInfo, err := json.Marshal(sources)
+	if err != nil {
+		return
+	}
+	metric.AddTag(ci.SourcesKey, string(sourcesInfo))
+}
+
+func (p *Provider) addCPU(metric *extractors.CAdvisorMetric, mType string, cpu *stats.CPUStats) {
+	if cpu == nil || cpu.UsageNanoCores == nil {
+		return
+	}
+	setTimestamp(metric, cpu.Time)
+
+	cpuTotal := float64(*cpu.UsageNanoCores) / nanoCoresToMillicores
+	metric.AddField(ci.MetricName(mType, ci.CPUTotal), cpuTotal)
+	if p.numCores != 0 {
+		metric.AddField(ci.MetricName(mType, ci.CPUUtilization), cpuTotal/float64(p.numCores*decimalToMillicores)*100)
+	}
+}
+
+func (p *Provider) addMemory(metric *extractors.CAdvisorMetric, mType, id string, memory *stats.MemoryStats, memoryCapacity int64) {
+	if memory == nil || memory.WorkingSetBytes == nil {
+		return
+	}
+	setTimestamp(metric, memory.Time)
+
+	metric.AddField(ci.MetricName(mType, ci.MemWorkingset), *memory.WorkingSetBytes)
+	if memory.UsageBytes != nil {
+		metric.AddField(ci.MetricName(mType, ci.MemUsage), *memory.UsageBytes)
+	}
+	if memory.RSSBytes != nil {
+		metric.AddField(ci.MetricName(mType, ci.MemRss), *memory.RSSBytes)
+	}
+	if memoryCapacity > 0 {
+		metric.AddField(ci.MetricName(mType, ci.MemUtilization), float64(*memory.WorkingSetBytes)/float64(memoryCapacity)*100)
+	}
+
+	fields := metric.GetFields()
+	if memory.PageFaults != nil {
+		p.assignRateValueToField(fields, ci.MetricName(mType, ci.MemPgfault), id, float64(*memory.PageFaults), memory.Time.Time)
+	}
+	if memory.MajorPageFaults != nil {
+		p.assignRateValueToField(fields, ci.MetricName(mType, ci.MemPgmajfault), id, float64(*memory.MajorPageFaults), memory.Time.Time)
+	}
+}
+
+// addNetwork adds the network rates of the node or the pod, summed over its interfaces
+func (p *Provider) addNetwork(metric *extractors.CAdvisorMetric, mType, id string, network *stats.NetworkStats) {
+	if network == nil {
+		return
+	}
+	interfaces := network.Interfaces
+	if len(interfaces) == 0 {
+		interfaces = []stats.InterfaceStats{network.InterfaceStats}
+	}
+
+	counters := map[string]float64{}
+	for _, ifce := range interfaces {
+		addCounter(counters, ci.NetRxBytes, ifce.RxBytes)
+		addCounter(counters, ci.NetRxErrors, ifce.RxErrors)
+		addCounter(counters, ci.NetTxBytes, ifce.TxBytes)
+		addCounter(counters, ci.NetTxErrors, ifce.TxErrors)
+	}
+
+	netFields := map[string]any{}
+	for name, value := range counters {
+		p.assignRateValueToField(netFields, name, id, value, network.Time.Time)
+	}
+	if netFields[ci.NetRxBytes] != nil && netFields[ci.NetTxBytes] != nil {
+		netFields[ci.NetTotalBytes] = netFields[ci.NetRxBytes].(float64) + netFields[ci.NetTxBytes].(float64)
+	}
+	for name, value := range netFields {
+		metric.AddField(ci.MetricName(mType, name), value)
+	}
+}
+
+// fsMetric returns the metric of the filesystem of the node
+func (p *Provider) fsMetric(fs *stats.FsStats) *extractors.CAdvisorMetric {
+	if fs == nil || fs.CapacityBytes == nil || fs.UsedBytes == nil {
+		return nil
+	}
+	metric := extractors.NewCAdvisorMetric(ci.TypeNodeFS, p.logger)
+	setTimestamp(metric, fs.Time)
+
+	metric.AddField(ci.MetricName(ci.TypeNodeFS, ci.FSUsage), *fs.UsedBytes)
+	metric.AddField(ci.MetricName(ci.TypeNodeFS, ci.FSCapacity), *fs.CapacityBytes)
+	if fs.AvailableBytes != nil {
+		metric.AddField(ci.MetricName(ci.TypeNodeFS, ci.FSAvailable), *fs.AvailableBytes)
+	}
+	if *fs.CapacityBytes != 0 {
+		metric.AddField(ci.MetricName(ci.TypeNodeFS, ci.FSUtilization), float64(*fs.UsedBytes)/float64(*fs.CapacityBytes)*100)
+	}
+	return metric
+}
+
+func (p *Provider) assignRateValueToField(fields map[string]any, metricName, id string, curVal float64, curTime time.Time) {
+	mKey := awsmetrics.NewKey(id+metricName, nil)
+	if val, ok := p.rateCalculator.Calculate(mKey, curVal, curTime); ok {
+		fields[metricName] = val.(float64) * float64(time.Second)
+	}
+}
+
+func newFloat64RateCalculator() awsmetrics.MetricCalculator {
+	return awsmetrics.NewMetricCalculator(func(prev *awsmetrics.MetricValue, val any, timestamp time.Time) (any, bool) {
+		if prev != nil {
+			deltaNs := timestamp.Sub(prev.Timestamp)
+			deltaValue := val.(float64) - prev.RawValue.(float64)
+			if deltaNs > ci.MinTimeDiff && deltaValue >= 0 {
+				return deltaValue / float64(deltaNs), true
+			}
+		}
+		return float64(0), false
+	})
+}
+
+// nodeMemoryCapacity returns the memory capacity of the node, which the kubelet reports
+// as the memory available on top of the working set
+func nodeMemoryCapacity(memory *stats.MemoryStats) int64 {
+	if memory == nil || memory.AvailableBytes == nil || memory.WorkingSetBytes == nil {
+		return 0
+	}
+	return int64(*memory.AvailableBytes + *memory.WorkingSetBytes)
+}
+
+func addPodTags(metric *extractors.CAdvisorMetric, podRef stats.PodReference) {
+	metric.AddTag(ci.K8sPodNameKey, podRef.Name)
+	metric.AddTag(ci.K8sNamespace, podRef.Namespace)
+	metric.AddTag(ci.PodIDKey, podRef.UID)
+}
+
+func addCounter(counters map[string]float64, name string, value *uint64) {
+	if value != nil {
+		counters[name] += float64(*value)
+	}
+}
+
+// setTimestamp sets the timestamp of the metric to the time of the most recent stats
+func setTimestamp(metric *extractors.CAdvisorMetric, t metav1.Time) {
+	if t.IsZero() {
+		return
+	}
+	ts := strconv.FormatInt(t.UnixNano(), 10)
+	if current := metric.GetTag(ci.Timestamp); current == "" || current < ts {
+		metric.AddTag(ci.Timestamp, ts)
+	}
+}
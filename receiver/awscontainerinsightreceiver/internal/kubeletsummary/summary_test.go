@@ -0,0 +1,168 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package kubeletsummary
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	stats "k8s.io/kubelet/pkg/apis/stats/v1alpha1"
+
+	ci "github.com/open-telemetry/opentelemetry-collector-contrib/internal/aws/containerinsight"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/awscontainerinsightreceiver/internal/cadvisor/extractors"
+)
+
+type mockHostInfo struct {
+	clusterName string
+}
+
+func (m *mockHostInfo) GetClusterName() string {
+	return m.clusterName
+}
+
+func (*mockHostInfo) GetInstanceID() string {
+	return ""
+}
+
+func (*mockHostInfo) GetInstanceType() string {
+	return ""
+}
+
+type mockSummaryClient struct {
+	summary *stats.Summary
+	err     error
+}
+
+func (m *mockSummaryClient) Summary() (*stats.Summary, error) {
+	return m.summary, m.err
+}
+
+func loadSummary(t *testing.T) *stats.Summary {
+	b, err := os.ReadFile(filepath.Join("testdata", "summary.json"))
+	require.NoError(t, err)
+	summary := &stats.Summary{}
+	require.NoError(t, json.Unmarshal(b, summary))
+	return summary
+}
+
+func TestDetectMode(t *testing.T) {
+	tests := []struct {
+		goos     string
+		nodeName string
+		want     Mode
+	}{
+		{goos: "linux", nodeName: "ip-192-168-1-10.us-west-2.compute.internal", want: ModeNone},
+		{goos: "linux", nodeName: "fargate-ip-192-168-1-10.us-west-2.compute.internal", want: ModeFargate},
+		{goos: "windows", nodeName: "ip-192-168-1-10.us-west-2.compute.internal", want: ModeWindows},
+		{goos: "linux", nodeName: "", want: ModeNone},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, DetectMode(tt.goos, tt.nodeName), tt.goos+"/"+tt.nodeName)
+	}
+}
+
+func TestNewFargateInfo(t *testing.T) {
+	t.Setenv("CLUSTER_NAME", "")
+	_, err := NewFargateInfo()
+	assert.Error(t, err)
+
+	t.Setenv("CLUSTER_NAME", "my-cluster")
+	info, err := NewFargateInfo()
+	require.NoError(t, err)
+	assert.Equal(t, "my-cluster", info.GetClusterName())
+	assert.Empty(t, info.GetInstanceID())
+	assert.Empty(t, info.GetInstanceType())
+}
+
+func TestNewMissingNodeName(t *testing.T) {
+	_, err := New(ModeFargate, "", &mockHostInfo{clusterName: "my-cluster"}, &mockSummaryClient{}, zap.NewNop())
+	assert.Error(t, err)
+}
+
+func TestConvert(t *testing.T) {
+	summary := loadSummary(t)
+	p, err := New(ModeFargate, summary.Node.NodeName, &mockHostInfo{clusterName: "my-cluster"}, &mockSummaryClient{}, zap.NewNop())
+	require.NoError(t, err)
+	defer func() { require.NoError(t, p.Shutdown()) }()
+	p.numCores = 2
+
+	metrics := p.decorateMetrics(p.convert(summary), "my-cluster")
+	require.Len(t, metrics, 4)
+	byType := map[string]*extractors.CAdvisorMetric{}
+	for _, m := range metrics {
+		byType[m.GetMetricType()] = m
+		assert.Equal(t, "my-cluster", m.GetTag(ci.ClusterNameKey))
+		assert.Equal(t, summary.Node.NodeName, m.GetTag(ci.NodeNameKey))
+		assert.Equal(t, `["kubelet","calculated"]`, m.GetTag(ci.SourcesKey))
+	}
+
+	node := byType[ci.TypeNode].GetFields()
+	assert.Equal(t, float64(500), node[ci.MetricName(ci.TypeNode, ci.CPUTotal)])
+	assert.Equal(t, float64(25), node[ci.MetricName(ci.TypeNode, ci.CPUUtilization)])
+	assert.Equal(t, int64(2000), node[ci.MetricName(ci.TypeNode, ci.CPULimit)])
+	assert.Equal(t, int64(4000000000), node[ci.MetricName(ci.TypeNode, ci.MemLimit)])
+	assert.Equal(t, uint64(1000000000), node[ci.MetricName(ci.TypeNode, ci.MemWorkingset)])
+	assert.Equal(t, float64(25), node[ci.MetricName(ci.TypeNode, ci.MemUtilization)])
+	// the rates are only computed from the second sample
+	assert.NotContains(t, node, ci.MetricName(ci.TypeNode, ci.NetRxBytes))
+
+	nodeFS := byType[ci.TypeNodeFS].GetFields()
+	assert.Equal(t, float64(25), nodeFS[ci.MetricName(ci.TypeNodeFS, ci.FSUtilization)])
+
+	pod := byType[ci.TypePod]
+	assert.Equal(t, "web-7d4b9c8f5-x2x9z", pod.GetTag(ci.PodNameKey))
+	assert.Equal(t, "default", pod.GetTag(ci.K8sNamespace))
+	assert.Equal(t, float64(250), pod.GetFields()[ci.MetricName(ci.TypePod, ci.CPUTotal)])
+	assert.Equal(t, float64(10), pod.GetFields()[ci.MetricName(ci.TypePod, ci.MemUtilization)])
+
+	container := byType[ci.TypeContainer]
+	assert.Equal(t, "web", container.GetTag(ci.ContainerNamekey))
+	assert.Equal(t, float64(200), container.GetFields()[ci.MetricName(ci.TypeContainer, ci.CPUTotal)])
+}
+
+func TestConvertNetworkRates(t *testing.T) {
+	summary := loadSummary(t)
+	p, err := New(ModeFargate, summary.Node.NodeName, &mockHostInfo{clusterName: "my-cluster"}, &mockSummaryClient{}, zap.NewNop())
+	require.NoError(t, err)
+	defer func() { require.NoError(t, p.Shutdown()) }()
+	p.convert(summary)
+
+	next := loadSummary(t)
+	next.Node.Network.Time = metav1.NewTime(summary.Node.Network.Time.Add(10 * time.Second))
+	rxBytes, txBytes := uint64(11000), uint64(42000)
+	next.Node.Network.Interfaces[0].RxBytes = &rxBytes
+	next.Node.Network.Interfaces[0].TxBytes = &txBytes
+
+	metrics := p.convert(next)
+	node := metrics[0].GetFields()
+	assert.InDelta(t, 1000, node[ci.MetricName(ci.TypeNode, ci.NetRxBytes)], 0.001)
+	assert.InDelta(t, 4000, node[ci.MetricName(ci.TypeNode, ci.NetTxBytes)], 0.001)
+	assert.InDelta(t, 5000, node[ci.MetricName(ci.TypeNode, ci.NetTotalBytes)], 0.001)
+}
+
+func TestGetMetrics(t *testing.T) {
+	summary := loadSummary(t)
+	client := &mockSummaryClient{summary: summary}
+	hostInfo := &mockHostInfo{clusterName: "my-cluster"}
+	p, err := New(ModeFargate, summary.Node.NodeName, hostInfo, client, zap.NewNop())
+	require.NoError(t, err)
+	defer func() { require.NoError(t, p.Shutdown()) }()
+
+	assert.Len(t, p.GetMetrics(), 4)
+
+	client.err = errors.New("unavailable")
+	assert.Empty(t, p.GetMetrics())
+
+	client.err = nil
+	hostInfo.clusterName = ""
+	assert.Empty(t, p.GetMetrics())
+}
@@ -6,6 +6,8 @@ package awscontainerinsightreceiver // import "github.com/open-telemetry/opentel
 import (
 	"context"
 	"errors"
+	"os"
+	"runtime"
 	"sync"
 	"time"
 
@@ -16,11 +18,14 @@ import (
 	"go.uber.org/zap"
 
 	ci "github.com/open-telemetry/opentelemetry-collector-contrib/internal/aws/containerinsight"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/aws/k8s/k8sclient"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/awscontainerinsightreceiver/internal/cadvisor"
 	ecsinfo "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/awscontainerinsightreceiver/internal/ecsInfo"
 	hostInfo "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/awscontainerinsightreceiver/internal/host"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/awscontainerinsightreceiver/internal/k8sapiserver"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/awscontainerinsightreceiver/internal/kubeletsummary"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/awscontainerinsightreceiver/internal/stores"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/awscontainerinsightreceiver/internal/stores/kubeletutil"
 )
 
 var _ receiver.Metrics = (*awsContainerInsightReceiver)(nil)
@@ -30,6 +35,13 @@ type metricsProvider interface {
 	Shutdown() error
 }
 
+// kubeletSummaryHostInfo provides the information about the nodes the stats are collected from the kubelet summary for
+type kubeletSummaryHostInfo interface {
+	GetClusterName() string
+	GetInstanceID() string
+	GetInstanceType() string
+}
+
 // awsContainerInsightReceiver implements the receiver.Metrics
 type awsContainerInsightReceiver struct {
 	settings     component.TelemetrySettings
@@ -59,6 +71,16 @@ func newAWSContainerInsightReceiver(
 func (acir *awsContainerInsightReceiver) Start(ctx context.Context, host component.Host) error {
 	ctx, acir.cancel = context.WithCancel(ctx)
 
+	if acir.config.ContainerOrchestrator == ci.EKS {
+		if mode := kubeletsummary.DetectMode(runtime.GOOS, os.Getenv("HOST_NAME")); mode != kubeletsummary.ModeNone {
+			if err := acir.startKubeletSummary(ctx, mode); err != nil {
+				return err
+			}
+			acir.startCollection(ctx)
+			return nil
+		}
+	}
+
 	hostinfo, err := hostInfo.NewInfo(acir.config.ContainerOrchestrator, acir.config.CollectionInterval, acir.settings.Logger)
 	if err != nil {
 		return err
@@ -94,6 +116,64 @@ func (acir *awsContainerInsightReceiver) Start(ctx context.Context, host compone
 		}
 	}
 
+	acir.startCollection(ctx)
+	return nil
+}
+
+// startKubeletSummary collects the node stats from the kubelet Summary API on the EKS nodes where
+// the embedded cAdvisor can't run. On Windows nodes the kubelet is called directly and the metrics
+// are decorated with the pod store, on Fargate nodes it is called through the API server proxy.
+func (acir *awsContainerInsightReceiver) startKubeletSummary(ctx context.Context, mode kubeletsummary.Mode) error {
+	logger := acir.settings.Logger
+	nodeName := os.Getenv("HOST_NAME")
+	logger.Info("Collecting the node stats from the kubelet summary", zap.String("mode", string(mode)))
+
+	var hostinfo kubeletSummaryHostInfo
+	var client kubeletsummary.SummaryClient
+	var options []kubeletsummary.Option
+	switch mode {
+	case kubeletsummary.ModeWindows:
+		info, err := hostInfo.NewInfo(acir.config.ContainerOrchestrator, acir.config.CollectionInterval, logger)
+		if err != nil {
+			return err
+		}
+		hostIP := os.Getenv("HOST_IP")
+		if hostIP == "" {
+			return errors.New("environment variable HOST_IP is not set in k8s deployment config")
+		}
+		kubeletClient, err := kubeletutil.NewKubeletClient(hostIP, ci.KubeSecurePort, logger)
+		if err != nil {
+			return err
+		}
+		k8sDecorator, err := stores.NewK8sDecorator(ctx, acir.config.TagService, acir.config.PrefFullPodName, acir.config.AddFullPodNameMetricLabel, logger)
+		if err != nil {
+			return err
+		}
+		hostinfo, client = info, kubeletClient
+		options = append(options, kubeletsummary.WithDecorator(k8sDecorator))
+	case kubeletsummary.ModeFargate:
+		info, err := kubeletsummary.NewFargateInfo()
+		if err != nil {
+			return err
+		}
+		k8sClient := k8sclient.Get(logger)
+		if k8sClient == nil {
+			return errors.New("failed to create the k8s client for the Fargate node")
+		}
+		hostinfo, client = info, kubeletsummary.NewAPIServerClient(ctx, k8sClient.GetClientSet(), nodeName)
+	}
+
+	summaryProvider, err := kubeletsummary.New(mode, nodeName, hostinfo, client, logger, options...)
+	if err != nil {
+		return err
+	}
+	acir.cadvisor = summaryProvider
+	acir.k8sapiserver, err = k8sapiserver.New(hostinfo, logger)
+	return err
+}
+
+// startCollection starts collecting the metrics at every collection interval
+func (acir *awsContainerInsightReceiver) startCollection(ctx context.Context) {
 	acir.cancelWg.Add(1)
 	go func() {
 		defer acir.cancelWg.Done()
@@ -117,8 +197,6 @@ func (acir *awsContainerInsightReceiver) Start(ctx context.Context, host compone
 			}
 		}
 	}()
-
-	return nil
 }
 
 // Shutdown stops the awsContainerInsightReceiver receiver.
@@ -40,8 +40,18 @@ type SQSConfig struct {
 	// MaxNumberOfMessages specifies the maximum number of messages to receive in a single poll.
 	// Valid values: 1-10. Default is 10.
 	MaxNumberOfMessages int64 `mapstructure:"max_number_of_messages"`
+	// VisibilityTimeout is the duration for which received messages are hidden from subsequent requests.
+	// A message whose objects could not all be ingested is not deleted, and is received again to be
+	// retried once this duration elapses. Maximum is 12 hours. Default is the visibility timeout of the queue.
+	VisibilityTimeout time.Duration `mapstructure:"visibility_timeout"`
+	// StorageID is the optional storage extension used to checkpoint the objects ingested for each message,
+	// so that the objects already ingested are skipped when a message is retried.
+	StorageID *component.ID `mapstructure:"storage"`
 }
 
+// maxVisibilityTimeout is the maximum visibility timeout supported by SQS.
+const maxVisibilityTimeout = 12 * time.Hour
+
 // Notifications groups optional notification sources.
 type Notifications struct {
 	OpAMP *component.ID `mapstructure:"opampextension"`
@@ -145,6 +155,11 @@ func (c Config) Validate() error {
 		if c.SQS.MaxNumberOfMessages < 0 || c.SQS.MaxNumberOfMessages > 10 {
 			errs = multierr.Append(errs, errors.New("sqs.max_number_of_messages must be between 1 and 10"))
 		}
+		if c.SQS.VisibilityTimeout < 0 || c.SQS.VisibilityTimeout > maxVisibilityTimeout {
+			errs = multierr.Append(errs, errors.New("sqs.visibility_timeout must be between 0 and 12h"))
+		} else if c.SQS.VisibilityTimeout%time.Second != 0 {
+			errs = multierr.Append(errs, errors.New("sqs.visibility_timeout must be a whole number of seconds"))
+		}
 	}
 	return errs
 }
@@ -6,6 +6,7 @@ package awss3receiver
 import (
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -67,6 +68,7 @@ func TestLoadConfig(t *testing.T) {
 	cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
 	require.NoError(t, err)
 	opampExtension := component.NewIDWithName(component.MustNewType("opamp"), "bar")
+	fileStorage := component.MustNewID("file_storage")
 	tests := []struct {
 		id           component.ID
 		expected     component.Config
@@ -148,6 +150,27 @@ func TestLoadConfig(t *testing.T) {
 				},
 			},
 		},
+		{
+			id: component.NewIDWithName(metadata.Type, "6"),
+			expected: &Config{
+				S3Downloader: S3DownloaderConfig{
+					Region:              "us-east-1",
+					S3Bucket:            "abucket",
+					S3Partition:         "minute",
+					EndpointPartitionID: "aws",
+				},
+				SQS: &SQSConfig{
+					QueueURL:          "https://sqs.us-east-1.amazonaws.com/123456789012/test-queue",
+					Region:            "us-east-1",
+					VisibilityTimeout: 5 * time.Minute,
+					StorageID:         &fileStorage,
+				},
+			},
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "7"),
+			errorMessage: "sqs.visibility_timeout must be between 0 and 12h",
+		},
 	}
 
 	for _, tt := range tests {
@@ -11,15 +11,20 @@ import (
 	"fmt"
 	"io"
 	"strings"
+	"sync"
 
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/consumer/consumererror"
+	"go.opentelemetry.io/collector/extension/xextension/storage"
 	"go.opentelemetry.io/collector/pdata/plog"
 	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/pdata/ptrace"
 	"go.opentelemetry.io/collector/receiver"
 	"go.opentelemetry.io/collector/receiver/receiverhelper"
 	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/storage/storageclient"
 )
 
 type encodingExtension struct {
@@ -43,11 +48,16 @@ type awss3Receiver struct {
 	dataProcessor   receiverProcessor
 	extensions      encodingExtensions
 	notifier        statusNotifier
+	id              component.ID
+	storageID       *component.ID
+	storageClient   storage.Client
+	wg              sync.WaitGroup
 }
 
 func newAWSS3Receiver(ctx context.Context, cfg *Config, telemetryType string, settings receiver.Settings, processor receiverProcessor) (*awss3Receiver, error) {
 	notifier := newNotifier(cfg, settings.Logger)
 	var reader s3Reader
+	var storageID *component.ID
 	var err error
 
 	// Create the appropriate reader based on configuration
@@ -62,6 +72,7 @@ func newAWSS3Receiver(ctx context.Context, cfg *Config, telemetryType string, se
 		if err != nil {
 			return nil, err
 		}
+		storageID = cfg.SQS.StorageID
 	default:
 		return nil, errors.New("invalid configuration: either time-based (StartTime/EndTime) or SQS-based configuration must be provided")
 	}
@@ -84,6 +95,8 @@ func newAWSS3Receiver(ctx context.Context, cfg *Config, telemetryType string, se
 		dataProcessor:   processor,
 		encodingsConfig: cfg.Encodings,
 		notifier:        notifier,
+		id:              settings.ID,
+		storageID:       storageID,
 	}, nil
 }
 
@@ -99,10 +112,21 @@ func (r *awss3Receiver) Start(ctx context.Context, host component.Host) error {
 	if err != nil {
 		return err
 	}
+	if r.storageID != nil {
+		r.storageClient, err = storageclient.Get(ctx, host, *r.storageID, component.KindReceiver, r.id, r.telemetryType)
+		if err != nil {
+			return err
+		}
+		if sqsReader, ok := r.reader.(*s3SQSNotificationReader); ok {
+			sqsReader.checkpoints = &sqsCheckpointer{client: r.storageClient}
+		}
+	}
 
 	var cancelCtx context.Context
 	cancelCtx, r.cancel = context.WithCancel(context.Background())
+	r.wg.Add(1)
 	go func() {
+		defer r.wg.Done()
 		_ = r.reader.readAll(cancelCtx, r.telemetryType, r.receiveBytes)
 	}()
 	return nil
@@ -117,6 +141,10 @@ func (r *awss3Receiver) Shutdown(ctx context.Context) error {
 	if r.cancel != nil {
 		r.cancel()
 	}
+	r.wg.Wait()
+	if r.storageClient != nil {
+		return r.storageClient.Close(ctx)
+	}
 	return nil
 }
 
@@ -127,12 +155,12 @@ func (r *awss3Receiver) receiveBytes(ctx context.Context, key string, data []byt
 	if strings.HasSuffix(key, ".gz") {
 		reader, err := gzip.NewReader(bytes.NewReader(data))
 		if err != nil {
-			return err
+			return consumererror.NewPermanent(err)
 		}
 		key = strings.TrimSuffix(key, ".gz")
 		data, err = io.ReadAll(reader)
 		if err != nil {
-			return err
+			return consumererror.NewPermanent(err)
 		}
 	}
 	return r.dataProcessor.processReceivedData(ctx, r, key, data)
@@ -172,7 +200,7 @@ func (r *traceReceiver) processReceivedData(ctx context.Context, rcvr *awss3Rece
 	rcvr.logger.Debug("Processing trace file", zap.String("key", key), zap.String("format", format))
 	traces, err := unmarshaler.UnmarshalTraces(data)
 	if err != nil {
-		return err
+		return consumererror.NewPermanent(err)
 	}
 	obsCtx := rcvr.obsrecv.StartTracesOp(ctx)
 	err = r.consumer.ConsumeTraces(ctx, traces)
@@ -214,7 +242,7 @@ func (r *metricsReceiver) processReceivedData(ctx context.Context, rcvr *awss3Re
 	rcvr.logger.Debug("Processing metric file", zap.String("key", key), zap.String("format", format))
 	metrics, err := unmarshaler.UnmarshalMetrics(data)
 	if err != nil {
-		return err
+		return consumererror.NewPermanent(err)
 	}
 	obsCtx := rcvr.obsrecv.StartMetricsOp(ctx)
 	err = r.consumer.ConsumeMetrics(ctx, metrics)
@@ -256,7 +284,7 @@ func (r *logsReceiver) processReceivedData(ctx context.Context, rcvr *awss3Recei
 	rcvr.logger.Debug("Processing log file", zap.String("key", key), zap.String("format", format))
 	logs, err := unmarshaler.UnmarshalLogs(data)
 	if err != nil {
-		return err
+		return consumererror.NewPermanent(err)
 	}
 	obsCtx := rcvr.obsrecv.StartLogsOp(ctx)
 	err = r.consumer.ConsumeLogs(ctx, logs)
@@ -8,11 +8,15 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"net/url"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/sqs"
+	"github.com/aws/aws-sdk-go-v2/service/sqs/types"
+	"go.opentelemetry.io/collector/consumer/consumererror"
 	"go.uber.org/zap"
 )
 
@@ -21,7 +25,8 @@ import (
 
 // s3ObjectData represents an S3 object in the notification
 type s3ObjectData struct {
-	Key string `json:"key"`
+	Key       string `json:"key"`
+	Sequencer string `json:"sequencer,omitempty"`
 }
 
 // s3BucketData represents an S3 bucket in the notification
@@ -63,6 +68,18 @@ type s3SQSNotificationReader struct {
 	s3Prefix            string
 	maxNumberOfMessages int32
 	waitTimeSeconds     int32
+	visibilityTimeout   int32
+	// checkpoints is set by the receiver when a storage extension is configured.
+	checkpoints *sqsCheckpointer
+	// maxReceiveCount is the number of receives after which the redrive policy of the queue
+	// moves a message to the dead-letter queue, 0 when the queue has no redrive policy.
+	maxReceiveCount int
+}
+
+// sqsRedrivePolicy is the redrive policy of a queue moving the messages failing repeatedly
+// to a dead-letter queue.
+type sqsRedrivePolicy struct {
+	MaxReceiveCount json.Number `json:"maxReceiveCount"`
 }
 
 func newS3SQSReader(ctx context.Context, logger *zap.Logger, cfg *Config) (*s3SQSNotificationReader, error) {
@@ -100,6 +117,7 @@ func newS3SQSReader(ctx context.Context, logger *zap.Logger, cfg *Config) (*s3SQ
 		s3Prefix:            cfg.S3Downloader.S3Prefix,
 		maxNumberOfMessages: maxMessages,
 		waitTimeSeconds:     waitTime,
+		visibilityTimeout:   int32(cfg.SQS.VisibilityTimeout / time.Second),
 	}, nil
 }
 
@@ -111,6 +129,10 @@ func (r *s3SQSNotificationReader) readAll(ctx context.Context, _ string, callbac
 		zap.Int32("maxNumberOfMessages", r.maxNumberOfMessages),
 		zap.Int32("waitTimeSeconds", r.waitTimeSeconds))
 
+	if r.checkpoints != nil {
+		r.loadMaxReceiveCount(ctx)
+	}
+
 	for {
 		select {
 		case <-ctx.Done():
@@ -122,6 +144,10 @@ func (r *s3SQSNotificationReader) readAll(ctx context.Context, _ string, callbac
 				QueueUrl:            aws.String(r.queueURL),
 				MaxNumberOfMessages: r.maxNumberOfMessages,
 				WaitTimeSeconds:     r.waitTimeSeconds,
+				VisibilityTimeout:   r.visibilityTimeout,
+				MessageSystemAttributeNames: []types.MessageSystemAttributeName{
+					types.MessageSystemAttributeNameApproximateReceiveCount,
+				},
 			})
 			if err != nil {
 				if ctx.Err() != nil {
@@ -136,73 +162,19 @@ func (r *s3SQSNotificationReader) readAll(ctx context.Context, _ string, callbac
 				zap.Int("messageCount", len(result.Messages)))
 
 			for _, message := range result.Messages {
-				var s3Event s3EventNotification
-				messageBody := *message.Body
-
-				// First try to parse as direct S3 event notification
-				if err = json.Unmarshal([]byte(messageBody), &s3Event); err != nil || len(s3Event.Records) == 0 {
-					// If direct parsing failed, try to extract from SNS notification format
-					r.logger.Debug("Direct parsing as S3 event failed, trying SNS format", zap.Error(err))
-
-					var snsMsg snsMessage
-
-					if err = json.Unmarshal([]byte(messageBody), &snsMsg); err != nil {
-						r.logger.Warn("Failed to parse message as SNS notification", zap.Error(err))
-						continue
-					}
-
-					if snsMsg.Type != "Notification" {
-						r.logger.Warn("Message is not a valid S3 notification", zap.String("type", snsMsg.Type))
-						continue
-					}
-					if err = json.Unmarshal([]byte(snsMsg.Message), &s3Event); err != nil {
-						r.logger.Warn("Failed to parse S3 event from SNS message", zap.Error(err))
-						continue
-					}
-				}
-
-				// Process each S3 object notification
-				for _, record := range s3Event.Records {
-					if record.EventSource != "aws:s3" || !strings.HasPrefix(record.EventName, "ObjectCreated:") {
-						continue
-					}
-					bucket := record.S3.Bucket.Name
-					key := record.S3.Object.Key
-
-					if bucket != r.s3Bucket {
-						r.logger.Debug("Skipping object from different bucket",
-							zap.String("bucket", bucket),
-							zap.String("targetBucket", r.s3Bucket))
+				if !r.processMessage(ctx, message, callback) {
+					if r.isLastReceive(message) {
+						// The redrive policy moves the message to the dead-letter queue, it won't be received again.
+						r.logger.Warn("Leaving message in SQS queue to be moved to the dead-letter queue",
+							zap.String("messageID", aws.ToString(message.MessageId)))
+						if err = r.checkpoints.remove(ctx, aws.ToString(message.MessageId)); err != nil {
+							r.logger.Warn("Failed to delete SQS message checkpoint", zap.Error(err))
+						}
 						continue
 					}
-
-					if r.s3Prefix != "" && !strings.HasPrefix(key, r.s3Prefix) {
-						r.logger.Debug("Skipping object not matching prefix",
-							zap.String("key", key),
-							zap.String("prefix", r.s3Prefix))
-						continue
-					}
-
-					r.logger.Info("Processing new S3 object",
-						zap.String("bucket", bucket),
-						zap.String("key", key))
-
-					var content []byte
-					content, err = retrieveS3Object(ctx, r.s3Client, bucket, key)
-					if err != nil {
-						r.logger.Error("Failed to get S3 object",
-							zap.String("bucket", bucket),
-							zap.String("key", key),
-							zap.Error(err))
-						continue
-					}
-
-					err = callback(ctx, key, content)
-					if err != nil {
-						r.logger.Error("Failed to process S3 object content",
-							zap.String("key", key),
-							zap.Error(err))
-					}
+					r.logger.Warn("Leaving message in SQS queue to be retried after its visibility timeout",
+						zap.String("messageID", aws.ToString(message.MessageId)))
+					continue
 				}
 
 				_, err = r.sqsClient.DeleteMessage(ctx, &sqs.DeleteMessageInput{
@@ -211,8 +183,158 @@ func (r *s3SQSNotificationReader) readAll(ctx context.Context, _ string, callbac
 				})
 				if err != nil {
 					r.logger.Warn("Failed to delete message from SQS queue", zap.Error(err))
+					continue
 				}
+				if err = r.checkpoints.remove(ctx, aws.ToString(message.MessageId)); err != nil {
+					r.logger.Warn("Failed to delete SQS message checkpoint", zap.Error(err))
+				}
+			}
+		}
+	}
+}
+
+// loadMaxReceiveCount retrieves the number of receives after which the messages are moved
+// to the dead-letter queue, so that their checkpoints can be deleted.
+func (r *s3SQSNotificationReader) loadMaxReceiveCount(ctx context.Context) {
+	output, err := r.sqsClient.GetQueueAttributes(ctx, &sqs.GetQueueAttributesInput{
+		QueueUrl:       aws.String(r.queueURL),
+		AttributeNames: []types.QueueAttributeName{types.QueueAttributeNameRedrivePolicy},
+	})
+	if err != nil {
+		r.logger.Warn("Failed to retrieve the redrive policy of the SQS queue, the checkpoints of the messages moved to the dead-letter queue won't be deleted", zap.Error(err))
+		return
+	}
+	policy, ok := output.Attributes[string(types.QueueAttributeNameRedrivePolicy)]
+	if !ok {
+		return
+	}
+	var redrivePolicy sqsRedrivePolicy
+	if err = json.Unmarshal([]byte(policy), &redrivePolicy); err != nil {
+		r.logger.Warn("Failed to parse the redrive policy of the SQS queue", zap.Error(err))
+		return
+	}
+	maxReceiveCount, err := redrivePolicy.MaxReceiveCount.Int64()
+	if err != nil {
+		r.logger.Warn("Failed to parse the redrive policy of the SQS queue", zap.Error(err))
+		return
+	}
+	r.maxReceiveCount = int(maxReceiveCount)
+}
+
+// isLastReceive returns whether the message is moved to the dead-letter queue instead of being
+// received again when it isn't deleted.
+func (r *s3SQSNotificationReader) isLastReceive(message types.Message) bool {
+	if r.maxReceiveCount <= 0 {
+		return false
+	}
+	receiveCount, err := strconv.Atoi(message.Attributes[string(types.MessageSystemAttributeNameApproximateReceiveCount)])
+	return err == nil && receiveCount >= r.maxReceiveCount
+}
+
+// processMessage ingests the objects of the notification held by a message. It returns whether
+// the message can be deleted, which is not the case when the ingestion of an object failed
+// and must be retried.
+func (r *s3SQSNotificationReader) processMessage(ctx context.Context, message types.Message, callback s3ObjectCallback) bool {
+	s3Event, err := r.parseNotification(aws.ToString(message.Body))
+	if err != nil {
+		// The message will never be a valid notification, so there is no point in retrying it.
+		r.logger.Warn("Failed to parse SQS message", zap.Error(err))
+		return true
+	}
+
+	messageID := aws.ToString(message.MessageId)
+	ingested, err := r.checkpoints.load(ctx, messageID)
+	if err != nil {
+		r.logger.Warn("Failed to load SQS message checkpoint", zap.String("messageID", messageID), zap.Error(err))
+		ingested = map[string]bool{}
+	}
+
+	complete := true
+	for _, record := range s3Event.Records {
+		if record.EventSource != "aws:s3" || !strings.HasPrefix(record.EventName, "ObjectCreated:") {
+			continue
+		}
+		bucket := record.S3.Bucket.Name
+		// Object keys are URL encoded in the notifications.
+		key, unescapeErr := url.QueryUnescape(record.S3.Object.Key)
+		if unescapeErr != nil {
+			key = record.S3.Object.Key
+		}
+
+		if bucket != r.s3Bucket {
+			r.logger.Debug("Skipping object from different bucket",
+				zap.String("bucket", bucket),
+				zap.String("targetBucket", r.s3Bucket))
+			continue
+		}
+
+		if r.s3Prefix != "" && !strings.HasPrefix(key, r.s3Prefix) {
+			r.logger.Debug("Skipping object not matching prefix",
+				zap.String("key", key),
+				zap.String("prefix", r.s3Prefix))
+			continue
+		}
+
+		object := bucket + "/" + key + "@" + record.S3.Object.Sequencer
+		if ingested[object] {
+			r.logger.Debug("Skipping object already ingested",
+				zap.String("bucket", bucket),
+				zap.String("key", key))
+			continue
+		}
+
+		r.logger.Info("Processing new S3 object",
+			zap.String("bucket", bucket),
+			zap.String("key", key))
+
+		content, err := retrieveS3Object(ctx, r.s3Client, bucket, key)
+		if err != nil {
+			r.logger.Error("Failed to get S3 object",
+				zap.String("bucket", bucket),
+				zap.String("key", key),
+				zap.Error(err))
+			complete = false
+			continue
+		}
+
+		if err = callback(ctx, key, content); err != nil {
+			r.logger.Error("Failed to process S3 object content",
+				zap.String("key", key),
+				zap.Error(err))
+			if !consumererror.IsPermanent(err) {
+				complete = false
+				continue
 			}
 		}
+
+		ingested[object] = true
+		if err = r.checkpoints.save(ctx, messageID, ingested); err != nil {
+			r.logger.Warn("Failed to save SQS message checkpoint", zap.String("messageID", messageID), zap.Error(err))
+		}
+	}
+	return complete
+}
+
+// parseNotification parses an S3 event notification, either sent directly to SQS or through SNS.
+func (r *s3SQSNotificationReader) parseNotification(body string) (s3EventNotification, error) {
+	var s3Event s3EventNotification
+	// First try to parse as direct S3 event notification
+	err := json.Unmarshal([]byte(body), &s3Event)
+	if err == nil && len(s3Event.Records) > 0 {
+		return s3Event, nil
+	}
+
+	// If direct parsing failed, try to extract from SNS notification format
+	r.logger.Debug("Direct parsing as S3 event failed, trying SNS format", zap.Error(err))
+	var snsMsg snsMessage
+	if err = json.Unmarshal([]byte(body), &snsMsg); err != nil {
+		return s3Event, fmt.Errorf("failed to parse message as SNS notification: %w", err)
+	}
+	if snsMsg.Type != "Notification" {
+		return s3Event, fmt.Errorf("message is not a valid S3 notification, type is %q", snsMsg.Type)
+	}
+	if err = json.Unmarshal([]byte(snsMsg.Message), &s3Event); err != nil {
+		return s3Event, fmt.Errorf("failed to parse S3 event from SNS message: %w", err)
 	}
+	return s3Event, nil
 }
@@ -19,7 +19,12 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer/consumererror"
 	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/storage/storagetest"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/awss3receiver/internal/metadata"
 )
 
 type mockS3ClientSQS struct {
@@ -63,6 +68,14 @@ func (m *mockSQSClient) DeleteMessage(ctx context.Context, params *sqs.DeleteMes
 	return args.Get(0).(*sqs.DeleteMessageOutput), args.Error(1)
 }
 
+func (m *mockSQSClient) GetQueueAttributes(ctx context.Context, params *sqs.GetQueueAttributesInput, _ ...func(*sqs.Options)) (*sqs.GetQueueAttributesOutput, error) {
+	args := m.Called(ctx, params)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*sqs.GetQueueAttributesOutput), args.Error(1)
+}
+
 func TestNewS3SQSReader(t *testing.T) {
 	logger := zap.NewNop()
 
@@ -459,15 +472,6 @@ func TestS3SQSReader_ReadAllErrorHandling(t *testing.T) {
 			errors.New("object retrieval failed"),
 		)
 
-		// Mock message deletion
-		mockSQS.On("DeleteMessage", mock.Anything, mock.MatchedBy(func(input *sqs.DeleteMessageInput) bool {
-			return *input.QueueUrl == cfg.SQS.QueueURL &&
-				*input.ReceiptHandle == "test-receipt-handle"
-		})).Return(
-			&sqs.DeleteMessageOutput{},
-			nil,
-		)
-
 		ctx, cancel := context.WithTimeout(context.Background(), 500*time.Millisecond)
 		defer cancel()
 		err = reader.readAll(ctx, "test-telemetry", func(_ context.Context, _ string, _ []byte) error {
@@ -476,6 +480,8 @@ func TestS3SQSReader_ReadAllErrorHandling(t *testing.T) {
 		})
 
 		assert.Error(t, err)
+		// The message is left in the queue to be retried.
+		mockSQS.AssertNotCalled(t, "DeleteMessage", mock.Anything, mock.Anything)
 	})
 }
 
@@ -637,3 +643,231 @@ func TestS3SQSReader_ReadAllWithPrefix(t *testing.T) {
 	assert.Equal(t, []byte("first-matching-content"), processedKeys["logs/matched-key-1"])
 	assert.Equal(t, []byte("second-matching-content"), processedKeys["logs/matched-key-2"])
 }
+
+func TestS3SQSReader_RetryWithCheckpoint(t *testing.T) {
+	mockS3 := new(mockS3ClientSQS)
+	mockSQS := new(mockSQSClient)
+	storageClient := storagetest.NewInMemoryClient(component.KindReceiver, component.NewID(metadata.Type), "")
+
+	reader := &s3SQSNotificationReader{
+		logger:              zap.NewNop(),
+		s3Client:            mockS3,
+		sqsClient:           mockSQS,
+		queueURL:            "https://sqs.us-east-1.amazonaws.com/123456789012/test-queue",
+		s3Bucket:            "test-bucket",
+		maxNumberOfMessages: 10,
+		waitTimeSeconds:     20,
+		visibilityTimeout:   300,
+		checkpoints:         &sqsCheckpointer{client: storageClient},
+	}
+
+	s3Event := s3EventNotification{
+		Records: []s3EventRecord{
+			{
+				EventSource: "aws:s3",
+				EventName:   "ObjectCreated:Put",
+				S3: s3Data{
+					Bucket: s3BucketData{Name: "test-bucket"},
+					Object: s3ObjectData{Key: "key-a", Sequencer: "01"},
+				},
+			},
+			{
+				EventSource: "aws:s3",
+				EventName:   "ObjectCreated:Put",
+				S3: s3Data{
+					Bucket: s3BucketData{Name: "test-bucket"},
+					Object: s3ObjectData{Key: "key+b", Sequencer: "02"},
+				},
+			},
+		},
+	}
+	eventJSON, err := json.Marshal(s3Event)
+	require.NoError(t, err)
+
+	message := types.Message{
+		MessageId:     aws.String("msg-1"),
+		Body:          aws.String(string(eventJSON)),
+		ReceiptHandle: aws.String("test-receipt-handle"),
+	}
+	// The message is received again after its visibility timeout, as it was not deleted.
+	mockSQS.On("ReceiveMessage", mock.Anything, mock.MatchedBy(func(input *sqs.ReceiveMessageInput) bool {
+		return input.VisibilityTimeout == 300
+	})).Return(&sqs.ReceiveMessageOutput{Messages: []types.Message{message}}, nil).Twice()
+	mockSQS.On("ReceiveMessage", mock.Anything, mock.Anything).Return(&sqs.ReceiveMessageOutput{}, nil)
+	mockSQS.On("DeleteMessage", mock.Anything, mock.Anything).Return(&sqs.DeleteMessageOutput{}, nil).Once()
+	mockSQS.On("GetQueueAttributes", mock.Anything, mock.Anything).Return(&sqs.GetQueueAttributesOutput{}, nil).Once()
+
+	mockS3.On("GetObject", mock.Anything, &s3.GetObjectInput{
+		Bucket: aws.String("test-bucket"),
+		Key:    aws.String("key-a"),
+	}).Return([]byte("a"), nil).Once()
+	// The key of the notification is URL encoded.
+	mockS3.On("GetObject", mock.Anything, &s3.GetObjectInput{
+		Bucket: aws.String("test-bucket"),
+		Key:    aws.String("key b"),
+	}).Return([]byte("b"), nil).Twice()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 500*time.Millisecond)
+	defer cancel()
+
+	var received []string
+	err = reader.readAll(ctx, "test-telemetry", func(_ context.Context, key string, _ []byte) error {
+		received = append(received, key)
+		if len(received) == 2 {
+			return errors.New("temporary failure")
+		}
+		return nil
+	})
+	assert.Equal(t, context.DeadlineExceeded, err)
+
+	// key-a is not ingested again when the message is retried.
+	assert.Equal(t, []string{"key-a", "key b", "key b"}, received)
+	mockS3.AssertExpectations(t)
+	mockSQS.AssertExpectations(t)
+	checkpoint, err := storageClient.Get(context.Background(), "sqs_message/msg-1")
+	require.NoError(t, err)
+	assert.Nil(t, checkpoint)
+}
+
+func TestS3SQSReader_DeadLetterRemovesCheckpoint(t *testing.T) {
+	mockS3 := new(mockS3ClientSQS)
+	mockSQS := new(mockSQSClient)
+	storageClient := storagetest.NewInMemoryClient(component.KindReceiver, component.NewID(metadata.Type), "")
+
+	reader := &s3SQSNotificationReader{
+		logger:              zap.NewNop(),
+		s3Client:            mockS3,
+		sqsClient:           mockSQS,
+		queueURL:            "https://sqs.us-east-1.amazonaws.com/123456789012/test-queue",
+		s3Bucket:            "test-bucket",
+		maxNumberOfMessages: 10,
+		waitTimeSeconds:     20,
+		visibilityTimeout:   300,
+		checkpoints:         &sqsCheckpointer{client: storageClient},
+	}
+
+	s3Event := s3EventNotification{
+		Records: []s3EventRecord{
+			{
+				EventSource: "aws:s3",
+				EventName:   "ObjectCreated:Put",
+				S3: s3Data{
+					Bucket: s3BucketData{Name: "test-bucket"},
+					Object: s3ObjectData{Key: "key-a", Sequencer: "01"},
+				},
+			},
+			{
+				EventSource: "aws:s3",
+				EventName:   "ObjectCreated:Put",
+				S3: s3Data{
+					Bucket: s3BucketData{Name: "test-bucket"},
+					Object: s3ObjectData{Key: "key-b", Sequencer: "02"},
+				},
+			},
+		},
+	}
+	eventJSON, err := json.Marshal(s3Event)
+	require.NoError(t, err)
+
+	newMessage := func(receiveCount string) types.Message {
+		return types.Message{
+			MessageId:     aws.String("msg-1"),
+			Body:          aws.String(string(eventJSON)),
+			ReceiptHandle: aws.String("test-receipt-handle"),
+			Attributes: map[string]string{
+				string(types.MessageSystemAttributeNameApproximateReceiveCount): receiveCount,
+			},
+		}
+	}
+	mockSQS.On("GetQueueAttributes", mock.Anything, &sqs.GetQueueAttributesInput{
+		QueueUrl:       aws.String("https://sqs.us-east-1.amazonaws.com/123456789012/test-queue"),
+		AttributeNames: []types.QueueAttributeName{types.QueueAttributeNameRedrivePolicy},
+	}).Return(&sqs.GetQueueAttributesOutput{Attributes: map[string]string{
+		string(types.QueueAttributeNameRedrivePolicy): `{"deadLetterTargetArn":"arn:aws:sqs:us-east-1:123456789012:test-dlq","maxReceiveCount":2}`,
+	}}, nil).Once()
+	// The message is moved to the dead-letter queue after its second receive.
+	mockSQS.On("ReceiveMessage", mock.Anything, mock.Anything).Return(&sqs.ReceiveMessageOutput{Messages: []types.Message{newMessage("1")}}, nil).Once()
+	mockSQS.On("ReceiveMessage", mock.Anything, mock.Anything).Return(&sqs.ReceiveMessageOutput{Messages: []types.Message{newMessage("2")}}, nil).Once()
+	mockSQS.On("ReceiveMessage", mock.Anything, mock.Anything).Return(&sqs.ReceiveMessageOutput{}, nil)
+
+	mockS3.On("GetObject", mock.Anything, &s3.GetObjectInput{
+		Bucket: aws.String("test-bucket"),
+		Key:    aws.String("key-a"),
+	}).Return([]byte("a"), nil).Once()
+	mockS3.On("GetObject", mock.Anything, &s3.GetObjectInput{
+		Bucket: aws.String("test-bucket"),
+		Key:    aws.String("key-b"),
+	}).Return([]byte("b"), nil).Twice()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 500*time.Millisecond)
+	defer cancel()
+
+	var received []string
+	err = reader.readAll(ctx, "test-telemetry", func(_ context.Context, key string, _ []byte) error {
+		received = append(received, key)
+		if key == "key-b" {
+			return errors.New("temporary failure")
+		}
+		return nil
+	})
+	assert.Equal(t, context.DeadlineExceeded, err)
+
+	// The message is never deleted, but its checkpoint is once it is moved to the dead-letter queue.
+	assert.Equal(t, []string{"key-a", "key-b", "key-b"}, received)
+	mockS3.AssertExpectations(t)
+	mockSQS.AssertExpectations(t)
+	mockSQS.AssertNotCalled(t, "DeleteMessage", mock.Anything, mock.Anything)
+	checkpoint, err := storageClient.Get(context.Background(), "sqs_message/msg-1")
+	require.NoError(t, err)
+	assert.Nil(t, checkpoint)
+}
+
+func TestS3SQSReader_PermanentErrorNotRetried(t *testing.T) {
+	mockS3 := new(mockS3ClientSQS)
+	mockSQS := new(mockSQSClient)
+
+	reader := &s3SQSNotificationReader{
+		logger:              zap.NewNop(),
+		s3Client:            mockS3,
+		sqsClient:           mockSQS,
+		queueURL:            "https://sqs.us-east-1.amazonaws.com/123456789012/test-queue",
+		s3Bucket:            "test-bucket",
+		maxNumberOfMessages: 10,
+		waitTimeSeconds:     20,
+	}
+
+	s3Event := s3EventNotification{
+		Records: []s3EventRecord{
+			{
+				EventSource: "aws:s3",
+				EventName:   "ObjectCreated:Put",
+				S3: s3Data{
+					Bucket: s3BucketData{Name: "test-bucket"},
+					Object: s3ObjectData{Key: "test-key"},
+				},
+			},
+		},
+	}
+	eventJSON, err := json.Marshal(s3Event)
+	require.NoError(t, err)
+
+	mockSQS.On("ReceiveMessage", mock.Anything, mock.Anything).Return(&sqs.ReceiveMessageOutput{
+		Messages: []types.Message{{
+			Body:          aws.String(string(eventJSON)),
+			ReceiptHandle: aws.String("test-receipt-handle"),
+		}},
+	}, nil).Once()
+	mockSQS.On("ReceiveMessage", mock.Anything, mock.Anything).Return(&sqs.ReceiveMessageOutput{}, nil)
+	mockSQS.On("DeleteMessage", mock.Anything, mock.Anything).Return(&sqs.DeleteMessageOutput{}, nil).Once()
+	mockS3.On("GetObject", mock.Anything, mock.Anything).Return([]byte("invalid"), nil).Once()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 500*time.Millisecond)
+	defer cancel()
+
+	err = reader.readAll(ctx, "test-telemetry", func(context.Context, string, []byte) error {
+		return consumererror.NewPermanent(errors.New("invalid content"))
+	})
+	assert.Equal(t, context.DeadlineExceeded, err)
+	mockS3.AssertExpectations(t)
+	mockSQS.AssertExpectations(t)
+}
@@ -0,0 +1,74 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package awss3receiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/awss3receiver"
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"go.opentelemetry.io/collector/extension/xextension/storage"
+)
+
+const sqsCheckpointKeyFormat = "sqs_message/%s"
+
+// sqsCheckpointer persists the objects ingested for the SQS messages being processed, so that
+// they are not ingested again when a message is received again after a failure.
+type sqsCheckpointer struct {
+	client storage.Client
+}
+
+// load returns the objects already ingested for a message.
+func (c *sqsCheckpointer) load(ctx context.Context, messageID string) (map[string]bool, error) {
+	ingested := map[string]bool{}
+	if c == nil || messageID == "" {
+		return ingested, nil
+	}
+	data, err := c.client.Get(ctx, fmt.Sprintf(sqsCheckpointKeyFormat, messageID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve checkpoint: %w", err)
+	}
+	if len(data) == 0 {
+		return ingested, nil
+	}
+	var objects []string
+	if err := json.Unmarshal(data, &objects); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal checkpoint: %w", err)
+	}
+	for _, object := range objects {
+		ingested[object] = true
+	}
+	return ingested, nil
+}
+
+// save stores the objects ingested for a message.
+func (c *sqsCheckpointer) save(ctx context.Context, messageID string, ingested map[string]bool) error {
+	if c == nil || messageID == "" {
+		return nil
+	}
+	objects := make([]string, 0, len(ingested))
+	for object := range ingested {
+		objects = append(objects, object)
+	}
+	data, err := json.Marshal(objects)
+	if err != nil {
+		return fmt.Errorf("failed to marshal checkpoint: %w", err)
+	}
+	if err := c.client.Set(ctx, fmt.Sprintf(sqsCheckpointKeyFormat, messageID), data); err != nil {
+		return fmt.Errorf("failed to store checkpoint: %w", err)
+	}
+	return nil
+}
+
+// remove deletes the checkpoint of a message once it is deleted from the queue or moved to
+// the dead-letter queue.
+func (c *sqsCheckpointer) remove(ctx context.Context, messageID string) error {
+	if c == nil || messageID == "" {
+		return nil
+	}
+	if err := c.client.Delete(ctx, fmt.Sprintf(sqsCheckpointKeyFormat, messageID)); err != nil {
+		return fmt.Errorf("failed to delete checkpoint: %w", err)
+	}
+	return nil
+}
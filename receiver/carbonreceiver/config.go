@@ -5,6 +5,8 @@ package carbonreceiver // import "github.com/open-telemetry/opentelemetry-collec
 
 import (
 	"errors"
+	"fmt"
+	"strings"
 	"time"
 
 	"go.opentelemetry.io/collector/config/confignet"
@@ -13,6 +15,13 @@ import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/carbonreceiver/protocol"
 )
 
+const (
+	// protocolLine is the Carbon plaintext, aka "line", protocol.
+	protocolLine = "line"
+	// protocolPickle is the Carbon pickle protocol.
+	protocolPickle = "pickle"
+)
+
 var _ xconfmap.Validator = (*Config)(nil)
 
 // Config defines configuration for the Carbon receiver.
@@ -23,6 +32,11 @@ type Config struct {
 	// if transport being used is UDP.
 	TCPIdleTimeout time.Duration `mapstructure:"tcp_idle_timeout"`
 
+	// Protocol is the framing used by clients, either "line" (the default) for
+	// the plaintext protocol or "pickle" for the pickle protocol used by
+	// Graphite relays. The pickle protocol is only supported over TCP.
+	Protocol string `mapstructure:"protocol"`
+
 	// Parser specifies a parser and the respective configuration to be used
 	// by the receiver.
 	Parser *protocol.Config `mapstructure:"parser"`
@@ -32,5 +46,14 @@ func (cfg *Config) Validate() error {
 	if cfg.TCPIdleTimeout < 0 {
 		return errors.New("'tcp_idle_timeout' must be non-negative")
 	}
+	switch cfg.Protocol {
+	case "", protocolLine:
+	case protocolPickle:
+		if transport := strings.ToLower(string(cfg.Transport)); transport != "" && transport != "tcp" {
+			return fmt.Errorf("'protocol' %q requires the 'tcp' transport", cfg.Protocol)
+		}
+	default:
+		return fmt.Errorf("unsupported 'protocol' %q, must be either %q or %q", cfg.Protocol, protocolLine, protocolPickle)
+	}
 	return nil
 }
@@ -41,6 +41,22 @@ func TestLoadConfig(t *testing.T) {
 					Transport: confignet.TransportTypeUDP,
 				},
 				TCPIdleTimeout: 5 * time.Second,
+				Protocol:       protocolLine,
+				Parser: &protocol.Config{
+					Type:   "plaintext",
+					Config: &protocol.PlaintextConfig{},
+				},
+			},
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "pickle"),
+			expected: &Config{
+				AddrConfig: confignet.AddrConfig{
+					Endpoint:  "localhost:2004",
+					Transport: confignet.TransportTypeTCP,
+				},
+				TCPIdleTimeout: 30 * time.Second,
+				Protocol:       protocolPickle,
 				Parser: &protocol.Config{
 					Type:   "plaintext",
 					Config: &protocol.PlaintextConfig{},
@@ -55,6 +71,7 @@ func TestLoadConfig(t *testing.T) {
 					Transport: confignet.TransportTypeTCP,
 				},
 				TCPIdleTimeout: 30 * time.Second,
+				Protocol:       protocolLine,
 				Parser: &protocol.Config{
 					Type: "regex",
 					Config: &protocol.RegexParserConfig{
@@ -114,3 +131,48 @@ func TestConfigValidate(t *testing.T) {
 	}
 	assert.Error(t, cfg.Validate())
 }
+
+func TestConfigValidateProtocol(t *testing.T) {
+	tests := []struct {
+		name      string
+		transport confignet.TransportType
+		protocol  string
+		wantErr   string
+	}{
+		{
+			name:      "pickle_tcp",
+			transport: confignet.TransportTypeTCP,
+			protocol:  protocolPickle,
+		},
+		{
+			name:      "pickle_udp",
+			transport: confignet.TransportTypeUDP,
+			protocol:  protocolPickle,
+			wantErr:   `'protocol' "pickle" requires the 'tcp' transport`,
+		},
+		{
+			name:      "line_udp",
+			transport: confignet.TransportTypeUDP,
+			protocol:  protocolLine,
+		},
+		{
+			name:      "unknown",
+			transport: confignet.TransportTypeTCP,
+			protocol:  "json",
+			wantErr:   `unsupported 'protocol' "json"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := createDefaultConfig().(*Config)
+			cfg.Transport = tt.transport
+			cfg.Protocol = tt.protocol
+			err := cfg.Validate()
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+}
@@ -38,6 +38,7 @@ func createDefaultConfig() component.Config {
 			Transport: confignet.TransportTypeTCP,
 		},
 		TCPIdleTimeout: tcpIdleTimeoutDefault,
+		Protocol:       protocolLine,
 		Parser: &protocol.Config{
 			Type:   "plaintext",
 			Config: &protocol.PlaintextConfig{},
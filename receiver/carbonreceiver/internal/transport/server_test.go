@@ -5,6 +5,8 @@ package transport
 
 import (
 	"context"
+	"encoding/binary"
+	"net"
 	"runtime"
 	"sync"
 	"testing"
@@ -100,6 +102,51 @@ func Test_Server_ListenAndServe(t *testing.T) {
 	}
 }
 
+func Test_TCPPickleServer_ListenAndServe(t *testing.T) {
+	addr := testutil.GetAvailableLocalNetworkAddress(t, "tcp")
+
+	svr, err := NewTCPPickleServer(addr, 1*time.Second)
+	require.NoError(t, err)
+
+	mc := new(consumertest.MetricsSink)
+	p, err := (&protocol.PlaintextConfig{}).BuildParser()
+	require.NoError(t, err)
+	mr := &mockReporter{}
+	mr.wgMetricsProcessed.Add(1)
+
+	wgListenAndServe := sync.WaitGroup{}
+	wgListenAndServe.Add(1)
+	go func() {
+		defer wgListenAndServe.Done()
+		assert.Error(t, svr.ListenAndServe(p, mc, mr))
+	}()
+
+	runtime.Gosched()
+
+	conn, err := net.Dial("tcp", addr)
+	require.NoError(t, err)
+
+	// pickle.dumps([("a.b", (1582230020, 2)), ("c.d", (1582230020.5, "3"))], protocol=2)
+	payload := []byte("\x80\x02]q\x00(X\x03\x00\x00\x00a.bq\x01J\x04\xeaN^K\x02\x86q\x02\x86q\x03" +
+		"X\x03\x00\x00\x00c.dq\x04GA\xd7\x93\xba\x81 \x00\x00X\x01\x00\x00\x003q\x05\x86q\x06\x86q\x07e.")
+	header := make([]byte, 4)
+	binary.BigEndian.PutUint32(header, uint32(len(payload)))
+	_, err = conn.Write(append(header, payload...))
+	require.NoError(t, err)
+
+	mr.wgMetricsProcessed.Wait()
+	require.NoError(t, conn.Close())
+	require.NoError(t, svr.Close())
+	wgListenAndServe.Wait()
+
+	mdd := mc.AllMetrics()
+	require.Len(t, mdd, 1)
+	require.Equal(t, 2, mdd[0].MetricCount())
+	metrics := mdd[0].ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	assert.Equal(t, "a.b", metrics.At(0).Name())
+	assert.Equal(t, "c.d", metrics.At(1).Name())
+}
+
 // mockReporter provides a Reporter that provides some useful functionalities for
 // tests (eg.: wait for certain number of messages).
 type mockReporter struct {
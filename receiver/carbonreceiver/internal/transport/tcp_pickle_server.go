@@ -0,0 +1,121 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package transport // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/carbonreceiver/internal/transport"
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"io"
+	"net"
+	"time"
+
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/carbonreceiver/protocol"
+)
+
+const (
+	// pickleHeaderSize is the size of the big-endian length header that
+	// precedes each pickle message.
+	pickleHeaderSize = 4
+
+	// maxPickleMessageSize is the largest pickle message accepted, it matches
+	// the limit used by Carbon itself.
+	maxPickleMessageSize = 1 << 20
+)
+
+// NewTCPPickleServer creates a transport.Server using TCP as its transport
+// and the framing of the Carbon pickle protocol, see
+// https://graphite.readthedocs.io/en/latest/feeding-carbon.html#the-pickle-protocol.
+func NewTCPPickleServer(
+	addr string,
+	idleTimeout time.Duration,
+) (Server, error) {
+	ln, err := net.Listen("tcp", addr)
+	if err != nil {
+		return nil, err
+	}
+
+	t := tcpServer{
+		ln:          ln,
+		idleTimeout: idleTimeout,
+		pickle:      true,
+	}
+	return &t, nil
+}
+
+func (t *tcpServer) handlePickleConnection(
+	p protocol.Parser,
+	nextConsumer consumer.Metrics,
+	conn net.Conn,
+) {
+	defer conn.Close()
+	reader := bufio.NewReader(conn)
+	header := make([]byte, pickleHeaderSize)
+	for {
+		if err := conn.SetDeadline(time.Now().Add(t.idleTimeout)); err != nil {
+			t.reporter.OnDebugf(
+				"TCP Transport (%s) - conn.SetDeadLine error: %v",
+				t.ln.Addr(),
+				err)
+			return
+		}
+
+		// Any error reading the header or the message, including io.EOF and
+		// idle timeouts, terminates the connection since it is not possible to
+		// recover the framing afterwards.
+		if _, err := io.ReadFull(reader, header); err != nil {
+			t.reporter.OnDebugf("TCP Transport (%s) - pickle header error: %v", t.ln.Addr(), err)
+			return
+		}
+		size := binary.BigEndian.Uint32(header)
+		if size > maxPickleMessageSize {
+			t.reporter.OnDebugf(
+				"TCP Transport (%s) - pickle message of %d bytes exceeds the limit of %d bytes",
+				t.ln.Addr(),
+				size,
+				maxPickleMessageSize)
+			return
+		}
+		payload := make([]byte, size)
+		if _, err := io.ReadFull(reader, payload); err != nil {
+			t.reporter.OnDebugf("TCP Transport (%s) - pickle message error: %v", t.ln.Addr(), err)
+			return
+		}
+
+		ctx := t.reporter.OnDataReceived(context.Background())
+		lines, err := protocol.UnpickleLines(payload)
+		if err != nil {
+			t.reporter.OnTranslationError(ctx, err)
+			t.reporter.OnMetricsProcessed(ctx, 0, nil)
+			continue
+		}
+
+		metrics := pmetric.NewMetrics()
+		metricSlice := metrics.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics()
+		for _, line := range lines {
+			metric, parseErr := p.Parse(line)
+			if parseErr != nil {
+				t.reporter.OnTranslationError(ctx, parseErr)
+				continue
+			}
+			metric.MoveTo(metricSlice.AppendEmpty())
+		}
+
+		numReceivedMetricPoints := metricSlice.Len()
+		if numReceivedMetricPoints == 0 {
+			t.reporter.OnMetricsProcessed(ctx, 0, nil)
+			continue
+		}
+		err = nextConsumer.ConsumeMetrics(ctx, metrics)
+		t.reporter.OnMetricsProcessed(ctx, numReceivedMetricPoints, err)
+		if err != nil {
+			// Same as the plaintext protocol, close the connection as a way
+			// to report the error back to the client.
+			return
+		}
+	}
+}
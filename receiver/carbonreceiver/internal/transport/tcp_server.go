@@ -24,6 +24,9 @@ type tcpServer struct {
 	wg          sync.WaitGroup
 	idleTimeout time.Duration
 	reporter    Reporter
+	// pickle indicates that connections use the framing of the Carbon pickle
+	// protocol instead of the plaintext one.
+	pickle bool
 }
 
 var _ Server = (*tcpServer)(nil)
@@ -67,7 +70,11 @@ func (t *tcpServer) ListenAndServe(
 			connMapMtx.Unlock()
 			t.wg.Add(1)
 			go func(c net.Conn) {
-				t.handleConnection(parser, nextConsumer, c)
+				if t.pickle {
+					t.handlePickleConnection(parser, nextConsumer, c)
+				} else {
+					t.handleConnection(parser, nextConsumer, c)
+				}
 				connMapMtx.Lock()
 				delete(acceptedConnMap, c)
 				connMapMtx.Unlock()
@@ -0,0 +1,440 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package protocol // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/carbonreceiver/protocol"
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// Pickle opcodes supported by the decoder. Only the subset required to
+// represent lists and tuples of strings and numbers is accepted, opcodes that
+// can instantiate arbitrary Python objects (eg.: GLOBAL, REDUCE) are rejected
+// in the same way the Carbon "SafeUnpickler" does.
+const (
+	opMark            = '('
+	opStop            = '.'
+	opPop             = '0'
+	opInt             = 'I'
+	opBinInt          = 'J'
+	opBinInt1         = 'K'
+	opBinInt2         = 'M'
+	opLong            = 'L'
+	opNone            = 'N'
+	opFloat           = 'F'
+	opBinFloat        = 'G'
+	opString          = 'S'
+	opBinString       = 'T'
+	opShortBinString  = 'U'
+	opUnicode         = 'V'
+	opBinUnicode      = 'X'
+	opBinBytes        = 'B'
+	opShortBinBytes   = 'C'
+	opAppend          = 'a'
+	opAppends         = 'e'
+	opList            = 'l'
+	opEmptyList       = ']'
+	opTuple           = 't'
+	opEmptyTuple      = ')'
+	opGet             = 'g'
+	opBinGet          = 'h'
+	opLongBinGet      = 'j'
+	opPut             = 'p'
+	opBinPut          = 'q'
+	opLongBinPut      = 'r'
+	opProto           = 0x80
+	opTuple1          = 0x85
+	opTuple2          = 0x86
+	opTuple3          = 0x87
+	opNewTrue         = 0x88
+	opNewFalse        = 0x89
+	opLong1           = 0x8a
+	opShortBinUnicode = 0x8c
+	opMemoize         = 0x94
+	opFrame           = 0x95
+)
+
+var errPickleTruncated = errors.New("pickle data is truncated")
+
+// pickleMark is pushed to the stack to delimit the items of a list or tuple.
+type pickleMark struct{}
+
+// pickleList is a mutable list, kept as a pointer so APPEND(S) can update
+// lists that are also referenced by the memo.
+type pickleList struct {
+	items []any
+}
+
+// UnpickleLines decodes a message of the Carbon pickle protocol, see
+// https://graphite.readthedocs.io/en/latest/feeding-carbon.html#the-pickle-protocol,
+// into lines of the plaintext protocol that can be handled by any Parser.
+//
+// The payload, without the 4 bytes length header, must be a pickled list of
+// tuples in the following format:
+//
+//	[(<metric_path>, (<metric_timestamp>, <metric_value>)), ...]
+func UnpickleLines(payload []byte) ([]string, error) {
+	obj, err := unpickle(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	var items []any
+	switch v := obj.(type) {
+	case *pickleList:
+		items = v.items
+	case []any:
+		items = v
+	default:
+		return nil, fmt.Errorf("pickle message must be a list of metrics, got %T", obj)
+	}
+
+	lines := make([]string, 0, len(items))
+	for _, item := range items {
+		metric, ok := item.([]any)
+		if !ok || len(metric) != 2 {
+			return nil, fmt.Errorf("invalid pickle metric %v, expected (path, (timestamp, value))", item)
+		}
+		path, ok := metric[0].(string)
+		if !ok {
+			return nil, fmt.Errorf("invalid pickle metric path %v", metric[0])
+		}
+		datapoint, ok := metric[1].([]any)
+		if !ok || len(datapoint) != 2 {
+			return nil, fmt.Errorf("invalid pickle datapoint %v for %q, expected (timestamp, value)", metric[1], path)
+		}
+		timestamp, err := formatPickleNumber(datapoint[0])
+		if err != nil {
+			return nil, fmt.Errorf("invalid timestamp for %q: %w", path, err)
+		}
+		value, err := formatPickleNumber(datapoint[1])
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for %q: %w", path, err)
+		}
+		lines = append(lines, path+" "+value+" "+timestamp)
+	}
+
+	return lines, nil
+}
+
+func formatPickleNumber(v any) (string, error) {
+	switch n := v.(type) {
+	case int64:
+		return strconv.FormatInt(n, 10), nil
+	case float64:
+		return strconv.FormatFloat(n, 'f', -1, 64), nil
+	case string:
+		// Some clients send the textual representation of the number.
+		return strings.TrimSpace(n), nil
+	}
+	return "", fmt.Errorf("unexpected type %T", v)
+}
+
+// unpickle runs the subset of the pickle virtual machine needed to decode
+// Carbon messages and returns the resulting object.
+func unpickle(data []byte) (any, error) {
+	var (
+		stack []any
+		memo  = map[int]any{}
+		pos   int
+	)
+
+	read := func(n int) ([]byte, error) {
+		if n < 0 || pos+n > len(data) {
+			return nil, errPickleTruncated
+		}
+		b := data[pos : pos+n]
+		pos += n
+		return b, nil
+	}
+	readLine := func() (string, error) {
+		idx := bytes.IndexByte(data[pos:], '\n')
+		if idx < 0 {
+			return "", errPickleTruncated
+		}
+		line := string(data[pos : pos+idx])
+		pos += idx + 1
+		return line, nil
+	}
+	pop := func() (any, error) {
+		if len(stack) == 0 {
+			return nil, errors.New("pickle stack underflow")
+		}
+		v := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		return v, nil
+	}
+	popMark := func() ([]any, error) {
+		for i := len(stack) - 1; i >= 0; i-- {
+			if _, ok := stack[i].(pickleMark); ok {
+				items := append([]any(nil), stack[i+1:]...)
+				stack = stack[:i]
+				return items, nil
+			}
+		}
+		return nil, errors.New("pickle mark not found")
+	}
+	popTuple := func(n int) error {
+		if len(stack) < n {
+			return errors.New("pickle stack underflow")
+		}
+		tuple := append([]any(nil), stack[len(stack)-n:]...)
+		stack = append(stack[:len(stack)-n], tuple)
+		return nil
+	}
+	appendTo := func(items ...any) error {
+		if len(stack) == 0 {
+			return errors.New("pickle stack underflow")
+		}
+		list, ok := stack[len(stack)-1].(*pickleList)
+		if !ok {
+			return fmt.Errorf("cannot append to %T", stack[len(stack)-1])
+		}
+		list.items = append(list.items, items...)
+		return nil
+	}
+
+	for {
+		b, err := read(1)
+		if err != nil {
+			return nil, err
+		}
+		op := b[0]
+		switch op {
+		case opStop:
+			return pop()
+		case opProto:
+			if _, err = read(1); err != nil {
+				return nil, err
+			}
+		case opFrame:
+			if _, err = read(8); err != nil {
+				return nil, err
+			}
+		case opMark:
+			stack = append(stack, pickleMark{})
+		case opPop:
+			_, err = pop()
+		case opNone:
+			stack = append(stack, nil)
+		case opNewTrue:
+			stack = append(stack, true)
+		case opNewFalse:
+			stack = append(stack, false)
+		case opInt:
+			var line string
+			if line, err = readLine(); err != nil {
+				return nil, err
+			}
+			switch line {
+			case "00":
+				stack = append(stack, false)
+			case "01":
+				stack = append(stack, true)
+			default:
+				var i int64
+				if i, err = strconv.ParseInt(line, 10, 64); err != nil {
+					return nil, fmt.Errorf("invalid pickle int %q: %w", line, err)
+				}
+				stack = append(stack, i)
+			}
+		case opLong:
+			var line string
+			if line, err = readLine(); err != nil {
+				return nil, err
+			}
+			var i int64
+			if i, err = strconv.ParseInt(strings.TrimSuffix(line, "L"), 10, 64); err != nil {
+				return nil, fmt.Errorf("invalid pickle long %q: %w", line, err)
+			}
+			stack = append(stack, i)
+		case opBinInt:
+			if b, err = read(4); err != nil {
+				return nil, err
+			}
+			stack = append(stack, int64(int32(binary.LittleEndian.Uint32(b))))
+		case opBinInt1:
+			if b, err = read(1); err != nil {
+				return nil, err
+			}
+			stack = append(stack, int64(b[0]))
+		case opBinInt2:
+			if b, err = read(2); err != nil {
+				return nil, err
+			}
+			stack = append(stack, int64(binary.LittleEndian.Uint16(b)))
+		case opLong1:
+			if b, err = read(1); err != nil {
+				return nil, err
+			}
+			if b, err = read(int(b[0])); err != nil {
+				return nil, err
+			}
+			var i int64
+			if i, err = decodeLong(b); err != nil {
+				return nil, err
+			}
+			stack = append(stack, i)
+		case opFloat:
+			var line string
+			if line, err = readLine(); err != nil {
+				return nil, err
+			}
+			var f float64
+			if f, err = strconv.ParseFloat(line, 64); err != nil {
+				return nil, fmt.Errorf("invalid pickle float %q: %w", line, err)
+			}
+			stack = append(stack, f)
+		case opBinFloat:
+			if b, err = read(8); err != nil {
+				return nil, err
+			}
+			stack = append(stack, math.Float64frombits(binary.BigEndian.Uint64(b)))
+		case opString:
+			var line string
+			if line, err = readLine(); err != nil {
+				return nil, err
+			}
+			if len(line) < 2 || (line[0] != '\'' && line[0] != '"') || line[len(line)-1] != line[0] {
+				return nil, fmt.Errorf("invalid pickle string %q", line)
+			}
+			stack = append(stack, line[1:len(line)-1])
+		case opUnicode:
+			var line string
+			if line, err = readLine(); err != nil {
+				return nil, err
+			}
+			stack = append(stack, line)
+		case opShortBinString, opShortBinBytes, opShortBinUnicode:
+			if b, err = read(1); err != nil {
+				return nil, err
+			}
+			if b, err = read(int(b[0])); err != nil {
+				return nil, err
+			}
+			stack = append(stack, string(b))
+		case opBinString, opBinBytes, opBinUnicode:
+			if b, err = read(4); err != nil {
+				return nil, err
+			}
+			if b, err = read(int(binary.LittleEndian.Uint32(b))); err != nil {
+				return nil, err
+			}
+			stack = append(stack, string(b))
+		case opEmptyList:
+			stack = append(stack, &pickleList{})
+		case opList:
+			var items []any
+			if items, err = popMark(); err != nil {
+				return nil, err
+			}
+			stack = append(stack, &pickleList{items: items})
+		case opAppend:
+			var item any
+			if item, err = pop(); err != nil {
+				return nil, err
+			}
+			err = appendTo(item)
+		case opAppends:
+			var items []any
+			if items, err = popMark(); err != nil {
+				return nil, err
+			}
+			err = appendTo(items...)
+		case opEmptyTuple:
+			stack = append(stack, []any{})
+		case opTuple:
+			var items []any
+			if items, err = popMark(); err != nil {
+				return nil, err
+			}
+			stack = append(stack, items)
+		case opTuple1:
+			err = popTuple(1)
+		case opTuple2:
+			err = popTuple(2)
+		case opTuple3:
+			err = popTuple(3)
+		case opPut, opBinPut, opLongBinPut, opMemoize:
+			var idx int
+			if idx, err = readMemoIndex(op, read, readLine, len(memo)); err != nil {
+				return nil, err
+			}
+			if len(stack) == 0 {
+				return nil, errors.New("pickle stack underflow")
+			}
+			memo[idx] = stack[len(stack)-1]
+		case opGet, opBinGet, opLongBinGet:
+			var idx int
+			if idx, err = readMemoIndex(op, read, readLine, len(memo)); err != nil {
+				return nil, err
+			}
+			v, ok := memo[idx]
+			if !ok {
+				return nil, fmt.Errorf("pickle memo key %d not found", idx)
+			}
+			stack = append(stack, v)
+		default:
+			return nil, fmt.Errorf("unsupported pickle opcode 0x%02x", op)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+}
+
+// readMemoIndex reads the memo index argument of the memo related opcodes.
+func readMemoIndex(op byte, read func(int) ([]byte, error), readLine func() (string, error), memoLen int) (int, error) {
+	switch op {
+	case opMemoize:
+		return memoLen, nil
+	case opPut, opGet:
+		line, err := readLine()
+		if err != nil {
+			return 0, err
+		}
+		idx, err := strconv.Atoi(line)
+		if err != nil {
+			return 0, fmt.Errorf("invalid pickle memo key %q: %w", line, err)
+		}
+		return idx, nil
+	case opBinPut, opBinGet:
+		b, err := read(1)
+		if err != nil {
+			return 0, err
+		}
+		return int(b[0]), nil
+	default:
+		b, err := read(4)
+		if err != nil {
+			return 0, err
+		}
+		return int(binary.LittleEndian.Uint32(b)), nil
+	}
+}
+
+// decodeLong decodes the little-endian two's complement representation used
+// by the LONG1 opcode.
+func decodeLong(b []byte) (int64, error) {
+	if len(b) == 0 {
+		return 0, nil
+	}
+	if len(b) > 8 {
+		return 0, fmt.Errorf("pickle long of %d bytes is out of range", len(b))
+	}
+	var u uint64
+	for i := len(b) - 1; i >= 0; i-- {
+		u = u<<8 | uint64(b[i])
+	}
+	if b[len(b)-1]&0x80 != 0 && len(b) < 8 {
+		// Sign extend negative numbers.
+		u |= ^uint64(0) << (8 * uint(len(b)))
+	}
+	return int64(u), nil
+}
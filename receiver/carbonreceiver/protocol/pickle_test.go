@@ -0,0 +1,88 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package protocol
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnpickleLines(t *testing.T) {
+	tests := []struct {
+		name    string
+		payload string
+		want    []string
+		wantErr string
+	}{
+		{
+			name:    "protocol_2_single_metric",
+			payload: "\x80\x02]q\x00X\x0b\x00\x00\x00test.metricq\x01J\x04\xeaN^G?\xf8\x00\x00\x00\x00\x00\x00\x86q\x02\x86q\x03a.",
+			want:    []string{"test.metric 1.5 1582230020"},
+		},
+		{
+			name:    "protocol_2_multiple_metrics",
+			payload: "\x80\x02]q\x00(X\x03\x00\x00\x00a.bq\x01J\x04\xeaN^K\x02\x86q\x02\x86q\x03X\x03\x00\x00\x00c.dq\x04GA\xd7\x93\xba\x81 \x00\x00X\x01\x00\x00\x003q\x05\x86q\x06\x86q\x07e.",
+			want: []string{
+				"a.b 2 1582230020",
+				"c.d 3 1582230020.5",
+			},
+		},
+		{
+			name:    "protocol_0",
+			payload: "(lp0\n(Vtest.metric\np1\n(I1582230020\nF1.5\ntp2\ntp3\na.",
+			want:    []string{"test.metric 1.5 1582230020"},
+		},
+		{
+			name:    "protocol_4",
+			payload: "\x80\x04\x95 \x00\x00\x00\x00\x00\x00\x00]\x94\x8c\x0btest.metric\x94J\x04\xeaN^J\xff\xff\xff\xff\x86\x94\x86\x94a.",
+			want:    []string{"test.metric -1 1582230020"},
+		},
+		{
+			name:    "memo_get",
+			payload: "\x80\x02]q\x00(X\x03\x00\x00\x00a.bq\x01J\x04\xeaN^K\x02\x86q\x02\x86q\x03h\x01h\x02\x86e.",
+			want: []string{
+				"a.b 2 1582230020",
+				"a.b 2 1582230020",
+			},
+		},
+		{
+			name:    "empty_list",
+			payload: "\x80\x02].",
+			want:    []string{},
+		},
+		{
+			name:    "global_rejected",
+			payload: "c__builtin__\neval\n.",
+			wantErr: "unsupported pickle opcode 0x63",
+		},
+		{
+			name:    "truncated",
+			payload: "\x80\x02]q\x00X\x0b\x00\x00\x00test",
+			wantErr: "pickle data is truncated",
+		},
+		{
+			name:    "not_a_list",
+			payload: "\x80\x02K\x01.",
+			wantErr: "pickle message must be a list of metrics",
+		},
+		{
+			name:    "invalid_datapoint",
+			payload: "\x80\x02]q\x00X\x01\x00\x00\x00aK\x01\x86a.",
+			wantErr: `invalid pickle datapoint 1 for "a"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := UnpickleLines([]byte(tt.payload))
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,140 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package dnscheckreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/dnscheckreceiver"
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/miekg/dns"
+	"go.opentelemetry.io/collector/scraper/scraperhelper"
+	"go.uber.org/multierr"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/dnscheckreceiver/internal/metadata"
+)
+
+const (
+	defaultRecordType = "A"
+	defaultTransport  = "udp"
+	defaultTimeout    = 5 * time.Second
+	defaultDNSPort    = "53"
+)
+
+// Predefined error responses for configuration validation failures
+var (
+	errMissingTargets   = errors.New("no targets specified")
+	errMissingName      = errors.New("'name' must be specified")
+	errMissingResolvers = errors.New("at least one resolver must be specified")
+	errConfigDNSCheck   = errors.New("invalid config")
+)
+
+// Config defines the configuration for the various elements of the receiver agent.
+type Config struct {
+	scraperhelper.ControllerConfig `mapstructure:",squash"`
+	metadata.MetricsBuilderConfig  `mapstructure:",squash"`
+	Targets                        []*targetConfig `mapstructure:"targets"`
+
+	// prevent unkeyed literal initialization
+	_ struct{}
+}
+
+// targetConfig defines a name resolved against each of the resolvers.
+type targetConfig struct {
+	// Name is the name queried.
+	Name string `mapstructure:"name"`
+	// RecordType is the type of the record queried, such as A, AAAA, CNAME, MX or TXT.
+	RecordType string `mapstructure:"record_type"`
+	// Resolvers are the addresses of the resolvers queried, as <host>[:<port>].
+	Resolvers []string `mapstructure:"resolvers"`
+	// Transport is the transport used to query the resolvers, udp or tcp.
+	Transport string `mapstructure:"transport"`
+	// Timeout is the timeout of each query.
+	Timeout time.Duration `mapstructure:"timeout"`
+	// ExpectedAnswers are the values the answers are checked against, such as
+	// the addresses of an A record.
+	ExpectedAnswers []string `mapstructure:"expected_answers"`
+}
+
+func validateTarget(cfg *targetConfig) error {
+	var err error
+
+	if cfg.Name == "" {
+		err = multierr.Append(err, errMissingName)
+	}
+
+	if _, ok := dns.StringToType[strings.ToUpper(cfg.recordType())]; !ok {
+		err = multierr.Append(err, fmt.Errorf("unknown record type: %s", cfg.RecordType))
+	}
+
+	if len(cfg.Resolvers) == 0 {
+		err = multierr.Append(err, errMissingResolvers)
+	}
+	for _, resolver := range cfg.Resolvers {
+		if strings.Contains(resolver, "://") {
+			err = multierr.Append(err, fmt.Errorf("resolver contains a scheme, which is not allowed: %s", resolver))
+		}
+	}
+
+	switch cfg.Transport {
+	case "", "udp", "tcp":
+	default:
+		err = multierr.Append(err, fmt.Errorf("invalid transport %q, must be udp or tcp", cfg.Transport))
+	}
+
+	if cfg.Timeout < 0 {
+		err = multierr.Append(err, errors.New("'timeout' must not be negative"))
+	}
+
+	if err != nil {
+		return fmt.Errorf("target %q: %w", cfg.Name, err)
+	}
+	return nil
+}
+
+func (cfg *targetConfig) recordType() string {
+	if cfg.RecordType == "" {
+		return defaultRecordType
+	}
+	return strings.ToUpper(cfg.RecordType)
+}
+
+func (cfg *targetConfig) transport() string {
+	if cfg.Transport == "" {
+		return defaultTransport
+	}
+	return cfg.Transport
+}
+
+func (cfg *targetConfig) timeout() time.Duration {
+	if cfg.Timeout == 0 {
+		return defaultTimeout
+	}
+	return cfg.Timeout
+}
+
+// resolverAddress returns the address of the resolver, with the DNS port when
+// it has no port.
+func resolverAddress(resolver string) string {
+	if _, _, err := net.SplitHostPort(resolver); err == nil {
+		return resolver
+	}
+	return net.JoinHostPort(strings.Trim(resolver, "[]"), defaultDNSPort)
+}
+
+func (cfg *Config) Validate() error {
+	var err error
+
+	if len(cfg.Targets) == 0 {
+		err = multierr.Append(err, errMissingTargets)
+	}
+
+	for _, target := range cfg.Targets {
+		err = multierr.Append(err, validateTarget(target))
+	}
+
+	return err
+}
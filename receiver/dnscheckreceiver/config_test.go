@@ -0,0 +1,102 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package dnscheckreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/dnscheckreceiver"
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/confmap/confmaptest"
+	"go.opentelemetry.io/collector/confmap/xconfmap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/dnscheckreceiver/internal/metadata"
+)
+
+func TestLoadConfig(t *testing.T) {
+	cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
+	require.NoError(t, err)
+
+	factory := NewFactory()
+	cfg := factory.CreateDefaultConfig()
+	sub, err := cm.Sub(component.NewID(metadata.Type).String())
+	require.NoError(t, err)
+	require.NoError(t, sub.Unmarshal(cfg))
+	require.NoError(t, xconfmap.Validate(cfg))
+
+	expected := factory.CreateDefaultConfig().(*Config)
+	expected.CollectionInterval = 30 * time.Second
+	expected.Targets = []*targetConfig{
+		{
+			Name:            "example.com",
+			Resolvers:       []string{"1.1.1.1", "8.8.8.8:53"},
+			ExpectedAnswers: []string{"93.184.215.14"},
+		},
+		{
+			Name:       "example.com",
+			RecordType: "MX",
+			Resolvers:  []string{"[2606:4700:4700::1111]:53"},
+			Transport:  "tcp",
+			Timeout:    2 * time.Second,
+		},
+	}
+	assert.Equal(t, expected, cfg)
+}
+
+func TestValidate(t *testing.T) {
+	testCases := []struct {
+		desc        string
+		targets     []*targetConfig
+		expectedErr string
+	}{
+		{
+			desc:        "missing targets",
+			expectedErr: "no targets specified",
+		},
+		{
+			desc:    "valid target",
+			targets: []*targetConfig{{Name: "example.com", RecordType: "aaaa", Resolvers: []string{"1.1.1.1"}}},
+		},
+		{
+			desc:        "missing name and resolvers",
+			targets:     []*targetConfig{{}},
+			expectedErr: `target "": 'name' must be specified; at least one resolver must be specified`,
+		},
+		{
+			desc: "invalid target",
+			targets: []*targetConfig{{
+				Name:       "example.com",
+				RecordType: "BOGUS",
+				Resolvers:  []string{"udp://1.1.1.1"},
+				Transport:  "quic",
+				Timeout:    -time.Second,
+			}},
+			expectedErr: `target "example.com": unknown record type: BOGUS; resolver contains a scheme, which is not allowed: udp://1.1.1.1; invalid transport "quic", must be udp or tcp; 'timeout' must not be negative`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			cfg := newDefaultConfig().(*Config)
+			cfg.Targets = tc.targets
+			err := cfg.Validate()
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
+
+func TestResolverAddress(t *testing.T) {
+	assert.Equal(t, "1.1.1.1:53", resolverAddress("1.1.1.1"))
+	assert.Equal(t, "1.1.1.1:5353", resolverAddress("1.1.1.1:5353"))
+	assert.Equal(t, "[2606:4700:4700::1111]:53", resolverAddress("2606:4700:4700::1111"))
+	assert.Equal(t, "[2606:4700:4700::1111]:53", resolverAddress("[2606:4700:4700::1111]"))
+	assert.Equal(t, "dns.example.com:53", resolverAddress("dns.example.com"))
+}
@@ -0,0 +1,187 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package dnscheckreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/dnscheckreceiver"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/miekg/dns"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/receiver"
+	"go.opentelemetry.io/collector/scraper/scrapererror"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/dnscheckreceiver/internal/metadata"
+)
+
+type scraper struct {
+	cfg      *Config
+	settings component.TelemetrySettings
+	mb       *metadata.MetricsBuilder
+	exchange func(ctx context.Context, target *targetConfig, msg *dns.Msg, resolver string) (*dns.Msg, time.Duration, error)
+	// errorCounts holds the number of errors of each name and resolver, reported
+	// by the cumulative dnscheck.error metric.
+	errorCounts map[string]int64
+}
+
+func exchange(ctx context.Context, target *targetConfig, msg *dns.Msg, resolver string) (*dns.Msg, time.Duration, error) {
+	client := &dns.Client{
+		Net:     target.transport(),
+		Timeout: target.timeout(),
+	}
+	return client.ExchangeContext(ctx, msg, resolver)
+}
+
+func (s *scraper) scrapeResolver(ctx context.Context, target *targetConfig, resolver string, mux *sync.Mutex) error {
+	recordType := target.recordType()
+	qtype := dns.StringToType[recordType]
+	msg := new(dns.Msg)
+	msg.SetQuestion(dns.Fqdn(target.Name), qtype)
+	msg.RecursionDesired = true
+
+	resp, rtt, err := s.exchange(ctx, target, msg, resolverAddress(resolver))
+	now := pcommon.NewTimestampFromTime(time.Now())
+
+	mux.Lock()
+	defer mux.Unlock()
+
+	if err != nil {
+		key := target.Name + "/" + recordType + "@" + resolver
+		s.errorCounts[key]++
+		s.mb.RecordDnscheckErrorDataPoint(now, s.errorCounts[key], target.Name, resolver, recordType, errorCode(err))
+		s.mb.RecordDnscheckStatusDataPoint(now, 0, target.Name, resolver, recordType)
+		return fmt.Errorf("failed to resolve %s %s with %s: %w", recordType, target.Name, resolver, err)
+	}
+
+	answers := answerValues(resp, qtype)
+	status := int64(0)
+	if resp.Rcode == dns.RcodeSuccess {
+		status = 1
+	}
+	if len(target.ExpectedAnswers) > 0 {
+		match := int64(0)
+		if answersMatch(answers, target.ExpectedAnswers) {
+			match = 1
+		}
+		s.mb.RecordDnscheckAnswerMatchDataPoint(now, match, target.Name, resolver, recordType)
+		status *= match
+	}
+
+	s.mb.RecordDnscheckDurationDataPoint(now, rtt.Milliseconds(), target.Name, resolver, recordType)
+	s.mb.RecordDnscheckResponseCodeDataPoint(now, int64(resp.Rcode), target.Name, resolver, recordType)
+	s.mb.RecordDnscheckAnswerCountDataPoint(now, int64(len(answers)), target.Name, resolver, recordType)
+	s.mb.RecordDnscheckStatusDataPoint(now, status, target.Name, resolver, recordType)
+	return nil
+}
+
+// answerValues returns the values of the records of the queried type in the
+// answer, such as the addresses of A records or the targets of CNAME records.
+func answerValues(resp *dns.Msg, qtype uint16) []string {
+	var values []string
+	for _, rr := range resp.Answer {
+		if rr.Header().Rrtype != qtype {
+			continue
+		}
+		switch record := rr.(type) {
+		case *dns.A:
+			values = append(values, record.A.String())
+		case *dns.AAAA:
+			values = append(values, record.AAAA.String())
+		case *dns.CNAME:
+			values = append(values, record.Target)
+		case *dns.NS:
+			values = append(values, record.Ns)
+		case *dns.PTR:
+			values = append(values, record.Ptr)
+		case *dns.MX:
+			values = append(values, record.Mx)
+		case *dns.TXT:
+			values = append(values, strings.Join(record.Txt, ""))
+		default:
+			values = append(values, strings.TrimPrefix(rr.String(), rr.Header().String()))
+		}
+	}
+	return values
+}
+
+// answersMatch reports whether there is at least one answer and all the answers
+// are among the expected answers. Names are compared case insensitively and
+// regardless of their trailing dot.
+func answersMatch(answers, expected []string) bool {
+	if len(answers) == 0 {
+		return false
+	}
+	normalize := func(v string) string {
+		return strings.TrimSuffix(strings.ToLower(v), ".")
+	}
+	expectedSet := make(map[string]bool, len(expected))
+	for _, v := range expected {
+		expectedSet[normalize(v)] = true
+	}
+	for _, v := range answers {
+		if !expectedSet[normalize(v)] {
+			return false
+		}
+	}
+	return true
+}
+
+func errorCode(err error) metadata.AttributeErrorCode {
+	var netErr net.Error
+	switch {
+	case errors.Is(err, context.DeadlineExceeded), errors.As(err, &netErr) && netErr.Timeout():
+		return metadata.AttributeErrorCodeTimeout
+	case errors.Is(err, syscall.ECONNREFUSED):
+		return metadata.AttributeErrorCodeConnectionRefused
+	case errors.Is(err, syscall.ENETUNREACH), errors.Is(err, syscall.EHOSTUNREACH):
+		return metadata.AttributeErrorCodeNetworkUnreachable
+	default:
+		return metadata.AttributeErrorCodeUnknownError
+	}
+}
+
+func (s *scraper) scrape(ctx context.Context) (pmetric.Metrics, error) {
+	if s.cfg == nil || len(s.cfg.Targets) == 0 {
+		return pmetric.NewMetrics(), errMissingTargets
+	}
+
+	var wg sync.WaitGroup
+	var mux sync.Mutex
+	errs := &scrapererror.ScrapeErrors{}
+
+	for _, target := range s.cfg.Targets {
+		for _, resolver := range target.Resolvers {
+			wg.Add(1)
+			go func(target *targetConfig, resolver string) {
+				defer wg.Done()
+				if err := s.scrapeResolver(ctx, target, resolver, &mux); err != nil {
+					mux.Lock()
+					errs.AddPartial(1, err)
+					mux.Unlock()
+				}
+			}(target, resolver)
+		}
+	}
+	wg.Wait()
+
+	return s.mb.Emit(), errs.Combine()
+}
+
+func newScraper(cfg *Config, settings receiver.Settings) *scraper {
+	return &scraper{
+		cfg:         cfg,
+		settings:    settings.TelemetrySettings,
+		mb:          metadata.NewMetricsBuilder(cfg.MetricsBuilderConfig, settings),
+		exchange:    exchange,
+		errorCounts: map[string]int64{},
+	}
+}
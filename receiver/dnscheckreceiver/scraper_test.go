@@ -0,0 +1,198 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package dnscheckreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/dnscheckreceiver"
+
+import (
+	"context"
+	"errors"
+	"net"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/miekg/dns"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/dnscheckreceiver/internal/metadata"
+)
+
+// runDNSServer starts a resolver answering example.com with 192.0.2.1 and
+// 192.0.2.2, and NXDOMAIN for the other names.
+func runDNSServer(t *testing.T) string {
+	pc, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	started := make(chan struct{})
+	server := &dns.Server{
+		PacketConn:        pc,
+		NotifyStartedFunc: func() { close(started) },
+		Handler: dns.HandlerFunc(func(w dns.ResponseWriter, req *dns.Msg) {
+			resp := new(dns.Msg)
+			resp.SetReply(req)
+			q := req.Question[0]
+			switch {
+			case q.Name == "example.com." && q.Qtype == dns.TypeA:
+				for _, ip := range []string{"192.0.2.1", "192.0.2.2"} {
+					rr, rrErr := dns.NewRR("example.com. 300 IN A " + ip)
+					assert.NoError(t, rrErr)
+					resp.Answer = append(resp.Answer, rr)
+				}
+			case q.Name == "example.com.":
+			default:
+				resp.Rcode = dns.RcodeNameError
+			}
+			assert.NoError(t, w.WriteMsg(resp))
+		}),
+	}
+	go func() {
+		assert.NoError(t, server.ActivateAndServe())
+	}()
+	<-started
+	t.Cleanup(func() {
+		assert.NoError(t, server.Shutdown())
+	})
+	return pc.LocalAddr().String()
+}
+
+func TestScraper(t *testing.T) {
+	resolver := runDNSServer(t)
+
+	testCases := []struct {
+		desc            string
+		target          *targetConfig
+		expectedStatus  int64
+		expectedRcode   int64
+		expectedCount   int64
+		expectedMatch   int64
+		expectMatchData bool
+	}{
+		{
+			desc:           "resolved name",
+			target:         &targetConfig{Name: "example.com"},
+			expectedStatus: 1,
+			expectedRcode:  0,
+			expectedCount:  2,
+		},
+		{
+			desc:            "matching answers",
+			target:          &targetConfig{Name: "example.com", ExpectedAnswers: []string{"192.0.2.1", "192.0.2.2", "192.0.2.3"}},
+			expectedStatus:  1,
+			expectedRcode:   0,
+			expectedCount:   2,
+			expectedMatch:   1,
+			expectMatchData: true,
+		},
+		{
+			desc:            "unexpected answers",
+			target:          &targetConfig{Name: "example.com", ExpectedAnswers: []string{"192.0.2.1"}},
+			expectedStatus:  0,
+			expectedRcode:   0,
+			expectedCount:   2,
+			expectedMatch:   0,
+			expectMatchData: true,
+		},
+		{
+			desc:            "no answer of the queried type",
+			target:          &targetConfig{Name: "example.com", RecordType: "aaaa", ExpectedAnswers: []string{"2001:db8::1"}},
+			expectedStatus:  0,
+			expectedRcode:   0,
+			expectedCount:   0,
+			expectedMatch:   0,
+			expectMatchData: true,
+		},
+		{
+			desc:           "unknown name",
+			target:         &targetConfig{Name: "missing.example.com"},
+			expectedStatus: 0,
+			expectedRcode:  3,
+			expectedCount:  0,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			cfg := newDefaultConfig().(*Config)
+			cfg.Metrics.DnscheckAnswerCount.Enabled = true
+			tc.target.Resolvers = []string{resolver}
+			cfg.Targets = []*targetConfig{tc.target}
+
+			s := newScraper(cfg, receivertest.NewNopSettings(metadata.Type))
+			metrics, err := s.scrape(context.Background())
+			require.NoError(t, err)
+
+			values := gaugeValues(t, metrics)
+			assert.Equal(t, tc.expectedStatus, values["dnscheck.status"])
+			assert.Equal(t, tc.expectedRcode, values["dnscheck.response_code"])
+			assert.Equal(t, tc.expectedCount, values["dnscheck.answer.count"])
+			assert.Contains(t, values, "dnscheck.duration")
+			match, ok := values["dnscheck.answer.match"]
+			assert.Equal(t, tc.expectMatchData, ok)
+			assert.Equal(t, tc.expectedMatch, match)
+		})
+	}
+}
+
+func TestScraperError(t *testing.T) {
+	cfg := newDefaultConfig().(*Config)
+	cfg.Targets = []*targetConfig{{Name: "example.com", Resolvers: []string{"192.0.2.53"}}}
+
+	s := newScraper(cfg, receivertest.NewNopSettings(metadata.Type))
+	s.exchange = func(context.Context, *targetConfig, *dns.Msg, string) (*dns.Msg, time.Duration, error) {
+		return nil, 0, &net.OpError{Op: "read", Net: "udp", Err: syscall.ECONNREFUSED}
+	}
+
+	for i := int64(1); i <= 2; i++ {
+		metrics, err := s.scrape(context.Background())
+		require.ErrorContains(t, err, "failed to resolve A example.com with 192.0.2.53")
+
+		values := gaugeValues(t, metrics)
+		assert.Equal(t, int64(0), values["dnscheck.status"])
+		assert.NotContains(t, values, "dnscheck.duration")
+
+		ms := metrics.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+		var found bool
+		for j := 0; j < ms.Len(); j++ {
+			if ms.At(j).Name() != "dnscheck.error" {
+				continue
+			}
+			found = true
+			dp := ms.At(j).Sum().DataPoints().At(0)
+			assert.Equal(t, i, dp.IntValue())
+			code, _ := dp.Attributes().Get("error.code")
+			assert.Equal(t, "connection_refused", code.Str())
+		}
+		assert.True(t, found)
+	}
+}
+
+func TestErrorCode(t *testing.T) {
+	assert.Equal(t, metadata.AttributeErrorCodeTimeout, errorCode(context.DeadlineExceeded))
+	assert.Equal(t, metadata.AttributeErrorCodeConnectionRefused, errorCode(&net.OpError{Err: syscall.ECONNREFUSED}))
+	assert.Equal(t, metadata.AttributeErrorCodeNetworkUnreachable, errorCode(&net.OpError{Err: syscall.ENETUNREACH}))
+	assert.Equal(t, metadata.AttributeErrorCodeUnknownError, errorCode(errors.New("bad response")))
+}
+
+func TestAnswersMatch(t *testing.T) {
+	assert.True(t, answersMatch([]string{"Mail.Example.com."}, []string{"mail.example.com"}))
+	assert.False(t, answersMatch(nil, []string{"mail.example.com"}))
+	assert.False(t, answersMatch([]string{"a.example.com", "b.example.com"}, []string{"a.example.com"}))
+}
+
+// gaugeValues returns the value of the single data point of each gauge.
+func gaugeValues(t *testing.T, metrics pmetric.Metrics) map[string]int64 {
+	values := map[string]int64{}
+	ms := metrics.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	for i := 0; i < ms.Len(); i++ {
+		m := ms.At(i)
+		if m.Type() != pmetric.MetricTypeGauge {
+			continue
+		}
+		require.Equal(t, 1, m.Gauge().DataPoints().Len(), m.Name())
+		values[m.Name()] = m.Gauge().DataPoints().At(0).IntValue()
+	}
+	return values
+}
@@ -0,0 +1,98 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package dockerstatsreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/dockerstatsreceiver"
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/docker"
+)
+
+const (
+	// autoEndpoint is the endpoint value requesting the detection of the socket
+	// of the local container engine.
+	autoEndpoint = "auto"
+
+	runtimeDocker = "docker"
+	runtimePodman = "podman"
+
+	// podmanComponentName is the name of the component reported by the version
+	// endpoint of Podman's Docker compatible API.
+	podmanComponentName = "Podman Engine"
+)
+
+// candidateEndpoints returns the sockets probed when the endpoint is auto-detected,
+// in order of preference: the rootful Docker daemon, a rootless Docker daemon, a
+// rootless Podman service of the current user and the rootful Podman service.
+func candidateEndpoints(getenv func(string) string) []string {
+	runtimeDir := getenv("XDG_RUNTIME_DIR")
+	if runtimeDir == "" {
+		runtimeDir = filepath.Join("/run/user", strconv.Itoa(os.Getuid()))
+	}
+	return []string{
+		"unix:///var/run/docker.sock",
+		"unix://" + filepath.Join(runtimeDir, "docker.sock"),
+		"unix://" + filepath.Join(runtimeDir, "podman", "podman.sock"),
+		"unix:///run/podman/podman.sock",
+	}
+}
+
+// detectEndpoint returns the endpoint of the local container engine: DOCKER_HOST
+// when it is set, the first existing socket of candidateEndpoints otherwise.
+func detectEndpoint(getenv func(string) string, exists func(string) bool) (string, error) {
+	if host := getenv("DOCKER_HOST"); host != "" {
+		return host, nil
+	}
+	candidates := candidateEndpoints(getenv)
+	for _, endpoint := range candidates {
+		if exists(strings.TrimPrefix(endpoint, "unix://")) {
+			return endpoint, nil
+		}
+	}
+	return "", errors.New("could not detect a Docker or Podman socket, tried DOCKER_HOST and " + strings.Join(candidates, ", "))
+}
+
+func socketExists(path string) bool {
+	info, err := os.Stat(path)
+	return err == nil && info.Mode()&os.ModeSocket != 0
+}
+
+// dockerConfig returns the configuration of the docker client, with the
+// endpoint resolved when it is auto-detected.
+func (r *metricsReceiver) dockerConfig() (*docker.Config, error) {
+	if r.config.Endpoint != autoEndpoint {
+		return &r.config.Config, nil
+	}
+	endpoint, err := detectEndpoint(os.Getenv, socketExists)
+	if err != nil {
+		return nil, err
+	}
+	r.settings.Logger.Info("Detected container engine endpoint", zap.String("endpoint", endpoint))
+	cfg := r.config.Config
+	cfg.Endpoint = endpoint
+	return &cfg, nil
+}
+
+// detectRuntime returns the container runtime serving the API, Podman's
+// compatible API is told apart by the components of the version endpoint.
+func (r *metricsReceiver) detectRuntime(ctx context.Context) string {
+	version, err := r.client.ServerVersion(ctx)
+	if err != nil {
+		r.settings.Logger.Debug("Could not fetch the server version, assuming Docker", zap.Error(err))
+		return runtimeDocker
+	}
+	for _, c := range version.Components {
+		if c.Name == podmanComponentName {
+			return runtimePodman
+		}
+	}
+	return runtimeDocker
+}
@@ -0,0 +1,71 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package dockerstatsreceiver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDetectEndpoint(t *testing.T) {
+	env := map[string]string{"XDG_RUNTIME_DIR": "/run/user/1000"}
+	getenv := func(key string) string { return env[key] }
+
+	tests := []struct {
+		name     string
+		sockets  []string
+		host     string
+		expected string
+	}{
+		{
+			name:     "docker",
+			sockets:  []string{"/var/run/docker.sock", "/run/user/1000/podman/podman.sock"},
+			expected: "unix:///var/run/docker.sock",
+		},
+		{
+			name:     "rootless docker",
+			sockets:  []string{"/run/user/1000/docker.sock"},
+			expected: "unix:///run/user/1000/docker.sock",
+		},
+		{
+			name:     "rootless podman",
+			sockets:  []string{"/run/user/1000/podman/podman.sock", "/run/podman/podman.sock"},
+			expected: "unix:///run/user/1000/podman/podman.sock",
+		},
+		{
+			name:     "rootful podman",
+			sockets:  []string{"/run/podman/podman.sock"},
+			expected: "unix:///run/podman/podman.sock",
+		},
+		{
+			name:     "DOCKER_HOST",
+			sockets:  []string{"/var/run/docker.sock"},
+			host:     "tcp://localhost:2375",
+			expected: "tcp://localhost:2375",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			env["DOCKER_HOST"] = tt.host
+			exists := func(path string) bool {
+				for _, s := range tt.sockets {
+					if s == path {
+						return true
+					}
+				}
+				return false
+			}
+			endpoint, err := detectEndpoint(getenv, exists)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, endpoint)
+		})
+	}
+
+	env["DOCKER_HOST"] = ""
+	_, err := detectEndpoint(getenv, func(string) bool { return false })
+	assert.ErrorContains(t, err, "could not detect a Docker or Podman socket")
+}
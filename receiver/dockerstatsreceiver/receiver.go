@@ -39,6 +39,7 @@ type metricsReceiver struct {
 	config   *Config
 	settings receiver.Settings
 	client   *docker.Client
+	runtime  string
 	mb       *metadata.MetricsBuilder
 	cancel   context.CancelFunc
 }
@@ -47,6 +48,7 @@ func newMetricsReceiver(set receiver.Settings, config *Config) *metricsReceiver
 	return &metricsReceiver{
 		config:   config,
 		settings: set,
+		runtime:  runtimeDocker,
 		mb:       metadata.NewMetricsBuilder(config.MetricsBuilderConfig, set),
 	}
 }
@@ -60,8 +62,11 @@ func (r *metricsReceiver) clientOptions() []client.Opt {
 }
 
 func (r *metricsReceiver) start(ctx context.Context, _ component.Host) error {
-	var err error
-	r.client, err = docker.NewDockerClient(&r.config.Config, r.settings.Logger, r.clientOptions()...)
+	dockerConfig, err := r.dockerConfig()
+	if err != nil {
+		return err
+	}
+	r.client, err = docker.NewDockerClient(dockerConfig, r.settings.Logger, r.clientOptions()...)
 	if err != nil {
 		return err
 	}
@@ -70,6 +75,7 @@ func (r *metricsReceiver) start(ctx context.Context, _ component.Host) error {
 	if err != nil {
 		return err
 	}
+	r.runtime = r.detectRuntime(ctx)
 
 	cctx, cancel := context.WithCancel(ctx)
 	r.cancel = cancel
@@ -145,7 +151,7 @@ func (r *metricsReceiver) recordContainerStats(now pcommon.Timestamp, containerS
 
 	// Always-present resource attrs + the user-configured resource attrs
 	rb := r.mb.NewResourceBuilder()
-	rb.SetContainerRuntime("docker")
+	rb.SetContainerRuntime(r.runtime)
 	rb.SetContainerHostname(container.Config.Hostname)
 	rb.SetContainerID(container.ID)
 	rb.SetContainerImageName(container.Config.Image)
@@ -367,6 +367,30 @@ func TestScrapeV2(t *testing.T) {
 	}
 }
 
+func TestScrapeV2Podman(t *testing.T) {
+	containerID := "10b703fb312b25e8368ab5a3bce3a1610d1cee5d71a94920f1a7adbc5b0cb326"
+	mockServer, err := dockerMockServer(&map[string]string{
+		"/v1.25/version":                              filepath.Join(mockFolder, "podman", "version.json"),
+		"/v1.25/containers/json":                      filepath.Join(mockFolder, "single_container", "containers.json"),
+		"/v1.25/containers/" + containerID + "/json":  filepath.Join(mockFolder, "single_container", "container.json"),
+		"/v1.25/containers/" + containerID + "/stats": filepath.Join(mockFolder, "single_container", "stats.json"),
+	})
+	require.NoError(t, err)
+	defer mockServer.Close()
+
+	receiver := newMetricsReceiver(
+		receivertest.NewNopSettings(metadata.Type), newTestConfigBuilder().withEndpoint(mockServer.URL).build())
+	require.NoError(t, receiver.start(context.Background(), componenttest.NewNopHost()))
+	defer func() { require.NoError(t, receiver.shutdown(context.Background())) }()
+
+	actualMetrics, err := receiver.scrapeV2(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 1, actualMetrics.ResourceMetrics().Len())
+	runtime, ok := actualMetrics.ResourceMetrics().At(0).Resource().Attributes().Get("container.runtime")
+	require.True(t, ok)
+	assert.Equal(t, "podman", runtime.Str())
+}
+
 func TestRecordBaseMetrics(t *testing.T) {
 	cfg := createDefaultConfig().(*Config)
 	cfg.Metrics = metadata.MetricsConfig{
@@ -4,8 +4,10 @@
 package googlecloudpubsubreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/googlecloudpubsubreceiver"
 
 import (
+	"errors"
 	"fmt"
 	"regexp"
+	"time"
 
 	"go.opentelemetry.io/collector/exporter/exporterhelper"
 )
@@ -36,6 +38,19 @@ type Config struct {
 
 	// The client id that will be used by Pubsub to make load balancing decisions
 	ClientID string `mapstructure:"client_id"`
+
+	// The deadline Pubsub waits for an acknowledgement before redelivering a message, defaults to 60 seconds.
+	AckDeadline time.Duration `mapstructure:"ack_deadline"`
+	// Limits on the messages Pubsub delivers to the receiver before they are acknowledged
+	FlowControl FlowControlConfig `mapstructure:"flow_control"`
+}
+
+// FlowControlConfig configures the server side flow control of the streaming pull.
+type FlowControlConfig struct {
+	// Maximum number of unacknowledged messages, 0 uses the Pubsub default
+	MaxOutstandingMessages int64 `mapstructure:"max_outstanding_messages"`
+	// Maximum size in bytes of the unacknowledged messages, 0 uses the Pubsub default
+	MaxOutstandingBytes int64 `mapstructure:"max_outstanding_bytes"`
 }
 
 func (config *Config) validate() error {
@@ -48,5 +63,17 @@ func (config *Config) validate() error {
 	default:
 		return fmt.Errorf("compression %v is not supported.  supported compression formats include [gzip]", config.Compression)
 	}
+	if config.AckDeadline < 10*time.Second || config.AckDeadline > 600*time.Second {
+		return fmt.Errorf("ack_deadline %v must be between 10s and 600s", config.AckDeadline)
+	}
+	if config.AckDeadline%time.Second != 0 {
+		return fmt.Errorf("ack_deadline %v must be a whole number of seconds", config.AckDeadline)
+	}
+	if config.FlowControl.MaxOutstandingMessages < 0 {
+		return errors.New("flow_control::max_outstanding_messages must not be negative")
+	}
+	if config.FlowControl.MaxOutstandingBytes < 0 {
+		return errors.New("flow_control::max_outstanding_bytes must not be negative")
+	}
 	return nil
 }
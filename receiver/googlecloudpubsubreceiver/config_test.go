@@ -30,8 +30,10 @@ func TestLoadConfig(t *testing.T) {
 		expectedErr error
 	}{
 		{
-			id:       component.NewIDWithName(metadata.Type, ""),
-			expected: &Config{},
+			id: component.NewIDWithName(metadata.Type, ""),
+			expected: &Config{
+				AckDeadline: 60 * time.Second,
+			},
 		},
 		{
 			id: component.NewIDWithName(metadata.Type, "customname"),
@@ -42,6 +44,18 @@ func TestLoadConfig(t *testing.T) {
 					Timeout: 20 * time.Second,
 				},
 				Subscription: "projects/my-project/subscriptions/otlp-subscription",
+				AckDeadline:  60 * time.Second,
+			},
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "flowcontrol"),
+			expected: &Config{
+				Subscription: "projects/my-project/subscriptions/otlp-subscription",
+				AckDeadline:  120 * time.Second,
+				FlowControl: FlowControlConfig{
+					MaxOutstandingMessages: 500,
+					MaxOutstandingBytes:    10485760,
+				},
 			},
 		},
 	}
@@ -70,4 +84,17 @@ func TestConfigValidation(t *testing.T) {
 	assert.Error(t, c.validate())
 	c.Subscription = "projects/my-project/subscriptions/my-subscription"
 	assert.NoError(t, c.validate())
+	c.AckDeadline = 5 * time.Second
+	assert.Error(t, c.validate())
+	c.AckDeadline = 601 * time.Second
+	assert.Error(t, c.validate())
+	c.AckDeadline = 30500 * time.Millisecond
+	assert.Error(t, c.validate())
+	c.AckDeadline = 30 * time.Second
+	assert.NoError(t, c.validate())
+	c.FlowControl.MaxOutstandingMessages = -1
+	assert.Error(t, c.validate())
+	c.FlowControl.MaxOutstandingMessages = 0
+	c.FlowControl.MaxOutstandingBytes = -1
+	assert.Error(t, c.validate())
 }
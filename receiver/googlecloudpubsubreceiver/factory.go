@@ -6,6 +6,7 @@ package googlecloudpubsubreceiver // import "github.com/open-telemetry/opentelem
 import (
 	"context"
 	"strings"
+	"time"
 
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/consumer"
@@ -38,7 +39,9 @@ type pubsubReceiverFactory struct {
 }
 
 func (*pubsubReceiverFactory) CreateDefaultConfig() component.Config {
-	return &Config{}
+	return &Config{
+		AckDeadline: 60 * time.Second,
+	}
 }
 
 func (factory *pubsubReceiverFactory) ensureReceiver(settings receiver.Settings, config component.Config) (*pubsubReceiver, error) {
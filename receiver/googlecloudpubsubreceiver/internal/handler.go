@@ -26,6 +26,20 @@ import (
 // Time to wait before restarting, when the stream stopped
 const streamRecoveryBackoffPeriod = 250 * time.Millisecond
 
+// Time a delivered message is remembered when exactly-once delivery is enabled, in case its
+// acknowledgement expires and Pubsub redelivers it
+const deliveredRetention = 10 * time.Minute
+
+// PullSettings are the options sent to Pubsub when opening a streaming pull.
+type PullSettings struct {
+	// Deadline Pubsub waits for an acknowledgement before redelivering a message
+	AckDeadline time.Duration
+	// Maximum number of unacknowledged messages, 0 uses the Pubsub default
+	MaxOutstandingMessages int64
+	// Maximum size in bytes of the unacknowledged messages, 0 uses the Pubsub default
+	MaxOutstandingBytes int64
+}
+
 type StreamHandler struct {
 	stream      pubsubpb.Subscriber_StreamingPullClient
 	pushMessage func(ctx context.Context, message *pubsubpb.ReceivedMessage) error
@@ -35,6 +49,17 @@ type StreamHandler struct {
 
 	clientID     string
 	subscription string
+	pullSettings PullSettings
+
+	// properties of the subscription, as reported by Pubsub on the stream
+	exactlyOnce     atomic.Bool
+	messageOrdering atomic.Bool
+	// message ids of pushed messages, whose acknowledgement is not yet confirmed, by ack id
+	pendingAcks map[string]string
+	// time the message ids were pushed into the pipeline
+	delivered map[string]time.Time
+	// ordering keys that are blocked by a message that failed, with the id of that message
+	blockedKeys map[string]string
 
 	cancel context.CancelFunc
 	// wait group for the send/receive function
@@ -62,6 +87,7 @@ func NewHandler(
 	client SubscriberClient,
 	clientID string,
 	subscription string,
+	pullSettings PullSettings,
 	callback func(ctx context.Context, message *pubsubpb.ReceivedMessage) error,
 ) (*StreamHandler, error) {
 	handler := StreamHandler{
@@ -70,8 +96,12 @@ func NewHandler(
 		client:           client,
 		clientID:         clientID,
 		subscription:     subscription,
+		pullSettings:     pullSettings,
 		pushMessage:      callback,
 		ackBatchWait:     10 * time.Second,
+		pendingAcks:      make(map[string]string),
+		delivered:        make(map[string]time.Time),
+		blockedKeys:      make(map[string]string),
 	}
 	return &handler, handler.initStream(ctx)
 }
@@ -84,11 +114,19 @@ func (handler *StreamHandler) initStream(ctx context.Context) error {
 		return err
 	}
 
+	ackDeadline := handler.pullSettings.AckDeadline
+	if ackDeadline == 0 {
+		ackDeadline = 60 * time.Second
+	}
 	request := pubsubpb.StreamingPullRequest{
 		Subscription:             handler.subscription,
-		StreamAckDeadlineSeconds: 60,
+		StreamAckDeadlineSeconds: int32(ackDeadline / time.Second),
 		ClientId:                 handler.clientID,
+		MaxOutstandingMessages:   handler.pullSettings.MaxOutstandingMessages,
+		MaxOutstandingBytes:      handler.pullSettings.MaxOutstandingBytes,
 	}
+	// Messages of a blocked ordering key are redelivered on the new stream, starting with the one that failed
+	clear(handler.blockedKeys)
 	if err := handler.stream.Send(&request); err != nil {
 		_ = handler.stream.CloseSend()
 		return err
@@ -155,6 +193,11 @@ func (handler *StreamHandler) Wait() {
 func (handler *StreamHandler) acknowledgeMessages() error {
 	handler.mutex.Lock()
 	defer handler.mutex.Unlock()
+	for id, deliveredAt := range handler.delivered {
+		if time.Since(deliveredAt) > deliveredRetention {
+			delete(handler.delivered, id)
+		}
+	}
 	if len(handler.acks) == 0 {
 		return nil
 	}
@@ -200,14 +243,14 @@ func (handler *StreamHandler) responseStream(ctx context.Context, cancel context
 		// block until the next message or timeout expires
 		resp, err := handler.stream.Recv()
 		if err == nil {
+			if properties := resp.SubscriptionProperties; properties != nil {
+				handler.exactlyOnce.Store(properties.ExactlyOnceDeliveryEnabled)
+				handler.messageOrdering.Store(properties.MessageOrderingEnabled)
+			}
+			handler.handleAcknowledgeConfirmation(resp.AcknowledgeConfirmation)
 			for _, message := range resp.ReceivedMessages {
 				// handle all the messages in the response, could be one or more
-				err = handler.pushMessage(context.Background(), message)
-				if err == nil {
-					// When sending a message though the pipeline fails, we ignore the error. We'll let Pubsub
-					// handle the flow control.
-					handler.ack(message.AckId)
-				}
+				handler.handleMessage(message)
 			}
 		} else {
 			s, grpcStatus := status.FromError(err)
@@ -242,3 +285,88 @@ func (handler *StreamHandler) responseStream(ctx context.Context, cancel context
 	handler.settings.Logger.Debug("Response Stream loop ended.")
 	handler.streamWaitGroup.Done()
 }
+
+func (handler *StreamHandler) handleMessage(message *pubsubpb.ReceivedMessage) {
+	messageID := message.GetMessage().GetMessageId()
+	orderingKey := ""
+	if handler.messageOrdering.Load() {
+		orderingKey = message.GetMessage().GetOrderingKey()
+	}
+	if failedID, blocked := handler.blockedKeys[orderingKey]; blocked && failedID != messageID {
+		// Pubsub redelivers the failed message followed by the rest of the ordering key, leave
+		// these unacknowledged so they aren't pushed out of order.
+		return
+	}
+
+	exactlyOnce := handler.exactlyOnce.Load()
+	if exactlyOnce && handler.isDelivered(messageID) {
+		// The acknowledgement of a pushed message expired, acknowledge the redelivery without
+		// pushing the message a second time.
+		handler.settings.Logger.Debug("Acknowledging redelivered message", zap.String("message_id", messageID))
+		handler.ackDelivered(message.AckId, messageID)
+		return
+	}
+
+	// When sending a message though the pipeline fails, it isn't acknowledged. We'll let Pubsub
+	// handle the redelivery.
+	if err := handler.pushMessage(context.Background(), message); err != nil {
+		if orderingKey != "" {
+			handler.blockedKeys[orderingKey] = messageID
+		}
+		return
+	}
+	if orderingKey != "" {
+		delete(handler.blockedKeys, orderingKey)
+	}
+	if exactlyOnce {
+		handler.ackDelivered(message.AckId, messageID)
+		return
+	}
+	handler.ack(message.AckId)
+}
+
+func (handler *StreamHandler) isDelivered(messageID string) bool {
+	handler.mutex.Lock()
+	defer handler.mutex.Unlock()
+	_, ok := handler.delivered[messageID]
+	return ok
+}
+
+// ackDelivered acknowledges a message and remembers it until Pubsub confirms the acknowledgement.
+func (handler *StreamHandler) ackDelivered(ackID, messageID string) {
+	handler.mutex.Lock()
+	defer handler.mutex.Unlock()
+	if _, ok := handler.delivered[messageID]; !ok {
+		handler.delivered[messageID] = time.Now()
+	}
+	handler.pendingAcks[ackID] = messageID
+	handler.acks = append(handler.acks, ackID)
+}
+
+// handleAcknowledgeConfirmation processes the result of the acknowledgements, only sent by Pubsub
+// when exactly-once delivery is enabled.
+func (handler *StreamHandler) handleAcknowledgeConfirmation(confirmation *pubsubpb.StreamingPullResponse_AcknowledgeConfirmation) {
+	if confirmation == nil {
+		return
+	}
+	handler.mutex.Lock()
+	defer handler.mutex.Unlock()
+	for _, ackID := range confirmation.AckIds {
+		delete(handler.delivered, handler.pendingAcks[ackID])
+		delete(handler.pendingAcks, ackID)
+	}
+	// The message will be redelivered, keep it as delivered so it is only acknowledged again.
+	for _, ackID := range confirmation.InvalidAckIds {
+		delete(handler.pendingAcks, ackID)
+	}
+	for _, ackID := range confirmation.UnorderedAckIds {
+		delete(handler.pendingAcks, ackID)
+	}
+	// Retry the acknowledgements that failed temporarily with the next batch.
+	handler.acks = append(handler.acks, confirmation.TemporaryFailedAckIds...)
+	if len(confirmation.InvalidAckIds) > 0 || len(confirmation.TemporaryFailedAckIds) > 0 {
+		handler.settings.Logger.Debug("Not all acknowledgements succeeded",
+			zap.Int("invalid", len(confirmation.InvalidAckIds)),
+			zap.Int("temporary_failed", len(confirmation.TemporaryFailedAckIds)))
+	}
+}
@@ -5,13 +5,16 @@ package internal
 
 import (
 	"context"
+	"errors"
 	"testing"
 	"time"
 
 	pubsub "cloud.google.com/go/pubsub/apiv1"
 	"cloud.google.com/go/pubsub/apiv1/pubsubpb"
 	"cloud.google.com/go/pubsub/pstest"
+	"github.com/googleapis/gax-go/v2"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/receiver/receivertest"
 	"google.golang.org/api/option"
 	"google.golang.org/grpc"
@@ -49,7 +52,7 @@ func TestCancelStream(t *testing.T) {
 	client, err := pubsub.NewSubscriberClient(ctx, copts...)
 	assert.NoError(t, err)
 
-	handler, err := NewHandler(ctx, settings, telemetryBuilder, client, "client-id", "projects/my-project/subscriptions/otlp",
+	handler, err := NewHandler(ctx, settings, telemetryBuilder, client, "client-id", "projects/my-project/subscriptions/otlp", PullSettings{},
 		func(context.Context, *pubsubpb.ReceivedMessage) error {
 			return nil
 		})
@@ -66,3 +69,151 @@ func TestCancelStream(t *testing.T) {
 	}()
 	handler.Wait()
 }
+
+type fakeStreamingPullClient struct {
+	pubsubpb.Subscriber_StreamingPullClient
+	requests []*pubsubpb.StreamingPullRequest
+}
+
+func (f *fakeStreamingPullClient) Send(request *pubsubpb.StreamingPullRequest) error {
+	f.requests = append(f.requests, request)
+	return nil
+}
+
+type fakeSubscriberClient struct {
+	stream *fakeStreamingPullClient
+}
+
+func (fakeSubscriberClient) Close() error {
+	return nil
+}
+
+func (f fakeSubscriberClient) StreamingPull(context.Context, ...gax.CallOption) (pubsubpb.Subscriber_StreamingPullClient, error) {
+	return f.stream, nil
+}
+
+func newTestHandler(t *testing.T, pullSettings PullSettings, callback func(context.Context, *pubsubpb.ReceivedMessage) error) (*StreamHandler, *fakeStreamingPullClient) {
+	settings := receivertest.NewNopSettings(metadata.Type)
+	telemetryBuilder, err := metadata.NewTelemetryBuilder(settings.TelemetrySettings)
+	require.NoError(t, err)
+	stream := &fakeStreamingPullClient{}
+	handler, err := NewHandler(context.Background(), settings, telemetryBuilder, fakeSubscriberClient{stream: stream},
+		"client-id", "projects/my-project/subscriptions/otlp", pullSettings, callback)
+	require.NoError(t, err)
+	return handler, stream
+}
+
+func receivedMessage(ackID, messageID, orderingKey string) *pubsubpb.ReceivedMessage {
+	return &pubsubpb.ReceivedMessage{
+		AckId: ackID,
+		Message: &pubsubpb.PubsubMessage{
+			MessageId:   messageID,
+			OrderingKey: orderingKey,
+		},
+	}
+}
+
+func TestInitStreamPullSettings(t *testing.T) {
+	_, stream := newTestHandler(t, PullSettings{
+		AckDeadline:            120 * time.Second,
+		MaxOutstandingMessages: 500,
+		MaxOutstandingBytes:    1 << 20,
+	}, func(context.Context, *pubsubpb.ReceivedMessage) error {
+		return nil
+	})
+	require.Len(t, stream.requests, 1)
+	request := stream.requests[0]
+	assert.Equal(t, "projects/my-project/subscriptions/otlp", request.Subscription)
+	assert.Equal(t, "client-id", request.ClientId)
+	assert.Equal(t, int32(120), request.StreamAckDeadlineSeconds)
+	assert.Equal(t, int64(500), request.MaxOutstandingMessages)
+	assert.Equal(t, int64(1<<20), request.MaxOutstandingBytes)
+}
+
+func TestHandleMessageFailureNotAcknowledged(t *testing.T) {
+	handler, _ := newTestHandler(t, PullSettings{}, func(_ context.Context, message *pubsubpb.ReceivedMessage) error {
+		if message.Message.MessageId == "1" {
+			return errors.New("pipeline refused")
+		}
+		return nil
+	})
+	handler.handleMessage(receivedMessage("ack-1", "1", ""))
+	handler.handleMessage(receivedMessage("ack-2", "2", ""))
+	assert.Equal(t, []string{"ack-2"}, handler.acks)
+}
+
+func TestHandleMessageOrderingKey(t *testing.T) {
+	failing := true
+	var pushed []string
+	handler, _ := newTestHandler(t, PullSettings{}, func(_ context.Context, message *pubsubpb.ReceivedMessage) error {
+		if message.Message.MessageId == "1" && failing {
+			return errors.New("pipeline refused")
+		}
+		pushed = append(pushed, message.Message.MessageId)
+		return nil
+	})
+	handler.messageOrdering.Store(true)
+
+	handler.handleMessage(receivedMessage("ack-1", "1", "key"))
+	handler.handleMessage(receivedMessage("ack-2", "2", "key"))
+	handler.handleMessage(receivedMessage("ack-3", "3", "other"))
+	assert.Equal(t, []string{"3"}, pushed)
+	assert.Equal(t, []string{"ack-3"}, handler.acks)
+
+	// the redelivery of the failed message unblocks the ordering key
+	failing = false
+	handler.handleMessage(receivedMessage("ack-4", "1", "key"))
+	handler.handleMessage(receivedMessage("ack-5", "2", "key"))
+	assert.Equal(t, []string{"3", "1", "2"}, pushed)
+	assert.Equal(t, []string{"ack-3", "ack-4", "ack-5"}, handler.acks)
+	assert.Empty(t, handler.blockedKeys)
+}
+
+func TestHandleMessageOrderingDisabled(t *testing.T) {
+	var pushed []string
+	handler, _ := newTestHandler(t, PullSettings{}, func(_ context.Context, message *pubsubpb.ReceivedMessage) error {
+		if message.Message.MessageId == "1" {
+			return errors.New("pipeline refused")
+		}
+		pushed = append(pushed, message.Message.MessageId)
+		return nil
+	})
+	handler.handleMessage(receivedMessage("ack-1", "1", "key"))
+	handler.handleMessage(receivedMessage("ack-2", "2", "key"))
+	assert.Equal(t, []string{"2"}, pushed)
+}
+
+func TestHandleMessageExactlyOnce(t *testing.T) {
+	var pushed []string
+	handler, stream := newTestHandler(t, PullSettings{}, func(_ context.Context, message *pubsubpb.ReceivedMessage) error {
+		pushed = append(pushed, message.Message.MessageId)
+		return nil
+	})
+	handler.exactlyOnce.Store(true)
+
+	handler.handleMessage(receivedMessage("ack-1", "1", ""))
+	handler.handleMessage(receivedMessage("ack-2", "2", ""))
+	require.NoError(t, handler.acknowledgeMessages())
+	assert.Equal(t, []string{"ack-1", "ack-2"}, stream.requests[len(stream.requests)-1].AckIds)
+
+	handler.handleAcknowledgeConfirmation(&pubsubpb.StreamingPullResponse_AcknowledgeConfirmation{
+		AckIds:                []string{"ack-1"},
+		TemporaryFailedAckIds: []string{"ack-2"},
+	})
+	assert.Equal(t, []string{"ack-2"}, handler.acks)
+	assert.NotContains(t, handler.delivered, "1")
+
+	handler.handleAcknowledgeConfirmation(&pubsubpb.StreamingPullResponse_AcknowledgeConfirmation{
+		InvalidAckIds: []string{"ack-2"},
+	})
+	// the acknowledgement expired, the redelivery is acknowledged without pushing it again
+	handler.handleMessage(receivedMessage("ack-3", "2", ""))
+	assert.Equal(t, []string{"1", "2"}, pushed)
+	assert.Equal(t, []string{"ack-2", "ack-3"}, handler.acks)
+
+	handler.handleAcknowledgeConfirmation(&pubsubpb.StreamingPullResponse_AcknowledgeConfirmation{
+		AckIds: []string{"ack-2", "ack-3"},
+	})
+	assert.Empty(t, handler.delivered)
+	assert.Empty(t, handler.pendingAcks)
+}
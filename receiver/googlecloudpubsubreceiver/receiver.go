@@ -16,6 +16,7 @@ import (
 	"cloud.google.com/go/pubsub/apiv1/pubsubpb"
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/consumer/consumererror"
 	"go.opentelemetry.io/collector/pdata/plog"
 	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/pdata/ptrace"
@@ -242,7 +243,7 @@ func (receiver *pubsubReceiver) handleTrace(ctx context.Context, payload []byte,
 	ctx = receiver.obsrecv.StartTracesOp(ctx)
 	err = receiver.tracesConsumer.ConsumeTraces(ctx, otlpData)
 	receiver.obsrecv.EndTracesOp(ctx, reportFormatProtobuf, count, err)
-	return nil
+	return consumerError(err)
 }
 
 func (receiver *pubsubReceiver) handleMetric(ctx context.Context, payload []byte, compression buildInCompression) error {
@@ -262,7 +263,7 @@ func (receiver *pubsubReceiver) handleMetric(ctx context.Context, payload []byte
 	ctx = receiver.obsrecv.StartMetricsOp(ctx)
 	err = receiver.metricsConsumer.ConsumeMetrics(ctx, otlpData)
 	receiver.obsrecv.EndMetricsOp(ctx, reportFormatProtobuf, count, err)
-	return nil
+	return consumerError(err)
 }
 
 func (receiver *pubsubReceiver) handleLog(ctx context.Context, payload []byte, compression buildInCompression) error {
@@ -282,7 +283,16 @@ func (receiver *pubsubReceiver) handleLog(ctx context.Context, payload []byte, c
 	ctx = receiver.obsrecv.StartLogsOp(ctx)
 	err = receiver.logsConsumer.ConsumeLogs(ctx, otlpData)
 	receiver.obsrecv.EndLogsOp(ctx, reportFormatProtobuf, count, err)
-	return nil
+	return consumerError(err)
+}
+
+// consumerError returns the errors of the pipeline for which the message should be redelivered,
+// messages refused with a permanent error are acknowledged and dropped.
+func consumerError(err error) error {
+	if consumererror.IsPermanent(err) {
+		return nil
+	}
+	return err
 }
 
 func (receiver *pubsubReceiver) increaseEncodingErrorMetric(ctx context.Context, signal string) {
@@ -346,6 +356,14 @@ func convertEncoding(encodingConfig string) (encoding buildInEncoding) {
 	return unknown
 }
 
+func (receiver *pubsubReceiver) pullSettings() internal.PullSettings {
+	return internal.PullSettings{
+		AckDeadline:            receiver.config.AckDeadline,
+		MaxOutstandingMessages: receiver.config.FlowControl.MaxOutstandingMessages,
+		MaxOutstandingBytes:    receiver.config.FlowControl.MaxOutstandingBytes,
+	}
+}
+
 func (receiver *pubsubReceiver) createMultiplexingReceiverHandler(ctx context.Context) error {
 	var err error
 	receiver.handler, err = internal.NewHandler(
@@ -355,6 +373,7 @@ func (receiver *pubsubReceiver) createMultiplexingReceiverHandler(ctx context.Co
 		receiver.client,
 		receiver.config.ClientID,
 		receiver.config.Subscription,
+		receiver.pullSettings(),
 		func(ctx context.Context, message *pubsubpb.ReceivedMessage) error {
 			payload := message.Message.Data
 			encoding, compression := receiver.detectEncoding(message.Message.Attributes)
@@ -414,6 +433,7 @@ func (receiver *pubsubReceiver) createReceiverHandler(ctx context.Context) error
 		receiver.client,
 		receiver.config.ClientID,
 		receiver.config.Subscription,
+		receiver.pullSettings(),
 		handlerFn)
 	if err != nil {
 		return err
@@ -5,6 +5,7 @@ package googlecloudpubsubreceiver
 
 import (
 	"context"
+	"errors"
 	"testing"
 	"time"
 
@@ -13,6 +14,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer/consumererror"
 	"go.opentelemetry.io/collector/consumer/consumertest"
 	"go.opentelemetry.io/collector/exporter/exporterhelper"
 	"go.opentelemetry.io/collector/pdata/plog"
@@ -174,6 +176,30 @@ func TestReceiver(t *testing.T) {
 	assert.NoError(t, receiver.Shutdown(ctx))
 }
 
+func TestHandleConsumerError(t *testing.T) {
+	ctx := context.Background()
+	srv, receiver := createBaseReceiver()
+	defer func() {
+		assert.NoError(t, srv.Close())
+	}()
+	obsrecv, err := receiverhelper.NewObsReport(receiverhelper.ObsReportSettings{
+		ReceiverID:             component.NewID(metadata.Type),
+		Transport:              reportTransport,
+		ReceiverCreateSettings: receiver.settings,
+	})
+	require.NoError(t, err)
+	receiver.obsrecv = obsrecv
+	receiver.logsUnmarshaler = &plog.ProtoUnmarshaler{}
+
+	// a retryable error is returned, so the message isn't acknowledged and will be redelivered
+	receiver.logsConsumer = consumertest.NewErr(errors.New("pipeline refused"))
+	assert.Error(t, receiver.handleLog(ctx, testdata.CreateLogExport(), uncompressed))
+
+	// a permanent error acknowledges the message, as redelivering it won't help
+	receiver.logsConsumer = consumertest.NewErr(consumererror.NewPermanent(errors.New("bad data")))
+	assert.NoError(t, receiver.handleLog(ctx, testdata.CreateLogExport(), uncompressed))
+}
+
 func TestEncodingMultipleConsumersForAnEncoding(t *testing.T) {
 	ctx := context.Background()
 	srv, receiver := createBaseReceiver()
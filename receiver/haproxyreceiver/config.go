@@ -5,6 +5,7 @@ package haproxyreceiver // import "github.com/open-telemetry/opentelemetry-colle
 
 import (
 	"errors"
+	"fmt"
 
 	"go.opentelemetry.io/collector/config/confighttp"
 	"go.opentelemetry.io/collector/scraper/scraperhelper"
@@ -12,15 +13,40 @@ import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/haproxyreceiver/internal/metadata"
 )
 
+const (
+	// formatCSV reads the CSV output of the stats page or of the `show stat` command.
+	formatCSV = "csv"
+	// formatTyped reads the output of the `show stat typed` command of the runtime API.
+	formatTyped = "typed"
+	// formatPrometheus reads the built-in Prometheus exporter of HAProxy.
+	formatPrometheus = "prometheus"
+)
+
 type Config struct {
 	confighttp.ClientConfig        `mapstructure:",squash"`
 	scraperhelper.ControllerConfig `mapstructure:",squash"`
 	metadata.MetricsBuilderConfig  `mapstructure:",squash"`
+
+	// Format is the format of the stats read from the endpoint: csv, typed or prometheus.
+	Format string `mapstructure:"format"`
 }
 
 func (c Config) Validate() error {
 	if c.Endpoint == "" {
 		return errors.New("'endpoint' cannot be empty")
 	}
+	switch c.Format {
+	case "", formatCSV:
+	case formatTyped:
+		if isHTTPEndpoint(c.Endpoint) {
+			return errors.New("the 'typed' format requires the endpoint of the runtime API socket")
+		}
+	case formatPrometheus:
+		if !isHTTPEndpoint(c.Endpoint) {
+			return errors.New("the 'prometheus' format requires an HTTP endpoint")
+		}
+	default:
+		return fmt.Errorf("invalid format %q, must be one of %q, %q or %q", c.Format, formatCSV, formatTyped, formatPrometheus)
+	}
 	return nil
 }
@@ -0,0 +1,41 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package haproxyreceiver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name     string
+		endpoint string
+		format   string
+		err      string
+	}{
+		{name: "missing endpoint", err: "'endpoint' cannot be empty"},
+		{name: "csv over http", endpoint: "http://localhost:8404/stats", format: formatCSV},
+		{name: "csv over socket", endpoint: "/var/run/haproxy.sock", format: formatCSV},
+		{name: "typed over socket", endpoint: "/var/run/haproxy.sock", format: formatTyped},
+		{name: "typed over http", endpoint: "http://localhost:8404/stats", format: formatTyped, err: "the 'typed' format requires the endpoint of the runtime API socket"},
+		{name: "prometheus over http", endpoint: "http://localhost:8405/metrics", format: formatPrometheus},
+		{name: "prometheus over socket", endpoint: "/var/run/haproxy.sock", format: formatPrometheus, err: "the 'prometheus' format requires an HTTP endpoint"},
+		{name: "invalid format", endpoint: "/var/run/haproxy.sock", format: "json", err: `invalid format "json", must be one of "csv", "typed" or "prometheus"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := newDefaultConfig().(*Config)
+			cfg.Endpoint = tt.endpoint
+			cfg.Format = tt.format
+			err := cfg.Validate()
+			if tt.err == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.err)
+			}
+		})
+	}
+}
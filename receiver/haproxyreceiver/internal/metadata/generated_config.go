@@ -54,6 +54,9 @@ type MetricsConfig struct {
 	HaproxySessionsCount        MetricConfig `mapstructure:"haproxy.sessions.count"`
 	HaproxySessionsRate         MetricConfig `mapstructure:"haproxy.sessions.rate"`
 	HaproxySessionsTotal        MetricConfig `mapstructure:"haproxy.sessions.total"`
+	HaproxyStatus               MetricConfig `mapstructure:"haproxy.status"`
+	HaproxyStatusLastChange     MetricConfig `mapstructure:"haproxy.status.last_change"`
+	HaproxyStatusTransitions    MetricConfig `mapstructure:"haproxy.status.transitions"`
 }
 
 func DefaultMetricsConfig() MetricsConfig {
@@ -136,6 +139,15 @@ func DefaultMetricsConfig() MetricsConfig {
 		HaproxySessionsTotal: MetricConfig{
 			Enabled: false,
 		},
+		HaproxyStatus: MetricConfig{
+			Enabled: false,
+		},
+		HaproxyStatusLastChange: MetricConfig{
+			Enabled: false,
+		},
+		HaproxyStatusTransitions: MetricConfig{
+			Enabled: false,
+		},
 	}
 }
 
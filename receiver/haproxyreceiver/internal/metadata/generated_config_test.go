@@ -53,6 +53,9 @@ func TestMetricsBuilderConfig(t *testing.T) {
 					HaproxySessionsCount:        MetricConfig{Enabled: true},
 					HaproxySessionsRate:         MetricConfig{Enabled: true},
 					HaproxySessionsTotal:        MetricConfig{Enabled: true},
+					HaproxyStatus:               MetricConfig{Enabled: true},
+					HaproxyStatusLastChange:     MetricConfig{Enabled: true},
+					HaproxyStatusTransitions:    MetricConfig{Enabled: true},
 				},
 				ResourceAttributes: ResourceAttributesConfig{
 					HaproxyAddr:        ResourceAttributeConfig{Enabled: true},
@@ -91,6 +94,9 @@ func TestMetricsBuilderConfig(t *testing.T) {
 					HaproxySessionsCount:        MetricConfig{Enabled: false},
 					HaproxySessionsRate:         MetricConfig{Enabled: false},
 					HaproxySessionsTotal:        MetricConfig{Enabled: false},
+					HaproxyStatus:               MetricConfig{Enabled: false},
+					HaproxyStatusLastChange:     MetricConfig{Enabled: false},
+					HaproxyStatusTransitions:    MetricConfig{Enabled: false},
 				},
 				ResourceAttributes: ResourceAttributesConfig{
 					HaproxyAddr:        ResourceAttributeConfig{Enabled: false},
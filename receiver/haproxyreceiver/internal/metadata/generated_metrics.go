@@ -14,6 +14,52 @@ import (
 	"go.opentelemetry.io/collector/receiver"
 )
 
+// AttributeState specifies the value state attribute.
+type AttributeState int
+
+const (
+	_ AttributeState = iota
+	AttributeStateUp
+	AttributeStateDown
+	AttributeStateMaint
+	AttributeStateDrain
+	AttributeStateNolb
+	AttributeStateNoCheck
+	AttributeStateOpen
+)
+
+// String returns the string representation of the AttributeState.
+func (av AttributeState) String() string {
+	switch av {
+	case AttributeStateUp:
+		return "up"
+	case AttributeStateDown:
+		return "down"
+	case AttributeStateMaint:
+		return "maint"
+	case AttributeStateDrain:
+		return "drain"
+	case AttributeStateNolb:
+		return "nolb"
+	case AttributeStateNoCheck:
+		return "no_check"
+	case AttributeStateOpen:
+		return "open"
+	}
+	return ""
+}
+
+// MapAttributeState is a helper map of string to AttributeState attribute value.
+var MapAttributeState = map[string]AttributeState{
+	"up":       AttributeStateUp,
+	"down":     AttributeStateDown,
+	"maint":    AttributeStateMaint,
+	"drain":    AttributeStateDrain,
+	"nolb":     AttributeStateNolb,
+	"no_check": AttributeStateNoCheck,
+	"open":     AttributeStateOpen,
+}
+
 // AttributeStatusCode specifies the value status_code attribute.
 type AttributeStatusCode int
 
@@ -135,6 +181,15 @@ var MetricsInfo = metricsInfo{
 	HaproxySessionsTotal: metricInfo{
 		Name: "haproxy.sessions.total",
 	},
+	HaproxyStatus: metricInfo{
+		Name: "haproxy.status",
+	},
+	HaproxyStatusLastChange: metricInfo{
+		Name: "haproxy.status.last_change",
+	},
+	HaproxyStatusTransitions: metricInfo{
+		Name: "haproxy.status.transitions",
+	},
 }
 
 type metricsInfo struct {
@@ -164,6 +219,9 @@ type metricsInfo struct {
 	HaproxySessionsCount        metricInfo
 	HaproxySessionsRate         metricInfo
 	HaproxySessionsTotal        metricInfo
+	HaproxyStatus               metricInfo
+	HaproxyStatusLastChange     metricInfo
+	HaproxyStatusTransitions    metricInfo
 }
 
 type metricInfo struct {
@@ -1488,6 +1546,157 @@ func newMetricHaproxySessionsTotal(cfg MetricConfig) metricHaproxySessionsTotal
 	return m
 }
 
+type metricHaproxyStatus struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills haproxy.status metric with initial data.
+func (m *metricHaproxyStatus) init() {
+	m.data.SetName("haproxy.status")
+	m.data.SetDescription("Whether the proxy or server is in the given state (1) or not (0). Corresponds to HAProxy's `status` metric.")
+	m.data.SetUnit("1")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricHaproxyStatus) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, stateAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("state", stateAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricHaproxyStatus) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricHaproxyStatus) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricHaproxyStatus(cfg MetricConfig) metricHaproxyStatus {
+	m := metricHaproxyStatus{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricHaproxyStatusLastChange struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills haproxy.status.last_change metric with initial data.
+func (m *metricHaproxyStatusLastChange) init() {
+	m.data.SetName("haproxy.status.last_change")
+	m.data.SetDescription("Time elapsed since the last UP to DOWN or DOWN to UP transition of the backend or server. Corresponds to HAProxy's `lastchg` metric.")
+	m.data.SetUnit("s")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricHaproxyStatusLastChange) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricHaproxyStatusLastChange) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricHaproxyStatusLastChange) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricHaproxyStatusLastChange(cfg MetricConfig) metricHaproxyStatusLastChange {
+	m := metricHaproxyStatusLastChange{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricHaproxyStatusTransitions struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills haproxy.status.transitions metric with initial data.
+func (m *metricHaproxyStatusTransitions) init() {
+	m.data.SetName("haproxy.status.transitions")
+	m.data.SetDescription("Number of UP to DOWN transitions of the backend or server. Corresponds to HAProxy's `chkdown` metric.")
+	m.data.SetUnit("{transitions}")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+}
+
+func (m *metricHaproxyStatusTransitions) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricHaproxyStatusTransitions) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricHaproxyStatusTransitions) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricHaproxyStatusTransitions(cfg MetricConfig) metricHaproxyStatusTransitions {
+	m := metricHaproxyStatusTransitions{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 // MetricsBuilder provides an interface for scrapers to report metrics while taking care of all the transformations
 // required to produce metric representation defined in metadata and user config.
 type MetricsBuilder struct {
@@ -1524,6 +1733,9 @@ type MetricsBuilder struct {
 	metricHaproxySessionsCount        metricHaproxySessionsCount
 	metricHaproxySessionsRate         metricHaproxySessionsRate
 	metricHaproxySessionsTotal        metricHaproxySessionsTotal
+	metricHaproxyStatus               metricHaproxyStatus
+	metricHaproxyStatusLastChange     metricHaproxyStatusLastChange
+	metricHaproxyStatusTransitions    metricHaproxyStatusTransitions
 }
 
 // MetricBuilderOption applies changes to default metrics builder.
@@ -1575,6 +1787,9 @@ func NewMetricsBuilder(mbc MetricsBuilderConfig, settings receiver.Settings, opt
 		metricHaproxySessionsCount:        newMetricHaproxySessionsCount(mbc.Metrics.HaproxySessionsCount),
 		metricHaproxySessionsRate:         newMetricHaproxySessionsRate(mbc.Metrics.HaproxySessionsRate),
 		metricHaproxySessionsTotal:        newMetricHaproxySessionsTotal(mbc.Metrics.HaproxySessionsTotal),
+		metricHaproxyStatus:               newMetricHaproxyStatus(mbc.Metrics.HaproxyStatus),
+		metricHaproxyStatusLastChange:     newMetricHaproxyStatusLastChange(mbc.Metrics.HaproxyStatusLastChange),
+		metricHaproxyStatusTransitions:    newMetricHaproxyStatusTransitions(mbc.Metrics.HaproxyStatusTransitions),
 		resourceAttributeIncludeFilter:    make(map[string]filter.Filter),
 		resourceAttributeExcludeFilter:    make(map[string]filter.Filter),
 	}
@@ -1691,6 +1906,9 @@ func (mb *MetricsBuilder) EmitForResource(options ...ResourceMetricsOption) {
 	mb.metricHaproxySessionsCount.emit(ils.Metrics())
 	mb.metricHaproxySessionsRate.emit(ils.Metrics())
 	mb.metricHaproxySessionsTotal.emit(ils.Metrics())
+	mb.metricHaproxyStatus.emit(ils.Metrics())
+	mb.metricHaproxyStatusLastChange.emit(ils.Metrics())
+	mb.metricHaproxyStatusTransitions.emit(ils.Metrics())
 
 	for _, op := range options {
 		op.apply(rm)
@@ -1927,6 +2145,21 @@ func (mb *MetricsBuilder) RecordHaproxyResponsesErrorsDataPoint(ts pcommon.Times
 	mb.metricHaproxyResponsesErrors.recordDataPoint(mb.startTime, ts, val)
 }
 
+// RecordHaproxyStatusDataPoint adds a data point to haproxy.status metric.
+func (mb *MetricsBuilder) RecordHaproxyStatusDataPoint(ts pcommon.Timestamp, val int64, stateAttributeValue AttributeState) {
+	mb.metricHaproxyStatus.recordDataPoint(mb.startTime, ts, val, stateAttributeValue.String())
+}
+
+// RecordHaproxyStatusLastChangeDataPoint adds a data point to haproxy.status.last_change metric.
+func (mb *MetricsBuilder) RecordHaproxyStatusLastChangeDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricHaproxyStatusLastChange.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordHaproxyStatusTransitionsDataPoint adds a data point to haproxy.status.transitions metric.
+func (mb *MetricsBuilder) RecordHaproxyStatusTransitionsDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricHaproxyStatusTransitions.recordDataPoint(mb.startTime, ts, val)
+}
+
 // RecordHaproxyServerSelectedTotalDataPoint adds a data point to haproxy.server_selected.total metric.
 func (mb *MetricsBuilder) RecordHaproxyServerSelectedTotalDataPoint(ts pcommon.Timestamp, inputVal string) error {
 	val, err := strconv.ParseInt(inputVal, 10, 64)
@@ -163,6 +163,15 @@ func TestMetricsBuilder(t *testing.T) {
 			allMetricsCount++
 			mb.RecordHaproxySessionsTotalDataPoint(ts, "1")
 
+			allMetricsCount++
+			mb.RecordHaproxyStatusDataPoint(ts, 1, AttributeStateUp)
+
+			allMetricsCount++
+			mb.RecordHaproxyStatusLastChangeDataPoint(ts, 1)
+
+			allMetricsCount++
+			mb.RecordHaproxyStatusTransitionsDataPoint(ts, 1)
+
 			rb := mb.NewResourceBuilder()
 			rb.SetHaproxyAddr("haproxy.addr-val")
 			rb.SetHaproxyProxyName("haproxy.proxy_name-val")
@@ -546,6 +555,47 @@ func TestMetricsBuilder(t *testing.T) {
 					assert.Equal(t, ts, dp.Timestamp())
 					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
 					assert.Equal(t, int64(1), dp.IntValue())
+				case "haproxy.status":
+					assert.False(t, validatedMetrics["haproxy.status"], "Found a duplicate in the metrics slice: haproxy.status")
+					validatedMetrics["haproxy.status"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Whether the proxy or server is in the given state (1) or not (0). Corresponds to HAProxy's `status` metric.", ms.At(i).Description())
+					assert.Equal(t, "1", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("state")
+					assert.True(t, ok)
+					assert.Equal(t, "up", attrVal.Str())
+				case "haproxy.status.last_change":
+					assert.False(t, validatedMetrics["haproxy.status.last_change"], "Found a duplicate in the metrics slice: haproxy.status.last_change")
+					validatedMetrics["haproxy.status.last_change"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Time elapsed since the last UP to DOWN or DOWN to UP transition of the backend or server. Corresponds to HAProxy's `lastchg` metric.", ms.At(i).Description())
+					assert.Equal(t, "s", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+				case "haproxy.status.transitions":
+					assert.False(t, validatedMetrics["haproxy.status.transitions"], "Found a duplicate in the metrics slice: haproxy.status.transitions")
+					validatedMetrics["haproxy.status.transitions"] = true
+					assert.Equal(t, pmetric.MetricTypeSum, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Sum().DataPoints().Len())
+					assert.Equal(t, "Number of UP to DOWN transitions of the backend or server. Corresponds to HAProxy's `chkdown` metric.", ms.At(i).Description())
+					assert.Equal(t, "{transitions}", ms.At(i).Unit())
+					assert.True(t, ms.At(i).Sum().IsMonotonic())
+					assert.Equal(t, pmetric.AggregationTemporalityCumulative, ms.At(i).Sum().AggregationTemporality())
+					dp := ms.At(i).Sum().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
 				}
 			}
 		})
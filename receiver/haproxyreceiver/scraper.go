@@ -11,7 +11,6 @@ import (
 	"io"
 	"net"
 	"net/http"
-	"net/url"
 	"strconv"
 	"strings"
 	"time"
@@ -27,7 +26,22 @@ import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/haproxyreceiver/internal/metadata"
 )
 
-var showStatsCommand = []byte("show stat\n")
+var (
+	showStatsCommand      = []byte("show stat\n")
+	showStatsTypedCommand = []byte("show stat typed\n")
+)
+
+// states are the values of the state attribute of haproxy.status, one data point
+// is recorded for each of them.
+var states = []metadata.AttributeState{
+	metadata.AttributeStateUp,
+	metadata.AttributeStateDown,
+	metadata.AttributeStateMaint,
+	metadata.AttributeStateDrain,
+	metadata.AttributeStateNolb,
+	metadata.AttributeStateNoCheck,
+	metadata.AttributeStateOpen,
+}
 
 type haproxyScraper struct {
 	cfg               *Config
@@ -38,42 +52,28 @@ type haproxyScraper struct {
 }
 
 func (s *haproxyScraper) scrape(ctx context.Context) (pmetric.Metrics, error) {
-	var records []map[string]string
-	if u, notURLerr := url.Parse(s.cfg.Endpoint); notURLerr == nil && strings.HasPrefix(u.Scheme, "http") {
-		resp, err := s.httpClient.Get(s.cfg.Endpoint + ";csv")
-		if err != nil {
-			return pmetric.NewMetrics(), err
-		}
-		defer resp.Body.Close()
-		buf, err := io.ReadAll(resp.Body)
-		if err != nil {
-			return pmetric.NewMetrics(), err
-		}
-		records, err = s.readStats(buf)
-		if err != nil {
-			return pmetric.NewMetrics(), err
-		}
+	var buf []byte
+	var err error
+	if isHTTPEndpoint(s.cfg.Endpoint) {
+		buf, err = s.fetchHTTP(ctx)
 	} else {
-		var d net.Dialer
-		c, err := d.DialContext(ctx, "unix", s.cfg.Endpoint)
-		if err != nil {
-			return pmetric.NewMetrics(), err
-		}
-		defer func(c net.Conn) {
-			_ = c.Close()
-		}(c)
-		_, err = c.Write(showStatsCommand)
-		if err != nil {
-			return pmetric.NewMetrics(), err
-		}
-		buf, err := io.ReadAll(c)
-		if err != nil {
-			return pmetric.NewMetrics(), err
-		}
+		buf, err = s.fetchSocket(ctx)
+	}
+	if err != nil {
+		return pmetric.NewMetrics(), err
+	}
+
+	var records []map[string]string
+	switch s.cfg.Format {
+	case formatTyped:
+		records, err = readTypedStats(buf)
+	case formatPrometheus:
+		records, err = readPrometheusStats(buf)
+	default:
 		records, err = s.readStats(buf)
-		if err != nil {
-			return pmetric.NewMetrics(), fmt.Errorf("error reading stats: %w", err)
-		}
+	}
+	if err != nil {
+		return pmetric.NewMetrics(), fmt.Errorf("error reading stats: %w", err)
 	}
 
 	var scrapeErrors []error
@@ -243,6 +243,31 @@ func (s *haproxyScraper) scrape(ctx context.Context) (pmetric.Metrics, error) {
 				scrapeErrors = append(scrapeErrors, err)
 			}
 		}
+		if record["status"] != "" {
+			if state, ok := parseState(record["status"]); ok {
+				for _, st := range states {
+					var val int64
+					if st == state {
+						val = 1
+					}
+					s.mb.RecordHaproxyStatusDataPoint(now, val, st)
+				}
+			}
+		}
+		if record["chkdown"] != "" {
+			if val, err := strconv.ParseInt(record["chkdown"], 10, 64); err != nil {
+				scrapeErrors = append(scrapeErrors, fmt.Errorf("failed to parse int64 for HaproxyStatusTransitions, value was %s: %w", record["chkdown"], err))
+			} else {
+				s.mb.RecordHaproxyStatusTransitionsDataPoint(now, val)
+			}
+		}
+		if record["lastchg"] != "" {
+			if val, err := strconv.ParseInt(record["lastchg"], 10, 64); err != nil {
+				scrapeErrors = append(scrapeErrors, fmt.Errorf("failed to parse int64 for HaproxyStatusLastChange, value was %s: %w", record["lastchg"], err))
+			} else {
+				s.mb.RecordHaproxyStatusLastChangeDataPoint(now, val)
+			}
+		}
 		rb := s.mb.NewResourceBuilder()
 		rb.SetHaproxyProxyName(record["pxname"])
 		rb.SetHaproxyServiceName(record["svname"])
@@ -280,6 +305,47 @@ func (*haproxyScraper) readStats(buf []byte) ([]map[string]string, error) {
 	return results, err
 }
 
+// fetchHTTP reads the stats from the stats page or from the Prometheus exporter.
+func (s *haproxyScraper) fetchHTTP(ctx context.Context) ([]byte, error) {
+	endpoint := s.cfg.Endpoint
+	if s.cfg.Format != formatPrometheus {
+		endpoint += ";csv"
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, http.NoBody)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("expected 200 response, got %d", resp.StatusCode)
+	}
+	return io.ReadAll(resp.Body)
+}
+
+// fetchSocket reads the stats from the runtime API socket.
+func (s *haproxyScraper) fetchSocket(ctx context.Context) ([]byte, error) {
+	var d net.Dialer
+	c, err := d.DialContext(ctx, "unix", s.cfg.Endpoint)
+	if err != nil {
+		return nil, err
+	}
+	defer func(c net.Conn) {
+		_ = c.Close()
+	}(c)
+	command := showStatsCommand
+	if s.cfg.Format == formatTyped {
+		command = showStatsTypedCommand
+	}
+	if _, err = c.Write(command); err != nil {
+		return nil, err
+	}
+	return io.ReadAll(c)
+}
+
 func (s *haproxyScraper) start(ctx context.Context, host component.Host) error {
 	var err error
 	s.httpClient, err = s.cfg.ToClient(ctx, host, s.telemetrySettings)
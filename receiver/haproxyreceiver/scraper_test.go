@@ -7,12 +7,16 @@ import (
 	"context"
 	"fmt"
 	"net"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/receiver/receivertest"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/golden"
@@ -143,3 +147,111 @@ func listenUnix(tb testing.TB) (net.Listener, string) {
 	tb.Cleanup(func() { assert.NoError(tb, l.Close()) })
 	return l, l.Addr().String()
 }
+
+func Test_scraper_readTypedStats(t *testing.T) {
+	l, socketAddr := listenUnix(t)
+	go func() {
+		c, err2 := l.Accept()
+		assert.NoError(t, err2)
+
+		buf := make([]byte, 512)
+		nr, err2 := c.Read(buf)
+		assert.NoError(t, err2)
+
+		data := string(buf[0:nr])
+		switch data {
+		case "show stat typed\n":
+			stats, err2 := os.ReadFile(filepath.Join("testdata", "stats_typed.txt"))
+			assert.NoError(t, err2)
+			_, err2 = c.Write(stats)
+			assert.NoError(t, err2)
+			assert.NoError(t, c.Close())
+		default:
+			assert.Fail(t, fmt.Sprintf("invalid message: %v", data))
+		}
+	}()
+
+	haProxyCfg := newDefaultConfig().(*Config)
+	haProxyCfg.Endpoint = socketAddr
+	haProxyCfg.Format = formatTyped
+	haProxyCfg.Metrics.HaproxyStatus.Enabled = true
+	haProxyCfg.Metrics.HaproxyStatusTransitions.Enabled = true
+	haProxyCfg.Metrics.HaproxyStatusLastChange.Enabled = true
+	s := newScraper(haProxyCfg, receivertest.NewNopSettings(metadata.Type))
+	m, err := s.scrape(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 2, m.ResourceMetrics().Len())
+
+	frontend := m.ResourceMetrics().At(0)
+	pxname, _ := frontend.Resource().Attributes().Get("haproxy.proxy_name")
+	assert.Equal(t, "myfrontend", pxname.Str())
+	assert.Equal(t, map[string]int64{"open": 1}, statusStates(t, frontend))
+
+	server := m.ResourceMetrics().At(1)
+	svname, _ := server.Resource().Attributes().Get("haproxy.service_name")
+	assert.Equal(t, "s1", svname.Str())
+	assert.Equal(t, map[string]int64{"down": 1}, statusStates(t, server))
+	assert.Equal(t, int64(3), findMetric(t, server, "haproxy.status.transitions").Sum().DataPoints().At(0).IntValue())
+	assert.Equal(t, int64(42), findMetric(t, server, "haproxy.status.last_change").Gauge().DataPoints().At(0).IntValue())
+}
+
+func Test_scraper_readPrometheusStats(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/metrics", r.URL.Path)
+		stats, err := os.ReadFile(filepath.Join("testdata", "prometheus.txt"))
+		assert.NoError(t, err)
+		_, err = w.Write(stats)
+		assert.NoError(t, err)
+	}))
+	defer srv.Close()
+
+	haProxyCfg := newDefaultConfig().(*Config)
+	haProxyCfg.Endpoint = srv.URL + "/metrics"
+	haProxyCfg.Format = formatPrometheus
+	haProxyCfg.Metrics.HaproxyStatus.Enabled = true
+	haProxyCfg.Metrics.HaproxyStatusTransitions.Enabled = true
+	haProxyCfg.Metrics.HaproxyStatusLastChange.Enabled = true
+	s := newScraper(haProxyCfg, receivertest.NewNopSettings(metadata.Type))
+	require.NoError(t, s.start(context.Background(), componenttest.NewNopHost()))
+	m, err := s.scrape(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 3, m.ResourceMetrics().Len())
+
+	frontend := m.ResourceMetrics().At(0)
+	assert.Equal(t, int64(1), findMetric(t, frontend, "haproxy.sessions.count").Gauge().DataPoints().At(0).IntValue())
+	assert.Equal(t, 2, findMetric(t, frontend, "haproxy.requests.total").Sum().DataPoints().Len())
+
+	backend := m.ResourceMetrics().At(1)
+	assert.InDelta(t, 15.0, findMetric(t, backend, "haproxy.sessions.average").Gauge().DataPoints().At(0).DoubleValue(), 0.0001)
+
+	server := m.ResourceMetrics().At(2)
+	assert.Equal(t, map[string]int64{"up": 1}, statusStates(t, server))
+	assert.Equal(t, int64(2), findMetric(t, server, "haproxy.status.transitions").Sum().DataPoints().At(0).IntValue())
+	assert.Equal(t, int64(3600), findMetric(t, server, "haproxy.status.last_change").Gauge().DataPoints().At(0).IntValue())
+}
+
+func findMetric(tb testing.TB, rm pmetric.ResourceMetrics, name string) pmetric.Metric {
+	metrics := rm.ScopeMetrics().At(0).Metrics()
+	for i := 0; i < metrics.Len(); i++ {
+		if metrics.At(i).Name() == name {
+			return metrics.At(i)
+		}
+	}
+	require.Failf(tb, "metric not found", "metric %s not found", name)
+	return pmetric.Metric{}
+}
+
+// statusStates returns the states of haproxy.status with a non-zero value.
+func statusStates(tb testing.TB, rm pmetric.ResourceMetrics) map[string]int64 {
+	dps := findMetric(tb, rm, "haproxy.status").Gauge().DataPoints()
+	require.Equal(tb, len(states), dps.Len())
+	result := map[string]int64{}
+	for i := 0; i < dps.Len(); i++ {
+		if dps.At(i).IntValue() == 0 {
+			continue
+		}
+		state, _ := dps.At(i).Attributes().Get("state")
+		result[state.Str()] = dps.At(i).IntValue()
+	}
+	return result
+}
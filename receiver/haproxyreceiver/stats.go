@@ -0,0 +1,240 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package haproxyreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/haproxyreceiver"
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/haproxyreceiver/internal/metadata"
+)
+
+// prometheusFields maps the names of the metrics of the Prometheus exporter,
+// without their haproxy_<object>_ prefix, to the fields of the CSV stats.
+var prometheusFields = map[string]string{
+	"current_sessions":                "scur",
+	"sessions_total":                  "stot",
+	"bytes_in_total":                  "bin",
+	"bytes_out_total":                 "bout",
+	"requests_denied_total":           "dreq",
+	"responses_denied_total":          "dresp",
+	"request_errors_total":            "ereq",
+	"connection_errors_total":         "econ",
+	"response_errors_total":           "eresp",
+	"retry_warnings_total":            "wretr",
+	"redispatch_warnings_total":       "wredis",
+	"current_queue":                   "qcur",
+	"client_aborts_total":             "cli_abrt",
+	"server_aborts_total":             "srv_abrt",
+	"connections_total":               "conn_tot",
+	"connections_rate_current":        "conn_rate",
+	"current_session_rate":            "rate",
+	"http_requests_rate_current":      "req_rate",
+	"loadbalanced_total":              "lbtot",
+	"downtime_seconds_total":          "downtime",
+	"check_failures_total":            "chkfail",
+	"check_up_down_total":             "chkdown",
+	"check_last_change_seconds":       "lastchg",
+	"compressor_bytes_in_total":       "comp_in",
+	"compressor_bytes_out_total":      "comp_out",
+	"compressor_bypassed_bytes_total": "comp_byp",
+	"http_responses_compressed_total": "comp_rsp",
+}
+
+// isHTTPEndpoint reports whether the endpoint is an HTTP URL rather than the
+// path of the runtime API socket.
+func isHTTPEndpoint(endpoint string) bool {
+	u, err := url.Parse(endpoint)
+	return err == nil && strings.HasPrefix(u.Scheme, "http")
+}
+
+// parseState returns the state of a status such as `UP`, `DOWN 1/2`, `MAINT (via
+// backend/server)` or `no check`.
+func parseState(status string) (metadata.AttributeState, bool) {
+	status = strings.ToLower(strings.TrimSpace(status))
+	if strings.HasPrefix(status, "no check") {
+		return metadata.AttributeStateNoCheck, true
+	}
+	word, _, _ := strings.Cut(status, " ")
+	state, ok := metadata.MapAttributeState[word]
+	return state, ok
+}
+
+// readTypedStats reads the output of `show stat typed`. Each line holds one
+// field of an object as `<type>.<proxy id>.<object id>.<field position>.<field
+// name>.<process>:<tags>:<value type>:<value>`, the fields are grouped back in
+// records keyed by field name, in the order the objects appear.
+func readTypedStats(buf []byte) ([]map[string]string, error) {
+	var results []map[string]string
+	index := map[string]int{}
+	scanner := bufio.NewScanner(bytes.NewReader(buf))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+		parts := strings.SplitN(line, ":", 4)
+		if len(parts) != 4 {
+			return nil, fmt.Errorf("invalid typed stat line %q", line)
+		}
+		position := strings.Split(parts[0], ".")
+		if len(position) != 6 {
+			return nil, fmt.Errorf("invalid typed stat position %q", parts[0])
+		}
+		key := strings.Join(position[:3], ".")
+		i, ok := index[key]
+		if !ok {
+			i = len(results)
+			index[key] = i
+			results = append(results, map[string]string{})
+		}
+		results[i][position[4]] = parts[3]
+	}
+	return results, scanner.Err()
+}
+
+// readPrometheusStats reads the metrics of the Prometheus exporter of the
+// frontends, backends and servers, and converts them to records keyed by the
+// names of the CSV stats fields.
+func readPrometheusStats(buf []byte) ([]map[string]string, error) {
+	var results []map[string]string
+	index := map[string]int{}
+	scanner := bufio.NewScanner(bytes.NewReader(buf))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		name, labels, value, err := parsePrometheusLine(line)
+		if err != nil {
+			return nil, err
+		}
+
+		var svname, field string
+		switch {
+		case strings.HasPrefix(name, "haproxy_frontend_"):
+			svname, field = "FRONTEND", strings.TrimPrefix(name, "haproxy_frontend_")
+		case strings.HasPrefix(name, "haproxy_backend_"):
+			svname, field = "BACKEND", strings.TrimPrefix(name, "haproxy_backend_")
+		case strings.HasPrefix(name, "haproxy_server_"):
+			svname, field = labels["server"], strings.TrimPrefix(name, "haproxy_server_")
+		default:
+			continue
+		}
+
+		var csvField, csvValue string
+		switch field {
+		case "http_responses_total":
+			csvField, csvValue = "hrsp_"+labels["code"], formatPrometheusValue(value)
+		case "status":
+			// One series per state, the current one has the value 1.
+			if value != 1 || labels["state"] == "" {
+				continue
+			}
+			csvField, csvValue = "status", labels["state"]
+		case "total_time_average_seconds":
+			csvField, csvValue = "ttime", formatPrometheusValue(value*1000)
+		default:
+			var ok bool
+			if csvField, ok = prometheusFields[field]; !ok {
+				continue
+			}
+			csvValue = formatPrometheusValue(value)
+		}
+
+		key := labels["proxy"] + "/" + svname
+		i, ok := index[key]
+		if !ok {
+			i = len(results)
+			index[key] = i
+			results = append(results, map[string]string{"pxname": labels["proxy"], "svname": svname})
+		}
+		results[i][csvField] = csvValue
+	}
+	return results, scanner.Err()
+}
+
+// parsePrometheusLine parses a sample of the Prometheus text format, such as
+// `haproxy_server_status{proxy="app",server="web1",state="UP"} 1`.
+func parsePrometheusLine(line string) (string, map[string]string, float64, error) {
+	labels := map[string]string{}
+	name, rest := line, ""
+	if i := strings.IndexAny(line, "{ "); i >= 0 {
+		name, rest = line[:i], line[i:]
+	}
+	if strings.HasPrefix(rest, "{") {
+		end := -1
+		quoted := false
+		for i := 1; i < len(rest); i++ {
+			switch {
+			case rest[i] == '\\' && quoted:
+				i++
+			case rest[i] == '"':
+				quoted = !quoted
+			case rest[i] == '}' && !quoted:
+				end = i
+			}
+			if end >= 0 {
+				break
+			}
+		}
+		if end < 0 {
+			return "", nil, 0, fmt.Errorf("invalid labels in line %q", line)
+		}
+		for _, pair := range splitLabels(rest[1:end]) {
+			key, val, ok := strings.Cut(pair, "=")
+			if !ok {
+				return "", nil, 0, fmt.Errorf("invalid label %q in line %q", pair, line)
+			}
+			unquoted, err := strconv.Unquote(strings.TrimSpace(val))
+			if err != nil {
+				return "", nil, 0, fmt.Errorf("invalid label %q in line %q: %w", pair, line, err)
+			}
+			labels[strings.TrimSpace(key)] = unquoted
+		}
+		rest = rest[end+1:]
+	}
+	fields := strings.Fields(rest)
+	if len(fields) == 0 {
+		return "", nil, 0, fmt.Errorf("missing value in line %q", line)
+	}
+	value, err := strconv.ParseFloat(fields[0], 64)
+	if err != nil {
+		return "", nil, 0, fmt.Errorf("invalid value in line %q: %w", line, err)
+	}
+	return name, labels, value, nil
+}
+
+// splitLabels splits the comma separated labels, ignoring the commas of the
+// quoted values.
+func splitLabels(s string) []string {
+	var pairs []string
+	quoted := false
+	start := 0
+	for i := 0; i < len(s); i++ {
+		switch {
+		case s[i] == '\\' && quoted:
+			i++
+		case s[i] == '"':
+			quoted = !quoted
+		case s[i] == ',' && !quoted:
+			pairs = append(pairs, s[start:i])
+			start = i + 1
+		}
+	}
+	if strings.TrimSpace(s[start:]) != "" {
+		pairs = append(pairs, s[start:])
+	}
+	return pairs
+}
+
+// formatPrometheusValue formats a value as the CSV stats do, integers are written
+// without decimals.
+func formatPrometheusValue(v float64) string {
+	return strconv.FormatFloat(v, 'f', -1, 64)
+}
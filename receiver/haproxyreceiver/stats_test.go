@@ -0,0 +1,62 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package haproxyreceiver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/haproxyreceiver/internal/metadata"
+)
+
+func TestParseState(t *testing.T) {
+	tests := map[string]metadata.AttributeState{
+		"UP":                     metadata.AttributeStateUp,
+		"UP 1/3":                 metadata.AttributeStateUp,
+		"DOWN":                   metadata.AttributeStateDown,
+		"MAINT (via backend/s1)": metadata.AttributeStateMaint,
+		"DRAIN":                  metadata.AttributeStateDrain,
+		"NOLB":                   metadata.AttributeStateNolb,
+		"no check":               metadata.AttributeStateNoCheck,
+		"OPEN":                   metadata.AttributeStateOpen,
+	}
+	for status, expected := range tests {
+		state, ok := parseState(status)
+		assert.True(t, ok, status)
+		assert.Equal(t, expected, state, status)
+	}
+	_, ok := parseState("FULL")
+	assert.False(t, ok)
+}
+
+func TestReadTypedStats(t *testing.T) {
+	records, err := readTypedStats([]byte("F.2.0.0.pxname.1:KNSV:str:fe\nF.2.0.1.svname.1:KNSV:str:FRONTEND\n\nS.3.1.73.addr.1:CGS:str:127.0.0.1:8080\n"))
+	require.NoError(t, err)
+	assert.Equal(t, []map[string]string{
+		{"pxname": "fe", "svname": "FRONTEND"},
+		{"addr": "127.0.0.1:8080"},
+	}, records)
+
+	_, err = readTypedStats([]byte("F.2.0.0.pxname.1\n"))
+	assert.Error(t, err)
+}
+
+func TestParsePrometheusLine(t *testing.T) {
+	name, labels, value, err := parsePrometheusLine(`haproxy_server_status{proxy="a,b",server="s\"1",state="UP"} 1 1700000000`)
+	require.NoError(t, err)
+	assert.Equal(t, "haproxy_server_status", name)
+	assert.Equal(t, map[string]string{"proxy": "a,b", "server": `s"1`, "state": "UP"}, labels)
+	assert.InDelta(t, 1.0, value, 0)
+
+	name, labels, value, err = parsePrometheusLine("haproxy_process_nbthread 4")
+	require.NoError(t, err)
+	assert.Equal(t, "haproxy_process_nbthread", name)
+	assert.Empty(t, labels)
+	assert.InDelta(t, 4.0, value, 0)
+
+	_, _, _, err = parsePrometheusLine(`haproxy_server_status{proxy="a" 1`)
+	assert.Error(t, err)
+}
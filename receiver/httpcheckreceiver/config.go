@@ -7,6 +7,8 @@ import (
 	"errors"
 	"fmt"
 	"net/url"
+	"regexp"
+	"strings"
 
 	"go.opentelemetry.io/collector/config/confighttp"
 	"go.opentelemetry.io/collector/scraper/scraperhelper"
@@ -19,13 +21,16 @@ import (
 var (
 	errInvalidEndpoint = errors.New(`"endpoint" must be in the form of <scheme>://<hostname>[:<port>]`)
 	errMissingEndpoint = errors.New("at least one of 'endpoint' or 'endpoints' must be specified")
+	errMissingName     = errors.New("'name' must be specified")
+	errMissingSteps    = errors.New("at least one step must be specified")
 )
 
 // Config defines the configuration for the various elements of the receiver agent.
 type Config struct {
 	scraperhelper.ControllerConfig `mapstructure:",squash"`
 	metadata.MetricsBuilderConfig  `mapstructure:",squash"`
-	Targets                        []*targetConfig `mapstructure:"targets"`
+	Targets                        []*targetConfig      `mapstructure:"targets"`
+	Transactions                   []*transactionConfig `mapstructure:"transactions"`
 
 	// prevent unkeyed literal initialization
 	_ struct{}
@@ -64,12 +69,116 @@ func (cfg *targetConfig) Validate() error {
 	return err
 }
 
+// transactionConfig defines a synthetic transaction, a sequence of HTTP steps
+// run in order where each step can use the variables extracted by the previous ones.
+type transactionConfig struct {
+	Name  string        `mapstructure:"name"`
+	Steps []*stepConfig `mapstructure:"steps"`
+}
+
+// stepConfig defines a request of a transaction. The endpoint, the headers and
+// the body can reference the variables extracted by the previous steps as {{name}}.
+type stepConfig struct {
+	confighttp.ClientConfig `mapstructure:",squash"`
+	Name                    string                   `mapstructure:"name"`
+	Method                  string                   `mapstructure:"method"`
+	Body                    string                   `mapstructure:"body"`
+	Extract                 map[string]extractConfig `mapstructure:"extract"`
+	Assertions              assertionsConfig         `mapstructure:"assertions"`
+}
+
+// extractConfig defines where the value of a variable is read from the response,
+// exactly one of the fields must be set.
+type extractConfig struct {
+	// JSONPath is the dot separated path of a value of the JSON body, such as `data.items.0.id`.
+	JSONPath string `mapstructure:"json_path"`
+	// Header is the name of a response header.
+	Header string `mapstructure:"header"`
+	// Regex is a regular expression matched against the body, the value is its
+	// first capturing group.
+	Regex string `mapstructure:"regex"`
+}
+
+// assertionsConfig defines the checks a response must pass for the step to succeed.
+type assertionsConfig struct {
+	// StatusCodes are the accepted status codes, any status code below 400 is
+	// accepted when empty.
+	StatusCodes  []int           `mapstructure:"status_codes"`
+	BodyContains []string        `mapstructure:"body_contains"`
+	JSON         []jsonAssertion `mapstructure:"json"`
+}
+
+// jsonAssertion checks a value of the JSON body. The value must only exist when
+// Equals is not set.
+type jsonAssertion struct {
+	Path   string `mapstructure:"path"`
+	Equals string `mapstructure:"equals"`
+}
+
+// Validate validates a transactionConfig.
+func (cfg *transactionConfig) Validate() error {
+	var err error
+	if cfg.Name == "" {
+		err = multierr.Append(err, fmt.Errorf("transaction: %w", errMissingName))
+	}
+	if len(cfg.Steps) == 0 {
+		err = multierr.Append(err, fmt.Errorf("transaction %q: %w", cfg.Name, errMissingSteps))
+	}
+	for i, step := range cfg.Steps {
+		if stepErr := step.validate(); stepErr != nil {
+			err = multierr.Append(err, fmt.Errorf("transaction %q, step %d: %w", cfg.Name, i, stepErr))
+		}
+	}
+	return err
+}
+
+func (cfg *stepConfig) validate() error {
+	var err error
+	if cfg.Name == "" {
+		err = multierr.Append(err, errMissingName)
+	}
+	if cfg.Endpoint == "" {
+		err = multierr.Append(err, errors.New("'endpoint' must be specified"))
+	} else if !strings.Contains(cfg.Endpoint, "{{") {
+		// Endpoints referencing variables can only be checked once expanded.
+		if _, parseErr := url.ParseRequestURI(cfg.Endpoint); parseErr != nil {
+			err = multierr.Append(err, fmt.Errorf("%s: %w", errInvalidEndpoint.Error(), parseErr))
+		}
+	}
+	for name, extract := range cfg.Extract {
+		set := 0
+		for _, field := range []string{extract.JSONPath, extract.Header, extract.Regex} {
+			if field != "" {
+				set++
+			}
+		}
+		if set != 1 {
+			err = multierr.Append(err, fmt.Errorf("variable %q: exactly one of 'json_path', 'header' or 'regex' must be specified", name))
+			continue
+		}
+		if extract.Regex != "" {
+			re, reErr := regexp.Compile(extract.Regex)
+			if reErr != nil {
+				err = multierr.Append(err, fmt.Errorf("variable %q: invalid regex: %w", name, reErr))
+			} else if re.NumSubexp() < 1 {
+				err = multierr.Append(err, fmt.Errorf("variable %q: regex must have a capturing group", name))
+			}
+		}
+	}
+	for _, assertion := range cfg.Assertions.JSON {
+		if assertion.Path == "" {
+			err = multierr.Append(err, errors.New("json assertion: 'path' must be specified"))
+		}
+	}
+	return err
+}
+
 // Validate validates the top-level Config by checking each targetConfig.
 func (cfg *Config) Validate() error {
 	var err error
 
-	// Ensure at least one target is configured.
-	if len(cfg.Targets) == 0 {
+	// Ensure at least one target or transaction is configured.
+	if len(cfg.Targets) == 0 && len(cfg.Transactions) == 0 {
 		err = multierr.Append(err, errors.New("no targets configured"))
 	}
 
@@ -78,5 +187,14 @@ func (cfg *Config) Validate() error {
 		err = multierr.Append(err, target.Validate())
 	}
 
+	names := map[string]bool{}
+	for _, transaction := range cfg.Transactions {
+		if names[transaction.Name] {
+			err = multierr.Append(err, fmt.Errorf("duplicate transaction name %q", transaction.Name))
+		}
+		names[transaction.Name] = true
+		err = multierr.Append(err, transaction.Validate())
+	}
+
 	return err
 }
@@ -4,6 +4,7 @@
 package httpcheckreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/httpcheckreceiver"
 
 import (
+	"errors"
 	"fmt"
 	"testing"
 
@@ -197,6 +198,69 @@ func TestValidate(t *testing.T) {
 			},
 			expectedErr: nil,
 		},
+		{
+			desc: "valid transaction",
+			cfg: &Config{
+				Transactions: []*transactionConfig{
+					{
+						Name: "checkout",
+						Steps: []*stepConfig{
+							{
+								ClientConfig: confighttp.ClientConfig{Endpoint: "https://example.com/login"},
+								Name:         "login",
+								Extract: map[string]extractConfig{
+									"token":   {JSONPath: "token"},
+									"session": {Regex: `session=(\w+)`},
+								},
+							},
+							{
+								ClientConfig: confighttp.ClientConfig{Endpoint: "https://example.com/orders/{{order_id}}"},
+								Name:         "orders",
+								Assertions: assertionsConfig{
+									JSON: []jsonAssertion{{Path: "status", Equals: "paid"}},
+								},
+							},
+						},
+					},
+				},
+				ControllerConfig: scraperhelper.NewDefaultControllerConfig(),
+			},
+			expectedErr: nil,
+		},
+		{
+			desc: "invalid transaction",
+			cfg: &Config{
+				Transactions: []*transactionConfig{
+					{
+						Steps: []*stepConfig{
+							{
+								ClientConfig: confighttp.ClientConfig{Endpoint: "https://example.com/login"},
+								Name:         "login",
+								Extract: map[string]extractConfig{
+									"token": {JSONPath: "token", Header: "X-Token"},
+								},
+							},
+							{
+								Name: "orders",
+								Extract: map[string]extractConfig{
+									"id": {Regex: `id=\w+`},
+								},
+							},
+						},
+					},
+					{
+						Name: "checkout",
+					},
+				},
+				ControllerConfig: scraperhelper.NewDefaultControllerConfig(),
+			},
+			expectedErr: multierr.Combine(
+				fmt.Errorf("transaction: %w", errMissingName),
+				errors.New(`transaction "", step 0: variable "token": exactly one of 'json_path', 'header' or 'regex' must be specified`),
+				errors.New(`transaction "", step 1: 'endpoint' must be specified; variable "id": regex must have a capturing group`),
+				fmt.Errorf(`transaction "checkout": %w`, errMissingSteps),
+			),
+		},
 	}
 
 	for _, tc := range testCases {
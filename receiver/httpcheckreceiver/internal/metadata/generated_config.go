@@ -35,7 +35,14 @@ type MetricsConfig struct {
 	HttpcheckResponseDuration         MetricConfig `mapstructure:"httpcheck.response.duration"`
 	HttpcheckStatus                   MetricConfig `mapstructure:"httpcheck.status"`
 	HttpcheckTLSCertRemaining         MetricConfig `mapstructure:"httpcheck.tls.cert_remaining"`
+	HttpcheckTLSChainCertRemaining    MetricConfig `mapstructure:"httpcheck.tls.chain.cert_remaining"`
+	HttpcheckTLSChainValid            MetricConfig `mapstructure:"httpcheck.tls.chain.valid"`
 	HttpcheckTLSHandshakeDuration     MetricConfig `mapstructure:"httpcheck.tls.handshake.duration"`
+	HttpcheckTransactionDuration      MetricConfig `mapstructure:"httpcheck.transaction.duration"`
+	HttpcheckTransactionStatus        MetricConfig `mapstructure:"httpcheck.transaction.status"`
+	HttpcheckTransactionStepDuration  MetricConfig `mapstructure:"httpcheck.transaction.step.duration"`
+	HttpcheckTransactionStepError     MetricConfig `mapstructure:"httpcheck.transaction.step.error"`
+	HttpcheckTransactionStepStatus    MetricConfig `mapstructure:"httpcheck.transaction.step.status"`
 }
 
 func DefaultMetricsConfig() MetricsConfig {
@@ -64,9 +71,30 @@ func DefaultMetricsConfig() MetricsConfig {
 		HttpcheckTLSCertRemaining: MetricConfig{
 			Enabled: false,
 		},
+		HttpcheckTLSChainCertRemaining: MetricConfig{
+			Enabled: false,
+		},
+		HttpcheckTLSChainValid: MetricConfig{
+			Enabled: false,
+		},
 		HttpcheckTLSHandshakeDuration: MetricConfig{
 			Enabled: false,
 		},
+		HttpcheckTransactionDuration: MetricConfig{
+			Enabled: true,
+		},
+		HttpcheckTransactionStatus: MetricConfig{
+			Enabled: true,
+		},
+		HttpcheckTransactionStepDuration: MetricConfig{
+			Enabled: true,
+		},
+		HttpcheckTransactionStepError: MetricConfig{
+			Enabled: true,
+		},
+		HttpcheckTransactionStepStatus: MetricConfig{
+			Enabled: true,
+		},
 	}
 }
 
@@ -35,7 +35,14 @@ func TestMetricsBuilderConfig(t *testing.T) {
 					HttpcheckResponseDuration:         MetricConfig{Enabled: true},
 					HttpcheckStatus:                   MetricConfig{Enabled: true},
 					HttpcheckTLSCertRemaining:         MetricConfig{Enabled: true},
+					HttpcheckTLSChainCertRemaining:    MetricConfig{Enabled: true},
+					HttpcheckTLSChainValid:            MetricConfig{Enabled: true},
 					HttpcheckTLSHandshakeDuration:     MetricConfig{Enabled: true},
+					HttpcheckTransactionDuration:      MetricConfig{Enabled: true},
+					HttpcheckTransactionStatus:        MetricConfig{Enabled: true},
+					HttpcheckTransactionStepDuration:  MetricConfig{Enabled: true},
+					HttpcheckTransactionStepError:     MetricConfig{Enabled: true},
+					HttpcheckTransactionStepStatus:    MetricConfig{Enabled: true},
 				},
 			},
 		},
@@ -51,7 +58,14 @@ func TestMetricsBuilderConfig(t *testing.T) {
 					HttpcheckResponseDuration:         MetricConfig{Enabled: false},
 					HttpcheckStatus:                   MetricConfig{Enabled: false},
 					HttpcheckTLSCertRemaining:         MetricConfig{Enabled: false},
+					HttpcheckTLSChainCertRemaining:    MetricConfig{Enabled: false},
+					HttpcheckTLSChainValid:            MetricConfig{Enabled: false},
 					HttpcheckTLSHandshakeDuration:     MetricConfig{Enabled: false},
+					HttpcheckTransactionDuration:      MetricConfig{Enabled: false},
+					HttpcheckTransactionStatus:        MetricConfig{Enabled: false},
+					HttpcheckTransactionStepDuration:  MetricConfig{Enabled: false},
+					HttpcheckTransactionStepError:     MetricConfig{Enabled: false},
+					HttpcheckTransactionStepStatus:    MetricConfig{Enabled: false},
 				},
 			},
 		},
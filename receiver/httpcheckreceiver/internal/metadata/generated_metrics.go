@@ -36,9 +36,30 @@ var MetricsInfo = metricsInfo{
 	HttpcheckTLSCertRemaining: metricInfo{
 		Name: "httpcheck.tls.cert_remaining",
 	},
+	HttpcheckTLSChainCertRemaining: metricInfo{
+		Name: "httpcheck.tls.chain.cert_remaining",
+	},
+	HttpcheckTLSChainValid: metricInfo{
+		Name: "httpcheck.tls.chain.valid",
+	},
 	HttpcheckTLSHandshakeDuration: metricInfo{
 		Name: "httpcheck.tls.handshake.duration",
 	},
+	HttpcheckTransactionDuration: metricInfo{
+		Name: "httpcheck.transaction.duration",
+	},
+	HttpcheckTransactionStatus: metricInfo{
+		Name: "httpcheck.transaction.status",
+	},
+	HttpcheckTransactionStepDuration: metricInfo{
+		Name: "httpcheck.transaction.step.duration",
+	},
+	HttpcheckTransactionStepError: metricInfo{
+		Name: "httpcheck.transaction.step.error",
+	},
+	HttpcheckTransactionStepStatus: metricInfo{
+		Name: "httpcheck.transaction.step.status",
+	},
 }
 
 type metricsInfo struct {
@@ -50,7 +71,14 @@ type metricsInfo struct {
 	HttpcheckResponseDuration         metricInfo
 	HttpcheckStatus                   metricInfo
 	HttpcheckTLSCertRemaining         metricInfo
+	HttpcheckTLSChainCertRemaining    metricInfo
+	HttpcheckTLSChainValid            metricInfo
 	HttpcheckTLSHandshakeDuration     metricInfo
+	HttpcheckTransactionDuration      metricInfo
+	HttpcheckTransactionStatus        metricInfo
+	HttpcheckTransactionStepDuration  metricInfo
+	HttpcheckTransactionStepError     metricInfo
+	HttpcheckTransactionStepStatus    metricInfo
 }
 
 type metricInfo struct {
@@ -477,6 +505,108 @@ func newMetricHttpcheckTLSCertRemaining(cfg MetricConfig) metricHttpcheckTLSCert
 	return m
 }
 
+type metricHttpcheckTLSChainCertRemaining struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills httpcheck.tls.chain.cert_remaining metric with initial data.
+func (m *metricHttpcheckTLSChainCertRemaining) init() {
+	m.data.SetName("httpcheck.tls.chain.cert_remaining")
+	m.data.SetDescription("Time in seconds until the expiry of the first certificate of the chain presented by the endpoint to expire, including the intermediate certificates. Negative values represent time in seconds since expiration.")
+	m.data.SetUnit("s")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricHttpcheckTLSChainCertRemaining) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, httpURLAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("http.url", httpURLAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricHttpcheckTLSChainCertRemaining) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricHttpcheckTLSChainCertRemaining) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricHttpcheckTLSChainCertRemaining(cfg MetricConfig) metricHttpcheckTLSChainCertRemaining {
+	m := metricHttpcheckTLSChainCertRemaining{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricHttpcheckTLSChainValid struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills httpcheck.tls.chain.valid metric with initial data.
+func (m *metricHttpcheckTLSChainValid) init() {
+	m.data.SetName("httpcheck.tls.chain.valid")
+	m.data.SetDescription("1 if the certificate chain presented by the endpoint is valid for its hostname and issued by a trusted root, otherwise 0.")
+	m.data.SetUnit("1")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricHttpcheckTLSChainValid) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, httpURLAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("http.url", httpURLAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricHttpcheckTLSChainValid) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricHttpcheckTLSChainValid) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricHttpcheckTLSChainValid(cfg MetricConfig) metricHttpcheckTLSChainValid {
+	m := metricHttpcheckTLSChainValid{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 type metricHttpcheckTLSHandshakeDuration struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
@@ -528,6 +658,271 @@ func newMetricHttpcheckTLSHandshakeDuration(cfg MetricConfig) metricHttpcheckTLS
 	return m
 }
 
+type metricHttpcheckTransactionDuration struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills httpcheck.transaction.duration metric with initial data.
+func (m *metricHttpcheckTransactionDuration) init() {
+	m.data.SetName("httpcheck.transaction.duration")
+	m.data.SetDescription("Measures the duration of all the steps of the transaction.")
+	m.data.SetUnit("ms")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricHttpcheckTransactionDuration) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, transactionNameAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("transaction.name", transactionNameAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricHttpcheckTransactionDuration) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricHttpcheckTransactionDuration) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricHttpcheckTransactionDuration(cfg MetricConfig) metricHttpcheckTransactionDuration {
+	m := metricHttpcheckTransactionDuration{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricHttpcheckTransactionStatus struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills httpcheck.transaction.status metric with initial data.
+func (m *metricHttpcheckTransactionStatus) init() {
+	m.data.SetName("httpcheck.transaction.status")
+	m.data.SetDescription("1 if all the steps of the transaction succeeded, otherwise 0.")
+	m.data.SetUnit("1")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(false)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricHttpcheckTransactionStatus) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, transactionNameAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("transaction.name", transactionNameAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricHttpcheckTransactionStatus) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricHttpcheckTransactionStatus) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricHttpcheckTransactionStatus(cfg MetricConfig) metricHttpcheckTransactionStatus {
+	m := metricHttpcheckTransactionStatus{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricHttpcheckTransactionStepDuration struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills httpcheck.transaction.step.duration metric with initial data.
+func (m *metricHttpcheckTransactionStepDuration) init() {
+	m.data.SetName("httpcheck.transaction.step.duration")
+	m.data.SetDescription("Measures the duration of the request of the step.")
+	m.data.SetUnit("ms")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricHttpcheckTransactionStepDuration) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, transactionNameAttributeValue string, transactionStepAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("transaction.name", transactionNameAttributeValue)
+	dp.Attributes().PutStr("transaction.step", transactionStepAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricHttpcheckTransactionStepDuration) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricHttpcheckTransactionStepDuration) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricHttpcheckTransactionStepDuration(cfg MetricConfig) metricHttpcheckTransactionStepDuration {
+	m := metricHttpcheckTransactionStepDuration{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricHttpcheckTransactionStepError struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills httpcheck.transaction.step.error metric with initial data.
+func (m *metricHttpcheckTransactionStepError) init() {
+	m.data.SetName("httpcheck.transaction.step.error")
+	m.data.SetDescription("Records the failures of the steps, either request errors or failed assertions.")
+	m.data.SetUnit("{error}")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(false)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricHttpcheckTransactionStepError) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, transactionNameAttributeValue string, transactionStepAttributeValue string, errorMessageAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("transaction.name", transactionNameAttributeValue)
+	dp.Attributes().PutStr("transaction.step", transactionStepAttributeValue)
+	dp.Attributes().PutStr("error.message", errorMessageAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricHttpcheckTransactionStepError) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricHttpcheckTransactionStepError) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricHttpcheckTransactionStepError(cfg MetricConfig) metricHttpcheckTransactionStepError {
+	m := metricHttpcheckTransactionStepError{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricHttpcheckTransactionStepStatus struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills httpcheck.transaction.step.status metric with initial data.
+func (m *metricHttpcheckTransactionStepStatus) init() {
+	m.data.SetName("httpcheck.transaction.step.status")
+	m.data.SetDescription("1 if the request of the step succeeded and all its assertions passed, otherwise 0.")
+	m.data.SetUnit("1")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(false)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricHttpcheckTransactionStepStatus) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, transactionNameAttributeValue string, transactionStepAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("transaction.name", transactionNameAttributeValue)
+	dp.Attributes().PutStr("transaction.step", transactionStepAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricHttpcheckTransactionStepStatus) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricHttpcheckTransactionStepStatus) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricHttpcheckTransactionStepStatus(cfg MetricConfig) metricHttpcheckTransactionStepStatus {
+	m := metricHttpcheckTransactionStepStatus{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 // MetricsBuilder provides an interface for scrapers to report metrics while taking care of all the transformations
 // required to produce metric representation defined in metadata and user config.
 type MetricsBuilder struct {
@@ -544,7 +939,14 @@ type MetricsBuilder struct {
 	metricHttpcheckResponseDuration         metricHttpcheckResponseDuration
 	metricHttpcheckStatus                   metricHttpcheckStatus
 	metricHttpcheckTLSCertRemaining         metricHttpcheckTLSCertRemaining
+	metricHttpcheckTLSChainCertRemaining    metricHttpcheckTLSChainCertRemaining
+	metricHttpcheckTLSChainValid            metricHttpcheckTLSChainValid
 	metricHttpcheckTLSHandshakeDuration     metricHttpcheckTLSHandshakeDuration
+	metricHttpcheckTransactionDuration      metricHttpcheckTransactionDuration
+	metricHttpcheckTransactionStatus        metricHttpcheckTransactionStatus
+	metricHttpcheckTransactionStepDuration  metricHttpcheckTransactionStepDuration
+	metricHttpcheckTransactionStepError     metricHttpcheckTransactionStepError
+	metricHttpcheckTransactionStepStatus    metricHttpcheckTransactionStepStatus
 }
 
 // MetricBuilderOption applies changes to default metrics builder.
@@ -578,7 +980,14 @@ func NewMetricsBuilder(mbc MetricsBuilderConfig, settings receiver.Settings, opt
 		metricHttpcheckResponseDuration:         newMetricHttpcheckResponseDuration(mbc.Metrics.HttpcheckResponseDuration),
 		metricHttpcheckStatus:                   newMetricHttpcheckStatus(mbc.Metrics.HttpcheckStatus),
 		metricHttpcheckTLSCertRemaining:         newMetricHttpcheckTLSCertRemaining(mbc.Metrics.HttpcheckTLSCertRemaining),
+		metricHttpcheckTLSChainCertRemaining:    newMetricHttpcheckTLSChainCertRemaining(mbc.Metrics.HttpcheckTLSChainCertRemaining),
+		metricHttpcheckTLSChainValid:            newMetricHttpcheckTLSChainValid(mbc.Metrics.HttpcheckTLSChainValid),
 		metricHttpcheckTLSHandshakeDuration:     newMetricHttpcheckTLSHandshakeDuration(mbc.Metrics.HttpcheckTLSHandshakeDuration),
+		metricHttpcheckTransactionDuration:      newMetricHttpcheckTransactionDuration(mbc.Metrics.HttpcheckTransactionDuration),
+		metricHttpcheckTransactionStatus:        newMetricHttpcheckTransactionStatus(mbc.Metrics.HttpcheckTransactionStatus),
+		metricHttpcheckTransactionStepDuration:  newMetricHttpcheckTransactionStepDuration(mbc.Metrics.HttpcheckTransactionStepDuration),
+		metricHttpcheckTransactionStepError:     newMetricHttpcheckTransactionStepError(mbc.Metrics.HttpcheckTransactionStepError),
+		metricHttpcheckTransactionStepStatus:    newMetricHttpcheckTransactionStepStatus(mbc.Metrics.HttpcheckTransactionStepStatus),
 	}
 
 	for _, op := range options {
@@ -652,7 +1061,14 @@ func (mb *MetricsBuilder) EmitForResource(options ...ResourceMetricsOption) {
 	mb.metricHttpcheckResponseDuration.emit(ils.Metrics())
 	mb.metricHttpcheckStatus.emit(ils.Metrics())
 	mb.metricHttpcheckTLSCertRemaining.emit(ils.Metrics())
+	mb.metricHttpcheckTLSChainCertRemaining.emit(ils.Metrics())
+	mb.metricHttpcheckTLSChainValid.emit(ils.Metrics())
 	mb.metricHttpcheckTLSHandshakeDuration.emit(ils.Metrics())
+	mb.metricHttpcheckTransactionDuration.emit(ils.Metrics())
+	mb.metricHttpcheckTransactionStatus.emit(ils.Metrics())
+	mb.metricHttpcheckTransactionStepDuration.emit(ils.Metrics())
+	mb.metricHttpcheckTransactionStepError.emit(ils.Metrics())
+	mb.metricHttpcheckTransactionStepStatus.emit(ils.Metrics())
 
 	for _, op := range options {
 		op.apply(rm)
@@ -714,11 +1130,46 @@ func (mb *MetricsBuilder) RecordHttpcheckTLSCertRemainingDataPoint(ts pcommon.Ti
 	mb.metricHttpcheckTLSCertRemaining.recordDataPoint(mb.startTime, ts, val, httpURLAttributeValue, httpTLSIssuerAttributeValue, httpTLSCnAttributeValue, httpTLSSanAttributeValue)
 }
 
+// RecordHttpcheckTLSChainCertRemainingDataPoint adds a data point to httpcheck.tls.chain.cert_remaining metric.
+func (mb *MetricsBuilder) RecordHttpcheckTLSChainCertRemainingDataPoint(ts pcommon.Timestamp, val int64, httpURLAttributeValue string) {
+	mb.metricHttpcheckTLSChainCertRemaining.recordDataPoint(mb.startTime, ts, val, httpURLAttributeValue)
+}
+
+// RecordHttpcheckTLSChainValidDataPoint adds a data point to httpcheck.tls.chain.valid metric.
+func (mb *MetricsBuilder) RecordHttpcheckTLSChainValidDataPoint(ts pcommon.Timestamp, val int64, httpURLAttributeValue string) {
+	mb.metricHttpcheckTLSChainValid.recordDataPoint(mb.startTime, ts, val, httpURLAttributeValue)
+}
+
 // RecordHttpcheckTLSHandshakeDurationDataPoint adds a data point to httpcheck.tls.handshake.duration metric.
 func (mb *MetricsBuilder) RecordHttpcheckTLSHandshakeDurationDataPoint(ts pcommon.Timestamp, val int64, httpURLAttributeValue string) {
 	mb.metricHttpcheckTLSHandshakeDuration.recordDataPoint(mb.startTime, ts, val, httpURLAttributeValue)
 }
 
+// RecordHttpcheckTransactionDurationDataPoint adds a data point to httpcheck.transaction.duration metric.
+func (mb *MetricsBuilder) RecordHttpcheckTransactionDurationDataPoint(ts pcommon.Timestamp, val int64, transactionNameAttributeValue string) {
+	mb.metricHttpcheckTransactionDuration.recordDataPoint(mb.startTime, ts, val, transactionNameAttributeValue)
+}
+
+// RecordHttpcheckTransactionStatusDataPoint adds a data point to httpcheck.transaction.status metric.
+func (mb *MetricsBuilder) RecordHttpcheckTransactionStatusDataPoint(ts pcommon.Timestamp, val int64, transactionNameAttributeValue string) {
+	mb.metricHttpcheckTransactionStatus.recordDataPoint(mb.startTime, ts, val, transactionNameAttributeValue)
+}
+
+// RecordHttpcheckTransactionStepDurationDataPoint adds a data point to httpcheck.transaction.step.duration metric.
+func (mb *MetricsBuilder) RecordHttpcheckTransactionStepDurationDataPoint(ts pcommon.Timestamp, val int64, transactionNameAttributeValue string, transactionStepAttributeValue string) {
+	mb.metricHttpcheckTransactionStepDuration.recordDataPoint(mb.startTime, ts, val, transactionNameAttributeValue, transactionStepAttributeValue)
+}
+
+// RecordHttpcheckTransactionStepErrorDataPoint adds a data point to httpcheck.transaction.step.error metric.
+func (mb *MetricsBuilder) RecordHttpcheckTransactionStepErrorDataPoint(ts pcommon.Timestamp, val int64, transactionNameAttributeValue string, transactionStepAttributeValue string, errorMessageAttributeValue string) {
+	mb.metricHttpcheckTransactionStepError.recordDataPoint(mb.startTime, ts, val, transactionNameAttributeValue, transactionStepAttributeValue, errorMessageAttributeValue)
+}
+
+// RecordHttpcheckTransactionStepStatusDataPoint adds a data point to httpcheck.transaction.step.status metric.
+func (mb *MetricsBuilder) RecordHttpcheckTransactionStepStatusDataPoint(ts pcommon.Timestamp, val int64, transactionNameAttributeValue string, transactionStepAttributeValue string) {
+	mb.metricHttpcheckTransactionStepStatus.recordDataPoint(mb.startTime, ts, val, transactionNameAttributeValue, transactionStepAttributeValue)
+}
+
 // Reset resets metrics builder to its initial state. It should be used when external metrics source is restarted,
 // and metrics builder should update its startTime and reset it's internal state accordingly.
 func (mb *MetricsBuilder) Reset(options ...MetricBuilderOption) {
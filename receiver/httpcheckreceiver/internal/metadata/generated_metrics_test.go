@@ -86,9 +86,35 @@ func TestMetricsBuilder(t *testing.T) {
 			allMetricsCount++
 			mb.RecordHttpcheckTLSCertRemainingDataPoint(ts, 1, "http.url-val", "http.tls.issuer-val", "http.tls.cn-val", []any{"http.tls.san-item1", "http.tls.san-item2"})
 
+			allMetricsCount++
+			mb.RecordHttpcheckTLSChainCertRemainingDataPoint(ts, 1, "http.url-val")
+
+			allMetricsCount++
+			mb.RecordHttpcheckTLSChainValidDataPoint(ts, 1, "http.url-val")
+
 			allMetricsCount++
 			mb.RecordHttpcheckTLSHandshakeDurationDataPoint(ts, 1, "http.url-val")
 
+			defaultMetricsCount++
+			allMetricsCount++
+			mb.RecordHttpcheckTransactionDurationDataPoint(ts, 1, "transaction.name-val")
+
+			defaultMetricsCount++
+			allMetricsCount++
+			mb.RecordHttpcheckTransactionStatusDataPoint(ts, 1, "transaction.name-val")
+
+			defaultMetricsCount++
+			allMetricsCount++
+			mb.RecordHttpcheckTransactionStepDurationDataPoint(ts, 1, "transaction.name-val", "transaction.step-val")
+
+			defaultMetricsCount++
+			allMetricsCount++
+			mb.RecordHttpcheckTransactionStepErrorDataPoint(ts, 1, "transaction.name-val", "transaction.step-val", "error.message-val")
+
+			defaultMetricsCount++
+			allMetricsCount++
+			mb.RecordHttpcheckTransactionStepStatusDataPoint(ts, 1, "transaction.name-val", "transaction.step-val")
+
 			res := pcommon.NewResource()
 			metrics := mb.Emit(WithResource(res))
 
@@ -259,6 +285,36 @@ func TestMetricsBuilder(t *testing.T) {
 					attrVal, ok = dp.Attributes().Get("http.tls.san")
 					assert.True(t, ok)
 					assert.Equal(t, []any{"http.tls.san-item1", "http.tls.san-item2"}, attrVal.Slice().AsRaw())
+				case "httpcheck.tls.chain.cert_remaining":
+					assert.False(t, validatedMetrics["httpcheck.tls.chain.cert_remaining"], "Found a duplicate in the metrics slice: httpcheck.tls.chain.cert_remaining")
+					validatedMetrics["httpcheck.tls.chain.cert_remaining"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Time in seconds until the expiry of the first certificate of the chain presented by the endpoint to expire, including the intermediate certificates. Negative values represent time in seconds since expiration.", ms.At(i).Description())
+					assert.Equal(t, "s", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("http.url")
+					assert.True(t, ok)
+					assert.Equal(t, "http.url-val", attrVal.Str())
+				case "httpcheck.tls.chain.valid":
+					assert.False(t, validatedMetrics["httpcheck.tls.chain.valid"], "Found a duplicate in the metrics slice: httpcheck.tls.chain.valid")
+					validatedMetrics["httpcheck.tls.chain.valid"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "1 if the certificate chain presented by the endpoint is valid for its hostname and issued by a trusted root, otherwise 0.", ms.At(i).Description())
+					assert.Equal(t, "1", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("http.url")
+					assert.True(t, ok)
+					assert.Equal(t, "http.url-val", attrVal.Str())
 				case "httpcheck.tls.handshake.duration":
 					assert.False(t, validatedMetrics["httpcheck.tls.handshake.duration"], "Found a duplicate in the metrics slice: httpcheck.tls.handshake.duration")
 					validatedMetrics["httpcheck.tls.handshake.duration"] = true
@@ -274,6 +330,99 @@ func TestMetricsBuilder(t *testing.T) {
 					attrVal, ok := dp.Attributes().Get("http.url")
 					assert.True(t, ok)
 					assert.Equal(t, "http.url-val", attrVal.Str())
+				case "httpcheck.transaction.duration":
+					assert.False(t, validatedMetrics["httpcheck.transaction.duration"], "Found a duplicate in the metrics slice: httpcheck.transaction.duration")
+					validatedMetrics["httpcheck.transaction.duration"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Measures the duration of all the steps of the transaction.", ms.At(i).Description())
+					assert.Equal(t, "ms", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("transaction.name")
+					assert.True(t, ok)
+					assert.Equal(t, "transaction.name-val", attrVal.Str())
+				case "httpcheck.transaction.status":
+					assert.False(t, validatedMetrics["httpcheck.transaction.status"], "Found a duplicate in the metrics slice: httpcheck.transaction.status")
+					validatedMetrics["httpcheck.transaction.status"] = true
+					assert.Equal(t, pmetric.MetricTypeSum, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Sum().DataPoints().Len())
+					assert.Equal(t, "1 if all the steps of the transaction succeeded, otherwise 0.", ms.At(i).Description())
+					assert.Equal(t, "1", ms.At(i).Unit())
+					assert.False(t, ms.At(i).Sum().IsMonotonic())
+					assert.Equal(t, pmetric.AggregationTemporalityCumulative, ms.At(i).Sum().AggregationTemporality())
+					dp := ms.At(i).Sum().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("transaction.name")
+					assert.True(t, ok)
+					assert.Equal(t, "transaction.name-val", attrVal.Str())
+				case "httpcheck.transaction.step.duration":
+					assert.False(t, validatedMetrics["httpcheck.transaction.step.duration"], "Found a duplicate in the metrics slice: httpcheck.transaction.step.duration")
+					validatedMetrics["httpcheck.transaction.step.duration"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Measures the duration of the request of the step.", ms.At(i).Description())
+					assert.Equal(t, "ms", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("transaction.name")
+					assert.True(t, ok)
+					assert.Equal(t, "transaction.name-val", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("transaction.step")
+					assert.True(t, ok)
+					assert.Equal(t, "transaction.step-val", attrVal.Str())
+				case "httpcheck.transaction.step.error":
+					assert.False(t, validatedMetrics["httpcheck.transaction.step.error"], "Found a duplicate in the metrics slice: httpcheck.transaction.step.error")
+					validatedMetrics["httpcheck.transaction.step.error"] = true
+					assert.Equal(t, pmetric.MetricTypeSum, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Sum().DataPoints().Len())
+					assert.Equal(t, "Records the failures of the steps, either request errors or failed assertions.", ms.At(i).Description())
+					assert.Equal(t, "{error}", ms.At(i).Unit())
+					assert.False(t, ms.At(i).Sum().IsMonotonic())
+					assert.Equal(t, pmetric.AggregationTemporalityCumulative, ms.At(i).Sum().AggregationTemporality())
+					dp := ms.At(i).Sum().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("transaction.name")
+					assert.True(t, ok)
+					assert.Equal(t, "transaction.name-val", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("transaction.step")
+					assert.True(t, ok)
+					assert.Equal(t, "transaction.step-val", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("error.message")
+					assert.True(t, ok)
+					assert.Equal(t, "error.message-val", attrVal.Str())
+				case "httpcheck.transaction.step.status":
+					assert.False(t, validatedMetrics["httpcheck.transaction.step.status"], "Found a duplicate in the metrics slice: httpcheck.transaction.step.status")
+					validatedMetrics["httpcheck.transaction.step.status"] = true
+					assert.Equal(t, pmetric.MetricTypeSum, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Sum().DataPoints().Len())
+					assert.Equal(t, "1 if the request of the step succeeded and all its assertions passed, otherwise 0.", ms.At(i).Description())
+					assert.Equal(t, "1", ms.At(i).Unit())
+					assert.False(t, ms.At(i).Sum().IsMonotonic())
+					assert.Equal(t, pmetric.AggregationTemporalityCumulative, ms.At(i).Sum().AggregationTemporality())
+					dp := ms.At(i).Sum().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("transaction.name")
+					assert.True(t, ok)
+					assert.Equal(t, "transaction.name-val", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("transaction.step")
+					assert.True(t, ok)
+					assert.Equal(t, "transaction.step-val", attrVal.Str())
 				}
 			}
 		})
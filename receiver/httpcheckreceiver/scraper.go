@@ -6,10 +6,12 @@ package httpcheckreceiver // import "github.com/open-telemetry/opentelemetry-col
 import (
 	"context"
 	"crypto/tls"
+	"crypto/x509"
 	"errors"
 	"io"
 	"net/http"
 	"net/http/httptrace"
+	"regexp"
 	"sync"
 	"time"
 
@@ -65,7 +67,11 @@ func (t *timingInfo) getDurations() (dnsMs, tcpMs, tlsMs, requestMs, responseMs
 }
 
 type httpcheckScraper struct {
-	clients  []*http.Client
+	clients      []*http.Client
+	transactions []*transaction
+	// rootCAs are the roots used to verify the certificate chain of each target,
+	// nil for the roots of the system.
+	rootCAs  []*x509.CertPool
 	cfg      *Config
 	settings component.TelemetrySettings
 	mb       *metadata.MetricsBuilder
@@ -104,6 +110,46 @@ func extractTLSInfo(state *tls.ConnectionState) (issuer, commonName string, sans
 	return issuer, commonName, sans, timeLeft
 }
 
+// peerCertificates returns the certificates presented by the server, including
+// when the request failed because they could not be verified.
+func peerCertificates(resp *http.Response, err error) []*x509.Certificate {
+	if resp != nil && resp.TLS != nil {
+		return resp.TLS.PeerCertificates
+	}
+	var verifyErr *tls.CertificateVerificationError
+	if errors.As(err, &verifyErr) {
+		return verifyErr.UnverifiedCertificates
+	}
+	return nil
+}
+
+// verifyChain reports whether the chain is valid for the host and issued by one
+// of the roots, independently of the InsecureSkipVerify setting of the client.
+func verifyChain(certs []*x509.Certificate, host string, roots *x509.CertPool) bool {
+	intermediates := x509.NewCertPool()
+	for _, cert := range certs[1:] {
+		intermediates.AddCert(cert)
+	}
+	_, err := certs[0].Verify(x509.VerifyOptions{
+		DNSName:       host,
+		Roots:         roots,
+		Intermediates: intermediates,
+	})
+	return err == nil
+}
+
+// chainTimeLeft returns the time in seconds until the first certificate of the
+// chain expires.
+func chainTimeLeft(certs []*x509.Certificate) int64 {
+	notAfter := certs[0].NotAfter
+	for _, cert := range certs[1:] {
+		if cert.NotAfter.Before(notAfter) {
+			notAfter = cert.NotAfter
+		}
+	}
+	return int64(time.Until(notAfter).Seconds())
+}
+
 // start initializes the scraper by creating HTTP clients for each endpoint.
 func (h *httpcheckScraper) start(ctx context.Context, host component.Host) (err error) {
 	var expandedTargets []*targetConfig
@@ -136,25 +182,76 @@ func (h *httpcheckScraper) start(ctx context.Context, host component.Host) (err
 			targetClone := *target
 			targetClone.Endpoint = endpoint
 
+			var rootCAs *x509.CertPool
+			if h.cfg.Metrics.HttpcheckTLSChainValid.Enabled {
+				tlsCfg, tlsErr := target.TLS.LoadTLSConfig(ctx)
+				if tlsErr != nil {
+					err = multierr.Append(err, tlsErr)
+					continue
+				}
+				if tlsCfg != nil {
+					rootCAs = tlsCfg.RootCAs
+				}
+			}
+
 			h.clients = append(h.clients, client)
+			h.rootCAs = append(h.rootCAs, rootCAs)
 			expandedTargets = append(expandedTargets, &targetClone) // Add the cloned target to expanded targets
 		}
 	}
 
 	h.cfg.Targets = expandedTargets // Replace targets with expanded targets
+
+	for _, transactionCfg := range h.cfg.Transactions {
+		t := &transaction{cfg: transactionCfg, regexes: map[string]*regexp.Regexp{}}
+		for _, step := range transactionCfg.Steps {
+			if step.Timeout == 0 {
+				step.Timeout = 30 * time.Second
+			}
+			client, clientErr := step.ToClient(ctx, host, h.settings)
+			if clientErr != nil {
+				h.settings.Logger.Error("failed to initialize HTTP client", zap.String("transaction", transactionCfg.Name), zap.String("step", step.Name), zap.Error(clientErr))
+				err = multierr.Append(err, clientErr)
+				t = nil
+				break
+			}
+			t.clients = append(t.clients, client)
+			for _, extract := range step.Extract {
+				if extract.Regex != "" {
+					t.regexes[extract.Regex] = regexp.MustCompile(extract.Regex)
+				}
+			}
+		}
+		if t != nil {
+			h.transactions = append(h.transactions, t)
+		}
+	}
 	return
 }
 
 // scrape performs the HTTP checks and records metrics based on responses.
 func (h *httpcheckScraper) scrape(ctx context.Context) (pmetric.Metrics, error) {
-	if len(h.clients) == 0 {
+	if len(h.clients) == 0 && len(h.transactions) == 0 {
 		return pmetric.NewMetrics(), errClientNotInit
 	}
 
 	var wg sync.WaitGroup
-	wg.Add(len(h.clients))
+	wg.Add(len(h.clients) + len(h.transactions))
 	var mux sync.Mutex
 
+	for _, t := range h.transactions {
+		go func(t *transaction) {
+			defer wg.Done()
+
+			now := pcommon.NewTimestampFromTime(time.Now())
+			results := runTransaction(ctx, t)
+
+			mux.Lock()
+			h.recordTransaction(now, t.cfg.Name, results)
+			mux.Unlock()
+		}(t)
+	}
+
 	for idx, client := range h.clients {
 		go func(targetClient *http.Client, targetIndex int) {
 			defer wg.Done()
@@ -230,21 +327,6 @@ func (h *httpcheckScraper) scrape(ctx context.Context) (pmetric.Metrics, error)
 
 			mux.Lock()
 
-			// Check if TLS metric is enabled and this is an HTTPS endpoint
-			if h.cfg.Metrics.HttpcheckTLSCertRemaining.Enabled && resp != nil && resp.TLS != nil {
-				// Extract TLS info directly from the HTTP response
-				issuer, commonName, sans, timeLeft := extractTLSInfo(resp.TLS)
-				if issuer != "" || commonName != "" || len(sans) > 0 {
-					h.mb.RecordHttpcheckTLSCertRemainingDataPoint(
-						now,
-						timeLeft,
-						h.cfg.Targets[targetIndex].Endpoint,
-						issuer,
-						commonName,
-						sans,
-					)
-				}
-			}
 			// Record timing breakdown metrics
 			dnsMs, tcpMs, tlsMs, requestMs, responseMs := timing.getDurations()
 			endpoint := h.cfg.Targets[targetIndex].Endpoint
@@ -263,20 +345,30 @@ func (h *httpcheckScraper) scrape(ctx context.Context) (pmetric.Metrics, error)
 			h.mb.RecordHttpcheckClientRequestDurationDataPoint(now, requestMs, endpoint)
 			h.mb.RecordHttpcheckResponseDurationDataPoint(now, responseMs, endpoint)
 
-			// Check if TLS metric is enabled and this is an HTTPS endpoint
-			if h.cfg.Metrics.HttpcheckTLSCertRemaining.Enabled && resp != nil && resp.TLS != nil {
-				// Extract TLS info directly from the HTTP response
-				issuer, commonName, sans, timeLeft := extractTLSInfo(resp.TLS)
-				if issuer != "" || commonName != "" || len(sans) > 0 {
-					h.mb.RecordHttpcheckTLSCertRemainingDataPoint(
-						now,
-						timeLeft,
-						endpoint,
-						issuer,
-						commonName,
-						sans,
-					)
+			// The certificates are also reported when the request failed because
+			// the chain could not be verified, such as for expired certificates.
+			if certs := peerCertificates(resp, err); len(certs) > 0 {
+				if h.cfg.Metrics.HttpcheckTLSCertRemaining.Enabled {
+					issuer, commonName, sans, timeLeft := extractTLSInfo(&tls.ConnectionState{PeerCertificates: certs})
+					if issuer != "" || commonName != "" || len(sans) > 0 {
+						h.mb.RecordHttpcheckTLSCertRemainingDataPoint(
+							now,
+							timeLeft,
+							endpoint,
+							issuer,
+							commonName,
+							sans,
+						)
+					}
+				}
+				if h.cfg.Metrics.HttpcheckTLSChainValid.Enabled {
+					valid := int64(0)
+					if verifyChain(certs, req.URL.Hostname(), h.rootCAs[targetIndex]) {
+						valid = 1
+					}
+					h.mb.RecordHttpcheckTLSChainValidDataPoint(now, valid, endpoint)
 				}
+				h.mb.RecordHttpcheckTLSChainCertRemainingDataPoint(now, chainTimeLeft(certs), endpoint)
 			}
 
 			statusCode := 0
@@ -7,6 +7,7 @@ import (
 	"context"
 	"crypto/tls"
 	"crypto/x509"
+	"encoding/pem"
 	"net/http"
 	"net/http/httptest"
 	"path/filepath"
@@ -16,6 +17,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/component/componenttest"
 	"go.opentelemetry.io/collector/config/confighttp"
+	"go.opentelemetry.io/collector/config/configopaque"
 	"go.opentelemetry.io/collector/config/configtls"
 	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/receiver/receivertest"
@@ -331,6 +333,78 @@ func TestHTTPSWithTLSDisabled(t *testing.T) {
 	}
 }
 
+func TestHTTPSChainValidity(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+	caPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+
+	testCases := []struct {
+		desc        string
+		tls         configtls.ClientConfig
+		expectValid int64
+		expectError bool
+	}{
+		{
+			desc:        "trusted certificate",
+			tls:         configtls.ClientConfig{Config: configtls.Config{CAPem: configopaque.String(caPEM)}},
+			expectValid: 1,
+		},
+		{
+			desc:        "untrusted certificate with verification skipped",
+			tls:         configtls.ClientConfig{InsecureSkipVerify: true},
+			expectValid: 0,
+		},
+		{
+			desc:        "untrusted certificate",
+			tls:         configtls.ClientConfig{},
+			expectValid: 0,
+			expectError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			cfg := createDefaultConfig().(*Config)
+			cfg.Metrics.HttpcheckTLSCertRemaining.Enabled = true
+			cfg.Metrics.HttpcheckTLSChainValid.Enabled = true
+			cfg.Metrics.HttpcheckTLSChainCertRemaining.Enabled = true
+			cfg.Targets = []*targetConfig{
+				{
+					ClientConfig: confighttp.ClientConfig{
+						Endpoint: server.URL,
+						TLS:      tc.tls,
+					},
+				},
+			}
+
+			scraper := newScraper(cfg, receivertest.NewNopSettings(metadata.Type))
+			require.NoError(t, scraper.start(context.Background(), componenttest.NewNopHost()))
+
+			metrics, err := scraper.scrape(context.Background())
+			require.NoError(t, err)
+
+			values := map[string]int64{}
+			ms := metrics.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+			for i := 0; i < ms.Len(); i++ {
+				m := ms.At(i)
+				switch m.Name() {
+				case "httpcheck.tls.cert_remaining", "httpcheck.tls.chain.valid", "httpcheck.tls.chain.cert_remaining":
+					require.Equal(t, 1, m.Gauge().DataPoints().Len(), m.Name())
+					values[m.Name()] = m.Gauge().DataPoints().At(0).IntValue()
+				case "httpcheck.error":
+					assert.True(t, tc.expectError, "unexpected request error")
+				}
+			}
+			require.Len(t, values, 3)
+			assert.Equal(t, tc.expectValid, values["httpcheck.tls.chain.valid"])
+			assert.Positive(t, values["httpcheck.tls.cert_remaining"])
+			assert.InDelta(t, values["httpcheck.tls.cert_remaining"], values["httpcheck.tls.chain.cert_remaining"], 1)
+		})
+	}
+}
+
 func TestNilClient(t *testing.T) {
 	scraper := newScraper(createDefaultConfig().(*Config), receivertest.NewNopSettings(metadata.Type))
 	actualMetrics, err := scraper.scrape(context.Background())
@@ -0,0 +1,266 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package httpcheckreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/httpcheckreceiver"
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/cookiejar"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+)
+
+// maxBodySize bounds the part of the response bodies read for the extractions
+// and the assertions.
+const maxBodySize = 4 << 20
+
+var variablePattern = regexp.MustCompile(`{{\s*([A-Za-z0-9_.-]+)\s*}}`)
+
+// transaction holds a transaction and the clients of its steps.
+type transaction struct {
+	cfg     *transactionConfig
+	clients []*http.Client
+	regexes map[string]*regexp.Regexp
+}
+
+// stepResult is the outcome of a step, skipped steps have no duration.
+type stepResult struct {
+	name     string
+	skipped  bool
+	duration time.Duration
+	err      error
+}
+
+// runTransaction runs the steps of the transaction in order, sharing the
+// extracted variables and the cookies between the steps. The steps following a
+// failed step are skipped.
+func runTransaction(ctx context.Context, t *transaction) []stepResult {
+	// The cookie jar only lives for a single run of the transaction, so sessions
+	// are not reused between scrapes.
+	jar, _ := cookiejar.New(nil)
+	vars := map[string]string{}
+	results := make([]stepResult, len(t.cfg.Steps))
+	failed := false
+	for i, step := range t.cfg.Steps {
+		results[i].name = step.Name
+		if failed {
+			results[i].skipped = true
+			continue
+		}
+		client := *t.clients[i]
+		client.Jar = jar
+		start := time.Now()
+		results[i].err = t.runStep(ctx, &client, step, vars)
+		results[i].duration = time.Since(start)
+		failed = results[i].err != nil
+	}
+	return results
+}
+
+func (t *transaction) runStep(ctx context.Context, client *http.Client, step *stepConfig, vars map[string]string) error {
+	endpoint, err := expandVariables(step.Endpoint, vars)
+	if err != nil {
+		return err
+	}
+	body, err := expandVariables(step.Body, vars)
+	if err != nil {
+		return err
+	}
+	var reqBody io.Reader = http.NoBody
+	if body != "" {
+		reqBody = strings.NewReader(body)
+	}
+	req, err := http.NewRequestWithContext(ctx, step.Method, endpoint, reqBody)
+	if err != nil {
+		return err
+	}
+	for key, value := range step.Headers {
+		expanded, expandErr := expandVariables(value.String(), vars)
+		if expandErr != nil {
+			return expandErr
+		}
+		req.Header.Set(key, expanded)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(io.LimitReader(resp.Body, maxBodySize))
+	if err != nil {
+		return err
+	}
+	// Drain the rest of the body to allow connection reuse
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	// The JSON body is only decoded when a JSON path is used.
+	var document any
+	var documentErr error
+	decoded := false
+	jsonDocument := func() (any, error) {
+		if !decoded {
+			decoded = true
+			decoder := json.NewDecoder(bytes.NewReader(respBody))
+			decoder.UseNumber()
+			documentErr = decoder.Decode(&document)
+		}
+		return document, documentErr
+	}
+
+	if err := checkAssertions(step.Assertions, resp.StatusCode, respBody, jsonDocument); err != nil {
+		return err
+	}
+
+	for name, extract := range step.Extract {
+		var value string
+		switch {
+		case extract.Header != "":
+			value = resp.Header.Get(extract.Header)
+			if value == "" {
+				return fmt.Errorf("variable %q: header %q not found", name, extract.Header)
+			}
+		case extract.Regex != "":
+			match := t.regexes[extract.Regex].FindSubmatch(respBody)
+			if match == nil {
+				return fmt.Errorf("variable %q: regex %q did not match", name, extract.Regex)
+			}
+			value = string(match[1])
+		default:
+			doc, err := jsonDocument()
+			if err != nil {
+				return fmt.Errorf("variable %q: invalid JSON body", name)
+			}
+			v, ok := lookupJSONPath(doc, extract.JSONPath)
+			if !ok {
+				return fmt.Errorf("variable %q: json path %q not found", name, extract.JSONPath)
+			}
+			value = jsonString(v)
+		}
+		vars[name] = value
+	}
+	return nil
+}
+
+// checkAssertions checks the response against the assertions of the step. The
+// errors do not include the content of the response so the error.message
+// attribute stays bounded.
+func checkAssertions(assertions assertionsConfig, statusCode int, body []byte, jsonDocument func() (any, error)) error {
+	if len(assertions.StatusCodes) > 0 {
+		if !slices.Contains(assertions.StatusCodes, statusCode) {
+			return fmt.Errorf("unexpected status code %d", statusCode)
+		}
+	} else if statusCode >= http.StatusBadRequest {
+		return fmt.Errorf("unexpected status code %d", statusCode)
+	}
+	for _, expected := range assertions.BodyContains {
+		if !bytes.Contains(body, []byte(expected)) {
+			return fmt.Errorf("body does not contain %q", expected)
+		}
+	}
+	for _, assertion := range assertions.JSON {
+		doc, err := jsonDocument()
+		if err != nil {
+			return errors.New("invalid JSON body")
+		}
+		v, ok := lookupJSONPath(doc, assertion.Path)
+		if !ok {
+			return fmt.Errorf("json path %q not found", assertion.Path)
+		}
+		if assertion.Equals != "" && jsonString(v) != assertion.Equals {
+			return fmt.Errorf("json path %q does not equal %q", assertion.Path, assertion.Equals)
+		}
+	}
+	return nil
+}
+
+// expandVariables replaces the {{name}} references with the value of the variables.
+func expandVariables(s string, vars map[string]string) (string, error) {
+	var err error
+	expanded := variablePattern.ReplaceAllStringFunc(s, func(ref string) string {
+		name := variablePattern.FindStringSubmatch(ref)[1]
+		value, ok := vars[name]
+		if !ok {
+			err = fmt.Errorf("undefined variable %q", name)
+		}
+		return value
+	})
+	return expanded, err
+}
+
+// lookupJSONPath returns the value at the dot separated path, where the elements
+// of the arrays are referenced by their index.
+func lookupJSONPath(doc any, path string) (any, bool) {
+	current := doc
+	for _, key := range strings.Split(path, ".") {
+		switch v := current.(type) {
+		case map[string]any:
+			next, ok := v[key]
+			if !ok {
+				return nil, false
+			}
+			current = next
+		case []any:
+			i, err := strconv.Atoi(key)
+			if err != nil || i < 0 || i >= len(v) {
+				return nil, false
+			}
+			current = v[i]
+		default:
+			return nil, false
+		}
+	}
+	return current, true
+}
+
+// jsonString formats a JSON value, strings are returned unquoted.
+func jsonString(v any) string {
+	switch value := v.(type) {
+	case string:
+		return value
+	case json.Number:
+		return value.String()
+	case bool:
+		return strconv.FormatBool(value)
+	case nil:
+		return "null"
+	default:
+		b, _ := json.Marshal(value)
+		return string(b)
+	}
+}
+
+// recordTransaction records the metrics of a run of the transaction.
+func (h *httpcheckScraper) recordTransaction(now pcommon.Timestamp, name string, results []stepResult) {
+	var total time.Duration
+	status := int64(1)
+	for _, result := range results {
+		stepStatus := int64(1)
+		if result.skipped || result.err != nil {
+			stepStatus = 0
+			status = 0
+		}
+		h.mb.RecordHttpcheckTransactionStepStatusDataPoint(now, stepStatus, name, result.name)
+		if result.skipped {
+			continue
+		}
+		total += result.duration
+		h.mb.RecordHttpcheckTransactionStepDurationDataPoint(now, result.duration.Milliseconds(), name, result.name)
+		if result.err != nil {
+			h.mb.RecordHttpcheckTransactionStepErrorDataPoint(now, int64(1), name, result.name, result.err.Error())
+		}
+	}
+	h.mb.RecordHttpcheckTransactionStatusDataPoint(now, status, name)
+	h.mb.RecordHttpcheckTransactionDurationDataPoint(now, total.Milliseconds(), name)
+}
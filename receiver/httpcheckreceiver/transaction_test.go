@@ -0,0 +1,199 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package httpcheckreceiver
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/config/confighttp"
+	"go.opentelemetry.io/collector/config/configopaque"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/httpcheckreceiver/internal/metadata"
+)
+
+func newTransactionServer(t *testing.T) *httptest.Server {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/login", func(rw http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		http.SetCookie(rw, &http.Cookie{Name: "session", Value: "s1"})
+		rw.Header().Set("X-Request-Id", "r1")
+		_, err := rw.Write([]byte(`{"token": "abc", "user": {"id": 7}}`))
+		assert.NoError(t, err)
+	})
+	mux.HandleFunc("/users/7/orders", func(rw http.ResponseWriter, r *http.Request) {
+		cookie, err := r.Cookie("session")
+		if r.Header.Get("Authorization") != "Bearer abc" || err != nil || cookie.Value != "s1" {
+			rw.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_, err = rw.Write([]byte(`{"orders": [{"id": "o1", "status": "paid"}]}`))
+		assert.NoError(t, err)
+	})
+	return httptest.NewServer(mux)
+}
+
+func TestScraperTransaction(t *testing.T) {
+	server := newTransactionServer(t)
+	defer server.Close()
+
+	testCases := []struct {
+		desc          string
+		assertions    assertionsConfig
+		status        int64
+		ordersStatus  int64
+		expectedError string
+	}{
+		{
+			desc: "assertions pass",
+			assertions: assertionsConfig{
+				StatusCodes:  []int{http.StatusOK},
+				BodyContains: []string{"orders"},
+				JSON:         []jsonAssertion{{Path: "orders.0.status", Equals: "paid"}, {Path: "orders.0.id"}},
+			},
+			status:       1,
+			ordersStatus: 1,
+		},
+		{
+			desc: "json assertion fails",
+			assertions: assertionsConfig{
+				JSON: []jsonAssertion{{Path: "orders.0.status", Equals: "refunded"}},
+			},
+			expectedError: `json path "orders.0.status" does not equal "refunded"`,
+		},
+		{
+			desc: "missing json path",
+			assertions: assertionsConfig{
+				JSON: []jsonAssertion{{Path: "orders.1.id"}},
+			},
+			expectedError: `json path "orders.1.id" not found`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			cfg := createDefaultConfig().(*Config)
+			cfg.Transactions = []*transactionConfig{{
+				Name: "checkout",
+				Steps: []*stepConfig{
+					{
+						ClientConfig: confighttp.ClientConfig{Endpoint: server.URL + "/login"},
+						Name:         "login",
+						Method:       http.MethodPost,
+						Body:         `{"user": "demo"}`,
+						Extract: map[string]extractConfig{
+							"token":      {JSONPath: "token"},
+							"user_id":    {JSONPath: "user.id"},
+							"request_id": {Header: "X-Request-Id"},
+						},
+					},
+					{
+						ClientConfig: confighttp.ClientConfig{
+							Endpoint: server.URL + "/users/{{user_id}}/orders",
+							Headers: map[string]configopaque.String{
+								"Authorization": "Bearer {{ token }}",
+							},
+						},
+						Name:       "orders",
+						Method:     http.MethodGet,
+						Assertions: tc.assertions,
+					},
+				},
+			}}
+			scraper := newScraper(cfg, receivertest.NewNopSettings(metadata.Type))
+			require.NoError(t, scraper.start(context.Background(), componenttest.NewNopHost()))
+
+			metrics, err := scraper.scrape(context.Background())
+			require.NoError(t, err)
+
+			ms := metrics.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+			assert.Equal(t, tc.status, transactionValue(t, ms, "httpcheck.transaction.status", ""))
+			assert.Equal(t, int64(1), transactionValue(t, ms, "httpcheck.transaction.step.status", "login"))
+			assert.Equal(t, tc.ordersStatus, transactionValue(t, ms, "httpcheck.transaction.step.status", "orders"))
+
+			stepErrors := findMetricByName(ms, "httpcheck.transaction.step.error")
+			if tc.expectedError == "" {
+				assert.Equal(t, pmetric.MetricTypeEmpty, stepErrors.Type())
+				return
+			}
+			require.Equal(t, 1, stepErrors.Sum().DataPoints().Len())
+			message, _ := stepErrors.Sum().DataPoints().At(0).Attributes().Get("error.message")
+			assert.Equal(t, tc.expectedError, message.Str())
+		})
+	}
+}
+
+func TestScraperTransactionSkipsStepsAfterFailure(t *testing.T) {
+	server := newTransactionServer(t)
+	defer server.Close()
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.Transactions = []*transactionConfig{{
+		Name: "checkout",
+		Steps: []*stepConfig{
+			{
+				ClientConfig: confighttp.ClientConfig{Endpoint: server.URL + "/users/7/orders"},
+				Name:         "orders",
+			},
+			{
+				ClientConfig: confighttp.ClientConfig{Endpoint: server.URL + "/login"},
+				Name:         "login",
+				Method:       http.MethodPost,
+			},
+		},
+	}}
+	scraper := newScraper(cfg, receivertest.NewNopSettings(metadata.Type))
+	require.NoError(t, scraper.start(context.Background(), componenttest.NewNopHost()))
+
+	metrics, err := scraper.scrape(context.Background())
+	require.NoError(t, err)
+
+	ms := metrics.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	assert.Equal(t, int64(0), transactionValue(t, ms, "httpcheck.transaction.status", ""))
+	assert.Equal(t, int64(0), transactionValue(t, ms, "httpcheck.transaction.step.status", "orders"))
+	assert.Equal(t, int64(0), transactionValue(t, ms, "httpcheck.transaction.step.status", "login"))
+	// The skipped step has no duration.
+	assert.Equal(t, 1, findMetricByName(ms, "httpcheck.transaction.step.duration").Gauge().DataPoints().Len())
+	message, _ := findMetricByName(ms, "httpcheck.transaction.step.error").Sum().DataPoints().At(0).Attributes().Get("error.message")
+	assert.Equal(t, "unexpected status code 401", message.Str())
+}
+
+func TestExpandVariables(t *testing.T) {
+	expanded, err := expandVariables("{{a}}/{{ b }}/{{a}}", map[string]string{"a": "x", "b": "y"})
+	require.NoError(t, err)
+	assert.Equal(t, "x/y/x", expanded)
+
+	_, err = expandVariables("{{missing}}", map[string]string{})
+	assert.EqualError(t, err, `undefined variable "missing"`)
+}
+
+func findMetricByName(ms pmetric.MetricSlice, name string) pmetric.Metric {
+	for i := 0; i < ms.Len(); i++ {
+		if ms.At(i).Name() == name {
+			return ms.At(i)
+		}
+	}
+	return pmetric.NewMetric()
+}
+
+// transactionValue returns the value of the data point of the given step, or of
+// the transaction when step is empty.
+func transactionValue(t *testing.T, ms pmetric.MetricSlice, name, step string) int64 {
+	dps := findMetricByName(ms, name).Sum().DataPoints()
+	for i := 0; i < dps.Len(); i++ {
+		stepName, ok := dps.At(i).Attributes().Get("transaction.step")
+		if step == "" || (ok && stepName.Str() == step) {
+			return dps.At(i).IntValue()
+		}
+	}
+	require.Failf(t, "data point not found", "no data point of %s for step %q", name, step)
+	return 0
+}
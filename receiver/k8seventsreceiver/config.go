@@ -4,6 +4,9 @@
 package k8seventsreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8seventsreceiver"
 
 import (
+	"errors"
+	"time"
+
 	k8s "k8s.io/client-go/kubernetes"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/k8sconfig"
@@ -16,11 +19,20 @@ type Config struct {
 	// List of ‘namespaces’ to collect events from.
 	Namespaces []string `mapstructure:"namespaces"`
 
+	// DedupWindow is the window during which repeated occurrences of the same event are
+	// aggregated in a single log record. The first occurrence is emitted right away, the
+	// following ones are counted and emitted once the window elapses.
+	// The deduplication is disabled when it is 0, the default.
+	DedupWindow time.Duration `mapstructure:"dedup_window"`
+
 	// For mocking
 	makeClient func(apiConf k8sconfig.APIConfig) (k8s.Interface, error)
 }
 
 func (cfg *Config) Validate() error {
+	if cfg.DedupWindow < 0 {
+		return errors.New("dedup_window must not be negative")
+	}
 	return cfg.APIConfig.Validate()
 }
 
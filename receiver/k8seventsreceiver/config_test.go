@@ -6,6 +6,7 @@ package k8seventsreceiver
 import (
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -35,7 +36,8 @@ func TestLoadConfig(t *testing.T) {
 		{
 			id: component.NewIDWithName(metadata.Type, "all_settings"),
 			expected: &Config{
-				Namespaces: []string{"default", "my_namespace"},
+				Namespaces:  []string{"default", "my_namespace"},
+				DedupWindow: 30 * time.Second,
 				APIConfig: k8sconfig.APIConfig{
 					AuthType: k8sconfig.AuthTypeServiceAccount,
 				},
@@ -57,3 +59,9 @@ func TestLoadConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateDedupWindow(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.DedupWindow = -time.Second
+	assert.EqualError(t, cfg.Validate(), "dedup_window must not be negative")
+}
@@ -0,0 +1,111 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package k8seventsreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8seventsreceiver"
+
+import (
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+// eventKey identifies the occurrences of an event that are deduplicated together.
+type eventKey struct {
+	namespace string
+	kind      string
+	name      string
+	uid       string
+	eventType string
+	reason    string
+	message   string
+}
+
+func newEventKey(ev *corev1.Event) eventKey {
+	return eventKey{
+		namespace: ev.InvolvedObject.Namespace,
+		kind:      ev.InvolvedObject.Kind,
+		name:      ev.InvolvedObject.Name,
+		uid:       string(ev.InvolvedObject.UID),
+		eventType: ev.Type,
+		reason:    ev.Reason,
+		message:   ev.Message,
+	}
+}
+
+// pendingEvent holds the state of the current dedup window of an event.
+type pendingEvent struct {
+	// Latest occurrence of the event.
+	event       *corev1.Event
+	windowStart time.Time
+	// Number of occurrences seen since the window started and not emitted yet.
+	occurrences int64
+}
+
+// aggregatedEvent is the latest occurrence of an event, along with the number of
+// occurrences it stands for.
+type aggregatedEvent struct {
+	event       *corev1.Event
+	occurrences int64
+}
+
+// deduplicator aggregates the repeated occurrences of the same event in a sliding
+// window: the first occurrence is emitted right away, the following ones are
+// counted and emitted as a single log record once the window elapses.
+type deduplicator struct {
+	window time.Duration
+
+	mu      sync.Mutex
+	pending map[eventKey]*pendingEvent
+
+	// For mocking.
+	now func() time.Time
+}
+
+func newDeduplicator(window time.Duration) *deduplicator {
+	return &deduplicator{
+		window:  window,
+		pending: map[eventKey]*pendingEvent{},
+		now:     time.Now,
+	}
+}
+
+// add records an occurrence of the event, and returns whether it is the first
+// one of its window and must be emitted right away.
+func (d *deduplicator) add(ev *corev1.Event) bool {
+	key := newEventKey(ev)
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if p, ok := d.pending[key]; ok {
+		p.event = ev
+		p.occurrences++
+		return false
+	}
+	d.pending[key] = &pendingEvent{event: ev, windowStart: d.now()}
+	return true
+}
+
+// flush returns the aggregated occurrences of the events whose window elapsed, or
+// of all the events if force is set. Events without new occurrences during their
+// window are forgotten, so the next occurrence is emitted right away.
+func (d *deduplicator) flush(force bool) []aggregatedEvent {
+	now := d.now()
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	var events []aggregatedEvent
+	for key, p := range d.pending {
+		if !force && now.Sub(p.windowStart) < d.window {
+			continue
+		}
+		if p.occurrences == 0 {
+			delete(d.pending, key)
+			continue
+		}
+		events = append(events, aggregatedEvent{event: p.event, occurrences: p.occurrences})
+		p.windowStart = now
+		p.occurrences = 0
+	}
+	return events
+}
@@ -0,0 +1,61 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package k8seventsreceiver
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeduplicator(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	d := newDeduplicator(time.Minute)
+	d.now = func() time.Time { return now }
+
+	// The first occurrence is emitted right away.
+	first := getEvent()
+	assert.True(t, d.add(first))
+
+	// Following occurrences are aggregated until the window elapses.
+	now = now.Add(10 * time.Second)
+	assert.False(t, d.add(getEvent()))
+	now = now.Add(10 * time.Second)
+	latest := getEvent()
+	latest.Name = "2"
+	assert.False(t, d.add(latest))
+	assert.Empty(t, d.flush(false))
+
+	// Other events aren't aggregated with it.
+	other := getEvent()
+	other.Reason = "testing_event_2"
+	assert.True(t, d.add(other))
+
+	now = now.Add(time.Minute)
+	events := d.flush(false)
+	require.Len(t, events, 1)
+	assert.Same(t, latest, events[0].event)
+	assert.Equal(t, int64(2), events[0].occurrences)
+
+	// Without new occurrences in the window the event is forgotten, and the next
+	// occurrence is emitted right away.
+	now = now.Add(time.Minute)
+	assert.Empty(t, d.flush(false))
+	assert.Empty(t, d.pending)
+	assert.True(t, d.add(getEvent()))
+}
+
+func TestDeduplicatorForceFlush(t *testing.T) {
+	d := newDeduplicator(time.Minute)
+
+	assert.True(t, d.add(getEvent()))
+	assert.False(t, d.add(getEvent()))
+	assert.False(t, d.add(getEvent()))
+
+	events := d.flush(true)
+	require.Len(t, events, 1)
+	assert.Equal(t, int64(2), events[0].occurrences)
+}
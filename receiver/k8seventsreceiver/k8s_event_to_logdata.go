@@ -81,3 +81,10 @@ func k8sEventToLogData(logger *zap.Logger, ev *corev1.Event) plog.Logs {
 
 	return ld
 }
+
+// setAggregatedCount sets the number of occurrences of the event represented by
+// the log record of the given event log data.
+func setAggregatedCount(ld plog.Logs, occurrences int64) {
+	lr := ld.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0)
+	lr.Attributes().PutInt("k8s.event.aggregated_count", occurrences)
+}
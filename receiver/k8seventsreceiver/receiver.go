@@ -5,10 +5,12 @@ package k8seventsreceiver // import "github.com/open-telemetry/opentelemetry-col
 
 import (
 	"context"
+	"sync"
 	"time"
 
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/pdata/plog"
 	"go.opentelemetry.io/collector/receiver"
 	"go.opentelemetry.io/collector/receiver/receiverhelper"
 	corev1 "k8s.io/api/core/v1"
@@ -28,6 +30,8 @@ type k8seventsReceiver struct {
 	ctx             context.Context
 	cancel          context.CancelFunc
 	obsrecv         *receiverhelper.ObsReport
+	dedup           *deduplicator
+	dedupWG         sync.WaitGroup
 }
 
 // newReceiver creates the Kubernetes events receiver with the given configuration.
@@ -47,13 +51,17 @@ func newReceiver(
 		return nil, err
 	}
 
-	return &k8seventsReceiver{
+	kr := &k8seventsReceiver{
 		settings:     set,
 		config:       config,
 		logsConsumer: consumer,
 		startTime:    time.Now(),
 		obsrecv:      obsrecv,
-	}, nil
+	}
+	if config.DedupWindow > 0 {
+		kr.dedup = newDeduplicator(config.DedupWindow)
+	}
+	return kr, nil
 }
 
 func (kr *k8seventsReceiver) Start(ctx context.Context, _ component.Host) error {
@@ -85,6 +93,7 @@ func (kr *k8seventsReceiver) Shutdown(context.Context) error {
 		close(stopperChan)
 	}
 	kr.cancel()
+	kr.dedupWG.Wait()
 	return nil
 }
 
@@ -107,15 +116,55 @@ func (kr *k8seventsReceiver) startWatch(ns string, client k8s.Interface) {
 }
 
 func (kr *k8seventsReceiver) handleEvent(ev *corev1.Event) {
-	if kr.allowEvent(ev) {
-		ld := k8sEventToLogData(kr.settings.Logger, ev)
+	if !kr.allowEvent(ev) {
+		return
+	}
+	if kr.dedup != nil && !kr.dedup.add(ev) {
+		return
+	}
+	ld := k8sEventToLogData(kr.settings.Logger, ev)
+	if kr.dedup != nil {
+		setAggregatedCount(ld, 1)
+	}
+	kr.consumeLogs(kr.ctx, ld)
+}
 
-		ctx := kr.obsrecv.StartLogsOp(kr.ctx)
-		consumerErr := kr.logsConsumer.ConsumeLogs(ctx, ld)
-		kr.obsrecv.EndLogsOp(ctx, metadata.Type.String(), 1, consumerErr)
+// flushDedupEvents periodically emits the aggregated occurrences of the events
+// until the receiver is shut down, when all the pending occurrences are emitted.
+func (kr *k8seventsReceiver) flushDedupEvents() {
+	defer kr.dedupWG.Done()
+
+	ticker := time.NewTicker(kr.dedup.window)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			kr.emitAggregatedEvents(kr.ctx, false)
+		case <-kr.ctx.Done():
+			kr.emitAggregatedEvents(context.WithoutCancel(kr.ctx), true)
+			return
+		}
 	}
 }
 
+func (kr *k8seventsReceiver) emitAggregatedEvents(ctx context.Context, force bool) {
+	ld := plog.NewLogs()
+	for _, aggregated := range kr.dedup.flush(force) {
+		eventLogs := k8sEventToLogData(kr.settings.Logger, aggregated.event)
+		setAggregatedCount(eventLogs, aggregated.occurrences)
+		eventLogs.ResourceLogs().MoveAndAppendTo(ld.ResourceLogs())
+	}
+	if ld.LogRecordCount() > 0 {
+		kr.consumeLogs(ctx, ld)
+	}
+}
+
+func (kr *k8seventsReceiver) consumeLogs(ctx context.Context, ld plog.Logs) {
+	ctx = kr.obsrecv.StartLogsOp(ctx)
+	consumerErr := kr.logsConsumer.ConsumeLogs(ctx, ld)
+	kr.obsrecv.EndLogsOp(ctx, metadata.Type.String(), ld.LogRecordCount(), consumerErr)
+}
+
 // startWatchingNamespace creates an informer and starts
 // watching a specific namespace for the events.
 func (*k8seventsReceiver) startWatchingNamespace(
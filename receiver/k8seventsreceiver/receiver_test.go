@@ -89,6 +89,36 @@ func TestDropEventsOlderThanStartupTime(t *testing.T) {
 	assert.Equal(t, 0, sink.LogRecordCount())
 }
 
+func TestHandleEventDedup(t *testing.T) {
+	rCfg := createDefaultConfig().(*Config)
+	rCfg.DedupWindow = time.Hour
+	rCfg.makeClient = func(k8sconfig.APIConfig) (k8s.Interface, error) {
+		return fake.NewSimpleClientset(), nil
+	}
+	sink := new(consumertest.LogsSink)
+	r, err := newReceiver(
+		receivertest.NewNopSettings(metadata.Type),
+		rCfg,
+		sink,
+	)
+	require.NoError(t, err)
+	require.NoError(t, r.Start(context.Background(), componenttest.NewNopHost()))
+	recv := r.(*k8seventsReceiver)
+
+	for range 3 {
+		recv.handleEvent(getEvent())
+	}
+	require.Equal(t, 1, sink.LogRecordCount())
+	count, _ := sink.AllLogs()[0].ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0).Attributes().Get("k8s.event.aggregated_count")
+	assert.Equal(t, int64(1), count.Int())
+
+	// The pending occurrences are emitted on shutdown.
+	require.NoError(t, r.Shutdown(context.Background()))
+	require.Equal(t, 2, sink.LogRecordCount())
+	count, _ = sink.AllLogs()[1].ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0).Attributes().Get("k8s.event.aggregated_count")
+	assert.Equal(t, int64(2), count.Int())
+}
+
 func TestGetEventTimestamp(t *testing.T) {
 	k8sEvent := getEvent()
 	eventTimestamp := getEventTimestamp(k8sEvent)
@@ -10,6 +10,8 @@ import (
 	"time"
 
 	"go.opentelemetry.io/collector/component"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	apiWatch "k8s.io/apimachinery/pkg/watch"
 	"k8s.io/client-go/discovery"
@@ -65,6 +67,11 @@ type Config struct {
 
 	K8sLeaderElector *component.ID `mapstructure:"k8s_leader_elector"`
 
+	// StorageID is the ID of the storage extension used to checkpoint the
+	// resourceVersion of the watched objects, so that watches resume after
+	// a restart instead of listing all the objects again.
+	StorageID *component.ID `mapstructure:"storage"`
+
 	// For mocking purposes only.
 	makeDiscoveryClient func() (discovery.ServerResourcesInterface, error)
 	makeDynamicClient   func() (dynamic.Interface, error)
@@ -95,6 +102,14 @@ func (c *Config) Validate() error {
 		if object.Mode == PullMode && c.IncludeInitialState {
 			return errors.New("include_initial_state can only be used with watch mode")
 		}
+
+		if _, err := labels.Parse(object.LabelSelector); err != nil {
+			return fmt.Errorf("invalid label_selector for %q: %w", object.Name, err)
+		}
+
+		if _, err := fields.ParseSelector(object.FieldSelector); err != nil {
+			return fmt.Errorf("invalid field_selector for %q: %w", object.Name, err)
+		}
 	}
 	return nil
 }
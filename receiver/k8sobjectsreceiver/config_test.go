@@ -22,6 +22,8 @@ import (
 func TestLoadConfig(t *testing.T) {
 	t.Parallel()
 
+	storageID := component.MustNewID("file_storage")
+
 	tests := []struct {
 		id       component.ID
 		expected *Config
@@ -97,6 +99,23 @@ func TestLoadConfig(t *testing.T) {
 				},
 			},
 		},
+		{
+			id: component.NewIDWithName(metadata.Type, "watch_with_storage"),
+			expected: &Config{
+				APIConfig: k8sconfig.APIConfig{
+					AuthType: k8sconfig.AuthTypeServiceAccount,
+				},
+				Objects: []*K8sObjectsConfig{
+					{
+						Name:          "pods",
+						Mode:          WatchMode,
+						LabelSelector: "app=frontend",
+						FieldSelector: "spec.nodeName=node-1",
+					},
+				},
+				StorageID: &storageID,
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -113,6 +132,7 @@ func TestLoadConfig(t *testing.T) {
 
 			assert.Equal(t, tt.expected.AuthType, cfg.AuthType)
 			assert.Equal(t, tt.expected.Objects, cfg.Objects)
+			assert.Equal(t, tt.expected.StorageID, cfg.StorageID)
 
 			err = cfg.Validate()
 			if tt.expected == nil {
@@ -159,6 +179,34 @@ func TestValidate(t *testing.T) {
 			},
 			expectedErr: "the Exclude config can only be used with watch mode",
 		},
+		{
+			desc: "invalid label selector",
+			cfg: &Config{
+				ErrorMode: PropagateError,
+				Objects: []*K8sObjectsConfig{
+					{
+						Name:          "pods",
+						Mode:          WatchMode,
+						LabelSelector: "environment in production",
+					},
+				},
+			},
+			expectedErr: `invalid label_selector for "pods": unable to parse requirement: found 'production' expected: '('`,
+		},
+		{
+			desc: "invalid field selector",
+			cfg: &Config{
+				ErrorMode: PropagateError,
+				Objects: []*K8sObjectsConfig{
+					{
+						Name:          "pods",
+						Mode:          WatchMode,
+						FieldSelector: "status.phase",
+					},
+				},
+			},
+			expectedErr: `invalid field_selector for "pods": invalid selector: 'status.phase'; can't understand 'status.phase'`,
+		},
 		{
 			desc: "default mode is set",
 			cfg: &Config{
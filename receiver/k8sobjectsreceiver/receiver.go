@@ -13,11 +13,13 @@ import (
 
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/extension/xextension/storage"
 	"go.opentelemetry.io/collector/receiver"
 	"go.opentelemetry.io/collector/receiver/receiverhelper"
 	"go.uber.org/zap"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/util/wait"
 	apiWatch "k8s.io/apimachinery/pkg/watch"
 	"k8s.io/client-go/dynamic"
@@ -25,6 +27,7 @@ import (
 	"k8s.io/client-go/tools/watch"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/k8sleaderelector"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/storage/storageclient"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sobjectsreceiver/internal/metadata"
 )
 
@@ -34,6 +37,7 @@ type k8sobjectsreceiver struct {
 	objects         []*K8sObjectsConfig
 	stopperChanList []chan struct{}
 	client          dynamic.Interface
+	storageClient   storage.Client
 	consumer        consumer.Logs
 	obsrecv         *receiverhelper.ObsReport
 	mu              sync.Mutex
@@ -120,6 +124,13 @@ func (kr *k8sobjectsreceiver) Start(ctx context.Context, host component.Host) er
 		return err
 	}
 
+	if kr.config.StorageID != nil {
+		kr.storageClient, err = storageclient.Get(ctx, host, *kr.config.StorageID, component.KindReceiver, kr.setting.ID, "")
+		if err != nil {
+			return err
+		}
+	}
+
 	if kr.config.K8sLeaderElector != nil {
 		k8sLeaderElector := host.GetExtensions()[*kr.config.K8sLeaderElector]
 		if k8sLeaderElector == nil {
@@ -143,10 +154,7 @@ func (kr *k8sobjectsreceiver) Start(ctx context.Context, host component.Host) er
 			},
 			func() {
 				kr.setting.Logger.Info("no longer leader, stopping")
-				err = kr.Shutdown(context.Background())
-				if err != nil {
-					kr.setting.Logger.Error("shutdown receiver error:", zap.Error(err))
-				}
+				kr.stop()
 			})
 	} else {
 		cctx, cancel := context.WithCancel(ctx)
@@ -159,7 +167,17 @@ func (kr *k8sobjectsreceiver) Start(ctx context.Context, host component.Host) er
 	return nil
 }
 
-func (kr *k8sobjectsreceiver) Shutdown(context.Context) error {
+func (kr *k8sobjectsreceiver) Shutdown(ctx context.Context) error {
+	kr.stop()
+	if kr.storageClient != nil {
+		return kr.storageClient.Close(ctx)
+	}
+	return nil
+}
+
+// stop stops collecting all the objects, the storage client is left open so
+// that collection can be started again when regaining the leadership.
+func (kr *k8sobjectsreceiver) stop() {
 	kr.setting.Logger.Info("Object Receiver stopped")
 	if kr.cancel != nil {
 		kr.cancel()
@@ -169,8 +187,8 @@ func (kr *k8sobjectsreceiver) Shutdown(context.Context) error {
 	for _, stopperChan := range kr.stopperChanList {
 		close(stopperChan)
 	}
+	kr.stopperChanList = nil
 	kr.mu.Unlock()
-	return nil
 }
 
 func (kr *k8sobjectsreceiver) start(ctx context.Context, object *K8sObjectsConfig) {
@@ -192,10 +210,10 @@ func (kr *k8sobjectsreceiver) start(ctx context.Context, object *K8sObjectsConfi
 
 	case WatchMode:
 		if len(object.Namespaces) == 0 {
-			go kr.startWatch(ctx, object, resource)
+			go kr.startWatch(ctx, object, resource, "")
 		} else {
 			for _, ns := range object.Namespaces {
-				go kr.startWatch(ctx, object, resource.Namespace(ns))
+				go kr.startWatch(ctx, object, resource.Namespace(ns), ns)
 			}
 		}
 	}
@@ -239,13 +257,23 @@ func (kr *k8sobjectsreceiver) startPull(ctx context.Context, config *K8sObjectsC
 	}
 }
 
-func (kr *k8sobjectsreceiver) startWatch(ctx context.Context, config *K8sObjectsConfig, resource dynamic.ResourceInterface) {
+func (kr *k8sobjectsreceiver) startWatch(ctx context.Context, config *K8sObjectsConfig, resource dynamic.ResourceInterface, namespace string) {
 	stopperChan := make(chan struct{})
 	kr.mu.Lock()
 	kr.stopperChanList = append(kr.stopperChanList, stopperChan)
 	kr.mu.Unlock()
 
-	if kr.config.IncludeInitialState {
+	cfgCopy := *config
+	checkpointKey := resourceVersionKey(config, namespace)
+	if resourceVersion := kr.loadResourceVersion(ctx, checkpointKey); resourceVersion != "" {
+		// Resume the watch where the previous run stopped, the initial state
+		// was already sent by the run that created the checkpoint.
+		kr.setting.Logger.Info("resuming watch from checkpoint",
+			zap.String("resource", config.gvr.String()),
+			zap.String("namespace", namespace),
+			zap.String("resourceVersion", resourceVersion))
+		cfgCopy.ResourceVersion = resourceVersion
+	} else if kr.config.IncludeInitialState {
 		kr.sendInitialState(ctx, config, resource)
 	}
 
@@ -256,7 +284,6 @@ func (kr *k8sobjectsreceiver) startWatch(ctx context.Context, config *K8sObjects
 	}
 
 	cancelCtx, cancel := context.WithCancel(ctx)
-	cfgCopy := *config
 	wait.UntilWithContext(cancelCtx, func(newCtx context.Context) {
 		resourceVersion, err := getResourceVersion(newCtx, &cfgCopy, resource)
 		if err != nil {
@@ -267,7 +294,9 @@ func (kr *k8sobjectsreceiver) startWatch(ctx context.Context, config *K8sObjects
 			return
 		}
 
-		done := kr.doWatch(newCtx, &cfgCopy, resourceVersion, watchFunc, stopperChan)
+		kr.storeResourceVersion(newCtx, checkpointKey, resourceVersion)
+
+		done := kr.doWatch(newCtx, &cfgCopy, resourceVersion, checkpointKey, watchFunc, stopperChan)
 		if done {
 			cancel()
 			return
@@ -330,7 +359,7 @@ func (kr *k8sobjectsreceiver) sendInitialState(ctx context.Context, config *K8sO
 }
 
 // doWatch returns true when watching is done, false when watching should be restarted.
-func (kr *k8sobjectsreceiver) doWatch(ctx context.Context, config *K8sObjectsConfig, resourceVersion string, watchFunc func(options metav1.ListOptions) (apiWatch.Interface, error), stopperChan chan struct{}) bool {
+func (kr *k8sobjectsreceiver) doWatch(ctx context.Context, config *K8sObjectsConfig, resourceVersion, checkpointKey string, watchFunc func(options metav1.ListOptions) (apiWatch.Interface, error), stopperChan chan struct{}) bool {
 	watcher, err := watch.NewRetryWatcher(resourceVersion, &cache.ListWatch{WatchFunc: watchFunc})
 	if err != nil {
 		kr.setting.Logger.Error("error in watching object",
@@ -364,16 +393,19 @@ func (kr *k8sobjectsreceiver) doWatch(ctx context.Context, config *K8sObjectsCon
 			if config.exclude[data.Type] {
 				kr.setting.Logger.Debug("dropping excluded data",
 					zap.String("type", string(data.Type)))
-				continue
+			} else {
+				logs, err := watchObjectsToLogData(&data, time.Now(), config)
+				if err != nil {
+					kr.setting.Logger.Error("error converting objects to log data", zap.Error(err))
+				} else {
+					obsCtx := kr.obsrecv.StartLogsOp(ctx)
+					err := kr.consumer.ConsumeLogs(obsCtx, logs)
+					kr.obsrecv.EndLogsOp(obsCtx, metadata.Type.String(), 1, err)
+				}
 			}
 
-			logs, err := watchObjectsToLogData(&data, time.Now(), config)
-			if err != nil {
-				kr.setting.Logger.Error("error converting objects to log data", zap.Error(err))
-			} else {
-				obsCtx := kr.obsrecv.StartLogsOp(ctx)
-				err := kr.consumer.ConsumeLogs(obsCtx, logs)
-				kr.obsrecv.EndLogsOp(obsCtx, metadata.Type.String(), 1, err)
+			if obj, isUnstructured := data.Object.(*unstructured.Unstructured); isUnstructured {
+				kr.storeResourceVersion(ctx, checkpointKey, obj.GetResourceVersion())
 			}
 		case <-stopperChan:
 			watcher.Stop()
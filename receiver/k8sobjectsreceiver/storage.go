@@ -0,0 +1,59 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package k8sobjectsreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sobjectsreceiver"
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"go.uber.org/zap"
+)
+
+// resourceVersionKey returns the storage key of the resourceVersion checkpoint of
+// the watch of the given object in the given namespace. The selectors are part of
+// the key so that objects only differing by their selectors don't share a checkpoint.
+func resourceVersionKey(config *K8sObjectsConfig, namespace string) string {
+	query := url.Values{}
+	if config.LabelSelector != "" {
+		query.Set("label_selector", config.LabelSelector)
+	}
+	if config.FieldSelector != "" {
+		query.Set("field_selector", config.FieldSelector)
+	}
+	key := fmt.Sprintf("resource_version/%s/%s/%s/%s", config.gvr.Group, config.gvr.Version, config.gvr.Resource, namespace)
+	if len(query) > 0 {
+		key += "?" + query.Encode()
+	}
+	return key
+}
+
+// loadResourceVersion returns the resourceVersion checkpointed by a previous run
+// of the receiver, or an empty string when there is none.
+func (kr *k8sobjectsreceiver) loadResourceVersion(ctx context.Context, key string) string {
+	if kr.storageClient == nil {
+		return ""
+	}
+	data, err := kr.storageClient.Get(ctx, key)
+	if err != nil {
+		kr.setting.Logger.Warn("could not load the resourceVersion checkpoint",
+			zap.String("key", key),
+			zap.Error(err))
+		return ""
+	}
+	return string(data)
+}
+
+// storeResourceVersion checkpoints the last resourceVersion seen by a watch so
+// that it can be resumed after a restart.
+func (kr *k8sobjectsreceiver) storeResourceVersion(ctx context.Context, key, resourceVersion string) {
+	if kr.storageClient == nil || resourceVersion == "" {
+		return
+	}
+	if err := kr.storageClient.Set(ctx, key, []byte(resourceVersion)); err != nil {
+		kr.setting.Logger.Warn("could not store the resourceVersion checkpoint",
+			zap.String("key", key),
+			zap.Error(err))
+	}
+}
@@ -0,0 +1,87 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package k8sobjectsreceiver
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/storage/storagetest"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sobjectsreceiver/internal/metadata"
+)
+
+func TestResourceVersionKey(t *testing.T) {
+	gvr := &schema.GroupVersionResource{Group: "events.k8s.io", Version: "v1", Resource: "events"}
+
+	assert.Equal(t, "resource_version/events.k8s.io/v1/events/default",
+		resourceVersionKey(&K8sObjectsConfig{gvr: gvr}, "default"))
+	assert.Equal(t, "resource_version/events.k8s.io/v1/events/",
+		resourceVersionKey(&K8sObjectsConfig{gvr: gvr}, ""))
+	assert.Equal(t, "resource_version/events.k8s.io/v1/events/default?field_selector=type%3DWarning&label_selector=app%3Dfrontend",
+		resourceVersionKey(&K8sObjectsConfig{gvr: gvr, LabelSelector: "app=frontend", FieldSelector: "type=Warning"}, "default"))
+}
+
+func TestWatchObjectResumesFromCheckpoint(t *testing.T) {
+	t.Parallel()
+
+	mockClient := newMockDynamicClient()
+	mockClient.createPods(
+		generatePod("pod1", "default", map[string]any{
+			"environment": "production",
+		}, "1"),
+	)
+
+	rCfg := createDefaultConfig().(*Config)
+	rCfg.makeDynamicClient = mockClient.getMockDynamicClient
+	rCfg.makeDiscoveryClient = getMockDiscoveryClient
+	rCfg.IncludeInitialState = true
+	rCfg.Objects = []*K8sObjectsConfig{
+		{
+			Name:       "pods",
+			Mode:       WatchMode,
+			Namespaces: []string{"default"},
+		},
+	}
+
+	client := storagetest.NewInMemoryClient(component.KindReceiver, component.NewID(metadata.Type), "")
+	key := "resource_version//v1/pods/default"
+	ctx := context.Background()
+	require.NoError(t, client.Set(ctx, key, []byte("1")))
+
+	consumer := newMockLogConsumer()
+	r, err := newReceiver(
+		receivertest.NewNopSettings(metadata.Type),
+		rCfg,
+		consumer,
+	)
+	require.NoError(t, err)
+	r.(*k8sobjectsreceiver).storageClient = client
+	require.NoError(t, r.Start(ctx, componenttest.NewNopHost()))
+
+	// The initial state isn't sent again when resuming from a checkpoint.
+	time.Sleep(time.Millisecond * 100)
+	assert.Equal(t, 0, consumer.Count())
+
+	mockClient.createPods(
+		generatePod("pod2", "default", map[string]any{
+			"environment": "production",
+		}, "2"),
+	)
+
+	assert.Eventually(t, func() bool {
+		data, _ := client.Get(ctx, key)
+		return string(data) == "2"
+	}, time.Second, 10*time.Millisecond)
+	assert.Equal(t, 1, consumer.Count())
+
+	assert.NoError(t, r.Shutdown(ctx))
+}
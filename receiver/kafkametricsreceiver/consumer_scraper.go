@@ -28,10 +28,13 @@ type consumerScraper struct {
 	clusterAdmin sarama.ClusterAdmin
 	config       Config
 	mb           *metadata.MetricsBuilder
+	// offsets holds the history of the latest offsets used to estimate the time lag.
+	offsets *offsetHistory
 }
 
 func (s *consumerScraper) start(_ context.Context, _ component.Host) error {
 	s.mb = metadata.NewMetricsBuilder(s.config.MetricsBuilderConfig, s.settings)
+	s.offsets = newOffsetHistory()
 	return nil
 }
 
@@ -113,7 +116,17 @@ func (s *consumerScraper) scrape(context.Context) (pmetric.Metrics, error) {
 		return pmetric.Metrics{}, listErr
 	}
 
-	now := pcommon.NewTimestampFromTime(time.Now())
+	scrapeTime := time.Now()
+	now := pcommon.NewTimestampFromTime(scrapeTime)
+	timeLagEnabled := s.config.Metrics.KafkaConsumerGroupTimeLag.Enabled || s.config.Metrics.KafkaConsumerGroupTimeLagMax.Enabled
+	if timeLagEnabled {
+		for topic, offsets := range topicPartitionOffset {
+			for partition, offset := range offsets {
+				s.offsets.add(topic, partition, offset, scrapeTime)
+			}
+		}
+		s.offsets.retain(topicPartitions)
+	}
 
 	for _, group := range consumerGroups {
 		s.mb.RecordKafkaConsumerGroupMembersDataPoint(now, int64(len(group.Members)), group.GroupId)
@@ -137,6 +150,8 @@ func (s *consumerScraper) scrape(context.Context) (pmetric.Metrics, error) {
 			if isConsumed {
 				var lagSum int64
 				var offsetSum int64
+				var timeLagMax time.Duration
+				hasTimeLag := false
 				for partition, block := range partitions {
 					consumerOffset := block.Offset
 					offsetSum += consumerOffset
@@ -152,9 +167,20 @@ func (s *consumerScraper) scrape(context.Context) (pmetric.Metrics, error) {
 						}
 					}
 					s.mb.RecordKafkaConsumerGroupLagDataPoint(now, consumerLag, group.GroupId, topic, int64(partition))
+
+					if timeLagEnabled && consumerLag != -1 {
+						if timeLag, ok := s.offsets.timeLag(topic, partition, consumerOffset, scrapeTime); ok {
+							s.mb.RecordKafkaConsumerGroupTimeLagDataPoint(now, timeLag.Seconds(), group.GroupId, topic, int64(partition))
+							timeLagMax = max(timeLagMax, timeLag)
+							hasTimeLag = true
+						}
+					}
 				}
 				s.mb.RecordKafkaConsumerGroupOffsetSumDataPoint(now, offsetSum, group.GroupId, topic)
 				s.mb.RecordKafkaConsumerGroupLagSumDataPoint(now, lagSum, group.GroupId, topic)
+				if hasTimeLag {
+					s.mb.RecordKafkaConsumerGroupTimeLagMaxDataPoint(now, timeLagMax.Seconds(), group.GroupId, topic)
+				}
 			}
 		}
 	}
@@ -137,6 +137,42 @@ func TestConsumerScraper_scrape(t *testing.T) {
 	assert.NotNil(t, md)
 }
 
+func TestConsumerScraper_scrape_timeLag(t *testing.T) {
+	filter := regexp.MustCompile(defaultGroupMatch)
+	client := newMockClient()
+	client.offset = 5
+	cfg := createDefaultConfig().(*Config)
+	cfg.Metrics.KafkaConsumerGroupTimeLag.Enabled = true
+	cfg.Metrics.KafkaConsumerGroupTimeLagMax.Enabled = true
+	cs := consumerScraper{
+		client:       client,
+		settings:     receivertest.NewNopSettings(metadata.Type),
+		clusterAdmin: newMockClusterAdmin(),
+		topicFilter:  filter,
+		groupFilter:  filter,
+		config:       *cfg,
+	}
+	require.NoError(t, cs.start(context.Background(), componenttest.NewNopHost()))
+	md, err := cs.scrape(context.Background())
+	require.NoError(t, err)
+
+	found := map[string]bool{}
+	metrics := md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	for i := 0; i < metrics.Len(); i++ {
+		m := metrics.At(i)
+		switch m.Name() {
+		case "kafka.consumer_group.time_lag", "kafka.consumer_group.time_lag_max":
+			found[m.Name()] = true
+			require.Equal(t, 1, m.Gauge().DataPoints().Len())
+			// The committed offset is older than the history, the lag is the age
+			// of the oldest sample.
+			assert.GreaterOrEqual(t, m.Gauge().DataPoints().At(0).DoubleValue(), float64(0))
+		}
+	}
+	assert.True(t, found["kafka.consumer_group.time_lag"])
+	assert.True(t, found["kafka.consumer_group.time_lag_max"])
+}
+
 func TestConsumerScraper_scrape_handlesListTopicError(t *testing.T) {
 	filter := regexp.MustCompile(defaultGroupMatch)
 	clusterAdmin := newMockClusterAdmin()
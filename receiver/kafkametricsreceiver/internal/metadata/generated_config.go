@@ -35,6 +35,8 @@ type MetricsConfig struct {
 	KafkaConsumerGroupMembers     MetricConfig `mapstructure:"kafka.consumer_group.members"`
 	KafkaConsumerGroupOffset      MetricConfig `mapstructure:"kafka.consumer_group.offset"`
 	KafkaConsumerGroupOffsetSum   MetricConfig `mapstructure:"kafka.consumer_group.offset_sum"`
+	KafkaConsumerGroupTimeLag     MetricConfig `mapstructure:"kafka.consumer_group.time_lag"`
+	KafkaConsumerGroupTimeLagMax  MetricConfig `mapstructure:"kafka.consumer_group.time_lag_max"`
 	KafkaPartitionCurrentOffset   MetricConfig `mapstructure:"kafka.partition.current_offset"`
 	KafkaPartitionOldestOffset    MetricConfig `mapstructure:"kafka.partition.oldest_offset"`
 	KafkaPartitionReplicas        MetricConfig `mapstructure:"kafka.partition.replicas"`
@@ -69,6 +71,12 @@ func DefaultMetricsConfig() MetricsConfig {
 		KafkaConsumerGroupOffsetSum: MetricConfig{
 			Enabled: true,
 		},
+		KafkaConsumerGroupTimeLag: MetricConfig{
+			Enabled: false,
+		},
+		KafkaConsumerGroupTimeLagMax: MetricConfig{
+			Enabled: false,
+		},
 		KafkaPartitionCurrentOffset: MetricConfig{
 			Enabled: true,
 		},
@@ -34,6 +34,8 @@ func TestMetricsBuilderConfig(t *testing.T) {
 					KafkaConsumerGroupMembers:     MetricConfig{Enabled: true},
 					KafkaConsumerGroupOffset:      MetricConfig{Enabled: true},
 					KafkaConsumerGroupOffsetSum:   MetricConfig{Enabled: true},
+					KafkaConsumerGroupTimeLag:     MetricConfig{Enabled: true},
+					KafkaConsumerGroupTimeLagMax:  MetricConfig{Enabled: true},
 					KafkaPartitionCurrentOffset:   MetricConfig{Enabled: true},
 					KafkaPartitionOldestOffset:    MetricConfig{Enabled: true},
 					KafkaPartitionReplicas:        MetricConfig{Enabled: true},
@@ -60,6 +62,8 @@ func TestMetricsBuilderConfig(t *testing.T) {
 					KafkaConsumerGroupMembers:     MetricConfig{Enabled: false},
 					KafkaConsumerGroupOffset:      MetricConfig{Enabled: false},
 					KafkaConsumerGroupOffsetSum:   MetricConfig{Enabled: false},
+					KafkaConsumerGroupTimeLag:     MetricConfig{Enabled: false},
+					KafkaConsumerGroupTimeLagMax:  MetricConfig{Enabled: false},
 					KafkaPartitionCurrentOffset:   MetricConfig{Enabled: false},
 					KafkaPartitionOldestOffset:    MetricConfig{Enabled: false},
 					KafkaPartitionReplicas:        MetricConfig{Enabled: false},
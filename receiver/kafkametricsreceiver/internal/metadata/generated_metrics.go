@@ -34,6 +34,12 @@ var MetricsInfo = metricsInfo{
 	KafkaConsumerGroupOffsetSum: metricInfo{
 		Name: "kafka.consumer_group.offset_sum",
 	},
+	KafkaConsumerGroupTimeLag: metricInfo{
+		Name: "kafka.consumer_group.time_lag",
+	},
+	KafkaConsumerGroupTimeLagMax: metricInfo{
+		Name: "kafka.consumer_group.time_lag_max",
+	},
 	KafkaPartitionCurrentOffset: metricInfo{
 		Name: "kafka.partition.current_offset",
 	},
@@ -71,6 +77,8 @@ type metricsInfo struct {
 	KafkaConsumerGroupMembers     metricInfo
 	KafkaConsumerGroupOffset      metricInfo
 	KafkaConsumerGroupOffsetSum   metricInfo
+	KafkaConsumerGroupTimeLag     metricInfo
+	KafkaConsumerGroupTimeLagMax  metricInfo
 	KafkaPartitionCurrentOffset   metricInfo
 	KafkaPartitionOldestOffset    metricInfo
 	KafkaPartitionReplicas        metricInfo
@@ -451,6 +459,111 @@ func newMetricKafkaConsumerGroupOffsetSum(cfg MetricConfig) metricKafkaConsumerG
 	return m
 }
 
+type metricKafkaConsumerGroupTimeLag struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills kafka.consumer_group.time_lag metric with initial data.
+func (m *metricKafkaConsumerGroupTimeLag) init() {
+	m.data.SetName("kafka.consumer_group.time_lag")
+	m.data.SetDescription("Estimated time the consumer group is behind the latest message at partition of topic")
+	m.data.SetUnit("s")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricKafkaConsumerGroupTimeLag) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64, groupAttributeValue string, topicAttributeValue string, partitionAttributeValue int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetDoubleValue(val)
+	dp.Attributes().PutStr("group", groupAttributeValue)
+	dp.Attributes().PutStr("topic", topicAttributeValue)
+	dp.Attributes().PutInt("partition", partitionAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricKafkaConsumerGroupTimeLag) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricKafkaConsumerGroupTimeLag) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricKafkaConsumerGroupTimeLag(cfg MetricConfig) metricKafkaConsumerGroupTimeLag {
+	m := metricKafkaConsumerGroupTimeLag{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricKafkaConsumerGroupTimeLagMax struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills kafka.consumer_group.time_lag_max metric with initial data.
+func (m *metricKafkaConsumerGroupTimeLagMax) init() {
+	m.data.SetName("kafka.consumer_group.time_lag_max")
+	m.data.SetDescription("Estimated maximum time the consumer group is behind the latest message across all partitions of topic")
+	m.data.SetUnit("s")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricKafkaConsumerGroupTimeLagMax) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64, groupAttributeValue string, topicAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetDoubleValue(val)
+	dp.Attributes().PutStr("group", groupAttributeValue)
+	dp.Attributes().PutStr("topic", topicAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricKafkaConsumerGroupTimeLagMax) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricKafkaConsumerGroupTimeLagMax) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricKafkaConsumerGroupTimeLagMax(cfg MetricConfig) metricKafkaConsumerGroupTimeLagMax {
+	m := metricKafkaConsumerGroupTimeLagMax{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 type metricKafkaPartitionCurrentOffset struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
@@ -937,6 +1050,8 @@ type MetricsBuilder struct {
 	metricKafkaConsumerGroupMembers     metricKafkaConsumerGroupMembers
 	metricKafkaConsumerGroupOffset      metricKafkaConsumerGroupOffset
 	metricKafkaConsumerGroupOffsetSum   metricKafkaConsumerGroupOffsetSum
+	metricKafkaConsumerGroupTimeLag     metricKafkaConsumerGroupTimeLag
+	metricKafkaConsumerGroupTimeLagMax  metricKafkaConsumerGroupTimeLagMax
 	metricKafkaPartitionCurrentOffset   metricKafkaPartitionCurrentOffset
 	metricKafkaPartitionOldestOffset    metricKafkaPartitionOldestOffset
 	metricKafkaPartitionReplicas        metricKafkaPartitionReplicas
@@ -978,6 +1093,8 @@ func NewMetricsBuilder(mbc MetricsBuilderConfig, settings receiver.Settings, opt
 		metricKafkaConsumerGroupMembers:     newMetricKafkaConsumerGroupMembers(mbc.Metrics.KafkaConsumerGroupMembers),
 		metricKafkaConsumerGroupOffset:      newMetricKafkaConsumerGroupOffset(mbc.Metrics.KafkaConsumerGroupOffset),
 		metricKafkaConsumerGroupOffsetSum:   newMetricKafkaConsumerGroupOffsetSum(mbc.Metrics.KafkaConsumerGroupOffsetSum),
+		metricKafkaConsumerGroupTimeLag:     newMetricKafkaConsumerGroupTimeLag(mbc.Metrics.KafkaConsumerGroupTimeLag),
+		metricKafkaConsumerGroupTimeLagMax:  newMetricKafkaConsumerGroupTimeLagMax(mbc.Metrics.KafkaConsumerGroupTimeLagMax),
 		metricKafkaPartitionCurrentOffset:   newMetricKafkaPartitionCurrentOffset(mbc.Metrics.KafkaPartitionCurrentOffset),
 		metricKafkaPartitionOldestOffset:    newMetricKafkaPartitionOldestOffset(mbc.Metrics.KafkaPartitionOldestOffset),
 		metricKafkaPartitionReplicas:        newMetricKafkaPartitionReplicas(mbc.Metrics.KafkaPartitionReplicas),
@@ -1072,6 +1189,8 @@ func (mb *MetricsBuilder) EmitForResource(options ...ResourceMetricsOption) {
 	mb.metricKafkaConsumerGroupMembers.emit(ils.Metrics())
 	mb.metricKafkaConsumerGroupOffset.emit(ils.Metrics())
 	mb.metricKafkaConsumerGroupOffsetSum.emit(ils.Metrics())
+	mb.metricKafkaConsumerGroupTimeLag.emit(ils.Metrics())
+	mb.metricKafkaConsumerGroupTimeLagMax.emit(ils.Metrics())
 	mb.metricKafkaPartitionCurrentOffset.emit(ils.Metrics())
 	mb.metricKafkaPartitionOldestOffset.emit(ils.Metrics())
 	mb.metricKafkaPartitionReplicas.emit(ils.Metrics())
@@ -1147,6 +1266,16 @@ func (mb *MetricsBuilder) RecordKafkaConsumerGroupOffsetSumDataPoint(ts pcommon.
 	mb.metricKafkaConsumerGroupOffsetSum.recordDataPoint(mb.startTime, ts, val, groupAttributeValue, topicAttributeValue)
 }
 
+// RecordKafkaConsumerGroupTimeLagDataPoint adds a data point to kafka.consumer_group.time_lag metric.
+func (mb *MetricsBuilder) RecordKafkaConsumerGroupTimeLagDataPoint(ts pcommon.Timestamp, val float64, groupAttributeValue string, topicAttributeValue string, partitionAttributeValue int64) {
+	mb.metricKafkaConsumerGroupTimeLag.recordDataPoint(mb.startTime, ts, val, groupAttributeValue, topicAttributeValue, partitionAttributeValue)
+}
+
+// RecordKafkaConsumerGroupTimeLagMaxDataPoint adds a data point to kafka.consumer_group.time_lag_max metric.
+func (mb *MetricsBuilder) RecordKafkaConsumerGroupTimeLagMaxDataPoint(ts pcommon.Timestamp, val float64, groupAttributeValue string, topicAttributeValue string) {
+	mb.metricKafkaConsumerGroupTimeLagMax.recordDataPoint(mb.startTime, ts, val, groupAttributeValue, topicAttributeValue)
+}
+
 // RecordKafkaPartitionCurrentOffsetDataPoint adds a data point to kafka.partition.current_offset metric.
 func (mb *MetricsBuilder) RecordKafkaPartitionCurrentOffsetDataPoint(ts pcommon.Timestamp, val int64, topicAttributeValue string, partitionAttributeValue int64) {
 	mb.metricKafkaPartitionCurrentOffset.recordDataPoint(mb.startTime, ts, val, topicAttributeValue, partitionAttributeValue)
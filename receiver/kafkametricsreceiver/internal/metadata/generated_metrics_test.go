@@ -95,6 +95,12 @@ func TestMetricsBuilder(t *testing.T) {
 			allMetricsCount++
 			mb.RecordKafkaConsumerGroupOffsetSumDataPoint(ts, 1, "group-val", "topic-val")
 
+			allMetricsCount++
+			mb.RecordKafkaConsumerGroupTimeLagDataPoint(ts, 1, "group-val", "topic-val", 9)
+
+			allMetricsCount++
+			mb.RecordKafkaConsumerGroupTimeLagMaxDataPoint(ts, 1, "group-val", "topic-val")
+
 			defaultMetricsCount++
 			allMetricsCount++
 			mb.RecordKafkaPartitionCurrentOffsetDataPoint(ts, 1, "topic-val", 9)
@@ -275,6 +281,45 @@ func TestMetricsBuilder(t *testing.T) {
 					attrVal, ok = dp.Attributes().Get("topic")
 					assert.True(t, ok)
 					assert.Equal(t, "topic-val", attrVal.Str())
+				case "kafka.consumer_group.time_lag":
+					assert.False(t, validatedMetrics["kafka.consumer_group.time_lag"], "Found a duplicate in the metrics slice: kafka.consumer_group.time_lag")
+					validatedMetrics["kafka.consumer_group.time_lag"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Estimated time the consumer group is behind the latest message at partition of topic", ms.At(i).Description())
+					assert.Equal(t, "s", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeDouble, dp.ValueType())
+					assert.InDelta(t, float64(1), dp.DoubleValue(), 0.01)
+					attrVal, ok := dp.Attributes().Get("group")
+					assert.True(t, ok)
+					assert.Equal(t, "group-val", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("topic")
+					assert.True(t, ok)
+					assert.Equal(t, "topic-val", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("partition")
+					assert.True(t, ok)
+					assert.EqualValues(t, 9, attrVal.Int())
+				case "kafka.consumer_group.time_lag_max":
+					assert.False(t, validatedMetrics["kafka.consumer_group.time_lag_max"], "Found a duplicate in the metrics slice: kafka.consumer_group.time_lag_max")
+					validatedMetrics["kafka.consumer_group.time_lag_max"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Estimated maximum time the consumer group is behind the latest message across all partitions of topic", ms.At(i).Description())
+					assert.Equal(t, "s", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeDouble, dp.ValueType())
+					assert.InDelta(t, float64(1), dp.DoubleValue(), 0.01)
+					attrVal, ok := dp.Attributes().Get("group")
+					assert.True(t, ok)
+					assert.Equal(t, "group-val", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("topic")
+					assert.True(t, ok)
+					assert.Equal(t, "topic-val", attrVal.Str())
 				case "kafka.partition.current_offset":
 					assert.False(t, validatedMetrics["kafka.partition.current_offset"], "Found a duplicate in the metrics slice: kafka.partition.current_offset")
 					validatedMetrics["kafka.partition.current_offset"] = true
@@ -0,0 +1,96 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package kafkametricsreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/kafkametricsreceiver"
+
+import (
+	"time"
+)
+
+// maxOffsetSamples is the number of latest offset samples kept per partition, it
+// bounds both the memory used and the time lag that can be interpolated.
+const maxOffsetSamples = 64
+
+type topicPartition struct {
+	topic     string
+	partition int32
+}
+
+// offsetSample is the latest offset of a partition and the time it was first seen.
+type offsetSample struct {
+	offset int64
+	time   time.Time
+}
+
+// offsetHistory keeps the latest offsets of the partitions seen on the previous
+// scrapes, to resolve the committed offsets of the consumer groups to the time
+// the messages at these offsets were produced.
+type offsetHistory struct {
+	partitions map[topicPartition][]offsetSample
+}
+
+func newOffsetHistory() *offsetHistory {
+	return &offsetHistory{partitions: map[topicPartition][]offsetSample{}}
+}
+
+// add records the latest offset of a partition. Only offset increases are kept,
+// so the sample of an offset holds the earliest time it was seen.
+func (h *offsetHistory) add(topic string, partition int32, offset int64, now time.Time) {
+	key := topicPartition{topic: topic, partition: partition}
+	samples := h.partitions[key]
+	if n := len(samples); n > 0 && samples[n-1].offset >= offset {
+		// The partition was recreated when its offset went back.
+		if samples[n-1].offset == offset {
+			return
+		}
+		samples = samples[:0]
+	}
+	if len(samples) == maxOffsetSamples {
+		samples = append(samples[:0], samples[1:]...)
+	}
+	h.partitions[key] = append(samples, offsetSample{offset: offset, time: now})
+}
+
+// retain forgets the partitions which are not in the given set.
+func (h *offsetHistory) retain(partitions map[string][]int32) {
+	for key := range h.partitions {
+		found := false
+		for _, p := range partitions[key.topic] {
+			if p == key.partition {
+				found = true
+				break
+			}
+		}
+		if !found {
+			delete(h.partitions, key)
+		}
+	}
+}
+
+// timeLag estimates how long ago the message at the committed offset was the
+// latest one of the partition. The time is linearly interpolated between the
+// samples surrounding the committed offset. When the committed offset is older
+// than the oldest sample, the age of that sample is returned as a lower bound.
+// ok is false when the partition has no history.
+func (h *offsetHistory) timeLag(topic string, partition int32, committed int64, now time.Time) (time.Duration, bool) {
+	samples := h.partitions[topicPartition{topic: topic, partition: partition}]
+	if len(samples) == 0 {
+		return 0, false
+	}
+	if committed >= samples[len(samples)-1].offset {
+		return 0, true
+	}
+	if committed < samples[0].offset {
+		return now.Sub(samples[0].time), true
+	}
+	for i := len(samples) - 1; i > 0; i-- {
+		prev, next := samples[i-1], samples[i]
+		if committed < prev.offset {
+			continue
+		}
+		ratio := float64(committed-prev.offset) / float64(next.offset-prev.offset)
+		produced := prev.time.Add(time.Duration(ratio * float64(next.time.Sub(prev.time))))
+		return now.Sub(produced), true
+	}
+	return now.Sub(samples[0].time), true
+}
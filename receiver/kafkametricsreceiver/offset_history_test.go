@@ -0,0 +1,64 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package kafkametricsreceiver
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOffsetHistoryTimeLag(t *testing.T) {
+	start := time.Unix(1700000000, 0)
+	h := newOffsetHistory()
+
+	_, ok := h.timeLag("topic", 0, 10, start)
+	assert.False(t, ok)
+
+	h.add("topic", 0, 100, start)
+	// Unchanged offsets keep the time the offset was first seen.
+	h.add("topic", 0, 100, start.Add(30*time.Second))
+	h.add("topic", 0, 200, start.Add(time.Minute))
+	h.add("topic", 0, 400, start.Add(2*time.Minute))
+	now := start.Add(3 * time.Minute)
+
+	tests := []struct {
+		name      string
+		committed int64
+		expected  time.Duration
+	}{
+		{name: "caught up", committed: 400, expected: 0},
+		{name: "on a sample", committed: 200, expected: 2 * time.Minute},
+		{name: "interpolated", committed: 150, expected: 150 * time.Second},
+		{name: "interpolated in the latest interval", committed: 300, expected: 90 * time.Second},
+		{name: "older than the history", committed: 50, expected: 3 * time.Minute},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lag, ok := h.timeLag("topic", 0, tt.committed, now)
+			assert.True(t, ok)
+			assert.Equal(t, tt.expected, lag)
+		})
+	}
+}
+
+func TestOffsetHistoryBounds(t *testing.T) {
+	start := time.Unix(1700000000, 0)
+	h := newOffsetHistory()
+	for i := range maxOffsetSamples + 10 {
+		h.add("topic", 0, int64(i+1), start.Add(time.Duration(i)*time.Second))
+	}
+	samples := h.partitions[topicPartition{topic: "topic", partition: 0}]
+	assert.Len(t, samples, maxOffsetSamples)
+	assert.Equal(t, int64(11), samples[0].offset)
+
+	// An offset going back resets the history of the partition.
+	h.add("topic", 0, 5, start)
+	assert.Len(t, h.partitions[topicPartition{topic: "topic", partition: 0}], 1)
+
+	h.add("other", 1, 5, start)
+	h.retain(map[string][]int32{"topic": {0}})
+	assert.Len(t, h.partitions, 1)
+}
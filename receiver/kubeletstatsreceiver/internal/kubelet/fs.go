@@ -19,3 +19,19 @@ func addFilesystemMetrics(mb *metadata.MetricsBuilder, filesystemMetrics metadat
 	recordIntDataPoint(mb, filesystemMetrics.Capacity, s.CapacityBytes, currentTime)
 	recordIntDataPoint(mb, filesystemMetrics.Usage, s.UsedBytes, currentTime)
 }
+
+// addEphemeralStorageMetrics records the ephemeral storage used by a container,
+// which is accounted by the kubelet as the sum of its writable layer and logs.
+func addEphemeralStorageMetrics(mb *metadata.MetricsBuilder, recordDataPoint metadata.RecordIntDataPointFunc, rootfs, logs *stats.FsStats, currentTime pcommon.Timestamp) {
+	var used uint64
+	var found bool
+	for _, s := range []*stats.FsStats{rootfs, logs} {
+		if s != nil && s.UsedBytes != nil {
+			used += *s.UsedBytes
+			found = true
+		}
+	}
+	if found {
+		recordDataPoint(mb, currentTime, int64(used))
+	}
+}
@@ -12,6 +12,7 @@ import (
 	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/receiver/receivertest"
 	"go.uber.org/zap"
+	stats "k8s.io/kubelet/pkg/apis/stats/v1alpha1"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/kubeletstatsreceiver/internal/metadata"
 )
@@ -169,6 +170,57 @@ func TestUptime(t *testing.T) {
 	requireContains(t, metrics, "container.uptime")
 }
 
+func TestSwapAndEphemeralStorage(t *testing.T) {
+	rc := &fakeRestClient{}
+	statsProvider := NewStatsProvider(rc)
+	summary, _ := statsProvider.StatsSummary()
+	available, usage := uint64(1024), uint64(512)
+	swap := &stats.SwapStats{
+		SwapAvailableBytes: &available,
+		SwapUsageBytes:     &usage,
+	}
+	summary.Node.Swap = swap
+	for i := range summary.Pods {
+		summary.Pods[i].Swap = swap
+		for j := range summary.Pods[i].Containers {
+			summary.Pods[i].Containers[j].Swap = swap
+		}
+	}
+	mgs := map[MetricGroup]bool{
+		ContainerMetricGroup: true,
+		PodMetricGroup:       true,
+		NodeMetricGroup:      true,
+	}
+
+	cfg := metadata.DefaultMetricsBuilderConfig()
+	cfg.Metrics.K8sNodeSwapAvailable.Enabled = true
+	cfg.Metrics.K8sNodeSwapUsage.Enabled = true
+	cfg.Metrics.K8sPodSwapAvailable.Enabled = true
+	cfg.Metrics.K8sPodSwapUsage.Enabled = true
+	cfg.Metrics.ContainerSwapAvailable.Enabled = true
+	cfg.Metrics.ContainerSwapUsage.Enabled = true
+	cfg.Metrics.K8sContainerEphemeralStorageUsage.Enabled = true
+
+	mbs := &metadata.MetricsBuilders{
+		NodeMetricsBuilder:      metadata.NewMetricsBuilder(cfg, receivertest.NewNopSettings(metadata.Type)),
+		PodMetricsBuilder:       metadata.NewMetricsBuilder(cfg, receivertest.NewNopSettings(metadata.Type)),
+		ContainerMetricsBuilder: metadata.NewMetricsBuilder(cfg, receivertest.NewNopSettings(metadata.Type)),
+	}
+
+	metrics := indexedFakeMetrics(MetricsData(zap.NewNop(), summary, Metadata{}, mgs, map[MetricGroup]bool{}, mbs))
+
+	for _, name := range []string{"k8s.node.swap", "k8s.pod.swap", "container.swap"} {
+		requireContains(t, metrics, name+".available")
+		requireContains(t, metrics, name+".usage")
+		require.Equal(t, int64(1024), metrics[name+".available"][0].Gauge().DataPoints().At(0).IntValue())
+		require.Equal(t, int64(512), metrics[name+".usage"][0].Gauge().DataPoints().At(0).IntValue())
+	}
+
+	// The first container of the summary uses 12288 bytes in its rootfs and 36864 bytes of logs.
+	requireContains(t, metrics, "k8s.container.ephemeral_storage.usage")
+	require.Equal(t, int64(12288+36864), metrics["k8s.container.ephemeral_storage.usage"][0].Gauge().DataPoints().At(0).IntValue())
+}
+
 func TestEmitMetrics(t *testing.T) {
 	metrics := indexedFakeMetrics(fakeMetrics())
 	metricNames := []string{
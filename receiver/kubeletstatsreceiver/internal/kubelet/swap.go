@@ -0,0 +1,20 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package kubelet // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/kubeletstatsreceiver/internal/kubelet"
+
+import (
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	stats "k8s.io/kubelet/pkg/apis/stats/v1alpha1"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/kubeletstatsreceiver/internal/metadata"
+)
+
+func addSwapMetrics(mb *metadata.MetricsBuilder, swapMetrics metadata.SwapMetrics, s *stats.SwapStats, currentTime pcommon.Timestamp) {
+	if s == nil {
+		return
+	}
+
+	recordIntDataPoint(mb, swapMetrics.Available, s.SwapAvailableBytes, currentTime)
+	recordIntDataPoint(mb, swapMetrics.Usage, s.SwapUsageBytes, currentTime)
+}
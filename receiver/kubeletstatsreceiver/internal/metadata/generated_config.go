@@ -40,10 +40,13 @@ type MetricsConfig struct {
 	ContainerMemoryRss                   MetricConfig `mapstructure:"container.memory.rss"`
 	ContainerMemoryUsage                 MetricConfig `mapstructure:"container.memory.usage"`
 	ContainerMemoryWorkingSet            MetricConfig `mapstructure:"container.memory.working_set"`
+	ContainerSwapAvailable               MetricConfig `mapstructure:"container.swap.available"`
+	ContainerSwapUsage                   MetricConfig `mapstructure:"container.swap.usage"`
 	ContainerUptime                      MetricConfig `mapstructure:"container.uptime"`
 	K8sContainerCPUNodeUtilization       MetricConfig `mapstructure:"k8s.container.cpu.node.utilization"`
 	K8sContainerCPULimitUtilization      MetricConfig `mapstructure:"k8s.container.cpu_limit_utilization"`
 	K8sContainerCPURequestUtilization    MetricConfig `mapstructure:"k8s.container.cpu_request_utilization"`
+	K8sContainerEphemeralStorageUsage    MetricConfig `mapstructure:"k8s.container.ephemeral_storage.usage"`
 	K8sContainerMemoryNodeUtilization    MetricConfig `mapstructure:"k8s.container.memory.node.utilization"`
 	K8sContainerMemoryLimitUtilization   MetricConfig `mapstructure:"k8s.container.memory_limit_utilization"`
 	K8sContainerMemoryRequestUtilization MetricConfig `mapstructure:"k8s.container.memory_request_utilization"`
@@ -61,6 +64,8 @@ type MetricsConfig struct {
 	K8sNodeMemoryWorkingSet              MetricConfig `mapstructure:"k8s.node.memory.working_set"`
 	K8sNodeNetworkErrors                 MetricConfig `mapstructure:"k8s.node.network.errors"`
 	K8sNodeNetworkIo                     MetricConfig `mapstructure:"k8s.node.network.io"`
+	K8sNodeSwapAvailable                 MetricConfig `mapstructure:"k8s.node.swap.available"`
+	K8sNodeSwapUsage                     MetricConfig `mapstructure:"k8s.node.swap.usage"`
 	K8sNodeUptime                        MetricConfig `mapstructure:"k8s.node.uptime"`
 	K8sPodCPUNodeUtilization             MetricConfig `mapstructure:"k8s.pod.cpu.node.utilization"`
 	K8sPodCPUTime                        MetricConfig `mapstructure:"k8s.pod.cpu.time"`
@@ -82,6 +87,8 @@ type MetricsConfig struct {
 	K8sPodMemoryRequestUtilization       MetricConfig `mapstructure:"k8s.pod.memory_request_utilization"`
 	K8sPodNetworkErrors                  MetricConfig `mapstructure:"k8s.pod.network.errors"`
 	K8sPodNetworkIo                      MetricConfig `mapstructure:"k8s.pod.network.io"`
+	K8sPodSwapAvailable                  MetricConfig `mapstructure:"k8s.pod.swap.available"`
+	K8sPodSwapUsage                      MetricConfig `mapstructure:"k8s.pod.swap.usage"`
 	K8sPodUptime                         MetricConfig `mapstructure:"k8s.pod.uptime"`
 	K8sVolumeAvailable                   MetricConfig `mapstructure:"k8s.volume.available"`
 	K8sVolumeCapacity                    MetricConfig `mapstructure:"k8s.volume.capacity"`
@@ -128,6 +135,12 @@ func DefaultMetricsConfig() MetricsConfig {
 		ContainerMemoryWorkingSet: MetricConfig{
 			Enabled: true,
 		},
+		ContainerSwapAvailable: MetricConfig{
+			Enabled: false,
+		},
+		ContainerSwapUsage: MetricConfig{
+			Enabled: false,
+		},
 		ContainerUptime: MetricConfig{
 			Enabled: false,
 		},
@@ -140,6 +153,9 @@ func DefaultMetricsConfig() MetricsConfig {
 		K8sContainerCPURequestUtilization: MetricConfig{
 			Enabled: false,
 		},
+		K8sContainerEphemeralStorageUsage: MetricConfig{
+			Enabled: false,
+		},
 		K8sContainerMemoryNodeUtilization: MetricConfig{
 			Enabled: false,
 		},
@@ -191,6 +207,12 @@ func DefaultMetricsConfig() MetricsConfig {
 		K8sNodeNetworkIo: MetricConfig{
 			Enabled: true,
 		},
+		K8sNodeSwapAvailable: MetricConfig{
+			Enabled: false,
+		},
+		K8sNodeSwapUsage: MetricConfig{
+			Enabled: false,
+		},
 		K8sNodeUptime: MetricConfig{
 			Enabled: false,
 		},
@@ -254,6 +276,12 @@ func DefaultMetricsConfig() MetricsConfig {
 		K8sPodNetworkIo: MetricConfig{
 			Enabled: true,
 		},
+		K8sPodSwapAvailable: MetricConfig{
+			Enabled: false,
+		},
+		K8sPodSwapUsage: MetricConfig{
+			Enabled: false,
+		},
 		K8sPodUptime: MetricConfig{
 			Enabled: false,
 		},
@@ -39,10 +39,13 @@ func TestMetricsBuilderConfig(t *testing.T) {
 					ContainerMemoryRss:                   MetricConfig{Enabled: true},
 					ContainerMemoryUsage:                 MetricConfig{Enabled: true},
 					ContainerMemoryWorkingSet:            MetricConfig{Enabled: true},
+					ContainerSwapAvailable:               MetricConfig{Enabled: true},
+					ContainerSwapUsage:                   MetricConfig{Enabled: true},
 					ContainerUptime:                      MetricConfig{Enabled: true},
 					K8sContainerCPUNodeUtilization:       MetricConfig{Enabled: true},
 					K8sContainerCPULimitUtilization:      MetricConfig{Enabled: true},
 					K8sContainerCPURequestUtilization:    MetricConfig{Enabled: true},
+					K8sContainerEphemeralStorageUsage:    MetricConfig{Enabled: true},
 					K8sContainerMemoryNodeUtilization:    MetricConfig{Enabled: true},
 					K8sContainerMemoryLimitUtilization:   MetricConfig{Enabled: true},
 					K8sContainerMemoryRequestUtilization: MetricConfig{Enabled: true},
@@ -60,6 +63,8 @@ func TestMetricsBuilderConfig(t *testing.T) {
 					K8sNodeMemoryWorkingSet:              MetricConfig{Enabled: true},
 					K8sNodeNetworkErrors:                 MetricConfig{Enabled: true},
 					K8sNodeNetworkIo:                     MetricConfig{Enabled: true},
+					K8sNodeSwapAvailable:                 MetricConfig{Enabled: true},
+					K8sNodeSwapUsage:                     MetricConfig{Enabled: true},
 					K8sNodeUptime:                        MetricConfig{Enabled: true},
 					K8sPodCPUNodeUtilization:             MetricConfig{Enabled: true},
 					K8sPodCPUTime:                        MetricConfig{Enabled: true},
@@ -81,6 +86,8 @@ func TestMetricsBuilderConfig(t *testing.T) {
 					K8sPodMemoryRequestUtilization:       MetricConfig{Enabled: true},
 					K8sPodNetworkErrors:                  MetricConfig{Enabled: true},
 					K8sPodNetworkIo:                      MetricConfig{Enabled: true},
+					K8sPodSwapAvailable:                  MetricConfig{Enabled: true},
+					K8sPodSwapUsage:                      MetricConfig{Enabled: true},
 					K8sPodUptime:                         MetricConfig{Enabled: true},
 					K8sVolumeAvailable:                   MetricConfig{Enabled: true},
 					K8sVolumeCapacity:                    MetricConfig{Enabled: true},
@@ -123,10 +130,13 @@ func TestMetricsBuilderConfig(t *testing.T) {
 					ContainerMemoryRss:                   MetricConfig{Enabled: false},
 					ContainerMemoryUsage:                 MetricConfig{Enabled: false},
 					ContainerMemoryWorkingSet:            MetricConfig{Enabled: false},
+					ContainerSwapAvailable:               MetricConfig{Enabled: false},
+					ContainerSwapUsage:                   MetricConfig{Enabled: false},
 					ContainerUptime:                      MetricConfig{Enabled: false},
 					K8sContainerCPUNodeUtilization:       MetricConfig{Enabled: false},
 					K8sContainerCPULimitUtilization:      MetricConfig{Enabled: false},
 					K8sContainerCPURequestUtilization:    MetricConfig{Enabled: false},
+					K8sContainerEphemeralStorageUsage:    MetricConfig{Enabled: false},
 					K8sContainerMemoryNodeUtilization:    MetricConfig{Enabled: false},
 					K8sContainerMemoryLimitUtilization:   MetricConfig{Enabled: false},
 					K8sContainerMemoryRequestUtilization: MetricConfig{Enabled: false},
@@ -144,6 +154,8 @@ func TestMetricsBuilderConfig(t *testing.T) {
 					K8sNodeMemoryWorkingSet:              MetricConfig{Enabled: false},
 					K8sNodeNetworkErrors:                 MetricConfig{Enabled: false},
 					K8sNodeNetworkIo:                     MetricConfig{Enabled: false},
+					K8sNodeSwapAvailable:                 MetricConfig{Enabled: false},
+					K8sNodeSwapUsage:                     MetricConfig{Enabled: false},
 					K8sNodeUptime:                        MetricConfig{Enabled: false},
 					K8sPodCPUNodeUtilization:             MetricConfig{Enabled: false},
 					K8sPodCPUTime:                        MetricConfig{Enabled: false},
@@ -165,6 +177,8 @@ func TestMetricsBuilderConfig(t *testing.T) {
 					K8sPodMemoryRequestUtilization:       MetricConfig{Enabled: false},
 					K8sPodNetworkErrors:                  MetricConfig{Enabled: false},
 					K8sPodNetworkIo:                      MetricConfig{Enabled: false},
+					K8sPodSwapAvailable:                  MetricConfig{Enabled: false},
+					K8sPodSwapUsage:                      MetricConfig{Enabled: false},
 					K8sPodUptime:                         MetricConfig{Enabled: false},
 					K8sVolumeAvailable:                   MetricConfig{Enabled: false},
 					K8sVolumeCapacity:                    MetricConfig{Enabled: false},
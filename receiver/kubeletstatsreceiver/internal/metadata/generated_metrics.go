@@ -75,6 +75,12 @@ var MetricsInfo = metricsInfo{
 	ContainerMemoryWorkingSet: metricInfo{
 		Name: "container.memory.working_set",
 	},
+	ContainerSwapAvailable: metricInfo{
+		Name: "container.swap.available",
+	},
+	ContainerSwapUsage: metricInfo{
+		Name: "container.swap.usage",
+	},
 	ContainerUptime: metricInfo{
 		Name: "container.uptime",
 	},
@@ -87,6 +93,9 @@ var MetricsInfo = metricsInfo{
 	K8sContainerCPURequestUtilization: metricInfo{
 		Name: "k8s.container.cpu_request_utilization",
 	},
+	K8sContainerEphemeralStorageUsage: metricInfo{
+		Name: "k8s.container.ephemeral_storage.usage",
+	},
 	K8sContainerMemoryNodeUtilization: metricInfo{
 		Name: "k8s.container.memory.node.utilization",
 	},
@@ -138,6 +147,12 @@ var MetricsInfo = metricsInfo{
 	K8sNodeNetworkIo: metricInfo{
 		Name: "k8s.node.network.io",
 	},
+	K8sNodeSwapAvailable: metricInfo{
+		Name: "k8s.node.swap.available",
+	},
+	K8sNodeSwapUsage: metricInfo{
+		Name: "k8s.node.swap.usage",
+	},
 	K8sNodeUptime: metricInfo{
 		Name: "k8s.node.uptime",
 	},
@@ -201,6 +216,12 @@ var MetricsInfo = metricsInfo{
 	K8sPodNetworkIo: metricInfo{
 		Name: "k8s.pod.network.io",
 	},
+	K8sPodSwapAvailable: metricInfo{
+		Name: "k8s.pod.swap.available",
+	},
+	K8sPodSwapUsage: metricInfo{
+		Name: "k8s.pod.swap.usage",
+	},
 	K8sPodUptime: metricInfo{
 		Name: "k8s.pod.uptime",
 	},
@@ -234,10 +255,13 @@ type metricsInfo struct {
 	ContainerMemoryRss                   metricInfo
 	ContainerMemoryUsage                 metricInfo
 	ContainerMemoryWorkingSet            metricInfo
+	ContainerSwapAvailable               metricInfo
+	ContainerSwapUsage                   metricInfo
 	ContainerUptime                      metricInfo
 	K8sContainerCPUNodeUtilization       metricInfo
 	K8sContainerCPULimitUtilization      metricInfo
 	K8sContainerCPURequestUtilization    metricInfo
+	K8sContainerEphemeralStorageUsage    metricInfo
 	K8sContainerMemoryNodeUtilization    metricInfo
 	K8sContainerMemoryLimitUtilization   metricInfo
 	K8sContainerMemoryRequestUtilization metricInfo
@@ -255,6 +279,8 @@ type metricsInfo struct {
 	K8sNodeMemoryWorkingSet              metricInfo
 	K8sNodeNetworkErrors                 metricInfo
 	K8sNodeNetworkIo                     metricInfo
+	K8sNodeSwapAvailable                 metricInfo
+	K8sNodeSwapUsage                     metricInfo
 	K8sNodeUptime                        metricInfo
 	K8sPodCPUNodeUtilization             metricInfo
 	K8sPodCPUTime                        metricInfo
@@ -276,6 +302,8 @@ type metricsInfo struct {
 	K8sPodMemoryRequestUtilization       metricInfo
 	K8sPodNetworkErrors                  metricInfo
 	K8sPodNetworkIo                      metricInfo
+	K8sPodSwapAvailable                  metricInfo
+	K8sPodSwapUsage                      metricInfo
 	K8sPodUptime                         metricInfo
 	K8sVolumeAvailable                   metricInfo
 	K8sVolumeCapacity                    metricInfo
@@ -878,6 +906,104 @@ func newMetricContainerMemoryWorkingSet(cfg MetricConfig) metricContainerMemoryW
 	return m
 }
 
+type metricContainerSwapAvailable struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills container.swap.available metric with initial data.
+func (m *metricContainerSwapAvailable) init() {
+	m.data.SetName("container.swap.available")
+	m.data.SetDescription("Container swap available")
+	m.data.SetUnit("By")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricContainerSwapAvailable) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricContainerSwapAvailable) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricContainerSwapAvailable) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricContainerSwapAvailable(cfg MetricConfig) metricContainerSwapAvailable {
+	m := metricContainerSwapAvailable{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricContainerSwapUsage struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills container.swap.usage metric with initial data.
+func (m *metricContainerSwapUsage) init() {
+	m.data.SetName("container.swap.usage")
+	m.data.SetDescription("Container swap usage")
+	m.data.SetUnit("By")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricContainerSwapUsage) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricContainerSwapUsage) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricContainerSwapUsage) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricContainerSwapUsage(cfg MetricConfig) metricContainerSwapUsage {
+	m := metricContainerSwapUsage{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 type metricContainerUptime struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
@@ -1076,6 +1202,55 @@ func newMetricK8sContainerCPURequestUtilization(cfg MetricConfig) metricK8sConta
 	return m
 }
 
+type metricK8sContainerEphemeralStorageUsage struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills k8s.container.ephemeral_storage.usage metric with initial data.
+func (m *metricK8sContainerEphemeralStorageUsage) init() {
+	m.data.SetName("k8s.container.ephemeral_storage.usage")
+	m.data.SetDescription("Container ephemeral storage usage, the sum of the bytes used by the container writable layer and logs")
+	m.data.SetUnit("By")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricK8sContainerEphemeralStorageUsage) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricK8sContainerEphemeralStorageUsage) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricK8sContainerEphemeralStorageUsage) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricK8sContainerEphemeralStorageUsage(cfg MetricConfig) metricK8sContainerEphemeralStorageUsage {
+	m := metricK8sContainerEphemeralStorageUsage{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 type metricK8sContainerMemoryNodeUtilization struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
@@ -1921,6 +2096,104 @@ func newMetricK8sNodeNetworkIo(cfg MetricConfig) metricK8sNodeNetworkIo {
 	return m
 }
 
+type metricK8sNodeSwapAvailable struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills k8s.node.swap.available metric with initial data.
+func (m *metricK8sNodeSwapAvailable) init() {
+	m.data.SetName("k8s.node.swap.available")
+	m.data.SetDescription("Node swap available")
+	m.data.SetUnit("By")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricK8sNodeSwapAvailable) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricK8sNodeSwapAvailable) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricK8sNodeSwapAvailable) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricK8sNodeSwapAvailable(cfg MetricConfig) metricK8sNodeSwapAvailable {
+	m := metricK8sNodeSwapAvailable{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricK8sNodeSwapUsage struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills k8s.node.swap.usage metric with initial data.
+func (m *metricK8sNodeSwapUsage) init() {
+	m.data.SetName("k8s.node.swap.usage")
+	m.data.SetDescription("Node swap usage")
+	m.data.SetUnit("By")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricK8sNodeSwapUsage) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricK8sNodeSwapUsage) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricK8sNodeSwapUsage) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricK8sNodeSwapUsage(cfg MetricConfig) metricK8sNodeSwapUsage {
+	m := metricK8sNodeSwapUsage{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 type metricK8sNodeUptime struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
@@ -2964,6 +3237,104 @@ func newMetricK8sPodNetworkIo(cfg MetricConfig) metricK8sPodNetworkIo {
 	return m
 }
 
+type metricK8sPodSwapAvailable struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills k8s.pod.swap.available metric with initial data.
+func (m *metricK8sPodSwapAvailable) init() {
+	m.data.SetName("k8s.pod.swap.available")
+	m.data.SetDescription("Pod swap available")
+	m.data.SetUnit("By")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricK8sPodSwapAvailable) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricK8sPodSwapAvailable) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricK8sPodSwapAvailable) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricK8sPodSwapAvailable(cfg MetricConfig) metricK8sPodSwapAvailable {
+	m := metricK8sPodSwapAvailable{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricK8sPodSwapUsage struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills k8s.pod.swap.usage metric with initial data.
+func (m *metricK8sPodSwapUsage) init() {
+	m.data.SetName("k8s.pod.swap.usage")
+	m.data.SetDescription("Pod swap usage")
+	m.data.SetUnit("By")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricK8sPodSwapUsage) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricK8sPodSwapUsage) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricK8sPodSwapUsage) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricK8sPodSwapUsage(cfg MetricConfig) metricK8sPodSwapUsage {
+	m := metricK8sPodSwapUsage{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 type metricK8sPodUptime struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
@@ -3282,10 +3653,13 @@ type MetricsBuilder struct {
 	metricContainerMemoryRss                   metricContainerMemoryRss
 	metricContainerMemoryUsage                 metricContainerMemoryUsage
 	metricContainerMemoryWorkingSet            metricContainerMemoryWorkingSet
+	metricContainerSwapAvailable               metricContainerSwapAvailable
+	metricContainerSwapUsage                   metricContainerSwapUsage
 	metricContainerUptime                      metricContainerUptime
 	metricK8sContainerCPUNodeUtilization       metricK8sContainerCPUNodeUtilization
 	metricK8sContainerCPULimitUtilization      metricK8sContainerCPULimitUtilization
 	metricK8sContainerCPURequestUtilization    metricK8sContainerCPURequestUtilization
+	metricK8sContainerEphemeralStorageUsage    metricK8sContainerEphemeralStorageUsage
 	metricK8sContainerMemoryNodeUtilization    metricK8sContainerMemoryNodeUtilization
 	metricK8sContainerMemoryLimitUtilization   metricK8sContainerMemoryLimitUtilization
 	metricK8sContainerMemoryRequestUtilization metricK8sContainerMemoryRequestUtilization
@@ -3303,6 +3677,8 @@ type MetricsBuilder struct {
 	metricK8sNodeMemoryWorkingSet              metricK8sNodeMemoryWorkingSet
 	metricK8sNodeNetworkErrors                 metricK8sNodeNetworkErrors
 	metricK8sNodeNetworkIo                     metricK8sNodeNetworkIo
+	metricK8sNodeSwapAvailable                 metricK8sNodeSwapAvailable
+	metricK8sNodeSwapUsage                     metricK8sNodeSwapUsage
 	metricK8sNodeUptime                        metricK8sNodeUptime
 	metricK8sPodCPUNodeUtilization             metricK8sPodCPUNodeUtilization
 	metricK8sPodCPUTime                        metricK8sPodCPUTime
@@ -3324,6 +3700,8 @@ type MetricsBuilder struct {
 	metricK8sPodMemoryRequestUtilization       metricK8sPodMemoryRequestUtilization
 	metricK8sPodNetworkErrors                  metricK8sPodNetworkErrors
 	metricK8sPodNetworkIo                      metricK8sPodNetworkIo
+	metricK8sPodSwapAvailable                  metricK8sPodSwapAvailable
+	metricK8sPodSwapUsage                      metricK8sPodSwapUsage
 	metricK8sPodUptime                         metricK8sPodUptime
 	metricK8sVolumeAvailable                   metricK8sVolumeAvailable
 	metricK8sVolumeCapacity                    metricK8sVolumeCapacity
@@ -3367,10 +3745,13 @@ func NewMetricsBuilder(mbc MetricsBuilderConfig, settings receiver.Settings, opt
 		metricContainerMemoryRss:                   newMetricContainerMemoryRss(mbc.Metrics.ContainerMemoryRss),
 		metricContainerMemoryUsage:                 newMetricContainerMemoryUsage(mbc.Metrics.ContainerMemoryUsage),
 		metricContainerMemoryWorkingSet:            newMetricContainerMemoryWorkingSet(mbc.Metrics.ContainerMemoryWorkingSet),
+		metricContainerSwapAvailable:               newMetricContainerSwapAvailable(mbc.Metrics.ContainerSwapAvailable),
+		metricContainerSwapUsage:                   newMetricContainerSwapUsage(mbc.Metrics.ContainerSwapUsage),
 		metricContainerUptime:                      newMetricContainerUptime(mbc.Metrics.ContainerUptime),
 		metricK8sContainerCPUNodeUtilization:       newMetricK8sContainerCPUNodeUtilization(mbc.Metrics.K8sContainerCPUNodeUtilization),
 		metricK8sContainerCPULimitUtilization:      newMetricK8sContainerCPULimitUtilization(mbc.Metrics.K8sContainerCPULimitUtilization),
 		metricK8sContainerCPURequestUtilization:    newMetricK8sContainerCPURequestUtilization(mbc.Metrics.K8sContainerCPURequestUtilization),
+		metricK8sContainerEphemeralStorageUsage:    newMetricK8sContainerEphemeralStorageUsage(mbc.Metrics.K8sContainerEphemeralStorageUsage),
 		metricK8sContainerMemoryNodeUtilization:    newMetricK8sContainerMemoryNodeUtilization(mbc.Metrics.K8sContainerMemoryNodeUtilization),
 		metricK8sContainerMemoryLimitUtilization:   newMetricK8sContainerMemoryLimitUtilization(mbc.Metrics.K8sContainerMemoryLimitUtilization),
 		metricK8sContainerMemoryRequestUtilization: newMetricK8sContainerMemoryRequestUtilization(mbc.Metrics.K8sContainerMemoryRequestUtilization),
@@ -3388,6 +3769,8 @@ func NewMetricsBuilder(mbc MetricsBuilderConfig, settings receiver.Settings, opt
 		metricK8sNodeMemoryWorkingSet:              newMetricK8sNodeMemoryWorkingSet(mbc.Metrics.K8sNodeMemoryWorkingSet),
 		metricK8sNodeNetworkErrors:                 newMetricK8sNodeNetworkErrors(mbc.Metrics.K8sNodeNetworkErrors),
 		metricK8sNodeNetworkIo:                     newMetricK8sNodeNetworkIo(mbc.Metrics.K8sNodeNetworkIo),
+		metricK8sNodeSwapAvailable:                 newMetricK8sNodeSwapAvailable(mbc.Metrics.K8sNodeSwapAvailable),
+		metricK8sNodeSwapUsage:                     newMetricK8sNodeSwapUsage(mbc.Metrics.K8sNodeSwapUsage),
 		metricK8sNodeUptime:                        newMetricK8sNodeUptime(mbc.Metrics.K8sNodeUptime),
 		metricK8sPodCPUNodeUtilization:             newMetricK8sPodCPUNodeUtilization(mbc.Metrics.K8sPodCPUNodeUtilization),
 		metricK8sPodCPUTime:                        newMetricK8sPodCPUTime(mbc.Metrics.K8sPodCPUTime),
@@ -3409,6 +3792,8 @@ func NewMetricsBuilder(mbc MetricsBuilderConfig, settings receiver.Settings, opt
 		metricK8sPodMemoryRequestUtilization:       newMetricK8sPodMemoryRequestUtilization(mbc.Metrics.K8sPodMemoryRequestUtilization),
 		metricK8sPodNetworkErrors:                  newMetricK8sPodNetworkErrors(mbc.Metrics.K8sPodNetworkErrors),
 		metricK8sPodNetworkIo:                      newMetricK8sPodNetworkIo(mbc.Metrics.K8sPodNetworkIo),
+		metricK8sPodSwapAvailable:                  newMetricK8sPodSwapAvailable(mbc.Metrics.K8sPodSwapAvailable),
+		metricK8sPodSwapUsage:                      newMetricK8sPodSwapUsage(mbc.Metrics.K8sPodSwapUsage),
 		metricK8sPodUptime:                         newMetricK8sPodUptime(mbc.Metrics.K8sPodUptime),
 		metricK8sVolumeAvailable:                   newMetricK8sVolumeAvailable(mbc.Metrics.K8sVolumeAvailable),
 		metricK8sVolumeCapacity:                    newMetricK8sVolumeCapacity(mbc.Metrics.K8sVolumeCapacity),
@@ -3589,10 +3974,13 @@ func (mb *MetricsBuilder) EmitForResource(options ...ResourceMetricsOption) {
 	mb.metricContainerMemoryRss.emit(ils.Metrics())
 	mb.metricContainerMemoryUsage.emit(ils.Metrics())
 	mb.metricContainerMemoryWorkingSet.emit(ils.Metrics())
+	mb.metricContainerSwapAvailable.emit(ils.Metrics())
+	mb.metricContainerSwapUsage.emit(ils.Metrics())
 	mb.metricContainerUptime.emit(ils.Metrics())
 	mb.metricK8sContainerCPUNodeUtilization.emit(ils.Metrics())
 	mb.metricK8sContainerCPULimitUtilization.emit(ils.Metrics())
 	mb.metricK8sContainerCPURequestUtilization.emit(ils.Metrics())
+	mb.metricK8sContainerEphemeralStorageUsage.emit(ils.Metrics())
 	mb.metricK8sContainerMemoryNodeUtilization.emit(ils.Metrics())
 	mb.metricK8sContainerMemoryLimitUtilization.emit(ils.Metrics())
 	mb.metricK8sContainerMemoryRequestUtilization.emit(ils.Metrics())
@@ -3610,6 +3998,8 @@ func (mb *MetricsBuilder) EmitForResource(options ...ResourceMetricsOption) {
 	mb.metricK8sNodeMemoryWorkingSet.emit(ils.Metrics())
 	mb.metricK8sNodeNetworkErrors.emit(ils.Metrics())
 	mb.metricK8sNodeNetworkIo.emit(ils.Metrics())
+	mb.metricK8sNodeSwapAvailable.emit(ils.Metrics())
+	mb.metricK8sNodeSwapUsage.emit(ils.Metrics())
 	mb.metricK8sNodeUptime.emit(ils.Metrics())
 	mb.metricK8sPodCPUNodeUtilization.emit(ils.Metrics())
 	mb.metricK8sPodCPUTime.emit(ils.Metrics())
@@ -3631,6 +4021,8 @@ func (mb *MetricsBuilder) EmitForResource(options ...ResourceMetricsOption) {
 	mb.metricK8sPodMemoryRequestUtilization.emit(ils.Metrics())
 	mb.metricK8sPodNetworkErrors.emit(ils.Metrics())
 	mb.metricK8sPodNetworkIo.emit(ils.Metrics())
+	mb.metricK8sPodSwapAvailable.emit(ils.Metrics())
+	mb.metricK8sPodSwapUsage.emit(ils.Metrics())
 	mb.metricK8sPodUptime.emit(ils.Metrics())
 	mb.metricK8sVolumeAvailable.emit(ils.Metrics())
 	mb.metricK8sVolumeCapacity.emit(ils.Metrics())
@@ -3728,6 +4120,16 @@ func (mb *MetricsBuilder) RecordContainerMemoryWorkingSetDataPoint(ts pcommon.Ti
 	mb.metricContainerMemoryWorkingSet.recordDataPoint(mb.startTime, ts, val)
 }
 
+// RecordContainerSwapAvailableDataPoint adds a data point to container.swap.available metric.
+func (mb *MetricsBuilder) RecordContainerSwapAvailableDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricContainerSwapAvailable.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordContainerSwapUsageDataPoint adds a data point to container.swap.usage metric.
+func (mb *MetricsBuilder) RecordContainerSwapUsageDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricContainerSwapUsage.recordDataPoint(mb.startTime, ts, val)
+}
+
 // RecordContainerUptimeDataPoint adds a data point to container.uptime metric.
 func (mb *MetricsBuilder) RecordContainerUptimeDataPoint(ts pcommon.Timestamp, val int64) {
 	mb.metricContainerUptime.recordDataPoint(mb.startTime, ts, val)
@@ -3748,6 +4150,11 @@ func (mb *MetricsBuilder) RecordK8sContainerCPURequestUtilizationDataPoint(ts pc
 	mb.metricK8sContainerCPURequestUtilization.recordDataPoint(mb.startTime, ts, val)
 }
 
+// RecordK8sContainerEphemeralStorageUsageDataPoint adds a data point to k8s.container.ephemeral_storage.usage metric.
+func (mb *MetricsBuilder) RecordK8sContainerEphemeralStorageUsageDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricK8sContainerEphemeralStorageUsage.recordDataPoint(mb.startTime, ts, val)
+}
+
 // RecordK8sContainerMemoryNodeUtilizationDataPoint adds a data point to k8s.container.memory.node.utilization metric.
 func (mb *MetricsBuilder) RecordK8sContainerMemoryNodeUtilizationDataPoint(ts pcommon.Timestamp, val float64) {
 	mb.metricK8sContainerMemoryNodeUtilization.recordDataPoint(mb.startTime, ts, val)
@@ -3833,6 +4240,16 @@ func (mb *MetricsBuilder) RecordK8sNodeNetworkIoDataPoint(ts pcommon.Timestamp,
 	mb.metricK8sNodeNetworkIo.recordDataPoint(mb.startTime, ts, val, interfaceAttributeValue, directionAttributeValue.String())
 }
 
+// RecordK8sNodeSwapAvailableDataPoint adds a data point to k8s.node.swap.available metric.
+func (mb *MetricsBuilder) RecordK8sNodeSwapAvailableDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricK8sNodeSwapAvailable.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordK8sNodeSwapUsageDataPoint adds a data point to k8s.node.swap.usage metric.
+func (mb *MetricsBuilder) RecordK8sNodeSwapUsageDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricK8sNodeSwapUsage.recordDataPoint(mb.startTime, ts, val)
+}
+
 // RecordK8sNodeUptimeDataPoint adds a data point to k8s.node.uptime metric.
 func (mb *MetricsBuilder) RecordK8sNodeUptimeDataPoint(ts pcommon.Timestamp, val int64) {
 	mb.metricK8sNodeUptime.recordDataPoint(mb.startTime, ts, val)
@@ -3938,6 +4355,16 @@ func (mb *MetricsBuilder) RecordK8sPodNetworkIoDataPoint(ts pcommon.Timestamp, v
 	mb.metricK8sPodNetworkIo.recordDataPoint(mb.startTime, ts, val, interfaceAttributeValue, directionAttributeValue.String())
 }
 
+// RecordK8sPodSwapAvailableDataPoint adds a data point to k8s.pod.swap.available metric.
+func (mb *MetricsBuilder) RecordK8sPodSwapAvailableDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricK8sPodSwapAvailable.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordK8sPodSwapUsageDataPoint adds a data point to k8s.pod.swap.usage metric.
+func (mb *MetricsBuilder) RecordK8sPodSwapUsageDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricK8sPodSwapUsage.recordDataPoint(mb.startTime, ts, val)
+}
+
 // RecordK8sPodUptimeDataPoint adds a data point to k8s.pod.uptime metric.
 func (mb *MetricsBuilder) RecordK8sPodUptimeDataPoint(ts pcommon.Timestamp, val int64) {
 	mb.metricK8sPodUptime.recordDataPoint(mb.startTime, ts, val)
@@ -120,6 +120,26 @@ var ContainerFilesystemMetrics = FilesystemMetrics{
 	Usage:     (*MetricsBuilder).RecordContainerFilesystemUsageDataPoint,
 }
 
+type SwapMetrics struct {
+	Available RecordIntDataPointFunc
+	Usage     RecordIntDataPointFunc
+}
+
+var NodeSwapMetrics = SwapMetrics{
+	Available: (*MetricsBuilder).RecordK8sNodeSwapAvailableDataPoint,
+	Usage:     (*MetricsBuilder).RecordK8sNodeSwapUsageDataPoint,
+}
+
+var PodSwapMetrics = SwapMetrics{
+	Available: (*MetricsBuilder).RecordK8sPodSwapAvailableDataPoint,
+	Usage:     (*MetricsBuilder).RecordK8sPodSwapUsageDataPoint,
+}
+
+var ContainerSwapMetrics = SwapMetrics{
+	Available: (*MetricsBuilder).RecordContainerSwapAvailableDataPoint,
+	Usage:     (*MetricsBuilder).RecordContainerSwapUsageDataPoint,
+}
+
 type NetworkMetrics struct {
 	IO     RecordIntDataPointWithDirectionFunc
 	Errors RecordIntDataPointWithDirectionFunc
@@ -7,6 +7,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/hashicorp/go-version"
 	"go.mongodb.org/mongo-driver/v2/bson"
@@ -25,6 +26,8 @@ type client interface {
 	DBStats(ctx context.Context, DBName string) (bson.M, error)
 	TopStats(ctx context.Context) (bson.M, error)
 	IndexStats(ctx context.Context, DBName, collectionName string) ([]bson.M, error)
+	CollectionStats(ctx context.Context, DBName, collectionName string) (bson.M, error)
+	CurrentOp(ctx context.Context, minRunning time.Duration) ([]bson.M, error)
 	RunCommand(ctx context.Context, db string, command bson.M) (bson.M, error)
 }
 
@@ -104,6 +107,53 @@ func (c *mongodbClient) IndexStats(ctx context.Context, database, collectionName
 	return indexStats, nil
 }
 
+// CollectionStats returns the storage stats of a collection
+// more information can be found here: https://www.mongodb.com/docs/manual/reference/operator/aggregation/collStats/
+func (c *mongodbClient) CollectionStats(ctx context.Context, database, collectionName string) (bson.M, error) {
+	collection := c.Database(database).Collection(collectionName)
+	cursor, err := collection.Aggregate(ctx, mongo.Pipeline{
+		bson.D{bson.E{Key: "$collStats", Value: bson.M{"storageStats": bson.M{}}}},
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var collStats []bson.M
+	if err = cursor.All(ctx, &collStats); err != nil {
+		return nil, err
+	}
+	if len(collStats) == 0 {
+		return nil, fmt.Errorf("no collection stats returned for %s.%s", database, collectionName)
+	}
+	// Sharded collections return a document per shard, the first one is kept.
+	return collStats[0], nil
+}
+
+// CurrentOp returns the active operations running for at least minRunning, the
+// longest running first
+// more information can be found here: https://www.mongodb.com/docs/manual/reference/operator/aggregation/currentOp/
+func (c *mongodbClient) CurrentOp(ctx context.Context, minRunning time.Duration) ([]bson.M, error) {
+	cursor, err := c.Database("admin").Aggregate(ctx, mongo.Pipeline{
+		bson.D{bson.E{Key: "$currentOp", Value: bson.M{"allUsers": true, "idleConnections": false}}},
+		bson.D{bson.E{Key: "$match", Value: bson.M{
+			"active":            true,
+			"microsecs_running": bson.M{"$gte": minRunning.Microseconds()},
+		}}},
+		bson.D{bson.E{Key: "$sort", Value: bson.M{"microsecs_running": -1}}},
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var ops []bson.M
+	if err = cursor.All(ctx, &ops); err != nil {
+		return nil, err
+	}
+	return ops, nil
+}
+
 // GetVersion returns a result of the version of mongo the client is connected to so adjustments in collection protocol can
 // be determined
 func (c *mongodbClient) GetVersion(ctx context.Context) (*version.Version, error) {
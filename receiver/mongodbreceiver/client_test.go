@@ -8,6 +8,7 @@ import (
 	"errors"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/go-version"
 	"github.com/stretchr/testify/mock"
@@ -69,6 +70,16 @@ func (fc *fakeClient) IndexStats(ctx context.Context, dbName, collectionName str
 	return args.Get(0).([]bson.M), args.Error(1)
 }
 
+func (fc *fakeClient) CollectionStats(ctx context.Context, dbName, collectionName string) (bson.M, error) {
+	args := fc.Called(ctx, dbName, collectionName)
+	return args.Get(0).(bson.M), args.Error(1)
+}
+
+func (fc *fakeClient) CurrentOp(ctx context.Context, minRunning time.Duration) ([]bson.M, error) {
+	args := fc.Called(ctx, minRunning)
+	return args.Get(0).([]bson.M), args.Error(1)
+}
+
 func (fc *fakeClient) RunCommand(ctx context.Context, db string, command bson.M) (bson.M, error) {
 	args := fc.Called(ctx, db, command)
 	if args.Get(0) == nil {
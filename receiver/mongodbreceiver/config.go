@@ -7,6 +7,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"path"
 	"strings"
 	"time"
 
@@ -33,6 +34,32 @@ type Config struct {
 	ReplicaSet       string                    `mapstructure:"replica_set,omitempty"`
 	Timeout          time.Duration             `mapstructure:"timeout"`
 	DirectConnection bool                      `mapstructure:"direct_connection"`
+	// Namespaces restricts the collections whose collection statistics and slow
+	// operations are collected.
+	Namespaces NamespaceFilter `mapstructure:"namespaces"`
+	// CurrentOp configures the slow operations sampled from $currentOp and
+	// reported as logs.
+	CurrentOp CurrentOpConfig `mapstructure:"current_op"`
+}
+
+// NamespaceFilter filters namespaces, in the "<database>.<collection>" form, with
+// shell patterns as supported by path.Match. A namespace is collected when it
+// matches one of the include patterns, or when no include pattern is set, and
+// matches none of the exclude patterns.
+type NamespaceFilter struct {
+	Include []string `mapstructure:"include"`
+	Exclude []string `mapstructure:"exclude"`
+}
+
+// CurrentOpConfig configures the collection of the slow operations.
+type CurrentOpConfig struct {
+	// Enabled turns on the reporting of the slow operations as logs.
+	Enabled bool `mapstructure:"enabled"`
+	// SlowThreshold is the minimum running time of a reported operation.
+	SlowThreshold time.Duration `mapstructure:"slow_threshold"`
+	// MaxOperations is the maximum number of operations reported per scrape, the
+	// longest running operations are kept.
+	MaxOperations int `mapstructure:"max_operations"`
 }
 
 func (c *Config) Validate() error {
@@ -53,6 +80,21 @@ func (c *Config) Validate() error {
 		err = multierr.Append(err, errors.New("password provided without user"))
 	}
 
+	for _, pattern := range append(append([]string{}, c.Namespaces.Include...), c.Namespaces.Exclude...) {
+		if _, matchErr := path.Match(pattern, ""); matchErr != nil {
+			err = multierr.Append(err, fmt.Errorf("invalid namespace pattern %q: %w", pattern, matchErr))
+		}
+	}
+
+	if c.CurrentOp.Enabled {
+		if c.CurrentOp.SlowThreshold < 0 {
+			err = multierr.Append(err, errors.New("current_op::slow_threshold must not be negative"))
+		}
+		if c.CurrentOp.MaxOperations <= 0 {
+			err = multierr.Append(err, errors.New("current_op::max_operations must be positive"))
+		}
+	}
+
 	if _, tlsErr := c.LoadTLSConfig(context.Background()); tlsErr != nil {
 		err = multierr.Append(err, fmt.Errorf("error loading tls configuration: %w", tlsErr))
 	}
@@ -119,3 +161,24 @@ func (c *Config) hostlist() []string {
 	}
 	return hosts
 }
+
+// matches returns whether the namespace of the collection passes the filter.
+func (f NamespaceFilter) matches(database, collection string) bool {
+	namespace := database + "." + collection
+	included := len(f.Include) == 0
+	for _, pattern := range f.Include {
+		if ok, _ := path.Match(pattern, namespace); ok {
+			included = true
+			break
+		}
+	}
+	if !included {
+		return false
+	}
+	for _, pattern := range f.Exclude {
+		if ok, _ := path.Match(pattern, namespace); ok {
+			return false
+		}
+	}
+	return true
+}
@@ -101,6 +101,67 @@ func TestValidate(t *testing.T) {
 	}
 }
 
+func TestValidateNamespacesAndCurrentOp(t *testing.T) {
+	testCases := []struct {
+		desc       string
+		namespaces NamespaceFilter
+		currentOp  CurrentOpConfig
+		expected   string
+	}{
+		{
+			desc:       "valid",
+			namespaces: NamespaceFilter{Include: []string{"shop.*"}, Exclude: []string{"shop.tmp_*"}},
+			currentOp:  CurrentOpConfig{Enabled: true, SlowThreshold: time.Second, MaxOperations: 10},
+		},
+		{
+			desc:       "invalid pattern",
+			namespaces: NamespaceFilter{Exclude: []string{"shop.[a"}},
+			expected:   `invalid namespace pattern "shop.[a"`,
+		},
+		{
+			desc:      "negative slow threshold",
+			currentOp: CurrentOpConfig{Enabled: true, SlowThreshold: -time.Second, MaxOperations: 10},
+			expected:  "current_op::slow_threshold must not be negative",
+		},
+		{
+			desc:      "no max operations",
+			currentOp: CurrentOpConfig{Enabled: true},
+			expected:  "current_op::max_operations must be positive",
+		},
+		{
+			desc:      "disabled current op is not validated",
+			currentOp: CurrentOpConfig{MaxOperations: -1},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			cfg := &Config{
+				Hosts:            []confignet.TCPAddrConfig{{Endpoint: defaultEndpoint}},
+				ControllerConfig: scraperhelper.NewDefaultControllerConfig(),
+				Namespaces:       tc.namespaces,
+				CurrentOp:        tc.currentOp,
+			}
+			err := xconfmap.Validate(cfg)
+			if tc.expected == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.expected)
+			}
+		})
+	}
+}
+
+func TestNamespaceFilter(t *testing.T) {
+	f := NamespaceFilter{}
+	require.True(t, f.matches("shop", "orders"))
+
+	f = NamespaceFilter{Include: []string{"shop.*", "admin.users"}, Exclude: []string{"shop.tmp_*"}}
+	require.True(t, f.matches("shop", "orders"))
+	require.True(t, f.matches("admin", "users"))
+	require.False(t, f.matches("shop", "tmp_import"))
+	require.False(t, f.matches("admin", "system.roles"))
+}
+
 func TestBadTLSConfigs(t *testing.T) {
 	testCases := []struct {
 		desc        string
@@ -216,6 +277,14 @@ func TestLoadConfig(t *testing.T) {
 	expected.Username = "otel"
 	expected.Password = "${env:MONGO_PASSWORD}"
 	expected.CollectionInterval = time.Minute
+	expected.Namespaces = NamespaceFilter{
+		Exclude: []string{"admin.*", "config.*", "local.*"},
+	}
+	expected.CurrentOp = CurrentOpConfig{
+		Enabled:       true,
+		SlowThreshold: 500 * time.Millisecond,
+		MaxOperations: 20,
+	}
 
 	require.Equal(t, expected, cfg)
 }
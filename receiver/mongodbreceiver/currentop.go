@@ -0,0 +1,131 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mongodbreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/mongodbreceiver"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/mongodbreceiver/internal/metadata"
+)
+
+// slowOperationEventName is the event name of the log records of the slow operations.
+const slowOperationEventName = "db.server.slow_operation"
+
+// scrapeLogs reports the slow operations sampled from $currentOp. Only the
+// longest running operations, up to current_op::max_operations, of the
+// namespaces passing the namespace filter are reported.
+func (s *mongodbScraper) scrapeLogs(ctx context.Context) (plog.Logs, error) {
+	logs := plog.NewLogs()
+	if !s.config.CurrentOp.Enabled {
+		return logs, nil
+	}
+	if s.client == nil {
+		return logs, errors.New("no client was initialized before calling scrape")
+	}
+
+	serverStatus, err := s.client.ServerStatus(ctx, "admin")
+	if err != nil {
+		return logs, fmt.Errorf("failed to fetch server status: %w", err)
+	}
+	serverAddress, serverPort, err := serverAddressAndPort(serverStatus)
+	if err != nil {
+		return logs, fmt.Errorf("failed to fetch server address and port: %w", err)
+	}
+
+	ops, err := s.client.CurrentOp(ctx, s.config.CurrentOp.SlowThreshold)
+	if err != nil {
+		return logs, fmt.Errorf("failed to fetch current operations: %w", err)
+	}
+
+	rl := logs.ResourceLogs().AppendEmpty()
+	rb := metadata.NewResourceBuilder(s.config.ResourceAttributes)
+	rb.SetServerAddress(serverAddress)
+	rb.SetServerPort(serverPort)
+	rb.Emit().MoveTo(rl.Resource())
+	sl := rl.ScopeLogs().AppendEmpty()
+	sl.Scope().SetName(metadata.ScopeName)
+
+	now := time.Now()
+	for _, op := range ops {
+		if sl.LogRecords().Len() >= s.config.CurrentOp.MaxOperations {
+			break
+		}
+		namespace, _ := op["ns"].(string)
+		database, collection, _ := strings.Cut(namespace, ".")
+		if database == "" || !s.config.Namespaces.matches(database, collection) {
+			continue
+		}
+		s.appendSlowOperation(sl.LogRecords().AppendEmpty(), op, database, collection, now)
+	}
+
+	if sl.LogRecords().Len() == 0 {
+		return plog.NewLogs(), nil
+	}
+	return logs, nil
+}
+
+// appendSlowOperation fills lr with the details of a $currentOp operation.
+func (s *mongodbScraper) appendSlowOperation(lr plog.LogRecord, op bson.M, database, collection string, observed time.Time) {
+	lr.SetEventName(slowOperationEventName)
+	lr.SetObservedTimestamp(pcommon.NewTimestampFromTime(observed))
+	lr.SetTimestamp(pcommon.NewTimestampFromTime(observed))
+	if opTime, ok := op["currentOpTime"].(string); ok {
+		if ts, err := time.Parse(time.RFC3339, opTime); err == nil {
+			lr.SetTimestamp(pcommon.NewTimestampFromTime(ts))
+		}
+	}
+
+	if command, ok := op["command"]; ok {
+		body, err := bson.MarshalExtJSON(command, false, false)
+		if err != nil {
+			s.logger.Debug("failed to marshal the command of a slow operation", zap.Error(err))
+		} else {
+			lr.Body().SetStr(string(body))
+		}
+	}
+
+	attrs := lr.Attributes()
+	attrs.PutStr("db.system.name", "mongodb")
+	attrs.PutStr("db.namespace", database)
+	if collection != "" {
+		attrs.PutStr("db.collection.name", collection)
+	}
+	if opType, ok := op["op"].(string); ok {
+		attrs.PutStr("db.operation.name", opType)
+	}
+	// The operation id is a string on mongos and a number on mongod.
+	if opID, ok := op["opid"]; ok {
+		attrs.PutStr("mongodb.operation.id", fmt.Sprint(opID))
+	}
+	if running, err := parseInt(op["microsecs_running"]); err == nil {
+		attrs.PutDouble("mongodb.operation.duration", time.Duration(running*int64(time.Microsecond)).Seconds())
+	}
+	if planSummary, ok := op["planSummary"].(string); ok {
+		attrs.PutStr("mongodb.operation.plan_summary", planSummary)
+	}
+	if waitingForLock, ok := op["waitingForLock"].(bool); ok {
+		attrs.PutBool("mongodb.operation.waiting_for_lock", waitingForLock)
+	}
+	if appName, ok := op["appName"].(string); ok {
+		attrs.PutStr("mongodb.application.name", appName)
+	}
+	if client, ok := op["client"].(string); ok {
+		if host, port, err := net.SplitHostPort(client); err == nil {
+			attrs.PutStr("client.address", host)
+			attrs.PutStr("client.port", port)
+		} else {
+			attrs.PutStr("client.address", client)
+		}
+	}
+}
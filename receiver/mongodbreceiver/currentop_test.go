@@ -0,0 +1,164 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mongodbreceiver
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+	"go.opentelemetry.io/collector/scraper/scrapererror"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/mongodbreceiver/internal/metadata"
+)
+
+func TestScrapeLogsCurrentOp(t *testing.T) {
+	cfg := NewFactory().CreateDefaultConfig().(*Config)
+	cfg.CurrentOp.Enabled = true
+	cfg.CurrentOp.MaxOperations = 2
+	cfg.Namespaces.Exclude = []string{"shop.tmp_*"}
+
+	fc := &fakeClient{}
+	fc.On("ServerStatus", mock.Anything, "admin").Return(bson.M{"host": "mongo-0:27018"}, nil)
+	fc.On("CurrentOp", mock.Anything, 100*time.Millisecond).Return([]bson.M{
+		{
+			"opid":              int32(42),
+			"op":                "query",
+			"ns":                "shop.orders",
+			"microsecs_running": int64(2500000),
+			"currentOpTime":     "2024-05-01T10:00:00.000+00:00",
+			"planSummary":       "COLLSCAN",
+			"waitingForLock":    false,
+			"appName":           "checkout",
+			"client":            "10.0.0.5:51234",
+			"command":           bson.M{"find": "orders", "filter": bson.M{"status": "pending"}},
+		},
+		{
+			"opid":              int32(43),
+			"op":                "insert",
+			"ns":                "shop.tmp_import",
+			"microsecs_running": int64(2000000),
+		},
+		{
+			"opid":              int32(44),
+			"op":                "command",
+			"ns":                "",
+			"microsecs_running": int64(1500000),
+		},
+		{
+			"opid":              "shard01:45",
+			"op":                "update",
+			"ns":                "shop.carts",
+			"microsecs_running": int64(1000000),
+		},
+		{
+			"opid":              int32(46),
+			"op":                "remove",
+			"ns":                "shop.sessions",
+			"microsecs_running": int64(500000),
+		},
+	}, nil)
+
+	s := newMongodbScraper(receivertest.NewNopSettings(metadata.Type), cfg)
+	s.client = fc
+
+	logs, err := s.scrapeLogs(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 2, logs.LogRecordCount())
+
+	rl := logs.ResourceLogs().At(0)
+	address, _ := rl.Resource().Attributes().Get("server.address")
+	assert.Equal(t, "mongo-0", address.Str())
+
+	lr := rl.ScopeLogs().At(0).LogRecords().At(0)
+	assert.Equal(t, slowOperationEventName, lr.EventName())
+	assert.Equal(t, time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC), lr.Timestamp().AsTime())
+	assert.JSONEq(t, `{"find": "orders", "filter": {"status": "pending"}}`, lr.Body().Str())
+	assert.Equal(t, map[string]any{
+		"db.system.name":                     "mongodb",
+		"db.namespace":                       "shop",
+		"db.collection.name":                 "orders",
+		"db.operation.name":                  "query",
+		"mongodb.operation.id":               "42",
+		"mongodb.operation.duration":         2.5,
+		"mongodb.operation.plan_summary":     "COLLSCAN",
+		"mongodb.operation.waiting_for_lock": false,
+		"mongodb.application.name":           "checkout",
+		"client.address":                     "10.0.0.5",
+		"client.port":                        "51234",
+	}, lr.Attributes().AsRaw())
+
+	// The excluded namespace and the operation without namespace are skipped.
+	lr = rl.ScopeLogs().At(0).LogRecords().At(1)
+	opID, _ := lr.Attributes().Get("mongodb.operation.id")
+	assert.Equal(t, "shard01:45", opID.Str())
+}
+
+func TestScrapeLogsCurrentOpDisabled(t *testing.T) {
+	cfg := NewFactory().CreateDefaultConfig().(*Config)
+
+	fc := &fakeClient{}
+	s := newMongodbScraper(receivertest.NewNopSettings(metadata.Type), cfg)
+	s.client = fc
+
+	logs, err := s.scrapeLogs(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 0, logs.LogRecordCount())
+	fc.AssertNotCalled(t, "CurrentOp", mock.Anything, mock.Anything)
+}
+
+func TestCollectCollectionStats(t *testing.T) {
+	cfg := NewFactory().CreateDefaultConfig().(*Config)
+	cfg.Metrics.MongodbCollectionDocumentCount.Enabled = true
+	cfg.Metrics.MongodbCollectionSize.Enabled = true
+	cfg.Metrics.MongodbCollectionStorageSize.Enabled = true
+	cfg.Metrics.MongodbCollectionIndexCount.Enabled = true
+	cfg.Metrics.MongodbCollectionIndexSize.Enabled = true
+	cfg.Namespaces.Include = []string{"shop.*"}
+
+	fc := &fakeClient{}
+	fc.On("CollectionStats", mock.Anything, "shop", "orders").Return(bson.M{
+		"storageStats": bson.M{
+			"count":          int32(10),
+			"size":           int64(2048),
+			"storageSize":    int64(4096),
+			"nindexes":       int32(2),
+			"totalIndexSize": int64(8192),
+		},
+	}, nil)
+
+	s := newMongodbScraper(receivertest.NewNopSettings(metadata.Type), cfg)
+	s.client = fc
+
+	now := pcommon.NewTimestampFromTime(time.Now())
+	errs := &scrapererror.ScrapeErrors{}
+	s.collectCollectionStats(context.Background(), now, "shop", "orders", errs)
+	// Collections outside of the included namespaces are not queried.
+	s.collectCollectionStats(context.Background(), now, "admin", "system.users", errs)
+	require.NoError(t, errs.Combine())
+	fc.AssertNumberOfCalls(t, "CollectionStats", 1)
+
+	expected := map[string]int64{
+		"mongodb.collection.document.count": 10,
+		"mongodb.collection.size":           2048,
+		"mongodb.collection.storage.size":   4096,
+		"mongodb.collection.index.count":    2,
+		"mongodb.collection.index.size":     8192,
+	}
+	metrics := s.mb.Emit().ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	require.Equal(t, len(expected), metrics.Len())
+	for i := 0; i < metrics.Len(); i++ {
+		m := metrics.At(i)
+		dp := m.Sum().DataPoints().At(0)
+		assert.Equal(t, expected[m.Name()], dp.IntValue(), m.Name())
+		collection, _ := dp.Attributes().Get("collection")
+		assert.Equal(t, "orders", collection.Str())
+	}
+}
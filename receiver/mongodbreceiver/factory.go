@@ -19,7 +19,11 @@ import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/mongodbreceiver/internal/metadata"
 )
 
-const defaultMongoDBPort = 27017
+const (
+	defaultMongoDBPort   = 27017
+	defaultSlowThreshold = 100 * time.Millisecond
+	defaultMaxOperations = 100
+)
 
 var defaultEndpoint = "localhost:" + strconv.Itoa(defaultMongoDBPort)
 
@@ -28,7 +32,8 @@ func NewFactory() receiver.Factory {
 	return receiver.NewFactory(
 		metadata.Type,
 		createDefaultConfig,
-		receiver.WithMetrics(createMetricsReceiver, metadata.MetricsStability))
+		receiver.WithMetrics(createMetricsReceiver, metadata.MetricsStability),
+		receiver.WithLogs(createLogsReceiver, metadata.LogsStability))
 }
 
 func createDefaultConfig() component.Config {
@@ -42,6 +47,10 @@ func createDefaultConfig() component.Config {
 		},
 		MetricsBuilderConfig: metadata.DefaultMetricsBuilderConfig(),
 		ClientConfig:         configtls.ClientConfig{},
+		CurrentOp: CurrentOpConfig{
+			SlowThreshold: defaultSlowThreshold,
+			MaxOperations: defaultMaxOperations,
+		},
 	}
 }
 
@@ -67,3 +76,30 @@ func createMetricsReceiver(
 		scraperhelper.AddScraper(metadata.Type, s),
 	)
 }
+
+func createLogsReceiver(
+	_ context.Context,
+	params receiver.Settings,
+	rConf component.Config,
+	consumer consumer.Logs,
+) (receiver.Logs, error) {
+	cfg := rConf.(*Config)
+	ms := newMongodbScraper(params, cfg)
+
+	s, err := scraper.NewLogs(
+		ms.scrapeLogs,
+		scraper.WithStart(ms.start),
+		scraper.WithShutdown(ms.shutdown))
+	if err != nil {
+		return nil, err
+	}
+
+	return scraperhelper.NewLogsController(
+		&cfg.ControllerConfig, params, consumer,
+		scraperhelper.AddFactoryWithConfig(
+			scraper.NewFactory(metadata.Type, nil,
+				scraper.WithLogs(func(context.Context, scraper.Settings, component.Config) (scraper.Logs, error) {
+					return s, nil
+				}, metadata.LogsStability)), nil),
+	)
+}
@@ -28,53 +28,58 @@ func (ms *MetricConfig) Unmarshal(parser *confmap.Conf) error {
 
 // MetricsConfig provides config for mongodb metrics.
 type MetricsConfig struct {
-	MongodbActiveReads            MetricConfig `mapstructure:"mongodb.active.reads"`
-	MongodbActiveWrites           MetricConfig `mapstructure:"mongodb.active.writes"`
-	MongodbCacheOperations        MetricConfig `mapstructure:"mongodb.cache.operations"`
-	MongodbCollectionCount        MetricConfig `mapstructure:"mongodb.collection.count"`
-	MongodbCommandsRate           MetricConfig `mapstructure:"mongodb.commands.rate"`
-	MongodbConnectionCount        MetricConfig `mapstructure:"mongodb.connection.count"`
-	MongodbCursorCount            MetricConfig `mapstructure:"mongodb.cursor.count"`
-	MongodbCursorTimeoutCount     MetricConfig `mapstructure:"mongodb.cursor.timeout.count"`
-	MongodbDataSize               MetricConfig `mapstructure:"mongodb.data.size"`
-	MongodbDatabaseCount          MetricConfig `mapstructure:"mongodb.database.count"`
-	MongodbDeletesRate            MetricConfig `mapstructure:"mongodb.deletes.rate"`
-	MongodbDocumentOperationCount MetricConfig `mapstructure:"mongodb.document.operation.count"`
-	MongodbExtentCount            MetricConfig `mapstructure:"mongodb.extent.count"`
-	MongodbFlushesRate            MetricConfig `mapstructure:"mongodb.flushes.rate"`
-	MongodbGetmoresRate           MetricConfig `mapstructure:"mongodb.getmores.rate"`
-	MongodbGlobalLockTime         MetricConfig `mapstructure:"mongodb.global_lock.time"`
-	MongodbHealth                 MetricConfig `mapstructure:"mongodb.health"`
-	MongodbIndexAccessCount       MetricConfig `mapstructure:"mongodb.index.access.count"`
-	MongodbIndexCount             MetricConfig `mapstructure:"mongodb.index.count"`
-	MongodbIndexSize              MetricConfig `mapstructure:"mongodb.index.size"`
-	MongodbInsertsRate            MetricConfig `mapstructure:"mongodb.inserts.rate"`
-	MongodbLockAcquireCount       MetricConfig `mapstructure:"mongodb.lock.acquire.count"`
-	MongodbLockAcquireTime        MetricConfig `mapstructure:"mongodb.lock.acquire.time"`
-	MongodbLockAcquireWaitCount   MetricConfig `mapstructure:"mongodb.lock.acquire.wait_count"`
-	MongodbLockDeadlockCount      MetricConfig `mapstructure:"mongodb.lock.deadlock.count"`
-	MongodbMemoryUsage            MetricConfig `mapstructure:"mongodb.memory.usage"`
-	MongodbNetworkIoReceive       MetricConfig `mapstructure:"mongodb.network.io.receive"`
-	MongodbNetworkIoTransmit      MetricConfig `mapstructure:"mongodb.network.io.transmit"`
-	MongodbNetworkRequestCount    MetricConfig `mapstructure:"mongodb.network.request.count"`
-	MongodbObjectCount            MetricConfig `mapstructure:"mongodb.object.count"`
-	MongodbOperationCount         MetricConfig `mapstructure:"mongodb.operation.count"`
-	MongodbOperationLatencyTime   MetricConfig `mapstructure:"mongodb.operation.latency.time"`
-	MongodbOperationReplCount     MetricConfig `mapstructure:"mongodb.operation.repl.count"`
-	MongodbOperationTime          MetricConfig `mapstructure:"mongodb.operation.time"`
-	MongodbPageFaults             MetricConfig `mapstructure:"mongodb.page_faults"`
-	MongodbQueriesRate            MetricConfig `mapstructure:"mongodb.queries.rate"`
-	MongodbReplCommandsPerSec     MetricConfig `mapstructure:"mongodb.repl_commands_per_sec"`
-	MongodbReplDeletesPerSec      MetricConfig `mapstructure:"mongodb.repl_deletes_per_sec"`
-	MongodbReplGetmoresPerSec     MetricConfig `mapstructure:"mongodb.repl_getmores_per_sec"`
-	MongodbReplInsertsPerSec      MetricConfig `mapstructure:"mongodb.repl_inserts_per_sec"`
-	MongodbReplQueriesPerSec      MetricConfig `mapstructure:"mongodb.repl_queries_per_sec"`
-	MongodbReplUpdatesPerSec      MetricConfig `mapstructure:"mongodb.repl_updates_per_sec"`
-	MongodbSessionCount           MetricConfig `mapstructure:"mongodb.session.count"`
-	MongodbStorageSize            MetricConfig `mapstructure:"mongodb.storage.size"`
-	MongodbUpdatesRate            MetricConfig `mapstructure:"mongodb.updates.rate"`
-	MongodbUptime                 MetricConfig `mapstructure:"mongodb.uptime"`
-	MongodbWtcacheBytesRead       MetricConfig `mapstructure:"mongodb.wtcache.bytes.read"`
+	MongodbActiveReads             MetricConfig `mapstructure:"mongodb.active.reads"`
+	MongodbActiveWrites            MetricConfig `mapstructure:"mongodb.active.writes"`
+	MongodbCacheOperations         MetricConfig `mapstructure:"mongodb.cache.operations"`
+	MongodbCollectionCount         MetricConfig `mapstructure:"mongodb.collection.count"`
+	MongodbCollectionDocumentCount MetricConfig `mapstructure:"mongodb.collection.document.count"`
+	MongodbCollectionIndexCount    MetricConfig `mapstructure:"mongodb.collection.index.count"`
+	MongodbCollectionIndexSize     MetricConfig `mapstructure:"mongodb.collection.index.size"`
+	MongodbCollectionSize          MetricConfig `mapstructure:"mongodb.collection.size"`
+	MongodbCollectionStorageSize   MetricConfig `mapstructure:"mongodb.collection.storage.size"`
+	MongodbCommandsRate            MetricConfig `mapstructure:"mongodb.commands.rate"`
+	MongodbConnectionCount         MetricConfig `mapstructure:"mongodb.connection.count"`
+	MongodbCursorCount             MetricConfig `mapstructure:"mongodb.cursor.count"`
+	MongodbCursorTimeoutCount      MetricConfig `mapstructure:"mongodb.cursor.timeout.count"`
+	MongodbDataSize                MetricConfig `mapstructure:"mongodb.data.size"`
+	MongodbDatabaseCount           MetricConfig `mapstructure:"mongodb.database.count"`
+	MongodbDeletesRate             MetricConfig `mapstructure:"mongodb.deletes.rate"`
+	MongodbDocumentOperationCount  MetricConfig `mapstructure:"mongodb.document.operation.count"`
+	MongodbExtentCount             MetricConfig `mapstructure:"mongodb.extent.count"`
+	MongodbFlushesRate             MetricConfig `mapstructure:"mongodb.flushes.rate"`
+	MongodbGetmoresRate            MetricConfig `mapstructure:"mongodb.getmores.rate"`
+	MongodbGlobalLockTime          MetricConfig `mapstructure:"mongodb.global_lock.time"`
+	MongodbHealth                  MetricConfig `mapstructure:"mongodb.health"`
+	MongodbIndexAccessCount        MetricConfig `mapstructure:"mongodb.index.access.count"`
+	MongodbIndexCount              MetricConfig `mapstructure:"mongodb.index.count"`
+	MongodbIndexSize               MetricConfig `mapstructure:"mongodb.index.size"`
+	MongodbInsertsRate             MetricConfig `mapstructure:"mongodb.inserts.rate"`
+	MongodbLockAcquireCount        MetricConfig `mapstructure:"mongodb.lock.acquire.count"`
+	MongodbLockAcquireTime         MetricConfig `mapstructure:"mongodb.lock.acquire.time"`
+	MongodbLockAcquireWaitCount    MetricConfig `mapstructure:"mongodb.lock.acquire.wait_count"`
+	MongodbLockDeadlockCount       MetricConfig `mapstructure:"mongodb.lock.deadlock.count"`
+	MongodbMemoryUsage             MetricConfig `mapstructure:"mongodb.memory.usage"`
+	MongodbNetworkIoReceive        MetricConfig `mapstructure:"mongodb.network.io.receive"`
+	MongodbNetworkIoTransmit       MetricConfig `mapstructure:"mongodb.network.io.transmit"`
+	MongodbNetworkRequestCount     MetricConfig `mapstructure:"mongodb.network.request.count"`
+	MongodbObjectCount             MetricConfig `mapstructure:"mongodb.object.count"`
+	MongodbOperationCount          MetricConfig `mapstructure:"mongodb.operation.count"`
+	MongodbOperationLatencyTime    MetricConfig `mapstructure:"mongodb.operation.latency.time"`
+	MongodbOperationReplCount      MetricConfig `mapstructure:"mongodb.operation.repl.count"`
+	MongodbOperationTime           MetricConfig `mapstructure:"mongodb.operation.time"`
+	MongodbPageFaults              MetricConfig `mapstructure:"mongodb.page_faults"`
+	MongodbQueriesRate             MetricConfig `mapstructure:"mongodb.queries.rate"`
+	MongodbReplCommandsPerSec      MetricConfig `mapstructure:"mongodb.repl_commands_per_sec"`
+	MongodbReplDeletesPerSec       MetricConfig `mapstructure:"mongodb.repl_deletes_per_sec"`
+	MongodbReplGetmoresPerSec      MetricConfig `mapstructure:"mongodb.repl_getmores_per_sec"`
+	MongodbReplInsertsPerSec       MetricConfig `mapstructure:"mongodb.repl_inserts_per_sec"`
+	MongodbReplQueriesPerSec       MetricConfig `mapstructure:"mongodb.repl_queries_per_sec"`
+	MongodbReplUpdatesPerSec       MetricConfig `mapstructure:"mongodb.repl_updates_per_sec"`
+	MongodbSessionCount            MetricConfig `mapstructure:"mongodb.session.count"`
+	MongodbStorageSize             MetricConfig `mapstructure:"mongodb.storage.size"`
+	MongodbUpdatesRate             MetricConfig `mapstructure:"mongodb.updates.rate"`
+	MongodbUptime                  MetricConfig `mapstructure:"mongodb.uptime"`
+	MongodbWtcacheBytesRead        MetricConfig `mapstructure:"mongodb.wtcache.bytes.read"`
 }
 
 func DefaultMetricsConfig() MetricsConfig {
@@ -91,6 +96,21 @@ func DefaultMetricsConfig() MetricsConfig {
 		MongodbCollectionCount: MetricConfig{
 			Enabled: true,
 		},
+		MongodbCollectionDocumentCount: MetricConfig{
+			Enabled: false,
+		},
+		MongodbCollectionIndexCount: MetricConfig{
+			Enabled: false,
+		},
+		MongodbCollectionIndexSize: MetricConfig{
+			Enabled: false,
+		},
+		MongodbCollectionSize: MetricConfig{
+			Enabled: false,
+		},
+		MongodbCollectionStorageSize: MetricConfig{
+			Enabled: false,
+		},
 		MongodbCommandsRate: MetricConfig{
 			Enabled: false,
 		},
@@ -27,53 +27,58 @@ func TestMetricsBuilderConfig(t *testing.T) {
 			name: "all_set",
 			want: MetricsBuilderConfig{
 				Metrics: MetricsConfig{
-					MongodbActiveReads:            MetricConfig{Enabled: true},
-					MongodbActiveWrites:           MetricConfig{Enabled: true},
-					MongodbCacheOperations:        MetricConfig{Enabled: true},
-					MongodbCollectionCount:        MetricConfig{Enabled: true},
-					MongodbCommandsRate:           MetricConfig{Enabled: true},
-					MongodbConnectionCount:        MetricConfig{Enabled: true},
-					MongodbCursorCount:            MetricConfig{Enabled: true},
-					MongodbCursorTimeoutCount:     MetricConfig{Enabled: true},
-					MongodbDataSize:               MetricConfig{Enabled: true},
-					MongodbDatabaseCount:          MetricConfig{Enabled: true},
-					MongodbDeletesRate:            MetricConfig{Enabled: true},
-					MongodbDocumentOperationCount: MetricConfig{Enabled: true},
-					MongodbExtentCount:            MetricConfig{Enabled: true},
-					MongodbFlushesRate:            MetricConfig{Enabled: true},
-					MongodbGetmoresRate:           MetricConfig{Enabled: true},
-					MongodbGlobalLockTime:         MetricConfig{Enabled: true},
-					MongodbHealth:                 MetricConfig{Enabled: true},
-					MongodbIndexAccessCount:       MetricConfig{Enabled: true},
-					MongodbIndexCount:             MetricConfig{Enabled: true},
-					MongodbIndexSize:              MetricConfig{Enabled: true},
-					MongodbInsertsRate:            MetricConfig{Enabled: true},
-					MongodbLockAcquireCount:       MetricConfig{Enabled: true},
-					MongodbLockAcquireTime:        MetricConfig{Enabled: true},
-					MongodbLockAcquireWaitCount:   MetricConfig{Enabled: true},
-					MongodbLockDeadlockCount:      MetricConfig{Enabled: true},
-					MongodbMemoryUsage:            MetricConfig{Enabled: true},
-					MongodbNetworkIoReceive:       MetricConfig{Enabled: true},
-					MongodbNetworkIoTransmit:      MetricConfig{Enabled: true},
-					MongodbNetworkRequestCount:    MetricConfig{Enabled: true},
-					MongodbObjectCount:            MetricConfig{Enabled: true},
-					MongodbOperationCount:         MetricConfig{Enabled: true},
-					MongodbOperationLatencyTime:   MetricConfig{Enabled: true},
-					MongodbOperationReplCount:     MetricConfig{Enabled: true},
-					MongodbOperationTime:          MetricConfig{Enabled: true},
-					MongodbPageFaults:             MetricConfig{Enabled: true},
-					MongodbQueriesRate:            MetricConfig{Enabled: true},
-					MongodbReplCommandsPerSec:     MetricConfig{Enabled: true},
-					MongodbReplDeletesPerSec:      MetricConfig{Enabled: true},
-					MongodbReplGetmoresPerSec:     MetricConfig{Enabled: true},
-					MongodbReplInsertsPerSec:      MetricConfig{Enabled: true},
-					MongodbReplQueriesPerSec:      MetricConfig{Enabled: true},
-					MongodbReplUpdatesPerSec:      MetricConfig{Enabled: true},
-					MongodbSessionCount:           MetricConfig{Enabled: true},
-					MongodbStorageSize:            MetricConfig{Enabled: true},
-					MongodbUpdatesRate:            MetricConfig{Enabled: true},
-					MongodbUptime:                 MetricConfig{Enabled: true},
-					MongodbWtcacheBytesRead:       MetricConfig{Enabled: true},
+					MongodbActiveReads:             MetricConfig{Enabled: true},
+					MongodbActiveWrites:            MetricConfig{Enabled: true},
+					MongodbCacheOperations:         MetricConfig{Enabled: true},
+					MongodbCollectionCount:         MetricConfig{Enabled: true},
+					MongodbCollectionDocumentCount: MetricConfig{Enabled: true},
+					MongodbCollectionIndexCount:    MetricConfig{Enabled: true},
+					MongodbCollectionIndexSize:     MetricConfig{Enabled: true},
+					MongodbCollectionSize:          MetricConfig{Enabled: true},
+					MongodbCollectionStorageSize:   MetricConfig{Enabled: true},
+					MongodbCommandsRate:            MetricConfig{Enabled: true},
+					MongodbConnectionCount:         MetricConfig{Enabled: true},
+					MongodbCursorCount:             MetricConfig{Enabled: true},
+					MongodbCursorTimeoutCount:      MetricConfig{Enabled: true},
+					MongodbDataSize:                MetricConfig{Enabled: true},
+					MongodbDatabaseCount:           MetricConfig{Enabled: true},
+					MongodbDeletesRate:             MetricConfig{Enabled: true},
+					MongodbDocumentOperationCount:  MetricConfig{Enabled: true},
+					MongodbExtentCount:             MetricConfig{Enabled: true},
+					MongodbFlushesRate:             MetricConfig{Enabled: true},
+					MongodbGetmoresRate:            MetricConfig{Enabled: true},
+					MongodbGlobalLockTime:          MetricConfig{Enabled: true},
+					MongodbHealth:                  MetricConfig{Enabled: true},
+					MongodbIndexAccessCount:        MetricConfig{Enabled: true},
+					MongodbIndexCount:              MetricConfig{Enabled: true},
+					MongodbIndexSize:               MetricConfig{Enabled: true},
+					MongodbInsertsRate:             MetricConfig{Enabled: true},
+					MongodbLockAcquireCount:        MetricConfig{Enabled: true},
+					MongodbLockAcquireTime:         MetricConfig{Enabled: true},
+					MongodbLockAcquireWaitCount:    MetricConfig{Enabled: true},
+					MongodbLockDeadlockCount:       MetricConfig{Enabled: true},
+					MongodbMemoryUsage:             MetricConfig{Enabled: true},
+					MongodbNetworkIoReceive:        MetricConfig{Enabled: true},
+					MongodbNetworkIoTransmit:       MetricConfig{Enabled: true},
+					MongodbNetworkRequestCount:     MetricConfig{Enabled: true},
+					MongodbObjectCount:             MetricConfig{Enabled: true},
+					MongodbOperationCount:          MetricConfig{Enabled: true},
+					MongodbOperationLatencyTime:    MetricConfig{Enabled: true},
+					MongodbOperationReplCount:      MetricConfig{Enabled: true},
+					MongodbOperationTime:           MetricConfig{Enabled: true},
+					MongodbPageFaults:              MetricConfig{Enabled: true},
+					MongodbQueriesRate:             MetricConfig{Enabled: true},
+					MongodbReplCommandsPerSec:      MetricConfig{Enabled: true},
+					MongodbReplDeletesPerSec:       MetricConfig{Enabled: true},
+					MongodbReplGetmoresPerSec:      MetricConfig{Enabled: true},
+					MongodbReplInsertsPerSec:       MetricConfig{Enabled: true},
+					MongodbReplQueriesPerSec:       MetricConfig{Enabled: true},
+					MongodbReplUpdatesPerSec:       MetricConfig{Enabled: true},
+					MongodbSessionCount:            MetricConfig{Enabled: true},
+					MongodbStorageSize:             MetricConfig{Enabled: true},
+					MongodbUpdatesRate:             MetricConfig{Enabled: true},
+					MongodbUptime:                  MetricConfig{Enabled: true},
+					MongodbWtcacheBytesRead:        MetricConfig{Enabled: true},
 				},
 				ResourceAttributes: ResourceAttributesConfig{
 					Database:      ResourceAttributeConfig{Enabled: true},
@@ -86,53 +91,58 @@ func TestMetricsBuilderConfig(t *testing.T) {
 			name: "none_set",
 			want: MetricsBuilderConfig{
 				Metrics: MetricsConfig{
-					MongodbActiveReads:            MetricConfig{Enabled: false},
-					MongodbActiveWrites:           MetricConfig{Enabled: false},
-					MongodbCacheOperations:        MetricConfig{Enabled: false},
-					MongodbCollectionCount:        MetricConfig{Enabled: false},
-					MongodbCommandsRate:           MetricConfig{Enabled: false},
-					MongodbConnectionCount:        MetricConfig{Enabled: false},
-					MongodbCursorCount:            MetricConfig{Enabled: false},
-					MongodbCursorTimeoutCount:     MetricConfig{Enabled: false},
-					MongodbDataSize:               MetricConfig{Enabled: false},
-					MongodbDatabaseCount:          MetricConfig{Enabled: false},
-					MongodbDeletesRate:            MetricConfig{Enabled: false},
-					MongodbDocumentOperationCount: MetricConfig{Enabled: false},
-					MongodbExtentCount:            MetricConfig{Enabled: false},
-					MongodbFlushesRate:            MetricConfig{Enabled: false},
-					MongodbGetmoresRate:           MetricConfig{Enabled: false},
-					MongodbGlobalLockTime:         MetricConfig{Enabled: false},
-					MongodbHealth:                 MetricConfig{Enabled: false},
-					MongodbIndexAccessCount:       MetricConfig{Enabled: false},
-					MongodbIndexCount:             MetricConfig{Enabled: false},
-					MongodbIndexSize:              MetricConfig{Enabled: false},
-					MongodbInsertsRate:            MetricConfig{Enabled: false},
-					MongodbLockAcquireCount:       MetricConfig{Enabled: false},
-					MongodbLockAcquireTime:        MetricConfig{Enabled: false},
-					MongodbLockAcquireWaitCount:   MetricConfig{Enabled: false},
-					MongodbLockDeadlockCount:      MetricConfig{Enabled: false},
-					MongodbMemoryUsage:            MetricConfig{Enabled: false},
-					MongodbNetworkIoReceive:       MetricConfig{Enabled: false},
-					MongodbNetworkIoTransmit:      MetricConfig{Enabled: false},
-					MongodbNetworkRequestCount:    MetricConfig{Enabled: false},
-					MongodbObjectCount:            MetricConfig{Enabled: false},
-					MongodbOperationCount:         MetricConfig{Enabled: false},
-					MongodbOperationLatencyTime:   MetricConfig{Enabled: false},
-					MongodbOperationReplCount:     MetricConfig{Enabled: false},
-					MongodbOperationTime:          MetricConfig{Enabled: false},
-					MongodbPageFaults:             MetricConfig{Enabled: false},
-					MongodbQueriesRate:            MetricConfig{Enabled: false},
-					MongodbReplCommandsPerSec:     MetricConfig{Enabled: false},
-					MongodbReplDeletesPerSec:      MetricConfig{Enabled: false},
-					MongodbReplGetmoresPerSec:     MetricConfig{Enabled: false},
-					MongodbReplInsertsPerSec:      MetricConfig{Enabled: false},
-					MongodbReplQueriesPerSec:      MetricConfig{Enabled: false},
-					MongodbReplUpdatesPerSec:      MetricConfig{Enabled: false},
-					MongodbSessionCount:           MetricConfig{Enabled: false},
-					MongodbStorageSize:            MetricConfig{Enabled: false},
-					MongodbUpdatesRate:            MetricConfig{Enabled: false},
-					MongodbUptime:                 MetricConfig{Enabled: false},
-					MongodbWtcacheBytesRead:       MetricConfig{Enabled: false},
+					MongodbActiveReads:             MetricConfig{Enabled: false},
+					MongodbActiveWrites:            MetricConfig{Enabled: false},
+					MongodbCacheOperations:         MetricConfig{Enabled: false},
+					MongodbCollectionCount:         MetricConfig{Enabled: false},
+					MongodbCollectionDocumentCount: MetricConfig{Enabled: false},
+					MongodbCollectionIndexCount:    MetricConfig{Enabled: false},
+					MongodbCollectionIndexSize:     MetricConfig{Enabled: false},
+					MongodbCollectionSize:          MetricConfig{Enabled: false},
+					MongodbCollectionStorageSize:   MetricConfig{Enabled: false},
+					MongodbCommandsRate:            MetricConfig{Enabled: false},
+					MongodbConnectionCount:         MetricConfig{Enabled: false},
+					MongodbCursorCount:             MetricConfig{Enabled: false},
+					MongodbCursorTimeoutCount:      MetricConfig{Enabled: false},
+					MongodbDataSize:                MetricConfig{Enabled: false},
+					MongodbDatabaseCount:           MetricConfig{Enabled: false},
+					MongodbDeletesRate:             MetricConfig{Enabled: false},
+					MongodbDocumentOperationCount:  MetricConfig{Enabled: false},
+					MongodbExtentCount:             MetricConfig{Enabled: false},
+					MongodbFlushesRate:             MetricConfig{Enabled: false},
+					MongodbGetmoresRate:            MetricConfig{Enabled: false},
+					MongodbGlobalLockTime:          MetricConfig{Enabled: false},
+					MongodbHealth:                  MetricConfig{Enabled: false},
+					MongodbIndexAccessCount:        MetricConfig{Enabled: false},
+					MongodbIndexCount:              MetricConfig{Enabled: false},
+					MongodbIndexSize:               MetricConfig{Enabled: false},
+					MongodbInsertsRate:             MetricConfig{Enabled: false},
+					MongodbLockAcquireCount:        MetricConfig{Enabled: false},
+					MongodbLockAcquireTime:         MetricConfig{Enabled: false},
+					MongodbLockAcquireWaitCount:    MetricConfig{Enabled: false},
+					MongodbLockDeadlockCount:       MetricConfig{Enabled: false},
+					MongodbMemoryUsage:             MetricConfig{Enabled: false},
+					MongodbNetworkIoReceive:        MetricConfig{Enabled: false},
+					MongodbNetworkIoTransmit:       MetricConfig{Enabled: false},
+					MongodbNetworkRequestCount:     MetricConfig{Enabled: false},
+					MongodbObjectCount:             MetricConfig{Enabled: false},
+					MongodbOperationCount:          MetricConfig{Enabled: false},
+					MongodbOperationLatencyTime:    MetricConfig{Enabled: false},
+					MongodbOperationReplCount:      MetricConfig{Enabled: false},
+					MongodbOperationTime:           MetricConfig{Enabled: false},
+					MongodbPageFaults:              MetricConfig{Enabled: false},
+					MongodbQueriesRate:             MetricConfig{Enabled: false},
+					MongodbReplCommandsPerSec:      MetricConfig{Enabled: false},
+					MongodbReplDeletesPerSec:       MetricConfig{Enabled: false},
+					MongodbReplGetmoresPerSec:      MetricConfig{Enabled: false},
+					MongodbReplInsertsPerSec:       MetricConfig{Enabled: false},
+					MongodbReplQueriesPerSec:       MetricConfig{Enabled: false},
+					MongodbReplUpdatesPerSec:       MetricConfig{Enabled: false},
+					MongodbSessionCount:            MetricConfig{Enabled: false},
+					MongodbStorageSize:             MetricConfig{Enabled: false},
+					MongodbUpdatesRate:             MetricConfig{Enabled: false},
+					MongodbUptime:                  MetricConfig{Enabled: false},
+					MongodbWtcacheBytesRead:        MetricConfig{Enabled: false},
 				},
 				ResourceAttributes: ResourceAttributesConfig{
 					Database:      ResourceAttributeConfig{Enabled: false},
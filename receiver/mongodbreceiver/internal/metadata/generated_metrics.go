@@ -263,6 +263,21 @@ var MetricsInfo = metricsInfo{
 	MongodbCollectionCount: metricInfo{
 		Name: "mongodb.collection.count",
 	},
+	MongodbCollectionDocumentCount: metricInfo{
+		Name: "mongodb.collection.document.count",
+	},
+	MongodbCollectionIndexCount: metricInfo{
+		Name: "mongodb.collection.index.count",
+	},
+	MongodbCollectionIndexSize: metricInfo{
+		Name: "mongodb.collection.index.size",
+	},
+	MongodbCollectionSize: metricInfo{
+		Name: "mongodb.collection.size",
+	},
+	MongodbCollectionStorageSize: metricInfo{
+		Name: "mongodb.collection.storage.size",
+	},
 	MongodbCommandsRate: metricInfo{
 		Name: "mongodb.commands.rate",
 	},
@@ -395,53 +410,58 @@ var MetricsInfo = metricsInfo{
 }
 
 type metricsInfo struct {
-	MongodbActiveReads            metricInfo
-	MongodbActiveWrites           metricInfo
-	MongodbCacheOperations        metricInfo
-	MongodbCollectionCount        metricInfo
-	MongodbCommandsRate           metricInfo
-	MongodbConnectionCount        metricInfo
-	MongodbCursorCount            metricInfo
-	MongodbCursorTimeoutCount     metricInfo
-	MongodbDataSize               metricInfo
-	MongodbDatabaseCount          metricInfo
-	MongodbDeletesRate            metricInfo
-	MongodbDocumentOperationCount metricInfo
-	MongodbExtentCount            metricInfo
-	MongodbFlushesRate            metricInfo
-	MongodbGetmoresRate           metricInfo
-	MongodbGlobalLockTime         metricInfo
-	MongodbHealth                 metricInfo
-	MongodbIndexAccessCount       metricInfo
-	MongodbIndexCount             metricInfo
-	MongodbIndexSize              metricInfo
-	MongodbInsertsRate            metricInfo
-	MongodbLockAcquireCount       metricInfo
-	MongodbLockAcquireTime        metricInfo
-	MongodbLockAcquireWaitCount   metricInfo
-	MongodbLockDeadlockCount      metricInfo
-	MongodbMemoryUsage            metricInfo
-	MongodbNetworkIoReceive       metricInfo
-	MongodbNetworkIoTransmit      metricInfo
-	MongodbNetworkRequestCount    metricInfo
-	MongodbObjectCount            metricInfo
-	MongodbOperationCount         metricInfo
-	MongodbOperationLatencyTime   metricInfo
-	MongodbOperationReplCount     metricInfo
-	MongodbOperationTime          metricInfo
-	MongodbPageFaults             metricInfo
-	MongodbQueriesRate            metricInfo
-	MongodbReplCommandsPerSec     metricInfo
-	MongodbReplDeletesPerSec      metricInfo
-	MongodbReplGetmoresPerSec     metricInfo
-	MongodbReplInsertsPerSec      metricInfo
-	MongodbReplQueriesPerSec      metricInfo
-	MongodbReplUpdatesPerSec      metricInfo
-	MongodbSessionCount           metricInfo
-	MongodbStorageSize            metricInfo
-	MongodbUpdatesRate            metricInfo
-	MongodbUptime                 metricInfo
-	MongodbWtcacheBytesRead       metricInfo
+	MongodbActiveReads             metricInfo
+	MongodbActiveWrites            metricInfo
+	MongodbCacheOperations         metricInfo
+	MongodbCollectionCount         metricInfo
+	MongodbCollectionDocumentCount metricInfo
+	MongodbCollectionIndexCount    metricInfo
+	MongodbCollectionIndexSize     metricInfo
+	MongodbCollectionSize          metricInfo
+	MongodbCollectionStorageSize   metricInfo
+	MongodbCommandsRate            metricInfo
+	MongodbConnectionCount         metricInfo
+	MongodbCursorCount             metricInfo
+	MongodbCursorTimeoutCount      metricInfo
+	MongodbDataSize                metricInfo
+	MongodbDatabaseCount           metricInfo
+	MongodbDeletesRate             metricInfo
+	MongodbDocumentOperationCount  metricInfo
+	MongodbExtentCount             metricInfo
+	MongodbFlushesRate             metricInfo
+	MongodbGetmoresRate            metricInfo
+	MongodbGlobalLockTime          metricInfo
+	MongodbHealth                  metricInfo
+	MongodbIndexAccessCount        metricInfo
+	MongodbIndexCount              metricInfo
+	MongodbIndexSize               metricInfo
+	MongodbInsertsRate             metricInfo
+	MongodbLockAcquireCount        metricInfo
+	MongodbLockAcquireTime         metricInfo
+	MongodbLockAcquireWaitCount    metricInfo
+	MongodbLockDeadlockCount       metricInfo
+	MongodbMemoryUsage             metricInfo
+	MongodbNetworkIoReceive        metricInfo
+	MongodbNetworkIoTransmit       metricInfo
+	MongodbNetworkRequestCount     metricInfo
+	MongodbObjectCount             metricInfo
+	MongodbOperationCount          metricInfo
+	MongodbOperationLatencyTime    metricInfo
+	MongodbOperationReplCount      metricInfo
+	MongodbOperationTime           metricInfo
+	MongodbPageFaults              metricInfo
+	MongodbQueriesRate             metricInfo
+	MongodbReplCommandsPerSec      metricInfo
+	MongodbReplDeletesPerSec       metricInfo
+	MongodbReplGetmoresPerSec      metricInfo
+	MongodbReplInsertsPerSec       metricInfo
+	MongodbReplQueriesPerSec       metricInfo
+	MongodbReplUpdatesPerSec       metricInfo
+	MongodbSessionCount            metricInfo
+	MongodbStorageSize             metricInfo
+	MongodbUpdatesRate             metricInfo
+	MongodbUptime                  metricInfo
+	MongodbWtcacheBytesRead        metricInfo
 }
 
 type metricInfo struct {
@@ -654,6 +674,271 @@ func newMetricMongodbCollectionCount(cfg MetricConfig) metricMongodbCollectionCo
 	return m
 }
 
+type metricMongodbCollectionDocumentCount struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills mongodb.collection.document.count metric with initial data.
+func (m *metricMongodbCollectionDocumentCount) init() {
+	m.data.SetName("mongodb.collection.document.count")
+	m.data.SetDescription("The number of documents in a collection.")
+	m.data.SetUnit("{documents}")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(false)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricMongodbCollectionDocumentCount) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, collectionAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("collection", collectionAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricMongodbCollectionDocumentCount) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricMongodbCollectionDocumentCount) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricMongodbCollectionDocumentCount(cfg MetricConfig) metricMongodbCollectionDocumentCount {
+	m := metricMongodbCollectionDocumentCount{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricMongodbCollectionIndexCount struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills mongodb.collection.index.count metric with initial data.
+func (m *metricMongodbCollectionIndexCount) init() {
+	m.data.SetName("mongodb.collection.index.count")
+	m.data.SetDescription("The number of indexes on a collection.")
+	m.data.SetUnit("{indexes}")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(false)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricMongodbCollectionIndexCount) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, collectionAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("collection", collectionAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricMongodbCollectionIndexCount) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricMongodbCollectionIndexCount) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricMongodbCollectionIndexCount(cfg MetricConfig) metricMongodbCollectionIndexCount {
+	m := metricMongodbCollectionIndexCount{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricMongodbCollectionIndexSize struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills mongodb.collection.index.size metric with initial data.
+func (m *metricMongodbCollectionIndexSize) init() {
+	m.data.SetName("mongodb.collection.index.size")
+	m.data.SetDescription("The total size of the indexes of a collection.")
+	m.data.SetUnit("By")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(false)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricMongodbCollectionIndexSize) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, collectionAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("collection", collectionAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricMongodbCollectionIndexSize) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricMongodbCollectionIndexSize) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricMongodbCollectionIndexSize(cfg MetricConfig) metricMongodbCollectionIndexSize {
+	m := metricMongodbCollectionIndexSize{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricMongodbCollectionSize struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills mongodb.collection.size metric with initial data.
+func (m *metricMongodbCollectionSize) init() {
+	m.data.SetName("mongodb.collection.size")
+	m.data.SetDescription("The uncompressed size in memory of all documents in a collection.")
+	m.data.SetUnit("By")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(false)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricMongodbCollectionSize) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, collectionAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("collection", collectionAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricMongodbCollectionSize) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricMongodbCollectionSize) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricMongodbCollectionSize(cfg MetricConfig) metricMongodbCollectionSize {
+	m := metricMongodbCollectionSize{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricMongodbCollectionStorageSize struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills mongodb.collection.storage.size metric with initial data.
+func (m *metricMongodbCollectionStorageSize) init() {
+	m.data.SetName("mongodb.collection.storage.size")
+	m.data.SetDescription("The storage allocated to a collection, including free space.")
+	m.data.SetUnit("By")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(false)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricMongodbCollectionStorageSize) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, collectionAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("collection", collectionAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricMongodbCollectionStorageSize) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricMongodbCollectionStorageSize) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricMongodbCollectionStorageSize(cfg MetricConfig) metricMongodbCollectionStorageSize {
+	m := metricMongodbCollectionStorageSize{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 type metricMongodbCommandsRate struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
@@ -2848,60 +3133,65 @@ func newMetricMongodbWtcacheBytesRead(cfg MetricConfig) metricMongodbWtcacheByte
 // MetricsBuilder provides an interface for scrapers to report metrics while taking care of all the transformations
 // required to produce metric representation defined in metadata and user config.
 type MetricsBuilder struct {
-	config                              MetricsBuilderConfig // config of the metrics builder.
-	startTime                           pcommon.Timestamp    // start time that will be applied to all recorded data points.
-	metricsCapacity                     int                  // maximum observed number of metrics per resource.
-	metricsBuffer                       pmetric.Metrics      // accumulates metrics data before emitting.
-	buildInfo                           component.BuildInfo  // contains version information.
-	resourceAttributeIncludeFilter      map[string]filter.Filter
-	resourceAttributeExcludeFilter      map[string]filter.Filter
-	metricMongodbActiveReads            metricMongodbActiveReads
-	metricMongodbActiveWrites           metricMongodbActiveWrites
-	metricMongodbCacheOperations        metricMongodbCacheOperations
-	metricMongodbCollectionCount        metricMongodbCollectionCount
-	metricMongodbCommandsRate           metricMongodbCommandsRate
-	metricMongodbConnectionCount        metricMongodbConnectionCount
-	metricMongodbCursorCount            metricMongodbCursorCount
-	metricMongodbCursorTimeoutCount     metricMongodbCursorTimeoutCount
-	metricMongodbDataSize               metricMongodbDataSize
-	metricMongodbDatabaseCount          metricMongodbDatabaseCount
-	metricMongodbDeletesRate            metricMongodbDeletesRate
-	metricMongodbDocumentOperationCount metricMongodbDocumentOperationCount
-	metricMongodbExtentCount            metricMongodbExtentCount
-	metricMongodbFlushesRate            metricMongodbFlushesRate
-	metricMongodbGetmoresRate           metricMongodbGetmoresRate
-	metricMongodbGlobalLockTime         metricMongodbGlobalLockTime
-	metricMongodbHealth                 metricMongodbHealth
-	metricMongodbIndexAccessCount       metricMongodbIndexAccessCount
-	metricMongodbIndexCount             metricMongodbIndexCount
-	metricMongodbIndexSize              metricMongodbIndexSize
-	metricMongodbInsertsRate            metricMongodbInsertsRate
-	metricMongodbLockAcquireCount       metricMongodbLockAcquireCount
-	metricMongodbLockAcquireTime        metricMongodbLockAcquireTime
-	metricMongodbLockAcquireWaitCount   metricMongodbLockAcquireWaitCount
-	metricMongodbLockDeadlockCount      metricMongodbLockDeadlockCount
-	metricMongodbMemoryUsage            metricMongodbMemoryUsage
-	metricMongodbNetworkIoReceive       metricMongodbNetworkIoReceive
-	metricMongodbNetworkIoTransmit      metricMongodbNetworkIoTransmit
-	metricMongodbNetworkRequestCount    metricMongodbNetworkRequestCount
-	metricMongodbObjectCount            metricMongodbObjectCount
-	metricMongodbOperationCount         metricMongodbOperationCount
-	metricMongodbOperationLatencyTime   metricMongodbOperationLatencyTime
-	metricMongodbOperationReplCount     metricMongodbOperationReplCount
-	metricMongodbOperationTime          metricMongodbOperationTime
-	metricMongodbPageFaults             metricMongodbPageFaults
-	metricMongodbQueriesRate            metricMongodbQueriesRate
-	metricMongodbReplCommandsPerSec     metricMongodbReplCommandsPerSec
-	metricMongodbReplDeletesPerSec      metricMongodbReplDeletesPerSec
-	metricMongodbReplGetmoresPerSec     metricMongodbReplGetmoresPerSec
-	metricMongodbReplInsertsPerSec      metricMongodbReplInsertsPerSec
-	metricMongodbReplQueriesPerSec      metricMongodbReplQueriesPerSec
-	metricMongodbReplUpdatesPerSec      metricMongodbReplUpdatesPerSec
-	metricMongodbSessionCount           metricMongodbSessionCount
-	metricMongodbStorageSize            metricMongodbStorageSize
-	metricMongodbUpdatesRate            metricMongodbUpdatesRate
-	metricMongodbUptime                 metricMongodbUptime
-	metricMongodbWtcacheBytesRead       metricMongodbWtcacheBytesRead
+	config                               MetricsBuilderConfig // config of the metrics builder.
+	startTime                            pcommon.Timestamp    // start time that will be applied to all recorded data points.
+	metricsCapacity                      int                  // maximum observed number of metrics per resource.
+	metricsBuffer                        pmetric.Metrics      // accumulates metrics data before emitting.
+	buildInfo                            component.BuildInfo  // contains version information.
+	resourceAttributeIncludeFilter       map[string]filter.Filter
+	resourceAttributeExcludeFilter       map[string]filter.Filter
+	metricMongodbActiveReads             metricMongodbActiveReads
+	metricMongodbActiveWrites            metricMongodbActiveWrites
+	metricMongodbCacheOperations         metricMongodbCacheOperations
+	metricMongodbCollectionCount         metricMongodbCollectionCount
+	metricMongodbCollectionDocumentCount metricMongodbCollectionDocumentCount
+	metricMongodbCollectionIndexCount    metricMongodbCollectionIndexCount
+	metricMongodbCollectionIndexSize     metricMongodbCollectionIndexSize
+	metricMongodbCollectionSize          metricMongodbCollectionSize
+	metricMongodbCollectionStorageSize   metricMongodbCollectionStorageSize
+	metricMongodbCommandsRate            metricMongodbCommandsRate
+	metricMongodbConnectionCount         metricMongodbConnectionCount
+	metricMongodbCursorCount             metricMongodbCursorCount
+	metricMongodbCursorTimeoutCount      metricMongodbCursorTimeoutCount
+	metricMongodbDataSize                metricMongodbDataSize
+	metricMongodbDatabaseCount           metricMongodbDatabaseCount
+	metricMongodbDeletesRate             metricMongodbDeletesRate
+	metricMongodbDocumentOperationCount  metricMongodbDocumentOperationCount
+	metricMongodbExtentCount             metricMongodbExtentCount
+	metricMongodbFlushesRate             metricMongodbFlushesRate
+	metricMongodbGetmoresRate            metricMongodbGetmoresRate
+	metricMongodbGlobalLockTime          metricMongodbGlobalLockTime
+	metricMongodbHealth                  metricMongodbHealth
+	metricMongodbIndexAccessCount        metricMongodbIndexAccessCount
+	metricMongodbIndexCount              metricMongodbIndexCount
+	metricMongodbIndexSize               metricMongodbIndexSize
+	metricMongodbInsertsRate             metricMongodbInsertsRate
+	metricMongodbLockAcquireCount        metricMongodbLockAcquireCount
+	metricMongodbLockAcquireTime         metricMongodbLockAcquireTime
+	metricMongodbLockAcquireWaitCount    metricMongodbLockAcquireWaitCount
+	metricMongodbLockDeadlockCount       metricMongodbLockDeadlockCount
+	metricMongodbMemoryUsage             metricMongodbMemoryUsage
+	metricMongodbNetworkIoReceive        metricMongodbNetworkIoReceive
+	metricMongodbNetworkIoTransmit       metricMongodbNetworkIoTransmit
+	metricMongodbNetworkRequestCount     metricMongodbNetworkRequestCount
+	metricMongodbObjectCount             metricMongodbObjectCount
+	metricMongodbOperationCount          metricMongodbOperationCount
+	metricMongodbOperationLatencyTime    metricMongodbOperationLatencyTime
+	metricMongodbOperationReplCount      metricMongodbOperationReplCount
+	metricMongodbOperationTime           metricMongodbOperationTime
+	metricMongodbPageFaults              metricMongodbPageFaults
+	metricMongodbQueriesRate             metricMongodbQueriesRate
+	metricMongodbReplCommandsPerSec      metricMongodbReplCommandsPerSec
+	metricMongodbReplDeletesPerSec       metricMongodbReplDeletesPerSec
+	metricMongodbReplGetmoresPerSec      metricMongodbReplGetmoresPerSec
+	metricMongodbReplInsertsPerSec       metricMongodbReplInsertsPerSec
+	metricMongodbReplQueriesPerSec       metricMongodbReplQueriesPerSec
+	metricMongodbReplUpdatesPerSec       metricMongodbReplUpdatesPerSec
+	metricMongodbSessionCount            metricMongodbSessionCount
+	metricMongodbStorageSize             metricMongodbStorageSize
+	metricMongodbUpdatesRate             metricMongodbUpdatesRate
+	metricMongodbUptime                  metricMongodbUptime
+	metricMongodbWtcacheBytesRead        metricMongodbWtcacheBytesRead
 }
 
 // MetricBuilderOption applies changes to default metrics builder.
@@ -2923,59 +3213,64 @@ func WithStartTime(startTime pcommon.Timestamp) MetricBuilderOption {
 }
 func NewMetricsBuilder(mbc MetricsBuilderConfig, settings receiver.Settings, options ...MetricBuilderOption) *MetricsBuilder {
 	mb := &MetricsBuilder{
-		config:                              mbc,
-		startTime:                           pcommon.NewTimestampFromTime(time.Now()),
-		metricsBuffer:                       pmetric.NewMetrics(),
-		buildInfo:                           settings.BuildInfo,
-		metricMongodbActiveReads:            newMetricMongodbActiveReads(mbc.Metrics.MongodbActiveReads),
-		metricMongodbActiveWrites:           newMetricMongodbActiveWrites(mbc.Metrics.MongodbActiveWrites),
-		metricMongodbCacheOperations:        newMetricMongodbCacheOperations(mbc.Metrics.MongodbCacheOperations),
-		metricMongodbCollectionCount:        newMetricMongodbCollectionCount(mbc.Metrics.MongodbCollectionCount),
-		metricMongodbCommandsRate:           newMetricMongodbCommandsRate(mbc.Metrics.MongodbCommandsRate),
-		metricMongodbConnectionCount:        newMetricMongodbConnectionCount(mbc.Metrics.MongodbConnectionCount),
-		metricMongodbCursorCount:            newMetricMongodbCursorCount(mbc.Metrics.MongodbCursorCount),
-		metricMongodbCursorTimeoutCount:     newMetricMongodbCursorTimeoutCount(mbc.Metrics.MongodbCursorTimeoutCount),
-		metricMongodbDataSize:               newMetricMongodbDataSize(mbc.Metrics.MongodbDataSize),
-		metricMongodbDatabaseCount:          newMetricMongodbDatabaseCount(mbc.Metrics.MongodbDatabaseCount),
-		metricMongodbDeletesRate:            newMetricMongodbDeletesRate(mbc.Metrics.MongodbDeletesRate),
-		metricMongodbDocumentOperationCount: newMetricMongodbDocumentOperationCount(mbc.Metrics.MongodbDocumentOperationCount),
-		metricMongodbExtentCount:            newMetricMongodbExtentCount(mbc.Metrics.MongodbExtentCount),
-		metricMongodbFlushesRate:            newMetricMongodbFlushesRate(mbc.Metrics.MongodbFlushesRate),
-		metricMongodbGetmoresRate:           newMetricMongodbGetmoresRate(mbc.Metrics.MongodbGetmoresRate),
-		metricMongodbGlobalLockTime:         newMetricMongodbGlobalLockTime(mbc.Metrics.MongodbGlobalLockTime),
-		metricMongodbHealth:                 newMetricMongodbHealth(mbc.Metrics.MongodbHealth),
-		metricMongodbIndexAccessCount:       newMetricMongodbIndexAccessCount(mbc.Metrics.MongodbIndexAccessCount),
-		metricMongodbIndexCount:             newMetricMongodbIndexCount(mbc.Metrics.MongodbIndexCount),
-		metricMongodbIndexSize:              newMetricMongodbIndexSize(mbc.Metrics.MongodbIndexSize),
-		metricMongodbInsertsRate:            newMetricMongodbInsertsRate(mbc.Metrics.MongodbInsertsRate),
-		metricMongodbLockAcquireCount:       newMetricMongodbLockAcquireCount(mbc.Metrics.MongodbLockAcquireCount),
-		metricMongodbLockAcquireTime:        newMetricMongodbLockAcquireTime(mbc.Metrics.MongodbLockAcquireTime),
-		metricMongodbLockAcquireWaitCount:   newMetricMongodbLockAcquireWaitCount(mbc.Metrics.MongodbLockAcquireWaitCount),
-		metricMongodbLockDeadlockCount:      newMetricMongodbLockDeadlockCount(mbc.Metrics.MongodbLockDeadlockCount),
-		metricMongodbMemoryUsage:            newMetricMongodbMemoryUsage(mbc.Metrics.MongodbMemoryUsage),
-		metricMongodbNetworkIoReceive:       newMetricMongodbNetworkIoReceive(mbc.Metrics.MongodbNetworkIoReceive),
-		metricMongodbNetworkIoTransmit:      newMetricMongodbNetworkIoTransmit(mbc.Metrics.MongodbNetworkIoTransmit),
-		metricMongodbNetworkRequestCount:    newMetricMongodbNetworkRequestCount(mbc.Metrics.MongodbNetworkRequestCount),
-		metricMongodbObjectCount:            newMetricMongodbObjectCount(mbc.Metrics.MongodbObjectCount),
-		metricMongodbOperationCount:         newMetricMongodbOperationCount(mbc.Metrics.MongodbOperationCount),
-		metricMongodbOperationLatencyTime:   newMetricMongodbOperationLatencyTime(mbc.Metrics.MongodbOperationLatencyTime),
-		metricMongodbOperationReplCount:     newMetricMongodbOperationReplCount(mbc.Metrics.MongodbOperationReplCount),
-		metricMongodbOperationTime:          newMetricMongodbOperationTime(mbc.Metrics.MongodbOperationTime),
-		metricMongodbPageFaults:             newMetricMongodbPageFaults(mbc.Metrics.MongodbPageFaults),
-		metricMongodbQueriesRate:            newMetricMongodbQueriesRate(mbc.Metrics.MongodbQueriesRate),
-		metricMongodbReplCommandsPerSec:     newMetricMongodbReplCommandsPerSec(mbc.Metrics.MongodbReplCommandsPerSec),
-		metricMongodbReplDeletesPerSec:      newMetricMongodbReplDeletesPerSec(mbc.Metrics.MongodbReplDeletesPerSec),
-		metricMongodbReplGetmoresPerSec:     newMetricMongodbReplGetmoresPerSec(mbc.Metrics.MongodbReplGetmoresPerSec),
-		metricMongodbReplInsertsPerSec:      newMetricMongodbReplInsertsPerSec(mbc.Metrics.MongodbReplInsertsPerSec),
-		metricMongodbReplQueriesPerSec:      newMetricMongodbReplQueriesPerSec(mbc.Metrics.MongodbReplQueriesPerSec),
-		metricMongodbReplUpdatesPerSec:      newMetricMongodbReplUpdatesPerSec(mbc.Metrics.MongodbReplUpdatesPerSec),
-		metricMongodbSessionCount:           newMetricMongodbSessionCount(mbc.Metrics.MongodbSessionCount),
-		metricMongodbStorageSize:            newMetricMongodbStorageSize(mbc.Metrics.MongodbStorageSize),
-		metricMongodbUpdatesRate:            newMetricMongodbUpdatesRate(mbc.Metrics.MongodbUpdatesRate),
-		metricMongodbUptime:                 newMetricMongodbUptime(mbc.Metrics.MongodbUptime),
-		metricMongodbWtcacheBytesRead:       newMetricMongodbWtcacheBytesRead(mbc.Metrics.MongodbWtcacheBytesRead),
-		resourceAttributeIncludeFilter:      make(map[string]filter.Filter),
-		resourceAttributeExcludeFilter:      make(map[string]filter.Filter),
+		config:                               mbc,
+		startTime:                            pcommon.NewTimestampFromTime(time.Now()),
+		metricsBuffer:                        pmetric.NewMetrics(),
+		buildInfo:                            settings.BuildInfo,
+		metricMongodbActiveReads:             newMetricMongodbActiveReads(mbc.Metrics.MongodbActiveReads),
+		metricMongodbActiveWrites:            newMetricMongodbActiveWrites(mbc.Metrics.MongodbActiveWrites),
+		metricMongodbCacheOperations:         newMetricMongodbCacheOperations(mbc.Metrics.MongodbCacheOperations),
+		metricMongodbCollectionCount:         newMetricMongodbCollectionCount(mbc.Metrics.MongodbCollectionCount),
+		metricMongodbCollectionDocumentCount: newMetricMongodbCollectionDocumentCount(mbc.Metrics.MongodbCollectionDocumentCount),
+		metricMongodbCollectionIndexCount:    newMetricMongodbCollectionIndexCount(mbc.Metrics.MongodbCollectionIndexCount),
+		metricMongodbCollectionIndexSize:     newMetricMongodbCollectionIndexSize(mbc.Metrics.MongodbCollectionIndexSize),
+		metricMongodbCollectionSize:          newMetricMongodbCollectionSize(mbc.Metrics.MongodbCollectionSize),
+		metricMongodbCollectionStorageSize:   newMetricMongodbCollectionStorageSize(mbc.Metrics.MongodbCollectionStorageSize),
+		metricMongodbCommandsRate:            newMetricMongodbCommandsRate(mbc.Metrics.MongodbCommandsRate),
+		metricMongodbConnectionCount:         newMetricMongodbConnectionCount(mbc.Metrics.MongodbConnectionCount),
+		metricMongodbCursorCount:             newMetricMongodbCursorCount(mbc.Metrics.MongodbCursorCount),
+		metricMongodbCursorTimeoutCount:      newMetricMongodbCursorTimeoutCount(mbc.Metrics.MongodbCursorTimeoutCount),
+		metricMongodbDataSize:                newMetricMongodbDataSize(mbc.Metrics.MongodbDataSize),
+		metricMongodbDatabaseCount:           newMetricMongodbDatabaseCount(mbc.Metrics.MongodbDatabaseCount),
+		metricMongodbDeletesRate:             newMetricMongodbDeletesRate(mbc.Metrics.MongodbDeletesRate),
+		metricMongodbDocumentOperationCount:  newMetricMongodbDocumentOperationCount(mbc.Metrics.MongodbDocumentOperationCount),
+		metricMongodbExtentCount:             newMetricMongodbExtentCount(mbc.Metrics.MongodbExtentCount),
+		metricMongodbFlushesRate:             newMetricMongodbFlushesRate(mbc.Metrics.MongodbFlushesRate),
+		metricMongodbGetmoresRate:            newMetricMongodbGetmoresRate(mbc.Metrics.MongodbGetmoresRate),
+		metricMongodbGlobalLockTime:          newMetricMongodbGlobalLockTime(mbc.Metrics.MongodbGlobalLockTime),
+		metricMongodbHealth:                  newMetricMongodbHealth(mbc.Metrics.MongodbHealth),
+		metricMongodbIndexAccessCount:        newMetricMongodbIndexAccessCount(mbc.Metrics.MongodbIndexAccessCount),
+		metricMongodbIndexCount:              newMetricMongodbIndexCount(mbc.Metrics.MongodbIndexCount),
+		metricMongodbIndexSize:               newMetricMongodbIndexSize(mbc.Metrics.MongodbIndexSize),
+		metricMongodbInsertsRate:             newMetricMongodbInsertsRate(mbc.Metrics.MongodbInsertsRate),
+		metricMongodbLockAcquireCount:        newMetricMongodbLockAcquireCount(mbc.Metrics.MongodbLockAcquireCount),
+		metricMongodbLockAcquireTime:         newMetricMongodbLockAcquireTime(mbc.Metrics.MongodbLockAcquireTime),
+		metricMongodbLockAcquireWaitCount:    newMetricMongodbLockAcquireWaitCount(mbc.Metrics.MongodbLockAcquireWaitCount),
+		metricMongodbLockDeadlockCount:       newMetricMongodbLockDeadlockCount(mbc.Metrics.MongodbLockDeadlockCount),
+		metricMongodbMemoryUsage:             newMetricMongodbMemoryUsage(mbc.Metrics.MongodbMemoryUsage),
+		metricMongodbNetworkIoReceive:        newMetricMongodbNetworkIoReceive(mbc.Metrics.MongodbNetworkIoReceive),
+		metricMongodbNetworkIoTransmit:       newMetricMongodbNetworkIoTransmit(mbc.Metrics.MongodbNetworkIoTransmit),
+		metricMongodbNetworkRequestCount:     newMetricMongodbNetworkRequestCount(mbc.Metrics.MongodbNetworkRequestCount),
+		metricMongodbObjectCount:             newMetricMongodbObjectCount(mbc.Metrics.MongodbObjectCount),
+		metricMongodbOperationCount:          newMetricMongodbOperationCount(mbc.Metrics.MongodbOperationCount),
+		metricMongodbOperationLatencyTime:    newMetricMongodbOperationLatencyTime(mbc.Metrics.MongodbOperationLatencyTime),
+		metricMongodbOperationReplCount:      newMetricMongodbOperationReplCount(mbc.Metrics.MongodbOperationReplCount),
+		metricMongodbOperationTime:           newMetricMongodbOperationTime(mbc.Metrics.MongodbOperationTime),
+		metricMongodbPageFaults:              newMetricMongodbPageFaults(mbc.Metrics.MongodbPageFaults),
+		metricMongodbQueriesRate:             newMetricMongodbQueriesRate(mbc.Metrics.MongodbQueriesRate),
+		metricMongodbReplCommandsPerSec:      newMetricMongodbReplCommandsPerSec(mbc.Metrics.MongodbReplCommandsPerSec),
+		metricMongodbReplDeletesPerSec:       newMetricMongodbReplDeletesPerSec(mbc.Metrics.MongodbReplDeletesPerSec),
+		metricMongodbReplGetmoresPerSec:      newMetricMongodbReplGetmoresPerSec(mbc.Metrics.MongodbReplGetmoresPerSec),
+		metricMongodbReplInsertsPerSec:       newMetricMongodbReplInsertsPerSec(mbc.Metrics.MongodbReplInsertsPerSec),
+		metricMongodbReplQueriesPerSec:       newMetricMongodbReplQueriesPerSec(mbc.Metrics.MongodbReplQueriesPerSec),
+		metricMongodbReplUpdatesPerSec:       newMetricMongodbReplUpdatesPerSec(mbc.Metrics.MongodbReplUpdatesPerSec),
+		metricMongodbSessionCount:            newMetricMongodbSessionCount(mbc.Metrics.MongodbSessionCount),
+		metricMongodbStorageSize:             newMetricMongodbStorageSize(mbc.Metrics.MongodbStorageSize),
+		metricMongodbUpdatesRate:             newMetricMongodbUpdatesRate(mbc.Metrics.MongodbUpdatesRate),
+		metricMongodbUptime:                  newMetricMongodbUptime(mbc.Metrics.MongodbUptime),
+		metricMongodbWtcacheBytesRead:        newMetricMongodbWtcacheBytesRead(mbc.Metrics.MongodbWtcacheBytesRead),
+		resourceAttributeIncludeFilter:       make(map[string]filter.Filter),
+		resourceAttributeExcludeFilter:       make(map[string]filter.Filter),
 	}
 	if mbc.ResourceAttributes.Database.MetricsInclude != nil {
 		mb.resourceAttributeIncludeFilter["database"] = filter.CreateFilter(mbc.ResourceAttributes.Database.MetricsInclude)
@@ -3068,6 +3363,11 @@ func (mb *MetricsBuilder) EmitForResource(options ...ResourceMetricsOption) {
 	mb.metricMongodbActiveWrites.emit(ils.Metrics())
 	mb.metricMongodbCacheOperations.emit(ils.Metrics())
 	mb.metricMongodbCollectionCount.emit(ils.Metrics())
+	mb.metricMongodbCollectionDocumentCount.emit(ils.Metrics())
+	mb.metricMongodbCollectionIndexCount.emit(ils.Metrics())
+	mb.metricMongodbCollectionIndexSize.emit(ils.Metrics())
+	mb.metricMongodbCollectionSize.emit(ils.Metrics())
+	mb.metricMongodbCollectionStorageSize.emit(ils.Metrics())
 	mb.metricMongodbCommandsRate.emit(ils.Metrics())
 	mb.metricMongodbConnectionCount.emit(ils.Metrics())
 	mb.metricMongodbCursorCount.emit(ils.Metrics())
@@ -3162,6 +3462,31 @@ func (mb *MetricsBuilder) RecordMongodbCollectionCountDataPoint(ts pcommon.Times
 	mb.metricMongodbCollectionCount.recordDataPoint(mb.startTime, ts, val)
 }
 
+// RecordMongodbCollectionDocumentCountDataPoint adds a data point to mongodb.collection.document.count metric.
+func (mb *MetricsBuilder) RecordMongodbCollectionDocumentCountDataPoint(ts pcommon.Timestamp, val int64, collectionAttributeValue string) {
+	mb.metricMongodbCollectionDocumentCount.recordDataPoint(mb.startTime, ts, val, collectionAttributeValue)
+}
+
+// RecordMongodbCollectionIndexCountDataPoint adds a data point to mongodb.collection.index.count metric.
+func (mb *MetricsBuilder) RecordMongodbCollectionIndexCountDataPoint(ts pcommon.Timestamp, val int64, collectionAttributeValue string) {
+	mb.metricMongodbCollectionIndexCount.recordDataPoint(mb.startTime, ts, val, collectionAttributeValue)
+}
+
+// RecordMongodbCollectionIndexSizeDataPoint adds a data point to mongodb.collection.index.size metric.
+func (mb *MetricsBuilder) RecordMongodbCollectionIndexSizeDataPoint(ts pcommon.Timestamp, val int64, collectionAttributeValue string) {
+	mb.metricMongodbCollectionIndexSize.recordDataPoint(mb.startTime, ts, val, collectionAttributeValue)
+}
+
+// RecordMongodbCollectionSizeDataPoint adds a data point to mongodb.collection.size metric.
+func (mb *MetricsBuilder) RecordMongodbCollectionSizeDataPoint(ts pcommon.Timestamp, val int64, collectionAttributeValue string) {
+	mb.metricMongodbCollectionSize.recordDataPoint(mb.startTime, ts, val, collectionAttributeValue)
+}
+
+// RecordMongodbCollectionStorageSizeDataPoint adds a data point to mongodb.collection.storage.size metric.
+func (mb *MetricsBuilder) RecordMongodbCollectionStorageSizeDataPoint(ts pcommon.Timestamp, val int64, collectionAttributeValue string) {
+	mb.metricMongodbCollectionStorageSize.recordDataPoint(mb.startTime, ts, val, collectionAttributeValue)
+}
+
 // RecordMongodbCommandsRateDataPoint adds a data point to mongodb.commands.rate metric.
 func (mb *MetricsBuilder) RecordMongodbCommandsRateDataPoint(ts pcommon.Timestamp, val float64) {
 	mb.metricMongodbCommandsRate.recordDataPoint(mb.startTime, ts, val)
@@ -82,6 +82,21 @@ func TestMetricsBuilder(t *testing.T) {
 			allMetricsCount++
 			mb.RecordMongodbCollectionCountDataPoint(ts, 1)
 
+			allMetricsCount++
+			mb.RecordMongodbCollectionDocumentCountDataPoint(ts, 1, "collection-val")
+
+			allMetricsCount++
+			mb.RecordMongodbCollectionIndexCountDataPoint(ts, 1, "collection-val")
+
+			allMetricsCount++
+			mb.RecordMongodbCollectionIndexSizeDataPoint(ts, 1, "collection-val")
+
+			allMetricsCount++
+			mb.RecordMongodbCollectionSizeDataPoint(ts, 1, "collection-val")
+
+			allMetricsCount++
+			mb.RecordMongodbCollectionStorageSizeDataPoint(ts, 1, "collection-val")
+
 			allMetricsCount++
 			mb.RecordMongodbCommandsRateDataPoint(ts, 1)
 
@@ -316,6 +331,91 @@ func TestMetricsBuilder(t *testing.T) {
 					assert.Equal(t, ts, dp.Timestamp())
 					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
 					assert.Equal(t, int64(1), dp.IntValue())
+				case "mongodb.collection.document.count":
+					assert.False(t, validatedMetrics["mongodb.collection.document.count"], "Found a duplicate in the metrics slice: mongodb.collection.document.count")
+					validatedMetrics["mongodb.collection.document.count"] = true
+					assert.Equal(t, pmetric.MetricTypeSum, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Sum().DataPoints().Len())
+					assert.Equal(t, "The number of documents in a collection.", ms.At(i).Description())
+					assert.Equal(t, "{documents}", ms.At(i).Unit())
+					assert.False(t, ms.At(i).Sum().IsMonotonic())
+					assert.Equal(t, pmetric.AggregationTemporalityCumulative, ms.At(i).Sum().AggregationTemporality())
+					dp := ms.At(i).Sum().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("collection")
+					assert.True(t, ok)
+					assert.Equal(t, "collection-val", attrVal.Str())
+				case "mongodb.collection.index.count":
+					assert.False(t, validatedMetrics["mongodb.collection.index.count"], "Found a duplicate in the metrics slice: mongodb.collection.index.count")
+					validatedMetrics["mongodb.collection.index.count"] = true
+					assert.Equal(t, pmetric.MetricTypeSum, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Sum().DataPoints().Len())
+					assert.Equal(t, "The number of indexes on a collection.", ms.At(i).Description())
+					assert.Equal(t, "{indexes}", ms.At(i).Unit())
+					assert.False(t, ms.At(i).Sum().IsMonotonic())
+					assert.Equal(t, pmetric.AggregationTemporalityCumulative, ms.At(i).Sum().AggregationTemporality())
+					dp := ms.At(i).Sum().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("collection")
+					assert.True(t, ok)
+					assert.Equal(t, "collection-val", attrVal.Str())
+				case "mongodb.collection.index.size":
+					assert.False(t, validatedMetrics["mongodb.collection.index.size"], "Found a duplicate in the metrics slice: mongodb.collection.index.size")
+					validatedMetrics["mongodb.collection.index.size"] = true
+					assert.Equal(t, pmetric.MetricTypeSum, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Sum().DataPoints().Len())
+					assert.Equal(t, "The total size of the indexes of a collection.", ms.At(i).Description())
+					assert.Equal(t, "By", ms.At(i).Unit())
+					assert.False(t, ms.At(i).Sum().IsMonotonic())
+					assert.Equal(t, pmetric.AggregationTemporalityCumulative, ms.At(i).Sum().AggregationTemporality())
+					dp := ms.At(i).Sum().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("collection")
+					assert.True(t, ok)
+					assert.Equal(t, "collection-val", attrVal.Str())
+				case "mongodb.collection.size":
+					assert.False(t, validatedMetrics["mongodb.collection.size"], "Found a duplicate in the metrics slice: mongodb.collection.size")
+					validatedMetrics["mongodb.collection.size"] = true
+					assert.Equal(t, pmetric.MetricTypeSum, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Sum().DataPoints().Len())
+					assert.Equal(t, "The uncompressed size in memory of all documents in a collection.", ms.At(i).Description())
+					assert.Equal(t, "By", ms.At(i).Unit())
+					assert.False(t, ms.At(i).Sum().IsMonotonic())
+					assert.Equal(t, pmetric.AggregationTemporalityCumulative, ms.At(i).Sum().AggregationTemporality())
+					dp := ms.At(i).Sum().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("collection")
+					assert.True(t, ok)
+					assert.Equal(t, "collection-val", attrVal.Str())
+				case "mongodb.collection.storage.size":
+					assert.False(t, validatedMetrics["mongodb.collection.storage.size"], "Found a duplicate in the metrics slice: mongodb.collection.storage.size")
+					validatedMetrics["mongodb.collection.storage.size"] = true
+					assert.Equal(t, pmetric.MetricTypeSum, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Sum().DataPoints().Len())
+					assert.Equal(t, "The storage allocated to a collection, including free space.", ms.At(i).Description())
+					assert.Equal(t, "By", ms.At(i).Unit())
+					assert.False(t, ms.At(i).Sum().IsMonotonic())
+					assert.Equal(t, pmetric.AggregationTemporalityCumulative, ms.At(i).Sum().AggregationTemporality())
+					dp := ms.At(i).Sum().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("collection")
+					assert.True(t, ok)
+					assert.Equal(t, "collection-val", attrVal.Str())
 				case "mongodb.commands.rate":
 					assert.False(t, validatedMetrics["mongodb.commands.rate"], "Found a duplicate in the metrics slice: mongodb.commands.rate")
 					validatedMetrics["mongodb.commands.rate"] = true
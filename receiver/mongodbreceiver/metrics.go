@@ -661,6 +661,30 @@ func (s *mongodbScraper) recordIndexAccess(now pcommon.Timestamp, documents []bs
 	s.mb.RecordMongodbIndexAccessCountDataPoint(now, indexAccessTotal, collectionName)
 }
 
+// Collection Stats
+func (s *mongodbScraper) recordCollectionStats(now pcommon.Timestamp, doc bson.M, dbName, collectionName string, errs *scrapererror.ScrapeErrors) {
+	metricAttributes := fmt.Sprintf("%s, %s", dbName, collectionName)
+	collectionMetrics := []struct {
+		name   string
+		field  string
+		record func(pcommon.Timestamp, int64, string)
+	}{
+		{"mongodb.collection.document.count", "count", s.mb.RecordMongodbCollectionDocumentCountDataPoint},
+		{"mongodb.collection.size", "size", s.mb.RecordMongodbCollectionSizeDataPoint},
+		{"mongodb.collection.storage.size", "storageSize", s.mb.RecordMongodbCollectionStorageSizeDataPoint},
+		{"mongodb.collection.index.count", "nindexes", s.mb.RecordMongodbCollectionIndexCountDataPoint},
+		{"mongodb.collection.index.size", "totalIndexSize", s.mb.RecordMongodbCollectionIndexSizeDataPoint},
+	}
+	for _, m := range collectionMetrics {
+		val, err := collectMetric(doc, []string{"storageStats", m.field})
+		if err != nil {
+			errs.AddPartial(1, fmt.Errorf(collectMetricWithAttributes, m.name, metricAttributes, err))
+			continue
+		}
+		m.record(now, val, collectionName)
+	}
+}
+
 // Top Stats
 func (s *mongodbScraper) recordOperationTime(now pcommon.Timestamp, doc bson.M, errs *scrapererror.ScrapeErrors) {
 	metricName := "mongodb.operation.time"
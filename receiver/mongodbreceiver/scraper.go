@@ -184,6 +184,7 @@ func (s *mongodbScraper) collectMetrics(ctx context.Context, errs *scrapererror.
 
 		for _, collectionName := range collectionNames {
 			s.collectIndexStats(ctx, now, dbName, collectionName, errs)
+			s.collectCollectionStats(ctx, now, dbName, collectionName, errs)
 		}
 
 		rb.SetServerAddress(serverAddress)
@@ -230,6 +231,29 @@ func (s *mongodbScraper) collectIndexStats(ctx context.Context, now pcommon.Time
 	s.recordIndexStats(now, indexStats, databaseName, collectionName, errs)
 }
 
+func (s *mongodbScraper) collectCollectionStats(ctx context.Context, now pcommon.Timestamp, databaseName, collectionName string, errs *scrapererror.ScrapeErrors) {
+	if !s.collectionStatsEnabled() || !s.config.Namespaces.matches(databaseName, collectionName) {
+		return
+	}
+	collStats, err := s.client.CollectionStats(ctx, databaseName, collectionName)
+	if err != nil {
+		errs.AddPartial(1, fmt.Errorf("failed to fetch collection stats metrics: %w", err))
+		return
+	}
+	s.recordCollectionStats(now, collStats, databaseName, collectionName, errs)
+}
+
+// collectionStatsEnabled returns whether one of the metrics read from $collStats
+// is enabled, the collection stats are not fetched otherwise.
+func (s *mongodbScraper) collectionStatsEnabled() bool {
+	m := s.config.Metrics
+	return m.MongodbCollectionDocumentCount.Enabled ||
+		m.MongodbCollectionSize.Enabled ||
+		m.MongodbCollectionStorageSize.Enabled ||
+		m.MongodbCollectionIndexCount.Enabled ||
+		m.MongodbCollectionIndexSize.Enabled
+}
+
 func (s *mongodbScraper) recordDBStats(now pcommon.Timestamp, doc bson.M, dbName string, errs *scrapererror.ScrapeErrors) {
 	s.recordCollections(now, doc, dbName, errs)
 	s.recordDataSize(now, doc, dbName, errs)
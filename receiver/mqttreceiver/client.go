@@ -0,0 +1,47 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mqttreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/mqttreceiver"
+
+import (
+	"context"
+	"crypto/tls"
+	"net/url"
+
+	"go.uber.org/zap"
+)
+
+// messageHandler is called for every message received on the subscribed topics.
+// The message is acknowledged to the broker once the handler returns.
+type messageHandler func(topic string, payload []byte)
+
+// subscription is a topic filter with its maximum quality of service.
+type subscription struct {
+	filter string
+	qos    byte
+}
+
+// mqttClient maintains a session with the broker, and renews the subscriptions on every (re)connection.
+type mqttClient interface {
+	connect(ctx context.Context) error
+	disconnect(ctx context.Context) error
+}
+
+// clientSettings holds everything needed to create a mqttClient.
+type clientSettings struct {
+	brokerURL     *url.URL
+	tlsConfig     *tls.Config
+	subscriptions []subscription
+	handler       messageHandler
+	logger        *zap.Logger
+}
+
+// clientFactory creates a mqttClient, it is replaced in tests.
+type clientFactory func(cfg *Config, settings clientSettings) (mqttClient, error)
+
+func newClient(cfg *Config, settings clientSettings) (mqttClient, error) {
+	if cfg.ProtocolVersion == protocolVersion311 {
+		return newClientV311(cfg, settings), nil
+	}
+	return newClientV5(cfg, settings), nil
+}
@@ -0,0 +1,72 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mqttreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/mqttreceiver"
+
+import (
+	"context"
+	"time"
+
+	mqtt "github.com/eclipse/paho.mqtt.golang"
+	"go.uber.org/zap"
+)
+
+// disconnectQuiesce is the time given to the in-flight messages to be handled on disconnection.
+const disconnectQuiesce = 250 * time.Millisecond
+
+// clientV311 is a MQTT 3.1.1 client that reconnects automatically.
+type clientV311 struct {
+	client mqtt.Client
+	logger *zap.Logger
+}
+
+func newClientV311(cfg *Config, settings clientSettings) *clientV311 {
+	c := &clientV311{logger: settings.logger}
+
+	filters := make(map[string]byte, len(settings.subscriptions))
+	for _, s := range settings.subscriptions {
+		filters[s.filter] = s.qos
+	}
+	onMessage := func(_ mqtt.Client, msg mqtt.Message) {
+		settings.handler(msg.Topic(), msg.Payload())
+	}
+
+	opts := mqtt.NewClientOptions().
+		AddBroker(settings.brokerURL.String()).
+		SetProtocolVersion(4).
+		SetClientID(cfg.ClientID).
+		SetUsername(cfg.Username).
+		SetPassword(string(cfg.Password)).
+		SetTLSConfig(settings.tlsConfig).
+		SetKeepAlive(cfg.KeepAlive).
+		SetConnectTimeout(cfg.ConnectTimeout).
+		SetCleanSession(cfg.CleanStart).
+		SetAutoReconnect(true).
+		SetConnectRetry(true).
+		SetOrderMatters(false).
+		SetOnConnectHandler(func(client mqtt.Client) {
+			// Subscriptions are renewed on every connection, the broker may have dropped the session.
+			token := client.SubscribeMultiple(filters, onMessage)
+			token.Wait()
+			if err := token.Error(); err != nil {
+				c.logger.Error("failed to subscribe to MQTT topics", zap.Error(err))
+			}
+		}).
+		SetConnectionLostHandler(func(_ mqtt.Client, err error) {
+			c.logger.Warn("connection to MQTT broker lost", zap.Error(err))
+		})
+	c.client = mqtt.NewClient(opts)
+	return c
+}
+
+func (c *clientV311) connect(context.Context) error {
+	// With connect retry enabled, the connection attempts go on in the background
+	// until one succeeds, so the connection token is not waited for.
+	c.client.Connect()
+	return nil
+}
+
+func (c *clientV311) disconnect(context.Context) error {
+	c.client.Disconnect(uint(disconnectQuiesce.Milliseconds()))
+	return nil
+}
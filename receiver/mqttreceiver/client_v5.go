@@ -0,0 +1,90 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mqttreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/mqttreceiver"
+
+import (
+	"context"
+	"math"
+	"net/url"
+
+	"github.com/eclipse/paho.golang/autopaho"
+	"github.com/eclipse/paho.golang/paho"
+	"go.uber.org/zap"
+)
+
+// clientV5 is a MQTT 5 client that reconnects automatically.
+type clientV5 struct {
+	config  autopaho.ClientConfig
+	connMgr *autopaho.ConnectionManager
+	logger  *zap.Logger
+}
+
+func newClientV5(cfg *Config, settings clientSettings) *clientV5 {
+	c := &clientV5{logger: settings.logger}
+
+	subscriptions := make([]paho.SubscribeOptions, 0, len(settings.subscriptions))
+	for _, s := range settings.subscriptions {
+		subscriptions = append(subscriptions, paho.SubscribeOptions{Topic: s.filter, QoS: s.qos})
+	}
+
+	var sessionExpiryInterval uint32
+	if !cfg.CleanStart {
+		// Keep the session, and the messages published while disconnected, as long as the broker allows.
+		sessionExpiryInterval = math.MaxUint32
+	}
+
+	c.config = autopaho.ClientConfig{
+		ServerUrls:                    []*url.URL{settings.brokerURL},
+		TlsCfg:                        settings.tlsConfig,
+		KeepAlive:                     uint16(cfg.KeepAlive.Seconds()),
+		ConnectTimeout:                cfg.ConnectTimeout,
+		CleanStartOnInitialConnection: cfg.CleanStart,
+		SessionExpiryInterval:         sessionExpiryInterval,
+		ConnectUsername:               cfg.Username,
+		ConnectPassword:               []byte(cfg.Password),
+		OnConnectionUp: func(cm *autopaho.ConnectionManager, _ *paho.Connack) {
+			if _, err := cm.Subscribe(context.Background(), &paho.Subscribe{Subscriptions: subscriptions}); err != nil {
+				c.logger.Error("failed to subscribe to MQTT topics", zap.Error(err))
+			}
+		},
+		OnConnectError: func(err error) {
+			c.logger.Warn("failed to connect to MQTT broker", zap.Error(err))
+		},
+		ClientConfig: paho.ClientConfig{
+			ClientID: cfg.ClientID,
+			OnPublishReceived: []func(paho.PublishReceived) (bool, error){
+				func(pr paho.PublishReceived) (bool, error) {
+					settings.handler(pr.Packet.Topic, pr.Packet.Payload)
+					return true, nil
+				},
+			},
+			OnClientError: func(err error) {
+				c.logger.Warn("MQTT client error", zap.Error(err))
+			},
+			OnServerDisconnect: func(d *paho.Disconnect) {
+				c.logger.Warn("disconnected by MQTT broker", zap.Uint8("reason_code", d.ReasonCode))
+			},
+		},
+	}
+	return c
+}
+
+func (c *clientV5) connect(ctx context.Context) error {
+	// The connection manager lives until disconnect, it must not be bound to the start context.
+	connMgr, err := autopaho.NewConnection(context.WithoutCancel(ctx), c.config)
+	if err != nil {
+		return err
+	}
+	c.connMgr = connMgr
+	return nil
+}
+
+func (c *clientV5) disconnect(ctx context.Context) error {
+	if c.connMgr == nil {
+		return nil
+	}
+	err := c.connMgr.Disconnect(ctx)
+	<-c.connMgr.Done()
+	return err
+}
@@ -0,0 +1,129 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mqttreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/mqttreceiver"
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config/configopaque"
+	"go.opentelemetry.io/collector/config/configtls"
+)
+
+const (
+	protocolVersion5   = "5"
+	protocolVersion311 = "3.1.1"
+
+	encodingOTLPProto = "otlp_proto"
+	encodingOTLPJSON  = "otlp_json"
+	encodingJSON      = "json"
+)
+
+// Config defines configuration for the MQTT receiver.
+type Config struct {
+	// Endpoint is the URL of the MQTT broker, e.g. mqtt://localhost:1883 or mqtts://localhost:8883.
+	Endpoint string `mapstructure:"endpoint"`
+	// ProtocolVersion is the MQTT protocol version, either "5" or "3.1.1".
+	ProtocolVersion string `mapstructure:"protocol_version"`
+	// ClientID identifies the session of the receiver on the broker.
+	ClientID string `mapstructure:"client_id"`
+	// Username and Password authenticate the receiver on the broker.
+	Username string              `mapstructure:"username"`
+	Password configopaque.String `mapstructure:"password"`
+	// TLS configures the connection to mqtts:// and ssl:// endpoints.
+	TLS configtls.ClientConfig `mapstructure:"tls"`
+	// KeepAlive is the interval of the keep alive pings sent to the broker.
+	KeepAlive time.Duration `mapstructure:"keep_alive"`
+	// ConnectTimeout bounds the time spent establishing the connection to the broker.
+	ConnectTimeout time.Duration `mapstructure:"connect_timeout"`
+	// CleanStart discards the session state kept by the broker when connecting.
+	CleanStart bool `mapstructure:"clean_start"`
+
+	// Topics are the topic templates the receiver subscribes to. The {name} segments
+	// match a single topic level and are added as resource attribute name.
+	Topics []string `mapstructure:"topics"`
+	// QoS is the maximum quality of service of the subscriptions: 0, 1 or 2.
+	QoS byte `mapstructure:"qos"`
+	// SharedSubscriptionGroup subscribes through $share/<group>/ so that the messages
+	// are load balanced between the collectors of the same group.
+	SharedSubscriptionGroup string `mapstructure:"shared_subscription_group"`
+
+	// Encoding of the payloads: otlp_proto, otlp_json or json.
+	Encoding string `mapstructure:"encoding"`
+	// JSON configures the json encoding.
+	JSON JSONConfig `mapstructure:"json"`
+}
+
+// JSONConfig configures how JSON objects are converted to telemetry.
+type JSONConfig struct {
+	// MetricNamePrefix is prepended to the name of the metrics created from the numeric fields.
+	MetricNamePrefix string `mapstructure:"metric_name_prefix"`
+	// TimestampField is the field holding the time of the measurement, in Unix
+	// seconds or RFC 3339. The reception time is used if empty or missing.
+	TimestampField string `mapstructure:"timestamp_field"`
+}
+
+var _ component.Config = (*Config)(nil)
+
+// Validate checks the receiver configuration is valid
+func (cfg *Config) Validate() error {
+	var errs []error
+
+	if cfg.Endpoint == "" {
+		errs = append(errs, errors.New("endpoint must be specified"))
+	} else if u, err := url.Parse(cfg.Endpoint); err != nil {
+		errs = append(errs, fmt.Errorf("invalid endpoint: %w", err))
+	} else if !isTCPScheme(u.Scheme) && !isTLSScheme(u.Scheme) {
+		errs = append(errs, fmt.Errorf("unsupported endpoint scheme %q", u.Scheme))
+	}
+
+	if cfg.ProtocolVersion != protocolVersion5 && cfg.ProtocolVersion != protocolVersion311 {
+		errs = append(errs, fmt.Errorf("protocol_version must be %q or %q", protocolVersion5, protocolVersion311))
+	}
+
+	if len(cfg.Topics) == 0 {
+		errs = append(errs, errors.New("at least one topic must be specified"))
+	}
+	for _, topic := range cfg.Topics {
+		if _, err := parseTopicTemplate(topic); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	if cfg.QoS > 2 {
+		errs = append(errs, errors.New("qos must be 0, 1 or 2"))
+	}
+
+	if strings.ContainsAny(cfg.SharedSubscriptionGroup, "/+#") {
+		errs = append(errs, errors.New("shared_subscription_group must not contain '/', '+' or '#'"))
+	}
+
+	switch cfg.Encoding {
+	case encodingOTLPProto, encodingOTLPJSON, encodingJSON:
+	default:
+		errs = append(errs, fmt.Errorf("unsupported encoding %q", cfg.Encoding))
+	}
+
+	return errors.Join(errs...)
+}
+
+// subscriptionFilter returns the topic filter to subscribe to for the topic template.
+func (cfg *Config) subscriptionFilter(template *topicTemplate) string {
+	if cfg.SharedSubscriptionGroup == "" {
+		return template.filter
+	}
+	return "$share/" + cfg.SharedSubscriptionGroup + "/" + template.filter
+}
+
+func isTCPScheme(scheme string) bool {
+	return scheme == "mqtt" || scheme == "tcp"
+}
+
+func isTLSScheme(scheme string) bool {
+	return scheme == "mqtts" || scheme == "ssl" || scheme == "tls"
+}
@@ -0,0 +1,117 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mqttreceiver
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config/configtls"
+	"go.opentelemetry.io/collector/confmap/confmaptest"
+	"go.opentelemetry.io/collector/confmap/xconfmap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/mqttreceiver/internal/metadata"
+)
+
+func TestLoadConfig(t *testing.T) {
+	cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
+	require.NoError(t, err)
+
+	tests := []struct {
+		id          component.ID
+		expected    component.Config
+		expectedErr []string
+	}{
+		{
+			id: component.NewID(metadata.Type),
+			expected: &Config{
+				Endpoint:        defaultEndpoint,
+				ProtocolVersion: protocolVersion5,
+				ClientID:        defaultClientID,
+				KeepAlive:       defaultKeepAlive,
+				ConnectTimeout:  defaultConnectTimeout,
+				Topics:          []string{"sensors/+/temperature"},
+				QoS:             defaultQoS,
+				Encoding:        encodingJSON,
+			},
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "all_settings"),
+			expected: &Config{
+				Endpoint:        "mqtts://broker.example.com:8883",
+				ProtocolVersion: protocolVersion311,
+				ClientID:        "otelcol-1",
+				Username:        "collector",
+				Password:        "secret",
+				TLS: configtls.ClientConfig{
+					Config: configtls.Config{CAFile: "ca.pem"},
+				},
+				KeepAlive:               time.Minute,
+				ConnectTimeout:          5 * time.Second,
+				CleanStart:              true,
+				Topics:                  []string{"devices/{device.id}/telemetry", "sites/{site}/+/events/#"},
+				QoS:                     2,
+				SharedSubscriptionGroup: "collectors",
+				Encoding:                encodingJSON,
+				JSON: JSONConfig{
+					MetricNamePrefix: "device.",
+					TimestampField:   "ts",
+				},
+			},
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "invalid"),
+			expectedErr: []string{
+				`unsupported endpoint scheme "http"`,
+				`protocol_version must be "5" or "3.1.1"`,
+				`duplicate segment name "id"`,
+				"qos must be 0, 1 or 2",
+				"shared_subscription_group must not contain",
+				`unsupported encoding "avro"`,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.id.String(), func(t *testing.T) {
+			factory := NewFactory()
+			cfg := factory.CreateDefaultConfig()
+
+			sub, err := cm.Sub(tt.id.String())
+			require.NoError(t, err)
+			require.NoError(t, sub.Unmarshal(cfg))
+
+			err = xconfmap.Validate(cfg)
+			if len(tt.expectedErr) > 0 {
+				require.Error(t, err)
+				for _, expected := range tt.expectedErr {
+					assert.ErrorContains(t, err, expected)
+				}
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, cfg)
+		})
+	}
+}
+
+func TestValidateRequiresTopics(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	assert.EqualError(t, cfg.Validate(), "at least one topic must be specified")
+}
+
+func TestSubscriptionFilter(t *testing.T) {
+	template, err := parseTopicTemplate("devices/{device.id}/telemetry")
+	require.NoError(t, err)
+
+	cfg := createDefaultConfig().(*Config)
+	assert.Equal(t, "devices/+/telemetry", cfg.subscriptionFilter(template))
+
+	cfg.SharedSubscriptionGroup = "collectors"
+	assert.Equal(t, "$share/collectors/devices/+/telemetry", cfg.subscriptionFilter(template))
+}
@@ -0,0 +1,184 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mqttreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/mqttreceiver"
+
+import (
+	"context"
+	"crypto/tls"
+	"net/url"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/receiver"
+	"go.opentelemetry.io/collector/receiver/receiverhelper"
+	"go.uber.org/zap"
+)
+
+const transport = "mqtt"
+
+type mqttReceiver struct {
+	cfg       *Config
+	settings  receiver.Settings
+	obsrecv   *receiverhelper.ObsReport
+	templates []*topicTemplate
+	newClient clientFactory
+	client    mqttClient
+
+	nextMetrics      consumer.Metrics
+	unmarshalMetrics metricsUnmarshaler
+	nextLogs         consumer.Logs
+	unmarshalLogs    logsUnmarshaler
+}
+
+func newReceiver(cfg *Config, set receiver.Settings) (*mqttReceiver, error) {
+	obsrecv, err := receiverhelper.NewObsReport(receiverhelper.ObsReportSettings{
+		ReceiverID:             set.ID,
+		Transport:              transport,
+		ReceiverCreateSettings: set,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	templates := make([]*topicTemplate, 0, len(cfg.Topics))
+	for _, topic := range cfg.Topics {
+		template, err := parseTopicTemplate(topic)
+		if err != nil {
+			return nil, err
+		}
+		templates = append(templates, template)
+	}
+
+	return &mqttReceiver{
+		cfg:       cfg,
+		settings:  set,
+		obsrecv:   obsrecv,
+		templates: templates,
+		newClient: newClient,
+	}, nil
+}
+
+func newMetricsReceiver(cfg *Config, set receiver.Settings, nextConsumer consumer.Metrics) (*mqttReceiver, error) {
+	r, err := newReceiver(cfg, set)
+	if err != nil {
+		return nil, err
+	}
+	r.nextMetrics = nextConsumer
+	r.unmarshalMetrics = newMetricsUnmarshaler(cfg)
+	return r, nil
+}
+
+func newLogsReceiver(cfg *Config, set receiver.Settings, nextConsumer consumer.Logs) (*mqttReceiver, error) {
+	r, err := newReceiver(cfg, set)
+	if err != nil {
+		return nil, err
+	}
+	r.nextLogs = nextConsumer
+	r.unmarshalLogs = newLogsUnmarshaler(cfg)
+	return r, nil
+}
+
+func (r *mqttReceiver) Start(ctx context.Context, _ component.Host) error {
+	brokerURL, err := url.Parse(r.cfg.Endpoint)
+	if err != nil {
+		return err
+	}
+	var tlsConfig *tls.Config
+	if isTLSScheme(brokerURL.Scheme) {
+		if tlsConfig, err = r.cfg.TLS.LoadTLSConfig(ctx); err != nil {
+			return err
+		}
+	}
+
+	subscriptions := make([]subscription, 0, len(r.templates))
+	for _, template := range r.templates {
+		subscriptions = append(subscriptions, subscription{
+			filter: r.cfg.subscriptionFilter(template),
+			qos:    r.cfg.QoS,
+		})
+	}
+
+	client, err := r.newClient(r.cfg, clientSettings{
+		brokerURL:     brokerURL,
+		tlsConfig:     tlsConfig,
+		subscriptions: subscriptions,
+		handler:       r.handleMessage,
+		logger:        r.settings.Logger,
+	})
+	if err != nil {
+		return err
+	}
+	r.client = client
+	return r.client.connect(ctx)
+}
+
+func (r *mqttReceiver) Shutdown(ctx context.Context) error {
+	if r.client == nil {
+		return nil
+	}
+	return r.client.disconnect(ctx)
+}
+
+// handleMessage converts the payload of a message, adds the values of the named segments
+// of the topic to the resource attributes, and passes the result to the next consumer.
+func (r *mqttReceiver) handleMessage(topic string, payload []byte) {
+	receivedAt := time.Now()
+	attributes := r.topicAttributes(topic)
+	ctx := context.Background()
+
+	if r.nextMetrics != nil {
+		obsCtx := r.obsrecv.StartMetricsOp(ctx)
+		metrics, err := r.unmarshalMetrics(payload, receivedAt)
+		if err != nil {
+			r.settings.Logger.Error("failed to unmarshal MQTT message", zap.String("topic", topic), zap.Error(err))
+			r.obsrecv.EndMetricsOp(obsCtx, r.cfg.Encoding, 0, err)
+			return
+		}
+		rms := metrics.ResourceMetrics()
+		for i := 0; i < rms.Len(); i++ {
+			putAttributes(rms.At(i).Resource().Attributes(), attributes)
+		}
+		err = r.nextMetrics.ConsumeMetrics(obsCtx, metrics)
+		if err != nil {
+			r.settings.Logger.Error("failed to consume metrics", zap.String("topic", topic), zap.Error(err))
+		}
+		r.obsrecv.EndMetricsOp(obsCtx, r.cfg.Encoding, metrics.DataPointCount(), err)
+		return
+	}
+
+	obsCtx := r.obsrecv.StartLogsOp(ctx)
+	logs, err := r.unmarshalLogs(payload, receivedAt)
+	if err != nil {
+		r.settings.Logger.Error("failed to unmarshal MQTT message", zap.String("topic", topic), zap.Error(err))
+		r.obsrecv.EndLogsOp(obsCtx, r.cfg.Encoding, 0, err)
+		return
+	}
+	rls := logs.ResourceLogs()
+	for i := 0; i < rls.Len(); i++ {
+		putAttributes(rls.At(i).Resource().Attributes(), attributes)
+	}
+	err = r.nextLogs.ConsumeLogs(obsCtx, logs)
+	if err != nil {
+		r.settings.Logger.Error("failed to consume logs", zap.String("topic", topic), zap.Error(err))
+	}
+	r.obsrecv.EndLogsOp(obsCtx, r.cfg.Encoding, logs.LogRecordCount(), err)
+}
+
+// topicAttributes returns the values of the named segments of the first topic template matching the topic.
+func (r *mqttReceiver) topicAttributes(topic string) map[string]string {
+	for _, template := range r.templates {
+		if attributes, ok := template.match(topic); ok {
+			return attributes
+		}
+	}
+	return nil
+}
+
+func putAttributes(dest pcommon.Map, attributes map[string]string) {
+	for k, v := range attributes {
+		dest.PutStr(k, v)
+	}
+}
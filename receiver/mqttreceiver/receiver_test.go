@@ -0,0 +1,99 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mqttreceiver
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/mqttreceiver/internal/metadata"
+)
+
+type fakeClient struct {
+	settings     clientSettings
+	connected    bool
+	disconnected bool
+}
+
+func (c *fakeClient) connect(context.Context) error {
+	c.connected = true
+	return nil
+}
+
+func (c *fakeClient) disconnect(context.Context) error {
+	c.disconnected = true
+	return nil
+}
+
+func newFakeClientFactory(client *fakeClient) clientFactory {
+	return func(_ *Config, settings clientSettings) (mqttClient, error) {
+		client.settings = settings
+		return client, nil
+	}
+}
+
+func TestMetricsReceiver(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Topics = []string{"sites/{site}/devices/{device.id}"}
+	cfg.SharedSubscriptionGroup = "collectors"
+	sink := new(consumertest.MetricsSink)
+
+	r, err := newMetricsReceiver(cfg, receivertest.NewNopSettings(metadata.Type), sink)
+	require.NoError(t, err)
+	client := &fakeClient{}
+	r.newClient = newFakeClientFactory(client)
+
+	require.NoError(t, r.Start(context.Background(), componenttest.NewNopHost()))
+	assert.True(t, client.connected)
+	assert.Equal(t, "localhost:1883", client.settings.brokerURL.Host)
+	assert.Nil(t, client.settings.tlsConfig)
+	assert.Equal(t, []subscription{{filter: "$share/collectors/sites/+/devices/+", qos: 1}}, client.settings.subscriptions)
+
+	client.settings.handler("sites/paris/devices/d1", []byte(`{"temperature":21.5}`))
+	client.settings.handler("sites/paris/devices/d1", []byte(`not json`))
+
+	require.Len(t, sink.AllMetrics(), 1)
+	rm := sink.AllMetrics()[0].ResourceMetrics().At(0)
+	assert.Equal(t, map[string]any{"site": "paris", "device.id": "d1"}, rm.Resource().Attributes().AsRaw())
+	assert.Equal(t, "temperature", rm.ScopeMetrics().At(0).Metrics().At(0).Name())
+
+	require.NoError(t, r.Shutdown(context.Background()))
+	assert.True(t, client.disconnected)
+}
+
+func TestLogsReceiver(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Topics = []string{"alerts/{severity}", "events/#"}
+	sink := new(consumertest.LogsSink)
+
+	r, err := newLogsReceiver(cfg, receivertest.NewNopSettings(metadata.Type), sink)
+	require.NoError(t, err)
+	client := &fakeClient{}
+	r.newClient = newFakeClientFactory(client)
+
+	require.NoError(t, r.Start(context.Background(), componenttest.NewNopHost()))
+	assert.Equal(t, []subscription{{filter: "alerts/+", qos: 1}, {filter: "events/#", qos: 1}}, client.settings.subscriptions)
+
+	client.settings.handler("alerts/critical", []byte(`{"message":"overheating"}`))
+	client.settings.handler("events/door", []byte(`{"message":"opened"}`))
+
+	require.Len(t, sink.AllLogs(), 2)
+	assert.Equal(t, map[string]any{"severity": "critical"}, sink.AllLogs()[0].ResourceLogs().At(0).Resource().Attributes().AsRaw())
+	assert.Equal(t, 0, sink.AllLogs()[1].ResourceLogs().At(0).Resource().Attributes().Len())
+
+	require.NoError(t, r.Shutdown(context.Background()))
+}
+
+func TestShutdownWithoutStart(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	r, err := newLogsReceiver(cfg, receivertest.NewNopSettings(metadata.Type), consumertest.NewNop())
+	require.NoError(t, err)
+	require.NoError(t, r.Shutdown(context.Background()))
+}
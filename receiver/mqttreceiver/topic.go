@@ -0,0 +1,95 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mqttreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/mqttreceiver"
+
+import (
+	"fmt"
+	"strings"
+)
+
+// topicTemplate is a topic filter where single level segments can be named,
+// e.g. "devices/{device.id}/+/telemetry/#". The values of the named segments
+// of the matching topics are extracted as attributes.
+type topicTemplate struct {
+	// filter is the MQTT topic filter of the template, where the named segments are replaced by '+'.
+	filter string
+	// names holds, for every level of the filter, the attribute name of the segment or "" if it is not named.
+	names []string
+	// multiLevel is set when the filter ends with the '#' wildcard.
+	multiLevel bool
+	// literals holds, for every level of the filter, the literal the level must match or "+" for wildcards.
+	literals []string
+}
+
+func parseTopicTemplate(template string) (*topicTemplate, error) {
+	if template == "" {
+		return nil, fmt.Errorf("invalid topic %q: must not be empty", template)
+	}
+
+	levels := strings.Split(template, "/")
+	t := &topicTemplate{
+		names:    make([]string, 0, len(levels)),
+		literals: make([]string, 0, len(levels)),
+	}
+	filterLevels := make([]string, 0, len(levels))
+	seen := make(map[string]struct{})
+	for i, level := range levels {
+		switch {
+		case level == "#":
+			if i != len(levels)-1 {
+				return nil, fmt.Errorf("invalid topic %q: '#' must be the last level", template)
+			}
+			t.multiLevel = true
+			filterLevels = append(filterLevels, "#")
+			continue
+		case level == "+":
+			t.names = append(t.names, "")
+			t.literals = append(t.literals, "+")
+			filterLevels = append(filterLevels, "+")
+		case strings.HasPrefix(level, "{") && strings.HasSuffix(level, "}"):
+			name := level[1 : len(level)-1]
+			if name == "" {
+				return nil, fmt.Errorf("invalid topic %q: empty segment name", template)
+			}
+			if _, ok := seen[name]; ok {
+				return nil, fmt.Errorf("invalid topic %q: duplicate segment name %q", template, name)
+			}
+			seen[name] = struct{}{}
+			t.names = append(t.names, name)
+			t.literals = append(t.literals, "+")
+			filterLevels = append(filterLevels, "+")
+		case strings.ContainsAny(level, "+#{}"):
+			return nil, fmt.Errorf("invalid topic %q: wildcards and segment names must occupy an entire level", template)
+		default:
+			t.names = append(t.names, "")
+			t.literals = append(t.literals, level)
+			filterLevels = append(filterLevels, level)
+		}
+	}
+	t.filter = strings.Join(filterLevels, "/")
+	return t, nil
+}
+
+// match reports whether the topic matches the template and returns the values of its named segments.
+func (t *topicTemplate) match(topic string) (map[string]string, bool) {
+	levels := strings.Split(topic, "/")
+	if len(levels) < len(t.names) || (!t.multiLevel && len(levels) != len(t.names)) {
+		return nil, false
+	}
+	// Topics starting with '$' are not matched by wildcards in the first level.
+	if strings.HasPrefix(topic, "$") && (len(t.literals) == 0 || t.literals[0] == "+") {
+		return nil, false
+	}
+
+	values := make(map[string]string)
+	for i, name := range t.names {
+		if literal := t.literals[i]; literal != "+" && literal != levels[i] {
+			return nil, false
+		}
+		if name != "" {
+			values[name] = levels[i]
+		}
+	}
+	return values, true
+}
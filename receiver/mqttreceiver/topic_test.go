@@ -0,0 +1,99 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mqttreceiver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseTopicTemplate(t *testing.T) {
+	tests := []struct {
+		template string
+		filter   string
+		err      string
+	}{
+		{template: "sensors/temperature", filter: "sensors/temperature"},
+		{template: "devices/{device.id}/+/telemetry/#", filter: "devices/+/+/telemetry/#"},
+		{template: "#", filter: "#"},
+		{template: "", err: `invalid topic "": must not be empty`},
+		{template: "devices/#/telemetry", err: `invalid topic "devices/#/telemetry": '#' must be the last level`},
+		{template: "devices/{}", err: `invalid topic "devices/{}": empty segment name`},
+		{template: "{a}/{a}", err: `invalid topic "{a}/{a}": duplicate segment name "a"`},
+		{template: "devices/id-{id}", err: `invalid topic "devices/id-{id}": wildcards and segment names must occupy an entire level`},
+		{template: "devices/a+", err: `invalid topic "devices/a+": wildcards and segment names must occupy an entire level`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.template, func(t *testing.T) {
+			template, err := parseTopicTemplate(tt.template)
+			if tt.err != "" {
+				assert.EqualError(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.filter, template.filter)
+		})
+	}
+}
+
+func TestTopicTemplateMatch(t *testing.T) {
+	tests := []struct {
+		template string
+		topic    string
+		expected map[string]string
+		matches  bool
+	}{
+		{
+			template: "devices/{device.id}/telemetry",
+			topic:    "devices/d1/telemetry",
+			expected: map[string]string{"device.id": "d1"},
+			matches:  true,
+		},
+		{
+			template: "sites/{site}/+/{kind}/#",
+			topic:    "sites/paris/floor1/events/door/open",
+			expected: map[string]string{"site": "paris", "kind": "events"},
+			matches:  true,
+		},
+		{
+			template: "devices/{device.id}/telemetry",
+			topic:    "devices/d1/status",
+		},
+		{
+			template: "devices/{device.id}/telemetry",
+			topic:    "devices/d1/telemetry/extra",
+		},
+		{
+			template: "devices/{device.id}/telemetry/#",
+			topic:    "devices/d1",
+		},
+		{
+			template: "{first}/telemetry",
+			topic:    "$SYS/telemetry",
+		},
+		{
+			template: "#",
+			topic:    "$SYS/broker/uptime",
+		},
+		{
+			template: "$SYS/{metric}",
+			topic:    "$SYS/uptime",
+			expected: map[string]string{"metric": "uptime"},
+			matches:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.template+" "+tt.topic, func(t *testing.T) {
+			template, err := parseTopicTemplate(tt.template)
+			require.NoError(t, err)
+			values, ok := template.match(tt.topic)
+			assert.Equal(t, tt.matches, ok)
+			if tt.matches {
+				assert.Equal(t, tt.expected, values)
+			}
+		})
+	}
+}
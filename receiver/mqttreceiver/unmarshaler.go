@@ -0,0 +1,165 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mqttreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/mqttreceiver"
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+)
+
+var errNotJSONObject = errors.New("payload is not a JSON object")
+
+// metricsUnmarshaler converts the payload of a message received at receivedAt into metrics.
+type metricsUnmarshaler func(payload []byte, receivedAt time.Time) (pmetric.Metrics, error)
+
+// logsUnmarshaler converts the payload of a message received at receivedAt into logs.
+type logsUnmarshaler func(payload []byte, receivedAt time.Time) (plog.Logs, error)
+
+func newMetricsUnmarshaler(cfg *Config) metricsUnmarshaler {
+	switch cfg.Encoding {
+	case encodingOTLPProto:
+		u := &pmetric.ProtoUnmarshaler{}
+		return func(payload []byte, _ time.Time) (pmetric.Metrics, error) {
+			return u.UnmarshalMetrics(payload)
+		}
+	case encodingOTLPJSON:
+		u := &pmetric.JSONUnmarshaler{}
+		return func(payload []byte, _ time.Time) (pmetric.Metrics, error) {
+			return u.UnmarshalMetrics(payload)
+		}
+	default:
+		return func(payload []byte, receivedAt time.Time) (pmetric.Metrics, error) {
+			return jsonToMetrics(cfg.JSON, payload, receivedAt)
+		}
+	}
+}
+
+func newLogsUnmarshaler(cfg *Config) logsUnmarshaler {
+	switch cfg.Encoding {
+	case encodingOTLPProto:
+		u := &plog.ProtoUnmarshaler{}
+		return func(payload []byte, _ time.Time) (plog.Logs, error) {
+			return u.UnmarshalLogs(payload)
+		}
+	case encodingOTLPJSON:
+		u := &plog.JSONUnmarshaler{}
+		return func(payload []byte, _ time.Time) (plog.Logs, error) {
+			return u.UnmarshalLogs(payload)
+		}
+	default:
+		return func(payload []byte, receivedAt time.Time) (plog.Logs, error) {
+			return jsonToLogs(cfg.JSON, payload, receivedAt)
+		}
+	}
+}
+
+// jsonToMetrics creates a gauge for every numeric or boolean field of a JSON object.
+// The fields of nested objects are named after their path, joined with '.'.
+func jsonToMetrics(cfg JSONConfig, payload []byte, receivedAt time.Time) (pmetric.Metrics, error) {
+	var object map[string]any
+	if err := json.Unmarshal(payload, &object); err != nil {
+		return pmetric.Metrics{}, fmt.Errorf("%w: %w", errNotJSONObject, err)
+	}
+	ts, err := jsonTimestamp(cfg, object, receivedAt)
+	if err != nil {
+		return pmetric.Metrics{}, err
+	}
+
+	values := make(map[string]float64)
+	flattenNumbers("", object, cfg.TimestampField, values)
+	names := make([]string, 0, len(values))
+	for name := range values {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	metrics := pmetric.NewMetrics()
+	ms := metrics.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics()
+	for _, name := range names {
+		m := ms.AppendEmpty()
+		m.SetName(cfg.MetricNamePrefix + name)
+		dp := m.SetEmptyGauge().DataPoints().AppendEmpty()
+		dp.SetTimestamp(pcommon.NewTimestampFromTime(ts))
+		dp.SetDoubleValue(values[name])
+	}
+	return metrics, nil
+}
+
+func flattenNumbers(prefix string, object map[string]any, timestampField string, values map[string]float64) {
+	for key, value := range object {
+		name := key
+		if prefix != "" {
+			name = prefix + "." + key
+		}
+		if name == timestampField {
+			continue
+		}
+		switch v := value.(type) {
+		case float64:
+			values[name] = v
+		case bool:
+			if v {
+				values[name] = 1
+			} else {
+				values[name] = 0
+			}
+		case map[string]any:
+			flattenNumbers(name, v, timestampField, values)
+		}
+	}
+}
+
+// jsonToLogs creates a log record whose body is the JSON object of the payload.
+func jsonToLogs(cfg JSONConfig, payload []byte, receivedAt time.Time) (plog.Logs, error) {
+	var object map[string]any
+	if err := json.Unmarshal(payload, &object); err != nil {
+		return plog.Logs{}, fmt.Errorf("%w: %w", errNotJSONObject, err)
+	}
+	ts, err := jsonTimestamp(cfg, object, receivedAt)
+	if err != nil {
+		return plog.Logs{}, err
+	}
+
+	logs := plog.NewLogs()
+	lr := logs.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords().AppendEmpty()
+	lr.SetTimestamp(pcommon.NewTimestampFromTime(ts))
+	lr.SetObservedTimestamp(pcommon.NewTimestampFromTime(receivedAt))
+	if err := lr.Body().SetEmptyMap().FromRaw(object); err != nil {
+		return plog.Logs{}, err
+	}
+	return logs, nil
+}
+
+// jsonTimestamp returns the time held by the configured timestamp field of the
+// object, or receivedAt if there is none.
+func jsonTimestamp(cfg JSONConfig, object map[string]any, receivedAt time.Time) (time.Time, error) {
+	if cfg.TimestampField == "" {
+		return receivedAt, nil
+	}
+	value, ok := object[cfg.TimestampField]
+	if !ok {
+		return receivedAt, nil
+	}
+	switch v := value.(type) {
+	case float64:
+		sec, frac := math.Modf(v)
+		return time.Unix(int64(sec), int64(frac*1e9)), nil
+	case string:
+		ts, err := time.Parse(time.RFC3339Nano, v)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("invalid %q field: %w", cfg.TimestampField, err)
+		}
+		return ts, nil
+	default:
+		return time.Time{}, fmt.Errorf("invalid %q field: must be a number or a RFC 3339 string", cfg.TimestampField)
+	}
+}
@@ -0,0 +1,95 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mqttreceiver
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+)
+
+func TestJSONToMetrics(t *testing.T) {
+	receivedAt := time.Unix(1700000000, 0)
+	cfg := &Config{
+		Encoding: encodingJSON,
+		JSON:     JSONConfig{MetricNamePrefix: "sensor.", TimestampField: "ts"},
+	}
+	unmarshal := newMetricsUnmarshaler(cfg)
+
+	metrics, err := unmarshal([]byte(`{"ts":1600000000.5,"temperature":21.5,"door_open":true,"battery":{"level":87},"name":"kitchen"}`), receivedAt)
+	require.NoError(t, err)
+
+	ms := metrics.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	require.Equal(t, 3, ms.Len())
+	expected := []struct {
+		name  string
+		value float64
+	}{
+		{name: "sensor.battery.level", value: 87},
+		{name: "sensor.door_open", value: 1},
+		{name: "sensor.temperature", value: 21.5},
+	}
+	for i, e := range expected {
+		m := ms.At(i)
+		assert.Equal(t, e.name, m.Name())
+		require.Equal(t, pmetric.MetricTypeGauge, m.Type())
+		dp := m.Gauge().DataPoints().At(0)
+		assert.Equal(t, e.value, dp.DoubleValue())
+		assert.Equal(t, pcommon.NewTimestampFromTime(time.Unix(1600000000, 5e8)), dp.Timestamp())
+	}
+}
+
+func TestJSONToMetricsInvalidPayload(t *testing.T) {
+	unmarshal := newMetricsUnmarshaler(&Config{Encoding: encodingJSON})
+	_, err := unmarshal([]byte(`[1, 2]`), time.Now())
+	assert.ErrorIs(t, err, errNotJSONObject)
+}
+
+func TestJSONToLogs(t *testing.T) {
+	receivedAt := time.Unix(1700000000, 0)
+	cfg := &Config{
+		Encoding: encodingJSON,
+		JSON:     JSONConfig{TimestampField: "time"},
+	}
+	unmarshal := newLogsUnmarshaler(cfg)
+
+	logs, err := unmarshal([]byte(`{"time":"2023-11-14T22:13:20Z","event":"door_open"}`), receivedAt)
+	require.NoError(t, err)
+
+	lr := logs.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0)
+	assert.Equal(t, pcommon.NewTimestampFromTime(time.Date(2023, 11, 14, 22, 13, 20, 0, time.UTC)), lr.Timestamp())
+	assert.Equal(t, pcommon.NewTimestampFromTime(receivedAt), lr.ObservedTimestamp())
+	assert.Equal(t, map[string]any{"time": "2023-11-14T22:13:20Z", "event": "door_open"}, lr.Body().Map().AsRaw())
+}
+
+func TestJSONInvalidTimestamp(t *testing.T) {
+	unmarshal := newLogsUnmarshaler(&Config{Encoding: encodingJSON, JSON: JSONConfig{TimestampField: "time"}})
+	_, err := unmarshal([]byte(`{"time":"yesterday"}`), time.Now())
+	assert.ErrorContains(t, err, `invalid "time" field`)
+}
+
+func TestOTLPUnmarshalers(t *testing.T) {
+	metrics := pmetric.NewMetrics()
+	metrics.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics().AppendEmpty().SetName("m")
+	logs := plog.NewLogs()
+	logs.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords().AppendEmpty().Body().SetStr("l")
+
+	protoMetrics, err := (&pmetric.ProtoMarshaler{}).MarshalMetrics(metrics)
+	require.NoError(t, err)
+	jsonLogs, err := (&plog.JSONMarshaler{}).MarshalLogs(logs)
+	require.NoError(t, err)
+
+	gotMetrics, err := newMetricsUnmarshaler(&Config{Encoding: encodingOTLPProto})(protoMetrics, time.Now())
+	require.NoError(t, err)
+	assert.Equal(t, metrics, gotMetrics)
+
+	gotLogs, err := newLogsUnmarshaler(&Config{Encoding: encodingOTLPJSON})(jsonLogs, time.Now())
+	require.NoError(t, err)
+	assert.Equal(t, logs, gotLogs)
+}
@@ -87,6 +87,9 @@ type statementEventStats struct {
 	countSortMergePasses      int64
 	countSortRows             int64
 	countNoIndexUsed          int64
+	countStar                 int64
+	sumLockTime               int64
+	maxTimerWait              int64
 }
 
 type tableLockWaitEventStats struct {
@@ -366,7 +369,7 @@ func (c *mySQLClient) getStatementEventsStats() ([]statementEventStats, error) {
 		"LEFT(DIGEST_TEXT, %d) as DIGEST_TEXT, FLOOR(SUM_TIMER_WAIT/1000), SUM_ERRORS,"+
 		"SUM_WARNINGS, SUM_ROWS_AFFECTED, SUM_ROWS_SENT, SUM_ROWS_EXAMINED,"+
 		"SUM_CREATED_TMP_DISK_TABLES, SUM_CREATED_TMP_TABLES, SUM_SORT_MERGE_PASSES,"+
-		"SUM_SORT_ROWS, SUM_NO_INDEX_USED "+
+		"SUM_SORT_ROWS, SUM_NO_INDEX_USED, COUNT_STAR, FLOOR(SUM_LOCK_TIME/1000), FLOOR(MAX_TIMER_WAIT/1000) "+
 		"FROM performance_schema.events_statements_summary_by_digest "+
 		"WHERE SCHEMA_NAME NOT IN ('mysql', 'performance_schema', 'information_schema') "+
 		"AND last_seen > DATE_SUB(NOW(), INTERVAL %d SECOND) "+
@@ -388,7 +391,8 @@ func (c *mySQLClient) getStatementEventsStats() ([]statementEventStats, error) {
 		err := rows.Scan(&s.schema, &s.digest, &s.digestText,
 			&s.sumTimerWait, &s.countErrors, &s.countWarnings,
 			&s.countRowsAffected, &s.countRowsSent, &s.countRowsExamined, &s.countCreatedTmpDiskTables,
-			&s.countCreatedTmpTables, &s.countSortMergePasses, &s.countSortRows, &s.countNoIndexUsed)
+			&s.countCreatedTmpTables, &s.countSortMergePasses, &s.countSortRows, &s.countNoIndexUsed,
+			&s.countStar, &s.sumLockTime, &s.maxTimerWait)
 		if err != nil {
 			return nil, err
 		}
@@ -190,9 +190,18 @@ func TestMetricsBuilder(t *testing.T) {
 			allMetricsCount++
 			mb.RecordMysqlStatementEventCountDataPoint(ts, 1, "schema-val", "digest-val", "digest_text-val", AttributeEventStateErrors)
 
+			allMetricsCount++
+			mb.RecordMysqlStatementEventExecutionsDataPoint(ts, 1, "schema-val", "digest-val", "digest_text-val")
+
+			allMetricsCount++
+			mb.RecordMysqlStatementEventLockTimeDataPoint(ts, 1, "schema-val", "digest-val", "digest_text-val")
+
 			allMetricsCount++
 			mb.RecordMysqlStatementEventWaitTimeDataPoint(ts, 1, "schema-val", "digest-val", "digest_text-val")
 
+			allMetricsCount++
+			mb.RecordMysqlStatementEventWaitTimeMaxDataPoint(ts, 1, "schema-val", "digest-val", "digest_text-val")
+
 			allMetricsCount++
 			mb.RecordMysqlTableAverageRowLengthDataPoint(ts, 1, "table_name-val", "schema-val")
 
@@ -836,6 +845,52 @@ func TestMetricsBuilder(t *testing.T) {
 					attrVal, ok = dp.Attributes().Get("kind")
 					assert.True(t, ok)
 					assert.Equal(t, "errors", attrVal.Str())
+				case "mysql.statement_event.executions":
+					assert.False(t, validatedMetrics["mysql.statement_event.executions"], "Found a duplicate in the metrics slice: mysql.statement_event.executions")
+					validatedMetrics["mysql.statement_event.executions"] = true
+					assert.Equal(t, pmetric.MetricTypeSum, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Sum().DataPoints().Len())
+					assert.Equal(t, "The number of executions of the statements with the digest.", ms.At(i).Description())
+					assert.Equal(t, "{execution}", ms.At(i).Unit())
+					assert.True(t, ms.At(i).Sum().IsMonotonic())
+					assert.Equal(t, pmetric.AggregationTemporalityCumulative, ms.At(i).Sum().AggregationTemporality())
+					dp := ms.At(i).Sum().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("schema")
+					assert.True(t, ok)
+					assert.Equal(t, "schema-val", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("digest")
+					assert.True(t, ok)
+					assert.Equal(t, "digest-val", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("digest_text")
+					assert.True(t, ok)
+					assert.Equal(t, "digest_text-val", attrVal.Str())
+				case "mysql.statement_event.lock.time":
+					assert.False(t, validatedMetrics["mysql.statement_event.lock.time"], "Found a duplicate in the metrics slice: mysql.statement_event.lock.time")
+					validatedMetrics["mysql.statement_event.lock.time"] = true
+					assert.Equal(t, pmetric.MetricTypeSum, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Sum().DataPoints().Len())
+					assert.Equal(t, "The total time spent waiting for table locks by the statements with the digest.", ms.At(i).Description())
+					assert.Equal(t, "ns", ms.At(i).Unit())
+					assert.True(t, ms.At(i).Sum().IsMonotonic())
+					assert.Equal(t, pmetric.AggregationTemporalityCumulative, ms.At(i).Sum().AggregationTemporality())
+					dp := ms.At(i).Sum().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("schema")
+					assert.True(t, ok)
+					assert.Equal(t, "schema-val", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("digest")
+					assert.True(t, ok)
+					assert.Equal(t, "digest-val", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("digest_text")
+					assert.True(t, ok)
+					assert.Equal(t, "digest_text-val", attrVal.Str())
 				case "mysql.statement_event.wait.time":
 					assert.False(t, validatedMetrics["mysql.statement_event.wait.time"], "Found a duplicate in the metrics slice: mysql.statement_event.wait.time")
 					validatedMetrics["mysql.statement_event.wait.time"] = true
@@ -859,6 +914,27 @@ func TestMetricsBuilder(t *testing.T) {
 					attrVal, ok = dp.Attributes().Get("digest_text")
 					assert.True(t, ok)
 					assert.Equal(t, "digest_text-val", attrVal.Str())
+				case "mysql.statement_event.wait.time.max":
+					assert.False(t, validatedMetrics["mysql.statement_event.wait.time.max"], "Found a duplicate in the metrics slice: mysql.statement_event.wait.time.max")
+					validatedMetrics["mysql.statement_event.wait.time.max"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "The maximum wait time of the statements with the digest.", ms.At(i).Description())
+					assert.Equal(t, "ns", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("schema")
+					assert.True(t, ok)
+					assert.Equal(t, "schema-val", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("digest")
+					assert.True(t, ok)
+					assert.Equal(t, "digest-val", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("digest_text")
+					assert.True(t, ok)
+					assert.Equal(t, "digest_text-val", attrVal.Str())
 				case "mysql.table.average_row_length":
 					assert.False(t, validatedMetrics["mysql.table.average_row_length"], "Found a duplicate in the metrics slice: mysql.table.average_row_length")
 					validatedMetrics["mysql.table.average_row_length"] = true
@@ -553,6 +553,9 @@ func (m *mySQLScraper) scrapeStatementEventsStats(now pcommon.Timestamp, errs *s
 		m.mb.RecordMysqlStatementEventCountDataPoint(now, s.countWarnings, s.schema, s.digest, s.digestText, metadata.AttributeEventStateWarnings)
 
 		m.mb.RecordMysqlStatementEventWaitTimeDataPoint(now, s.sumTimerWait, s.schema, s.digest, s.digestText)
+		m.mb.RecordMysqlStatementEventWaitTimeMaxDataPoint(now, s.maxTimerWait, s.schema, s.digest, s.digestText)
+		m.mb.RecordMysqlStatementEventExecutionsDataPoint(now, s.countStar, s.schema, s.digest, s.digestText)
+		m.mb.RecordMysqlStatementEventLockTimeDataPoint(now, s.sumLockTime, s.schema, s.digest, s.digestText)
 	}
 }
 
@@ -12,11 +12,14 @@ import (
 	"strconv"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/go-version"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/config/confignet"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/receiver/receivertest"
 	"go.opentelemetry.io/collector/scraper/scrapererror"
 
@@ -173,6 +176,38 @@ func TestScrapeBufferPoolPagesMiscOutOfBounds(t *testing.T) {
 		pmetrictest.IgnoreMetricDataPointsOrder(), pmetrictest.IgnoreStartTimestamp(), pmetrictest.IgnoreTimestamp()))
 }
 
+func TestScrapeStatementEventDigestMetrics(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.MetricsBuilderConfig.Metrics = metadata.MetricsConfig{}
+	cfg.MetricsBuilderConfig.Metrics.MysqlStatementEventExecutions.Enabled = true
+	cfg.MetricsBuilderConfig.Metrics.MysqlStatementEventLockTime.Enabled = true
+	cfg.MetricsBuilderConfig.Metrics.MysqlStatementEventWaitTimeMax.Enabled = true
+
+	scraper := newMySQLScraper(receivertest.NewNopSettings(metadata.Type), cfg)
+	scraper.sqlclient = &mockClient{statementEventsFile: "statement_events"}
+
+	errs := &scrapererror.ScrapeErrors{}
+	scraper.scrapeStatementEventsStats(pcommon.NewTimestampFromTime(time.Now()), errs)
+	require.NoError(t, errs.Combine())
+
+	metrics := scraper.mb.Emit().ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	values := map[string]int64{}
+	for i := 0; i < metrics.Len(); i++ {
+		m := metrics.At(i)
+		switch m.Type() {
+		case pmetric.MetricTypeSum:
+			values[m.Name()] = m.Sum().DataPoints().At(0).IntValue()
+		case pmetric.MetricTypeGauge:
+			values[m.Name()] = m.Gauge().DataPoints().At(0).IntValue()
+		}
+	}
+	assert.Equal(t, map[string]int64{
+		"mysql.statement_event.executions":    13,
+		"mysql.statement_event.lock.time":     14,
+		"mysql.statement_event.wait.time.max": 15,
+	}, values)
+}
+
 var _ client = (*mockClient)(nil)
 
 type mockClient struct {
@@ -330,6 +365,9 @@ func (c *mockClient) getStatementEventsStats() ([]statementEventStats, error) {
 		s.countSortMergePasses, _ = parseInt(text[11])
 		s.countSortRows, _ = parseInt(text[12])
 		s.countNoIndexUsed, _ = parseInt(text[13])
+		s.countStar, _ = parseInt(text[14])
+		s.sumLockTime, _ = parseInt(text[15])
+		s.maxTimerWait, _ = parseInt(text[16])
 
 		stats = append(stats, s)
 	}
@@ -0,0 +1,59 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package netconnreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/netconnreceiver"
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+
+	"go.opentelemetry.io/collector/scraper/scraperhelper"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/netconnreceiver/internal/metadata"
+)
+
+const (
+	modeAuto    = "auto"
+	modeNetlink = "netlink"
+	modeProcfs  = "procfs"
+)
+
+var errConfigNetconn = errors.New("invalid config")
+
+// Config defines the configuration for the various elements of the receiver agent.
+type Config struct {
+	scraperhelper.ControllerConfig `mapstructure:",squash"`
+	metadata.MetricsBuilderConfig  `mapstructure:",squash"`
+	// Mode selects how the TCP connections are listed: netlink for the socket
+	// diagnostics of the kernel, which report the round trip time and the
+	// retransmits of the connections, procfs for the /proc/net/tcp and
+	// /proc/net/tcp6 files, or auto to use netlink and fall back to procfs when
+	// the socket diagnostics are not available.
+	Mode string `mapstructure:"mode"`
+	// RootPath is the root of the host filesystem, used to read /proc when the
+	// collector runs in a container.
+	RootPath string `mapstructure:"root_path"`
+
+	// prevent unkeyed literal initialization
+	_ struct{}
+}
+
+// Validate validates the configuration by checking for the supported mode and
+// an absolute root path.
+func (cfg *Config) Validate() error {
+	switch cfg.Mode {
+	case modeAuto, modeNetlink, modeProcfs:
+	default:
+		return fmt.Errorf("invalid mode %q, must be one of %q, %q or %q", cfg.Mode, modeAuto, modeNetlink, modeProcfs)
+	}
+	if cfg.RootPath != "" && !filepath.IsAbs(cfg.RootPath) {
+		return fmt.Errorf("root_path must be an absolute path: %s", cfg.RootPath)
+	}
+	return nil
+}
+
+// procPath returns the path of the proc filesystem of the host.
+func (cfg *Config) procPath() string {
+	return filepath.Join(cfg.RootPath, "/", "proc")
+}
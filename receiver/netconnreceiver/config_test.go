@@ -0,0 +1,80 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package netconnreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/netconnreceiver"
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/confmap/confmaptest"
+	"go.opentelemetry.io/collector/confmap/xconfmap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/netconnreceiver/internal/metadata"
+)
+
+func TestLoadConfig(t *testing.T) {
+	cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
+	require.NoError(t, err)
+
+	factory := NewFactory()
+	cfg := factory.CreateDefaultConfig()
+	sub, err := cm.Sub(component.NewID(metadata.Type).String())
+	require.NoError(t, err)
+	require.NoError(t, sub.Unmarshal(cfg))
+	require.NoError(t, xconfmap.Validate(cfg))
+
+	expected := factory.CreateDefaultConfig().(*Config)
+	expected.CollectionInterval = 30 * time.Second
+	expected.Mode = modeProcfs
+	expected.RootPath = "/hostfs"
+	assert.Equal(t, expected, cfg)
+	assert.Equal(t, filepath.Join("/hostfs", "proc"), cfg.(*Config).procPath())
+}
+
+func TestValidate(t *testing.T) {
+	testCases := []struct {
+		desc        string
+		mode        string
+		rootPath    string
+		expectedErr string
+	}{
+		{
+			desc: "default",
+			mode: modeAuto,
+		},
+		{
+			desc:     "netlink with root path",
+			mode:     modeNetlink,
+			rootPath: "/hostfs",
+		},
+		{
+			desc:        "invalid mode",
+			mode:        "ebpf",
+			expectedErr: `invalid mode "ebpf", must be one of "auto", "netlink" or "procfs"`,
+		},
+		{
+			desc:        "relative root path",
+			mode:        modeProcfs,
+			rootPath:    "hostfs",
+			expectedErr: "root_path must be an absolute path: hostfs",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			cfg := newDefaultConfig().(*Config)
+			cfg.Mode = tc.mode
+			cfg.RootPath = tc.rootPath
+			err := xconfmap.Validate(cfg)
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
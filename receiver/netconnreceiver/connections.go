@@ -0,0 +1,190 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package netconnreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/netconnreceiver"
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/netconnreceiver/internal/metadata"
+)
+
+// tcpStates maps the TCP states of the kernel to the network.connection.state values.
+var tcpStates = map[uint8]metadata.AttributeNetworkConnectionState{
+	1:  metadata.AttributeNetworkConnectionStateEstablished,
+	2:  metadata.AttributeNetworkConnectionStateSynSent,
+	3:  metadata.AttributeNetworkConnectionStateSynReceived,
+	4:  metadata.AttributeNetworkConnectionStateFinWait1,
+	5:  metadata.AttributeNetworkConnectionStateFinWait2,
+	6:  metadata.AttributeNetworkConnectionStateTimeWait,
+	7:  metadata.AttributeNetworkConnectionStateClosed,
+	8:  metadata.AttributeNetworkConnectionStateCloseWait,
+	9:  metadata.AttributeNetworkConnectionStateLastAck,
+	10: metadata.AttributeNetworkConnectionStateListen,
+	11: metadata.AttributeNetworkConnectionStateClosing,
+}
+
+// containerIDPattern matches the container IDs in the cgroup paths, such as
+// /kubepods/burstable/pod<uid>/<id> or /system.slice/docker-<id>.scope.
+var containerIDPattern = regexp.MustCompile(`[0-9a-f]{64}`)
+
+// tcpConnection is a TCP socket of the host.
+type tcpConnection struct {
+	state metadata.AttributeNetworkConnectionState
+	// inode is the inode of the socket, 0 for the sockets which are not owned
+	// by a process anymore, such as the ones in the TIME_WAIT state.
+	inode uint64
+	// retransmits is the number of segments retransmitted by the connection.
+	// With procfs, it is only the number of unacknowledged retransmits.
+	retransmits uint32
+	// rtt is the smoothed round trip time, which is only known with netlink.
+	rtt    time.Duration
+	hasRTT bool
+}
+
+// processInfo identifies the process owning sockets, the zero value is used
+// for the sockets without an owning process.
+type processInfo struct {
+	pid         int64
+	name        string
+	containerID string
+}
+
+// readProcNetTCP lists the TCP connections of /proc/net/tcp and /proc/net/tcp6,
+// the latter is missing when IPv6 is disabled.
+func readProcNetTCP(procPath string) ([]tcpConnection, error) {
+	var conns []tcpConnection
+	for _, name := range []string{"tcp", "tcp6"} {
+		f, err := os.Open(filepath.Join(procPath, "net", name))
+		if err != nil {
+			if name == "tcp6" && errors.Is(err, fs.ErrNotExist) {
+				continue
+			}
+			return nil, err
+		}
+		c, err := parseProcNetTCP(f)
+		_ = f.Close()
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse %s: %w", f.Name(), err)
+		}
+		conns = append(conns, c...)
+	}
+	return conns, nil
+}
+
+// parseProcNetTCP parses the lines of /proc/net/tcp, such as
+// `0: 0100007F:0CEA 00000000:0000 0A 00000000:00000000 00:00000000 00000000 0 0 12345 1 ...`,
+// where the 4th field is the state, the 7th one the number of unacknowledged
+// retransmits and the 10th one the inode of the socket.
+func parseProcNetTCP(r io.Reader) ([]tcpConnection, error) {
+	var conns []tcpConnection
+	scanner := bufio.NewScanner(r)
+	// Skip the header
+	scanner.Scan()
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 10 {
+			continue
+		}
+		state, err := strconv.ParseUint(fields[3], 16, 8)
+		if err != nil {
+			return nil, fmt.Errorf("invalid state %q: %w", fields[3], err)
+		}
+		retransmits, err := strconv.ParseUint(fields[6], 16, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid retransmits %q: %w", fields[6], err)
+		}
+		inode, err := strconv.ParseUint(fields[9], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid inode %q: %w", fields[9], err)
+		}
+		connState, ok := tcpStates[uint8(state)]
+		if !ok {
+			continue
+		}
+		conns = append(conns, tcpConnection{
+			state:       connState,
+			inode:       inode,
+			retransmits: uint32(retransmits),
+		})
+	}
+	return conns, scanner.Err()
+}
+
+// socketOwners maps the inodes of the sockets to the processes owning them, by
+// reading the file descriptors of the processes. The processes whose file
+// descriptors cannot be read, usually for lack of permissions, are skipped.
+func socketOwners(procPath string) (map[uint64]processInfo, error) {
+	entries, err := os.ReadDir(procPath)
+	if err != nil {
+		return nil, err
+	}
+	owners := map[uint64]processInfo{}
+	for _, entry := range entries {
+		pid, err := strconv.ParseInt(entry.Name(), 10, 64)
+		if err != nil || !entry.IsDir() {
+			continue
+		}
+		fdPath := filepath.Join(procPath, entry.Name(), "fd")
+		fds, err := os.ReadDir(fdPath)
+		if err != nil {
+			continue
+		}
+		var info *processInfo
+		for _, fd := range fds {
+			target, err := os.Readlink(filepath.Join(fdPath, fd.Name()))
+			if err != nil || !strings.HasPrefix(target, "socket:[") {
+				continue
+			}
+			inode, err := strconv.ParseUint(strings.TrimSuffix(strings.TrimPrefix(target, "socket:["), "]"), 10, 64)
+			if err != nil {
+				continue
+			}
+			// The name and the container of the process are only read for the
+			// processes owning sockets.
+			if info == nil {
+				info = &processInfo{
+					pid:         pid,
+					name:        readProcessName(procPath, entry.Name()),
+					containerID: readContainerID(procPath, entry.Name()),
+				}
+			}
+			owners[inode] = *info
+		}
+	}
+	return owners, nil
+}
+
+func readProcessName(procPath, pid string) string {
+	comm, err := os.ReadFile(filepath.Join(procPath, pid, "comm"))
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(comm))
+}
+
+// readContainerID returns the ID of the container of the process from its
+// cgroups, or an empty string when it does not run in a container.
+func readContainerID(procPath, pid string) string {
+	cgroup, err := os.ReadFile(filepath.Join(procPath, pid, "cgroup"))
+	if err != nil {
+		return ""
+	}
+	var containerID string
+	for _, line := range strings.Split(string(cgroup), "\n") {
+		if id := containerIDPattern.FindString(line); id != "" {
+			containerID = id
+		}
+	}
+	return containerID
+}
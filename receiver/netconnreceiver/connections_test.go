@@ -0,0 +1,95 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package netconnreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/netconnreceiver"
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/netconnreceiver/internal/metadata"
+)
+
+const procNetTCP = `  sl  local_address rem_address   st tx_queue rx_queue tr tm->when retrnsmt   uid  timeout inode
+   0: 0100007F:0CEA 00000000:0000 0A 00000000:00000000 00:00000000 00000000     0        0 1001 1 0000000000000000 100 0 0 10 0
+   1: 0100007F:A2C4 0100007F:0CEA 01 00000000:00000000 00:00000000 00000003  1000        0 1002 1 0000000000000000 20 4 30 10 -1
+   2: 0100007F:A2C6 0100007F:0CEA 06 00000000:00000000 03:000012E2 00000000     0        0 0 3 0000000000000000
+`
+
+func TestParseProcNetTCP(t *testing.T) {
+	conns, err := parseProcNetTCP(strings.NewReader(procNetTCP))
+	require.NoError(t, err)
+	assert.Equal(t, []tcpConnection{
+		{state: metadata.AttributeNetworkConnectionStateListen, inode: 1001},
+		{state: metadata.AttributeNetworkConnectionStateEstablished, inode: 1002, retransmits: 3},
+		{state: metadata.AttributeNetworkConnectionStateTimeWait},
+	}, conns)
+
+	_, err = parseProcNetTCP(strings.NewReader("header\n0: a b ZZ 0 0 0 0 0 0\n"))
+	require.ErrorContains(t, err, `invalid state "ZZ"`)
+}
+
+func TestReadProcNetTCP(t *testing.T) {
+	procPath := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(procPath, "net"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(procPath, "net", "tcp"), []byte(procNetTCP), 0o600))
+
+	// tcp6 is missing when IPv6 is disabled
+	conns, err := readProcNetTCP(procPath)
+	require.NoError(t, err)
+	assert.Len(t, conns, 3)
+
+	require.NoError(t, os.WriteFile(filepath.Join(procPath, "net", "tcp6"), []byte(procNetTCP), 0o600))
+	conns, err = readProcNetTCP(procPath)
+	require.NoError(t, err)
+	assert.Len(t, conns, 6)
+
+	_, err = readProcNetTCP(filepath.Join(procPath, "missing"))
+	require.Error(t, err)
+}
+
+func TestSocketOwners(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("creating symbolic links requires privileges on Windows")
+	}
+	procPath := t.TempDir()
+	containerID := strings.Repeat("ab", 32)
+	writeProcess(t, procPath, "42", "nginx", "0::/system.slice/docker-"+containerID+".scope\n", map[string]string{
+		"3": "socket:[1001]",
+		"4": "socket:[1002]",
+		"5": "/var/log/nginx/access.log",
+	})
+	writeProcess(t, procPath, "43", "sshd", "0::/system.slice/ssh.service\n", map[string]string{
+		"3": "socket:[2001]",
+	})
+	writeProcess(t, procPath, "44", "sleep", "0::/user.slice\n", map[string]string{
+		"0": "/dev/null",
+	})
+	require.NoError(t, os.MkdirAll(filepath.Join(procPath, "sys"), 0o755))
+
+	owners, err := socketOwners(procPath)
+	require.NoError(t, err)
+	nginx := processInfo{pid: 42, name: "nginx", containerID: containerID}
+	assert.Equal(t, map[uint64]processInfo{
+		1001: nginx,
+		1002: nginx,
+		2001: {pid: 43, name: "sshd"},
+	}, owners)
+}
+
+func writeProcess(t *testing.T, procPath, pid, name, cgroup string, fds map[string]string) {
+	t.Helper()
+	fdPath := filepath.Join(procPath, pid, "fd")
+	require.NoError(t, os.MkdirAll(fdPath, 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(procPath, pid, "comm"), []byte(name+"\n"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(procPath, pid, "cgroup"), []byte(cgroup), 0o600))
+	for fd, target := range fds {
+		require.NoError(t, os.Symlink(target, filepath.Join(fdPath, fd)))
+	}
+}
@@ -0,0 +1,6 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package netconnreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/netconnreceiver"
+
+//go:generate mdatagen metadata.yaml
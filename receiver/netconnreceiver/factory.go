@@ -0,0 +1,60 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package netconnreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/netconnreceiver"
+
+import (
+	"context"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/receiver"
+	collectorscraper "go.opentelemetry.io/collector/scraper"
+	"go.opentelemetry.io/collector/scraper/scraperhelper"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/netconnreceiver/internal/metadata"
+)
+
+// NewFactory creates a factory for netconnreceiver receiver.
+func NewFactory() receiver.Factory {
+	return receiver.NewFactory(
+		metadata.Type,
+		newDefaultConfig,
+		receiver.WithMetrics(newReceiver, metadata.MetricsStability))
+}
+
+func newDefaultConfig() component.Config {
+	cfg := scraperhelper.NewDefaultControllerConfig()
+
+	return &Config{
+		ControllerConfig:     cfg,
+		MetricsBuilderConfig: metadata.DefaultMetricsBuilderConfig(),
+		Mode:                 modeAuto,
+	}
+}
+
+func newReceiver(
+	_ context.Context,
+	settings receiver.Settings,
+	cfg component.Config,
+	consumer consumer.Metrics,
+) (receiver.Metrics, error) {
+	netconnConfig, ok := cfg.(*Config)
+	if !ok {
+		return nil, errConfigNetconn
+	}
+
+	mp := newScraper(netconnConfig, settings)
+	s, err := collectorscraper.NewMetrics(mp.scrape, collectorscraper.WithStart(mp.start))
+	if err != nil {
+		return nil, err
+	}
+	opt := scraperhelper.AddScraper(metadata.Type, s)
+
+	return scraperhelper.NewMetricsController(
+		&netconnConfig.ControllerConfig,
+		settings,
+		consumer,
+		opt,
+	)
+}
@@ -0,0 +1,156 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build linux
+
+package netconnreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/netconnreceiver"
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"time"
+
+	"golang.org/x/sys/unix"
+)
+
+const (
+	sizeofNlMsghdr      = 16
+	sizeofInetDiagReqV2 = 56
+	sizeofInetDiagMsg   = 72
+	sizeofRtAttr        = 4
+	// The offsets of the fields of struct tcp_info used by the receiver.
+	tcpInfoRTTOffset          = 68
+	tcpInfoTotalRetransOffset = 100
+)
+
+var errMalformedNetlinkMessage = errors.New("malformed netlink message")
+
+// dumpTCPConnections lists the TCP connections of the network namespace of the
+// collector with the socket diagnostics of the kernel, the same information as
+// reported by `ss -ti`.
+func dumpTCPConnections() ([]tcpConnection, error) {
+	fd, err := unix.Socket(unix.AF_NETLINK, unix.SOCK_DGRAM|unix.SOCK_CLOEXEC, unix.NETLINK_INET_DIAG)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open the netlink socket: %w", err)
+	}
+	defer unix.Close(fd)
+
+	var conns []tcpConnection
+	for i, family := range []uint8{unix.AF_INET, unix.AF_INET6} {
+		c, err := dumpFamilyTCPConnections(fd, family, uint32(i+1))
+		if err != nil {
+			return nil, err
+		}
+		conns = append(conns, c...)
+	}
+	return conns, nil
+}
+
+func dumpFamilyTCPConnections(fd int, family uint8, seq uint32) ([]tcpConnection, error) {
+	req := make([]byte, sizeofNlMsghdr+sizeofInetDiagReqV2)
+	binary.NativeEndian.PutUint32(req[0:4], uint32(len(req)))
+	binary.NativeEndian.PutUint16(req[4:6], unix.SOCK_DIAG_BY_FAMILY)
+	binary.NativeEndian.PutUint16(req[6:8], unix.NLM_F_REQUEST|unix.NLM_F_DUMP)
+	binary.NativeEndian.PutUint32(req[8:12], seq)
+	// struct inet_diag_req_v2, requesting the tcp_info of the sockets in any state
+	req[16] = family
+	req[17] = unix.IPPROTO_TCP
+	req[18] = 1 << (unix.INET_DIAG_INFO - 1)
+	binary.NativeEndian.PutUint32(req[20:24], 0xffffffff)
+
+	if err := unix.Sendto(fd, req, 0, &unix.SockaddrNetlink{Family: unix.AF_NETLINK}); err != nil {
+		return nil, fmt.Errorf("failed to send the netlink request: %w", err)
+	}
+
+	var conns []tcpConnection
+	buf := make([]byte, 32*1024)
+	for {
+		n, _, err := unix.Recvfrom(fd, buf, 0)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read the netlink response: %w", err)
+		}
+		done, err := parseInetDiagMessages(buf[:n], seq, &conns)
+		if err != nil {
+			return nil, err
+		}
+		if done {
+			return conns, nil
+		}
+	}
+}
+
+// parseInetDiagMessages appends the sockets of the netlink messages to conns,
+// and reports whether the last message of the dump was received.
+func parseInetDiagMessages(b []byte, seq uint32, conns *[]tcpConnection) (bool, error) {
+	for len(b) >= sizeofNlMsghdr {
+		msgLen := binary.NativeEndian.Uint32(b[0:4])
+		msgType := binary.NativeEndian.Uint16(b[4:6])
+		if msgLen < sizeofNlMsghdr || int(msgLen) > len(b) {
+			return false, errMalformedNetlinkMessage
+		}
+		if binary.NativeEndian.Uint32(b[8:12]) == seq {
+			switch msgType {
+			case unix.NLMSG_DONE:
+				return true, nil
+			case unix.NLMSG_ERROR:
+				if msgLen < sizeofNlMsghdr+4 {
+					return false, errMalformedNetlinkMessage
+				}
+				errno := -int32(binary.NativeEndian.Uint32(b[16:20]))
+				return false, fmt.Errorf("netlink request failed: %w", unix.Errno(errno))
+			case unix.SOCK_DIAG_BY_FAMILY:
+				if conn, ok := parseInetDiagMsg(b[sizeofNlMsghdr:msgLen]); ok {
+					*conns = append(*conns, conn)
+				}
+			}
+		}
+		next := netlinkAlign(int(msgLen))
+		if next >= len(b) {
+			break
+		}
+		b = b[next:]
+	}
+	return false, nil
+}
+
+// parseInetDiagMsg parses a struct inet_diag_msg followed by its attributes.
+func parseInetDiagMsg(b []byte) (tcpConnection, bool) {
+	if len(b) < sizeofInetDiagMsg {
+		return tcpConnection{}, false
+	}
+	state, ok := tcpStates[b[1]]
+	if !ok {
+		return tcpConnection{}, false
+	}
+	conn := tcpConnection{
+		state: state,
+		inode: uint64(binary.NativeEndian.Uint32(b[68:72])),
+	}
+	attrs := b[sizeofInetDiagMsg:]
+	for len(attrs) >= sizeofRtAttr {
+		attrLen := int(binary.NativeEndian.Uint16(attrs[0:2]))
+		attrType := binary.NativeEndian.Uint16(attrs[2:4])
+		if attrLen < sizeofRtAttr || attrLen > len(attrs) {
+			break
+		}
+		if attrType == unix.INET_DIAG_INFO {
+			info := attrs[sizeofRtAttr:attrLen]
+			if len(info) >= tcpInfoTotalRetransOffset+4 {
+				conn.rtt = time.Duration(binary.NativeEndian.Uint32(info[tcpInfoRTTOffset:tcpInfoRTTOffset+4])) * time.Microsecond
+				conn.hasRTT = true
+				conn.retransmits = binary.NativeEndian.Uint32(info[tcpInfoTotalRetransOffset : tcpInfoTotalRetransOffset+4])
+			}
+		}
+		next := netlinkAlign(attrLen)
+		if next >= len(attrs) {
+			break
+		}
+		attrs = attrs[next:]
+	}
+	return conn, true
+}
+
+func netlinkAlign(n int) int {
+	return (n + 3) &^ 3
+}
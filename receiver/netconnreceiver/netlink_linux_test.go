@@ -0,0 +1,102 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build linux
+
+package netconnreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/netconnreceiver"
+
+import (
+	"encoding/binary"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/sys/unix"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/netconnreceiver/internal/metadata"
+)
+
+// netlinkMessage builds a netlink message of the given type and sequence number.
+func netlinkMessage(msgType uint16, seq uint32, payload []byte) []byte {
+	msg := make([]byte, netlinkAlign(sizeofNlMsghdr+len(payload)))
+	binary.NativeEndian.PutUint32(msg[0:4], uint32(sizeofNlMsghdr+len(payload)))
+	binary.NativeEndian.PutUint16(msg[4:6], msgType)
+	binary.NativeEndian.PutUint32(msg[8:12], seq)
+	copy(msg[sizeofNlMsghdr:], payload)
+	return msg
+}
+
+// inetDiagMsg builds a struct inet_diag_msg, with a tcp_info attribute when
+// rtt is not zero.
+func inetDiagMsg(state uint8, inode uint32, rtt time.Duration, totalRetrans uint32) []byte {
+	msg := make([]byte, sizeofInetDiagMsg)
+	msg[0] = unix.AF_INET
+	msg[1] = state
+	binary.NativeEndian.PutUint32(msg[68:72], inode)
+	if rtt == 0 {
+		return msg
+	}
+	info := make([]byte, 232)
+	binary.NativeEndian.PutUint32(info[tcpInfoRTTOffset:], uint32(rtt.Microseconds()))
+	binary.NativeEndian.PutUint32(info[tcpInfoTotalRetransOffset:], totalRetrans)
+	attr := make([]byte, sizeofRtAttr)
+	binary.NativeEndian.PutUint16(attr[0:2], uint16(sizeofRtAttr+len(info)))
+	binary.NativeEndian.PutUint16(attr[2:4], unix.INET_DIAG_INFO)
+	return append(append(msg, attr...), info...)
+}
+
+func TestParseInetDiagMessages(t *testing.T) {
+	var buf []byte
+	buf = append(buf, netlinkMessage(unix.SOCK_DIAG_BY_FAMILY, 1, inetDiagMsg(1, 1001, 1500*time.Microsecond, 7))...)
+	buf = append(buf, netlinkMessage(unix.SOCK_DIAG_BY_FAMILY, 1, inetDiagMsg(10, 1002, 0, 0))...)
+	// Messages of other requests are ignored
+	buf = append(buf, netlinkMessage(unix.SOCK_DIAG_BY_FAMILY, 2, inetDiagMsg(1, 2001, 0, 0))...)
+
+	var conns []tcpConnection
+	done, err := parseInetDiagMessages(buf, 1, &conns)
+	require.NoError(t, err)
+	assert.False(t, done)
+	assert.Equal(t, []tcpConnection{
+		{
+			state:       metadata.AttributeNetworkConnectionStateEstablished,
+			inode:       1001,
+			retransmits: 7,
+			rtt:         1500 * time.Microsecond,
+			hasRTT:      true,
+		},
+		{
+			state: metadata.AttributeNetworkConnectionStateListen,
+			inode: 1002,
+		},
+	}, conns)
+
+	done, err = parseInetDiagMessages(netlinkMessage(unix.NLMSG_DONE, 1, make([]byte, 4)), 1, &conns)
+	require.NoError(t, err)
+	assert.True(t, done)
+	assert.Len(t, conns, 2)
+}
+
+func TestParseInetDiagMessagesError(t *testing.T) {
+	payload := make([]byte, 4)
+	errno := -int32(unix.EPERM)
+	binary.NativeEndian.PutUint32(payload, uint32(errno))
+
+	var conns []tcpConnection
+	_, err := parseInetDiagMessages(netlinkMessage(unix.NLMSG_ERROR, 1, payload), 1, &conns)
+	require.ErrorIs(t, err, unix.EPERM)
+
+	truncated := netlinkMessage(unix.SOCK_DIAG_BY_FAMILY, 1, inetDiagMsg(1, 1001, 0, 0))[:sizeofNlMsghdr+10]
+	_, err = parseInetDiagMessages(truncated, 1, &conns)
+	require.ErrorIs(t, err, errMalformedNetlinkMessage)
+}
+
+func TestDumpTCPConnections(t *testing.T) {
+	conns, err := dumpTCPConnections()
+	if err != nil {
+		t.Skipf("the socket diagnostics are not available: %v", err)
+	}
+	for _, conn := range conns {
+		assert.NotEqual(t, metadata.AttributeNetworkConnectionState(0), conn.state)
+	}
+}
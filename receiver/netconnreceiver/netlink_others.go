@@ -0,0 +1,12 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build !linux
+
+package netconnreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/netconnreceiver"
+
+import "errors"
+
+func dumpTCPConnections() ([]tcpConnection, error) {
+	return nil, errors.New("the socket diagnostics are only available on Linux")
+}
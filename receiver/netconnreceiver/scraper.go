@@ -0,0 +1,112 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package netconnreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/netconnreceiver"
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/receiver"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/netconnreceiver/internal/metadata"
+)
+
+type scraper struct {
+	cfg      *Config
+	settings component.TelemetrySettings
+	mb       *metadata.MetricsBuilder
+	// listConnections lists the TCP connections with the socket diagnostics or
+	// procfs, as selected by the mode.
+	listConnections func() ([]tcpConnection, error)
+	socketOwners    func() (map[uint64]processInfo, error)
+}
+
+// processStats holds the connections of a process.
+type processStats struct {
+	connections map[metadata.AttributeNetworkConnectionState]int64
+	retransmits int64
+	rttSum      time.Duration
+	rttCount    int64
+}
+
+func (s *scraper) start(_ context.Context, _ component.Host) error {
+	procfs := func() ([]tcpConnection, error) {
+		return readProcNetTCP(s.cfg.procPath())
+	}
+	switch s.cfg.Mode {
+	case modeNetlink:
+		s.listConnections = dumpTCPConnections
+	case modeProcfs:
+		s.listConnections = procfs
+	default:
+		if _, err := dumpTCPConnections(); err != nil {
+			s.settings.Logger.Info("The socket diagnostics are not available, falling back to procfs, the round trip times are not reported", zap.Error(err))
+			s.listConnections = procfs
+		} else {
+			s.listConnections = dumpTCPConnections
+		}
+	}
+	return nil
+}
+
+func (s *scraper) scrape(_ context.Context) (pmetric.Metrics, error) {
+	conns, err := s.listConnections()
+	if err != nil {
+		return pmetric.NewMetrics(), fmt.Errorf("failed to list the TCP connections: %w", err)
+	}
+	owners, err := s.socketOwners()
+	if err != nil {
+		return pmetric.NewMetrics(), fmt.Errorf("failed to list the sockets of the processes: %w", err)
+	}
+
+	stats := map[processInfo]*processStats{}
+	for _, conn := range conns {
+		// The sockets without an owning process are reported with the zero process.
+		owner := owners[conn.inode]
+		if conn.inode == 0 {
+			owner = processInfo{}
+		}
+		ps, ok := stats[owner]
+		if !ok {
+			ps = &processStats{connections: map[metadata.AttributeNetworkConnectionState]int64{}}
+			stats[owner] = ps
+		}
+		ps.connections[conn.state]++
+		ps.retransmits += int64(conn.retransmits)
+		if conn.hasRTT && conn.state == metadata.AttributeNetworkConnectionStateEstablished {
+			ps.rttSum += conn.rtt
+			ps.rttCount++
+		}
+	}
+
+	now := pcommon.NewTimestampFromTime(time.Now())
+	for owner, ps := range stats {
+		for state, count := range ps.connections {
+			s.mb.RecordNetconnTCPConnectionsDataPoint(now, count, owner.pid, owner.name, owner.containerID, state)
+		}
+		s.mb.RecordNetconnTCPRetransmitsDataPoint(now, ps.retransmits, owner.pid, owner.name, owner.containerID)
+		if ps.rttCount > 0 {
+			avg := float64(ps.rttSum) / float64(ps.rttCount) / float64(time.Millisecond)
+			s.mb.RecordNetconnTCPRttDataPoint(now, avg, owner.pid, owner.name, owner.containerID)
+		}
+	}
+
+	return s.mb.Emit(), nil
+}
+
+func newScraper(cfg *Config, settings receiver.Settings) *scraper {
+	return &scraper{
+		cfg:      cfg,
+		settings: settings.TelemetrySettings,
+		mb:       metadata.NewMetricsBuilder(cfg.MetricsBuilderConfig, settings),
+		socketOwners: func() (map[uint64]processInfo, error) {
+			return socketOwners(cfg.procPath())
+		},
+	}
+}
@@ -0,0 +1,128 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package netconnreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/netconnreceiver"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/netconnreceiver/internal/metadata"
+)
+
+func TestScraper(t *testing.T) {
+	cfg := newDefaultConfig().(*Config)
+	s := newScraper(cfg, receivertest.NewNopSettings(metadata.Type))
+	s.listConnections = func() ([]tcpConnection, error) {
+		return []tcpConnection{
+			{state: metadata.AttributeNetworkConnectionStateListen, inode: 1001},
+			{state: metadata.AttributeNetworkConnectionStateEstablished, inode: 1002, retransmits: 2, rtt: 10 * time.Millisecond, hasRTT: true},
+			{state: metadata.AttributeNetworkConnectionStateEstablished, inode: 1003, retransmits: 1, rtt: 20 * time.Millisecond, hasRTT: true},
+			{state: metadata.AttributeNetworkConnectionStateSynSent, inode: 1004, rtt: time.Second, hasRTT: true},
+			{state: metadata.AttributeNetworkConnectionStateTimeWait},
+			{state: metadata.AttributeNetworkConnectionStateTimeWait},
+			// A socket of a process whose file descriptors cannot be read
+			{state: metadata.AttributeNetworkConnectionStateEstablished, inode: 9999, retransmits: 5},
+		}, nil
+	}
+	nginx := processInfo{pid: 42, name: "nginx", containerID: "abc"}
+	s.socketOwners = func() (map[uint64]processInfo, error) {
+		return map[uint64]processInfo{1001: nginx, 1002: nginx, 1003: nginx, 1004: nginx}, nil
+	}
+
+	metrics, err := s.scrape(context.Background())
+	require.NoError(t, err)
+
+	values := numberValues(t, metrics)
+	assert.Equal(t, map[string]float64{
+		"netconn.tcp.connections/listen":      1,
+		"netconn.tcp.connections/established": 2,
+		"netconn.tcp.connections/syn_sent":    1,
+		"netconn.tcp.retransmits":             3,
+		"netconn.tcp.rtt":                     15,
+	}, values["42/nginx/abc"])
+	assert.Equal(t, map[string]float64{
+		"netconn.tcp.connections/time_wait":   2,
+		"netconn.tcp.connections/established": 1,
+		"netconn.tcp.retransmits":             5,
+	}, values["0//"])
+}
+
+func TestScraperErrors(t *testing.T) {
+	cfg := newDefaultConfig().(*Config)
+	s := newScraper(cfg, receivertest.NewNopSettings(metadata.Type))
+	s.listConnections = func() ([]tcpConnection, error) {
+		return nil, errors.New("permission denied")
+	}
+	_, err := s.scrape(context.Background())
+	require.EqualError(t, err, "failed to list the TCP connections: permission denied")
+
+	s.listConnections = func() ([]tcpConnection, error) {
+		return nil, nil
+	}
+	s.socketOwners = func() (map[uint64]processInfo, error) {
+		return nil, errors.New("no such file or directory")
+	}
+	_, err = s.scrape(context.Background())
+	require.EqualError(t, err, "failed to list the sockets of the processes: no such file or directory")
+}
+
+func TestScraperStart(t *testing.T) {
+	cfg := newDefaultConfig().(*Config)
+	cfg.Mode = modeProcfs
+	cfg.RootPath = t.TempDir()
+	s := newScraper(cfg, receivertest.NewNopSettings(metadata.Type))
+	require.NoError(t, s.start(context.Background(), nil))
+
+	// There is no /proc/net/tcp in the root path
+	_, err := s.scrape(context.Background())
+	require.ErrorContains(t, err, "failed to list the TCP connections")
+}
+
+// numberValues returns the values of the data points by process, as
+// <pid>/<name>/<container id>, and by metric name, suffixed by the state for
+// the connections.
+func numberValues(t *testing.T, metrics pmetric.Metrics) map[string]map[string]float64 {
+	t.Helper()
+	values := map[string]map[string]float64{}
+	ms := metrics.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	for i := 0; i < ms.Len(); i++ {
+		m := ms.At(i)
+		var dps pmetric.NumberDataPointSlice
+		switch m.Type() {
+		case pmetric.MetricTypeSum:
+			dps = m.Sum().DataPoints()
+		case pmetric.MetricTypeGauge:
+			dps = m.Gauge().DataPoints()
+		default:
+			t.Fatalf("unexpected metric type %s", m.Type())
+		}
+		for j := 0; j < dps.Len(); j++ {
+			dp := dps.At(j)
+			attrs := dp.Attributes().AsRaw()
+			process := fmt.Sprintf("%v/%v/%v", attrs["process.pid"], attrs["process.executable.name"], attrs["container.id"])
+			name := m.Name()
+			if state, ok := attrs["network.connection.state"]; ok {
+				name += "/" + state.(string)
+			}
+			if values[process] == nil {
+				values[process] = map[string]float64{}
+			}
+			switch dp.ValueType() {
+			case pmetric.NumberDataPointValueTypeInt:
+				values[process][name] = float64(dp.IntValue())
+			case pmetric.NumberDataPointValueTypeDouble:
+				values[process][name] = dp.DoubleValue()
+			}
+		}
+	}
+	return values
+}
@@ -5,6 +5,9 @@ package netflowreceiver // import "github.com/open-telemetry/opentelemetry-colle
 
 import (
 	"errors"
+	"time"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/netflowreceiver/internal/metadata"
 )
 
 // Config represents the receiver config settings within the collector's config.yaml
@@ -33,6 +36,19 @@ type Config struct {
 
 	// SendRaw determines whether to send raw flow messages instead of parsing them
 	SendRaw bool `mapstructure:"send_raw"`
+
+	// NormalizeSamplingRate multiplies the bytes and packets of the sampled flows
+	// by their sampling rate, so they estimate the actual traffic
+	NormalizeSamplingRate bool `mapstructure:"normalize_sampling_rate"`
+
+	// DefaultSamplingRate is the sampling rate of the flows whose exporter does not report one
+	// It is only used when normalizing the sampling rate
+	DefaultSamplingRate uint64 `mapstructure:"default_sampling_rate"`
+
+	// MetricsInterval is the interval at which the metrics aggregating the flows are reported
+	MetricsInterval time.Duration `mapstructure:"metrics_interval"`
+
+	metadata.MetricsBuilderConfig `mapstructure:",squash"`
 }
 
 // Validate checks if the receiver configuration is valid
@@ -66,5 +82,9 @@ func (cfg *Config) Validate() error {
 		return errors.New("port must be greater than 0")
 	}
 
+	if cfg.MetricsInterval <= 0 {
+		return errors.New("metrics_interval must be greater than 0")
+	}
+
 	return nil
 }
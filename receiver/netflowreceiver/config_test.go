@@ -6,6 +6,7 @@ package netflowreceiver
 import (
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -33,42 +34,64 @@ func TestLoadConfig(t *testing.T) {
 		{
 			id: component.NewIDWithName(metadata.Type, "one_listener"),
 			expected: &Config{
-				Scheme:    "netflow",
-				Port:      2055,
-				Sockets:   1,
-				Workers:   1,
-				QueueSize: 1000,
+				Scheme:               "netflow",
+				Port:                 2055,
+				Sockets:              1,
+				Workers:              1,
+				QueueSize:            1000,
+				MetricsInterval:      time.Minute,
+				MetricsBuilderConfig: metadata.DefaultMetricsBuilderConfig(),
 			},
 		},
 		{
 			id: component.NewIDWithName(metadata.Type, "zero_queue"),
 			expected: &Config{
-				Scheme:    "netflow",
-				Port:      2055,
-				Sockets:   1,
-				Workers:   1,
-				QueueSize: 1000,
+				Scheme:               "netflow",
+				Port:                 2055,
+				Sockets:              1,
+				Workers:              1,
+				QueueSize:            1000,
+				MetricsInterval:      time.Minute,
+				MetricsBuilderConfig: metadata.DefaultMetricsBuilderConfig(),
 			},
 		},
 		{
 			id: component.NewIDWithName(metadata.Type, "sflow"),
 			expected: &Config{
-				Scheme:    "sflow",
-				Port:      6343,
-				Sockets:   1,
-				Workers:   1,
-				QueueSize: 1000,
+				Scheme:               "sflow",
+				Port:                 6343,
+				Sockets:              1,
+				Workers:              1,
+				QueueSize:            1000,
+				MetricsInterval:      time.Minute,
+				MetricsBuilderConfig: metadata.DefaultMetricsBuilderConfig(),
 			},
 		},
 		{
 			id: component.NewIDWithName(metadata.Type, "raw_logs"),
 			expected: &Config{
-				Scheme:    "netflow",
-				Port:      2055,
-				Sockets:   1,
-				Workers:   1,
-				QueueSize: 1000,
-				SendRaw:   true,
+				Scheme:               "netflow",
+				Port:                 2055,
+				Sockets:              1,
+				Workers:              1,
+				QueueSize:            1000,
+				SendRaw:              true,
+				MetricsInterval:      time.Minute,
+				MetricsBuilderConfig: metadata.DefaultMetricsBuilderConfig(),
+			},
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "normalized_metrics"),
+			expected: &Config{
+				Scheme:                "netflow",
+				Port:                  2055,
+				Sockets:               1,
+				Workers:               1,
+				QueueSize:             1000,
+				NormalizeSamplingRate: true,
+				DefaultSamplingRate:   100,
+				MetricsInterval:       30 * time.Second,
+				MetricsBuilderConfig:  metadata.DefaultMetricsBuilderConfig(),
 			},
 		},
 	}
@@ -114,6 +137,10 @@ func TestInvalidConfig(t *testing.T) {
 			id:  component.NewIDWithName(metadata.Type, "zero_workers"),
 			err: "workers must be greater than 0",
 		},
+		{
+			id:  component.NewIDWithName(metadata.Type, "zero_metrics_interval"),
+			err: "metrics_interval must be greater than 0",
+		},
 	}
 
 	for _, tt := range tests {
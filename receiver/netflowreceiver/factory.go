@@ -5,11 +5,13 @@ package netflowreceiver // import "github.com/open-telemetry/opentelemetry-colle
 
 import (
 	"context"
+	"time"
 
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/consumer"
 	"go.opentelemetry.io/collector/receiver"
 
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/sharedcomponent"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/netflowreceiver/internal/metadata"
 )
 
@@ -19,7 +21,8 @@ const (
 	// The default UDP packet buffer size in GoFlow2 is 9000 bytes, which means
 	// that for a full queue of 1000 messages, the size in memory will be 9MB.
 	// Source: https://github.com/netsampler/goflow2/blob/v2.2.1/README.md#security-notes-and-assumptions
-	defaultQueueSize = 1_000
+	defaultQueueSize       = 1_000
+	defaultMetricsInterval = time.Minute
 )
 
 // NewFactory creates a factory for netflow receiver.
@@ -27,31 +30,57 @@ func NewFactory() receiver.Factory {
 	return receiver.NewFactory(
 		metadata.Type,
 		createDefaultConfig,
-		receiver.WithLogs(createLogsReceiver, metadata.LogsStability))
+		receiver.WithLogs(createLogsReceiver, metadata.LogsStability),
+		receiver.WithMetrics(createMetricsReceiver, metadata.MetricsStability))
 }
 
 // Config defines configuration for netflow receiver.
 // By default we listen for netflow traffic on port 2055
 func createDefaultConfig() component.Config {
 	return &Config{
-		Scheme:    "netflow",
-		Port:      2055,
-		Sockets:   defaultSockets,
-		Workers:   defaultWorkers,
-		QueueSize: defaultQueueSize,
+		Scheme:               "netflow",
+		Port:                 2055,
+		Sockets:              defaultSockets,
+		Workers:              defaultWorkers,
+		QueueSize:            defaultQueueSize,
+		MetricsInterval:      defaultMetricsInterval,
+		MetricsBuilderConfig: metadata.DefaultMetricsBuilderConfig(),
 	}
 }
 
-// createLogsReceiver creates a netflow receiver.
+// createLogsReceiver creates a netflow receiver sending the flows as logs.
 // We also create the UDP receiver, which is the piece of software that actually listens
 // for incoming netflow traffic on an UDP port.
 func createLogsReceiver(_ context.Context, params receiver.Settings, cfg component.Config, consumer consumer.Logs) (receiver.Logs, error) {
-	conf := *(cfg.(*Config))
+	var err error
+	rcfg := cfg.(*Config)
+	r := receivers.GetOrAdd(rcfg, func() (nr component.Component) {
+		nr, err = newNetflowReceiver(params, *rcfg)
+		return nr
+	})
+	if err != nil {
+		return nil, err
+	}
 
-	nr, err := newNetflowLogsReceiver(params, conf, consumer)
+	r.Unwrap().(*netflowReceiver).logConsumer = consumer
+	return r, nil
+}
+
+// createMetricsReceiver creates a netflow receiver sending metrics aggregating the flows.
+// The logs and metrics receivers of the same configuration share the UDP receiver.
+func createMetricsReceiver(_ context.Context, params receiver.Settings, cfg component.Config, consumer consumer.Metrics) (receiver.Metrics, error) {
+	var err error
+	rcfg := cfg.(*Config)
+	r := receivers.GetOrAdd(rcfg, func() (nr component.Component) {
+		nr, err = newNetflowReceiver(params, *rcfg)
+		return nr
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	return nr, nil
+	r.Unwrap().(*netflowReceiver).metricsConsumer = consumer
+	return r, nil
 }
+
+var receivers = sharedcomponent.NewSharedComponents()
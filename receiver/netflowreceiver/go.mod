@@ -4,6 +4,7 @@ go 1.23.0
 
 require (
 	github.com/netsampler/goflow2/v2 v2.2.3
+	github.com/open-telemetry/opentelemetry-collector-contrib/internal/sharedcomponent v0.132.0
 	github.com/stretchr/testify v1.10.0
 	go.opentelemetry.io/collector/component v1.38.0
 	go.opentelemetry.io/collector/component/componenttest v0.132.0
@@ -62,3 +63,5 @@ require (
 	google.golang.org/protobuf v1.36.7 // indirect
 	gopkg.in/yaml.v3 v3.0.1 // indirect
 )
+
+replace github.com/open-telemetry/opentelemetry-collector-contrib/internal/sharedcomponent => ../../internal/sharedcomponent
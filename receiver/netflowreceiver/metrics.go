@@ -0,0 +1,134 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package netflowreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/netflowreceiver"
+
+import (
+	"context"
+	"net/netip"
+	"sync"
+	"time"
+
+	"github.com/netsampler/goflow2/v2/producer"
+	protoproducer "github.com/netsampler/goflow2/v2/producer/proto"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/receiver"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/netflowreceiver/internal/metadata"
+)
+
+// flowKey identifies the flows aggregated together
+type flowKey struct {
+	samplerAddress string
+	flowType       metadata.AttributeFlowType
+	transport      string
+}
+
+// flowTotals holds the cumulative totals of the flows aggregated together
+type flowTotals struct {
+	flows   int64
+	bytes   int64
+	packets int64
+}
+
+// flowAggregator aggregates the flows received by exporter, flow type and transport protocol,
+// and reports the cumulative totals as metrics at every interval
+type flowAggregator struct {
+	logger          *zap.Logger
+	metricsConsumer consumer.Metrics
+	mb              *metadata.MetricsBuilder
+	interval        time.Duration
+
+	mu     sync.Mutex
+	totals map[flowKey]*flowTotals
+
+	done chan struct{}
+	wg   sync.WaitGroup
+}
+
+func newFlowAggregator(params receiver.Settings, cfg Config, metricsConsumer consumer.Metrics) *flowAggregator {
+	return &flowAggregator{
+		logger:          params.Logger,
+		metricsConsumer: metricsConsumer,
+		mb:              metadata.NewMetricsBuilder(cfg.MetricsBuilderConfig, params),
+		interval:        cfg.MetricsInterval,
+		totals:          map[flowKey]*flowTotals{},
+		done:            make(chan struct{}),
+	}
+}
+
+// record adds the flow messages to the totals
+func (a *flowAggregator) record(flowMessageSet []producer.ProducerMessage) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	for _, msg := range flowMessageSet {
+		pm, ok := msg.(*protoproducer.ProtoProducerMessage)
+		if !ok {
+			continue
+		}
+		samplerAddr, _ := netip.AddrFromSlice(pm.SamplerAddress)
+		flowType, ok := metadata.MapAttributeFlowType[getFlowTypeName(int32(pm.Type))]
+		if !ok {
+			flowType = metadata.AttributeFlowTypeUnknown
+		}
+		key := flowKey{
+			samplerAddress: samplerAddr.String(),
+			flowType:       flowType,
+			transport:      getTransportName(pm.Proto),
+		}
+		totals, ok := a.totals[key]
+		if !ok {
+			totals = &flowTotals{}
+			a.totals[key] = totals
+		}
+		totals.flows++
+		totals.bytes += int64(pm.Bytes)
+		totals.packets += int64(pm.Packets)
+	}
+}
+
+func (a *flowAggregator) start() {
+	a.wg.Add(1)
+	go func() {
+		defer a.wg.Done()
+		ticker := time.NewTicker(a.interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				if err := a.emit(context.Background()); err != nil {
+					a.logger.Error("failed to send the flow metrics", zap.Error(err))
+				}
+			case <-a.done:
+				return
+			}
+		}
+	}()
+}
+
+func (a *flowAggregator) shutdown() {
+	close(a.done)
+	a.wg.Wait()
+}
+
+// emit sends the current totals to the metrics consumer, nothing is sent until a flow is received
+func (a *flowAggregator) emit(ctx context.Context) error {
+	a.mu.Lock()
+	if len(a.totals) == 0 {
+		a.mu.Unlock()
+		return nil
+	}
+	now := pcommon.NewTimestampFromTime(time.Now())
+	for key, totals := range a.totals {
+		a.mb.RecordNetflowFlowsDataPoint(now, totals.flows, key.samplerAddress, key.flowType, key.transport)
+		a.mb.RecordNetflowIoBytesDataPoint(now, totals.bytes, key.samplerAddress, key.flowType, key.transport)
+		a.mb.RecordNetflowIoPacketsDataPoint(now, totals.packets, key.samplerAddress, key.flowType, key.transport)
+	}
+	metrics := a.mb.Emit()
+	a.mu.Unlock()
+
+	return a.metricsConsumer.ConsumeMetrics(ctx, metrics)
+}
@@ -0,0 +1,87 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package netflowreceiver
+
+import (
+	"context"
+	"net/netip"
+	"testing"
+
+	flowpb "github.com/netsampler/goflow2/v2/pb"
+	"github.com/netsampler/goflow2/v2/producer"
+	protoproducer "github.com/netsampler/goflow2/v2/producer/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/netflowreceiver/internal/metadata"
+)
+
+func newTestFlow(sampler string, flowType flowpb.FlowMessage_FlowType, proto uint32, bytes, packets uint64) *protoproducer.ProtoProducerMessage {
+	return &protoproducer.ProtoProducerMessage{
+		FlowMessage: flowpb.FlowMessage{
+			SamplerAddress: netip.MustParseAddr(sampler).AsSlice(),
+			Type:           flowType,
+			Proto:          proto,
+			Bytes:          bytes,
+			Packets:        packets,
+		},
+	}
+}
+
+func TestFlowAggregator(t *testing.T) {
+	sink := &consumertest.MetricsSink{}
+	cfg := createDefaultConfig().(*Config)
+	aggregator := newFlowAggregator(receivertest.NewNopSettings(metadata.Type), *cfg, sink)
+
+	// Nothing is sent before the first flow is received
+	require.NoError(t, aggregator.emit(context.Background()))
+	assert.Empty(t, sink.AllMetrics())
+
+	aggregator.record([]producer.ProducerMessage{
+		newTestFlow("192.168.1.100", flowpb.FlowMessage_NETFLOW_V9, 6, 1000, 10),
+		newTestFlow("192.168.1.100", flowpb.FlowMessage_NETFLOW_V9, 6, 500, 5),
+		newTestFlow("192.168.1.100", flowpb.FlowMessage_NETFLOW_V9, 17, 200, 2),
+	})
+	require.NoError(t, aggregator.emit(context.Background()))
+
+	// The totals are cumulative
+	aggregator.record([]producer.ProducerMessage{
+		newTestFlow("192.168.1.101", flowpb.FlowMessage_SFLOW_5, 6, 100, 1),
+		newTestFlow("192.168.1.100", flowpb.FlowMessage_NETFLOW_V9, 6, 100, 1),
+	})
+	require.NoError(t, aggregator.emit(context.Background()))
+
+	allMetrics := sink.AllMetrics()
+	require.Len(t, allMetrics, 2)
+
+	assertDataPoint(t, allMetrics[0], "netflow.flows", "192.168.1.100", "netflow_v9", "tcp", 2)
+	assertDataPoint(t, allMetrics[0], "netflow.io.bytes", "192.168.1.100", "netflow_v9", "tcp", 1500)
+	assertDataPoint(t, allMetrics[0], "netflow.io.packets", "192.168.1.100", "netflow_v9", "udp", 2)
+
+	assertDataPoint(t, allMetrics[1], "netflow.flows", "192.168.1.100", "netflow_v9", "tcp", 3)
+	assertDataPoint(t, allMetrics[1], "netflow.io.bytes", "192.168.1.100", "netflow_v9", "tcp", 1600)
+	assertDataPoint(t, allMetrics[1], "netflow.io.bytes", "192.168.1.101", "sflow_5", "tcp", 100)
+}
+
+func assertDataPoint(t *testing.T, metrics pmetric.Metrics, name, sampler, flowType, transport string, expected int64) {
+	t.Helper()
+	ms := metrics.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	for i := 0; i < ms.Len(); i++ {
+		if ms.At(i).Name() != name {
+			continue
+		}
+		dps := ms.At(i).Sum().DataPoints()
+		for j := 0; j < dps.Len(); j++ {
+			attrs := dps.At(j).Attributes().AsRaw()
+			if attrs["flow.sampler_address"] == sampler && attrs["flow.type"] == flowType && attrs["network.transport"] == transport {
+				assert.Equal(t, expected, dps.At(j).IntValue())
+				return
+			}
+		}
+	}
+	assert.Failf(t, "data point not found", "%s{%s, %s, %s}", name, sampler, flowType, transport)
+}
@@ -256,3 +256,16 @@ func addMessageAttributes(m producer.ProducerMessage, r *plog.LogRecord) error {
 
 	return nil
 }
+
+// normalizeSamplingRate multiplies the bytes and packets of a sampled flow by its sampling rate,
+// or by the default sampling rate if the exporter did not report one, so they estimate the actual traffic
+func normalizeSamplingRate(pm *protoproducer.ProtoProducerMessage, defaultSamplingRate uint64) {
+	if pm.SamplingRate == 0 {
+		pm.SamplingRate = defaultSamplingRate
+	}
+	if pm.SamplingRate <= 1 {
+		return
+	}
+	pm.Bytes *= pm.SamplingRate
+	pm.Packets *= pm.SamplingRate
+}
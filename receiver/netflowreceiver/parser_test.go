@@ -125,3 +125,59 @@ func TestEmptyConvertToOtel(t *testing.T) {
 
 	assert.Equal(t, expectedAttributes, record.Attributes())
 }
+
+func TestNormalizeSamplingRate(t *testing.T) {
+	tests := []struct {
+		name                string
+		samplingRate        uint64
+		defaultSamplingRate uint64
+		expectedRate        uint64
+		expectedBytes       uint64
+		expectedPackets     uint64
+	}{
+		{
+			name:            "reported sampling rate",
+			samplingRate:    100,
+			expectedRate:    100,
+			expectedBytes:   150000,
+			expectedPackets: 1000,
+		},
+		{
+			name:                "default sampling rate",
+			defaultSamplingRate: 10,
+			expectedRate:        10,
+			expectedBytes:       15000,
+			expectedPackets:     100,
+		},
+		{
+			name:                "reported sampling rate takes precedence",
+			samplingRate:        2,
+			defaultSamplingRate: 10,
+			expectedRate:        2,
+			expectedBytes:       3000,
+			expectedPackets:     20,
+		},
+		{
+			name:            "unsampled",
+			expectedRate:    0,
+			expectedBytes:   1500,
+			expectedPackets: 10,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pm := &protoproducer.ProtoProducerMessage{
+				FlowMessage: flowpb.FlowMessage{
+					Bytes:        1500,
+					Packets:      10,
+					SamplingRate: tt.samplingRate,
+				},
+			}
+			normalizeSamplingRate(pm, tt.defaultSamplingRate)
+			assert.Equal(t, tt.expectedRate, pm.SamplingRate)
+			assert.Equal(t, tt.expectedBytes, pm.Bytes)
+			assert.Equal(t, tt.expectedPackets, pm.Packets)
+		})
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"fmt"
 
 	"github.com/netsampler/goflow2/v2/producer"
+	protoproducer "github.com/netsampler/goflow2/v2/producer/proto"
 	"go.opentelemetry.io/collector/consumer"
 	"go.opentelemetry.io/collector/pdata/plog"
 	"go.uber.org/zap"
@@ -16,11 +17,16 @@ import (
 )
 
 // otelLogsProducerWrapper is a wrapper around a producer.ProducerInterface that sends the messages to a log consumer
+// and, when the receiver is part of a metrics pipeline, to the flow aggregator
 type otelLogsProducerWrapper struct {
 	wrapped     producer.ProducerInterface
 	logConsumer consumer.Logs
 	logger      *zap.Logger
 	sendRaw     bool
+
+	aggregator            *flowAggregator
+	normalizeSamplingRate bool
+	defaultSamplingRate   uint64
 }
 
 // Produce converts the message into a list log records and sends them to log consumer
@@ -39,6 +45,27 @@ func (o *otelLogsProducerWrapper) Produce(msg any, args *producer.ProduceArgs) (
 		return flowMessageSet, err
 	}
 
+	if o.normalizeSamplingRate {
+		for _, msg := range flowMessageSet {
+			if pm, ok := msg.(*protoproducer.ProtoProducerMessage); ok {
+				normalizeSamplingRate(pm, o.defaultSamplingRate)
+			}
+		}
+	}
+
+	if o.aggregator != nil {
+		o.aggregator.record(flowMessageSet)
+	}
+
+	if len(flowMessageSet) == 0 {
+		o.logger.Info("received a packet with no flow messages from", zap.String("agent", args.SamplerAddress.String()))
+	}
+
+	// The receiver may only be part of a metrics pipeline
+	if o.logConsumer == nil {
+		return flowMessageSet, nil
+	}
+
 	// Create the otel log structure to hold our messages
 	log := plog.NewLogs()
 	scopeLog := log.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty()
@@ -60,10 +87,6 @@ func (o *otelLogsProducerWrapper) Produce(msg any, args *producer.ProduceArgs) (
 		}
 	}
 
-	if len(flowMessageSet) == 0 {
-		o.logger.Info("received a packet with no flow messages from", zap.String("agent", args.SamplerAddress.String()))
-	}
-
 	err = o.logConsumer.ConsumeLogs(context.Background(), log)
 	if err != nil {
 		return flowMessageSet, err
@@ -80,7 +103,7 @@ func (o *otelLogsProducerWrapper) Commit(flowMessageSet []producer.ProducerMessa
 	o.wrapped.Commit(flowMessageSet)
 }
 
-func newOtelLogsProducer(wrapped producer.ProducerInterface, logConsumer consumer.Logs, logger *zap.Logger, sendRaw bool) producer.ProducerInterface {
+func newOtelLogsProducer(wrapped producer.ProducerInterface, logConsumer consumer.Logs, logger *zap.Logger, sendRaw bool) *otelLogsProducerWrapper {
 	return &otelLogsProducerWrapper{
 		wrapped:     wrapped,
 		logConsumer: logConsumer,
@@ -4,6 +4,7 @@
 package netflowreceiver
 
 import (
+	"context"
 	"fmt"
 	"net/netip"
 	"testing"
@@ -15,8 +16,11 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/receiver/receivertest"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zaptest/observer"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/netflowreceiver/internal/metadata"
 )
 
 func TestProduce(t *testing.T) {
@@ -186,3 +190,68 @@ func TestProducerPanic(t *testing.T) {
 	assert.Equal(t, "unexpected error processing the message", log.Message)
 	assert.Equal(t, "producer panic!", log.ContextMap()["error"])
 }
+
+func TestProduceMetricsOnly(t *testing.T) {
+	message := &netflow.NFv9Packet{
+		Version:        9,
+		Count:          1,
+		SystemUptime:   0xb3bff683,
+		UnixSeconds:    0x618aa3a8,
+		SequenceNumber: 838987416,
+		SourceId:       256,
+		FlowSets: []any{
+			netflow.DataFlowSet{
+				FlowSetHeader: netflow.FlowSetHeader{
+					Id:     260,
+					Length: 1372,
+				},
+				Records: []netflow.DataRecord{
+					{
+						Values: []netflow.DataField{
+							{
+								PenProvided: false,
+								Type:        1,
+								Pen:         0,
+								Value:       []uint8{0x00, 0x00, 0x00, 0x64},
+							},
+							{
+								PenProvided: false,
+								Type:        2,
+								Pen:         0,
+								Value:       []uint8{0x00, 0x00, 0x00, 0x01},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	cfgProducer := &protoproducer.ProducerConfig{}
+	cfgm, err := cfgProducer.Compile()
+	require.NoError(t, err)
+
+	protoProducer, err := protoproducer.CreateProtoProducer(cfgm, protoproducer.CreateSamplingSystem)
+	require.NoError(t, err)
+
+	sink := &consumertest.MetricsSink{}
+	cfg := createDefaultConfig().(*Config)
+	otelLogsProducer := newOtelLogsProducer(protoProducer, nil, zap.NewNop(), false)
+	otelLogsProducer.aggregator = newFlowAggregator(receivertest.NewNopSettings(metadata.Type), *cfg, sink)
+	otelLogsProducer.normalizeSamplingRate = true
+	otelLogsProducer.defaultSamplingRate = 10
+
+	messages, err := otelLogsProducer.Produce(message, &producer.ProduceArgs{})
+	require.NoError(t, err)
+	require.Len(t, messages, 1)
+
+	pm, ok := messages[0].(*protoproducer.ProtoProducerMessage)
+	require.True(t, ok)
+	assert.Equal(t, uint64(1000), pm.Bytes)
+	assert.Equal(t, uint64(10), pm.Packets)
+	assert.Equal(t, uint64(10), pm.SamplingRate)
+
+	require.NoError(t, otelLogsProducer.aggregator.emit(context.Background()))
+	require.Len(t, sink.AllMetrics(), 1)
+	assertDataPoint(t, sink.AllMetrics()[0], "netflow.io.bytes", "invalid IP", "netflow_v9", "hopopt", 1000)
+}
@@ -29,13 +29,16 @@ func (d dropHandler) Dropped(msg utils.Message) {
 }
 
 type netflowReceiver struct {
-	config      Config
-	logger      *zap.Logger
-	udpReceiver *utils.UDPReceiver
-	logConsumer consumer.Logs
+	config          Config
+	settings        receiver.Settings
+	logger          *zap.Logger
+	udpReceiver     *utils.UDPReceiver
+	logConsumer     consumer.Logs
+	metricsConsumer consumer.Metrics
+	aggregator      *flowAggregator
 }
 
-func newNetflowLogsReceiver(params receiver.Settings, cfg Config, consumer consumer.Logs) (receiver.Logs, error) {
+func newNetflowReceiver(params receiver.Settings, cfg Config) (*netflowReceiver, error) {
 	// UDP receiver configuration
 	udpCfg := &utils.UDPReceiverConfig{
 		Sockets:   cfg.Sockets,
@@ -53,8 +56,8 @@ func newNetflowLogsReceiver(params receiver.Settings, cfg Config, consumer consu
 
 	nr := &netflowReceiver{
 		logger:      params.Logger,
+		settings:    params,
 		config:      cfg,
-		logConsumer: consumer,
 		udpReceiver: udpReceiver,
 	}
 
@@ -62,6 +65,12 @@ func newNetflowLogsReceiver(params receiver.Settings, cfg Config, consumer consu
 }
 
 func (nr *netflowReceiver) Start(_ context.Context, _ component.Host) error {
+	// The flows are only aggregated when the receiver is part of a metrics pipeline
+	if nr.metricsConsumer != nil {
+		nr.aggregator = newFlowAggregator(nr.settings, nr.config, nr.metricsConsumer)
+		nr.aggregator.start()
+	}
+
 	// The function that will decode packets
 	decodeFunc, err := nr.buildDecodeFunc()
 	if err != nil {
@@ -80,6 +89,9 @@ func (nr *netflowReceiver) Start(_ context.Context, _ component.Host) error {
 }
 
 func (nr *netflowReceiver) Shutdown(context.Context) error {
+	if nr.aggregator != nil {
+		nr.aggregator.shutdown()
+	}
 	if nr.udpReceiver == nil {
 		return nil
 	}
@@ -109,6 +121,11 @@ func (nr *netflowReceiver) buildDecodeFunc() (utils.DecoderFunc, error) {
 	// the otel log producer converts those messages into OpenTelemetry logs
 	// it is a wrapper around the protobuf producer
 	otelLogsProducer := newOtelLogsProducer(protoProducer, nr.logConsumer, nr.logger, nr.config.SendRaw)
+	otelLogsProducer.aggregator = nr.aggregator
+	if nr.config.NormalizeSamplingRate {
+		otelLogsProducer.normalizeSamplingRate = true
+		otelLogsProducer.defaultSamplingRate = nr.config.DefaultSamplingRate
+	}
 
 	cfgPipe := &utils.PipeConfig{
 		Producer: otelLogsProducer,
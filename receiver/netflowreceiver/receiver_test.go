@@ -11,6 +11,7 @@ import (
 	"go.opentelemetry.io/collector/consumer/consumertest"
 	"go.opentelemetry.io/collector/receiver/receivertest"
 
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/sharedcomponent"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/netflowreceiver/internal/metadata"
 )
 
@@ -21,5 +22,20 @@ func TestCreateValidDefaultReceiver(t *testing.T) {
 	receiver, err := factory.CreateLogs(context.Background(), set, cfg, consumertest.NewNop())
 	assert.NoError(t, err, "receiver creation failed")
 	assert.NotNil(t, receiver, "receiver creation failed")
-	assert.NotNil(t, receiver.(*netflowReceiver).udpReceiver)
+	assert.NotNil(t, receiver.(*sharedcomponent.SharedComponent).Unwrap().(*netflowReceiver).udpReceiver)
+}
+
+func TestCreateSharedReceiver(t *testing.T) {
+	factory := NewFactory()
+	cfg := factory.CreateDefaultConfig()
+	set := receivertest.NewNopSettings(metadata.Type)
+	logsReceiver, err := factory.CreateLogs(context.Background(), set, cfg, consumertest.NewNop())
+	assert.NoError(t, err, "logs receiver creation failed")
+	metricsReceiver, err := factory.CreateMetrics(context.Background(), set, cfg, consumertest.NewNop())
+	assert.NoError(t, err, "metrics receiver creation failed")
+	assert.Same(t, logsReceiver, metricsReceiver)
+
+	nr := metricsReceiver.(*sharedcomponent.SharedComponent).Unwrap().(*netflowReceiver)
+	assert.NotNil(t, nr.logConsumer)
+	assert.NotNil(t, nr.metricsConsumer)
 }
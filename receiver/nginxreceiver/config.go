@@ -4,17 +4,40 @@
 package nginxreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/nginxreceiver"
 
 import (
+	"fmt"
+
 	"go.opentelemetry.io/collector/config/confighttp"
 	"go.opentelemetry.io/collector/scraper/scraperhelper"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/nginxreceiver/internal/metadata"
 )
 
+const (
+	// apiTypeStubStatus is the status page of the ngx_http_stub_status_module.
+	apiTypeStubStatus = "stub_status"
+	// apiTypePlus is the REST API of NGINX Plus.
+	apiTypePlus = "plus"
+	// apiTypeVTS is the JSON status of the nginx-module-vts community module.
+	apiTypeVTS = "vts"
+)
+
 type Config struct {
 	scraperhelper.ControllerConfig `mapstructure:",squash"`
 	confighttp.ClientConfig        `mapstructure:",squash"`
 	MetricsBuilderConfig           metadata.MetricsBuilderConfig `mapstructure:",squash"`
 
+	// APIType is the API the endpoint serves: stub_status, plus or vts.
+	APIType string `mapstructure:"api_type"`
+
 	// prevent unkeyed literal initialization
 	_ struct{}
 }
+
+func (cfg *Config) Validate() error {
+	switch cfg.APIType {
+	case "", apiTypeStubStatus, apiTypePlus, apiTypeVTS:
+		return nil
+	default:
+		return fmt.Errorf("invalid api_type %q, must be one of %q, %q or %q", cfg.APIType, apiTypeStubStatus, apiTypePlus, apiTypeVTS)
+	}
+}
@@ -27,3 +27,14 @@ func TestLoadConfig(t *testing.T) {
 
 	assert.Equal(t, factory.CreateDefaultConfig(), cfg)
 }
+
+func TestValidateAPIType(t *testing.T) {
+	cfg := NewFactory().CreateDefaultConfig().(*Config)
+	require.NoError(t, cfg.Validate())
+
+	cfg.APIType = apiTypePlus
+	require.NoError(t, cfg.Validate())
+
+	cfg.APIType = "status"
+	require.ErrorContains(t, cfg.Validate(), `invalid api_type "status"`)
+}
@@ -37,6 +37,7 @@ func createDefaultConfig() component.Config {
 		ControllerConfig:     cfg,
 		ClientConfig:         clientConfig,
 		MetricsBuilderConfig: metadata.DefaultMetricsBuilderConfig(),
+		APIType:              apiTypeStubStatus,
 	}
 }
 
@@ -27,10 +27,18 @@ func (ms *MetricConfig) Unmarshal(parser *confmap.Conf) error {
 
 // MetricsConfig provides config for nginx metrics.
 type MetricsConfig struct {
-	NginxConnectionsAccepted MetricConfig `mapstructure:"nginx.connections_accepted"`
-	NginxConnectionsCurrent  MetricConfig `mapstructure:"nginx.connections_current"`
-	NginxConnectionsHandled  MetricConfig `mapstructure:"nginx.connections_handled"`
-	NginxRequests            MetricConfig `mapstructure:"nginx.requests"`
+	NginxConnectionsAccepted      MetricConfig `mapstructure:"nginx.connections_accepted"`
+	NginxConnectionsCurrent       MetricConfig `mapstructure:"nginx.connections_current"`
+	NginxConnectionsHandled       MetricConfig `mapstructure:"nginx.connections_handled"`
+	NginxRequests                 MetricConfig `mapstructure:"nginx.requests"`
+	NginxServerZoneIo             MetricConfig `mapstructure:"nginx.server_zone.io"`
+	NginxServerZoneRequests       MetricConfig `mapstructure:"nginx.server_zone.requests"`
+	NginxServerZoneResponses      MetricConfig `mapstructure:"nginx.server_zone.responses"`
+	NginxUpstreamPeerHealthy      MetricConfig `mapstructure:"nginx.upstream.peer.healthy"`
+	NginxUpstreamPeerIo           MetricConfig `mapstructure:"nginx.upstream.peer.io"`
+	NginxUpstreamPeerRequests     MetricConfig `mapstructure:"nginx.upstream.peer.requests"`
+	NginxUpstreamPeerResponseTime MetricConfig `mapstructure:"nginx.upstream.peer.response_time"`
+	NginxUpstreamPeerResponses    MetricConfig `mapstructure:"nginx.upstream.peer.responses"`
 }
 
 func DefaultMetricsConfig() MetricsConfig {
@@ -47,6 +55,30 @@ func DefaultMetricsConfig() MetricsConfig {
 		NginxRequests: MetricConfig{
 			Enabled: true,
 		},
+		NginxServerZoneIo: MetricConfig{
+			Enabled: true,
+		},
+		NginxServerZoneRequests: MetricConfig{
+			Enabled: true,
+		},
+		NginxServerZoneResponses: MetricConfig{
+			Enabled: true,
+		},
+		NginxUpstreamPeerHealthy: MetricConfig{
+			Enabled: true,
+		},
+		NginxUpstreamPeerIo: MetricConfig{
+			Enabled: true,
+		},
+		NginxUpstreamPeerRequests: MetricConfig{
+			Enabled: true,
+		},
+		NginxUpstreamPeerResponseTime: MetricConfig{
+			Enabled: true,
+		},
+		NginxUpstreamPeerResponses: MetricConfig{
+			Enabled: true,
+		},
 	}
 }
 
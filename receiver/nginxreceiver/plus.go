@@ -0,0 +1,81 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package nginxreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/nginxreceiver"
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/nginxreceiver/internal/metadata"
+)
+
+// plusAPIVersion is the version of the NGINX Plus API queried. The server zones
+// and upstreams have the same layout in all the versions supported by the
+// currently maintained NGINX Plus releases.
+const plusAPIVersion = 6
+
+// plusServerZone is a server zone of the /http/server_zones endpoint.
+type plusServerZone struct {
+	Requests  int64           `json:"requests"`
+	Responses statusResponses `json:"responses"`
+	Received  int64           `json:"received"`
+	Sent      int64           `json:"sent"`
+}
+
+// plusUpstream is an upstream group of the /http/upstreams endpoint.
+type plusUpstream struct {
+	Peers []plusPeer `json:"peers"`
+}
+
+type plusPeer struct {
+	Server       string          `json:"server"`
+	State        string          `json:"state"`
+	Requests     int64           `json:"requests"`
+	Responses    statusResponses `json:"responses"`
+	Received     int64           `json:"received"`
+	Sent         int64           `json:"sent"`
+	ResponseTime int64           `json:"response_time"`
+}
+
+// scrapePlus collects the server zones and upstreams from the NGINX Plus API,
+// the endpoint is the base URL of the API, such as http://localhost:8080/api.
+func (r *nginxScraper) scrapePlus(ctx context.Context) error {
+	baseURL := fmt.Sprintf("%s/%d", strings.TrimSuffix(r.cfg.Endpoint, "/"), plusAPIVersion)
+
+	var serverZones map[string]plusServerZone
+	if err := r.getJSON(ctx, baseURL+"/http/server_zones", &serverZones); err != nil {
+		return err
+	}
+	var upstreams map[string]plusUpstream
+	if err := r.getJSON(ctx, baseURL+"/http/upstreams", &upstreams); err != nil {
+		return err
+	}
+
+	now := pcommon.NewTimestampFromTime(time.Now())
+	for zone, stats := range serverZones {
+		r.mb.RecordNginxServerZoneRequestsDataPoint(now, stats.Requests, zone)
+		stats.Responses.record(func(val int64, statusRange metadata.AttributeStatusRange) {
+			r.mb.RecordNginxServerZoneResponsesDataPoint(now, val, zone, statusRange)
+		})
+		r.mb.RecordNginxServerZoneIoDataPoint(now, stats.Received, zone, metadata.AttributeDirectionReceived)
+		r.mb.RecordNginxServerZoneIoDataPoint(now, stats.Sent, zone, metadata.AttributeDirectionSent)
+	}
+	for upstream, group := range upstreams {
+		for _, peer := range group.Peers {
+			r.mb.RecordNginxUpstreamPeerRequestsDataPoint(now, peer.Requests, upstream, peer.Server)
+			peer.Responses.record(func(val int64, statusRange metadata.AttributeStatusRange) {
+				r.mb.RecordNginxUpstreamPeerResponsesDataPoint(now, val, upstream, peer.Server, statusRange)
+			})
+			r.mb.RecordNginxUpstreamPeerIoDataPoint(now, peer.Received, upstream, peer.Server, metadata.AttributeDirectionReceived)
+			r.mb.RecordNginxUpstreamPeerIoDataPoint(now, peer.Sent, upstream, peer.Server, metadata.AttributeDirectionSent)
+			r.mb.RecordNginxUpstreamPeerResponseTimeDataPoint(now, peer.ResponseTime, upstream, peer.Server)
+			r.mb.RecordNginxUpstreamPeerHealthyDataPoint(now, boolToInt64(peer.State == "up"), upstream, peer.Server)
+		}
+	}
+	return nil
+}
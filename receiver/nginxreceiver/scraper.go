@@ -5,6 +5,9 @@ package nginxreceiver // import "github.com/open-telemetry/opentelemetry-collect
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
 	"time"
 
 	"github.com/nginx/nginx-prometheus-exporter/client"
@@ -18,10 +21,11 @@ import (
 )
 
 type nginxScraper struct {
-	client   *client.NginxClient
-	settings component.TelemetrySettings
-	cfg      *Config
-	mb       *metadata.MetricsBuilder
+	httpClient *http.Client
+	client     *client.NginxClient
+	settings   component.TelemetrySettings
+	cfg        *Config
+	mb         *metadata.MetricsBuilder
 }
 
 func newNginxScraper(
@@ -41,16 +45,33 @@ func (r *nginxScraper) start(ctx context.Context, host component.Host) error {
 	if err != nil {
 		return err
 	}
+	r.httpClient = httpClient
 	r.client = client.NewNginxClient(httpClient, r.cfg.Endpoint)
 	return nil
 }
 
-func (r *nginxScraper) scrape(context.Context) (pmetric.Metrics, error) {
-	stats, err := r.client.GetStubStats()
+func (r *nginxScraper) scrape(ctx context.Context) (pmetric.Metrics, error) {
+	var err error
+	switch r.cfg.APIType {
+	case apiTypePlus:
+		err = r.scrapePlus(ctx)
+	case apiTypeVTS:
+		err = r.scrapeVTS(ctx)
+	default:
+		err = r.scrapeStubStatus()
+	}
 	if err != nil {
 		r.settings.Logger.Error("Failed to fetch nginx stats", zap.Error(err))
 		return pmetric.Metrics{}, err
 	}
+	return r.mb.Emit(), nil
+}
+
+func (r *nginxScraper) scrapeStubStatus() error {
+	stats, err := r.client.GetStubStats()
+	if err != nil {
+		return err
+	}
 
 	now := pcommon.NewTimestampFromTime(time.Now())
 	r.mb.RecordNginxRequestsDataPoint(now, stats.Requests)
@@ -60,5 +81,51 @@ func (r *nginxScraper) scrape(context.Context) (pmetric.Metrics, error) {
 	r.mb.RecordNginxConnectionsCurrentDataPoint(now, stats.Connections.Reading, metadata.AttributeStateReading)
 	r.mb.RecordNginxConnectionsCurrentDataPoint(now, stats.Connections.Writing, metadata.AttributeStateWriting)
 	r.mb.RecordNginxConnectionsCurrentDataPoint(now, stats.Connections.Waiting, metadata.AttributeStateWaiting)
-	return r.mb.Emit(), nil
+	return nil
+}
+
+// getJSON decodes the JSON document served at url into v.
+func (r *nginxScraper) getJSON(ctx context.Context, url string, v any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, http.NoBody)
+	if err != nil {
+		return fmt.Errorf("failed to create request for %s: %w", url, err)
+	}
+	resp, err := r.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to get %s: %w", url, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("expected 200 response, got %d", resp.StatusCode)
+	}
+	if err := json.NewDecoder(resp.Body).Decode(v); err != nil {
+		return fmt.Errorf("failed to decode the response of %s: %w", url, err)
+	}
+	return nil
+}
+
+// statusResponses is the number of responses by status code class, as reported
+// by both NGINX Plus and the VTS module.
+type statusResponses struct {
+	Responses1xx int64 `json:"1xx"`
+	Responses2xx int64 `json:"2xx"`
+	Responses3xx int64 `json:"3xx"`
+	Responses4xx int64 `json:"4xx"`
+	Responses5xx int64 `json:"5xx"`
+}
+
+func (s statusResponses) record(record func(int64, metadata.AttributeStatusRange)) {
+	record(s.Responses1xx, metadata.AttributeStatusRange1xx)
+	record(s.Responses2xx, metadata.AttributeStatusRange2xx)
+	record(s.Responses3xx, metadata.AttributeStatusRange3xx)
+	record(s.Responses4xx, metadata.AttributeStatusRange4xx)
+	record(s.Responses5xx, metadata.AttributeStatusRange5xx)
+}
+
+func boolToInt64(b bool) int64 {
+	if b {
+		return 1
+	}
+	return 0
 }
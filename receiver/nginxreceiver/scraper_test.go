@@ -8,6 +8,7 @@ import (
 	"errors"
 	"net/http"
 	"net/http/httptest"
+	"os"
 	"path/filepath"
 	"testing"
 
@@ -17,6 +18,7 @@ import (
 	"go.opentelemetry.io/collector/config/confighttp"
 	"go.opentelemetry.io/collector/config/configtls"
 	"go.opentelemetry.io/collector/confmap/xconfmap"
+	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/receiver/receivertest"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/golden"
@@ -101,6 +103,124 @@ func TestScraperFailedStart(t *testing.T) {
 	require.Error(t, err)
 }
 
+func TestScraperPlusAndVTS(t *testing.T) {
+	files := map[string]string{
+		"/api/6/http/server_zones": "plus_server_zones.json",
+		"/api/6/http/upstreams":    "plus_upstreams.json",
+		"/status/format/json":      "vts_status.json",
+	}
+	nginxMock := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		file, ok := files[req.URL.Path]
+		if !ok {
+			rw.WriteHeader(http.StatusNotFound)
+			return
+		}
+		data, err := os.ReadFile(filepath.Join("testdata", "scraper", file))
+		assert.NoError(t, err)
+		_, err = rw.Write(data)
+		assert.NoError(t, err)
+	}))
+	defer nginxMock.Close()
+
+	tests := []struct {
+		name         string
+		apiType      string
+		endpoint     string
+		zone         string
+		zoneRequests int64
+		zone5xx      int64
+		zoneSent     int64
+		upstream     string
+		peer         string
+		peerRequests int64
+		peerReceived int64
+		responseTime int64
+		unhealthy    string
+	}{
+		{
+			name:         "plus",
+			apiType:      apiTypePlus,
+			endpoint:     nginxMock.URL + "/api",
+			zone:         "hg.nginx.org",
+			zoneRequests: 175276,
+			zone5xx:      86,
+			zoneSent:     5296913612,
+			upstream:     "trac-backend",
+			peer:         "10.0.0.1:8080",
+			peerRequests: 25006,
+			peerReceived: 1032491825,
+			responseTime: 42,
+			unhealthy:    "10.0.0.2:8080",
+		},
+		{
+			name:         "vts",
+			apiType:      apiTypeVTS,
+			endpoint:     nginxMock.URL + "/status/format/json",
+			zone:         "example.com",
+			zoneRequests: 300,
+			zone5xx:      3,
+			zoneSent:     1048576,
+			upstream:     "backend",
+			peer:         "10.0.0.1:8080",
+			peerRequests: 150,
+			peerReceived: 524288,
+			responseTime: 27,
+			unhealthy:    "10.0.0.2:8080",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := createDefaultConfig().(*Config)
+			cfg.Endpoint = tt.endpoint
+			cfg.APIType = tt.apiType
+			require.NoError(t, xconfmap.Validate(cfg))
+
+			scraper := newNginxScraper(receivertest.NewNopSettings(metadata.Type), cfg)
+			require.NoError(t, scraper.start(context.Background(), componenttest.NewNopHost()))
+			md, err := scraper.scrape(context.Background())
+			require.NoError(t, err)
+
+			metrics := map[string]pmetric.Metric{}
+			ms := md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+			for i := 0; i < ms.Len(); i++ {
+				metrics[ms.At(i).Name()] = ms.At(i)
+			}
+			assert.NotContains(t, metrics, "nginx.requests")
+
+			// The VTS zone aggregating all the server zones is skipped.
+			require.Equal(t, 1, metrics["nginx.server_zone.requests"].Sum().DataPoints().Len())
+			assert.Equal(t, tt.zoneRequests, findDataPoint(t, metrics["nginx.server_zone.requests"].Sum().DataPoints(), map[string]string{"zone": tt.zone}).IntValue())
+			assert.Equal(t, tt.zone5xx, findDataPoint(t, metrics["nginx.server_zone.responses"].Sum().DataPoints(), map[string]string{"zone": tt.zone, "status_range": "5xx"}).IntValue())
+			assert.Equal(t, tt.zoneSent, findDataPoint(t, metrics["nginx.server_zone.io"].Sum().DataPoints(), map[string]string{"zone": tt.zone, "direction": "sent"}).IntValue())
+
+			peer := map[string]string{"upstream": tt.upstream, "peer": tt.peer}
+			assert.Equal(t, tt.peerRequests, findDataPoint(t, metrics["nginx.upstream.peer.requests"].Sum().DataPoints(), peer).IntValue())
+			assert.Equal(t, tt.peerReceived, findDataPoint(t, metrics["nginx.upstream.peer.io"].Sum().DataPoints(), map[string]string{"upstream": tt.upstream, "peer": tt.peer, "direction": "received"}).IntValue())
+			assert.Equal(t, tt.responseTime, findDataPoint(t, metrics["nginx.upstream.peer.response_time"].Gauge().DataPoints(), peer).IntValue())
+			assert.Equal(t, int64(1), findDataPoint(t, metrics["nginx.upstream.peer.healthy"].Gauge().DataPoints(), peer).IntValue())
+			assert.Equal(t, int64(0), findDataPoint(t, metrics["nginx.upstream.peer.healthy"].Gauge().DataPoints(), map[string]string{"upstream": tt.upstream, "peer": tt.unhealthy}).IntValue())
+		})
+	}
+}
+
+func findDataPoint(t *testing.T, dps pmetric.NumberDataPointSlice, attrs map[string]string) pmetric.NumberDataPoint {
+	for i := 0; i < dps.Len(); i++ {
+		dp := dps.At(i)
+		matches := true
+		for k, v := range attrs {
+			if val, ok := dp.Attributes().Get(k); !ok || val.Str() != v {
+				matches = false
+				break
+			}
+		}
+		if matches {
+			return dp
+		}
+	}
+	require.Failf(t, "data point not found", "attributes: %v", attrs)
+	return pmetric.NewNumberDataPoint()
+}
+
 func newMockServer(t *testing.T) *httptest.Server {
 	return httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
 		if req.URL.Path == "/status" {
@@ -0,0 +1,74 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package nginxreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/nginxreceiver"
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/nginxreceiver/internal/metadata"
+)
+
+// vtsAllZones is the server zone aggregating all the others in the VTS status.
+const vtsAllZones = "*"
+
+// vtsStatus is the JSON status of the nginx-module-vts module.
+type vtsStatus struct {
+	ServerZones   map[string]vtsServerZone       `json:"serverZones"`
+	UpstreamZones map[string][]vtsUpstreamServer `json:"upstreamZones"`
+}
+
+type vtsServerZone struct {
+	RequestCounter int64           `json:"requestCounter"`
+	InBytes        int64           `json:"inBytes"`
+	OutBytes       int64           `json:"outBytes"`
+	Responses      statusResponses `json:"responses"`
+}
+
+type vtsUpstreamServer struct {
+	Server         string          `json:"server"`
+	RequestCounter int64           `json:"requestCounter"`
+	InBytes        int64           `json:"inBytes"`
+	OutBytes       int64           `json:"outBytes"`
+	Responses      statusResponses `json:"responses"`
+	ResponseMsec   int64           `json:"responseMsec"`
+	Down           bool            `json:"down"`
+}
+
+// scrapeVTS collects the server zones and upstreams from the VTS module, the
+// endpoint is the URL of the JSON status, such as http://localhost/status/format/json.
+func (r *nginxScraper) scrapeVTS(ctx context.Context) error {
+	var status vtsStatus
+	if err := r.getJSON(ctx, r.cfg.Endpoint, &status); err != nil {
+		return err
+	}
+
+	now := pcommon.NewTimestampFromTime(time.Now())
+	for zone, stats := range status.ServerZones {
+		if zone == vtsAllZones {
+			continue
+		}
+		r.mb.RecordNginxServerZoneRequestsDataPoint(now, stats.RequestCounter, zone)
+		stats.Responses.record(func(val int64, statusRange metadata.AttributeStatusRange) {
+			r.mb.RecordNginxServerZoneResponsesDataPoint(now, val, zone, statusRange)
+		})
+		r.mb.RecordNginxServerZoneIoDataPoint(now, stats.InBytes, zone, metadata.AttributeDirectionReceived)
+		r.mb.RecordNginxServerZoneIoDataPoint(now, stats.OutBytes, zone, metadata.AttributeDirectionSent)
+	}
+	for upstream, servers := range status.UpstreamZones {
+		for _, server := range servers {
+			r.mb.RecordNginxUpstreamPeerRequestsDataPoint(now, server.RequestCounter, upstream, server.Server)
+			server.Responses.record(func(val int64, statusRange metadata.AttributeStatusRange) {
+				r.mb.RecordNginxUpstreamPeerResponsesDataPoint(now, val, upstream, server.Server, statusRange)
+			})
+			r.mb.RecordNginxUpstreamPeerIoDataPoint(now, server.InBytes, upstream, server.Server, metadata.AttributeDirectionReceived)
+			r.mb.RecordNginxUpstreamPeerIoDataPoint(now, server.OutBytes, upstream, server.Server, metadata.AttributeDirectionSent)
+			r.mb.RecordNginxUpstreamPeerResponseTimeDataPoint(now, server.ResponseMsec, upstream, server.Server)
+			r.mb.RecordNginxUpstreamPeerHealthyDataPoint(now, boolToInt64(!server.Down), upstream, server.Server)
+		}
+	}
+	return nil
+}
@@ -4,7 +4,9 @@
 package otelarrowreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/otelarrowreceiver"
 
 import (
+	"errors"
 	"fmt"
+	"time"
 
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/config/configgrpc"
@@ -34,10 +36,52 @@ type AdmissionConfig struct {
 	// unexpectedly large amount of memory in the arrow receiver.
 	WaitingLimitMiB uint64 `mapstructure:"waiting_limit_mib"`
 
+	// RetryDelay is returned to clients, as gRPC RetryInfo, with requests that
+	// were rejected because too much data is waiting.  Exporters that honor
+	// RetryInfo wait for this delay before retrying, otherwise the rejection is
+	// permanent.  When this field is zero, no RetryInfo is returned.
+	RetryDelay time.Duration `mapstructure:"retry_delay"`
+
+	// Tenant configures admission limits applied per tenant, in addition to the
+	// limits above, which are shared by all tenants.
+	Tenant TenantAdmissionConfig `mapstructure:"tenant"`
+
+	// prevent unkeyed literal initialization
+	_ struct{}
+}
+
+// TenantAdmissionConfig configures per-tenant admission limits.
+type TenantAdmissionConfig struct {
+	// Header is the request metadata key that identifies the tenant.  Requests
+	// without this header are only subject to the shared limits.  When this
+	// field is empty, per-tenant admission control is disabled.
+	Header string `mapstructure:"header"`
+
+	// TenantLimits are the default limits of each tenant.
+	TenantLimits `mapstructure:",squash"`
+
+	// Overrides are the limits of specific tenants, keyed by header value.
+	Overrides map[string]TenantLimits `mapstructure:"overrides"`
+
+	// MaxTenants limits the number of tenants with their own limits.  Tenants
+	// beyond this number share a single set of default limits.
+	MaxTenants int `mapstructure:"max_tenants"`
+
 	// prevent unkeyed literal initialization
 	_ struct{}
 }
 
+// TenantLimits are the admission limits of a tenant.
+type TenantLimits struct {
+	// RequestLimitMiB limits the uncompressed size of the tenant's requests
+	// being processed.  When this field is zero, the tenant is not limited.
+	RequestLimitMiB uint64 `mapstructure:"request_limit_mib"`
+
+	// WaitingLimitMiB limits the uncompressed size of the tenant's requests
+	// waiting to be processed.
+	WaitingLimitMiB uint64 `mapstructure:"waiting_limit_mib"`
+}
+
 // ArrowConfig support configuring the Arrow receiver.
 type ArrowConfig struct {
 	// MemoryLimitMiB is the size of a shared memory region used
@@ -72,6 +116,7 @@ type Config struct {
 var (
 	_ component.Config   = (*Config)(nil)
 	_ xconfmap.Validator = (*ArrowConfig)(nil)
+	_ xconfmap.Validator = (*AdmissionConfig)(nil)
 )
 
 func (cfg *ArrowConfig) Validate() error {
@@ -88,6 +133,29 @@ func (cfg *Config) Validate() error {
 	if err := cfg.Arrow.Validate(); err != nil {
 		return err
 	}
+	if err := cfg.Admission.Validate(); err != nil {
+		return err
+	}
+	if cfg.Admission.Tenant.Header != "" && !cfg.GRPC.IncludeMetadata {
+		return errors.New("admission.tenant.header requires protocols.grpc.include_metadata")
+	}
+	return nil
+}
+
+func (cfg *AdmissionConfig) Validate() error {
+	if cfg.RetryDelay < 0 {
+		return fmt.Errorf("admission.retry_delay must not be negative: %v", cfg.RetryDelay)
+	}
+	tenant := &cfg.Tenant
+	if tenant.Header == "" {
+		if len(tenant.Overrides) != 0 {
+			return errors.New("admission.tenant.overrides requires admission.tenant.header")
+		}
+		return nil
+	}
+	if tenant.MaxTenants < 0 {
+		return fmt.Errorf("admission.tenant.max_tenants must not be negative: %d", tenant.MaxTenants)
+	}
 	return nil
 }
 
@@ -4,12 +4,14 @@
 package otelarrowreceiver
 
 import (
+	"context"
 	"path/filepath"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/client"
 	"go.opentelemetry.io/collector/config/configgrpc"
 	"go.opentelemetry.io/collector/config/confignet"
 	"go.opentelemetry.io/collector/config/configoptional"
@@ -84,6 +86,9 @@ func TestUnmarshalConfig(t *testing.T) {
 			Admission: AdmissionConfig{
 				RequestLimitMiB: 80,
 				WaitingLimitMiB: 100,
+				Tenant: TenantAdmissionConfig{
+					MaxTenants: defaultMaxTenants,
+				},
 			},
 		}, cfg)
 }
@@ -134,10 +139,95 @@ func TestUnmarshalConfigUnix(t *testing.T) {
 			Admission: AdmissionConfig{
 				RequestLimitMiB: defaultRequestLimitMiB,
 				WaitingLimitMiB: defaultWaitingLimitMiB,
+				Tenant: TenantAdmissionConfig{
+					MaxTenants: defaultMaxTenants,
+				},
 			},
 		}, cfg)
 }
 
+func TestUnmarshalConfigAdmission(t *testing.T) {
+	cm, err := confmaptest.LoadConf(filepath.Join("testdata", "admission.yaml"))
+	require.NoError(t, err)
+	factory := NewFactory()
+	cfg := factory.CreateDefaultConfig()
+	require.NoError(t, cm.Unmarshal(cfg))
+	require.NoError(t, xconfmap.Validate(cfg))
+	assert.Equal(t,
+		AdmissionConfig{
+			RequestLimitMiB: 256,
+			WaitingLimitMiB: 64,
+			RetryDelay:      2 * time.Second,
+			Tenant: TenantAdmissionConfig{
+				Header: "x-tenant-id",
+				TenantLimits: TenantLimits{
+					RequestLimitMiB: 32,
+					WaitingLimitMiB: 8,
+				},
+				MaxTenants: 50,
+				Overrides: map[string]TenantLimits{
+					"big-tenant": {
+						RequestLimitMiB: 128,
+						WaitingLimitMiB: 32,
+					},
+				},
+			},
+		}, cfg.(*Config).Admission)
+
+	settings := tenantSettings(cfg.(*Config).Admission.Tenant)
+	assert.Equal(t, uint64(32<<20), settings.Default.RequestLimit)
+	assert.Equal(t, uint64(128<<20), settings.Overrides["big-tenant"].RequestLimit)
+	assert.Equal(t, 50, settings.MaxTenants)
+
+	ctx := client.NewContext(context.Background(), client.Info{
+		Metadata: client.NewMetadata(map[string][]string{"x-tenant-id": {"big-tenant"}}),
+	})
+	assert.Equal(t, "big-tenant", settings.Tenant(ctx))
+	assert.Empty(t, settings.Tenant(context.Background()))
+}
+
+func TestValidateAdmission(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(*Config)
+		err    string
+	}{
+		{
+			name:   "negative retry delay",
+			modify: func(cfg *Config) { cfg.Admission.RetryDelay = -time.Second },
+			err:    "admission.retry_delay must not be negative: -1s",
+		},
+		{
+			name: "overrides without header",
+			modify: func(cfg *Config) {
+				cfg.Admission.Tenant.Overrides = map[string]TenantLimits{"a": {RequestLimitMiB: 1}}
+			},
+			err: "admission.tenant.overrides requires admission.tenant.header",
+		},
+		{
+			name: "negative max tenants",
+			modify: func(cfg *Config) {
+				cfg.GRPC.IncludeMetadata = true
+				cfg.Admission.Tenant.Header = "x-tenant-id"
+				cfg.Admission.Tenant.MaxTenants = -1
+			},
+			err: "admission.tenant.max_tenants must not be negative: -1",
+		},
+		{
+			name:   "header without include_metadata",
+			modify: func(cfg *Config) { cfg.Admission.Tenant.Header = "x-tenant-id" },
+			err:    "admission.tenant.header requires protocols.grpc.include_metadata",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := NewFactory().CreateDefaultConfig().(*Config)
+			tt.modify(cfg)
+			assert.EqualError(t, cfg.Validate(), tt.err)
+		})
+	}
+}
+
 func TestUnmarshalConfigTypoDefaultProtocol(t *testing.T) {
 	cm, err := confmaptest.LoadConf(filepath.Join("testdata", "typo_default_proto_config.yaml"))
 	require.NoError(t, err)
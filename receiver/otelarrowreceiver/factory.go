@@ -22,6 +22,7 @@ const (
 	defaultMemoryLimitMiB  = 128
 	defaultRequestLimitMiB = 128
 	defaultWaitingLimitMiB = 32
+	defaultMaxTenants      = 100
 )
 
 // NewFactory creates a new OTel-Arrow receiver factory.
@@ -53,6 +54,9 @@ func createDefaultConfig() component.Config {
 		Admission: AdmissionConfig{
 			RequestLimitMiB: defaultRequestLimitMiB,
 			WaitingLimitMiB: defaultWaitingLimitMiB,
+			Tenant: TenantAdmissionConfig{
+				MaxTenants: defaultMaxTenants,
+			},
 		},
 	}
 }
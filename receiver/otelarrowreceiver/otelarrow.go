@@ -10,6 +10,7 @@ import (
 
 	arrowpb "github.com/open-telemetry/otel-arrow/go/api/experimental/arrow/v1"
 	arrowRecord "github.com/open-telemetry/otel-arrow/go/pkg/otel/arrow_record"
+	"go.opentelemetry.io/collector/client"
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/component/componentstatus"
 	"go.opentelemetry.io/collector/config/configgrpc"
@@ -50,6 +51,32 @@ type otelArrowReceiver struct {
 	settings receiver.Settings
 }
 
+// tenantSettings converts the per-tenant admission configuration into
+// the settings of an admission2.TenantQueue.
+func tenantSettings(cfg TenantAdmissionConfig) admission2.TenantSettings {
+	limits := func(l TenantLimits) admission2.TenantLimits {
+		return admission2.TenantLimits{
+			RequestLimit: l.RequestLimitMiB << 20,
+			WaitingLimit: l.WaitingLimitMiB << 20,
+		}
+	}
+	overrides := make(map[string]admission2.TenantLimits, len(cfg.Overrides))
+	for tenant, l := range cfg.Overrides {
+		overrides[tenant] = limits(l)
+	}
+	return admission2.TenantSettings{
+		Tenant: func(ctx context.Context) string {
+			if values := client.FromContext(ctx).Metadata.Get(cfg.Header); len(values) != 0 {
+				return values[0]
+			}
+			return ""
+		},
+		Default:    limits(cfg.TenantLimits),
+		Overrides:  overrides,
+		MaxTenants: cfg.MaxTenants,
+	}
+}
+
 // newOTelArrowReceiver just creates the OpenTelemetry receiver services. It is the caller's
 // responsibility to invoke the respective Start*Reception methods as well
 // as the various Stop*Reception methods to end it.
@@ -75,6 +102,10 @@ func newOTelArrowReceiver(cfg *Config, set receiver.Settings) (*otelArrowReceive
 			return nil, err
 		}
 	}
+	if tenant := cfg.Admission.Tenant; tenant.Header != "" {
+		bq = admission2.NewTenantQueue(set.ID, set.TelemetrySettings, bq, tenantSettings(tenant))
+	}
+	bq = admission2.WithRetryDelay(bq, cfg.Admission.RetryDelay)
 	r := &otelArrowReceiver{
 		cfg:          cfg,
 		settings:     set,
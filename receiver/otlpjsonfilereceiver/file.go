@@ -5,9 +5,11 @@ package otlpjsonfilereceiver // import "github.com/open-telemetry/opentelemetry-
 
 import (
 	"context"
+	"errors"
 
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/consumer/consumererror"
 	"go.opentelemetry.io/collector/consumer/xconsumer"
 	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/plog"
@@ -42,11 +44,33 @@ type Config struct {
 	fileconsumer.Config `mapstructure:",squash"`
 	StorageID           *component.ID `mapstructure:"storage"`
 	ReplayFile          bool          `mapstructure:"replay_file"`
+	// RetryOnFailure configures reading the data refused by the pipeline again.
+	RetryOnFailure RetryOnFailureConfig `mapstructure:"retry_on_failure"`
+}
+
+// RetryOnFailureConfig configures reading the data refused by the pipeline again on the
+// next poll, instead of dropping it.
+type RetryOnFailureConfig struct {
+	Enabled bool `mapstructure:"enabled"`
+	// MaxAttempts is the number of times in a row the data is refused before it is dropped.
+	MaxAttempts int `mapstructure:"max_attempts"`
+}
+
+const defaultMaxAttempts = 10
+
+func (c *Config) Validate() error {
+	if c.RetryOnFailure.Enabled && c.RetryOnFailure.MaxAttempts <= 0 {
+		return errors.New("retry_on_failure::max_attempts must be greater than zero")
+	}
+	return nil
 }
 
 func createDefaultConfig() component.Config {
 	return &Config{
 		Config: *fileconsumer.NewConfig(),
+		RetryOnFailure: RetryOnFailureConfig{
+			MaxAttempts: defaultMaxAttempts,
+		},
 	}
 }
 
@@ -79,10 +103,7 @@ func createLogsReceiver(_ context.Context, settings receiver.Settings, configura
 		return nil, err
 	}
 	cfg := configuration.(*Config)
-	opts := make([]fileconsumer.Option, 0)
-	if cfg.ReplayFile {
-		opts = append(opts, fileconsumer.WithNoTracking())
-	}
+	opts := fileconsumerOptions(cfg)
 	input, err := cfg.Build(settings.TelemetrySettings, func(ctx context.Context, tokens [][]byte, attributes map[string]any, _ int64, _ []int64) error {
 		for _, token := range tokens {
 			ctx = obsrecv.StartLogsOp(ctx)
@@ -107,6 +128,9 @@ func createLogsReceiver(_ context.Context, settings receiver.Settings, configura
 					err = logs.ConsumeLogs(ctx, l)
 				}
 				obsrecv.EndLogsOp(ctx, metadata.Type.String(), logRecordCount, err)
+				if cfg.retryable(err) {
+					return err
+				}
 			}
 		}
 		return nil
@@ -129,10 +153,7 @@ func createMetricsReceiver(_ context.Context, settings receiver.Settings, config
 		return nil, err
 	}
 	cfg := configuration.(*Config)
-	opts := make([]fileconsumer.Option, 0)
-	if cfg.ReplayFile {
-		opts = append(opts, fileconsumer.WithNoTracking())
-	}
+	opts := fileconsumerOptions(cfg)
 	input, err := cfg.Build(settings.TelemetrySettings, func(ctx context.Context, tokens [][]byte, attributes map[string]any, _ int64, _ []int64) error {
 		for _, token := range tokens {
 			ctx = obsrecv.StartMetricsOp(ctx)
@@ -156,6 +177,9 @@ func createMetricsReceiver(_ context.Context, settings receiver.Settings, config
 					err = metrics.ConsumeMetrics(ctx, m)
 				}
 				obsrecv.EndMetricsOp(ctx, metadata.Type.String(), m.MetricCount(), err)
+				if cfg.retryable(err) {
+					return err
+				}
 			}
 		}
 		return nil
@@ -178,10 +202,7 @@ func createTracesReceiver(_ context.Context, settings receiver.Settings, configu
 		return nil, err
 	}
 	cfg := configuration.(*Config)
-	opts := make([]fileconsumer.Option, 0)
-	if cfg.ReplayFile {
-		opts = append(opts, fileconsumer.WithNoTracking())
-	}
+	opts := fileconsumerOptions(cfg)
 	input, err := cfg.Build(settings.TelemetrySettings, func(ctx context.Context, tokens [][]byte, attributes map[string]any, _ int64, _ []int64) error {
 		for _, token := range tokens {
 			ctx = obsrecv.StartTracesOp(ctx)
@@ -205,6 +226,9 @@ func createTracesReceiver(_ context.Context, settings receiver.Settings, configu
 					err = traces.ConsumeTraces(ctx, t)
 				}
 				obsrecv.EndTracesOp(ctx, metadata.Type.String(), t.SpanCount(), err)
+				if cfg.retryable(err) {
+					return err
+				}
 			}
 		}
 		return nil
@@ -219,16 +243,15 @@ func createTracesReceiver(_ context.Context, settings receiver.Settings, configu
 func createProfilesReceiver(_ context.Context, settings receiver.Settings, configuration component.Config, profiles xconsumer.Profiles) (xreceiver.Profiles, error) {
 	profilesUnmarshaler := &pprofile.JSONUnmarshaler{}
 	cfg := configuration.(*Config)
-	opts := make([]fileconsumer.Option, 0)
-	if cfg.ReplayFile {
-		opts = append(opts, fileconsumer.WithNoTracking())
-	}
+	opts := fileconsumerOptions(cfg)
 	input, err := cfg.Build(settings.TelemetrySettings, func(ctx context.Context, tokens [][]byte, _ map[string]any, _ int64, _ []int64) error {
 		for _, token := range tokens {
 			p, _ := profilesUnmarshaler.UnmarshalProfiles(token)
 			// TODO Append token.Attributes
 			if p.ResourceProfiles().Len() != 0 {
-				_ = profiles.ConsumeProfiles(ctx, p)
+				if err := profiles.ConsumeProfiles(ctx, p); cfg.retryable(err) {
+					return err
+				}
 			}
 		}
 		return nil
@@ -240,6 +263,24 @@ func createProfilesReceiver(_ context.Context, settings receiver.Settings, confi
 	return &otlpjsonfilereceiver{input: input, id: settings.ID, storageID: cfg.StorageID}, nil
 }
 
+func fileconsumerOptions(cfg *Config) []fileconsumer.Option {
+	opts := make([]fileconsumer.Option, 0)
+	if cfg.RetryOnFailure.Enabled {
+		// Data refused by the pipeline is read again on the next poll rather than dropped,
+		// so that the files act as a buffer in front of the pipeline.
+		opts = append(opts, fileconsumer.WithRetryOnEmitError(cfg.RetryOnFailure.MaxAttempts))
+	}
+	if cfg.ReplayFile {
+		opts = append(opts, fileconsumer.WithNoTracking())
+	}
+	return opts
+}
+
+// retryable reports whether the data must be read again because the pipeline failed to consume it.
+func (c *Config) retryable(err error) bool {
+	return c.RetryOnFailure.Enabled && err != nil && !consumererror.IsPermanent(err)
+}
+
 func appendToMap(attributes map[string]any, attr pcommon.Map) {
 	for key, value := range attributes {
 		switch v := value.(type) {
@@ -5,8 +5,10 @@ package otlpjsonfilereceiver // import "github.com/open-telemetry/opentelemetry-
 
 import (
 	"context"
+	"errors"
 	"os"
 	"path/filepath"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -15,6 +17,8 @@ import (
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/component/componenttest"
 	"go.opentelemetry.io/collector/confmap/confmaptest"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/consumer/consumererror"
 	"go.opentelemetry.io/collector/consumer/consumertest"
 	"go.opentelemetry.io/collector/pdata/plog"
 	"go.opentelemetry.io/collector/pdata/pmetric"
@@ -193,6 +197,113 @@ func TestFileLogsReceiver(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestFileLogsReceiverRetriesRefusedData(t *testing.T) {
+	tempFolder := t.TempDir()
+	factory := NewFactory()
+	cfg := createDefaultConfig().(*Config)
+	cfg.Include = []string{filepath.Join(tempFolder, "*")}
+	cfg.StartAt = "beginning"
+	cfg.PollInterval = 10 * time.Millisecond
+	cfg.RetryOnFailure.Enabled = true
+	sink := new(consumertest.LogsSink)
+	var calls atomic.Int64
+	next, err := consumer.NewLogs(func(ctx context.Context, ld plog.Logs) error {
+		if calls.Add(1) <= 2 {
+			return errors.New("pipeline is full")
+		}
+		return sink.ConsumeLogs(ctx, ld)
+	})
+	require.NoError(t, err)
+	receiver, err := factory.CreateLogs(context.Background(), receivertest.NewNopSettings(metadata.Type), cfg, next)
+	require.NoError(t, err)
+	require.NoError(t, receiver.Start(context.Background(), nil))
+	defer func() {
+		assert.NoError(t, receiver.Shutdown(context.Background()))
+	}()
+
+	b, err := (&plog.JSONMarshaler{}).MarshalLogs(testdata.GenerateLogs(1))
+	require.NoError(t, err)
+	b = append(b, '\n')
+	require.NoError(t, os.WriteFile(filepath.Join(tempFolder, "logs.json"), b, 0o600))
+
+	require.Eventually(t, func() bool {
+		return sink.LogRecordCount() == 1
+	}, 5*time.Second, 10*time.Millisecond)
+	assert.GreaterOrEqual(t, calls.Load(), int64(3))
+}
+
+func TestFileLogsReceiverDropsPermanentlyRefusedData(t *testing.T) {
+	tempFolder := t.TempDir()
+	factory := NewFactory()
+	cfg := createDefaultConfig().(*Config)
+	cfg.Include = []string{filepath.Join(tempFolder, "*")}
+	cfg.StartAt = "beginning"
+	cfg.PollInterval = 10 * time.Millisecond
+	cfg.RetryOnFailure.Enabled = true
+	var calls atomic.Int64
+	next, err := consumer.NewLogs(func(context.Context, plog.Logs) error {
+		calls.Add(1)
+		return consumererror.NewPermanent(errors.New("invalid data"))
+	})
+	require.NoError(t, err)
+	receiver, err := factory.CreateLogs(context.Background(), receivertest.NewNopSettings(metadata.Type), cfg, next)
+	require.NoError(t, err)
+	require.NoError(t, receiver.Start(context.Background(), nil))
+
+	b, err := (&plog.JSONMarshaler{}).MarshalLogs(testdata.GenerateLogs(1))
+	require.NoError(t, err)
+	b = append(b, '\n')
+	require.NoError(t, os.WriteFile(filepath.Join(tempFolder, "logs.json"), b, 0o600))
+
+	require.Eventually(t, func() bool {
+		return calls.Load() == 1
+	}, 5*time.Second, 10*time.Millisecond)
+	time.Sleep(100 * time.Millisecond)
+	require.NoError(t, receiver.Shutdown(context.Background()))
+	assert.Equal(t, int64(1), calls.Load())
+}
+
+func TestFileLogsReceiverDropsRefusedDataByDefault(t *testing.T) {
+	tempFolder := t.TempDir()
+	factory := NewFactory()
+	cfg := createDefaultConfig().(*Config)
+	cfg.Include = []string{filepath.Join(tempFolder, "*")}
+	cfg.StartAt = "beginning"
+	cfg.PollInterval = 10 * time.Millisecond
+	var calls atomic.Int64
+	next, err := consumer.NewLogs(func(context.Context, plog.Logs) error {
+		calls.Add(1)
+		return errors.New("pipeline is full")
+	})
+	require.NoError(t, err)
+	receiver, err := factory.CreateLogs(context.Background(), receivertest.NewNopSettings(metadata.Type), cfg, next)
+	require.NoError(t, err)
+	require.NoError(t, receiver.Start(context.Background(), nil))
+
+	b, err := (&plog.JSONMarshaler{}).MarshalLogs(testdata.GenerateLogs(1))
+	require.NoError(t, err)
+	b = append(b, '\n')
+	require.NoError(t, os.WriteFile(filepath.Join(tempFolder, "logs.json"), b, 0o600))
+
+	require.Eventually(t, func() bool {
+		return calls.Load() == 1
+	}, 5*time.Second, 10*time.Millisecond)
+	time.Sleep(100 * time.Millisecond)
+	require.NoError(t, receiver.Shutdown(context.Background()))
+	assert.Equal(t, int64(1), calls.Load())
+}
+
+func TestValidateConfig(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	require.NoError(t, cfg.Validate())
+
+	cfg.RetryOnFailure.Enabled = true
+	require.NoError(t, cfg.Validate())
+
+	cfg.RetryOnFailure.MaxAttempts = 0
+	assert.EqualError(t, cfg.Validate(), "retry_on_failure::max_attempts must be greater than zero")
+}
+
 func testdataConfigYamlAsMap() *Config {
 	return &Config{
 		Config: fileconsumer.Config{
@@ -215,6 +326,9 @@ func testdataConfigYamlAsMap() *Config {
 				Exclude: []string{"/var/log/example.log"},
 			},
 		},
+		RetryOnFailure: RetryOnFailureConfig{
+			MaxAttempts: defaultMaxAttempts,
+		},
 	}
 }
 
@@ -0,0 +1,168 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package pingreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/pingreceiver"
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/collector/scraper/scraperhelper"
+	"go.uber.org/multierr"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/pingreceiver/internal/metadata"
+)
+
+const (
+	modeAuto         = "auto"
+	modePrivileged   = "privileged"
+	modeUnprivileged = "unprivileged"
+
+	defaultCount       = 3
+	defaultInterval    = time.Second
+	defaultTimeout     = time.Second
+	defaultPayloadSize = 56
+	// maxPayloadSize is the largest payload of an echo request fitting in an
+	// IPv4 packet.
+	maxPayloadSize = 65507
+)
+
+// defaultRTTBuckets are the default bounds of the ping.rtt histogram, in milliseconds.
+var defaultRTTBuckets = []float64{1, 2, 5, 10, 20, 50, 100, 200, 500, 1000, 2000, 5000}
+
+// Predefined error responses for configuration validation failures
+var (
+	errMissingTargets = errors.New("no targets specified")
+	errMissingHost    = errors.New("'host' must be specified")
+	errConfigPing     = errors.New("invalid config")
+)
+
+// Config defines the configuration for the various elements of the receiver agent.
+type Config struct {
+	scraperhelper.ControllerConfig `mapstructure:",squash"`
+	metadata.MetricsBuilderConfig  `mapstructure:",squash"`
+	Targets                        []*targetConfig `mapstructure:"targets"`
+	// RTTHistogram configures the ping.rtt histogram of the round trip times.
+	RTTHistogram rttHistogramConfig `mapstructure:"rtt_histogram"`
+
+	// prevent unkeyed literal initialization
+	_ struct{}
+}
+
+type rttHistogramConfig struct {
+	Enabled bool `mapstructure:"enabled"`
+	// Buckets are the explicit bounds of the histogram, in milliseconds.
+	Buckets []float64 `mapstructure:"buckets"`
+}
+
+// targetConfig defines a host probed with echo requests on each scrape.
+type targetConfig struct {
+	// Host is the name or the IP address of the host probed.
+	Host string `mapstructure:"host"`
+	// Count is the number of echo requests sent on each scrape.
+	Count int `mapstructure:"count"`
+	// Interval is the time between two echo requests.
+	Interval time.Duration `mapstructure:"interval"`
+	// Timeout is how long the reply of the last echo request is waited for.
+	Timeout time.Duration `mapstructure:"timeout"`
+	// PayloadSize is the size of the data of the echo requests, in bytes.
+	PayloadSize *int `mapstructure:"payload_size"`
+	// Mode selects the sockets used to send the echo requests: privileged for
+	// raw ICMP sockets, unprivileged for the ICMP datagram sockets, which are
+	// created as UDP sockets and do not require the CAP_NET_RAW capability, or
+	// auto to use raw sockets and fall back to the datagram sockets when raw
+	// sockets are not permitted.
+	Mode string `mapstructure:"mode"`
+}
+
+func validateTarget(cfg *targetConfig) error {
+	var err error
+
+	if cfg.Host == "" {
+		err = multierr.Append(err, errMissingHost)
+	} else if strings.Contains(cfg.Host, "://") {
+		err = multierr.Append(err, fmt.Errorf("host contains a scheme, which is not allowed: %s", cfg.Host))
+	}
+
+	if cfg.Count < 0 {
+		err = multierr.Append(err, errors.New("'count' must not be negative"))
+	}
+	if cfg.Interval < 0 {
+		err = multierr.Append(err, errors.New("'interval' must not be negative"))
+	}
+	if cfg.Timeout < 0 {
+		err = multierr.Append(err, errors.New("'timeout' must not be negative"))
+	}
+	if cfg.PayloadSize != nil && (*cfg.PayloadSize < 0 || *cfg.PayloadSize > maxPayloadSize) {
+		err = multierr.Append(err, fmt.Errorf("'payload_size' must be between 0 and %d", maxPayloadSize))
+	}
+
+	switch cfg.Mode {
+	case "", modeAuto, modePrivileged, modeUnprivileged:
+	default:
+		err = multierr.Append(err, fmt.Errorf("invalid mode %q, must be %s, %s or %s", cfg.Mode, modeAuto, modePrivileged, modeUnprivileged))
+	}
+
+	if err != nil {
+		return fmt.Errorf("target %q: %w", cfg.Host, err)
+	}
+	return nil
+}
+
+func (cfg *targetConfig) count() int {
+	if cfg.Count == 0 {
+		return defaultCount
+	}
+	return cfg.Count
+}
+
+func (cfg *targetConfig) interval() time.Duration {
+	if cfg.Interval == 0 {
+		return defaultInterval
+	}
+	return cfg.Interval
+}
+
+func (cfg *targetConfig) timeout() time.Duration {
+	if cfg.Timeout == 0 {
+		return defaultTimeout
+	}
+	return cfg.Timeout
+}
+
+func (cfg *targetConfig) payloadSize() int {
+	if cfg.PayloadSize == nil {
+		return defaultPayloadSize
+	}
+	return *cfg.PayloadSize
+}
+
+func (cfg *targetConfig) mode() string {
+	if cfg.Mode == "" {
+		return modeAuto
+	}
+	return cfg.Mode
+}
+
+func (cfg *Config) Validate() error {
+	var err error
+
+	if len(cfg.Targets) == 0 {
+		err = multierr.Append(err, errMissingTargets)
+	}
+
+	for _, target := range cfg.Targets {
+		err = multierr.Append(err, validateTarget(target))
+	}
+
+	for i := 1; i < len(cfg.RTTHistogram.Buckets); i++ {
+		if cfg.RTTHistogram.Buckets[i] <= cfg.RTTHistogram.Buckets[i-1] {
+			err = multierr.Append(err, errors.New("'rtt_histogram.buckets' must be strictly increasing"))
+			break
+		}
+	}
+
+	return err
+}
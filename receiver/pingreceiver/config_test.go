@@ -0,0 +1,117 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package pingreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/pingreceiver"
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/confmap/confmaptest"
+	"go.opentelemetry.io/collector/confmap/xconfmap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/pingreceiver/internal/metadata"
+)
+
+func TestLoadConfig(t *testing.T) {
+	cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
+	require.NoError(t, err)
+
+	factory := NewFactory()
+	cfg := factory.CreateDefaultConfig()
+	sub, err := cm.Sub(component.NewID(metadata.Type).String())
+	require.NoError(t, err)
+	require.NoError(t, sub.Unmarshal(cfg))
+	require.NoError(t, xconfmap.Validate(cfg))
+
+	payloadSize := 1400
+	expected := factory.CreateDefaultConfig().(*Config)
+	expected.CollectionInterval = 30 * time.Second
+	expected.Targets = []*targetConfig{
+		{
+			Host: "example.com",
+		},
+		{
+			Host:        "192.0.2.1",
+			Count:       5,
+			Interval:    200 * time.Millisecond,
+			Timeout:     2 * time.Second,
+			PayloadSize: &payloadSize,
+			Mode:        modeUnprivileged,
+		},
+	}
+	expected.RTTHistogram.Buckets = []float64{10, 50, 100, 500}
+	assert.Equal(t, expected, cfg)
+}
+
+func TestValidate(t *testing.T) {
+	negative := -1
+	tooLarge := maxPayloadSize + 1
+	testCases := []struct {
+		desc        string
+		targets     []*targetConfig
+		buckets     []float64
+		expectedErr string
+	}{
+		{
+			desc:        "missing targets",
+			expectedErr: "no targets specified",
+		},
+		{
+			desc:    "valid target",
+			targets: []*targetConfig{{Host: "example.com", Mode: modePrivileged}},
+		},
+		{
+			desc:        "missing host",
+			targets:     []*targetConfig{{}},
+			expectedErr: `target "": 'host' must be specified`,
+		},
+		{
+			desc: "invalid target",
+			targets: []*targetConfig{{
+				Host:     "icmp://example.com",
+				Count:    -1,
+				Interval: -time.Second,
+				Timeout:  -time.Second,
+				Mode:     "raw",
+			}},
+			expectedErr: `target "icmp://example.com": host contains a scheme, which is not allowed: icmp://example.com; 'count' must not be negative; 'interval' must not be negative; 'timeout' must not be negative; invalid mode "raw", must be auto, privileged or unprivileged`,
+		},
+		{
+			desc:        "negative payload size",
+			targets:     []*targetConfig{{Host: "example.com", PayloadSize: &negative}},
+			expectedErr: `target "example.com": 'payload_size' must be between 0 and 65507`,
+		},
+		{
+			desc:        "payload size too large",
+			targets:     []*targetConfig{{Host: "example.com", PayloadSize: &tooLarge}},
+			expectedErr: `target "example.com": 'payload_size' must be between 0 and 65507`,
+		},
+		{
+			desc:        "unsorted buckets",
+			targets:     []*targetConfig{{Host: "example.com"}},
+			buckets:     []float64{10, 5},
+			expectedErr: "'rtt_histogram.buckets' must be strictly increasing",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			cfg := newDefaultConfig().(*Config)
+			cfg.Targets = tc.targets
+			if tc.buckets != nil {
+				cfg.RTTHistogram.Buckets = tc.buckets
+			}
+			err := cfg.Validate()
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,6 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package pingreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/pingreceiver"
+
+//go:generate mdatagen metadata.yaml
@@ -0,0 +1,64 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package pingreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/pingreceiver"
+
+import (
+	"context"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/receiver"
+	collectorscraper "go.opentelemetry.io/collector/scraper"
+	"go.opentelemetry.io/collector/scraper/scraperhelper"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/pingreceiver/internal/metadata"
+)
+
+// NewFactory creates a factory for pingreceiver receiver.
+func NewFactory() receiver.Factory {
+	return receiver.NewFactory(
+		metadata.Type,
+		newDefaultConfig,
+		receiver.WithMetrics(newReceiver, metadata.MetricsStability))
+}
+
+func newDefaultConfig() component.Config {
+	cfg := scraperhelper.NewDefaultControllerConfig()
+
+	return &Config{
+		ControllerConfig:     cfg,
+		MetricsBuilderConfig: metadata.DefaultMetricsBuilderConfig(),
+		Targets:              []*targetConfig{},
+		RTTHistogram: rttHistogramConfig{
+			Enabled: true,
+			Buckets: defaultRTTBuckets,
+		},
+	}
+}
+
+func newReceiver(
+	_ context.Context,
+	settings receiver.Settings,
+	cfg component.Config,
+	consumer consumer.Metrics,
+) (receiver.Metrics, error) {
+	pingConfig, ok := cfg.(*Config)
+	if !ok {
+		return nil, errConfigPing
+	}
+
+	mp := newScraper(pingConfig, settings)
+	s, err := collectorscraper.NewMetrics(mp.scrape)
+	if err != nil {
+		return nil, err
+	}
+	opt := scraperhelper.AddScraper(metadata.Type, s)
+
+	return scraperhelper.NewMetricsController(
+		&pingConfig.ControllerConfig,
+		settings,
+		consumer,
+		opt,
+	)
+}
@@ -0,0 +1,209 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package pingreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/pingreceiver"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"net"
+	"os"
+	"time"
+
+	"golang.org/x/net/icmp"
+	"golang.org/x/net/ipv4"
+	"golang.org/x/net/ipv6"
+)
+
+const (
+	protocolICMP     = 1
+	protocolIPv6ICMP = 58
+)
+
+// probeResult is the outcome of a probe of a target, with the round trip times
+// of the echo requests that got a reply.
+type probeResult struct {
+	sent int
+	rtts []time.Duration
+}
+
+type echoReply struct {
+	seq int
+	at  time.Time
+}
+
+// probe sends the echo requests of the target, one every interval, and waits for
+// their replies until the timeout elapses after the last request. The result
+// holds the requests sent before an error occurred.
+func probe(ctx context.Context, target *targetConfig) (probeResult, error) {
+	addr, err := resolveIPAddr(ctx, target.Host)
+	if err != nil {
+		return probeResult{}, err
+	}
+	conn, privileged, err := listen(target.mode(), addr.IP)
+	if err != nil {
+		return probeResult{}, err
+	}
+	defer conn.Close()
+
+	var dst net.Addr = addr
+	if !privileged {
+		dst = &net.UDPAddr{IP: addr.IP, Zone: addr.Zone}
+	}
+	isIPv4 := addr.IP.To4() != nil
+	proto := protocolICMP
+	var echoType icmp.Type = ipv4.ICMPTypeEcho
+	if !isIPv4 {
+		proto, echoType = protocolIPv6ICMP, ipv6.ICMPTypeEchoRequest
+	}
+
+	// The kernel sets the identifier of the echo requests sent on datagram
+	// sockets and only delivers their replies, so the identifier is only
+	// checked on raw sockets, which receive all the ICMP messages.
+	id := rand.IntN(0xffff) + 1
+	count := target.count()
+	replies := make(chan echoReply, count)
+	done := make(chan struct{})
+	defer close(done)
+	go readReplies(conn, proto, addr.IP, privileged, id, replies, done)
+
+	sentAt := make([]time.Time, 0, count)
+	received := map[int]time.Duration{}
+	record := func(r echoReply) {
+		if _, ok := received[r.seq]; !ok && r.seq < len(sentAt) {
+			received[r.seq] = r.at.Sub(sentAt[r.seq])
+		}
+	}
+	wait := func(until time.Time, all bool) error {
+		timer := time.NewTimer(time.Until(until))
+		defer timer.Stop()
+		for !all || len(received) < len(sentAt) {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-timer.C:
+				return nil
+			case r := <-replies:
+				record(r)
+			}
+		}
+		return nil
+	}
+	result := func() probeResult {
+		res := probeResult{sent: len(sentAt)}
+		for seq := range sentAt {
+			if rtt, ok := received[seq]; ok {
+				res.rtts = append(res.rtts, rtt)
+			}
+		}
+		return res
+	}
+
+	payload := make([]byte, target.payloadSize())
+	for seq := 0; seq < count; seq++ {
+		if seq > 0 {
+			if err := wait(sentAt[seq-1].Add(target.interval()), false); err != nil {
+				return result(), err
+			}
+		}
+		msg := icmp.Message{
+			Type: echoType,
+			Body: &icmp.Echo{ID: id, Seq: seq, Data: payload},
+		}
+		b, err := msg.Marshal(nil)
+		if err != nil {
+			return result(), err
+		}
+		now := time.Now()
+		if _, err := conn.WriteTo(b, dst); err != nil {
+			return result(), err
+		}
+		sentAt = append(sentAt, now)
+	}
+	err = wait(sentAt[len(sentAt)-1].Add(target.timeout()), true)
+	return result(), err
+}
+
+// readReplies sends the echo replies received from the IP to the channel, until
+// the connection is closed.
+func readReplies(conn *icmp.PacketConn, proto int, ip net.IP, checkID bool, id int, replies chan<- echoReply, done <-chan struct{}) {
+	buf := make([]byte, 1<<16)
+	for {
+		n, peer, err := conn.ReadFrom(buf)
+		if err != nil {
+			return
+		}
+		at := time.Now()
+		if !peerIP(peer).Equal(ip) {
+			continue
+		}
+		msg, err := icmp.ParseMessage(proto, buf[:n])
+		if err != nil || (msg.Type != ipv4.ICMPTypeEchoReply && msg.Type != ipv6.ICMPTypeEchoReply) {
+			continue
+		}
+		echo, ok := msg.Body.(*icmp.Echo)
+		if !ok || (checkID && echo.ID != id) {
+			continue
+		}
+		select {
+		case replies <- echoReply{seq: echo.Seq, at: at}:
+		case <-done:
+			return
+		}
+	}
+}
+
+// listen opens the socket used to send the echo requests to the IP, and reports
+// whether it is a raw socket.
+func listen(mode string, ip net.IP) (*icmp.PacketConn, bool, error) {
+	isIPv4 := ip.To4() != nil
+	if mode != modeUnprivileged {
+		network, address := "ip4:icmp", "0.0.0.0"
+		if !isIPv4 {
+			network, address = "ip6:ipv6-icmp", "::"
+		}
+		conn, err := icmp.ListenPacket(network, address)
+		if err == nil {
+			return conn, true, nil
+		}
+		if mode == modePrivileged || !errors.Is(err, os.ErrPermission) {
+			return nil, false, err
+		}
+	}
+	network, address := "udp4", "0.0.0.0"
+	if !isIPv4 {
+		network, address = "udp6", "::"
+	}
+	conn, err := icmp.ListenPacket(network, address)
+	return conn, false, err
+}
+
+// resolveIPAddr returns the address of the host, preferring IPv4 addresses.
+func resolveIPAddr(ctx context.Context, host string) (*net.IPAddr, error) {
+	addrs, err := net.DefaultResolver.LookupIPAddr(ctx, host)
+	if err != nil {
+		return nil, err
+	}
+	if len(addrs) == 0 {
+		return nil, fmt.Errorf("no address found for %s", host)
+	}
+	for _, addr := range addrs {
+		if addr.IP.To4() != nil {
+			return &addr, nil
+		}
+	}
+	return &addrs[0], nil
+}
+
+func peerIP(addr net.Addr) net.IP {
+	switch a := addr.(type) {
+	case *net.IPAddr:
+		return a.IP
+	case *net.UDPAddr:
+		return a.IP
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,60 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package pingreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/pingreceiver"
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProbeLoopback(t *testing.T) {
+	for _, mode := range []string{modePrivileged, modeUnprivileged} {
+		t.Run(mode, func(t *testing.T) {
+			conn, _, err := listen(mode, net.IPv4(127, 0, 0, 1))
+			if err != nil {
+				t.Skipf("%s ICMP sockets are not available: %v", mode, err)
+			}
+			require.NoError(t, conn.Close())
+
+			res, err := probe(context.Background(), &targetConfig{
+				Host:     "127.0.0.1",
+				Count:    3,
+				Interval: 10 * time.Millisecond,
+				Mode:     mode,
+			})
+			require.NoError(t, err)
+			assert.Equal(t, 3, res.sent)
+			assert.Len(t, res.rtts, 3)
+		})
+	}
+}
+
+func TestProbeCanceled(t *testing.T) {
+	conn, _, err := listen(modeAuto, net.IPv4(127, 0, 0, 1))
+	if err != nil {
+		t.Skipf("ICMP sockets are not available: %v", err)
+	}
+	require.NoError(t, conn.Close())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	res, err := probe(ctx, &targetConfig{Host: "127.0.0.1", Count: 3, Interval: time.Hour})
+	require.ErrorIs(t, err, context.Canceled)
+	assert.Equal(t, 1, res.sent)
+}
+
+func TestResolveIPAddr(t *testing.T) {
+	addr, err := resolveIPAddr(context.Background(), "::1")
+	require.NoError(t, err)
+	assert.Equal(t, "::1", addr.String())
+
+	addr, err = resolveIPAddr(context.Background(), "192.0.2.1")
+	require.NoError(t, err)
+	assert.Equal(t, "192.0.2.1", addr.String())
+}
@@ -0,0 +1,159 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package pingreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/pingreceiver"
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/receiver"
+	"go.opentelemetry.io/collector/scraper/scrapererror"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/pingreceiver/internal/metadata"
+)
+
+// targetStats holds the cumulative counts of a target.
+type targetStats struct {
+	sent         int64
+	received     int64
+	rttCount     uint64
+	rttSum       float64
+	bucketCounts []uint64
+}
+
+type scraper struct {
+	cfg       *Config
+	settings  component.TelemetrySettings
+	buildInfo component.BuildInfo
+	mb        *metadata.MetricsBuilder
+	probe     func(ctx context.Context, target *targetConfig) (probeResult, error)
+	startTime pcommon.Timestamp
+	// stats holds the counts of each target, in the order of the targets.
+	stats []*targetStats
+}
+
+func (s *scraper) record(now pcommon.Timestamp, target *targetConfig, stats *targetStats, res probeResult) {
+	stats.sent += int64(res.sent)
+	stats.received += int64(len(res.rtts))
+	s.mb.RecordPingPacketsSentDataPoint(now, stats.sent, target.Host)
+	s.mb.RecordPingPacketsReceivedDataPoint(now, stats.received, target.Host)
+
+	// A probe failing before sending any request, for instance because the
+	// host cannot be resolved, is reported as a complete loss.
+	loss := 1.0
+	if res.sent > 0 {
+		loss = float64(res.sent-len(res.rtts)) / float64(res.sent)
+	}
+	s.mb.RecordPingPacketLossDataPoint(now, loss, target.Host)
+
+	if len(res.rtts) == 0 {
+		return
+	}
+	minRTT, maxRTT := math.Inf(1), math.Inf(-1)
+	var sum, sumSquares float64
+	for _, rtt := range res.rtts {
+		ms := float64(rtt) / float64(time.Millisecond)
+		minRTT = min(minRTT, ms)
+		maxRTT = max(maxRTT, ms)
+		sum += ms
+		sumSquares += ms * ms
+
+		stats.rttCount++
+		stats.rttSum += ms
+		stats.bucketCounts[sort.SearchFloat64s(s.cfg.RTTHistogram.Buckets, ms)]++
+	}
+	n := float64(len(res.rtts))
+	avg := sum / n
+	s.mb.RecordPingRttMinDataPoint(now, minRTT, target.Host)
+	s.mb.RecordPingRttMaxDataPoint(now, maxRTT, target.Host)
+	s.mb.RecordPingRttAvgDataPoint(now, avg, target.Host)
+	s.mb.RecordPingRttStddevDataPoint(now, math.Sqrt(max(sumSquares/n-avg*avg, 0)), target.Host)
+}
+
+// appendRTTHistogram appends the cumulative ping.rtt histogram of the targets to
+// the metrics emitted by the metrics builder.
+func (s *scraper) appendRTTHistogram(metrics pmetric.Metrics, now pcommon.Timestamp) {
+	var sm pmetric.ScopeMetrics
+	if metrics.ResourceMetrics().Len() == 0 {
+		sm = metrics.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty()
+		sm.Scope().SetName(metadata.ScopeName)
+		sm.Scope().SetVersion(s.buildInfo.Version)
+	} else {
+		sm = metrics.ResourceMetrics().At(0).ScopeMetrics().At(0)
+	}
+
+	m := sm.Metrics().AppendEmpty()
+	m.SetName("ping.rtt")
+	m.SetDescription("Round trip time of the echo replies received.")
+	m.SetUnit("ms")
+	histogram := m.SetEmptyHistogram()
+	histogram.SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	for i, target := range s.cfg.Targets {
+		stats := s.stats[i]
+		dp := histogram.DataPoints().AppendEmpty()
+		dp.SetStartTimestamp(s.startTime)
+		dp.SetTimestamp(now)
+		dp.Attributes().PutStr("ping.target", target.Host)
+		dp.SetCount(stats.rttCount)
+		dp.SetSum(stats.rttSum)
+		dp.ExplicitBounds().FromRaw(s.cfg.RTTHistogram.Buckets)
+		dp.BucketCounts().FromRaw(stats.bucketCounts)
+	}
+}
+
+func (s *scraper) scrape(ctx context.Context) (pmetric.Metrics, error) {
+	if s.cfg == nil || len(s.cfg.Targets) == 0 {
+		return pmetric.NewMetrics(), errMissingTargets
+	}
+
+	var wg sync.WaitGroup
+	var mux sync.Mutex
+	errs := &scrapererror.ScrapeErrors{}
+
+	for i, target := range s.cfg.Targets {
+		wg.Add(1)
+		go func(target *targetConfig, stats *targetStats) {
+			defer wg.Done()
+			res, err := s.probe(ctx, target)
+			now := pcommon.NewTimestampFromTime(time.Now())
+
+			mux.Lock()
+			defer mux.Unlock()
+			s.record(now, target, stats, res)
+			if err != nil {
+				errs.AddPartial(1, fmt.Errorf("failed to ping %s: %w", target.Host, err))
+			}
+		}(target, s.stats[i])
+	}
+	wg.Wait()
+
+	metrics := s.mb.Emit()
+	if s.cfg.RTTHistogram.Enabled {
+		s.appendRTTHistogram(metrics, pcommon.NewTimestampFromTime(time.Now()))
+	}
+	return metrics, errs.Combine()
+}
+
+func newScraper(cfg *Config, settings receiver.Settings) *scraper {
+	stats := make([]*targetStats, len(cfg.Targets))
+	for i := range stats {
+		stats[i] = &targetStats{bucketCounts: make([]uint64, len(cfg.RTTHistogram.Buckets)+1)}
+	}
+	return &scraper{
+		cfg:       cfg,
+		settings:  settings.TelemetrySettings,
+		buildInfo: settings.BuildInfo,
+		mb:        metadata.NewMetricsBuilder(cfg.MetricsBuilderConfig, settings),
+		probe:     probe,
+		startTime: pcommon.NewTimestampFromTime(time.Now()),
+		stats:     stats,
+	}
+}
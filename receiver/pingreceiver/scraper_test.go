@@ -0,0 +1,131 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package pingreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/pingreceiver"
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/pingreceiver/internal/metadata"
+)
+
+func TestScraper(t *testing.T) {
+	cfg := newDefaultConfig().(*Config)
+	cfg.Metrics.PingRttStddev.Enabled = true
+	cfg.Targets = []*targetConfig{{Host: "reachable.example.com"}, {Host: "unknown.example.com"}}
+
+	s := newScraper(cfg, receivertest.NewNopSettings(metadata.Type))
+	s.probe = func(_ context.Context, target *targetConfig) (probeResult, error) {
+		if target.Host == "unknown.example.com" {
+			return probeResult{}, errors.New("no such host")
+		}
+		return probeResult{
+			sent: 4,
+			rtts: []time.Duration{10 * time.Millisecond, 20 * time.Millisecond, 30 * time.Millisecond},
+		}, nil
+	}
+
+	for i := int64(1); i <= 2; i++ {
+		metrics, err := s.scrape(context.Background())
+		require.EqualError(t, err, "failed to ping unknown.example.com: no such host")
+
+		values := numberValues(t, metrics)
+		reachable := values["reachable.example.com"]
+		assert.Equal(t, 4*i, int64(reachable["ping.packets.sent"]))
+		assert.Equal(t, 3*i, int64(reachable["ping.packets.received"]))
+		assert.Equal(t, 0.25, reachable["ping.packet_loss"])
+		assert.InDelta(t, 10, reachable["ping.rtt.min"], 1e-9)
+		assert.InDelta(t, 30, reachable["ping.rtt.max"], 1e-9)
+		assert.InDelta(t, 20, reachable["ping.rtt.avg"], 1e-9)
+		assert.InDelta(t, 8.165, reachable["ping.rtt.stddev"], 1e-3)
+
+		unknown := values["unknown.example.com"]
+		assert.Equal(t, map[string]float64{
+			"ping.packets.sent":     0,
+			"ping.packets.received": 0,
+			"ping.packet_loss":      1,
+		}, unknown)
+
+		histogram := findMetric(t, metrics, "ping.rtt").Histogram()
+		assert.Equal(t, pmetric.AggregationTemporalityCumulative, histogram.AggregationTemporality())
+		require.Equal(t, 2, histogram.DataPoints().Len())
+		dp := histogram.DataPoints().At(0)
+		target, _ := dp.Attributes().Get("ping.target")
+		assert.Equal(t, "reachable.example.com", target.Str())
+		assert.Equal(t, uint64(3*i), dp.Count())
+		assert.InDelta(t, float64(60*i), dp.Sum(), 1e-9)
+		assert.Equal(t, defaultRTTBuckets, dp.ExplicitBounds().AsRaw())
+		expectedCounts := make([]uint64, len(defaultRTTBuckets)+1)
+		expectedCounts[3], expectedCounts[4], expectedCounts[5] = uint64(i), uint64(i), uint64(i)
+		assert.Equal(t, expectedCounts, dp.BucketCounts().AsRaw())
+		assert.Equal(t, uint64(0), histogram.DataPoints().At(1).Count())
+	}
+}
+
+func TestScraperWithoutHistogram(t *testing.T) {
+	cfg := newDefaultConfig().(*Config)
+	cfg.RTTHistogram.Enabled = false
+	cfg.Targets = []*targetConfig{{Host: "example.com"}}
+
+	s := newScraper(cfg, receivertest.NewNopSettings(metadata.Type))
+	s.probe = func(context.Context, *targetConfig) (probeResult, error) {
+		return probeResult{sent: 1, rtts: []time.Duration{time.Millisecond}}, nil
+	}
+	metrics, err := s.scrape(context.Background())
+	require.NoError(t, err)
+	ms := metrics.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	for i := 0; i < ms.Len(); i++ {
+		assert.NotEqual(t, "ping.rtt", ms.At(i).Name())
+	}
+}
+
+// numberValues returns the value of the gauge and sum data points, by target.
+func numberValues(t *testing.T, metrics pmetric.Metrics) map[string]map[string]float64 {
+	values := map[string]map[string]float64{}
+	ms := metrics.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	for i := 0; i < ms.Len(); i++ {
+		m := ms.At(i)
+		var dps pmetric.NumberDataPointSlice
+		switch m.Type() {
+		case pmetric.MetricTypeGauge:
+			dps = m.Gauge().DataPoints()
+		case pmetric.MetricTypeSum:
+			dps = m.Sum().DataPoints()
+		default:
+			continue
+		}
+		for j := 0; j < dps.Len(); j++ {
+			dp := dps.At(j)
+			target, ok := dp.Attributes().Get("ping.target")
+			require.True(t, ok)
+			if values[target.Str()] == nil {
+				values[target.Str()] = map[string]float64{}
+			}
+			if dp.ValueType() == pmetric.NumberDataPointValueTypeInt {
+				values[target.Str()][m.Name()] = float64(dp.IntValue())
+			} else {
+				values[target.Str()][m.Name()] = dp.DoubleValue()
+			}
+		}
+	}
+	return values
+}
+
+func findMetric(t *testing.T, metrics pmetric.Metrics, name string) pmetric.Metric {
+	ms := metrics.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	for i := 0; i < ms.Len(); i++ {
+		if ms.At(i).Name() == name {
+			return ms.At(i)
+		}
+	}
+	require.Failf(t, "metric not found", name)
+	return pmetric.NewMetric()
+}
@@ -63,6 +63,8 @@ type client interface {
 	getDatabaseTableMetrics(ctx context.Context, db string) (map[tableIdentifier]tableStats, error)
 	getBlocksReadByTable(ctx context.Context, db string) (map[tableIdentifier]tableIOStats, error)
 	getReplicationStats(ctx context.Context) ([]replicationStats, error)
+	getReplicationSlotStats(ctx context.Context) ([]replicationSlotStats, error)
+	getQueryStats(ctx context.Context, limit int64) ([]queryStats, error)
 	getLatestWalAgeSeconds(ctx context.Context) (int64, error)
 	getMaxConnections(ctx context.Context) (int64, error)
 	getIndexStats(ctx context.Context, database string) (map[indexIdentifer]indexStat, error)
@@ -761,6 +763,93 @@ func (c *postgreSQLClient) getReplicationStats(ctx context.Context) ([]replicati
 	return rs, errors
 }
 
+type replicationSlotStats struct {
+	slotName               string
+	slotType               string
+	retainedWalBytes       int64
+	confirmedFlushLagBytes int64
+}
+
+// getReplicationSlotStats returns the WAL retained by each replication slot and,
+// for logical slots, the WAL not yet confirmed by their consumer. On a standby
+// the lag is computed against the last received WAL position.
+func (c *postgreSQLClient) getReplicationSlotStats(ctx context.Context) ([]replicationSlotStats, error) {
+	query := `SELECT
+	slot_name,
+	slot_type,
+	coalesce(pg_wal_lsn_diff(CASE WHEN pg_is_in_recovery() THEN pg_last_wal_receive_lsn() ELSE pg_current_wal_lsn() END, restart_lsn), -1)::bigint AS retained_wal_bytes,
+	coalesce(pg_wal_lsn_diff(CASE WHEN pg_is_in_recovery() THEN pg_last_wal_receive_lsn() ELSE pg_current_wal_lsn() END, confirmed_flush_lsn), -1)::bigint AS confirmed_flush_lag_bytes
+	FROM pg_replication_slots;
+	`
+	rows, err := c.client.QueryContext(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("unable to query pg_replication_slots: %w", err)
+	}
+	defer rows.Close()
+	var rs []replicationSlotStats
+	var errors error
+	for rows.Next() {
+		var stats replicationSlotStats
+		err = rows.Scan(&stats.slotName, &stats.slotType, &stats.retainedWalBytes, &stats.confirmedFlushLagBytes)
+		if err != nil {
+			errors = multierr.Append(errors, err)
+			continue
+		}
+		rs = append(rs, stats)
+	}
+
+	return rs, errors
+}
+
+type queryStats struct {
+	database      string
+	queryID       string
+	role          string
+	query         string
+	calls         int64
+	totalExecTime float64 // milliseconds
+	rows          int64
+}
+
+// getQueryStats returns the statistics of the statements of pg_stat_statements
+// that spent the most time executing.
+func (c *postgreSQLClient) getQueryStats(ctx context.Context, limit int64) ([]queryStats, error) {
+	query := `/* otel-collector-ignore */ SELECT
+	coalesce(datname, ''),
+	coalesce(queryid::TEXT, ''),
+	coalesce(rolname, ''),
+	query,
+	calls,
+	total_exec_time,
+	rows
+	FROM pg_stat_statements
+	LEFT JOIN pg_roles ON pg_stat_statements.userid = pg_roles.oid
+	LEFT JOIN pg_database ON pg_stat_statements.dbid = pg_database.oid
+	WHERE query != '<insufficient privilege>'
+	AND query NOT LIKE '/* otel-collector-ignore */%'
+	ORDER BY total_exec_time DESC
+	LIMIT $1;
+	`
+	rows, err := c.client.QueryContext(ctx, query, limit)
+	if err != nil {
+		return nil, fmt.Errorf("unable to query pg_stat_statements: %w", err)
+	}
+	defer rows.Close()
+	var qs []queryStats
+	var errors error
+	for rows.Next() {
+		var stats queryStats
+		err = rows.Scan(&stats.database, &stats.queryID, &stats.role, &stats.query, &stats.calls, &stats.totalExecTime, &stats.rows)
+		if err != nil {
+			errors = multierr.Append(errors, err)
+			continue
+		}
+		qs = append(qs, stats)
+	}
+
+	return qs, errors
+}
+
 func (c *postgreSQLClient) getLatestWalAgeSeconds(ctx context.Context) (int64, error) {
 	query := `SELECT
 	coalesce(last_archived_time, CURRENT_TIMESTAMP) AS last_archived_wal,
@@ -25,6 +25,7 @@ const (
 	ErrNotSupported        = "invalid config: field '%s' not supported"
 	ErrTransportsSupported = "invalid config: 'transport' must be 'tcp' or 'unix'"
 	ErrHostPort            = "invalid config: 'endpoint' must be in the form <host>:<port> no matter what 'transport' is configured"
+	ErrQueryMetricsTopN    = "invalid config: 'query_metrics_collection.top_n' must be positive"
 )
 
 type TopQueryCollection struct {
@@ -37,6 +38,15 @@ type TopQueryCollection struct {
 	_ struct{}
 }
 
+// QueryMetricsCollection configures the metrics of the top statements of
+// pg_stat_statements.
+type QueryMetricsCollection struct {
+	// TopN is the number of statements reported, ranked by total execution time.
+	TopN int64 `mapstructure:"top_n"`
+	// prevent unkeyed literal initialization
+	_ struct{}
+}
+
 type QuerySampleCollection struct {
 	MaxRowsPerQuery int64 `mapstructure:"max_rows_per_query"`
 	// prevent unkeyed literal initialization
@@ -56,6 +66,7 @@ type Config struct {
 	metadata.LogsBuilderConfig     `mapstructure:",squash"`
 	QuerySampleCollection          `mapstructure:"query_sample_collection,omitempty"`
 	TopQueryCollection             `mapstructure:"top_query_collection,omitempty"`
+	QueryMetricsCollection         `mapstructure:"query_metrics_collection,omitempty"`
 }
 
 type ConnectionPool struct {
@@ -85,6 +96,10 @@ func (cfg *Config) Validate() error {
 		err = multierr.Append(err, fmt.Errorf(ErrNotSupported, "MinVersion"))
 	}
 
+	if cfg.QueryMetricsCollection.TopN <= 0 {
+		err = multierr.Append(err, errors.New(ErrQueryMetricsTopN))
+	}
+
 	switch cfg.Transport {
 	case confignet.TransportTypeTCP, confignet.TransportTypeUnix:
 		_, _, endpointErr := net.SplitHostPort(cfg.Endpoint)
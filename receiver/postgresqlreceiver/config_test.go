@@ -89,6 +89,17 @@ func TestValidate(t *testing.T) {
 				fmt.Errorf(ErrNotSupported, "MinVersion"),
 			},
 		},
+		{
+			desc: "invalid query metrics top n",
+			defaultConfigModifier: func(cfg *Config) {
+				cfg.Username = "otel"
+				cfg.Password = "otel"
+				cfg.QueryMetricsCollection.TopN = 0
+			},
+			expected: []error{
+				errors.New(ErrQueryMetricsTopN),
+			},
+		},
 		{
 			desc: "no error",
 			defaultConfigModifier: func(cfg *Config) {
@@ -184,6 +195,7 @@ func TestLoadConfig(t *testing.T) {
 			MaxIdle:     ptr(5),
 			MaxOpen:     ptr(10),
 		}
+		expected.QueryMetricsCollection.TopN = 50
 
 		require.Equal(t, expected, cfg)
 	})
@@ -76,6 +76,9 @@ func createDefaultConfig() component.Config {
 			QueryPlanCacheSize:     1000,
 			QueryPlanCacheTTL:      time.Hour,
 		},
+		QueryMetricsCollection: QueryMetricsCollection{
+			TopN: 100,
+		},
 	}
 }
 
@@ -28,42 +28,47 @@ func (ms *MetricConfig) Unmarshal(parser *confmap.Conf) error {
 
 // MetricsConfig provides config for postgresql metrics.
 type MetricsConfig struct {
-	PostgresqlBackends                 MetricConfig `mapstructure:"postgresql.backends"`
-	PostgresqlBgwriterBuffersAllocated MetricConfig `mapstructure:"postgresql.bgwriter.buffers.allocated"`
-	PostgresqlBgwriterBuffersWrites    MetricConfig `mapstructure:"postgresql.bgwriter.buffers.writes"`
-	PostgresqlBgwriterCheckpointCount  MetricConfig `mapstructure:"postgresql.bgwriter.checkpoint.count"`
-	PostgresqlBgwriterDuration         MetricConfig `mapstructure:"postgresql.bgwriter.duration"`
-	PostgresqlBgwriterMaxwritten       MetricConfig `mapstructure:"postgresql.bgwriter.maxwritten"`
-	PostgresqlBlksHit                  MetricConfig `mapstructure:"postgresql.blks_hit"`
-	PostgresqlBlksRead                 MetricConfig `mapstructure:"postgresql.blks_read"`
-	PostgresqlBlocksRead               MetricConfig `mapstructure:"postgresql.blocks_read"`
-	PostgresqlCommits                  MetricConfig `mapstructure:"postgresql.commits"`
-	PostgresqlConnectionMax            MetricConfig `mapstructure:"postgresql.connection.max"`
-	PostgresqlDatabaseCount            MetricConfig `mapstructure:"postgresql.database.count"`
-	PostgresqlDatabaseLocks            MetricConfig `mapstructure:"postgresql.database.locks"`
-	PostgresqlDbSize                   MetricConfig `mapstructure:"postgresql.db_size"`
-	PostgresqlDeadlocks                MetricConfig `mapstructure:"postgresql.deadlocks"`
-	PostgresqlFunctionCalls            MetricConfig `mapstructure:"postgresql.function.calls"`
-	PostgresqlIndexScans               MetricConfig `mapstructure:"postgresql.index.scans"`
-	PostgresqlIndexSize                MetricConfig `mapstructure:"postgresql.index.size"`
-	PostgresqlOperations               MetricConfig `mapstructure:"postgresql.operations"`
-	PostgresqlReplicationDataDelay     MetricConfig `mapstructure:"postgresql.replication.data_delay"`
-	PostgresqlRollbacks                MetricConfig `mapstructure:"postgresql.rollbacks"`
-	PostgresqlRows                     MetricConfig `mapstructure:"postgresql.rows"`
-	PostgresqlSequentialScans          MetricConfig `mapstructure:"postgresql.sequential_scans"`
-	PostgresqlTableCount               MetricConfig `mapstructure:"postgresql.table.count"`
-	PostgresqlTableSize                MetricConfig `mapstructure:"postgresql.table.size"`
-	PostgresqlTableVacuumCount         MetricConfig `mapstructure:"postgresql.table.vacuum.count"`
-	PostgresqlTempIo                   MetricConfig `mapstructure:"postgresql.temp.io"`
-	PostgresqlTempFiles                MetricConfig `mapstructure:"postgresql.temp_files"`
-	PostgresqlTupDeleted               MetricConfig `mapstructure:"postgresql.tup_deleted"`
-	PostgresqlTupFetched               MetricConfig `mapstructure:"postgresql.tup_fetched"`
-	PostgresqlTupInserted              MetricConfig `mapstructure:"postgresql.tup_inserted"`
-	PostgresqlTupReturned              MetricConfig `mapstructure:"postgresql.tup_returned"`
-	PostgresqlTupUpdated               MetricConfig `mapstructure:"postgresql.tup_updated"`
-	PostgresqlWalAge                   MetricConfig `mapstructure:"postgresql.wal.age"`
-	PostgresqlWalDelay                 MetricConfig `mapstructure:"postgresql.wal.delay"`
-	PostgresqlWalLag                   MetricConfig `mapstructure:"postgresql.wal.lag"`
+	PostgresqlBackends                         MetricConfig `mapstructure:"postgresql.backends"`
+	PostgresqlBgwriterBuffersAllocated         MetricConfig `mapstructure:"postgresql.bgwriter.buffers.allocated"`
+	PostgresqlBgwriterBuffersWrites            MetricConfig `mapstructure:"postgresql.bgwriter.buffers.writes"`
+	PostgresqlBgwriterCheckpointCount          MetricConfig `mapstructure:"postgresql.bgwriter.checkpoint.count"`
+	PostgresqlBgwriterDuration                 MetricConfig `mapstructure:"postgresql.bgwriter.duration"`
+	PostgresqlBgwriterMaxwritten               MetricConfig `mapstructure:"postgresql.bgwriter.maxwritten"`
+	PostgresqlBlksHit                          MetricConfig `mapstructure:"postgresql.blks_hit"`
+	PostgresqlBlksRead                         MetricConfig `mapstructure:"postgresql.blks_read"`
+	PostgresqlBlocksRead                       MetricConfig `mapstructure:"postgresql.blocks_read"`
+	PostgresqlCommits                          MetricConfig `mapstructure:"postgresql.commits"`
+	PostgresqlConnectionMax                    MetricConfig `mapstructure:"postgresql.connection.max"`
+	PostgresqlDatabaseCount                    MetricConfig `mapstructure:"postgresql.database.count"`
+	PostgresqlDatabaseLocks                    MetricConfig `mapstructure:"postgresql.database.locks"`
+	PostgresqlDbSize                           MetricConfig `mapstructure:"postgresql.db_size"`
+	PostgresqlDeadlocks                        MetricConfig `mapstructure:"postgresql.deadlocks"`
+	PostgresqlFunctionCalls                    MetricConfig `mapstructure:"postgresql.function.calls"`
+	PostgresqlIndexScans                       MetricConfig `mapstructure:"postgresql.index.scans"`
+	PostgresqlIndexSize                        MetricConfig `mapstructure:"postgresql.index.size"`
+	PostgresqlOperations                       MetricConfig `mapstructure:"postgresql.operations"`
+	PostgresqlQueryCalls                       MetricConfig `mapstructure:"postgresql.query.calls"`
+	PostgresqlQueryDuration                    MetricConfig `mapstructure:"postgresql.query.duration"`
+	PostgresqlQueryRows                        MetricConfig `mapstructure:"postgresql.query.rows"`
+	PostgresqlReplicationDataDelay             MetricConfig `mapstructure:"postgresql.replication.data_delay"`
+	PostgresqlReplicationSlotConfirmedFlushLag MetricConfig `mapstructure:"postgresql.replication_slot.confirmed_flush_lag"`
+	PostgresqlReplicationSlotRetainedWal       MetricConfig `mapstructure:"postgresql.replication_slot.retained_wal"`
+	PostgresqlRollbacks                        MetricConfig `mapstructure:"postgresql.rollbacks"`
+	PostgresqlRows                             MetricConfig `mapstructure:"postgresql.rows"`
+	PostgresqlSequentialScans                  MetricConfig `mapstructure:"postgresql.sequential_scans"`
+	PostgresqlTableCount                       MetricConfig `mapstructure:"postgresql.table.count"`
+	PostgresqlTableSize                        MetricConfig `mapstructure:"postgresql.table.size"`
+	PostgresqlTableVacuumCount                 MetricConfig `mapstructure:"postgresql.table.vacuum.count"`
+	PostgresqlTempIo                           MetricConfig `mapstructure:"postgresql.temp.io"`
+	PostgresqlTempFiles                        MetricConfig `mapstructure:"postgresql.temp_files"`
+	PostgresqlTupDeleted                       MetricConfig `mapstructure:"postgresql.tup_deleted"`
+	PostgresqlTupFetched                       MetricConfig `mapstructure:"postgresql.tup_fetched"`
+	PostgresqlTupInserted                      MetricConfig `mapstructure:"postgresql.tup_inserted"`
+	PostgresqlTupReturned                      MetricConfig `mapstructure:"postgresql.tup_returned"`
+	PostgresqlTupUpdated                       MetricConfig `mapstructure:"postgresql.tup_updated"`
+	PostgresqlWalAge                           MetricConfig `mapstructure:"postgresql.wal.age"`
+	PostgresqlWalDelay                         MetricConfig `mapstructure:"postgresql.wal.delay"`
+	PostgresqlWalLag                           MetricConfig `mapstructure:"postgresql.wal.lag"`
 }
 
 func DefaultMetricsConfig() MetricsConfig {
@@ -125,9 +130,24 @@ func DefaultMetricsConfig() MetricsConfig {
 		PostgresqlOperations: MetricConfig{
 			Enabled: true,
 		},
+		PostgresqlQueryCalls: MetricConfig{
+			Enabled: false,
+		},
+		PostgresqlQueryDuration: MetricConfig{
+			Enabled: false,
+		},
+		PostgresqlQueryRows: MetricConfig{
+			Enabled: false,
+		},
 		PostgresqlReplicationDataDelay: MetricConfig{
 			Enabled: true,
 		},
+		PostgresqlReplicationSlotConfirmedFlushLag: MetricConfig{
+			Enabled: false,
+		},
+		PostgresqlReplicationSlotRetainedWal: MetricConfig{
+			Enabled: false,
+		},
 		PostgresqlRollbacks: MetricConfig{
 			Enabled: true,
 		},
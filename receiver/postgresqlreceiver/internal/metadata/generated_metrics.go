@@ -154,6 +154,32 @@ var MapAttributeOperation = map[string]AttributeOperation{
 	"hot_upd": AttributeOperationHotUpd,
 }
 
+// AttributeReplicationSlotType specifies the value replication_slot_type attribute.
+type AttributeReplicationSlotType int
+
+const (
+	_ AttributeReplicationSlotType = iota
+	AttributeReplicationSlotTypeLogical
+	AttributeReplicationSlotTypePhysical
+)
+
+// String returns the string representation of the AttributeReplicationSlotType.
+func (av AttributeReplicationSlotType) String() string {
+	switch av {
+	case AttributeReplicationSlotTypeLogical:
+		return "logical"
+	case AttributeReplicationSlotTypePhysical:
+		return "physical"
+	}
+	return ""
+}
+
+// MapAttributeReplicationSlotType is a helper map of string to AttributeReplicationSlotType attribute value.
+var MapAttributeReplicationSlotType = map[string]AttributeReplicationSlotType{
+	"logical":  AttributeReplicationSlotTypeLogical,
+	"physical": AttributeReplicationSlotTypePhysical,
+}
+
 // AttributeSource specifies the value source attribute.
 type AttributeSource int
 
@@ -318,9 +344,24 @@ var MetricsInfo = metricsInfo{
 	PostgresqlOperations: metricInfo{
 		Name: "postgresql.operations",
 	},
+	PostgresqlQueryCalls: metricInfo{
+		Name: "postgresql.query.calls",
+	},
+	PostgresqlQueryDuration: metricInfo{
+		Name: "postgresql.query.duration",
+	},
+	PostgresqlQueryRows: metricInfo{
+		Name: "postgresql.query.rows",
+	},
 	PostgresqlReplicationDataDelay: metricInfo{
 		Name: "postgresql.replication.data_delay",
 	},
+	PostgresqlReplicationSlotConfirmedFlushLag: metricInfo{
+		Name: "postgresql.replication_slot.confirmed_flush_lag",
+	},
+	PostgresqlReplicationSlotRetainedWal: metricInfo{
+		Name: "postgresql.replication_slot.retained_wal",
+	},
 	PostgresqlRollbacks: metricInfo{
 		Name: "postgresql.rollbacks",
 	},
@@ -372,42 +413,47 @@ var MetricsInfo = metricsInfo{
 }
 
 type metricsInfo struct {
-	PostgresqlBackends                 metricInfo
-	PostgresqlBgwriterBuffersAllocated metricInfo
-	PostgresqlBgwriterBuffersWrites    metricInfo
-	PostgresqlBgwriterCheckpointCount  metricInfo
-	PostgresqlBgwriterDuration         metricInfo
-	PostgresqlBgwriterMaxwritten       metricInfo
-	PostgresqlBlksHit                  metricInfo
-	PostgresqlBlksRead                 metricInfo
-	PostgresqlBlocksRead               metricInfo
-	PostgresqlCommits                  metricInfo
-	PostgresqlConnectionMax            metricInfo
-	PostgresqlDatabaseCount            metricInfo
-	PostgresqlDatabaseLocks            metricInfo
-	PostgresqlDbSize                   metricInfo
-	PostgresqlDeadlocks                metricInfo
-	PostgresqlFunctionCalls            metricInfo
-	PostgresqlIndexScans               metricInfo
-	PostgresqlIndexSize                metricInfo
-	PostgresqlOperations               metricInfo
-	PostgresqlReplicationDataDelay     metricInfo
-	PostgresqlRollbacks                metricInfo
-	PostgresqlRows                     metricInfo
-	PostgresqlSequentialScans          metricInfo
-	PostgresqlTableCount               metricInfo
-	PostgresqlTableSize                metricInfo
-	PostgresqlTableVacuumCount         metricInfo
-	PostgresqlTempIo                   metricInfo
-	PostgresqlTempFiles                metricInfo
-	PostgresqlTupDeleted               metricInfo
-	PostgresqlTupFetched               metricInfo
-	PostgresqlTupInserted              metricInfo
-	PostgresqlTupReturned              metricInfo
-	PostgresqlTupUpdated               metricInfo
-	PostgresqlWalAge                   metricInfo
-	PostgresqlWalDelay                 metricInfo
-	PostgresqlWalLag                   metricInfo
+	PostgresqlBackends                         metricInfo
+	PostgresqlBgwriterBuffersAllocated         metricInfo
+	PostgresqlBgwriterBuffersWrites            metricInfo
+	PostgresqlBgwriterCheckpointCount          metricInfo
+	PostgresqlBgwriterDuration                 metricInfo
+	PostgresqlBgwriterMaxwritten               metricInfo
+	PostgresqlBlksHit                          metricInfo
+	PostgresqlBlksRead                         metricInfo
+	PostgresqlBlocksRead                       metricInfo
+	PostgresqlCommits                          metricInfo
+	PostgresqlConnectionMax                    metricInfo
+	PostgresqlDatabaseCount                    metricInfo
+	PostgresqlDatabaseLocks                    metricInfo
+	PostgresqlDbSize                           metricInfo
+	PostgresqlDeadlocks                        metricInfo
+	PostgresqlFunctionCalls                    metricInfo
+	PostgresqlIndexScans                       metricInfo
+	PostgresqlIndexSize                        metricInfo
+	PostgresqlOperations                       metricInfo
+	PostgresqlQueryCalls                       metricInfo
+	PostgresqlQueryDuration                    metricInfo
+	PostgresqlQueryRows                        metricInfo
+	PostgresqlReplicationDataDelay             metricInfo
+	PostgresqlReplicationSlotConfirmedFlushLag metricInfo
+	PostgresqlReplicationSlotRetainedWal       metricInfo
+	PostgresqlRollbacks                        metricInfo
+	PostgresqlRows                             metricInfo
+	PostgresqlSequentialScans                  metricInfo
+	PostgresqlTableCount                       metricInfo
+	PostgresqlTableSize                        metricInfo
+	PostgresqlTableVacuumCount                 metricInfo
+	PostgresqlTempIo                           metricInfo
+	PostgresqlTempFiles                        metricInfo
+	PostgresqlTupDeleted                       metricInfo
+	PostgresqlTupFetched                       metricInfo
+	PostgresqlTupInserted                      metricInfo
+	PostgresqlTupReturned                      metricInfo
+	PostgresqlTupUpdated                       metricInfo
+	PostgresqlWalAge                           metricInfo
+	PostgresqlWalDelay                         metricInfo
+	PostgresqlWalLag                           metricInfo
 }
 
 type metricInfo struct {
@@ -1393,6 +1439,174 @@ func newMetricPostgresqlOperations(cfg MetricConfig) metricPostgresqlOperations
 	return m
 }
 
+type metricPostgresqlQueryCalls struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills postgresql.query.calls metric with initial data.
+func (m *metricPostgresqlQueryCalls) init() {
+	m.data.SetName("postgresql.query.calls")
+	m.data.SetDescription("Number of times the statement was executed, for the top statements of pg_stat_statements.")
+	m.data.SetUnit("{call}")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricPostgresqlQueryCalls) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, dbNamespaceAttributeValue string, postgresqlQueryidAttributeValue string, postgresqlRolnameAttributeValue string, dbQueryTextAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("db.namespace", dbNamespaceAttributeValue)
+	dp.Attributes().PutStr("postgresql.queryid", postgresqlQueryidAttributeValue)
+	dp.Attributes().PutStr("postgresql.rolname", postgresqlRolnameAttributeValue)
+	dp.Attributes().PutStr("db.query.text", dbQueryTextAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricPostgresqlQueryCalls) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricPostgresqlQueryCalls) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricPostgresqlQueryCalls(cfg MetricConfig) metricPostgresqlQueryCalls {
+	m := metricPostgresqlQueryCalls{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricPostgresqlQueryDuration struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills postgresql.query.duration metric with initial data.
+func (m *metricPostgresqlQueryDuration) init() {
+	m.data.SetName("postgresql.query.duration")
+	m.data.SetDescription("Total time spent executing the statement, for the top statements of pg_stat_statements.")
+	m.data.SetUnit("s")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricPostgresqlQueryDuration) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64, dbNamespaceAttributeValue string, postgresqlQueryidAttributeValue string, postgresqlRolnameAttributeValue string, dbQueryTextAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetDoubleValue(val)
+	dp.Attributes().PutStr("db.namespace", dbNamespaceAttributeValue)
+	dp.Attributes().PutStr("postgresql.queryid", postgresqlQueryidAttributeValue)
+	dp.Attributes().PutStr("postgresql.rolname", postgresqlRolnameAttributeValue)
+	dp.Attributes().PutStr("db.query.text", dbQueryTextAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricPostgresqlQueryDuration) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricPostgresqlQueryDuration) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricPostgresqlQueryDuration(cfg MetricConfig) metricPostgresqlQueryDuration {
+	m := metricPostgresqlQueryDuration{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricPostgresqlQueryRows struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills postgresql.query.rows metric with initial data.
+func (m *metricPostgresqlQueryRows) init() {
+	m.data.SetName("postgresql.query.rows")
+	m.data.SetDescription("Number of rows retrieved or affected by the statement, for the top statements of pg_stat_statements.")
+	m.data.SetUnit("{row}")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricPostgresqlQueryRows) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, dbNamespaceAttributeValue string, postgresqlQueryidAttributeValue string, postgresqlRolnameAttributeValue string, dbQueryTextAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("db.namespace", dbNamespaceAttributeValue)
+	dp.Attributes().PutStr("postgresql.queryid", postgresqlQueryidAttributeValue)
+	dp.Attributes().PutStr("postgresql.rolname", postgresqlRolnameAttributeValue)
+	dp.Attributes().PutStr("db.query.text", dbQueryTextAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricPostgresqlQueryRows) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricPostgresqlQueryRows) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricPostgresqlQueryRows(cfg MetricConfig) metricPostgresqlQueryRows {
+	m := metricPostgresqlQueryRows{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 type metricPostgresqlReplicationDataDelay struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
@@ -1444,6 +1658,110 @@ func newMetricPostgresqlReplicationDataDelay(cfg MetricConfig) metricPostgresqlR
 	return m
 }
 
+type metricPostgresqlReplicationSlotConfirmedFlushLag struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills postgresql.replication_slot.confirmed_flush_lag metric with initial data.
+func (m *metricPostgresqlReplicationSlotConfirmedFlushLag) init() {
+	m.data.SetName("postgresql.replication_slot.confirmed_flush_lag")
+	m.data.SetDescription("Amount of WAL not yet confirmed by the consumer of the logical replication slot.")
+	m.data.SetUnit("By")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricPostgresqlReplicationSlotConfirmedFlushLag) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, replicationSlotAttributeValue string, replicationSlotTypeAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("replication_slot", replicationSlotAttributeValue)
+	dp.Attributes().PutStr("replication_slot_type", replicationSlotTypeAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricPostgresqlReplicationSlotConfirmedFlushLag) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricPostgresqlReplicationSlotConfirmedFlushLag) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricPostgresqlReplicationSlotConfirmedFlushLag(cfg MetricConfig) metricPostgresqlReplicationSlotConfirmedFlushLag {
+	m := metricPostgresqlReplicationSlotConfirmedFlushLag{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricPostgresqlReplicationSlotRetainedWal struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills postgresql.replication_slot.retained_wal metric with initial data.
+func (m *metricPostgresqlReplicationSlotRetainedWal) init() {
+	m.data.SetName("postgresql.replication_slot.retained_wal")
+	m.data.SetDescription("Amount of WAL retained by the replication slot, between its restart LSN and the current WAL position.")
+	m.data.SetUnit("By")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricPostgresqlReplicationSlotRetainedWal) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, replicationSlotAttributeValue string, replicationSlotTypeAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("replication_slot", replicationSlotAttributeValue)
+	dp.Attributes().PutStr("replication_slot_type", replicationSlotTypeAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricPostgresqlReplicationSlotRetainedWal) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricPostgresqlReplicationSlotRetainedWal) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricPostgresqlReplicationSlotRetainedWal(cfg MetricConfig) metricPostgresqlReplicationSlotRetainedWal {
+	m := metricPostgresqlReplicationSlotRetainedWal{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 type metricPostgresqlRollbacks struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
@@ -2265,49 +2583,54 @@ func newMetricPostgresqlWalLag(cfg MetricConfig) metricPostgresqlWalLag {
 // MetricsBuilder provides an interface for scrapers to report metrics while taking care of all the transformations
 // required to produce metric representation defined in metadata and user config.
 type MetricsBuilder struct {
-	config                                   MetricsBuilderConfig // config of the metrics builder.
-	startTime                                pcommon.Timestamp    // start time that will be applied to all recorded data points.
-	metricsCapacity                          int                  // maximum observed number of metrics per resource.
-	metricsBuffer                            pmetric.Metrics      // accumulates metrics data before emitting.
-	buildInfo                                component.BuildInfo  // contains version information.
-	resourceAttributeIncludeFilter           map[string]filter.Filter
-	resourceAttributeExcludeFilter           map[string]filter.Filter
-	metricPostgresqlBackends                 metricPostgresqlBackends
-	metricPostgresqlBgwriterBuffersAllocated metricPostgresqlBgwriterBuffersAllocated
-	metricPostgresqlBgwriterBuffersWrites    metricPostgresqlBgwriterBuffersWrites
-	metricPostgresqlBgwriterCheckpointCount  metricPostgresqlBgwriterCheckpointCount
-	metricPostgresqlBgwriterDuration         metricPostgresqlBgwriterDuration
-	metricPostgresqlBgwriterMaxwritten       metricPostgresqlBgwriterMaxwritten
-	metricPostgresqlBlksHit                  metricPostgresqlBlksHit
-	metricPostgresqlBlksRead                 metricPostgresqlBlksRead
-	metricPostgresqlBlocksRead               metricPostgresqlBlocksRead
-	metricPostgresqlCommits                  metricPostgresqlCommits
-	metricPostgresqlConnectionMax            metricPostgresqlConnectionMax
-	metricPostgresqlDatabaseCount            metricPostgresqlDatabaseCount
-	metricPostgresqlDatabaseLocks            metricPostgresqlDatabaseLocks
-	metricPostgresqlDbSize                   metricPostgresqlDbSize
-	metricPostgresqlDeadlocks                metricPostgresqlDeadlocks
-	metricPostgresqlFunctionCalls            metricPostgresqlFunctionCalls
-	metricPostgresqlIndexScans               metricPostgresqlIndexScans
-	metricPostgresqlIndexSize                metricPostgresqlIndexSize
-	metricPostgresqlOperations               metricPostgresqlOperations
-	metricPostgresqlReplicationDataDelay     metricPostgresqlReplicationDataDelay
-	metricPostgresqlRollbacks                metricPostgresqlRollbacks
-	metricPostgresqlRows                     metricPostgresqlRows
-	metricPostgresqlSequentialScans          metricPostgresqlSequentialScans
-	metricPostgresqlTableCount               metricPostgresqlTableCount
-	metricPostgresqlTableSize                metricPostgresqlTableSize
-	metricPostgresqlTableVacuumCount         metricPostgresqlTableVacuumCount
-	metricPostgresqlTempIo                   metricPostgresqlTempIo
-	metricPostgresqlTempFiles                metricPostgresqlTempFiles
-	metricPostgresqlTupDeleted               metricPostgresqlTupDeleted
-	metricPostgresqlTupFetched               metricPostgresqlTupFetched
-	metricPostgresqlTupInserted              metricPostgresqlTupInserted
-	metricPostgresqlTupReturned              metricPostgresqlTupReturned
-	metricPostgresqlTupUpdated               metricPostgresqlTupUpdated
-	metricPostgresqlWalAge                   metricPostgresqlWalAge
-	metricPostgresqlWalDelay                 metricPostgresqlWalDelay
-	metricPostgresqlWalLag                   metricPostgresqlWalLag
+	config                                           MetricsBuilderConfig // config of the metrics builder.
+	startTime                                        pcommon.Timestamp    // start time that will be applied to all recorded data points.
+	metricsCapacity                                  int                  // maximum observed number of metrics per resource.
+	metricsBuffer                                    pmetric.Metrics      // accumulates metrics data before emitting.
+	buildInfo                                        component.BuildInfo  // contains version information.
+	resourceAttributeIncludeFilter                   map[string]filter.Filter
+	resourceAttributeExcludeFilter                   map[string]filter.Filter
+	metricPostgresqlBackends                         metricPostgresqlBackends
+	metricPostgresqlBgwriterBuffersAllocated         metricPostgresqlBgwriterBuffersAllocated
+	metricPostgresqlBgwriterBuffersWrites            metricPostgresqlBgwriterBuffersWrites
+	metricPostgresqlBgwriterCheckpointCount          metricPostgresqlBgwriterCheckpointCount
+	metricPostgresqlBgwriterDuration                 metricPostgresqlBgwriterDuration
+	metricPostgresqlBgwriterMaxwritten               metricPostgresqlBgwriterMaxwritten
+	metricPostgresqlBlksHit                          metricPostgresqlBlksHit
+	metricPostgresqlBlksRead                         metricPostgresqlBlksRead
+	metricPostgresqlBlocksRead                       metricPostgresqlBlocksRead
+	metricPostgresqlCommits                          metricPostgresqlCommits
+	metricPostgresqlConnectionMax                    metricPostgresqlConnectionMax
+	metricPostgresqlDatabaseCount                    metricPostgresqlDatabaseCount
+	metricPostgresqlDatabaseLocks                    metricPostgresqlDatabaseLocks
+	metricPostgresqlDbSize                           metricPostgresqlDbSize
+	metricPostgresqlDeadlocks                        metricPostgresqlDeadlocks
+	metricPostgresqlFunctionCalls                    metricPostgresqlFunctionCalls
+	metricPostgresqlIndexScans                       metricPostgresqlIndexScans
+	metricPostgresqlIndexSize                        metricPostgresqlIndexSize
+	metricPostgresqlOperations                       metricPostgresqlOperations
+	metricPostgresqlQueryCalls                       metricPostgresqlQueryCalls
+	metricPostgresqlQueryDuration                    metricPostgresqlQueryDuration
+	metricPostgresqlQueryRows                        metricPostgresqlQueryRows
+	metricPostgresqlReplicationDataDelay             metricPostgresqlReplicationDataDelay
+	metricPostgresqlReplicationSlotConfirmedFlushLag metricPostgresqlReplicationSlotConfirmedFlushLag
+	metricPostgresqlReplicationSlotRetainedWal       metricPostgresqlReplicationSlotRetainedWal
+	metricPostgresqlRollbacks                        metricPostgresqlRollbacks
+	metricPostgresqlRows                             metricPostgresqlRows
+	metricPostgresqlSequentialScans                  metricPostgresqlSequentialScans
+	metricPostgresqlTableCount                       metricPostgresqlTableCount
+	metricPostgresqlTableSize                        metricPostgresqlTableSize
+	metricPostgresqlTableVacuumCount                 metricPostgresqlTableVacuumCount
+	metricPostgresqlTempIo                           metricPostgresqlTempIo
+	metricPostgresqlTempFiles                        metricPostgresqlTempFiles
+	metricPostgresqlTupDeleted                       metricPostgresqlTupDeleted
+	metricPostgresqlTupFetched                       metricPostgresqlTupFetched
+	metricPostgresqlTupInserted                      metricPostgresqlTupInserted
+	metricPostgresqlTupReturned                      metricPostgresqlTupReturned
+	metricPostgresqlTupUpdated                       metricPostgresqlTupUpdated
+	metricPostgresqlWalAge                           metricPostgresqlWalAge
+	metricPostgresqlWalDelay                         metricPostgresqlWalDelay
+	metricPostgresqlWalLag                           metricPostgresqlWalLag
 }
 
 // MetricBuilderOption applies changes to default metrics builder.
@@ -2329,48 +2652,53 @@ func WithStartTime(startTime pcommon.Timestamp) MetricBuilderOption {
 }
 func NewMetricsBuilder(mbc MetricsBuilderConfig, settings receiver.Settings, options ...MetricBuilderOption) *MetricsBuilder {
 	mb := &MetricsBuilder{
-		config:                                   mbc,
-		startTime:                                pcommon.NewTimestampFromTime(time.Now()),
-		metricsBuffer:                            pmetric.NewMetrics(),
-		buildInfo:                                settings.BuildInfo,
-		metricPostgresqlBackends:                 newMetricPostgresqlBackends(mbc.Metrics.PostgresqlBackends),
-		metricPostgresqlBgwriterBuffersAllocated: newMetricPostgresqlBgwriterBuffersAllocated(mbc.Metrics.PostgresqlBgwriterBuffersAllocated),
-		metricPostgresqlBgwriterBuffersWrites:    newMetricPostgresqlBgwriterBuffersWrites(mbc.Metrics.PostgresqlBgwriterBuffersWrites),
-		metricPostgresqlBgwriterCheckpointCount:  newMetricPostgresqlBgwriterCheckpointCount(mbc.Metrics.PostgresqlBgwriterCheckpointCount),
-		metricPostgresqlBgwriterDuration:         newMetricPostgresqlBgwriterDuration(mbc.Metrics.PostgresqlBgwriterDuration),
-		metricPostgresqlBgwriterMaxwritten:       newMetricPostgresqlBgwriterMaxwritten(mbc.Metrics.PostgresqlBgwriterMaxwritten),
-		metricPostgresqlBlksHit:                  newMetricPostgresqlBlksHit(mbc.Metrics.PostgresqlBlksHit),
-		metricPostgresqlBlksRead:                 newMetricPostgresqlBlksRead(mbc.Metrics.PostgresqlBlksRead),
-		metricPostgresqlBlocksRead:               newMetricPostgresqlBlocksRead(mbc.Metrics.PostgresqlBlocksRead),
-		metricPostgresqlCommits:                  newMetricPostgresqlCommits(mbc.Metrics.PostgresqlCommits),
-		metricPostgresqlConnectionMax:            newMetricPostgresqlConnectionMax(mbc.Metrics.PostgresqlConnectionMax),
-		metricPostgresqlDatabaseCount:            newMetricPostgresqlDatabaseCount(mbc.Metrics.PostgresqlDatabaseCount),
-		metricPostgresqlDatabaseLocks:            newMetricPostgresqlDatabaseLocks(mbc.Metrics.PostgresqlDatabaseLocks),
-		metricPostgresqlDbSize:                   newMetricPostgresqlDbSize(mbc.Metrics.PostgresqlDbSize),
-		metricPostgresqlDeadlocks:                newMetricPostgresqlDeadlocks(mbc.Metrics.PostgresqlDeadlocks),
-		metricPostgresqlFunctionCalls:            newMetricPostgresqlFunctionCalls(mbc.Metrics.PostgresqlFunctionCalls),
-		metricPostgresqlIndexScans:               newMetricPostgresqlIndexScans(mbc.Metrics.PostgresqlIndexScans),
-		metricPostgresqlIndexSize:                newMetricPostgresqlIndexSize(mbc.Metrics.PostgresqlIndexSize),
-		metricPostgresqlOperations:               newMetricPostgresqlOperations(mbc.Metrics.PostgresqlOperations),
-		metricPostgresqlReplicationDataDelay:     newMetricPostgresqlReplicationDataDelay(mbc.Metrics.PostgresqlReplicationDataDelay),
-		metricPostgresqlRollbacks:                newMetricPostgresqlRollbacks(mbc.Metrics.PostgresqlRollbacks),
-		metricPostgresqlRows:                     newMetricPostgresqlRows(mbc.Metrics.PostgresqlRows),
-		metricPostgresqlSequentialScans:          newMetricPostgresqlSequentialScans(mbc.Metrics.PostgresqlSequentialScans),
-		metricPostgresqlTableCount:               newMetricPostgresqlTableCount(mbc.Metrics.PostgresqlTableCount),
-		metricPostgresqlTableSize:                newMetricPostgresqlTableSize(mbc.Metrics.PostgresqlTableSize),
-		metricPostgresqlTableVacuumCount:         newMetricPostgresqlTableVacuumCount(mbc.Metrics.PostgresqlTableVacuumCount),
-		metricPostgresqlTempIo:                   newMetricPostgresqlTempIo(mbc.Metrics.PostgresqlTempIo),
-		metricPostgresqlTempFiles:                newMetricPostgresqlTempFiles(mbc.Metrics.PostgresqlTempFiles),
-		metricPostgresqlTupDeleted:               newMetricPostgresqlTupDeleted(mbc.Metrics.PostgresqlTupDeleted),
-		metricPostgresqlTupFetched:               newMetricPostgresqlTupFetched(mbc.Metrics.PostgresqlTupFetched),
-		metricPostgresqlTupInserted:              newMetricPostgresqlTupInserted(mbc.Metrics.PostgresqlTupInserted),
-		metricPostgresqlTupReturned:              newMetricPostgresqlTupReturned(mbc.Metrics.PostgresqlTupReturned),
-		metricPostgresqlTupUpdated:               newMetricPostgresqlTupUpdated(mbc.Metrics.PostgresqlTupUpdated),
-		metricPostgresqlWalAge:                   newMetricPostgresqlWalAge(mbc.Metrics.PostgresqlWalAge),
-		metricPostgresqlWalDelay:                 newMetricPostgresqlWalDelay(mbc.Metrics.PostgresqlWalDelay),
-		metricPostgresqlWalLag:                   newMetricPostgresqlWalLag(mbc.Metrics.PostgresqlWalLag),
-		resourceAttributeIncludeFilter:           make(map[string]filter.Filter),
-		resourceAttributeExcludeFilter:           make(map[string]filter.Filter),
+		config:                                           mbc,
+		startTime:                                        pcommon.NewTimestampFromTime(time.Now()),
+		metricsBuffer:                                    pmetric.NewMetrics(),
+		buildInfo:                                        settings.BuildInfo,
+		metricPostgresqlBackends:                         newMetricPostgresqlBackends(mbc.Metrics.PostgresqlBackends),
+		metricPostgresqlBgwriterBuffersAllocated:         newMetricPostgresqlBgwriterBuffersAllocated(mbc.Metrics.PostgresqlBgwriterBuffersAllocated),
+		metricPostgresqlBgwriterBuffersWrites:            newMetricPostgresqlBgwriterBuffersWrites(mbc.Metrics.PostgresqlBgwriterBuffersWrites),
+		metricPostgresqlBgwriterCheckpointCount:          newMetricPostgresqlBgwriterCheckpointCount(mbc.Metrics.PostgresqlBgwriterCheckpointCount),
+		metricPostgresqlBgwriterDuration:                 newMetricPostgresqlBgwriterDuration(mbc.Metrics.PostgresqlBgwriterDuration),
+		metricPostgresqlBgwriterMaxwritten:               newMetricPostgresqlBgwriterMaxwritten(mbc.Metrics.PostgresqlBgwriterMaxwritten),
+		metricPostgresqlBlksHit:                          newMetricPostgresqlBlksHit(mbc.Metrics.PostgresqlBlksHit),
+		metricPostgresqlBlksRead:                         newMetricPostgresqlBlksRead(mbc.Metrics.PostgresqlBlksRead),
+		metricPostgresqlBlocksRead:                       newMetricPostgresqlBlocksRead(mbc.Metrics.PostgresqlBlocksRead),
+		metricPostgresqlCommits:                          newMetricPostgresqlCommits(mbc.Metrics.PostgresqlCommits),
+		metricPostgresqlConnectionMax:                    newMetricPostgresqlConnectionMax(mbc.Metrics.PostgresqlConnectionMax),
+		metricPostgresqlDatabaseCount:                    newMetricPostgresqlDatabaseCount(mbc.Metrics.PostgresqlDatabaseCount),
+		metricPostgresqlDatabaseLocks:                    newMetricPostgresqlDatabaseLocks(mbc.Metrics.PostgresqlDatabaseLocks),
+		metricPostgresqlDbSize:                           newMetricPostgresqlDbSize(mbc.Metrics.PostgresqlDbSize),
+		metricPostgresqlDeadlocks:                        newMetricPostgresqlDeadlocks(mbc.Metrics.PostgresqlDeadlocks),
+		metricPostgresqlFunctionCalls:                    newMetricPostgresqlFunctionCalls(mbc.Metrics.PostgresqlFunctionCalls),
+		metricPostgresqlIndexScans:                       newMetricPostgresqlIndexScans(mbc.Metrics.PostgresqlIndexScans),
+		metricPostgresqlIndexSize:                        newMetricPostgresqlIndexSize(mbc.Metrics.PostgresqlIndexSize),
+		metricPostgresqlOperations:                       newMetricPostgresqlOperations(mbc.Metrics.PostgresqlOperations),
+		metricPostgresqlQueryCalls:                       newMetricPostgresqlQueryCalls(mbc.Metrics.PostgresqlQueryCalls),
+		metricPostgresqlQueryDuration:                    newMetricPostgresqlQueryDuration(mbc.Metrics.PostgresqlQueryDuration),
+		metricPostgresqlQueryRows:                        newMetricPostgresqlQueryRows(mbc.Metrics.PostgresqlQueryRows),
+		metricPostgresqlReplicationDataDelay:             newMetricPostgresqlReplicationDataDelay(mbc.Metrics.PostgresqlReplicationDataDelay),
+		metricPostgresqlReplicationSlotConfirmedFlushLag: newMetricPostgresqlReplicationSlotConfirmedFlushLag(mbc.Metrics.PostgresqlReplicationSlotConfirmedFlushLag),
+		metricPostgresqlReplicationSlotRetainedWal:       newMetricPostgresqlReplicationSlotRetainedWal(mbc.Metrics.PostgresqlReplicationSlotRetainedWal),
+		metricPostgresqlRollbacks:                        newMetricPostgresqlRollbacks(mbc.Metrics.PostgresqlRollbacks),
+		metricPostgresqlRows:                             newMetricPostgresqlRows(mbc.Metrics.PostgresqlRows),
+		metricPostgresqlSequentialScans:                  newMetricPostgresqlSequentialScans(mbc.Metrics.PostgresqlSequentialScans),
+		metricPostgresqlTableCount:                       newMetricPostgresqlTableCount(mbc.Metrics.PostgresqlTableCount),
+		metricPostgresqlTableSize:                        newMetricPostgresqlTableSize(mbc.Metrics.PostgresqlTableSize),
+		metricPostgresqlTableVacuumCount:                 newMetricPostgresqlTableVacuumCount(mbc.Metrics.PostgresqlTableVacuumCount),
+		metricPostgresqlTempIo:                           newMetricPostgresqlTempIo(mbc.Metrics.PostgresqlTempIo),
+		metricPostgresqlTempFiles:                        newMetricPostgresqlTempFiles(mbc.Metrics.PostgresqlTempFiles),
+		metricPostgresqlTupDeleted:                       newMetricPostgresqlTupDeleted(mbc.Metrics.PostgresqlTupDeleted),
+		metricPostgresqlTupFetched:                       newMetricPostgresqlTupFetched(mbc.Metrics.PostgresqlTupFetched),
+		metricPostgresqlTupInserted:                      newMetricPostgresqlTupInserted(mbc.Metrics.PostgresqlTupInserted),
+		metricPostgresqlTupReturned:                      newMetricPostgresqlTupReturned(mbc.Metrics.PostgresqlTupReturned),
+		metricPostgresqlTupUpdated:                       newMetricPostgresqlTupUpdated(mbc.Metrics.PostgresqlTupUpdated),
+		metricPostgresqlWalAge:                           newMetricPostgresqlWalAge(mbc.Metrics.PostgresqlWalAge),
+		metricPostgresqlWalDelay:                         newMetricPostgresqlWalDelay(mbc.Metrics.PostgresqlWalDelay),
+		metricPostgresqlWalLag:                           newMetricPostgresqlWalLag(mbc.Metrics.PostgresqlWalLag),
+		resourceAttributeIncludeFilter:                   make(map[string]filter.Filter),
+		resourceAttributeExcludeFilter:                   make(map[string]filter.Filter),
 	}
 	if mbc.ResourceAttributes.PostgresqlDatabaseName.MetricsInclude != nil {
 		mb.resourceAttributeIncludeFilter["postgresql.database.name"] = filter.CreateFilter(mbc.ResourceAttributes.PostgresqlDatabaseName.MetricsInclude)
@@ -2484,7 +2812,12 @@ func (mb *MetricsBuilder) EmitForResource(options ...ResourceMetricsOption) {
 	mb.metricPostgresqlIndexScans.emit(ils.Metrics())
 	mb.metricPostgresqlIndexSize.emit(ils.Metrics())
 	mb.metricPostgresqlOperations.emit(ils.Metrics())
+	mb.metricPostgresqlQueryCalls.emit(ils.Metrics())
+	mb.metricPostgresqlQueryDuration.emit(ils.Metrics())
+	mb.metricPostgresqlQueryRows.emit(ils.Metrics())
 	mb.metricPostgresqlReplicationDataDelay.emit(ils.Metrics())
+	mb.metricPostgresqlReplicationSlotConfirmedFlushLag.emit(ils.Metrics())
+	mb.metricPostgresqlReplicationSlotRetainedWal.emit(ils.Metrics())
 	mb.metricPostgresqlRollbacks.emit(ils.Metrics())
 	mb.metricPostgresqlRows.emit(ils.Metrics())
 	mb.metricPostgresqlSequentialScans.emit(ils.Metrics())
@@ -2627,11 +2960,36 @@ func (mb *MetricsBuilder) RecordPostgresqlOperationsDataPoint(ts pcommon.Timesta
 	mb.metricPostgresqlOperations.recordDataPoint(mb.startTime, ts, val, operationAttributeValue.String())
 }
 
+// RecordPostgresqlQueryCallsDataPoint adds a data point to postgresql.query.calls metric.
+func (mb *MetricsBuilder) RecordPostgresqlQueryCallsDataPoint(ts pcommon.Timestamp, val int64, dbNamespaceAttributeValue string, postgresqlQueryidAttributeValue string, postgresqlRolnameAttributeValue string, dbQueryTextAttributeValue string) {
+	mb.metricPostgresqlQueryCalls.recordDataPoint(mb.startTime, ts, val, dbNamespaceAttributeValue, postgresqlQueryidAttributeValue, postgresqlRolnameAttributeValue, dbQueryTextAttributeValue)
+}
+
+// RecordPostgresqlQueryDurationDataPoint adds a data point to postgresql.query.duration metric.
+func (mb *MetricsBuilder) RecordPostgresqlQueryDurationDataPoint(ts pcommon.Timestamp, val float64, dbNamespaceAttributeValue string, postgresqlQueryidAttributeValue string, postgresqlRolnameAttributeValue string, dbQueryTextAttributeValue string) {
+	mb.metricPostgresqlQueryDuration.recordDataPoint(mb.startTime, ts, val, dbNamespaceAttributeValue, postgresqlQueryidAttributeValue, postgresqlRolnameAttributeValue, dbQueryTextAttributeValue)
+}
+
+// RecordPostgresqlQueryRowsDataPoint adds a data point to postgresql.query.rows metric.
+func (mb *MetricsBuilder) RecordPostgresqlQueryRowsDataPoint(ts pcommon.Timestamp, val int64, dbNamespaceAttributeValue string, postgresqlQueryidAttributeValue string, postgresqlRolnameAttributeValue string, dbQueryTextAttributeValue string) {
+	mb.metricPostgresqlQueryRows.recordDataPoint(mb.startTime, ts, val, dbNamespaceAttributeValue, postgresqlQueryidAttributeValue, postgresqlRolnameAttributeValue, dbQueryTextAttributeValue)
+}
+
 // RecordPostgresqlReplicationDataDelayDataPoint adds a data point to postgresql.replication.data_delay metric.
 func (mb *MetricsBuilder) RecordPostgresqlReplicationDataDelayDataPoint(ts pcommon.Timestamp, val int64, replicationClientAttributeValue string) {
 	mb.metricPostgresqlReplicationDataDelay.recordDataPoint(mb.startTime, ts, val, replicationClientAttributeValue)
 }
 
+// RecordPostgresqlReplicationSlotConfirmedFlushLagDataPoint adds a data point to postgresql.replication_slot.confirmed_flush_lag metric.
+func (mb *MetricsBuilder) RecordPostgresqlReplicationSlotConfirmedFlushLagDataPoint(ts pcommon.Timestamp, val int64, replicationSlotAttributeValue string, replicationSlotTypeAttributeValue AttributeReplicationSlotType) {
+	mb.metricPostgresqlReplicationSlotConfirmedFlushLag.recordDataPoint(mb.startTime, ts, val, replicationSlotAttributeValue, replicationSlotTypeAttributeValue.String())
+}
+
+// RecordPostgresqlReplicationSlotRetainedWalDataPoint adds a data point to postgresql.replication_slot.retained_wal metric.
+func (mb *MetricsBuilder) RecordPostgresqlReplicationSlotRetainedWalDataPoint(ts pcommon.Timestamp, val int64, replicationSlotAttributeValue string, replicationSlotTypeAttributeValue AttributeReplicationSlotType) {
+	mb.metricPostgresqlReplicationSlotRetainedWal.recordDataPoint(mb.startTime, ts, val, replicationSlotAttributeValue, replicationSlotTypeAttributeValue.String())
+}
+
 // RecordPostgresqlRollbacksDataPoint adds a data point to postgresql.rollbacks metric.
 func (mb *MetricsBuilder) RecordPostgresqlRollbacksDataPoint(ts pcommon.Timestamp, val int64) {
 	mb.metricPostgresqlRollbacks.recordDataPoint(mb.startTime, ts, val)
@@ -139,10 +139,25 @@ func TestMetricsBuilder(t *testing.T) {
 			allMetricsCount++
 			mb.RecordPostgresqlOperationsDataPoint(ts, 1, AttributeOperationIns)
 
+			allMetricsCount++
+			mb.RecordPostgresqlQueryCallsDataPoint(ts, 1, "db.namespace-val", "postgresql.queryid-val", "postgresql.rolname-val", "db.query.text-val")
+
+			allMetricsCount++
+			mb.RecordPostgresqlQueryDurationDataPoint(ts, 1, "db.namespace-val", "postgresql.queryid-val", "postgresql.rolname-val", "db.query.text-val")
+
+			allMetricsCount++
+			mb.RecordPostgresqlQueryRowsDataPoint(ts, 1, "db.namespace-val", "postgresql.queryid-val", "postgresql.rolname-val", "db.query.text-val")
+
 			defaultMetricsCount++
 			allMetricsCount++
 			mb.RecordPostgresqlReplicationDataDelayDataPoint(ts, 1, "replication_client-val")
 
+			allMetricsCount++
+			mb.RecordPostgresqlReplicationSlotConfirmedFlushLagDataPoint(ts, 1, "replication_slot-val", AttributeReplicationSlotTypeLogical)
+
+			allMetricsCount++
+			mb.RecordPostgresqlReplicationSlotRetainedWalDataPoint(ts, 1, "replication_slot-val", AttributeReplicationSlotTypeLogical)
+
 			defaultMetricsCount++
 			allMetricsCount++
 			mb.RecordPostgresqlRollbacksDataPoint(ts, 1)
@@ -512,6 +527,84 @@ func TestMetricsBuilder(t *testing.T) {
 					attrVal, ok := dp.Attributes().Get("operation")
 					assert.True(t, ok)
 					assert.Equal(t, "ins", attrVal.Str())
+				case "postgresql.query.calls":
+					assert.False(t, validatedMetrics["postgresql.query.calls"], "Found a duplicate in the metrics slice: postgresql.query.calls")
+					validatedMetrics["postgresql.query.calls"] = true
+					assert.Equal(t, pmetric.MetricTypeSum, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Sum().DataPoints().Len())
+					assert.Equal(t, "Number of times the statement was executed, for the top statements of pg_stat_statements.", ms.At(i).Description())
+					assert.Equal(t, "{call}", ms.At(i).Unit())
+					assert.True(t, ms.At(i).Sum().IsMonotonic())
+					assert.Equal(t, pmetric.AggregationTemporalityCumulative, ms.At(i).Sum().AggregationTemporality())
+					dp := ms.At(i).Sum().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("db.namespace")
+					assert.True(t, ok)
+					assert.Equal(t, "db.namespace-val", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("postgresql.queryid")
+					assert.True(t, ok)
+					assert.Equal(t, "postgresql.queryid-val", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("postgresql.rolname")
+					assert.True(t, ok)
+					assert.Equal(t, "postgresql.rolname-val", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("db.query.text")
+					assert.True(t, ok)
+					assert.Equal(t, "db.query.text-val", attrVal.Str())
+				case "postgresql.query.duration":
+					assert.False(t, validatedMetrics["postgresql.query.duration"], "Found a duplicate in the metrics slice: postgresql.query.duration")
+					validatedMetrics["postgresql.query.duration"] = true
+					assert.Equal(t, pmetric.MetricTypeSum, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Sum().DataPoints().Len())
+					assert.Equal(t, "Total time spent executing the statement, for the top statements of pg_stat_statements.", ms.At(i).Description())
+					assert.Equal(t, "s", ms.At(i).Unit())
+					assert.True(t, ms.At(i).Sum().IsMonotonic())
+					assert.Equal(t, pmetric.AggregationTemporalityCumulative, ms.At(i).Sum().AggregationTemporality())
+					dp := ms.At(i).Sum().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeDouble, dp.ValueType())
+					assert.InDelta(t, float64(1), dp.DoubleValue(), 0.01)
+					attrVal, ok := dp.Attributes().Get("db.namespace")
+					assert.True(t, ok)
+					assert.Equal(t, "db.namespace-val", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("postgresql.queryid")
+					assert.True(t, ok)
+					assert.Equal(t, "postgresql.queryid-val", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("postgresql.rolname")
+					assert.True(t, ok)
+					assert.Equal(t, "postgresql.rolname-val", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("db.query.text")
+					assert.True(t, ok)
+					assert.Equal(t, "db.query.text-val", attrVal.Str())
+				case "postgresql.query.rows":
+					assert.False(t, validatedMetrics["postgresql.query.rows"], "Found a duplicate in the metrics slice: postgresql.query.rows")
+					validatedMetrics["postgresql.query.rows"] = true
+					assert.Equal(t, pmetric.MetricTypeSum, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Sum().DataPoints().Len())
+					assert.Equal(t, "Number of rows retrieved or affected by the statement, for the top statements of pg_stat_statements.", ms.At(i).Description())
+					assert.Equal(t, "{row}", ms.At(i).Unit())
+					assert.True(t, ms.At(i).Sum().IsMonotonic())
+					assert.Equal(t, pmetric.AggregationTemporalityCumulative, ms.At(i).Sum().AggregationTemporality())
+					dp := ms.At(i).Sum().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("db.namespace")
+					assert.True(t, ok)
+					assert.Equal(t, "db.namespace-val", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("postgresql.queryid")
+					assert.True(t, ok)
+					assert.Equal(t, "postgresql.queryid-val", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("postgresql.rolname")
+					assert.True(t, ok)
+					assert.Equal(t, "postgresql.rolname-val", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("db.query.text")
+					assert.True(t, ok)
+					assert.Equal(t, "db.query.text-val", attrVal.Str())
 				case "postgresql.replication.data_delay":
 					assert.False(t, validatedMetrics["postgresql.replication.data_delay"], "Found a duplicate in the metrics slice: postgresql.replication.data_delay")
 					validatedMetrics["postgresql.replication.data_delay"] = true
@@ -527,6 +620,42 @@ func TestMetricsBuilder(t *testing.T) {
 					attrVal, ok := dp.Attributes().Get("replication_client")
 					assert.True(t, ok)
 					assert.Equal(t, "replication_client-val", attrVal.Str())
+				case "postgresql.replication_slot.confirmed_flush_lag":
+					assert.False(t, validatedMetrics["postgresql.replication_slot.confirmed_flush_lag"], "Found a duplicate in the metrics slice: postgresql.replication_slot.confirmed_flush_lag")
+					validatedMetrics["postgresql.replication_slot.confirmed_flush_lag"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Amount of WAL not yet confirmed by the consumer of the logical replication slot.", ms.At(i).Description())
+					assert.Equal(t, "By", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("replication_slot")
+					assert.True(t, ok)
+					assert.Equal(t, "replication_slot-val", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("replication_slot_type")
+					assert.True(t, ok)
+					assert.Equal(t, "logical", attrVal.Str())
+				case "postgresql.replication_slot.retained_wal":
+					assert.False(t, validatedMetrics["postgresql.replication_slot.retained_wal"], "Found a duplicate in the metrics slice: postgresql.replication_slot.retained_wal")
+					validatedMetrics["postgresql.replication_slot.retained_wal"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Amount of WAL retained by the replication slot, between its restart LSN and the current WAL position.", ms.At(i).Description())
+					assert.Equal(t, "By", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("replication_slot")
+					assert.True(t, ok)
+					assert.Equal(t, "replication_slot-val", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("replication_slot_type")
+					assert.True(t, ok)
+					assert.Equal(t, "logical", attrVal.Str())
 				case "postgresql.rollbacks":
 					assert.False(t, validatedMetrics["postgresql.rollbacks"], "Found a duplicate in the metrics slice: postgresql.rollbacks")
 					validatedMetrics["postgresql.rollbacks"] = true
@@ -170,6 +170,8 @@ func (p *postgreSQLScraper) scrape(ctx context.Context) (pmetric.Metrics, error)
 	p.collectBGWriterStats(ctx, now, listClient, &errs)
 	p.collectWalAge(ctx, now, listClient, &errs)
 	p.collectReplicationStats(ctx, now, listClient, &errs)
+	p.collectReplicationSlotStats(ctx, now, listClient, &errs)
+	p.collectQueryStats(ctx, now, listClient, &errs)
 	p.collectMaxConnections(ctx, now, listClient, &errs)
 	p.collectDatabaseLocks(ctx, now, listClient, &errs)
 
@@ -582,6 +584,61 @@ func (p *postgreSQLScraper) collectMaxConnections(
 	p.mb.RecordPostgresqlConnectionMaxDataPoint(now, mc)
 }
 
+func (p *postgreSQLScraper) collectReplicationSlotStats(
+	ctx context.Context,
+	now pcommon.Timestamp,
+	client client,
+	errs *errsMux,
+) {
+	metrics := p.config.Metrics
+	if !metrics.PostgresqlReplicationSlotRetainedWal.Enabled && !metrics.PostgresqlReplicationSlotConfirmedFlushLag.Enabled {
+		return
+	}
+
+	rss, err := client.getReplicationSlotStats(ctx)
+	if err != nil {
+		errs.addPartial(err)
+		return
+	}
+	for _, rs := range rss {
+		slotType, ok := metadata.MapAttributeReplicationSlotType[rs.slotType]
+		if !ok {
+			continue
+		}
+		if rs.retainedWalBytes >= 0 {
+			p.mb.RecordPostgresqlReplicationSlotRetainedWalDataPoint(now, rs.retainedWalBytes, rs.slotName, slotType)
+		}
+		// The confirmed flush position is only set for logical slots.
+		if slotType == metadata.AttributeReplicationSlotTypeLogical && rs.confirmedFlushLagBytes >= 0 {
+			p.mb.RecordPostgresqlReplicationSlotConfirmedFlushLagDataPoint(now, rs.confirmedFlushLagBytes, rs.slotName, slotType)
+		}
+	}
+}
+
+func (p *postgreSQLScraper) collectQueryStats(
+	ctx context.Context,
+	now pcommon.Timestamp,
+	client client,
+	errs *errsMux,
+) {
+	metrics := p.config.Metrics
+	if !metrics.PostgresqlQueryCalls.Enabled && !metrics.PostgresqlQueryDuration.Enabled && !metrics.PostgresqlQueryRows.Enabled {
+		return
+	}
+
+	qss, err := client.getQueryStats(ctx, p.config.QueryMetricsCollection.TopN)
+	if err != nil {
+		errs.addPartial(err)
+		return
+	}
+	for _, qs := range qss {
+		p.mb.RecordPostgresqlQueryCallsDataPoint(now, qs.calls, qs.database, qs.queryID, qs.role, qs.query)
+		// pg_stat_statements reports the execution time in milliseconds.
+		p.mb.RecordPostgresqlQueryDurationDataPoint(now, qs.totalExecTime/1000, qs.database, qs.queryID, qs.role, qs.query)
+		p.mb.RecordPostgresqlQueryRowsDataPoint(now, qs.rows, qs.database, qs.queryID, qs.role, qs.query)
+	}
+}
+
 func (p *postgreSQLScraper) collectReplicationStats(
 	ctx context.Context,
 	now pcommon.Timestamp,
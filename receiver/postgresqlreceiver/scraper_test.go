@@ -18,6 +18,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/tj/assert"
 	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/receiver/receivertest"
 	"go.uber.org/zap"
@@ -513,6 +514,52 @@ func TestScrapeTopQueries(t *testing.T) {
 	assert.NoError(t, errs)
 }
 
+func TestCollectReplicationSlotAndQueryStats(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Metrics = metadata.MetricsConfig{}
+	cfg.Metrics.PostgresqlReplicationSlotRetainedWal.Enabled = true
+	cfg.Metrics.PostgresqlReplicationSlotConfirmedFlushLag.Enabled = true
+	cfg.Metrics.PostgresqlQueryCalls.Enabled = true
+	cfg.Metrics.PostgresqlQueryDuration.Enabled = true
+	cfg.QueryMetricsCollection.TopN = 5
+
+	client := new(mockClient)
+	client.On("getReplicationSlotStats", mock.Anything).Return([]replicationSlotStats{
+		{slotName: "standby", slotType: "physical", retainedWalBytes: 2048, confirmedFlushLagBytes: -1},
+		{slotName: "cdc", slotType: "logical", retainedWalBytes: 4096, confirmedFlushLagBytes: 1024},
+	}, nil)
+	client.On("getQueryStats", mock.Anything, int64(5)).Return([]queryStats{
+		{database: "otel", queryID: "114514", role: "otel", query: "SELECT * FROM t WHERE id = $1", calls: 12, totalExecTime: 1500, rows: 12},
+	}, nil)
+
+	scraper := newPostgreSQLScraper(receivertest.NewNopSettings(metadata.Type), cfg, new(mockClientFactory), newCache(1), newTTLCache[string](1, time.Second))
+	var errs errsMux
+	now := pcommon.NewTimestampFromTime(time.Now())
+	scraper.collectReplicationSlotStats(context.Background(), now, client, &errs)
+	scraper.collectQueryStats(context.Background(), now, client, &errs)
+	require.NoError(t, errs.combine())
+
+	metrics := scraper.mb.Emit().ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	byName := map[string]pmetric.Metric{}
+	for i := 0; i < metrics.Len(); i++ {
+		byName[metrics.At(i).Name()] = metrics.At(i)
+	}
+	require.Len(t, byName, 4)
+
+	assert.Equal(t, 2, byName["postgresql.replication_slot.retained_wal"].Gauge().DataPoints().Len())
+	lag := byName["postgresql.replication_slot.confirmed_flush_lag"].Gauge().DataPoints()
+	require.Equal(t, 1, lag.Len())
+	assert.Equal(t, int64(1024), lag.At(0).IntValue())
+	slot, _ := lag.At(0).Attributes().Get("replication_slot")
+	assert.Equal(t, "cdc", slot.Str())
+
+	assert.Equal(t, int64(12), byName["postgresql.query.calls"].Sum().DataPoints().At(0).IntValue())
+	duration := byName["postgresql.query.duration"].Sum().DataPoints().At(0)
+	assert.InDelta(t, 1.5, duration.DoubleValue(), 1e-9)
+	queryID, _ := duration.Attributes().Get("postgresql.queryid")
+	assert.Equal(t, "114514", queryID.Str())
+}
+
 type (
 	mockClientFactory       struct{ mock.Mock }
 	mockClient              struct{ mock.Mock }
@@ -616,6 +663,16 @@ func (m *mockClient) getReplicationStats(ctx context.Context) ([]replicationStat
 	return args.Get(0).([]replicationStats), args.Error(1)
 }
 
+func (m *mockClient) getReplicationSlotStats(ctx context.Context) ([]replicationSlotStats, error) {
+	args := m.Called(ctx)
+	return args.Get(0).([]replicationSlotStats), args.Error(1)
+}
+
+func (m *mockClient) getQueryStats(ctx context.Context, limit int64) ([]queryStats, error) {
+	args := m.Called(ctx, limit)
+	return args.Get(0).([]queryStats), args.Error(1)
+}
+
 func (m *mockClient) listDatabases(_ context.Context) ([]string, error) {
 	args := m.Called()
 	return args.Get(0).([]string), args.Error(1)
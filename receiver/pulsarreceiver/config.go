@@ -5,6 +5,8 @@ package pulsarreceiver // import "github.com/open-telemetry/opentelemetry-collec
 
 import (
 	"errors"
+	"fmt"
+	"time"
 
 	"github.com/apache/pulsar-client-go/pulsar"
 	"go.opentelemetry.io/collector/component"
@@ -20,6 +22,17 @@ type Config struct {
 	Topic string `mapstructure:"topic"`
 	// The Subscription that receiver will be consuming messages from (default "otlp_subscription")
 	Subscription string `mapstructure:"subscription"`
+	// The type of the subscription: exclusive, shared, failover or key_shared (default "failover")
+	SubscriptionType string `mapstructure:"subscription_type"`
+	// KeyShared configures the key_shared subscription type.
+	KeyShared KeyShared `mapstructure:"key_shared"`
+	// DeadLetter configures the topic messages are moved to after failing to be consumed too many times.
+	DeadLetter configoptional.Optional[DeadLetter] `mapstructure:"dead_letter"`
+	// NackRedeliveryDelay is the delay after which negatively acknowledged messages are redelivered
+	// (default: the Pulsar client default of 1 minute). Ignored if nack_backoff is set.
+	NackRedeliveryDelay time.Duration `mapstructure:"nack_redelivery_delay"`
+	// NackBackoff configures an exponential redelivery delay for negatively acknowledged messages.
+	NackBackoff configoptional.Optional[NackBackoff] `mapstructure:"nack_backoff"`
 	// Encoding of the messages (default "otlp_proto")
 	Encoding string `mapstructure:"encoding"`
 	// Name specifies the consumer name.
@@ -31,6 +44,26 @@ type Config struct {
 	Authentication             Authentication `mapstructure:"auth"`
 }
 
+type KeyShared struct {
+	// AllowOutOfOrderDelivery relaxes the ordering guarantee of messages with the same key
+	// when consumers join or leave the subscription.
+	AllowOutOfOrderDelivery bool `mapstructure:"allow_out_of_order_delivery"`
+}
+
+type DeadLetter struct {
+	// MaxDeliveries is the number of deliveries after which a message is moved to the dead letter topic.
+	MaxDeliveries uint32 `mapstructure:"max_deliveries"`
+	// Topic is the dead letter topic (default: the Pulsar client default of "<topic>-<subscription>-DLQ").
+	Topic string `mapstructure:"topic"`
+}
+
+type NackBackoff struct {
+	// InitialInterval is the redelivery delay after the first negative acknowledgment.
+	InitialInterval time.Duration `mapstructure:"initial_interval"`
+	// MaxInterval is the upper bound of the redelivery delay.
+	MaxInterval time.Duration `mapstructure:"max_interval"`
+}
+
 type Authentication struct {
 	TLS    configoptional.Optional[TLS]    `mapstructure:"tls"`
 	Token  configoptional.Optional[Token]  `mapstructure:"token"`
@@ -65,8 +98,32 @@ type OAuth2 struct {
 
 var _ component.Config = (*Config)(nil)
 
+var subscriptionTypes = map[string]pulsar.SubscriptionType{
+	"exclusive":  pulsar.Exclusive,
+	"shared":     pulsar.Shared,
+	"failover":   pulsar.Failover,
+	"key_shared": pulsar.KeyShared,
+}
+
 // Validate checks the receiver configuration is valid
-func (*Config) Validate() error {
+func (cfg *Config) Validate() error {
+	if _, ok := subscriptionTypes[cfg.SubscriptionType]; cfg.SubscriptionType != "" && !ok {
+		return fmt.Errorf("unsupported subscription_type %q", cfg.SubscriptionType)
+	}
+	if cfg.DeadLetter.HasValue() {
+		if cfg.DeadLetter.Get().MaxDeliveries == 0 {
+			return errors.New("dead_letter.max_deliveries must be greater than 0")
+		}
+		if cfg.SubscriptionType != "shared" && cfg.SubscriptionType != "key_shared" {
+			return errors.New("dead_letter requires the shared or key_shared subscription_type")
+		}
+	}
+	if cfg.NackBackoff.HasValue() {
+		backoff := cfg.NackBackoff.Get()
+		if backoff.InitialInterval <= 0 || backoff.MaxInterval < backoff.InitialInterval {
+			return errors.New("nack_backoff requires 0 < initial_interval <= max_interval")
+		}
+	}
 	return nil
 }
 
@@ -134,9 +191,60 @@ func (cfg *Config) consumerOptions() (pulsar.ConsumerOptions, error) {
 		options.Name = cfg.ConsumerName
 	}
 
+	if subscriptionType, ok := subscriptionTypes[cfg.SubscriptionType]; ok {
+		options.Type = subscriptionType
+	}
+	if options.Type == pulsar.KeyShared {
+		options.KeySharedPolicy = &pulsar.KeySharedPolicy{
+			Mode:                    pulsar.KeySharedPolicyModeAutoSplit,
+			AllowOutOfOrderDelivery: cfg.KeyShared.AllowOutOfOrderDelivery,
+		}
+	}
+
+	if cfg.DeadLetter.HasValue() {
+		deadLetter := cfg.DeadLetter.Get()
+		options.DLQ = &pulsar.DLQPolicy{
+			MaxDeliveries:   deadLetter.MaxDeliveries,
+			DeadLetterTopic: deadLetter.Topic,
+		}
+	}
+
+	if cfg.NackBackoff.HasValue() {
+		backoff := cfg.NackBackoff.Get()
+		options.NackBackoffPolicy = &nackBackoffPolicy{
+			initialInterval: backoff.InitialInterval,
+			maxInterval:     backoff.MaxInterval,
+		}
+	} else if cfg.NackRedeliveryDelay > 0 {
+		options.NackRedeliveryDelay = cfg.NackRedeliveryDelay
+	}
+
 	if options.SubscriptionName == "" || options.Topic == "" {
 		return options, errors.New("topic and subscription is required")
 	}
 
 	return options, nil
 }
+
+// nackOnFailure reports whether messages that failed to be consumed are negatively acknowledged
+// for redelivery instead of being acknowledged and dropped.
+func (cfg *Config) nackOnFailure() bool {
+	return cfg.DeadLetter.HasValue()
+}
+
+// nackBackoffPolicy doubles the redelivery delay of a negatively acknowledged message on every
+// redelivery, starting at initialInterval and up to maxInterval.
+type nackBackoffPolicy struct {
+	initialInterval time.Duration
+	maxInterval     time.Duration
+}
+
+var _ pulsar.NackBackoffPolicy = (*nackBackoffPolicy)(nil)
+
+func (p *nackBackoffPolicy) Next(redeliveryCount uint32) time.Duration {
+	delay := p.initialInterval
+	for i := uint32(0); i < redeliveryCount && delay < p.maxInterval; i++ {
+		delay *= 2
+	}
+	return min(delay, p.maxInterval)
+}
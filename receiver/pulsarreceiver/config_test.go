@@ -6,7 +6,9 @@ package pulsarreceiver // import "github.com/open-telemetry/opentelemetry-collec
 import (
 	"path/filepath"
 	"testing"
+	"time"
 
+	"github.com/apache/pulsar-client-go/pulsar"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/component"
@@ -39,6 +41,91 @@ func TestLoadConfig(t *testing.T) {
 	)
 }
 
+func TestLoadConfigKeySharedDeadLetter(t *testing.T) {
+	cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yml"))
+	require.NoError(t, err)
+	factory := NewFactory()
+	cfg := factory.CreateDefaultConfig()
+
+	sub, err := cm.Sub(component.NewIDWithName(metadata.Type, "key_shared").String())
+	require.NoError(t, err)
+	require.NoError(t, sub.Unmarshal(cfg))
+	require.NoError(t, cfg.(*Config).Validate())
+
+	assert.Equal(t, &Config{
+		Topic:            "otel-pulsar",
+		Endpoint:         defaultServiceURL,
+		Subscription:     "otel-collector",
+		SubscriptionType: "key_shared",
+		KeyShared:        KeyShared{AllowOutOfOrderDelivery: true},
+		DeadLetter:       configoptional.Some(DeadLetter{MaxDeliveries: 5, Topic: "otel-pulsar-dlq"}),
+		NackBackoff:      configoptional.Some(NackBackoff{InitialInterval: time.Second, MaxInterval: time.Minute}),
+		Encoding:         defaultEncoding,
+	},
+		cfg,
+	)
+
+	options, err := cfg.(*Config).consumerOptions()
+	require.NoError(t, err)
+	assert.Equal(t, pulsar.KeyShared, options.Type)
+	assert.Equal(t, &pulsar.KeySharedPolicy{Mode: pulsar.KeySharedPolicyModeAutoSplit, AllowOutOfOrderDelivery: true}, options.KeySharedPolicy)
+	assert.Equal(t, &pulsar.DLQPolicy{MaxDeliveries: 5, DeadLetterTopic: "otel-pulsar-dlq"}, options.DLQ)
+	assert.NotNil(t, options.NackBackoffPolicy)
+	assert.True(t, cfg.(*Config).nackOnFailure())
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(*Config)
+		err    string
+	}{
+		{
+			name:   "unsupported subscription type",
+			modify: func(cfg *Config) { cfg.SubscriptionType = "round_robin" },
+			err:    `unsupported subscription_type "round_robin"`,
+		},
+		{
+			name: "dead letter without max deliveries",
+			modify: func(cfg *Config) {
+				cfg.SubscriptionType = "shared"
+				cfg.DeadLetter = configoptional.Some(DeadLetter{})
+			},
+			err: "dead_letter.max_deliveries must be greater than 0",
+		},
+		{
+			name: "dead letter with failover subscription",
+			modify: func(cfg *Config) {
+				cfg.DeadLetter = configoptional.Some(DeadLetter{MaxDeliveries: 3})
+			},
+			err: "dead_letter requires the shared or key_shared subscription_type",
+		},
+		{
+			name: "invalid nack backoff",
+			modify: func(cfg *Config) {
+				cfg.NackBackoff = configoptional.Some(NackBackoff{InitialInterval: time.Minute, MaxInterval: time.Second})
+			},
+			err: "nack_backoff requires 0 < initial_interval <= max_interval",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := createDefaultConfig().(*Config)
+			tt.modify(cfg)
+			assert.EqualError(t, cfg.Validate(), tt.err)
+		})
+	}
+}
+
+func TestNackBackoffPolicy(t *testing.T) {
+	policy := &nackBackoffPolicy{initialInterval: time.Second, maxInterval: 10 * time.Second}
+	assert.Equal(t, time.Second, policy.Next(0))
+	assert.Equal(t, 2*time.Second, policy.Next(1))
+	assert.Equal(t, 8*time.Second, policy.Next(3))
+	assert.Equal(t, 10*time.Second, policy.Next(4))
+	assert.Equal(t, 10*time.Second, policy.Next(100))
+}
+
 func TestDefaultConfig(t *testing.T) {
 	factory := NewFactory()
 	cfg := factory.CreateDefaultConfig().(*Config)
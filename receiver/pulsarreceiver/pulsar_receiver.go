@@ -32,6 +32,7 @@ type pulsarTracesConsumer struct {
 	unmarshaler     TracesUnmarshaler
 	settings        receiver.Settings
 	consumerOptions pulsar.ConsumerOptions
+	nackOnFailure   bool
 	obsrecv         *receiverhelper.ObsReport
 }
 
@@ -68,6 +69,7 @@ func newTracesReceiver(config Config, set receiver.Settings, unmarshalers map[st
 		settings:        set,
 		client:          client,
 		consumerOptions: consumerOptions,
+		nackOnFailure:   config.nackOnFailure(),
 	}, nil
 }
 
@@ -114,6 +116,10 @@ func consumerTracesLoop(ctx context.Context, c *pulsarTracesConsumer) error {
 		if err != nil {
 			c.settings.Logger.Error("failed to unmarshaler traces message", zap.Error(err))
 			c.obsrecv.EndTracesOp(obsCtx, unmarshaler.Encoding(), 0, err)
+			if c.nackOnFailure {
+				c.consumer.Nack(message)
+				continue
+			}
 			_ = c.consumer.Ack(message)
 			return err
 		}
@@ -122,8 +128,19 @@ func consumerTracesLoop(ctx context.Context, c *pulsarTracesConsumer) error {
 			c.settings.Logger.Error("consume traces failed", zap.Error(err))
 		}
 		c.obsrecv.EndTracesOp(obsCtx, unmarshaler.Encoding(), traces.SpanCount(), err)
-		_ = c.consumer.Ack(message)
+		settleMessage(c.consumer, message, c.nackOnFailure, err)
+	}
+}
+
+// settleMessage acknowledges a consumed message. When nackOnFailure is set, messages that failed
+// to be consumed are negatively acknowledged instead so they are redelivered, and eventually moved
+// to the dead letter topic.
+func settleMessage(consumer pulsar.Consumer, message pulsar.Message, nackOnFailure bool, err error) {
+	if err != nil && nackOnFailure {
+		consumer.Nack(message)
+		return
 	}
+	_ = consumer.Ack(message)
 }
 
 func (c *pulsarTracesConsumer) Shutdown(context.Context) error {
@@ -145,6 +162,7 @@ type pulsarMetricsConsumer struct {
 	cancel          context.CancelFunc
 	settings        receiver.Settings
 	consumerOptions pulsar.ConsumerOptions
+	nackOnFailure   bool
 	obsrecv         *receiverhelper.ObsReport
 }
 
@@ -181,6 +199,7 @@ func newMetricsReceiver(config Config, set receiver.Settings, unmarshalers map[s
 		settings:        set,
 		client:          client,
 		consumerOptions: consumerOptions,
+		nackOnFailure:   config.nackOnFailure(),
 	}, nil
 }
 
@@ -229,6 +248,10 @@ func consumeMetricsLoop(ctx context.Context, c *pulsarMetricsConsumer) error {
 		if err != nil {
 			c.settings.Logger.Error("failed to unmarshaler metrics message", zap.Error(err))
 			c.obsrecv.EndMetricsOp(obsCtx, unmarshaler.Encoding(), 0, err)
+			if c.nackOnFailure {
+				c.consumer.Nack(message)
+				continue
+			}
 			_ = c.consumer.Ack(message)
 			return err
 		}
@@ -238,7 +261,7 @@ func consumeMetricsLoop(ctx context.Context, c *pulsarMetricsConsumer) error {
 		}
 		c.obsrecv.EndMetricsOp(obsCtx, unmarshaler.Encoding(), metrics.DataPointCount(), err)
 
-		_ = c.consumer.Ack(message)
+		settleMessage(c.consumer, message, c.nackOnFailure, err)
 	}
 }
 
@@ -261,6 +284,7 @@ type pulsarLogsConsumer struct {
 	cancel          context.CancelFunc
 	settings        receiver.Settings
 	consumerOptions pulsar.ConsumerOptions
+	nackOnFailure   bool
 	obsrecv         *receiverhelper.ObsReport
 }
 
@@ -298,6 +322,7 @@ func newLogsReceiver(config Config, set receiver.Settings, unmarshalers map[stri
 		settings:        set,
 		client:          client,
 		consumerOptions: consumerOptions,
+		nackOnFailure:   config.nackOnFailure(),
 	}, nil
 }
 
@@ -343,6 +368,10 @@ func consumeLogsLoop(ctx context.Context, c *pulsarLogsConsumer) error {
 		if err != nil {
 			c.settings.Logger.Error("failed to unmarshaler logs message", zap.Error(err))
 			c.obsrecv.EndLogsOp(obsCtx, unmarshaler.Encoding(), 0, err)
+			if c.nackOnFailure {
+				c.consumer.Nack(message)
+				continue
+			}
 			_ = c.consumer.Ack(message)
 			return err
 		}
@@ -351,7 +380,7 @@ func consumeLogsLoop(ctx context.Context, c *pulsarLogsConsumer) error {
 			c.settings.Logger.Error("consume traces failed", zap.Error(err))
 		}
 		c.obsrecv.EndLogsOp(obsCtx, unmarshaler.Encoding(), logs.LogRecordCount(), err)
-		_ = c.consumer.Ack(message)
+		settleMessage(c.consumer, message, c.nackOnFailure, err)
 	}
 }
 
@@ -108,6 +108,9 @@ type MetricsConfig struct {
 	RabbitmqNodeSocketsUsed                     MetricConfig `mapstructure:"rabbitmq.node.sockets_used"`
 	RabbitmqNodeSocketsUsedDetailsRate          MetricConfig `mapstructure:"rabbitmq.node.sockets_used_details.rate"`
 	RabbitmqNodeUptime                          MetricConfig `mapstructure:"rabbitmq.node.uptime"`
+	RabbitmqQueueLeaderAvailable                MetricConfig `mapstructure:"rabbitmq.queue.leader.available"`
+	RabbitmqQueueMessageAge                     MetricConfig `mapstructure:"rabbitmq.queue.message.age"`
+	RabbitmqQueueReplicaCount                   MetricConfig `mapstructure:"rabbitmq.queue.replica.count"`
 }
 
 func DefaultMetricsConfig() MetricsConfig {
@@ -352,6 +355,15 @@ func DefaultMetricsConfig() MetricsConfig {
 		RabbitmqNodeUptime: MetricConfig{
 			Enabled: false,
 		},
+		RabbitmqQueueLeaderAvailable: MetricConfig{
+			Enabled: false,
+		},
+		RabbitmqQueueMessageAge: MetricConfig{
+			Enabled: false,
+		},
+		RabbitmqQueueReplicaCount: MetricConfig{
+			Enabled: false,
+		},
 	}
 }
 
@@ -107,6 +107,9 @@ func TestMetricsBuilderConfig(t *testing.T) {
 					RabbitmqNodeSocketsUsed:                     MetricConfig{Enabled: true},
 					RabbitmqNodeSocketsUsedDetailsRate:          MetricConfig{Enabled: true},
 					RabbitmqNodeUptime:                          MetricConfig{Enabled: true},
+					RabbitmqQueueLeaderAvailable:                MetricConfig{Enabled: true},
+					RabbitmqQueueMessageAge:                     MetricConfig{Enabled: true},
+					RabbitmqQueueReplicaCount:                   MetricConfig{Enabled: true},
 				},
 				ResourceAttributes: ResourceAttributesConfig{
 					RabbitmqNodeName:  ResourceAttributeConfig{Enabled: true},
@@ -199,6 +202,9 @@ func TestMetricsBuilderConfig(t *testing.T) {
 					RabbitmqNodeSocketsUsed:                     MetricConfig{Enabled: false},
 					RabbitmqNodeSocketsUsedDetailsRate:          MetricConfig{Enabled: false},
 					RabbitmqNodeUptime:                          MetricConfig{Enabled: false},
+					RabbitmqQueueLeaderAvailable:                MetricConfig{Enabled: false},
+					RabbitmqQueueMessageAge:                     MetricConfig{Enabled: false},
+					RabbitmqQueueReplicaCount:                   MetricConfig{Enabled: false},
 				},
 				ResourceAttributes: ResourceAttributesConfig{
 					RabbitmqNodeName:  ResourceAttributeConfig{Enabled: false},
@@ -38,6 +38,32 @@ var MapAttributeMessageState = map[string]AttributeMessageState{
 	"unacknowledged": AttributeMessageStateUnacknowledged,
 }
 
+// AttributeReplicaState specifies the value replica.state attribute.
+type AttributeReplicaState int
+
+const (
+	_ AttributeReplicaState = iota
+	AttributeReplicaStateOnline
+	AttributeReplicaStateOffline
+)
+
+// String returns the string representation of the AttributeReplicaState.
+func (av AttributeReplicaState) String() string {
+	switch av {
+	case AttributeReplicaStateOnline:
+		return "online"
+	case AttributeReplicaStateOffline:
+		return "offline"
+	}
+	return ""
+}
+
+// MapAttributeReplicaState is a helper map of string to AttributeReplicaState attribute value.
+var MapAttributeReplicaState = map[string]AttributeReplicaState{
+	"online":  AttributeReplicaStateOnline,
+	"offline": AttributeReplicaStateOffline,
+}
+
 var MetricsInfo = metricsInfo{
 	RabbitmqConsumerCount: metricInfo{
 		Name: "rabbitmq.consumer.count",
@@ -279,6 +305,15 @@ var MetricsInfo = metricsInfo{
 	RabbitmqNodeUptime: metricInfo{
 		Name: "rabbitmq.node.uptime",
 	},
+	RabbitmqQueueLeaderAvailable: metricInfo{
+		Name: "rabbitmq.queue.leader.available",
+	},
+	RabbitmqQueueMessageAge: metricInfo{
+		Name: "rabbitmq.queue.message.age",
+	},
+	RabbitmqQueueReplicaCount: metricInfo{
+		Name: "rabbitmq.queue.replica.count",
+	},
 }
 
 type metricsInfo struct {
@@ -362,6 +397,9 @@ type metricsInfo struct {
 	RabbitmqNodeSocketsUsed                     metricInfo
 	RabbitmqNodeSocketsUsedDetailsRate          metricInfo
 	RabbitmqNodeUptime                          metricInfo
+	RabbitmqQueueLeaderAvailable                metricInfo
+	RabbitmqQueueMessageAge                     metricInfo
+	RabbitmqQueueReplicaCount                   metricInfo
 }
 
 type metricInfo struct {
@@ -4450,6 +4488,157 @@ func newMetricRabbitmqNodeUptime(cfg MetricConfig) metricRabbitmqNodeUptime {
 	return m
 }
 
+type metricRabbitmqQueueLeaderAvailable struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills rabbitmq.queue.leader.available metric with initial data.
+func (m *metricRabbitmqQueueLeaderAvailable) init() {
+	m.data.SetName("rabbitmq.queue.leader.available")
+	m.data.SetDescription("Whether a quorum queue has a leader running on an online replica (1 = available, 0 = unavailable).")
+	m.data.SetUnit("1")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricRabbitmqQueueLeaderAvailable) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricRabbitmqQueueLeaderAvailable) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricRabbitmqQueueLeaderAvailable) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricRabbitmqQueueLeaderAvailable(cfg MetricConfig) metricRabbitmqQueueLeaderAvailable {
+	m := metricRabbitmqQueueLeaderAvailable{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricRabbitmqQueueMessageAge struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills rabbitmq.queue.message.age metric with initial data.
+func (m *metricRabbitmqQueueMessageAge) init() {
+	m.data.SetName("rabbitmq.queue.message.age")
+	m.data.SetDescription("The age of the oldest message in the queue.")
+	m.data.SetUnit("s")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricRabbitmqQueueMessageAge) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetDoubleValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricRabbitmqQueueMessageAge) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricRabbitmqQueueMessageAge) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricRabbitmqQueueMessageAge(cfg MetricConfig) metricRabbitmqQueueMessageAge {
+	m := metricRabbitmqQueueMessageAge{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricRabbitmqQueueReplicaCount struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills rabbitmq.queue.replica.count metric with initial data.
+func (m *metricRabbitmqQueueReplicaCount) init() {
+	m.data.SetName("rabbitmq.queue.replica.count")
+	m.data.SetDescription("The number of replicas of a quorum queue.")
+	m.data.SetUnit("{replicas}")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(false)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricRabbitmqQueueReplicaCount) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, replicaStateAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("state", replicaStateAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricRabbitmqQueueReplicaCount) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricRabbitmqQueueReplicaCount) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricRabbitmqQueueReplicaCount(cfg MetricConfig) metricRabbitmqQueueReplicaCount {
+	m := metricRabbitmqQueueReplicaCount{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 // MetricsBuilder provides an interface for scrapers to report metrics while taking care of all the transformations
 // required to produce metric representation defined in metadata and user config.
 type MetricsBuilder struct {
@@ -4540,6 +4729,9 @@ type MetricsBuilder struct {
 	metricRabbitmqNodeSocketsUsed                     metricRabbitmqNodeSocketsUsed
 	metricRabbitmqNodeSocketsUsedDetailsRate          metricRabbitmqNodeSocketsUsedDetailsRate
 	metricRabbitmqNodeUptime                          metricRabbitmqNodeUptime
+	metricRabbitmqQueueLeaderAvailable                metricRabbitmqQueueLeaderAvailable
+	metricRabbitmqQueueMessageAge                     metricRabbitmqQueueMessageAge
+	metricRabbitmqQueueReplicaCount                   metricRabbitmqQueueReplicaCount
 }
 
 // MetricBuilderOption applies changes to default metrics builder.
@@ -4645,6 +4837,9 @@ func NewMetricsBuilder(mbc MetricsBuilderConfig, settings receiver.Settings, opt
 		metricRabbitmqNodeSocketsUsed:                     newMetricRabbitmqNodeSocketsUsed(mbc.Metrics.RabbitmqNodeSocketsUsed),
 		metricRabbitmqNodeSocketsUsedDetailsRate:          newMetricRabbitmqNodeSocketsUsedDetailsRate(mbc.Metrics.RabbitmqNodeSocketsUsedDetailsRate),
 		metricRabbitmqNodeUptime:                          newMetricRabbitmqNodeUptime(mbc.Metrics.RabbitmqNodeUptime),
+		metricRabbitmqQueueLeaderAvailable:                newMetricRabbitmqQueueLeaderAvailable(mbc.Metrics.RabbitmqQueueLeaderAvailable),
+		metricRabbitmqQueueMessageAge:                     newMetricRabbitmqQueueMessageAge(mbc.Metrics.RabbitmqQueueMessageAge),
+		metricRabbitmqQueueReplicaCount:                   newMetricRabbitmqQueueReplicaCount(mbc.Metrics.RabbitmqQueueReplicaCount),
 		resourceAttributeIncludeFilter:                    make(map[string]filter.Filter),
 		resourceAttributeExcludeFilter:                    make(map[string]filter.Filter),
 	}
@@ -4815,6 +5010,9 @@ func (mb *MetricsBuilder) EmitForResource(options ...ResourceMetricsOption) {
 	mb.metricRabbitmqNodeSocketsUsed.emit(ils.Metrics())
 	mb.metricRabbitmqNodeSocketsUsedDetailsRate.emit(ils.Metrics())
 	mb.metricRabbitmqNodeUptime.emit(ils.Metrics())
+	mb.metricRabbitmqQueueLeaderAvailable.emit(ils.Metrics())
+	mb.metricRabbitmqQueueMessageAge.emit(ils.Metrics())
+	mb.metricRabbitmqQueueReplicaCount.emit(ils.Metrics())
 
 	for _, op := range options {
 		op.apply(rm)
@@ -5246,6 +5444,21 @@ func (mb *MetricsBuilder) RecordRabbitmqNodeUptimeDataPoint(ts pcommon.Timestamp
 	mb.metricRabbitmqNodeUptime.recordDataPoint(mb.startTime, ts, val)
 }
 
+// RecordRabbitmqQueueLeaderAvailableDataPoint adds a data point to rabbitmq.queue.leader.available metric.
+func (mb *MetricsBuilder) RecordRabbitmqQueueLeaderAvailableDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricRabbitmqQueueLeaderAvailable.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordRabbitmqQueueMessageAgeDataPoint adds a data point to rabbitmq.queue.message.age metric.
+func (mb *MetricsBuilder) RecordRabbitmqQueueMessageAgeDataPoint(ts pcommon.Timestamp, val float64) {
+	mb.metricRabbitmqQueueMessageAge.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordRabbitmqQueueReplicaCountDataPoint adds a data point to rabbitmq.queue.replica.count metric.
+func (mb *MetricsBuilder) RecordRabbitmqQueueReplicaCountDataPoint(ts pcommon.Timestamp, val int64, replicaStateAttributeValue AttributeReplicaState) {
+	mb.metricRabbitmqQueueReplicaCount.recordDataPoint(mb.startTime, ts, val, replicaStateAttributeValue.String())
+}
+
 // Reset resets metrics builder to its initial state. It should be used when external metrics source is restarted,
 // and metrics builder should update its startTime and reset it's internal state accordingly.
 func (mb *MetricsBuilder) Reset(options ...MetricBuilderOption) {
@@ -314,6 +314,15 @@ func TestMetricsBuilder(t *testing.T) {
 			allMetricsCount++
 			mb.RecordRabbitmqNodeUptimeDataPoint(ts, 1)
 
+			allMetricsCount++
+			mb.RecordRabbitmqQueueLeaderAvailableDataPoint(ts, 1)
+
+			allMetricsCount++
+			mb.RecordRabbitmqQueueMessageAgeDataPoint(ts, 1)
+
+			allMetricsCount++
+			mb.RecordRabbitmqQueueReplicaCountDataPoint(ts, 1, AttributeReplicaStateOnline)
+
 			rb := mb.NewResourceBuilder()
 			rb.SetRabbitmqNodeName("rabbitmq.node.name-val")
 			rb.SetRabbitmqQueueName("rabbitmq.queue.name-val")
@@ -1463,6 +1472,47 @@ func TestMetricsBuilder(t *testing.T) {
 					assert.Equal(t, ts, dp.Timestamp())
 					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
 					assert.Equal(t, int64(1), dp.IntValue())
+				case "rabbitmq.queue.leader.available":
+					assert.False(t, validatedMetrics["rabbitmq.queue.leader.available"], "Found a duplicate in the metrics slice: rabbitmq.queue.leader.available")
+					validatedMetrics["rabbitmq.queue.leader.available"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Whether a quorum queue has a leader running on an online replica (1 = available, 0 = unavailable).", ms.At(i).Description())
+					assert.Equal(t, "1", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+				case "rabbitmq.queue.message.age":
+					assert.False(t, validatedMetrics["rabbitmq.queue.message.age"], "Found a duplicate in the metrics slice: rabbitmq.queue.message.age")
+					validatedMetrics["rabbitmq.queue.message.age"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "The age of the oldest message in the queue.", ms.At(i).Description())
+					assert.Equal(t, "s", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeDouble, dp.ValueType())
+					assert.InDelta(t, float64(1), dp.DoubleValue(), 0.01)
+				case "rabbitmq.queue.replica.count":
+					assert.False(t, validatedMetrics["rabbitmq.queue.replica.count"], "Found a duplicate in the metrics slice: rabbitmq.queue.replica.count")
+					validatedMetrics["rabbitmq.queue.replica.count"] = true
+					assert.Equal(t, pmetric.MetricTypeSum, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Sum().DataPoints().Len())
+					assert.Equal(t, "The number of replicas of a quorum queue.", ms.At(i).Description())
+					assert.Equal(t, "{replicas}", ms.At(i).Unit())
+					assert.False(t, ms.At(i).Sum().IsMonotonic())
+					assert.Equal(t, pmetric.AggregationTemporalityCumulative, ms.At(i).Sum().AggregationTemporality())
+					dp := ms.At(i).Sum().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("state")
+					assert.True(t, ok)
+					assert.Equal(t, "online", attrVal.Str())
 				}
 			}
 		})
@@ -9,12 +9,22 @@ type Queue struct {
 	Name  string `json:"name"`
 	Node  string `json:"node"`
 	VHost string `json:"vhost"`
+	Type  string `json:"type"`
 
 	// Metrics
 	Consumers              int64 `json:"consumers"`
 	UnacknowledgedMessages int64 `json:"messages_unacknowledged"`
 	ReadyMessages          int64 `json:"messages_ready"`
 
+	// HeadMessageTimestamp is the timestamp property, in seconds, of the message
+	// at the head of the queue. It is null when the property is not set.
+	HeadMessageTimestamp *int64 `json:"head_message_timestamp"`
+
+	// Replicas of quorum queues
+	Leader  string   `json:"leader"`
+	Members []string `json:"members"`
+	Online  []string `json:"online"`
+
 	// Embedded Metrics
 	MessageStats map[string]any `json:"message_stats"`
 }
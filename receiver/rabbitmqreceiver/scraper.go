@@ -30,6 +30,9 @@ const (
 	dropUnroutableStat = "drop_unroutable"
 )
 
+// quorumQueueType is the type of the replicated queues based on Raft.
+const quorumQueueType = "quorum"
+
 // Metrics to gather from queue message_stats structure
 var messageStatMetrics = []string{
 	deliverStat,
@@ -156,6 +159,15 @@ func (r *rabbitmqScraper) collectQueue(queue *models.Queue, now pcommon.Timestam
 			r.mb.RecordRabbitmqMessageDroppedDataPoint(now, val64)
 		}
 	}
+	if queue.HeadMessageTimestamp != nil {
+		age := now.AsTime().Sub(time.Unix(*queue.HeadMessageTimestamp, 0))
+		r.mb.RecordRabbitmqQueueMessageAgeDataPoint(now, max(age, 0).Seconds())
+	}
+
+	if queue.Type == quorumQueueType {
+		r.collectQuorumQueue(queue, now)
+	}
+
 	rb := r.mb.NewResourceBuilder()
 	rb.SetRabbitmqQueueName(queue.Name)
 	rb.SetRabbitmqNodeName(queue.Node)
@@ -163,6 +175,25 @@ func (r *rabbitmqScraper) collectQueue(queue *models.Queue, now pcommon.Timestam
 	r.mb.EmitForResource(metadata.WithResource(rb.Emit()))
 }
 
+// collectQuorumQueue collects the health of the replicas of a quorum queue
+func (r *rabbitmqScraper) collectQuorumQueue(queue *models.Queue, now pcommon.Timestamp) {
+	online := make(map[string]bool, len(queue.Online))
+	for _, node := range queue.Online {
+		online[node] = true
+	}
+	var onlineCount int64
+	for _, member := range queue.Members {
+		if online[member] {
+			onlineCount++
+		}
+	}
+	r.mb.RecordRabbitmqQueueReplicaCountDataPoint(now, onlineCount, metadata.AttributeReplicaStateOnline)
+	r.mb.RecordRabbitmqQueueReplicaCountDataPoint(now, int64(len(queue.Members))-onlineCount, metadata.AttributeReplicaStateOffline)
+
+	leaderAvailable := queue.Leader != "" && online[queue.Leader]
+	r.mb.RecordRabbitmqQueueLeaderAvailableDataPoint(now, boolToInt64(leaderAvailable))
+}
+
 // collectNode collects metrics for a specific RabbitMQ node
 func (r *rabbitmqScraper) collectNode(node *models.Node, now pcommon.Timestamp) {
 	r.mb.RecordRabbitmqNodeDiskFreeDataPoint(now, node.DiskFree)
@@ -9,12 +9,14 @@ import (
 	"errors"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/component/componenttest"
 	"go.opentelemetry.io/collector/config/confighttp"
 	"go.opentelemetry.io/collector/config/configtls"
+	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/receiver/receivertest"
 	"go.opentelemetry.io/collector/scraper/scrapererror"
@@ -285,3 +287,71 @@ func TestScraperScrape(t *testing.T) {
 		})
 	}
 }
+
+func TestScraperCollectQueueHealth(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Metrics.RabbitmqQueueMessageAge.Enabled = true
+	cfg.Metrics.RabbitmqQueueReplicaCount.Enabled = true
+	cfg.Metrics.RabbitmqQueueLeaderAvailable.Enabled = true
+
+	now := time.Unix(1700000100, 0)
+	headTimestamp := int64(1700000000)
+	queues := []*models.Queue{
+		{
+			Name:                 "orders",
+			Node:                 "rabbit@node1",
+			VHost:                "/",
+			Type:                 "quorum",
+			HeadMessageTimestamp: &headTimestamp,
+			Leader:               "rabbit@node2",
+			Members:              []string{"rabbit@node1", "rabbit@node2", "rabbit@node3"},
+			Online:               []string{"rabbit@node1", "rabbit@node3"},
+		},
+		{
+			Name:  "events",
+			Node:  "rabbit@node1",
+			VHost: "/",
+			Type:  "classic",
+		},
+	}
+
+	scraper := newScraper(zap.NewNop(), cfg, receivertest.NewNopSettings(metadata.Type))
+	for _, queue := range queues {
+		scraper.collectQueue(queue, pcommon.NewTimestampFromTime(now))
+	}
+	md := scraper.mb.Emit()
+
+	got := map[string]map[string]pmetric.Metric{}
+	for i := 0; i < md.ResourceMetrics().Len(); i++ {
+		rm := md.ResourceMetrics().At(i)
+		name, _ := rm.Resource().Attributes().Get("rabbitmq.queue.name")
+		got[name.Str()] = map[string]pmetric.Metric{}
+		metrics := rm.ScopeMetrics().At(0).Metrics()
+		for j := 0; j < metrics.Len(); j++ {
+			got[name.Str()][metrics.At(j).Name()] = metrics.At(j)
+		}
+	}
+
+	orders := got["orders"]
+	require.Contains(t, orders, "rabbitmq.queue.message.age")
+	require.InDelta(t, 100.0, orders["rabbitmq.queue.message.age"].Gauge().DataPoints().At(0).DoubleValue(), 0)
+
+	replicas := orders["rabbitmq.queue.replica.count"].Sum().DataPoints()
+	require.Equal(t, 2, replicas.Len())
+	for i := 0; i < replicas.Len(); i++ {
+		state, _ := replicas.At(i).Attributes().Get("state")
+		switch state.Str() {
+		case "online":
+			require.Equal(t, int64(2), replicas.At(i).IntValue())
+		case "offline":
+			require.Equal(t, int64(1), replicas.At(i).IntValue())
+		}
+	}
+	// The leader runs on an offline replica.
+	require.Equal(t, int64(0), orders["rabbitmq.queue.leader.available"].Gauge().DataPoints().At(0).IntValue())
+
+	// Classic queues without a head message timestamp have none of the metrics.
+	require.NotContains(t, got["events"], "rabbitmq.queue.message.age")
+	require.NotContains(t, got["events"], "rabbitmq.queue.replica.count")
+	require.NotContains(t, got["events"], "rabbitmq.queue.leader.available")
+}
@@ -53,8 +53,8 @@ func createK8sHintsBuilder(config DiscoveryConfig, logger *zap.Logger) k8sHintsB
 }
 
 // createReceiverTemplateFromHints creates a receiver configuration based on the provided hints.
-// Hints are extracted from Pod's annotations.
-// Scraper configurations are only created for Port Endpoints.
+// Hints are extracted from Pod's annotations or from the labels of Docker containers.
+// Scraper configurations are only created for Port and Container Endpoints.
 // Log receiver configurations are only created for Pod Container Endpoints.
 func (builder *k8sHintsBuilder) createReceiverTemplateFromHints(env observer.EndpointEnv) (*receiverTemplate, error) {
 	var pod observer.Pod
@@ -64,6 +64,10 @@ func (builder *k8sHintsBuilder) createReceiverTemplateFromHints(env observer.End
 		return nil, fmt.Errorf("could not get endpoint type: %v", zap.Any("env", env))
 	}
 
+	if endpointType == string(observer.ContainerType) {
+		return builder.createContainerScraper(env)
+	}
+
 	if endpointType != string(observer.PortType) && endpointType != string(observer.PodContainerType) {
 		return nil, nil
 	}
@@ -106,6 +110,40 @@ func (builder *k8sHintsBuilder) createScraper(
 	port = p.Port
 	pod := p.Pod
 
+	return builder.createScraperTemplate(annotations, env, port, pod.UID)
+}
+
+// createContainerScraper creates a scraper configuration from the labels of a
+// Container endpoint, as reported by the Docker observer. Labels use the same
+// keys as the Pod annotations and are scoped by the container's port.
+func (builder *k8sHintsBuilder) createContainerScraper(env observer.EndpointEnv) (*receiverTemplate, error) {
+	var c observer.Container
+	err := mapstructure.Decode(env, &c)
+	if err != nil {
+		return nil, fmt.Errorf("could not extract container event: %v", zap.Any("env", env))
+	}
+	if c.Port == 0 {
+		return nil, fmt.Errorf("could not extract container port: %v", zap.Any("env", env))
+	}
+	containerID := getStringEnv(env, "container_id")
+	if containerID == "" {
+		return nil, fmt.Errorf("could not extract container id: %v", zap.Any("env", env))
+	}
+
+	builder.logger.Debug("handling hints for added endpoint", zap.Any("env", env))
+
+	labels := mergeAnnotations(c.Labels, builder.defaultAnnotations)
+	return builder.createScraperTemplate(labels, env, c.Port, containerID)
+}
+
+// createScraperTemplate creates the scraper configuration of the given port,
+// the receiver is named after the port and the id of its Pod or container.
+func (builder *k8sHintsBuilder) createScraperTemplate(
+	annotations map[string]string,
+	env observer.EndpointEnv,
+	port uint16,
+	id string,
+) (*receiverTemplate, error) {
 	if !discoveryEnabled(annotations, otelMetricsHints, fmt.Sprint(port)) {
 		return nil, nil
 	}
@@ -127,7 +165,7 @@ func (builder *k8sHintsBuilder) createScraper(
 		return nil, fmt.Errorf("could not create receiver configuration: %v", zap.Error(err))
 	}
 
-	recTemplate, err := newReceiverTemplate(fmt.Sprintf("%v/%v_%v", subreceiverKey, pod.UID, port), userConfMap)
+	recTemplate, err := newReceiverTemplate(fmt.Sprintf("%v/%v_%v", subreceiverKey, id, port), userConfMap)
 	recTemplate.signals = receiverSignals{metrics: true, logs: false, traces: false}
 
 	return &recTemplate, err
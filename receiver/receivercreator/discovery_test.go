@@ -217,6 +217,92 @@ endpoint: 1.2.3.4:6379`
 	}
 }
 
+func TestContainerHintsBuilderMetrics(t *testing.T) {
+	logger := zaptest.NewLogger(t, zaptest.Level(zap.InfoLevel))
+
+	id := component.ID{}
+	require.NoError(t, id.UnmarshalText([]byte("redis/container-1_6379")))
+
+	config := `
+collection_interval: "20s"
+endpoint: 172.17.0.2:6379`
+
+	tests := map[string]struct {
+		labels             map[string]string
+		defaultAnnotations map[string]string
+		expectedReceiver   *receiverTemplate
+	}{
+		"container_level_hints": {
+			labels: map[string]string{
+				otelMetricsHints + ".6379/enabled": "true",
+				otelMetricsHints + ".6379/scraper": "redis",
+				otelMetricsHints + ".6379/config":  config,
+			},
+			expectedReceiver: &receiverTemplate{
+				receiverConfig: receiverConfig{
+					id:     id,
+					config: userConfigMap{"collection_interval": "20s", "endpoint": "172.17.0.2:6379"},
+				},
+				signals: receiverSignals{metrics: true},
+			},
+		},
+		"default_annotations": {
+			labels: map[string]string{
+				otelMetricsHints + "/scraper": "redis",
+			},
+			defaultAnnotations: map[string]string{
+				otelMetricsHints + "/enabled": "true",
+			},
+			expectedReceiver: &receiverTemplate{
+				receiverConfig: receiverConfig{
+					id:     id,
+					config: userConfigMap{},
+				},
+				signals: receiverSignals{metrics: true},
+			},
+		},
+		"disabled": {
+			labels: map[string]string{
+				otelMetricsHints + "/enabled": "false",
+				otelMetricsHints + "/scraper": "redis",
+			},
+		},
+		"no_labels": {},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			builder := createK8sHintsBuilder(DiscoveryConfig{Enabled: true, DefaultAnnotations: test.defaultAnnotations}, logger)
+			env, err := (&observer.Endpoint{
+				ID:     "container-1:6379",
+				Target: "172.17.0.2:6379",
+				Details: &observer.Container{
+					Name:          "redis",
+					Image:         "redis",
+					Port:          6379,
+					AlternatePort: 6379,
+					ContainerID:   "container-1",
+					Host:          "172.17.0.2",
+					Transport:     observer.ProtocolTCP,
+					Labels:        test.labels,
+				},
+			}).Env()
+			require.NoError(t, err)
+
+			subreceiverTemplate, err := builder.createReceiverTemplateFromHints(env)
+			require.NoError(t, err)
+			if test.expectedReceiver == nil {
+				require.Nil(t, subreceiverTemplate)
+				return
+			}
+			require.NotNil(t, subreceiverTemplate)
+			require.Equal(t, test.expectedReceiver.config, subreceiverTemplate.config)
+			require.Equal(t, test.expectedReceiver.signals, subreceiverTemplate.signals)
+			require.Equal(t, test.expectedReceiver.id, subreceiverTemplate.id)
+		})
+	}
+}
+
 func TestK8sHintsBuilderLogs(t *testing.T) {
 	logger := zaptest.NewLogger(t, zaptest.Level(zap.InfoLevel))
 
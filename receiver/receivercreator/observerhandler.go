@@ -85,11 +85,11 @@ func (obs *observerHandler) OnAdd(added []observer.Endpoint) {
 			builder := createK8sHintsBuilder(obs.config.Discovery, obs.params.Logger)
 			subreceiverTemplate, err := builder.createReceiverTemplateFromHints(env)
 			if err != nil {
-				obs.params.Logger.Error("could not extract configurations from K8s hints' annotations", zap.Error(err))
+				obs.params.Logger.Error("could not extract configurations from hints", zap.Error(err))
 				break
 			}
 			if subreceiverTemplate != nil {
-				obs.params.Logger.Debug("adding K8s hinted receiver", zap.Any("subreceiver", subreceiverTemplate))
+				obs.params.Logger.Debug("adding hinted receiver", zap.Any("subreceiver", subreceiverTemplate))
 				obs.startReceiver(*subreceiverTemplate, env, e)
 				continue
 			}
@@ -5,6 +5,7 @@ package redisreceiver // import "github.com/open-telemetry/opentelemetry-collect
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/redis/go-redis/v9"
 )
@@ -16,10 +17,34 @@ type client interface {
 	// line delimiter
 	// redis lines are delimited by \r\n, files (for testing) by \n
 	delimiter() string
+	// retrieves the addresses of the nodes of the cluster, from CLUSTER SLOTS
+	retrieveClusterNodes() ([]string, error)
+	// retrieves the latest latency spike of every event, from LATENCY LATEST
+	retrieveLatencyLatest() ([]latencyEvent, error)
+	// retrieves the latency spikes of an event, from LATENCY HISTORY
+	retrieveLatencyHistory(event string) ([]latencySample, error)
 	// close release redis client connection pool
 	close() error
 }
 
+// latencyEvent is an entry of LATENCY LATEST.
+type latencyEvent struct {
+	name string
+	// Unix time of the latest spike, in seconds.
+	timestamp int64
+	// Latest and all-time maximum latencies, in milliseconds.
+	latest int64
+	max    int64
+}
+
+// latencySample is an entry of LATENCY HISTORY.
+type latencySample struct {
+	// Unix time of the spike, in seconds.
+	timestamp int64
+	// Latency of the spike, in milliseconds.
+	latency int64
+}
+
 // Wraps a real Redis client, implements `client` interface.
 type redisClient struct {
 	client *redis.Client
@@ -44,6 +69,87 @@ func (c *redisClient) retrieveInfo() (string, error) {
 	return c.client.Info(context.Background(), "all").Result()
 }
 
+// Retrieve the addresses of the nodes serving slots of the cluster, primaries
+// and replicas.
+func (c *redisClient) retrieveClusterNodes() ([]string, error) {
+	slots, err := c.client.ClusterSlots(context.Background()).Result()
+	if err != nil {
+		return nil, err
+	}
+	var addrs []string
+	seen := map[string]bool{}
+	for _, slot := range slots {
+		for _, node := range slot.Nodes {
+			if node.Addr == "" || seen[node.Addr] {
+				continue
+			}
+			seen[node.Addr] = true
+			addrs = append(addrs, node.Addr)
+		}
+	}
+	return addrs, nil
+}
+
+// Retrieve LATENCY LATEST, each entry is the event name, the unix time of the
+// latest spike, its latency and the maximum latency of the event.
+func (c *redisClient) retrieveLatencyLatest() ([]latencyEvent, error) {
+	entries, err := c.client.Do(context.Background(), "LATENCY", "LATEST").Slice()
+	if err != nil {
+		return nil, err
+	}
+	events := make([]latencyEvent, 0, len(entries))
+	for _, entry := range entries {
+		fields, ok := entry.([]any)
+		if !ok || len(fields) < 4 {
+			return nil, fmt.Errorf("unexpected LATENCY LATEST entry %v", entry)
+		}
+		name, ok := fields[0].(string)
+		if !ok {
+			return nil, fmt.Errorf("unexpected LATENCY LATEST event name %v", fields[0])
+		}
+		ints, err := toInt64s(fields[1:4])
+		if err != nil {
+			return nil, fmt.Errorf("unexpected LATENCY LATEST entry for event %q: %w", name, err)
+		}
+		events = append(events, latencyEvent{name: name, timestamp: ints[0], latest: ints[1], max: ints[2]})
+	}
+	return events, nil
+}
+
+// Retrieve LATENCY HISTORY of an event, each entry is the unix time of a spike
+// and its latency.
+func (c *redisClient) retrieveLatencyHistory(event string) ([]latencySample, error) {
+	entries, err := c.client.Do(context.Background(), "LATENCY", "HISTORY", event).Slice()
+	if err != nil {
+		return nil, err
+	}
+	samples := make([]latencySample, 0, len(entries))
+	for _, entry := range entries {
+		fields, ok := entry.([]any)
+		if !ok || len(fields) < 2 {
+			return nil, fmt.Errorf("unexpected LATENCY HISTORY entry %v", entry)
+		}
+		ints, err := toInt64s(fields[:2])
+		if err != nil {
+			return nil, fmt.Errorf("unexpected LATENCY HISTORY entry for event %q: %w", event, err)
+		}
+		samples = append(samples, latencySample{timestamp: ints[0], latency: ints[1]})
+	}
+	return samples, nil
+}
+
+func toInt64s(values []any) ([]int64, error) {
+	ints := make([]int64, len(values))
+	for i, v := range values {
+		n, ok := v.(int64)
+		if !ok {
+			return nil, fmt.Errorf("%v is not an integer", v)
+		}
+		ints[i] = n
+	}
+	return ints, nil
+}
+
 // close client to release connection pool.
 func (c *redisClient) close() error {
 	return c.client.Close()
@@ -33,6 +33,18 @@ func (fakeClient) retrieveInfo() (string, error) {
 	return readFile("info")
 }
 
+func (fakeClient) retrieveClusterNodes() ([]string, error) {
+	return []string{"10.0.0.1:6379", "10.0.0.2:6379"}, nil
+}
+
+func (fakeClient) retrieveLatencyLatest() ([]latencyEvent, error) {
+	return []latencyEvent{{name: "command", timestamp: 1700000100, latest: 250, max: 900}}, nil
+}
+
+func (fakeClient) retrieveLatencyHistory(string) ([]latencySample, error) {
+	return []latencySample{{timestamp: 1700000000, latency: 900}, {timestamp: 1700000100, latency: 250}}, nil
+}
+
 func (fakeClient) close() error {
 	return nil
 }
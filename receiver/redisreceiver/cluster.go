@@ -0,0 +1,114 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package redisreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/redisreceiver"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/receiver"
+	"go.opentelemetry.io/collector/scraper"
+	"go.opentelemetry.io/collector/scraper/scrapererror"
+	"go.uber.org/zap"
+)
+
+// clusterScraper scrapes every node of a Redis Cluster. The nodes are discovered
+// with CLUSTER SLOTS from the configured endpoint on each scrape, so nodes added
+// to or removed from the cluster are followed.
+type clusterScraper struct {
+	seed      client
+	newClient func(addr string) client
+	settings  receiver.Settings
+	cfg       *Config
+	// nodes holds the scraper of each known node, by address.
+	nodes map[string]*redisScraper
+}
+
+func newClusterScraperWithClient(seed client, newClient func(addr string) client, settings receiver.Settings, cfg *Config) (scraper.Metrics, error) {
+	if _, err := newConfigInfo(cfg); err != nil {
+		return nil, err
+	}
+	cs := &clusterScraper{
+		seed:      seed,
+		newClient: newClient,
+		settings:  settings,
+		cfg:       cfg,
+		nodes:     map[string]*redisScraper{},
+	}
+	return scraper.NewMetrics(
+		cs.Scrape,
+		scraper.WithShutdown(cs.shutdown),
+	)
+}
+
+// Scrape scrapes the nodes of the cluster, each node is reported as a separate
+// resource identified by its address and port.
+func (cs *clusterScraper) Scrape(ctx context.Context) (pmetric.Metrics, error) {
+	addrs, err := cs.seed.retrieveClusterNodes()
+	if err != nil {
+		return pmetric.Metrics{}, fmt.Errorf("failed to discover the cluster nodes: %w", err)
+	}
+
+	md := pmetric.NewMetrics()
+	current := make(map[string]bool, len(addrs))
+	var errs error
+	failed := 0
+	for _, addr := range addrs {
+		current[addr] = true
+		node, err := cs.node(addr)
+		if err != nil {
+			errs = errors.Join(errs, err)
+			failed++
+			continue
+		}
+		nodeMetrics, err := node.Scrape(ctx)
+		if err != nil {
+			errs = errors.Join(errs, fmt.Errorf("failed to scrape node %s: %w", addr, err))
+			failed++
+			continue
+		}
+		nodeMetrics.ResourceMetrics().MoveAndAppendTo(md.ResourceMetrics())
+	}
+
+	// Release the connections to the nodes which left the cluster.
+	for addr, node := range cs.nodes {
+		if current[addr] {
+			continue
+		}
+		if err := node.shutdown(ctx); err != nil {
+			cs.settings.Logger.Warn("failed to close the connection to a removed node", zap.String("node", addr), zap.Error(err))
+		}
+		delete(cs.nodes, addr)
+	}
+
+	if errs != nil {
+		return md, scrapererror.NewPartialScrapeError(errs, failed)
+	}
+	return md, nil
+}
+
+// node returns the scraper of the node at addr, creating it on first use.
+func (cs *clusterScraper) node(addr string) (*redisScraper, error) {
+	if node, ok := cs.nodes[addr]; ok {
+		return node, nil
+	}
+	address, port, err := net.SplitHostPort(addr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid node address %q: %w", addr, err)
+	}
+	node := newNodeScraper(cs.newClient(addr), cs.settings, cs.cfg, configInfo{Address: address, Port: port})
+	cs.nodes[addr] = node
+	return node, nil
+}
+
+func (cs *clusterScraper) shutdown(ctx context.Context) error {
+	errs := cs.seed.close()
+	for _, node := range cs.nodes {
+		errs = errors.Join(errs, node.shutdown(ctx))
+	}
+	return errs
+}
@@ -0,0 +1,97 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package redisreceiver
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/redisreceiver/internal/metadata"
+)
+
+func TestClusterScraper(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Endpoint = "localhost:6379"
+	cfg.Cluster.Enabled = true
+	cfg.MetricsBuilderConfig.ResourceAttributes.ServerAddress.Enabled = true
+
+	var created []string
+	newClient := func(addr string) client {
+		created = append(created, addr)
+		return newFakeClient()
+	}
+	s, err := newClusterScraperWithClient(newFakeClient(), newClient, receivertest.NewNopSettings(metadata.Type), cfg)
+	require.NoError(t, err)
+
+	md, err := s.ScrapeMetrics(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 2, md.ResourceMetrics().Len())
+	var addrs []string
+	for i := 0; i < md.ResourceMetrics().Len(); i++ {
+		addr, ok := md.ResourceMetrics().At(i).Resource().Attributes().Get("server.address")
+		require.True(t, ok)
+		addrs = append(addrs, addr.Str())
+	}
+	assert.ElementsMatch(t, []string{"10.0.0.1", "10.0.0.2"}, addrs)
+
+	// The clients of the nodes are reused across scrapes.
+	_, err = s.ScrapeMetrics(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, []string{"10.0.0.1:6379", "10.0.0.2:6379"}, created)
+	require.NoError(t, s.Shutdown(context.Background()))
+}
+
+func TestLatencyMetrics(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Endpoint = "localhost:6379"
+	cfg.MetricsBuilderConfig.Metrics.RedisLatencyEventMax.Enabled = true
+	cfg.MetricsBuilderConfig.Metrics.RedisLatencyEventDuration.Enabled = true
+
+	rs := newNodeScraper(newFakeClient(), receivertest.NewNopSettings(metadata.Type), cfg, configInfo{})
+	rs.startTime = 1700000050
+
+	md, err := rs.Scrape(context.Background())
+	require.NoError(t, err)
+	metrics := md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	var found int
+	for i := 0; i < metrics.Len(); i++ {
+		m := metrics.At(i)
+		switch m.Name() {
+		case "redis.latency.event.max":
+			found++
+			assert.InDelta(t, 0.9, m.Gauge().DataPoints().At(0).DoubleValue(), 1e-9)
+		case "redis.latency.event.duration":
+			found++
+			// Only the spike which happened after the start is reported.
+			require.Equal(t, 1, m.Gauge().DataPoints().Len())
+			dp := m.Gauge().DataPoints().At(0)
+			assert.InDelta(t, 0.25, dp.DoubleValue(), 1e-9)
+			assert.Equal(t, int64(1700000100), dp.Timestamp().AsTime().Unix())
+		}
+	}
+	assert.Equal(t, 2, found)
+
+	// The spikes are reported once.
+	md, err = rs.Scrape(context.Background())
+	require.NoError(t, err)
+	metrics = md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	for i := 0; i < metrics.Len(); i++ {
+		assert.NotEqual(t, "redis.latency.event.duration", metrics.At(i).Name())
+	}
+}
+
+func TestParseKeyspaceDB(t *testing.T) {
+	db, ok := parseKeyspaceDB("db21")
+	assert.True(t, ok)
+	assert.Equal(t, 21, db)
+
+	for _, key := range []string{"db", "dbx", "db-1", "used_memory"} {
+		_, ok = parseKeyspaceDB(key)
+		assert.False(t, ok, key)
+	}
+}
@@ -35,9 +35,19 @@ type Config struct {
 
 	TLS configtls.ClientConfig `mapstructure:"tls,omitempty"`
 
+	// Cluster configures the scraping of the nodes of a Redis Cluster.
+	Cluster ClusterConfig `mapstructure:"cluster"`
+
 	MetricsBuilderConfig metadata.MetricsBuilderConfig `mapstructure:",squash"`
 }
 
+// ClusterConfig configures the scraping of a Redis Cluster.
+type ClusterConfig struct {
+	// Enabled makes the receiver discover the nodes of the cluster with
+	// CLUSTER SLOTS from the endpoint, and scrape each of them.
+	Enabled bool `mapstructure:"enabled"`
+}
+
 // configInfo holds configuration information to be used as resource/metrics attributes.
 type configInfo struct {
 	Address string
@@ -40,6 +40,9 @@ func TestConfig(t *testing.T) {
 			},
 			Username: "test",
 			Password: "test",
+			Cluster: ClusterConfig{
+				Enabled: true,
+			},
 			ControllerConfig: scraperhelper.ControllerConfig{
 				CollectionInterval: 10 * time.Second,
 				InitialDelay:       time.Second,
@@ -47,6 +47,8 @@ type MetricsConfig struct {
 	RedisKeysExpired                       MetricConfig `mapstructure:"redis.keys.expired"`
 	RedisKeyspaceHits                      MetricConfig `mapstructure:"redis.keyspace.hits"`
 	RedisKeyspaceMisses                    MetricConfig `mapstructure:"redis.keyspace.misses"`
+	RedisLatencyEventDuration              MetricConfig `mapstructure:"redis.latency.event.duration"`
+	RedisLatencyEventMax                   MetricConfig `mapstructure:"redis.latency.event.max"`
 	RedisLatestFork                        MetricConfig `mapstructure:"redis.latest_fork"`
 	RedisMaxmemory                         MetricConfig `mapstructure:"redis.maxmemory"`
 	RedisMemoryFragmentationRatio          MetricConfig `mapstructure:"redis.memory.fragmentation_ratio"`
@@ -124,6 +126,12 @@ func DefaultMetricsConfig() MetricsConfig {
 		RedisKeyspaceMisses: MetricConfig{
 			Enabled: true,
 		},
+		RedisLatencyEventDuration: MetricConfig{
+			Enabled: false,
+		},
+		RedisLatencyEventMax: MetricConfig{
+			Enabled: false,
+		},
 		RedisLatestFork: MetricConfig{
 			Enabled: true,
 		},
@@ -46,6 +46,8 @@ func TestMetricsBuilderConfig(t *testing.T) {
 					RedisKeysExpired:                       MetricConfig{Enabled: true},
 					RedisKeyspaceHits:                      MetricConfig{Enabled: true},
 					RedisKeyspaceMisses:                    MetricConfig{Enabled: true},
+					RedisLatencyEventDuration:              MetricConfig{Enabled: true},
+					RedisLatencyEventMax:                   MetricConfig{Enabled: true},
 					RedisLatestFork:                        MetricConfig{Enabled: true},
 					RedisMaxmemory:                         MetricConfig{Enabled: true},
 					RedisMemoryFragmentationRatio:          MetricConfig{Enabled: true},
@@ -93,6 +95,8 @@ func TestMetricsBuilderConfig(t *testing.T) {
 					RedisKeysExpired:                       MetricConfig{Enabled: false},
 					RedisKeyspaceHits:                      MetricConfig{Enabled: false},
 					RedisKeyspaceMisses:                    MetricConfig{Enabled: false},
+					RedisLatencyEventDuration:              MetricConfig{Enabled: false},
+					RedisLatencyEventMax:                   MetricConfig{Enabled: false},
 					RedisLatestFork:                        MetricConfig{Enabled: false},
 					RedisMaxmemory:                         MetricConfig{Enabled: false},
 					RedisMemoryFragmentationRatio:          MetricConfig{Enabled: false},
@@ -168,6 +168,12 @@ var MetricsInfo = metricsInfo{
 	RedisKeyspaceMisses: metricInfo{
 		Name: "redis.keyspace.misses",
 	},
+	RedisLatencyEventDuration: metricInfo{
+		Name: "redis.latency.event.duration",
+	},
+	RedisLatencyEventMax: metricInfo{
+		Name: "redis.latency.event.max",
+	},
 	RedisLatestFork: metricInfo{
 		Name: "redis.latest_fork",
 	},
@@ -238,6 +244,8 @@ type metricsInfo struct {
 	RedisKeysExpired                       metricInfo
 	RedisKeyspaceHits                      metricInfo
 	RedisKeyspaceMisses                    metricInfo
+	RedisLatencyEventDuration              metricInfo
+	RedisLatencyEventMax                   metricInfo
 	RedisLatestFork                        metricInfo
 	RedisMaxmemory                         metricInfo
 	RedisMemoryFragmentationRatio          metricInfo
@@ -1230,6 +1238,108 @@ func newMetricRedisKeyspaceMisses(cfg MetricConfig) metricRedisKeyspaceMisses {
 	return m
 }
 
+type metricRedisLatencyEventDuration struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills redis.latency.event.duration metric with initial data.
+func (m *metricRedisLatencyEventDuration) init() {
+	m.data.SetName("redis.latency.event.duration")
+	m.data.SetDescription("Latency spike of a Redis event, one data point per spike recorded by the latency monitor since the previous scrape.")
+	m.data.SetUnit("s")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricRedisLatencyEventDuration) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64, eventAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetDoubleValue(val)
+	dp.Attributes().PutStr("event", eventAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricRedisLatencyEventDuration) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricRedisLatencyEventDuration) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricRedisLatencyEventDuration(cfg MetricConfig) metricRedisLatencyEventDuration {
+	m := metricRedisLatencyEventDuration{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricRedisLatencyEventMax struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills redis.latency.event.max metric with initial data.
+func (m *metricRedisLatencyEventMax) init() {
+	m.data.SetName("redis.latency.event.max")
+	m.data.SetDescription("Maximum latency of a Redis event since the latency monitor started or was reset.")
+	m.data.SetUnit("s")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricRedisLatencyEventMax) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64, eventAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetDoubleValue(val)
+	dp.Attributes().PutStr("event", eventAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricRedisLatencyEventMax) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricRedisLatencyEventMax) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricRedisLatencyEventMax(cfg MetricConfig) metricRedisLatencyEventMax {
+	m := metricRedisLatencyEventMax{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 type metricRedisLatestFork struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
@@ -2057,6 +2167,8 @@ type MetricsBuilder struct {
 	metricRedisKeysExpired                       metricRedisKeysExpired
 	metricRedisKeyspaceHits                      metricRedisKeyspaceHits
 	metricRedisKeyspaceMisses                    metricRedisKeyspaceMisses
+	metricRedisLatencyEventDuration              metricRedisLatencyEventDuration
+	metricRedisLatencyEventMax                   metricRedisLatencyEventMax
 	metricRedisLatestFork                        metricRedisLatestFork
 	metricRedisMaxmemory                         metricRedisMaxmemory
 	metricRedisMemoryFragmentationRatio          metricRedisMemoryFragmentationRatio
@@ -2117,6 +2229,8 @@ func NewMetricsBuilder(mbc MetricsBuilderConfig, settings receiver.Settings, opt
 		metricRedisKeysExpired:                       newMetricRedisKeysExpired(mbc.Metrics.RedisKeysExpired),
 		metricRedisKeyspaceHits:                      newMetricRedisKeyspaceHits(mbc.Metrics.RedisKeyspaceHits),
 		metricRedisKeyspaceMisses:                    newMetricRedisKeyspaceMisses(mbc.Metrics.RedisKeyspaceMisses),
+		metricRedisLatencyEventDuration:              newMetricRedisLatencyEventDuration(mbc.Metrics.RedisLatencyEventDuration),
+		metricRedisLatencyEventMax:                   newMetricRedisLatencyEventMax(mbc.Metrics.RedisLatencyEventMax),
 		metricRedisLatestFork:                        newMetricRedisLatestFork(mbc.Metrics.RedisLatestFork),
 		metricRedisMaxmemory:                         newMetricRedisMaxmemory(mbc.Metrics.RedisMaxmemory),
 		metricRedisMemoryFragmentationRatio:          newMetricRedisMemoryFragmentationRatio(mbc.Metrics.RedisMemoryFragmentationRatio),
@@ -2242,6 +2356,8 @@ func (mb *MetricsBuilder) EmitForResource(options ...ResourceMetricsOption) {
 	mb.metricRedisKeysExpired.emit(ils.Metrics())
 	mb.metricRedisKeyspaceHits.emit(ils.Metrics())
 	mb.metricRedisKeyspaceMisses.emit(ils.Metrics())
+	mb.metricRedisLatencyEventDuration.emit(ils.Metrics())
+	mb.metricRedisLatencyEventMax.emit(ils.Metrics())
 	mb.metricRedisLatestFork.emit(ils.Metrics())
 	mb.metricRedisMaxmemory.emit(ils.Metrics())
 	mb.metricRedisMemoryFragmentationRatio.emit(ils.Metrics())
@@ -2384,6 +2500,16 @@ func (mb *MetricsBuilder) RecordRedisKeyspaceMissesDataPoint(ts pcommon.Timestam
 	mb.metricRedisKeyspaceMisses.recordDataPoint(mb.startTime, ts, val)
 }
 
+// RecordRedisLatencyEventDurationDataPoint adds a data point to redis.latency.event.duration metric.
+func (mb *MetricsBuilder) RecordRedisLatencyEventDurationDataPoint(ts pcommon.Timestamp, val float64, eventAttributeValue string) {
+	mb.metricRedisLatencyEventDuration.recordDataPoint(mb.startTime, ts, val, eventAttributeValue)
+}
+
+// RecordRedisLatencyEventMaxDataPoint adds a data point to redis.latency.event.max metric.
+func (mb *MetricsBuilder) RecordRedisLatencyEventMaxDataPoint(ts pcommon.Timestamp, val float64, eventAttributeValue string) {
+	mb.metricRedisLatencyEventMax.recordDataPoint(mb.startTime, ts, val, eventAttributeValue)
+}
+
 // RecordRedisLatestForkDataPoint adds a data point to redis.latest_fork metric.
 func (mb *MetricsBuilder) RecordRedisLatestForkDataPoint(ts pcommon.Timestamp, val int64) {
 	mb.metricRedisLatestFork.recordDataPoint(mb.startTime, ts, val)
@@ -141,6 +141,12 @@ func TestMetricsBuilder(t *testing.T) {
 			allMetricsCount++
 			mb.RecordRedisKeyspaceMissesDataPoint(ts, 1)
 
+			allMetricsCount++
+			mb.RecordRedisLatencyEventDurationDataPoint(ts, 1, "event-val")
+
+			allMetricsCount++
+			mb.RecordRedisLatencyEventMaxDataPoint(ts, 1, "event-val")
+
 			defaultMetricsCount++
 			allMetricsCount++
 			mb.RecordRedisLatestForkDataPoint(ts, 1)
@@ -504,6 +510,36 @@ func TestMetricsBuilder(t *testing.T) {
 					assert.Equal(t, ts, dp.Timestamp())
 					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
 					assert.Equal(t, int64(1), dp.IntValue())
+				case "redis.latency.event.duration":
+					assert.False(t, validatedMetrics["redis.latency.event.duration"], "Found a duplicate in the metrics slice: redis.latency.event.duration")
+					validatedMetrics["redis.latency.event.duration"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Latency spike of a Redis event, one data point per spike recorded by the latency monitor since the previous scrape.", ms.At(i).Description())
+					assert.Equal(t, "s", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeDouble, dp.ValueType())
+					assert.InDelta(t, float64(1), dp.DoubleValue(), 0.01)
+					attrVal, ok := dp.Attributes().Get("event")
+					assert.True(t, ok)
+					assert.Equal(t, "event-val", attrVal.Str())
+				case "redis.latency.event.max":
+					assert.False(t, validatedMetrics["redis.latency.event.max"], "Found a duplicate in the metrics slice: redis.latency.event.max")
+					validatedMetrics["redis.latency.event.max"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Maximum latency of a Redis event since the latency monitor started or was reset.", ms.At(i).Description())
+					assert.Equal(t, "s", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeDouble, dp.ValueType())
+					assert.InDelta(t, float64(1), dp.DoubleValue(), 0.01)
+					attrVal, ok := dp.Attributes().Get("event")
+					assert.True(t, ok)
+					assert.Equal(t, "event-val", attrVal.Str())
 				case "redis.latest_fork":
 					assert.False(t, validatedMetrics["redis.latest_fork"], "Found a duplicate in the metrics slice: redis.latest_fork")
 					validatedMetrics["redis.latest_fork"] = true
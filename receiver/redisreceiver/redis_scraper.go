@@ -29,13 +29,37 @@ type redisScraper struct {
 	mb         *metadata.MetricsBuilder
 	uptime     time.Duration
 	configInfo configInfo
-}
 
-const redisMaxDbs = 16 // Maximum possible number of redis databases
+	// collectLatencyMax and collectLatencyHistory tell whether the latency
+	// monitor is queried.
+	collectLatencyMax     bool
+	collectLatencyHistory bool
+	// latencyHistorySeen holds the unix time of the latest spike recorded for
+	// each latency event, the spikes are recorded only once.
+	latencyHistorySeen map[string]int64
+	startTime          int64
+}
 
 func newRedisScraper(cfg *Config, settings receiver.Settings) (scraper.Metrics, error) {
+	opts, err := newRedisOptions(cfg, cfg.Endpoint)
+	if err != nil {
+		return nil, err
+	}
+	if cfg.Cluster.Enabled {
+		newClient := func(addr string) client {
+			nodeOpts := *opts
+			nodeOpts.Addr = addr
+			return newRedisClient(&nodeOpts)
+		}
+		return newClusterScraperWithClient(newRedisClient(opts), newClient, settings, cfg)
+	}
+	return newRedisScraperWithClient(newRedisClient(opts), settings, cfg)
+}
+
+// newRedisOptions returns the options of the client connecting to addr.
+func newRedisOptions(cfg *Config, addr string) (*redis.Options, error) {
 	opts := &redis.Options{
-		Addr:     cfg.Endpoint,
+		Addr:     addr,
 		Username: cfg.Username,
 		Password: string(cfg.Password),
 		Network:  string(cfg.Transport),
@@ -45,7 +69,7 @@ func newRedisScraper(cfg *Config, settings receiver.Settings) (scraper.Metrics,
 	if opts.TLSConfig, err = cfg.TLS.LoadTLSConfig(context.Background()); err != nil {
 		return nil, err
 	}
-	return newRedisScraperWithClient(newRedisClient(opts), settings, cfg)
+	return opts, nil
 }
 
 func newRedisScraperWithClient(client client, settings receiver.Settings, cfg *Config) (scraper.Metrics, error) {
@@ -53,19 +77,28 @@ func newRedisScraperWithClient(client client, settings receiver.Settings, cfg *C
 	if err != nil {
 		return nil, err
 	}
-	rs := &redisScraper{
-		client:     client,
-		redisSvc:   newRedisSvc(client),
-		settings:   settings.TelemetrySettings,
-		mb:         metadata.NewMetricsBuilder(cfg.MetricsBuilderConfig, settings),
-		configInfo: configInfo,
-	}
+	rs := newNodeScraper(client, settings, cfg, configInfo)
 	return scraper.NewMetrics(
 		rs.Scrape,
 		scraper.WithShutdown(rs.shutdown),
 	)
 }
 
+// newNodeScraper returns the scraper of a single Redis server.
+func newNodeScraper(client client, settings receiver.Settings, cfg *Config, configInfo configInfo) *redisScraper {
+	return &redisScraper{
+		client:                client,
+		redisSvc:              newRedisSvc(client),
+		settings:              settings.TelemetrySettings,
+		mb:                    metadata.NewMetricsBuilder(cfg.MetricsBuilderConfig, settings),
+		configInfo:            configInfo,
+		collectLatencyMax:     cfg.MetricsBuilderConfig.Metrics.RedisLatencyEventMax.Enabled,
+		collectLatencyHistory: cfg.MetricsBuilderConfig.Metrics.RedisLatencyEventDuration.Enabled,
+		latencyHistorySeen:    map[string]int64{},
+		startTime:             time.Now().Unix(),
+	}
+}
+
 func (rs *redisScraper) shutdown(context.Context) error {
 	if rs.client != nil {
 		return rs.client.close()
@@ -99,6 +132,7 @@ func (rs *redisScraper) Scrape(context.Context) (pmetric.Metrics, error) {
 	rs.recordKeyspaceMetrics(now, inf)
 	rs.recordRoleMetrics(now, inf)
 	rs.recordCmdMetrics(now, inf)
+	rs.recordLatencyMetrics(now)
 	rb := rs.mb.NewResourceBuilder()
 	rb.SetRedisVersion(rs.getRedisVersion(inf))
 	rb.SetServerAddress(rs.configInfo.Address)
@@ -135,11 +169,11 @@ func (rs *redisScraper) recordCommonMetrics(ts pcommon.Timestamp, inf info) {
 }
 
 // recordKeyspaceMetrics records metrics from 'keyspace' Redis info key-value pairs,
-// e.g. "db0: keys=1,expires=2,avg_ttl=3".
+// e.g. "db0: keys=1,expires=2,avg_ttl=3". Servers may be configured with more
+// than the default 16 databases, so every "db<N>" key is recorded.
 func (rs *redisScraper) recordKeyspaceMetrics(ts pcommon.Timestamp, inf info) {
-	for db := range redisMaxDbs {
-		key := "db" + strconv.Itoa(db)
-		str, ok := inf[key]
+	for key, str := range inf {
+		db, ok := parseKeyspaceDB(key)
 		if !ok {
 			continue
 		}
@@ -155,6 +189,18 @@ func (rs *redisScraper) recordKeyspaceMetrics(ts pcommon.Timestamp, inf info) {
 	}
 }
 
+// parseKeyspaceDB returns the database index of a keyspace key, e.g. 3 for "db3".
+func parseKeyspaceDB(key string) (int, bool) {
+	if !strings.HasPrefix(key, "db") {
+		return 0, false
+	}
+	db, err := strconv.Atoi(key[len("db"):])
+	if err != nil || db < 0 {
+		return 0, false
+	}
+	return db, true
+}
+
 // getRedisVersion retrieves version string from 'redis_version' Redis info key-value pairs
 // e.g. "redis_version:5.0.7"
 func (*redisScraper) getRedisVersion(inf info) string {
@@ -234,3 +280,50 @@ func (rs *redisScraper) recordCmdLatencyMetrics(ts pcommon.Timestamp, cmd, val s
 		}
 	}
 }
+
+// recordLatencyMetrics records the latency spikes reported by the latency
+// monitor. The monitor is disabled unless latency-monitor-threshold is set, and
+// the LATENCY command may be unavailable on managed services, so failures are
+// logged without failing the scrape.
+func (rs *redisScraper) recordLatencyMetrics(ts pcommon.Timestamp) {
+	if !rs.collectLatencyMax && !rs.collectLatencyHistory {
+		return
+	}
+
+	events, err := rs.client.retrieveLatencyLatest()
+	if err != nil {
+		rs.settings.Logger.Warn("failed to retrieve LATENCY LATEST", zap.Error(err))
+		return
+	}
+	for _, event := range events {
+		if rs.collectLatencyMax {
+			rs.mb.RecordRedisLatencyEventMaxDataPoint(ts, float64(event.max)/1e3, event.name)
+		}
+		if !rs.collectLatencyHistory {
+			continue
+		}
+
+		seen, ok := rs.latencyHistorySeen[event.name]
+		if !ok {
+			// The spikes that happened before the receiver started are not reported.
+			seen = rs.startTime
+		}
+		if event.timestamp <= seen {
+			continue
+		}
+		samples, err := rs.client.retrieveLatencyHistory(event.name)
+		if err != nil {
+			rs.settings.Logger.Warn("failed to retrieve LATENCY HISTORY", zap.String("event", event.name), zap.Error(err))
+			continue
+		}
+		latest := seen
+		for _, sample := range samples {
+			if sample.timestamp <= seen {
+				continue
+			}
+			rs.mb.RecordRedisLatencyEventDurationDataPoint(pcommon.NewTimestampFromTime(time.Unix(sample.timestamp, 0)), float64(sample.latency)/1e3, event.name)
+			latest = max(latest, sample.timestamp)
+		}
+		rs.latencyHistorySeen[event.name] = latest
+	}
+}
@@ -62,6 +62,9 @@ func newClient(cfg *Config, logger *zap.Logger) (client, error) {
 	// Create goSNMP client
 	goSNMP := newGoSNMPWrapper()
 	goSNMP.SetTimeout(cfg.Timeout)
+	if cfg.MaxRepetitions > 0 {
+		goSNMP.SetMaxRepetitions(cfg.MaxRepetitions)
+	}
 
 	// Set goSNMP version based on config
 	switch cfg.Version {
@@ -98,6 +101,14 @@ func newClient(cfg *Config, logger *zap.Logger) (client, error) {
 	if goSNMP.GetVersion() == gosnmp.Version3 {
 		// Set goSNMP v3 configs
 		setV3ClientConfigs(goSNMP, cfg)
+
+		// Set goSNMP v3 context based on config
+		contextEngineID, err := cfg.contextEngineID()
+		if err != nil {
+			return nil, fmt.Errorf("failed to create goSNMP client: issue parsing context_engine_id '%s'. %w", cfg.ContextEngineID, err)
+		}
+		goSNMP.SetContextName(cfg.ContextName)
+		goSNMP.SetContextEngineID(contextEngineID)
 	} else {
 		// Set goSNMP community string
 		goSNMP.SetCommunity(cfg.Community)
@@ -4,10 +4,12 @@
 package snmpreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/snmpreceiver"
 
 import (
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"math"
 	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/gosnmp/gosnmp"
@@ -59,6 +61,22 @@ func TestNewClient(t *testing.T) {
 			logger:      zap.NewNop(),
 			expectError: nil,
 		},
+		{
+			desc: "Valid v3 configuration with context and max repetitions",
+			cfg: &Config{
+				Version:         "v3",
+				Endpoint:        "udp://localhost:161",
+				User:            "user",
+				SecurityLevel:   "no_auth_no_priv",
+				ContextName:     "vlan-100",
+				ContextEngineID: "0x80001f8880e9630000d61ff449",
+				MaxRepetitions:  10,
+			},
+			host:        componenttest.NewNopHost(),
+			settings:    componenttest.NewNopTelemetrySettings(),
+			logger:      zap.NewNop(),
+			expectError: nil,
+		},
 	}
 
 	for _, tc := range testCase {
@@ -85,6 +103,9 @@ func compareConfigToClient(t *testing.T, client *snmpClient, cfg *Config) {
 	require.Contains(t, cfg.Endpoint, client.client.GetTarget())
 	require.Contains(t, cfg.Endpoint, strconv.FormatInt(int64(client.client.GetPort()), 10))
 	require.Contains(t, cfg.Endpoint, client.client.GetTransport())
+	if cfg.MaxRepetitions > 0 {
+		require.Equal(t, cfg.MaxRepetitions, client.client.GetMaxRepetitions())
+	}
 	switch cfg.Version {
 	case "v1":
 		require.Equal(t, gosnmp.Version1, client.client.GetVersion())
@@ -96,6 +117,10 @@ func compareConfigToClient(t *testing.T, client *snmpClient, cfg *Config) {
 		require.Equal(t, gosnmp.Version3, client.client.GetVersion())
 		securityParams := client.client.GetSecurityParameters().(*gosnmp.UsmSecurityParameters)
 		require.Equal(t, cfg.User, securityParams.UserName)
+		require.Equal(t, cfg.ContextName, client.client.GetContextName())
+		contextEngineID, err := hex.DecodeString(strings.TrimPrefix(cfg.ContextEngineID, "0x"))
+		require.NoError(t, err)
+		require.Equal(t, string(contextEngineID), client.client.GetContextEngineID())
 		switch cfg.SecurityLevel {
 		case "no_auth_no_priv":
 			require.Equal(t, gosnmp.NoAuthNoPriv, client.client.GetMsgFlags())
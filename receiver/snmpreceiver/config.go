@@ -3,6 +3,7 @@
 package snmpreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/snmpreceiver"
 
 import (
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"net/url"
@@ -53,6 +54,8 @@ var (
 	errMsgMultipleKeysSetOnResourceAttribute        = `resource attribute '%s' must have only one of oid, scalar_oid, or indexed_value_prefix`
 	errScalarOIDResourceAttributeEndsInNonzeroDigit = `resource attribute '%s' has scalar_oid '%s' that ends in a nonzero digit (scalar oids should not be indexed)`
 	errColumnOIDResourceAttributeEndsInZero         = `resource attribute '%s' has oid '%s' that ends in a zero (column oids should be indexed)`
+	errMsgIndexComponentsNoOIDOrPrefix              = `%s '%s' index_components must be used with an oid or indexed_value_prefix`
+	errMsgIndexComponentsNotPositive                = `%s '%s' index_components must only contain positive positions`
 
 	// Config errors
 	errEmptyEndpoint        = errors.New("endpoint must be specified")
@@ -68,6 +71,8 @@ var (
 	errEmptyPrivacyType     = errors.New("privacy_type must be specified when security_level is auth_priv")
 	errBadPrivacyType       = errors.New("privacy_type must be either DES, AES, AES192, AES192C, AES256, AES256C")
 	errEmptyPrivacyPassword = errors.New("privacy_password must be specified when security_level is auth_priv")
+	errContextNotV3         = errors.New("context_name and context_engine_id are only valid when version is v3")
+	errBadContextEngineID   = errors.New("context_engine_id must be a hexadecimal string")
 	errMetricRequired       = errors.New("must have at least one config under metrics")
 )
 
@@ -121,6 +126,23 @@ type Config struct {
 	// Only valid for version “v3” and if "auth_priv" is selected for SecurityLevel
 	PrivacyPassword configopaque.String `mapstructure:"privacy_password"`
 
+	// ContextName is the SNMPv3 context name used for the requests, selecting one of the
+	// contexts of agents exposing several MIB instances (e.g. one per VLAN or per virtual router).
+	// Only valid for version “v3”
+	ContextName string `mapstructure:"context_name"`
+
+	// ContextEngineID is the SNMPv3 context engine ID used for the requests, as a hexadecimal string.
+	// Only valid for version “v3”
+	// Default: the authoritative engine ID of the agent
+	ContextEngineID string `mapstructure:"context_engine_id"`
+
+	// MaxRepetitions is the max-repetitions value of the GETBULK requests used to walk column OIDs.
+	// Larger values reduce the number of round trips for large tables, smaller values help agents
+	// that fail or time out on large responses.
+	// Only valid for versions "v2c" and "v3"
+	// Default: 50
+	MaxRepetitions uint32 `mapstructure:"max_repetitions"`
+
 	// ResourceAttributes defines what resource attributes will be used for this receiver and is composed
 	// of resource attribute names along with their resource attribute configurations
 	ResourceAttributes map[string]*ResourceAttributeConfig `mapstructure:"resource_attributes"`
@@ -153,6 +175,13 @@ type ResourceAttributeConfig struct {
 	// as an attribute on that resource. The related indexed metric values will then be used to associate metric datapoints to
 	// those resources.
 	IndexedValuePrefix string `mapstructure:"indexed_value_prefix"` // required and valid if no oid or scalar_oid field
+	// IndexComponents is optional and only valid alongside OID or IndexedValuePrefix.
+	// This lists the positions (starting at 1) of the sub-identifiers of the indexes of metric values which are used
+	// in place of the whole index to find the value of this resource attribute. This allows metrics of tables with
+	// composite indexes (Ex: an interface index and a VLAN ID) to be associated with tables indexed by a part of their
+	// index, and several sub-identifiers can be joined to build composite values. The sub-identifiers are joined in
+	// the given order.
+	IndexComponents []int `mapstructure:"index_components"`
 
 	// prevent unkeyed literal initialization
 	_ struct{}
@@ -173,6 +202,10 @@ type AttributeConfig struct {
 	// IndexedValuePrefix is required only if Enum and OID are not defined.
 	// This is used alongside metrics with ColumnOIDs to assign attribute values using this prefix + the OID index of the metric value
 	IndexedValuePrefix string `mapstructure:"indexed_value_prefix"`
+	// IndexComponents is optional and only valid alongside OID or IndexedValuePrefix.
+	// This lists the positions (starting at 1) of the sub-identifiers of the indexes of metric values which are used
+	// in place of the whole index to find the value of this attribute. The sub-identifiers are joined in the given order.
+	IndexComponents []int `mapstructure:"index_components"`
 }
 
 // MetricConfig contains config info about a given metric
@@ -261,6 +294,9 @@ func (cfg *Config) Validate() error {
 	combinedErr = errors.Join(combinedErr, validateVersion(cfg))
 	if strings.EqualFold(cfg.Version, "V3") {
 		combinedErr = errors.Join(combinedErr, validateSecurity(cfg))
+		combinedErr = errors.Join(combinedErr, validateContext(cfg))
+	} else if cfg.ContextName != "" || cfg.ContextEngineID != "" {
+		combinedErr = errors.Join(combinedErr, errContextNotV3)
 	}
 	combinedErr = errors.Join(combinedErr, validateMetricConfigs(cfg))
 
@@ -337,6 +373,21 @@ func validateSecurity(cfg *Config) error {
 	}
 }
 
+// validateContext validates the ContextEngineID
+func validateContext(cfg *Config) error {
+	if _, err := cfg.contextEngineID(); err != nil {
+		return errBadContextEngineID
+	}
+
+	return nil
+}
+
+// contextEngineID returns the ContextEngineID decoded from its hexadecimal representation
+func (cfg *Config) contextEngineID() (string, error) {
+	engineID, err := hex.DecodeString(strings.TrimPrefix(cfg.ContextEngineID, "0x"))
+	return string(engineID), err
+}
+
 // validateAuth validates the AuthType and AuthPassword
 func validateAuth(cfg *Config) error {
 	var combinedErr error
@@ -589,6 +640,7 @@ func validateAttributeConfigs(cfg *Config) error {
 		if len(attrCfg.Enum) == 0 && attrCfg.OID == "" && attrCfg.IndexedValuePrefix == "" {
 			combinedErr = errors.Join(combinedErr, fmt.Errorf(errMsgAttributeConfigNoEnumOIDOrPrefix, attrName))
 		}
+		combinedErr = errors.Join(combinedErr, validateIndexComponents("attribute", attrName, attrCfg.IndexComponents, attrCfg.OID != "" || attrCfg.IndexedValuePrefix != ""))
 	}
 
 	return combinedErr
@@ -633,6 +685,26 @@ func validateResourceAttributeConfigs(cfg *Config) error {
 		default:
 			combinedErr = errors.Join(combinedErr, fmt.Errorf(errMsgResourceAttributeNoOIDOrScalarOIDOrPrefix, attrName))
 		}
+		combinedErr = errors.Join(combinedErr, validateIndexComponents("resource_attribute", attrName, attrCfg.IndexComponents, hasOID || hasIVP))
 	}
 	return combinedErr
 }
+
+// validateIndexComponents validates the IndexComponents of an AttributeConfig or ResourceAttributeConfig
+func validateIndexComponents(kind, attrName string, indexComponents []int, indexed bool) error {
+	if len(indexComponents) == 0 {
+		return nil
+	}
+
+	if !indexed {
+		return fmt.Errorf(errMsgIndexComponentsNoOIDOrPrefix, kind, attrName)
+	}
+
+	for _, position := range indexComponents {
+		if position < 1 {
+			return fmt.Errorf(errMsgIndexComponentsNotPositive, kind, attrName)
+		}
+	}
+
+	return nil
+}
@@ -179,6 +179,16 @@ func (h configHelper) getAttributeConfigOID(name string) string {
 	return attrConfig.OID
 }
 
+// getAttributeConfigIndexComponents returns the index components of an attribute config
+func (h configHelper) getAttributeConfigIndexComponents(name string) []int {
+	attrConfig := h.cfg.Attributes[name]
+	if attrConfig == nil {
+		return nil
+	}
+
+	return attrConfig.IndexComponents
+}
+
 // getResourceAttributeConfigIndexedValuePrefix returns the indexed value prefix of a resource attribute config
 func (h configHelper) getResourceAttributeConfigIndexedValuePrefix(name string) string {
 	attrConfig := h.cfg.ResourceAttributes[name]
@@ -209,6 +219,16 @@ func (h configHelper) getResourceAttributeConfigScalarOID(name string) string {
 	return attrConfig.ScalarOID
 }
 
+// getResourceAttributeConfigIndexComponents returns the index components of a resource attribute config
+func (h configHelper) getResourceAttributeConfigIndexComponents(name string) []int {
+	attrConfig := h.cfg.ResourceAttributes[name]
+	if attrConfig == nil {
+		return nil
+	}
+
+	return attrConfig.IndexComponents
+}
+
 // getMetricConfigAttributes returns the metric config attributes for a given OID
 func (h configHelper) getMetricConfigAttributes(oid string) []Attribute {
 	return h.metricAttributesByOID[oid]
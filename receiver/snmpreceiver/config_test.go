@@ -131,6 +131,17 @@ func TestLoadConfigConnectionConfigs(t *testing.T) {
 	expectedConfigV3NoPrivacyPassword.AuthPassword = "p"
 	expectedConfigV3NoPrivacyPassword.Metrics = metrics
 
+	expectedConfigV3Context := factory.CreateDefaultConfig().(*Config)
+	expectedConfigV3Context.Version = "v3"
+	expectedConfigV3Context.User = "u"
+	expectedConfigV3Context.SecurityLevel = "auth_priv"
+	expectedConfigV3Context.AuthPassword = "p"
+	expectedConfigV3Context.PrivacyPassword = "pp"
+	expectedConfigV3Context.ContextName = "vlan-100"
+	expectedConfigV3Context.ContextEngineID = "80001f8880e9630000d61ff449"
+	expectedConfigV3Context.MaxRepetitions = 10
+	expectedConfigV3Context.Metrics = metrics
+
 	testCases := []testCase{
 		{
 			name:        "NoEndpointUsesDefault",
@@ -246,6 +257,12 @@ func TestLoadConfigConnectionConfigs(t *testing.T) {
 			expectedCfg: expectedConfigV3Simple,
 			expectedErr: "",
 		},
+		{
+			name:        "GoodV3ContextNoErrors",
+			nameVal:     "v3_context_good",
+			expectedCfg: expectedConfigV3Context,
+			expectedErr: "",
+		},
 	}
 
 	for _, test := range testCases {
@@ -773,6 +790,19 @@ func TestLoadConfigMetricConfigs(t *testing.T) {
 	expectedConfigScalarOIDWithIVPResourceAttributeIsInvalid.ResourceAttributes = getBaseResourceAttrConfig("prefix")
 	expectedConfigScalarOIDWithIVPResourceAttributeIsInvalid.Metrics["m3"].ScalarOIDs[0].ResourceAttributes = []string{"ra1"}
 
+	expectedConfigIndexComponents := factory.CreateDefaultConfig().(*Config)
+	expectedConfigIndexComponents.Metrics = getBaseMetricConfig(true, false)
+	expectedConfigIndexComponents.Attributes = getBaseAttrConfig("prefix")
+	expectedConfigIndexComponents.Attributes["a2"].IndexComponents = []int{2, 3}
+	expectedConfigIndexComponents.ResourceAttributes = map[string]*ResourceAttributeConfig{
+		"ra1": {
+			OID:             "2",
+			IndexComponents: []int{1},
+		},
+	}
+	expectedConfigIndexComponents.Metrics["m3"].ColumnOIDs[0].ResourceAttributes = []string{"ra1"}
+	expectedConfigIndexComponents.Metrics["m3"].ColumnOIDs[0].Attributes = []Attribute{{Name: "a2"}}
+
 	testCases := []testCase{
 		{
 			name:        "NoMetricConfigsErrors",
@@ -990,6 +1020,12 @@ func TestLoadConfigMetricConfigs(t *testing.T) {
 			expectedCfg: expectedConfigScalarOIDWithIVPResourceAttributeIsInvalid,
 			expectedErr: fmt.Sprintf(errMsgScalarMetricHasIndexedResourceAttribute, "m3", "ra1"),
 		},
+		{
+			name:        "IndexComponentsNoErrors",
+			nameVal:     "index_components_good",
+			expectedCfg: expectedConfigIndexComponents,
+			expectedErr: "",
+		},
 	}
 
 	for _, test := range testCases {
@@ -1133,6 +1169,61 @@ func TestValidate(t *testing.T) {
 			},
 			expectedErr: errEmptyPrivacyType.Error(),
 		},
+		{
+			name: "ContextWithoutV3Errors",
+			cfg: &Config{
+				Endpoint:    "udp://localhost:161",
+				Version:     "v2c",
+				Community:   "public",
+				ContextName: "vlan-100",
+				Metrics:     getBaseMetricConfig(true, true),
+			},
+			expectedErr: errContextNotV3.Error(),
+		},
+		{
+			name: "V3BadContextEngineIDErrors",
+			cfg: &Config{
+				Endpoint:        "udp://localhost:161",
+				Version:         "v3",
+				SecurityLevel:   "no_auth_no_priv",
+				User:            "u",
+				ContextEngineID: "not-hex",
+				Metrics:         getBaseMetricConfig(true, true),
+			},
+			expectedErr: errBadContextEngineID.Error(),
+		},
+		{
+			name: "AttributeIndexComponentsWithEnumErrors",
+			cfg: &Config{
+				Endpoint:  "udp://localhost:161",
+				Version:   "v2c",
+				Community: "public",
+				Attributes: map[string]*AttributeConfig{
+					"a2": {
+						Enum:            []string{"val1"},
+						IndexComponents: []int{1},
+					},
+				},
+				Metrics: getBaseMetricConfig(true, true),
+			},
+			expectedErr: fmt.Sprintf(errMsgIndexComponentsNoOIDOrPrefix, "attribute", "a2"),
+		},
+		{
+			name: "ResourceAttributeIndexComponentsNotPositiveErrors",
+			cfg: &Config{
+				Endpoint:  "udp://localhost:161",
+				Version:   "v2c",
+				Community: "public",
+				ResourceAttributes: map[string]*ResourceAttributeConfig{
+					"ra1": {
+						OID:             "2",
+						IndexComponents: []int{0},
+					},
+				},
+				Metrics: getBaseMetricConfig(true, true),
+			},
+			expectedErr: fmt.Sprintf(errMsgIndexComponentsNotPositive, "resource_attribute", "ra1"),
+		},
 	}
 
 	for _, test := range testCases {
@@ -80,6 +80,24 @@ type goSNMPWrapper interface {
 	// SetMaxOids sets the MaxOids
 	SetMaxOids(maxOids int)
 
+	// GetMaxRepetitions gets the MaxRepetitions
+	GetMaxRepetitions() uint32
+
+	// SetMaxRepetitions sets the MaxRepetitions
+	SetMaxRepetitions(maxRepetitions uint32)
+
+	// GetContextName gets the ContextName
+	GetContextName() string
+
+	// SetContextName sets the ContextName
+	SetContextName(contextName string)
+
+	// GetContextEngineID gets the ContextEngineID
+	GetContextEngineID() string
+
+	// SetContextEngineID sets the ContextEngineID
+	SetContextEngineID(contextEngineID string)
+
 	// GetMsgFlags gets the MsgFlags
 	GetMsgFlags() gosnmp.SnmpV3MsgFlags
 
@@ -188,6 +206,36 @@ func (w *otelGoSNMPWrapper) SetMaxOids(maxOids int) {
 	w.MaxOids = maxOids
 }
 
+// GetMaxRepetitions gets the MaxRepetitions
+func (w *otelGoSNMPWrapper) GetMaxRepetitions() uint32 {
+	return w.MaxRepetitions
+}
+
+// SetMaxRepetitions sets the MaxRepetitions
+func (w *otelGoSNMPWrapper) SetMaxRepetitions(maxRepetitions uint32) {
+	w.MaxRepetitions = maxRepetitions
+}
+
+// GetContextName gets the ContextName
+func (w *otelGoSNMPWrapper) GetContextName() string {
+	return w.ContextName
+}
+
+// SetContextName sets the ContextName
+func (w *otelGoSNMPWrapper) SetContextName(contextName string) {
+	w.ContextName = contextName
+}
+
+// GetContextEngineID gets the ContextEngineID
+func (w *otelGoSNMPWrapper) GetContextEngineID() string {
+	return w.ContextEngineID
+}
+
+// SetContextEngineID sets the ContextEngineID
+func (w *otelGoSNMPWrapper) SetContextEngineID(contextEngineID string) {
+	w.ContextEngineID = contextEngineID
+}
+
 // GetMsgFlags gets the MsgFlags
 func (w *otelGoSNMPWrapper) GetMsgFlags() gosnmp.SnmpV3MsgFlags {
 	return w.MsgFlags
@@ -102,6 +102,34 @@ func (_m *MockGoSNMPWrapper) GetCommunity() string {
 	return r0
 }
 
+// GetContextEngineID provides a mock function with given fields:
+func (_m *MockGoSNMPWrapper) GetContextEngineID() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// GetContextName provides a mock function with given fields:
+func (_m *MockGoSNMPWrapper) GetContextName() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
 // GetMaxOids provides a mock function with given fields:
 func (_m *MockGoSNMPWrapper) GetMaxOids() int {
 	ret := _m.Called()
@@ -116,6 +144,20 @@ func (_m *MockGoSNMPWrapper) GetMaxOids() int {
 	return r0
 }
 
+// GetMaxRepetitions provides a mock function with given fields:
+func (_m *MockGoSNMPWrapper) GetMaxRepetitions() uint32 {
+	ret := _m.Called()
+
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func() uint32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	return r0
+}
+
 // GetMsgFlags provides a mock function with given fields:
 func (_m *MockGoSNMPWrapper) GetMsgFlags() gosnmp.SnmpV3MsgFlags {
 	ret := _m.Called()
@@ -235,11 +277,26 @@ func (_m *MockGoSNMPWrapper) SetCommunity(community string) {
 	_m.Called(community)
 }
 
+// SetContextEngineID provides a mock function with given fields: contextEngineID
+func (_m *MockGoSNMPWrapper) SetContextEngineID(contextEngineID string) {
+	_m.Called(contextEngineID)
+}
+
+// SetContextName provides a mock function with given fields: contextName
+func (_m *MockGoSNMPWrapper) SetContextName(contextName string) {
+	_m.Called(contextName)
+}
+
 // SetMaxOids provides a mock function with given fields: maxOids
 func (_m *MockGoSNMPWrapper) SetMaxOids(maxOids int) {
 	_m.Called(maxOids)
 }
 
+// SetMaxRepetitions provides a mock function with given fields: maxRepetitions
+func (_m *MockGoSNMPWrapper) SetMaxRepetitions(maxRepetitions uint32) {
+	_m.Called(maxRepetitions)
+}
+
 // SetMsgFlags provides a mock function with given fields: msgFlags
 func (_m *MockGoSNMPWrapper) SetMsgFlags(msgFlags gosnmp.SnmpV3MsgFlags) {
 	_m.Called(msgFlags)
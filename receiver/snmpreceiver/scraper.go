@@ -233,7 +233,7 @@ func (s *snmpScraper) indexedDataToMetric(
 	if len(resourceAttributes) == numScalarResourceAttributes {
 		resourceKey = getResourceKey(resourceAttributeNames, "")
 	} else {
-		resourceKey = getResourceKey(resourceAttributeNames, indexString)
+		resourceKey = getResourceKey(resourceAttributeNames, getResourceIndex(configHelper, data.columnOID, indexString))
 	}
 
 	// Create a new resource if needed
@@ -319,11 +319,13 @@ func getIndexedDataPointAttributes(
 		var attributeValue string
 		prefix := configHelper.getAttributeConfigIndexedValuePrefix(attributeName)
 		oid := configHelper.getAttributeConfigOID(attributeName)
+		index, ok := selectIndexComponents(indexString, configHelper.getAttributeConfigIndexComponents(attributeName))
 		switch {
+		case !ok:
 		case prefix != "":
-			attributeValue = prefix + indexString
+			attributeValue = prefix + index
 		case oid != "":
-			attributeValue = columnOIDIndexedAttributeValues[oid][indexString]
+			attributeValue = columnOIDIndexedAttributeValues[oid][index]
 		default:
 			attributeValue = attribute.Value
 		}
@@ -355,11 +357,14 @@ func getResourceAttributes(
 		prefix := configHelper.getResourceAttributeConfigIndexedValuePrefix(attributeName)
 		oid := configHelper.getResourceAttributeConfigOID(attributeName)
 		scalarOid := configHelper.getResourceAttributeConfigScalarOID(attributeName)
+		index, ok := selectIndexComponents(indexString, configHelper.getResourceAttributeConfigIndexComponents(attributeName))
 		switch {
+		case !ok:
+			return nil, errors.New(errMsgResourceAttributeEmptyValue)
 		case prefix != "":
-			resourceAttributes[attributeName] = prefix + indexString
+			resourceAttributes[attributeName] = prefix + index
 		case oid != "":
-			attributeValue := columnOIDIndexedResourceAttributeValues[oid][indexString]
+			attributeValue := columnOIDIndexedResourceAttributeValues[oid][index]
 
 			if attributeValue == "" {
 				return nil, errors.New(errMsgResourceAttributeEmptyValue)
@@ -376,6 +381,45 @@ func getResourceAttributes(
 	return resourceAttributes, nil
 }
 
+// getResourceIndex returns the part of the index of a metric value identifying its resource. When the
+// indexed resource attributes select components of the index, the values of the other components
+// (Ex: a VLAN ID used as a datapoint attribute) belong to the same resource.
+func getResourceIndex(configHelper *configHelper, columnOID string, indexString string) string {
+	var resourceIndex string
+	for _, attributeName := range configHelper.getResourceAttributeNames(columnOID) {
+		indexComponents := configHelper.getResourceAttributeConfigIndexComponents(attributeName)
+		if len(indexComponents) == 0 {
+			if configHelper.getResourceAttributeConfigScalarOID(attributeName) == "" {
+				return indexString
+			}
+			continue
+		}
+		index, _ := selectIndexComponents(indexString, indexComponents)
+		resourceIndex += index
+	}
+
+	return resourceIndex
+}
+
+// selectIndexComponents returns the index made of the sub-identifiers of the given index at the given positions
+// (starting at 1), or the whole index if there are no positions. It returns false if a position is out of range.
+func selectIndexComponents(indexString string, indexComponents []int) (string, bool) {
+	if len(indexComponents) == 0 {
+		return indexString, true
+	}
+
+	subIdentifiers := strings.Split(strings.TrimPrefix(indexString, "."), ".")
+	var index strings.Builder
+	for _, position := range indexComponents {
+		if position < 1 || position > len(subIdentifiers) {
+			return "", false
+		}
+		index.WriteString("." + subIdentifiers[position-1])
+	}
+
+	return index.String(), true
+}
+
 // scrapeScalarResourceAttributes retrieves all SNMP data from resource attribute
 // config scalar OIDs and stores the returned data for later use by metrics
 func (s *snmpScraper) scrapeScalarResourceAttributes(
@@ -3078,6 +3078,106 @@ func TestScrape(t *testing.T) {
 				require.NoError(t, err)
 			},
 		},
+
+		{
+			desc: "Resource attribute and attribute with index components split a composite index (30)",
+			testFunc: func(t *testing.T) {
+				mockClient := new(mockClient)
+				coidRA11 := snmpData{
+					columnOID: ".1",
+					oid:       ".1.1",
+					value:     "eth0",
+					valueType: stringVal,
+				}
+				coidRA12 := snmpData{
+					columnOID: ".1",
+					oid:       ".1.2",
+					value:     "eth1",
+					valueType: stringVal,
+				}
+				coid21100 := snmpData{
+					columnOID: ".2",
+					oid:       ".2.1.100",
+					value:     int64(1),
+					valueType: integerVal,
+				}
+				coid21200 := snmpData{
+					columnOID: ".2",
+					oid:       ".2.1.200",
+					value:     int64(2),
+					valueType: integerVal,
+				}
+				coid22100 := snmpData{
+					columnOID: ".2",
+					oid:       ".2.2.100",
+					value:     int64(3),
+					valueType: integerVal,
+				}
+				coid23 := snmpData{
+					columnOID: ".2",
+					oid:       ".2.3",
+					value:     int64(4),
+					valueType: integerVal,
+				}
+				mockClient.On("Connect").Return(nil)
+				mockClient.On("Close").Return(nil)
+				mockClient.On("GetIndexedData", []string{".1"}, mock.Anything).Return([]snmpData{coidRA11, coidRA12}).Once()
+				mockClient.On("GetIndexedData", []string{".2"}, mock.Anything).Return([]snmpData{coid21100, coid21200, coid22100, coid23}).Once()
+				scraper := &snmpScraper{
+					cfg: &Config{
+						ResourceAttributes: map[string]*ResourceAttributeConfig{
+							"rattr1": {
+								OID:             ".1",
+								IndexComponents: []int{1},
+							},
+						},
+						Attributes: map[string]*AttributeConfig{
+							"attr1": {
+								IndexedValuePrefix: "vlan",
+								IndexComponents:    []int{2},
+							},
+						},
+						Metrics: map[string]*MetricConfig{
+							"metric1": {
+								Description: "test description",
+								Unit:        "By",
+								Gauge: &GaugeMetric{
+									ValueType: "int",
+								},
+								ColumnOIDs: []ColumnOID{
+									{
+										OID:                ".2",
+										ResourceAttributes: []string{"rattr1"},
+										Attributes: []Attribute{
+											{
+												Name: "attr1",
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+					settings: receivertest.NewNopSettings(metadata.Type),
+					client:   mockClient,
+					logger:   zap.NewNop(),
+				}
+
+				expectedMetricGen := func(t *testing.T) pmetric.Metrics {
+					goldenPath := filepath.Join("testdata", "expected_metrics", "30_composite_index_res_attr_and_attr_golden.yaml")
+					expectedMetrics, err := golden.ReadMetrics(goldenPath)
+					require.NoError(t, err)
+					return expectedMetrics
+				}
+				expectedMetrics := expectedMetricGen(t)
+				metrics, err := scraper.scrape(context.Background())
+				// The value indexed by a single sub-identifier has no VLAN and does not create a datapoint
+				expectedErr := fmt.Errorf(errMsgIndexedMetricOIDProcessing, ".2.3", ".2", fmt.Errorf(errMsgOIDAttributeEmptyValue, "metric1", errors.New(errMsgAttributeEmptyValue)))
+				require.EqualError(t, err, expectedErr.Error())
+				err = pmetrictest.CompareMetrics(expectedMetrics, metrics, pmetrictest.IgnoreTimestamp())
+				require.NoError(t, err)
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -4,6 +4,8 @@
 package splunkhecreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/splunkhecreceiver"
 
 import (
+	"errors"
+
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/config/confighttp"
 
@@ -41,4 +43,16 @@ type Ack struct {
 	Extension *component.ID `mapstructure:"extension"`
 	// Path for Ack API, default is '/services/collector/ack'. Ignored if Extension is not provided.
 	Path string `mapstructure:"path"`
+	// RequireChannel rejects event and raw requests without a data channel, as Splunk does for tokens with
+	// indexer acknowledgement enabled. Requires Extension.
+	RequireChannel bool `mapstructure:"require_channel"`
+}
+
+var errRequireChannelWithoutExtension = errors.New(`"ack::require_channel" requires "ack::extension" to be set`)
+
+func (cfg *Config) Validate() error {
+	if cfg.RequireChannel && cfg.Extension == nil {
+		return errRequireChannelWithoutExtension
+	}
+	return nil
 }
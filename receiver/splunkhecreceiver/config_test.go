@@ -98,3 +98,13 @@ func TestLoadConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateConfig(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.RequireChannel = true
+	assert.ErrorIs(t, xconfmap.Validate(cfg), errRequireChannelWithoutExtension)
+
+	id := component.MustNewID("ack_extension")
+	cfg.Extension = &id
+	assert.NoError(t, xconfmap.Validate(cfg))
+}
@@ -195,12 +195,38 @@ func (r *splunkReceiver) Shutdown(context.Context) error {
 	return err
 }
 
-func (r *splunkReceiver) processSuccessResponseWithAck(resp http.ResponseWriter, channelID string) error {
+// extractDataChannel returns the data channel of an event or raw request.
+// It returns false when the request must be rejected because its channel is invalid or missing while required.
+func (r *splunkReceiver) extractDataChannel(resp http.ResponseWriter, req *http.Request) (string, bool) {
+	channelID, extracted := r.extractChannel(req)
+	if !extracted {
+		if r.ackExt != nil && r.config.RequireChannel {
+			r.failRequest(resp, http.StatusBadRequest, requiredDataChannelHeader, nil)
+			return "", false
+		}
+		return "", true
+	}
+	if channelErr := r.validateChannelHeader(channelID); channelErr != nil {
+		r.failRequest(resp, http.StatusBadRequest, []byte(channelErr.Error()), channelErr)
+		return "", false
+	}
+	return channelID, true
+}
+
+// startAck registers a request with the ack extension before its data is consumed.
+// The ack ID is only acknowledged once the data was accepted by the pipeline, see processSuccessResponseWithAck.
+func (r *splunkReceiver) startAck(channelID string) (uint64, bool) {
+	if channelID == "" || r.ackExt == nil {
+		return 0, false
+	}
+	return r.ackExt.ProcessEvent(channelID), true
+}
+
+func (r *splunkReceiver) processSuccessResponseWithAck(resp http.ResponseWriter, channelID string, ackID uint64) error {
 	if r.ackExt == nil {
 		panic("writing response with ack when ack extension is not configured")
 	}
 
-	ackID := r.ackExt.ProcessEvent(channelID)
 	r.ackExt.Ack(channelID, ackID)
 	return r.processSuccessResponse(resp, []byte(fmt.Sprintf(responseOKWithAckID, ackID)))
 }
@@ -272,13 +298,9 @@ func (r *splunkReceiver) handleRawReq(resp http.ResponseWriter, req *http.Reques
 		return
 	}
 
-	var channelID string
-	var extracted bool
-	if channelID, extracted = r.extractChannel(req); extracted {
-		if channelErr := r.validateChannelHeader(channelID); channelErr != nil {
-			r.failRequest(resp, http.StatusBadRequest, []byte(channelErr.Error()), channelErr)
-			return
-		}
+	channelID, ok := r.extractDataChannel(resp, req)
+	if !ok {
+		return
 	}
 
 	if req.ContentLength == 0 {
@@ -321,6 +343,7 @@ func (r *splunkReceiver) handleRawReq(resp http.ResponseWriter, req *http.Reques
 		r.failRequest(resp, http.StatusInternalServerError, errInternalServerError, err)
 		return
 	}
+	ackID, withAck := r.startAck(channelID)
 	consumerErr := r.logsConsumer.ConsumeLogs(ctx, ld)
 
 	_ = bodyReader.Close()
@@ -329,13 +352,13 @@ func (r *splunkReceiver) handleRawReq(resp http.ResponseWriter, req *http.Reques
 		r.failRequest(resp, http.StatusInternalServerError, errInternalServerError, consumerErr)
 	} else {
 		var ackErr error
-		if channelID != "" && r.ackExt != nil {
-			ackErr = r.processSuccessResponseWithAck(resp, channelID)
+		if withAck {
+			ackErr = r.processSuccessResponseWithAck(resp, channelID, ackID)
 		} else {
 			ackErr = r.processSuccessResponse(resp, okRespBody)
 		}
 		if ackErr != nil {
-			r.failRequest(resp, http.StatusInternalServerError, errInternalServerError, err)
+			r.failRequest(resp, http.StatusInternalServerError, errInternalServerError, ackErr)
 		} else {
 			r.obsrecv.EndLogsOp(ctx, metadata.Type.String(), slLen, nil)
 		}
@@ -388,12 +411,9 @@ func (r *splunkReceiver) handleReq(resp http.ResponseWriter, req *http.Request)
 		return
 	}
 
-	channelID, extracted := r.extractChannel(req)
-	if extracted {
-		if channelErr := r.validateChannelHeader(channelID); channelErr != nil {
-			r.failRequest(resp, http.StatusBadRequest, []byte(channelErr.Error()), channelErr)
-			return
-		}
+	channelID, ok := r.extractDataChannel(resp, req)
+	if !ok {
+		return
 	}
 
 	bodyReader := req.Body
@@ -457,6 +477,7 @@ func (r *splunkReceiver) handleReq(resp http.ResponseWriter, req *http.Request)
 		}
 	}
 	resourceCustomizer := r.createResourceCustomizer(req)
+	ackID, withAck := r.startAck(channelID)
 	if r.logsConsumer != nil && len(events) > 0 {
 		ld, err := splunkHecToLogData(r.settings.Logger, events, resourceCustomizer, r.config)
 		if err != nil {
@@ -483,8 +504,8 @@ func (r *splunkReceiver) handleReq(resp http.ResponseWriter, req *http.Request)
 	}
 
 	var ackErr error
-	if channelID != "" && r.ackExt != nil {
-		ackErr = r.processSuccessResponseWithAck(resp, channelID)
+	if withAck {
+		ackErr = r.processSuccessResponseWithAck(resp, channelID, ackID)
 	} else {
 		ackErr = r.processSuccessResponse(resp, okRespBody)
 	}
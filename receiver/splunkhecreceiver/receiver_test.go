@@ -1739,6 +1739,116 @@ func Test_splunkhecReceiver_handleReq_WithAck(t *testing.T) {
 	}
 }
 
+func Test_splunkhecReceiver_handleReq_RequireChannel(t *testing.T) {
+	config := createDefaultConfig().(*Config)
+	config.Endpoint = "localhost:0" // Actually not creating the endpoint
+	id := component.MustNewID("ack_extension")
+	config.Extension = &id
+	config.RequireChannel = true
+	splunkMsg := buildSplunkHecMsg(float64(time.Now().UnixNano())/1e6, 3)
+	msgBytes, err := json.Marshal(splunkMsg)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name string
+		path string
+	}{
+		{name: "event", path: "http://localhost/foo"},
+		{name: "raw", path: "http://localhost/services/collector/raw"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sink := new(consumertest.LogsSink)
+			rcv, err := newReceiver(receivertest.NewNopSettings(metadata.Type), *config)
+			require.NoError(t, err)
+			rcv.logsConsumer = sink
+
+			mh := mockHost{extensions: map[component.ID]component.Component{
+				id: &mockAckExtension{},
+			}}
+			require.NoError(t, rcv.Start(context.Background(), mh))
+			defer func() {
+				assert.NoError(t, rcv.Shutdown(context.Background()))
+			}()
+
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodPost, tt.path, bytes.NewReader(msgBytes))
+			if tt.name == "raw" {
+				rcv.handleRawReq(w, req)
+			} else {
+				rcv.handleReq(w, req)
+			}
+
+			resp := w.Result()
+			defer resp.Body.Close()
+			var body any
+			require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+			assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+			assert.Equal(t, map[string]any{"code": float64(10), "text": "Data channel is missing"}, body)
+			assert.Empty(t, sink.AllLogs())
+		})
+	}
+}
+
+func Test_splunkhecReceiver_handleReq_AckAfterConsume(t *testing.T) {
+	config := createDefaultConfig().(*Config)
+	config.Endpoint = "localhost:0" // Actually not creating the endpoint
+	id := component.MustNewID("ack_extension")
+	config.Extension = &id
+	splunkMsg := buildSplunkHecMsg(float64(time.Now().UnixNano())/1e6, 3)
+	msgBytes, err := json.Marshal(splunkMsg)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name       string
+		consumer   consumer.Logs
+		wantStatus int
+		wantAcked  bool
+	}{
+		{name: "consumed", consumer: new(consumertest.LogsSink), wantStatus: http.StatusOK, wantAcked: true},
+		{name: "consumer_error", consumer: consumertest.NewErr(errors.New("bad consumer")), wantStatus: http.StatusInternalServerError},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rcv, err := newReceiver(receivertest.NewNopSettings(metadata.Type), *config)
+			require.NoError(t, err)
+			rcv.logsConsumer = tt.consumer
+
+			var processed, acked []uint64
+			mh := mockHost{extensions: map[component.ID]component.Component{
+				id: &mockAckExtension{
+					processEvent: func(string) uint64 {
+						processed = append(processed, 7)
+						return 7
+					},
+					ack: func(_ string, ackID uint64) {
+						acked = append(acked, ackID)
+					},
+				},
+			}}
+			require.NoError(t, rcv.Start(context.Background(), mh))
+			defer func() {
+				assert.NoError(t, rcv.Shutdown(context.Background()))
+			}()
+
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodPost, "http://localhost/foo", bytes.NewReader(msgBytes))
+			req.Header.Set("X-Splunk-Request-Channel", "fbd3036f-0f1c-4e98-b71c-d4cd61213f90")
+			rcv.handleReq(w, req)
+
+			resp := w.Result()
+			defer resp.Body.Close()
+			assert.Equal(t, tt.wantStatus, resp.StatusCode)
+			assert.Equal(t, []uint64{7}, processed)
+			if tt.wantAcked {
+				assert.Equal(t, []uint64{7}, acked)
+			} else {
+				assert.Empty(t, acked)
+			}
+		})
+	}
+}
+
 func Test_splunkhecreceiver_handleHealthPath(t *testing.T) {
 	config := createDefaultConfig().(*Config)
 	sink := new(consumertest.LogsSink)
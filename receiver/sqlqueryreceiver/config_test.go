@@ -246,6 +246,38 @@ func TestLoadConfig(t *testing.T) {
 				},
 			},
 		},
+		{
+			fname: "config-logs-tracking-columns.yaml",
+			id:    component.NewIDWithName(metadata.Type, ""),
+			expected: &Config{
+				Config: sqlquery.Config{
+					ControllerConfig: scraperhelper.ControllerConfig{
+						CollectionInterval: 10 * time.Second,
+						InitialDelay:       time.Second,
+					},
+					Driver:     "postgres",
+					DataSource: "host=localhost port=5432 user=me password=s3cr3t sslmode=disable",
+					Queries: []sqlquery.Query{
+						{
+							SQL:                 "select * from audit_log where (created_at, id) > (?, ?) order by created_at, id",
+							TrackingColumns:     []string{"created_at", "id"},
+							TrackingStartValues: []string{"2024-01-01T00:00:00Z", "0"},
+							Logs: []sqlquery.LogsCfg{
+								{
+									BodyColumn:           "action",
+									JSONAttributesColumn: "details",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			fname:        "config-logs-invalid-tracking-start-values.yaml",
+			id:           component.NewIDWithName(metadata.Type, ""),
+			errorMessage: "'tracking_start_values' must have the same length as 'tracking_columns' (2), got 1",
+		},
 		{
 			fname:        "config-logs-missing-body-column.yaml",
 			id:           component.NewIDWithName(metadata.Type, ""),
@@ -6,8 +6,10 @@ package sqlqueryreceiver // import "github.com/open-telemetry/opentelemetry-coll
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"go.opentelemetry.io/collector/component"
@@ -215,9 +217,10 @@ type logsQueryReceiver struct {
 	logger       *zap.Logger
 	telemetry    sqlquery.TelemetryConfig
 
-	db            *sql.DB
-	client        sqlquery.DbClient
-	trackingValue string
+	db     *sql.DB
+	client sqlquery.DbClient
+	// trackingValues holds the current values of the tracking columns.
+	trackingValues []string
 	// TODO: Extract persistence into its own component
 	storageClient           storage.Client
 	trackingValueStorageKey string
@@ -241,8 +244,12 @@ func newLogsQueryReceiver(
 		telemetry:     telemetry,
 		storageClient: storageClient,
 	}
-	queryReceiver.trackingValue = queryReceiver.query.TrackingStartValue
-	queryReceiver.trackingValueStorageKey = fmt.Sprintf("%s.%s", queryReceiver.id, "trackingValue")
+	queryReceiver.trackingValues = queryReceiver.trackingStartValues()
+	if len(queryReceiver.query.TrackingColumns) > 0 {
+		queryReceiver.trackingValueStorageKey = fmt.Sprintf("%s.%s", queryReceiver.id, "trackingValues")
+	} else {
+		queryReceiver.trackingValueStorageKey = fmt.Sprintf("%s.%s", queryReceiver.id, "trackingValue")
+	}
 	return queryReceiver
 }
 
@@ -258,25 +265,55 @@ func (queryReceiver *logsQueryReceiver) start(ctx context.Context) error {
 	}
 	queryReceiver.client = queryReceiver.createClient(sqlquery.DbWrapper{Db: queryReceiver.db}, queryReceiver.query.SQL, queryReceiver.logger, queryReceiver.telemetry)
 
-	queryReceiver.trackingValue = queryReceiver.retrieveTrackingValue(ctx)
+	queryReceiver.trackingValues = queryReceiver.retrieveTrackingValues(ctx)
 
 	return nil
 }
 
-// retrieveTrackingValue retrieves the tracking value from storage, if storage is configured.
-// Otherwise, it returns the tracking value configured in `tracking_start_value`.
-func (queryReceiver *logsQueryReceiver) retrieveTrackingValue(ctx context.Context) string {
-	trackingValueFromConfig := queryReceiver.query.TrackingStartValue
+// trackingColumns returns the columns whose values are passed as parameters of the query,
+// either the single `tracking_column` or the composite `tracking_columns`.
+func (queryReceiver *logsQueryReceiver) trackingColumns() []string {
+	if queryReceiver.query.TrackingColumn != "" {
+		return []string{queryReceiver.query.TrackingColumn}
+	}
+	return queryReceiver.query.TrackingColumns
+}
+
+// trackingStartValues returns the tracking values configured in `tracking_start_value`
+// or `tracking_start_values`.
+func (queryReceiver *logsQueryReceiver) trackingStartValues() []string {
+	if len(queryReceiver.query.TrackingColumns) == 0 {
+		return []string{queryReceiver.query.TrackingStartValue}
+	}
+	values := make([]string, len(queryReceiver.query.TrackingColumns))
+	copy(values, queryReceiver.query.TrackingStartValues)
+	return values
+}
+
+// retrieveTrackingValues retrieves the tracking values from storage, if storage is configured.
+// Otherwise, it returns the tracking values configured in `tracking_start_value` or `tracking_start_values`.
+func (queryReceiver *logsQueryReceiver) retrieveTrackingValues(ctx context.Context) []string {
+	trackingValuesFromConfig := queryReceiver.trackingStartValues()
 	if queryReceiver.storageClient == nil {
-		return trackingValueFromConfig
+		return trackingValuesFromConfig
 	}
 
 	storedTrackingValueBytes, err := queryReceiver.storageClient.Get(ctx, queryReceiver.trackingValueStorageKey)
 	if err != nil || storedTrackingValueBytes == nil {
-		return trackingValueFromConfig
+		return trackingValuesFromConfig
 	}
 
-	return string(storedTrackingValueBytes)
+	if len(queryReceiver.query.TrackingColumns) == 0 {
+		return []string{string(storedTrackingValueBytes)}
+	}
+
+	// Composite tracking values are stored as a JSON array, values stored for a
+	// different set of tracking columns are ignored.
+	var storedTrackingValues []string
+	if err := json.Unmarshal(storedTrackingValueBytes, &storedTrackingValues); err != nil || len(storedTrackingValues) != len(trackingValuesFromConfig) {
+		return trackingValuesFromConfig
+	}
+	return storedTrackingValues
 }
 
 func (queryReceiver *logsQueryReceiver) collect(ctx context.Context) (plog.Logs, error) {
@@ -285,8 +322,12 @@ func (queryReceiver *logsQueryReceiver) collect(ctx context.Context) (plog.Logs,
 	var rows []sqlquery.StringMap
 	var err error
 	observedAt := pcommon.NewTimestampFromTime(time.Now())
-	if queryReceiver.query.TrackingColumn != "" {
-		rows, err = queryReceiver.client.QueryRows(ctx, queryReceiver.trackingValue)
+	if len(queryReceiver.trackingColumns()) > 0 {
+		args := make([]any, len(queryReceiver.trackingValues))
+		for i, value := range queryReceiver.trackingValues {
+			args[i] = value
+		}
+		rows, err = queryReceiver.client.QueryRows(ctx, args...)
 	} else {
 		rows, err = queryReceiver.client.QueryRows(ctx)
 	}
@@ -312,17 +353,29 @@ func (queryReceiver *logsQueryReceiver) collect(ctx context.Context) (plog.Logs,
 }
 
 func (queryReceiver *logsQueryReceiver) storeTrackingValue(ctx context.Context, row sqlquery.StringMap) error {
-	if queryReceiver.query.TrackingColumn == "" {
+	trackingColumns := queryReceiver.trackingColumns()
+	if len(trackingColumns) == 0 {
 		return nil
 	}
-	queryReceiver.trackingValue = row[queryReceiver.query.TrackingColumn]
-	if queryReceiver.storageClient != nil {
-		err := queryReceiver.storageClient.Set(ctx, queryReceiver.trackingValueStorageKey, []byte(queryReceiver.trackingValue))
-		if err != nil {
+	values := make([]string, len(trackingColumns))
+	for i, column := range trackingColumns {
+		values[i] = row[column]
+	}
+	queryReceiver.trackingValues = values
+	if queryReceiver.storageClient == nil {
+		return nil
+	}
+
+	var storedValue []byte
+	if len(queryReceiver.query.TrackingColumns) > 0 {
+		var err error
+		if storedValue, err = json.Marshal(values); err != nil {
 			return err
 		}
+	} else {
+		storedValue = []byte(values[0])
 	}
-	return nil
+	return queryReceiver.storageClient.Set(ctx, queryReceiver.trackingValueStorageKey, storedValue)
 }
 
 func rowToLog(row sqlquery.StringMap, config sqlquery.LogsCfg, logRecord plog.LogRecord) error {
@@ -342,9 +395,55 @@ func rowToLog(row sqlquery.StringMap, config sqlquery.LogsCfg, logRecord plog.Lo
 			errs = append(errs, fmt.Errorf("rowToLog: attribute_column '%s' not found in result set", columnName))
 		}
 	}
+
+	if config.JSONAttributesColumn != "" {
+		if jsonVal, found := row[config.JSONAttributesColumn]; !found {
+			errs = append(errs, fmt.Errorf("rowToLog: json_attributes_column '%s' not found in result set", config.JSONAttributesColumn))
+		} else if jsonVal != "" {
+			errs = append(errs, putJSONAttributes(attrs, config.JSONAttributesColumn, jsonVal))
+		}
+	}
 	return errors.Join(errs...)
 }
 
+// putJSONAttributes adds the fields of the JSON object held by the column to attrs.
+func putJSONAttributes(attrs pcommon.Map, columnName, value string) error {
+	var fields map[string]any
+	decoder := json.NewDecoder(strings.NewReader(value))
+	decoder.UseNumber()
+	if err := decoder.Decode(&fields); err != nil {
+		return fmt.Errorf("rowToLog: json_attributes_column '%s' is not a JSON object: %w", columnName, err)
+	}
+	for k, v := range fields {
+		if err := attrs.PutEmpty(k).FromRaw(fromJSONNumbers(v)); err != nil {
+			return fmt.Errorf("rowToLog: json_attributes_column '%s': %w", columnName, err)
+		}
+	}
+	return nil
+}
+
+// fromJSONNumbers converts the json.Number values to int64 when they are integers,
+// float64 otherwise, so that integers are not turned into double attributes.
+func fromJSONNumbers(v any) any {
+	switch val := v.(type) {
+	case json.Number:
+		if i, err := val.Int64(); err == nil {
+			return i
+		}
+		f, _ := val.Float64()
+		return f
+	case map[string]any:
+		for k, item := range val {
+			val[k] = fromJSONNumbers(item)
+		}
+	case []any:
+		for i, item := range val {
+			val[i] = fromJSONNumbers(item)
+		}
+	}
+	return v
+}
+
 func (queryReceiver *logsQueryReceiver) shutdown(context.Context) error {
 	if queryReceiver.db == nil {
 		return nil
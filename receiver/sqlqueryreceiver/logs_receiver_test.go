@@ -10,12 +10,15 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/component/componenttest"
 	"go.opentelemetry.io/collector/consumer/consumertest"
 	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/receiver/receivertest"
 	"go.opentelemetry.io/collector/scraper/scraperhelper"
+	"go.uber.org/zap"
 
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/storage/storagetest"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/sqlquery"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/sqlqueryreceiver/internal/metadata"
 )
@@ -81,6 +84,95 @@ func TestLogsQueryReceiver_MissingColumnInResultSet(t *testing.T) {
 	assert.ErrorContains(t, err, "rowToLog: body_column 'expected_body_column' not found in result set")
 }
 
+type recordingDBClient struct {
+	rows [][]sqlquery.StringMap
+	args [][]any
+}
+
+func (c *recordingDBClient) QueryRows(_ context.Context, args ...any) ([]sqlquery.StringMap, error) {
+	idx := len(c.args)
+	c.args = append(c.args, args)
+	return c.rows[idx], nil
+}
+
+func TestLogsQueryReceiver_CompositeTrackingColumns(t *testing.T) {
+	ctx := context.Background()
+	storageClient := storagetest.NewInMemoryClient(component.KindReceiver, component.MustNewID("sqlquery"), "")
+	query := sqlquery.Query{
+		SQL:                 "select * from audit where (ts, id) > (?, ?) order by ts, id",
+		TrackingColumns:     []string{"ts", "id"},
+		TrackingStartValues: []string{"2024-01-01", "0"},
+		Logs: []sqlquery.LogsCfg{
+			{
+				BodyColumn: "body",
+			},
+		},
+	}
+	client := &recordingDBClient{
+		rows: [][]sqlquery.StringMap{
+			{
+				{"ts": "2024-01-02", "id": "7", "body": "first"},
+				{"ts": "2024-01-02", "id": "9", "body": "second"},
+			},
+			{},
+		},
+	}
+	queryReceiver := newLogsQueryReceiver("0", query, nil, nil, zap.NewNop(), sqlquery.TelemetryConfig{}, storageClient)
+	queryReceiver.client = client
+
+	logs, err := queryReceiver.collect(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 2, logs.LogRecordCount())
+	assert.Equal(t, []any{"2024-01-01", "0"}, client.args[0])
+
+	_, err = queryReceiver.collect(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []any{"2024-01-02", "9"}, client.args[1])
+
+	stored, err := storageClient.Get(ctx, "0.trackingValues")
+	require.NoError(t, err)
+	assert.JSONEq(t, `["2024-01-02","9"]`, string(stored))
+
+	// The tracking values are restored from storage.
+	restored := newLogsQueryReceiver("0", query, nil, nil, zap.NewNop(), sqlquery.TelemetryConfig{}, storageClient)
+	assert.Equal(t, []string{"2024-01-02", "9"}, restored.retrieveTrackingValues(ctx))
+}
+
+func TestLogsQueryReceiver_JSONAttributesColumn(t *testing.T) {
+	fakeClient := &sqlquery.FakeDBClient{
+		StringMaps: [][]sqlquery.StringMap{
+			{
+				{"body": "login", "details": `{"user":"alice","attempts":3,"ratio":0.5,"roles":["admin"]}`},
+				{"body": "logout", "details": ""},
+				{"body": "broken", "details": `[1, 2]`},
+			},
+		},
+	}
+	queryReceiver := logsQueryReceiver{
+		client: fakeClient,
+		query: sqlquery.Query{
+			Logs: []sqlquery.LogsCfg{
+				{
+					BodyColumn:           "body",
+					JSONAttributesColumn: "details",
+				},
+			},
+		},
+	}
+	logs, err := queryReceiver.collect(context.Background())
+	assert.ErrorContains(t, err, "rowToLog: json_attributes_column 'details' is not a JSON object")
+	require.Equal(t, 3, logs.LogRecordCount())
+
+	attrs := logs.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0).Attributes()
+	assert.Equal(t, map[string]any{
+		"user":     "alice",
+		"attempts": int64(3),
+		"ratio":    0.5,
+		"roles":    []any{"admin"},
+	}, attrs.AsRaw())
+	assert.Equal(t, 0, logs.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(1).Attributes().Len())
+}
+
 func TestLogsQueryReceiver_BothDatasourceFields(t *testing.T) {
 	createReceiver := createLogsReceiverFunc(fakeDBConnect, mkFakeClient)
 	ctx := context.Background()
@@ -5,6 +5,7 @@ package sqlserverreceiver // import "github.com/open-telemetry/opentelemetry-col
 
 import (
 	"errors"
+	"fmt"
 	"time"
 
 	"go.opentelemetry.io/collector/config/configopaque"
@@ -30,6 +31,31 @@ type TopQueryCollection struct {
 	MaxQuerySampleCount uint          `mapstructure:"max_query_sample_count"`
 	TopQueryCount       uint          `mapstructure:"top_query_count"`
 	CollectionInterval  time.Duration `mapstructure:"collection_interval"`
+	// Source is where the top queries are read from: `dm_exec_query_stats` reads
+	// the plan cache, `query_store` reads the Query Store of the databases where
+	// it is enabled, which keeps the statistics of queries evicted from the cache.
+	Source string `mapstructure:"source"`
+	// OrderBy is the statistic used to rank the queries read from the Query
+	// Store: `cpu_time`, `duration`, `logical_reads`, `physical_reads` or
+	// `execution_count`.
+	OrderBy string `mapstructure:"order_by"`
+}
+
+const (
+	topQuerySourceQueryStats = "dm_exec_query_stats"
+	topQuerySourceQueryStore = "query_store"
+
+	defaultQueryStoreOrderBy = "cpu_time"
+)
+
+// queryStoreOrderByColumns maps the values of `order_by` to the columns of the
+// Query Store query.
+var queryStoreOrderByColumns = map[string]string{
+	"cpu_time":        "total_worker_time",
+	"duration":        "total_elapsed_time",
+	"logical_reads":   "total_logical_reads",
+	"physical_reads":  "total_physical_reads",
+	"execution_count": "execution_count",
 }
 
 // Config defines configuration for a sqlserver receiver.
@@ -79,6 +105,16 @@ func (cfg *Config) Validate() error {
 		return errors.New("`top_query_collection.collection_interval` must not be less than 0")
 	}
 
+	switch cfg.TopQueryCollection.Source {
+	case "", topQuerySourceQueryStats, topQuerySourceQueryStore:
+	default:
+		return fmt.Errorf("`top_query_collection.source` must be either %q or %q, got %q", topQuerySourceQueryStats, topQuerySourceQueryStore, cfg.TopQueryCollection.Source)
+	}
+
+	if _, ok := queryStoreOrderByColumns[cfg.TopQueryCollection.OrderBy]; cfg.TopQueryCollection.OrderBy != "" && !ok {
+		return fmt.Errorf("`top_query_collection.order_by` must be one of `cpu_time`, `duration`, `logical_reads`, `physical_reads` or `execution_count`, got %q", cfg.TopQueryCollection.OrderBy)
+	}
+
 	cfg.isDirectDBConnectionEnabled, err = directDBConnectionEnabled(cfg)
 
 	return err
@@ -106,6 +106,38 @@ func TestValidate(t *testing.T) {
 			},
 			expectedSuccess: false,
 		},
+		{
+			desc: "config with Query Store source",
+			cfg: &Config{
+				ControllerConfig: scraperhelper.NewDefaultControllerConfig(),
+				TopQueryCollection: TopQueryCollection{
+					Source:  "query_store",
+					OrderBy: "duration",
+				},
+			},
+			expectedSuccess: true,
+		},
+		{
+			desc: "config with invalid top query source",
+			cfg: &Config{
+				ControllerConfig: scraperhelper.NewDefaultControllerConfig(),
+				TopQueryCollection: TopQueryCollection{
+					Source: "plan_cache",
+				},
+			},
+			expectedSuccess: false,
+		},
+		{
+			desc: "config with invalid Query Store order",
+			cfg: &Config{
+				ControllerConfig: scraperhelper.NewDefaultControllerConfig(),
+				TopQueryCollection: TopQueryCollection{
+					Source:  "query_store",
+					OrderBy: "memory",
+				},
+			},
+			expectedSuccess: false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -201,6 +233,8 @@ func TestLoadConfig(t *testing.T) {
 		expected.TopQueryCount = 200
 		expected.MaxQuerySampleCount = 1000
 		expected.TopQueryCollection.CollectionInterval = 80 * time.Second
+		expected.TopQueryCollection.Source = "query_store"
+		expected.TopQueryCollection.OrderBy = "logical_reads"
 
 		expected.QuerySample = QuerySample{
 			MaxRowsPerQuery: 1450,
@@ -60,6 +60,8 @@ func createDefaultConfig() component.Config {
 			MaxQuerySampleCount: 1000,
 			TopQueryCount:       200,
 			CollectionInterval:  time.Minute,
+			Source:              topQuerySourceQueryStats,
+			OrderBy:             defaultQueryStoreOrderBy,
 		},
 	}
 }
@@ -94,7 +96,11 @@ func setupLogQueries(cfg *Config) []string {
 	}
 
 	if cfg.Events.DbServerTopQuery.Enabled {
-		queries = append(queries, getSQLServerQueryTextAndPlanQuery())
+		if cfg.TopQueryCollection.Source == topQuerySourceQueryStore {
+			queries = append(queries, getSQLServerQueryStoreTopQueriesQuery(cfg.TopQueryCollection.OrderBy))
+		} else {
+			queries = append(queries, getSQLServerQueryTextAndPlanQuery())
+		}
 	}
 
 	return queries
@@ -296,5 +302,7 @@ func isWaitStatsQueryEnabled(metrics *metadata.MetricsConfig) bool {
 		return false
 	}
 
-	return metrics.SqlserverOsWaitDuration.Enabled
+	return metrics.SqlserverOsWaitCount.Enabled ||
+		metrics.SqlserverOsWaitDuration.Enabled ||
+		metrics.SqlserverOsWaitSignalDuration.Enabled
 }
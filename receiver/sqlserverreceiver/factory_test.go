@@ -48,6 +48,8 @@ func TestFactory(t *testing.T) {
 						MaxQuerySampleCount: 1000,
 						TopQueryCount:       200,
 						CollectionInterval:  time.Minute,
+						Source:              "dm_exec_query_stats",
+						OrderBy:             "cpu_time",
 					},
 					QuerySample: QuerySample{
 						MaxRowsPerQuery: 100,
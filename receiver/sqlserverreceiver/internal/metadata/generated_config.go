@@ -52,7 +52,9 @@ type MetricsConfig struct {
 	SqlserverLogoutRate                         MetricConfig `mapstructure:"sqlserver.logout.rate"`
 	SqlserverMemoryGrantsPendingCount           MetricConfig `mapstructure:"sqlserver.memory.grants.pending.count"`
 	SqlserverMemoryUsage                        MetricConfig `mapstructure:"sqlserver.memory.usage"`
+	SqlserverOsWaitCount                        MetricConfig `mapstructure:"sqlserver.os.wait.count"`
 	SqlserverOsWaitDuration                     MetricConfig `mapstructure:"sqlserver.os.wait.duration"`
+	SqlserverOsWaitSignalDuration               MetricConfig `mapstructure:"sqlserver.os.wait.signal.duration"`
 	SqlserverPageBufferCacheFreeListStallsRate  MetricConfig `mapstructure:"sqlserver.page.buffer_cache.free_list.stalls.rate"`
 	SqlserverPageBufferCacheHitRatio            MetricConfig `mapstructure:"sqlserver.page.buffer_cache.hit_ratio"`
 	SqlserverPageCheckpointFlushRate            MetricConfig `mapstructure:"sqlserver.page.checkpoint.flush.rate"`
@@ -154,9 +156,15 @@ func DefaultMetricsConfig() MetricsConfig {
 		SqlserverMemoryUsage: MetricConfig{
 			Enabled: false,
 		},
+		SqlserverOsWaitCount: MetricConfig{
+			Enabled: false,
+		},
 		SqlserverOsWaitDuration: MetricConfig{
 			Enabled: false,
 		},
+		SqlserverOsWaitSignalDuration: MetricConfig{
+			Enabled: false,
+		},
 		SqlserverPageBufferCacheFreeListStallsRate: MetricConfig{
 			Enabled: false,
 		},
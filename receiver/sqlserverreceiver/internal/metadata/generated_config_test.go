@@ -51,7 +51,9 @@ func TestMetricsBuilderConfig(t *testing.T) {
 					SqlserverLogoutRate:                         MetricConfig{Enabled: true},
 					SqlserverMemoryGrantsPendingCount:           MetricConfig{Enabled: true},
 					SqlserverMemoryUsage:                        MetricConfig{Enabled: true},
+					SqlserverOsWaitCount:                        MetricConfig{Enabled: true},
 					SqlserverOsWaitDuration:                     MetricConfig{Enabled: true},
+					SqlserverOsWaitSignalDuration:               MetricConfig{Enabled: true},
 					SqlserverPageBufferCacheFreeListStallsRate:  MetricConfig{Enabled: true},
 					SqlserverPageBufferCacheHitRatio:            MetricConfig{Enabled: true},
 					SqlserverPageCheckpointFlushRate:            MetricConfig{Enabled: true},
@@ -116,7 +118,9 @@ func TestMetricsBuilderConfig(t *testing.T) {
 					SqlserverLogoutRate:                         MetricConfig{Enabled: false},
 					SqlserverMemoryGrantsPendingCount:           MetricConfig{Enabled: false},
 					SqlserverMemoryUsage:                        MetricConfig{Enabled: false},
+					SqlserverOsWaitCount:                        MetricConfig{Enabled: false},
 					SqlserverOsWaitDuration:                     MetricConfig{Enabled: false},
+					SqlserverOsWaitSignalDuration:               MetricConfig{Enabled: false},
 					SqlserverPageBufferCacheFreeListStallsRate:  MetricConfig{Enabled: false},
 					SqlserverPageBufferCacheHitRatio:            MetricConfig{Enabled: false},
 					SqlserverPageCheckpointFlushRate:            MetricConfig{Enabled: false},
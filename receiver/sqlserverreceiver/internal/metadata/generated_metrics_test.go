@@ -145,9 +145,15 @@ func TestMetricsBuilder(t *testing.T) {
 			allMetricsCount++
 			mb.RecordSqlserverMemoryUsageDataPoint(ts, 1)
 
+			allMetricsCount++
+			mb.RecordSqlserverOsWaitCountDataPoint(ts, 1, "wait.category-val", "wait.type-val")
+
 			allMetricsCount++
 			mb.RecordSqlserverOsWaitDurationDataPoint(ts, 1, "wait.category-val", "wait.type-val")
 
+			allMetricsCount++
+			mb.RecordSqlserverOsWaitSignalDurationDataPoint(ts, 1, "wait.category-val", "wait.type-val")
+
 			allMetricsCount++
 			mb.RecordSqlserverPageBufferCacheFreeListStallsRateDataPoint(ts, 1)
 
@@ -611,6 +617,26 @@ func TestMetricsBuilder(t *testing.T) {
 					assert.Equal(t, ts, dp.Timestamp())
 					assert.Equal(t, pmetric.NumberDataPointValueTypeDouble, dp.ValueType())
 					assert.InDelta(t, float64(1), dp.DoubleValue(), 0.01)
+				case "sqlserver.os.wait.count":
+					assert.False(t, validatedMetrics["sqlserver.os.wait.count"], "Found a duplicate in the metrics slice: sqlserver.os.wait.count")
+					validatedMetrics["sqlserver.os.wait.count"] = true
+					assert.Equal(t, pmetric.MetricTypeSum, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Sum().DataPoints().Len())
+					assert.Equal(t, "Number of waits on this wait type.", ms.At(i).Description())
+					assert.Equal(t, "{wait}", ms.At(i).Unit())
+					assert.True(t, ms.At(i).Sum().IsMonotonic())
+					assert.Equal(t, pmetric.AggregationTemporalityCumulative, ms.At(i).Sum().AggregationTemporality())
+					dp := ms.At(i).Sum().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("wait.category")
+					assert.True(t, ok)
+					assert.Equal(t, "wait.category-val", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("wait.type")
+					assert.True(t, ok)
+					assert.Equal(t, "wait.type-val", attrVal.Str())
 				case "sqlserver.os.wait.duration":
 					assert.False(t, validatedMetrics["sqlserver.os.wait.duration"], "Found a duplicate in the metrics slice: sqlserver.os.wait.duration")
 					validatedMetrics["sqlserver.os.wait.duration"] = true
@@ -631,6 +657,26 @@ func TestMetricsBuilder(t *testing.T) {
 					attrVal, ok = dp.Attributes().Get("wait.type")
 					assert.True(t, ok)
 					assert.Equal(t, "wait.type-val", attrVal.Str())
+				case "sqlserver.os.wait.signal.duration":
+					assert.False(t, validatedMetrics["sqlserver.os.wait.signal.duration"], "Found a duplicate in the metrics slice: sqlserver.os.wait.signal.duration")
+					validatedMetrics["sqlserver.os.wait.signal.duration"] = true
+					assert.Equal(t, pmetric.MetricTypeSum, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Sum().DataPoints().Len())
+					assert.Equal(t, "Total time between the signaling of the waiting threads and the start of their execution for this wait type.", ms.At(i).Description())
+					assert.Equal(t, "s", ms.At(i).Unit())
+					assert.True(t, ms.At(i).Sum().IsMonotonic())
+					assert.Equal(t, pmetric.AggregationTemporalityCumulative, ms.At(i).Sum().AggregationTemporality())
+					dp := ms.At(i).Sum().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeDouble, dp.ValueType())
+					assert.InDelta(t, float64(1), dp.DoubleValue(), 0.01)
+					attrVal, ok := dp.Attributes().Get("wait.category")
+					assert.True(t, ok)
+					assert.Equal(t, "wait.category-val", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("wait.type")
+					assert.True(t, ok)
+					assert.Equal(t, "wait.type-val", attrVal.Str())
 				case "sqlserver.page.buffer_cache.free_list.stalls.rate":
 					assert.False(t, validatedMetrics["sqlserver.page.buffer_cache.free_list.stalls.rate"], "Found a duplicate in the metrics slice: sqlserver.page.buffer_cache.free_list.stalls.rate")
 					validatedMetrics["sqlserver.page.buffer_cache.free_list.stalls.rate"] = true
@@ -1029,3 +1029,16 @@ func getSQLServerWaitStatsQuery(instanceName string) string {
 	r := strings.NewReplacer("{filter_instance_name}", "")
 	return r.Replace(sqlServerWaitStatsQuery)
 }
+
+//go:embed templates/queryStoreTopQueries.tmpl
+var sqlServerQueryStoreTopQueriesTemplate string
+
+// getSQLServerQueryStoreTopQueriesQuery returns the query reading the top
+// queries from the Query Store of the databases, sorted by the given column.
+func getSQLServerQueryStoreTopQueriesQuery(orderBy string) string {
+	column, ok := queryStoreOrderByColumns[orderBy]
+	if !ok {
+		column = queryStoreOrderByColumns[defaultQueryStoreOrderBy]
+	}
+	return fmt.Sprintf(sqlServerQueryStoreTopQueriesTemplate, column)
+}
@@ -143,6 +143,12 @@ func (s *sqlServerScraperHelper) ScrapeLogs(ctx context.Context) (plog.Logs, err
 			return plog.NewLogs(), nil
 		}
 		resources, err = s.recordDatabaseQueryTextAndPlan(ctx, s.config.TopQueryCount)
+	case getSQLServerQueryStoreTopQueriesQuery(s.config.TopQueryCollection.OrderBy):
+		if s.lastExecutionTimestamp.Add(s.config.TopQueryCollection.CollectionInterval).After(time.Now()) {
+			s.logger.Debug("Skipping the collection of top queries because the current time has not yet exceeded the last execution time plus the specified collection interval")
+			return plog.NewLogs(), nil
+		}
+		resources, err = s.recordDatabaseQueryStoreTopQueries(ctx)
 	case getSQLServerQuerySamplesQuery():
 		resources, err = s.recordDatabaseSampleQuery(ctx)
 	default:
@@ -583,9 +589,11 @@ func (s *sqlServerScraperHelper) recordDatabaseStatusMetrics(ctx context.Context
 func (s *sqlServerScraperHelper) recordDatabaseWaitMetrics(ctx context.Context) error {
 	// Constants are the columns for metrics from query
 	const (
-		waitCategory = "wait_category"
-		waitTimeMs   = "wait_time_ms"
-		waitType     = "wait_type"
+		signalWaitTimeMs  = "signal_wait_time_ms"
+		waitCategory      = "wait_category"
+		waitTimeMs        = "wait_time_ms"
+		waitType          = "wait_type"
+		waitingTasksCount = "waiting_tasks_count"
 	)
 
 	rows, err := s.client.QueryRows(ctx)
@@ -618,6 +626,20 @@ func (s *sqlServerScraperHelper) recordDatabaseWaitMetrics(ctx context.Context)
 			s.mb.RecordSqlserverOsWaitDurationDataPoint(now, val.(float64)/1e3, row[waitCategory], row[waitType])
 		}
 
+		val, err = retrieveInt(row, waitingTasksCount)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to parse valueKey for row %d: %w in %s", i, err, waitingTasksCount))
+		} else {
+			s.mb.RecordSqlserverOsWaitCountDataPoint(now, val.(int64), row[waitCategory], row[waitType])
+		}
+
+		val, err = retrieveFloat(row, signalWaitTimeMs)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to parse valueKey for row %d: %w in %s", i, err, signalWaitTimeMs))
+		} else {
+			s.mb.RecordSqlserverOsWaitSignalDurationDataPoint(now, val.(float64)/1e3, row[waitCategory], row[waitType])
+		}
+
 		s.mb.EmitForResource(metadata.WithResource(rb.Emit()))
 	}
 
@@ -792,6 +814,110 @@ func (s *sqlServerScraperHelper) recordDatabaseQueryTextAndPlan(ctx context.Cont
 	return resources, errors.Join(errs...)
 }
 
+// recordDatabaseQueryStoreTopQueries reports the top queries read from the Query
+// Store. Unlike the plan cache, the Query Store aggregates the statistics over
+// time intervals, so the values are reported as read without caching them.
+func (s *sqlServerScraperHelper) recordDatabaseQueryStoreTopQueries(ctx context.Context) (pcommon.Resource, error) {
+	// Constants are the column names of the Query Store query
+	const (
+		executionCount = "execution_count"
+		logicalReads   = "total_logical_reads"
+		logicalWrites  = "total_logical_writes"
+		physicalReads  = "total_physical_reads"
+		queryHash      = "query_hash"
+		queryPlan      = "query_plan"
+		queryPlanHash  = "query_plan_hash"
+		queryText      = "query_text"
+		rowsReturned   = "total_rows"
+		// the time returned from mssql is in microsecond
+		totalElapsedTime = "total_elapsed_time"
+		totalGrant       = "total_grant_kb"
+		// the time returned from mssql is in microsecond
+		totalWorkerTime = "total_worker_time"
+
+		dbSystemNameVal = "microsoft.sql_server"
+	)
+
+	resources := pcommon.NewResource()
+
+	rows, err := s.client.QueryRows(
+		ctx,
+		sql.Named("lookbackTime", -int64(s.config.LookbackTime)),
+		sql.Named("topNValue", s.config.TopQueryCount),
+		sql.Named("instanceName", s.config.InstanceName),
+	)
+	if err != nil {
+		if !errors.Is(err, sqlquery.ErrNullValueWarning) {
+			return resources, fmt.Errorf("sqlServerScraperHelper failed getting rows: %w", err)
+		}
+		s.logger.Warn("problems encountered getting log rows", zap.Error(err))
+	}
+	var errs []error
+
+	resourcesAdded := false
+	now := time.Now()
+	timestamp := pcommon.NewTimestampFromTime(now)
+	s.lastExecutionTimestamp = now
+	for _, row := range rows {
+		// reporting human-readable query hash and query hash plan
+		queryHashVal := hex.EncodeToString([]byte(row[queryHash]))
+		queryPlanHashVal := hex.EncodeToString([]byte(row[queryPlanHash]))
+
+		queryTextVal := s.retrieveValue(row, queryText, &errs, func(row sqlquery.StringMap, columnName string) (any, error) {
+			statement := row[columnName]
+			obfuscated, err := s.obfuscator.obfuscateSQLString(statement)
+			if err != nil {
+				s.logger.Error(fmt.Sprintf("failed to obfuscate SQL statement: %v", statement))
+				return "", nil
+			}
+
+			return obfuscated, nil
+		})
+		queryPlanVal := s.retrieveValue(row, queryPlan, &errs, func(row sqlquery.StringMap, columnName string) (any, error) {
+			return s.obfuscator.obfuscateXMLPlan(row[columnName])
+		})
+
+		executionCountVal := s.retrieveValue(row, executionCount, &errs, retrieveInt)
+		logicalReadsVal := s.retrieveValue(row, logicalReads, &errs, retrieveInt)
+		logicalWritesVal := s.retrieveValue(row, logicalWrites, &errs, retrieveInt)
+		physicalReadsVal := s.retrieveValue(row, physicalReads, &errs, retrieveInt)
+		rowsReturnedVal := s.retrieveValue(row, rowsReturned, &errs, retrieveInt)
+		totalGrantVal := s.retrieveValue(row, totalGrant, &errs, retrieveInt)
+		totalElapsedTimeVal := s.retrieveValue(row, totalElapsedTime, &errs, retrieveInt)
+		totalWorkerTimeVal := s.retrieveValue(row, totalWorkerTime, &errs, retrieveInt)
+
+		s.logger.Debug(fmt.Sprintf("QueryHash: %v, PlanHash: %v, DataRow: %v", queryHashVal, queryPlanHashVal, row))
+
+		if !resourcesAdded {
+			resourceAttributes := resources.Attributes()
+			resourceAttributes.PutStr("host.name", s.config.Server)
+			resourceAttributes.PutStr("sqlserver.computer.name", row[computerNameKey])
+			resourceAttributes.PutStr("sqlserver.instance.name", row[instanceNameKey])
+
+			resourcesAdded = true
+		}
+		s.lb.RecordDbServerTopQueryEvent(
+			context.Background(),
+			timestamp,
+			float64(totalWorkerTimeVal.(int64))/1_000_000,
+			queryTextVal.(string),
+			executionCountVal.(int64),
+			logicalReadsVal.(int64),
+			logicalWritesVal.(int64),
+			physicalReadsVal.(int64),
+			queryHashVal,
+			queryPlanVal.(string),
+			queryPlanHashVal,
+			rowsReturnedVal.(int64),
+			float64(totalElapsedTimeVal.(int64))/1_000_000,
+			totalGrantVal.(int64),
+			s.config.Server,
+			int64(s.config.Port),
+			dbSystemNameVal)
+	}
+	return resources, errors.Join(errs...)
+}
+
 func (s *sqlServerScraperHelper) retrieveValue(
 	row sqlquery.StringMap,
 	column string,
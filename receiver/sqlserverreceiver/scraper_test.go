@@ -16,6 +16,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/component/componenttest"
 	"go.opentelemetry.io/collector/receiver/receivertest"
 
@@ -341,6 +342,8 @@ func (mc mockClient) QueryRows(context.Context, ...any) ([]sqlquery.StringMap, e
 		queryResults, err = readFile("queryTextAndPlanQueryData.txt")
 	case getSQLServerQuerySamplesQuery():
 		queryResults, err = readFile("recordDatabaseSampleQueryData.txt")
+	case getSQLServerQueryStoreTopQueriesQuery(defaultQueryStoreOrderBy):
+		queryResults, err = readFile("queryStoreTopQueriesData.txt")
 	default:
 		return nil, errors.New("No valid query found")
 	}
@@ -431,6 +434,93 @@ func TestQueryTextAndPlanQuery(t *testing.T) {
 	assert.NoError(t, errs)
 }
 
+func TestQueryStoreTopQueries(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Username = "sa"
+	cfg.Password = "password"
+	cfg.Port = 1433
+	cfg.Server = "0.0.0.0"
+	cfg.TopQueryCollection.Source = "query_store"
+	assert.NoError(t, cfg.Validate())
+
+	configureAllScraperMetricsAndEvents(cfg, false)
+	cfg.Events.DbServerTopQuery.Enabled = true
+
+	scrapers := setupSQLServerLogsScrapers(receivertest.NewNopSettings(metadata.Type), cfg)
+	require.Len(t, scrapers, 1)
+
+	scraper := scrapers[0]
+	assert.Equal(t, getSQLServerQueryStoreTopQueriesQuery("cpu_time"), scraper.sqlQuery)
+	scraper.client = mockClient{
+		instanceName: scraper.config.InstanceName,
+		SQL:          scraper.sqlQuery,
+	}
+
+	actualLogs, err := scraper.ScrapeLogs(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 2, actualLogs.LogRecordCount())
+
+	// The Query Store totals are reported as read, without waiting for a
+	// previous scrape to compute a difference.
+	record := actualLogs.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0)
+	assert.Equal(t, "db.server.top_query", record.EventName())
+	attrs := record.Attributes().AsRaw()
+	assert.Equal(t, "SELECT * FROM orders WHERE customer_id = ?", attrs["db.query.text"])
+	assert.Equal(t, hex.EncodeToString([]byte("0x37849E874171E3F3")), attrs["sqlserver.query_hash"])
+	assert.Equal(t, int64(12), attrs["sqlserver.execution_count"])
+	assert.Equal(t, int64(340), attrs["sqlserver.total_logical_reads"])
+	assert.InDelta(t, 2.0, attrs["sqlserver.total_worker_time"], 1e-9)
+	assert.InDelta(t, 2.5, attrs["sqlserver.total_elapsed_time"], 1e-9)
+
+	record = actualLogs.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(1)
+	assert.Equal(t, "UPDATE stock SET quantity = quantity - ? WHERE sku = ?", record.Attributes().AsRaw()["db.query.text"])
+}
+
+func TestQueryStoreTopQueriesQueryOrder(t *testing.T) {
+	assert.Contains(t, getSQLServerQueryStoreTopQueriesQuery("logical_reads"), "ORDER BY total_logical_reads DESC")
+	assert.Contains(t, getSQLServerQueryStoreTopQueriesQuery("duration"), "ORDER BY total_elapsed_time DESC")
+	assert.Contains(t, getSQLServerQueryStoreTopQueriesQuery(""), "ORDER BY total_worker_time DESC")
+}
+
+func TestWaitStatsCountAndSignalDuration(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Username = "sa"
+	cfg.Password = "password"
+	cfg.Port = 1433
+	cfg.Server = "0.0.0.0"
+	configureAllScraperMetricsAndEvents(cfg, false)
+	cfg.Metrics.SqlserverOsWaitCount.Enabled = true
+	cfg.Metrics.SqlserverOsWaitSignalDuration.Enabled = true
+	assert.NoError(t, cfg.Validate())
+
+	scrapers := setupSQLServerScrapers(receivertest.NewNopSettings(metadata.Type), cfg)
+	require.Len(t, scrapers, 1)
+
+	scraper := scrapers[0]
+	scraper.client = mockClient{
+		instanceName: scraper.config.InstanceName,
+		SQL:          scraper.sqlQuery,
+	}
+
+	actualMetrics, err := scraper.ScrapeMetrics(context.Background())
+	require.NoError(t, err)
+
+	// The third row of the test data is a CMEMTHREAD wait.
+	metrics := actualMetrics.ResourceMetrics().At(2).ScopeMetrics().At(0).Metrics()
+	require.Equal(t, 2, metrics.Len())
+	for i := 0; i < metrics.Len(); i++ {
+		m := metrics.At(i)
+		switch m.Name() {
+		case "sqlserver.os.wait.count":
+			assert.Equal(t, int64(1684), m.Sum().DataPoints().At(0).IntValue())
+		case "sqlserver.os.wait.signal.duration":
+			assert.InDelta(t, 1.557, m.Sum().DataPoints().At(0).DoubleValue(), 1e-9)
+		default:
+			t.Errorf("unexpected metric %s", m.Name())
+		}
+	}
+}
+
 func TestInvalidQueryTextAndPlanQuery(t *testing.T) {
 	cfg := createDefaultConfig().(*Config)
 	cfg.Username = "sa"
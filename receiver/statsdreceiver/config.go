@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"strings"
 	"time"
 
 	"github.com/lightstep/go-expohisto/structure"
@@ -27,6 +28,14 @@ type Config struct {
 	TimerHistogramMapping   []protocol.TimerHistogramMapping `mapstructure:"timer_histogram_mapping"`
 	// Will only be used when transport set to 'unixgram'.
 	SocketPermissions os.FileMode `mapstructure:"socket_permissions"`
+	// EnableOriginDetection detects the container of the clients from the
+	// credentials of the sending process, as done by DogStatsD. Only supported
+	// on Linux when transport is set to 'unixgram'.
+	EnableOriginDetection bool `mapstructure:"enable_origin_detection"`
+	// EnableContainerIDResourceAttribute emits the container ID sent in the
+	// DogStatsD container ID field as the 'container.id' resource attribute
+	// instead of a data point attribute.
+	EnableContainerIDResourceAttribute bool `mapstructure:"enable_container_id_resource_attribute"`
 }
 
 func (c *Config) Validate() error {
@@ -85,6 +94,10 @@ func (c *Config) Validate() error {
 		}
 	}
 
+	if c.EnableOriginDetection && !strings.EqualFold(string(c.NetAddr.Transport), "unixgram") {
+		errs = multierr.Append(errs, errors.New("enable_origin_detection requires transport: unixgram"))
+	}
+
 	if TimerHistogramMappingMissingObjectName {
 		errs = multierr.Append(errs, errors.New("must specify object id for all TimerHistogramMappings"))
 	}
@@ -97,6 +97,7 @@ func TestValidate(t *testing.T) {
 		observerTypeNotSupportErr      = "observer_type is not supported for histogram and timing metrics: %s"
 		invalidHistogramErr            = "histogram configuration requires observer_type: histogram"
 		invalidSummaryErr              = "summary configuration requires observer_type: summary"
+		originDetectionErr             = "enable_origin_detection requires transport: unixgram"
 	)
 
 	tests := []test{
@@ -192,6 +193,17 @@ func TestValidate(t *testing.T) {
 			},
 			expectedErr: negativeAggregationIntervalErr,
 		},
+		{
+			name: "originDetectionWithoutUnixgram",
+			cfg: &Config{
+				NetAddr: confignet.AddrConfig{
+					Transport: confignet.TransportTypeUDP,
+				},
+				AggregationInterval:   10,
+				EnableOriginDetection: true,
+			},
+			expectedErr: originDetectionErr,
+		},
 	}
 
 	for _, test := range tests {
@@ -62,10 +62,15 @@ type StatsDParser struct {
 	histogramEvents         ObserverCategory
 	lastIntervalTime        time.Time
 	BuildInfo               component.BuildInfo
+	// EnableContainerIDResourceAttribute moves the container ID sent in the
+	// DogStatsD container ID field from the data point attributes to the
+	// resource attributes.
+	EnableContainerIDResourceAttribute bool
 }
 
 type instruments struct {
 	addr                   net.Addr
+	containerID            string
 	gauges                 map[statsDMetricDescription]pmetric.ScopeMetrics
 	counters               map[statsDMetricDescription]pmetric.ScopeMetrics
 	summaries              map[statsDMetricDescription]summaryMetric
@@ -73,13 +78,14 @@ type instruments struct {
 	timersAndDistributions []pmetric.ScopeMetrics
 }
 
-func newInstruments(addr net.Addr) *instruments {
+func newInstruments(addr net.Addr, containerID string) *instruments {
 	return &instruments{
-		addr:       addr,
-		gauges:     make(map[statsDMetricDescription]pmetric.ScopeMetrics),
-		counters:   make(map[statsDMetricDescription]pmetric.ScopeMetrics),
-		summaries:  make(map[statsDMetricDescription]summaryMetric),
-		histograms: make(map[statsDMetricDescription]histogramMetric),
+		addr:        addr,
+		containerID: containerID,
+		gauges:      make(map[statsDMetricDescription]pmetric.ScopeMetrics),
+		counters:    make(map[statsDMetricDescription]pmetric.ScopeMetrics),
+		summaries:   make(map[statsDMetricDescription]summaryMetric),
+		histograms:  make(map[statsDMetricDescription]histogramMetric),
 	}
 }
 
@@ -107,6 +113,7 @@ type statsDMetric struct {
 	unit        string
 	sampleRate  float64
 	timestamp   uint64
+	containerID string
 }
 
 type statsDMetricDescription struct {
@@ -183,6 +190,9 @@ func (p *StatsDParser) GetMetrics() []BatchMetrics {
 			Metrics: pmetric.NewMetrics(),
 		}
 		rm := batch.Metrics.ResourceMetrics().AppendEmpty()
+		if instrument.containerID != "" {
+			rm.Resource().Attributes().PutStr(string(semconv.ContainerIDKey), instrument.containerID)
+		}
 		for _, metric := range instrument.gauges {
 			p.copyMetricAndScope(rm, metric)
 		}
@@ -267,10 +277,11 @@ func (p *StatsDParser) Aggregate(line string, addr net.Addr) error {
 	if p.enableIPOnlyAggregation {
 		addrKey = newIPOnlyNetAddr(addr)
 	}
+	addrKey.ContainerID = p.resourceContainerID(&parsedMetric, addr)
 
 	instrument, ok := p.instrumentsByAddress[addrKey]
 	if !ok {
-		instrument = newInstruments(addr)
+		instrument = newInstruments(addr, addrKey.ContainerID)
 		p.instrumentsByAddress[addrKey] = instrument
 	}
 
@@ -343,6 +354,27 @@ func (p *StatsDParser) Aggregate(line string, addr net.Addr) error {
 	return nil
 }
 
+// resourceContainerID returns the container ID to be set as resource attribute
+// of the metric. The container ID field of the message is used when enabled,
+// removing it from the metric attributes, otherwise the container detected by
+// the transport via origin detection, if any, is used.
+func (p *StatsDParser) resourceContainerID(m *statsDMetric, addr net.Addr) string {
+	if p.EnableContainerIDResourceAttribute && m.containerID != "" {
+		attrs, _ := m.description.attrs.Filter(func(kv attribute.KeyValue) bool {
+			return kv.Key != semconv.ContainerIDKey
+		})
+		if attrs.Len() == 0 {
+			attrs = attribute.Set{}
+		}
+		m.description.attrs = attrs
+		return m.containerID
+	}
+	if origin, ok := addr.(interface{ ContainerID() string }); ok {
+		return origin.ContainerID()
+	}
+	return ""
+}
+
 func parseMessageToMetric(line string, enableMetricType, enableSimpleTags bool) (statsDMetric, error) {
 	result := statsDMetric{}
 
@@ -422,6 +454,7 @@ func parseMessageToMetric(line string, enableMetricType, enableSimpleTags bool)
 			containerID := strings.TrimPrefix(part, "c:")
 
 			if containerID != "" {
+				result.containerID = containerID
 				kvs = append(kvs, attribute.String(string(semconv.ContainerIDKey), containerID))
 			}
 		case strings.HasPrefix(part, "T"):
@@ -465,6 +498,9 @@ func parseMessageToMetric(line string, enableMetricType, enableSimpleTags bool)
 type netAddr struct {
 	Network string
 	String  string
+	// ContainerID is the container ID set as resource attribute, metrics from
+	// different containers are never aggregated together.
+	ContainerID string
 }
 
 func newNetAddr(addr net.Addr) netAddr {
@@ -117,16 +117,20 @@ func Test_ParseMessageToMetric(t *testing.T) {
 		{
 			name:  "counter metric with container ID",
 			input: "test.metric:42|c|#key:value|c:abc123",
-			wantMetric: testStatsDMetric(
-				"test.metric",
-				42,
-				false,
-				"c",
-				0,
-				[]string{"key", string(semconv.ContainerIDKey)},
-				[]string{"value", "abc123"},
-				0,
-			),
+			wantMetric: func() statsDMetric {
+				m := testStatsDMetric(
+					"test.metric",
+					42,
+					false,
+					"c",
+					0,
+					[]string{"key", string(semconv.ContainerIDKey)},
+					[]string{"value", "abc123"},
+					0,
+				)
+				m.containerID = "abc123"
+				return m
+			}(),
 		},
 		{
 			name:  "counter metric with timestamp",
@@ -1963,3 +1967,60 @@ func TestStatsDParser_IPOnlyAggregation(t *testing.T) {
 
 	assert.Equal(t, int64(4), value)
 }
+
+func TestStatsDParser_ContainerIDResourceAttribute(t *testing.T) {
+	testAddr, _ := net.ResolveUDPAddr("udp", "1.2.3.4:5678")
+	p := &StatsDParser{EnableContainerIDResourceAttribute: true}
+	require.NoError(t, p.Initialize(false, false, false, false, nil))
+
+	require.NoError(t, p.Aggregate("test.metric:1|c|#key:value|c:abc123", testAddr))
+	require.NoError(t, p.Aggregate("test.metric:2|c|#key:value|c:abc123", testAddr))
+	require.NoError(t, p.Aggregate("test.metric:5|c|c:def456", testAddr))
+	require.NoError(t, p.Aggregate("test.metric:7|c", testAddr))
+
+	metrics := p.GetMetrics()
+	require.Len(t, metrics, 3)
+
+	byContainer := map[string]pmetric.Metrics{}
+	for _, batch := range metrics {
+		var containerID string
+		if v, ok := batch.Metrics.ResourceMetrics().At(0).Resource().Attributes().Get(string(semconv.ContainerIDKey)); ok {
+			containerID = v.Str()
+		}
+		byContainer[containerID] = batch.Metrics
+	}
+	require.Contains(t, byContainer, "abc123")
+	require.Contains(t, byContainer, "def456")
+	require.Contains(t, byContainer, "")
+
+	dp := byContainer["abc123"].ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0).Sum().DataPoints().At(0)
+	assert.Equal(t, int64(3), dp.IntValue())
+	assert.Equal(t, map[string]any{"key": "value"}, dp.Attributes().AsRaw())
+
+	dp = byContainer["def456"].ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0).Sum().DataPoints().At(0)
+	assert.Equal(t, int64(5), dp.IntValue())
+	assert.Empty(t, dp.Attributes().AsRaw())
+}
+
+type originAddr struct {
+	net.Addr
+	containerID string
+}
+
+func (a originAddr) ContainerID() string {
+	return a.containerID
+}
+
+func TestStatsDParser_OriginContainerID(t *testing.T) {
+	testAddr, _ := net.ResolveUDPAddr("udp", "1.2.3.4:5678")
+	p := &StatsDParser{}
+	require.NoError(t, p.Initialize(false, false, false, false, nil))
+
+	require.NoError(t, p.Aggregate("test.metric:1|c", originAddr{Addr: testAddr, containerID: "abc123"}))
+
+	metrics := p.GetMetrics()
+	require.Len(t, metrics, 1)
+	v, ok := metrics[0].Metrics.ResourceMetrics().At(0).Resource().Attributes().Get(string(semconv.ContainerIDKey))
+	require.True(t, ok)
+	assert.Equal(t, "abc123", v.Str())
+}
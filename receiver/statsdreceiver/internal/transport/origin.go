@@ -0,0 +1,94 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package transport // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/statsdreceiver/internal/transport"
+
+import (
+	"bufio"
+	"bytes"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"time"
+)
+
+// originCacheTTL is how long the container ID resolved for a process is
+// reused before looking it up again, PIDs can be recycled so entries must
+// not live forever.
+const originCacheTTL = time.Minute
+
+// containerIDPattern matches the container IDs used by Docker, containerd and
+// CRI-O in the cgroup paths of the containerized processes.
+var containerIDPattern = regexp.MustCompile(`[0-9a-f]{64}`)
+
+// procRoot is the mount point of the proc filesystem, it is a variable so
+// tests can replace it.
+var procRoot = "/proc"
+
+type originEntry struct {
+	containerID string
+	expiration  time.Time
+}
+
+// originResolver resolves the container ID of the process that sent a
+// datagram, as done by the DogStatsD origin detection. It is not safe for
+// concurrent use.
+type originResolver struct {
+	cache map[int32]originEntry
+	now   func() time.Time
+}
+
+func newOriginResolver() *originResolver {
+	return &originResolver{
+		cache: make(map[int32]originEntry),
+		now:   time.Now,
+	}
+}
+
+// containerID returns the container ID of the process with the given PID, or
+// an empty string if the process is not running in a container.
+func (r *originResolver) containerID(pid int32) string {
+	now := r.now()
+	if entry, ok := r.cache[pid]; ok && now.Before(entry.expiration) {
+		return entry.containerID
+	}
+
+	// Drop expired entries so the cache doesn't grow with every PID seen.
+	for k, entry := range r.cache {
+		if !now.Before(entry.expiration) {
+			delete(r.cache, k)
+		}
+	}
+
+	var containerID string
+	if data, err := os.ReadFile(filepath.Join(procRoot, strconv.Itoa(int(pid)), "cgroup")); err == nil {
+		containerID = containerIDFromCgroup(data)
+	}
+	r.cache[pid] = originEntry{
+		containerID: containerID,
+		expiration:  now.Add(originCacheTTL),
+	}
+	return containerID
+}
+
+// containerIDFromCgroup extracts the container ID from the content of a
+// /proc/<pid>/cgroup file. Each line has the format
+// "<hierarchy-id>:<controllers>:<cgroup-path>", the container ID is the last
+// match found on the cgroup paths.
+func containerIDFromCgroup(data []byte) string {
+	var containerID string
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		line := scanner.Bytes()
+		idx := bytes.LastIndexByte(line, ':')
+		if idx < 0 {
+			continue
+		}
+		matches := containerIDPattern.FindAll(line[idx+1:], -1)
+		if len(matches) > 0 {
+			containerID = string(matches[len(matches)-1])
+		}
+	}
+	return containerID
+}
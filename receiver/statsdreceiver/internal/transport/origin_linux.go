@@ -0,0 +1,48 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build linux
+
+package transport // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/statsdreceiver/internal/transport"
+
+import (
+	"net"
+	"syscall"
+)
+
+// originOOBSize is the size of the buffer needed to receive the credentials
+// of the sender as an ancillary message.
+var originOOBSize = syscall.CmsgSpace(syscall.SizeofUcred)
+
+// enableOriginDetection configures the socket to receive the credentials of
+// the sender with every datagram.
+func enableOriginDetection(conn *net.UnixConn) error {
+	rawConn, err := conn.SyscallConn()
+	if err != nil {
+		return err
+	}
+	var sockErr error
+	err = rawConn.Control(func(fd uintptr) {
+		sockErr = syscall.SetsockoptInt(int(fd), syscall.SOL_SOCKET, syscall.SO_PASSCRED, 1)
+	})
+	if err != nil {
+		return err
+	}
+	return sockErr
+}
+
+// originPID returns the PID of the sender from the ancillary data of a
+// datagram.
+func originPID(oob []byte) (int32, bool) {
+	msgs, err := syscall.ParseSocketControlMessage(oob)
+	if err != nil {
+		return 0, false
+	}
+	for i := range msgs {
+		cred, err := syscall.ParseUnixCredentials(&msgs[i])
+		if err == nil {
+			return cred.Pid, true
+		}
+	}
+	return 0, false
+}
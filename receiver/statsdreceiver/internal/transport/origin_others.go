@@ -0,0 +1,21 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build !linux
+
+package transport // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/statsdreceiver/internal/transport"
+
+import (
+	"errors"
+	"net"
+)
+
+var originOOBSize = 0
+
+func enableOriginDetection(*net.UnixConn) error {
+	return errors.New("origin detection is only supported on Linux")
+}
+
+func originPID([]byte) (int32, bool) {
+	return 0, false
+}
@@ -0,0 +1,80 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package transport
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testContainerID = "3c7a1b5a4f2e9d8c7b6a5f4e3d2c1b0a9f8e7d6c5b4a3f2e1d0c9b8a7f6e5d4c"
+
+func TestContainerIDFromCgroup(t *testing.T) {
+	tests := []struct {
+		name   string
+		cgroup string
+		want   string
+	}{
+		{
+			name:   "cgroup_v1_docker",
+			cgroup: "12:memory:/docker/" + testContainerID + "\n11:cpu,cpuacct:/docker/" + testContainerID + "\n",
+			want:   testContainerID,
+		},
+		{
+			name:   "cgroup_v2_systemd",
+			cgroup: "0::/system.slice/docker-" + testContainerID + ".scope\n",
+			want:   testContainerID,
+		},
+		{
+			name:   "kubernetes_containerd",
+			cgroup: "0::/kubepods.slice/kubepods-burstable.slice/kubepods-burstable-pod1234.slice/cri-containerd-" + testContainerID + ".scope\n",
+			want:   testContainerID,
+		},
+		{
+			name:   "not_in_container",
+			cgroup: "0::/user.slice/user-1000.slice/session-2.scope\n",
+			want:   "",
+		},
+		{
+			name:   "empty",
+			cgroup: "",
+			want:   "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, containerIDFromCgroup([]byte(tt.cgroup)))
+		})
+	}
+}
+
+func TestOriginResolverCache(t *testing.T) {
+	procDir := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(procDir, "42"), 0o755))
+	cgroupPath := filepath.Join(procDir, "42", "cgroup")
+	require.NoError(t, os.WriteFile(cgroupPath, []byte("0::/docker/"+testContainerID+"\n"), 0o600))
+
+	originalProcRoot := procRoot
+	procRoot = procDir
+	defer func() { procRoot = originalProcRoot }()
+
+	now := time.Now()
+	r := newOriginResolver()
+	r.now = func() time.Time { return now }
+
+	assert.Equal(t, testContainerID, r.containerID(42))
+	assert.Empty(t, r.containerID(43))
+
+	// Cached results are used until they expire.
+	require.NoError(t, os.Remove(cgroupPath))
+	assert.Equal(t, testContainerID, r.containerID(42))
+
+	now = now.Add(originCacheTTL)
+	assert.Empty(t, r.containerID(42))
+}
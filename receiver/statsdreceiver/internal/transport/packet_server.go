@@ -71,8 +71,9 @@ func (*packetServer) handlePacket(
 }
 
 type udsAddr struct {
-	network string
-	address string
+	network     string
+	address     string
+	containerID string
 }
 
 func (u *udsAddr) Network() string {
@@ -82,3 +83,9 @@ func (u *udsAddr) Network() string {
 func (u *udsAddr) String() string {
 	return u.address
 }
+
+// ContainerID returns the container ID of the client detected via origin
+// detection, if any.
+func (u *udsAddr) ContainerID() string {
+	return u.containerID
+}
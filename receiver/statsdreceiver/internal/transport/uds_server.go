@@ -4,20 +4,28 @@
 package transport // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/statsdreceiver/internal/transport"
 
 import (
+	"errors"
 	"fmt"
 	"net"
 	"os"
+
+	"go.opentelemetry.io/collector/consumer"
 )
 
 type udsServer struct {
 	packetServer
+	// origins resolves the container of the clients, it is nil when origin
+	// detection is disabled.
+	origins *originResolver
 }
 
 // Ensure that Server is implemented on UDS Server.
 var _ Server = (*udsServer)(nil)
 
 // NewUDSServer creates a transport.Server using Unixgram as its transport.
-func NewUDSServer(transport Transport, socketPath string, socketPermissions os.FileMode) (Server, error) {
+// When originDetection is true the container ID of each client is detected
+// from the credentials of the sending process.
+func NewUDSServer(transport Transport, socketPath string, socketPermissions os.FileMode, originDetection bool) (Server, error) {
 	if !transport.IsPacketTransport() {
 		return nil, fmt.Errorf("NewUDSServer with %s: %w", transport.String(), ErrUnsupportedPacketTransport)
 	}
@@ -31,14 +39,74 @@ func NewUDSServer(transport Transport, socketPath string, socketPermissions os.F
 		return nil, fmt.Errorf("running chmod %v: %w", socketPermissions, err)
 	}
 
+	var origins *originResolver
+	if originDetection {
+		unixConn, ok := conn.(*net.UnixConn)
+		if !ok {
+			conn.Close()
+			return nil, fmt.Errorf("origin detection is not supported by %T", conn)
+		}
+		if err := enableOriginDetection(unixConn); err != nil {
+			conn.Close()
+			return nil, fmt.Errorf("enabling origin detection: %w", err)
+		}
+		origins = newOriginResolver()
+	}
+
 	return &udsServer{
 		packetServer: packetServer{
 			packetConn: conn,
 			transport:  transport,
 		},
+		origins: origins,
 	}, nil
 }
 
+// ListenAndServe starts the server ready to receive metrics.
+func (u *udsServer) ListenAndServe(
+	nextConsumer consumer.Metrics,
+	reporter Reporter,
+	transferChan chan<- Metric,
+) error {
+	if u.origins == nil {
+		return u.packetServer.ListenAndServe(nextConsumer, reporter, transferChan)
+	}
+	if nextConsumer == nil || reporter == nil {
+		return errNilListenAndServeParameters
+	}
+
+	conn := u.packetConn.(*net.UnixConn)
+	buf := make([]byte, 65527)
+	oob := make([]byte, originOOBSize)
+	for {
+		n, oobn, _, _, err := conn.ReadMsgUnix(buf, oob)
+		addr := &udsAddr{
+			network: u.transport.String(),
+			address: u.packetConn.LocalAddr().String(),
+		}
+		if pid, ok := originPID(oob[:oobn]); ok {
+			addr.containerID = u.origins.containerID(pid)
+		}
+
+		if n > 0 {
+			u.handlePacket(n, buf, addr, transferChan)
+		}
+		if err != nil {
+			reporter.OnDebugf("%s Transport (%s) - ReadMsgUnix error: %v",
+				u.transport,
+				u.packetConn.LocalAddr(),
+				err)
+			var netErr net.Error
+			if errors.As(err, &netErr) {
+				if netErr.Timeout() {
+					continue
+				}
+			}
+			return err
+		}
+	}
+}
+
 // Close closes the server.
 func (u *udsServer) Close() error {
 	os.Remove(u.packetConn.LocalAddr().String())
@@ -5,17 +5,21 @@
 package transport
 
 import (
+	"net"
 	"os"
+	"path/filepath"
+	"strconv"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/consumer/consumertest"
 )
 
 func Test_NewUDSServer_ListenPacketFailure(t *testing.T) {
 	invalidPath := "/invalid_path/test_socket"
 
-	server, err := NewUDSServer("unixgram", invalidPath, 0o622)
+	server, err := NewUDSServer("unixgram", invalidPath, 0o622, false)
 
 	assert.Error(t, err)
 	assert.Nil(t, server)
@@ -26,7 +30,7 @@ func Test_UDSServer_Close(t *testing.T) {
 	socketPath := "/tmp/test_socket_close"
 	defer os.Remove(socketPath)
 
-	server, err := NewUDSServer("unixgram", socketPath, 0o622)
+	server, err := NewUDSServer("unixgram", socketPath, 0o622, false)
 	require.NoError(t, err)
 	require.NotNil(t, server)
 
@@ -44,9 +48,9 @@ func Test_NewUDSServer_AppliesChmod(t *testing.T) {
 	socketPath := "/tmp/test_socket_chmod"
 	defer os.Remove(socketPath) // Cleanup after test
 
-	expectedPermissions := os.FileMode(0o622)
+	expectedPermissions := os.FileMode(0o622, false)
 
-	server, err := NewUDSServer("unixgram", socketPath, expectedPermissions)
+	server, err := NewUDSServer("unixgram", socketPath, expectedPermissions, false)
 	require.NoError(t, err)
 	require.NotNil(t, server)
 
@@ -58,3 +62,40 @@ func Test_NewUDSServer_AppliesChmod(t *testing.T) {
 
 	server.Close()
 }
+
+func Test_UDSServer_OriginDetection(t *testing.T) {
+	socketPath := "/tmp/test_socket_origin"
+	defer os.Remove(socketPath)
+
+	containerID := "3c7a1b5a4f2e9d8c7b6a5f4e3d2c1b0a9f8e7d6c5b4a3f2e1d0c9b8a7f6e5d4c"
+	procDir := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(procDir, strconv.Itoa(os.Getpid())), 0o755))
+	require.NoError(t, os.WriteFile(
+		filepath.Join(procDir, strconv.Itoa(os.Getpid()), "cgroup"),
+		[]byte("0::/system.slice/docker-"+containerID+".scope\n"),
+		0o600))
+	originalProcRoot := procRoot
+	procRoot = procDir
+	defer func() { procRoot = originalProcRoot }()
+
+	server, err := NewUDSServer("unixgram", socketPath, 0o622, true)
+	require.NoError(t, err)
+	defer server.Close()
+
+	transferChan := make(chan Metric, 1)
+	go func() {
+		_ = server.ListenAndServe(consumertest.NewNop(), NewMockReporter(0), transferChan)
+	}()
+
+	conn, err := net.Dial("unixgram", socketPath)
+	require.NoError(t, err)
+	defer conn.Close()
+	_, err = conn.Write([]byte("test.metric:42|c"))
+	require.NoError(t, err)
+
+	metric := <-transferChan
+	assert.Equal(t, "test.metric:42|c", metric.Raw)
+	origin, ok := metric.Addr.(interface{ ContainerID() string })
+	require.True(t, ok)
+	assert.Equal(t, containerID, origin.ContainerID())
+}
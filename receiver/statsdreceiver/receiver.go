@@ -78,7 +78,8 @@ func newReceiver(
 		obsrecv:      obsrecv,
 		reporter:     rep,
 		parser: &parser.StatsDParser{
-			BuildInfo: set.BuildInfo,
+			BuildInfo:                          set.BuildInfo,
+			EnableContainerIDResourceAttribute: config.EnableContainerIDResourceAttribute,
 		},
 	}
 	return r, nil
@@ -92,7 +93,7 @@ func buildTransportServer(config Config) (transport.Server, error) {
 	case transport.TCP, transport.TCP4, transport.TCP6:
 		return transport.NewTCPServer(trans, config.NetAddr.Endpoint)
 	case transport.UDS:
-		return transport.NewUDSServer(trans, config.NetAddr.Endpoint, config.SocketPermissions)
+		return transport.NewUDSServer(trans, config.NetAddr.Endpoint, config.SocketPermissions, config.EnableOriginDetection)
 	}
 
 	return nil, fmt.Errorf("unsupported transport %q", string(config.NetAddr.Transport))
@@ -138,6 +138,7 @@ func (vc *vcenterClient) Datastores(ctx context.Context, containerMoRef vt.Manag
 		"name",
 		"summary.capacity",
 		"summary.freeSpace",
+		"summary.url",
 	}, &datastores)
 	if err != nil {
 		return nil, fmt.Errorf("unable to retrieve Datastores: %w", err)
@@ -188,6 +189,7 @@ func (vc *vcenterClient) HostSystems(ctx context.Context, containerMoRef vt.Mana
 		"summary.quickStats.overallMemoryUsage",
 		"summary.quickStats.overallCpuUsage",
 		"summary.overallStatus",
+		"runtime.vsanRuntimeInfo",
 		"vm",
 		"parent",
 	}, &hosts)
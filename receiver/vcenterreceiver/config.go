@@ -25,6 +25,10 @@ type Config struct {
 	Endpoint                       string              `mapstructure:"endpoint"`
 	Username                       string              `mapstructure:"username"`
 	Password                       configopaque.String `mapstructure:"password"`
+	// Clusters limits the vSAN and datastore performance queries to the clusters
+	// with these names, to bound the load on vCenter. All the clusters are
+	// queried when empty.
+	Clusters []string `mapstructure:"clusters"`
 }
 
 // Validate checks to see if the supplied config will work for the receiver
@@ -52,6 +56,13 @@ func (c *Config) Validate() error {
 		err = multierr.Append(err, errors.New("password not provided and is required"))
 	}
 
+	for _, cluster := range c.Clusters {
+		if cluster == "" {
+			err = multierr.Append(err, errors.New("cluster names must not be empty"))
+			break
+		}
+	}
+
 	if _, tlsErr := c.LoadTLSConfig(context.Background()); tlsErr != nil {
 		err = multierr.Append(err, fmt.Errorf("error loading tls configuration: %w", tlsErr))
 	}
@@ -76,6 +76,17 @@ func TestConfigValidation(t *testing.T) {
 			},
 			expectedErr: errors.New("password not provided"),
 		},
+		{
+			desc: "empty cluster name",
+			cfg: Config{
+				Endpoint:         "https://vcsa.some-host",
+				Username:         "otelu",
+				Password:         "otelp",
+				Clusters:         []string{"cluster-a", ""},
+				ControllerConfig: scraperhelper.NewDefaultControllerConfig(),
+			},
+			expectedErr: errors.New("cluster names must not be empty"),
+		},
 	}
 
 	for _, tc := range cases {
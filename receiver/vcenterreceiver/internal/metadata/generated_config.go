@@ -36,6 +36,7 @@ type MetricsConfig struct {
 	VcenterClusterVMCount               MetricConfig `mapstructure:"vcenter.cluster.vm.count"`
 	VcenterClusterVMTemplateCount       MetricConfig `mapstructure:"vcenter.cluster.vm_template.count"`
 	VcenterClusterVsanCongestions       MetricConfig `mapstructure:"vcenter.cluster.vsan.congestions"`
+	VcenterClusterVsanDiskIssues        MetricConfig `mapstructure:"vcenter.cluster.vsan.disk.issues"`
 	VcenterClusterVsanLatencyAvg        MetricConfig `mapstructure:"vcenter.cluster.vsan.latency.avg"`
 	VcenterClusterVsanOperations        MetricConfig `mapstructure:"vcenter.cluster.vsan.operations"`
 	VcenterClusterVsanThroughput        MetricConfig `mapstructure:"vcenter.cluster.vsan.throughput"`
@@ -46,6 +47,9 @@ type MetricsConfig struct {
 	VcenterDatacenterHostCount          MetricConfig `mapstructure:"vcenter.datacenter.host.count"`
 	VcenterDatacenterMemoryLimit        MetricConfig `mapstructure:"vcenter.datacenter.memory.limit"`
 	VcenterDatacenterVMCount            MetricConfig `mapstructure:"vcenter.datacenter.vm.count"`
+	VcenterDatastoreDiskLatencyAvg      MetricConfig `mapstructure:"vcenter.datastore.disk.latency.avg"`
+	VcenterDatastoreDiskOperations      MetricConfig `mapstructure:"vcenter.datastore.disk.operations"`
+	VcenterDatastoreDiskThroughput      MetricConfig `mapstructure:"vcenter.datastore.disk.throughput"`
 	VcenterDatastoreDiskUsage           MetricConfig `mapstructure:"vcenter.datastore.disk.usage"`
 	VcenterDatastoreDiskUtilization     MetricConfig `mapstructure:"vcenter.datastore.disk.utilization"`
 	VcenterHostCPUCapacity              MetricConfig `mapstructure:"vcenter.host.cpu.capacity"`
@@ -65,7 +69,9 @@ type MetricsConfig struct {
 	VcenterHostNetworkUsage             MetricConfig `mapstructure:"vcenter.host.network.usage"`
 	VcenterHostVsanCacheHitRate         MetricConfig `mapstructure:"vcenter.host.vsan.cache.hit_rate"`
 	VcenterHostVsanCongestions          MetricConfig `mapstructure:"vcenter.host.vsan.congestions"`
+	VcenterHostVsanDiskIssues           MetricConfig `mapstructure:"vcenter.host.vsan.disk.issues"`
 	VcenterHostVsanLatencyAvg           MetricConfig `mapstructure:"vcenter.host.vsan.latency.avg"`
+	VcenterHostVsanMembers              MetricConfig `mapstructure:"vcenter.host.vsan.members"`
 	VcenterHostVsanOperations           MetricConfig `mapstructure:"vcenter.host.vsan.operations"`
 	VcenterHostVsanThroughput           MetricConfig `mapstructure:"vcenter.host.vsan.throughput"`
 	VcenterResourcePoolCPUShares        MetricConfig `mapstructure:"vcenter.resource_pool.cpu.shares"`
@@ -127,6 +133,9 @@ func DefaultMetricsConfig() MetricsConfig {
 		VcenterClusterVsanCongestions: MetricConfig{
 			Enabled: true,
 		},
+		VcenterClusterVsanDiskIssues: MetricConfig{
+			Enabled: false,
+		},
 		VcenterClusterVsanLatencyAvg: MetricConfig{
 			Enabled: true,
 		},
@@ -157,6 +166,15 @@ func DefaultMetricsConfig() MetricsConfig {
 		VcenterDatacenterVMCount: MetricConfig{
 			Enabled: true,
 		},
+		VcenterDatastoreDiskLatencyAvg: MetricConfig{
+			Enabled: false,
+		},
+		VcenterDatastoreDiskOperations: MetricConfig{
+			Enabled: false,
+		},
+		VcenterDatastoreDiskThroughput: MetricConfig{
+			Enabled: false,
+		},
 		VcenterDatastoreDiskUsage: MetricConfig{
 			Enabled: true,
 		},
@@ -214,9 +232,15 @@ func DefaultMetricsConfig() MetricsConfig {
 		VcenterHostVsanCongestions: MetricConfig{
 			Enabled: true,
 		},
+		VcenterHostVsanDiskIssues: MetricConfig{
+			Enabled: false,
+		},
 		VcenterHostVsanLatencyAvg: MetricConfig{
 			Enabled: true,
 		},
+		VcenterHostVsanMembers: MetricConfig{
+			Enabled: false,
+		},
 		VcenterHostVsanOperations: MetricConfig{
 			Enabled: true,
 		},
@@ -35,6 +35,7 @@ func TestMetricsBuilderConfig(t *testing.T) {
 					VcenterClusterVMCount:               MetricConfig{Enabled: true},
 					VcenterClusterVMTemplateCount:       MetricConfig{Enabled: true},
 					VcenterClusterVsanCongestions:       MetricConfig{Enabled: true},
+					VcenterClusterVsanDiskIssues:        MetricConfig{Enabled: true},
 					VcenterClusterVsanLatencyAvg:        MetricConfig{Enabled: true},
 					VcenterClusterVsanOperations:        MetricConfig{Enabled: true},
 					VcenterClusterVsanThroughput:        MetricConfig{Enabled: true},
@@ -45,6 +46,9 @@ func TestMetricsBuilderConfig(t *testing.T) {
 					VcenterDatacenterHostCount:          MetricConfig{Enabled: true},
 					VcenterDatacenterMemoryLimit:        MetricConfig{Enabled: true},
 					VcenterDatacenterVMCount:            MetricConfig{Enabled: true},
+					VcenterDatastoreDiskLatencyAvg:      MetricConfig{Enabled: true},
+					VcenterDatastoreDiskOperations:      MetricConfig{Enabled: true},
+					VcenterDatastoreDiskThroughput:      MetricConfig{Enabled: true},
 					VcenterDatastoreDiskUsage:           MetricConfig{Enabled: true},
 					VcenterDatastoreDiskUtilization:     MetricConfig{Enabled: true},
 					VcenterHostCPUCapacity:              MetricConfig{Enabled: true},
@@ -64,7 +68,9 @@ func TestMetricsBuilderConfig(t *testing.T) {
 					VcenterHostNetworkUsage:             MetricConfig{Enabled: true},
 					VcenterHostVsanCacheHitRate:         MetricConfig{Enabled: true},
 					VcenterHostVsanCongestions:          MetricConfig{Enabled: true},
+					VcenterHostVsanDiskIssues:           MetricConfig{Enabled: true},
 					VcenterHostVsanLatencyAvg:           MetricConfig{Enabled: true},
+					VcenterHostVsanMembers:              MetricConfig{Enabled: true},
 					VcenterHostVsanOperations:           MetricConfig{Enabled: true},
 					VcenterHostVsanThroughput:           MetricConfig{Enabled: true},
 					VcenterResourcePoolCPUShares:        MetricConfig{Enabled: true},
@@ -127,6 +133,7 @@ func TestMetricsBuilderConfig(t *testing.T) {
 					VcenterClusterVMCount:               MetricConfig{Enabled: false},
 					VcenterClusterVMTemplateCount:       MetricConfig{Enabled: false},
 					VcenterClusterVsanCongestions:       MetricConfig{Enabled: false},
+					VcenterClusterVsanDiskIssues:        MetricConfig{Enabled: false},
 					VcenterClusterVsanLatencyAvg:        MetricConfig{Enabled: false},
 					VcenterClusterVsanOperations:        MetricConfig{Enabled: false},
 					VcenterClusterVsanThroughput:        MetricConfig{Enabled: false},
@@ -137,6 +144,9 @@ func TestMetricsBuilderConfig(t *testing.T) {
 					VcenterDatacenterHostCount:          MetricConfig{Enabled: false},
 					VcenterDatacenterMemoryLimit:        MetricConfig{Enabled: false},
 					VcenterDatacenterVMCount:            MetricConfig{Enabled: false},
+					VcenterDatastoreDiskLatencyAvg:      MetricConfig{Enabled: false},
+					VcenterDatastoreDiskOperations:      MetricConfig{Enabled: false},
+					VcenterDatastoreDiskThroughput:      MetricConfig{Enabled: false},
 					VcenterDatastoreDiskUsage:           MetricConfig{Enabled: false},
 					VcenterDatastoreDiskUtilization:     MetricConfig{Enabled: false},
 					VcenterHostCPUCapacity:              MetricConfig{Enabled: false},
@@ -156,7 +166,9 @@ func TestMetricsBuilderConfig(t *testing.T) {
 					VcenterHostNetworkUsage:             MetricConfig{Enabled: false},
 					VcenterHostVsanCacheHitRate:         MetricConfig{Enabled: false},
 					VcenterHostVsanCongestions:          MetricConfig{Enabled: false},
+					VcenterHostVsanDiskIssues:           MetricConfig{Enabled: false},
 					VcenterHostVsanLatencyAvg:           MetricConfig{Enabled: false},
+					VcenterHostVsanMembers:              MetricConfig{Enabled: false},
 					VcenterHostVsanOperations:           MetricConfig{Enabled: false},
 					VcenterHostVsanThroughput:           MetricConfig{Enabled: false},
 					VcenterResourcePoolCPUShares:        MetricConfig{Enabled: false},
@@ -437,6 +437,9 @@ var MetricsInfo = metricsInfo{
 	VcenterClusterVsanCongestions: metricInfo{
 		Name: "vcenter.cluster.vsan.congestions",
 	},
+	VcenterClusterVsanDiskIssues: metricInfo{
+		Name: "vcenter.cluster.vsan.disk.issues",
+	},
 	VcenterClusterVsanLatencyAvg: metricInfo{
 		Name: "vcenter.cluster.vsan.latency.avg",
 	},
@@ -467,6 +470,15 @@ var MetricsInfo = metricsInfo{
 	VcenterDatacenterVMCount: metricInfo{
 		Name: "vcenter.datacenter.vm.count",
 	},
+	VcenterDatastoreDiskLatencyAvg: metricInfo{
+		Name: "vcenter.datastore.disk.latency.avg",
+	},
+	VcenterDatastoreDiskOperations: metricInfo{
+		Name: "vcenter.datastore.disk.operations",
+	},
+	VcenterDatastoreDiskThroughput: metricInfo{
+		Name: "vcenter.datastore.disk.throughput",
+	},
 	VcenterDatastoreDiskUsage: metricInfo{
 		Name: "vcenter.datastore.disk.usage",
 	},
@@ -524,9 +536,15 @@ var MetricsInfo = metricsInfo{
 	VcenterHostVsanCongestions: metricInfo{
 		Name: "vcenter.host.vsan.congestions",
 	},
+	VcenterHostVsanDiskIssues: metricInfo{
+		Name: "vcenter.host.vsan.disk.issues",
+	},
 	VcenterHostVsanLatencyAvg: metricInfo{
 		Name: "vcenter.host.vsan.latency.avg",
 	},
+	VcenterHostVsanMembers: metricInfo{
+		Name: "vcenter.host.vsan.members",
+	},
 	VcenterHostVsanOperations: metricInfo{
 		Name: "vcenter.host.vsan.operations",
 	},
@@ -637,6 +655,7 @@ type metricsInfo struct {
 	VcenterClusterVMCount               metricInfo
 	VcenterClusterVMTemplateCount       metricInfo
 	VcenterClusterVsanCongestions       metricInfo
+	VcenterClusterVsanDiskIssues        metricInfo
 	VcenterClusterVsanLatencyAvg        metricInfo
 	VcenterClusterVsanOperations        metricInfo
 	VcenterClusterVsanThroughput        metricInfo
@@ -647,6 +666,9 @@ type metricsInfo struct {
 	VcenterDatacenterHostCount          metricInfo
 	VcenterDatacenterMemoryLimit        metricInfo
 	VcenterDatacenterVMCount            metricInfo
+	VcenterDatastoreDiskLatencyAvg      metricInfo
+	VcenterDatastoreDiskOperations      metricInfo
+	VcenterDatastoreDiskThroughput      metricInfo
 	VcenterDatastoreDiskUsage           metricInfo
 	VcenterDatastoreDiskUtilization     metricInfo
 	VcenterHostCPUCapacity              metricInfo
@@ -666,7 +688,9 @@ type metricsInfo struct {
 	VcenterHostNetworkUsage             metricInfo
 	VcenterHostVsanCacheHitRate         metricInfo
 	VcenterHostVsanCongestions          metricInfo
+	VcenterHostVsanDiskIssues           metricInfo
 	VcenterHostVsanLatencyAvg           metricInfo
+	VcenterHostVsanMembers              metricInfo
 	VcenterHostVsanOperations           metricInfo
 	VcenterHostVsanThroughput           metricInfo
 	VcenterResourcePoolCPUShares        metricInfo
@@ -1116,6 +1140,55 @@ func newMetricVcenterClusterVsanCongestions(cfg MetricConfig) metricVcenterClust
 	return m
 }
 
+type metricVcenterClusterVsanDiskIssues struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills vcenter.cluster.vsan.disk.issues metric with initial data.
+func (m *metricVcenterClusterVsanDiskIssues) init() {
+	m.data.SetName("vcenter.cluster.vsan.disk.issues")
+	m.data.SetDescription("The number of vSAN disk issues reported by the hosts of the cluster.")
+	m.data.SetUnit("{issues}")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricVcenterClusterVsanDiskIssues) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricVcenterClusterVsanDiskIssues) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricVcenterClusterVsanDiskIssues) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricVcenterClusterVsanDiskIssues(cfg MetricConfig) metricVcenterClusterVsanDiskIssues {
+	m := metricVcenterClusterVsanDiskIssues{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 type metricVcenterClusterVsanLatencyAvg struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
@@ -1636,6 +1709,159 @@ func newMetricVcenterDatacenterVMCount(cfg MetricConfig) metricVcenterDatacenter
 	return m
 }
 
+type metricVcenterDatastoreDiskLatencyAvg struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills vcenter.datastore.disk.latency.avg metric with initial data.
+func (m *metricVcenterDatastoreDiskLatencyAvg) init() {
+	m.data.SetName("vcenter.datastore.disk.latency.avg")
+	m.data.SetDescription("The latency of operations to the datastore.")
+	m.data.SetUnit("ms")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricVcenterDatastoreDiskLatencyAvg) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, diskDirectionAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("direction", diskDirectionAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricVcenterDatastoreDiskLatencyAvg) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricVcenterDatastoreDiskLatencyAvg) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricVcenterDatastoreDiskLatencyAvg(cfg MetricConfig) metricVcenterDatastoreDiskLatencyAvg {
+	m := metricVcenterDatastoreDiskLatencyAvg{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricVcenterDatastoreDiskOperations struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills vcenter.datastore.disk.operations metric with initial data.
+func (m *metricVcenterDatastoreDiskOperations) init() {
+	m.data.SetName("vcenter.datastore.disk.operations")
+	m.data.SetDescription("The number of operations on the datastore each second.")
+	m.data.SetUnit("{operations/s}")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricVcenterDatastoreDiskOperations) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, diskDirectionAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("direction", diskDirectionAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricVcenterDatastoreDiskOperations) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricVcenterDatastoreDiskOperations) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricVcenterDatastoreDiskOperations(cfg MetricConfig) metricVcenterDatastoreDiskOperations {
+	m := metricVcenterDatastoreDiskOperations{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricVcenterDatastoreDiskThroughput struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills vcenter.datastore.disk.throughput metric with initial data.
+func (m *metricVcenterDatastoreDiskThroughput) init() {
+	m.data.SetName("vcenter.datastore.disk.throughput")
+	m.data.SetDescription("The number of kilobytes read from or written to the datastore each second.")
+	m.data.SetUnit("{KiBy/s}")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricVcenterDatastoreDiskThroughput) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, diskDirectionAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("direction", diskDirectionAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricVcenterDatastoreDiskThroughput) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricVcenterDatastoreDiskThroughput) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricVcenterDatastoreDiskThroughput(cfg MetricConfig) metricVcenterDatastoreDiskThroughput {
+	m := metricVcenterDatastoreDiskThroughput{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 type metricVcenterDatastoreDiskUsage struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
@@ -2611,6 +2837,55 @@ func newMetricVcenterHostVsanCongestions(cfg MetricConfig) metricVcenterHostVsan
 	return m
 }
 
+type metricVcenterHostVsanDiskIssues struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills vcenter.host.vsan.disk.issues metric with initial data.
+func (m *metricVcenterHostVsanDiskIssues) init() {
+	m.data.SetName("vcenter.host.vsan.disk.issues")
+	m.data.SetDescription("The number of vSAN disk issues reported by the host.")
+	m.data.SetUnit("{issues}")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricVcenterHostVsanDiskIssues) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricVcenterHostVsanDiskIssues) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricVcenterHostVsanDiskIssues) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricVcenterHostVsanDiskIssues(cfg MetricConfig) metricVcenterHostVsanDiskIssues {
+	m := metricVcenterHostVsanDiskIssues{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 type metricVcenterHostVsanLatencyAvg struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
@@ -2662,6 +2937,55 @@ func newMetricVcenterHostVsanLatencyAvg(cfg MetricConfig) metricVcenterHostVsanL
 	return m
 }
 
+type metricVcenterHostVsanMembers struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills vcenter.host.vsan.members metric with initial data.
+func (m *metricVcenterHostVsanMembers) init() {
+	m.data.SetName("vcenter.host.vsan.members")
+	m.data.SetDescription("The number of hosts in the vSAN cluster membership as seen by the host.")
+	m.data.SetUnit("{hosts}")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricVcenterHostVsanMembers) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricVcenterHostVsanMembers) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricVcenterHostVsanMembers) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricVcenterHostVsanMembers(cfg MetricConfig) metricVcenterHostVsanMembers {
+	m := metricVcenterHostVsanMembers{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 type metricVcenterHostVsanOperations struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
@@ -4374,6 +4698,7 @@ type MetricsBuilder struct {
 	metricVcenterClusterVMCount               metricVcenterClusterVMCount
 	metricVcenterClusterVMTemplateCount       metricVcenterClusterVMTemplateCount
 	metricVcenterClusterVsanCongestions       metricVcenterClusterVsanCongestions
+	metricVcenterClusterVsanDiskIssues        metricVcenterClusterVsanDiskIssues
 	metricVcenterClusterVsanLatencyAvg        metricVcenterClusterVsanLatencyAvg
 	metricVcenterClusterVsanOperations        metricVcenterClusterVsanOperations
 	metricVcenterClusterVsanThroughput        metricVcenterClusterVsanThroughput
@@ -4384,6 +4709,9 @@ type MetricsBuilder struct {
 	metricVcenterDatacenterHostCount          metricVcenterDatacenterHostCount
 	metricVcenterDatacenterMemoryLimit        metricVcenterDatacenterMemoryLimit
 	metricVcenterDatacenterVMCount            metricVcenterDatacenterVMCount
+	metricVcenterDatastoreDiskLatencyAvg      metricVcenterDatastoreDiskLatencyAvg
+	metricVcenterDatastoreDiskOperations      metricVcenterDatastoreDiskOperations
+	metricVcenterDatastoreDiskThroughput      metricVcenterDatastoreDiskThroughput
 	metricVcenterDatastoreDiskUsage           metricVcenterDatastoreDiskUsage
 	metricVcenterDatastoreDiskUtilization     metricVcenterDatastoreDiskUtilization
 	metricVcenterHostCPUCapacity              metricVcenterHostCPUCapacity
@@ -4403,7 +4731,9 @@ type MetricsBuilder struct {
 	metricVcenterHostNetworkUsage             metricVcenterHostNetworkUsage
 	metricVcenterHostVsanCacheHitRate         metricVcenterHostVsanCacheHitRate
 	metricVcenterHostVsanCongestions          metricVcenterHostVsanCongestions
+	metricVcenterHostVsanDiskIssues           metricVcenterHostVsanDiskIssues
 	metricVcenterHostVsanLatencyAvg           metricVcenterHostVsanLatencyAvg
+	metricVcenterHostVsanMembers              metricVcenterHostVsanMembers
 	metricVcenterHostVsanOperations           metricVcenterHostVsanOperations
 	metricVcenterHostVsanThroughput           metricVcenterHostVsanThroughput
 	metricVcenterResourcePoolCPUShares        metricVcenterResourcePoolCPUShares
@@ -4470,6 +4800,7 @@ func NewMetricsBuilder(mbc MetricsBuilderConfig, settings receiver.Settings, opt
 		metricVcenterClusterVMCount:               newMetricVcenterClusterVMCount(mbc.Metrics.VcenterClusterVMCount),
 		metricVcenterClusterVMTemplateCount:       newMetricVcenterClusterVMTemplateCount(mbc.Metrics.VcenterClusterVMTemplateCount),
 		metricVcenterClusterVsanCongestions:       newMetricVcenterClusterVsanCongestions(mbc.Metrics.VcenterClusterVsanCongestions),
+		metricVcenterClusterVsanDiskIssues:        newMetricVcenterClusterVsanDiskIssues(mbc.Metrics.VcenterClusterVsanDiskIssues),
 		metricVcenterClusterVsanLatencyAvg:        newMetricVcenterClusterVsanLatencyAvg(mbc.Metrics.VcenterClusterVsanLatencyAvg),
 		metricVcenterClusterVsanOperations:        newMetricVcenterClusterVsanOperations(mbc.Metrics.VcenterClusterVsanOperations),
 		metricVcenterClusterVsanThroughput:        newMetricVcenterClusterVsanThroughput(mbc.Metrics.VcenterClusterVsanThroughput),
@@ -4480,6 +4811,9 @@ func NewMetricsBuilder(mbc MetricsBuilderConfig, settings receiver.Settings, opt
 		metricVcenterDatacenterHostCount:          newMetricVcenterDatacenterHostCount(mbc.Metrics.VcenterDatacenterHostCount),
 		metricVcenterDatacenterMemoryLimit:        newMetricVcenterDatacenterMemoryLimit(mbc.Metrics.VcenterDatacenterMemoryLimit),
 		metricVcenterDatacenterVMCount:            newMetricVcenterDatacenterVMCount(mbc.Metrics.VcenterDatacenterVMCount),
+		metricVcenterDatastoreDiskLatencyAvg:      newMetricVcenterDatastoreDiskLatencyAvg(mbc.Metrics.VcenterDatastoreDiskLatencyAvg),
+		metricVcenterDatastoreDiskOperations:      newMetricVcenterDatastoreDiskOperations(mbc.Metrics.VcenterDatastoreDiskOperations),
+		metricVcenterDatastoreDiskThroughput:      newMetricVcenterDatastoreDiskThroughput(mbc.Metrics.VcenterDatastoreDiskThroughput),
 		metricVcenterDatastoreDiskUsage:           newMetricVcenterDatastoreDiskUsage(mbc.Metrics.VcenterDatastoreDiskUsage),
 		metricVcenterDatastoreDiskUtilization:     newMetricVcenterDatastoreDiskUtilization(mbc.Metrics.VcenterDatastoreDiskUtilization),
 		metricVcenterHostCPUCapacity:              newMetricVcenterHostCPUCapacity(mbc.Metrics.VcenterHostCPUCapacity),
@@ -4499,7 +4833,9 @@ func NewMetricsBuilder(mbc MetricsBuilderConfig, settings receiver.Settings, opt
 		metricVcenterHostNetworkUsage:             newMetricVcenterHostNetworkUsage(mbc.Metrics.VcenterHostNetworkUsage),
 		metricVcenterHostVsanCacheHitRate:         newMetricVcenterHostVsanCacheHitRate(mbc.Metrics.VcenterHostVsanCacheHitRate),
 		metricVcenterHostVsanCongestions:          newMetricVcenterHostVsanCongestions(mbc.Metrics.VcenterHostVsanCongestions),
+		metricVcenterHostVsanDiskIssues:           newMetricVcenterHostVsanDiskIssues(mbc.Metrics.VcenterHostVsanDiskIssues),
 		metricVcenterHostVsanLatencyAvg:           newMetricVcenterHostVsanLatencyAvg(mbc.Metrics.VcenterHostVsanLatencyAvg),
+		metricVcenterHostVsanMembers:              newMetricVcenterHostVsanMembers(mbc.Metrics.VcenterHostVsanMembers),
 		metricVcenterHostVsanOperations:           newMetricVcenterHostVsanOperations(mbc.Metrics.VcenterHostVsanOperations),
 		metricVcenterHostVsanThroughput:           newMetricVcenterHostVsanThroughput(mbc.Metrics.VcenterHostVsanThroughput),
 		metricVcenterResourcePoolCPUShares:        newMetricVcenterResourcePoolCPUShares(mbc.Metrics.VcenterResourcePoolCPUShares),
@@ -4685,6 +5021,7 @@ func (mb *MetricsBuilder) EmitForResource(options ...ResourceMetricsOption) {
 	mb.metricVcenterClusterVMCount.emit(ils.Metrics())
 	mb.metricVcenterClusterVMTemplateCount.emit(ils.Metrics())
 	mb.metricVcenterClusterVsanCongestions.emit(ils.Metrics())
+	mb.metricVcenterClusterVsanDiskIssues.emit(ils.Metrics())
 	mb.metricVcenterClusterVsanLatencyAvg.emit(ils.Metrics())
 	mb.metricVcenterClusterVsanOperations.emit(ils.Metrics())
 	mb.metricVcenterClusterVsanThroughput.emit(ils.Metrics())
@@ -4695,6 +5032,9 @@ func (mb *MetricsBuilder) EmitForResource(options ...ResourceMetricsOption) {
 	mb.metricVcenterDatacenterHostCount.emit(ils.Metrics())
 	mb.metricVcenterDatacenterMemoryLimit.emit(ils.Metrics())
 	mb.metricVcenterDatacenterVMCount.emit(ils.Metrics())
+	mb.metricVcenterDatastoreDiskLatencyAvg.emit(ils.Metrics())
+	mb.metricVcenterDatastoreDiskOperations.emit(ils.Metrics())
+	mb.metricVcenterDatastoreDiskThroughput.emit(ils.Metrics())
 	mb.metricVcenterDatastoreDiskUsage.emit(ils.Metrics())
 	mb.metricVcenterDatastoreDiskUtilization.emit(ils.Metrics())
 	mb.metricVcenterHostCPUCapacity.emit(ils.Metrics())
@@ -4714,7 +5054,9 @@ func (mb *MetricsBuilder) EmitForResource(options ...ResourceMetricsOption) {
 	mb.metricVcenterHostNetworkUsage.emit(ils.Metrics())
 	mb.metricVcenterHostVsanCacheHitRate.emit(ils.Metrics())
 	mb.metricVcenterHostVsanCongestions.emit(ils.Metrics())
+	mb.metricVcenterHostVsanDiskIssues.emit(ils.Metrics())
 	mb.metricVcenterHostVsanLatencyAvg.emit(ils.Metrics())
+	mb.metricVcenterHostVsanMembers.emit(ils.Metrics())
 	mb.metricVcenterHostVsanOperations.emit(ils.Metrics())
 	mb.metricVcenterHostVsanThroughput.emit(ils.Metrics())
 	mb.metricVcenterResourcePoolCPUShares.emit(ils.Metrics())
@@ -4819,6 +5161,11 @@ func (mb *MetricsBuilder) RecordVcenterClusterVsanCongestionsDataPoint(ts pcommo
 	mb.metricVcenterClusterVsanCongestions.recordDataPoint(mb.startTime, ts, val)
 }
 
+// RecordVcenterClusterVsanDiskIssuesDataPoint adds a data point to vcenter.cluster.vsan.disk.issues metric.
+func (mb *MetricsBuilder) RecordVcenterClusterVsanDiskIssuesDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricVcenterClusterVsanDiskIssues.recordDataPoint(mb.startTime, ts, val)
+}
+
 // RecordVcenterClusterVsanLatencyAvgDataPoint adds a data point to vcenter.cluster.vsan.latency.avg metric.
 func (mb *MetricsBuilder) RecordVcenterClusterVsanLatencyAvgDataPoint(ts pcommon.Timestamp, val int64, vsanLatencyTypeAttributeValue AttributeVsanLatencyType) {
 	mb.metricVcenterClusterVsanLatencyAvg.recordDataPoint(mb.startTime, ts, val, vsanLatencyTypeAttributeValue.String())
@@ -4869,6 +5216,21 @@ func (mb *MetricsBuilder) RecordVcenterDatacenterVMCountDataPoint(ts pcommon.Tim
 	mb.metricVcenterDatacenterVMCount.recordDataPoint(mb.startTime, ts, val, entityStatusAttributeValue.String(), vmCountPowerStateAttributeValue.String())
 }
 
+// RecordVcenterDatastoreDiskLatencyAvgDataPoint adds a data point to vcenter.datastore.disk.latency.avg metric.
+func (mb *MetricsBuilder) RecordVcenterDatastoreDiskLatencyAvgDataPoint(ts pcommon.Timestamp, val int64, diskDirectionAttributeValue AttributeDiskDirection) {
+	mb.metricVcenterDatastoreDiskLatencyAvg.recordDataPoint(mb.startTime, ts, val, diskDirectionAttributeValue.String())
+}
+
+// RecordVcenterDatastoreDiskOperationsDataPoint adds a data point to vcenter.datastore.disk.operations metric.
+func (mb *MetricsBuilder) RecordVcenterDatastoreDiskOperationsDataPoint(ts pcommon.Timestamp, val int64, diskDirectionAttributeValue AttributeDiskDirection) {
+	mb.metricVcenterDatastoreDiskOperations.recordDataPoint(mb.startTime, ts, val, diskDirectionAttributeValue.String())
+}
+
+// RecordVcenterDatastoreDiskThroughputDataPoint adds a data point to vcenter.datastore.disk.throughput metric.
+func (mb *MetricsBuilder) RecordVcenterDatastoreDiskThroughputDataPoint(ts pcommon.Timestamp, val int64, diskDirectionAttributeValue AttributeDiskDirection) {
+	mb.metricVcenterDatastoreDiskThroughput.recordDataPoint(mb.startTime, ts, val, diskDirectionAttributeValue.String())
+}
+
 // RecordVcenterDatastoreDiskUsageDataPoint adds a data point to vcenter.datastore.disk.usage metric.
 func (mb *MetricsBuilder) RecordVcenterDatastoreDiskUsageDataPoint(ts pcommon.Timestamp, val int64, diskStateAttributeValue AttributeDiskState) {
 	mb.metricVcenterDatastoreDiskUsage.recordDataPoint(mb.startTime, ts, val, diskStateAttributeValue.String())
@@ -4964,11 +5326,21 @@ func (mb *MetricsBuilder) RecordVcenterHostVsanCongestionsDataPoint(ts pcommon.T
 	mb.metricVcenterHostVsanCongestions.recordDataPoint(mb.startTime, ts, val)
 }
 
+// RecordVcenterHostVsanDiskIssuesDataPoint adds a data point to vcenter.host.vsan.disk.issues metric.
+func (mb *MetricsBuilder) RecordVcenterHostVsanDiskIssuesDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricVcenterHostVsanDiskIssues.recordDataPoint(mb.startTime, ts, val)
+}
+
 // RecordVcenterHostVsanLatencyAvgDataPoint adds a data point to vcenter.host.vsan.latency.avg metric.
 func (mb *MetricsBuilder) RecordVcenterHostVsanLatencyAvgDataPoint(ts pcommon.Timestamp, val int64, vsanLatencyTypeAttributeValue AttributeVsanLatencyType) {
 	mb.metricVcenterHostVsanLatencyAvg.recordDataPoint(mb.startTime, ts, val, vsanLatencyTypeAttributeValue.String())
 }
 
+// RecordVcenterHostVsanMembersDataPoint adds a data point to vcenter.host.vsan.members metric.
+func (mb *MetricsBuilder) RecordVcenterHostVsanMembersDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricVcenterHostVsanMembers.recordDataPoint(mb.startTime, ts, val)
+}
+
 // RecordVcenterHostVsanOperationsDataPoint adds a data point to vcenter.host.vsan.operations metric.
 func (mb *MetricsBuilder) RecordVcenterHostVsanOperationsDataPoint(ts pcommon.Timestamp, val int64, vsanOperationTypeAttributeValue AttributeVsanOperationType) {
 	mb.metricVcenterHostVsanOperations.recordDataPoint(mb.startTime, ts, val, vsanOperationTypeAttributeValue.String())
@@ -100,6 +100,9 @@ func TestMetricsBuilder(t *testing.T) {
 			allMetricsCount++
 			mb.RecordVcenterClusterVsanCongestionsDataPoint(ts, 1)
 
+			allMetricsCount++
+			mb.RecordVcenterClusterVsanDiskIssuesDataPoint(ts, 1)
+
 			defaultMetricsCount++
 			allMetricsCount++
 			mb.RecordVcenterClusterVsanLatencyAvgDataPoint(ts, 1, AttributeVsanLatencyTypeRead)
@@ -140,6 +143,15 @@ func TestMetricsBuilder(t *testing.T) {
 			allMetricsCount++
 			mb.RecordVcenterDatacenterVMCountDataPoint(ts, 1, AttributeEntityStatusRed, AttributeVMCountPowerStateOn)
 
+			allMetricsCount++
+			mb.RecordVcenterDatastoreDiskLatencyAvgDataPoint(ts, 1, AttributeDiskDirectionRead)
+
+			allMetricsCount++
+			mb.RecordVcenterDatastoreDiskOperationsDataPoint(ts, 1, AttributeDiskDirectionRead)
+
+			allMetricsCount++
+			mb.RecordVcenterDatastoreDiskThroughputDataPoint(ts, 1, AttributeDiskDirectionRead)
+
 			defaultMetricsCount++
 			allMetricsCount++
 			mb.RecordVcenterDatastoreDiskUsageDataPoint(ts, 1, AttributeDiskStateAvailable)
@@ -215,10 +227,16 @@ func TestMetricsBuilder(t *testing.T) {
 			allMetricsCount++
 			mb.RecordVcenterHostVsanCongestionsDataPoint(ts, 1)
 
+			allMetricsCount++
+			mb.RecordVcenterHostVsanDiskIssuesDataPoint(ts, 1)
+
 			defaultMetricsCount++
 			allMetricsCount++
 			mb.RecordVcenterHostVsanLatencyAvgDataPoint(ts, 1, AttributeVsanLatencyTypeRead)
 
+			allMetricsCount++
+			mb.RecordVcenterHostVsanMembersDataPoint(ts, 1)
+
 			defaultMetricsCount++
 			allMetricsCount++
 			mb.RecordVcenterHostVsanOperationsDataPoint(ts, 1, AttributeVsanOperationTypeRead)
@@ -498,6 +516,18 @@ func TestMetricsBuilder(t *testing.T) {
 					assert.Equal(t, ts, dp.Timestamp())
 					assert.Equal(t, pmetric.NumberDataPointValueTypeDouble, dp.ValueType())
 					assert.InDelta(t, float64(1), dp.DoubleValue(), 0.01)
+				case "vcenter.cluster.vsan.disk.issues":
+					assert.False(t, validatedMetrics["vcenter.cluster.vsan.disk.issues"], "Found a duplicate in the metrics slice: vcenter.cluster.vsan.disk.issues")
+					validatedMetrics["vcenter.cluster.vsan.disk.issues"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "The number of vSAN disk issues reported by the hosts of the cluster.", ms.At(i).Description())
+					assert.Equal(t, "{issues}", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
 				case "vcenter.cluster.vsan.latency.avg":
 					assert.False(t, validatedMetrics["vcenter.cluster.vsan.latency.avg"], "Found a duplicate in the metrics slice: vcenter.cluster.vsan.latency.avg")
 					validatedMetrics["vcenter.cluster.vsan.latency.avg"] = true
@@ -659,6 +689,51 @@ func TestMetricsBuilder(t *testing.T) {
 					attrVal, ok = dp.Attributes().Get("power_state")
 					assert.True(t, ok)
 					assert.Equal(t, "on", attrVal.Str())
+				case "vcenter.datastore.disk.latency.avg":
+					assert.False(t, validatedMetrics["vcenter.datastore.disk.latency.avg"], "Found a duplicate in the metrics slice: vcenter.datastore.disk.latency.avg")
+					validatedMetrics["vcenter.datastore.disk.latency.avg"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "The latency of operations to the datastore.", ms.At(i).Description())
+					assert.Equal(t, "ms", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("direction")
+					assert.True(t, ok)
+					assert.Equal(t, "read", attrVal.Str())
+				case "vcenter.datastore.disk.operations":
+					assert.False(t, validatedMetrics["vcenter.datastore.disk.operations"], "Found a duplicate in the metrics slice: vcenter.datastore.disk.operations")
+					validatedMetrics["vcenter.datastore.disk.operations"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "The number of operations on the datastore each second.", ms.At(i).Description())
+					assert.Equal(t, "{operations/s}", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("direction")
+					assert.True(t, ok)
+					assert.Equal(t, "read", attrVal.Str())
+				case "vcenter.datastore.disk.throughput":
+					assert.False(t, validatedMetrics["vcenter.datastore.disk.throughput"], "Found a duplicate in the metrics slice: vcenter.datastore.disk.throughput")
+					validatedMetrics["vcenter.datastore.disk.throughput"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "The number of kilobytes read from or written to the datastore each second.", ms.At(i).Description())
+					assert.Equal(t, "{KiBy/s}", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("direction")
+					assert.True(t, ok)
+					assert.Equal(t, "read", attrVal.Str())
 				case "vcenter.datastore.disk.usage":
 					assert.False(t, validatedMetrics["vcenter.datastore.disk.usage"], "Found a duplicate in the metrics slice: vcenter.datastore.disk.usage")
 					validatedMetrics["vcenter.datastore.disk.usage"] = true
@@ -953,6 +1028,18 @@ func TestMetricsBuilder(t *testing.T) {
 					assert.Equal(t, ts, dp.Timestamp())
 					assert.Equal(t, pmetric.NumberDataPointValueTypeDouble, dp.ValueType())
 					assert.InDelta(t, float64(1), dp.DoubleValue(), 0.01)
+				case "vcenter.host.vsan.disk.issues":
+					assert.False(t, validatedMetrics["vcenter.host.vsan.disk.issues"], "Found a duplicate in the metrics slice: vcenter.host.vsan.disk.issues")
+					validatedMetrics["vcenter.host.vsan.disk.issues"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "The number of vSAN disk issues reported by the host.", ms.At(i).Description())
+					assert.Equal(t, "{issues}", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
 				case "vcenter.host.vsan.latency.avg":
 					assert.False(t, validatedMetrics["vcenter.host.vsan.latency.avg"], "Found a duplicate in the metrics slice: vcenter.host.vsan.latency.avg")
 					validatedMetrics["vcenter.host.vsan.latency.avg"] = true
@@ -968,6 +1055,18 @@ func TestMetricsBuilder(t *testing.T) {
 					attrVal, ok := dp.Attributes().Get("type")
 					assert.True(t, ok)
 					assert.Equal(t, "read", attrVal.Str())
+				case "vcenter.host.vsan.members":
+					assert.False(t, validatedMetrics["vcenter.host.vsan.members"], "Found a duplicate in the metrics slice: vcenter.host.vsan.members")
+					validatedMetrics["vcenter.host.vsan.members"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "The number of hosts in the vSAN cluster membership as seen by the host.", ms.At(i).Description())
+					assert.Equal(t, "{hosts}", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
 				case "vcenter.host.vsan.operations":
 					assert.False(t, validatedMetrics["vcenter.host.vsan.operations"], "Found a duplicate in the metrics slice: vcenter.host.vsan.operations")
 					validatedMetrics["vcenter.host.vsan.operations"] = true
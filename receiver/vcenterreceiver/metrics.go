@@ -4,6 +4,9 @@
 package vcenterreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/vcenterreceiver"
 
 import (
+	"path"
+	"strings"
+
 	"github.com/vmware/govmomi/performance"
 	"github.com/vmware/govmomi/vim25/mo"
 	"github.com/vmware/govmomi/vim25/types"
@@ -118,6 +121,124 @@ func (v *vcenterMetricScraper) recordDatastoreStats(
 	v.mb.RecordVcenterDatastoreDiskUsageDataPoint(ts, diskUsage, metadata.AttributeDiskStateUsed)
 	v.mb.RecordVcenterDatastoreDiskUsageDataPoint(ts, s.FreeSpace, metadata.AttributeDiskStateAvailable)
 	v.mb.RecordVcenterDatastoreDiskUtilizationDataPoint(ts, diskUtilization)
+
+	perf := v.scrapeData.datastorePerfByID[datastoreID(ds)]
+	if perf == nil {
+		return
+	}
+	v.mb.RecordVcenterDatastoreDiskLatencyAvgDataPoint(ts, perf.averageReadLatency(), metadata.AttributeDiskDirectionRead)
+	v.mb.RecordVcenterDatastoreDiskLatencyAvgDataPoint(ts, perf.averageWriteLatency(), metadata.AttributeDiskDirectionWrite)
+	v.mb.RecordVcenterDatastoreDiskOperationsDataPoint(ts, perf.readOps, metadata.AttributeDiskDirectionRead)
+	v.mb.RecordVcenterDatastoreDiskOperationsDataPoint(ts, perf.writeOps, metadata.AttributeDiskDirectionWrite)
+	v.mb.RecordVcenterDatastoreDiskThroughputDataPoint(ts, perf.readThroughput, metadata.AttributeDiskDirectionRead)
+	v.mb.RecordVcenterDatastoreDiskThroughputDataPoint(ts, perf.writeThroughput, metadata.AttributeDiskDirectionWrite)
+}
+
+// datastoreID returns the ID the hosts report the performance metrics of a Datastore with,
+// which is the last element of its URL (ds:///vmfs/volumes/<id>/)
+func datastoreID(ds *mo.Datastore) string {
+	url := strings.TrimSuffix(ds.Summary.Url, "/")
+	if url == "" {
+		return ""
+	}
+	return path.Base(url)
+}
+
+// datastorePerfMetricList is the list of the Host Performance Counters rolled up per Datastore
+var datastorePerfMetricList = []string{
+	"datastore.numberReadAveraged.average",
+	"datastore.numberWriteAveraged.average",
+	"datastore.read.average",
+	"datastore.write.average",
+	"datastore.totalReadLatency.average",
+	"datastore.totalWriteLatency.average",
+}
+
+// datastorePerfStats contains the performance metrics of a Datastore summed over the hosts using it
+type datastorePerfStats struct {
+	readOps         int64
+	writeOps        int64
+	readThroughput  int64
+	writeThroughput int64
+	// The latencies are summed both as they are and weighted by the number of operations
+	// so the latency of idle Datastores can still be averaged over the hosts
+	readLatency          int64
+	writeLatency         int64
+	readLatencyWeighted  int64
+	writeLatencyWeighted int64
+	hosts                int64
+}
+
+func (s *datastorePerfStats) averageReadLatency() int64 {
+	return averageLatency(s.readLatencyWeighted, s.readOps, s.readLatency, s.hosts)
+}
+
+func (s *datastorePerfStats) averageWriteLatency() int64 {
+	return averageLatency(s.writeLatencyWeighted, s.writeOps, s.writeLatency, s.hosts)
+}
+
+// averageLatency returns the latency averaged over the operations of the hosts, or over the hosts if there
+// were no operations
+func averageLatency(weighted, ops, total, hosts int64) int64 {
+	switch {
+	case ops > 0:
+		return weighted / ops
+	case hosts > 0:
+		return total / hosts
+	default:
+		return 0
+	}
+}
+
+// rollupDatastorePerformance rolls up the latest Datastore performance metrics of each host per Datastore ID
+func rollupDatastorePerformance(entityMetrics map[string]*performance.EntityMetric) map[string]*datastorePerfStats {
+	rollups := map[string]*datastorePerfStats{}
+	for _, entityMetric := range entityMetrics {
+		hostStats := map[string]*datastorePerfStats{}
+		for _, val := range entityMetric.Value {
+			if val.Instance == "" || len(val.Value) == 0 {
+				continue
+			}
+			stats := hostStats[val.Instance]
+			if stats == nil {
+				stats = &datastorePerfStats{}
+				hostStats[val.Instance] = stats
+			}
+			latest := val.Value[len(val.Value)-1]
+			switch val.Name {
+			case "datastore.numberReadAveraged.average":
+				stats.readOps = latest
+			case "datastore.numberWriteAveraged.average":
+				stats.writeOps = latest
+			case "datastore.read.average":
+				stats.readThroughput = latest
+			case "datastore.write.average":
+				stats.writeThroughput = latest
+			case "datastore.totalReadLatency.average":
+				stats.readLatency = latest
+			case "datastore.totalWriteLatency.average":
+				stats.writeLatency = latest
+			}
+		}
+
+		for id, stats := range hostStats {
+			rollup := rollups[id]
+			if rollup == nil {
+				rollup = &datastorePerfStats{}
+				rollups[id] = rollup
+			}
+			rollup.readOps += stats.readOps
+			rollup.writeOps += stats.writeOps
+			rollup.readThroughput += stats.readThroughput
+			rollup.writeThroughput += stats.writeThroughput
+			rollup.readLatency += stats.readLatency
+			rollup.writeLatency += stats.writeLatency
+			rollup.readLatencyWeighted += stats.readLatency * stats.readOps
+			rollup.writeLatencyWeighted += stats.writeLatency * stats.writeOps
+			rollup.hosts++
+		}
+	}
+	return rollups
 }
 
 // recordClusterStats records stat metrics for a vSphere Cluster
@@ -174,6 +295,22 @@ func (v *vcenterMetricScraper) recordClusterVSANMetrics(vSANMetrics *vSANMetricR
 	}
 }
 
+// recordClusterVSANHealth records vSAN health metrics for a vSphere Cluster from the runtime info of its hosts
+func (v *vcenterMetricScraper) recordClusterVSANHealth(ts pcommon.Timestamp, cr *mo.ComputeResource) {
+	var diskIssues int64
+	reported := false
+	for _, hs := range v.scrapeData.hostsByRef {
+		if hs.Parent == nil || hs.Parent.Value != cr.Reference().Value || hs.Runtime.VsanRuntimeInfo == nil {
+			continue
+		}
+		diskIssues += int64(len(hs.Runtime.VsanRuntimeInfo.DiskIssues))
+		reported = true
+	}
+	if reported {
+		v.mb.RecordVcenterClusterVsanDiskIssuesDataPoint(ts, diskIssues)
+	}
+}
+
 // recordResourcePoolStats records stat metrics for a vSphere Resource Pool
 func (v *vcenterMetricScraper) recordResourcePoolStats(
 	ts pcommon.Timestamp,
@@ -222,6 +359,18 @@ func (v *vcenterMetricScraper) recordHostSystemStats(
 	v.mb.RecordVcenterHostCPUUtilizationDataPoint(ts, cpuUtilization)
 }
 
+// recordHostVSANHealth records vSAN health metrics for a vSphere host
+func (v *vcenterMetricScraper) recordHostVSANHealth(ts pcommon.Timestamp, hs *mo.HostSystem) {
+	info := hs.Runtime.VsanRuntimeInfo
+	if info == nil {
+		return
+	}
+	v.mb.RecordVcenterHostVsanDiskIssuesDataPoint(ts, int64(len(info.DiskIssues)))
+	if len(info.MembershipList) > 0 {
+		v.mb.RecordVcenterHostVsanMembersDataPoint(ts, int64(len(info.MembershipList)))
+	}
+}
+
 // recordHostVSANMetrics records vSAN metrics for a vSphere host
 func (v *vcenterMetricScraper) recordHostVSANMetrics(vSANMetrics *vSANMetricResults) {
 	for _, metric := range vSANMetrics.MetricDetails {
@@ -194,6 +194,7 @@ func (v *vcenterMetricScraper) buildHostMetrics(
 
 	// Record & emit Host metric data points
 	v.recordHostSystemStats(ts, hs)
+	v.recordHostVSANHealth(ts, hs)
 	hostPerfMetrics := v.scrapeData.hostPerfMetricsByRef[hs.Reference().Value]
 	if hostPerfMetrics != nil {
 		v.recordHostPerformanceMetrics(hostPerfMetrics)
@@ -388,6 +389,7 @@ func (v *vcenterMetricScraper) buildClusterMetrics(
 		return err
 	}
 
+	v.recordClusterVSANHealth(ts, cr)
 	vSANMetrics := v.scrapeData.clusterVSANMetricsByUUID[vSANConfig.DefaultConfig.Uuid]
 	if vSANMetrics != nil {
 		v.recordClusterVSANMetrics(vSANMetrics)
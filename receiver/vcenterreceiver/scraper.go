@@ -4,6 +4,7 @@ package vcenterreceiver // import "github.com/open-telemetry/opentelemetry-colle
 import (
 	"context"
 	"fmt"
+	"slices"
 
 	"github.com/vmware/govmomi/object"
 	"github.com/vmware/govmomi/performance"
@@ -42,6 +43,7 @@ type vcenterScrapeData struct {
 	vmVSANMetricsByUUID      map[string]*vSANMetricResults
 	hostVSANMetricsByUUID    map[string]*vSANMetricResults
 	clusterVSANMetricsByUUID map[string]*vSANMetricResults
+	datastorePerfByID        map[string]*datastorePerfStats
 }
 
 type vcenterMetricScraper struct {
@@ -85,6 +87,7 @@ func newVcenterScrapeData() *vcenterScrapeData {
 		vmVSANMetricsByUUID:      make(map[string]*vSANMetricResults),
 		hostVSANMetricsByUUID:    make(map[string]*vSANMetricResults),
 		clusterVSANMetricsByUUID: make(map[string]*vSANMetricResults),
+		datastorePerfByID:        make(map[string]*datastorePerfStats),
 	}
 }
 
@@ -241,7 +244,7 @@ func (v *vcenterMetricScraper) scrapeComputes(ctx context.Context, dc *mo.Datace
 	for i := range computes {
 		computeRef := computes[i].Reference()
 		v.scrapeData.computesByRef[computeRef.Value] = &computes[i]
-		if computeRef.Type == "ClusterComputeResource" {
+		if computeRef.Type == "ClusterComputeResource" && v.inClusterScope(&computeRef) {
 			v.scrapeData.clusterRefs = append(v.scrapeData.clusterRefs, &computeRef)
 		}
 	}
@@ -287,6 +290,10 @@ func (v *vcenterMetricScraper) scrapeHosts(ctx context.Context, dc *mo.Datacente
 		v.scrapeData.hostPerfMetricsByRef = results.resultsByRef
 	}
 
+	if v.datastorePerfEnabled() {
+		v.scrapeDatastorePerformance(ctx, hosts, errs)
+	}
+
 	vSANMetrics, err := v.client.VSANHosts(ctx, v.scrapeData.clusterRefs)
 	if err != nil {
 		errs.AddPartial(1, fmt.Errorf("failed to retrieve vSAN metrics for Hosts: %w", err))
@@ -295,6 +302,53 @@ func (v *vcenterMetricScraper) scrapeHosts(ctx context.Context, dc *mo.Datacente
 	v.scrapeData.hostVSANMetricsByUUID = vSANMetrics.MetricResultsByUUID
 }
 
+// scrapeDatastorePerformance scrapes the datastore performance metrics of the HostSystems in the cluster scope
+// and stores them rolled up per Datastore
+func (v *vcenterMetricScraper) scrapeDatastorePerformance(ctx context.Context, hosts []mo.HostSystem, errs *scrapererror.ScrapeErrors) {
+	hsRefs := []types.ManagedObjectReference{}
+	for i := range hosts {
+		if hosts[i].Parent != nil && v.inClusterScope(hosts[i].Parent) {
+			hsRefs = append(hsRefs, hosts[i].Reference())
+		}
+	}
+	if len(hsRefs) == 0 {
+		return
+	}
+
+	spec := types.PerfQuerySpec{
+		MaxSample:  1,
+		Format:     string(types.PerfFormatNormal),
+		IntervalId: int32(20),
+	}
+	results, err := v.client.PerfMetricsQuery(ctx, spec, datastorePerfMetricList, hsRefs)
+	if err != nil {
+		errs.AddPartial(1, fmt.Errorf("failed to retrieve datastore perf metrics for HostSystems: %w", err))
+		return
+	}
+	v.scrapeData.datastorePerfByID = rollupDatastorePerformance(results.resultsByRef)
+}
+
+// inClusterScope returns whether the vSAN and performance queries are enabled for a ComputeResource,
+// standalone hosts are out of scope as soon as clusters are configured
+func (v *vcenterMetricScraper) inClusterScope(crRef *types.ManagedObjectReference) bool {
+	if len(v.config.Clusters) == 0 {
+		return true
+	}
+	cr := v.scrapeData.computesByRef[crRef.Value]
+	if cr == nil || cr.Reference().Type != "ClusterComputeResource" {
+		return false
+	}
+	return slices.Contains(v.config.Clusters, cr.Name)
+}
+
+// datastorePerfEnabled returns whether any of the metrics rolled up from the datastore performance metrics is enabled
+func (v *vcenterMetricScraper) datastorePerfEnabled() bool {
+	metrics := v.config.MetricsBuilderConfig.Metrics
+	return metrics.VcenterDatastoreDiskLatencyAvg.Enabled ||
+		metrics.VcenterDatastoreDiskOperations.Enabled ||
+		metrics.VcenterDatastoreDiskThroughput.Enabled
+}
+
 // scrapeResourcePools scrapes and stores all relevant property data for a Datacenter's ResourcePools/vApps
 func (v *vcenterMetricScraper) scrapeResourcePools(ctx context.Context, dc *mo.Datacenter, errs *scrapererror.ScrapeErrors) {
 	// Init for current collection
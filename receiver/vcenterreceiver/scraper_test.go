@@ -9,6 +9,9 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	"github.com/vmware/govmomi/performance"
+	"github.com/vmware/govmomi/vim25/mo"
+	"github.com/vmware/govmomi/vim25/types"
 	"go.opentelemetry.io/collector/featuregate"
 	"go.opentelemetry.io/collector/receiver/receivertest"
 	"go.uber.org/zap"
@@ -159,3 +162,66 @@ func TestStartFailures_Metrics(t *testing.T) {
 		}
 	}
 }
+
+func TestRollupDatastorePerformance(t *testing.T) {
+	hostMetric := func(host string, values map[string]int64) *performance.EntityMetric {
+		metric := &performance.EntityMetric{Entity: types.ManagedObjectReference{Type: "HostSystem", Value: host}}
+		for name, value := range values {
+			metric.Value = append(metric.Value, performance.MetricSeries{Name: name, Instance: "ds-uuid", Value: []int64{value}})
+		}
+		return metric
+	}
+	rollups := rollupDatastorePerformance(map[string]*performance.EntityMetric{
+		"host-1": hostMetric("host-1", map[string]int64{
+			"datastore.numberReadAveraged.average":  30,
+			"datastore.numberWriteAveraged.average": 0,
+			"datastore.read.average":                100,
+			"datastore.totalReadLatency.average":    2,
+			"datastore.totalWriteLatency.average":   4,
+		}),
+		"host-2": hostMetric("host-2", map[string]int64{
+			"datastore.numberReadAveraged.average":  10,
+			"datastore.numberWriteAveraged.average": 0,
+			"datastore.read.average":                50,
+			"datastore.totalReadLatency.average":    6,
+			"datastore.totalWriteLatency.average":   8,
+		}),
+	})
+
+	require.Len(t, rollups, 1)
+	stats := rollups["ds-uuid"]
+	require.NotNil(t, stats)
+	require.Equal(t, int64(40), stats.readOps)
+	require.Equal(t, int64(150), stats.readThroughput)
+	// (2*30 + 6*10) / 40
+	require.Equal(t, int64(3), stats.averageReadLatency())
+	// No writes, averaged over the hosts
+	require.Equal(t, int64(6), stats.averageWriteLatency())
+}
+
+func TestDatastoreID(t *testing.T) {
+	ds := &mo.Datastore{}
+	require.Empty(t, datastoreID(ds))
+	ds.Summary.Url = "ds:///vmfs/volumes/5f3c2a1e-8b2d9c4e-1a2b-001122334455/"
+	require.Equal(t, "5f3c2a1e-8b2d9c4e-1a2b-001122334455", datastoreID(ds))
+}
+
+func TestInClusterScope(t *testing.T) {
+	scraper := newVmwareVcenterScraper(zap.NewNop(), &Config{}, receivertest.NewNopSettings(metadata.Type))
+	cluster := &mo.ComputeResource{Name: "cluster-a"}
+	cluster.Self = types.ManagedObjectReference{Type: "ClusterComputeResource", Value: "domain-c1"}
+	standalone := &mo.ComputeResource{Name: "host-b"}
+	standalone.Self = types.ManagedObjectReference{Type: "ComputeResource", Value: "domain-s2"}
+	scraper.scrapeData.computesByRef[cluster.Self.Value] = cluster
+	scraper.scrapeData.computesByRef[standalone.Self.Value] = standalone
+
+	require.True(t, scraper.inClusterScope(&cluster.Self))
+	require.True(t, scraper.inClusterScope(&standalone.Self))
+
+	scraper.config.Clusters = []string{"cluster-a"}
+	require.True(t, scraper.inClusterScope(&cluster.Self))
+	require.False(t, scraper.inClusterScope(&standalone.Self))
+
+	scraper.config.Clusters = []string{"cluster-c"}
+	require.False(t, scraper.inClusterScope(&cluster.Self))
+}
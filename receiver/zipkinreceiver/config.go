@@ -4,8 +4,13 @@
 package zipkinreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/zipkinreceiver"
 
 import (
+	"errors"
+	"time"
+
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/config/confighttp"
+	"go.opentelemetry.io/collector/config/configopaque"
+	"go.opentelemetry.io/collector/config/configtls"
 )
 
 // Config defines configuration for Zipkin receiver.
@@ -16,13 +21,72 @@ type Config struct {
 	// Disabled by default
 	ParseStringTags bool `mapstructure:"parse_string_tags"`
 
+	// RabbitMQ configures the receiver to also consume spans from a RabbitMQ queue,
+	// as done by the Zipkin RabbitMQ collector. Disabled when not set.
+	RabbitMQ *RabbitMQConfig `mapstructure:"rabbitmq"`
+
 	// prevent unkeyed literal initialization
 	_ struct{}
 }
 
+// RabbitMQConfig defines the RabbitMQ queue spans are consumed from.
+type RabbitMQConfig struct {
+	// Endpoint is the AMQP URL of the broker, e.g. amqp://localhost:5672.
+	Endpoint string `mapstructure:"endpoint"`
+	// VHost is the virtual host of the queue.
+	VHost string `mapstructure:"vhost"`
+	// Queue is the name of the queue spans are consumed from. Default is "zipkin".
+	Queue string `mapstructure:"queue"`
+	// TLS configures the TLS connection to the broker.
+	TLS *configtls.ClientConfig `mapstructure:"tls"`
+	// Auth configures the credentials used to connect to the broker.
+	Auth RabbitMQAuthConfig `mapstructure:"auth"`
+	// ConnectionTimeout is the timeout to establish a connection to the broker.
+	ConnectionTimeout time.Duration `mapstructure:"connection_timeout"`
+	// Heartbeat is the interval of the AMQP heartbeats.
+	Heartbeat time.Duration `mapstructure:"heartbeat"`
+	// PrefetchCount is the number of unacknowledged messages the broker delivers
+	// to the receiver. Default is 0, meaning no limit.
+	PrefetchCount int `mapstructure:"prefetch_count"`
+	// RequeueBackOff configures the delay before requeuing the messages the next
+	// consumer failed to consume.
+	RequeueBackOff RabbitMQBackOffConfig `mapstructure:"requeue_backoff"`
+}
+
+// RabbitMQBackOffConfig defines the delay before requeuing a message, doubled
+// for each consecutive failure of the next consumer.
+type RabbitMQBackOffConfig struct {
+	// InitialInterval is the delay after the first failure. Default is 1s.
+	InitialInterval time.Duration `mapstructure:"initial_interval"`
+	// MaxInterval is the upper bound of the delay. Default is 30s.
+	MaxInterval time.Duration `mapstructure:"max_interval"`
+}
+
+// RabbitMQAuthConfig defines the plain authentication with the broker.
+type RabbitMQAuthConfig struct {
+	Username string              `mapstructure:"username"`
+	Password configopaque.String `mapstructure:"password"`
+}
+
 var _ component.Config = (*Config)(nil)
 
 // Validate checks the receiver configuration is valid
-func (*Config) Validate() error {
+func (cfg *Config) Validate() error {
+	if cfg.RabbitMQ == nil {
+		return nil
+	}
+	if cfg.RabbitMQ.Endpoint == "" {
+		return errors.New("rabbitmq.endpoint is required")
+	}
+	// Password-less users are possible so only validate username
+	if cfg.RabbitMQ.Auth.Username == "" {
+		return errors.New("rabbitmq.auth.username is required")
+	}
+	if cfg.RabbitMQ.PrefetchCount < 0 {
+		return errors.New("rabbitmq.prefetch_count must not be negative")
+	}
+	if cfg.RabbitMQ.RequeueBackOff.InitialInterval < 0 || cfg.RabbitMQ.RequeueBackOff.MaxInterval < 0 {
+		return errors.New("rabbitmq.requeue_backoff intervals must not be negative")
+	}
 	return nil
 }
@@ -6,6 +6,7 @@ package zipkinreceiver
 import (
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -24,8 +25,9 @@ func TestLoadConfig(t *testing.T) {
 	require.NoError(t, err)
 
 	tests := []struct {
-		id       component.ID
-		expected component.Config
+		id          component.ID
+		expected    component.Config
+		expectedErr string
 	}{
 		{
 			id:       component.NewID(metadata.Type),
@@ -49,6 +51,35 @@ func TestLoadConfig(t *testing.T) {
 				ParseStringTags: true,
 			},
 		},
+		{
+			id: component.NewIDWithName(metadata.Type, "rabbitmq"),
+			expected: &Config{
+				ServerConfig: confighttp.ServerConfig{
+					Endpoint: defaultHTTPEndpoint,
+				},
+				RabbitMQ: &RabbitMQConfig{
+					Endpoint: "amqp://localhost:5672",
+					Queue:    "spans",
+					Auth: RabbitMQAuthConfig{
+						Username: "guest",
+						Password: "guest",
+					},
+					PrefetchCount: 100,
+					RequeueBackOff: RabbitMQBackOffConfig{
+						InitialInterval: 2 * time.Second,
+						MaxInterval:     time.Minute,
+					},
+				},
+			},
+		},
+		{
+			id:          component.NewIDWithName(metadata.Type, "rabbitmq_missing_username"),
+			expectedErr: "rabbitmq.auth.username is required",
+		},
+		{
+			id:          component.NewIDWithName(metadata.Type, "rabbitmq_negative_requeue_backoff"),
+			expectedErr: "rabbitmq.requeue_backoff intervals must not be negative",
+		},
 	}
 
 	for _, tt := range tests {
@@ -60,6 +91,10 @@ func TestLoadConfig(t *testing.T) {
 			require.NoError(t, err)
 			require.NoError(t, sub.Unmarshal(cfg))
 
+			if tt.expectedErr != "" {
+				assert.ErrorContains(t, xconfmap.Validate(cfg), tt.expectedErr)
+				return
+			}
 			assert.NoError(t, xconfmap.Validate(cfg))
 			assert.Equal(t, tt.expected, cfg)
 		})
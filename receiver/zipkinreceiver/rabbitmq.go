@@ -0,0 +1,198 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package zipkinreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/zipkinreceiver"
+
+import (
+	"bytes"
+	"context"
+	"time"
+
+	amqp "github.com/rabbitmq/amqp091-go"
+	"go.opentelemetry.io/collector/consumer/consumererror"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/rabbitmq"
+)
+
+const (
+	receiverTransportRabbitMQ = "rabbitmq"
+
+	defaultRabbitMQQueue      = "zipkin"
+	rabbitMQReconnectInterval = 5 * time.Second
+
+	defaultRabbitMQRequeueInitialInterval = time.Second
+	defaultRabbitMQRequeueMaxInterval     = 30 * time.Second
+)
+
+// startRabbitMQ connects to the configured broker and starts consuming the
+// spans published to the queue in the background.
+func (zr *zipkinReceiver) startRabbitMQ(ctx context.Context) error {
+	cfg := zr.config.RabbitMQ
+	dialConfig := rabbitmq.DialConfig{
+		URL:   cfg.Endpoint,
+		Vhost: cfg.VHost,
+		Auth: &amqp.PlainAuth{
+			Username: cfg.Auth.Username,
+			Password: string(cfg.Auth.Password),
+		},
+		ConnectionTimeout: cfg.ConnectionTimeout,
+		Heartbeat:         cfg.Heartbeat,
+		ConnectionName:    "otel-collector-" + zr.settings.ID.String(),
+	}
+	if cfg.TLS != nil {
+		tlsConfig, err := cfg.TLS.LoadTLSConfig(ctx)
+		if err != nil {
+			return err
+		}
+		dialConfig.TLS = tlsConfig
+	}
+
+	zr.settings.Logger.Info("Establishing initial connection to RabbitMQ")
+	conn, err := zr.amqpClient.DialConfig(dialConfig)
+	if err != nil {
+		return err
+	}
+	zr.amqpConnection = conn
+
+	consumeCtx, cancel := context.WithCancel(context.Background())
+	zr.cancelConsume = cancel
+	zr.shutdownWG.Add(1)
+	go func() {
+		defer zr.shutdownWG.Done()
+		zr.consumeRabbitMQ(consumeCtx)
+	}()
+	return nil
+}
+
+// consumeRabbitMQ consumes the queue until the context is cancelled,
+// restoring the connection whenever the deliveries stop.
+func (zr *zipkinReceiver) consumeRabbitMQ(ctx context.Context) {
+	for {
+		deliveries, err := zr.subscribeRabbitMQ(ctx)
+		if err != nil {
+			zr.settings.Logger.Warn("Failed to consume from RabbitMQ", zap.Error(err))
+		} else {
+			for delivery := range deliveries {
+				zr.handleDelivery(ctx, delivery)
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(rabbitMQReconnectInterval):
+		}
+		if err := zr.amqpConnection.ReconnectIfUnhealthy(); err != nil {
+			zr.settings.Logger.Warn("Failed to reconnect to RabbitMQ", zap.Error(err))
+		}
+	}
+}
+
+func (zr *zipkinReceiver) subscribeRabbitMQ(ctx context.Context) (<-chan amqp.Delivery, error) {
+	channel, err := zr.amqpConnection.Channel()
+	if err != nil {
+		return nil, err
+	}
+	if zr.config.RabbitMQ.PrefetchCount > 0 {
+		if err = channel.Qos(zr.config.RabbitMQ.PrefetchCount, 0, false); err != nil {
+			return nil, err
+		}
+	}
+	queue := zr.config.RabbitMQ.Queue
+	if queue == "" {
+		queue = defaultRabbitMQQueue
+	}
+	return channel.ConsumeWithContext(ctx, queue, "", false, false, false, false, nil)
+}
+
+// handleDelivery converts the spans of a message and acknowledges it once they
+// are consumed. Messages that can't be decoded or are permanently rejected by
+// the next consumer are dropped, others are requeued.
+func (zr *zipkinReceiver) handleDelivery(ctx context.Context, delivery amqp.Delivery) {
+	obsrecv := zr.obsrecvrs[receiverTransportRabbitMQ]
+	ctx = obsrecv.StartTracesOp(ctx)
+
+	td, receiverTagValue, err := zr.unmarshalMessage(delivery.ContentType, delivery.Body)
+	if err != nil {
+		zr.settings.Logger.Debug("Failed to decode RabbitMQ message", zap.Error(err))
+		obsrecv.EndTracesOp(ctx, receiverTagValue, 0, err)
+		if err = delivery.Reject(false); err != nil {
+			zr.settings.Logger.Warn("Failed to reject RabbitMQ message", zap.Error(err))
+		}
+		return
+	}
+
+	numReceivedSpans := td.SpanCount()
+	consumerErr := zr.nextConsumer.ConsumeTraces(ctx, td)
+	obsrecv.EndTracesOp(ctx, receiverTagValue, numReceivedSpans, consumerErr)
+
+	switch {
+	case consumerErr == nil:
+		zr.requeueDelay = 0
+		err = delivery.Ack(false)
+	case consumererror.IsPermanent(consumerErr):
+		zr.requeueDelay = 0
+		err = delivery.Reject(false)
+	default:
+		zr.waitBeforeRequeue(ctx)
+		err = delivery.Nack(false, true)
+	}
+	if err != nil {
+		zr.settings.Logger.Warn("Failed to acknowledge RabbitMQ message", zap.Error(err))
+	}
+}
+
+// waitBeforeRequeue waits before a message is requeued, doubling the delay for
+// each consecutive failure, so that the messages the next consumer keeps failing
+// to consume don't bounce between the broker and the receiver without pause.
+func (zr *zipkinReceiver) waitBeforeRequeue(ctx context.Context) {
+	initialInterval := zr.config.RabbitMQ.RequeueBackOff.InitialInterval
+	if initialInterval == 0 {
+		initialInterval = defaultRabbitMQRequeueInitialInterval
+	}
+	maxInterval := zr.config.RabbitMQ.RequeueBackOff.MaxInterval
+	if maxInterval == 0 {
+		maxInterval = defaultRabbitMQRequeueMaxInterval
+	}
+
+	if zr.requeueDelay == 0 {
+		zr.requeueDelay = min(initialInterval, maxInterval)
+	} else {
+		zr.requeueDelay = min(2*zr.requeueDelay, maxInterval)
+	}
+	zr.settings.Logger.Debug("Backing off before requeuing RabbitMQ message", zap.Duration("delay", zr.requeueDelay))
+	select {
+	case <-ctx.Done():
+	case <-time.After(zr.requeueDelay):
+	}
+}
+
+// unmarshalMessage decodes a list of spans published by a Zipkin reporter. The
+// encoding is taken from the content type of the message, falling back to
+// detecting it from the payload like the Zipkin collector does.
+func (zr *zipkinReceiver) unmarshalMessage(contentType string, body []byte) (ptrace.Traces, string, error) {
+	switch {
+	case contentType == "application/x-protobuf":
+		td, err := zr.protobufUnmarshaler.UnmarshalTraces(body)
+		return td, zipkinV2TagValue, err
+	case contentType == "application/x-thrift":
+		td, err := zr.v1ThriftUnmarshaler.UnmarshalTraces(body)
+		return td, zipkinV1TagValue, err
+	case len(body) > 0 && body[0] == '[':
+		if bytes.Contains(body, []byte(`"binaryAnnotations"`)) {
+			td, err := zr.v1JSONUnmarshaler.UnmarshalTraces(body)
+			return td, zipkinV1TagValue, err
+		}
+		td, err := zr.jsonUnmarshaler.UnmarshalTraces(body)
+		return td, zipkinV2TagValue, err
+	case len(body) > 0 && body[0] == 0x0a:
+		// Field 1 (spans) of the proto3 ListOfSpans message.
+		td, err := zr.protobufUnmarshaler.UnmarshalTraces(body)
+		return td, zipkinV2TagValue, err
+	default:
+		td, err := zr.v1ThriftUnmarshaler.UnmarshalTraces(body)
+		return td, zipkinV1TagValue, err
+	}
+}
@@ -0,0 +1,258 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package zipkinreceiver
+
+import (
+	"context"
+	"errors"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	amqp "github.com/rabbitmq/amqp091-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/config/confighttp"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/consumer/consumererror"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/rabbitmq"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/translator/zipkin/zipkinv2"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/zipkinreceiver/internal/metadata"
+)
+
+func TestUnmarshalMessage(t *testing.T) {
+	zr, err := newReceiver(&Config{}, consumertest.NewNop(), receivertest.NewNopSettings(metadata.Type))
+	require.NoError(t, err)
+
+	v2JSON, err := os.ReadFile(zipkinV2Single)
+	require.NoError(t, err)
+	v1JSON, err := os.ReadFile(zipkinV1SingleBatch)
+	require.NoError(t, err)
+	td, err := zipkinv2.NewJSONTracesUnmarshaler(false).UnmarshalTraces(v2JSON)
+	require.NoError(t, err)
+	v2Proto, err := zipkinv2.NewProtobufTracesMarshaler().MarshalTraces(td)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name        string
+		contentType string
+		body        []byte
+		wantTag     string
+		wantErr     bool
+	}{
+		{name: "v2_json", contentType: "application/json", body: v2JSON, wantTag: zipkinV2TagValue},
+		{name: "v2_json_detected", body: v2JSON, wantTag: zipkinV2TagValue},
+		{name: "v1_json_detected", body: v1JSON, wantTag: zipkinV1TagValue},
+		{name: "v2_proto", contentType: "application/x-protobuf", body: v2Proto, wantTag: zipkinV2TagValue},
+		{name: "v2_proto_detected", body: v2Proto, wantTag: zipkinV2TagValue},
+		{name: "invalid", body: []byte("+$%"), wantTag: zipkinV1TagValue, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, tag, err := zr.unmarshalMessage(tt.contentType, tt.body)
+			assert.Equal(t, tt.wantTag, tag)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Positive(t, got.SpanCount())
+		})
+	}
+}
+
+type fakeAcknowledger struct {
+	acked    bool
+	requeued bool
+	rejected bool
+}
+
+func (a *fakeAcknowledger) Ack(uint64, bool) error {
+	a.acked = true
+	return nil
+}
+
+func (a *fakeAcknowledger) Nack(_ uint64, _, requeue bool) error {
+	a.requeued = requeue
+	a.rejected = !requeue
+	return nil
+}
+
+func (a *fakeAcknowledger) Reject(_ uint64, requeue bool) error {
+	a.requeued = requeue
+	a.rejected = !requeue
+	return nil
+}
+
+func TestHandleDelivery(t *testing.T) {
+	v2JSON, err := os.ReadFile(zipkinV2Single)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name         string
+		nextConsumer consumer.Traces
+		body         []byte
+		want         fakeAcknowledger
+	}{
+		{
+			name:         "consumed",
+			nextConsumer: consumertest.NewNop(),
+			body:         v2JSON,
+			want:         fakeAcknowledger{acked: true},
+		},
+		{
+			name:         "transient_error",
+			nextConsumer: consumertest.NewErr(errors.New("consumer error")),
+			body:         v2JSON,
+			want:         fakeAcknowledger{requeued: true},
+		},
+		{
+			name:         "permanent_error",
+			nextConsumer: consumertest.NewErr(consumererror.NewPermanent(errors.New("consumer error"))),
+			body:         v2JSON,
+			want:         fakeAcknowledger{rejected: true},
+		},
+		{
+			name:         "invalid_message",
+			nextConsumer: consumertest.NewNop(),
+			body:         []byte("+$%"),
+			want:         fakeAcknowledger{rejected: true},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &Config{RabbitMQ: &RabbitMQConfig{RequeueBackOff: RabbitMQBackOffConfig{InitialInterval: time.Millisecond}}}
+			zr, err := newReceiver(cfg, tt.nextConsumer, receivertest.NewNopSettings(metadata.Type))
+			require.NoError(t, err)
+
+			ack := &fakeAcknowledger{}
+			zr.handleDelivery(context.Background(), amqp.Delivery{Acknowledger: ack, Body: tt.body})
+			assert.Equal(t, tt.want, *ack)
+		})
+	}
+}
+
+func TestWaitBeforeRequeue(t *testing.T) {
+	v2JSON, err := os.ReadFile(zipkinV2Single)
+	require.NoError(t, err)
+
+	nextConsumer := &consumertest.TracesSink{}
+	cfg := &Config{RabbitMQ: &RabbitMQConfig{RequeueBackOff: RabbitMQBackOffConfig{
+		InitialInterval: time.Millisecond,
+		MaxInterval:     4 * time.Millisecond,
+	}}}
+	zr, err := newReceiver(cfg, nextConsumer, receivertest.NewNopSettings(metadata.Type))
+	require.NoError(t, err)
+
+	// The delay is doubled for each consecutive failure, up to the max interval.
+	var delays []time.Duration
+	for range 4 {
+		zr.waitBeforeRequeue(context.Background())
+		delays = append(delays, zr.requeueDelay)
+	}
+	assert.Equal(t, []time.Duration{time.Millisecond, 2 * time.Millisecond, 4 * time.Millisecond, 4 * time.Millisecond}, delays)
+
+	// The delay is reset once a message is consumed.
+	zr.handleDelivery(context.Background(), amqp.Delivery{Acknowledger: &fakeAcknowledger{}, Body: v2JSON})
+	assert.Zero(t, zr.requeueDelay)
+}
+
+type fakeAmqpClient struct {
+	connection *fakeConnection
+	dialConfig rabbitmq.DialConfig
+}
+
+func (c *fakeAmqpClient) DialConfig(config rabbitmq.DialConfig) (rabbitmq.Connection, error) {
+	c.dialConfig = config
+	return c.connection, nil
+}
+
+type fakeConnection struct {
+	channel *fakeChannel
+}
+
+func (*fakeConnection) ReconnectIfUnhealthy() error { return nil }
+
+func (*fakeConnection) IsClosed() bool { return false }
+
+func (c *fakeConnection) Channel() (rabbitmq.Channel, error) { return c.channel, nil }
+
+func (*fakeConnection) NotifyClose(receiver chan *amqp.Error) chan *amqp.Error { return receiver }
+
+func (*fakeConnection) Close() error { return nil }
+
+type fakeChannel struct {
+	deliveries chan amqp.Delivery
+	closeOnce  sync.Once
+
+	queue         string
+	prefetchCount int
+}
+
+func (*fakeChannel) Confirm(bool) error { return nil }
+
+func (*fakeChannel) PublishWithDeferredConfirmWithContext(context.Context, string, string, bool, bool, amqp.Publishing) (rabbitmq.DeferredConfirmation, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (*fakeChannel) NotifyReturn(receiver chan amqp.Return) chan amqp.Return { return receiver }
+
+func (c *fakeChannel) Qos(prefetchCount, _ int, _ bool) error {
+	c.prefetchCount = prefetchCount
+	return nil
+}
+
+func (c *fakeChannel) ConsumeWithContext(ctx context.Context, queue, _ string, _, _, _, _ bool, _ amqp.Table) (<-chan amqp.Delivery, error) {
+	c.queue = queue
+	go func() {
+		<-ctx.Done()
+		c.closeOnce.Do(func() { close(c.deliveries) })
+	}()
+	return c.deliveries, nil
+}
+
+func (*fakeChannel) IsClosed() bool { return false }
+
+func (*fakeChannel) Close() error { return nil }
+
+func TestRabbitMQConsume(t *testing.T) {
+	v2JSON, err := os.ReadFile(zipkinV2Single)
+	require.NoError(t, err)
+
+	sink := new(consumertest.TracesSink)
+	cfg := &Config{
+		ServerConfig: confighttp.ServerConfig{
+			Endpoint: "localhost:0",
+		},
+		RabbitMQ: &RabbitMQConfig{
+			Endpoint:      "amqp://localhost:5672",
+			Auth:          RabbitMQAuthConfig{Username: "guest", Password: "guest"},
+			PrefetchCount: 10,
+		},
+	}
+	zr, err := newReceiver(cfg, sink, receivertest.NewNopSettings(metadata.Type))
+	require.NoError(t, err)
+
+	channel := &fakeChannel{deliveries: make(chan amqp.Delivery, 1)}
+	client := &fakeAmqpClient{connection: &fakeConnection{channel: channel}}
+	zr.amqpClient = client
+
+	require.NoError(t, zr.Start(context.Background(), componenttest.NewNopHost()))
+	ack := &fakeAcknowledger{}
+	channel.deliveries <- amqp.Delivery{Acknowledger: ack, ContentType: "application/json", Body: v2JSON}
+	assert.Eventually(t, func() bool {
+		return sink.SpanCount() > 0
+	}, time.Second, 10*time.Millisecond)
+	require.NoError(t, zr.Shutdown(context.Background()))
+
+	assert.True(t, ack.acked)
+	assert.Equal(t, defaultRabbitMQQueue, channel.queue)
+	assert.Equal(t, 10, channel.prefetchCount)
+	assert.Equal(t, "amqp://localhost:5672", client.dialConfig.URL)
+}
@@ -13,6 +13,7 @@ import (
 	"net/http"
 	"strings"
 	"sync"
+	"time"
 
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/component/componentstatus"
@@ -22,6 +23,7 @@ import (
 	"go.opentelemetry.io/collector/receiver"
 	"go.opentelemetry.io/collector/receiver/receiverhelper"
 
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/rabbitmq"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/translator/zipkin/zipkinv1"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/translator/zipkin/zipkinv2"
 )
@@ -52,6 +54,13 @@ type zipkinReceiver struct {
 	protobufUnmarshaler      ptrace.Unmarshaler
 	protobufDebugUnmarshaler ptrace.Unmarshaler
 
+	amqpClient     rabbitmq.AmqpClient
+	amqpConnection rabbitmq.Connection
+	cancelConsume  context.CancelFunc
+	// requeueDelay is the delay before requeuing the last message the next
+	// consumer failed to consume, reset once a message is consumed.
+	requeueDelay time.Duration
+
 	settings  receiver.Settings
 	obsrecvrs map[string]*receiverhelper.ObsReport
 }
@@ -60,7 +69,7 @@ var _ http.Handler = (*zipkinReceiver)(nil)
 
 // newReceiver creates a new zipkinReceiver reference.
 func newReceiver(config *Config, nextConsumer consumer.Traces, settings receiver.Settings) (*zipkinReceiver, error) {
-	transports := []string{receiverTransportV1Thrift, receiverTransportV1JSON, receiverTransportV2JSON, receiverTransportV2PROTO}
+	transports := []string{receiverTransportV1Thrift, receiverTransportV1JSON, receiverTransportV2JSON, receiverTransportV2PROTO, receiverTransportRabbitMQ}
 	obsrecvrs := make(map[string]*receiverhelper.ObsReport)
 	for _, transport := range transports {
 		obsrecv, err := receiverhelper.NewObsReport(receiverhelper.ObsReportSettings{
@@ -82,6 +91,7 @@ func newReceiver(config *Config, nextConsumer consumer.Traces, settings receiver
 		jsonUnmarshaler:          zipkinv2.NewJSONTracesUnmarshaler(config.ParseStringTags),
 		protobufUnmarshaler:      zipkinv2.NewProtobufTracesUnmarshaler(false, config.ParseStringTags),
 		protobufDebugUnmarshaler: zipkinv2.NewProtobufTracesUnmarshaler(true, config.ParseStringTags),
+		amqpClient:               rabbitmq.NewAmqpClient(settings.Logger),
 		settings:                 settings,
 		obsrecvrs:                obsrecvrs,
 	}
@@ -114,6 +124,9 @@ func (zr *zipkinReceiver) Start(ctx context.Context, host component.Host) error
 		}
 	}()
 
+	if zr.config.RabbitMQ != nil {
+		return zr.startRabbitMQ(ctx)
+	}
 	return nil
 }
 
@@ -151,12 +164,18 @@ func (zr *zipkinReceiver) v2ToTraceSpans(blob []byte, hdr http.Header) (reqs ptr
 // giving it a chance to perform any necessary clean-up and shutting down
 // its HTTP server.
 func (zr *zipkinReceiver) Shutdown(context.Context) error {
-	var err error
+	var errs []error
 	if zr.server != nil {
-		err = zr.server.Close()
+		errs = append(errs, zr.server.Close())
+	}
+	if zr.cancelConsume != nil {
+		zr.cancelConsume()
+	}
+	if zr.amqpConnection != nil {
+		errs = append(errs, zr.amqpConnection.Close())
 	}
 	zr.shutdownWG.Wait()
-	return err
+	return errors.Join(errs...)
 }
 
 // processBodyIfNecessary checks the "Content-Encoding" HTTP header and if